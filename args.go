@@ -1,20 +1,27 @@
 package arm
 
+import "math"
+
 // Arg is any instruction argument.
 //
 // The following are argument types:
-//   - [Reg]: integer, SP, SIMD scalar, or SIMD vector register (with optional element index)
+//   - [Reg]: integer, SP, SIMD scalar, SIMD vector, SVE vector, SVE predicate, or SME ZA register (with optional element index)
 //   - [RegList]: list of sequential registers
+//   - [ZASlice]: horizontal or vertical slice of an SME ZA tile, or a vector of the ZA array
 //   - [Ref]: memory reference with register base, optionally followed by X register or immediate for post-indexing
 //   - [RefOffset]: memory reference with register base and immediate offset
 //   - [RefPreIndexed]: pre-indexed memory reference with register base and immediate offset
 //   - [RefIndexed]: memory index with register base, register index, and optional index modifier
+//   - [RefVL]: memory reference with register base and immediate offset scaled by the vector length
 //   - [Imm]: 32-bit immediate integer
 //   - [Float]: 32-bit immediate float
+//   - [Double]: 64-bit immediate float
+//   - [Half]: 16-bit immediate float
 //   - [Wide]: 64-bit immediate integer
 //   - [Mod]: modifier with optional immediate shift/rotate
 //   - [Label]: label reference with optional offset from label address
 //   - [Symbol]: constant identifier
+//   - [SystemReg]: system register for MRS/MSR
 type Arg interface {
 	arg()
 }
@@ -29,6 +36,9 @@ type Arg interface {
 //   - Stack Pointer: [WSP], [XSP]
 //   - Scalar SIMD: [ScalarB], [ScalarH], [ScalarS], [ScalarD], [ScalarQ]
 //   - Vector SIMD: [Vec4B], [Vec8B], [Vec16B], [Vec2H], [Vec4H], [Vec8H], [Vec2S], [Vec4S], [Vec1D], [Vec2D], [Vec1Q]
+//   - Scalable Vector: [Z], [ZB], [ZH], [ZS], [ZD], [ZQ]
+//   - Scalable Predicate: [P], [PB], [PH], [PS], [PD], qualified with [Reg.Zeroing] or [Reg.Merging]
+//   - SME Matrix: [ZA], [ZAB], [ZAH], [ZAS], [ZAD], [ZAQ]
 type Reg struct {
 	ID      uint8   // 0-31 register number
 	Type    RegType // element size; integer, SP, or SIMD register type; 34/64/128-bit indicator
@@ -45,6 +55,18 @@ type RegList struct {
 
 func (r RegList) arg() {}
 
+// ZASlice is a horizontal or vertical slice of an SME ZA tile, selected by a W12-W15 index register
+// plus an immediate offset. A horizontal slice of the [ZA] array register is a ZA array vector.
+// ZA slices may be constructed with [Reg.H] and [Reg.V].
+type ZASlice struct {
+	Tile     Reg   // ZA|ZAB|ZAH|ZAS|ZAD|ZAQ
+	Idx      Reg   // W12-W15
+	Offset   uint8 // slice offset
+	Vertical bool  // vertical (V) or horizontal (H) slice
+}
+
+func (s ZASlice) arg() {}
+
 // ----------------------------------------------------------------
 
 // Ref is a memory reference argument with a register base.
@@ -64,6 +86,7 @@ type RefOffset struct {
 func (r RefOffset) arg() {}
 
 // RefPreIndexed is a pre-indexed memory reference argument with a register base and immediate offset.
+// Memory copy and set instructions use a zero offset for their writeback-only operands ([Xn]!).
 type RefPreIndexed struct {
 	Base   Reg // X|SP
 	Offset int32
@@ -71,11 +94,20 @@ type RefPreIndexed struct {
 
 func (r RefPreIndexed) arg() {}
 
+// RefVL is a memory reference argument with a register base and an immediate offset,
+// in multiples of the scalable vector or predicate length (MUL VL).
+type RefVL struct {
+	Base   Reg // X|SP
+	Offset int32
+}
+
+func (r RefVL) arg() {}
+
 // RefIndexed is an memory reference argument with a register base, register index, and optional index modifier.
 type RefIndexed struct {
 	Base Reg // X|SP
-	Idx  Reg // X|W
-	Mod  Mod // Idx=X: LSL|SXTX, Idx=W: SXTW|UXTW; LSL requires imm
+	Idx  Reg // X|W|Z
+	Mod  Mod // Idx=X: LSL|SXTX, Idx=W: SXTW|UXTW, Idx=Z: LSL|SXTW|UXTW; LSL requires imm
 }
 
 func (r RefIndexed) arg() {}
@@ -97,10 +129,49 @@ type Float float32
 
 func (i Float) arg() {}
 
+// Double is a 64-bit float immediate argument.
+type Double float64
+
+func (i Double) arg() {}
+
+// Half is a 16-bit float immediate argument, holding the bits of an IEEE 754 half-precision value.
+type Half uint16
+
+func (i Half) arg() {}
+
+// Float64 returns the value of h as a 64-bit float. The conversion is exact.
+func (h Half) Float64() float64 {
+	sign, exp, frac := uint64(h>>15), uint64(h>>10)&0x1F, uint64(h)&0x3FF
+	switch {
+	case exp == 0x1F: // infinity or NaN
+		return math.Float64frombits(sign<<63 | 0x7FF<<52 | frac<<42)
+	case exp == 0 && frac == 0:
+		return math.Float64frombits(sign << 63)
+	case exp == 0: // subnormal
+		return math.Copysign(math.Ldexp(float64(frac), -24), float64(1-2*int(sign)))
+	}
+	return math.Float64frombits(sign<<63 | (exp+1008)<<52 | frac<<42)
+}
+
+// halfFromFloat64 returns the half-precision bits of f, which must be exactly representable as a 16-bit float.
+func halfFromFloat64(f float64) Half {
+	bits := math.Float64bits(f)
+	sign, exp, frac := uint16(bits>>63)<<15, int(bits>>52)&0x7FF, bits&(1<<52-1)
+	switch {
+	case exp == 0x7FF: // infinity or NaN
+		return Half(sign | 0x7C00 | uint16(frac>>42))
+	case exp == 0:
+		return Half(sign)
+	case exp < 1009: // subnormal
+		return Half(sign | uint16((1<<52|frac)>>(1051-exp)))
+	}
+	return Half(sign | uint16(exp-1008)<<10 | uint16(frac>>42))
+}
+
 // ----------------------------------------------------------------
 
-// Mod is a shift, rotate, or extension modifier argument.
-// Shift and rotate modifiers require an immediate.
+// Mod is a shift, rotate, extension, or multiplier modifier argument.
+// Shift, rotate, and multiplier modifiers require an immediate.
 //
 // The following modifiers are available:
 //
@@ -117,6 +188,7 @@ func (i Float) arg() {}
 //   - [ModASR]: shift modifier with ID [SymASR]
 //   - [ModROR]: rotate modifier with ID [SymROR]
 //   - [ModMSL]: shift modifier with ID [SymMSL]
+//   - [ModMUL]: multiplier modifier with ID [SymMUL]
 type Mod struct {
 	ID     uint8 // modifier symbol
 	ImmInv uint8 // bitwise complement, zero indicates unset
@@ -143,32 +215,160 @@ func (l Symbol) arg() {}
 
 // ----------------------------------------------------------------
 
-// Flat is an internal argument, flattened for encoding.
-type Flat interface {
-	flat()
+// SystemReg is a system register argument for MRS and MSR (register), encoded as op0:op1:CRn:CRm:op2.
+// Named system registers are listed in [SystemRegs], and other registers may be constructed with [SysReg].
+type SystemReg uint16
+
+func (r SystemReg) arg() {}
+
+// ----------------------------------------------------------------
+
+// Operand is an argument converted to a fixed-size tagged value, for matching and encoding without interface
+// type switches. Arguments are converted by [ArgOperand] when passed to [Assembler.Inst].
+type Operand struct {
+	Kind OperandKind
+	Reg  Reg    // register, first register of a list, memory base register, or ZA tile
+	Idx  Reg    // memory index register, or ZA slice index register
+	Mod  Mod    // modifier, or memory index modifier
+	Len  uint8  // register list length, or ZA slice offset
+	Vert bool   // vertical ZA slice
+	Imm  uint64 // immediate, float bits, memory offset, symbol, system register, or label ID and offset
 }
 
-// FlatReg is an internal register argument, flattened for encoding.
-type FlatReg uint8
+// OperandKind is the argument type of an [Operand].
+type OperandKind uint8
+
+const (
+	OperandNone OperandKind = iota
+	OperandReg
+	OperandRegList
+	OperandZASlice
+	OperandRef
+	OperandRefOffset
+	OperandRefPreIndexed
+	OperandRefVL
+	OperandRefIndexed
+	OperandImm
+	OperandWide
+	OperandFloat
+	OperandDouble
+	OperandHalf
+	OperandMod
+	OperandLabel
+	OperandSymbol
+	OperandSystemReg
+)
+
+// ArgOperand converts arg to an [Operand]. Immediates are sign-extended to 64 bits, and floats are stored as
+// the bits of an equal 64-bit float.
+func ArgOperand(arg Arg) Operand {
+	switch arg := arg.(type) {
+	case Reg:
+		return Operand{Kind: OperandReg, Reg: arg}
+	case RegList:
+		return Operand{Kind: OperandRegList, Reg: arg.First, Len: arg.Len}
+	case ZASlice:
+		return Operand{Kind: OperandZASlice, Reg: arg.Tile, Idx: arg.Idx, Len: arg.Offset, Vert: arg.Vertical}
+	case Ref:
+		return Operand{Kind: OperandRef, Reg: arg.Base}
+	case RefOffset:
+		return Operand{Kind: OperandRefOffset, Reg: arg.Base, Imm: uint64(arg.Offset)}
+	case RefPreIndexed:
+		return Operand{Kind: OperandRefPreIndexed, Reg: arg.Base, Imm: uint64(arg.Offset)}
+	case RefVL:
+		return Operand{Kind: OperandRefVL, Reg: arg.Base, Imm: uint64(arg.Offset)}
+	case RefIndexed:
+		return Operand{Kind: OperandRefIndexed, Reg: arg.Base, Idx: arg.Idx, Mod: arg.Mod}
+	case Imm:
+		return Operand{Kind: OperandImm, Imm: uint64(arg)}
+	case Wide:
+		return Operand{Kind: OperandWide, Imm: uint64(arg)}
+	case Float:
+		return Operand{Kind: OperandFloat, Imm: math.Float64bits(float64(arg))}
+	case Double:
+		return Operand{Kind: OperandDouble, Imm: math.Float64bits(float64(arg))}
+	case Half:
+		return Operand{Kind: OperandHalf, Imm: math.Float64bits(arg.Float64())}
+	case Mod:
+		return Operand{Kind: OperandMod, Mod: arg}
+	case Label:
+		return Operand{Kind: OperandLabel, Imm: uint64(arg.ID) | uint64(uint32(arg.Offset))<<32}
+	case Symbol:
+		return Operand{Kind: OperandSymbol, Imm: uint64(arg)}
+	case SystemReg:
+		return Operand{Kind: OperandSystemReg, Imm: uint64(arg)}
+	}
+	return Operand{}
+}
 
-func (r FlatReg) flat() {}
+// Arg converts o to the argument it was converted from by [ArgOperand], or nil if o is unset.
+func (o Operand) Arg() Arg {
+	switch o.Kind {
+	case OperandReg:
+		return o.Reg
+	case OperandRegList:
+		return RegList{First: o.Reg, Len: o.Len}
+	case OperandZASlice:
+		return ZASlice{Tile: o.Reg, Idx: o.Idx, Offset: o.Len, Vertical: o.Vert}
+	case OperandRef:
+		return Ref{Base: o.Reg}
+	case OperandRefOffset:
+		return RefOffset{Base: o.Reg, Offset: int32(o.Imm)}
+	case OperandRefPreIndexed:
+		return RefPreIndexed{Base: o.Reg, Offset: int32(o.Imm)}
+	case OperandRefVL:
+		return RefVL{Base: o.Reg, Offset: int32(o.Imm)}
+	case OperandRefIndexed:
+		return RefIndexed{Base: o.Reg, Idx: o.Idx, Mod: o.Mod}
+	case OperandImm:
+		return Imm(o.Imm)
+	case OperandWide:
+		return Wide(o.Imm)
+	case OperandFloat:
+		return Float(math.Float64frombits(o.Imm))
+	case OperandDouble:
+		return Double(math.Float64frombits(o.Imm))
+	case OperandHalf:
+		return halfFromFloat64(math.Float64frombits(o.Imm))
+	case OperandMod:
+		return o.Mod
+	case OperandLabel:
+		return o.Label()
+	case OperandSymbol:
+		return Symbol(o.Imm)
+	case OperandSystemReg:
+		return SystemReg(o.Imm)
+	}
+	return nil
+}
 
-// FlatImm is an internal immediate argument, flattened for encoding.
-type FlatImm uint64
+// Label returns the label for an operand of kind [OperandLabel].
+func (o Operand) Label() Label { return Label{ID: uint32(o.Imm), Offset: int32(o.Imm >> 32)} }
 
-func (i FlatImm) flat() {}
+// ----------------------------------------------------------------
 
-// FlatMod is an internal modifier argument, flattened for encoding.
-type FlatMod uint8
+// Flat is an internal argument, flattened for encoding.
+type Flat struct {
+	Kind  FlatKind
+	Value uint64 // register number, immediate, modifier symbol, or label ID and offset
+}
 
-func (m FlatMod) flat() {}
+// FlatKind is the type of a flattened argument.
+type FlatKind uint8
 
-// FlatLabel is an internal label argument, flattened for encoding.
-type FlatLabel Label
+const (
+	FlatDefault FlatKind = iota // default argument for an omitted optional argument
+	FlatReg                     // register argument
+	FlatImm                     // immediate argument
+	FlatMod                     // modifier argument
+	FlatLabel                   // label argument
+)
 
-func (l FlatLabel) flat() {}
+// Label returns the label for a flattened argument of kind [FlatLabel].
+func (f Flat) Label() Label { return Label{ID: uint32(f.Value), Offset: int32(f.Value >> 32)} }
 
-// FlatDefault is an internal default argument, flattened for encoding.
-type FlatDefault struct{}
+// reg returns the register number for a flattened register argument.
+func (f Flat) reg() (uint8, bool) { return uint8(f.Value), f.Kind == FlatReg }
 
-func (d FlatDefault) flat() {}
+// imm returns the value for a flattened immediate argument.
+func (f Flat) imm() (uint64, bool) { return f.Value, f.Kind == FlatImm }
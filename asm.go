@@ -1,7 +1,8 @@
 // Package asm implements an ARMv8 (AArch64) instruction assembler in Go.
 //
 // This library is mostly adapted from the CensoredUsername/dynasm-rs (Rust) project, and is not heavily tested.
-// See https://github.com/CensoredUsername/dynasm-rs. SVE/SME instructions are not yet supported.
+// See https://github.com/CensoredUsername/dynasm-rs. SVE/SVE2 and SME instructions are partially supported; see
+// INSTRUCTIONS.md for the supported encodings.
 //
 // The Assembler type encodes executable instructions to a code buffer.
 //
@@ -9,18 +10,26 @@
 // and encoded after all label addresses are assigned.
 //
 // The following are argument types:
-//   - [Reg]: integer, SP, SIMD scalar, or SIMD vector register (with optional element index)
+//   - [Reg]: integer, SP, SIMD scalar, SIMD vector, SVE vector, SVE predicate, or SME ZA register (with optional element index)
 //   - [RegList]: list of sequential registers
+//   - [ZASlice]: horizontal or vertical slice of an SME ZA tile, or a vector of the ZA array
 //   - [Ref]: memory reference with register base, optionally followed by X register or immediate for post-indexing
 //   - [RefOffset]: memory reference with register base and immediate offset
 //   - [RefPreIndexed]: pre-indexed memory reference with register base and immediate offset
 //   - [RefIndexed]: memory index with register base, register index, and optional index modifier
+//   - [RefVL]: memory reference with register base and immediate offset scaled by the vector length
 //   - [Imm]: 32-bit immediate integer
 //   - [Float]: 32-bit immediate float
+//   - [Double]: 64-bit immediate float
+//   - [Half]: 16-bit immediate float
 //   - [Wide]: 64-bit immediate integer
 //   - [Mod]: modifier with optional immediate shift/rotate
 //   - [Label]: label reference with optional offset from label address
 //   - [Symbol]: constant identifier
+//   - [SystemReg]: system register for MRS/MSR
+//
+// An Assembler may be restricted to the features of a target [CPU] (see [LookupCPU]), in which case
+// instructions requiring unavailable features are rejected with a [*FeatureError].
 package arm
 
 // Assembler encodes executable instructions to a code buffer.
@@ -28,36 +37,58 @@ package arm
 // Some instructions support [Label] offset arguments, which may be resolved
 // and encoded after all label addresses are assigned.
 type Assembler struct {
-	Code    []byte   // code buffer indexed by PC
-	LabelPC []uint32 // label PC by ID
-	Relocs  []Reloc  // label references
-	Args    []Arg    // arguments for the current instruction
-	Flat    []Flat   // flattened arguments for the current matched instruction
-	PC      uint32   // current code offset
-
-	CurrentInst Inst   // current instruction mnemonic, offset into the Patterns array
-	Count       uint8  // available encodings for the current instruction
-	Idx         int8   // encoding index for the current instruction
-	SimdSize    uint8  // SIMD width for the current instruction when applicable
-	Opcode      uint32 // opcode (without arguments) for the current matched instruction
-	Err         error  // most recent error
+	Code    []byte      // code buffer indexed by PC
+	LabelPC []uint32    // label PC by ID
+	Relocs  []Reloc     // label references
+	Pool    []PoolConst // constants for pending literal loads, see [Assembler.EmitPool]
+	Args    []Operand   // arguments for the current instruction
+	Flat    []Flat      // flattened arguments for the current matched instruction
+	PC      uint32      // current code offset
+
+	CurrentInst Inst    // current instruction mnemonic, index into the PatternOffsets array
+	Count       uint8   // available encodings for the current instruction
+	Idx         int8    // encoding index for the current instruction
+	SimdSize    uint8   // SIMD width for the current instruction when applicable
+	Opcode      uint32  // opcode (without arguments) for the current matched instruction
+	Feature     Feature // feature required by the current matched instruction, or 0
+	Err         error   // most recent error
+
+	CPU   *CPU           // target features for gating instructions, or nil to allow all instructions; not reset by Init
+	Cache *EncodingCache // memoized encoding selection, or nil to match all encodings; not reset by Init
+
+	CollectErrors bool   // collect errors for failed instructions instead of setting Err; not reset by Init
+	Placeholder   uint32 // instruction written in place of failed instructions when collecting errors (UDF #0 if unset); not reset by Init
+	TrackSource   bool   // record the caller source position of each instruction, see [Assembler.SourceAt]; not reset by Init
+
+	Observers []Observer // callbacks for each instruction, label, and relocation; not reset by Init
 
 	patternLen  uint8  // argument-matcher count for the current instruction
-	patsOffset  uint16 // current offset within the Patterns array
-	cmdsOffset  uint16 // current offset within the Commands array
+	patsOffset  uint32 // current offset within the Patterns array
+	cmdsOffset  uint32 // current offset within the Commands array
 	cmdsLen     uint8  // encoding-command count for the current matched instruction
-	scratchArgs [6]Arg
+	scratchArgs [6]Operand
 	scratchFlat [12]Flat
 	pattern     [6]EncOp // current argument-matcher list unpacked from the Patterns array
 	cmds        [8]EncOp // current encoding-command list unpacked from the Commands array
+
+	labelMoves  []labelMove // label bindings replaced since the first snapshot, see [Assembler.Snapshot]
+	trackLabels bool        // record label bindings replaced by SetLabel
+	errs        []error     // errors collected for failed instructions, see [Assembler.Errors]
+	sources     []SourceLine
+	srcPos      SourcePos    // source position set by SetSourcePos
+	srcSet      bool         // srcPos is set
+	procs       []UnwindProc // call frame information, see [Assembler.CFIStartProc]
+	cfiOpen     bool         // the last procedure has not been ended
+	written     writtenInst  // most recent instruction written to the code buffer, see [Assembler.Explain]
+	observing   bool         // an observer callback is running; instructions encoded by AfterInst are not observed
 }
 
 // Reloc is a [Label] reference deferred for encoding after all relocations are being applied.
 // Relocations are used internally, and exposed for debugging.
 type Reloc struct {
-	InstPC uint32    // instruction with label offset argument
-	Op     uint8     // relocation type
-	Jump   FlatLabel // label ID with optional offset
+	InstPC uint32 // instruction with label offset argument
+	Op     uint8  // relocation type
+	Jump   Label  // label ID with optional offset
 }
 
 // EncOp is a matching or encoding operator decoded from the [Patterns] or [Commands] arrays.
@@ -69,18 +100,22 @@ type EncOp struct {
 
 // Initialize or re-initialize the assembler with a new code buffer, resetting the PC and all state.
 func (a *Assembler) Init(mem []byte) {
-	a.Code, a.PC, a.LabelPC, a.Relocs, a.Err = mem, 0, nil, nil, nil
+	a.Code, a.PC, a.LabelPC, a.Relocs, a.Pool, a.Err = mem, 0, nil, nil, nil, nil
+	a.labelMoves, a.trackLabels, a.errs, a.sources = nil, false, nil, nil
+	a.procs, a.cfiOpen = nil, false
 	a.CurrentInst = 0
 	a.Args = a.scratchArgs[:0]
 	a.Flat = a.scratchFlat[:0]
 	a.Count = 0
 	a.Opcode = 0
+	a.Feature = 0
 	a.Idx = -1
 	a.SimdSize = 0
 	a.patsOffset = 0
 	a.patternLen = 0
 	a.cmdsOffset = 0
 	a.cmdsLen = 0
+	a.written = writtenInst{}
 }
 
 // NewLabel registers a new label identifier at the current PC. The label may be used as an offset argument,
@@ -92,7 +127,15 @@ func (a *Assembler) NewLabel() Label {
 }
 
 // SetLabel sets the PC for a label to the current PC.
-func (a *Assembler) SetLabel(label Label) { a.LabelPC[label.ID] = a.PC }
+func (a *Assembler) SetLabel(label Label) {
+	if a.trackLabels {
+		a.labelMoves = append(a.labelMoves, labelMove{label.ID, a.LabelPC[label.ID]})
+	}
+	a.LabelPC[label.ID] = a.PC
+	for _, o := range a.Observers {
+		o.LabelBound(a, label, a.PC)
+	}
+}
 
 // Pattern returns the list of matching operators for the most recent matching iteration, useful for debugging.
 func (a *Assembler) Pattern() []EncOp { return a.pattern[:a.patternLen] }
@@ -101,89 +144,419 @@ func (a *Assembler) Pattern() []EncOp { return a.pattern[:a.patternLen] }
 func (a *Assembler) Commands() []EncOp { return a.cmds[:a.cmdsLen] }
 
 // ApplyRelocations patches all instructions containing label offset arguments, with the currently assigned
-// PC value for each label.
+// PC value for each label. If the CollectErrors field is set, instructions with label offsets which could not
+// be encoded are replaced with the placeholder, and errors are collected (see [Assembler.Errors]).
 func (a *Assembler) ApplyRelocations() bool {
 	if a.Err != nil {
 		return false
 	}
+	ok := true
 	for _, rel := range a.Relocs {
 		opcode := dec32(a.Code[rel.InstPC:])
 		targetPC := int64(a.LabelPC[rel.Jump.ID]) + int64(rel.Jump.Offset)
-		enc, ok := encOffset(rel.Op, targetPC-int64(rel.InstPC))
-		if !ok {
-			a.Err = ErrInvalidEncoding
-			return false
+		enc, encoded := encOffset(rel.Op, targetPC-int64(rel.InstPC))
+		if !encoded {
+			if !a.CollectErrors {
+				a.Err = ErrInvalidEncoding
+				return false
+			}
+			pos, _ := a.SourceAt(rel.InstPC)
+			a.errs = append(a.errs, &InstError{PC: rel.InstPC, Pos: pos, Err: ErrInvalidEncoding})
+			enc32(a.Code[rel.InstPC:], a.Placeholder)
+			ok = false
+			continue
 		}
 		enc32(a.Code[rel.InstPC:], opcode|enc)
+		for _, o := range a.Observers {
+			o.RelocApplied(a, rel, opcode|enc)
+		}
 	}
 	a.Relocs = nil
-	return true
+	return ok
+}
+
+// Errors returns the errors collected for failed instructions since the most recent call to [Assembler.Init]
+// when the CollectErrors field is set, followed by Err if set. Collected errors are [*InstError] values which wrap
+// the error for each instruction, and may be checked with errors.Is.
+func (a *Assembler) Errors() []error {
+	errs := append([]error(nil), a.errs...)
+	if a.Err != nil {
+		errs = append(errs, a.Err)
+	}
+	return errs
+}
+
+// instErr handles Err for a failed instruction at the current PC. If the CollectErrors field is set, the error
+// is collected, then Err is cleared and the placeholder is written in place of the instruction. Otherwise, if
+// source positions are tracked, Err is wrapped in an [*InstError] with the source position. Returns false.
+func (a *Assembler) instErr(inst Inst) bool {
+	if a.Err == nil {
+		return false
+	}
+	var pos SourcePos
+	if a.TrackSource || a.srcSet {
+		pos, _ = a.SourceAt(a.PC)
+	}
+	if !a.CollectErrors {
+		if pos != (SourcePos{}) {
+			a.Err = &InstError{PC: a.PC, Inst: inst, Pos: pos, Err: a.Err}
+		}
+		return false
+	}
+	a.errs = append(a.errs, &InstError{PC: a.PC, Inst: inst, Pos: pos, Err: a.Err})
+	a.Err = nil
+	for len(a.Relocs) != 0 && a.Relocs[len(a.Relocs)-1].InstPC == a.PC { // partially encoded
+		a.Relocs = a.Relocs[:len(a.Relocs)-1]
+	}
+	if int(a.PC)+4 <= len(a.Code) {
+		enc32(a.Code[a.PC:], a.Placeholder)
+		a.PC += 4
+	}
+	return false
 }
 
 // Inst advances to the first matched encoding for inst and args, then writes
 // the matched instruction to the code buffer if one was found.
 //
 // If no matching instruction was found or arguments could not be encoded,
-// the call will return false and the Err field will be set.
+// the call will return false and the Err field will be set. If the CPU field is set,
+// encodings which require unavailable features are skipped; when no other encoding
+// matches, Err will be set to a [*FeatureError].
+//
+// If the Cache field is set, the encoding matched for the shape of args is cached for later calls
+// (see [EncodingCache]). If the CollectErrors field is set, errors are collected instead of setting Err
+// (see [Assembler.Errors]).
 func (a *Assembler) Inst(inst Inst, args ...Arg) bool {
 	if a.Err != nil {
 		return false
 	}
-	if inst == 0 || inst > ZIP2 {
+	if a.TrackSource || a.srcSet {
+		a.recordPos(1)
+	}
+	if len(a.Observers) != 0 && !a.observing {
+		a.observeBefore(inst, args)
+		if a.inst(inst, args) {
+			return a.observeAfter(inst)
+		}
+		return a.instErr(inst)
+	}
+	if a.inst(inst, args) {
+		return true
+	}
+	return a.instErr(inst)
+}
+
+// inst writes the first matched encoding for inst and args, without collecting errors.
+func (a *Assembler) inst(inst Inst, args []Arg) bool {
+	if !a.beginInst(inst, args) {
+		return false
+	}
+	var missing Feature
+
+	var key shapeKey
+	cached := a.Cache != nil
+	if cached {
+		if key, cached = a.cacheKey(); cached && a.loadCached(key) {
+			return a.encodeMatched()
+		}
+	}
+
+	for a.firstPattern(); a.Idx < int8(a.Count); a.Idx++ { // each encoding pattern
+		cmdsOffset, feature, extra := a.nextPattern()
+		if !a.matchPattern() {
+			continue
+		}
+		if f := a.missingFeature(feature, extra); f != 0 {
+			if missing == 0 {
+				missing = f
+			}
+			continue
+		}
+		a.Feature = feature
+		a.loadCommands(cmdsOffset)
+		if cached {
+			a.storeCached(key)
+		}
+		return a.encodeMatched()
+	}
+
+	if missing != 0 {
+		a.Err = &FeatureError{Inst: inst, Feature: missing, CPU: a.CPU.Name}
+		return false
+	}
+	a.Err = ErrNoMatch
+	return false
+}
+
+// InstIdx writes inst with args to the code buffer using the encoding at index idx for inst (see [Encodings]
+// and [Assembler.Matches]), rather than the first matched encoding.
+//
+// If idx is not an encoding index for inst, Err will be set to [ErrInvalidIdx]. If args are not accepted by
+// the encoding, Err will be set to [ErrNoMatch], or to a [*FeatureError] if the encoding requires a feature
+// which is not available for the CPU. The Cache field is not used. Errors are collected as for [Assembler.Inst].
+func (a *Assembler) InstIdx(inst Inst, idx int8, args ...Arg) bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.TrackSource || a.srcSet {
+		a.recordPos(1)
+	}
+	if len(a.Observers) != 0 && !a.observing {
+		a.observeBefore(inst, args)
+		if a.instIdx(inst, idx, args) {
+			return a.observeAfter(inst)
+		}
+		return a.instErr(inst)
+	}
+	if a.instIdx(inst, idx, args) {
+		return true
+	}
+	return a.instErr(inst)
+}
+
+// instIdx writes the encoding at index idx for inst and args, without collecting errors.
+func (a *Assembler) instIdx(inst Inst, idx int8, args []Arg) bool {
+	if !a.beginInst(inst, args) {
+		return false
+	}
+	a.firstPattern()
+	if idx < 0 || idx >= int8(a.Count) {
+		a.Err = ErrInvalidIdx
+		return false
+	}
+	var cmdsOffset uint32
+	var feature, extra Feature
+	for ; a.Idx <= idx; a.Idx++ {
+		cmdsOffset, feature, extra = a.nextPattern()
+	}
+	a.Idx = idx
+	if !a.matchPattern() {
+		a.Err = ErrNoMatch
+		return false
+	}
+	if f := a.missingFeature(feature, extra); f != 0 {
+		a.Err = &FeatureError{Inst: inst, Feature: f, CPU: a.CPU.Name}
+		return false
+	}
+	a.Feature = feature
+	a.loadCommands(cmdsOffset)
+	return a.encodeMatched()
+}
+
+// Match is an encoding which accepts the arguments of an instruction, as returned by [Assembler.Matches].
+type Match struct {
+	Idx          int8    // encoding index for the instruction (see [Encodings] and [Assembler.InstIdx])
+	Feature      Feature // feature required by the encoding, or 0
+	ExtraFeature Feature // feature required in addition to Feature, or 0
+	Opcode       uint32  // encoded instruction, without label offsets
+}
+
+// Matches returns each encoding which accepts inst with args, in matching order, along with the instruction
+// encoded by each. The first match is the encoding selected by [Assembler.Inst]. If the CPU field is set,
+// encodings which require unavailable features are omitted.
+//
+// Nothing is written to the code buffer, and the PC, relocations, and Err field are unchanged. The state of
+// the most recent matched instruction (Idx, Opcode, ...) is overwritten.
+func (a *Assembler) Matches(inst Inst, args ...Arg) []Match {
+	var buf [4]byte
+	defer a.endDryRun(a.beginDryRun(buf[:]))
+	if !a.beginInst(inst, args) {
+		return nil
+	}
+	var matches []Match
+	for a.firstPattern(); a.Idx < int8(a.Count); a.Idx++ {
+		cmdsOffset, feature, extra := a.nextPattern()
+		if !a.matchPattern() || a.missingFeature(feature, extra) != 0 {
+			continue
+		}
+		a.Feature = feature
+		a.loadCommands(cmdsOffset)
+		a.PC = 0
+		if a.encode() {
+			matches = append(matches, Match{Idx: a.Idx, Feature: feature, ExtraFeature: extra, Opcode: dec32(buf[:])})
+		}
+		a.Err = nil
+		a.Relocs = a.Relocs[:0]
+	}
+	return matches
+}
+
+// Encode returns the instruction which [Assembler.Inst] would write for inst and args, without writing to
+// the code buffer. If an argument is a label offset, the returned opcode does not include the offset, and
+// relocKind is the relocation type for the offset (RelB, ...); otherwise relocKind is 0.
+//
+// The code buffer, PC, relocations, and Err field are unchanged, and the Cache field is not used. The state
+// of the most recent matched instruction (Idx, Opcode, ...) is overwritten.
+func (a *Assembler) Encode(inst Inst, args ...Arg) (opcode uint32, relocKind uint8, err error) {
+	var buf [4]byte
+	state := a.beginDryRun(buf[:])
+	cache := a.Cache
+	a.Cache = nil
+	if a.inst(inst, args) {
+		opcode = dec32(buf[:])
+		if len(a.Relocs) != 0 {
+			relocKind = a.Relocs[0].Op
+		}
+	}
+	err = a.Err
+	a.Cache = cache
+	a.endDryRun(state)
+	return opcode, relocKind, err
+}
+
+// CanEncode returns true if inst and args may be encoded by [Assembler.Inst], without writing to the code
+// buffer (see [Assembler.Encode]).
+func (a *Assembler) CanEncode(inst Inst, args ...Arg) bool {
+	_, _, err := a.Encode(inst, args...)
+	return err == nil
+}
+
+// dryRunState is the output state of an Assembler, saved while encoding to a scratch buffer.
+type dryRunState struct {
+	code    []byte
+	pc      uint32
+	relocs  []Reloc
+	err     error
+	written writtenInst
+}
+
+// beginDryRun redirects encoding to buf with empty relocations and no error, returning the saved state.
+func (a *Assembler) beginDryRun(buf []byte) dryRunState {
+	state := dryRunState{a.Code, a.PC, a.Relocs, a.Err, a.written}
+	a.Code, a.PC, a.Relocs, a.Err = buf, 0, nil, nil
+	return state
+}
+
+// endDryRun restores the state saved by beginDryRun.
+func (a *Assembler) endDryRun(state dryRunState) {
+	a.Code, a.PC, a.Relocs, a.Err, a.written = state.code, state.pc, state.relocs, state.err, state.written
+}
+
+// beginInst resets the state for the current instruction and converts args to operands, returning false
+// if inst can not be encoded.
+func (a *Assembler) beginInst(inst Inst, args []Arg) bool {
+	if a.Err != nil {
+		return false
+	}
+	if inst == 0 || int(inst) >= len(PatternOffsets) {
 		a.Err = ErrInvalidInst
 		return false
 	}
 	a.CurrentInst = inst
-	a.Args = append(a.scratchArgs[:0], args...)
+	a.Args = a.scratchArgs[:0]
+	for _, arg := range args {
+		a.Args = append(a.Args, ArgOperand(arg))
+	}
 	a.Flat = a.scratchFlat[:0]
 	a.SimdSize = 0
 	a.cmdsOffset = 0
 	a.cmdsLen = 0
+	a.Feature = 0
+	return true
+}
 
-	a.patsOffset = uint16(inst)
+// firstPattern advances to the first encoding pattern for the current instruction.
+func (a *Assembler) firstPattern() {
+	a.patsOffset = PatternOffsets[a.CurrentInst]
 	a.Count = uint8(Patterns[a.patsOffset])
 	a.patsOffset++
+	a.Idx = 0
+}
 
-	for a.Idx = 0; a.Idx < int8(a.Count); a.Idx++ { // each encoding pattern
-		a.patternLen = uint8(Patterns[a.patsOffset])
+// nextPattern unpacks the argument matchers for the encoding pattern at the current offset, then returns
+// the offset of its encoding operators in the Commands array and its required features.
+func (a *Assembler) nextPattern() (cmdsOffset uint32, feature, extra Feature) {
+	a.patternLen = uint8(Patterns[a.patsOffset])
+	a.patsOffset++
+	for m := uint8(0); m < a.patternLen; m++ { // each matcher for pattern
+		op := Patterns[a.patsOffset]
 		a.patsOffset++
-		for m := uint8(0); m < a.patternLen; m++ { // each matcher for pattern
-			op := Patterns[a.patsOffset]
-			a.patsOffset++
-			a.pattern[m].Op = op
-			xs := MatcherArgCounts[op]
-			copy(a.pattern[m].X[:], Patterns[a.patsOffset:a.patsOffset+uint16(xs)])
-			a.patsOffset += uint16(xs)
+		a.pattern[m].Op = op
+		xs := MatcherArgCounts[op]
+		for x := uint8(0); x < xs; x++ {
+			a.pattern[m].X[x] = Patterns[a.patsOffset+uint32(x)]
 		}
+		a.patsOffset += uint32(xs)
+	}
 
-		cmdsOffset := uint16(Patterns[a.patsOffset])<<8 | uint16(Patterns[a.patsOffset+1])
-		a.patsOffset += 2
+	cmdsOffset = uint32(Patterns[a.patsOffset])<<16 | uint32(Patterns[a.patsOffset+1])<<8 | uint32(Patterns[a.patsOffset+2])
+	feature, extra = Feature(Patterns[a.patsOffset+3]), Feature(Patterns[a.patsOffset+4])
+	a.patsOffset += 5
+	return cmdsOffset, feature, extra
+}
 
-		if !a.matchPattern() {
-			continue
+// missingFeature returns feature or extra if it is not available for the CPU, or 0 if both are available.
+func (a *Assembler) missingFeature(feature, extra Feature) Feature {
+	switch {
+	case a.CPU == nil:
+		return 0
+	case !a.CPU.Features.Has(feature):
+		return feature
+	case !a.CPU.Features.Has(extra):
+		return extra
+	}
+	return 0
+}
+
+// encodeMatched writes the matched instruction to the code buffer. Encoding failures are not retried
+// with other encodings.
+func (a *Assembler) encodeMatched() bool {
+	if !a.encode() {
+		if a.Err == nil {
+			a.Err = ErrInvalidEncoding
 		}
+		return false
+	}
+	a.recordWritten()
+	return true
+}
+
+// writtenInst is the encoding of an instruction written to the code buffer, recorded for [Assembler.Explain].
+type writtenInst struct {
+	inst    Inst
+	idx     int8
+	pc      uint32
+	cmdsLen uint8
+	cmds    [8]EncOp
+}
 
-		a.cmdsOffset = cmdsOffset
-		opcode := Commands[a.cmdsOffset : a.cmdsOffset+4]
-		a.Opcode = uint32(opcode[0])<<24 | uint32(opcode[1])<<16 | uint32(opcode[2])<<8 | uint32(opcode[3])
-		a.cmdsOffset += 4
-		a.cmdsLen = Commands[a.cmdsOffset]
+// recordWritten records the current matched instruction after it has been written to the code buffer.
+func (a *Assembler) recordWritten() {
+	a.written = writtenInst{inst: a.CurrentInst, idx: a.Idx, pc: a.PC - 4, cmdsLen: a.cmdsLen, cmds: a.cmds}
+}
+
+// loadCommands unpacks the opcode and encoding operators at offset in the Commands array.
+func (a *Assembler) loadCommands(offset uint32) {
+	a.cmdsOffset = offset
+	opcode := Commands[a.cmdsOffset : a.cmdsOffset+4]
+	a.Opcode = uint32(opcode[0])<<24 | uint32(opcode[1])<<16 | uint32(opcode[2])<<8 | uint32(opcode[3])
+	a.cmdsOffset += 4
+	a.cmdsLen = Commands[a.cmdsOffset]
+	a.cmdsOffset++
+	for i := uint8(0); i < a.cmdsLen; i++ {
+		op := Commands[a.cmdsOffset]
 		a.cmdsOffset++
-		for i := uint8(0); i < a.cmdsLen; i++ {
-			op := Commands[a.cmdsOffset]
-			a.cmdsOffset++
-			a.cmds[i].Op = op
-			xs := CmdArgCounts[op]
-			copy(a.cmds[i].X[:], Commands[a.cmdsOffset:a.cmdsOffset+uint16(xs)])
-			a.cmdsOffset += uint16(xs)
-		}
-		if !a.encode() {
-			a.Err = ErrInvalidEncoding
-			return false
+		a.cmds[i].Op = op
+		xs := CmdArgCounts[op]
+		for x := uint8(0); x < xs; x++ {
+			a.cmds[i].X[x] = Commands[a.cmdsOffset+uint32(x)]
 		}
-		return true
+		a.cmdsOffset += uint32(xs)
 	}
+}
 
-	a.Err = ErrNoMatch
-	return false
+// Features returns the union of features required by any encoding of inst.
+func (inst Inst) Features() Features {
+	if inst == 0 || int(inst) >= len(InstFeatures) {
+		return 0
+	}
+	return InstFeatures[inst]
+}
+
+// String returns the mnemonic for inst.
+func (inst Inst) String() string {
+	if inst == 0 || int(inst) >= len(InstName) {
+		return ""
+	}
+	return InstName[inst]
 }
@@ -1,16 +1,18 @@
 package arm
 
-import (
-	"math"
-	"math/bits"
-)
+import "math"
 
 // encode writes the matched instruction to the code buffer.
 func (a *Assembler) encode() bool {
+	a.flattenArgs()
+	return a.encodeFlat()
+}
+
+// encodeFlat writes the current instruction to the code buffer from flattened arguments.
+func (a *Assembler) encodeFlat() bool {
 	if int(a.PC)+4 > len(a.Code) {
 		return false
 	}
-	a.flattenArgs()
 	opcode, args, cursor := a.Opcode, a.Flat, uint8(0)
 Scan:
 	for _, cmd := range a.Commands() {
@@ -29,8 +31,9 @@ Scan:
 			continue Scan
 		}
 
-		switch arg := args[cursor].(type) {
+		switch flat := args[cursor]; flat.Kind {
 		case FlatReg:
+			arg := uint8(flat.Value)
 			switch cmd.Op {
 			default:
 				return false
@@ -59,13 +62,41 @@ Scan:
 				}
 				opcode |= uint32(arg) << offset
 			case CmdRNext:
-				prev, ok := args[cursor-1].(FlatReg)
+				prev, ok := args[cursor-1].reg()
 				if !ok || arg != (prev+1)%32 {
 					return false
 				}
+			case CmdRLo8:
+				offset := cmd.X[0]
+				if arg >= 8 {
+					return false
+				}
+				opcode |= uint32(arg) << offset
+			case CmdRSame:
+				back := cmd.X[0]
+				if cursor < back {
+					return false
+				}
+				prev, ok := args[cursor-back].reg()
+				if !ok || arg != prev {
+					return false
+				}
+			case CmdRbits:
+				offset, bitlen := cmd.X[0], cmd.X[1]
+				if uint32(arg) >= 1<<bitlen {
+					return false
+				}
+				opcode |= uint32(arg) << offset
+			case CmdRW12:
+				offset := cmd.X[0]
+				if arg < 12 || arg > 15 {
+					return false
+				}
+				opcode |= uint32(arg-12) << offset
 			}
 
 		case FlatMod:
+			arg := uint8(flat.Value)
 			switch cmd.Op {
 			case CmdRotates:
 				switch uint8(arg) {
@@ -107,9 +138,19 @@ Scan:
 						opcode |= 0b011 << 13
 					}
 				}
+			case CmdXs:
+				offset := cmd.X[0]
+				switch uint8(arg) {
+				default:
+					return false
+				case SymUXTW:
+				case SymSXTW:
+					opcode |= 1 << offset
+				}
 			}
 
 		case FlatImm:
+			arg := flat.Value
 			switch cmd.Op {
 			default:
 				return false
@@ -180,6 +221,23 @@ Scan:
 					return false
 				}
 				opcode |= ((uint32(arg) >> shift) & uint32(mask)) << offset
+			case CmdSscaled9:
+				shift := cmd.X[0]
+				const offset = 12
+				const mask = (int32(1) << 9) - 1
+				const half = (int32(1) << (9 - 1)) * -1
+				if !signedRangeCheck(int64(arg), half, mask+half, shift) {
+					return false
+				}
+				opcode |= ((uint32(arg) >> shift) & uint32(mask)) << offset
+			case CmdSfield:
+				offset, bitlen := cmd.X[0], cmd.X[1]
+				mask := (int32(1) << bitlen) - 1
+				half := (int32(1) << (bitlen - 1)) * -1
+				if !signedRangeCheck(int64(arg), half, mask+half, 0) {
+					return false
+				}
+				opcode |= (uint32(arg) & uint32(mask)) << offset
 			case CmdUslice, CmdSslice:
 				offset, bitlen, start := cmd.X[0], cmd.X[1], cmd.X[2]
 				mask := (uint32(1) << bitlen) - 1
@@ -211,7 +269,7 @@ Scan:
 					return false
 				}
 				mask := (uint64(1) << bitlen) - 1
-				prev, ok := args[cursor-1].(FlatImm)
+				prev, ok := args[cursor-1].imm()
 				if !ok {
 					return false
 				}
@@ -245,6 +303,9 @@ Scan:
 				offset, specialType := cmd.X[0], cmd.X[1]
 				enc, ok := encSpecialImm(offset, specialType, uint64(arg))
 				if !ok {
+					if specialType == SpecialImmFloat || specialType == SpecialImmFloatSplit {
+						a.Err = ErrInvalidFloatImm
+					}
 					return false
 				}
 				opcode |= enc
@@ -268,7 +329,7 @@ Scan:
 				if cursor == 0 {
 					return false
 				}
-				prev, ok := args[cursor-1].(FlatImm)
+				prev, ok := args[cursor-1].imm()
 				if !ok {
 					return false
 				}
@@ -290,6 +351,22 @@ Scan:
 				if !unsignedRangeCheck(uint64(arg), 1, max, 0) {
 					return false
 				}
+			case CmdChkSbits:
+				bitlen := cmd.X[0]
+				mask := (int32(1) << bitlen) - 1
+				half := (int32(1) << (bitlen - 1)) * -1
+				if !signedRangeCheck(int64(arg), half, mask+half, 0) {
+					return false
+				}
+			case CmdUsame:
+				back := cmd.X[0]
+				if cursor < back {
+					return false
+				}
+				prev, ok := args[cursor-back].imm()
+				if !ok || arg != prev {
+					return false
+				}
 			}
 
 		case FlatLabel:
@@ -297,7 +374,7 @@ Scan:
 				return false
 			}
 			relType := cmd.X[0]
-			a.Relocs = append(a.Relocs, Reloc{a.PC, relType, arg})
+			a.Relocs = append(a.Relocs, Reloc{a.PC, relType, flat.Label()})
 
 		case FlatDefault:
 			switch cmd.Op {
@@ -328,13 +405,21 @@ Scan:
 					return false
 				}
 				opcode |= uint32(i) << offset
+			case CmdUsame: // default to 0
+				back := cmd.X[0]
+				if cursor < back {
+					return false
+				}
+				if prev, ok := args[cursor-back].imm(); !ok || prev != 0 {
+					return false
+				}
 			}
 		}
 
 		switch cmd.Op {
 		default:
 			cursor++
-		case CmdUslice, CmdSslice, CmdChkUbits, CmdChkUsum, CmdChkSscaled, CmdChkUrange1:
+		case CmdUslice, CmdSslice, CmdChkUbits, CmdChkUsum, CmdChkSscaled, CmdChkUrange1, CmdChkSbits:
 			// non-consuming
 		}
 	}
@@ -388,9 +473,9 @@ func encOffset(relType uint8, imm int64) (opcode uint32, ok bool) {
 	return 0, false
 }
 
-func checkAlt(alts []uint16, v FlatImm) (i uint8, ok bool) {
+func checkAlt(alts []uint16, v uint64) (i uint8, ok bool) {
 	for i := len(alts) - 1; i >= 0; i-- {
-		if v == FlatImm(alts[i]) {
+		if v == uint64(alts[i]) {
 			return uint8(i), true
 		}
 	}
@@ -427,122 +512,69 @@ func encSpecialImm(offset, op uint8, v uint64) (opcode uint32, ok bool) {
 		return encImmFloat(offset, v)
 	case SpecialImmFloatSplit:
 		return encImmFloatSplit(offset, v)
+	case SpecialImmTszRight64:
+		return encImmTszRight64(offset, v)
 	}
 	return
 }
 
 func encImmLogical32(offset uint8, v uint64) (uint32, bool) {
-	if v > math.MaxUint32 {
-		return 0, false
-	}
-	transitions := uint32(v) ^ (bits.RotateLeft32(uint32(v), -1))
-	div := uint32(bits.OnesCount32(transitions))
-	if div == 0 {
-		return 0, false
-	}
-	elemSize := uint32(64) / div
-	if uint32(v) != bits.RotateLeft32(uint32(v), int(elemSize)) {
-		return 0, false
-	}
-	elem := uint32(v) & ((1 << elemSize) - 1)
-	ones := uint32(bits.OnesCount32(elem))
-	imms := (^((elemSize << 1) - 1) & 0x3F) | (ones - 1)
-	var immr uint32
-	if elem&1 != 0 {
-		immr = ones - uint32(bits.TrailingZeros32(^elem))
-	} else {
-		immr = elemSize - uint32(bits.TrailingZeros32(elem))
-	}
-	enc := (uint16(immr) << 6) | uint16(imms)
-	return uint32(enc) << offset, true
+	enc, ok := EncodeLogicalImm(v, DWORD)
+	return uint32(enc) << offset, ok
 }
 
 func encImmLogical64(offset uint8, v uint64) (uint32, bool) {
-	transitions := v ^ (bits.RotateLeft64(v, -1))
-	div := uint64(bits.OnesCount64(transitions))
-	if div == 0 {
-		return 0, false
-	}
-	elemSize := uint64(128) / div
-	if v != bits.RotateLeft64(v, int(elemSize)) {
-		return 0, false
-	}
-	elem := v & ((1 << elemSize) - 1)
-	ones := uint64(bits.OnesCount64(elem))
-	imms := (^((elemSize << 1) - 1) & 0x7F) | (ones - 1)
-	var immr uint64
-	if elem&1 != 0 {
-		immr = ones - uint64(bits.TrailingZeros64(^elem))
-	} else {
-		immr = elemSize - uint64(bits.TrailingZeros64(elem))
-	}
-	var n uint16
-	if imms&0x40 == 0 {
-		n = 1
-	}
-	imms &= 0x3F
-	enc := (n << 12) | (uint16(immr) << 6) | uint16(imms)
-	return uint32(enc) << offset, true
+	enc, ok := EncodeLogicalImm(v, QWORD)
+	return uint32(enc) << offset, ok
 }
 
 func encImmStretched(offset uint8, v uint64) (uint32, bool) {
-	chk := v & 0x0101010101010101
-	chk |= chk << 1
-	chk |= chk << 2
-	chk |= chk << 4
-	if v != chk {
+	enc, ok := EncodeByteMask(v)
+	if !ok {
 		return 0, false
 	}
-	masked := v & 0x8040201008040201
-	masked |= masked >> 32
-	masked |= masked >> 16
-	masked |= masked >> 8
-	enc := uint32(masked) & 0xFF
-	opcode := (enc & 0x1F) << offset
-	opcode |= (enc & 0xE0) << (offset + 6)
+	opcode := uint32(enc&0x1F) << offset
+	opcode |= uint32(enc&0xE0) << (offset + 6)
 	return opcode, true
 }
 
 func encImmWide64(offset uint8, v uint64) (uint32, bool) {
-	pos := uint32(bits.TrailingZeros64(v)) & 0b110000
-	masked := uint32(v>>pos) & 0xFFFF
-	if uint64(masked)<<pos != v {
-		return 0, false
-	}
-	enc := masked | (pos << 12)
-	return enc << offset, true
+	imm, shift, ok := EncodeWideImm(v, QWORD)
+	return (uint32(imm) | uint32(shift)<<12) << offset, ok
 }
 
 func encImmWide32(offset uint8, v uint64, invert bool) (uint32, bool) {
-	if v > math.MaxUint32 {
-		return 0, false
-	}
-	if invert {
+	if invert && v <= math.MaxUint32 {
 		v = uint64(uint32(^v))
 	}
-	pos := uint32(bits.TrailingZeros32(uint32(v))) & 0b10000
-	masked := uint32(v>>pos) & 0xFFFF
-	if uint64(masked)<<pos != v {
-		return 0, false
-	}
-	enc := masked | (pos << 12)
-	return enc << offset, true
+	imm, shift, ok := EncodeWideImm(v, DWORD)
+	return (uint32(imm) | uint32(shift)<<12) << offset, ok
 }
 
 func encImmFloat(offset uint8, v uint64) (uint32, bool) {
-	enc := uint8((((v >> 24) & 0x80) | ((v >> 19) & 0x7F)))
-	if chk := (uint32(v) >> 25) & 0x3F; (chk == 0b100000 || chk == 0b011111) && uint32(v)&0x7FFFF == 0 {
-		return uint32(enc) << offset, true
+	enc, ok := encFP8(v)
+	if !ok {
+		return 0, false
 	}
-	return 0, false
+	return uint32(enc) << offset, true
 }
 
 func encImmFloatSplit(offset uint8, v uint64) (uint32, bool) {
-	enc := uint8((((v >> 24) & 0x80) | ((v >> 19) & 0x7F)))
-	if chk := (uint32(v) >> 25) & 0x3F; (chk == 0b100000 || chk == 0b011111) && uint32(v)&0x7FFFF == 0 {
-		opcode := uint32(enc&0x1F) << offset
-		opcode |= uint32(enc&0xE0) << (offset + 6)
-		return opcode, true
+	enc, ok := encFP8(v)
+	if !ok {
+		return 0, false
 	}
-	return 0, false
+	opcode := uint32(enc&0x1F) << offset
+	opcode |= uint32(enc&0xE0) << (offset + 6)
+	return opcode, true
+}
+
+func encImmTszRight64(offset uint8, v uint64) (uint32, bool) {
+	if v < 1 || v > 64 {
+		return 0, false
+	}
+	enc := uint32(128 - v) // tsz:imm3, with the 64-bit element size marker at bit 6
+	opcode := (enc & 0x1F) << offset
+	opcode |= ((enc >> 5) & 0b11) << 22
+	return opcode, true
 }
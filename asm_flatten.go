@@ -1,7 +1,5 @@
 package arm
 
-import "math"
-
 // flattenArgs unnests matched arguments to an internal form before encoding.
 func (a *Assembler) flattenArgs() {
 	var cursor int
@@ -12,63 +10,67 @@ func (a *Assembler) flattenArgs() {
 		flatArgCount := int(MatcherFlatArgCounts[m.Op])
 		argCount := len(a.Flat)
 		if cursor < len(a.Args) {
-			switch arg := a.Args[cursor].(type) {
-			case Reg:
-				a.appendFlat(FlatReg(arg.ID))
-				if arg.HasElem() && m.Op != MatVElementStatic {
-					a.appendFlat(FlatImm(arg.GetElem()))
+			switch arg := a.Args[cursor]; arg.Kind {
+			case OperandReg, OperandRegList:
+				a.appendFlat(FlatReg, uint64(arg.Reg.ID))
+				if arg.Reg.HasElem() && m.Op != MatVElementStatic {
+					a.appendFlat(FlatImm, uint64(arg.Reg.GetElem()))
 				}
-			case RegList:
-				a.appendFlat(FlatReg(arg.First.ID))
-				if arg.First.HasElem() && m.Op != MatVElementStatic {
-					a.appendFlat(FlatImm(arg.First.GetElem()))
+			case OperandZASlice:
+				var vertical uint64
+				if arg.Vert {
+					vertical = 1
 				}
-			case Imm:
-				a.appendFlat(FlatImm(arg))
-			case Float:
-				a.appendFlat(FlatImm(math.Float32bits(float32(arg))))
-			case Wide:
-				a.appendFlat(FlatImm(arg))
-			case Ref:
-				a.appendFlat(FlatReg(arg.Base.ID))
-			case RefOffset:
-				a.appendFlat(FlatReg(arg.Base.ID), FlatImm(arg.Offset))
-			case RefPreIndexed:
-				a.appendFlat(FlatReg(arg.Base.ID), FlatImm(arg.Offset))
-			case RefIndexed:
-				a.appendFlat(FlatReg(arg.Base.ID), FlatReg(arg.Idx.ID))
+				a.appendFlat(FlatReg, uint64(arg.Reg.ID))
+				a.appendFlat(FlatImm, vertical)
+				a.appendFlat(FlatReg, uint64(arg.Idx.ID))
+				a.appendFlat(FlatImm, uint64(arg.Len))
+			case OperandImm, OperandWide, OperandFloat, OperandDouble, OperandHalf:
+				a.appendFlat(FlatImm, arg.Imm)
+			case OperandRef:
+				a.appendFlat(FlatReg, uint64(arg.Reg.ID))
+			case OperandRefOffset, OperandRefPreIndexed, OperandRefVL:
+				a.appendFlat(FlatReg, uint64(arg.Reg.ID))
+				a.appendFlat(FlatImm, arg.Imm)
+			case OperandRefIndexed:
+				a.appendFlat(FlatReg, uint64(arg.Reg.ID))
+				a.appendFlat(FlatReg, uint64(arg.Idx.ID))
 				if arg.Mod.ID != 0 {
-					a.appendFlat(FlatMod(arg.Mod.ID))
+					a.appendFlat(FlatMod, uint64(arg.Mod.ID))
 					if arg.Mod.HasImm() {
-						a.appendFlat(FlatImm(arg.Mod.GetImm()))
+						a.appendFlat(FlatImm, uint64(arg.Mod.GetImm()))
 					}
 				}
-			case Mod:
+			case OperandMod:
 				if flatArgCount >= 2 {
-					a.appendFlat(FlatMod(arg.ID))
+					a.appendFlat(FlatMod, uint64(arg.Mod.ID))
 				}
-				if arg.HasImm() {
-					a.appendFlat(FlatImm(arg.GetImm()))
+				if arg.Mod.HasImm() {
+					a.appendFlat(FlatImm, uint64(arg.Mod.GetImm()))
 				}
-			case Label:
-				a.appendFlat(FlatLabel(arg))
-			case Symbol:
-				switch arg {
+			case OperandSystemReg:
+				a.appendFlat(FlatImm, arg.Imm&0x7FFF) // op0 is encoded in 1 bit
+			case OperandLabel:
+				a.appendFlat(FlatLabel, arg.Imm)
+			case OperandSymbol:
+				switch Symbol(arg.Imm) {
 				case INVERTED, LOGICAL: // skip
 				default:
-					a.appendFlat(FlatImm(arg))
+					if flatArgCount != 0 { // literal symbols are matched, not encoded
+						a.appendFlat(FlatImm, arg.Imm)
+					}
 				}
 			}
 		}
 
 		for added := len(a.Flat) - argCount; added < flatArgCount; added++ {
-			a.appendFlat(FlatDefault{})
+			a.appendFlat(FlatDefault, 0)
 		}
 
 		cursor++
 	}
 }
 
-func (a *Assembler) appendFlat(flat ...Flat) {
-	a.Flat = append(a.Flat, flat...)
+func (a *Assembler) appendFlat(kind FlatKind, value uint64) {
+	a.Flat = append(a.Flat, Flat{Kind: kind, Value: value})
 }
@@ -1,5 +1,7 @@
 package arm
 
+import "math"
+
 // matchPattern returns true if the encoding at the current iterator position matches.
 func (a *Assembler) matchPattern() bool {
 	a.SimdSize = 0
@@ -23,7 +25,7 @@ func (a *Assembler) matchPattern() bool {
 	return a.matchArgSlice(args[required:], pattern[required+1:]) // skip MatEnd
 }
 
-func (a *Assembler) matchArgSlice(args []Arg, pattern []EncOp) bool {
+func (a *Assembler) matchArgSlice(args []Operand, pattern []EncOp) bool {
 	for i, m := range pattern {
 		if !a.matchArg(args[i], m) {
 			return false
@@ -32,9 +34,10 @@ func (a *Assembler) matchArgSlice(args []Arg, pattern []EncOp) bool {
 	return true
 }
 
-func (a *Assembler) matchArg(arg Arg, m EncOp) bool {
-	switch arg := arg.(type) {
-	case Reg:
+func (a *Assembler) matchArg(o Operand, m EncOp) bool {
+	switch o.Kind {
+	case OperandReg:
+		arg := o.Reg
 		if !checkReg(arg) {
 			return false
 		}
@@ -66,12 +69,29 @@ func (a *Assembler) matchArg(arg Arg, m EncOp) bool {
 		case MatVStaticElement:
 			return arg.HasElem() && arg.ElemSize() == Size(m.X[0]) && arg.Lanes() == m.X[1]
 		case MatVElement:
-			return arg.HasElem() && arg.ElemSize() == Size(m.X[0])
+			return arg.HasElem() && arg.Family() != RegVec32 && arg.ElemSize() == Size(m.X[0])
 		case MatVElementStatic:
-			return arg.HasElem() && arg.ElemSize() == Size(m.X[0]) && arg.GetElem() == m.X[1]
+			return arg.HasElem() && arg.Family() != RegVec32 && arg.ElemSize() == Size(m.X[0]) && arg.GetElem() == m.X[1]
+		// scalable
+		case MatZ:
+			return !arg.HasElem() && arg.Family() == RegSVE && arg.ElemSize() == Size(m.X[0])
+		case MatZElement:
+			return arg.HasElem() && arg.Family() == RegSVE && arg.ElemSize() == Size(m.X[0])
+		case MatP:
+			return arg.Family() == RegPred && arg.ElemSize() == Size(m.X[0])
+		case MatPZ:
+			return arg.Type == RPZ
+		case MatPM:
+			return arg.Type == RPM
+		// matrix
+		case MatZA:
+			return arg.Type == RZA
+		case MatZATile:
+			return arg.Family() == RegZA && arg.ElemSize() != 0 && arg.ElemSize() == Size(m.X[0])
 		}
 
-	case RegList:
+	case OperandRegList:
+		arg := RegList{First: o.Reg, Len: o.Len}
 		if !checkReg(arg.First) {
 			return false
 		}
@@ -82,64 +102,92 @@ func (a *Assembler) matchArg(arg Arg, m EncOp) bool {
 			return !arg.First.HasElem() && arg.Len == m.X[0] && arg.First.ElemSize() == Size(m.X[1]) && arg.First.Lanes() == m.X[2]
 		case MatRegListElement:
 			return arg.First.HasElem() && arg.Len == m.X[0] && arg.First.ElemSize() == Size(m.X[1])
+		case MatZList:
+			return !arg.First.HasElem() && arg.Len == m.X[0] && arg.First.Family() == RegSVE && arg.First.ElemSize() == Size(m.X[1])
 		}
 
-	case Imm:
-		switch m.Op {
-		case MatImm, MatOffset:
-			return true
-		case MatLitInt:
-			return arg == Imm(m.X[0])
-		}
+	case OperandZASlice:
+		return m.Op == MatZASlice && checkZASlice(ZASlice{Tile: o.Reg, Idx: o.Idx, Offset: o.Len, Vertical: o.Vert}, Size(m.X[0]))
 
-	case Wide:
+	case OperandImm, OperandWide:
 		switch m.Op {
 		case MatImm, MatOffset:
 			return true
 		case MatLitInt:
-			return arg == Wide(m.X[0])
+			return o.Imm == uint64(m.X[0])
 		}
 
-	case Float:
+	case OperandFloat, OperandDouble, OperandHalf:
 		switch m.Op {
 		case MatFloat:
 			return true
 		case MatLitFloat:
-			return arg == Float(m.X[0])
+			return o.Imm == math.Float64bits(float64(m.X[0])) // -0.0 does not match 0.0
 		}
 
-	case Mod:
+	case OperandMod:
 		switch m.Op {
 		case MatMod:
-			return checkMod(ModList[m.X[0]], arg.ID)
+			return checkMod(ModList[m.X[0]], o.Mod.ID)
 		case MatLitMod:
-			return arg.ID == m.X[0]
+			return o.Mod.ID == m.X[0]
 		}
 
-	case Ref:
-		return (m.Op == MatRefBase || m.Op == MatRefOffset) && checkReg(arg.Base) && checkRefBase(arg.Base)
+	case OperandRef:
+		if m.Op == MatRefZ {
+			return checkReg(o.Reg) && checkRefBaseZ(o.Reg, Size(m.X[0]))
+		}
+		return (m.Op == MatRefBase || m.Op == MatRefOffset || m.Op == MatRefVL) && checkReg(o.Reg) && checkRefBase(o.Reg)
 
-	case RefOffset:
-		return m.Op == MatRefOffset && checkReg(arg.Base) && checkRefBase(arg.Base)
+	case OperandRefOffset:
+		if m.Op == MatRefZ {
+			return checkReg(o.Reg) && checkRefBaseZ(o.Reg, Size(m.X[0]))
+		}
+		return m.Op == MatRefOffset && checkReg(o.Reg) && checkRefBase(o.Reg)
 
-	case RefPreIndexed:
-		return m.Op == MatRefPre && checkReg(arg.Base) && checkRefBase(arg.Base)
+	case OperandRefVL:
+		return m.Op == MatRefVL && checkReg(o.Reg) && checkRefBase(o.Reg)
 
-	case RefIndexed:
-		return m.Op == MatRefIndex && checkReg(arg.Base) && checkReg(arg.Idx) && checkRefBase(arg.Base) && arg.Idx.Family() == RegInt
+	case OperandRefPreIndexed:
+		if m.Op == MatRefWback {
+			return checkReg(o.Reg) && o.Reg.Type == RX && o.Imm == 0
+		}
+		return m.Op == MatRefPre && checkReg(o.Reg) && checkRefBase(o.Reg)
 
-	case Label:
-		if int(arg.ID) >= len(a.LabelPC) {
+	case OperandRefIndexed:
+		if !checkReg(o.Reg) || !checkReg(o.Idx) || !checkRefBase(o.Reg) {
+			return false
+		}
+		switch m.Op {
+		case MatRefIndex:
+			return o.Idx.Family() == RegInt
+		case MatRefIndexLSL:
+			return o.Idx.Type == RX && checkIndexMod(o.Mod, SymLSL, m.X[0])
+		case MatRefIndexZ:
+			if o.Idx.Family() != RegSVE || o.Idx.ElemSize() != Size(m.X[0]) {
+				return false
+			}
+			if o.Idx.ElemSize() == QWORD {
+				return checkIndexMod(o.Mod, SymLSL, m.X[1])
+			}
+			return checkIndexMod(o.Mod, SymUXTW, m.X[1]) || checkIndexMod(o.Mod, SymSXTW, m.X[1])
+		}
+
+	case OperandLabel:
+		if int(o.Label().ID) >= len(a.LabelPC) {
 			return false
 		}
 		return m.Op == MatOffset
 
-	case Symbol:
+	case OperandSystemReg:
+		return m.Op == MatSysReg && o.Imm>>14 >= 2 // op0 is 2 (debug) or 3 (non-debug)
+
+	case OperandSymbol:
 		switch m.Op {
 		case MatSymbol, MatCond:
 			return true
 		case MatLitSymbol:
-			return arg == Symbol(m.X[0])
+			return o.Imm == uint64(m.X[0])
 		}
 	}
 	return false
@@ -164,8 +212,8 @@ func checkReg(r Reg) bool {
 		}
 	case RegVec32:
 		switch r.Type {
-		case V4B, V2H:
-			return r.ID < 32 && (!r.HasElem() || r.GetElem() < r.Lanes())
+		case V4B, V2H: // elements index 32-bit groups within a 128-bit register
+			return r.ID < 32 && (!r.HasElem() || r.GetElem() < 4)
 		}
 	case RegVec64:
 		switch r.Type {
@@ -177,12 +225,60 @@ func checkReg(r Reg) bool {
 		case V16B, V8H, V4S, V2D, V1Q:
 			return r.ID < 32 && (!r.HasElem() || r.GetElem() < r.Lanes())
 		}
+	case RegSVE:
+		return r.ID < 32 && r.ElemSize() <= OWORD
+	case RegPred:
+		return r.ID < 16 && r.ElemSize() <= QWORD && !r.HasElem()
+	case RegPredZ, RegPredM:
+		return r.ID < 16 && r.ElemSize() == 0 && !r.HasElem()
+	case RegZA:
+		if r.ElemSize() == 0 { // ZA array
+			return r.ID == 0 && !r.HasElem()
+		}
+		return r.ElemSize() <= OWORD && r.ID < zaTileCount(r.ElemSize()) && !r.HasElem()
 	}
 	return false
 }
 
+// zaTileCount returns the number of ZA tiles with elements of the given size (ZA0.B, ZA0.H-ZA1.H, ..., ZA0.Q-ZA15.Q).
+func zaTileCount(size Size) uint8 { return 1 << (size - BYTE) }
+
+// checkZASlice returns true if s is a valid slice of a ZA tile with elements of the given size,
+// or a valid ZA array vector if size is 0.
+func checkZASlice(s ZASlice, size Size) bool {
+	if !checkReg(s.Tile) || s.Tile.Family() != RegZA || s.Tile.ElemSize() != size {
+		return false
+	}
+	if s.Idx.Type != RW || s.Idx.ID < 12 || s.Idx.ID > 15 || s.Idx.HasElem() {
+		return false
+	}
+	if size == 0 { // ZA array vector
+		return !s.Vertical && s.Offset < 16
+	}
+	return s.Offset < 16/zaTileCount(size)
+}
+
 func checkRefBase(r Reg) bool { return r.Family() == RegInt || r.Family() == RegSP }
 
+func checkRefBaseZ(r Reg, size Size) bool {
+	return r.Family() == RegSVE && r.ElemSize() == size && !r.HasElem()
+}
+
+// checkIndexMod returns true if mod has the symbol id with an amount of shift, or if mod is unset
+// when id is LSL and shift is 0.
+func checkIndexMod(mod Mod, id uint8, shift uint8) bool {
+	if mod.ID == 0 {
+		return id == SymLSL && shift == 0
+	}
+	if mod.ID != id {
+		return false
+	}
+	if !mod.HasImm() {
+		return shift == 0 && id != SymLSL
+	}
+	return mod.GetImm() == shift
+}
+
 func (a *Assembler) matchOrSetSimdSize(reg Reg) bool {
 	switch reg.Family() {
 	case RegInt, RegSP, RegFloat:
@@ -1,6 +1,14 @@
 package arm
 
-import "testing"
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"runtime"
+	"strings"
+	"testing"
+)
 
 func TestLabels(t *testing.T) {
 	code := make([]byte, 256)
@@ -58,6 +66,7 @@ func TestLabels(t *testing.T) {
 func TestEncoding(t *testing.T) {
 	code := make([]byte, 256)
 	var a Assembler
+	a.Cache = testCache
 
 	test := func(enc uint32, inst Inst, args ...Arg) {
 		a.Init(code)
@@ -66,6 +75,9 @@ func TestEncoding(t *testing.T) {
 			t.Fail()
 		} else if actual := dec32(code); actual != enc {
 			t.Logf("Invalid inst=%v:\n%032b (expected) = %08X\n%032b (actual)   = %08X\n%032b (opcode)\n%032b (args", inst, enc, enc, actual, actual, a.Opcode, a.Opcode^actual)
+			if e, ok := a.Explain(); ok {
+				t.Logf("actual: %v", e)
+			}
 			t.Fail()
 		}
 	}
@@ -285,6 +297,19 @@ func TestEncoding(t *testing.T) {
 	test(0xD8E477B8, PRFM, Imm(24), Imm(-225548))
 	test(0xF8A369AB, PRFM, Imm(11), RefIndexed{X(13), X(3), ModLSL})
 	test(0xF89501AA, PRFUM, Imm(10), RefOffset{X(13), -176})
+	// Named prefetch operations and prefetch (immediate):
+	test(0xF9800000, PRFM, PLDL1KEEP, Ref{X(0)})
+	test(0xF9800001, PRFM, PLDL1STRM, Ref{X(0)})
+	test(0xF9800432, PRFM, PSTL2KEEP, RefOffset{X(1), 8})
+	test(0xF9BFFCA6, PRFM, PLDSLCKEEP, RefOffset{X(5), 32760})
+	test(0xF9BFFCA6, PRFM, Imm(6), RefOffset{X(5), 32760})
+	test(0xF8A4786F, PRFM, PLISLCSTRM, RefIndexed{X(3), X(4), ModLSL.Imm(3)})
+	test(0xF89FF045, PRFUM, PLDL3STRM, RefOffset{X(2), -1})
+	test(0xD8E477B0, PRFM, PSTL1KEEP, Imm(-225548))
+	// Hint aliases:
+	test(0xD50320DF, DGH)
+	test(0xD50322DF, CLRBHB)
+	test(0xD50322DF, HINT, Imm(22))
 	test(0xD503223F, PSB, CSYNC)
 
 	test(0xD65F02E0, RET, X(23))
@@ -341,3 +366,2181 @@ func TestEncoding(t *testing.T) {
 	test(0x363DA928, TBZ, X(8), Imm(7), Imm(-19164))
 
 }
+
+func TestEncodingSVE(t *testing.T) {
+	code := make([]byte, 256)
+	var a Assembler
+	a.Cache = testCache
+
+	test := func(enc uint32, inst Inst, args ...Arg) {
+		a.Init(code)
+		if !a.Inst(inst, args...) {
+			t.Logf("Failed to encode inst %v for enc %08X -- err: %v\n\targs:\n%#+v", inst, enc, a.Err, args)
+			t.Fail()
+		} else if actual := dec32(code); actual != enc {
+			t.Logf("Invalid inst=%v:\n%032b (expected) = %08X\n%032b (actual)   = %08X\n%032b (opcode)\n%032b (args", inst, enc, enc, actual, actual, a.Opcode, a.Opcode^actual)
+			if e, ok := a.Explain(); ok {
+				t.Logf("actual: %v", e)
+			}
+			t.Fail()
+		}
+	}
+
+	// Expected encodings generated with llvm-mc -triple=aarch64 -mattr=+sve,+sve2
+
+	test(0x0416BE57, ABS, ZB(23), P(7).Merging(), ZB(18))
+
+	test(0x043601B7, ADD, ZB(23), ZB(13), ZB(22))
+	test(0x04000EA8, ADD, ZB(8), P(3).Merging(), ZB(8), ZB(21))
+	test(0x2520D822, ADD, ZB(2), ZB(2), Imm(193))
+
+	test(0x047757B3, ADDPL, X(19), X(23), Imm(-3))
+
+	test(0x043B51F3, ADDVL, X(19), X(27), Imm(15))
+
+	test(0x041A140F, AND, ZB(15), P(5).Merging(), ZB(15), ZB(0))
+	test(0x042632B4, AND, ZD(20), ZD(21), ZD(6))
+	test(0x058000E6, AND, ZS(6), ZS(6), Wide(0xff))
+
+	test(0x041A3A56, ANDV, ScalarB(22), P(6), ZB(18))
+
+	test(0x041097D5, ASR, ZB(21), P(5).Merging(), ZB(21), ZB(30))
+	test(0x042A910F, ASR, ZB(15), ZB(8), Imm(6))
+	test(0x040085F8, ASR, ZB(24), P(1).Merging(), ZB(24), Imm(1))
+
+	test(0x047D39EA, BCAX, ZD(10), ZD(10), ZD(29), ZD(15))
+
+	test(0x041B0A36, BIC, ZB(22), P(2).Merging(), ZB(22), ZB(17))
+	test(0x04E930AA, BIC, ZD(10), ZD(5), ZD(9))
+
+	test(0x04283FAF, BSL, ZD(15), ZD(15), ZD(8), ZD(29))
+
+	test(0x046A3EB8, BSL1N, ZD(24), ZD(24), ZD(10), ZD(21))
+
+	test(0x04B93E1E, BSL2N, ZD(30), ZD(30), ZD(25), ZD(16))
+
+	test(0x0418ACA8, CLS, ZB(8), P(3).Merging(), ZB(5))
+
+	test(0x0419A4AF, CLZ, ZB(15), P(1).Merging(), ZB(5))
+
+	test(0x2415B5CF, CMPEQ, PB(15), P(5).Zeroing(), ZB(14), ZB(21))
+	test(0x25129DCC, CMPEQ, PB(12), P(7).Zeroing(), ZB(14), Imm(-14))
+
+	test(0x24159966, CMPGE, PB(6), P(6).Zeroing(), ZB(11), ZB(21))
+	test(0x25070AC1, CMPGE, PB(1), P(2).Zeroing(), ZB(22), Imm(7))
+
+	test(0x24029270, CMPGT, PB(0), P(4).Zeroing(), ZB(19), ZB(2))
+	test(0x25130852, CMPGT, PB(2), P(2).Zeroing(), ZB(2), Imm(-13))
+
+	test(0x241216DF, CMPHI, PB(15), P(5).Zeroing(), ZB(22), ZB(18))
+	test(0x243D4957, CMPHI, PB(7), P(2).Zeroing(), ZB(10), Imm(117))
+
+	test(0x24040B2C, CMPHS, PB(12), P(2).Zeroing(), ZB(25), ZB(4))
+	test(0x242858EB, CMPHS, PB(11), P(6).Zeroing(), ZB(7), Imm(33))
+
+	test(0x25043F5C, CMPLE, PB(12), P(7).Zeroing(), ZB(26), Imm(4))
+
+	test(0x2431B7A1, CMPLO, PB(1), P(5).Zeroing(), ZB(29), Imm(70))
+
+	test(0x2421A5B6, CMPLS, PB(6), P(1).Zeroing(), ZB(13), Imm(6))
+
+	test(0x2513270B, CMPLT, PB(11), P(1).Zeroing(), ZB(24), Imm(-13))
+
+	test(0x240DA0BC, CMPNE, PB(12), P(0).Zeroing(), ZB(5), ZB(13))
+	test(0x25088C15, CMPNE, PB(5), P(3).Zeroing(), ZB(0), Imm(8))
+
+	test(0x041ABE0D, CNT, ZB(13), P(7).Merging(), ZB(16))
+
+	test(0x0420E3FE, CNTB, X(30))
+
+	test(0x04E0E3F6, CNTD, X(22))
+
+	test(0x0460E3F9, CNTH, X(25))
+
+	test(0x04A0E3E2, CNTW, X(2))
+
+	test(0x05A19EB2, COMPACT, ZS(18), P(7), ZS(21))
+
+	test(0x0430E7F1, DECB, X(17))
+
+	test(0x04F0E7EE, DECD, X(14))
+
+	test(0x0470E7E3, DECH, X(3))
+
+	test(0x04B0E7EC, DECW, X(12))
+
+	test(0x05203B19, DUP, ZB(25), W(24))
+	test(0x2538DE36, DUP, ZB(22), Imm(-15))
+
+	test(0x04190AC9, EOR, ZB(9), P(2).Merging(), ZB(9), ZB(22))
+	test(0x04AC3048, EOR, ZD(8), ZD(2), ZD(12))
+	test(0x0540F066, EOR, ZS(6), ZS(6), Wide(0x3c))
+
+	test(0x04293919, EOR3, ZD(25), ZD(25), ZD(9), ZD(8))
+
+	test(0x04192AFC, EORV, ScalarB(28), P(2), ZB(23))
+
+	test(0x053E05E7, EXT, ZB(7), ZB(7), ZB(15), Imm(241))
+
+	test(0x65489FB5, FABD, ZH(21), P(7).Merging(), ZH(21), ZH(29))
+
+	test(0x045CA346, FABS, ZH(6), P(0).Merging(), ZH(26))
+
+	test(0x654502CB, FADD, ZH(11), ZH(22), ZH(5))
+	test(0x654091A4, FADD, ZH(4), P(4).Merging(), ZH(4), ZH(13))
+
+	test(0x65403CE7, FADDV, ScalarH(7), P(7), ZH(7))
+
+	test(0x655C7CC7, FCMEQ, PH(7), P(7).Zeroing(), ZH(6), ZH(28))
+
+	test(0x65444587, FCMGE, PH(7), P(1).Zeroing(), ZH(12), ZH(4))
+
+	test(0x65465D17, FCMGT, PH(7), P(7).Zeroing(), ZH(8), ZH(6))
+
+	test(0x65426EBB, FCMNE, PH(11), P(3).Zeroing(), ZH(21), ZH(2))
+
+	test(0x655AA948, FCVTZS, ZH(8), P(2).Merging(), ZH(10))
+
+	test(0x655BBF05, FCVTZU, ZH(5), P(7).Merging(), ZH(24))
+
+	test(0x654D8AE8, FDIV, ZH(8), P(2).Merging(), ZH(8), ZH(23))
+
+	test(0x654C9F54, FDIVR, ZH(20), P(7).Merging(), ZH(20), ZH(26))
+
+	test(0x2579C101, FDUP, ZH(1), Float(3.0))
+
+	test(0x654692C9, FMAX, ZH(9), P(4).Merging(), ZH(9), ZH(22))
+
+	test(0x65448AB1, FMAXNM, ZH(17), P(2).Merging(), ZH(17), ZH(21))
+
+	test(0x65442CBD, FMAXNMV, ScalarH(29), P(3), ZH(5))
+
+	test(0x654637D2, FMAXV, ScalarH(18), P(5), ZH(30))
+
+	test(0x65478483, FMIN, ZH(3), P(1).Merging(), ZH(3), ZH(4))
+
+	test(0x65458E5B, FMINNM, ZH(27), P(3).Merging(), ZH(27), ZH(18))
+
+	test(0x6545320C, FMINNMV, ScalarH(12), P(4), ZH(16))
+
+	test(0x65473821, FMINV, ScalarH(1), P(6), ZH(1))
+
+	test(0x6577003B, FMLA, ZH(27), P(0).Merging(), ZH(1), ZH(23))
+	test(0x646802AB, FMLA, ZH(11), ZH(21), ZH(0).I(5))
+
+	test(0x6572394A, FMLS, ZH(10), P(6).Merging(), ZH(10), ZH(18))
+	test(0x642B0752, FMLS, ZH(18), ZH(26), ZH(3).I(1))
+
+	test(0x65410922, FMUL, ZH(2), ZH(9), ZH(1))
+	test(0x654281C8, FMUL, ZH(8), P(0).Merging(), ZH(8), ZH(14))
+	test(0x6425218C, FMUL, ZH(12), ZH(12), ZH(5).I(0))
+
+	test(0x654A96BE, FMULX, ZH(30), P(5).Merging(), ZH(30), ZH(21))
+
+	test(0x045DA4C9, FNEG, ZH(9), P(1).Merging(), ZH(6))
+
+	test(0x6565578E, FNMLA, ZH(14), P(5).Merging(), ZH(28), ZH(5))
+
+	test(0x656F65A8, FNMLS, ZH(8), P(1).Merging(), ZH(13), ZH(15))
+
+	test(0x65561957, FRECPS, ZH(23), ZH(10), ZH(22))
+
+	test(0x654CB705, FRECPX, ZH(5), P(5).Merging(), ZH(24))
+
+	test(0x6544B594, FRINTA, ZH(20), P(5).Merging(), ZH(12))
+
+	test(0x6547BEB0, FRINTI, ZH(16), P(7).Merging(), ZH(21))
+
+	test(0x6542A36A, FRINTM, ZH(10), P(0).Merging(), ZH(27))
+
+	test(0x6540A5F7, FRINTN, ZH(23), P(1).Merging(), ZH(15))
+
+	test(0x6541BD2B, FRINTP, ZH(11), P(7).Merging(), ZH(9))
+
+	test(0x6546A0AC, FRINTX, ZH(12), P(0).Merging(), ZH(5))
+
+	test(0x6543A4B5, FRINTZ, ZH(21), P(1).Merging(), ZH(5))
+
+	test(0x65551F7D, FRSQRTS, ZH(29), ZH(27), ZH(21))
+
+	test(0x65499AD4, FSCALE, ZH(20), P(6).Merging(), ZH(20), ZH(22))
+
+	test(0x654DAC3A, FSQRT, ZH(26), P(3).Merging(), ZH(1))
+
+	test(0x655D070E, FSUB, ZH(14), ZH(24), ZH(29))
+	test(0x65419EB7, FSUB, ZH(23), P(7).Merging(), ZH(23), ZH(21))
+
+	test(0x65439E35, FSUBR, ZH(21), P(7).Merging(), ZH(21), ZH(17))
+
+	test(0x0430E3F3, INCB, X(19))
+
+	test(0x04F0E3F9, INCD, X(25))
+
+	test(0x0470E3E8, INCH, X(8))
+
+	test(0x04B0E3F0, INCW, X(16))
+
+	test(0x043442C9, INDEX, ZB(9), Imm(-10), Imm(-12))
+	test(0x04364C54, INDEX, ZB(20), W(2), W(22))
+
+	test(0xA403B056, LD1B, ZB(22).List(1), P(4).Zeroing(), RefVL{X(2), 3})
+	test(0xA4105D19, LD1B, ZB(25).List(1), P(7).Zeroing(), RefIndexed{Base: X(8), Idx: X(16)})
+	test(0xC455DC3C, LD1B, ZD(28).List(1), P(7).Zeroing(), RefIndexed{Base: X(1), Idx: ZD(21)})
+	test(0x8420DF6A, LD1B, ZS(10).List(1), P(7).Zeroing(), RefOffset{ZS(27), 0})
+
+	test(0xA5E0A2CE, LD1D, ZD(14).List(1), P(0).Zeroing(), RefVL{X(22), 0})
+	test(0xA5F74E35, LD1D, ZD(21).List(1), P(3).Zeroing(), RefIndexed{X(17), X(23), ModLSL.Imm(3)})
+	test(0xC5CAD88A, LD1D, ZD(10).List(1), P(6).Zeroing(), RefIndexed{Base: X(4), Idx: ZD(10)})
+
+	test(0xA4A9A46F, LD1H, ZH(15).List(1), P(1).Zeroing(), RefVL{X(3), -7})
+	test(0xA4B24E18, LD1H, ZH(24).List(1), P(3).Zeroing(), RefIndexed{X(16), X(18), ModLSL.Imm(1)})
+	test(0xC4C7CEB2, LD1H, ZD(18).List(1), P(3).Zeroing(), RefIndexed{Base: X(21), Idx: ZD(7)})
+	test(0x84BECCC9, LD1H, ZS(9).List(1), P(3).Zeroing(), RefOffset{ZS(6), 60})
+
+	test(0x84488B1E, LD1RB, ZB(30).List(1), P(2).Zeroing(), RefOffset{X(24), 8})
+
+	test(0x85C8F318, LD1RD, ZD(24).List(1), P(4).Zeroing(), RefOffset{X(24), 64})
+
+	test(0x84D4BCD1, LD1RH, ZH(17).List(1), P(7).Zeroing(), RefOffset{X(6), 40})
+
+	test(0x857ED79B, LD1RW, ZS(27).List(1), P(5).Zeroing(), RefOffset{X(28), 248})
+
+	test(0xA54AA1AA, LD1W, ZS(10).List(1), P(0).Zeroing(), RefVL{X(13), -6})
+	test(0xA55A50D4, LD1W, ZS(20).List(1), P(4).Zeroing(), RefIndexed{X(6), X(26), ModLSL.Imm(2)})
+	test(0xC557DCD4, LD1W, ZD(20).List(1), P(7).Zeroing(), RefIndexed{Base: X(6), Idx: ZD(23)})
+	test(0x853DCEE3, LD1W, ZS(3).List(1), P(3).Zeroing(), RefOffset{ZS(23), 116})
+
+	test(0xA4097569, LDFF1B, ZB(9).List(1), P(5).Zeroing(), RefIndexed{Base: X(11), Idx: X(9)})
+
+	test(0xA5ED60D1, LDFF1D, ZD(17).List(1), P(0).Zeroing(), RefIndexed{X(6), X(13), ModLSL.Imm(3)})
+
+	test(0xA4B56950, LDFF1H, ZH(16).List(1), P(2).Zeroing(), RefIndexed{X(10), X(21), ModLSL.Imm(1)})
+
+	test(0xA5597DBD, LDFF1W, ZS(29).List(1), P(7).Zeroing(), RefIndexed{X(13), X(25), ModLSL.Imm(2)})
+
+	test(0x85B35680, LDR, Z(0), RefVL{X(20), -99})
+	test(0x858B09E5, LDR, P(5), RefVL{X(15), 90})
+
+	test(0x04139F0C, LSL, ZB(12), P(7).Merging(), ZB(12), ZB(24))
+	test(0x042B9CEE, LSL, ZB(14), ZB(7), Imm(3))
+	test(0x04038193, LSL, ZB(19), P(0).Merging(), ZB(19), Imm(4))
+
+	test(0x041199F2, LSR, ZB(18), P(6).Merging(), ZB(18), ZB(15))
+	test(0x0429959B, LSR, ZB(27), ZB(12), Imm(7))
+	test(0x04018D4E, LSR, ZB(14), P(3).Merging(), ZB(14), Imm(6))
+
+	test(0x0420BE82, MOVPRFX, Z(2), Z(20))
+	test(0x04103AFA, MOVPRFX, ZB(26), P(6).Zeroing(), ZB(23))
+
+	test(0x043761A3, MUL, ZB(3), ZB(13), ZB(23))
+	test(0x041003A4, MUL, ZB(4), P(0).Merging(), ZB(4), ZB(29))
+	test(0x2530DA0D, MUL, ZB(13), ZB(13), Imm(-48))
+
+	test(0x04FE3F40, NBSL, ZD(0), ZD(0), ZD(30), ZD(26))
+
+	test(0x0417B0E5, NEG, ZB(5), P(4).Merging(), ZB(7))
+
+	test(0x041EB224, NOT, ZB(4), P(4).Merging(), ZB(17))
+
+	test(0x041804F4, ORR, ZB(20), P(1).Merging(), ZB(20), ZB(7))
+	test(0x04793021, ORR, ZD(1), ZD(1), ZD(25))
+	test(0x05000826, ORR, ZS(6), ZS(6), Wide(0x80000001))
+
+	test(0x04183594, ORV, ScalarB(20), P(5), ZB(12))
+
+	test(0x2518E400, PFALSE, PB(0))
+
+	test(0x2550E1E0, PTEST, P(8), PB(15))
+
+	test(0x2518E3E4, PTRUE, PB(4))
+
+	test(0x2519E3E8, PTRUES, PB(8))
+
+	test(0x2519F00B, RDFFR, PB(11))
+	test(0x2518F0E0, RDFFR, PB(0), P(7).Zeroing())
+
+	test(0x2558F12B, RDFFRS, PB(11), P(9).Zeroing())
+
+	test(0x04BF5219, RDVL, X(25), Imm(16))
+
+	test(0x05383932, REV, ZB(18), ZB(9))
+	test(0x053440A3, REV, PB(3), PB(5))
+
+	test(0x040C0664, SABD, ZB(4), P(1).Merging(), ZB(4), ZB(19))
+
+	test(0x04003BC6, SADDV, ScalarD(6), P(6), ZB(30))
+
+	test(0x6552B1F1, SCVTF, ZH(17), P(4).Merging(), ZH(15))
+
+	test(0x04941778, SDIV, ZS(24), P(5).Merging(), ZS(24), ZS(27))
+
+	test(0x049611D3, SDIVR, ZS(19), P(4).Merging(), ZS(19), ZS(14))
+
+	test(0x052DF2EE, SEL, ZB(14), P(12), ZB(23), ZB(13))
+
+	test(0x252C9000, SETFFR)
+
+	test(0x04080680, SMAX, ZB(0), P(1).Merging(), ZB(0), ZB(20))
+	test(0x2528D358, SMAX, ZB(24), ZB(24), Imm(-102))
+
+	test(0x0408236A, SMAXV, ScalarB(10), P(0), ZB(27))
+
+	test(0x040A1B81, SMIN, ZB(1), P(6).Merging(), ZB(1), ZB(28))
+	test(0x252ADD90, SMIN, ZB(16), ZB(16), Imm(-20))
+
+	test(0x040A2B13, SMINV, ScalarB(19), P(2), ZB(24))
+
+	test(0x043E6988, SMULH, ZB(8), ZB(12), ZB(30))
+	test(0x0412116F, SMULH, ZB(15), P(4).Merging(), ZB(15), ZB(11))
+
+	test(0x052C8F28, SPLICE, ZB(8), P(3), ZB(8), ZB(25))
+
+	test(0x043E1039, SQADD, ZB(25), ZB(1), ZB(30))
+	test(0x2524C9B0, SQADD, ZB(16), ZB(16), Imm(77))
+
+	test(0x043272B7, SQDMULH, ZB(23), ZB(21), ZB(18))
+
+	test(0x042A1BBA, SQSUB, ZB(26), ZB(29), ZB(10))
+	test(0x2526C91D, SQSUB, ZB(29), ZB(29), Imm(72))
+
+	test(0xE409FF0D, ST1B, ZB(13).List(1), P(7), RefVL{X(24), -7})
+	test(0xE40A5014, ST1B, ZB(20).List(1), P(4), RefIndexed{Base: X(0), Idx: X(10)})
+	test(0xE416BB00, ST1B, ZD(0).List(1), P(6), RefIndexed{Base: X(24), Idx: ZD(22)})
+	test(0xE47DBE65, ST1B, ZS(5).List(1), P(7), RefOffset{ZS(19), 29})
+
+	test(0xE5E7FE74, ST1D, ZD(20).List(1), P(7), RefVL{X(19), 7})
+	test(0xE5F55EAB, ST1D, ZD(11).List(1), P(7), RefIndexed{X(21), X(21), ModLSL.Imm(3)})
+	test(0xE585ABC9, ST1D, ZD(9).List(1), P(2), RefIndexed{Base: X(30), Idx: ZD(5)})
+
+	test(0xE4A6F3D7, ST1H, ZH(23).List(1), P(4), RefVL{X(30), 6})
+	test(0xE4A84256, ST1H, ZH(22).List(1), P(0), RefIndexed{X(18), X(8), ModLSL.Imm(1)})
+	test(0xE494AB86, ST1H, ZD(6).List(1), P(2), RefIndexed{Base: X(28), Idx: ZD(20)})
+	test(0xE4F2B40F, ST1H, ZS(15).List(1), P(5), RefOffset{ZS(0), 36})
+
+	test(0xE54DE1B6, ST1W, ZS(22).List(1), P(0), RefVL{X(13), -3})
+	test(0xE5584AB2, ST1W, ZS(18).List(1), P(2), RefIndexed{X(21), X(24), ModLSL.Imm(2)})
+	test(0xE507A8FD, ST1W, ZD(29).List(1), P(2), RefIndexed{Base: X(7), Idx: ZD(7)})
+	test(0xE569A212, ST1W, ZS(18).List(1), P(0), RefOffset{ZS(16), 36})
+
+	test(0xE59C5329, STR, Z(9), RefVL{X(25), 228})
+	test(0xE59016E5, STR, P(5), RefVL{X(23), 133})
+
+	test(0x042606DB, SUB, ZB(27), ZB(22), ZB(6))
+	test(0x040117D4, SUB, ZB(20), P(5).Merging(), ZB(20), ZB(30))
+	test(0x2521C913, SUB, ZB(19), ZB(19), Imm(72))
+
+	test(0x04030082, SUBR, ZB(2), P(0).Merging(), ZB(2), ZB(4))
+	test(0x2523D718, SUBR, ZB(24), ZB(24), Imm(184))
+
+	test(0x05713989, SUNPKHI, ZH(9), ZB(12))
+
+	test(0x05703819, SUNPKLO, ZH(25), ZB(0))
+
+	test(0x053D30DD, TBL, ZB(29), ZB(6).List(1), ZB(29))
+
+	test(0x053A70C6, TRN1, ZB(6), ZB(6), ZB(26))
+	test(0x052E5128, TRN1, PB(8), PB(9), PB(14))
+
+	test(0x05387792, TRN2, ZB(18), ZB(28), ZB(24))
+	test(0x052B54AA, TRN2, PB(10), PB(5), PB(11))
+
+	test(0x040D0617, UABD, ZB(23), P(1).Merging(), ZB(23), ZB(16))
+
+	test(0x040124CA, UADDV, ScalarD(10), P(1), ZB(6))
+
+	test(0x6553BE11, UCVTF, ZH(17), P(7).Merging(), ZH(16))
+
+	test(0x04950FA8, UDIV, ZS(8), P(3).Merging(), ZS(8), ZS(29))
+
+	test(0x04971E50, UDIVR, ZS(16), P(7).Merging(), ZS(16), ZS(18))
+
+	test(0x04090B69, UMAX, ZB(9), P(2).Merging(), ZB(9), ZB(27))
+	test(0x2529C362, UMAX, ZB(2), ZB(2), Imm(27))
+
+	test(0x04092B68, UMAXV, ScalarB(8), P(2), ZB(27))
+
+	test(0x040B0352, UMIN, ZB(18), P(0).Merging(), ZB(18), ZB(26))
+	test(0x252BD158, UMIN, ZB(24), ZB(24), Imm(138))
+
+	test(0x040B20C6, UMINV, ScalarB(6), P(0), ZB(6))
+
+	test(0x04346EAC, UMULH, ZB(12), ZB(21), ZB(20))
+	test(0x041302E9, UMULH, ZB(9), P(0).Merging(), ZB(9), ZB(23))
+
+	test(0x043317C3, UQADD, ZB(3), ZB(30), ZB(19))
+	test(0x2525CDA0, UQADD, ZB(0), ZB(0), Imm(109))
+
+	test(0x04261C4A, UQSUB, ZB(10), ZB(2), ZB(6))
+	test(0x2527D72A, UQSUB, ZB(10), ZB(10), Imm(185))
+
+	test(0x05733AF9, UUNPKHI, ZH(25), ZB(23))
+
+	test(0x057238A1, UUNPKLO, ZH(1), ZB(5))
+
+	test(0x053C6A4B, UZP1, ZB(11), ZB(18), ZB(28))
+	test(0x05224809, UZP1, PB(9), PB(0), PB(2))
+
+	test(0x052C6C91, UZP2, ZB(17), ZB(4), ZB(12))
+	test(0x052B4C01, UZP2, PB(1), PB(0), PB(11))
+
+	test(0x252C012E, WHILEGE, PB(14), W(9), W(12))
+
+	test(0x25200298, WHILEGT, PB(8), W(20), W(0))
+
+	test(0x253D0997, WHILEHI, PB(7), W(12), W(29))
+
+	test(0x25290AE3, WHILEHS, PB(3), W(23), W(9))
+
+	test(0x25250614, WHILELE, PB(4), W(16), W(5))
+
+	test(0x253B0D4C, WHILELO, PB(12), W(10), W(27))
+
+	test(0x25350E92, WHILELS, PB(2), W(20), W(21))
+
+	test(0x253A0709, WHILELT, PB(9), W(24), W(26))
+
+	test(0x25289160, WRFFR, PB(11))
+
+	test(0x052160F0, ZIP1, ZB(16), ZB(7), ZB(1))
+	test(0x052F41E7, ZIP1, PB(7), PB(15), PB(15))
+
+	test(0x052C66C3, ZIP2, ZB(3), ZB(22), ZB(12))
+	test(0x052B45ED, ZIP2, PB(13), PB(15), PB(11))
+}
+
+func TestEncodingSME(t *testing.T) {
+	code := make([]byte, 256)
+	var a Assembler
+	a.Cache = testCache
+
+	test := func(enc uint32, inst Inst, args ...Arg) {
+		a.Init(code)
+		if !a.Inst(inst, args...) {
+			t.Logf("Failed to encode inst %v for enc %08X -- err: %v\n\targs:\n%#+v", inst, enc, a.Err, args)
+			t.Fail()
+		} else if actual := dec32(code); actual != enc {
+			t.Logf("Invalid inst=%v:\n%032b (expected) = %08X\n%032b (actual)   = %08X\n%032b (opcode)\n%032b (args", inst, enc, enc, actual, actual, a.Opcode, a.Opcode^actual)
+			if e, ok := a.Explain(); ok {
+				t.Logf("actual: %v", e)
+			}
+			t.Fail()
+		}
+	}
+
+	// Expected encodings generated with llvm-mc -triple=aarch64 -mattr=+sme,+sme-f64,+sme-i64
+	// (ADDSPL, ADDSVL, and RDSVL from the Arm Architecture Reference Manual)
+
+	test(0xC0904EA2, ADDHA, ZAS(2), P(3).Merging(), P(2).Merging(), ZS(21))
+	test(0xC0D0E4A3, ADDHA, ZAD(3), P(1).Merging(), P(7).Merging(), ZD(5))
+
+	test(0x04615F7F, ADDSPL, XSP, X(1), Imm(-5))
+
+	test(0x043F58A1, ADDSVL, X(1), XSP, Imm(5))
+
+	test(0xC0919443, ADDVA, ZAS(3), P(5).Merging(), P(4).Merging(), ZS(2))
+	test(0xC0D13AE1, ADDVA, ZAD(1), P(6).Merging(), P(1).Merging(), ZD(23))
+
+	test(0x81835E01, BFMOPA, ZAS(1), P(7).Merging(), P(2).Merging(), ZH(16), ZH(3))
+
+	test(0x8196E131, BFMOPS, ZAS(1), P(0).Merging(), P(7).Merging(), ZH(9), ZH(22))
+
+	test(0x809086A0, FMOPA, ZAS(0), P(1).Merging(), P(4).Merging(), ZS(21), ZS(16))
+	test(0x80CF59E5, FMOPA, ZAD(5), P(6).Merging(), P(2).Merging(), ZD(15), ZD(15))
+	test(0x81BA2763, FMOPA, ZAS(3), P(1).Merging(), P(1).Merging(), ZH(27), ZH(26))
+
+	test(0x809EBC10, FMOPS, ZAS(0), P(7).Merging(), P(5).Merging(), ZS(0), ZS(30))
+	test(0x80C9A9D4, FMOPS, ZAD(4), P(2).Merging(), P(5).Merging(), ZD(14), ZD(9))
+	test(0x81A59850, FMOPS, ZAS(0), P(6).Merging(), P(4).Merging(), ZH(2), ZH(5))
+
+	test(0xE013224E, LD1B, ZAB(0).H(W(13), 14), P(0).Zeroing(), RefIndexed{Base: X(18), Idx: X(19)})
+	test(0xE01F2C68, LD1B, ZAB(0).H(W(13), 8), P(3).Zeroing(), Ref{X(3)})
+
+	test(0xE0CAFB45, LD1D, ZAD(2).V(W(15), 1), P(6).Zeroing(), RefIndexed{X(26), X(10), ModLSL.Imm(3)})
+	test(0xE0DFDFC3, LD1D, ZAD(1).V(W(14), 1), P(7).Zeroing(), Ref{X(30)})
+
+	test(0xE046DACB, LD1H, ZAH(1).V(W(14), 3), P(6).Zeroing(), RefIndexed{X(22), X(6), ModLSL.Imm(1)})
+	test(0xE05F4EE3, LD1H, ZAH(0).H(W(14), 3), P(3).Zeroing(), Ref{X(23)})
+
+	test(0xE1CB2EEF, LD1Q, ZAQ(15).H(W(13), 0), P(3).Zeroing(), RefIndexed{X(23), X(11), ModLSL.Imm(4)})
+	test(0xE1DFD9E3, LD1Q, ZAQ(3).V(W(14), 0), P(6).Zeroing(), Ref{X(15)})
+
+	test(0xE082CE6C, LD1W, ZAS(3).V(W(14), 0), P(3).Zeroing(), RefIndexed{X(19), X(2), ModLSL.Imm(2)})
+	test(0xE09F05C3, LD1W, ZAS(0).H(W(12), 3), P(1).Zeroing(), Ref{X(14)})
+
+	test(0xE100210A, LDR, ZA.H(W(13), 10), RefVL{X(8), 10})
+	test(0xE100220D, LDR, ZA.H(W(13), 13), RefVL{X(16), 13})
+
+	test(0xC000E88D, MOVA, ZAB(0).V(W(15), 13), P(2).Merging(), ZB(4))
+	test(0xC0406A43, MOVA, ZAH(0).H(W(15), 3), P(2).Merging(), ZH(18))
+	test(0xC080D2AD, MOVA, ZAS(3).V(W(14), 1), P(4).Merging(), ZS(21))
+	test(0xC0C00B43, MOVA, ZAD(1).H(W(12), 1), P(2).Merging(), ZD(26))
+	test(0xC0C113E0, MOVA, ZAQ(0).H(W(12), 0), P(4).Merging(), ZQ(31))
+	test(0xC002FC7A, MOVA, ZB(26), P(7).Merging(), ZAB(0).V(W(15), 3))
+	test(0xC042CDA0, MOVA, ZH(0), P(3).Merging(), ZAH(1).V(W(14), 5))
+	test(0xC082790A, MOVA, ZS(10), P(6).Merging(), ZAS(2).H(W(15), 0))
+	test(0xC0C221B1, MOVA, ZD(17), P(0).Merging(), ZAD(6).H(W(13), 1))
+	test(0xC0C32CC0, MOVA, ZQ(0), P(3).Merging(), ZAQ(6).H(W(13), 0))
+
+	test(0xD503437F, MSR, SVCRSM, Imm(1))
+	test(0xD503447F, MSR, SVCRZA, Imm(0))
+	test(0xD503467F, MSR, SVCRSMZA, Imm(0))
+
+	test(0x04BF5C01, RDSVL, X(1), Imm(-32))
+
+	test(0xA09D8783, SMOPA, ZAS(3), P(1).Merging(), P(4).Merging(), ZB(28), ZB(29))
+	test(0xA0D0A145, SMOPA, ZAD(5), P(0).Merging(), P(5).Merging(), ZH(10), ZH(16))
+
+	test(0xA08FBC71, SMOPS, ZAS(1), P(7).Merging(), P(5).Merging(), ZB(3), ZB(15))
+	test(0xA0D5ECB2, SMOPS, ZAD(2), P(3).Merging(), P(7).Merging(), ZH(5), ZH(21))
+
+	test(0xD503477F, SMSTART)
+	test(0xD503457F, SMSTART, SVCRZA)
+	test(0xD503457F, SMSTART, SVCRZA)
+
+	test(0xD503467F, SMSTOP)
+	test(0xD503447F, SMSTOP, SVCRZA)
+	test(0xD503427F, SMSTOP, SVCRSM)
+
+	test(0xE02E8605, ST1B, ZAB(0).V(W(12), 5), P(1), RefIndexed{Base: X(16), Idx: X(14)})
+	test(0xE03F73A7, ST1B, ZAB(0).H(W(15), 7), P(4), Ref{X(29)})
+
+	test(0xE0F59520, ST1D, ZAD(0).V(W(12), 0), P(5), RefIndexed{X(9), X(21), ModLSL.Imm(3)})
+	test(0xE0FF2802, ST1D, ZAD(1).H(W(13), 0), P(2), Ref{X(0)})
+
+	test(0xE07D3A64, ST1H, ZAH(0).H(W(13), 4), P(6), RefIndexed{X(19), X(29), ModLSL.Imm(1)})
+	test(0xE07F830A, ST1H, ZAH(1).V(W(12), 2), P(0), Ref{X(24)})
+
+	test(0xE1F485E9, ST1Q, ZAQ(9).V(W(12), 0), P(1), RefIndexed{X(15), X(20), ModLSL.Imm(4)})
+	test(0xE1FFF2E4, ST1Q, ZAQ(4).V(W(15), 0), P(4), Ref{X(23)})
+
+	test(0xE0B72862, ST1W, ZAS(0).H(W(13), 2), P(2), RefIndexed{X(3), X(23), ModLSL.Imm(2)})
+	test(0xE0BF1D42, ST1W, ZAS(0).H(W(12), 2), P(7), Ref{X(10)})
+
+	test(0xE1206044, STR, ZA.H(W(15), 4), RefVL{X(2), 4})
+	test(0xE120618D, STR, ZA.H(W(15), 13), RefVL{X(12), 13})
+
+	test(0xA0A28CC2, SUMOPA, ZAS(2), P(3).Merging(), P(4).Merging(), ZB(6), ZB(2))
+	test(0xA0EBF806, SUMOPA, ZAD(6), P(6).Merging(), P(7).Merging(), ZH(0), ZH(11))
+
+	test(0xA0B861D2, SUMOPS, ZAS(2), P(0).Merging(), P(3).Merging(), ZB(14), ZB(24))
+	test(0xA0F6D493, SUMOPS, ZAD(3), P(5).Merging(), P(6).Merging(), ZH(4), ZH(22))
+
+	test(0xA1A7C2A2, UMOPA, ZAS(2), P(0).Merging(), P(6).Merging(), ZB(21), ZB(7))
+	test(0xA1FD3264, UMOPA, ZAD(4), P(4).Merging(), P(1).Merging(), ZH(19), ZH(29))
+
+	test(0xA1B23033, UMOPS, ZAS(3), P(4).Merging(), P(1).Merging(), ZB(1), ZB(18))
+	test(0xA1E33C13, UMOPS, ZAD(3), P(7).Merging(), P(1).Merging(), ZH(0), ZH(3))
+
+	test(0xA19228A0, USMOPA, ZAS(0), P(2).Merging(), P(1).Merging(), ZB(5), ZB(18))
+	test(0xA1C6EBC3, USMOPA, ZAD(3), P(2).Merging(), P(7).Merging(), ZH(30), ZH(6))
+
+	test(0xA19F9431, USMOPS, ZAS(1), P(5).Merging(), P(4).Merging(), ZB(1), ZB(31))
+	test(0xA1DBF1B2, USMOPS, ZAD(2), P(4).Merging(), P(7).Merging(), ZH(13), ZH(27))
+
+	test(0xC00800FF, ZERO, ZA)
+}
+
+func TestEncodingExtensions(t *testing.T) {
+	code := make([]byte, 256)
+	var a Assembler
+	a.Cache = testCache
+
+	test := func(enc uint32, inst Inst, args ...Arg) {
+		a.Init(code)
+		if !a.Inst(inst, args...) {
+			t.Logf("Failed to encode inst %v for enc %08X -- err: %v\n\targs:\n%#+v", inst, enc, a.Err, args)
+			t.Fail()
+		} else if actual := dec32(code); actual != enc {
+			t.Logf("Invalid inst=%v:\n%032b (expected) = %08X\n%032b (actual)   = %08X\n%032b (opcode)\n%032b (args", inst, enc, enc, actual, actual, a.Opcode, a.Opcode^actual)
+			if e, ok := a.Explain(); ok {
+				t.Logf("actual: %v", e)
+			}
+			t.Fail()
+		}
+	}
+
+	test(0x91840C0D, ADDG, X(13), X(0), Imm(64), Imm(3))
+	test(0x919C2DDB, ADDG, X(27), X(14), Imm(448), Imm(11))
+
+	test(0xD500405F, AXFLAG)
+
+	test(0x1E63402B, BFCVT, ScalarH(11), ScalarS(1))
+
+	test(0x0EA169B4, BFCVTN, Vec4H(20), Vec4S(13))
+
+	test(0x4EA16B2D, BFCVTN2, Vec8H(13), Vec4S(25))
+
+	test(0x2E54FE06, BFDOT, Vec2S(6), Vec4H(16), Vec4H(20))
+	test(0x0F77F120, BFDOT, Vec2S(0), Vec4H(9), Vec2H(23).I(1))
+	test(0x6E4EFF6E, BFDOT, Vec4S(14), Vec8H(27), Vec8H(14))
+	test(0x4F74FAB0, BFDOT, Vec4S(16), Vec8H(21), Vec2H(20).I(3))
+
+	test(0x2ED0FC8A, BFMLALB, Vec4S(10), Vec8H(4), Vec8H(16))
+	test(0x0FE6F1C6, BFMLALB, Vec4S(6), Vec8H(14), Vec8H(6).I(2))
+	test(0x0FDBFA22, BFMLALB, Vec4S(2), Vec8H(17), Vec8H(11).I(5))
+
+	test(0x6EC6FE44, BFMLALT, Vec4S(4), Vec8H(18), Vec8H(6))
+	test(0x4FD3FAFA, BFMLALT, Vec4S(26), Vec8H(23), Vec8H(3).I(5))
+	test(0x4FEBF0F2, BFMLALT, Vec4S(18), Vec8H(7), Vec8H(11).I(2))
+
+	test(0x6E4DEE7D, BFMMLA, Vec4S(29), Vec8H(19), Vec8H(13))
+
+	test(0xD503241F, BTI)
+	test(0xD50324DF, BTI, BTIJC)
+	test(0xD503245F, BTI, BTIC)
+	test(0xD503249F, BTI, BTIJ)
+
+	test(0x1D8A0688, CPYE, RefPreIndexed{Base: X(8)}, RefPreIndexed{Base: X(10)}, X(20))
+
+	test(0x198B0683, CPYFE, RefPreIndexed{Base: X(3)}, RefPreIndexed{Base: X(11)}, X(20))
+
+	test(0x19510722, CPYFM, RefPreIndexed{Base: X(2)}, RefPreIndexed{Base: X(17)}, X(25))
+
+	test(0x190A06C8, CPYFP, RefPreIndexed{Base: X(8)}, RefPreIndexed{Base: X(10)}, X(22))
+
+	test(0x1D4B0684, CPYM, RefPreIndexed{Base: X(4)}, RefPreIndexed{Base: X(11)}, X(20))
+
+	test(0x1D0A07C0, CPYP, RefPreIndexed{Base: X(0)}, RefPreIndexed{Base: X(10)}, X(30))
+
+	test(0x1E28C25A, FRINT32X, ScalarS(26), ScalarS(18))
+	test(0x1E68C24A, FRINT32X, ScalarD(10), ScalarD(18))
+	test(0x6E21E8FA, FRINT32X, Vec4S(26), Vec4S(7))
+	test(0x6E21EB03, FRINT32X, Vec4S(3), Vec4S(24))
+	test(0x6E61E83F, FRINT32X, Vec2D(31), Vec2D(1))
+
+	test(0x1E284314, FRINT32Z, ScalarS(20), ScalarS(24))
+	test(0x1E684128, FRINT32Z, ScalarD(8), ScalarD(9))
+	test(0x4E21EACA, FRINT32Z, Vec4S(10), Vec4S(22))
+	test(0x4E21E8B9, FRINT32Z, Vec4S(25), Vec4S(5))
+	test(0x4E61EBCC, FRINT32Z, Vec2D(12), Vec2D(30))
+
+	test(0x1E29C382, FRINT64X, ScalarS(2), ScalarS(28))
+	test(0x1E69C1CE, FRINT64X, ScalarD(14), ScalarD(14))
+	test(0x6E21F97C, FRINT64X, Vec4S(28), Vec4S(11))
+	test(0x2E21F96A, FRINT64X, Vec2S(10), Vec2S(11))
+	test(0x6E61F87D, FRINT64X, Vec2D(29), Vec2D(3))
+
+	test(0x1E29427D, FRINT64Z, ScalarS(29), ScalarS(19))
+	test(0x1E6940C3, FRINT64Z, ScalarD(3), ScalarD(6))
+	test(0x4E21F9D3, FRINT64Z, Vec4S(19), Vec4S(14))
+	test(0x0E21F8EE, FRINT64Z, Vec2S(14), Vec2S(7))
+	test(0x4E61F98A, FRINT64Z, Vec2D(10), Vec2D(12))
+
+	test(0x9ACC157D, GMI, X(29), X(11), X(12))
+
+	test(0x9AD31373, IRG, X(19), X(27), X(19))
+	test(0x9AD01049, IRG, X(9), X(2), X(16))
+	test(0x9ADF1020, IRG, X(0), X(1))
+
+	test(0xF83FD072, LD64B, X(18), Ref{X(3)})
+
+	test(0xD979F12E, LDG, X(14), RefOffset{X(9), -1552})
+	test(0xD97D22EA, LDG, X(10), RefOffset{X(23), -736})
+
+	test(0x19CC8681, SETE, RefPreIndexed{Base: X(1)}, X(20), X(12))
+
+	test(0x1DCD8685, SETGE, RefPreIndexed{Base: X(5)}, X(20), X(13))
+
+	test(0x1DD246E3, SETGM, RefPreIndexed{Base: X(3)}, X(23), X(18))
+
+	test(0x1DCD06E6, SETGP, RefPreIndexed{Base: X(6)}, X(23), X(13))
+
+	test(0x19CC46E0, SETM, RefPreIndexed{Base: X(0)}, X(23), X(12))
+
+	test(0x19CE0763, SETP, RefPreIndexed{Base: X(3)}, X(27), X(14))
+
+	test(0x4E8FA583, SMMLA, Vec4S(3), Vec16B(12), Vec16B(15))
+
+	test(0xD9B65B4C, ST2G, X(12), RefOffset{X(26), -2480})
+	test(0xD9A78FD7, ST2G, X(23), RefPreIndexed{X(30), 1920})
+	test(0xD9A0E49B, ST2G, X(27), Ref{X(4)}, Imm(224))
+
+	test(0xF83F9256, ST64B, X(22), Ref{X(18)})
+
+	test(0xF835B0EA, ST64BV, X(21), X(10), Ref{X(7)})
+
+	test(0xF821A32C, ST64BV0, X(1), X(12), Ref{X(25)})
+
+	test(0xD93C9AB9, STG, X(25), RefOffset{X(21), -880})
+	test(0xD92B0EB1, STG, X(17), RefPreIndexed{X(21), 2816})
+	test(0xD93E1509, STG, X(9), Ref{X(8)}, Imm(-496))
+
+	test(0x6922A015, STGP, X(21), X(8), RefOffset{X(0), -944})
+	test(0x69B175AC, STGP, X(12), X(29), RefPreIndexed{X(13), -480})
+	test(0x68A1112D, STGP, X(13), X(4), Ref{X(9)}, Imm(-992))
+
+	test(0xD9E5CAC4, STZ2G, X(4), RefOffset{X(22), 1472})
+	test(0xD9E97C59, STZ2G, X(25), RefPreIndexed{X(2), 2416})
+	test(0xD9EB45A5, STZ2G, X(5), Ref{X(13)}, Imm(2880))
+
+	test(0xD96E39BA, STZG, X(26), RefOffset{X(13), 3632})
+	test(0xD963AC90, STZG, X(16), RefPreIndexed{X(4), 928})
+	test(0xD96E2538, STZG, X(24), Ref{X(9)}, Imm(3616))
+
+	test(0xD1AA35E2, SUBG, X(2), X(15), Imm(672), Imm(13))
+	test(0xD1993AFA, SUBG, X(26), X(23), Imm(400), Imm(14))
+
+	test(0x9ACD00E5, SUBP, X(5), X(7), X(13))
+
+	test(0xBAD501B9, SUBPS, X(25), X(13), X(21))
+
+	test(0x0F07F845, SUDOT, Vec2S(5), Vec8B(2), Vec4B(7).I(2))
+	test(0x4F18F94B, SUDOT, Vec4S(11), Vec16B(10), Vec4B(24).I(2))
+
+	test(0x6E92A696, UMMLA, Vec4S(22), Vec16B(20), Vec16B(18))
+
+	test(0x0E8D9DEF, USDOT, Vec2S(15), Vec8B(15), Vec8B(13))
+	test(0x4E869C53, USDOT, Vec4S(19), Vec16B(2), Vec16B(6))
+	test(0x0F91F809, USDOT, Vec2S(9), Vec8B(0), Vec4B(17).I(2))
+	test(0x4FA4F279, USDOT, Vec4S(25), Vec16B(19), Vec4B(4).I(1))
+
+	test(0x4E8EADD0, USMMLA, Vec4S(16), Vec16B(14), Vec16B(14))
+
+	test(0xD503101C, WFET, X(28))
+
+	test(0xD503102A, WFIT, X(10))
+
+	test(0xD500403F, XAFLAG)
+
+	// CSSC encodings from the architecture reference manual:
+	test(0x5AC020E3, ABS, W(3), W(7))
+	test(0xDAC020E3, ABS, X(3), X(7))
+	test(0x5AC01C41, CNT, W(1), W(2))
+	test(0xDAC01BC0, CTZ, X(0), X(30))
+	test(0x11C3FC20, SMAX, W(0), W(1), Imm(-1))
+	test(0x1AC26020, SMAX, W(0), W(1), W(2))
+	test(0x11CA00A4, SMIN, W(4), W(5), Imm(-128))
+	test(0x91C7FC62, UMAX, X(2), X(3), Imm(255))
+	test(0x91CC00E6, UMIN, X(6), X(7), Imm(0))
+	test(0x9ACB6D49, UMIN, X(9), X(10), X(11))
+}
+
+func TestFeatures(t *testing.T) {
+	code := make([]byte, 256)
+	var a Assembler
+	a.Init(code)
+
+	target := func(name string) {
+		cpu, err := LookupCPU(name)
+		if err != nil {
+			t.Fatalf("Failed to look up CPU %s: %v", name, err)
+		}
+		a.Init(code)
+		a.CPU = &cpu
+	}
+	accept := func(feature Feature, inst Inst, args ...Arg) {
+		if !a.Inst(inst, args...) {
+			t.Fatalf("Failed to encode %s: %v", inst, a.Err)
+		}
+		if a.Feature != feature {
+			t.Fatalf("Invalid feature for %s: %v, expecting %v", inst, a.Feature, feature)
+		}
+	}
+	reject := func(feature Feature, inst Inst, args ...Arg) {
+		a.Init(code)
+		if a.Inst(inst, args...) {
+			t.Fatalf("Encoded %s for %s, expecting %s to be required", inst, a.CPU.Name, feature)
+		}
+		var err *FeatureError
+		if !errors.As(a.Err, &err) || !errors.Is(a.Err, ErrUnsupportedFeature) {
+			t.Fatalf("Invalid error for %s: %v", inst, a.Err)
+		}
+		if err.Inst != inst || err.Feature != feature || err.CPU != a.CPU.Name {
+			t.Fatalf("Invalid error for %s: %+v", inst, *err)
+		}
+	}
+
+	target("armv8.0-a")
+	accept(0, ABS, ScalarD(1), ScalarD(2))
+	accept(0, FMOV, ScalarS(1), ScalarS(2))
+	accept(0, FCVT, ScalarH(1), ScalarS(2))
+	accept(0, PACIASP) // hint space
+	accept(0, BTI)     // hint space
+	reject(FeatLSE, CAS, W(12), W(4), Ref{X(5)})
+	reject(FeatLRCPC, LDAPR, W(0), Ref{X(1)})
+	reject(FeatCSSC, ABS, W(3), W(7))
+	reject(FeatFP16, FMOV, ScalarH(1), ScalarH(2))
+	reject(FeatSVE, ADD, ZB(0), ZB(1), ZB(2))
+	reject(FeatDotProd, SDOT, Vec4S(0), Vec16B(1), Vec16B(2))
+	if err := a.Err.Error(); err != "SDOT requires FEAT_DotProd, which is not available for armv8.0-a" {
+		t.Fatalf("Invalid error message: %s", err)
+	}
+
+	target("neoverse-n1")
+	accept(FeatLSE, CAS, W(12), W(4), Ref{X(5)})
+	accept(FeatLRCPC, LDAPR, W(0), Ref{X(1)})
+	accept(FeatFP16, FMOV, ScalarH(1), ScalarH(2))
+	reject(FeatLRCPC2, LDAPUR, W(0), Ref{X(1)})
+	reject(FeatSVE, ADD, ZB(0), ZB(1), ZB(2))
+
+	target("armv8.2-a+dotprod+nolse")
+	accept(FeatDotProd, SDOT, Vec4S(0), Vec16B(1), Vec16B(2))
+	accept(FeatCRC32, CRC32B, W(0), W(1), W(2))
+	reject(FeatLSE, CAS, W(12), W(4), Ref{X(5)})
+
+	target("armv9.0-a")
+	accept(FeatSVE2, MUL, ZB(0), ZB(1), ZB(2))
+	reject(FeatSME, ZERO, ZA)
+
+	// Removing a feature keeps its prerequisites, and removes the features which depend on it:
+	for _, test := range []struct {
+		name        string
+		has, hasNot []Feature
+	}{
+		{"neoverse-n2+nosve2", []Feature{FeatSVE, FeatFP16}, []Feature{FeatSVE2}},
+		{"neoverse-n2+nosve", []Feature{FeatFP16, FeatFHM}, []Feature{FeatSVE, FeatSVE2}},
+		{"neoverse-n2+nofp16", []Feature{FeatBF16}, []Feature{FeatFP16, FeatFHM, FeatSVE, FeatSVE2}},
+		{"armv8.2-a+fp16+nofp16fml", []Feature{FeatFP16}, []Feature{FeatFHM}},
+		{"armv8.4-a+rcpc2+norcpc", nil, []Feature{FeatLRCPC, FeatLRCPC2}},
+		{"apple-m1+nosha2", []Feature{FeatAES}, []Feature{FeatSHA1, FeatSHA256, FeatSHA512, FeatSHA3}},
+		{"armv9.0-a+sme-f64f64+nosme", []Feature{FeatBF16, FeatSVE2}, []Feature{FeatSME, FeatSMEF64F64}},
+	} {
+		cpu, err := LookupCPU(test.name)
+		if err != nil {
+			t.Fatalf("Failed to look up CPU %s: %v", test.name, err)
+		}
+		for _, f := range test.has {
+			if !cpu.Features.Has(f) {
+				t.Fatalf("Expected %s for %s, found %v", f, test.name, cpu.Features)
+			}
+		}
+		for _, f := range test.hasNot {
+			if cpu.Features.Has(f) {
+				t.Fatalf("Unexpected %s for %s, found %v", f, test.name, cpu.Features)
+			}
+		}
+	}
+	target("neoverse-n2+nosve2")
+	accept(FeatSVE, ADD, ZB(0), ZB(1), ZB(2))
+	reject(FeatSVE2, MUL, ZB(0), ZB(1), ZB(2))
+
+	// Some encodings require two features:
+	target("armv8.8-a")
+	accept(FeatMOPS, SETP, RefPreIndexed{Base: X(3)}, X(27), X(14))
+	reject(FeatMTE, SETGP, RefPreIndexed{Base: X(6)}, X(23), X(13))
+	target("armv8.8-a+memtag")
+	accept(FeatMOPS, SETGP, RefPreIndexed{Base: X(6)}, X(23), X(13))
+	target("armv8.3-a")
+	accept(FeatFCMA, FCMLA, Vec4S(0), Vec4S(1), Vec4S(2), Imm(90))
+	reject(FeatFP16, FCMLA, Vec4H(0), Vec4H(1), Vec4H(2), Imm(90))
+	reject(FeatFP16, FCADD, Vec8H(0), Vec8H(1), Vec8H(2), Imm(270))
+	target("armv8.3-a+fp16")
+	accept(FeatFCMA, FCMLA, Vec4H(0), Vec4H(1), Vec4H(2), Imm(90))
+	for _, enc := range Encodings(SETGP) {
+		if enc.Feature != FeatMOPS || enc.ExtraFeature != FeatMTE {
+			t.Fatalf("Invalid features for SETGP: %v, %v", enc.Feature, enc.ExtraFeature)
+		}
+	}
+
+	for _, name := range []string{"cortex-z1", "armv8.2-a+nothing"} {
+		if _, err := LookupCPU(name); err == nil {
+			t.Fatalf("Expected error for unknown CPU %s", name)
+		}
+	}
+
+	// Without a CPU, all encodings are allowed:
+	a.Init(code)
+	a.CPU = nil
+	accept(FeatSME, ZERO, ZA)
+
+	if fs := CAS.Features(); fs != 1<<FeatLSE {
+		t.Fatalf("Invalid features for CAS: %v", fs)
+	}
+	if fs := FMOV.Features(); fs != 1<<FeatFP16|1<<FeatSVE {
+		t.Fatalf("Invalid features for FMOV: %v", fs)
+	}
+	if fs := ADD.Features(); fs != 1<<FeatSVE {
+		t.Fatalf("Invalid features for ADD: %v", fs)
+	}
+}
+
+func TestSystemRegs(t *testing.T) {
+	code := make([]byte, 256)
+	var a Assembler
+	a.Init(code)
+
+	test := func(expected uint32, inst Inst, args ...Arg) {
+		a.PC = 0
+		if !a.Inst(inst, args...) {
+			t.Fatalf("Failed to encode %s %v: %v", inst, args, a.Err)
+		}
+		if actual := dec32(code); actual != expected {
+			t.Fatalf("Invalid %s %v: %08X, expecting %08X", inst, args, actual, expected)
+		}
+	}
+
+	test(0xD53BD040, MRS, X(0), TPIDR_EL0)
+	test(0xD51BD040, MSR, TPIDR_EL0, X(0))
+	test(0xD53BD040, MRS, X(0), SysReg(3, 3, 13, 0, 2))
+	test(0xD53BD040, MRS, X(0), Imm(0b1_011_1101_0000_010))
+	test(0xD53BE041, MRS, X(1), CNTVCT_EL0)
+	test(0xD53B4202, MRS, X(2), NZCV)
+	test(0xD51B4403, MSR, FPCR, X(3))
+	test(0xD53B4424, MRS, X(4), FPSR)
+	test(0xD53B00E5, MRS, X(5), DCZID_EL0)
+	test(0xD53B0026, MRS, X(6), CTR_EL0)
+	test(0xD5380007, MRS, X(7), MIDR_EL1)
+	test(0xD5330508, MRS, X(8), DBGDTRRX_EL0)
+	test(0xD5181009, MSR, SCTLR_EL1, X(9))
+	test(0xD53BEA1A, MRS, X(26), PMEVCNTR16_EL0)
+	test(0xD5384260, MRS, X(0), PAN)
+	test(0xD5184260, MSR, PAN, X(0))
+	test(0xD5184200, MSR, SPSEL, X(0))
+	test(0xD50040BF, MSR, SPSEL, Imm(0)) // MSR (immediate)
+
+	if a.Inst(MRS, X(0), SystemReg(0b01_011_1101_0000_010)) || a.Err != ErrNoMatch {
+		t.Fatalf("Expected no match for op0=1: %v", a.Err)
+	}
+
+	if name := TPIDR_EL0.String(); name != "TPIDR_EL0" {
+		t.Fatalf("Invalid name for TPIDR_EL0: %s", name)
+	}
+	if name := SysReg(3, 0, 4, 2, 3).String(); name != "PAN" {
+		t.Fatalf("Invalid name for PAN: %s", name)
+	}
+	if name := SysReg(3, 7, 15, 15, 7).String(); name != "S3_7_C15_C15_7" {
+		t.Fatalf("Invalid generic name: %s", name)
+	}
+	if op0, op1, crn, crm, op2 := CNTVCT_EL0.Fields(); op0 != 3 || op1 != 3 || crn != 14 || crm != 0 || op2 != 2 {
+		t.Fatalf("Invalid fields for CNTVCT_EL0: %d %d %d %d %d", op0, op1, crn, crm, op2)
+	}
+	for name, expected := range map[string]SystemReg{"tpidr_el0": TPIDR_EL0, "SPSel": SysReg(3, 0, 4, 2, 0), "s3_3_c13_c0_2": TPIDR_EL0} {
+		if r, ok := LookupSystemReg(name); !ok || r != expected {
+			t.Fatalf("Invalid lookup for %s: %v", name, r)
+		}
+	}
+	for _, name := range []string{"TPIDR_EL9", "S1_0_C0_C0_0", "S3_8_C0_C0_0", "S3_0_C16_C0_0"} {
+		if _, ok := LookupSystemReg(name); ok {
+			t.Fatalf("Expected lookup failure for %s", name)
+		}
+	}
+	for i := 1; i < len(SystemRegs); i++ {
+		if SystemRegs[i-1].Reg >= SystemRegs[i].Reg {
+			t.Fatalf("System registers out of order: %s, %s", SystemRegs[i-1].Name, SystemRegs[i].Name)
+		}
+	}
+}
+
+func TestFloatImm(t *testing.T) {
+	code := make([]byte, 256)
+	var a Assembler
+	a.Init(code)
+
+	test := func(expected uint32, inst Inst, args ...Arg) {
+		a.PC = 0
+		if !a.Inst(inst, args...) {
+			t.Fatalf("Failed to encode %s %v: %v", inst, args, a.Err)
+		}
+		if actual := dec32(code); actual != expected {
+			t.Fatalf("Invalid %s %v: %08X, expecting %08X", inst, args, actual, expected)
+		}
+	}
+
+	test(0x1E609000, FMOV, ScalarD(0), Double(2.5))
+	test(0x1EEC1000, FMOV, ScalarH(0), Half(0x3800)) // 0.5
+	test(0x1EEC1000, FMOV, ScalarH(0), Float(0.5))
+	test(0x1E37F003, FMOV, ScalarS(3), Double(-31))
+	test(0x1E681007, FMOV, ScalarD(7), Half(0x3000)) // 0.125
+	test(0x6F07F681, FMOV, Vec2D(1), Double(-1.25))
+	test(0x0F03FC02, FMOV, Vec4H(2), Half(0x3800))
+	test(0x4F00F503, FMOV, Vec4S(3), Double(3))
+	test(0x9E6703E9, FMOV, ScalarD(9), Double(0))
+	test(0x1EE703E2, FMOV, ScalarH(2), Half(0))
+	test(0x2579CC01, FMOV, ZH(1), Half(0x3800))
+	test(0x0592C081, FMOV, ZS(1), P(2).Merging(), Double(2.5))
+	test(0x05DFDE03, FMOV, ZD(3), P(15).Merging(), Half(0xBC00)) // -1.0
+	test(0x25F8C005, FMOV, ZD(5), Double(0))
+	test(0x05934004, FMOV, ZS(4), P(3).Merging(), Float(0))
+	test(0x1E602028, FCMP, ScalarD(1), Double(0))
+	test(0x25F9CE02, FDUP, ZD(2), Double(1))
+
+	for _, arg := range []Arg{Double(0.1), Double(32), Double(1.0 / 16), Float(1e-3), Half(0x3555), Double(math.NaN()), Double(math.Inf(1)), Double(math.Copysign(0, -1)), Half(0x8000)} {
+		a.PC = 0
+		if a.Inst(FMOV, ScalarD(0), arg) || a.Err != ErrInvalidFloatImm {
+			t.Fatalf("Expected invalid float immediate for %v: %v", arg, a.Err)
+		}
+		a.Init(code)
+	}
+
+	for f, ok := range map[float64]bool{2.5: true, -31: true, 0.125: true, 1.9375: true, 0: false, 0.1: false, 32: false, 1.0 / 16: false, 1.03125: false} {
+		if IsFP8(f) != ok {
+			t.Fatalf("Invalid IsFP8(%v): %v", f, !ok)
+		}
+	}
+	for h, f := range map[Half]float64{0x3800: 0.5, 0xBC00: -1, 0x7BFF: 65504, 0x0001: 0x1p-24, 0x8000: math.Copysign(0, -1), 0x7C00: math.Inf(1)} {
+		if v := h.Float64(); v != f || math.Signbit(v) != math.Signbit(f) {
+			t.Fatalf("Invalid value for half %04X: %v, expecting %v", uint16(h), v, f)
+		}
+	}
+}
+
+func TestVecConst(t *testing.T) {
+	code := make([]byte, 256)
+	var a Assembler
+
+	test := func(r Reg, lo, hi uint64, expected ...uint32) {
+		var v [16]byte
+		binary.LittleEndian.PutUint64(v[:], lo)
+		binary.LittleEndian.PutUint64(v[8:], hi)
+		a.Init(code)
+		if !a.VecConst(r, v) {
+			t.Fatalf("Failed to materialize %016X%016X: %v", hi, lo, a.Err)
+		}
+		if a.PC != uint32(4*len(expected)) {
+			t.Fatalf("Invalid length for %016X%016X: %d, expecting %d", hi, lo, a.PC, 4*len(expected))
+		}
+		for i, enc := range expected {
+			if actual := dec32(code[4*i:]); actual != enc {
+				t.Fatalf("Invalid inst %d for %016X%016X: %08X, expecting %08X", i, hi, lo, actual, enc)
+			}
+		}
+	}
+
+	test(Vec4S(0), 0, 0, 0x4F00E400)                                                // movi v0.16b, #0
+	test(Vec4S(1), 0x0000AB000000AB00, 0x0000AB000000AB00, 0x4F052561)              // movi v1.4s, #171, lsl #8
+	test(Vec16B(2), 0xFFFF54FFFFFF54FF, 0xFFFF54FFFFFF54FF, 0x6F052562)             // mvni v2.4s, #171, lsl #8
+	test(Vec2D(3), 0x0001FFFF0001FFFF, 0x0001FFFF0001FFFF, 0x4F00D423)              // movi v3.4s, #1, msl #16
+	test(Vec2D(4), 0x00FF00FFFF0000FF, 0x00FF00FFFF0000FF, 0x6F02E724)              // movi v4.2d, #0xff00ffff0000ff
+	test(Vec4S(5), 0x4020000040200000, 0x4020000040200000, 0x4F00F485)              // fmov v5.4s, #2.5
+	test(Vec8B(6), 0x00FF00FFFF0000FF, 0x1234, 0x2F02E726)                          // movi d6, #0xff00ffff0000ff
+	test(Vec4S(7), 0x00AB00CD00AB00CD, 0x00AB00CD00AB00CD, 0x4F0605A7, 0x4F055567)  // movi v7.4s, #205; orr v7.4s, #171, lsl #16
+	test(Vec4S(8), 0xFF54FF00FF54FF00, 0xFF54FF00FF54FF00, 0x6F0707E8, 0x6F055568)  // mvni v8.4s, #255; bic v8.4s, #171, lsl #16
+	test(Vec8H(9), 0x3FC03FC03FC03FC0, 0x3FC03FC03FC03FC0, 0x4F03FFE9)              // fmov v9.8h, #1.9375
+	test(Vec2D(10), 0x4004000000000000, 0x4004000000000000, 0x6F00F48A)             // fmov v10.2d, #2.5
+	test(Vec8H(11), 0x1234123412341234, 0x1234123412341234, 0x4F01868B, 0x4F00B64B) // movi v11.8h, #52; orr v11.8h, #18, lsl #8
+	test(Vec4S(12), 0x0123456789ABCDEF, 0xFEDCBA9876543210, 0x9C00000C)             // ldr q12, <pool>
+
+	cpu, _ := LookupCPU("armv8.0-a")
+	a.CPU = &cpu
+	test(Vec8H(9), 0x3FC03FC03FC03FC0, 0x3FC03FC03FC03FC0, 0x4F068409, 0x4F01B7E9) // movi v9.8h, #192; orr v9.8h, #63, lsl #8
+	a.CPU = nil
+
+	// Literal loads share pool entries for identical constants:
+	a.Init(code)
+	value := [16]byte{0: 1, 3: 2, 8: 3, 15: 4}
+	if !a.VecConst(Vec4S(0), value) || !a.VecConst(Vec2D(1), value) || !a.VecConst(Vec8B(2), value) || !a.Inst(RET) {
+		t.Fatalf("Failed to materialize constants: %v", a.Err)
+	}
+	if len(a.Pool) != 2 {
+		t.Fatalf("Invalid pool length: %d", len(a.Pool))
+	}
+	if !a.EmitPool() || !a.ApplyRelocations() {
+		t.Fatalf("Failed to emit pool: %v", a.Err)
+	}
+	if a.PC != 40 || len(a.Pool) != 0 {
+		t.Fatalf("Invalid PC after pool: %d", a.PC)
+	}
+	for i, enc := range []uint32{0x9C000080, 0x9C000061, 0x5C0000C2} { // ldr q0, #16; ldr q1, #12; ldr d2, #24
+		if actual := dec32(code[4*i:]); actual != enc {
+			t.Fatalf("Invalid literal load %d: %08X, expecting %08X", i, actual, enc)
+		}
+	}
+	if !bytes.Equal(code[16:32], value[:]) || !bytes.Equal(code[32:40], value[:8]) {
+		t.Fatalf("Invalid pool data: % X", code[16:40])
+	}
+}
+
+func TestImmediates(t *testing.T) {
+	for _, size := range []Size{DWORD, QWORD} {
+		values := map[uint64]bool{}
+		for enc := uint16(0); enc < 1<<13; enc++ {
+			v, ok := DecodeLogicalImm(enc, size)
+			if !ok {
+				continue
+			}
+			values[v] = true
+			if actual, ok := EncodeLogicalImm(v, size); !ok {
+				t.Fatalf("Failed to encode logical immediate %016X", v)
+			} else if decoded, _ := DecodeLogicalImm(actual, size); decoded != v {
+				t.Fatalf("Invalid logical immediate for %016X: %04X", v, actual)
+			}
+		}
+		if count, expected := len(values), map[Size]int{DWORD: 1302, QWORD: 5334}[size]; count != expected {
+			t.Fatalf("Invalid logical immediate count for %v: %d, expecting %d", SizeName[size], count, expected)
+		}
+	}
+	if enc, ok := EncodeLogicalImm(0x00FF00FF00FF00FF, QWORD); !ok || enc != 0x0027 {
+		t.Fatalf("Invalid logical immediate: %04X", enc)
+	}
+	for _, v := range []uint64{0, math.MaxUint64, 0x1234} {
+		if IsLogicalImm(v, QWORD) {
+			t.Fatalf("Expected invalid logical immediate for %016X", v)
+		}
+	}
+	if IsLogicalImm(1<<32, DWORD) || !IsLogicalImm(0xFFFF0000, DWORD) {
+		t.Fatalf("Invalid 32-bit logical immediate checks")
+	}
+
+	if imm, shift, ok := EncodeWideImm(0x12340000, DWORD); !ok || imm != 0x1234 || shift != 16 {
+		t.Fatalf("Invalid wide immediate: %04X, %d", imm, shift)
+	}
+	if imm, shift, ok := EncodeWideImm(0xABCD000000000000, QWORD); !ok || imm != 0xABCD || shift != 48 {
+		t.Fatalf("Invalid wide immediate: %04X, %d", imm, shift)
+	}
+	if _, _, ok := EncodeWideImm(0x10001, QWORD); ok {
+		t.Fatalf("Expected invalid wide immediate")
+	}
+
+	for imm := 0; imm < 256; imm++ {
+		f := DecodeFP8(uint8(imm))
+		if enc, ok := EncodeFP8(f); !ok || enc != uint8(imm) {
+			t.Fatalf("Invalid FP8 immediate for %v: %02X, expecting %02X", f, enc, imm)
+		}
+		if v := DecodeByteMask(uint8(imm)); v != 0 || imm == 0 {
+			if enc, ok := EncodeByteMask(v); !ok || enc != uint8(imm) {
+				t.Fatalf("Invalid byte mask for %016X: %02X, expecting %02X", v, enc, imm)
+			}
+		}
+	}
+	if f := DecodeFP8(0x70); f != 1 {
+		t.Fatalf("Invalid FP8 value: %v", f)
+	}
+	if v := DecodeByteMask(0b10000101); v != 0xFF00000000FF00FF {
+		t.Fatalf("Invalid byte mask: %016X", v)
+	}
+	if _, ok := EncodeByteMask(0x00FF00FF00FF00FE); ok {
+		t.Fatalf("Expected invalid byte mask")
+	}
+}
+
+func TestEmitters(t *testing.T) {
+	code, expected := make([]byte, 256), make([]byte, 256)
+	var a, b Assembler
+
+	test := func(name string, emit func(*Assembler) bool, inst Inst, args ...Arg) {
+		a.Init(code)
+		b.Init(expected)
+		if !emit(&a) {
+			t.Fatalf("Failed to emit %s: %v", name, a.Err)
+		}
+		if !b.Inst(inst, args...) {
+			t.Fatalf("Failed to encode %s: %v", name, b.Err)
+		}
+		if a.PC != b.PC || !bytes.Equal(code[:a.PC], expected[:b.PC]) {
+			t.Fatalf("Invalid encoding for %s: % X, expecting % X", name, code[:a.PC], expected[:b.PC])
+		}
+	}
+
+	test("ADD_XXX_Mod", func(a *Assembler) bool { return a.ADD_XXX_Mod(1, 2, 3, ModLSL.Imm(4)) }, ADD, X(1), X(2), X(3), ModLSL.Imm(4))
+	test("ADD_XXX", func(a *Assembler) bool { return a.ADD_XXX(1, 2, 3) }, ADD, X(1), X(2), X(3))
+	test("ADD_V4SV4SV4S", func(a *Assembler) bool { return a.ADD_V4SV4SV4S(4, 5, 6) }, ADD, Vec4S(4), Vec4S(5), Vec4S(6))
+	test("ADD_V8BV8BV8B", func(a *Assembler) bool { return a.ADD_V8BV8BV8B(4, 5, 6) }, ADD, Vec8B(4), Vec8B(5), Vec8B(6))
+	test("LDR_X_RefOffset", func(a *Assembler) bool { return a.LDR_X_RefOffset(0, 31, 16) }, LDR, X(0), RefOffset{XSP, 16})
+	test("STR_X_RefPre", func(a *Assembler) bool { return a.STR_X_RefPre(30, 31, -16) }, STR, X(30), RefPreIndexed{XSP, -16})
+	test("LDP_XX_RefOffset", func(a *Assembler) bool { return a.LDP_XX_RefOffset(29, 30, 31, 16) }, LDP, X(29), X(30), RefOffset{XSP, 16})
+	test("MOV_X_Imm", func(a *Assembler) bool { return a.MOV_X_Imm(7, 0xABCD000000000000) }, MOV, X(7), Wide(0xABCD000000000000))
+	test("MOV_LOGICAL_Xsp_Imm", func(a *Assembler) bool { return a.MOV_LOGICAL_Xsp_Imm(31, 0xFFFF0000FFFF0000) }, MOV, LOGICAL, XSP, Wide(0xFFFF0000FFFF0000))
+	test("MOV_XVDi", func(a *Assembler) bool { return a.MOV_XVDi(1, 2, 1) }, MOV, X(1), Vec2D(2).I(1))
+	test("CSEL_XXX_Cond", func(a *Assembler) bool { return a.CSEL_XXX_Cond(1, 2, 3, NE) }, CSEL, X(1), X(2), X(3), NE)
+	test("FMOV_D_Float", func(a *Assembler) bool { return a.FMOV_D_Float(3, 2.5) }, FMOV, ScalarD(3), Float(2.5))
+	test("MRS_X_SysReg", func(a *Assembler) bool { return a.MRS_X_SysReg(0, TPIDR_EL0) }, MRS, X(0), TPIDR_EL0)
+	test("RET", func(a *Assembler) bool { return a.RET() }, RET)
+
+	// Labels are relocated as they are for Inst:
+	a.Init(code)
+	label := a.NewLabel()
+	if !a.B_Label(label) || !a.CBZ_X_Label(1, label) {
+		t.Fatalf("Failed to emit branches: %v", a.Err)
+	}
+	a.SetLabel(label)
+	if !a.ApplyRelocations() {
+		t.Fatalf("Failed to emit branches: %v", a.Err)
+	}
+	if dec32(code) != 0x14000002 || dec32(code[4:]) != 0xB4000021 { // b #8; cbz x1, #4
+		t.Fatalf("Invalid branches: %08X %08X", dec32(code), dec32(code[4:]))
+	}
+
+	// Invalid arguments are rejected:
+	for name, emit := range map[string]func(*Assembler) bool{
+		"register":  func(a *Assembler) bool { return a.ADD_XXX(32, 0, 0) },
+		"modifier":  func(a *Assembler) bool { return a.ADD_XXX_Mod(0, 0, 0, ModMSL.Imm(8)) },
+		"immediate": func(a *Assembler) bool { return a.LDR_X_RefOffset(0, 0, 3) },
+		"label":     func(a *Assembler) bool { return a.B_Label(Label{ID: 100}) },
+	} {
+		a.Init(code)
+		if emit(&a) || a.Err == nil {
+			t.Fatalf("Expected error for invalid %s", name)
+		}
+	}
+
+	cpu, _ := LookupCPU("armv8.0-a")
+	a.Init(code)
+	a.CPU = &cpu
+	if a.CAS_XX_Ref(0, 1, 2) {
+		t.Fatalf("Expected feature error for CAS")
+	} else if err, ok := a.Err.(*FeatureError); !ok || err.Feature != FeatLSE {
+		t.Fatalf("Invalid feature error for CAS: %v", a.Err)
+	}
+}
+
+func TestOperands(t *testing.T) {
+	args := []Arg{
+		X(1), Vec4S(2).I(3), Vec16B(4).List(2), ZAS(1).V(W(13), 2), Ref{XSP}, RefOffset{X(1), -8}, RefPreIndexed{XSP, -16},
+		RefVL{X(2), -3}, RefIndexed{X(3), W(4), ModSXTW.Imm(2)}, Imm(-5), Wide(1 << 63), Float(-2.5), Double(0.1),
+		Half(0x3C00), Half(0x0001), Half(0xFC00), ModLSL.Imm(12), Label{ID: 7, Offset: -4}, NE, TPIDR_EL0,
+	}
+	for _, arg := range args {
+		if actual := ArgOperand(arg).Arg(); actual != arg {
+			t.Fatalf("Invalid operand for %#v: %#v", arg, actual)
+		}
+	}
+
+	code := make([]byte, 64)
+	var a Assembler
+	a.Init(code)
+	label := a.NewLabel()
+	allocs := testing.AllocsPerRun(100, func() {
+		a.PC, a.Relocs = 0, a.Relocs[:0]
+		a.Inst(ADD, X(1), X(2), X(3), ModLSL.Imm(4))
+		a.Inst(LD1, Vec4S(0).List(2), Ref{XSP})
+		a.Inst(FMOV, ScalarD(1), Double(2.5))
+		a.Inst(B, label)
+		a.ADD_XXX_Mod(1, 2, 3, ModLSL.Imm(4))
+	})
+	if a.Err != nil || allocs != 0 {
+		t.Fatalf("Invalid allocations: %v (%v)", allocs, a.Err)
+	}
+}
+
+func BenchmarkInst(b *testing.B) {
+	code := make([]byte, 4096)
+	var a Assembler
+	a.Init(code)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if a.PC == uint32(len(code)) {
+			a.PC = 0
+		}
+		a.Inst(ADD, X(1), X(2), X(3), ModLSL.Imm(4))
+		a.Inst(LDR, X(0), RefOffset{XSP, 16})
+		a.Inst(FADD, Vec4S(1), Vec4S(2), Vec4S(3))
+		a.Inst(MOV, X(7), Wide(0xABCD000000000000))
+	}
+}
+
+func BenchmarkInstCached(b *testing.B) {
+	code := make([]byte, 4096)
+	var a Assembler
+	a.Init(code)
+	a.Cache = &EncodingCache{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if a.PC == uint32(len(code)) {
+			a.PC = 0
+		}
+		a.Inst(ADD, X(1), X(2), X(3), ModLSL.Imm(4))
+		a.Inst(LDR, X(0), RefOffset{XSP, 16})
+		a.Inst(FADD, Vec4S(1), Vec4S(2), Vec4S(3))
+		a.Inst(MOV, X(7), Wide(0xABCD000000000000))
+	}
+}
+
+func BenchmarkEmit(b *testing.B) {
+	code := make([]byte, 4096)
+	var a Assembler
+	a.Init(code)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if a.PC == uint32(len(code)) {
+			a.PC = 0
+		}
+		a.ADD_XXX_Mod(1, 2, 3, ModLSL.Imm(4))
+		a.LDR_X_RefOffset(0, 31, 16)
+		a.FADD_V4SV4SV4S(1, 2, 3)
+		a.MOV_X_Imm(7, 0xABCD000000000000)
+	}
+}
+
+// testCache is shared by the encoding tests when they are run with a cache by TestEncodingCache.
+var testCache *EncodingCache
+
+func TestEncodingCache(t *testing.T) {
+	testCache = &EncodingCache{}
+	defer func() { testCache = nil }()
+	for i := 0; i < 2; i++ { // populate, then encode from the cache
+		TestEncoding(t)
+		TestEncodingSVE(t)
+		TestEncodingSME(t)
+		TestEncodingExtensions(t)
+	}
+	if testCache.Hits == 0 || testCache.Hits < testCache.Misses {
+		t.Fatalf("Invalid cache hits: %d hits, %d misses", testCache.Hits, testCache.Misses)
+	}
+
+	code := make([]byte, 64)
+	var a Assembler
+	a.Cache = &EncodingCache{}
+	test := func(enc uint32, inst Inst, args ...Arg) {
+		a.Init(code)
+		if !a.Inst(inst, args...) {
+			t.Fatalf("Failed to encode inst %v for enc %08X: %v", inst, enc, a.Err)
+		} else if actual := dec32(code); actual != enc {
+			t.Fatalf("Invalid inst %v: %08X, expecting %08X", inst, actual, enc)
+		}
+	}
+
+	// Range checks are applied to immediates with a cached encoding:
+	test(0x91001041, ADD, X(1), X(2), Imm(4))
+	a.Init(code)
+	if a.Inst(ADD, X(1), X(2), Imm(5000)) || a.Err != ErrInvalidEncoding {
+		t.Fatalf("Expected invalid encoding for ADD: %v", a.Err)
+	}
+	test(0x91002041, ADD, X(1), X(2), Imm(8))
+	hits := a.Cache.Hits
+	test(0x91002041, ADD, X(1), X(2), Imm(8))
+	if a.Cache.Hits != hits+1 {
+		t.Fatalf("Expected cache hit for ADD")
+	}
+
+	// Literal arguments are part of the shape:
+	test(0x9E6703E0, FMOV, ScalarD(0), Double(0))
+	test(0x1E609000, FMOV, ScalarD(0), Double(2.5))
+	test(0x9E6703E0, FMOV, ScalarD(0), Double(0))
+
+	// Registers are matched by range:
+	test(0x8B030041, ADD, X(1), X(2), X(3))
+	test(0x8B1F03FF, ADD, XZR, XZR, XZR)
+	test(0x910003FF, ADD, XSP, XSP, Imm(0))
+	a.Init(code)
+	if a.Inst(ADD, X(32), X(2), X(3)) {
+		t.Fatalf("Expected invalid register for ADD")
+	}
+
+	// Unknown labels and unavailable features are not cached:
+	a.Init(code)
+	if a.Inst(B, Label{ID: 1}) {
+		t.Fatalf("Expected invalid label for B")
+	}
+	a.Err = nil
+	label := a.NewLabel()
+	if !a.Inst(B, label) {
+		t.Fatalf("Failed to encode B: %v", a.Err)
+	}
+	test(0xC8A07C41, CAS, X(0), X(1), Ref{X(2)})
+	cpu, _ := LookupCPU("armv8.0-a")
+	a.CPU = &cpu
+	a.Init(code)
+	if a.Inst(CAS, X(0), X(1), Ref{X(2)}) {
+		t.Fatalf("Expected feature error for CAS")
+	}
+}
+
+func TestEncodings(t *testing.T) {
+	infos := Encodings(ADD)
+	if len(infos) == 0 || infos[0].Inst != ADD {
+		t.Fatalf("Missing encodings for ADD")
+	}
+	var imm *EncodingInfo
+	for i := range infos {
+		if infos[i].Doc == "add Xd|SP, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])" {
+			imm = &infos[i]
+		}
+	}
+	if imm == nil {
+		t.Fatalf("Missing immediate encoding for ADD")
+	}
+	if imm.Opcode != 0x91000000 || len(imm.Args) != 4 {
+		t.Fatalf("Invalid immediate encoding for ADD: %08X, %d args", imm.Opcode, len(imm.Args))
+	}
+	if arg := imm.Args[2]; arg.Kind != FlatImm || arg.Min != 0 || arg.Max != 4095 {
+		t.Fatalf("Invalid immediate range for ADD: %+v", arg)
+	}
+	if arg := imm.Args[3]; arg.Kind != FlatImm || len(arg.Alts) != 2 || arg.Alts[0] != 0 || arg.Alts[1] != 12 {
+		t.Fatalf("Invalid shift alternatives for ADD: %+v", arg)
+	}
+	var a Assembler
+	a.Init(make([]byte, 4))
+	if !a.Inst(ADD, X(1), X(2), Imm(4)) {
+		t.Fatalf("Failed to encode ADD: %v", a.Err)
+	}
+	if infos[a.Idx].Opcode != a.Opcode || infos[a.Idx].Doc != imm.Doc {
+		t.Fatalf("Invalid matched encoding for ADD: %q", infos[a.Idx].Doc)
+	}
+
+	for _, info := range Encodings(LDR) {
+		if info.Doc == "ldr Xd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3)" {
+			if arg := info.Args[2]; arg.Scale != 3 || arg.Min != 0 || arg.Max != 32767 {
+				t.Fatalf("Invalid offset range for LDR: %+v", arg)
+			}
+		}
+	}
+
+	for inst := Inst(1); int(inst) < len(PatternOffsets); inst++ {
+		for _, info := range Encodings(inst) {
+			if info.Doc == "" {
+				t.Fatalf("Missing doc for %v encoding %d", inst, info.Idx)
+			}
+			var count int
+			for _, m := range info.Pattern {
+				count += int(MatcherFlatArgCounts[m.Op])
+			}
+			if len(info.Args) != count {
+				t.Fatalf("Invalid args for %v encoding %d: %d, expecting %d", inst, info.Idx, len(info.Args), count)
+			}
+		}
+	}
+	if Encodings(0) != nil {
+		t.Fatalf("Expected no encodings for invalid inst")
+	}
+}
+
+func TestMatches(t *testing.T) {
+	code := make([]byte, 8)
+	var a Assembler
+	a.Init(code)
+	matches := a.Matches(ADD, X(1), X(2), X(3), ModLSL.Imm(2))
+	if len(matches) != 2 || matches[0].Opcode != 0x8B030841 || matches[1].Opcode != 0x8B236841 {
+		t.Fatalf("Invalid matches for ADD: %+v", matches)
+	}
+	if a.PC != 0 || a.Err != nil || dec32(code) != 0 {
+		t.Fatalf("Unexpected state after matching ADD")
+	}
+	if !a.InstIdx(ADD, matches[1].Idx, X(1), X(2), X(3), ModLSL.Imm(2)) || dec32(code) != 0x8B236841 {
+		t.Fatalf("Failed to encode ADD with encoding %d: %08X, %v", matches[1].Idx, dec32(code), a.Err)
+	}
+	if a.Idx != matches[1].Idx || a.PC != 4 {
+		t.Fatalf("Invalid state after encoding ADD: idx %d, PC %d", a.Idx, a.PC)
+	}
+	if a.InstIdx(ADD, matches[1].Idx, X(1), X(2), Imm(4)) || a.Err != ErrNoMatch {
+		t.Fatalf("Expected no match for ADD: %v", a.Err)
+	}
+	a.Err = nil
+	if a.InstIdx(ADD, 100, X(1), X(2), X(3)) || a.Err != ErrInvalidIdx {
+		t.Fatalf("Expected invalid index for ADD: %v", a.Err)
+	}
+
+	// Label offsets are not resolved or recorded:
+	a.Init(code)
+	label := a.NewLabel()
+	if matches := a.Matches(B, label); len(matches) != 1 || matches[0].Opcode != 0x14000000 || len(a.Relocs) != 0 {
+		t.Fatalf("Invalid matches for B: %+v", matches)
+	}
+	if matches := a.Matches(ADD, X(1), X(2), Imm(5000)); len(matches) != 0 {
+		t.Fatalf("Expected no matches for ADD: %+v", matches)
+	}
+
+	cpu, _ := LookupCPU("armv8.0-a")
+	a.CPU = &cpu
+	if matches := a.Matches(CAS, X(0), X(1), Ref{X(2)}); len(matches) != 0 {
+		t.Fatalf("Expected no matches for CAS: %+v", matches)
+	}
+	a.CPU = nil
+	idx := a.Matches(CAS, X(0), X(1), Ref{X(2)})[0].Idx
+	a.CPU = &cpu
+	if a.InstIdx(CAS, idx, X(0), X(1), Ref{X(2)}) || !errors.Is(a.Err, ErrUnsupportedFeature) {
+		t.Fatalf("Expected feature error for CAS: %v", a.Err)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	code := make([]byte, 8)
+	var a Assembler
+	a.Init(code)
+	if opcode, rel, err := a.Encode(ADD, X(0), X(1), Imm(4)); err != nil || opcode != 0x91001020 || rel != 0 {
+		t.Fatalf("Invalid dry-run encoding for ADD: %08X, %d, %v", opcode, rel, err)
+	}
+	if _, _, err := a.Encode(ADD, X(0), X(1), Imm(5000)); err != ErrInvalidEncoding {
+		t.Fatalf("Expected invalid encoding for ADD: %v", err)
+	}
+	if a.CanEncode(ADD, X(0), X(1), Imm(5000)) || !a.CanEncode(ADD, X(0), X(1), Imm(4095)) {
+		t.Fatalf("Invalid CanEncode for ADD")
+	}
+	if a.PC != 0 || a.Err != nil || dec32(code) != 0 {
+		t.Fatalf("Unexpected state after dry-run encoding")
+	}
+
+	// Label offsets are returned with the relocation type:
+	label := a.NewLabel()
+	if opcode, rel, err := a.Encode(CBZ, X(3), label); err != nil || opcode != 0xB4000003 || rel != RelBCond {
+		t.Fatalf("Invalid dry-run encoding for CBZ: %08X, %d, %v", opcode, rel, err)
+	}
+	if !a.Inst(B, label) || len(a.Relocs) != 1 {
+		t.Fatalf("Failed to encode B: %v", a.Err)
+	}
+	if opcode, rel, err := a.Encode(B, label); err != nil || opcode != 0x14000000 || rel != RelB || len(a.Relocs) != 1 {
+		t.Fatalf("Invalid dry-run encoding for B: %08X, %d, %v", opcode, rel, err)
+	}
+
+	// The error of the assembler is not changed:
+	a.Inst(ADD, X(0), X(1), Imm(5000))
+	if !a.CanEncode(ADD, X(0), X(1), Imm(4)) || a.Err != ErrInvalidEncoding || a.PC != 4 {
+		t.Fatalf("Unexpected state after dry-run encoding with error: %v", a.Err)
+	}
+
+	cpu, _ := LookupCPU("armv8.0-a")
+	a.CPU = &cpu
+	if _, _, err := a.Encode(CAS, X(0), X(1), Ref{X(2)}); !errors.Is(err, ErrUnsupportedFeature) {
+		t.Fatalf("Expected feature error for CAS: %v", err)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	code := make([]byte, 32)
+	var a Assembler
+	a.Init(code)
+	loop := a.NewLabel()
+	if !a.Inst(ADD, X(0), X(1), Imm(4)) {
+		t.Fatalf("Failed to encode ADD: %v", a.Err)
+	}
+	s := a.Snapshot()
+
+	a.SetLabel(loop)
+	done := a.NewLabel()
+	a.Inst(CBZ, X(0), done)
+	a.Inst(B, loop)
+	a.SetLabel(done)
+	a.Inst(ADD, X(0), X(1), Imm(5000))
+	if a.PC != 12 || len(a.LabelPC) != 2 || len(a.Relocs) != 2 || a.Err == nil {
+		t.Fatalf("Unexpected state before restore: PC %d, %d labels, %d relocs, %v", a.PC, len(a.LabelPC), len(a.Relocs), a.Err)
+	}
+
+	a.Restore(s)
+	if a.PC != 4 || len(a.LabelPC) != 1 || a.LabelPC[loop.ID] != 0 || len(a.Relocs) != 0 || a.Err != nil {
+		t.Fatalf("Unexpected state after restore: PC %d, %d labels, %d relocs, %v", a.PC, len(a.LabelPC), len(a.Relocs), a.Err)
+	}
+	if dec32(code) != 0x91001020 || dec32(code[4:]) != 0 || dec32(code[8:]) != 0 {
+		t.Fatalf("Code was not restored")
+	}
+
+	// Assemble an alternative sequence from the snapshot:
+	a.SetLabel(loop)
+	a.Inst(SUB, X(0), X(0), Imm(1))
+	a.Inst(CBNZ, X(0), loop)
+	if !a.ApplyRelocations() || dec32(code[4:]) != 0xD1000400 || dec32(code[8:]) != 0xB5FFFFE0 {
+		t.Fatalf("Invalid code after restore: %08X %08X, %v", dec32(code[4:]), dec32(code[8:]), a.Err)
+	}
+}
+
+func TestCollectErrors(t *testing.T) {
+	code := make([]byte, 32)
+	var a Assembler
+	a.Init(code)
+	a.CollectErrors = true
+	a.Placeholder = 0xD4200000 // brk #0
+	far := a.NewLabel()
+	a.Inst(ADD, X(0), X(1), Imm(5000))
+	a.Inst(ADD, X(0), X(1), Imm(4))
+	a.Inst(FMOV, ScalarD(0), X(1), X(2))
+	a.Inst(TBZ, X(0), Imm(1), far)
+	a.ADD_XXX(XReg(40), 1, 2)
+	if a.PC != 20 || a.Err != nil {
+		t.Fatalf("Unexpected state after collecting errors: PC %d, %v", a.PC, a.Err)
+	}
+	a.PC = 0x10000 - 4
+	a.SetLabel(far)
+	a.PC = 20
+	if a.ApplyRelocations() {
+		t.Fatalf("Expected invalid label offset for TBZ")
+	}
+
+	errs := a.Errors()
+	if len(errs) != 4 {
+		t.Fatalf("Expected 4 errors, found %d: %v", len(errs), errs)
+	}
+	for i, expected := range []struct {
+		pc   uint32
+		inst Inst
+		err  error
+	}{{0, ADD, ErrInvalidEncoding}, {8, FMOV, ErrNoMatch}, {16, ADD, ErrNoMatch}, {12, 0, ErrInvalidEncoding}} {
+		var err *InstError
+		if !errors.As(errs[i], &err) || err.PC != expected.pc || err.Inst != expected.inst || !errors.Is(err, expected.err) {
+			t.Fatalf("Invalid error %d: %v", i, errs[i])
+		}
+	}
+	for i, enc := range []uint32{0xD4200000, 0x91001020, 0xD4200000, 0xD4200000, 0xD4200000} {
+		if actual := dec32(code[i*4:]); actual != enc {
+			t.Fatalf("Invalid code at %d: %08X, expecting %08X", i*4, actual, enc)
+		}
+	}
+	if errs[0].Error() != "ADD at 0x0: invalid instruction encoding" {
+		t.Fatalf("Invalid error message: %s", errs[0])
+	}
+
+	a.Init(code)
+	if len(a.Errors()) != 0 {
+		t.Fatalf("Errors were not reset by Init")
+	}
+
+	// Errors for discarded instructions are removed by Restore:
+	s := a.Snapshot()
+	a.Inst(ADD, X(0), X(1), Imm(5000))
+	if len(a.Errors()) != 1 {
+		t.Fatalf("Expected an error before restoring the snapshot")
+	}
+	a.Restore(s)
+	if len(a.Errors()) != 0 || a.PC != 0 {
+		t.Fatalf("Errors were not removed by Restore: %v", a.Errors())
+	}
+}
+
+func TestSourcePos(t *testing.T) {
+	code := make([]byte, 32)
+	var a Assembler
+	a.Init(code)
+	a.Inst(ADD, X(0), X(1), Imm(4))
+	if len(a.SourceLines()) != 0 {
+		t.Fatalf("Unexpected source positions without tracking")
+	}
+
+	a.TrackSource = true
+	_, file, line, _ := runtime.Caller(0)
+	a.Inst(ADD, X(0), X(1), Imm(4))
+	a.ADD_XXX(0, 1, 2)
+	if pos, ok := a.SourceAt(4); !ok || pos.File != file || pos.Line != line+1 {
+		t.Fatalf("Invalid source position for Inst: %v", pos)
+	}
+	if pos, ok := a.SourceAt(8); !ok || pos.File != file || pos.Line != line+2 {
+		t.Fatalf("Invalid source position for emitter: %v", pos)
+	}
+	if _, ok := a.SourceAt(0); ok {
+		t.Fatalf("Unexpected source position for untracked instruction")
+	}
+
+	// Supplied positions are recorded in place of the caller:
+	a.SetSourcePos(SourcePos{"main.dsl", 7})
+	s := a.Snapshot()
+	a.Inst(SUB, X(0), X(1), Imm(4))
+	if lines := a.SourceLines(); len(lines) != 3 || lines[2] != (SourceLine{12, SourcePos{"main.dsl", 7}}) {
+		t.Fatalf("Invalid source lines: %v", lines)
+	}
+	a.Restore(s)
+	if len(a.SourceLines()) != 2 {
+		t.Fatalf("Source positions were not restored")
+	}
+
+	// Errors include the source position:
+	a.Inst(ADD, X(0), X(1), Imm(5000))
+	var err *InstError
+	if !errors.As(a.Err, &err) || err.Pos != (SourcePos{"main.dsl", 7}) || !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("Invalid error with source position: %v", a.Err)
+	}
+	if a.Err.Error() != "main.dsl:7: ADD at 0xc: invalid instruction encoding" {
+		t.Fatalf("Invalid error message: %v", a.Err)
+	}
+	a.SetSourcePos(SourcePos{})
+	a.Err = nil
+	a.CollectErrors = true
+	a.Inst(ADD, X(0), X(1), Imm(5000))
+	if errs := a.Errors(); len(errs) != 1 || !errors.As(errs[0], &err) || err.Pos.File != file {
+		t.Fatalf("Invalid collected error with source position: %v", errs)
+	}
+
+	// Positions are written as a .debug_line line number program, as decoded by llvm-dwarfdump --debug-line:
+	a = Assembler{}
+	a.Init(code)
+	for _, pos := range []SourcePos{{"a.dsl", 1}, {"a.dsl", 3}, {"b.dsl", 2}, {}} {
+		a.SetSourcePos(pos)
+		a.Inst(NOP)
+	}
+	expected := []byte{
+		0x41, 0, 0, 0, 4, 0, 0x26, 0, 0, 0, // unit_length, version, header_length
+		4, 1, 1, 0xFB, 14, 13, // min_inst_length, max_ops_per_inst, default_is_stmt, line_base, line_range, opcode_base
+		0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 0, 1, // standard_opcode_lengths
+		0,                                   // include_directories
+		'a', '.', 'd', 's', 'l', 0, 0, 0, 0, // file_names[1]
+		'b', '.', 'd', 's', 'l', 0, 0, 0, 0, // file_names[2]
+		0,
+		0, 9, 2, 0, 0x10, 0, 0, 0, 0, 0, 0, // DW_LNE_set_address (0x1000)
+		0x12, // 0x1000: a.dsl:1
+		0x22, // 0x1004: a.dsl:3
+		4, 2, // DW_LNS_set_file (b.dsl)
+		0x1F, // 0x1008: b.dsl:2
+		2, 2, // DW_LNS_advance_pc (8)
+		0, 1, 1, // 0x1010: DW_LNE_end_sequence
+	}
+	if actual := a.DebugLine(0x1000); !bytes.Equal(actual, expected) {
+		t.Fatalf("Invalid .debug_line section: % X", actual)
+	}
+}
+
+type testObserver struct {
+	NopObserver
+	before, after []Inst
+	idx           []int8
+	labels        []uint32
+	relocs        []uint32
+}
+
+func (o *testObserver) BeforeInst(a *Assembler, inst Inst, args []Operand) {
+	o.before = append(o.before, inst)
+}
+
+func (o *testObserver) AfterInst(a *Assembler, inst Inst, pc, opcode uint32) {
+	if pc != a.PC-4 || opcode != dec32(a.Code[pc:]) || len(a.Commands()) == 0 {
+		panic("invalid state for observer")
+	}
+	o.after = append(o.after, inst)
+	o.idx = append(o.idx, a.Idx)
+}
+
+func (o *testObserver) LabelBound(a *Assembler, label Label, pc uint32) {
+	o.labels = append(o.labels, pc)
+}
+
+func (o *testObserver) RelocApplied(a *Assembler, rel Reloc, opcode uint32) {
+	o.relocs = append(o.relocs, opcode)
+}
+
+func TestObservers(t *testing.T) {
+	code := make([]byte, 32)
+	var a Assembler
+	a.Init(code)
+	first, second := &testObserver{}, &testObserver{}
+	a.Observers = []Observer{first, second, NopObserver{}}
+	loop := a.NewLabel()
+	a.Inst(ADD, X(0), X(1), X(2), ModLSL.Imm(2))
+	a.Inst(ADD, X(0), X(1), Imm(5000))
+	a.Err = nil
+	a.ADD_XXX(0, 1, 2)
+	a.SetLabel(loop)
+	a.Inst(B, loop)
+	a.Encode(ADD, X(0), X(1), Imm(4))
+	if !a.ApplyRelocations() {
+		t.Fatalf("Failed to apply relocations: %v", a.Err)
+	}
+	for _, o := range []*testObserver{first, second} {
+		if len(o.before) != 4 || len(o.after) != 3 || o.after[2] != B || o.idx[0] != 1 {
+			t.Fatalf("Invalid observed instructions: %v, %v, %v", o.before, o.after, o.idx)
+		}
+		if len(o.labels) != 1 || o.labels[0] != 8 || len(o.relocs) != 1 || o.relocs[0] != 0x14000000 {
+			t.Fatalf("Invalid observed labels or relocations: %v, %v", o.labels, o.relocs)
+		}
+	}
+}
+
+// countingObserver injects an increment of X28 after each observed instruction.
+type countingObserver struct{ NopObserver }
+
+func (countingObserver) AfterInst(a *Assembler, inst Inst, pc, opcode uint32) {
+	a.Inst(ADD, X(28), X(28), Imm(1))
+}
+
+func TestObserverInjection(t *testing.T) {
+	code := make([]byte, 32)
+	var a Assembler
+	a.Init(code)
+	traced := &testObserver{}
+	a.Observers = []Observer{traced, countingObserver{}}
+	if !a.Inst(ADD, X(0), X(1), X(2)) || !a.ADD_XXX(0, 1, 2) {
+		t.Fatalf("Failed to encode instrumented instructions: %v", a.Err)
+	}
+	if len(traced.before) != 2 || len(traced.after) != 2 {
+		t.Fatalf("Injected instructions were observed: %v, %v", traced.before, traced.after)
+	}
+	for i, expected := range []uint32{0x8B020020, 0x9100079C, 0x8B020020, 0x9100079C} {
+		if actual := dec32(code[i*4:]); actual != expected {
+			t.Fatalf("Invalid instruction %d: %08X, expecting %08X", i, actual, expected)
+		}
+	}
+
+	// Errors for injected instructions are returned for the observed instruction:
+	a.Init(code[:4])
+	if a.Inst(ADD, X(0), X(1), X(2)) || a.Err == nil || a.PC != 4 {
+		t.Fatalf("Expecting an error for the injected instruction, found %v", a.Err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	code := make([]byte, 8)
+	var a Assembler
+	a.Init(code)
+	if _, ok := a.Explain(); ok {
+		t.Fatalf("Unexpected explanation without an instruction")
+	}
+	a.Inst(ADD, X(0), X(1), Imm(0x123))
+	e, ok := a.Explain()
+	if !ok || e.Opcode != 0x91048C20 || len(e.Fields) != 5 {
+		t.Fatalf("Invalid explanation for ADD: %v", e)
+	}
+	for i, expected := range []BitField{
+		{Hi: 31, Lo: 23, Value: 0x122, Name: "fixed", Arg: -1},
+		{Hi: 22, Lo: 22, Value: 0, Name: "imm1", Arg: 3, Cmd: EncOp{CmdUAlt2, [3]uint8{22, 6}}},
+		{Hi: 21, Lo: 10, Value: 0x123, Name: "imm12", Arg: 2, Cmd: EncOp{CmdUbits, [3]uint8{10, 12}}},
+		{Hi: 9, Lo: 5, Value: 1, Name: "Rn", Arg: 1, Cmd: EncOp{Op: CmdR5}},
+		{Hi: 4, Lo: 0, Value: 0, Name: "Rd", Arg: 0, Cmd: EncOp{Op: CmdR0}},
+	} {
+		if e.Fields[i] != expected {
+			t.Fatalf("Invalid field %d for ADD: %+v, expecting %+v", i, e.Fields[i], expected)
+		}
+	}
+	if s := e.String(); !strings.Contains(s, "|100100010|0|000100100011|00001|00000| = 0x91048C20") ||
+		!strings.Contains(s, "21:10  imm12   0x123     arg 2   Ubits(10, 12)") {
+		t.Fatalf("Invalid rendering for ADD:\n%s", s)
+	}
+
+	// Split fields are listed separately:
+	label := a.NewLabel()
+	a.Inst(ADR, X(3), Label{ID: label.ID, Offset: 0x1231})
+	a.ApplyRelocations()
+	if e, ok = a.Explain(); !ok || e.Opcode != 0x30009183 || len(e.Fields) != 5 ||
+		e.Fields[1].Name != "offset" || e.Fields[1].Value != 1 || e.Fields[3].Value != 0x48C || e.Fields[3].Arg != 1 {
+		t.Fatalf("Invalid explanation for ADR: %v", e)
+	}
+
+	// Dry runs and placeholders for failed instructions are not explained:
+	code = make([]byte, 16)
+	a.Init(code)
+	a.CollectErrors = true
+	a.Inst(ADD, X(0), X(1), X(2))
+	a.Encode(MOVZ, X(0), Imm(1))
+	a.CanEncode(MOVZ, X(0), Imm(1))
+	a.Matches(MOVZ, X(0), Imm(1))
+	if e, ok = a.Explain(); !ok || e.Inst != ADD || e.Opcode != 0x8B020020 {
+		t.Fatalf("Invalid explanation for ADD after dry runs: %v", e)
+	}
+	a.Inst(ADD, X(0), X(1), ZB(2))
+	a.Inst(ZIP2, Vec16B(0), Vec8H(1), Vec4S(2))
+	if e, ok = a.Explain(); !ok || e.Inst != ADD || e.Opcode != 0x8B020020 || a.PC != 12 || len(a.Errors()) != 2 {
+		t.Fatalf("Invalid explanation for ADD after failed instructions: %v", e)
+	}
+	a.CollectErrors = false
+
+	// Opcodes may be explained without an assembler:
+	var found bool
+	for _, e := range ExplainOpcode(0x8B030841) {
+		if e.Inst == ADD && e.Idx == 1 && e.Fields[4].Name == "imm6" && e.Fields[4].Value == 2 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Missing explanation for ADD opcode")
+	}
+	if _, ok := Explain(ADD, 1, 0x91048C20); ok {
+		t.Fatalf("Unexpected explanation with mismatched fixed bits")
+	}
+	if e, ok := Explain(ADD, 6, 0x91048C20); !ok || e.Fields[2].Value != 0x123 {
+		t.Fatalf("Invalid explanation for ADD opcode: %v", e)
+	}
+}
+
+func TestFrame(t *testing.T) {
+	frame := Frame{
+		Saved:    []Reg{ScalarD(8), X(21), X(19), X(20)},
+		Locals:   []Local{{Size: 8, Align: 8}, {Size: 16, Align: 16}, {Size: 4, Align: 4}},
+		Outgoing: 16,
+	}
+	l, err := frame.Layout()
+	if err != nil {
+		t.Fatalf("Failed to lay out frame: %v", err)
+	}
+	if l.Size != 112 || l.SaveSize != 48 || l.FP != 64 {
+		t.Fatalf("Invalid frame layout: %+v", l)
+	}
+	for i, offset := range []uint32{56, 32, 28} {
+		if l.Locals[i] != offset {
+			t.Fatalf("Invalid offset for local %d: %d, expecting %d", i, l.Locals[i], offset)
+		}
+	}
+	for i, offset := range []uint32{104, 96, 80, 88} {
+		if l.Saved[i] != offset {
+			t.Fatalf("Invalid offset for saved register %d: %d, expecting %d", i, l.Saved[i], offset)
+		}
+	}
+
+	code := make([]byte, 64)
+	var a Assembler
+	a.Init(code)
+	if !a.Prologue(l) || !a.Epilogue(l) {
+		t.Fatalf("Failed to encode frame: %v", a.Err)
+	}
+	for i, enc := range []uint32{
+		0xA9BD7BFD, // stp x29, x30, [sp, #-48]!
+		0xA90153F3, // stp x19, x20, [sp, #16]
+		0xF90013F5, // str x21, [sp, #32]
+		0xFD0017E8, // str d8, [sp, #40]
+		0x910003FD, // mov x29, sp
+		0xD10103FF, // sub sp, sp, #64
+		0x910003BF, // mov sp, x29
+		0xA94153F3, // ldp x19, x20, [sp, #16]
+		0xF94013F5, // ldr x21, [sp, #32]
+		0xFD4017E8, // ldr d8, [sp, #40]
+		0xA8C37BFD, // ldp x29, x30, [sp], #48
+		0xD65F03C0, // ret
+	} {
+		if actual := dec32(code[i*4:]); actual != enc {
+			t.Fatalf("Invalid frame instruction %d: %08X, expecting %08X", i, actual, enc)
+		}
+	}
+
+	// Large frames are allocated with a shifted immediate:
+	l, _ = Frame{Locals: []Local{{Size: 0x12340}}}.Layout()
+	a.Init(code)
+	if !a.Prologue(l) || a.PC != 16 || dec32(code[8:]) != 0xD1404BFF || dec32(code[12:]) != 0xD10D03FF {
+		t.Fatalf("Invalid prologue for large frame: %08X %08X, %v", dec32(code[8:]), dec32(code[12:]), a.Err)
+	}
+
+	for _, f := range []Frame{
+		{Saved: []Reg{X(18)}},
+		{Saved: []Reg{X(19), X(19)}},
+		{Saved: []Reg{ScalarD(16)}},
+		{Locals: []Local{{Size: 8, Align: 32}}},
+		{Locals: []Local{{Size: 1 << 24}}},
+	} {
+		if _, err := f.Layout(); err != ErrInvalidFrame {
+			t.Fatalf("Expected invalid frame for %+v", f)
+		}
+	}
+
+	// Instructions are attributed to the caller of Prologue and Epilogue:
+	a.Init(code)
+	a.TrackSource = true
+	_, file, line, _ := runtime.Caller(0)
+	a.Prologue(l)
+	a.Epilogue(l)
+	prologueEnd := uint32(16)
+	for _, src := range a.SourceLines() {
+		expected := line + 1
+		if src.PC >= prologueEnd {
+			expected++
+		}
+		if src.Pos != (SourcePos{file, expected}) {
+			t.Fatalf("Invalid source position at %d: %v, expecting line %d", src.PC, src.Pos, expected)
+		}
+	}
+	if len(a.SourceLines()) != int(a.PC/4) {
+		t.Fatalf("Invalid source line count: %d", len(a.SourceLines()))
+	}
+}
+
+func TestCallConv(t *testing.T) {
+	big := StructOf(ValueI64, ValueI64, ValueI64)
+	sig := Signature{
+		Params:  []ValueType{ValueI32, ValueF64, StructOf(ValueF32, ValueF32, ValueF32), big, ValueI64, ValueI128, ValueI64, ValueI64, ValueI64, ValueI8, ValueI16},
+		Results: []ValueType{big},
+	}
+	stack := func(offset, size uint32) ArgPart { return ArgPart{Offset: offset, Size: size} }
+	reg := func(r Reg, size uint32) ArgPart { return ArgPart{Reg: r, Size: size} }
+	common := [][]ArgPart{
+		{reg(W(0), 4)},
+		{reg(ScalarD(0), 8)},
+		{reg(ScalarS(1), 4), reg(ScalarS(2), 4), reg(ScalarS(3), 4)},
+		{reg(X(1), 8)},
+		{reg(X(2), 8)},
+		{reg(X(4), 8), reg(X(5), 8)},
+		{reg(X(6), 8)},
+		{reg(X(7), 8)},
+		{stack(0, 8)},
+	}
+	for _, test := range []struct {
+		cc        CallConv
+		sig       Signature
+		params    [][]ArgPart
+		results   [][]ArgPart
+		stackSize uint32
+	}{
+		{AAPCS64, sig, append(common[:9:9], []ArgPart{stack(8, 1)}, []ArgPart{stack(16, 2)}), [][]ArgPart{{reg(X(8), 8)}}, 32},
+		{Darwin, sig, append(common[:9:9], []ArgPart{stack(8, 1)}, []ArgPart{stack(10, 2)}), [][]ArgPart{{reg(X(8), 8)}}, 16},
+		{
+			Darwin,
+			Signature{Params: []ValueType{ValuePtr, ValueI32, ValueF64}, Variadic: true, Fixed: 1},
+			[][]ArgPart{{reg(X(0), 8)}, {stack(0, 4)}, {stack(8, 8)}}, nil, 16,
+		},
+		{
+			GoABIInternal,
+			Signature{
+				Params:  []ValueType{ValueI64, StructOf(ValueF64, ValueI32), ArrayOf(ValueI64, 2), ValueF32},
+				Results: []ValueType{ValueI64, ValueF64, ArrayOf(ValueI64, 2)},
+			},
+			[][]ArgPart{{reg(X(0), 8)}, {reg(ScalarD(0), 8), reg(W(1), 4)}, {stack(8, 8), stack(16, 8)}, {reg(ScalarS(1), 4)}},
+			[][]ArgPart{{reg(X(0), 8)}, {reg(ScalarD(0), 8)}, {stack(24, 8), stack(32, 8)}},
+			72, // args=64 for the Go compiler, with spill slots at 40, 48, and 64
+		},
+		{
+			// func(a int32, b int64, c [2]int32, d int8) int32, with args=32 for the Go compiler:
+			GoABIInternal,
+			Signature{Params: []ValueType{ValueI32, ValueI64, ArrayOf(ValueI32, 2), ValueI8}, Results: []ValueType{ValueI32}},
+			[][]ArgPart{{reg(W(0), 4)}, {reg(X(1), 8)}, {stack(8, 4), stack(12, 4)}, {reg(W(2), 1)}},
+			[][]ArgPart{{reg(W(0), 4)}},
+			40,
+		},
+	} {
+		l, err := test.cc.Layout(test.sig)
+		if err != nil {
+			t.Fatalf("Failed to lay out signature for convention %d: %v", test.cc, err)
+		}
+		for _, locs := range []struct {
+			actual   []ArgLoc
+			expected [][]ArgPart
+		}{{l.Params, test.params}, {l.Results, test.results}} {
+			if len(locs.actual) != len(locs.expected) {
+				t.Fatalf("Invalid location count for convention %d: %d, expecting %d", test.cc, len(locs.actual), len(locs.expected))
+			}
+			for i, loc := range locs.actual {
+				if !equalParts(loc.Parts, locs.expected[i]) {
+					t.Fatalf("Invalid location %d for convention %d: %+v, expecting %+v", i, test.cc, loc.Parts, locs.expected[i])
+				}
+			}
+		}
+		if l.StackSize != test.stackSize {
+			t.Fatalf("Invalid stack size for convention %d: %d, expecting %d", test.cc, l.StackSize, test.stackSize)
+		}
+		if test.cc != GoABIInternal && len(l.Params) > 3 && (!l.Params[3].ByRef || !l.Results[0].ByRef) {
+			t.Fatalf("Expecting large composites to be passed by reference for convention %d", test.cc)
+		}
+	}
+	// HFAs are passed on the stack by member once V0-V7 are used, in a slot rounded up to 8 bytes:
+	hfa := Signature{Params: []ValueType{
+		ValueF64, ValueF64, ValueF64, ValueF64, ValueF64, ValueF64, ValueF64, ValueF64,
+		StructOf(ValueF32, ValueF32, ValueF32), StructOf(ValueF32, ValueF32, ValueF32),
+	}}
+	for _, cc := range []CallConv{AAPCS64, Darwin} {
+		l, err := cc.Layout(hfa)
+		if err != nil || !equalParts(l.Params[8].Parts, []ArgPart{stack(0, 4), stack(4, 4), stack(8, 4)}) ||
+			!equalParts(l.Params[9].Parts, []ArgPart{stack(16, 4), stack(20, 4), stack(24, 4)}) || l.StackSize != 32 {
+			t.Fatalf("Invalid layout of HFA on the stack for convention %d: %+v, %v", cc, l, err)
+		}
+	}
+	if _, err := GoABIInternal.Layout(Signature{Params: []ValueType{ValueV128}}); err != ErrInvalidSignature {
+		t.Fatalf("Expecting invalid signature, found %v", err)
+	}
+
+	code := make([]byte, 128)
+	var a Assembler
+	a.Init(code)
+	l, _ := AAPCS64.Layout(Signature{Params: []ValueType{ValueI64, ValueI64, ValueF64}})
+	vl, _ := Darwin.Layout(Signature{Params: []ValueType{ValuePtr, ValueI32, ValueF64}, Variadic: true, Fixed: 1})
+	gl, _ := GoABIInternal.Layout(Signature{
+		Params:  []ValueType{ValueI64, ValueI64},
+		Results: []ValueType{ValueI64, ValueF64, ArrayOf(ValueI64, 2)},
+	})
+	if !a.MarshalArgs(l, [][]Reg{{X(1)}, {X(0)}, {X(2)}}) ||
+		!a.MarshalArgs(vl, [][]Reg{{X(1)}, {W(3)}, {ScalarD(1)}}) ||
+		!a.ParallelMove([]Move{{ScalarQ(1), ScalarQ(0)}, {ScalarQ(0), ScalarQ(1)}}, X(16), ScalarQ(16)) ||
+		!a.UnmarshalResults(gl, [][]Reg{{X(2)}, {X(3)}, {X(4), X(5)}}) {
+		t.Fatalf("Failed to marshal arguments: %v", a.Err)
+	}
+	hl, _ := AAPCS64.Layout(hfa)
+	hfaSrcs := [][]Reg{}
+	for i := uint8(0); i < 8; i++ {
+		hfaSrcs = append(hfaSrcs, []Reg{ScalarD(i)})
+	}
+	hfaSrcs = append(hfaSrcs, []Reg{ScalarS(8), ScalarS(9), ScalarS(10)}, []Reg{ScalarS(11), ScalarS(12), ScalarS(13)})
+	if !a.MarshalArgs(hl, hfaSrcs) {
+		t.Fatalf("Failed to marshal HFA arguments: %v", a.Err)
+	}
+	expected := []uint32{
+		0x9E670040, // fmov d0, x2
+		0xAA0103F0, // mov x16, x1
+		0xAA0003E1, // mov x1, x0
+		0xAA1003E0, // mov x0, x16
+		0xB90003E3, // str w3, [sp]
+		0xFD0007E1, // str d1, [sp, #8]
+		0xAA0103E0, // mov x0, x1
+		0x4EA01C10, // mov v16.16b, v0.16b
+		0x4EA11C20, // mov v0.16b, v1.16b
+		0x4EB01E01, // mov v1.16b, v16.16b
+		0xAA0003E2, // mov x2, x0
+		0x9E660003, // fmov x3, d0
+		0xF94007E4, // ldr x4, [sp, #8]
+		0xF9400BE5, // ldr x5, [sp, #16]
+		0xBD0003E8, // str s8, [sp]
+		0xBD0007E9, // str s9, [sp, #4]
+		0xBD000BEA, // str s10, [sp, #8]
+		0xBD0013EB, // str s11, [sp, #16]
+		0xBD0017EC, // str s12, [sp, #20]
+		0xBD001BED, // str s13, [sp, #24]
+	}
+	if a.PC != uint32(len(expected)*4) {
+		t.Fatalf("Invalid code size: %d, expecting %d", a.PC, len(expected)*4)
+	}
+	for i, enc := range expected {
+		if actual := dec32(code[i*4:]); actual != enc {
+			t.Fatalf("Invalid move instruction %d: %08X, expecting %08X", i, actual, enc)
+		}
+	}
+
+	for _, moves := range [][]Move{
+		{{X(0), X(1)}, {X(0), X(2)}},
+		{{X(16), X(0)}, {X(0), X(16)}},
+		{{ScalarD(0), ScalarS(16)}},
+		{{X(0), XSP}},
+		{{WSP, W(1)}},
+		{{ScalarD(0), XSP}, {ScalarD(31), ScalarD(1)}},
+		{{ZB(0), ZB(1)}},
+	} {
+		a.Init(code)
+		if a.ParallelMove(moves, X(16), ScalarQ(16)) || a.Err != ErrInvalidMove {
+			t.Fatalf("Expecting invalid moves for %v, found %v", moves, a.Err)
+		}
+	}
+
+	// Moves and stores are attributed to the caller:
+	a.Init(code)
+	a.TrackSource = true
+	_, file, line, _ := runtime.Caller(0)
+	a.MarshalArgs(vl, [][]Reg{{X(1)}, {W(3)}, {ScalarD(1)}})
+	if lines := a.SourceLines(); len(lines) != 3 || lines[0].Pos != (SourcePos{file, line + 1}) || lines[2].Pos != lines[0].Pos {
+		t.Fatalf("Invalid source positions for marshalled arguments: %v", lines)
+	}
+}
+
+func equalParts(actual, expected []ArgPart) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+	for i := range actual {
+		if actual[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestUnwind(t *testing.T) {
+	code := make([]byte, 128)
+	var a Assembler
+	a.Init(code)
+	l, _ := Frame{Saved: []Reg{X(19), ScalarD(8)}, Locals: []Local{{Size: 16}}}.Layout()
+	if !a.CFIStartProc() || !a.Prologue(l) || !a.Inst(NOP) || !a.Epilogue(l) || !a.Inst(NOP) || !a.CFIEndProc() {
+		t.Fatalf("Failed to encode procedure: %v", a.Err)
+	}
+	procs := a.UnwindProcs()
+	if len(procs) != 1 || procs[0].Start != 0 || procs[0].End != 48 || len(procs[0].Insts) != 14 {
+		t.Fatalf("Invalid unwind procedures: %+v", procs)
+	}
+	if inst := procs[0].Insts[5]; inst.PC != 16 || inst.Op != CFIDefCFARegister || inst.Reg != X(29) {
+		t.Fatalf("Invalid CFA rule after prologue: %+v", inst)
+	}
+
+	// FDE instructions, as decoded by llvm-dwarfdump --eh-frame:
+	expectedFDE := []byte{
+		0x3C, 0, 0, 0, 0x1C, 0, 0, 0, // length, CIE pointer
+		0, 0x10, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 0, 0, 0, 0, // pc=00001000...00001030
+		0,        // augmentation data length
+		0x41,     // DW_CFA_advance_loc: 4
+		0x0E, 32, // DW_CFA_def_cfa_offset: +32
+		0x9D, 4, 0x9E, 3, // DW_CFA_offset: W29 -32, W30 -24
+		0x42,    // DW_CFA_advance_loc: 8
+		0x93, 2, // DW_CFA_offset: W19 -16
+		0x11, 72, 1, // DW_CFA_offset_extended_sf: B8 -8
+		0x41, 0x0D, 29, // DW_CFA_advance_loc: 4, DW_CFA_def_cfa_register: W29
+		0x42, 0x0A, // DW_CFA_advance_loc: 8, DW_CFA_remember_state
+		0x41, 0x0C, 31, 32, // DW_CFA_advance_loc: 4, DW_CFA_def_cfa: WSP +32
+		0x42, 0xD3, 0x06, 72, // DW_CFA_advance_loc: 8, DW_CFA_restore: W19, DW_CFA_restore_extended: B8
+		0x41, 0x0E, 0, 0xDD, 0xDE, // DW_CFA_advance_loc: 4, DW_CFA_def_cfa_offset: +0, DW_CFA_restore: W29, W30
+		0x41, 0x0B, // DW_CFA_advance_loc: 4, DW_CFA_restore_state
+		0, 0, 0, 0, 0, 0, // padding
+	}
+	eh, fdes := a.EHFrame(0x1000)
+	if len(fdes) != 1 || fdes[0] != 24 || !bytes.Equal(eh[24:len(eh)-4], expectedFDE) || dec32(eh[len(eh)-4:]) != 0 {
+		t.Fatalf("Invalid .eh_frame section: % X", eh)
+	}
+	cie := []byte{0x14, 0, 0, 0, 0, 0, 0, 0, 1, 'z', 'R', 0, 4, 0x78, 30, 1, 0, 0x0C, 31, 0, 0, 0, 0, 0}
+	if !bytes.Equal(eh[:24], cie) {
+		t.Fatalf("Invalid CIE: % X", eh[:24])
+	}
+	df := a.DebugFrame(0x1000)
+	if len(df) != 16+64 || dec32(df[4:]) != 0xFFFFFFFF || dec32(df[20:]) != 0 {
+		t.Fatalf("Invalid .debug_frame section: % X", df)
+	}
+
+	// Rules are rolled back with snapshots:
+	s := a.Snapshot()
+	if !a.CFIStartProc() || !a.Inst(NOP) || !a.CFIOffset(X(19), -16) {
+		t.Fatalf("Failed to record rules: %v", a.Err)
+	}
+	a.Restore(s)
+	if len(a.UnwindProcs()) != 1 || a.CFIOffset(X(19), -16) || a.Err != ErrInvalidCFI {
+		t.Fatalf("Expecting rules outside of a procedure to be rejected, found %v", a.Err)
+	}
+}
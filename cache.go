@@ -0,0 +1,155 @@
+package arm
+
+import "math"
+
+// EncodingCache memoizes the encoding selected by [Assembler.Inst] for each instruction and argument shape.
+// An argument shape includes the type, element index, and number class of each register, the kind of each
+// memory reference, and each modifier ID, along with any other argument properties which are checked by the
+// matching operators. Immediate values and offsets are not part of a shape unless they may match a literal,
+// so range checks are still applied to each immediate when the cached encoding is written.
+//
+// A cache may be shared by any number of Assemblers, but not concurrently. The zero value is ready to use.
+type EncodingCache struct {
+	Hits   uint64 // instructions encoded with a cached encoding
+	Misses uint64 // instructions matched without a cached encoding
+
+	entries map[shapeKey]cacheEntry
+}
+
+// Reset removes all cached encodings.
+func (c *EncodingCache) Reset() {
+	c.Hits, c.Misses, c.entries = 0, 0, nil
+}
+
+// Len returns the number of cached encodings.
+func (c *EncodingCache) Len() int { return len(c.entries) }
+
+// shapeKey identifies an instruction with an argument shape and the features available to the assembler.
+type shapeKey struct {
+	features Features
+	inst     Inst
+	count    uint8
+	shapes   [6]uint64
+}
+
+// cacheEntry is the matched state for a shapeKey, with unpacked matching and encoding operators.
+type cacheEntry struct {
+	count      uint8
+	idx        int8
+	simdSize   uint8
+	feature    Feature
+	patternLen uint8
+	cmdsLen    uint8
+	patsOffset uint32
+	cmdsOffset uint32
+	opcode     uint32
+	pattern    [6]EncOp
+	cmds       [8]EncOp
+}
+
+// cacheKey returns the shape key for the current instruction and arguments, or false if the arguments
+// can not be cached.
+func (a *Assembler) cacheKey() (key shapeKey, ok bool) {
+	if len(a.Args) > len(key.shapes) {
+		return key, false
+	}
+	key.features = math.MaxUint64
+	if a.CPU != nil {
+		key.features = a.CPU.Features
+	}
+	key.inst, key.count = a.CurrentInst, uint8(len(a.Args))
+	for i, o := range a.Args {
+		key.shapes[i] = a.operandShape(o)
+	}
+	return key, true
+}
+
+// loadCached restores the matched state for a cached encoding, returning false if none was found.
+func (a *Assembler) loadCached(key shapeKey) bool {
+	e, ok := a.Cache.entries[key]
+	if !ok {
+		a.Cache.Misses++
+		return false
+	}
+	a.Cache.Hits++
+	a.Count, a.Idx, a.SimdSize, a.Feature = e.count, e.idx, e.simdSize, e.feature
+	a.patternLen, a.cmdsLen, a.patsOffset, a.cmdsOffset, a.Opcode = e.patternLen, e.cmdsLen, e.patsOffset, e.cmdsOffset, e.opcode
+	a.pattern, a.cmds = e.pattern, e.cmds
+	return true
+}
+
+// storeCached caches the matched state for the current instruction.
+func (a *Assembler) storeCached(key shapeKey) {
+	if a.Cache.entries == nil {
+		a.Cache.entries = make(map[shapeKey]cacheEntry)
+	}
+	a.Cache.entries[key] = cacheEntry{
+		count:      a.Count,
+		idx:        a.Idx,
+		simdSize:   a.SimdSize,
+		feature:    a.Feature,
+		patternLen: a.patternLen,
+		cmdsLen:    a.cmdsLen,
+		patsOffset: a.patsOffset,
+		cmdsOffset: a.cmdsOffset,
+		opcode:     a.Opcode,
+		pattern:    a.pattern,
+		cmds:       a.cmds,
+	}
+}
+
+// operandShape packs the properties of o which are checked by matching operators.
+func (a *Assembler) operandShape(o Operand) uint64 {
+	shape := uint64(o.Kind)
+	switch o.Kind {
+	case OperandReg, OperandRef, OperandRefOffset, OperandRefVL:
+		shape |= regShape(o.Reg) << 8
+	case OperandRegList:
+		shape |= regShape(o.Reg)<<8 | uint64(o.Len)<<28
+	case OperandZASlice:
+		shape |= regShape(o.Reg)<<8 | regShape(o.Idx)<<28 | uint64(o.Len)<<48
+		if o.Vert {
+			shape |= 1 << 7
+		}
+	case OperandRefPreIndexed:
+		shape |= regShape(o.Reg) << 8
+		if o.Imm == 0 {
+			shape |= 1 << 7
+		}
+	case OperandRefIndexed:
+		shape |= regShape(o.Reg)<<8 | regShape(o.Idx)<<28 | uint64(o.Mod.ID)<<48 | uint64(o.Mod.ImmInv)<<56
+	case OperandImm, OperandWide:
+		if o.Imm <= math.MaxUint8 { // literal integers
+			shape |= (o.Imm + 1) << 8
+		}
+	case OperandFloat, OperandDouble, OperandHalf:
+		if f := math.Float64frombits(o.Imm); f >= 0 && f <= math.MaxUint8 && f == math.Trunc(f) { // literal floats
+			shape |= (uint64(f) + 1) << 8
+		}
+	case OperandMod:
+		shape |= uint64(o.Mod.ID) << 8
+	case OperandLabel:
+		if int(o.Label().ID) < len(a.LabelPC) {
+			shape |= 1 << 7
+		}
+	case OperandSymbol:
+		shape |= o.Imm << 8
+	case OperandSystemReg:
+		if o.Imm>>14 >= 2 {
+			shape |= 1 << 7
+		}
+	}
+	return shape
+}
+
+// regShape packs the type and element index of r with the range of its register number, in 20 bits.
+// Ranges are split at each number checked by matching operators (ZA tiles, predicates, W12-W15, SP/ZR).
+func regShape(r Reg) uint64 {
+	var class uint64
+	for _, min := range [...]uint8{1, 2, 4, 8, 12, 16, 31, 32} {
+		if r.ID >= min {
+			class++
+		}
+	}
+	return uint64(r.Type) | uint64(r.ElemInv)<<8 | class<<16
+}
@@ -0,0 +1,685 @@
+package arm
+
+// ValueKind is the kind of a [ValueType].
+type ValueKind uint8
+
+const (
+	_ ValueKind = iota
+
+	ValueInt    // integer or pointer
+	ValueFloat  // floating-point scalar
+	ValueVector // 64-bit or 128-bit short vector
+	ValueStruct // composite with members in Fields
+	ValueArray  // composite with Count elements of type Fields[0]
+)
+
+// ValueType is the type of a parameter or result in a [Signature]. Composite types may be created with
+// [StructOf] and [ArrayOf].
+type ValueType struct {
+	Kind   ValueKind
+	Size   uint32
+	Align  uint32
+	Fields []ValueType // members of a struct, or the element type of an array
+	Count  uint32      // number of elements of an array
+}
+
+// Scalar value types:
+var (
+	ValueI8   = ValueType{Kind: ValueInt, Size: 1, Align: 1}
+	ValueI16  = ValueType{Kind: ValueInt, Size: 2, Align: 2}
+	ValueI32  = ValueType{Kind: ValueInt, Size: 4, Align: 4}
+	ValueI64  = ValueType{Kind: ValueInt, Size: 8, Align: 8}
+	ValueI128 = ValueType{Kind: ValueInt, Size: 16, Align: 16}
+	ValuePtr  = ValueI64
+	ValueF16  = ValueType{Kind: ValueFloat, Size: 2, Align: 2}
+	ValueF32  = ValueType{Kind: ValueFloat, Size: 4, Align: 4}
+	ValueF64  = ValueType{Kind: ValueFloat, Size: 8, Align: 8}
+	ValueV64  = ValueType{Kind: ValueVector, Size: 8, Align: 8}
+	ValueV128 = ValueType{Kind: ValueVector, Size: 16, Align: 16}
+)
+
+// StructOf returns a struct type with C layout for fields.
+func StructOf(fields ...ValueType) ValueType {
+	t := ValueType{Kind: ValueStruct, Align: 1, Fields: fields}
+	for _, f := range fields {
+		t.Size = alignUp(t.Size, f.Align) + f.Size
+		if f.Align > t.Align {
+			t.Align = f.Align
+		}
+	}
+	t.Size = alignUp(t.Size, t.Align)
+	return t
+}
+
+// ArrayOf returns an array type with count elements of elem.
+func ArrayOf(elem ValueType, count uint32) ValueType {
+	return ValueType{Kind: ValueArray, Size: elem.Size * count, Align: elem.Align, Fields: []ValueType{elem}, Count: count}
+}
+
+// composite returns true if t is a struct or array.
+func (t ValueType) composite() bool { return t.Kind == ValueStruct || t.Kind == ValueArray }
+
+// valueLeaf is a scalar member of a value, at an offset from the start of the value.
+type valueLeaf struct {
+	kind   ValueKind
+	size   uint32
+	offset uint32
+}
+
+// leaves appends the scalar members of t at offset to leaves, in memory order.
+func (t ValueType) leaves(leaves []valueLeaf, offset uint32) []valueLeaf {
+	switch t.Kind {
+	case ValueStruct:
+		var fieldOffset uint32
+		for _, f := range t.Fields {
+			fieldOffset = alignUp(fieldOffset, f.Align)
+			leaves = f.leaves(leaves, offset+fieldOffset)
+			fieldOffset += f.Size
+		}
+		return leaves
+	case ValueArray:
+		for i := uint32(0); i < t.Count; i++ {
+			leaves = t.Fields[0].leaves(leaves, offset+i*t.Fields[0].Size)
+		}
+		return leaves
+	}
+	return append(leaves, valueLeaf{t.Kind, t.Size, offset})
+}
+
+// homogeneous returns the member count and member size of t if t is a floating-point or short vector type, or
+// a homogeneous floating-point or short vector aggregate (HFA or HVA) with at most 4 members.
+func (t ValueType) homogeneous() (count, size uint32, ok bool) {
+	leaves := t.leaves(nil, 0)
+	if len(leaves) == 0 || len(leaves) > 4 {
+		return 0, 0, false
+	}
+	for i, leaf := range leaves {
+		if leaf.kind != ValueFloat && leaf.kind != ValueVector || leaf.kind != leaves[0].kind ||
+			leaf.size != leaves[0].size || leaf.offset != uint32(i)*leaf.size {
+			return 0, 0, false
+		}
+	}
+	return uint32(len(leaves)), leaves[0].size, t.Size == uint32(len(leaves))*leaves[0].size
+}
+
+// CallConv is a calling convention which assigns the parameters and results of a [Signature] to registers and
+// stack slots.
+type CallConv uint8
+
+const (
+	// AAPCS64 is the standard procedure call standard for AArch64 (Linux, BSD, Windows without variadics).
+	// Arguments are passed in X0-X7 and V0-V7, with HFA/HVA members in consecutive V registers, composites larger
+	// than 16 bytes by reference, and an indirect result pointer in X8. Stack arguments use 8-byte slots.
+	AAPCS64 CallConv = iota
+	// Darwin is the Apple arm64 variant of AAPCS64. Scalar stack arguments are packed at their natural alignment,
+	// and variadic arguments are always passed on the stack in 8-byte slots.
+	Darwin
+	// GoABIInternal is the register-based Go internal ABI for arm64. Arguments and results are assigned to
+	// X0-X15 and D0-D15 (including struct fields) if all of their members fit, or otherwise to the stack.
+	// Stack arguments start at 8(SP), above the slot for the callee's saved return address; results which are not
+	// assigned to registers follow the stack arguments, then the callee may spill each register argument to a slot
+	// at its natural alignment. The StackSize of a layout includes the return address slot and the spill area.
+	GoABIInternal
+)
+
+// Signature describes the parameters and results of a function for [CallConv.Layout].
+type Signature struct {
+	Params   []ValueType
+	Results  []ValueType
+	Variadic bool // the function is variadic, with Fixed named parameters
+	Fixed    int  // number of named parameters of a variadic function
+}
+
+// CallLayout is the location of each parameter and result of a [Signature] under a [CallConv].
+type CallLayout struct {
+	Params    []ArgLoc
+	Results   []ArgLoc
+	StackSize uint32 // size of the stack argument area, a multiple of 16 for C conventions or 8 for Go
+}
+
+// ArgLoc is the location of a parameter or result, split into parts in memory order. A value passed by reference
+// has a single part for the address of a copy of the value, which is written by the caller; for an indirect
+// result (AAPCS64), the address is passed in X8.
+type ArgLoc struct {
+	Parts []ArgPart
+	ByRef bool
+}
+
+// ArgPart is a register or stack slot holding part of a parameter or result.
+type ArgPart struct {
+	Reg    Reg    // register holding the part, or the zero Reg if the part is on the stack
+	Offset uint32 // offset of the part in the stack argument area, if on the stack
+	Size   uint32 // size of the part in bytes
+}
+
+// OnStack returns true if the part is passed in the stack argument area.
+func (p ArgPart) OnStack() bool { return p.Reg == Reg{} }
+
+// callState tracks the next general-purpose register, SIMD register, and stack offset for assigning values.
+type callState struct {
+	cc         CallConv
+	ngrn, nsrn uint8
+	nsaa       uint32
+}
+
+// Layout assigns the parameters and results of sig to registers and stack slots, returning
+// [ErrInvalidSignature] if a type is invalid or unsupported by the convention.
+func (cc CallConv) Layout(sig Signature) (CallLayout, error) {
+	var l CallLayout
+	for _, t := range append(append([]ValueType(nil), sig.Params...), sig.Results...) {
+		if !t.valid() {
+			return l, ErrInvalidSignature
+		}
+	}
+	s := callState{cc: cc}
+	if cc == GoABIInternal {
+		s.nsaa = goFixedFrameSize
+	}
+	for i, t := range sig.Params {
+		var loc ArgLoc
+		var ok bool
+		switch {
+		case cc == GoABIInternal:
+			loc, ok = s.assignGo(t)
+		case cc == Darwin && sig.Variadic && i >= sig.Fixed:
+			loc, ok = s.assignVariadic(t), true
+		default:
+			loc, ok = s.assignC(t), true
+		}
+		if !ok {
+			return l, ErrInvalidSignature
+		}
+		l.Params = append(l.Params, loc)
+	}
+
+	results := callState{cc: cc, nsaa: alignUp(s.nsaa, 8)}
+	for _, t := range sig.Results {
+		var loc ArgLoc
+		var ok bool
+		if cc == GoABIInternal {
+			loc, ok = results.assignGo(t)
+		} else if t.composite() && t.Size > 16 && !t.isHomogeneous() {
+			loc, ok = ArgLoc{Parts: []ArgPart{{Reg: X(8), Size: 8}}, ByRef: true}, true
+		} else {
+			loc = results.assignC(t)
+			ok = !loc.Parts[0].OnStack()
+		}
+		if !ok {
+			return l, ErrInvalidSignature
+		}
+		l.Results = append(l.Results, loc)
+	}
+
+	if cc == GoABIInternal {
+		spill := alignUp(results.nsaa, 8)
+		for i, t := range sig.Params {
+			if parts := l.Params[i].Parts; len(parts) != 0 && !parts[0].OnStack() {
+				spill = alignUp(spill, t.Align) + t.Size
+			}
+		}
+		l.StackSize = alignUp(spill, 8)
+	} else {
+		l.StackSize = alignUp(s.nsaa, 16)
+	}
+	return l, nil
+}
+
+// valid returns true if the size and alignment of t are consistent with its kind.
+func (t ValueType) valid() bool {
+	if t.Align == 0 || t.Align&(t.Align-1) != 0 || t.Align > 16 {
+		return false
+	}
+	switch t.Kind {
+	case ValueInt:
+		return t.Size == 1 || t.Size == 2 || t.Size == 4 || t.Size == 8 || t.Size == 16
+	case ValueFloat:
+		return t.Size == 2 || t.Size == 4 || t.Size == 8 || t.Size == 16
+	case ValueVector:
+		return t.Size == 8 || t.Size == 16
+	case ValueStruct:
+		for _, f := range t.Fields {
+			if !f.valid() {
+				return false
+			}
+		}
+		return true
+	case ValueArray:
+		return len(t.Fields) == 1 && t.Fields[0].valid()
+	}
+	return false
+}
+
+func (t ValueType) isHomogeneous() bool {
+	_, _, ok := t.homogeneous()
+	return ok
+}
+
+// assignC assigns a parameter or result under AAPCS64 (rules C.1-C.17), with Darwin stack packing.
+func (s *callState) assignC(t ValueType) ArgLoc {
+	if count, size, ok := t.homogeneous(); ok {
+		if s.nsrn+uint8(count) <= 8 {
+			var loc ArgLoc
+			for i := uint32(0); i < count; i++ {
+				loc.Parts = append(loc.Parts, ArgPart{Reg: simdReg(s.nsrn, size), Size: size})
+				s.nsrn++
+			}
+			return loc
+		}
+		s.nsrn = 8
+		if !t.composite() {
+			return s.stackScalar(t)
+		}
+		align := uint32(8)
+		if t.Align == 16 {
+			align = 16
+		}
+		return s.stackParts(t, align, size)
+	}
+
+	if t.composite() && t.Size > 16 { // copied to memory, passed by reference
+		loc := s.assignC(ValuePtr)
+		loc.ByRef = true
+		return loc
+	}
+	if !t.composite() && t.Size <= 8 {
+		if s.ngrn < 8 {
+			loc := ArgLoc{Parts: []ArgPart{{Reg: intReg(s.ngrn, t.Size), Size: t.Size}}}
+			s.ngrn++
+			return loc
+		}
+		return s.stackScalar(t)
+	}
+
+	// 128-bit integers and composites of up to 16 bytes, in 8-byte registers
+	dwords := uint8((t.Size + 7) / 8)
+	if t.Align == 16 {
+		s.ngrn = (s.ngrn + 1) &^ 1
+	}
+	if s.ngrn+dwords <= 8 {
+		var loc ArgLoc
+		for i := uint8(0); i < dwords; i++ {
+			loc.Parts = append(loc.Parts, ArgPart{Reg: X(s.ngrn), Size: 8})
+			s.ngrn++
+		}
+		return loc
+	}
+	s.ngrn = 8
+	align := uint32(8)
+	if t.Align == 16 {
+		align = 16
+	}
+	return s.stackParts(t, align, 8)
+}
+
+// assignVariadic assigns a variadic parameter under Darwin, in 8-byte aligned stack slots.
+func (s *callState) assignVariadic(t ValueType) ArgLoc {
+	if t.composite() && t.Size > 16 && !t.isHomogeneous() {
+		s.nsaa = alignUp(s.nsaa, 8)
+		loc := ArgLoc{Parts: []ArgPart{{Offset: s.nsaa, Size: 8}}, ByRef: true}
+		s.nsaa += 8
+		return loc
+	}
+	if !t.composite() {
+		s.nsaa = alignUp(s.nsaa, maxUint32(t.Align, 8))
+		loc := ArgLoc{Parts: []ArgPart{{Offset: s.nsaa, Size: t.Size}}}
+		s.nsaa += alignUp(t.Size, 8)
+		return loc
+	}
+	return s.stackParts(t, maxUint32(t.Align, 8), 8)
+}
+
+// stackScalar assigns a scalar to the stack: in an 8-byte slot for AAPCS64, or packed at its natural
+// alignment for Darwin.
+func (s *callState) stackScalar(t ValueType) ArgLoc {
+	size, align := alignUp(t.Size, 8), maxUint32(t.Align, 8)
+	if s.cc == Darwin {
+		size, align = t.Size, t.Align
+	}
+	s.nsaa = alignUp(s.nsaa, align)
+	loc := ArgLoc{Parts: []ArgPart{{Offset: s.nsaa, Size: t.Size}}}
+	s.nsaa += size
+	return loc
+}
+
+// stackParts assigns a composite to the stack with the given alignment, in parts of partSize bytes covering the
+// value (the members of an HFA/HVA, or dwords). The stack slot is rounded up to 8 bytes.
+func (s *callState) stackParts(t ValueType, align, partSize uint32) ArgLoc {
+	s.nsaa = alignUp(s.nsaa, align)
+	var loc ArgLoc
+	for offset := uint32(0); offset < t.Size; offset += partSize {
+		loc.Parts = append(loc.Parts, ArgPart{Offset: s.nsaa + offset, Size: partSize})
+	}
+	s.nsaa += alignUp(t.Size, 8)
+	return loc
+}
+
+// goFixedFrameSize is the size of the slot at 0(SP) for the return address saved by a Go function on arm64.
+const goFixedFrameSize = 8
+
+// assignGo assigns a parameter or result under the Go internal ABI, returning false for unsupported types.
+func (s *callState) assignGo(t ValueType) (ArgLoc, bool) {
+	leaves, inRegs := t.leaves(nil, 0), true
+	var ints, floats uint8
+	if !goRegisterable(t) {
+		inRegs = false
+	}
+	for _, leaf := range leaves {
+		switch leaf.kind {
+		case ValueInt:
+			ints += uint8((leaf.size + 7) / 8)
+		case ValueFloat:
+			if leaf.size != 4 && leaf.size != 8 {
+				return ArgLoc{}, false
+			}
+			floats++
+		default:
+			return ArgLoc{}, false
+		}
+	}
+	var loc ArgLoc
+	if inRegs && s.ngrn+ints <= 16 && s.nsrn+floats <= 16 {
+		for _, leaf := range leaves {
+			switch {
+			case leaf.kind == ValueFloat:
+				loc.Parts = append(loc.Parts, ArgPart{Reg: simdReg(s.nsrn, leaf.size), Size: leaf.size})
+				s.nsrn++
+			case leaf.size == 16:
+				loc.Parts = append(loc.Parts, ArgPart{Reg: X(s.ngrn), Size: 8}, ArgPart{Reg: X(s.ngrn + 1), Size: 8})
+				s.ngrn += 2
+			default:
+				loc.Parts = append(loc.Parts, ArgPart{Reg: intReg(s.ngrn, leaf.size), Size: leaf.size})
+				s.ngrn++
+			}
+		}
+		return loc, true
+	}
+	s.nsaa = alignUp(s.nsaa, t.Align)
+	for _, leaf := range leaves {
+		loc.Parts = append(loc.Parts, ArgPart{Offset: s.nsaa + leaf.offset, Size: leaf.size})
+	}
+	s.nsaa += t.Size
+	return loc, true
+}
+
+// goRegisterable returns false if t contains an array with more than one element, which the Go internal ABI
+// always assigns to the stack.
+func goRegisterable(t ValueType) bool {
+	switch t.Kind {
+	case ValueArray:
+		return t.Count <= 1 && (t.Count == 0 || goRegisterable(t.Fields[0]))
+	case ValueStruct:
+		for _, f := range t.Fields {
+			if !goRegisterable(f) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// intReg returns the general-purpose register id, as a W register for values of up to 4 bytes.
+func intReg(id uint8, size uint32) Reg {
+	if size <= 4 {
+		return W(id)
+	}
+	return X(id)
+}
+
+// simdReg returns the scalar SIMD register id for a value of the given size.
+func simdReg(id uint8, size uint32) Reg {
+	switch size {
+	case 1:
+		return ScalarB(id)
+	case 2:
+		return ScalarH(id)
+	case 4:
+		return ScalarS(id)
+	case 8:
+		return ScalarD(id)
+	}
+	return ScalarQ(id)
+}
+
+func alignUp(n, align uint32) uint32 { return (n + align - 1) &^ (align - 1) }
+
+func maxUint32(a, b uint32) uint32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Move is a register move for [Assembler.ParallelMove].
+type Move struct {
+	Dst, Src Reg
+}
+
+// ParallelMove writes register moves which assign the value of each Src to its Dst as if all moves were performed
+// at once, ordering moves so that no source is overwritten before it is read. Cycles are broken with a scratch
+// register: scratchX for general-purpose registers, or scratchV for SIMD registers. Moves may be between general-
+// purpose (X or W) and SIMD (B, H, S, D, or Q) registers; SIMD registers are moved as 128-bit registers unless the
+// other register is general-purpose. If two moves have the same destination, a move reads or writes scratchX or
+// scratchV, or a register is neither general-purpose nor SIMD (e.g. SP), Err is set to [ErrInvalidMove].
+func (a *Assembler) ParallelMove(moves []Move, scratchX, scratchV Reg) bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.setCallerPos(1) {
+		defer a.SetSourcePos(SourcePos{})
+	}
+	pending := make([]Move, 0, len(moves))
+	for i, m := range moves {
+		if !isMovable(m.Dst) || !isMovable(m.Src) ||
+			isScratch(m.Dst, scratchX, scratchV) || isScratch(m.Src, scratchX, scratchV) {
+			a.Err = ErrInvalidMove
+			return false
+		}
+		for _, prev := range moves[:i] {
+			if sameReg(prev.Dst, m.Dst) {
+				a.Err = ErrInvalidMove
+				return false
+			}
+		}
+		if !sameReg(m.Dst, m.Src) || m.Dst.Type != m.Src.Type && isGPR(m.Dst) {
+			pending = append(pending, m)
+		}
+	}
+
+	ok := true
+	for len(pending) != 0 {
+		progress := false
+		for i := 0; i < len(pending); {
+			if readByOther(pending, i) {
+				i++
+				continue
+			}
+			ok = a.move(pending[i].Dst, pending[i].Src) && ok
+			pending = append(pending[:i], pending[i+1:]...)
+			progress = true
+		}
+		if progress {
+			continue
+		}
+		// every remaining destination is read by another move, so moves form cycles
+		src := pending[0].Src
+		scratch := Reg{ID: scratchV.ID, Type: RQ}
+		if isGPR(src) {
+			scratch = Reg{ID: scratchX.ID, Type: src.Type}
+		}
+		ok = a.move(scratch, src) && ok
+		for i := range pending {
+			if sameReg(pending[i].Src, src) {
+				pending[i].Src = Reg{ID: scratch.ID, Type: pending[i].Src.Type}
+				if !isGPR(scratch) {
+					pending[i].Src.Type = RQ
+				}
+			}
+		}
+	}
+	return ok
+}
+
+// readByOther returns true if the destination of pending[i] is the source of another pending move.
+func readByOther(pending []Move, i int) bool {
+	for j, m := range pending {
+		if j != i && sameReg(m.Src, pending[i].Dst) {
+			return true
+		}
+	}
+	return false
+}
+
+// move writes a single register move.
+func (a *Assembler) move(dst, src Reg) bool {
+	switch {
+	case isGPR(dst) && isGPR(src):
+		if dst.Type == RW || src.Type == RW {
+			return a.Inst(MOV, W(dst.ID), W(src.ID))
+		}
+		return a.Inst(MOV, X(dst.ID), X(src.ID))
+	case isGPR(src): // GPR to SIMD
+		if src.Type == RX && dst.Type != RS && dst.Type != RH && dst.Type != RB {
+			return a.Inst(FMOV, ScalarD(dst.ID), X(src.ID))
+		}
+		return a.Inst(FMOV, ScalarS(dst.ID), W(src.ID))
+	case isGPR(dst): // SIMD to GPR
+		if dst.Type == RX {
+			return a.Inst(FMOV, X(dst.ID), ScalarD(src.ID))
+		}
+		return a.Inst(FMOV, W(dst.ID), ScalarS(src.ID))
+	}
+	return a.Inst(MOV, Vec16B(dst.ID), Vec16B(src.ID))
+}
+
+// isScratch returns true if r is a view of the scratch register for its register bank.
+func isScratch(r, scratchX, scratchV Reg) bool {
+	if isGPR(r) {
+		return sameReg(r, scratchX)
+	}
+	return sameReg(r, scratchV)
+}
+
+// isMovable returns true if r is a general-purpose or SIMD register, which may be moved by [Assembler.ParallelMove].
+func isMovable(r Reg) bool {
+	switch r.Family() {
+	case RegInt, RegFloat, RegVec32, RegVec64, RegVec128:
+		return true
+	}
+	return false
+}
+
+// isGPR returns true if r is a general-purpose register.
+func isGPR(r Reg) bool { return r.Family() == RegInt }
+
+// sameReg returns true if r and other are views of the same physical register.
+func sameReg(r, other Reg) bool { return r.ID == other.ID && isGPR(r) == isGPR(other) }
+
+// MarshalArgs writes the stores and register moves which pass arguments for a call with the layout l. srcs holds
+// the registers containing each parameter, with a register for each part of its location (a single address
+// register for a parameter passed by reference). Stack parts are stored relative to SP, which must point to a
+// stack argument area of at least l.StackSize bytes; then register parts are assigned with [Assembler.ParallelMove],
+// using X16 (IP0) and V16 as scratch registers, which must not hold arguments.
+func (a *Assembler) MarshalArgs(l CallLayout, srcs [][]Reg) bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.setCallerPos(1) {
+		defer a.SetSourcePos(SourcePos{})
+	}
+	if len(srcs) != len(l.Params) {
+		a.Err = ErrInvalidMove
+		return false
+	}
+	var moves []Move
+	ok := true
+	for i, loc := range l.Params {
+		if len(srcs[i]) != len(loc.Parts) {
+			a.Err = ErrInvalidMove
+			return false
+		}
+		for j, part := range loc.Parts {
+			if part.OnStack() {
+				ok = a.storeArg(srcs[i][j], part) && ok
+			} else {
+				moves = append(moves, Move{Dst: part.Reg, Src: srcs[i][j]})
+			}
+		}
+	}
+	return a.ParallelMove(moves, X(16), ScalarQ(16)) && ok
+}
+
+// UnmarshalResults writes the loads and register moves which copy the results of a call with the layout l to
+// dsts, with a register for each part of each result. Stack parts (for the Go internal ABI) are loaded relative
+// to SP, after register parts are assigned with [Assembler.ParallelMove] using X16 and V16 as scratch registers.
+// Results passed by reference are not copied.
+func (a *Assembler) UnmarshalResults(l CallLayout, dsts [][]Reg) bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.setCallerPos(1) {
+		defer a.SetSourcePos(SourcePos{})
+	}
+	if len(dsts) != len(l.Results) {
+		a.Err = ErrInvalidMove
+		return false
+	}
+	var moves []Move
+	var loads []Move // stack parts, loaded after the moves so that result registers are not overwritten
+	var parts []ArgPart
+	for i, loc := range l.Results {
+		if loc.ByRef {
+			continue
+		}
+		if len(dsts[i]) != len(loc.Parts) {
+			a.Err = ErrInvalidMove
+			return false
+		}
+		for j, part := range loc.Parts {
+			if part.OnStack() {
+				loads = append(loads, Move{Dst: dsts[i][j]})
+				parts = append(parts, part)
+			} else {
+				moves = append(moves, Move{Dst: dsts[i][j], Src: part.Reg})
+			}
+		}
+	}
+	ok := a.ParallelMove(moves, X(16), ScalarQ(16))
+	for i, load := range loads {
+		ok = a.loadArg(load.Dst, parts[i]) && ok
+	}
+	return ok
+}
+
+// storeArg stores the register src to the stack part of an argument.
+func (a *Assembler) storeArg(src Reg, part ArgPart) bool {
+	ref := RefOffset{XSP, int32(part.Offset)}
+	if !isGPR(src) {
+		return a.Inst(STR, simdReg(src.ID, part.Size), ref)
+	}
+	switch part.Size {
+	case 1:
+		return a.Inst(STRB, W(src.ID), ref)
+	case 2:
+		return a.Inst(STRH, W(src.ID), ref)
+	case 4:
+		return a.Inst(STR, W(src.ID), ref)
+	}
+	return a.Inst(STR, X(src.ID), ref)
+}
+
+// loadArg loads the stack part of a result to the register dst.
+func (a *Assembler) loadArg(dst Reg, part ArgPart) bool {
+	ref := RefOffset{XSP, int32(part.Offset)}
+	if !isGPR(dst) {
+		return a.Inst(LDR, simdReg(dst.ID, part.Size), ref)
+	}
+	switch part.Size {
+	case 1:
+		return a.Inst(LDRB, W(dst.ID), ref)
+	case 2:
+		return a.Inst(LDRH, W(dst.ID), ref)
+	case 4:
+		return a.Inst(LDR, W(dst.ID), ref)
+	}
+	return a.Inst(LDR, X(dst.ID), ref)
+}
@@ -0,0 +1,104 @@
+package arm
+
+import "math"
+
+// Typed register numbers for the generated emitter methods (see inst_emit.go). Each type accepts only the
+// registers of a single matcher, so operand kinds are checked at compile time; register numbers are checked
+// when the instruction is encoded.
+//
+// Register 31 is the zero register for [WReg] and [XReg], and the stack pointer for [WSPReg] and [XSPReg].
+// [VReg] is a vector register with an element index, passed with a separate index argument.
+type (
+	WReg    uint8 // 32-bit integer register
+	XReg    uint8 // 64-bit integer register
+	WSPReg  uint8 // 32-bit integer register or WSP
+	XSPReg  uint8 // 64-bit integer register or SP
+	BReg    uint8 // 8-bit scalar SIMD register
+	HReg    uint8 // 16-bit scalar SIMD register
+	SReg    uint8 // 32-bit scalar SIMD register
+	DReg    uint8 // 64-bit scalar SIMD register
+	QReg    uint8 // 128-bit scalar SIMD register
+	V4BReg  uint8 // 4x8-bit vector SIMD register
+	V8BReg  uint8 // 8x8-bit vector SIMD register
+	V16BReg uint8 // 16x8-bit vector SIMD register
+	V2HReg  uint8 // 2x16-bit vector SIMD register
+	V4HReg  uint8 // 4x16-bit vector SIMD register
+	V8HReg  uint8 // 8x16-bit vector SIMD register
+	V2SReg  uint8 // 2x32-bit vector SIMD register
+	V4SReg  uint8 // 4x32-bit vector SIMD register
+	V1DReg  uint8 // 1x64-bit vector SIMD register
+	V2DReg  uint8 // 2x64-bit vector SIMD register
+	V1QReg  uint8 // 1x128-bit vector SIMD register
+	VReg    uint8 // vector SIMD register with an element index
+)
+
+// emit encodes inst with flattened arguments for a known encoding, bypassing pattern matching. The encoding is
+// identified by its index idx for inst and its offset in the Commands array. Arguments are checked by the
+// encoding commands as they are for [Assembler.Inst].
+func (a *Assembler) emit(inst Inst, idx int8, cmdsOffset uint32, feature, extra Feature, simdSize uint8, flat ...Flat) bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.TrackSource || a.srcSet {
+		a.recordPos(2)
+	}
+	if f := a.missingFeature(feature, extra); f != 0 {
+		a.Err = &FeatureError{Inst: inst, Feature: f, CPU: a.CPU.Name}
+		return a.instErr(inst)
+	}
+	a.CurrentInst = inst
+	a.Args = a.scratchArgs[:0]
+	a.Flat = append(a.scratchFlat[:0], flat...)
+	a.SimdSize = simdSize
+	a.Count = 0
+	a.Idx = idx
+	a.patternLen = 0
+	a.Feature = feature
+	a.loadCommands(cmdsOffset)
+	observe := len(a.Observers) != 0 && !a.observing
+	if observe {
+		a.observing = true
+		for _, o := range a.Observers {
+			o.BeforeInst(a, inst, a.Args)
+		}
+		a.observing = false
+	}
+	if !a.encodeFlat() {
+		if a.Err == nil {
+			a.Err = ErrInvalidEncoding
+		}
+		return a.instErr(inst)
+	}
+	a.recordWritten()
+	if observe {
+		return a.observeAfter(inst)
+	}
+	return true
+}
+
+// emitErr sets the error for a typed emitter method for inst with invalid arguments.
+func (a *Assembler) emitErr(inst Inst, err error) bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.TrackSource || a.srcSet {
+		a.recordPos(2)
+	}
+	a.Err = err
+	return a.instErr(inst)
+}
+
+// validLabel returns true if l was created by [Assembler.NewLabel].
+func (a *Assembler) validLabel(l Label) bool { return int(l.ID) < len(a.LabelPC) }
+
+// flatModImm returns the flattened shift amount for a modifier, or the default if unset.
+func flatModImm(m Mod) Flat {
+	if m.HasImm() {
+		return Flat{FlatImm, uint64(m.GetImm())}
+	}
+	return Flat{}
+}
+
+func flatFloat(f float64) Flat { return Flat{FlatImm, math.Float64bits(f)} }
+
+func flatLabel(l Label) Flat { return Flat{FlatLabel, uint64(l.ID) | uint64(uint32(l.Offset))<<32} }
@@ -13,6 +13,10 @@ const (
 	CmdRNz16 // RNz16, encode a register (except 31) or reference base into a 5-bit bitfield at bit 16
 	CmdREven // REven(offset), encode an even register or reference base into a 5-bit bitfield at bit $0
 	CmdRNext // encode that this register should be the previous register, plus one
+	CmdRLo8  // RLo8(offset), encode a register in the range 0-7 into a 3-bit bitfield at bit $0
+	CmdRSame // RSame(back), encode that this register should be the same as the register $0 arguments before it
+	CmdRbits // Rbits(offset, bitlen), encode a register in the range 0 to (1 << $1) - 1 into a $1-bit bitfield at bit $0
+	CmdRW12  // RW12(offset), encode a W12-W15 register into a 2-bit bitfield at bit $0
 
 	CmdRwidth30 // Rwidth, SIMD 128-bit indicator at bit 30
 
@@ -26,14 +30,17 @@ const (
 	CmdUsub      // Usub(offset, bitlen, val), encode at $0, $1 bits long, $2 - value. Checks if the value is in the range 0 .. value
 	CmdUnegmod   // Unegmod(offset, bitlen), encode at $0, $1 bits long, -value % (1 << $1). Checks if the value is in the range 0 .. value
 	CmdUsumdec   // Usumdec(offset, bitlen), encode at $0, $1 bits long, the value of the previous arg + the value of the current arg - 1
+	CmdUsame     // Usame(back), encode that this immediate should be the same as the immediate $0 arguments before it
 	CmdUfields11 // Ufields11(count), encode an immediate bitwise with $0 fields, into bits [11, 21, 20]
 	CmdUfields30 // Ufields30(count), encode an immediate bitwise with $0 fields, into bits [30, 12, 11, 10]
 	CmdUfields21 // Ufields21, encode an immediate bitwise with 1 field, into bit 21
 
 	// signed immediate encodings
 
-	CmdSbits   // Sbits, encode a signed immediate starting at bit 12, 9 bits long
-	CmdSscaled // Sscaled(shift), encode a signed immediate, starting at bit 15, 7 bits long, shifted $0 bits to the right before encoding
+	CmdSbits    // Sbits, encode a signed immediate starting at bit 12, 9 bits long
+	CmdSscaled  // Sscaled(shift), encode a signed immediate, starting at bit 15, 7 bits long, shifted $0 bits to the right before encoding
+	CmdSfield   // Sfield(offset, bitlen), encode a signed immediate starting at bit $0, $1 bits long
+	CmdSscaled9 // Sscaled9(shift), encode a signed immediate, starting at bit 12, 9 bits long, shifted $0 bits to the right before encoding
 
 	// bit slice encodings. These don't advance the current argument. Only the slice argument actually encodes anything
 
@@ -41,6 +48,7 @@ const (
 	CmdChkUsum    // ChkUsum(shift), checks that the pointed value fits between 1 and (1 << $0) - prev
 	CmdChkSscaled // ChkSscaled, with (offset 10, shift 3)
 	CmdChkUrange1 // ChkUrange(max), // check if the pointed value is between 1 and $0
+	CmdChkSbits   // ChkSbits(bitlen), checks if the pointed value fits in a signed immediate $0 bits long
 	CmdUslice     // Uslice(offset, bitlen, startoffset), encode at $0, $1 bits long, the bitslice starting at $2 from the current arg
 	CmdSslice     // Sslice(offset, bitlen, startoffset), encodes at $0, $1 bits long, the bitslice starting at $2 from the current arg
 
@@ -51,6 +59,7 @@ const (
 	CmdRotates  // Rotates, 2-bits field encoding at bit 22 [LSL, LSR, ASR, ROR]
 	CmdExtendsW // ExtendsW, 3-bits field encoding at bit 13 [UXTB, UXTH, UXTW, UXTX, SXTB, SXTH, SXTW, SXTX]. Additionally, LSL is interpreted as UXTW
 	CmdExtendsX // ExtendsX, 3-bits field encoding at bit 13 [UXTB, UXTH, UXTW, UXTX, SXTB, SXTH, SXTW, SXTX]. Additionally, LSL is interpreted as UXTX
+	CmdXs       // Xs(offset), 1-bit field encoding at bit $0 [UXTW, SXTW]
 
 	// Condition encodings.
 
@@ -87,6 +96,10 @@ const (
 	SymBARRIEROPS
 	SymMSRIMMOPS
 	SymCONTROLREGS
+	SymSVEPATTERNS
+	SymSVCRFIELDS
+	SymBTITARGETS
+	SymPRFOPS
 )
 
 // Arm Architecture Reference Manual for A-profile architecture, 4 Feb 2022 Issue H.a
@@ -119,6 +132,7 @@ const (
 	SpecialImmLogical64
 	SpecialImmFloat
 	SpecialImmFloatSplit
+	SpecialImmTszRight64
 )
 
 var SpecialName = [...]string{
@@ -131,6 +145,7 @@ var SpecialName = [...]string{
 	SpecialImmLogical64:  "SpecialImmLogical64",
 	SpecialImmFloat:      "SpecialImmFloat",
 	SpecialImmFloatSplit: "SpecialImmFloatSplit",
+	SpecialImmTszRight64: "SpecialImmTszRight64",
 }
 
 var Alts2 = [...][2]uint16{
@@ -161,6 +176,10 @@ var CmdArgCounts = [...]uint8{
 	CmdRNz16:      0,
 	CmdREven:      1,
 	CmdRNext:      0,
+	CmdRLo8:       1,
+	CmdRSame:      1,
+	CmdRbits:      2,
+	CmdRW12:       1,
 	CmdRwidth30:   0,
 	CmdUbits:      2,
 	CmdUscaled:    3,
@@ -170,21 +189,26 @@ var CmdArgCounts = [...]uint8{
 	CmdUsub:       3,
 	CmdUnegmod:    2,
 	CmdUsumdec:    2,
+	CmdUsame:      1,
 	CmdUfields11:  1,
 	CmdUfields30:  1,
 	CmdUfields21:  0,
 	CmdSbits:      0,
 	CmdSscaled:    1,
+	CmdSfield:     2,
+	CmdSscaled9:   1,
 	CmdChkUbits:   1,
 	CmdChkUsum:    1,
 	CmdChkSscaled: 0,
 	CmdChkUrange1: 1,
+	CmdChkSbits:   1,
 	CmdUslice:     3,
 	CmdSslice:     3,
 	CmdSpecial:    2,
 	CmdRotates:    0,
 	CmdExtendsW:   0,
 	CmdExtendsX:   0,
+	CmdXs:         1,
 	CmdCond:       1,
 	CmdCondInv:    1,
 	CmdLitList:    2,
@@ -202,6 +226,10 @@ var CmdName = [...]string{
 	CmdRNz16:       "CmdRNz16",
 	CmdREven:       "CmdREven",
 	CmdRNext:       "CmdRNext",
+	CmdRLo8:        "CmdRLo8",
+	CmdRSame:       "CmdRSame",
+	CmdRbits:       "CmdRbits",
+	CmdRW12:        "CmdRW12",
 	CmdRwidth30:    "CmdRwidth30",
 	CmdUbits:       "CmdUbits",
 	CmdUscaled:     "CmdUscaled",
@@ -211,21 +239,26 @@ var CmdName = [...]string{
 	CmdUsub:        "CmdUsub",
 	CmdUnegmod:     "CmdUnegmod",
 	CmdUsumdec:     "CmdUsumdec",
+	CmdUsame:       "CmdUsame",
 	CmdUfields11:   "CmdUfields11",
 	CmdUfields30:   "CmdUfields30",
 	CmdUfields21:   "CmdUfields21",
 	CmdSbits:       "CmdSbits",
 	CmdSscaled:     "CmdSscaled",
+	CmdSfield:      "CmdSfield",
+	CmdSscaled9:    "CmdSscaled9",
 	CmdChkUbits:    "CmdChkUbits",
 	CmdChkUsum:     "CmdChkUsum",
 	CmdChkSscaled:  "CmdChkSscaled",
 	CmdChkUrange1:  "CmdChkUrange1",
+	CmdChkSbits:    "CmdChkSbits",
 	CmdUslice:      "CmdUslice",
 	CmdSslice:      "CmdSslice",
 	CmdSpecial:     "CmdSpecial",
 	CmdRotates:     "CmdRotates",
 	CmdExtendsW:    "CmdExtendsW",
 	CmdExtendsX:    "CmdExtendsX",
+	CmdXs:          "CmdXs",
 	CmdCond:        "CmdCond",
 	CmdCondInv:     "CmdCondInv",
 	CmdLitList:     "CmdLitList",
@@ -244,4 +277,8 @@ var CmdName = [...]string{
 	SymBARRIEROPS:  "SymBARRIEROPS",
 	SymMSRIMMOPS:   "SymMSRIMMOPS",
 	SymCONTROLREGS: "SymCONTROLREGS",
+	SymSVEPATTERNS: "SymSVEPATTERNS",
+	SymSVCRFIELDS:  "SymSVCRFIELDS",
+	SymBTITARGETS:  "SymBTITARGETS",
+	SymPRFOPS:      "SymPRFOPS",
 }
@@ -0,0 +1,261 @@
+package arm
+
+import "math"
+
+// EncodingInfo describes an encoding of an instruction, as listed by [Encodings].
+type EncodingInfo struct {
+	Inst         Inst
+	Idx          int8          // encoding index for Inst, as stored in [Assembler.Idx] when matched
+	Doc          string        // syntax and constraints; dual-width SIMD encodings list each width on a separate line
+	Feature      Feature       // feature required by the encoding, or 0
+	ExtraFeature Feature       // feature required in addition to Feature, or 0
+	Opcode       uint32        // fixed opcode bits, without arguments
+	Pattern      []EncOp       // argument-matching operators (see [Assembler.Pattern])
+	Commands     []EncOp       // encoding operators (see [Assembler.Commands])
+	Args         []FlatArgInfo // flattened arguments, in encoding order
+}
+
+// FlatArgInfo describes a flattened argument of an encoding, with constraints derived from its encoding operators.
+//
+// Min and Max are the inclusive range of register numbers, immediate values, or label offsets. Unconstrained
+// immediates have a range of [math.MinInt64, math.MaxInt64], and are checked by the special encoding, symbol
+// list, or alternatives of the argument if any.
+type FlatArgInfo struct {
+	Kind     FlatKind // register, immediate, modifier, or label (or immediate offset); FlatDefault if not encoded
+	Matcher  uint8    // index of the argument-matching operator in Pattern for the argument
+	Min      int64    // minimum value
+	Max      int64    // maximum value
+	Scale    uint8    // values must be multiples of 1 << Scale (even registers have a scale of 1)
+	Same     int8     // index of an earlier argument which must be equal, or -1
+	Next     bool     // register number must follow the previous argument (modulo 32)
+	Alts     []uint16 // allowed immediate values, or nil
+	Special  uint8    // special immediate type (SpecialImmLogical64, ...), or 0
+	Rel      uint8    // relocation type for label offsets (RelB, ...), or 0
+	Commands []EncOp  // encoding operators for the argument
+}
+
+// Encodings returns a description of each encoding for inst, in matching order.
+func Encodings(inst Inst) []EncodingInfo {
+	if inst == 0 || int(inst) >= len(PatternOffsets) {
+		return nil
+	}
+	offset := PatternOffsets[inst]
+	infos := make([]EncodingInfo, Patterns[offset])
+	offset++
+	var a Assembler // for unpacking commands
+	for idx := range infos {
+		info := &infos[idx]
+		info.Inst, info.Idx = inst, int8(idx)
+		info.Doc = EncodingDocs[int(EncodingDocOffsets[inst])+idx]
+		info.Pattern = make([]EncOp, Patterns[offset])
+		offset++
+		for m := range info.Pattern {
+			op := Patterns[offset]
+			offset++
+			info.Pattern[m].Op = op
+			xs := uint32(MatcherArgCounts[op])
+			copy(info.Pattern[m].X[:], Patterns[offset:offset+xs])
+			offset += xs
+		}
+		cmdsOffset := uint32(Patterns[offset])<<16 | uint32(Patterns[offset+1])<<8 | uint32(Patterns[offset+2])
+		info.Feature, info.ExtraFeature = Feature(Patterns[offset+3]), Feature(Patterns[offset+4])
+		offset += 5
+
+		a.cmds = [len(a.cmds)]EncOp{} // clear unused operands of earlier commands
+		a.loadCommands(cmdsOffset)
+		info.Opcode = a.Opcode
+		info.Commands = append([]EncOp(nil), a.Commands()...)
+		info.Args = flatArgInfo(info.Pattern, info.Commands)
+	}
+	return infos
+}
+
+// flatArgInfo groups encoding operators by flattened argument, following the cursor of [Assembler.encodeFlat].
+func flatArgInfo(pattern, cmds []EncOp) []FlatArgInfo {
+	var args []FlatArgInfo
+	for mi, m := range pattern {
+		for i := uint8(0); i < MatcherFlatArgCounts[m.Op]; i++ {
+			args = append(args, FlatArgInfo{Matcher: uint8(mi), Min: math.MinInt64, Max: math.MaxInt64, Same: -1})
+		}
+	}
+	cursor := 0
+	for _, cmd := range cmds {
+		switch cmd.Op {
+		case CmdAdv:
+			cursor++
+			continue
+		case CmdBack:
+			cursor--
+			continue
+		case CmdRwidth30:
+			continue
+		}
+		if cursor >= len(args) {
+			break
+		}
+		args[cursor].constrain(cmd, cursor)
+		switch cmd.Op {
+		default:
+			cursor++
+		case CmdUslice, CmdSslice, CmdChkUbits, CmdChkUsum, CmdChkSscaled, CmdChkUrange1, CmdChkSbits:
+			// non-consuming
+		}
+	}
+	return args
+}
+
+// constrain applies the constraints of an encoding operator to the flattened argument at index cursor.
+func (arg *FlatArgInfo) constrain(cmd EncOp, cursor int) {
+	arg.Commands = append(arg.Commands, cmd)
+	x0, x1, x2 := cmd.X[0], cmd.X[1], cmd.X[2]
+	switch cmd.Op {
+	// registers:
+	case CmdR0, CmdR5, CmdR10, CmdR16:
+		arg.Kind = FlatReg
+		arg.setRange(0, 31)
+	case CmdRLo16:
+		arg.Kind = FlatReg
+		arg.setRange(0, 15)
+	case CmdRNz16:
+		arg.Kind = FlatReg
+		arg.setRange(0, 30)
+	case CmdREven:
+		arg.Kind, arg.Scale = FlatReg, 1
+		arg.setRange(0, 31)
+	case CmdRNext:
+		arg.Kind, arg.Next = FlatReg, true
+		arg.setRange(0, 31)
+	case CmdRLo8:
+		arg.Kind = FlatReg
+		arg.setRange(0, 7)
+	case CmdRSame:
+		arg.Kind, arg.Same = FlatReg, int8(cursor-int(x0))
+		arg.setRange(0, 31)
+	case CmdRbits:
+		arg.Kind = FlatReg
+		arg.setRange(0, 1<<x1-1)
+	case CmdRW12:
+		arg.Kind = FlatReg
+		arg.setRange(12, 15)
+
+	// modifiers:
+	case CmdRotates, CmdExtendsW, CmdExtendsX, CmdXs:
+		arg.Kind = FlatMod
+
+	// labels and offsets:
+	case CmdOffset:
+		arg.Kind, arg.Rel = FlatLabel, x0
+		switch x0 {
+		case RelB:
+			arg.setSigned(26, 2)
+		case RelBCond:
+			arg.setSigned(19, 2)
+		case RelAdr:
+			arg.setSigned(21, 0)
+		case RelAdrp:
+			arg.setSigned(21, 12)
+		case RelTbz:
+			arg.setSigned(14, 2)
+		}
+
+	// immediates:
+	case CmdUbits:
+		arg.Kind = FlatImm
+		arg.setRange(0, 1<<x1-1)
+	case CmdUscaled:
+		arg.Kind, arg.Scale = FlatImm, x2
+		arg.setRange(0, 1<<(x1+x2)-1)
+	case CmdUAlt2, CmdUAlt4:
+		arg.Kind = FlatImm
+		var alts []uint16
+		if cmd.Op == CmdUAlt2 {
+			alts = Alts2[x1][:]
+		} else {
+			alts = Alts4[x1][:]
+		}
+		for _, v := range alts {
+			if !containsAlt(arg.Alts, v) {
+				arg.Alts = append(arg.Alts, v)
+			}
+		}
+	case CmdUrange:
+		arg.Kind = FlatImm
+		arg.setRange(int64(x1), int64(x2))
+	case CmdUsub:
+		arg.Kind = FlatImm
+		arg.setRange(int64(x2)+1-1<<x1, int64(x2))
+	case CmdUnegmod:
+		arg.Kind = FlatImm
+		arg.setRange(0, 1<<x1-1)
+	case CmdUsumdec:
+		arg.Kind = FlatImm
+		arg.setRange(1, 1<<x1)
+	case CmdUsame:
+		arg.Kind, arg.Same = FlatImm, int8(cursor-int(x0))
+	case CmdUfields11, CmdUfields30:
+		arg.Kind = FlatImm
+		arg.setRange(0, 1<<x0-1)
+	case CmdUfields21:
+		arg.Kind = FlatImm
+		arg.setRange(0, 1)
+	case CmdSbits:
+		arg.Kind = FlatImm
+		arg.setSigned(9, 0)
+	case CmdSscaled:
+		arg.Kind, arg.Scale = FlatImm, x0
+		arg.setSigned(7, x0)
+	case CmdSfield:
+		arg.Kind = FlatImm
+		arg.setSigned(x1, 0)
+	case CmdSscaled9:
+		arg.Kind, arg.Scale = FlatImm, x0
+		arg.setSigned(9, x0)
+	case CmdChkUbits:
+		arg.Kind = FlatImm
+		arg.setRange(0, 1<<x0-1)
+	case CmdChkUsum:
+		arg.Kind = FlatImm
+		arg.setRange(1, 1<<x0)
+	case CmdChkSscaled:
+		arg.Kind, arg.Scale = FlatImm, 3
+		arg.setSigned(10, 3)
+	case CmdChkUrange1:
+		arg.Kind = FlatImm
+		arg.setRange(1, int64(x0))
+	case CmdChkSbits:
+		arg.Kind = FlatImm
+		arg.setSigned(x0, 0)
+	case CmdSpecial:
+		arg.Kind, arg.Special = FlatImm, x1
+		if x1 == SpecialImmTszRight64 {
+			arg.setRange(1, 64)
+		}
+	case CmdUslice, CmdSslice, CmdCond, CmdCondInv, CmdLitList:
+		arg.Kind = FlatImm
+	}
+}
+
+// setRange narrows the range of arg to [min, max].
+func (arg *FlatArgInfo) setRange(min, max int64) {
+	if min > arg.Min {
+		arg.Min = min
+	}
+	if max < arg.Max {
+		arg.Max = max
+	}
+}
+
+// setSigned narrows the range of arg to a signed immediate with bitlen bits, shifted left by scale bits.
+func (arg *FlatArgInfo) setSigned(bitlen, scale uint8) {
+	half := int64(1) << (bitlen - 1 + scale)
+	arg.setRange(-half, half-1)
+}
+
+func containsAlt(alts []uint16, v uint16) bool {
+	for _, alt := range alts {
+		if alt == v {
+			return true
+		}
+	}
+	return false
+}
@@ -1,12 +1,59 @@
 package arm
 
+import "strconv"
+
 const (
-	ErrInvalidInst     ErrorMessage = "invalid instruction id"
-	ErrNoMatch         ErrorMessage = "no matching encoding"
-	ErrInvalidEncoding ErrorMessage = "invalid instruction encoding"
+	ErrInvalidInst        ErrorMessage = "invalid instruction id"
+	ErrNoMatch            ErrorMessage = "no matching encoding"
+	ErrInvalidIdx         ErrorMessage = "invalid encoding index"
+	ErrInvalidFrame       ErrorMessage = "invalid stack frame"
+	ErrInvalidSignature   ErrorMessage = "invalid or unsupported call signature"
+	ErrInvalidMove        ErrorMessage = "invalid register moves"
+	ErrInvalidCFI         ErrorMessage = "invalid call frame information"
+	ErrInvalidEncoding    ErrorMessage = "invalid instruction encoding"
+	ErrUnsupportedFeature ErrorMessage = "unsupported CPU feature"
+	ErrInvalidFloatImm    ErrorMessage = "float immediate is not exactly representable with 8 bits"
 )
 
 // ErrorMessage is an error message type, returned when instruction matching or encoding fails.
 type ErrorMessage string
 
 func (err ErrorMessage) Error() string { return string(err) }
+
+// FeatureError is returned when the only matching encodings for an instruction require a feature
+// which is not available for the target [CPU]. FeatureError wraps [ErrUnsupportedFeature].
+type FeatureError struct {
+	Inst    Inst
+	Feature Feature
+	CPU     string
+}
+
+func (err *FeatureError) Error() string {
+	return err.Inst.String() + " requires " + err.Feature.String() + ", which is not available for " + err.CPU
+}
+
+func (err *FeatureError) Unwrap() error { return ErrUnsupportedFeature }
+
+// InstError is an error for a failed instruction, collected when the CollectErrors field of an [Assembler] is set
+// (see [Assembler.Errors]), or returned for a failed instruction when source positions are tracked. InstError wraps
+// the error for the instruction.
+type InstError struct {
+	PC   uint32    // code offset of the instruction
+	Inst Inst      // instruction mnemonic, or 0 for a label offset which could not be encoded
+	Pos  SourcePos // source position of the instruction, if tracked (see [Assembler.SourceAt])
+	Err  error
+}
+
+func (err *InstError) Error() string {
+	name := "label offset"
+	if err.Inst != 0 {
+		name = err.Inst.String()
+	}
+	msg := name + " at 0x" + strconv.FormatUint(uint64(err.PC), 16) + ": " + err.Err.Error()
+	if err.Pos != (SourcePos{}) {
+		return err.Pos.String() + ": " + msg
+	}
+	return msg
+}
+
+func (err *InstError) Unwrap() error { return err.Err }
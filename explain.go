@@ -0,0 +1,318 @@
+package arm
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// Explanation is a breakdown of an encoded instruction into the bit fields written by each encoding operator,
+// returned by [Explain], [ExplainOpcode], or [Assembler.Explain].
+type Explanation struct {
+	Inst   Inst
+	Idx    int8       // encoding index for Inst
+	Doc    string     // syntax and constraints of the encoding
+	Opcode uint32     // encoded instruction
+	Fields []BitField // bit fields, from the most significant bit
+}
+
+// BitField is a contiguous range of bits in an encoded instruction. Fixed opcode bits have an Arg of -1.
+// An encoding operator which writes non-contiguous bits (e.g. the split offset of ADR) has a field for each range.
+type BitField struct {
+	Hi, Lo uint8  // most and least significant bit of the field
+	Value  uint32 // value of the field, shifted right by Lo
+	Name   string // field name (Rd, Rn, imm12, ...), or "fixed" for fixed opcode bits
+	Arg    int8   // index of the flattened argument encoded by Cmd, or -1 for fixed opcode bits
+	Cmd    EncOp  // encoding operator which wrote the field
+}
+
+// Explain breaks down opcode as an instruction encoded with the encoding at index idx for inst (see [Encodings]),
+// returning false if idx is not an encoding index for inst or the fixed opcode bits of the encoding do not match.
+func Explain(inst Inst, idx int8, opcode uint32) (Explanation, bool) {
+	infos := Encodings(inst)
+	if idx < 0 || int(idx) >= len(infos) {
+		return Explanation{}, false
+	}
+	info := &infos[idx]
+	if !fixedMatch(info, opcode) {
+		return Explanation{}, false
+	}
+	return explain(inst, idx, info.Doc, opcode, info.Commands), true
+}
+
+// ExplainOpcode breaks down opcode for each encoding with matching fixed opcode bits, for instructions which were
+// not encoded by an Assembler. More than one encoding may match, e.g. for aliases.
+func ExplainOpcode(opcode uint32) []Explanation {
+	var matches []Explanation
+	for inst := Inst(1); int(inst) < len(PatternOffsets); inst++ {
+		for _, info := range Encodings(inst) {
+			if fixedMatch(&info, opcode) {
+				matches = append(matches, explain(inst, info.Idx, info.Doc, opcode, info.Commands))
+			}
+		}
+	}
+	return matches
+}
+
+// Explain breaks down the most recent instruction written to the code buffer by [Assembler.Inst], [Assembler.InstIdx],
+// or a typed emitter method, returning false if no instruction was written. Placeholders for failed instructions
+// and dry runs ([Assembler.Encode], [Assembler.Matches], ...) are not explained. Label offsets are included if
+// relocations have been applied.
+func (a *Assembler) Explain() (Explanation, bool) {
+	w := &a.written
+	if w.inst == 0 || w.idx < 0 || int(w.idx) >= int(Patterns[PatternOffsets[w.inst]]) || int(w.pc)+4 > int(a.PC) {
+		return Explanation{}, false
+	}
+	doc := EncodingDocs[int(EncodingDocOffsets[w.inst])+int(w.idx)]
+	return explain(w.inst, w.idx, doc, dec32(a.Code[w.pc:]), w.cmds[:w.cmdsLen]), true
+}
+
+// explain splits opcode into the fields written by cmds and the remaining fixed opcode bits.
+func explain(inst Inst, idx int8, doc string, opcode uint32, cmds []EncOp) Explanation {
+	e := Explanation{Inst: inst, Idx: idx, Doc: doc, Opcode: opcode}
+	var owners [32]int8 // command index + 1 for each bit, or 0 for fixed bits
+	var args [8]int8
+	cursor := int8(0)
+	for i, cmd := range cmds {
+		switch cmd.Op {
+		case CmdAdv:
+			cursor++
+			continue
+		case CmdBack:
+			cursor--
+			continue
+		case CmdRwidth30:
+			args[i] = -1
+		default:
+			args[i] = cursor
+		}
+		for mask := cmdMask(cmd); mask != 0; mask &= mask - 1 {
+			owners[bits.TrailingZeros32(mask)] = int8(i + 1)
+		}
+		switch cmd.Op {
+		default:
+			cursor++
+		case CmdRwidth30, CmdUslice, CmdSslice, CmdChkUbits, CmdChkUsum, CmdChkSscaled, CmdChkUrange1, CmdChkSbits:
+			// non-consuming
+		}
+	}
+	for hi := 31; hi >= 0; {
+		lo := hi
+		for lo > 0 && owners[lo-1] == owners[hi] {
+			lo--
+		}
+		field := BitField{Hi: uint8(hi), Lo: uint8(lo), Value: opcode >> lo & (1<<(hi-lo+1) - 1), Name: "fixed", Arg: -1}
+		if owner := owners[hi]; owner != 0 {
+			field.Cmd, field.Arg = cmds[owner-1], args[owner-1]
+			field.Name = fieldName(field.Cmd, bits.OnesCount32(cmdMask(field.Cmd)))
+		}
+		e.Fields = append(e.Fields, field)
+		hi = lo - 1
+	}
+	return e
+}
+
+// String renders the explanation as a bit diagram followed by a table of fields.
+func (e Explanation) String() string {
+	var b strings.Builder
+	b.WriteString(e.Inst.String() + " (encoding " + strconv.Itoa(int(e.Idx)) + "): " + strings.ReplaceAll(e.Doc, "\n", " / "))
+	b.WriteString("\n|")
+	for _, f := range e.Fields {
+		for i := int(f.Hi); i >= int(f.Lo); i-- {
+			b.WriteByte('0' + byte(e.Opcode>>i&1))
+		}
+		b.WriteByte('|')
+	}
+	b.WriteString(" = 0x" + hex32(e.Opcode) + "\n")
+	for _, f := range e.Fields {
+		bitRange := strconv.Itoa(int(f.Hi))
+		if f.Hi != f.Lo {
+			bitRange += ":" + strconv.Itoa(int(f.Lo))
+		}
+		b.WriteString(pad(bitRange, 7) + pad(f.Name, 8) + pad("0x"+strconv.FormatUint(uint64(f.Value), 16), 10))
+		if f.Arg >= 0 {
+			b.WriteString(pad("arg "+strconv.Itoa(int(f.Arg)), 8))
+		} else {
+			b.WriteString(pad("", 8))
+		}
+		if f.Cmd.Op != 0 {
+			b.WriteString(cmdString(f.Cmd))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// fixedMatch returns true if opcode has the fixed opcode bits of an encoding. Fixed bits may be set within an
+// operand field (e.g. the element size of an SVE logical immediate), in which case they are combined with the field.
+func fixedMatch(info *EncodingInfo, opcode uint32) bool {
+	mask := cmdsMask(info.Commands)
+	return opcode&info.Opcode == info.Opcode && opcode&^mask == info.Opcode&^mask
+}
+
+// cmdsMask returns the union of the bits written by cmds.
+func cmdsMask(cmds []EncOp) (mask uint32) {
+	for _, cmd := range cmds {
+		mask |= cmdMask(cmd)
+	}
+	return mask
+}
+
+// cmdMask returns the bits of an instruction which may be written by an encoding operator.
+func cmdMask(cmd EncOp) uint32 {
+	x0, x1, x2 := cmd.X[0], cmd.X[1], cmd.X[2]
+	bitsAt := func(offset, bitlen uint8) uint32 { return (1<<bitlen - 1) << offset }
+	switch cmd.Op {
+	case CmdR0:
+		return bitsAt(0, 5)
+	case CmdR5:
+		return bitsAt(5, 5)
+	case CmdR10:
+		return bitsAt(10, 5)
+	case CmdR16, CmdRNz16:
+		return bitsAt(16, 5)
+	case CmdRLo16:
+		return bitsAt(16, 4)
+	case CmdREven:
+		return bitsAt(x0, 5)
+	case CmdRLo8:
+		return bitsAt(x0, 3)
+	case CmdRbits:
+		return bitsAt(x0, x1)
+	case CmdRW12:
+		return bitsAt(x0, 2)
+	case CmdRwidth30:
+		return 1 << 30
+	case CmdUbits, CmdUscaled, CmdUsub, CmdUnegmod, CmdUsumdec, CmdSfield, CmdUslice, CmdSslice:
+		return bitsAt(x0, x1)
+	case CmdUAlt2:
+		return bitsAt(x0, 1)
+	case CmdUAlt4:
+		return bitsAt(x0, 2)
+	case CmdUrange:
+		return bitsAt(x0, uint8(bits.Len8(x2-x1)))
+	case CmdUfields11:
+		return fieldsMask([]uint8{20, 21, 11}[3-x0:])
+	case CmdUfields30:
+		return fieldsMask([]uint8{10, 11, 12, 30}[4-x0:])
+	case CmdUfields21:
+		return 1 << 21
+	case CmdSbits, CmdSscaled9:
+		return bitsAt(12, 9)
+	case CmdSscaled:
+		return bitsAt(15, 7)
+	case CmdSpecial:
+		switch x1 {
+		case SpecialImmWideInv32, SpecialImmWideInv64, SpecialImmWide32, SpecialImmWide64:
+			return bitsAt(x0, 18)
+		case SpecialImmStretched, SpecialImmFloatSplit:
+			return bitsAt(x0, 5) | bitsAt(x0+11, 3)
+		case SpecialImmLogical32, SpecialImmLogical64:
+			return bitsAt(x0, 13)
+		case SpecialImmFloat:
+			return bitsAt(x0, 8)
+		case SpecialImmTszRight64:
+			return bitsAt(x0, 5) | bitsAt(22, 2)
+		}
+	case CmdRotates:
+		return bitsAt(22, 2)
+	case CmdExtendsW, CmdExtendsX:
+		return bitsAt(13, 3)
+	case CmdXs:
+		return bitsAt(x0, 1)
+	case CmdCond, CmdCondInv:
+		return bitsAt(x0, 4)
+	case CmdLitList:
+		return bitsAt(x0, symListWidth(x1))
+	case CmdOffset:
+		switch x0 {
+		case RelB:
+			return bitsAt(0, 26)
+		case RelBCond:
+			return bitsAt(5, 19)
+		case RelAdr, RelAdrp:
+			return bitsAt(5, 19) | bitsAt(29, 2)
+		case RelTbz:
+			return bitsAt(5, 14)
+		}
+	}
+	return 0
+}
+
+func fieldsMask(fields []uint8) (mask uint32) {
+	for _, b := range fields {
+		mask |= 1 << b
+	}
+	return mask
+}
+
+// fieldName returns a conventional name for the field written by cmd, with n bits in total.
+func fieldName(cmd EncOp, n int) string {
+	switch cmd.Op {
+	case CmdR0, CmdR5, CmdR10, CmdR16, CmdRNz16, CmdRLo16, CmdREven, CmdRLo8, CmdRbits, CmdRW12:
+		offset := cmd.X[0]
+		switch cmd.Op {
+		case CmdR0:
+			offset = 0
+		case CmdR5:
+			offset = 5
+		case CmdR10:
+			offset = 10
+		case CmdR16, CmdRNz16, CmdRLo16:
+			offset = 16
+		}
+		switch offset {
+		case 0:
+			return "Rd"
+		case 5:
+			return "Rn"
+		case 10:
+			return "Ra"
+		case 16:
+			return "Rm"
+		}
+		return "R"
+	case CmdRwidth30:
+		return "Q"
+	case CmdRotates:
+		return "shift"
+	case CmdExtendsW, CmdExtendsX, CmdXs:
+		return "option"
+	case CmdCond, CmdCondInv:
+		return "cond"
+	case CmdLitList:
+		return "op"
+	case CmdOffset:
+		return "offset"
+	}
+	return "imm" + strconv.Itoa(n)
+}
+
+// cmdString formats an encoding operator with its operands, e.g. Ubits(10, 12).
+func cmdString(cmd EncOp) string {
+	name := strings.TrimPrefix(CmdName[cmd.Op], "Cmd")
+	n := CmdArgCounts[cmd.Op]
+	if n == 0 {
+		return name
+	}
+	name += "("
+	for i := uint8(0); i < n; i++ {
+		if i != 0 {
+			name += ", "
+		}
+		name += strconv.Itoa(int(cmd.X[i]))
+	}
+	return name + ")"
+}
+
+func hex32(v uint32) string {
+	s := strconv.FormatUint(uint64(v), 16)
+	return strings.Repeat("0", 8-len(s)) + strings.ToUpper(s)
+}
+
+func pad(s string, n int) string {
+	if len(s) >= n {
+		return s + " "
+	}
+	return s + strings.Repeat(" ", n-len(s))
+}
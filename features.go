@@ -0,0 +1,292 @@
+package arm
+
+import "strings"
+
+// Feature is an optional architecture feature (FEAT_*) required by an instruction encoding.
+// The zero value indicates that no optional feature is required.
+type Feature uint8
+
+// Architecture features
+const (
+	_ Feature = iota
+
+	FeatCRC32     // CRC32 instructions
+	FeatLSE       // large system extension atomics (CAS, LDADD, SWP, ...)
+	FeatRDM       // rounding double multiply accumulate (SQRDMLAH, SQRDMLSH)
+	FeatLOR       // limited ordering regions (LDLAR, STLLR)
+	FeatFP16      // half-precision floating-point data processing
+	FeatFHM       // half-precision floating-point multiply-add long (FMLAL, FMLSL)
+	FeatDotProd   // integer dot product (SDOT, UDOT)
+	FeatAES       // AES instructions and 64-bit polynomial multiply long (PMULL)
+	FeatSHA1      // SHA1 instructions
+	FeatSHA256    // SHA256 instructions
+	FeatSHA512    // SHA512 instructions
+	FeatSHA3      // SHA3 instructions (BCAX, EOR3, RAX1, XAR)
+	FeatSM3       // SM3 instructions
+	FeatSM4       // SM4 instructions
+	FeatPAuth     // pointer authentication, except for instructions in the hint space
+	FeatJSCVT     // JavaScript conversion (FJCVTZS)
+	FeatFCMA      // floating-point complex number arithmetic (FCADD, FCMLA)
+	FeatLRCPC     // load-acquire RCpc (LDAPR)
+	FeatLRCPC2    // load-acquire RCpc with immediate offsets (LDAPUR, STLUR)
+	FeatFlagM     // condition flag manipulation (CFINV, RMIF, SETF8, SETF16)
+	FeatFlagM2    // condition flag format conversion (AXFLAG, XAFLAG)
+	FeatFRINTTS   // floating-point round to 32/64-bit integer (FRINT32X, ...)
+	FeatSB        // speculation barrier (SB)
+	FeatSPECRES   // speculation restriction (CFP, CPP, DVP)
+	FeatBF16      // BFloat16 instructions
+	FeatI8MM      // 8-bit integer matrix multiply
+	FeatMTE       // memory tagging
+	FeatWFxT      // wait for event/interrupt with timeout (WFET, WFIT)
+	FeatLS64      // single-copy atomic 64-byte loads and stores
+	FeatMOPS      // memory copy and set
+	FeatCSSC      // common short sequence compression (scalar ABS, CNT, CTZ, SMAX, ...)
+	FeatSVE       // scalable vector extension
+	FeatSVE2      // scalable vector extension, version 2
+	FeatSME       // scalable matrix extension
+	FeatSMEF64F64 // SME double-precision outer products
+	FeatSMEI16I64 // SME 16-bit to 64-bit integer outer products and tile accumulation
+	featCount
+)
+
+var FeatureName = [...]string{
+	FeatCRC32:     "FEAT_CRC32",
+	FeatLSE:       "FEAT_LSE",
+	FeatRDM:       "FEAT_RDM",
+	FeatLOR:       "FEAT_LOR",
+	FeatFP16:      "FEAT_FP16",
+	FeatFHM:       "FEAT_FHM",
+	FeatDotProd:   "FEAT_DotProd",
+	FeatAES:       "FEAT_AES",
+	FeatSHA1:      "FEAT_SHA1",
+	FeatSHA256:    "FEAT_SHA256",
+	FeatSHA512:    "FEAT_SHA512",
+	FeatSHA3:      "FEAT_SHA3",
+	FeatSM3:       "FEAT_SM3",
+	FeatSM4:       "FEAT_SM4",
+	FeatPAuth:     "FEAT_PAuth",
+	FeatJSCVT:     "FEAT_JSCVT",
+	FeatFCMA:      "FEAT_FCMA",
+	FeatLRCPC:     "FEAT_LRCPC",
+	FeatLRCPC2:    "FEAT_LRCPC2",
+	FeatFlagM:     "FEAT_FlagM",
+	FeatFlagM2:    "FEAT_FlagM2",
+	FeatFRINTTS:   "FEAT_FRINTTS",
+	FeatSB:        "FEAT_SB",
+	FeatSPECRES:   "FEAT_SPECRES",
+	FeatBF16:      "FEAT_BF16",
+	FeatI8MM:      "FEAT_I8MM",
+	FeatMTE:       "FEAT_MTE",
+	FeatWFxT:      "FEAT_WFxT",
+	FeatLS64:      "FEAT_LS64",
+	FeatMOPS:      "FEAT_MOPS",
+	FeatCSSC:      "FEAT_CSSC",
+	FeatSVE:       "FEAT_SVE",
+	FeatSVE2:      "FEAT_SVE2",
+	FeatSME:       "FEAT_SME",
+	FeatSMEF64F64: "FEAT_SME_F64F64",
+	FeatSMEI16I64: "FEAT_SME_I16I64",
+}
+
+// String returns the architecture name for f (e.g. FEAT_LSE).
+func (f Feature) String() string {
+	if f == 0 || f >= featCount {
+		return ""
+	}
+	return FeatureName[f]
+}
+
+// Features is a set of architecture features.
+type Features uint64
+
+// Has returns true if f is in fs. All sets include the zero Feature.
+func (fs Features) Has(f Feature) bool { return fs&(1<<f) != 0 || f == 0 }
+
+// With returns the union of fs and the given features.
+func (fs Features) With(f ...Feature) Features {
+	for _, x := range f {
+		fs |= 1 << x
+	}
+	return fs
+}
+
+// Without returns fs with the given features removed.
+func (fs Features) Without(f ...Feature) Features {
+	for _, x := range f {
+		fs &^= 1 << x
+	}
+	return fs
+}
+
+// String returns a comma-separated list of the features in fs.
+func (fs Features) String() string {
+	var b strings.Builder
+	for f := Feature(1); f < featCount; f++ {
+		if fs.Has(f) {
+			if b.Len() != 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(FeatureName[f])
+		}
+	}
+	return b.String()
+}
+
+// Architecture profiles, including the features which are mandatory at each version.
+// Instructions in the hint space (BTI, PACIASP, AUTIASP, ...) execute as NOP when unimplemented,
+// and are available for all profiles.
+const (
+	ARMv8_0A Features = 0
+	ARMv8_1A          = ARMv8_0A | 1<<FeatCRC32 | 1<<FeatLSE | 1<<FeatRDM | 1<<FeatLOR
+	ARMv8_2A          = ARMv8_1A
+	ARMv8_3A          = ARMv8_2A | 1<<FeatPAuth | 1<<FeatJSCVT | 1<<FeatFCMA | 1<<FeatLRCPC
+	ARMv8_4A          = ARMv8_3A | 1<<FeatDotProd | 1<<FeatFlagM | 1<<FeatLRCPC2
+	ARMv8_5A          = ARMv8_4A | 1<<FeatFlagM2 | 1<<FeatFRINTTS | 1<<FeatSB | 1<<FeatSPECRES
+	ARMv8_6A          = ARMv8_5A | 1<<FeatBF16 | 1<<FeatI8MM
+	ARMv8_7A          = ARMv8_6A | 1<<FeatWFxT
+	ARMv8_8A          = ARMv8_7A | 1<<FeatMOPS
+	ARMv8_9A          = ARMv8_8A | 1<<FeatCSSC
+	ARMv9_0A          = ARMv8_5A | 1<<FeatFP16 | 1<<FeatSVE | 1<<FeatSVE2
+	ARMv9_1A          = ARMv9_0A | ARMv8_6A
+	ARMv9_2A          = ARMv9_1A | ARMv8_7A
+	ARMv9_3A          = ARMv9_2A | ARMv8_8A
+	ARMv9_4A          = ARMv9_3A | ARMv8_9A
+)
+
+const featCrypto = 1<<FeatAES | 1<<FeatSHA1 | 1<<FeatSHA256
+
+// CPU is a named set of architecture features available for a target. An [Assembler] configured with
+// a CPU rejects instructions which require features outside of the set.
+type CPU struct {
+	Name     string
+	Features Features
+}
+
+// CPUs contains named architecture and processor profiles, which may be extended with features
+// through [LookupCPU].
+var CPUs = map[string]Features{
+	"armv8-a":   ARMv8_0A,
+	"armv8.0-a": ARMv8_0A,
+	"armv8.1-a": ARMv8_1A,
+	"armv8.2-a": ARMv8_2A,
+	"armv8.3-a": ARMv8_3A,
+	"armv8.4-a": ARMv8_4A,
+	"armv8.5-a": ARMv8_5A,
+	"armv8.6-a": ARMv8_6A,
+	"armv8.7-a": ARMv8_7A,
+	"armv8.8-a": ARMv8_8A,
+	"armv8.9-a": ARMv8_9A,
+	"armv9-a":   ARMv9_0A,
+	"armv9.0-a": ARMv9_0A,
+	"armv9.1-a": ARMv9_1A,
+	"armv9.2-a": ARMv9_2A,
+	"armv9.3-a": ARMv9_3A,
+	"armv9.4-a": ARMv9_4A,
+
+	"cortex-a53":  ARMv8_0A | 1<<FeatCRC32 | featCrypto,
+	"cortex-a72":  ARMv8_0A | 1<<FeatCRC32 | featCrypto,
+	"cortex-a76":  ARMv8_2A | 1<<FeatFP16 | 1<<FeatDotProd | 1<<FeatLRCPC | featCrypto,
+	"neoverse-n1": ARMv8_2A | 1<<FeatFP16 | 1<<FeatDotProd | 1<<FeatLRCPC | featCrypto,
+	"neoverse-v1": ARMv8_4A | 1<<FeatFP16 | 1<<FeatFHM | 1<<FeatBF16 | 1<<FeatI8MM | 1<<FeatSVE | featCrypto,
+	"neoverse-n2": ARMv9_0A | 1<<FeatFHM | 1<<FeatBF16 | 1<<FeatI8MM | 1<<FeatMTE,
+	"neoverse-v2": ARMv9_0A | 1<<FeatFHM | 1<<FeatBF16 | 1<<FeatI8MM | 1<<FeatMTE,
+	"apple-m1":    ARMv8_4A | 1<<FeatFP16 | 1<<FeatFHM | 1<<FeatSHA3 | 1<<FeatSHA512 | 1<<FeatFlagM2 | 1<<FeatFRINTTS | 1<<FeatSB | 1<<FeatSPECRES | featCrypto,
+	"apple-m2":    ARMv8_6A | 1<<FeatFP16 | 1<<FeatFHM | 1<<FeatSHA3 | 1<<FeatSHA512 | featCrypto,
+}
+
+// FeatureFlags maps feature names accepted by [LookupCPU] to sets of features.
+var FeatureFlags = map[string]Features{
+	"crc":        1 << FeatCRC32,
+	"lse":        1 << FeatLSE,
+	"rdm":        1 << FeatRDM,
+	"lor":        1 << FeatLOR,
+	"fp16":       1 << FeatFP16,
+	"fp16fml":    1<<FeatFP16 | 1<<FeatFHM,
+	"dotprod":    1 << FeatDotProd,
+	"aes":        1 << FeatAES,
+	"sha2":       1<<FeatSHA1 | 1<<FeatSHA256,
+	"sha3":       1<<FeatSHA1 | 1<<FeatSHA256 | 1<<FeatSHA512 | 1<<FeatSHA3,
+	"sm4":        1<<FeatSM3 | 1<<FeatSM4,
+	"crypto":     featCrypto,
+	"pauth":      1 << FeatPAuth,
+	"rcpc":       1 << FeatLRCPC,
+	"rcpc2":      1<<FeatLRCPC | 1<<FeatLRCPC2,
+	"flagm":      1 << FeatFlagM,
+	"flagm2":     1<<FeatFlagM | 1<<FeatFlagM2,
+	"frintts":    1 << FeatFRINTTS,
+	"sb":         1 << FeatSB,
+	"predres":    1 << FeatSPECRES,
+	"bf16":       1 << FeatBF16,
+	"i8mm":       1 << FeatI8MM,
+	"memtag":     1 << FeatMTE,
+	"wfxt":       1 << FeatWFxT,
+	"ls64":       1 << FeatLS64,
+	"mops":       1 << FeatMOPS,
+	"cssc":       1 << FeatCSSC,
+	"sve":        1<<FeatFP16 | 1<<FeatSVE,
+	"sve2":       1<<FeatFP16 | 1<<FeatSVE | 1<<FeatSVE2,
+	"sme":        1<<FeatFP16 | 1<<FeatBF16 | 1<<FeatSME,
+	"sme-f64f64": 1<<FeatFP16 | 1<<FeatBF16 | 1<<FeatSME | 1<<FeatSMEF64F64,
+	"sme-i16i64": 1<<FeatFP16 | 1<<FeatBF16 | 1<<FeatSME | 1<<FeatSMEI16I64,
+}
+
+// featureRequires lists the features which each feature depends on. Removing a feature with [LookupCPU] also
+// removes the features which depend on it, but not the features it depends on.
+var featureRequires = [featCount]Features{
+	FeatFHM:       1 << FeatFP16,
+	FeatSHA512:    1<<FeatSHA1 | 1<<FeatSHA256,
+	FeatSHA3:      1<<FeatSHA1 | 1<<FeatSHA256,
+	FeatLRCPC2:    1 << FeatLRCPC,
+	FeatFlagM2:    1 << FeatFlagM,
+	FeatSVE:       1 << FeatFP16,
+	FeatSVE2:      1<<FeatFP16 | 1<<FeatSVE,
+	FeatSME:       1<<FeatFP16 | 1<<FeatBF16,
+	FeatSMEF64F64: 1<<FeatFP16 | 1<<FeatBF16 | 1<<FeatSME,
+	FeatSMEI16I64: 1<<FeatFP16 | 1<<FeatBF16 | 1<<FeatSME,
+}
+
+// withoutFlags returns fs without the features named by flags (excluding their prerequisites), and without any
+// features which depend on a removed feature.
+func (fs Features) withoutFlags(flags Features) Features {
+	var prereqs Features
+	for f := Feature(1); f < featCount; f++ {
+		if flags.Has(f) {
+			prereqs |= featureRequires[f]
+		}
+	}
+	removed := flags &^ prereqs
+	for changed := true; changed; {
+		changed = false
+		for f := Feature(1); f < featCount; f++ {
+			if removed&(1<<f) == 0 && featureRequires[f]&removed != 0 {
+				removed |= 1 << f
+				changed = true
+			}
+		}
+	}
+	return fs &^ removed
+}
+
+// LookupCPU returns the CPU for an architecture or processor name from [CPUs], optionally followed by
+// a list of feature names from [FeatureFlags] to add (+name) or remove (+noname), e.g. armv8.2-a+dotprod
+// or neoverse-n1+nolse. Removing a feature also removes the features which depend on it (e.g. +nosve removes
+// SVE2), but not the features it depends on (e.g. +nosve2 keeps SVE and FP16).
+func LookupCPU(name string) (CPU, error) {
+	base, exts, _ := strings.Cut(name, "+")
+	fs, ok := CPUs[base]
+	if !ok {
+		return CPU{}, ErrorMessage("unknown CPU: " + base)
+	}
+	for exts != "" {
+		var ext string
+		ext, exts, _ = strings.Cut(exts, "+")
+		if flags, ok := FeatureFlags[ext]; ok {
+			fs |= flags
+		} else if flags, ok := FeatureFlags[strings.TrimPrefix(ext, "no")]; ok && strings.HasPrefix(ext, "no") {
+			fs = fs.withoutFlags(flags)
+		} else {
+			return CPU{}, ErrorMessage("unknown CPU feature: " + ext)
+		}
+	}
+	return CPU{Name: name, Features: fs}, nil
+}
@@ -0,0 +1,189 @@
+package arm
+
+import "sort"
+
+// Frame describes the stack frame of a function following the AAPCS64 procedure call standard, for
+// [Frame.Layout]. The frame record (X29 and X30) and callee-saved registers are stored at the top of the frame,
+// followed by locals, then the outgoing stack argument area at SP:
+//
+//	       +------------------------+ <- SP at entry
+//	       | callee-saved registers |
+//	X29 -> | X29, X30 (frame record)|
+//	       | locals                 |
+//	       | outgoing arguments     |
+//	SP  -> +------------------------+
+type Frame struct {
+	Saved    []Reg   // callee-saved registers used by the function (X19-X28 and D8-D15)
+	Locals   []Local // local variables, allocated downward from the frame record
+	Outgoing uint32  // size of the outgoing stack argument area at SP
+}
+
+// Local is a local variable in a [Frame].
+type Local struct {
+	Size  uint32
+	Align uint32 // power of 2, at most 16; 0 for an alignment of 1
+}
+
+// FrameLayout is the layout of a [Frame], returned by [Frame.Layout] and written by [Assembler.Prologue] and
+// [Assembler.Epilogue]. SP is 16-byte aligned within the prologue and epilogue, and at every instruction between.
+type FrameLayout struct {
+	Size     uint32   // size of the frame, a multiple of 16
+	SaveSize uint32   // size of the frame record and callee-saved registers, a multiple of 16
+	FP       uint32   // offset of the frame record (X29) from SP after the prologue
+	Saved    []uint32 // offset of each callee-saved register from SP after the prologue, in the order of Frame.Saved
+	Locals   []uint32 // offset of each local from SP after the prologue (the offset from X29 is Locals[i] - FP)
+
+	saves []frameSave // callee-saved registers ordered by offset
+}
+
+// frameSave is a callee-saved register with its offset from the frame record.
+type frameSave struct {
+	reg    Reg
+	offset uint32
+}
+
+// Layout returns the layout of the frame, or [ErrInvalidFrame] if a saved register is not callee-saved or is
+// listed twice, a local has an invalid alignment, or the frame is larger than 16 MB.
+func (f Frame) Layout() (FrameLayout, error) {
+	var l FrameLayout
+	var seen [2]uint32
+	for _, r := range f.Saved {
+		var kind int
+		switch {
+		case r.Type == RX && r.ID >= 19 && r.ID <= 28:
+		case r.Type == RD && r.ID >= 8 && r.ID <= 15:
+			kind = 1
+		default:
+			return l, ErrInvalidFrame
+		}
+		if seen[kind]&(1<<r.ID) != 0 {
+			return l, ErrInvalidFrame
+		}
+		seen[kind] |= 1 << r.ID
+		l.saves = append(l.saves, frameSave{reg: r})
+	}
+	sort.Slice(l.saves, func(i, j int) bool {
+		ri, rj := l.saves[i].reg, l.saves[j].reg
+		return ri.Type == RX && rj.Type != RX || ri.Type == rj.Type && ri.ID < rj.ID
+	})
+	for i := range l.saves {
+		l.saves[i].offset = 16 + 8*uint32(i)
+	}
+	l.SaveSize = align16(16 + 8*uint32(len(l.saves)))
+
+	var localsSize uint32
+	offsets := make([]uint32, len(f.Locals)) // offset below the frame record
+	for i, local := range f.Locals {
+		align := local.Align
+		if align == 0 {
+			align = 1
+		}
+		if align > 16 || align&(align-1) != 0 || local.Size > maxFrameSize {
+			return l, ErrInvalidFrame
+		}
+		localsSize = (localsSize + local.Size + align - 1) &^ (align - 1)
+		offsets[i] = localsSize
+	}
+	if localsSize > maxFrameSize || f.Outgoing > maxFrameSize {
+		return l, ErrInvalidFrame
+	}
+	l.FP = align16(localsSize + f.Outgoing)
+	l.Size = l.FP + l.SaveSize
+	if l.Size > maxFrameSize {
+		return l, ErrInvalidFrame
+	}
+	l.Locals = make([]uint32, len(f.Locals))
+	for i, offset := range offsets {
+		l.Locals[i] = l.FP - offset
+	}
+	l.Saved = make([]uint32, len(f.Saved))
+	for i, r := range f.Saved {
+		for _, save := range l.saves {
+			if save.reg == r {
+				l.Saved[i] = l.FP + save.offset
+			}
+		}
+	}
+	return l, nil
+}
+
+// maxFrameSize is the maximum size of a frame, allocated with a pair of 12-bit immediates.
+const maxFrameSize = 1<<24 - 16
+
+func align16(n uint32) uint32 { return (n + 15) &^ 15 }
+
+// Prologue writes the function prologue for a frame layout: the frame record and callee-saved registers are
+// stored with a pre-indexed STP followed by STP/STR pairs, X29 is set to the frame record, and SP is decremented
+// for locals and outgoing arguments. Within a procedure (see [Assembler.CFIStartProc]), the CFA and saved
+// registers are recorded for unwind info, with the CFA defined relative to X29.
+func (a *Assembler) Prologue(l FrameLayout) bool {
+	if a.setCallerPos(1) {
+		defer a.SetSourcePos(SourcePos{})
+	}
+	size := int32(l.SaveSize)
+	ok := a.Inst(STP, X(29), X(30), RefPreIndexed{XSP, -size})
+	a.cfiFrame(
+		CFIInst{Op: CFIDefCFAOffset, Offset: size},
+		CFIInst{Op: CFIOffset, Reg: X(29), Offset: -size},
+		CFIInst{Op: CFIOffset, Reg: X(30), Offset: 8 - size},
+	)
+	ok = a.frameSaves(STP, STR, l.saves) && ok
+	for _, save := range l.saves {
+		a.cfiFrame(CFIInst{Op: CFIOffset, Reg: save.reg, Offset: int32(save.offset) - size})
+	}
+	ok = a.Inst(ADD, X(29), XSP, Imm(0)) && ok
+	a.cfiFrame(CFIInst{Op: CFIDefCFARegister, Reg: X(29)})
+	if locals := l.FP; locals != 0 {
+		if hi := locals >> 12; hi != 0 {
+			ok = a.Inst(SUB, XSP, XSP, Imm(hi), ModLSL.Imm(12)) && ok
+		}
+		if lo := locals & 0xFFF; lo != 0 {
+			ok = a.Inst(SUB, XSP, XSP, Imm(lo)) && ok
+		}
+	}
+	return ok
+}
+
+// Epilogue writes the function epilogue for a frame layout, followed by a RET: SP is restored from X29, then the
+// callee-saved registers and frame record are loaded with LDP/LDR pairs and a post-indexed LDP. Within a procedure,
+// the unwind rules are remembered before the epilogue and restored after the RET, for code which follows it.
+func (a *Assembler) Epilogue(l FrameLayout) bool {
+	if a.setCallerPos(1) {
+		defer a.SetSourcePos(SourcePos{})
+	}
+	ok := true
+	a.cfiFrame(CFIInst{Op: CFIRememberState})
+	if l.FP != 0 {
+		ok = a.Inst(ADD, XSP, X(29), Imm(0))
+	}
+	a.cfiFrame(CFIInst{Op: CFIDefCFA, Reg: XSP, Offset: int32(l.SaveSize)})
+	ok = a.frameSaves(LDP, LDR, l.saves) && ok
+	for _, save := range l.saves {
+		a.cfiFrame(CFIInst{Op: CFIRestore, Reg: save.reg})
+	}
+	ok = a.Inst(LDP, X(29), X(30), Ref{XSP}, Imm(l.SaveSize)) && ok
+	a.cfiFrame(
+		CFIInst{Op: CFIDefCFAOffset},
+		CFIInst{Op: CFIRestore, Reg: X(29)},
+		CFIInst{Op: CFIRestore, Reg: X(30)},
+	)
+	ok = a.Inst(RET) && ok
+	a.cfiFrame(CFIInst{Op: CFIRestoreState})
+	return ok
+}
+
+// frameSaves writes a pair instruction for each pair of adjacent registers of the same type in saves, or a
+// single instruction for each register without a pair, with offsets from the frame record at SP.
+func (a *Assembler) frameSaves(pair, single Inst, saves []frameSave) bool {
+	ok := true
+	for i := 0; i < len(saves); i++ {
+		save := saves[i]
+		if i+1 < len(saves) && saves[i+1].reg.Type == save.reg.Type {
+			ok = a.Inst(pair, save.reg, saves[i+1].reg, RefOffset{XSP, int32(save.offset)}) && ok
+			i++
+			continue
+		}
+		ok = a.Inst(single, save.reg, RefOffset{XSP, int32(save.offset)}) && ok
+	}
+	return ok
+}
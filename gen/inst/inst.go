@@ -62,6 +62,9 @@ func main() {
 			// Formatted big-endian to match encoding diagrams:
 			fmt.Fprintf(out, "\t0b%08b, 0b%08b, 0b%08b, 0b%08b,", byte(enc.Op>>24), byte(enc.Op>>16), byte(enc.Op>>8), byte(enc.Op))
 			encOffset += 4
+			if len(enc.Cmds) > 8 { // see Assembler.cmds
+				panic(fmt.Sprintf("too many commands for %s: %d", name, len(enc.Cmds)))
+			}
 			fmt.Fprintf(out, " %d,", len(enc.Cmds))
 			encOffset++
 			for _, c := range enc.Cmds {
@@ -111,14 +114,18 @@ func main() {
 	encOffset = 1
 	var encIdx int
 	patternOffsets := make([]uint32, len(names))
+	instFeatures := make([]arm.Features, len(names))
 	out.WriteString("// Code generated by gen/inst/inst.go; DO NOT EDIT.\n\n")
 	out.WriteString("package arm\n\n")
 
 	out.WriteString("// The Patterns table contains matching info for all instruction encodings.\n")
 	out.WriteString("// Each instruction's list of encodings is prefixed with the encoding count,\n")
 	out.WriteString("// followed by a length-prefixed list of matching operators and commands offset\n")
-	out.WriteString("// for each encoding; the commands offset is a 16-bit index into the Commands table.\n")
+	out.WriteString("// for each encoding; the commands offset is a 24-bit big-endian index into the\n")
+	out.WriteString("// Commands table, followed by the Feature required by the encoding (or 0) and an extra\n")
+	out.WriteString("// Feature required in addition to the first (or 0).\n")
 	out.WriteString("// Matching operators apply to unflattened Arg types.\n")
+	out.WriteString("// The table is indexed through the PatternOffsets table.\n")
 	out.WriteString("var Patterns = [...]byte{\n\t0,\n")
 	for i, name := range names {
 		patternOffsets[i] = encOffset
@@ -135,6 +142,9 @@ func main() {
 					break
 				}
 			}
+			if len(enc.Match) > 6 { // see Assembler.pattern
+				panic(fmt.Sprintf("too many matchers for %s: %d", name, len(enc.Match)))
+			}
 			fmt.Fprintf(out, "\t%d,", len(enc.Match))
 			encOffset++
 			for _, m := range enc.Match {
@@ -148,13 +158,15 @@ func main() {
 						fmt.Fprintf(out, " %s,", arm.ModListName[x])
 					case arm.MatLitMod:
 						fmt.Fprintf(out, " %s,", arm.ModName[x])
-					case arm.MatV, arm.MatVStatic, arm.MatVElement, arm.MatVElementStatic, arm.MatVStaticElement:
-						if i == 0 {
+					case arm.MatV, arm.MatVStatic, arm.MatVElement, arm.MatVElementStatic, arm.MatVStaticElement,
+						arm.MatZ, arm.MatZElement, arm.MatP, arm.MatRefIndexZ, arm.MatRefZ,
+						arm.MatZATile, arm.MatZASlice:
+						if i == 0 && x != 0 {
 							fmt.Fprintf(out, " byte(%s),", arm.SizeName[x])
 						} else {
 							fmt.Fprintf(out, " %d,", x)
 						}
-					case arm.MatRegList, arm.MatRegListStatic, arm.MatRegListElement:
+					case arm.MatRegList, arm.MatRegListStatic, arm.MatRegListElement, arm.MatZList:
 						if i == 1 {
 							fmt.Fprintf(out, " byte(%s),", arm.SizeName[x])
 						} else {
@@ -167,13 +179,51 @@ func main() {
 				}
 			}
 			cmd := cmdOffsets[encIdx]
-			fmt.Fprintf(out, " 0x%x, 0x%x,\n", uint8(cmd>>8), uint8(cmd))
+			if cmd >= 1<<24 {
+				panic(fmt.Sprintf("commands offset overflow for %s: %d", name, cmd))
+			}
+			fmt.Fprintf(out, " 0x%x, 0x%x, 0x%x,", uint8(cmd>>16), uint8(cmd>>8), uint8(cmd))
+			encOffset += 3
+			for _, f := range [...]arm.Feature{opmap.Feature(name, enc), opmap.ExtraFeature(name, enc)} {
+				if f != 0 {
+					fmt.Fprintf(out, " byte(%s),", featureConst(f))
+					instFeatures[i] = instFeatures[i].With(f)
+				} else {
+					out.WriteString(" 0,")
+				}
+			}
+			out.WriteByte('\n')
 			encOffset += 2
 			encIdx++
 		}
 
 		out.WriteByte('\n')
 	}
+	out.WriteString("}\n\n")
+
+	out.WriteString("// The PatternOffsets table maps each Inst to the start of its encodings in the Patterns table.\n")
+	out.WriteString("var PatternOffsets = [...]uint32{\n\t0,\n")
+	for i, name := range names {
+		fmt.Fprintf(out, "\t%d, // %s\n", patternOffsets[i], strings.ToUpper(name))
+	}
+	out.WriteString("}\n\n")
+
+	out.WriteString("// The InstFeatures table contains the union of features required by any encoding of each Inst.\n")
+	out.WriteString("var InstFeatures = [...]Features{\n\t0,\n")
+	for i, name := range names {
+		out.WriteByte('\t')
+		if instFeatures[i] == 0 {
+			out.WriteByte('0')
+		}
+		sep := ""
+		for f := arm.Feature(1); int(f) < len(arm.FeatureName); f++ {
+			if instFeatures[i].Has(f) {
+				fmt.Fprintf(out, "%s1<<%s", sep, featureConst(f))
+				sep = " | "
+			}
+		}
+		fmt.Fprintf(out, ", // %s\n", strings.ToUpper(name))
+	}
 	out.WriteString("}\n")
 
 	formatted, err = format.Source([]byte(out.String()))
@@ -193,15 +243,25 @@ func main() {
 
 	out.WriteString("package arm\n\n")
 
-	out.WriteString("// Inst is an instruction mnemonic. The integer value is an offset into the Patterns array.\n")
+	out.WriteString("// Inst is an instruction mnemonic. The integer value is an index into the PatternOffsets array.\n")
 	out.WriteString("type Inst uint16\n\n")
 
+	if len(names) >= 1<<16 {
+		panic(fmt.Sprintf("too many instructions: %d", len(names)))
+	}
 	out.WriteString("// Instructions\n")
 	out.WriteString("const (\n")
 	for i, name := range names {
-		fmt.Fprintf(out, "\t%s Inst = %d\n", strings.ToUpper(name), patternOffsets[i])
+		fmt.Fprintf(out, "\t%s Inst = %d\n", strings.ToUpper(name), i+1)
 	}
-	out.WriteString(")\n")
+	out.WriteString(")\n\n")
+
+	out.WriteString("// InstName contains the mnemonic for each Inst.\n")
+	out.WriteString("var InstName = [...]string{\n\t\"\",\n")
+	for _, name := range names {
+		fmt.Fprintf(out, "\t%q,\n", strings.ToUpper(name))
+	}
+	out.WriteString("}\n")
 
 	formatted, err = format.Source([]byte(out.String()))
 	if err != nil {
@@ -211,6 +271,89 @@ func main() {
 		panic(err.Error())
 	}
 
+	// ------------------------ encoding docs ------------------------
+
+	out = new(strings.Builder)
+	out.Grow(512 * 1024)
+	out.WriteString("// Code generated by gen/inst/inst.go; DO NOT EDIT.\n\n")
+	out.WriteString("package arm\n\n")
+
+	out.WriteString("// The EncodingDocs table contains the syntax and constraints of each instruction encoding,\n")
+	out.WriteString("// in the order of the Patterns table. Dual-width SIMD encodings list each width on a separate line.\n")
+	out.WriteString("var EncodingDocs = [...]string{\n")
+	docOffsets := make([]int, len(names))
+	encIdx = 0
+	for i, name := range names {
+		docOffsets[i] = encIdx
+		for _, widths := range encDocMap[name] {
+			docs := make([]string, 0, 2)
+			for widthIdx, width := range widths {
+				if widthIdx > 0 && width.Size == 0 { // single width
+					break
+				}
+				docs = append(docs, unpadDoc(width.Doc))
+			}
+			fmt.Fprintf(out, "\t%q,\n", strings.Join(docs, "\n"))
+			encIdx++
+		}
+	}
+	out.WriteString("}\n\n")
+	if encIdx >= 1<<16 {
+		panic(fmt.Sprintf("too many encodings: %d", encIdx))
+	}
+
+	out.WriteString("// The EncodingDocOffsets table maps each Inst to the index of its first encoding in the EncodingDocs table.\n")
+	out.WriteString("var EncodingDocOffsets = [...]uint16{\n\t0,\n")
+	for i, name := range names {
+		fmt.Fprintf(out, "\t%d, // %s\n", docOffsets[i], strings.ToUpper(name))
+	}
+	out.WriteString("}\n")
+
+	formatted, err = format.Source([]byte(out.String()))
+	if err != nil {
+		panic(err.Error())
+	}
+	if err := os.WriteFile("inst_docs.go", formatted, 0664); err != nil {
+		panic(err.Error())
+	}
+
+	// ------------------------ typed emitters ------------------------
+
+	out = new(strings.Builder)
+	out.Grow(1024 * 1024)
+	out.WriteString("// Code generated by gen/inst/inst.go; DO NOT EDIT.\n\n")
+	out.WriteString("package arm\n\n")
+	encIdx = 0
+	emitted := make(map[string]bool)
+	for _, name := range names {
+		encDocList := encDocMap[name]
+		for instEncIdx, enc := range opmap.EncMap[name] {
+			for widthIdx, width := range encDocList[instEncIdx] {
+				doc := width.Doc
+				if widthIdx > 0 && width.Size == 0 { // single width
+					break
+				}
+				for _, optional := range [...]bool{false, true} {
+					e, ok := newEmitter(name, enc, width.Size, optional)
+					if !ok || emitted[e.method] {
+						continue
+					}
+					emitted[e.method] = true
+					e.write(out, doc, instEncIdx, cmdOffsets[encIdx], opmap.Feature(name, enc), opmap.ExtraFeature(name, enc))
+				}
+			}
+			encIdx++
+		}
+	}
+
+	formatted, err = format.Source([]byte(out.String()))
+	if err != nil {
+		panic(err.Error())
+	}
+	if err := os.WriteFile("inst_emit.go", formatted, 0664); err != nil {
+		panic(err.Error())
+	}
+
 	// ------------------------ instruction docs ------------------------
 
 	out = new(strings.Builder)
@@ -225,7 +368,10 @@ func main() {
 	out.WriteString("Instructions encodings may include constraints indicating allowed register numbers, immediate ranges,\n")
 	out.WriteString("and immediate alignment. Where a constraint indicates a right shift, the immediate value is shifted\n")
 	out.WriteString("during encoding but the provided argument should not be shifted beforehand; the shift amount indicates\n")
-	out.WriteString("the required alignment for the provided argument.\n\n\n")
+	out.WriteString("the required alignment for the provided argument.\n\n")
+
+	out.WriteString("Encodings which require an optional architecture feature are marked with the feature name. When an\n")
+	out.WriteString("Assembler is configured with a CPU, encodings which require unavailable features are rejected.\n\n\n")
 
 	writeAliasLinks := func(nameDesc instref.NameDesc) {
 		if len(nameDesc.AliasOf) != 0 {
@@ -260,10 +406,27 @@ func main() {
 			out.WriteByte('\n')
 		}
 
+		// A single feature required by all encodings is noted once, otherwise encodings are marked individually:
+		var shared string
+		for j, enc := range opmap.EncMap[name] {
+			if f := featureDoc(name, enc); j == 0 {
+				shared = f
+			} else if f != shared {
+				shared = ""
+			}
+		}
+		if shared != "" {
+			fmt.Fprintf(out, "Requires %s.\n\n", shared)
+		}
+
 		out.WriteString("```\n")
-		for _, encWidths := range encDocMap[name] {
+		for j, encWidths := range encDocMap[name] {
+			f := featureDoc(name, opmap.EncMap[name][j])
 			for _, width := range encWidths {
 				out.WriteString(width.Doc)
+				if f != "" && shared == "" {
+					fmt.Fprintf(out, "  [%s]", f)
+				}
 				out.WriteByte('\n')
 				if width.Size == 0 { // single width
 					break
@@ -277,3 +440,269 @@ func main() {
 		panic(err.Error())
 	}
 }
+
+// featureDoc returns the features required by an encoding of the named instruction (e.g. FEAT_MOPS and FEAT_MTE),
+// or an empty string.
+func featureDoc(name string, enc opmap.Encoding) string {
+	f, extra := opmap.Feature(name, enc), opmap.ExtraFeature(name, enc)
+	if extra != 0 {
+		return f.String() + " and " + extra.String()
+	}
+	return f.String()
+}
+
+// featureConst returns the name of the constant for f in the arm package.
+func featureConst(f arm.Feature) string {
+	return "Feat" + strings.ReplaceAll(strings.TrimPrefix(f.String(), "FEAT_"), "_", "")
+}
+
+// emitter is a typed method which encodes a single instruction encoding without pattern matching.
+type emitter struct {
+	inst     string
+	method   string
+	params   []emitterParam
+	checks   []string // conditions for invalid arguments
+	regs     []string // register parameters, checked for numbers below 32
+	flat     []string // flattened argument expressions
+	simdSize int
+}
+
+type emitterParam struct{ name, typ string }
+
+// newEmitter returns the typed method for an encoding of the named instruction with the given SIMD width,
+// including optional arguments if optional is true. Encodings with scalable vector, predicate, matrix,
+// register list, or register-indexed memory operands are not supported.
+func newEmitter(name string, enc opmap.Encoding, width int, optional bool) (e emitter, ok bool) {
+	e.inst, e.simdSize = strings.ToUpper(name), width
+	var parts []string
+	var regToken bool // the previous token was a register, to be joined with the next register token
+	hasOptional := false
+	nextReg := 0
+	unsigned := false
+	for _, c := range enc.Cmds {
+		if c.Op == arm.CmdSpecial {
+			switch c.X[1] {
+			case arm.SpecialImmWide64, arm.SpecialImmWideInv64, arm.SpecialImmLogical64, arm.SpecialImmStretched:
+				unsigned = true
+			}
+		}
+	}
+	regName := func() string {
+		names := [...]string{"rd", "rn", "rm", "ra", "rb", "rc"}
+		if nextReg == 0 && len(e.params) != 0 {
+			nextReg = 1
+		}
+		n := names[nextReg]
+		nextReg++
+		return n
+	}
+	counts := make(map[string]int)
+	param := func(base, typ string) string {
+		counts[base]++
+		n := base
+		if counts[base] > 1 {
+			n = fmt.Sprintf("%s%d", base, counts[base])
+		}
+		e.params = append(e.params, emitterParam{n, typ})
+		return n
+	}
+	addToken := func(token string, reg bool) {
+		if reg && regToken {
+			parts[len(parts)-1] += token
+		} else {
+			parts = append(parts, token)
+		}
+		regToken = reg
+	}
+	addReg := func(token, typ string) string {
+		n := param(regName(), typ)
+		addToken(token, true)
+		e.regs = append(e.regs, n)
+		e.flat = append(e.flat, "Flat{FlatReg, uint64("+n+")}")
+		return n
+	}
+	addIdx := func(lanes int) {
+		idx := param("idx", "uint8")
+		e.checks = append(e.checks, fmt.Sprintf("%s >= %d", idx, lanes))
+		e.flat = append(e.flat, "Flat{FlatImm, uint64("+idx+")}")
+	}
+	arrangement := func(size arm.Size, lanes int) string {
+		return fmt.Sprintf("V%d%s", lanes, sizeLetter(size))
+	}
+
+	for i, m := range enc.Match {
+		switch m.Op {
+		case arm.MatEnd:
+			hasOptional = true
+			if !optional {
+				// omitted optional arguments are encoded with their defaults
+				for _, m := range enc.Match[i+1:] {
+					for n := arm.MatcherFlatArgCounts[m.Op]; n > 0; n-- {
+						e.flat = append(e.flat, "Flat{}")
+					}
+				}
+				break
+			}
+			continue
+		case arm.MatW:
+			addReg("W", "WReg")
+		case arm.MatX:
+			addReg("X", "XReg")
+		case arm.MatWSP:
+			addReg("Wsp", "WSPReg")
+		case arm.MatXSP:
+			addReg("Xsp", "XSPReg")
+		case arm.MatB, arm.MatH, arm.MatS, arm.MatD, arm.MatQ:
+			letter := strings.TrimPrefix(arm.MatchName[m.Op], "Mat")
+			addReg(letter, letter+"Reg")
+		case arm.MatV:
+			size := arm.Size(m.X[0])
+			a := arrangement(size, width/sizeBytes(size))
+			addReg(a, a+"Reg")
+		case arm.MatVStatic:
+			a := arrangement(arm.Size(m.X[0]), int(m.X[1]))
+			addReg(a, a+"Reg")
+		case arm.MatVElement:
+			size := arm.Size(m.X[0])
+			addReg("V"+sizeLetter(size)+"i", "VReg")
+			addIdx(16 / sizeBytes(size))
+		case arm.MatVElementStatic:
+			addReg(fmt.Sprintf("V%s%d", sizeLetter(arm.Size(m.X[0])), m.X[1]), "VReg")
+		case arm.MatVStaticElement:
+			addReg(arrangement(arm.Size(m.X[0]), int(m.X[1]))+"i", "VReg")
+			addIdx(int(m.X[1]))
+		case arm.MatImm:
+			addToken("Imm", false)
+			typ := "int64"
+			if unsigned {
+				typ = "uint64"
+			}
+			imm := param("imm", typ)
+			e.flat = append(e.flat, "Flat{FlatImm, uint64("+imm+")}")
+		case arm.MatFloat:
+			addToken("Float", false)
+			f := param("f", "float64")
+			e.flat = append(e.flat, "flatFloat("+f+")")
+		case arm.MatOffset:
+			addToken("Label", false)
+			label := param("label", "Label")
+			e.checks = append(e.checks, "!a.validLabel("+label+")")
+			e.flat = append(e.flat, "flatLabel("+label+")")
+		case arm.MatRefBase:
+			addToken("Ref", false)
+			base := param("base", "XSPReg")
+			e.regs = append(e.regs, base)
+			e.flat = append(e.flat, "Flat{FlatReg, uint64("+base+")}")
+		case arm.MatRefOffset, arm.MatRefPre:
+			addToken(strings.TrimPrefix(arm.MatchName[m.Op], "Mat"), false)
+			base, offset := param("base", "XSPReg"), param("offset", "int32")
+			e.regs = append(e.regs, base)
+			e.flat = append(e.flat, "Flat{FlatReg, uint64("+base+")}", "Flat{FlatImm, uint64("+offset+")}")
+		case arm.MatMod:
+			addToken("Mod", false)
+			mod := param("mod", "Mod")
+			e.checks = append(e.checks, fmt.Sprintf("!checkMod(ModList[%s], %s.ID)", arm.ModListName[m.X[0]], mod))
+			e.flat = append(e.flat, "Flat{FlatMod, uint64("+mod+".ID)}", "flatModImm("+mod+")")
+		case arm.MatLitMod:
+			addToken(strings.TrimPrefix(arm.ModName[m.X[0]], "Sym"), false)
+			amount := param("amount", "uint8")
+			e.flat = append(e.flat, "Flat{FlatImm, uint64("+amount+")}")
+		case arm.MatCond:
+			addToken("Cond", false)
+			cond := param("cond", "Symbol")
+			e.flat = append(e.flat, "Flat{FlatImm, uint64("+cond+")}")
+		case arm.MatSymbol:
+			addToken("Sym", false)
+			sym := param("sym", "Symbol")
+			e.flat = append(e.flat, "Flat{FlatImm, uint64("+sym+")}")
+		case arm.MatSysReg:
+			addToken("SysReg", false)
+			sysreg := param("sysreg", "SystemReg")
+			e.checks = append(e.checks, sysreg+">>14 < 2")
+			e.flat = append(e.flat, "Flat{FlatImm, uint64("+sysreg+" & 0x7FFF)}")
+		case arm.MatLitSymbol:
+			addToken(arm.SymbolName[m.X[0]], false)
+		case arm.MatLitInt:
+			addToken(fmt.Sprint(m.X[0]), false)
+		case arm.MatLitFloat:
+			if m.X[0] == 0 {
+				addToken("Zero", false)
+			} else {
+				addToken(fmt.Sprintf("F%d", m.X[0]), false)
+			}
+		default:
+			return e, false
+		}
+		if m.Op == arm.MatEnd {
+			break
+		}
+	}
+	if optional && !hasOptional {
+		return e, false
+	}
+	e.method = e.inst
+	if len(parts) != 0 {
+		e.method += "_" + strings.Join(parts, "_")
+	}
+	return e, true
+}
+
+// unpadDoc removes the padding between the syntax and constraints of an encoding doc.
+func unpadDoc(doc string) string {
+	form, constraints, _ := strings.Cut(doc, "  \u00b7")
+	form = strings.TrimSpace(form)
+	if i := strings.LastIndex(constraints, "\u00b7  ("); i >= 0 {
+		return form + " " + constraints[i+len("\u00b7  "):]
+	}
+	return form
+}
+
+// write writes the method for e, with the instruction format from the instruction reference.
+func (e emitter) write(out *strings.Builder, doc string, idx int, cmdOffset uint32, feature, extra arm.Feature) {
+	fmt.Fprintf(out, "// %s encodes %s.\n", e.method, unpadDoc(doc))
+	if extra != 0 {
+		fmt.Fprintf(out, "// Requires %s and %s.\n", feature, extra)
+	} else if feature != 0 {
+		fmt.Fprintf(out, "// Requires %s.\n", feature)
+	}
+	fmt.Fprintf(out, "func (a *Assembler) %s(", e.method)
+	for i, p := range e.params {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		out.WriteString(p.name)
+		if i+1 == len(e.params) || e.params[i+1].typ != p.typ {
+			out.WriteString(" " + p.typ)
+		}
+	}
+	out.WriteString(") bool {\n")
+	checks := e.checks
+	if len(e.regs) == 1 {
+		checks = append([]string{e.regs[0] + " >= 32"}, checks...)
+	} else if len(e.regs) > 1 {
+		checks = append([]string{"(uint8(" + strings.Join(e.regs, ")|uint8(") + ")) >= 32"}, checks...)
+	}
+	if len(checks) != 0 {
+		fmt.Fprintf(out, "\tif %s {\n\t\treturn a.emitErr(%s, ErrNoMatch)\n\t}\n", strings.Join(checks, " || "), e.inst)
+	}
+	feat, extraFeat := "0", "0"
+	if feature != 0 {
+		feat = featureConst(feature)
+	}
+	if extra != 0 {
+		extraFeat = featureConst(extra)
+	}
+	fmt.Fprintf(out, "\treturn a.emit(%s, %d, %d, %s, %s, %d", e.inst, idx, cmdOffset, feat, extraFeat, e.simdSize)
+	for _, f := range e.flat {
+		out.WriteString(", " + f)
+	}
+	out.WriteString(")\n}\n\n")
+}
+
+// sizeLetter returns the arrangement suffix for SIMD elements of the given size.
+func sizeLetter(size arm.Size) string {
+	return [...]string{arm.BYTE: "B", arm.WORD: "H", arm.DWORD: "S", arm.QWORD: "D", arm.OWORD: "Q"}[size]
+}
+
+// sizeBytes returns the number of bytes in SIMD elements of the given size.
+func sizeBytes(size arm.Size) int { return 1 << (size - arm.BYTE) }
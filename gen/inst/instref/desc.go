@@ -54,7 +54,7 @@ func Descriptions() (map[string][]NameDesc, error) {
 	}
 
 	for _, f := range x.Files {
-		if title := f.IForms.Title; strings.Contains(title, "SVE") || strings.Contains(title, "SME") {
+		if title := f.IForms.Title; strings.Contains(title, "SME") {
 			continue
 		}
 		for _, iform := range f.IForms.List {
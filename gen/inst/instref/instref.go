@@ -58,6 +58,8 @@ func Get(lineChars int) map[string][][2]EncodingWidth {
 						line.WriteString("<symbol>")
 					case arm.MatCond:
 						line.WriteString("<cond>")
+					case arm.MatSysReg:
+						line.WriteString("<sysreg>")
 					case arm.MatImm, arm.MatFloat:
 						line.WriteByte('#')
 						line.WriteString(immName(matcher.flat[0].suffix, encInfo.numImms, false))
@@ -103,6 +105,34 @@ func Get(lineChars int) map[string][][2]EncodingWidth {
 					case arm.MatRegListElement:
 						count, size := int(matcher.m.X[0]), arm.Size(matcher.m.X[1])
 						fmt.Fprintf(line, "{V%s.%s * %d}[i]", regSuffixes[matcher.flat[0].suffix], sizeName(size), count)
+					case arm.MatZ:
+						fmt.Fprintf(line, "Z%s%s", regSuffixes[matcher.flat[0].suffix], sveSizeName(arm.Size(matcher.m.X[0])))
+					case arm.MatZElement:
+						fmt.Fprintf(line, "Z%s%s[i]", regSuffixes[matcher.flat[0].suffix], sveSizeName(arm.Size(matcher.m.X[0])))
+					case arm.MatZList:
+						count, size := int(matcher.m.X[0]), arm.Size(matcher.m.X[1])
+						fmt.Fprintf(line, "{Z%s%s * %d}", regSuffixes[matcher.flat[0].suffix], sveSizeName(size), count)
+					case arm.MatP:
+						if size := arm.Size(matcher.m.X[0]); size == 0 && i > 0 {
+							line.WriteString("P" + predName(matcher.flat[0].suffix, encInfo.numPreds))
+						} else {
+							fmt.Fprintf(line, "P%s%s", regSuffixes[matcher.flat[0].suffix], sveSizeName(size))
+						}
+					case arm.MatPZ:
+						line.WriteString("P" + predName(matcher.flat[0].suffix, encInfo.numPreds) + "/Z")
+					case arm.MatPM:
+						line.WriteString("P" + predName(matcher.flat[0].suffix, encInfo.numPreds) + "/M")
+					case arm.MatZA:
+						line.WriteString("ZA")
+					case arm.MatZATile:
+						fmt.Fprintf(line, "ZA%s%s", regSuffixes[matcher.flat[0].suffix], sveSizeName(arm.Size(matcher.m.X[0])))
+					case arm.MatZASlice:
+						imm := immName(matcher.flat[3].suffix, encInfo.numImms, false)
+						if size := arm.Size(matcher.m.X[0]); size == 0 {
+							fmt.Fprintf(line, "ZA[W12-W15, #%s]", imm)
+						} else {
+							fmt.Fprintf(line, "ZA%sH|V%s[W12-W15, #%s]", regSuffixes[matcher.flat[0].suffix], sveSizeName(size), imm)
+						}
 					case arm.MatOffset:
 						line.WriteString("<offset>")
 					case arm.MatRefBase:
@@ -111,9 +141,34 @@ func Get(lineChars int) map[string][][2]EncodingWidth {
 						line.WriteString(fmt.Sprintf("[X%s|SP {, #%s }]", regSuffixes[matcher.flat[0].suffix], immName(matcher.flat[1].suffix, encInfo.numImms, false)))
 					case arm.MatRefPre:
 						line.WriteString(fmt.Sprintf("[X%s|SP, #%s]!", regSuffixes[matcher.flat[0].suffix], immName(matcher.flat[1].suffix, encInfo.numImms, false)))
+					case arm.MatRefWback:
+						line.WriteString(fmt.Sprintf("[X%s]!", regSuffixes[matcher.flat[0].suffix]))
 					case arm.MatRefIndex:
 						line.WriteString(fmt.Sprintf("[X%s|SP, W%s|X%s {, LSL|UXTW|SXTW|SXTX #%s }]",
 							regSuffixes[matcher.flat[0].suffix], regSuffixes[matcher.flat[1].suffix], regSuffixes[matcher.flat[1].suffix], immName(matcher.flat[3].suffix, encInfo.numImms, false)))
+					case arm.MatRefVL:
+						line.WriteString(fmt.Sprintf("[X%s|SP {, #%s, MUL VL }]", regSuffixes[matcher.flat[0].suffix], immName(matcher.flat[1].suffix, encInfo.numImms, false)))
+					case arm.MatRefIndexLSL:
+						if shift := matcher.m.X[0]; shift != 0 {
+							line.WriteString(fmt.Sprintf("[X%s|SP, X%s, LSL #%d]", regSuffixes[matcher.flat[0].suffix], regSuffixes[matcher.flat[1].suffix], shift))
+						} else {
+							line.WriteString(fmt.Sprintf("[X%s|SP, X%s]", regSuffixes[matcher.flat[0].suffix], regSuffixes[matcher.flat[1].suffix]))
+						}
+					case arm.MatRefIndexZ:
+						size, shift := arm.Size(matcher.m.X[0]), matcher.m.X[1]
+						line.WriteString(fmt.Sprintf("[X%s|SP, Z%s%s", regSuffixes[matcher.flat[0].suffix], regSuffixes[matcher.flat[1].suffix], sveSizeName(size)))
+						switch {
+						case size != arm.QWORD && shift != 0:
+							fmt.Fprintf(line, ", UXTW|SXTW #%d]", shift)
+						case size != arm.QWORD:
+							line.WriteString(", UXTW|SXTW]")
+						case shift != 0:
+							fmt.Fprintf(line, ", LSL #%d]", shift)
+						default:
+							line.WriteString("]")
+						}
+					case arm.MatRefZ:
+						line.WriteString(fmt.Sprintf("[Z%s%s {, #%s }]", regSuffixes[matcher.flat[0].suffix], sveSizeName(arm.Size(matcher.m.X[0])), immName(matcher.flat[1].suffix, encInfo.numImms, false)))
 					case arm.MatLitMod:
 						line.WriteString(strings.TrimPrefix(arm.ModName[matcher.m.X[0]], "Sym"))
 						line.WriteString(" #")
@@ -188,6 +243,13 @@ func Get(lineChars int) map[string][][2]EncodingWidth {
 // casp* instructions have destination as the last register
 var regSuffixes = [...]string{"d", "n", "m", "a", "b", "d"} // Xd, Xn, Xm, Xa, Xb, Xd
 
+func predName(predNum, predCount int) string { // g, g1-g2
+	if predCount > 1 {
+		return fmt.Sprintf("g%d", predNum+1)
+	}
+	return "g"
+}
+
 func immName(immNum, immCount int, isOffset bool) string { // imm, imm1-imm4, offset
 	if isOffset {
 		return "offset"
@@ -213,6 +275,13 @@ func sizeName(size arm.Size) string {
 	}
 	return ""
 }
+func sveSizeName(size arm.Size) string {
+	if size == 0 {
+		return ""
+	}
+	return "." + sizeName(size)
+}
+
 func sizeBytes(size arm.Size) int {
 	switch size {
 	case arm.BYTE:
@@ -234,6 +303,7 @@ func sizeBytes(size arm.Size) int {
 
 type encodingInfo struct {
 	numImms        int
+	numPreds       int
 	hasOptional    bool
 	hasConstraints bool
 	dualWidth      bool
@@ -272,6 +342,7 @@ type flatArgInfo struct {
 	immMax      int64
 	immSumDec   int64 // imm == immSumDec - prevImm
 	immAlt      bool  // ignore min/max
+	same        *flatArgInfo
 	cmds        []arm.EncOp
 	constraints []string
 }
@@ -292,8 +363,14 @@ IndexCommands:
 		switch m.Op {
 		case arm.MatV, arm.MatRegList:
 			encInfo.dualWidth = true
-		case arm.MatRefOffset, arm.MatRefPre, arm.MatRefIndex, arm.MatImm, arm.MatFloat, arm.MatMod, arm.MatLitMod:
+		case arm.MatRefOffset, arm.MatRefPre, arm.MatRefIndex, arm.MatImm, arm.MatFloat, arm.MatMod, arm.MatLitMod, arm.MatRefVL, arm.MatRefZ, arm.MatZASlice:
 			encInfo.numImms++
+		case arm.MatPZ, arm.MatPM:
+			encInfo.numPreds++
+		case arm.MatP:
+			if m.X[0] == 0 && mi > 0 {
+				encInfo.numPreds++
+			}
 		}
 		fc := int(arm.MatcherFlatArgCounts[m.Op])
 		minfo.flat = make([]flatArgInfo, fc)
@@ -320,10 +397,14 @@ GroupCommands:
 		matcherIdx := flat2matcher[flatArgIdx]
 		matcherFlatIdx := flat2matcherFlat[flatArgIdx]
 		encInfo.matchers[matcherIdx].flat[matcherFlatIdx].cmds = append(encInfo.matchers[matcherIdx].flat[matcherFlatIdx].cmds, c)
+		if c.Op == arm.CmdRSame || c.Op == arm.CmdUsame {
+			sameIdx := flatArgIdx - int(c.X[0])
+			encInfo.matchers[matcherIdx].flat[matcherFlatIdx].same = &encInfo.matchers[flat2matcher[sameIdx]].flat[flat2matcherFlat[sameIdx]]
+		}
 		switch c.Op {
 		default:
 			flatArgIdx++
-		case arm.CmdUslice, arm.CmdSslice, arm.CmdChkUbits, arm.CmdChkUsum, arm.CmdChkSscaled, arm.CmdChkUrange1:
+		case arm.CmdUslice, arm.CmdSslice, arm.CmdChkUbits, arm.CmdChkUsum, arm.CmdChkSscaled, arm.CmdChkUrange1, arm.CmdChkSbits:
 			// non-consuming
 		}
 	}
@@ -339,6 +420,13 @@ GroupCommands:
 		}
 		matcher.flat[flatArgIdx].fmtRegArgConstraints()
 	}
+	nextPredSuffix := 0
+	updatePred := func(matcherIdx int) {
+		matcher := &encInfo.matchers[matcherIdx]
+		matcher.flat[0].suffix = nextPredSuffix
+		matcher.flat[0].fmtPredArgConstraints(encInfo.numPreds)
+		nextPredSuffix++
+	}
 	updateImm := func(matcherIdx, flatArgIdx int) {
 		matcher := &encInfo.matchers[matcherIdx]
 		matcher.flat[flatArgIdx].suffix = nextImmSuffix
@@ -350,22 +438,41 @@ GroupCommands:
 		switch op := matcher.m.Op; op {
 		case arm.MatW, arm.MatX, arm.MatWSP, arm.MatXSP, arm.MatB, arm.MatH, arm.MatS, arm.MatD, arm.MatQ,
 			arm.MatV, arm.MatVStatic, arm.MatVElement, arm.MatVStaticElement, arm.MatVElementStatic,
-			arm.MatRegList, arm.MatRegListStatic, arm.MatRegListElement, arm.MatRefBase:
+			arm.MatRegList, arm.MatRegListStatic, arm.MatRegListElement, arm.MatRefBase, arm.MatRefWback,
+			arm.MatZ, arm.MatZElement, arm.MatZList:
+			updateReg(mi, 0)
+		case arm.MatP:
+			if matcher.m.X[0] != 0 || mi == 0 {
+				updateReg(mi, 0)
+			} else {
+				updatePred(mi)
+			}
+		case arm.MatPZ, arm.MatPM:
+			updatePred(mi)
+		case arm.MatZATile:
 			updateReg(mi, 0)
-		case arm.MatRefOffset, arm.MatRefPre:
+		case arm.MatZASlice:
+			if matcher.m.X[0] != 0 {
+				updateReg(mi, 0)
+			}
+			updateImm(mi, 3)
+		case arm.MatRefOffset, arm.MatRefPre, arm.MatRefVL, arm.MatRefZ:
 			updateReg(mi, 0)
 			updateImm(mi, 1)
 		case arm.MatRefIndex:
 			updateReg(mi, 0)
 			updateReg(mi, 1)
 			updateImm(mi, 3)
+		case arm.MatRefIndexLSL, arm.MatRefIndexZ:
+			updateReg(mi, 0)
+			updateReg(mi, 1)
 		case arm.MatImm, arm.MatFloat, arm.MatLitMod:
 			updateImm(mi, 0)
 		case arm.MatMod:
 			updateImm(mi, 1)
 		case arm.MatOffset:
 			matcher.flat[0].fmtImmArgConstraints(encInfo.numImms, true)
-		case arm.MatSymbol, arm.MatLitSymbol:
+		case arm.MatSymbol, arm.MatLitSymbol, arm.MatSysReg:
 			// no constraints
 		}
 		if matcher.hasConstraints() {
@@ -376,6 +483,15 @@ GroupCommands:
 	return encInfo
 }
 
+// Governing predicates are named Pg (or Pg1, Pg2) and do not consume a register suffix.
+func (flat *flatArgInfo) fmtPredArgConstraints(predCount int) {
+	for _, c := range flat.cmds {
+		if c.Op == arm.CmdRLo8 {
+			flat.constraints = []string{predName(flat.suffix, predCount) + " < 8"}
+		}
+	}
+}
+
 func (flat *flatArgInfo) fmtRegArgConstraints() {
 	name := regSuffixes[flat.suffix]
 	var list []string
@@ -390,6 +506,12 @@ func (flat *flatArgInfo) fmtRegArgConstraints() {
 		case arm.CmdRNext:
 			prev := regSuffixes[flat.suffix-1]
 			list = append(list, fmt.Sprintf("%s == %s + 1", name, prev))
+		case arm.CmdRLo8:
+			list = append(list, name+" < 8")
+		case arm.CmdRbits:
+			list = append(list, fmt.Sprintf("%s < %d", name, 1<<c.X[1]))
+		case arm.CmdRSame:
+			list = append(list, fmt.Sprintf("%s == %s", name, regSuffixes[flat.same.suffix]))
 		}
 	}
 	flat.constraints = list
@@ -421,6 +543,14 @@ func (flat *flatArgInfo) fmtImmArgConstraints(immCount int, isOffset bool) {
 			half := int64(1) << (9 - 1)
 			flat.setMin(-1 * half)
 			flat.setMax(half - 1)
+		case arm.CmdSfield:
+			half := int64(1) << (c.X[1] - 1)
+			flat.setMin(-1 * half)
+			flat.setMax(half - 1)
+		case arm.CmdChkSbits:
+			half := int64(1) << (c.X[0] - 1)
+			flat.setMin(-1 * half)
+			flat.setMax(half - 1)
 		case arm.CmdChkUbits:
 			bitlen := c.X[0]
 			flat.setMin(0)
@@ -437,6 +567,13 @@ func (flat *flatArgInfo) fmtImmArgConstraints(immCount int, isOffset bool) {
 			flat.setMin(-1 * half)
 			flat.setMax(half - 1)
 			misc = append(misc, fmt.Sprintf("%s >> %d", name, scale))
+		case arm.CmdSscaled9:
+			const bits = uint8(9)
+			scale := c.X[0]
+			half := int64(1) << (bits + scale - 1)
+			flat.setMin(-1 * half)
+			flat.setMax(half - 1)
+			misc = append(misc, fmt.Sprintf("%s >> %d", name, scale))
 		case arm.CmdChkSscaled:
 			const bits, scale = uint8(10), uint8(3)
 			half := int64(1) << (bits + scale - 1)
@@ -478,6 +615,8 @@ func (flat *flatArgInfo) fmtImmArgConstraints(immCount int, isOffset bool) {
 		case arm.CmdUfields21:
 			flat.setMin(0)
 			flat.setMax(1)
+		case arm.CmdUsame:
+			misc = append(misc, fmt.Sprintf("%s == %s", name, immName(flat.same.suffix, immCount, false)))
 		case arm.CmdUAlt2, arm.CmdUAlt4:
 			listIdx := c.X[1]
 			if c.Op == arm.CmdUAlt2 && arm.Alts2[listIdx] == ([2]uint16{0, 0}) {
@@ -529,6 +668,9 @@ func (flat *flatArgInfo) fmtImmArgConstraints(immCount int, isOffset bool) {
 				misc = append(misc, name+" is split float")
 			case arm.SpecialImmStretched:
 				misc = append(misc, name+" is stretched")
+			case arm.SpecialImmTszRight64:
+				flat.setMin(1)
+				flat.setMax(64)
 			}
 		case arm.CmdOffset:
 			switch relType := c.X[0]; relType {
@@ -0,0 +1,269 @@
+package opmap
+
+import (
+	"strings"
+
+	"github.com/wdamron/arm"
+)
+
+// Feature returns the architecture feature required by an encoding of the named instruction, or 0 if
+// the encoding is available for all ARMv8.0-A targets. Encodings merged from extension maps may set
+// the Feature field explicitly.
+//
+// Instructions in the hint space (BTI, PACIASP, AUTIASP, XPACLRI, ESB, CSDB, ...) execute as NOP where
+// unimplemented, and do not require a feature. Features selected by system operation symbols
+// (DC CVAP, TLBI RVAE1, ...) are not tracked.
+func Feature(name string, enc Encoding) arm.Feature {
+	if enc.Feature != 0 {
+		return enc.Feature
+	}
+	if f, ok := featureNames[name]; ok {
+		return f
+	}
+	if isLSE(name) {
+		return arm.FeatLSE
+	}
+	switch {
+	case strings.HasPrefix(name, "crc32"):
+		return arm.FeatCRC32
+	case strings.HasPrefix(name, "sha1"):
+		return arm.FeatSHA1
+	case strings.HasPrefix(name, "sha256"):
+		return arm.FeatSHA256
+	case strings.HasPrefix(name, "sha512"):
+		return arm.FeatSHA512
+	case strings.HasPrefix(name, "sm3"):
+		return arm.FeatSM3
+	case strings.HasPrefix(name, "sm4"):
+		return arm.FeatSM4
+	case strings.HasPrefix(name, "ldapur"), strings.HasPrefix(name, "stlur"):
+		return arm.FeatLRCPC2
+	case strings.HasPrefix(name, "ldapr"):
+		return arm.FeatLRCPC
+	case strings.HasPrefix(name, "ldlar"), strings.HasPrefix(name, "stllr"):
+		return arm.FeatLOR
+	}
+	switch name {
+	case "abs", "cnt", "ctz", "smax", "smin", "umax", "umin":
+		if len(enc.Match) != 0 && (enc.Match[0].Op == arm.MatW || enc.Match[0].Op == arm.MatX) {
+			return arm.FeatCSSC
+		}
+		return 0
+	case "pmull", "pmull2":
+		if matchesSize(enc, arm.QWORD) || matchesSize(enc, arm.OWORD) {
+			return arm.FeatAES // 64-bit polynomial multiply long
+		}
+		return 0
+	case "fcvt", "fcvtl", "fcvtl2", "fcvtn", "fcvtn2", "fcvtxn", "fcvtxn2":
+		return 0 // half-precision conversions are available without FEAT_FP16
+	}
+	if (strings.HasPrefix(name, "f") || name == "scvtf" || name == "ucvtf") && matchesSize(enc, arm.WORD) {
+		return arm.FeatFP16
+	}
+	return 0
+}
+
+// ExtraFeature returns a second architecture feature required by an encoding of the named instruction in addition
+// to [Feature], or 0 if a single feature is required.
+func ExtraFeature(name string, enc Encoding) arm.Feature {
+	if enc.Feature != 0 {
+		return 0
+	}
+	switch name {
+	case "setgp", "setgm", "setge":
+		return arm.FeatMTE // tag-setting memory set
+	case "fcadd", "fcmla":
+		if matchesSize(enc, arm.WORD) {
+			return arm.FeatFP16 // half-precision complex arithmetic
+		}
+	}
+	return 0
+}
+
+// sveFeature returns the feature required by an SVE encoding of the named instruction.
+func sveFeature(name string, enc Encoding) arm.Feature {
+	switch name {
+	case "bcax", "bsl", "bsl1n", "bsl2n", "eor3", "nbsl", "sqdmulh", "whilege", "whilegt", "whilehi", "whilehs":
+		return arm.FeatSVE2
+	case "mul", "smulh", "umulh":
+		if len(enc.Match) == 3 && enc.Match[2].Op == arm.MatZ { // unpredicated vectors
+			return arm.FeatSVE2
+		}
+	}
+	return arm.FeatSVE
+}
+
+// smeFeature returns the feature required by an SME encoding of the named instruction.
+func smeFeature(name string, enc Encoding) arm.Feature {
+	wide := len(enc.Match) != 0 && enc.Match[0].Op == arm.MatZATile && enc.Match[0].X[0] == uint8(arm.QWORD)
+	switch {
+	case wide && (name == "fmopa" || name == "fmops"):
+		return arm.FeatSMEF64F64
+	case wide && name != "zero":
+		return arm.FeatSMEI16I64
+	}
+	return arm.FeatSME
+}
+
+// matchesSize returns true if any SIMD scalar or vector matcher for enc has elements of the given size.
+func matchesSize(enc Encoding, size arm.Size) bool {
+	for _, m := range enc.Match {
+		switch m.Op {
+		case arm.MatH:
+			if size == arm.WORD {
+				return true
+			}
+		case arm.MatV, arm.MatVStatic, arm.MatVElement, arm.MatVElementStatic, arm.MatVStaticElement:
+			if m.X[0] == uint8(size) {
+				return true
+			}
+		case arm.MatRegList, arm.MatRegListStatic, arm.MatRegListElement:
+			if m.X[1] == uint8(size) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isLSE returns true for compare-and-swap, swap, and atomic memory operation mnemonics.
+func isLSE(name string) bool {
+	if strings.HasPrefix(name, "cas") || strings.HasPrefix(name, "swp") {
+		return true
+	}
+	if !strings.HasPrefix(name, "ld") && !strings.HasPrefix(name, "st") {
+		return false
+	}
+	for _, op := range [...]string{"add", "clr", "eor", "set", "smax", "smin", "umax", "umin"} {
+		if strings.HasPrefix(name[2:], op) {
+			return true
+		}
+	}
+	return false
+}
+
+var featureNames = map[string]arm.Feature{
+	"sqrdmlah": arm.FeatRDM,
+	"sqrdmlsh": arm.FeatRDM,
+
+	"fmlal":  arm.FeatFHM,
+	"fmlal2": arm.FeatFHM,
+	"fmlsl":  arm.FeatFHM,
+	"fmlsl2": arm.FeatFHM,
+
+	"sdot": arm.FeatDotProd,
+	"udot": arm.FeatDotProd,
+
+	"aesd":   arm.FeatAES,
+	"aese":   arm.FeatAES,
+	"aesimc": arm.FeatAES,
+	"aesmc":  arm.FeatAES,
+
+	"bcax": arm.FeatSHA3,
+	"eor3": arm.FeatSHA3,
+	"rax1": arm.FeatSHA3,
+	"xar":  arm.FeatSHA3,
+
+	"autda":  arm.FeatPAuth,
+	"autdb":  arm.FeatPAuth,
+	"autdza": arm.FeatPAuth,
+	"autdzb": arm.FeatPAuth,
+	"autia":  arm.FeatPAuth,
+	"autib":  arm.FeatPAuth,
+	"autiza": arm.FeatPAuth,
+	"autizb": arm.FeatPAuth,
+	"blraa":  arm.FeatPAuth,
+	"blraaz": arm.FeatPAuth,
+	"blrab":  arm.FeatPAuth,
+	"blrabz": arm.FeatPAuth,
+	"braa":   arm.FeatPAuth,
+	"braaz":  arm.FeatPAuth,
+	"brab":   arm.FeatPAuth,
+	"brabz":  arm.FeatPAuth,
+	"eretaa": arm.FeatPAuth,
+	"eretab": arm.FeatPAuth,
+	"ldraa":  arm.FeatPAuth,
+	"ldrab":  arm.FeatPAuth,
+	"pacda":  arm.FeatPAuth,
+	"pacdb":  arm.FeatPAuth,
+	"pacdza": arm.FeatPAuth,
+	"pacdzb": arm.FeatPAuth,
+	"pacga":  arm.FeatPAuth,
+	"pacia":  arm.FeatPAuth,
+	"pacib":  arm.FeatPAuth,
+	"paciza": arm.FeatPAuth,
+	"pacizb": arm.FeatPAuth,
+	"retaa":  arm.FeatPAuth,
+	"retab":  arm.FeatPAuth,
+	"xpacd":  arm.FeatPAuth,
+	"xpaci":  arm.FeatPAuth,
+
+	"fjcvtzs": arm.FeatJSCVT,
+	"fcadd":   arm.FeatFCMA,
+	"fcmla":   arm.FeatFCMA,
+
+	"cfinv":  arm.FeatFlagM,
+	"rmif":   arm.FeatFlagM,
+	"setf8":  arm.FeatFlagM,
+	"setf16": arm.FeatFlagM,
+	"axflag": arm.FeatFlagM2,
+	"xaflag": arm.FeatFlagM2,
+
+	"frint32x": arm.FeatFRINTTS,
+	"frint32z": arm.FeatFRINTTS,
+	"frint64x": arm.FeatFRINTTS,
+	"frint64z": arm.FeatFRINTTS,
+
+	"sb":  arm.FeatSB,
+	"cfp": arm.FeatSPECRES,
+	"cpp": arm.FeatSPECRES,
+	"dvp": arm.FeatSPECRES,
+
+	"bfcvt":   arm.FeatBF16,
+	"bfcvtn":  arm.FeatBF16,
+	"bfcvtn2": arm.FeatBF16,
+	"bfdot":   arm.FeatBF16,
+	"bfmlalb": arm.FeatBF16,
+	"bfmlalt": arm.FeatBF16,
+	"bfmmla":  arm.FeatBF16,
+
+	"smmla":  arm.FeatI8MM,
+	"ummla":  arm.FeatI8MM,
+	"usmmla": arm.FeatI8MM,
+	"usdot":  arm.FeatI8MM,
+	"sudot":  arm.FeatI8MM,
+
+	"irg":   arm.FeatMTE,
+	"gmi":   arm.FeatMTE,
+	"addg":  arm.FeatMTE,
+	"subg":  arm.FeatMTE,
+	"subp":  arm.FeatMTE,
+	"subps": arm.FeatMTE,
+	"stg":   arm.FeatMTE,
+	"stzg":  arm.FeatMTE,
+	"st2g":  arm.FeatMTE,
+	"stz2g": arm.FeatMTE,
+	"stgp":  arm.FeatMTE,
+	"ldg":   arm.FeatMTE,
+
+	"wfet": arm.FeatWFxT,
+	"wfit": arm.FeatWFxT,
+
+	"ld64b":   arm.FeatLS64,
+	"st64b":   arm.FeatLS64,
+	"st64bv":  arm.FeatLS64,
+	"st64bv0": arm.FeatLS64,
+
+	"cpyp":  arm.FeatMOPS,
+	"cpym":  arm.FeatMOPS,
+	"cpye":  arm.FeatMOPS,
+	"cpyfp": arm.FeatMOPS,
+	"cpyfm": arm.FeatMOPS,
+	"cpyfe": arm.FeatMOPS,
+	"setp":  arm.FeatMOPS,
+	"setm":  arm.FeatMOPS,
+	"sete":  arm.FeatMOPS,
+	"setgp": arm.FeatMOPS, // also requires FEAT_MTE (see ExtraFeature)
+	"setgm": arm.FeatMOPS, // also requires FEAT_MTE (see ExtraFeature)
+	"setge": arm.FeatMOPS, // also requires FEAT_MTE (see ExtraFeature)
+}
@@ -45,9 +45,10 @@ func alt4(offset uint8, a0, a1, a2, a3 uint16) arm.EncOp {
 }
 
 type Encoding struct {
-	Op    uint32
-	Match []arm.EncOp
-	Cmds  []arm.EncOp
+	Op      uint32
+	Match   []arm.EncOp
+	Cmds    []arm.EncOp
+	Feature arm.Feature // required feature, if not derived from the mnemonic (see [Feature])
 }
 
 // See https://github.com/CensoredUsername/dynasm-rs/blob/e6248cc55917e96b7ce7a590c0c93f61c609471e/plugin/src/arch/aarch64/opmap.rs
@@ -699,6 +700,11 @@ var EncMap = map[string][]Encoding{
 			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatCond)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdBack), cmd(arm.CmdR16), cmd(arm.CmdCondInv, 12)}},
 	},
+	"clrbhb": {
+		{Op: 0b11010101000000110010001011011111,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+	},
 	"clrex": {
 		{Op: 0b11010101000000110011000001011111,
 			Match: []arm.EncOp{mat(arm.MatImm)},
@@ -1115,6 +1121,11 @@ var EncMap = map[string][]Encoding{
 			Match: []arm.EncOp{mat(arm.MatEnd), mat(arm.MatImm)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 5, 16)}},
 	},
+	"dgh": {
+		{Op: 0b11010101000000110010000011011111,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+	},
 	"dmb": {
 		{Op: 0b11010101000000110011000010111111,
 			Match: []arm.EncOp{mat(arm.MatSymbol)},
@@ -2601,6 +2612,16 @@ var EncMap = map[string][]Encoding{
 		{Op: 0b10011110101011100000000000000000,
 			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatVElementStatic, uint8(arm.QWORD), 1)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// FMOV (scalar, zero)
+		{Op: 0b00011110111001110000001111100000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00011110001001110000001111100000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b10011110011001110000001111100000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
 		// FMOV (scalar, mkmat(Immediate)
 		{Op: 0b00011110111000000001000000000000,
 			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatFloat)},
@@ -5112,9 +5133,33 @@ var EncMap = map[string][]Encoding{
 		{Op: 0b11010101001100000000000000000000,
 			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatImm)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdUbits, 5, 15)}},
+		{Op: 0b11010101001100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSysReg)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdUbits, 5, 15)}},
+		{Op: 0b11010101001110000100001000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatLitSymbol, uint8(arm.SPSEL))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b11010101001110000100001001100000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatLitSymbol, uint8(arm.PAN))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b11010101001110000100001010000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatLitSymbol, uint8(arm.UAO))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b11010101001110110100001010100000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatLitSymbol, uint8(arm.DIT))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
 	},
 	"msr": {
 		// MSR (immediate)
+		{Op: 0b11010101000000110100001001111111,
+			Match: []arm.EncOp{mat(arm.MatLitSymbol, uint8(arm.SVCRSM)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 8, 1)}},
+		{Op: 0b11010101000000110100010001111111,
+			Match: []arm.EncOp{mat(arm.MatLitSymbol, uint8(arm.SVCRZA)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 8, 1)}},
+		{Op: 0b11010101000000110100011001111111,
+			Match: []arm.EncOp{mat(arm.MatLitSymbol, uint8(arm.SVCRSMZA)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 8, 1)}},
 		{Op: 0b11010101000000000100000000011111,
 			Match: []arm.EncOp{mat(arm.MatSymbol), mat(arm.MatImm)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 5, arm.SymMSRIMMOPS), cmd(arm.CmdUbits, 8, 4)}},
@@ -5122,6 +5167,21 @@ var EncMap = map[string][]Encoding{
 		{Op: 0b11010101000100000000000000000000,
 			Match: []arm.EncOp{mat(arm.MatImm), mat(arm.MatX)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 5, 15), cmd(arm.CmdR0)}},
+		{Op: 0b11010101000100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatSysReg), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 5, 15), cmd(arm.CmdR0)}},
+		{Op: 0b11010101000110000100001000000000,
+			Match: []arm.EncOp{mat(arm.MatLitSymbol, uint8(arm.SPSEL)), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b11010101000110000100001001100000,
+			Match: []arm.EncOp{mat(arm.MatLitSymbol, uint8(arm.PAN)), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b11010101000110000100001010000000,
+			Match: []arm.EncOp{mat(arm.MatLitSymbol, uint8(arm.UAO)), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b11010101000110110100001010100000,
+			Match: []arm.EncOp{mat(arm.MatLitSymbol, uint8(arm.DIT)), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
 	},
 	"msub": {
 		{Op: 0b00011011000000001000000000000000,
@@ -5375,19 +5435,35 @@ var EncMap = map[string][]Encoding{
 			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
 	},
 	"prfm": {
+		// PRFM (immediate)
+		{Op: 0b11111001100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatImm), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 0, 5), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 10, 12, 3)}},
+		{Op: 0b11111001100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatSymbol), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 0, arm.SymPRFOPS), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 10, 12, 3)}},
 		// PRFM (literal)
 		{Op: 0b11011000000000000000000000000000,
 			Match: []arm.EncOp{mat(arm.MatImm), mat(arm.MatOffset)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 0, 5), cmd(arm.CmdOffset, arm.RelBCond)}},
+		{Op: 0b11011000000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatSymbol), mat(arm.MatOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 0, arm.SymPRFOPS), cmd(arm.CmdOffset, arm.RelBCond)}},
 		// PRFM (register)
 		{Op: 0b11111000101000000000100000000000,
 			Match: []arm.EncOp{mat(arm.MatImm), mat(arm.MatRefIndex)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 0, 5), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdExtendsX), alt2(12, 0, 3)}},
+		{Op: 0b11111000101000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatSymbol), mat(arm.MatRefIndex)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 0, arm.SymPRFOPS), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdExtendsX), alt2(12, 0, 3)}},
 	},
 	"prfum": {
 		{Op: 0b11111000100000000000000000000000,
 			Match: []arm.EncOp{mat(arm.MatImm), mat(arm.MatRefOffset)},
 			Cmds:  []arm.EncOp{cmd(arm.CmdUbits, 0, 5), cmd(arm.CmdR5), cmd(arm.CmdSbits)}},
+		{Op: 0b11111000100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatSymbol), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 0, arm.SymPRFOPS), cmd(arm.CmdR5), cmd(arm.CmdSbits)}},
 	},
 	"psb": {
 		{Op: 0b11010101000000110010001000111111,
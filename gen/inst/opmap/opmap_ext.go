@@ -0,0 +1,504 @@
+package opmap
+
+import "github.com/wdamron/arm"
+
+// Armv8.5 and later extension encodings (BTI, MTE, MOPS, LS64, WFxT, FRINTTS, FlagM2, CSSC, BF16, I8MM), merged into [EncMap] at init.
+var extEncMap = map[string][]Encoding{
+	"abs": {
+		// ABS (scalar)
+		{Op: 0b01011010110000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b11011010110000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"addg": {
+		// ADDG
+		{Op: 0b10010001100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatXSP), mat(arm.MatImm), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 6, 4), cmd(arm.CmdUbits, 10, 4)}},
+	},
+	"axflag": {
+		// AXFLAG
+		{Op: 0b11010101000000000100000001011111,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+	},
+	"bfcvt": {
+		// BFCVT
+		{Op: 0b00011110011000110100000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatS)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"bfcvtn": {
+		// BFCVTN, BFCVTN2
+		{Op: 0b00001110101000010110100000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.WORD), 4), mat(arm.MatVStatic, uint8(arm.DWORD), 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"bfcvtn2": {
+		// BFCVTN, BFCVTN2
+		{Op: 0b01001110101000010110100000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVStatic, uint8(arm.DWORD), 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"bfdot": {
+		// BFDOT (vector)
+		{Op: 0b00101110010000001111110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 2), mat(arm.MatVStatic, uint8(arm.WORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// BFDOT (by element)
+		{Op: 0b00001111010000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 2), mat(arm.MatVStatic, uint8(arm.WORD), 4), mat(arm.MatVStaticElement, uint8(arm.WORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdUfields11, 2)}},
+		// BFDOT (vector)
+		{Op: 0b01101110010000001111110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVStatic, uint8(arm.WORD), 8)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// BFDOT (by element)
+		{Op: 0b01001111010000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVStaticElement, uint8(arm.WORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdUfields11, 2)}},
+	},
+	"bfmlalb": {
+		// BFMLALB (vector)
+		{Op: 0b00101110110000001111110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVStatic, uint8(arm.WORD), 8)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// BFMLALB (by element)
+		{Op: 0b00001111110000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVElement, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo16), cmd(arm.CmdUfields11, 3)}},
+	},
+	"bfmlalt": {
+		// BFMLALT (vector)
+		{Op: 0b01101110110000001111110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVStatic, uint8(arm.WORD), 8)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// BFMLALT (by element)
+		{Op: 0b01001111110000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVElement, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo16), cmd(arm.CmdUfields11, 3)}},
+	},
+	"bfmmla": {
+		// BFMMLA
+		{Op: 0b01101110010000001110110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.WORD), 8), mat(arm.MatVStatic, uint8(arm.WORD), 8)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"bti": {
+		// BTI
+		{Op: 0b11010101000000110010010000011111,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+		{Op: 0b11010101000000110010010000011111,
+			Match: []arm.EncOp{mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 6, arm.SymBTITARGETS)}},
+	},
+	"cnt": {
+		// CNT (scalar)
+		{Op: 0b01011010110000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b11011010110000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"cpye": {
+		// CPYE
+		{Op: 0b00011101100000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatRefWback), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdR5)}},
+	},
+	"cpyfe": {
+		// CPYFE
+		{Op: 0b00011001100000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatRefWback), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdR5)}},
+	},
+	"cpyfm": {
+		// CPYFM
+		{Op: 0b00011001010000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatRefWback), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdR5)}},
+	},
+	"cpyfp": {
+		// CPYFP
+		{Op: 0b00011001000000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatRefWback), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdR5)}},
+	},
+	"cpym": {
+		// CPYM
+		{Op: 0b00011101010000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatRefWback), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdR5)}},
+	},
+	"cpyp": {
+		// CPYP
+		{Op: 0b00011101000000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatRefWback), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdR5)}},
+	},
+	"ctz": {
+		// CTZ
+		{Op: 0b01011010110000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b11011010110000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"frint32x": {
+		// FRINT32X (scalar)
+		{Op: 0b00011110001010001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatS)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00011110011010001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatD)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// FRINT32X (vector)
+		{Op: 0b00101110001000011110100000000000,
+			Match: []arm.EncOp{mat(arm.MatV, uint8(arm.DWORD)), mat(arm.MatV, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+		{Op: 0b01101110011000011110100000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.QWORD), 2), mat(arm.MatVStatic, uint8(arm.QWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+	},
+	"frint32z": {
+		// FRINT32Z (scalar)
+		{Op: 0b00011110001010000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatS)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00011110011010000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatD)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// FRINT32Z (vector)
+		{Op: 0b00001110001000011110100000000000,
+			Match: []arm.EncOp{mat(arm.MatV, uint8(arm.DWORD)), mat(arm.MatV, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+		{Op: 0b01001110011000011110100000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.QWORD), 2), mat(arm.MatVStatic, uint8(arm.QWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+	},
+	"frint64x": {
+		// FRINT64X (scalar)
+		{Op: 0b00011110001010011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatS)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00011110011010011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatD)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// FRINT64X (vector)
+		{Op: 0b00101110001000011111100000000000,
+			Match: []arm.EncOp{mat(arm.MatV, uint8(arm.DWORD)), mat(arm.MatV, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+		{Op: 0b01101110011000011111100000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.QWORD), 2), mat(arm.MatVStatic, uint8(arm.QWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+	},
+	"frint64z": {
+		// FRINT64Z (scalar)
+		{Op: 0b00011110001010010100000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatS)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00011110011010010100000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatD)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// FRINT64Z (vector)
+		{Op: 0b00001110001000011111100000000000,
+			Match: []arm.EncOp{mat(arm.MatV, uint8(arm.DWORD)), mat(arm.MatV, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+		{Op: 0b01001110011000011111100000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.QWORD), 2), mat(arm.MatVStatic, uint8(arm.QWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRwidth30)}},
+	},
+	"gmi": {
+		// GMI
+		{Op: 0b10011010110000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatXSP), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"irg": {
+		// IRG
+		{Op: 0b10011010110000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatXSP), mat(arm.MatEnd), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"ld64b": {
+		// LD64B
+		{Op: 0b11111000001111111101000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdREven, 0), cmd(arm.CmdR5)}},
+	},
+	"ldg": {
+		// LDG
+		{Op: 0b11011001011000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+	},
+	"sete": {
+		// SETE
+		{Op: 0b00011001110000001000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"setge": {
+		// SETGE
+		{Op: 0b00011101110000001000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"setgm": {
+		// SETGM
+		{Op: 0b00011101110000000100010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"setgp": {
+		// SETGP
+		{Op: 0b00011101110000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"setm": {
+		// SETM
+		{Op: 0b00011001110000000100010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"setp": {
+		// SETP
+		{Op: 0b00011001110000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatRefWback), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdAdv), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"smax": {
+		// SMAX (scalar, immediate)
+		{Op: 0b00010001110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSfield, 10, 8)}},
+		{Op: 0b10010001110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSfield, 10, 8)}},
+		// SMAX (scalar, register)
+		{Op: 0b00011010110000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10011010110000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"smin": {
+		// SMIN (scalar, immediate)
+		{Op: 0b00010001110010000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSfield, 10, 8)}},
+		{Op: 0b10010001110010000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSfield, 10, 8)}},
+		// SMIN (scalar, register)
+		{Op: 0b00011010110000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10011010110000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"smmla": {
+		// SMMLA
+		{Op: 0b01001110100000001010010000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.BYTE), 16), mat(arm.MatVStatic, uint8(arm.BYTE), 16)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"st2g": {
+		// ST2G
+		{Op: 0b11011001101000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001101000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefPre)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001101000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefBase), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+	},
+	"st64b": {
+		// ST64B
+		{Op: 0b11111000001111111001000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdREven, 0), cmd(arm.CmdR5)}},
+	},
+	"st64bv": {
+		// ST64BV
+		{Op: 0b11111000001000001011000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR16), cmd(arm.CmdREven, 0), cmd(arm.CmdR5)}},
+	},
+	"st64bv0": {
+		// ST64BV0
+		{Op: 0b11111000001000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR16), cmd(arm.CmdREven, 0), cmd(arm.CmdR5)}},
+	},
+	"stg": {
+		// STG
+		{Op: 0b11011001001000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001001000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefPre)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001001000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefBase), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+	},
+	"stgp": {
+		// STGP
+		{Op: 0b01101001000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR10), cmd(arm.CmdR5), cmd(arm.CmdSscaled, 4)}},
+		{Op: 0b01101001100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatRefPre)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR10), cmd(arm.CmdR5), cmd(arm.CmdSscaled, 4)}},
+		{Op: 0b01101000100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatRefBase), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR10), cmd(arm.CmdR5), cmd(arm.CmdSscaled, 4)}},
+	},
+	"stz2g": {
+		// STZ2G
+		{Op: 0b11011001111000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001111000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefPre)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001111000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefBase), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+	},
+	"stzg": {
+		// STZG
+		{Op: 0b11011001011000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001011000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefPre)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+		{Op: 0b11011001011000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatRefBase), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSscaled9, 4)}},
+	},
+	"subg": {
+		// SUBG
+		{Op: 0b11010001100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatXSP), mat(arm.MatImm), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 6, 4), cmd(arm.CmdUbits, 10, 4)}},
+	},
+	"subp": {
+		// SUBP
+		{Op: 0b10011010110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatXSP), mat(arm.MatXSP)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"subps": {
+		// SUBPS
+		{Op: 0b10111010110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatXSP), mat(arm.MatXSP)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"sudot": {
+		// SUDOT (by element)
+		{Op: 0b00001111000000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 2), mat(arm.MatVStatic, uint8(arm.BYTE), 8), mat(arm.MatVStaticElement, uint8(arm.BYTE), 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdUfields11, 2)}},
+		{Op: 0b01001111000000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.BYTE), 16), mat(arm.MatVStaticElement, uint8(arm.BYTE), 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdUfields11, 2)}},
+	},
+	"umax": {
+		// UMAX (scalar, immediate)
+		{Op: 0b00010001110001000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUbits, 10, 8)}},
+		{Op: 0b10010001110001000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUbits, 10, 8)}},
+		// UMAX (scalar, register)
+		{Op: 0b00011010110000000110010000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10011010110000000110010000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"umin": {
+		// UMIN (scalar, immediate)
+		{Op: 0b00010001110011000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUbits, 10, 8)}},
+		{Op: 0b10010001110011000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUbits, 10, 8)}},
+		// UMIN (scalar, register)
+		{Op: 0b00011010110000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatW), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10011010110000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"ummla": {
+		// UMMLA
+		{Op: 0b01101110100000001010010000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.BYTE), 16), mat(arm.MatVStatic, uint8(arm.BYTE), 16)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"usdot": {
+		// USDOT (vector)
+		{Op: 0b00001110100000001001110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 2), mat(arm.MatVStatic, uint8(arm.BYTE), 8), mat(arm.MatVStatic, uint8(arm.BYTE), 8)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01001110100000001001110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.BYTE), 16), mat(arm.MatVStatic, uint8(arm.BYTE), 16)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// USDOT (by element)
+		{Op: 0b00001111100000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 2), mat(arm.MatVStatic, uint8(arm.BYTE), 8), mat(arm.MatVStaticElement, uint8(arm.BYTE), 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdUfields11, 2)}},
+		{Op: 0b01001111100000001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.BYTE), 16), mat(arm.MatVStaticElement, uint8(arm.BYTE), 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdUfields11, 2)}},
+	},
+	"usmmla": {
+		// USMMLA
+		{Op: 0b01001110100000001010110000000000,
+			Match: []arm.EncOp{mat(arm.MatVStatic, uint8(arm.DWORD), 4), mat(arm.MatVStatic, uint8(arm.BYTE), 16), mat(arm.MatVStatic, uint8(arm.BYTE), 16)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"wfet": {
+		// WFET
+		{Op: 0b11010101000000110001000000000000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+	},
+	"wfit": {
+		// WFIT
+		{Op: 0b11010101000000110001000000100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+	},
+	"xaflag": {
+		// XAFLAG
+		{Op: 0b11010101000000000100000000111111,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+	},
+}
+
+func init() {
+	for name, encs := range extEncMap {
+		EncMap[name] = append(EncMap[name], encs...)
+	}
+}
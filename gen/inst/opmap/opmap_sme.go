@@ -0,0 +1,322 @@
+package opmap
+
+import "github.com/wdamron/arm"
+
+// Scalable Matrix Extension (SME) encodings, merged into [EncMap] at init.
+var smeEncMap = map[string][]Encoding{
+	"addha": {
+		// ADDHA
+		{Op: 0b11000000100100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5)}},
+		{Op: 0b11000000110100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5)}},
+	},
+	"addspl": {
+		// ADDSPL
+		{Op: 0b00000100011000000101100000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatXSP), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16), cmd(arm.CmdSfield, 5, 6)}},
+	},
+	"addsvl": {
+		// ADDSVL
+		{Op: 0b00000100001000000101100000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatXSP), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16), cmd(arm.CmdSfield, 5, 6)}},
+	},
+	"addva": {
+		// ADDVA
+		{Op: 0b11000000100100010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5)}},
+		{Op: 0b11000000110100010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5)}},
+	},
+	"bfmopa": {
+		// BFMOPA
+		{Op: 0b10000001100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"bfmops": {
+		// BFMOPS
+		{Op: 0b10000001100000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fmopa": {
+		// FMOPA (non-widening)
+		{Op: 0b10000000100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10000000110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// FMOPA (widening)
+		{Op: 0b10000001101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fmops": {
+		// FMOPS (non-widening)
+		{Op: 0b10000000100000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10000000110000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// FMOPS (widening)
+		{Op: 0b10000001101000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"ld1b": {
+		// LD1B (scalar plus scalar, tile slice)
+		{Op: 0b11100000000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdAdv), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 4), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000000111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdAdv), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 4), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"ld1d": {
+		// LD1D (scalar plus scalar, tile slice)
+		{Op: 0b11100000110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 3)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 1, 3), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 1), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000110111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 1, 3), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 1), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"ld1h": {
+		// LD1H (scalar plus scalar, tile slice)
+		{Op: 0b11100000010000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 3, 1), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000010111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 3, 1), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"ld1q": {
+		// LD1Q
+		{Op: 0b11100001110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.OWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 4), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUrange, 0, 0, 0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100001110111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.OWORD)), mat(arm.MatPZ), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 4), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUrange, 0, 0, 0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"ld1w": {
+		// LD1W (scalar plus scalar, tile slice)
+		{Op: 0b11100000100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 2, 2), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000100111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 2, 2), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"ldr": {
+		// LDR (array vector)
+		{Op: 0b11100001000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdAdv), cmd(arm.CmdAdv), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 4), cmd(arm.CmdR5), cmd(arm.CmdUsame, 2)}},
+	},
+	"mova": {
+		// MOVA (vector to tile)
+		{Op: 0b11000000000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdAdv), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 4), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b11000000010000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 3, 1), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b11000000100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 2, 2), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b11000000110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 1, 3), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 1), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b11000000110000010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.OWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.OWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 4), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUrange, 0, 0, 0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		// MOVA (tile to vector)
+		{Op: 0b11000000000000100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZASlice, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdAdv), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 5, 4)}},
+		{Op: 0b11000000010000100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZASlice, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRbits, 8, 1), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 5, 3)}},
+		{Op: 0b11000000100000100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZASlice, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRbits, 7, 2), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 5, 2)}},
+		{Op: 0b11000000110000100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZASlice, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRbits, 6, 3), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 5, 1)}},
+		{Op: 0b11000000110000110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.OWORD)), mat(arm.MatPM), mat(arm.MatZASlice, uint8(arm.OWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRbits, 5, 4), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUrange, 5, 0, 0)}},
+	},
+	"rdsvl": {
+		// RDSVL
+		{Op: 0b00000100101111110101100000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 6)}},
+	},
+	"smopa": {
+		// SMOPA
+		{Op: 0b10100000100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100000110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"smops": {
+		// SMOPS
+		{Op: 0b10100000100000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100000110000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"smstart": {
+		// SMSTART
+		{Op: 0b11010101000000110100011101111111,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+		{Op: 0b11010101000000000100000100011111,
+			Match: []arm.EncOp{mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 5, arm.SymSVCRFIELDS)}},
+	},
+	"smstop": {
+		// SMSTOP
+		{Op: 0b11010101000000110100011001111111,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+		{Op: 0b11010101000000000100000000011111,
+			Match: []arm.EncOp{mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdLitList, 5, arm.SymSVCRFIELDS)}},
+	},
+	"st1b": {
+		// ST1B (scalar plus scalar, tile slice)
+		{Op: 0b11100000001000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.BYTE)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdAdv), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 4), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000001111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.BYTE)), mat(arm.MatP, 0), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdAdv), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 4), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"st1d": {
+		// ST1D (scalar plus scalar, tile slice)
+		{Op: 0b11100000111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 3)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 1, 3), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 1), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000111111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 1, 3), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 1), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"st1h": {
+		// ST1H (scalar plus scalar, tile slice)
+		{Op: 0b11100000011000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 3, 1), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000011111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 3, 1), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"st1q": {
+		// ST1Q
+		{Op: 0b11100001111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.OWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 4)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 4), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUrange, 0, 0, 0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100001111111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.OWORD)), mat(arm.MatP, 0), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 4), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUrange, 0, 0, 0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"st1w": {
+		// ST1W (scalar plus scalar, tile slice)
+		{Op: 0b11100000101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 2, 2), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b11100000101111110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefBase)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 2, 2), cmd(arm.CmdUbits, 15, 1), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"str": {
+		// STR (array vector)
+		{Op: 0b11100001001000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZASlice, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdAdv), cmd(arm.CmdAdv), cmd(arm.CmdRW12, 13), cmd(arm.CmdUbits, 0, 4), cmd(arm.CmdR5), cmd(arm.CmdUsame, 2)}},
+	},
+	"sumopa": {
+		// SUMOPA
+		{Op: 0b10100000101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100000111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"sumops": {
+		// SUMOPS
+		{Op: 0b10100000101000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100000111000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"umopa": {
+		// UMOPA
+		{Op: 0b10100001101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100001111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"umops": {
+		// UMOPS
+		{Op: 0b10100001101000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100001111000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"usmopa": {
+		// USMOPA
+		{Op: 0b10100001100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100001110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"usmops": {
+		// USMOPS
+		{Op: 0b10100001100000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 2), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b10100001110000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatZATile, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdRbits, 0, 3), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRLo8, 13), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"zero": {
+		// ZERO
+		{Op: 0b11000000000010000000000011111111,
+			Match: []arm.EncOp{mat(arm.MatZA)},
+			Cmds:  []arm.EncOp{}},
+	},
+}
+
+func init() {
+	for name, encs := range smeEncMap {
+		for i := range encs {
+			encs[i].Feature = smeFeature(name, encs[i])
+		}
+		EncMap[name] = append(EncMap[name], encs...)
+	}
+}
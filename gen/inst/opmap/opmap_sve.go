@@ -0,0 +1,3044 @@
+package opmap
+
+import "github.com/wdamron/arm"
+
+// Scalable Vector Extension (SVE and SVE2) encodings, merged into [EncMap] at init.
+//
+// Only a subset of SVE is implemented: contiguous, gather, and replicating loads and stores (LD1*, LD1R*, LDFF1*,
+// ST1*, LDR/STR), integer and floating-point arithmetic, reductions, comparisons, conversions between integers and
+// floats, predicate and FFR manipulation, counting, permutes, and the SVE2 bitwise ternary instructions.
+// Not yet supported:
+//   - sign-extending, non-fault, and non-temporal loads and stores (LD1S*, LDNF1*, LDNT1*, STNT1*, ...)
+//   - structure loads and stores (LD2-LD4, ST2-ST4)
+//   - prefetches (PRFB, PRFH, PRFW, PRFD)
+//   - dot products (SDOT, UDOT, ...) and matrix multiplies
+//   - DUP (indexed), TBX, and FCVT between floating-point sizes
+//   - most SVE2 widening, narrowing, and pairwise instructions, SQRDMLAH/SQRDMLSH, MATCH/NMATCH, and HISTCNT
+var sveEncMap = map[string][]Encoding{
+	"abs": {
+		// ABS (predicated)
+		{Op: 0b00000100000101101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010101101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100101101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110101101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"add": {
+		// ADD (vectors, unpredicated)
+		{Op: 0b00000100001000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// ADD (vectors, predicated)
+		{Op: 0b00000100000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// ADD (immediate)
+		{Op: 0b00100101001000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+	},
+	"addpl": {
+		// ADDPL
+		{Op: 0b00000100011000000101000000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatXSP), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16), cmd(arm.CmdSfield, 5, 6)}},
+	},
+	"addvl": {
+		// ADDVL
+		{Op: 0b00000100001000000101000000000000,
+			Match: []arm.EncOp{mat(arm.MatXSP), mat(arm.MatXSP), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16), cmd(arm.CmdSfield, 5, 6)}},
+	},
+	"and": {
+		// AND (vectors, predicated)
+		{Op: 0b00000100000110100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// AND (vectors, unpredicated)
+		{Op: 0b00000100001000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// AND (immediate)
+		{Op: 0b00000101100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSpecial, 5, arm.SpecialImmLogical32)}},
+		{Op: 0b00000101100000100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSpecial, 5, arm.SpecialImmLogical64)}},
+	},
+	"andv": {
+		// ANDV
+		{Op: 0b00000100000110100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatB), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"asr": {
+		// ASR (vectors, predicated)
+		{Op: 0b00000100000100001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// ASR (immediate, unpredicated)
+		{Op: 0b00000100001010001001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUsub, 16, 3, 8)}},
+		{Op: 0b00000100001100001001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUsub, 16, 4, 16)}},
+		{Op: 0b00000100011000001001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUsub, 16, 5, 32)}},
+		{Op: 0b00000100101000001001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSpecial, 16, arm.SpecialImmTszRight64)}},
+		// ASR (immediate, predicated)
+		{Op: 0b00000100000000001000000100000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUsub, 5, 3, 8)}},
+		{Op: 0b00000100000000001000001000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUsub, 5, 4, 16)}},
+		{Op: 0b00000100010000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUsub, 5, 5, 32)}},
+		{Op: 0b00000100100000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdSpecial, 5, arm.SpecialImmTszRight64)}},
+	},
+	"bcax": {
+		// BCAX
+		{Op: 0b00000100011000000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdR16), cmd(arm.CmdR5)}},
+	},
+	"bic": {
+		// BIC (vectors, predicated)
+		{Op: 0b00000100000110110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// BIC (vectors, unpredicated)
+		{Op: 0b00000100111000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"bsl": {
+		// BSL
+		{Op: 0b00000100001000000011110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdR16), cmd(arm.CmdR5)}},
+	},
+	"bsl1n": {
+		// BSL1N
+		{Op: 0b00000100011000000011110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdR16), cmd(arm.CmdR5)}},
+	},
+	"bsl2n": {
+		// BSL2N
+		{Op: 0b00000100101000000011110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdR16), cmd(arm.CmdR5)}},
+	},
+	"cls": {
+		// CLS (predicated)
+		{Op: 0b00000100000110001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"clz": {
+		// CLZ (predicated)
+		{Op: 0b00000100000110011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"cmpeq": {
+		// CMPEQ (vectors)
+		{Op: 0b00100100000000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100010000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100100000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100110000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// CMPEQ (immediate)
+		{Op: 0b00100101000000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101010000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101100000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101110000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+	},
+	"cmpge": {
+		// CMPGE (vectors)
+		{Op: 0b00100100000000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100010000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100100000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100110000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// CMPGE (immediate)
+		{Op: 0b00100101000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101010000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+	},
+	"cmpgt": {
+		// CMPGT (vectors)
+		{Op: 0b00100100000000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100010000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100100000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100110000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// CMPGT (immediate)
+		{Op: 0b00100101000000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101010000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101100000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101110000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+	},
+	"cmphi": {
+		// CMPHI (vectors)
+		{Op: 0b00100100000000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100010000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100100000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100110000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// CMPHI (immediate)
+		{Op: 0b00100100001000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100011000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100101000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100111000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+	},
+	"cmphs": {
+		// CMPHS (vectors)
+		{Op: 0b00100100000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100010000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// CMPHS (immediate)
+		{Op: 0b00100100001000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100011000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+	},
+	"cmple": {
+		// CMPLE (immediate)
+		{Op: 0b00100101000000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101010000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101100000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101110000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+	},
+	"cmplo": {
+		// CMPLO (immediate)
+		{Op: 0b00100100001000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100011000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100101000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100111000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+	},
+	"cmpls": {
+		// CMPLS (immediate)
+		{Op: 0b00100100001000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100011000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100101000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+		{Op: 0b00100100111000000010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUbits, 14, 7)}},
+	},
+	"cmplt": {
+		// CMPLT (immediate)
+		{Op: 0b00100101000000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101010000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101100000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101110000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+	},
+	"cmpne": {
+		// CMPNE (vectors)
+		{Op: 0b00100100000000001010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100010000001010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100100000001010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100100110000001010000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// CMPNE (immediate)
+		{Op: 0b00100101000000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101010000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101100000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00100101110000001000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 5)}},
+	},
+	"cnt": {
+		// CNT (predicated)
+		{Op: 0b00000100000110101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"cntb": {
+		// CNTB
+		{Op: 0b00000100001000001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100001000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"cntd": {
+		// CNTD
+		{Op: 0b00000100111000001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100111000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"cnth": {
+		// CNTH
+		{Op: 0b00000100011000001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100011000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"cntw": {
+		// CNTW
+		{Op: 0b00000100101000001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100101000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"compact": {
+		// COMPACT
+		{Op: 0b00000101101000011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111000011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"decb": {
+		// DECB (scalar)
+		{Op: 0b00000100001100001110011111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100001100001110010000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"decd": {
+		// DECD (scalar)
+		{Op: 0b00000100111100001110011111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100111100001110010000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"dech": {
+		// DECH (scalar)
+		{Op: 0b00000100011100001110011111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100011100001110010000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"decw": {
+		// DECW (scalar)
+		{Op: 0b00000100101100001110011111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100101100001110010000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"dup": {
+		// DUP (scalar)
+		{Op: 0b00000101001000000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatWSP)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101011000000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatWSP)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101000000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatWSP)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111000000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatXSP)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// DUP (immediate)
+		{Op: 0b00100101001110001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101011110001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101110001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111110001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 8), alt2(13, 0, 8)}},
+	},
+	"eor": {
+		// EOR (vectors, predicated)
+		{Op: 0b00000100000110010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// EOR (vectors, unpredicated)
+		{Op: 0b00000100101000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// EOR (immediate)
+		{Op: 0b00000101010000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSpecial, 5, arm.SpecialImmLogical32)}},
+		{Op: 0b00000101010000100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSpecial, 5, arm.SpecialImmLogical64)}},
+	},
+	"eor3": {
+		// EOR3
+		{Op: 0b00000100001000000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdR16), cmd(arm.CmdR5)}},
+	},
+	"eorv": {
+		// EORV
+		{Op: 0b00000100000110010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatB), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"ext": {
+		// EXT
+		{Op: 0b00000101001000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdR5), cmd(arm.CmdChkUbits, 8), cmd(arm.CmdUslice, 10, 3, 0), cmd(arm.CmdUslice, 16, 5, 3), cmd(arm.CmdAdv)}},
+	},
+	"fabd": {
+		// FABD (vectors, predicated)
+		{Op: 0b01100101010010001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100010001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110010001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fabs": {
+		// FABS
+		{Op: 0b00000100010111001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100111001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110111001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fadd": {
+		// FADD (vectors, unpredicated)
+		{Op: 0b01100101010000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// FADD (vectors, predicated)
+		{Op: 0b01100101010000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"faddv": {
+		// FADDV
+		{Op: 0b01100101010000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fcmeq": {
+		// FCMEQ (vectors)
+		{Op: 0b01100101010000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fcmge": {
+		// FCMGE (vectors)
+		{Op: 0b01100101010000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fcmgt": {
+		// FCMGT (vectors)
+		{Op: 0b01100101010000000100000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000100000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000100000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fcmne": {
+		// FCMNE (vectors)
+		{Op: 0b01100101010000000110000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000110000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000110000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fcvtzs": {
+		// FCVTZS
+		{Op: 0b01100101010110101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100111001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110111101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fcvtzu": {
+		// FCVTZU
+		{Op: 0b01100101010110111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100111011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110111111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fdiv": {
+		// FDIV (vectors, predicated)
+		{Op: 0b01100101010011011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100011011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110011011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fdivr": {
+		// FDIVR (vectors, predicated)
+		{Op: 0b01100101010011001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100011001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110011001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fdup": {
+		// FDUP
+		{Op: 0b00100101011110011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+		{Op: 0b00100101101110011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+		{Op: 0b00100101111110011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+	},
+	"fmax": {
+		// FMAX (vectors, predicated)
+		{Op: 0b01100101010001101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fmaxnm": {
+		// FMAXNM (vectors, predicated)
+		{Op: 0b01100101010001001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fmaxnmv": {
+		// FMAXNMV
+		{Op: 0b01100101010001000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fmaxv": {
+		// FMAXV
+		{Op: 0b01100101010001100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fmin": {
+		// FMIN (vectors, predicated)
+		{Op: 0b01100101010001111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fminnm": {
+		// FMINNM (vectors, predicated)
+		{Op: 0b01100101010001011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fminnmv": {
+		// FMINNMV
+		{Op: 0b01100101010001010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fminv": {
+		// FMINV
+		{Op: 0b01100101010001110010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001110010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001110010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fmla": {
+		// FMLA (vectors)
+		{Op: 0b01100101011000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// FMLA (indexed)
+		{Op: 0b01100100001000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZElement, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo8, 16), cmd(arm.CmdChkUbits, 3), cmd(arm.CmdUslice, 19, 2, 0), cmd(arm.CmdUslice, 22, 1, 2), cmd(arm.CmdAdv)}},
+		{Op: 0b01100100101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZElement, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo8, 16), cmd(arm.CmdUbits, 19, 2)}},
+		{Op: 0b01100100111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZElement, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo16), cmd(arm.CmdUbits, 20, 1)}},
+	},
+	"fmls": {
+		// FMLS (vectors)
+		{Op: 0b01100101011000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101101000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101111000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// FMLS (indexed)
+		{Op: 0b01100100001000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZElement, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo8, 16), cmd(arm.CmdChkUbits, 3), cmd(arm.CmdUslice, 19, 2, 0), cmd(arm.CmdUslice, 22, 1, 2), cmd(arm.CmdAdv)}},
+		{Op: 0b01100100101000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZElement, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo8, 16), cmd(arm.CmdUbits, 19, 2)}},
+		{Op: 0b01100100111000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZElement, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo16), cmd(arm.CmdUbits, 20, 1)}},
+	},
+	"fmov": {
+		// FMOV (zero, predicated)
+		{Op: 0b00000101010100000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16)}},
+		{Op: 0b00000101100100000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16)}},
+		{Op: 0b00000101110100000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16)}},
+		// FMOV (zero, unpredicated)
+		{Op: 0b00100101011110001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101101110001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101111110001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatLitFloat, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		// FMOV (immediate, predicated)
+		{Op: 0b00000101010100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+		{Op: 0b00000101100100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+		{Op: 0b00000101110100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR16), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+		// FMOV (immediate, unpredicated)
+		{Op: 0b00100101011110011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+		{Op: 0b00100101101110011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+		{Op: 0b00100101111110011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatFloat)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSpecial, 5, arm.SpecialImmFloat)}},
+	},
+	"fmul": {
+		// FMUL (vectors, unpredicated)
+		{Op: 0b01100101010000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// FMUL (vectors, predicated)
+		{Op: 0b01100101010000101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// FMUL (indexed)
+		{Op: 0b01100100001000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZElement, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo8, 16), cmd(arm.CmdChkUbits, 3), cmd(arm.CmdUslice, 19, 2, 0), cmd(arm.CmdUslice, 22, 1, 2), cmd(arm.CmdAdv)}},
+		{Op: 0b01100100101000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZElement, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo8, 16), cmd(arm.CmdUbits, 19, 2)}},
+		{Op: 0b01100100111000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZElement, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdRLo16), cmd(arm.CmdUbits, 20, 1)}},
+	},
+	"fmulx": {
+		// FMULX (vectors, predicated)
+		{Op: 0b01100101010010101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100010101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110010101000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fneg": {
+		// FNEG
+		{Op: 0b00000100010111011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100111011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110111011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fnmla": {
+		// FNMLA (vectors)
+		{Op: 0b01100101011000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101101000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101111000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fnmls": {
+		// FNMLS (vectors)
+		{Op: 0b01100101011000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101101000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101111000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"frecps": {
+		// FRECPS (vectors, unpredicated)
+		{Op: 0b01100101010000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"frecpx": {
+		// FRECPX
+		{Op: 0b01100101010011001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100011001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110011001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frinta": {
+		// FRINTA
+		{Op: 0b01100101010001001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frinti": {
+		// FRINTI
+		{Op: 0b01100101010001111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frintm": {
+		// FRINTM
+		{Op: 0b01100101010000101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frintn": {
+		// FRINTN
+		{Op: 0b01100101010000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frintp": {
+		// FRINTP
+		{Op: 0b01100101010000011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frintx": {
+		// FRINTX
+		{Op: 0b01100101010001101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100001101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110001101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frintz": {
+		// FRINTZ
+		{Op: 0b01100101010000111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"frsqrts": {
+		// FRSQRTS (vectors, unpredicated)
+		{Op: 0b01100101010000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"fscale": {
+		// FSCALE (vectors, predicated)
+		{Op: 0b01100101010010011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100010011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110010011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fsqrt": {
+		// FSQRT
+		{Op: 0b01100101010011011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100011011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110011011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"fsub": {
+		// FSUB (vectors, unpredicated)
+		{Op: 0b01100101010000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101100000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b01100101110000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// FSUB (vectors, predicated)
+		{Op: 0b01100101010000011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"fsubr": {
+		// FSUBR (vectors, predicated)
+		{Op: 0b01100101010000111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100000111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110000111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"incb": {
+		// INCB (scalar)
+		{Op: 0b00000100001100001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100001100001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"incd": {
+		// INCD (scalar)
+		{Op: 0b00000100111100001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100111100001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"inch": {
+		// INCH (scalar)
+		{Op: 0b00000100011100001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100011100001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"incw": {
+		// INCW (scalar)
+		{Op: 0b00000100101100001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00000100101100001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatSymbol), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymMUL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS), cmd(arm.CmdUrange, 16, 1, 16)}},
+	},
+	"index": {
+		// INDEX (immediates)
+		{Op: 0b00000100001000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00000100011000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00000100101000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 5), cmd(arm.CmdSfield, 16, 5)}},
+		{Op: 0b00000100111000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 5), cmd(arm.CmdSfield, 16, 5)}},
+		// INDEX (scalars)
+		{Op: 0b00000100001000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"ld1b": {
+		// LD1B (scalar plus immediate)
+		{Op: 0b10100100000000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b10100100001000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b10100100010000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b10100100011000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// LD1B (scalar plus scalar)
+		{Op: 0b10100100000000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10100100001000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10100100010000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10100100011000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// LD1B (scalar plus vector)
+		{Op: 0b11000100010000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10000100000000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 22), cmd(arm.CmdAdv)}},
+		// LD1B (vector plus immediate)
+		{Op: 0b10000100001000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 0)}},
+		{Op: 0b11000100001000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 0)}},
+	},
+	"ld1d": {
+		// LD1D (scalar plus immediate)
+		{Op: 0b10100101111000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// LD1D (scalar plus scalar)
+		{Op: 0b10100101111000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 3)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// LD1D (scalar plus vector)
+		{Op: 0b11000101110000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11000101111000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 3)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+	},
+	"ld1h": {
+		// LD1H (scalar plus immediate)
+		{Op: 0b10100100101000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b10100100110000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b10100100111000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// LD1H (scalar plus scalar)
+		{Op: 0b10100100101000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10100100110000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10100100111000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// LD1H (scalar plus vector)
+		{Op: 0b11000100110000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11000100111000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10000100100000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 22), cmd(arm.CmdAdv)}},
+		{Op: 0b10000100101000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 22), cmd(arm.CmdAdv)}},
+		// LD1H (vector plus immediate)
+		{Op: 0b10000100101000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 1)}},
+		{Op: 0b11000100101000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 1)}},
+	},
+	"ld1rb": {
+		// LD1RB
+		{Op: 0b10000100010000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 6, 0)}},
+	},
+	"ld1rd": {
+		// LD1RD
+		{Op: 0b10000101110000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 6, 3)}},
+	},
+	"ld1rh": {
+		// LD1RH
+		{Op: 0b10000100110000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 6, 1)}},
+	},
+	"ld1rw": {
+		// LD1RW
+		{Op: 0b10000101010000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefOffset)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 6, 2)}},
+	},
+	"ld1w": {
+		// LD1W (scalar plus immediate)
+		{Op: 0b10100101010000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b10100101011000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// LD1W (scalar plus scalar)
+		{Op: 0b10100101010000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10100101011000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// LD1W (scalar plus vector)
+		{Op: 0b11000101010000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11000101011000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b10000101000000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 22), cmd(arm.CmdAdv)}},
+		{Op: 0b10000101001000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 22), cmd(arm.CmdAdv)}},
+		// LD1W (vector plus immediate)
+		{Op: 0b10000101001000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 2)}},
+		{Op: 0b11000101001000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 2)}},
+	},
+	"ldff1b": {
+		// LDFF1B (scalar plus scalar)
+		{Op: 0b10100100000000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+	},
+	"ldff1d": {
+		// LDFF1D (scalar plus scalar)
+		{Op: 0b10100101111000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 3)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+	},
+	"ldff1h": {
+		// LDFF1H (scalar plus scalar)
+		{Op: 0b10100100101000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+	},
+	"ldff1w": {
+		// LDFF1W (scalar plus scalar)
+		{Op: 0b10100101010000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatRefIndexLSL, 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+	},
+	"ldr": {
+		// LDR (vector)
+		{Op: 0b10000101100000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdChkSbits, 9), cmd(arm.CmdSslice, 10, 3, 0), cmd(arm.CmdSslice, 16, 6, 3), cmd(arm.CmdAdv)}},
+		// LDR (predicate)
+		{Op: 0b10000101100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdChkSbits, 9), cmd(arm.CmdSslice, 10, 3, 0), cmd(arm.CmdSslice, 16, 6, 3), cmd(arm.CmdAdv)}},
+	},
+	"lsl": {
+		// LSL (vectors, predicated)
+		{Op: 0b00000100000100111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// LSL (immediate, unpredicated)
+		{Op: 0b00000100001010001001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUbits, 16, 3)}},
+		{Op: 0b00000100001100001001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUbits, 16, 4)}},
+		{Op: 0b00000100011000001001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUbits, 16, 5)}},
+		{Op: 0b00000100101000001001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdChkUbits, 6), cmd(arm.CmdUslice, 16, 5, 0), cmd(arm.CmdUslice, 22, 1, 5), cmd(arm.CmdAdv)}},
+		// LSL (immediate, predicated)
+		{Op: 0b00000100000000111000000100000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUbits, 5, 3)}},
+		{Op: 0b00000100000000111000001000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUbits, 5, 4)}},
+		{Op: 0b00000100010000111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUbits, 5, 5)}},
+		{Op: 0b00000100100000111000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdChkUbits, 6), cmd(arm.CmdUslice, 5, 5, 0), cmd(arm.CmdUslice, 22, 1, 5), cmd(arm.CmdAdv)}},
+	},
+	"lsr": {
+		// LSR (vectors, predicated)
+		{Op: 0b00000100000100011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// LSR (immediate, unpredicated)
+		{Op: 0b00000100001010001001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUsub, 16, 3, 8)}},
+		{Op: 0b00000100001100001001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUsub, 16, 4, 16)}},
+		{Op: 0b00000100011000001001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdUsub, 16, 5, 32)}},
+		{Op: 0b00000100101000001001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdSpecial, 16, arm.SpecialImmTszRight64)}},
+		// LSR (immediate, predicated)
+		{Op: 0b00000100000000011000000100000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUsub, 5, 3, 8)}},
+		{Op: 0b00000100000000011000001000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUsub, 5, 4, 16)}},
+		{Op: 0b00000100010000011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdUsub, 5, 5, 32)}},
+		{Op: 0b00000100100000011000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdSpecial, 5, arm.SpecialImmTszRight64)}},
+	},
+	"movprfx": {
+		// MOVPRFX (unpredicated)
+		{Op: 0b00000100001000001011110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, 0), mat(arm.MatZ, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// MOVPRFX (predicated)
+		{Op: 0b00000100000100000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100000100010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPZ), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"mul": {
+		// MUL (vectors, unpredicated)
+		{Op: 0b00000100001000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// MUL (vectors, predicated)
+		{Op: 0b00000100000100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// MUL (immediate)
+		{Op: 0b00100101001100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101011100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101101100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101111100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+	},
+	"nbsl": {
+		// NBSL
+		{Op: 0b00000100111000000011110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdR16), cmd(arm.CmdR5)}},
+	},
+	"neg": {
+		// NEG (predicated)
+		{Op: 0b00000100000101111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010101111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100101111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110101111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"not": {
+		// NOT (predicated)
+		{Op: 0b00000100000111101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010111101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100111101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110111101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"orr": {
+		// ORR (vectors, predicated)
+		{Op: 0b00000100000110000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// ORR (vectors, unpredicated)
+		{Op: 0b00000100011000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// ORR (immediate)
+		{Op: 0b00000101000000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSpecial, 5, arm.SpecialImmLogical32)}},
+		{Op: 0b00000101000000100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSpecial, 5, arm.SpecialImmLogical64)}},
+	},
+	"orv": {
+		// ORV
+		{Op: 0b00000100000110000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatB), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010110000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100110000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110110000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"pfalse": {
+		// PFALSE
+		{Op: 0b00100101000110001110010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+	},
+	"ptest": {
+		// PTEST
+		{Op: 0b00100101010100001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, 0), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR10), cmd(arm.CmdR5)}},
+	},
+	"ptrue": {
+		// PTRUE
+		{Op: 0b00100101000110001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101000110001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+		{Op: 0b00100101010110001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101010110001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+		{Op: 0b00100101100110001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101100110001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+		{Op: 0b00100101110110001110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101110110001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+	},
+	"ptrues": {
+		// PTRUES
+		{Op: 0b00100101000110011110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101000110011110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+		{Op: 0b00100101010110011110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101010110011110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+		{Op: 0b00100101100110011110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101100110011110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+		{Op: 0b00100101110110011110001111100000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		{Op: 0b00100101110110011110000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatSymbol)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdLitList, 5, arm.SymSVEPATTERNS)}},
+	},
+	"rdffr": {
+		// RDFFR (unpredicated)
+		{Op: 0b00100101000110011111000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0)}},
+		// RDFFR, RDFFRS (predicated)
+		{Op: 0b00100101000110001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"rdffrs": {
+		// RDFFR, RDFFRS (predicated)
+		{Op: 0b00100101010110001111000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatPZ)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"rdvl": {
+		// RDVL
+		{Op: 0b00000100101111110101000000000000,
+			Match: []arm.EncOp{mat(arm.MatX), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdSfield, 5, 6)}},
+	},
+	"rev": {
+		// REV (vector)
+		{Op: 0b00000101001110000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101011110000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101110000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111110000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		// REV (predicate)
+		{Op: 0b00000101001101000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101011101000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101101000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111101000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"sabd": {
+		// SABD (vectors, predicated)
+		{Op: 0b00000100000011000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010011000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100011000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110011000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"saddv": {
+		// SADDV
+		{Op: 0b00000100000000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100000000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"scvtf": {
+		// SCVTF
+		{Op: 0b01100101010100101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100101001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110101101010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"sdiv": {
+		// SDIV (vectors, predicated)
+		{Op: 0b00000100100101000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110101000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"sdivr": {
+		// SDIVR (vectors, predicated)
+		{Op: 0b00000100100101100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110101100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"sel": {
+		// SEL (vectors)
+		{Op: 0b00000101001000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR10), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"setffr": {
+		// SETFFR
+		{Op: 0b00100101001011001001000000000000,
+			Match: []arm.EncOp{},
+			Cmds:  []arm.EncOp{}},
+	},
+	"smax": {
+		// SMAX (vectors, predicated)
+		{Op: 0b00000100000010000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// SMAX (immediate)
+		{Op: 0b00100101001010001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101011010001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101101010001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101111010001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+	},
+	"smaxv": {
+		// SMAXV
+		{Op: 0b00000100000010000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatB), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010000010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"smin": {
+		// SMIN (vectors, predicated)
+		{Op: 0b00000100000010100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// SMIN (immediate)
+		{Op: 0b00100101001010101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101011010101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101101010101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+		{Op: 0b00100101111010101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdSfield, 5, 8)}},
+	},
+	"sminv": {
+		// SMINV
+		{Op: 0b00000100000010100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatB), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010100010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"smulh": {
+		// SMULH (vectors, unpredicated)
+		{Op: 0b00000100001000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// SMULH (vectors, predicated)
+		{Op: 0b00000100000100100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100100000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"splice": {
+		// SPLICE
+		{Op: 0b00000101001011001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000101011011001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101011001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111011001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"sqadd": {
+		// SQADD (vectors, unpredicated)
+		{Op: 0b00000100001000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// SQADD (immediate)
+		{Op: 0b00100101001001001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011001001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101001001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111001001100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+	},
+	"sqdmulh": {
+		// SQDMULH (vectors, unpredicated)
+		{Op: 0b00000100001000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"sqsub": {
+		// SQSUB (vectors, unpredicated)
+		{Op: 0b00000100001000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// SQSUB (immediate)
+		{Op: 0b00100101001001101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011001101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101001101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111001101100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+	},
+	"st1b": {
+		// ST1B (scalar plus immediate)
+		{Op: 0b11100100000000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.BYTE)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b11100100001000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b11100100010000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b11100100011000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// ST1B (scalar plus scalar)
+		{Op: 0b11100100000000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.BYTE)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100001000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100010000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100011000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// ST1B (scalar plus vector)
+		{Op: 0b11100100000000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100010000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 14), cmd(arm.CmdAdv)}},
+		// ST1B (vector plus immediate)
+		{Op: 0b11100100011000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 0)}},
+		{Op: 0b11100100010000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 0)}},
+	},
+	"st1d": {
+		// ST1D (scalar plus immediate)
+		{Op: 0b11100101111000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// ST1D (scalar plus scalar)
+		{Op: 0b11100101111000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 3)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// ST1D (scalar plus vector)
+		{Op: 0b11100101100000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100101101000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 3)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+	},
+	"st1h": {
+		// ST1H (scalar plus immediate)
+		{Op: 0b11100100101000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b11100100110000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b11100100111000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// ST1H (scalar plus scalar)
+		{Op: 0b11100100101000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100110000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100111000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// ST1H (scalar plus vector)
+		{Op: 0b11100100100000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100101000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100110000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 14), cmd(arm.CmdAdv)}},
+		{Op: 0b11100100111000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 1)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 14), cmd(arm.CmdAdv)}},
+		// ST1H (vector plus immediate)
+		{Op: 0b11100100111000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 1)}},
+		{Op: 0b11100100110000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 1)}},
+	},
+	"st1w": {
+		// ST1W (scalar plus immediate)
+		{Op: 0b11100101010000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		{Op: 0b11100101011000001110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdSfield, 16, 4)}},
+		// ST1W (scalar plus scalar)
+		{Op: 0b11100101010000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100101011000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexLSL, 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdRNz16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		// ST1W (scalar plus vector)
+		{Op: 0b11100101000000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100101001000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.QWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdAdv), cmd(arm.CmdAdv)}},
+		{Op: 0b11100101010000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 0)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 14), cmd(arm.CmdAdv)}},
+		{Op: 0b11100101011000001000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefIndexZ, uint8(arm.DWORD), 2)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdR16), cmd(arm.CmdXs, 14), cmd(arm.CmdAdv)}},
+		// ST1W (vector plus immediate)
+		{Op: 0b11100101011000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatP, 0), mat(arm.MatRefZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 2)}},
+		{Op: 0b11100101010000001010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatP, 0), mat(arm.MatRefZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5), cmd(arm.CmdUscaled, 16, 5, 2)}},
+	},
+	"str": {
+		// STR (vector)
+		{Op: 0b11100101100000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdChkSbits, 9), cmd(arm.CmdSslice, 10, 3, 0), cmd(arm.CmdSslice, 16, 6, 3), cmd(arm.CmdAdv)}},
+		// STR (predicate)
+		{Op: 0b11100101100000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, 0), mat(arm.MatRefVL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdChkSbits, 9), cmd(arm.CmdSslice, 10, 3, 0), cmd(arm.CmdSslice, 16, 6, 3), cmd(arm.CmdAdv)}},
+	},
+	"sub": {
+		// SUB (vectors, unpredicated)
+		{Op: 0b00000100001000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// SUB (vectors, predicated)
+		{Op: 0b00000100000000010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010000010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100000010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110000010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// SUB (immediate)
+		{Op: 0b00100101001000011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011000011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101000011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111000011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+	},
+	"subr": {
+		// SUBR (vectors, predicated)
+		{Op: 0b00000100000000110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010000110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100000110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110000110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// SUBR (immediate)
+		{Op: 0b00100101001000111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011000111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101000111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111000111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+	},
+	"sunpkhi": {
+		// SUNPKHI, SUNPKLO
+		{Op: 0b00000101011100010011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101100010011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111100010011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"sunpklo": {
+		// SUNPKHI, SUNPKLO
+		{Op: 0b00000101011100000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101100000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111100000011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"tbl": {
+		// TBL
+		{Op: 0b00000101001000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZList, 1, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZList, 1, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZList, 1, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000011000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZList, 1, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"trn1": {
+		// TRN1, TRN2 (vectors)
+		{Op: 0b00000101001000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000111000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// TRN1, TRN2 (predicates)
+		{Op: 0b00000101001000000101000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000101000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000101000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000101000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"trn2": {
+		// TRN1, TRN2 (vectors)
+		{Op: 0b00000101001000000111010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000111010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000111010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000111010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// TRN1, TRN2 (predicates)
+		{Op: 0b00000101001000000101010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000101010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000101010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000101010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"uabd": {
+		// UABD (vectors, predicated)
+		{Op: 0b00000100000011010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010011010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100011010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110011010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"uaddv": {
+		// UADDV
+		{Op: 0b00000100000000010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010000010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100000010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110000010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"ucvtf": {
+		// UCVTF
+		{Op: 0b01100101010100111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101100101011010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b01100101110101111010000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"udiv": {
+		// UDIV (vectors, predicated)
+		{Op: 0b00000100100101010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110101010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"udivr": {
+		// UDIVR (vectors, predicated)
+		{Op: 0b00000100100101110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110101110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"umax": {
+		// UMAX (vectors, predicated)
+		{Op: 0b00000100000010010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010010000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// UMAX (immediate)
+		{Op: 0b00100101001010011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011010011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101101010011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101111010011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+	},
+	"umaxv": {
+		// UMAXV
+		{Op: 0b00000100000010010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatB), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010010010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"umin": {
+		// UMIN (vectors, predicated)
+		{Op: 0b00000100000010110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		// UMIN (immediate)
+		{Op: 0b00100101001010111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011010111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101101010111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101111010111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+	},
+	"uminv": {
+		// UMINV
+		{Op: 0b00000100000010110010000000000000,
+			Match: []arm.EncOp{mat(arm.MatB), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010010110010000000000000,
+			Match: []arm.EncOp{mat(arm.MatH), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100010110010000000000000,
+			Match: []arm.EncOp{mat(arm.MatS), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110010110010000000000000,
+			Match: []arm.EncOp{mat(arm.MatD), mat(arm.MatP, 0), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdR5)}},
+	},
+	"umulh": {
+		// UMULH (vectors, unpredicated)
+		{Op: 0b00000100001000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// UMULH (vectors, predicated)
+		{Op: 0b00000100000100110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100010100110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100100100110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+		{Op: 0b00000100110100110000000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatPM), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRLo8, 10), cmd(arm.CmdRSame, 2), cmd(arm.CmdR5)}},
+	},
+	"uqadd": {
+		// UQADD (vectors, unpredicated)
+		{Op: 0b00000100001000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// UQADD (immediate)
+		{Op: 0b00100101001001011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011001011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101001011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111001011100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+	},
+	"uqsub": {
+		// UQSUB (vectors, unpredicated)
+		{Op: 0b00000100001000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100011000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100101000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000100111000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// UQSUB (immediate)
+		{Op: 0b00100101001001111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatImm)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8)}},
+		{Op: 0b00100101011001111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101101001111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+		{Op: 0b00100101111001111100000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatImm), mat(arm.MatEnd), mat(arm.MatLitMod, arm.SymLSL)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdRSame, 1), cmd(arm.CmdUbits, 5, 8), alt2(13, 0, 8)}},
+	},
+	"uunpkhi": {
+		// UUNPKHI, UUNPKLO
+		{Op: 0b00000101011100110011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101100110011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111100110011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"uunpklo": {
+		// UUNPKHI, UUNPKLO
+		{Op: 0b00000101011100100011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101101100100011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+		{Op: 0b00000101111100100011100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5)}},
+	},
+	"uzp1": {
+		// UZP1, UZP2 (vectors)
+		{Op: 0b00000101001000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000110100000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// UZP1, UZP2 (predicates)
+		{Op: 0b00000101001000000100100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000100100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000100100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000100100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"uzp2": {
+		// UZP1, UZP2 (vectors)
+		{Op: 0b00000101001000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000110110000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// UZP1, UZP2 (predicates)
+		{Op: 0b00000101001000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000100110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilege": {
+		// WHILEGE
+		{Op: 0b00100101001000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilegt": {
+		// WHILEGT
+		{Op: 0b00100101001000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001000000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilehi": {
+		// WHILEHI
+		{Op: 0b00100101001000000000100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001100000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilehs": {
+		// WHILEHS
+		{Op: 0b00100101001000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001100000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilele": {
+		// WHILELE
+		{Op: 0b00100101001000000000010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001010000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilelo": {
+		// WHILELO
+		{Op: 0b00100101001000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001110000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilels": {
+		// WHILELS
+		{Op: 0b00100101001000000000110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001110000010000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"whilelt": {
+		// WHILELT
+		{Op: 0b00100101001000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101001000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101011000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101101000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000000010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatW), mat(arm.MatW)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00100101111000000001010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatX), mat(arm.MatX)},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"wrffr": {
+		// WRFFR
+		{Op: 0b00100101001010001001000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR5)}},
+	},
+	"zip1": {
+		// ZIP1, ZIP2 (vectors)
+		{Op: 0b00000101001000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000110000000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// ZIP1, ZIP2 (predicates)
+		{Op: 0b00000101001000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000100000000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+	"zip2": {
+		// ZIP1, ZIP2 (vectors)
+		{Op: 0b00000101001000000110010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE)), mat(arm.MatZ, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000110010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD)), mat(arm.MatZ, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000110010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD)), mat(arm.MatZ, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000110010000000000,
+			Match: []arm.EncOp{mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD)), mat(arm.MatZ, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		// ZIP1, ZIP2 (predicates)
+		{Op: 0b00000101001000000100010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE)), mat(arm.MatP, uint8(arm.BYTE))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101011000000100010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD)), mat(arm.MatP, uint8(arm.WORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101101000000100010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD)), mat(arm.MatP, uint8(arm.DWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+		{Op: 0b00000101111000000100010000000000,
+			Match: []arm.EncOp{mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD)), mat(arm.MatP, uint8(arm.QWORD))},
+			Cmds:  []arm.EncOp{cmd(arm.CmdR0), cmd(arm.CmdR5), cmd(arm.CmdR16)}},
+	},
+}
+
+func init() {
+	for name, encs := range sveEncMap {
+		for i := range encs {
+			encs[i].Feature = sveFeature(name, encs[i])
+		}
+		EncMap[name] = append(EncMap[name], encs...)
+	}
+}
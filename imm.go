@@ -0,0 +1,162 @@
+package arm
+
+import (
+	"math"
+	"math/bits"
+)
+
+// IsLogicalImm returns true if v is encodable as a logical (bitmask) immediate for AND, ORR, EOR, ANDS, and TST
+// with 32-bit (size [DWORD]) or 64-bit (size [QWORD]) registers.
+func IsLogicalImm(v uint64, size Size) bool {
+	_, ok := EncodeLogicalImm(v, size)
+	return ok
+}
+
+// EncodeLogicalImm returns the 13-bit N:immr:imms encoding of a logical (bitmask) immediate with 32-bit (size [DWORD])
+// or 64-bit (size [QWORD]) elements. Logical immediates are a rotated run of ones within an element of 2, 4, 8, 16,
+// 32, or 64 bits, replicated across the register. All-zero and all-ones values are not encodable.
+func EncodeLogicalImm(v uint64, size Size) (enc uint16, ok bool) {
+	switch size {
+	case DWORD:
+		if v > math.MaxUint32 {
+			return 0, false
+		}
+		v |= v << 32
+	case QWORD:
+	default:
+		return 0, false
+	}
+	transitions := v ^ (bits.RotateLeft64(v, -1))
+	div := uint64(bits.OnesCount64(transitions))
+	if div == 0 {
+		return 0, false
+	}
+	elemSize := uint64(128) / div
+	if v != bits.RotateLeft64(v, int(elemSize)) {
+		return 0, false
+	}
+	elem := v & ((1 << elemSize) - 1)
+	ones := uint64(bits.OnesCount64(elem))
+	imms := (^((elemSize << 1) - 1) & 0x7F) | (ones - 1)
+	var immr uint64
+	if elem&1 != 0 {
+		immr = ones - uint64(bits.TrailingZeros64(^elem))
+	} else {
+		immr = elemSize - uint64(bits.TrailingZeros64(elem))
+	}
+	var n uint16
+	if imms&0x40 == 0 {
+		n = 1
+	}
+	imms &= 0x3F
+	return (n << 12) | (uint16(immr) << 6) | uint16(imms), true
+}
+
+// DecodeLogicalImm returns the value of a 13-bit N:immr:imms logical immediate with 32-bit (size [DWORD]) or 64-bit
+// (size [QWORD]) elements, or false if the encoding is reserved. Bits of immr beyond the element size are ignored.
+func DecodeLogicalImm(enc uint16, size Size) (uint64, bool) {
+	n, immr, imms := uint64(enc>>12)&1, uint64(enc>>6)&0x3F, uint64(enc)&0x3F
+	if enc>>13 != 0 || (size != DWORD && size != QWORD) || (size == DWORD && n != 0) {
+		return 0, false
+	}
+	length := bits.Len64(n<<6|^imms&0x3F) - 1
+	if length < 1 {
+		return 0, false
+	}
+	levels := uint64(1)<<length - 1
+	if imms&levels == levels { // all ones within the element
+		return 0, false
+	}
+	elemSize := uint(1) << length
+	s, r := imms&levels, immr&levels
+	elem := uint64(1)<<(s+1) - 1
+	if r != 0 {
+		elem = (elem>>r | elem<<(elemSize-uint(r))) & (1<<elemSize - 1)
+	}
+	for ; elemSize < 64; elemSize *= 2 {
+		elem |= elem << elemSize
+	}
+	if size == DWORD {
+		elem &= math.MaxUint32
+	}
+	return elem, true
+}
+
+// EncodeWideImm returns the 16-bit immediate and left shift (0, 16, 32, or 48) which produce v for MOVZ with
+// 32-bit (size [DWORD]) or 64-bit (size [QWORD]) registers. Values for MOVN may be encoded by inverting v.
+func EncodeWideImm(v uint64, size Size) (imm uint16, shift uint8, ok bool) {
+	switch size {
+	case DWORD:
+		if v > math.MaxUint32 {
+			return 0, 0, false
+		}
+	case QWORD:
+	default:
+		return 0, 0, false
+	}
+	pos := uint8(bits.TrailingZeros64(v)) & 0b110000
+	if size == DWORD {
+		pos &= 0b10000
+	}
+	if uint64(uint16(v>>pos))<<pos != v {
+		return 0, 0, false
+	}
+	return uint16(v >> pos), pos, true
+}
+
+// IsFP8 returns true if f is exactly representable as an 8-bit floating-point immediate, which is
+// required for [Float], [Double], and [Half] arguments to FMOV (immediate) and FDUP. Encodable values
+// are ±n/16 × 2^r, with 16 ≤ n ≤ 31 and -3 ≤ r ≤ 4.
+func IsFP8(f float64) bool {
+	_, ok := EncodeFP8(f)
+	return ok
+}
+
+// EncodeFP8 returns the 8-bit floating-point immediate (sign, 3-bit exponent, 4-bit fraction) for f.
+// Values which are not exactly representable are rejected rather than rounded.
+func EncodeFP8(f float64) (uint8, bool) { return encFP8(math.Float64bits(f)) }
+
+// DecodeFP8 returns the value of an 8-bit floating-point immediate.
+func DecodeFP8(imm uint8) float64 {
+	v := uint64(imm&0x80)<<56 | uint64(imm&0x3F)<<48
+	if imm&0x40 != 0 {
+		v |= 0xFF << 54 // exponent 0b0111111111xx
+	} else {
+		v |= 1 << 62 // exponent 0b1000000000xx
+	}
+	return math.Float64frombits(v)
+}
+
+// encFP8 returns the 8-bit floating-point immediate for the bits of a 64-bit float.
+func encFP8(v uint64) (uint8, bool) {
+	if chk := (v >> 54) & 0x1FF; (chk != 0b100000000 && chk != 0b011111111) || v&(1<<48-1) != 0 {
+		return 0, false
+	}
+	return uint8((v>>56)&0x80 | (v>>48)&0x7F), true
+}
+
+// EncodeByteMask returns the 8-bit immediate for a 64-bit value in which each byte is either 0x00 or 0xFF,
+// as used by MOVI (64-bit scalar and 2D vector forms). Bit i of the immediate selects byte i of the value.
+func EncodeByteMask(v uint64) (uint8, bool) {
+	chk := v & 0x0101010101010101
+	chk |= chk << 1
+	chk |= chk << 2
+	chk |= chk << 4
+	if v != chk {
+		return 0, false
+	}
+	masked := v & 0x8040201008040201
+	masked |= masked >> 32
+	masked |= masked >> 16
+	masked |= masked >> 8
+	return uint8(masked), true
+}
+
+// DecodeByteMask returns the 64-bit value for an 8-bit byte-mask immediate.
+func DecodeByteMask(imm uint8) uint64 {
+	v := uint64(imm)
+	v = (v | v<<28) & 0x0000000F0000000F
+	v = (v | v<<14) & 0x0003000300030003
+	v = (v | v<<7) & 0x0101010101010101
+	return v * 0xFF
+}
@@ -3,755 +3,1826 @@
 
 package arm
 
-// Inst is an instruction mnemonic. The integer value is an offset into the Patterns array.
+// Inst is an instruction mnemonic. The integer value is an index into the PatternOffsets array.
 type Inst uint16
 
 // Instructions
 const (
 	ABS       Inst = 1
-	ADC       Inst = 37
-	ADCS      Inst = 50
-	ADD       Inst = 63
-	ADDHN     Inst = 172
-	ADDHN2    Inst = 209
-	ADDP      Inst = 246
-	ADDS      Inst = 293
-	ADDV      Inst = 357
-	ADR       Inst = 377
-	ADRP      Inst = 383
-	AESD      Inst = 389
-	AESE      Inst = 399
-	AESIMC    Inst = 409
-	AESMC     Inst = 419
-	AND       Inst = 429
-	ANDS      Inst = 469
-	ASR       Inst = 500
-	ASRV      Inst = 525
-	AT        Inst = 538
-	AUTDA     Inst = 544
-	AUTDB     Inst = 550
-	AUTDZA    Inst = 556
-	AUTDZB    Inst = 561
-	AUTIA     Inst = 566
-	AUTIA1716 Inst = 572
-	AUTIASP   Inst = 576
-	AUTIAZ    Inst = 580
-	AUTIB     Inst = 584
-	AUTIB1716 Inst = 590
-	AUTIBSP   Inst = 594
-	AUTIBZ    Inst = 598
-	AUTIZA    Inst = 602
-	AUTIZB    Inst = 607
-	B         Inst = 612
-	BCAX      Inst = 622
-	BFC       Inst = 638
-	BFI       Inst = 651
-	BFM       Inst = 666
-	BFXIL     Inst = 681
-	BIC       Inst = 696
-	BICS      Inst = 742
-	BIF       Inst = 761
-	BIT       Inst = 771
-	BL        Inst = 781
-	BLR       Inst = 786
-	BLRAA     Inst = 791
-	BLRAAZ    Inst = 797
-	BLRAB     Inst = 802
-	BLRABZ    Inst = 808
-	BR        Inst = 813
-	BRAA      Inst = 818
-	BRAAZ     Inst = 824
-	BRAB      Inst = 829
-	BRABZ     Inst = 835
-	BRK       Inst = 840
-	BSL       Inst = 845
-	CAS       Inst = 855
-	CASA      Inst = 868
-	CASAB     Inst = 881
-	CASAH     Inst = 888
-	CASAL     Inst = 895
-	CASALB    Inst = 908
-	CASALH    Inst = 915
-	CASB      Inst = 922
-	CASH      Inst = 929
-	CASL      Inst = 936
-	CASLB     Inst = 949
-	CASLH     Inst = 956
-	CASP      Inst = 963
-	CASPA     Inst = 980
-	CASPAL    Inst = 997
-	CASPL     Inst = 1014
-	CBNZ      Inst = 1031
-	CBZ       Inst = 1042
-	CCMN      Inst = 1053
-	CCMP      Inst = 1082
-	CFINV     Inst = 1111
-	CFP       Inst = 1115
-	CINC      Inst = 1122
-	CINV      Inst = 1135
-	CLREX     Inst = 1148
-	CLS       Inst = 1156
-	CLZ       Inst = 1188
-	CMEQ      Inst = 1220
-	CMGE      Inst = 1311
-	CMGT      Inst = 1402
-	CMHI      Inst = 1493
-	CMHS      Inst = 1539
-	CMLE      Inst = 1585
-	CMLT      Inst = 1631
-	CMN       Inst = 1677
-	CMP       Inst = 1733
-	CMTST     Inst = 1789
-	CNEG      Inst = 1835
-	CNT       Inst = 1848
-	CPP       Inst = 1856
-	CRC32B    Inst = 1863
-	CRC32CB   Inst = 1870
-	CRC32CH   Inst = 1877
-	CRC32CW   Inst = 1884
-	CRC32CX   Inst = 1891
-	CRC32H    Inst = 1898
-	CRC32W    Inst = 1905
-	CRC32X    Inst = 1912
-	CSDB      Inst = 1919
-	CSEL      Inst = 1923
-	CSET      Inst = 1938
-	CSETM     Inst = 1949
-	CSINC     Inst = 1960
-	CSINV     Inst = 1975
-	CSNEG     Inst = 1990
-	DC        Inst = 2005
-	DCPS1     Inst = 2011
-	DCPS2     Inst = 2017
-	DCPS3     Inst = 2023
-	DMB       Inst = 2029
-	DRPS      Inst = 2038
-	DSB       Inst = 2042
-	DUP       Inst = 2051
-	DVP       Inst = 2130
-	EON       Inst = 2137
-	EOR       Inst = 2156
-	EOR3      Inst = 2196
-	ERET      Inst = 2212
-	ERETAA    Inst = 2216
-	ERETAB    Inst = 2220
-	ESB       Inst = 2224
-	EXT       Inst = 2228
-	EXTR      Inst = 2255
-	FABD      Inst = 2270
-	FABS      Inst = 2319
-	FACGE     Inst = 2358
-	FACGT     Inst = 2407
-	FADD      Inst = 2456
-	FADDP     Inst = 2505
-	FCADD     Inst = 2557
-	FCCMP     Inst = 2591
-	FCCMPE    Inst = 2613
-	FCMEQ     Inst = 2635
-	FCMGE     Inst = 2734
-	FCMGT     Inst = 2833
-	FCMLA     Inst = 2932
-	FCMLE     Inst = 3002
-	FCMLT     Inst = 3053
-	FCMP      Inst = 3104
-	FCMPE     Inst = 3138
-	FCSEL     Inst = 3172
-	FCVT      Inst = 3194
-	FCVTAS    Inst = 3225
-	FCVTAU    Inst = 3294
-	FCVTL     Inst = 3363
-	FCVTL2    Inst = 3382
-	FCVTMS    Inst = 3401
-	FCVTMU    Inst = 3470
-	FCVTN     Inst = 3539
-	FCVTN2    Inst = 3549
-	FCVTNS    Inst = 3559
-	FCVTNU    Inst = 3628
-	FCVTPS    Inst = 3697
-	FCVTPU    Inst = 3766
-	FCVTXN    Inst = 3835
-	FCVTXN2   Inst = 3850
-	FCVTZS    Inst = 3860
-	FCVTZU    Inst = 4009
-	FDIV      Inst = 4158
-	FJCVTZS   Inst = 4207
-	FMADD     Inst = 4213
-	FMAX      Inst = 4235
-	FMAXNM    Inst = 4284
-	FMAXNMP   Inst = 4333
-	FMAXNMV   Inst = 4385
-	FMAXP     Inst = 4399
-	FMAXV     Inst = 4451
-	FMIN      Inst = 4465
-	FMINNM    Inst = 4514
-	FMINNMP   Inst = 4563
-	FMINNMV   Inst = 4615
-	FMINP     Inst = 4629
-	FMINV     Inst = 4681
-	FMLA      Inst = 4695
-	FMLAL     Inst = 4776
-	FMLAL2    Inst = 4823
-	FMLS      Inst = 4870
-	FMLSL     Inst = 4951
-	FMLSL2    Inst = 4998
-	FMOV      Inst = 5045
-	FMSUB     Inst = 5149
-	FMUL      Inst = 5171
-	FMULX     Inst = 5270
-	FNEG      Inst = 5369
-	FNMADD    Inst = 5408
-	FNMSUB    Inst = 5430
-	FNMUL     Inst = 5452
-	FRECPE    Inst = 5471
-	FRECPS    Inst = 5510
-	FRECPX    Inst = 5559
-	FRINTA    Inst = 5575
-	FRINTI    Inst = 5614
-	FRINTM    Inst = 5653
-	FRINTN    Inst = 5692
-	FRINTP    Inst = 5731
-	FRINTX    Inst = 5770
-	FRINTZ    Inst = 5809
-	FRSQRTE   Inst = 5848
-	FRSQRTS   Inst = 5887
-	FSQRT     Inst = 5936
-	FSUB      Inst = 5975
-	HINT      Inst = 6024
-	HLT       Inst = 6029
-	HVC       Inst = 6034
-	IC        Inst = 6039
-	INS       Inst = 6050
-	ISB       Inst = 6103
-	LD1       Inst = 6116
-	LD1R      Inst = 6773
-	LD2       Inst = 6870
-	LD2R      Inst = 7099
-	LD3       Inst = 7196
-	LD3R      Inst = 7425
-	LD4       Inst = 7522
-	LD4R      Inst = 7751
-	LDADD     Inst = 7848
-	LDADDA    Inst = 7861
-	LDADDAB   Inst = 7874
-	LDADDAH   Inst = 7881
-	LDADDAL   Inst = 7888
-	LDADDALB  Inst = 7901
-	LDADDALH  Inst = 7908
-	LDADDB    Inst = 7915
-	LDADDH    Inst = 7922
-	LDADDL    Inst = 7929
-	LDADDLB   Inst = 7942
-	LDADDLH   Inst = 7949
-	LDAPR     Inst = 7956
-	LDAPRB    Inst = 7967
-	LDAPRH    Inst = 7973
-	LDAPUR    Inst = 7979
-	LDAPURB   Inst = 7990
-	LDAPURH   Inst = 7996
-	LDAPURSB  Inst = 8002
-	LDAPURSH  Inst = 8013
-	LDAPURSW  Inst = 8024
-	LDAR      Inst = 8030
-	LDARB     Inst = 8041
-	LDARH     Inst = 8047
-	LDAXP     Inst = 8053
-	LDAXR     Inst = 8066
-	LDAXRB    Inst = 8077
-	LDAXRH    Inst = 8083
-	LDCLR     Inst = 8089
-	LDCLRA    Inst = 8102
-	LDCLRAB   Inst = 8115
-	LDCLRAH   Inst = 8122
-	LDCLRAL   Inst = 8129
-	LDCLRALB  Inst = 8142
-	LDCLRALH  Inst = 8149
-	LDCLRB    Inst = 8156
-	LDCLRH    Inst = 8163
-	LDCLRL    Inst = 8170
-	LDCLRLB   Inst = 8183
-	LDCLRLH   Inst = 8190
-	LDEOR     Inst = 8197
-	LDEORA    Inst = 8210
-	LDEORAB   Inst = 8223
-	LDEORAH   Inst = 8230
-	LDEORAL   Inst = 8237
-	LDEORALB  Inst = 8250
-	LDEORALH  Inst = 8257
-	LDEORB    Inst = 8264
-	LDEORH    Inst = 8271
-	LDEORL    Inst = 8278
-	LDEORLB   Inst = 8291
-	LDEORLH   Inst = 8298
-	LDLAR     Inst = 8305
-	LDLARB    Inst = 8316
-	LDLARH    Inst = 8322
-	LDNP      Inst = 8328
-	LDP       Inst = 8359
-	LDPSW     Inst = 8455
-	LDR       Inst = 8475
-	LDRAA     Inst = 8648
-	LDRAB     Inst = 8659
-	LDRB      Inst = 8670
-	LDRH      Inst = 8692
-	LDRSB     Inst = 8714
-	LDRSH     Inst = 8757
-	LDRSW     Inst = 8800
-	LDSET     Inst = 8827
-	LDSETA    Inst = 8840
-	LDSETAB   Inst = 8853
-	LDSETAH   Inst = 8860
-	LDSETAL   Inst = 8867
-	LDSETALB  Inst = 8880
-	LDSETALH  Inst = 8887
-	LDSETB    Inst = 8894
-	LDSETH    Inst = 8901
-	LDSETL    Inst = 8908
-	LDSETLB   Inst = 8921
-	LDSETLH   Inst = 8928
-	LDSMAX    Inst = 8935
-	LDSMAXA   Inst = 8948
-	LDSMAXAB  Inst = 8961
-	LDSMAXAH  Inst = 8968
-	LDSMAXAL  Inst = 8975
-	LDSMAXALB Inst = 8988
-	LDSMAXALH Inst = 8995
-	LDSMAXB   Inst = 9002
-	LDSMAXH   Inst = 9009
-	LDSMAXL   Inst = 9016
-	LDSMAXLB  Inst = 9029
-	LDSMAXLH  Inst = 9036
-	LDSMIN    Inst = 9043
-	LDSMINA   Inst = 9056
-	LDSMINAB  Inst = 9069
-	LDSMINAH  Inst = 9076
-	LDSMINAL  Inst = 9083
-	LDSMINALB Inst = 9096
-	LDSMINALH Inst = 9103
-	LDSMINB   Inst = 9110
-	LDSMINH   Inst = 9117
-	LDSMINL   Inst = 9124
-	LDSMINLB  Inst = 9137
-	LDSMINLH  Inst = 9144
-	LDTR      Inst = 9151
-	LDTRB     Inst = 9162
-	LDTRH     Inst = 9168
-	LDTRSB    Inst = 9174
-	LDTRSH    Inst = 9185
-	LDTRSW    Inst = 9196
-	LDUMAX    Inst = 9202
-	LDUMAXA   Inst = 9215
-	LDUMAXAB  Inst = 9228
-	LDUMAXAH  Inst = 9235
-	LDUMAXAL  Inst = 9242
-	LDUMAXALB Inst = 9255
-	LDUMAXALH Inst = 9262
-	LDUMAXB   Inst = 9269
-	LDUMAXH   Inst = 9276
-	LDUMAXL   Inst = 9283
-	LDUMAXLB  Inst = 9296
-	LDUMAXLH  Inst = 9303
-	LDUMIN    Inst = 9310
-	LDUMINA   Inst = 9323
-	LDUMINAB  Inst = 9336
-	LDUMINAH  Inst = 9343
-	LDUMINAL  Inst = 9350
-	LDUMINALB Inst = 9363
-	LDUMINALH Inst = 9370
-	LDUMINB   Inst = 9377
-	LDUMINH   Inst = 9384
-	LDUMINL   Inst = 9391
-	LDUMINLB  Inst = 9404
-	LDUMINLH  Inst = 9411
-	LDUR      Inst = 9418
-	LDURB     Inst = 9454
-	LDURH     Inst = 9460
-	LDURSB    Inst = 9466
-	LDURSH    Inst = 9477
-	LDURSW    Inst = 9488
-	LDXP      Inst = 9494
-	LDXR      Inst = 9507
-	LDXRB     Inst = 9518
-	LDXRH     Inst = 9524
-	LSL       Inst = 9530
-	LSLV      Inst = 9555
-	LSR       Inst = 9568
-	LSRV      Inst = 9593
-	MADD      Inst = 9606
-	MLA       Inst = 9621
-	MLS       Inst = 9667
-	MNEG      Inst = 9713
-	MOV       Inst = 9726
-	MOVI      Inst = 9880
-	MOVK      Inst = 9928
-	MOVN      Inst = 9945
-	MOVZ      Inst = 9962
-	MRS       Inst = 9979
-	MSR       Inst = 9985
-	MSUB      Inst = 9996
-	MUL       Inst = 10011
-	MVN       Inst = 10069
-	MVNI      Inst = 10093
-	NEG       Inst = 10120
-	NEGS      Inst = 10172
-	NGC       Inst = 10189
-	NGCS      Inst = 10200
-	NOP       Inst = 10211
-	NOT       Inst = 10215
-	ORN       Inst = 10223
-	ORR       Inst = 10251
-	PACDA     Inst = 10309
-	PACDB     Inst = 10315
-	PACDZA    Inst = 10321
-	PACDZB    Inst = 10326
-	PACGA     Inst = 10331
-	PACIA     Inst = 10338
-	PACIA1716 Inst = 10344
-	PACIASP   Inst = 10348
-	PACIAZ    Inst = 10352
-	PACIB     Inst = 10356
-	PACIB1716 Inst = 10362
-	PACIBSP   Inst = 10366
-	PACIBZ    Inst = 10370
-	PACIZA    Inst = 10374
-	PACIZB    Inst = 10379
-	PMUL      Inst = 10384
-	PMULL     Inst = 10394
-	PMULL2    Inst = 10419
-	PRFM      Inst = 10444
-	PRFUM     Inst = 10455
-	PSB       Inst = 10461
-	PSSBB     Inst = 10467
-	RADDHN    Inst = 10471
-	RADDHN2   Inst = 10508
-	RAX1      Inst = 10545
-	RBIT      Inst = 10558
-	RET       Inst = 10576
-	RETAA     Inst = 10584
-	RETAB     Inst = 10588
-	REV       Inst = 10592
-	REV16     Inst = 10603
-	REV32     Inst = 10621
-	REV64     Inst = 10641
-	RMIF      Inst = 10668
-	ROR       Inst = 10675
-	RORV      Inst = 10700
-	RSHRN     Inst = 10713
-	RSHRN2    Inst = 10744
-	RSUBHN    Inst = 10775
-	RSUBHN2   Inst = 10812
-	SABA      Inst = 10849
-	SABAL     Inst = 10877
-	SABAL2    Inst = 10914
-	SABD      Inst = 10951
-	SABDL     Inst = 10979
-	SABDL2    Inst = 11016
-	SADALP    Inst = 11053
-	SADDL     Inst = 11075
-	SADDL2    Inst = 11112
-	SADDLP    Inst = 11149
-	SADDLV    Inst = 11171
-	SADDW     Inst = 11191
-	SADDW2    Inst = 11228
-	SB        Inst = 11265
-	SBC       Inst = 11269
-	SBCS      Inst = 11282
-	SBFIZ     Inst = 11295
-	SBFM      Inst = 11310
-	SBFX      Inst = 11325
-	SCVTF     Inst = 11340
-	SDIV      Inst = 11489
-	SDOT      Inst = 11502
-	SETF16    Inst = 11551
-	SETF8     Inst = 11556
-	SEV       Inst = 11561
-	SEVL      Inst = 11565
-	SHA1C     Inst = 11569
-	SHA1H     Inst = 11578
-	SHA1M     Inst = 11584
-	SHA1P     Inst = 11593
-	SHA1SU0   Inst = 11602
-	SHA1SU1   Inst = 11615
-	SHA256H   Inst = 11625
-	SHA256H2  Inst = 11634
-	SHA256SU0 Inst = 11643
-	SHA256SU1 Inst = 11653
-	SHA512H   Inst = 11666
-	SHA512H2  Inst = 11675
-	SHA512SU0 Inst = 11684
-	SHA512SU1 Inst = 11694
-	SHADD     Inst = 11707
-	SHL       Inst = 11735
-	SHLL      Inst = 11776
-	SHLL2     Inst = 11810
-	SHRN      Inst = 11844
-	SHRN2     Inst = 11875
-	SHSUB     Inst = 11906
-	SLI       Inst = 11934
-	SM3PARTW1 Inst = 11975
-	SM3PARTW2 Inst = 11988
-	SM3SS1    Inst = 12001
-	SM3TT1A   Inst = 12017
-	SM3TT1B   Inst = 12029
-	SM3TT2A   Inst = 12041
-	SM3TT2B   Inst = 12053
-	SM4E      Inst = 12065
-	SM4EKEY   Inst = 12075
-	SMADDL    Inst = 12088
-	SMAX      Inst = 12096
-	SMAXP     Inst = 12124
-	SMAXV     Inst = 12152
-	SMC       Inst = 12172
-	SMIN      Inst = 12177
-	SMINP     Inst = 12205
-	SMINV     Inst = 12233
-	SMLAL     Inst = 12253
-	SMLAL2    Inst = 12312
-	SMLSL     Inst = 12371
-	SMLSL2    Inst = 12430
-	SMNEGL    Inst = 12489
-	SMOV      Inst = 12496
-	SMSUBL    Inst = 12527
-	SMULH     Inst = 12535
-	SMULL     Inst = 12542
-	SMULL2    Inst = 12607
-	SQABS     Inst = 12666
-	SQADD     Inst = 12717
-	SQDMLAL   Inst = 12781
-	SQDMLAL2  Inst = 12854
-	SQDMLSL   Inst = 12901
-	SQDMLSL2  Inst = 12974
-	SQDMULH   Inst = 13021
-	SQDMULL   Inst = 13084
-	SQDMULL2  Inst = 13157
-	SQNEG     Inst = 13204
-	SQRDMLAH  Inst = 13255
-	SQRDMLSH  Inst = 13318
-	SQRDMULH  Inst = 13381
-	SQRSHL    Inst = 13444
-	SQRSHRN   Inst = 13508
-	SQRSHRN2  Inst = 13557
-	SQRSHRUN  Inst = 13588
-	SQRSHRUN2 Inst = 13637
-	SQSHL     Inst = 13668
-	SQSHLU    Inst = 13790
-	SQSHRN    Inst = 13849
-	SQSHRN2   Inst = 13898
-	SQSHRUN   Inst = 13929
-	SQSHRUN2  Inst = 13978
-	SQSUB     Inst = 14009
-	SQXTN     Inst = 14073
-	SQXTN2    Inst = 14116
-	SQXTUN    Inst = 14144
-	SQXTUN2   Inst = 14187
-	SRHADD    Inst = 14215
-	SRI       Inst = 14243
-	SRSHL     Inst = 14284
-	SRSHR     Inst = 14330
-	SRSRA     Inst = 14371
-	SSBB      Inst = 14412
-	SSHL      Inst = 14416
-	SSHLL     Inst = 14462
-	SSHLL2    Inst = 14493
-	SSHR      Inst = 14524
-	SSRA      Inst = 14565
-	SSUBL     Inst = 14606
-	SSUBL2    Inst = 14643
-	SSUBW     Inst = 14680
-	SSUBW2    Inst = 14717
-	ST1       Inst = 14754
-	ST2       Inst = 15411
-	ST3       Inst = 15640
-	ST4       Inst = 15869
-	STADD     Inst = 16098
-	STADDB    Inst = 16109
-	STADDH    Inst = 16115
-	STADDL    Inst = 16121
-	STADDLB   Inst = 16132
-	STADDLH   Inst = 16138
-	STCLR     Inst = 16144
-	STCLRB    Inst = 16155
-	STCLRH    Inst = 16161
-	STCLRL    Inst = 16167
-	STCLRLB   Inst = 16178
-	STCLRLH   Inst = 16184
-	STEOR     Inst = 16190
-	STEORB    Inst = 16201
-	STEORH    Inst = 16207
-	STEORL    Inst = 16213
-	STEORLB   Inst = 16224
-	STEORLH   Inst = 16230
-	STLLR     Inst = 16236
-	STLLRB    Inst = 16247
-	STLLRH    Inst = 16253
-	STLR      Inst = 16259
-	STLRB     Inst = 16270
-	STLRH     Inst = 16276
-	STLUR     Inst = 16282
-	STLURB    Inst = 16293
-	STLURH    Inst = 16299
-	STLXP     Inst = 16305
-	STLXR     Inst = 16320
-	STLXRB    Inst = 16333
-	STLXRH    Inst = 16340
-	STNP      Inst = 16347
-	STP       Inst = 16378
-	STR       Inst = 16474
-	STRB      Inst = 16622
-	STRH      Inst = 16644
-	STSET     Inst = 16666
-	STSETB    Inst = 16677
-	STSETH    Inst = 16683
-	STSETL    Inst = 16689
-	STSETLB   Inst = 16700
-	STSETLH   Inst = 16706
-	STSMAX    Inst = 16712
-	STSMAXB   Inst = 16723
-	STSMAXH   Inst = 16729
-	STSMAXL   Inst = 16735
-	STSMAXLB  Inst = 16746
-	STSMAXLH  Inst = 16752
-	STSMIN    Inst = 16758
-	STSMINB   Inst = 16769
-	STSMINH   Inst = 16775
-	STSMINL   Inst = 16781
-	STSMINLB  Inst = 16792
-	STSMINLH  Inst = 16798
-	STTR      Inst = 16804
-	STTRB     Inst = 16815
-	STTRH     Inst = 16821
-	STUMAX    Inst = 16827
-	STUMAXB   Inst = 16838
-	STUMAXH   Inst = 16844
-	STUMAXL   Inst = 16850
-	STUMAXLB  Inst = 16861
-	STUMAXLH  Inst = 16867
-	STUMIN    Inst = 16873
-	STUMINB   Inst = 16884
-	STUMINH   Inst = 16890
-	STUMINL   Inst = 16896
-	STUMINLB  Inst = 16907
-	STUMINLH  Inst = 16913
-	STUR      Inst = 16919
-	STURB     Inst = 16955
-	STURH     Inst = 16961
-	STXP      Inst = 16967
-	STXR      Inst = 16982
-	STXRB     Inst = 16995
-	STXRH     Inst = 17002
-	SUB       Inst = 17009
-	SUBHN     Inst = 17118
-	SUBHN2    Inst = 17155
-	SUBS      Inst = 17192
-	SUQADD    Inst = 17256
-	SVC       Inst = 17307
-	SWP       Inst = 17312
-	SWPA      Inst = 17325
-	SWPAB     Inst = 17338
-	SWPAH     Inst = 17345
-	SWPAL     Inst = 17352
-	SWPALB    Inst = 17365
-	SWPALH    Inst = 17372
-	SWPB      Inst = 17379
-	SWPH      Inst = 17386
-	SWPL      Inst = 17393
-	SWPLB     Inst = 17406
-	SWPLH     Inst = 17413
-	SXTB      Inst = 17420
-	SXTH      Inst = 17431
-	SXTL      Inst = 17442
-	SXTL2     Inst = 17470
-	SXTW      Inst = 17498
-	SYS       Inst = 17504
-	SYSL      Inst = 17514
-	TBL       Inst = 17523
-	TBNZ      Inst = 17568
-	TBX       Inst = 17581
-	TBZ       Inst = 17626
-	TLBI      Inst = 17639
-	TRN1      Inst = 17646
-	TRN2      Inst = 17686
-	TSB       Inst = 17726
-	TST       Inst = 17732
-	UABA      Inst = 17759
-	UABAL     Inst = 17787
-	UABAL2    Inst = 17824
-	UABD      Inst = 17861
-	UABDL     Inst = 17889
-	UABDL2    Inst = 17926
-	UADALP    Inst = 17963
-	UADDL     Inst = 17985
-	UADDL2    Inst = 18022
-	UADDLP    Inst = 18059
-	UADDLV    Inst = 18081
-	UADDW     Inst = 18101
-	UADDW2    Inst = 18138
-	UBFIZ     Inst = 18175
-	UBFM      Inst = 18190
-	UBFX      Inst = 18205
-	UCVTF     Inst = 18220
-	UDF       Inst = 18369
-	UDIV      Inst = 18374
-	UDOT      Inst = 18387
-	UHADD     Inst = 18436
-	UHSUB     Inst = 18464
-	UMADDL    Inst = 18492
-	UMAX      Inst = 18500
-	UMAXP     Inst = 18528
-	UMAXV     Inst = 18556
-	UMIN      Inst = 18576
-	UMINP     Inst = 18604
-	UMINV     Inst = 18632
-	UMLAL     Inst = 18652
-	UMLAL2    Inst = 18711
-	UMLSL     Inst = 18770
-	UMLSL2    Inst = 18829
-	UMNEGL    Inst = 18888
-	UMOV      Inst = 18895
-	UMSUBL    Inst = 18920
-	UMULH     Inst = 18928
-	UMULL     Inst = 18935
-	UMULL2    Inst = 19000
-	UQADD     Inst = 19059
-	UQRSHL    Inst = 19123
-	UQRSHRN   Inst = 19187
-	UQRSHRN2  Inst = 19236
-	UQSHL     Inst = 19267
-	UQSHRN    Inst = 19389
-	UQSHRN2   Inst = 19438
-	UQSUB     Inst = 19469
-	UQXTN     Inst = 19533
-	UQXTN2    Inst = 19576
-	URECPE    Inst = 19604
-	URHADD    Inst = 19612
-	URSHL     Inst = 19640
-	URSHR     Inst = 19686
-	URSQRTE   Inst = 19727
-	URSRA     Inst = 19735
-	USHL      Inst = 19776
-	USHLL     Inst = 19822
-	USHLL2    Inst = 19853
-	USHR      Inst = 19884
-	USQADD    Inst = 19925
-	USRA      Inst = 19976
-	USUBL     Inst = 20017
-	USUBL2    Inst = 20054
-	USUBW     Inst = 20091
-	USUBW2    Inst = 20128
-	UXTB      Inst = 20165
-	UXTH      Inst = 20171
-	UXTL      Inst = 20177
-	UXTL2     Inst = 20205
-	UZP1      Inst = 20233
-	UZP2      Inst = 20273
-	WFE       Inst = 20313
-	WFI       Inst = 20317
-	XAR       Inst = 20321
-	XPACD     Inst = 20335
-	XPACI     Inst = 20340
-	XPACLRI   Inst = 20345
-	XTN       Inst = 20349
-	XTN2      Inst = 20377
-	YIELD     Inst = 20405
-	ZIP1      Inst = 20409
-	ZIP2      Inst = 20449
+	ADC       Inst = 2
+	ADCS      Inst = 3
+	ADD       Inst = 4
+	ADDG      Inst = 5
+	ADDHA     Inst = 6
+	ADDHN     Inst = 7
+	ADDHN2    Inst = 8
+	ADDP      Inst = 9
+	ADDPL     Inst = 10
+	ADDS      Inst = 11
+	ADDSPL    Inst = 12
+	ADDSVL    Inst = 13
+	ADDV      Inst = 14
+	ADDVA     Inst = 15
+	ADDVL     Inst = 16
+	ADR       Inst = 17
+	ADRP      Inst = 18
+	AESD      Inst = 19
+	AESE      Inst = 20
+	AESIMC    Inst = 21
+	AESMC     Inst = 22
+	AND       Inst = 23
+	ANDS      Inst = 24
+	ANDV      Inst = 25
+	ASR       Inst = 26
+	ASRV      Inst = 27
+	AT        Inst = 28
+	AUTDA     Inst = 29
+	AUTDB     Inst = 30
+	AUTDZA    Inst = 31
+	AUTDZB    Inst = 32
+	AUTIA     Inst = 33
+	AUTIA1716 Inst = 34
+	AUTIASP   Inst = 35
+	AUTIAZ    Inst = 36
+	AUTIB     Inst = 37
+	AUTIB1716 Inst = 38
+	AUTIBSP   Inst = 39
+	AUTIBZ    Inst = 40
+	AUTIZA    Inst = 41
+	AUTIZB    Inst = 42
+	AXFLAG    Inst = 43
+	B         Inst = 44
+	BCAX      Inst = 45
+	BFC       Inst = 46
+	BFCVT     Inst = 47
+	BFCVTN    Inst = 48
+	BFCVTN2   Inst = 49
+	BFDOT     Inst = 50
+	BFI       Inst = 51
+	BFM       Inst = 52
+	BFMLALB   Inst = 53
+	BFMLALT   Inst = 54
+	BFMMLA    Inst = 55
+	BFMOPA    Inst = 56
+	BFMOPS    Inst = 57
+	BFXIL     Inst = 58
+	BIC       Inst = 59
+	BICS      Inst = 60
+	BIF       Inst = 61
+	BIT       Inst = 62
+	BL        Inst = 63
+	BLR       Inst = 64
+	BLRAA     Inst = 65
+	BLRAAZ    Inst = 66
+	BLRAB     Inst = 67
+	BLRABZ    Inst = 68
+	BR        Inst = 69
+	BRAA      Inst = 70
+	BRAAZ     Inst = 71
+	BRAB      Inst = 72
+	BRABZ     Inst = 73
+	BRK       Inst = 74
+	BSL       Inst = 75
+	BSL1N     Inst = 76
+	BSL2N     Inst = 77
+	BTI       Inst = 78
+	CAS       Inst = 79
+	CASA      Inst = 80
+	CASAB     Inst = 81
+	CASAH     Inst = 82
+	CASAL     Inst = 83
+	CASALB    Inst = 84
+	CASALH    Inst = 85
+	CASB      Inst = 86
+	CASH      Inst = 87
+	CASL      Inst = 88
+	CASLB     Inst = 89
+	CASLH     Inst = 90
+	CASP      Inst = 91
+	CASPA     Inst = 92
+	CASPAL    Inst = 93
+	CASPL     Inst = 94
+	CBNZ      Inst = 95
+	CBZ       Inst = 96
+	CCMN      Inst = 97
+	CCMP      Inst = 98
+	CFINV     Inst = 99
+	CFP       Inst = 100
+	CINC      Inst = 101
+	CINV      Inst = 102
+	CLRBHB    Inst = 103
+	CLREX     Inst = 104
+	CLS       Inst = 105
+	CLZ       Inst = 106
+	CMEQ      Inst = 107
+	CMGE      Inst = 108
+	CMGT      Inst = 109
+	CMHI      Inst = 110
+	CMHS      Inst = 111
+	CMLE      Inst = 112
+	CMLT      Inst = 113
+	CMN       Inst = 114
+	CMP       Inst = 115
+	CMPEQ     Inst = 116
+	CMPGE     Inst = 117
+	CMPGT     Inst = 118
+	CMPHI     Inst = 119
+	CMPHS     Inst = 120
+	CMPLE     Inst = 121
+	CMPLO     Inst = 122
+	CMPLS     Inst = 123
+	CMPLT     Inst = 124
+	CMPNE     Inst = 125
+	CMTST     Inst = 126
+	CNEG      Inst = 127
+	CNT       Inst = 128
+	CNTB      Inst = 129
+	CNTD      Inst = 130
+	CNTH      Inst = 131
+	CNTW      Inst = 132
+	COMPACT   Inst = 133
+	CPP       Inst = 134
+	CPYE      Inst = 135
+	CPYFE     Inst = 136
+	CPYFM     Inst = 137
+	CPYFP     Inst = 138
+	CPYM      Inst = 139
+	CPYP      Inst = 140
+	CRC32B    Inst = 141
+	CRC32CB   Inst = 142
+	CRC32CH   Inst = 143
+	CRC32CW   Inst = 144
+	CRC32CX   Inst = 145
+	CRC32H    Inst = 146
+	CRC32W    Inst = 147
+	CRC32X    Inst = 148
+	CSDB      Inst = 149
+	CSEL      Inst = 150
+	CSET      Inst = 151
+	CSETM     Inst = 152
+	CSINC     Inst = 153
+	CSINV     Inst = 154
+	CSNEG     Inst = 155
+	CTZ       Inst = 156
+	DC        Inst = 157
+	DCPS1     Inst = 158
+	DCPS2     Inst = 159
+	DCPS3     Inst = 160
+	DECB      Inst = 161
+	DECD      Inst = 162
+	DECH      Inst = 163
+	DECW      Inst = 164
+	DGH       Inst = 165
+	DMB       Inst = 166
+	DRPS      Inst = 167
+	DSB       Inst = 168
+	DUP       Inst = 169
+	DVP       Inst = 170
+	EON       Inst = 171
+	EOR       Inst = 172
+	EOR3      Inst = 173
+	EORV      Inst = 174
+	ERET      Inst = 175
+	ERETAA    Inst = 176
+	ERETAB    Inst = 177
+	ESB       Inst = 178
+	EXT       Inst = 179
+	EXTR      Inst = 180
+	FABD      Inst = 181
+	FABS      Inst = 182
+	FACGE     Inst = 183
+	FACGT     Inst = 184
+	FADD      Inst = 185
+	FADDP     Inst = 186
+	FADDV     Inst = 187
+	FCADD     Inst = 188
+	FCCMP     Inst = 189
+	FCCMPE    Inst = 190
+	FCMEQ     Inst = 191
+	FCMGE     Inst = 192
+	FCMGT     Inst = 193
+	FCMLA     Inst = 194
+	FCMLE     Inst = 195
+	FCMLT     Inst = 196
+	FCMNE     Inst = 197
+	FCMP      Inst = 198
+	FCMPE     Inst = 199
+	FCSEL     Inst = 200
+	FCVT      Inst = 201
+	FCVTAS    Inst = 202
+	FCVTAU    Inst = 203
+	FCVTL     Inst = 204
+	FCVTL2    Inst = 205
+	FCVTMS    Inst = 206
+	FCVTMU    Inst = 207
+	FCVTN     Inst = 208
+	FCVTN2    Inst = 209
+	FCVTNS    Inst = 210
+	FCVTNU    Inst = 211
+	FCVTPS    Inst = 212
+	FCVTPU    Inst = 213
+	FCVTXN    Inst = 214
+	FCVTXN2   Inst = 215
+	FCVTZS    Inst = 216
+	FCVTZU    Inst = 217
+	FDIV      Inst = 218
+	FDIVR     Inst = 219
+	FDUP      Inst = 220
+	FJCVTZS   Inst = 221
+	FMADD     Inst = 222
+	FMAX      Inst = 223
+	FMAXNM    Inst = 224
+	FMAXNMP   Inst = 225
+	FMAXNMV   Inst = 226
+	FMAXP     Inst = 227
+	FMAXV     Inst = 228
+	FMIN      Inst = 229
+	FMINNM    Inst = 230
+	FMINNMP   Inst = 231
+	FMINNMV   Inst = 232
+	FMINP     Inst = 233
+	FMINV     Inst = 234
+	FMLA      Inst = 235
+	FMLAL     Inst = 236
+	FMLAL2    Inst = 237
+	FMLS      Inst = 238
+	FMLSL     Inst = 239
+	FMLSL2    Inst = 240
+	FMOPA     Inst = 241
+	FMOPS     Inst = 242
+	FMOV      Inst = 243
+	FMSUB     Inst = 244
+	FMUL      Inst = 245
+	FMULX     Inst = 246
+	FNEG      Inst = 247
+	FNMADD    Inst = 248
+	FNMLA     Inst = 249
+	FNMLS     Inst = 250
+	FNMSUB    Inst = 251
+	FNMUL     Inst = 252
+	FRECPE    Inst = 253
+	FRECPS    Inst = 254
+	FRECPX    Inst = 255
+	FRINT32X  Inst = 256
+	FRINT32Z  Inst = 257
+	FRINT64X  Inst = 258
+	FRINT64Z  Inst = 259
+	FRINTA    Inst = 260
+	FRINTI    Inst = 261
+	FRINTM    Inst = 262
+	FRINTN    Inst = 263
+	FRINTP    Inst = 264
+	FRINTX    Inst = 265
+	FRINTZ    Inst = 266
+	FRSQRTE   Inst = 267
+	FRSQRTS   Inst = 268
+	FSCALE    Inst = 269
+	FSQRT     Inst = 270
+	FSUB      Inst = 271
+	FSUBR     Inst = 272
+	GMI       Inst = 273
+	HINT      Inst = 274
+	HLT       Inst = 275
+	HVC       Inst = 276
+	IC        Inst = 277
+	INCB      Inst = 278
+	INCD      Inst = 279
+	INCH      Inst = 280
+	INCW      Inst = 281
+	INDEX     Inst = 282
+	INS       Inst = 283
+	IRG       Inst = 284
+	ISB       Inst = 285
+	LD1       Inst = 286
+	LD1B      Inst = 287
+	LD1D      Inst = 288
+	LD1H      Inst = 289
+	LD1Q      Inst = 290
+	LD1R      Inst = 291
+	LD1RB     Inst = 292
+	LD1RD     Inst = 293
+	LD1RH     Inst = 294
+	LD1RW     Inst = 295
+	LD1W      Inst = 296
+	LD2       Inst = 297
+	LD2R      Inst = 298
+	LD3       Inst = 299
+	LD3R      Inst = 300
+	LD4       Inst = 301
+	LD4R      Inst = 302
+	LD64B     Inst = 303
+	LDADD     Inst = 304
+	LDADDA    Inst = 305
+	LDADDAB   Inst = 306
+	LDADDAH   Inst = 307
+	LDADDAL   Inst = 308
+	LDADDALB  Inst = 309
+	LDADDALH  Inst = 310
+	LDADDB    Inst = 311
+	LDADDH    Inst = 312
+	LDADDL    Inst = 313
+	LDADDLB   Inst = 314
+	LDADDLH   Inst = 315
+	LDAPR     Inst = 316
+	LDAPRB    Inst = 317
+	LDAPRH    Inst = 318
+	LDAPUR    Inst = 319
+	LDAPURB   Inst = 320
+	LDAPURH   Inst = 321
+	LDAPURSB  Inst = 322
+	LDAPURSH  Inst = 323
+	LDAPURSW  Inst = 324
+	LDAR      Inst = 325
+	LDARB     Inst = 326
+	LDARH     Inst = 327
+	LDAXP     Inst = 328
+	LDAXR     Inst = 329
+	LDAXRB    Inst = 330
+	LDAXRH    Inst = 331
+	LDCLR     Inst = 332
+	LDCLRA    Inst = 333
+	LDCLRAB   Inst = 334
+	LDCLRAH   Inst = 335
+	LDCLRAL   Inst = 336
+	LDCLRALB  Inst = 337
+	LDCLRALH  Inst = 338
+	LDCLRB    Inst = 339
+	LDCLRH    Inst = 340
+	LDCLRL    Inst = 341
+	LDCLRLB   Inst = 342
+	LDCLRLH   Inst = 343
+	LDEOR     Inst = 344
+	LDEORA    Inst = 345
+	LDEORAB   Inst = 346
+	LDEORAH   Inst = 347
+	LDEORAL   Inst = 348
+	LDEORALB  Inst = 349
+	LDEORALH  Inst = 350
+	LDEORB    Inst = 351
+	LDEORH    Inst = 352
+	LDEORL    Inst = 353
+	LDEORLB   Inst = 354
+	LDEORLH   Inst = 355
+	LDFF1B    Inst = 356
+	LDFF1D    Inst = 357
+	LDFF1H    Inst = 358
+	LDFF1W    Inst = 359
+	LDG       Inst = 360
+	LDLAR     Inst = 361
+	LDLARB    Inst = 362
+	LDLARH    Inst = 363
+	LDNP      Inst = 364
+	LDP       Inst = 365
+	LDPSW     Inst = 366
+	LDR       Inst = 367
+	LDRAA     Inst = 368
+	LDRAB     Inst = 369
+	LDRB      Inst = 370
+	LDRH      Inst = 371
+	LDRSB     Inst = 372
+	LDRSH     Inst = 373
+	LDRSW     Inst = 374
+	LDSET     Inst = 375
+	LDSETA    Inst = 376
+	LDSETAB   Inst = 377
+	LDSETAH   Inst = 378
+	LDSETAL   Inst = 379
+	LDSETALB  Inst = 380
+	LDSETALH  Inst = 381
+	LDSETB    Inst = 382
+	LDSETH    Inst = 383
+	LDSETL    Inst = 384
+	LDSETLB   Inst = 385
+	LDSETLH   Inst = 386
+	LDSMAX    Inst = 387
+	LDSMAXA   Inst = 388
+	LDSMAXAB  Inst = 389
+	LDSMAXAH  Inst = 390
+	LDSMAXAL  Inst = 391
+	LDSMAXALB Inst = 392
+	LDSMAXALH Inst = 393
+	LDSMAXB   Inst = 394
+	LDSMAXH   Inst = 395
+	LDSMAXL   Inst = 396
+	LDSMAXLB  Inst = 397
+	LDSMAXLH  Inst = 398
+	LDSMIN    Inst = 399
+	LDSMINA   Inst = 400
+	LDSMINAB  Inst = 401
+	LDSMINAH  Inst = 402
+	LDSMINAL  Inst = 403
+	LDSMINALB Inst = 404
+	LDSMINALH Inst = 405
+	LDSMINB   Inst = 406
+	LDSMINH   Inst = 407
+	LDSMINL   Inst = 408
+	LDSMINLB  Inst = 409
+	LDSMINLH  Inst = 410
+	LDTR      Inst = 411
+	LDTRB     Inst = 412
+	LDTRH     Inst = 413
+	LDTRSB    Inst = 414
+	LDTRSH    Inst = 415
+	LDTRSW    Inst = 416
+	LDUMAX    Inst = 417
+	LDUMAXA   Inst = 418
+	LDUMAXAB  Inst = 419
+	LDUMAXAH  Inst = 420
+	LDUMAXAL  Inst = 421
+	LDUMAXALB Inst = 422
+	LDUMAXALH Inst = 423
+	LDUMAXB   Inst = 424
+	LDUMAXH   Inst = 425
+	LDUMAXL   Inst = 426
+	LDUMAXLB  Inst = 427
+	LDUMAXLH  Inst = 428
+	LDUMIN    Inst = 429
+	LDUMINA   Inst = 430
+	LDUMINAB  Inst = 431
+	LDUMINAH  Inst = 432
+	LDUMINAL  Inst = 433
+	LDUMINALB Inst = 434
+	LDUMINALH Inst = 435
+	LDUMINB   Inst = 436
+	LDUMINH   Inst = 437
+	LDUMINL   Inst = 438
+	LDUMINLB  Inst = 439
+	LDUMINLH  Inst = 440
+	LDUR      Inst = 441
+	LDURB     Inst = 442
+	LDURH     Inst = 443
+	LDURSB    Inst = 444
+	LDURSH    Inst = 445
+	LDURSW    Inst = 446
+	LDXP      Inst = 447
+	LDXR      Inst = 448
+	LDXRB     Inst = 449
+	LDXRH     Inst = 450
+	LSL       Inst = 451
+	LSLV      Inst = 452
+	LSR       Inst = 453
+	LSRV      Inst = 454
+	MADD      Inst = 455
+	MLA       Inst = 456
+	MLS       Inst = 457
+	MNEG      Inst = 458
+	MOV       Inst = 459
+	MOVA      Inst = 460
+	MOVI      Inst = 461
+	MOVK      Inst = 462
+	MOVN      Inst = 463
+	MOVPRFX   Inst = 464
+	MOVZ      Inst = 465
+	MRS       Inst = 466
+	MSR       Inst = 467
+	MSUB      Inst = 468
+	MUL       Inst = 469
+	MVN       Inst = 470
+	MVNI      Inst = 471
+	NBSL      Inst = 472
+	NEG       Inst = 473
+	NEGS      Inst = 474
+	NGC       Inst = 475
+	NGCS      Inst = 476
+	NOP       Inst = 477
+	NOT       Inst = 478
+	ORN       Inst = 479
+	ORR       Inst = 480
+	ORV       Inst = 481
+	PACDA     Inst = 482
+	PACDB     Inst = 483
+	PACDZA    Inst = 484
+	PACDZB    Inst = 485
+	PACGA     Inst = 486
+	PACIA     Inst = 487
+	PACIA1716 Inst = 488
+	PACIASP   Inst = 489
+	PACIAZ    Inst = 490
+	PACIB     Inst = 491
+	PACIB1716 Inst = 492
+	PACIBSP   Inst = 493
+	PACIBZ    Inst = 494
+	PACIZA    Inst = 495
+	PACIZB    Inst = 496
+	PFALSE    Inst = 497
+	PMUL      Inst = 498
+	PMULL     Inst = 499
+	PMULL2    Inst = 500
+	PRFM      Inst = 501
+	PRFUM     Inst = 502
+	PSB       Inst = 503
+	PSSBB     Inst = 504
+	PTEST     Inst = 505
+	PTRUE     Inst = 506
+	PTRUES    Inst = 507
+	RADDHN    Inst = 508
+	RADDHN2   Inst = 509
+	RAX1      Inst = 510
+	RBIT      Inst = 511
+	RDFFR     Inst = 512
+	RDFFRS    Inst = 513
+	RDSVL     Inst = 514
+	RDVL      Inst = 515
+	RET       Inst = 516
+	RETAA     Inst = 517
+	RETAB     Inst = 518
+	REV       Inst = 519
+	REV16     Inst = 520
+	REV32     Inst = 521
+	REV64     Inst = 522
+	RMIF      Inst = 523
+	ROR       Inst = 524
+	RORV      Inst = 525
+	RSHRN     Inst = 526
+	RSHRN2    Inst = 527
+	RSUBHN    Inst = 528
+	RSUBHN2   Inst = 529
+	SABA      Inst = 530
+	SABAL     Inst = 531
+	SABAL2    Inst = 532
+	SABD      Inst = 533
+	SABDL     Inst = 534
+	SABDL2    Inst = 535
+	SADALP    Inst = 536
+	SADDL     Inst = 537
+	SADDL2    Inst = 538
+	SADDLP    Inst = 539
+	SADDLV    Inst = 540
+	SADDV     Inst = 541
+	SADDW     Inst = 542
+	SADDW2    Inst = 543
+	SB        Inst = 544
+	SBC       Inst = 545
+	SBCS      Inst = 546
+	SBFIZ     Inst = 547
+	SBFM      Inst = 548
+	SBFX      Inst = 549
+	SCVTF     Inst = 550
+	SDIV      Inst = 551
+	SDIVR     Inst = 552
+	SDOT      Inst = 553
+	SEL       Inst = 554
+	SETE      Inst = 555
+	SETF16    Inst = 556
+	SETF8     Inst = 557
+	SETFFR    Inst = 558
+	SETGE     Inst = 559
+	SETGM     Inst = 560
+	SETGP     Inst = 561
+	SETM      Inst = 562
+	SETP      Inst = 563
+	SEV       Inst = 564
+	SEVL      Inst = 565
+	SHA1C     Inst = 566
+	SHA1H     Inst = 567
+	SHA1M     Inst = 568
+	SHA1P     Inst = 569
+	SHA1SU0   Inst = 570
+	SHA1SU1   Inst = 571
+	SHA256H   Inst = 572
+	SHA256H2  Inst = 573
+	SHA256SU0 Inst = 574
+	SHA256SU1 Inst = 575
+	SHA512H   Inst = 576
+	SHA512H2  Inst = 577
+	SHA512SU0 Inst = 578
+	SHA512SU1 Inst = 579
+	SHADD     Inst = 580
+	SHL       Inst = 581
+	SHLL      Inst = 582
+	SHLL2     Inst = 583
+	SHRN      Inst = 584
+	SHRN2     Inst = 585
+	SHSUB     Inst = 586
+	SLI       Inst = 587
+	SM3PARTW1 Inst = 588
+	SM3PARTW2 Inst = 589
+	SM3SS1    Inst = 590
+	SM3TT1A   Inst = 591
+	SM3TT1B   Inst = 592
+	SM3TT2A   Inst = 593
+	SM3TT2B   Inst = 594
+	SM4E      Inst = 595
+	SM4EKEY   Inst = 596
+	SMADDL    Inst = 597
+	SMAX      Inst = 598
+	SMAXP     Inst = 599
+	SMAXV     Inst = 600
+	SMC       Inst = 601
+	SMIN      Inst = 602
+	SMINP     Inst = 603
+	SMINV     Inst = 604
+	SMLAL     Inst = 605
+	SMLAL2    Inst = 606
+	SMLSL     Inst = 607
+	SMLSL2    Inst = 608
+	SMMLA     Inst = 609
+	SMNEGL    Inst = 610
+	SMOPA     Inst = 611
+	SMOPS     Inst = 612
+	SMOV      Inst = 613
+	SMSTART   Inst = 614
+	SMSTOP    Inst = 615
+	SMSUBL    Inst = 616
+	SMULH     Inst = 617
+	SMULL     Inst = 618
+	SMULL2    Inst = 619
+	SPLICE    Inst = 620
+	SQABS     Inst = 621
+	SQADD     Inst = 622
+	SQDMLAL   Inst = 623
+	SQDMLAL2  Inst = 624
+	SQDMLSL   Inst = 625
+	SQDMLSL2  Inst = 626
+	SQDMULH   Inst = 627
+	SQDMULL   Inst = 628
+	SQDMULL2  Inst = 629
+	SQNEG     Inst = 630
+	SQRDMLAH  Inst = 631
+	SQRDMLSH  Inst = 632
+	SQRDMULH  Inst = 633
+	SQRSHL    Inst = 634
+	SQRSHRN   Inst = 635
+	SQRSHRN2  Inst = 636
+	SQRSHRUN  Inst = 637
+	SQRSHRUN2 Inst = 638
+	SQSHL     Inst = 639
+	SQSHLU    Inst = 640
+	SQSHRN    Inst = 641
+	SQSHRN2   Inst = 642
+	SQSHRUN   Inst = 643
+	SQSHRUN2  Inst = 644
+	SQSUB     Inst = 645
+	SQXTN     Inst = 646
+	SQXTN2    Inst = 647
+	SQXTUN    Inst = 648
+	SQXTUN2   Inst = 649
+	SRHADD    Inst = 650
+	SRI       Inst = 651
+	SRSHL     Inst = 652
+	SRSHR     Inst = 653
+	SRSRA     Inst = 654
+	SSBB      Inst = 655
+	SSHL      Inst = 656
+	SSHLL     Inst = 657
+	SSHLL2    Inst = 658
+	SSHR      Inst = 659
+	SSRA      Inst = 660
+	SSUBL     Inst = 661
+	SSUBL2    Inst = 662
+	SSUBW     Inst = 663
+	SSUBW2    Inst = 664
+	ST1       Inst = 665
+	ST1B      Inst = 666
+	ST1D      Inst = 667
+	ST1H      Inst = 668
+	ST1Q      Inst = 669
+	ST1W      Inst = 670
+	ST2       Inst = 671
+	ST2G      Inst = 672
+	ST3       Inst = 673
+	ST4       Inst = 674
+	ST64B     Inst = 675
+	ST64BV    Inst = 676
+	ST64BV0   Inst = 677
+	STADD     Inst = 678
+	STADDB    Inst = 679
+	STADDH    Inst = 680
+	STADDL    Inst = 681
+	STADDLB   Inst = 682
+	STADDLH   Inst = 683
+	STCLR     Inst = 684
+	STCLRB    Inst = 685
+	STCLRH    Inst = 686
+	STCLRL    Inst = 687
+	STCLRLB   Inst = 688
+	STCLRLH   Inst = 689
+	STEOR     Inst = 690
+	STEORB    Inst = 691
+	STEORH    Inst = 692
+	STEORL    Inst = 693
+	STEORLB   Inst = 694
+	STEORLH   Inst = 695
+	STG       Inst = 696
+	STGP      Inst = 697
+	STLLR     Inst = 698
+	STLLRB    Inst = 699
+	STLLRH    Inst = 700
+	STLR      Inst = 701
+	STLRB     Inst = 702
+	STLRH     Inst = 703
+	STLUR     Inst = 704
+	STLURB    Inst = 705
+	STLURH    Inst = 706
+	STLXP     Inst = 707
+	STLXR     Inst = 708
+	STLXRB    Inst = 709
+	STLXRH    Inst = 710
+	STNP      Inst = 711
+	STP       Inst = 712
+	STR       Inst = 713
+	STRB      Inst = 714
+	STRH      Inst = 715
+	STSET     Inst = 716
+	STSETB    Inst = 717
+	STSETH    Inst = 718
+	STSETL    Inst = 719
+	STSETLB   Inst = 720
+	STSETLH   Inst = 721
+	STSMAX    Inst = 722
+	STSMAXB   Inst = 723
+	STSMAXH   Inst = 724
+	STSMAXL   Inst = 725
+	STSMAXLB  Inst = 726
+	STSMAXLH  Inst = 727
+	STSMIN    Inst = 728
+	STSMINB   Inst = 729
+	STSMINH   Inst = 730
+	STSMINL   Inst = 731
+	STSMINLB  Inst = 732
+	STSMINLH  Inst = 733
+	STTR      Inst = 734
+	STTRB     Inst = 735
+	STTRH     Inst = 736
+	STUMAX    Inst = 737
+	STUMAXB   Inst = 738
+	STUMAXH   Inst = 739
+	STUMAXL   Inst = 740
+	STUMAXLB  Inst = 741
+	STUMAXLH  Inst = 742
+	STUMIN    Inst = 743
+	STUMINB   Inst = 744
+	STUMINH   Inst = 745
+	STUMINL   Inst = 746
+	STUMINLB  Inst = 747
+	STUMINLH  Inst = 748
+	STUR      Inst = 749
+	STURB     Inst = 750
+	STURH     Inst = 751
+	STXP      Inst = 752
+	STXR      Inst = 753
+	STXRB     Inst = 754
+	STXRH     Inst = 755
+	STZ2G     Inst = 756
+	STZG      Inst = 757
+	SUB       Inst = 758
+	SUBG      Inst = 759
+	SUBHN     Inst = 760
+	SUBHN2    Inst = 761
+	SUBP      Inst = 762
+	SUBPS     Inst = 763
+	SUBR      Inst = 764
+	SUBS      Inst = 765
+	SUDOT     Inst = 766
+	SUMOPA    Inst = 767
+	SUMOPS    Inst = 768
+	SUNPKHI   Inst = 769
+	SUNPKLO   Inst = 770
+	SUQADD    Inst = 771
+	SVC       Inst = 772
+	SWP       Inst = 773
+	SWPA      Inst = 774
+	SWPAB     Inst = 775
+	SWPAH     Inst = 776
+	SWPAL     Inst = 777
+	SWPALB    Inst = 778
+	SWPALH    Inst = 779
+	SWPB      Inst = 780
+	SWPH      Inst = 781
+	SWPL      Inst = 782
+	SWPLB     Inst = 783
+	SWPLH     Inst = 784
+	SXTB      Inst = 785
+	SXTH      Inst = 786
+	SXTL      Inst = 787
+	SXTL2     Inst = 788
+	SXTW      Inst = 789
+	SYS       Inst = 790
+	SYSL      Inst = 791
+	TBL       Inst = 792
+	TBNZ      Inst = 793
+	TBX       Inst = 794
+	TBZ       Inst = 795
+	TLBI      Inst = 796
+	TRN1      Inst = 797
+	TRN2      Inst = 798
+	TSB       Inst = 799
+	TST       Inst = 800
+	UABA      Inst = 801
+	UABAL     Inst = 802
+	UABAL2    Inst = 803
+	UABD      Inst = 804
+	UABDL     Inst = 805
+	UABDL2    Inst = 806
+	UADALP    Inst = 807
+	UADDL     Inst = 808
+	UADDL2    Inst = 809
+	UADDLP    Inst = 810
+	UADDLV    Inst = 811
+	UADDV     Inst = 812
+	UADDW     Inst = 813
+	UADDW2    Inst = 814
+	UBFIZ     Inst = 815
+	UBFM      Inst = 816
+	UBFX      Inst = 817
+	UCVTF     Inst = 818
+	UDF       Inst = 819
+	UDIV      Inst = 820
+	UDIVR     Inst = 821
+	UDOT      Inst = 822
+	UHADD     Inst = 823
+	UHSUB     Inst = 824
+	UMADDL    Inst = 825
+	UMAX      Inst = 826
+	UMAXP     Inst = 827
+	UMAXV     Inst = 828
+	UMIN      Inst = 829
+	UMINP     Inst = 830
+	UMINV     Inst = 831
+	UMLAL     Inst = 832
+	UMLAL2    Inst = 833
+	UMLSL     Inst = 834
+	UMLSL2    Inst = 835
+	UMMLA     Inst = 836
+	UMNEGL    Inst = 837
+	UMOPA     Inst = 838
+	UMOPS     Inst = 839
+	UMOV      Inst = 840
+	UMSUBL    Inst = 841
+	UMULH     Inst = 842
+	UMULL     Inst = 843
+	UMULL2    Inst = 844
+	UQADD     Inst = 845
+	UQRSHL    Inst = 846
+	UQRSHRN   Inst = 847
+	UQRSHRN2  Inst = 848
+	UQSHL     Inst = 849
+	UQSHRN    Inst = 850
+	UQSHRN2   Inst = 851
+	UQSUB     Inst = 852
+	UQXTN     Inst = 853
+	UQXTN2    Inst = 854
+	URECPE    Inst = 855
+	URHADD    Inst = 856
+	URSHL     Inst = 857
+	URSHR     Inst = 858
+	URSQRTE   Inst = 859
+	URSRA     Inst = 860
+	USDOT     Inst = 861
+	USHL      Inst = 862
+	USHLL     Inst = 863
+	USHLL2    Inst = 864
+	USHR      Inst = 865
+	USMMLA    Inst = 866
+	USMOPA    Inst = 867
+	USMOPS    Inst = 868
+	USQADD    Inst = 869
+	USRA      Inst = 870
+	USUBL     Inst = 871
+	USUBL2    Inst = 872
+	USUBW     Inst = 873
+	USUBW2    Inst = 874
+	UUNPKHI   Inst = 875
+	UUNPKLO   Inst = 876
+	UXTB      Inst = 877
+	UXTH      Inst = 878
+	UXTL      Inst = 879
+	UXTL2     Inst = 880
+	UZP1      Inst = 881
+	UZP2      Inst = 882
+	WFE       Inst = 883
+	WFET      Inst = 884
+	WFI       Inst = 885
+	WFIT      Inst = 886
+	WHILEGE   Inst = 887
+	WHILEGT   Inst = 888
+	WHILEHI   Inst = 889
+	WHILEHS   Inst = 890
+	WHILELE   Inst = 891
+	WHILELO   Inst = 892
+	WHILELS   Inst = 893
+	WHILELT   Inst = 894
+	WRFFR     Inst = 895
+	XAFLAG    Inst = 896
+	XAR       Inst = 897
+	XPACD     Inst = 898
+	XPACI     Inst = 899
+	XPACLRI   Inst = 900
+	XTN       Inst = 901
+	XTN2      Inst = 902
+	YIELD     Inst = 903
+	ZERO      Inst = 904
+	ZIP1      Inst = 905
+	ZIP2      Inst = 906
 )
+
+// InstName contains the mnemonic for each Inst.
+var InstName = [...]string{
+	"",
+	"ABS",
+	"ADC",
+	"ADCS",
+	"ADD",
+	"ADDG",
+	"ADDHA",
+	"ADDHN",
+	"ADDHN2",
+	"ADDP",
+	"ADDPL",
+	"ADDS",
+	"ADDSPL",
+	"ADDSVL",
+	"ADDV",
+	"ADDVA",
+	"ADDVL",
+	"ADR",
+	"ADRP",
+	"AESD",
+	"AESE",
+	"AESIMC",
+	"AESMC",
+	"AND",
+	"ANDS",
+	"ANDV",
+	"ASR",
+	"ASRV",
+	"AT",
+	"AUTDA",
+	"AUTDB",
+	"AUTDZA",
+	"AUTDZB",
+	"AUTIA",
+	"AUTIA1716",
+	"AUTIASP",
+	"AUTIAZ",
+	"AUTIB",
+	"AUTIB1716",
+	"AUTIBSP",
+	"AUTIBZ",
+	"AUTIZA",
+	"AUTIZB",
+	"AXFLAG",
+	"B",
+	"BCAX",
+	"BFC",
+	"BFCVT",
+	"BFCVTN",
+	"BFCVTN2",
+	"BFDOT",
+	"BFI",
+	"BFM",
+	"BFMLALB",
+	"BFMLALT",
+	"BFMMLA",
+	"BFMOPA",
+	"BFMOPS",
+	"BFXIL",
+	"BIC",
+	"BICS",
+	"BIF",
+	"BIT",
+	"BL",
+	"BLR",
+	"BLRAA",
+	"BLRAAZ",
+	"BLRAB",
+	"BLRABZ",
+	"BR",
+	"BRAA",
+	"BRAAZ",
+	"BRAB",
+	"BRABZ",
+	"BRK",
+	"BSL",
+	"BSL1N",
+	"BSL2N",
+	"BTI",
+	"CAS",
+	"CASA",
+	"CASAB",
+	"CASAH",
+	"CASAL",
+	"CASALB",
+	"CASALH",
+	"CASB",
+	"CASH",
+	"CASL",
+	"CASLB",
+	"CASLH",
+	"CASP",
+	"CASPA",
+	"CASPAL",
+	"CASPL",
+	"CBNZ",
+	"CBZ",
+	"CCMN",
+	"CCMP",
+	"CFINV",
+	"CFP",
+	"CINC",
+	"CINV",
+	"CLRBHB",
+	"CLREX",
+	"CLS",
+	"CLZ",
+	"CMEQ",
+	"CMGE",
+	"CMGT",
+	"CMHI",
+	"CMHS",
+	"CMLE",
+	"CMLT",
+	"CMN",
+	"CMP",
+	"CMPEQ",
+	"CMPGE",
+	"CMPGT",
+	"CMPHI",
+	"CMPHS",
+	"CMPLE",
+	"CMPLO",
+	"CMPLS",
+	"CMPLT",
+	"CMPNE",
+	"CMTST",
+	"CNEG",
+	"CNT",
+	"CNTB",
+	"CNTD",
+	"CNTH",
+	"CNTW",
+	"COMPACT",
+	"CPP",
+	"CPYE",
+	"CPYFE",
+	"CPYFM",
+	"CPYFP",
+	"CPYM",
+	"CPYP",
+	"CRC32B",
+	"CRC32CB",
+	"CRC32CH",
+	"CRC32CW",
+	"CRC32CX",
+	"CRC32H",
+	"CRC32W",
+	"CRC32X",
+	"CSDB",
+	"CSEL",
+	"CSET",
+	"CSETM",
+	"CSINC",
+	"CSINV",
+	"CSNEG",
+	"CTZ",
+	"DC",
+	"DCPS1",
+	"DCPS2",
+	"DCPS3",
+	"DECB",
+	"DECD",
+	"DECH",
+	"DECW",
+	"DGH",
+	"DMB",
+	"DRPS",
+	"DSB",
+	"DUP",
+	"DVP",
+	"EON",
+	"EOR",
+	"EOR3",
+	"EORV",
+	"ERET",
+	"ERETAA",
+	"ERETAB",
+	"ESB",
+	"EXT",
+	"EXTR",
+	"FABD",
+	"FABS",
+	"FACGE",
+	"FACGT",
+	"FADD",
+	"FADDP",
+	"FADDV",
+	"FCADD",
+	"FCCMP",
+	"FCCMPE",
+	"FCMEQ",
+	"FCMGE",
+	"FCMGT",
+	"FCMLA",
+	"FCMLE",
+	"FCMLT",
+	"FCMNE",
+	"FCMP",
+	"FCMPE",
+	"FCSEL",
+	"FCVT",
+	"FCVTAS",
+	"FCVTAU",
+	"FCVTL",
+	"FCVTL2",
+	"FCVTMS",
+	"FCVTMU",
+	"FCVTN",
+	"FCVTN2",
+	"FCVTNS",
+	"FCVTNU",
+	"FCVTPS",
+	"FCVTPU",
+	"FCVTXN",
+	"FCVTXN2",
+	"FCVTZS",
+	"FCVTZU",
+	"FDIV",
+	"FDIVR",
+	"FDUP",
+	"FJCVTZS",
+	"FMADD",
+	"FMAX",
+	"FMAXNM",
+	"FMAXNMP",
+	"FMAXNMV",
+	"FMAXP",
+	"FMAXV",
+	"FMIN",
+	"FMINNM",
+	"FMINNMP",
+	"FMINNMV",
+	"FMINP",
+	"FMINV",
+	"FMLA",
+	"FMLAL",
+	"FMLAL2",
+	"FMLS",
+	"FMLSL",
+	"FMLSL2",
+	"FMOPA",
+	"FMOPS",
+	"FMOV",
+	"FMSUB",
+	"FMUL",
+	"FMULX",
+	"FNEG",
+	"FNMADD",
+	"FNMLA",
+	"FNMLS",
+	"FNMSUB",
+	"FNMUL",
+	"FRECPE",
+	"FRECPS",
+	"FRECPX",
+	"FRINT32X",
+	"FRINT32Z",
+	"FRINT64X",
+	"FRINT64Z",
+	"FRINTA",
+	"FRINTI",
+	"FRINTM",
+	"FRINTN",
+	"FRINTP",
+	"FRINTX",
+	"FRINTZ",
+	"FRSQRTE",
+	"FRSQRTS",
+	"FSCALE",
+	"FSQRT",
+	"FSUB",
+	"FSUBR",
+	"GMI",
+	"HINT",
+	"HLT",
+	"HVC",
+	"IC",
+	"INCB",
+	"INCD",
+	"INCH",
+	"INCW",
+	"INDEX",
+	"INS",
+	"IRG",
+	"ISB",
+	"LD1",
+	"LD1B",
+	"LD1D",
+	"LD1H",
+	"LD1Q",
+	"LD1R",
+	"LD1RB",
+	"LD1RD",
+	"LD1RH",
+	"LD1RW",
+	"LD1W",
+	"LD2",
+	"LD2R",
+	"LD3",
+	"LD3R",
+	"LD4",
+	"LD4R",
+	"LD64B",
+	"LDADD",
+	"LDADDA",
+	"LDADDAB",
+	"LDADDAH",
+	"LDADDAL",
+	"LDADDALB",
+	"LDADDALH",
+	"LDADDB",
+	"LDADDH",
+	"LDADDL",
+	"LDADDLB",
+	"LDADDLH",
+	"LDAPR",
+	"LDAPRB",
+	"LDAPRH",
+	"LDAPUR",
+	"LDAPURB",
+	"LDAPURH",
+	"LDAPURSB",
+	"LDAPURSH",
+	"LDAPURSW",
+	"LDAR",
+	"LDARB",
+	"LDARH",
+	"LDAXP",
+	"LDAXR",
+	"LDAXRB",
+	"LDAXRH",
+	"LDCLR",
+	"LDCLRA",
+	"LDCLRAB",
+	"LDCLRAH",
+	"LDCLRAL",
+	"LDCLRALB",
+	"LDCLRALH",
+	"LDCLRB",
+	"LDCLRH",
+	"LDCLRL",
+	"LDCLRLB",
+	"LDCLRLH",
+	"LDEOR",
+	"LDEORA",
+	"LDEORAB",
+	"LDEORAH",
+	"LDEORAL",
+	"LDEORALB",
+	"LDEORALH",
+	"LDEORB",
+	"LDEORH",
+	"LDEORL",
+	"LDEORLB",
+	"LDEORLH",
+	"LDFF1B",
+	"LDFF1D",
+	"LDFF1H",
+	"LDFF1W",
+	"LDG",
+	"LDLAR",
+	"LDLARB",
+	"LDLARH",
+	"LDNP",
+	"LDP",
+	"LDPSW",
+	"LDR",
+	"LDRAA",
+	"LDRAB",
+	"LDRB",
+	"LDRH",
+	"LDRSB",
+	"LDRSH",
+	"LDRSW",
+	"LDSET",
+	"LDSETA",
+	"LDSETAB",
+	"LDSETAH",
+	"LDSETAL",
+	"LDSETALB",
+	"LDSETALH",
+	"LDSETB",
+	"LDSETH",
+	"LDSETL",
+	"LDSETLB",
+	"LDSETLH",
+	"LDSMAX",
+	"LDSMAXA",
+	"LDSMAXAB",
+	"LDSMAXAH",
+	"LDSMAXAL",
+	"LDSMAXALB",
+	"LDSMAXALH",
+	"LDSMAXB",
+	"LDSMAXH",
+	"LDSMAXL",
+	"LDSMAXLB",
+	"LDSMAXLH",
+	"LDSMIN",
+	"LDSMINA",
+	"LDSMINAB",
+	"LDSMINAH",
+	"LDSMINAL",
+	"LDSMINALB",
+	"LDSMINALH",
+	"LDSMINB",
+	"LDSMINH",
+	"LDSMINL",
+	"LDSMINLB",
+	"LDSMINLH",
+	"LDTR",
+	"LDTRB",
+	"LDTRH",
+	"LDTRSB",
+	"LDTRSH",
+	"LDTRSW",
+	"LDUMAX",
+	"LDUMAXA",
+	"LDUMAXAB",
+	"LDUMAXAH",
+	"LDUMAXAL",
+	"LDUMAXALB",
+	"LDUMAXALH",
+	"LDUMAXB",
+	"LDUMAXH",
+	"LDUMAXL",
+	"LDUMAXLB",
+	"LDUMAXLH",
+	"LDUMIN",
+	"LDUMINA",
+	"LDUMINAB",
+	"LDUMINAH",
+	"LDUMINAL",
+	"LDUMINALB",
+	"LDUMINALH",
+	"LDUMINB",
+	"LDUMINH",
+	"LDUMINL",
+	"LDUMINLB",
+	"LDUMINLH",
+	"LDUR",
+	"LDURB",
+	"LDURH",
+	"LDURSB",
+	"LDURSH",
+	"LDURSW",
+	"LDXP",
+	"LDXR",
+	"LDXRB",
+	"LDXRH",
+	"LSL",
+	"LSLV",
+	"LSR",
+	"LSRV",
+	"MADD",
+	"MLA",
+	"MLS",
+	"MNEG",
+	"MOV",
+	"MOVA",
+	"MOVI",
+	"MOVK",
+	"MOVN",
+	"MOVPRFX",
+	"MOVZ",
+	"MRS",
+	"MSR",
+	"MSUB",
+	"MUL",
+	"MVN",
+	"MVNI",
+	"NBSL",
+	"NEG",
+	"NEGS",
+	"NGC",
+	"NGCS",
+	"NOP",
+	"NOT",
+	"ORN",
+	"ORR",
+	"ORV",
+	"PACDA",
+	"PACDB",
+	"PACDZA",
+	"PACDZB",
+	"PACGA",
+	"PACIA",
+	"PACIA1716",
+	"PACIASP",
+	"PACIAZ",
+	"PACIB",
+	"PACIB1716",
+	"PACIBSP",
+	"PACIBZ",
+	"PACIZA",
+	"PACIZB",
+	"PFALSE",
+	"PMUL",
+	"PMULL",
+	"PMULL2",
+	"PRFM",
+	"PRFUM",
+	"PSB",
+	"PSSBB",
+	"PTEST",
+	"PTRUE",
+	"PTRUES",
+	"RADDHN",
+	"RADDHN2",
+	"RAX1",
+	"RBIT",
+	"RDFFR",
+	"RDFFRS",
+	"RDSVL",
+	"RDVL",
+	"RET",
+	"RETAA",
+	"RETAB",
+	"REV",
+	"REV16",
+	"REV32",
+	"REV64",
+	"RMIF",
+	"ROR",
+	"RORV",
+	"RSHRN",
+	"RSHRN2",
+	"RSUBHN",
+	"RSUBHN2",
+	"SABA",
+	"SABAL",
+	"SABAL2",
+	"SABD",
+	"SABDL",
+	"SABDL2",
+	"SADALP",
+	"SADDL",
+	"SADDL2",
+	"SADDLP",
+	"SADDLV",
+	"SADDV",
+	"SADDW",
+	"SADDW2",
+	"SB",
+	"SBC",
+	"SBCS",
+	"SBFIZ",
+	"SBFM",
+	"SBFX",
+	"SCVTF",
+	"SDIV",
+	"SDIVR",
+	"SDOT",
+	"SEL",
+	"SETE",
+	"SETF16",
+	"SETF8",
+	"SETFFR",
+	"SETGE",
+	"SETGM",
+	"SETGP",
+	"SETM",
+	"SETP",
+	"SEV",
+	"SEVL",
+	"SHA1C",
+	"SHA1H",
+	"SHA1M",
+	"SHA1P",
+	"SHA1SU0",
+	"SHA1SU1",
+	"SHA256H",
+	"SHA256H2",
+	"SHA256SU0",
+	"SHA256SU1",
+	"SHA512H",
+	"SHA512H2",
+	"SHA512SU0",
+	"SHA512SU1",
+	"SHADD",
+	"SHL",
+	"SHLL",
+	"SHLL2",
+	"SHRN",
+	"SHRN2",
+	"SHSUB",
+	"SLI",
+	"SM3PARTW1",
+	"SM3PARTW2",
+	"SM3SS1",
+	"SM3TT1A",
+	"SM3TT1B",
+	"SM3TT2A",
+	"SM3TT2B",
+	"SM4E",
+	"SM4EKEY",
+	"SMADDL",
+	"SMAX",
+	"SMAXP",
+	"SMAXV",
+	"SMC",
+	"SMIN",
+	"SMINP",
+	"SMINV",
+	"SMLAL",
+	"SMLAL2",
+	"SMLSL",
+	"SMLSL2",
+	"SMMLA",
+	"SMNEGL",
+	"SMOPA",
+	"SMOPS",
+	"SMOV",
+	"SMSTART",
+	"SMSTOP",
+	"SMSUBL",
+	"SMULH",
+	"SMULL",
+	"SMULL2",
+	"SPLICE",
+	"SQABS",
+	"SQADD",
+	"SQDMLAL",
+	"SQDMLAL2",
+	"SQDMLSL",
+	"SQDMLSL2",
+	"SQDMULH",
+	"SQDMULL",
+	"SQDMULL2",
+	"SQNEG",
+	"SQRDMLAH",
+	"SQRDMLSH",
+	"SQRDMULH",
+	"SQRSHL",
+	"SQRSHRN",
+	"SQRSHRN2",
+	"SQRSHRUN",
+	"SQRSHRUN2",
+	"SQSHL",
+	"SQSHLU",
+	"SQSHRN",
+	"SQSHRN2",
+	"SQSHRUN",
+	"SQSHRUN2",
+	"SQSUB",
+	"SQXTN",
+	"SQXTN2",
+	"SQXTUN",
+	"SQXTUN2",
+	"SRHADD",
+	"SRI",
+	"SRSHL",
+	"SRSHR",
+	"SRSRA",
+	"SSBB",
+	"SSHL",
+	"SSHLL",
+	"SSHLL2",
+	"SSHR",
+	"SSRA",
+	"SSUBL",
+	"SSUBL2",
+	"SSUBW",
+	"SSUBW2",
+	"ST1",
+	"ST1B",
+	"ST1D",
+	"ST1H",
+	"ST1Q",
+	"ST1W",
+	"ST2",
+	"ST2G",
+	"ST3",
+	"ST4",
+	"ST64B",
+	"ST64BV",
+	"ST64BV0",
+	"STADD",
+	"STADDB",
+	"STADDH",
+	"STADDL",
+	"STADDLB",
+	"STADDLH",
+	"STCLR",
+	"STCLRB",
+	"STCLRH",
+	"STCLRL",
+	"STCLRLB",
+	"STCLRLH",
+	"STEOR",
+	"STEORB",
+	"STEORH",
+	"STEORL",
+	"STEORLB",
+	"STEORLH",
+	"STG",
+	"STGP",
+	"STLLR",
+	"STLLRB",
+	"STLLRH",
+	"STLR",
+	"STLRB",
+	"STLRH",
+	"STLUR",
+	"STLURB",
+	"STLURH",
+	"STLXP",
+	"STLXR",
+	"STLXRB",
+	"STLXRH",
+	"STNP",
+	"STP",
+	"STR",
+	"STRB",
+	"STRH",
+	"STSET",
+	"STSETB",
+	"STSETH",
+	"STSETL",
+	"STSETLB",
+	"STSETLH",
+	"STSMAX",
+	"STSMAXB",
+	"STSMAXH",
+	"STSMAXL",
+	"STSMAXLB",
+	"STSMAXLH",
+	"STSMIN",
+	"STSMINB",
+	"STSMINH",
+	"STSMINL",
+	"STSMINLB",
+	"STSMINLH",
+	"STTR",
+	"STTRB",
+	"STTRH",
+	"STUMAX",
+	"STUMAXB",
+	"STUMAXH",
+	"STUMAXL",
+	"STUMAXLB",
+	"STUMAXLH",
+	"STUMIN",
+	"STUMINB",
+	"STUMINH",
+	"STUMINL",
+	"STUMINLB",
+	"STUMINLH",
+	"STUR",
+	"STURB",
+	"STURH",
+	"STXP",
+	"STXR",
+	"STXRB",
+	"STXRH",
+	"STZ2G",
+	"STZG",
+	"SUB",
+	"SUBG",
+	"SUBHN",
+	"SUBHN2",
+	"SUBP",
+	"SUBPS",
+	"SUBR",
+	"SUBS",
+	"SUDOT",
+	"SUMOPA",
+	"SUMOPS",
+	"SUNPKHI",
+	"SUNPKLO",
+	"SUQADD",
+	"SVC",
+	"SWP",
+	"SWPA",
+	"SWPAB",
+	"SWPAH",
+	"SWPAL",
+	"SWPALB",
+	"SWPALH",
+	"SWPB",
+	"SWPH",
+	"SWPL",
+	"SWPLB",
+	"SWPLH",
+	"SXTB",
+	"SXTH",
+	"SXTL",
+	"SXTL2",
+	"SXTW",
+	"SYS",
+	"SYSL",
+	"TBL",
+	"TBNZ",
+	"TBX",
+	"TBZ",
+	"TLBI",
+	"TRN1",
+	"TRN2",
+	"TSB",
+	"TST",
+	"UABA",
+	"UABAL",
+	"UABAL2",
+	"UABD",
+	"UABDL",
+	"UABDL2",
+	"UADALP",
+	"UADDL",
+	"UADDL2",
+	"UADDLP",
+	"UADDLV",
+	"UADDV",
+	"UADDW",
+	"UADDW2",
+	"UBFIZ",
+	"UBFM",
+	"UBFX",
+	"UCVTF",
+	"UDF",
+	"UDIV",
+	"UDIVR",
+	"UDOT",
+	"UHADD",
+	"UHSUB",
+	"UMADDL",
+	"UMAX",
+	"UMAXP",
+	"UMAXV",
+	"UMIN",
+	"UMINP",
+	"UMINV",
+	"UMLAL",
+	"UMLAL2",
+	"UMLSL",
+	"UMLSL2",
+	"UMMLA",
+	"UMNEGL",
+	"UMOPA",
+	"UMOPS",
+	"UMOV",
+	"UMSUBL",
+	"UMULH",
+	"UMULL",
+	"UMULL2",
+	"UQADD",
+	"UQRSHL",
+	"UQRSHRN",
+	"UQRSHRN2",
+	"UQSHL",
+	"UQSHRN",
+	"UQSHRN2",
+	"UQSUB",
+	"UQXTN",
+	"UQXTN2",
+	"URECPE",
+	"URHADD",
+	"URSHL",
+	"URSHR",
+	"URSQRTE",
+	"URSRA",
+	"USDOT",
+	"USHL",
+	"USHLL",
+	"USHLL2",
+	"USHR",
+	"USMMLA",
+	"USMOPA",
+	"USMOPS",
+	"USQADD",
+	"USRA",
+	"USUBL",
+	"USUBL2",
+	"USUBW",
+	"USUBW2",
+	"UUNPKHI",
+	"UUNPKLO",
+	"UXTB",
+	"UXTH",
+	"UXTL",
+	"UXTL2",
+	"UZP1",
+	"UZP2",
+	"WFE",
+	"WFET",
+	"WFI",
+	"WFIT",
+	"WHILEGE",
+	"WHILEGT",
+	"WHILEHI",
+	"WHILEHS",
+	"WHILELE",
+	"WHILELO",
+	"WHILELS",
+	"WHILELT",
+	"WRFFR",
+	"XAFLAG",
+	"XAR",
+	"XPACD",
+	"XPACI",
+	"XPACLRI",
+	"XTN",
+	"XTN2",
+	"YIELD",
+	"ZERO",
+	"ZIP1",
+	"ZIP2",
+}
@@ -21,6 +21,18 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b10111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// abs Vd.2D, Vn.2D
 	0b00001110, 0b11100000, 0b10111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// abs Wd, Wn
+	0b01011010, 0b11000000, 0b00100000, 0b00000000, 2, CmdR0, CmdR5,
+	// abs Xd, Xn
+	0b11011010, 0b11000000, 0b00100000, 0b00000000, 2, CmdR0, CmdR5,
+	// abs Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	0b00000100, 0b00010110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// abs Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	0b00000100, 0b01010110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// abs Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	0b00000100, 0b10010110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// abs Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	0b00000100, 0b11010110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// adc Wd, Wn, Wm
 	0b00011010, 0b00000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -59,6 +71,38 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b10000100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// add Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11100000, 0b10000100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// add Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// add Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// add Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// add Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// add Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// add Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// add Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// add Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// add Zd.B, Zn.B, #imm  ················································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00100000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// add Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b01100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// add Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b10100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// add Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b11100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+
+	// addg Xd|SP, Xn|SP, #imm1, #imm2  ················  (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16)
+	0b10010001, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdUscaled, 16, 6, 4, CmdUbits, 10, 4,
+
+	// addha ZAd.S, Pg1/M, Pg2/M, Zn.S  ······································  (d < 4, g1 < 8, g2 < 8)
+	0b11000000, 0b10010000, 0b00000000, 0b00000000, 4, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5,
+	// addha ZAd.D, Pg1/M, Pg2/M, Zn.D  ······································  (d < 8, g1 < 8, g2 < 8)
+	0b11000000, 0b11010000, 0b00000000, 0b00000000, 4, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5,
 
 	// addhn Vd.8B, Vn.8H, Vm.8H
 	0b00001110, 0b00100000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -88,6 +132,9 @@ var Commands = [...]byte{
 	// addp Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11100000, 0b10111100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 
+	// addpl Xd|SP, Xn|SP, #imm  ···················································  (-32 <= imm < 32)
+	0b00000100, 0b01100000, 0b01010000, 0b00000000, 3, CmdR0, CmdR16, CmdSfield, 5, 6,
+
 	// adds Wd, Wn, Wm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 32)
 	0b00101011, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// adds Xd, Xn, Xm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 64)
@@ -103,6 +150,12 @@ var Commands = [...]byte{
 	// adds Xd, Xn|SP, #imm1 {, LSL #imm2 }  ·····················  (0 <= imm1 < 4096, imm2 in [0, 12])
 	0b10110001, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdUbits, 10, 12, CmdUAlt2, 22, 6,
 
+	// addspl Xd|SP, Xn|SP, #imm  ··················································  (-32 <= imm < 32)
+	0b00000100, 0b01100000, 0b01011000, 0b00000000, 3, CmdR0, CmdR16, CmdSfield, 5, 6,
+
+	// addsvl Xd|SP, Xn|SP, #imm  ··················································  (-32 <= imm < 32)
+	0b00000100, 0b00100000, 0b01011000, 0b00000000, 3, CmdR0, CmdR16, CmdSfield, 5, 6,
+
 	// addv Bd, Vn.16B
 	// addv Bd, Vn.8B
 	0b00001110, 0b00110001, 0b10111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
@@ -112,6 +165,14 @@ var Commands = [...]byte{
 	// addv Sd, Vn.4S
 	0b00001110, 0b10110001, 0b10111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 
+	// addva ZAd.S, Pg1/M, Pg2/M, Zn.S  ······································  (d < 4, g1 < 8, g2 < 8)
+	0b11000000, 0b10010001, 0b00000000, 0b00000000, 4, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5,
+	// addva ZAd.D, Pg1/M, Pg2/M, Zn.D  ······································  (d < 8, g1 < 8, g2 < 8)
+	0b11000000, 0b11010001, 0b00000000, 0b00000000, 4, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5,
+
+	// addvl Xd|SP, Xn|SP, #imm  ···················································  (-32 <= imm < 32)
+	0b00000100, 0b00100000, 0b01010000, 0b00000000, 3, CmdR0, CmdR16, CmdSfield, 5, 6,
+
 	// adr Xd, <offset>  ···············································  (offset is 21-bit (+/- 1 MB))
 	0b00010000, 0b00000000, 0b00000000, 0b00000000, 2, CmdR0, CmdOffset, RelAdr,
 
@@ -141,6 +202,20 @@ var Commands = [...]byte{
 	0b00001010, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// and Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
 	0b10001010, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 6,
+	// and Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00011010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// and Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01011010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// and Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10011010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// and Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11011010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// and Zd.D, Zn.D, Zm.D
+	0b00000100, 0b00100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// and Zd.S, Zn.S, #imm  ·········································  (n == d, imm is 32-bit logical)
+	0b00000101, 0b10000000, 0b00000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSpecial, 5, SpecialImmLogical32,
+	// and Zd.D, Zn.D, #imm  ·········································  (n == d, imm is 64-bit logical)
+	0b00000101, 0b10000010, 0b00000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSpecial, 5, SpecialImmLogical64,
 
 	// ands Wd, Wn, #imm  ····················································  (imm is 32-bit logical)
 	0b01110010, 0b00000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdSpecial, 10, SpecialImmLogical32,
@@ -151,6 +226,15 @@ var Commands = [...]byte{
 	// ands Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ····································  (0 <= imm < 64)
 	0b11101010, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 6,
 
+	// andv Bd, Pg, Zn.B  ····································································  (g < 8)
+	0b00000100, 0b00011010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// andv Hd, Pg, Zn.H  ····································································  (g < 8)
+	0b00000100, 0b01011010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// andv Sd, Pg, Zn.S  ····································································  (g < 8)
+	0b00000100, 0b10011010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// andv Dd, Pg, Zn.D  ····································································  (g < 8)
+	0b00000100, 0b11011010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+
 	// asr Wd, Wn, Wm
 	0b00011010, 0b11000000, 0b00101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// asr Xd, Xn, Xm
@@ -159,6 +243,30 @@ var Commands = [...]byte{
 	0b00010011, 0b00000000, 0b01111100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 16, 5,
 	// asr Xd, Xn, #imm  ·····························································  (0 <= imm < 64)
 	0b10010011, 0b01000000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 16, 6,
+	// asr Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00010000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// asr Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01010000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// asr Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10010000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// asr Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11010000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// asr Zd.B, Zn.B, #imm  ··························································  (0 < imm <= 8)
+	0b00000100, 0b00101000, 0b10010000, 0b00000000, 3, CmdR0, CmdR5, CmdUsub, 16, 3, 8,
+	// asr Zd.H, Zn.H, #imm  ·························································  (0 < imm <= 16)
+	0b00000100, 0b00110000, 0b10010000, 0b00000000, 3, CmdR0, CmdR5, CmdUsub, 16, 4, 16,
+	// asr Zd.S, Zn.S, #imm  ·························································  (0 < imm <= 32)
+	0b00000100, 0b01100000, 0b10010000, 0b00000000, 3, CmdR0, CmdR5, CmdUsub, 16, 5, 32,
+	// asr Zd.D, Zn.D, #imm  ·························································  (0 < imm <= 64)
+	0b00000100, 0b10100000, 0b10010000, 0b00000000, 3, CmdR0, CmdR5, CmdSpecial, 16, SpecialImmTszRight64,
+	// asr Zd.B, Pg/M, Zn.B, #imm  ·····································  (g < 8, n == d, 0 < imm <= 8)
+	0b00000100, 0b00000000, 0b10000001, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUsub, 5, 3, 8,
+	// asr Zd.H, Pg/M, Zn.H, #imm  ····································  (g < 8, n == d, 0 < imm <= 16)
+	0b00000100, 0b00000000, 0b10000010, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUsub, 5, 4, 16,
+	// asr Zd.S, Pg/M, Zn.S, #imm  ····································  (g < 8, n == d, 0 < imm <= 32)
+	0b00000100, 0b01000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUsub, 5, 5, 32,
+	// asr Zd.D, Pg/M, Zn.D, #imm  ····································  (g < 8, n == d, 0 < imm <= 64)
+	0b00000100, 0b10000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdSpecial, 5, SpecialImmTszRight64,
 
 	// asrv Wd, Wn, Wm
 	0b00011010, 0b11000000, 0b00101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -210,6 +318,9 @@ var Commands = [...]byte{
 	// autizb Xd
 	0b11011010, 0b11000001, 0b00110111, 0b11100000, 1, CmdR0,
 
+	// axflag
+	0b11010101, 0b00000000, 0b01000000, 0b01011111, 0,
+
 	// b <cond>, <offset>  ········································  (offset >> 2 is 19-bit (+/- 1 MB))
 	0b01010100, 0b00000000, 0b00000000, 0b00000000, 2, CmdCond, 0, CmdOffset, RelBCond,
 	// b <offset>  ··············································  (offset >> 2 is 26-bit (+/- 128 MB))
@@ -217,12 +328,32 @@ var Commands = [...]byte{
 
 	// bcax Vd.16B, Vn.16B, Vm.16B, Va.16B
 	0b11001110, 0b00100000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
+	// bcax Zd.D, Zn.D, Zm.D, Za.D  ·························································  (n == d)
+	0b00000100, 0b01100000, 0b00111000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdR16, CmdR5,
 
 	// bfc Wd, #imm1, #imm2  ·····················  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
 	0b00110011, 0b00000000, 0b00000011, 0b11100000, 4, CmdR0, CmdUnegmod, 16, 5, CmdChkUsum, 5, CmdUrange, 10, 1, 32,
 	// bfc Xd, #imm1, #imm2  ·····················  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
 	0b10110011, 0b01000000, 0b00000011, 0b11100000, 4, CmdR0, CmdUnegmod, 16, 6, CmdChkUsum, 6, CmdUrange, 10, 1, 64,
 
+	// bfcvt Hd, Sn
+	0b00011110, 0b01100011, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+
+	// bfcvtn Vd.4H, Vn.4S
+	0b00001110, 0b10100001, 0b01101000, 0b00000000, 2, CmdR0, CmdR5,
+
+	// bfcvtn2 Vd.8H, Vn.4S
+	0b01001110, 0b10100001, 0b01101000, 0b00000000, 2, CmdR0, CmdR5,
+
+	// bfdot Vd.2S, Vn.4H, Vm.4H
+	0b00101110, 0b01000000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// bfdot Vd.2S, Vn.4H, Vm.2H[i]
+	0b00001111, 0b01000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
+	// bfdot Vd.4S, Vn.8H, Vm.8H
+	0b01101110, 0b01000000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// bfdot Vd.4S, Vn.8H, Vm.2H[i]
+	0b01001111, 0b01000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
+
 	// bfi Wd, Wn, #imm1, #imm2  ·················  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
 	0b00110011, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdUnegmod, 16, 5, CmdChkUsum, 5, CmdUrange, 10, 1, 32,
 	// bfi Xd, Xn, #imm1, #imm2  ·················  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
@@ -233,6 +364,25 @@ var Commands = [...]byte{
 	// bfm Xd, Xn, #imm1, #imm2  ··················  (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64)
 	0b10110011, 0b01000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdUbits, 16, 6, CmdChkUsum, 6, CmdUbits, 10, 6,
 
+	// bfmlalb Vd.4S, Vn.8H, Vm.8H
+	0b00101110, 0b11000000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// bfmlalb Vd.4S, Vn.8H, Vm.H[i]  ·······················································  (m < 16)
+	0b00001111, 0b11000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
+
+	// bfmlalt Vd.4S, Vn.8H, Vm.8H
+	0b01101110, 0b11000000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// bfmlalt Vd.4S, Vn.8H, Vm.H[i]  ·······················································  (m < 16)
+	0b01001111, 0b11000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
+
+	// bfmmla Vd.4S, Vn.8H, Vm.8H
+	0b01101110, 0b01000000, 0b11101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// bfmopa ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 4, g1 < 8, g2 < 8)
+	0b10000001, 0b10000000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
+	// bfmops ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 4, g1 < 8, g2 < 8)
+	0b10000001, 0b10000000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
 	// bfxil Wd, Wn, #imm1, #imm2  ···············  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
 	0b00110011, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdUbits, 16, 5, CmdChkUsum, 5, CmdUsumdec, 10, 5,
 	// bfxil Xd, Xn, #imm1, #imm2  ···············  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
@@ -251,6 +401,16 @@ var Commands = [...]byte{
 	0b00001010, 0b00100000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// bic Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
 	0b10001010, 0b00100000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 6,
+	// bic Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00011011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// bic Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01011011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// bic Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10011011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// bic Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11011011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// bic Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// bics Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ····································  (0 <= imm < 32)
 	0b01101010, 0b00100000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
@@ -304,6 +464,19 @@ var Commands = [...]byte{
 	// bsl Vd.16B, Vn.16B, Vm.16B
 	// bsl Vd.8B, Vn.8B, Vm.8B
 	0b00101110, 0b01100000, 0b00011100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// bsl Zd.D, Zn.D, Zm.D, Za.D  ··························································  (n == d)
+	0b00000100, 0b00100000, 0b00111100, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdR16, CmdR5,
+
+	// bsl1n Zd.D, Zn.D, Zm.D, Za.D  ························································  (n == d)
+	0b00000100, 0b01100000, 0b00111100, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdR16, CmdR5,
+
+	// bsl2n Zd.D, Zn.D, Zm.D, Za.D  ························································  (n == d)
+	0b00000100, 0b10100000, 0b00111100, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdR16, CmdR5,
+
+	// bti
+	0b11010101, 0b00000011, 0b00100100, 0b00011111, 0,
+	// bti <symbol>
+	0b11010101, 0b00000011, 0b00100100, 0b00011111, 1, CmdLitList, 6, SymBTITARGETS,
 
 	// cas Wd, Wn, [Xm|SP]
 	0b10001000, 0b10100000, 0b01111100, 0b00000000, 3, CmdR16, CmdR0, CmdR5,
@@ -413,6 +586,9 @@ var Commands = [...]byte{
 	// cinv Xd, Xn, <cond>
 	0b11011010, 0b10000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdBack, CmdR16, CmdCondInv, 12,
 
+	// clrbhb
+	0b11010101, 0b00000011, 0b00100010, 0b11011111, 0,
+
 	// clrex #imm  ···································································  (0 <= imm < 16)
 	0b11010101, 0b00000011, 0b00110000, 0b01011111, 1, CmdUbits, 8, 4,
 	// clrex
@@ -431,6 +607,14 @@ var Commands = [...]byte{
 	0b01011010, 0b11000000, 0b00010100, 0b00000000, 2, CmdR0, CmdR5,
 	// cls Xd, Xn
 	0b11011010, 0b11000000, 0b00010100, 0b00000000, 2, CmdR0, CmdR5,
+	// cls Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	0b00000100, 0b00011000, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// cls Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	0b00000100, 0b01011000, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// cls Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	0b00000100, 0b10011000, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// cls Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	0b00000100, 0b11011000, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// clz Vd.16B, Vn.16B
 	// clz Vd.8B, Vn.8B
@@ -445,6 +629,14 @@ var Commands = [...]byte{
 	0b01011010, 0b11000000, 0b00010000, 0b00000000, 2, CmdR0, CmdR5,
 	// clz Xd, Xn
 	0b11011010, 0b11000000, 0b00010000, 0b00000000, 2, CmdR0, CmdR5,
+	// clz Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	0b00000100, 0b00011001, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// clz Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	0b00000100, 0b01011001, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// clz Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	0b00000100, 0b10011001, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// clz Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	0b00000100, 0b11011001, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// cmeq Dd, Dn, Dm
 	0b01111110, 0b11100000, 0b10001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -613,6 +805,144 @@ var Commands = [...]byte{
 	// cmp Xd|SP, #imm1 {, LSL #imm2 }  ··························  (0 <= imm1 < 4096, imm2 in [0, 12])
 	0b11110001, 0b00000000, 0b00000000, 0b00011111, 3, CmdR5, CmdUbits, 10, 12, CmdUAlt2, 22, 6,
 
+	// cmpeq Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	0b00100100, 0b00000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpeq Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b00100100, 0b01000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpeq Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b00100100, 0b10000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpeq Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b00100100, 0b11000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpeq Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b00000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpeq Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b01000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpeq Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b10000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpeq Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b11000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+
+	// cmpge Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	0b00100100, 0b00000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpge Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b00100100, 0b01000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpge Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b00100100, 0b10000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpge Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b00100100, 0b11000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpge Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpge Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b01000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpge Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpge Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b11000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+
+	// cmpgt Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	0b00100100, 0b00000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpgt Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b00100100, 0b01000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpgt Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b00100100, 0b10000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpgt Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b00100100, 0b11000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpgt Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b00000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpgt Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b01000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpgt Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b10000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpgt Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b11000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+
+	// cmphi Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	0b00100100, 0b00000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphi Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b00100100, 0b01000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphi Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b00100100, 0b10000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphi Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b00100100, 0b11000000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphi Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b00100000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmphi Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b01100000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmphi Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b10100000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmphi Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b11100000, 0b00000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+
+	// cmphs Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	0b00100100, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphs Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b00100100, 0b01000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphs Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b00100100, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphs Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b00100100, 0b11000000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmphs Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b00100000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmphs Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b01100000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmphs Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b10100000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmphs Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b11100000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+
+	// cmple Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b00000000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmple Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b01000000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmple Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b10000000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmple Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b11000000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+
+	// cmplo Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b00100000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmplo Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b01100000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmplo Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b10100000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmplo Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b11100000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+
+	// cmpls Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b00100000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmpls Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b01100000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmpls Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b10100000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+	// cmpls Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	0b00100100, 0b11100000, 0b00100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUbits, 14, 7,
+
+	// cmplt Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b00000000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmplt Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b01000000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmplt Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b10000000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmplt Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b11000000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+
+	// cmpne Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	0b00100100, 0b00000000, 0b10100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpne Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b00100100, 0b01000000, 0b10100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpne Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b00100100, 0b10000000, 0b10100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpne Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b00100100, 0b11000000, 0b10100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// cmpne Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b00000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpne Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b01000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpne Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b10000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+	// cmpne Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	0b00100101, 0b11000000, 0b10000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 5,
+
 	// cmtst Dd, Dn, Dm
 	0b01011110, 0b11100000, 0b10001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// cmtst Vd.16B, Vn.16B, Vm.16B
@@ -635,10 +965,65 @@ var Commands = [...]byte{
 	// cnt Vd.16B, Vn.16B
 	// cnt Vd.8B, Vn.8B
 	0b00001110, 0b00100000, 0b01011000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// cnt Wd, Wn
+	0b01011010, 0b11000000, 0b00011100, 0b00000000, 2, CmdR0, CmdR5,
+	// cnt Xd, Xn
+	0b11011010, 0b11000000, 0b00011100, 0b00000000, 2, CmdR0, CmdR5,
+	// cnt Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	0b00000100, 0b00011010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// cnt Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	0b00000100, 0b01011010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// cnt Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	0b00000100, 0b10011010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// cnt Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	0b00000100, 0b11011010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+
+	// cntb Xd
+	0b00000100, 0b00100000, 0b11100011, 0b11100000, 1, CmdR0,
+	// cntb Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b00100000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// cntd Xd
+	0b00000100, 0b11100000, 0b11100011, 0b11100000, 1, CmdR0,
+	// cntd Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b11100000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// cnth Xd
+	0b00000100, 0b01100000, 0b11100011, 0b11100000, 1, CmdR0,
+	// cnth Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b01100000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// cntw Xd
+	0b00000100, 0b10100000, 0b11100011, 0b11100000, 1, CmdR0,
+	// cntw Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b10100000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// compact Zd.S, Pg, Zn.S  ·······························································  (g < 8)
+	0b00000101, 0b10100001, 0b10000000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// compact Zd.D, Pg, Zn.D  ·······························································  (g < 8)
+	0b00000101, 0b11100001, 0b10000000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// cpp RCTX, Xn
 	0b11010101, 0b00001011, 0b01110011, 0b11100000, 1, CmdR0,
 
+	// cpye [Xd]!, [Xn]!, Xm
+	0b00011101, 0b10000000, 0b00000100, 0b00000000, 5, CmdR0, CmdAdv, CmdR16, CmdAdv, CmdR5,
+
+	// cpyfe [Xd]!, [Xn]!, Xm
+	0b00011001, 0b10000000, 0b00000100, 0b00000000, 5, CmdR0, CmdAdv, CmdR16, CmdAdv, CmdR5,
+
+	// cpyfm [Xd]!, [Xn]!, Xm
+	0b00011001, 0b01000000, 0b00000100, 0b00000000, 5, CmdR0, CmdAdv, CmdR16, CmdAdv, CmdR5,
+
+	// cpyfp [Xd]!, [Xn]!, Xm
+	0b00011001, 0b00000000, 0b00000100, 0b00000000, 5, CmdR0, CmdAdv, CmdR16, CmdAdv, CmdR5,
+
+	// cpym [Xd]!, [Xn]!, Xm
+	0b00011101, 0b01000000, 0b00000100, 0b00000000, 5, CmdR0, CmdAdv, CmdR16, CmdAdv, CmdR5,
+
+	// cpyp [Xd]!, [Xn]!, Xm
+	0b00011101, 0b00000000, 0b00000100, 0b00000000, 5, CmdR0, CmdAdv, CmdR16, CmdAdv, CmdR5,
+
 	// crc32b Wd, Wn, Wm
 	0b00011010, 0b11000000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
@@ -696,6 +1081,11 @@ var Commands = [...]byte{
 	// csneg Xd, Xn, Xm, <cond>
 	0b11011010, 0b10000000, 0b00000100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdCond, 12,
 
+	// ctz Wd, Wn
+	0b01011010, 0b11000000, 0b00011000, 0b00000000, 2, CmdR0, CmdR5,
+	// ctz Xd, Xn
+	0b11011010, 0b11000000, 0b00011000, 0b00000000, 2, CmdR0, CmdR5,
+
 	// dc <symbol>, Xn
 	0b11010101, 0b00001000, 0b01110000, 0b00000000, 2, CmdLitList, 5, SymDCOPS, CmdR0,
 
@@ -708,6 +1098,29 @@ var Commands = [...]byte{
 	// dcps3  {, #imm }  ··························································  (0 <= imm < 65536)
 	0b11010100, 0b10100000, 0b00000000, 0b00000011, 1, CmdUbits, 5, 16,
 
+	// decb Xd
+	0b00000100, 0b00110000, 0b11100111, 0b11100000, 1, CmdR0,
+	// decb Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b00110000, 0b11100100, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// decd Xd
+	0b00000100, 0b11110000, 0b11100111, 0b11100000, 1, CmdR0,
+	// decd Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b11110000, 0b11100100, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// dech Xd
+	0b00000100, 0b01110000, 0b11100111, 0b11100000, 1, CmdR0,
+	// dech Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b01110000, 0b11100100, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// decw Xd
+	0b00000100, 0b10110000, 0b11100111, 0b11100000, 1, CmdR0,
+	// decw Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b10110000, 0b11100100, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// dgh
+	0b11010101, 0b00000011, 0b00100000, 0b11011111, 0,
+
 	// dmb <symbol>
 	0b11010101, 0b00000011, 0b00110000, 0b10111111, 1, CmdLitList, 8, SymBARRIEROPS,
 	// dmb #imm  ·····································································  (0 <= imm < 16)
@@ -751,6 +1164,22 @@ var Commands = [...]byte{
 	0b00001110, 0b00000100, 0b00001100, 0b00000000, 3, CmdR0, CmdRwidth30, CmdR5,
 	// dup Vd.2D, Xn
 	0b00001110, 0b00001000, 0b00001100, 0b00000000, 3, CmdR0, CmdRwidth30, CmdR5,
+	// dup Zd.B, Wn|WSP
+	0b00000101, 0b00100000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// dup Zd.H, Wn|WSP
+	0b00000101, 0b01100000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// dup Zd.S, Wn|WSP
+	0b00000101, 0b10100000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// dup Zd.D, Xn|SP
+	0b00000101, 0b11100000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// dup Zd.B, #imm  ···························································  (-128 <= imm < 128)
+	0b00100101, 0b00111000, 0b11000000, 0b00000000, 2, CmdR0, CmdSfield, 5, 8,
+	// dup Zd.H, #imm1 {, LSL #imm2 }  ··························  (-128 <= imm1 < 128, imm2 in [0, 8])
+	0b00100101, 0b01111000, 0b11000000, 0b00000000, 3, CmdR0, CmdSfield, 5, 8, CmdUAlt2, 13, 5,
+	// dup Zd.S, #imm1 {, LSL #imm2 }  ··························  (-128 <= imm1 < 128, imm2 in [0, 8])
+	0b00100101, 0b10111000, 0b11000000, 0b00000000, 3, CmdR0, CmdSfield, 5, 8, CmdUAlt2, 13, 5,
+	// dup Zd.D, #imm1 {, LSL #imm2 }  ··························  (-128 <= imm1 < 128, imm2 in [0, 8])
+	0b00100101, 0b11111000, 0b11000000, 0b00000000, 3, CmdR0, CmdSfield, 5, 8, CmdUAlt2, 13, 5,
 
 	// dvp RCTX, Xn
 	0b11010101, 0b00001011, 0b01110011, 0b10100000, 1, CmdR0,
@@ -771,9 +1200,34 @@ var Commands = [...]byte{
 	0b01001010, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// eor Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
 	0b11001010, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 6,
+	// eor Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00011001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// eor Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01011001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// eor Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10011001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// eor Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11011001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// eor Zd.D, Zn.D, Zm.D
+	0b00000100, 0b10100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// eor Zd.S, Zn.S, #imm  ·········································  (n == d, imm is 32-bit logical)
+	0b00000101, 0b01000000, 0b00000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSpecial, 5, SpecialImmLogical32,
+	// eor Zd.D, Zn.D, #imm  ·········································  (n == d, imm is 64-bit logical)
+	0b00000101, 0b01000010, 0b00000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSpecial, 5, SpecialImmLogical64,
 
 	// eor3 Vd.16B, Vn.16B, Vm.16B, Va.16B
 	0b11001110, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
+	// eor3 Zd.D, Zn.D, Zm.D, Za.D  ·························································  (n == d)
+	0b00000100, 0b00100000, 0b00111000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdR16, CmdR5,
+
+	// eorv Bd, Pg, Zn.B  ····································································  (g < 8)
+	0b00000100, 0b00011001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// eorv Hd, Pg, Zn.H  ····································································  (g < 8)
+	0b00000100, 0b01011001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// eorv Sd, Pg, Zn.S  ····································································  (g < 8)
+	0b00000100, 0b10011001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// eorv Dd, Pg, Zn.D  ····································································  (g < 8)
+	0b00000100, 0b11011001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// eret
 	0b11010110, 0b10011111, 0b00000011, 0b11100000, 0,
@@ -791,6 +1245,8 @@ var Commands = [...]byte{
 	0b00101110, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUbits, 11, 3,
 	// ext Vd.16B, Vn.16B, Vm.16B, #imm  ·············································  (0 <= imm < 16)
 	0b01101110, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUbits, 11, 4,
+	// ext Zd.B, Zn.B, Zm.B, #imm  ··········································  (n == d, 0 <= imm < 256)
+	0b00000101, 0b00100000, 0b00000000, 0b00000000, 7, CmdR0, CmdRSame, 1, CmdR5, CmdChkUbits, 8, CmdUslice, 10, 3, 0, CmdUslice, 16, 5, 3, CmdAdv,
 
 	// extr Wd, Wn, Wm, #imm  ························································  (0 <= imm < 32)
 	0b00010011, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUbits, 10, 5,
@@ -811,6 +1267,12 @@ var Commands = [...]byte{
 	0b00101110, 0b10100000, 0b11010100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// fabd Vd.2D, Vn.2D, Vm.2D
 	0b00101110, 0b11100000, 0b11010100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// fabd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b01100101, 0b01001000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fabd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b01100101, 0b10001000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fabd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b01100101, 0b11001000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// fabs Vd.8H, Vn.8H
 	// fabs Vd.4H, Vn.4H
@@ -826,6 +1288,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
 	// fabs Dd, Dn
 	0b00011110, 0b01100000, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// fabs Zd.H, Pg/M, Zn.H  ································································  (g < 8)
+	0b00000100, 0b01011100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fabs Zd.S, Pg/M, Zn.S  ································································  (g < 8)
+	0b00000100, 0b10011100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fabs Zd.D, Pg/M, Zn.D  ································································  (g < 8)
+	0b00000100, 0b11011100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// facge Hd, Hn, Hm
 	0b01111110, 0b01000000, 0b00101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -871,6 +1339,18 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b00101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fadd Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b00101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fadd Zd.H, Zn.H, Zm.H
+	0b01100101, 0b01000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fadd Zd.S, Zn.S, Zm.S
+	0b01100101, 0b10000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fadd Zd.D, Zn.D, Zm.D
+	0b01100101, 0b11000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fadd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b01100101, 0b01000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fadd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b01100101, 0b10000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fadd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b01100101, 0b11000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// faddp Hd, Vn.2H
 	0b01011110, 0b00110000, 0b11011000, 0b00000000, 2, CmdR0, CmdR5,
@@ -887,6 +1367,13 @@ var Commands = [...]byte{
 	// faddp Vd.2D, Vn.2D, Vm.2D
 	0b00101110, 0b01100000, 0b11010100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 
+	// faddv Hd, Pg, Zn.H  ···································································  (g < 8)
+	0b01100101, 0b01000000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// faddv Sd, Pg, Zn.S  ···································································  (g < 8)
+	0b01100101, 0b10000000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// faddv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b01100101, 0b11000000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+
 	// fcadd Vd.8H, Vn.8H, Vm.8H, #imm  ···········································  (imm in [90, 270])
 	// fcadd Vd.4H, Vn.4H, Vm.4H, #imm  ···········································  (imm in [90, 270])
 	0b00101110, 0b01000000, 0b11100100, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdUAlt2, 12, 9, CmdRwidth30,
@@ -938,6 +1425,12 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b11011000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// fcmeq Vd.2D, Vn.2D, #0.0
 	0b00001110, 0b11100000, 0b11011000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// fcmeq Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b01100101, 0b01000000, 0b01100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmeq Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b01100101, 0b10000000, 0b01100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmeq Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b01100101, 0b11000000, 0b01100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
 
 	// fcmge Hd, Hn, Hm
 	0b01111110, 0b01000000, 0b00100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -967,6 +1460,12 @@ var Commands = [...]byte{
 	0b00101110, 0b10100000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// fcmge Vd.2D, Vn.2D, #0.0
 	0b00101110, 0b11100000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// fcmge Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b01100101, 0b01000000, 0b01000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmge Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b01100101, 0b10000000, 0b01000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmge Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b01100101, 0b11000000, 0b01000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
 
 	// fcmgt Hd, Hn, Hm
 	0b01111110, 0b11000000, 0b00100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -996,6 +1495,12 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// fcmgt Vd.2D, Vn.2D, #0.0
 	0b00001110, 0b11100000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// fcmgt Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b01100101, 0b01000000, 0b01000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmgt Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b01100101, 0b10000000, 0b01000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmgt Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b01100101, 0b11000000, 0b01000000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
 
 	// fcmla Vd.4H, Vn.4H, Vm.H[i], #imm  ·································  (imm in [0, 90, 180, 270])
 	0b00101111, 0b01000000, 0b00010000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdUfields21, CmdUAlt4, 13, 2,
@@ -1042,6 +1547,13 @@ var Commands = [...]byte{
 	// fcmlt Vd.2D, Vn.2D, #0.0
 	0b00001110, 0b11100000, 0b11101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 
+	// fcmne Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	0b01100101, 0b01000000, 0b01100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmne Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	0b01100101, 0b10000000, 0b01100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fcmne Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	0b01100101, 0b11000000, 0b01100000, 0b00010000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+
 	// fcmp Hd, Hn
 	0b00011110, 0b11100000, 0b00100000, 0b00000000, 2, CmdR5, CmdR16,
 	// fcmp Hd, #0.0
@@ -1380,6 +1892,12 @@ var Commands = [...]byte{
 	0b00011110, 0b01111000, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
 	// fcvtzs Xd, Dn
 	0b10011110, 0b01111000, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
+	// fcvtzs Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01011010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fcvtzs Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10011100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fcvtzs Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11011110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fcvtzu Hd, Hn, #imm  ··························································  (0 < imm <= 16)
 	0b01111111, 0b00000000, 0b11111100, 0b00000000, 4, CmdR0, CmdR5, CmdChkUrange1, 16, CmdUsub, 16, 5, 32,
@@ -1433,6 +1951,12 @@ var Commands = [...]byte{
 	0b00011110, 0b01111001, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
 	// fcvtzu Xd, Dn
 	0b10011110, 0b01111001, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
+	// fcvtzu Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01011011, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fcvtzu Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10011101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fcvtzu Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11011111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fdiv Vd.8H, Vn.8H, Vm.8H
 	// fdiv Vd.4H, Vn.4H, Vm.4H
@@ -1448,6 +1972,26 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fdiv Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fdiv Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b01100101, 0b01001101, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fdiv Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b01100101, 0b10001101, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fdiv Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b01100101, 0b11001101, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+
+	// fdivr Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	0b01100101, 0b01001100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fdivr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	0b01100101, 0b10001100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fdivr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	0b01100101, 0b11001100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+
+	// fdup Zd.H, #imm  ·······························································  (imm is float)
+	0b00100101, 0b01111001, 0b11000000, 0b00000000, 2, CmdR0, CmdSpecial, 5, SpecialImmFloat,
+	// fdup Zd.S, #imm  ·······························································  (imm is float)
+	0b00100101, 0b10111001, 0b11000000, 0b00000000, 2, CmdR0, CmdSpecial, 5, SpecialImmFloat,
+	// fdup Zd.D, #imm  ·······························································  (imm is float)
+	0b00100101, 0b11111001, 0b11000000, 0b00000000, 2, CmdR0, CmdSpecial, 5, SpecialImmFloat,
 
 	// fjcvtzs Wd, Dn
 	0b00011110, 0b01111110, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
@@ -1473,6 +2017,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b01001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fmax Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b01001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fmax Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b01100101, 0b01000110, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmax Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b01100101, 0b10000110, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmax Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b01100101, 0b11000110, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// fmaxnm Vd.8H, Vn.8H, Vm.8H
 	// fmaxnm Vd.4H, Vn.4H, Vm.4H
@@ -1488,6 +2038,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fmaxnm Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fmaxnm Zd.H, Pg/M, Zn.H, Zm.H  ················································  (g < 8, n == d)
+	0b01100101, 0b01000100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmaxnm Zd.S, Pg/M, Zn.S, Zm.S  ················································  (g < 8, n == d)
+	0b01100101, 0b10000100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmaxnm Zd.D, Pg/M, Zn.D, Zm.D  ················································  (g < 8, n == d)
+	0b01100101, 0b11000100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// fmaxnmp Hd, Vn.2H
 	0b01011110, 0b00110000, 0b11001000, 0b00000000, 2, CmdR0, CmdR5,
@@ -1509,6 +2065,12 @@ var Commands = [...]byte{
 	0b00001110, 0b00110000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// fmaxnmv Sd, Vn.4S
 	0b00101110, 0b00110000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// fmaxnmv Hd, Pg, Zn.H  ·································································  (g < 8)
+	0b01100101, 0b01000100, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fmaxnmv Sd, Pg, Zn.S  ·································································  (g < 8)
+	0b01100101, 0b10000100, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fmaxnmv Dd, Pg, Zn.D  ·································································  (g < 8)
+	0b01100101, 0b11000100, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fmaxp Hd, Vn.2H
 	0b01011110, 0b00110000, 0b11111000, 0b00000000, 2, CmdR0, CmdR5,
@@ -1530,6 +2092,12 @@ var Commands = [...]byte{
 	0b00001110, 0b00110000, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// fmaxv Sd, Vn.4S
 	0b00101110, 0b00110000, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// fmaxv Hd, Pg, Zn.H  ···································································  (g < 8)
+	0b01100101, 0b01000110, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fmaxv Sd, Pg, Zn.S  ···································································  (g < 8)
+	0b01100101, 0b10000110, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fmaxv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b01100101, 0b11000110, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fmin Vd.8H, Vn.8H, Vm.8H
 	// fmin Vd.4H, Vn.4H, Vm.4H
@@ -1545,6 +2113,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b01011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fmin Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b01011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fmin Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b01100101, 0b01000111, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmin Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b01100101, 0b10000111, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmin Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b01100101, 0b11000111, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// fminnm Vd.8H, Vn.8H, Vm.8H
 	// fminnm Vd.4H, Vn.4H, Vm.4H
@@ -1560,6 +2134,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b01111000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fminnm Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b01111000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fminnm Zd.H, Pg/M, Zn.H, Zm.H  ················································  (g < 8, n == d)
+	0b01100101, 0b01000101, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fminnm Zd.S, Pg/M, Zn.S, Zm.S  ················································  (g < 8, n == d)
+	0b01100101, 0b10000101, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fminnm Zd.D, Pg/M, Zn.D, Zm.D  ················································  (g < 8, n == d)
+	0b01100101, 0b11000101, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// fminnmp Hd, Vn.2H
 	0b01011110, 0b10110000, 0b11001000, 0b00000000, 2, CmdR0, CmdR5,
@@ -1581,6 +2161,12 @@ var Commands = [...]byte{
 	0b00001110, 0b10110000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// fminnmv Sd, Vn.4S
 	0b00101110, 0b10110000, 0b11001000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// fminnmv Hd, Pg, Zn.H  ·································································  (g < 8)
+	0b01100101, 0b01000101, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fminnmv Sd, Pg, Zn.S  ·································································  (g < 8)
+	0b01100101, 0b10000101, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fminnmv Dd, Pg, Zn.D  ·································································  (g < 8)
+	0b01100101, 0b11000101, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fminp Hd, Vn.2H
 	0b01011110, 0b10110000, 0b11111000, 0b00000000, 2, CmdR0, CmdR5,
@@ -1602,6 +2188,12 @@ var Commands = [...]byte{
 	0b00001110, 0b10110000, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// fminv Sd, Vn.4S
 	0b00101110, 0b10110000, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// fminv Hd, Pg, Zn.H  ···································································  (g < 8)
+	0b01100101, 0b01000111, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fminv Sd, Pg, Zn.S  ···································································  (g < 8)
+	0b01100101, 0b10000111, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fminv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b01100101, 0b11000111, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fmla Hd, Hn, Vm.H[i]  ································································  (m < 16)
 	0b01011111, 0b00000000, 0b00010000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -1625,6 +2217,18 @@ var Commands = [...]byte{
 	0b00001110, 0b00100000, 0b11001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// fmla Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b01100000, 0b11001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// fmla Zd.H, Pg/M, Zn.H, Zm.H  ··························································  (g < 8)
+	0b01100101, 0b01100000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fmla Zd.S, Pg/M, Zn.S, Zm.S  ··························································  (g < 8)
+	0b01100101, 0b10100000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fmla Zd.D, Pg/M, Zn.D, Zm.D  ··························································  (g < 8)
+	0b01100101, 0b11100000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fmla Zd.H, Zn.H, Zm.H[i]  ·····························································  (m < 8)
+	0b01100100, 0b00100000, 0b00000000, 0b00000000, 7, CmdR0, CmdR5, CmdRLo8, 16, CmdChkUbits, 3, CmdUslice, 19, 2, 0, CmdUslice, 22, 1, 2, CmdAdv,
+	// fmla Zd.S, Zn.S, Zm.S[i]  ·····························································  (m < 8)
+	0b01100100, 0b10100000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo8, 16, CmdUbits, 19, 2,
+	// fmla Zd.D, Zn.D, Zm.D[i]  ····························································  (m < 16)
+	0b01100100, 0b11100000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUbits, 20, 1,
 
 	// fmlal Vd.2S, Vn.2H, Vm.H[i]  ·························································  (m < 16)
 	0b00001111, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -1666,6 +2270,18 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b11001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// fmls Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11100000, 0b11001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// fmls Zd.H, Pg/M, Zn.H, Zm.H  ··························································  (g < 8)
+	0b01100101, 0b01100000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fmls Zd.S, Pg/M, Zn.S, Zm.S  ··························································  (g < 8)
+	0b01100101, 0b10100000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fmls Zd.D, Pg/M, Zn.D, Zm.D  ··························································  (g < 8)
+	0b01100101, 0b11100000, 0b00100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fmls Zd.H, Zn.H, Zm.H[i]  ·····························································  (m < 8)
+	0b01100100, 0b00100000, 0b00000100, 0b00000000, 7, CmdR0, CmdR5, CmdRLo8, 16, CmdChkUbits, 3, CmdUslice, 19, 2, 0, CmdUslice, 22, 1, 2, CmdAdv,
+	// fmls Zd.S, Zn.S, Zm.S[i]  ·····························································  (m < 8)
+	0b01100100, 0b10100000, 0b00000100, 0b00000000, 4, CmdR0, CmdR5, CmdRLo8, 16, CmdUbits, 19, 2,
+	// fmls Zd.D, Zn.D, Zm.D[i]  ····························································  (m < 16)
+	0b01100100, 0b11100000, 0b00000100, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUbits, 20, 1,
 
 	// fmlsl Vd.2S, Vn.2H, Vm.H[i]  ·························································  (m < 16)
 	0b00001111, 0b10000000, 0b01000000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -1685,6 +2301,20 @@ var Commands = [...]byte{
 	// fmlsl2 Vd.4S, Vn.4H, Vm.4H
 	0b01101110, 0b10100000, 0b11001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// fmopa ZAd.S, Pg1/M, Pg2/M, Zn.S, Zm.S  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10000000, 0b10000000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// fmopa ZAd.D, Pg1/M, Pg2/M, Zn.D, Zm.D  ································  (d < 8, g1 < 8, g2 < 8)
+	0b10000000, 0b11000000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// fmopa ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10000001, 0b10100000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
+	// fmops ZAd.S, Pg1/M, Pg2/M, Zn.S, Zm.S  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10000000, 0b10000000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// fmops ZAd.D, Pg1/M, Pg2/M, Zn.D, Zm.D  ································  (d < 8, g1 < 8, g2 < 8)
+	0b10000000, 0b11000000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// fmops ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10000001, 0b10100000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
 	// fmov Vd.8H, #imm  ························································  (imm is split float)
 	// fmov Vd.4H, #imm  ························································  (imm is split float)
 	0b00001111, 0b00000000, 0b11111100, 0b00000000, 3, CmdR0, CmdSpecial, 5, SpecialImmFloatSplit, CmdRwidth30,
@@ -1719,12 +2349,42 @@ var Commands = [...]byte{
 	0b10011110, 0b01100110, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
 	// fmov Xd, Vn.D[1]
 	0b10011110, 0b10101110, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
+	// fmov Hd, #0.0
+	0b00011110, 0b11100111, 0b00000011, 0b11100000, 1, CmdR0,
+	// fmov Sd, #0.0
+	0b00011110, 0b00100111, 0b00000011, 0b11100000, 1, CmdR0,
+	// fmov Dd, #0.0
+	0b10011110, 0b01100111, 0b00000011, 0b11100000, 1, CmdR0,
 	// fmov Hd, #imm  ·································································  (imm is float)
 	0b00011110, 0b11100000, 0b00010000, 0b00000000, 2, CmdR0, CmdSpecial, 13, SpecialImmFloat,
 	// fmov Sd, #imm  ·································································  (imm is float)
 	0b00011110, 0b00100000, 0b00010000, 0b00000000, 2, CmdR0, CmdSpecial, 13, SpecialImmFloat,
 	// fmov Dd, #imm  ·································································  (imm is float)
 	0b00011110, 0b01100000, 0b00010000, 0b00000000, 2, CmdR0, CmdSpecial, 13, SpecialImmFloat,
+	// fmov Zd.H, Pg/M, #0.0
+	0b00000101, 0b01010000, 0b01000000, 0b00000000, 2, CmdR0, CmdR16,
+	// fmov Zd.S, Pg/M, #0.0
+	0b00000101, 0b10010000, 0b01000000, 0b00000000, 2, CmdR0, CmdR16,
+	// fmov Zd.D, Pg/M, #0.0
+	0b00000101, 0b11010000, 0b01000000, 0b00000000, 2, CmdR0, CmdR16,
+	// fmov Zd.H, #0.0
+	0b00100101, 0b01111000, 0b11000000, 0b00000000, 1, CmdR0,
+	// fmov Zd.S, #0.0
+	0b00100101, 0b10111000, 0b11000000, 0b00000000, 1, CmdR0,
+	// fmov Zd.D, #0.0
+	0b00100101, 0b11111000, 0b11000000, 0b00000000, 1, CmdR0,
+	// fmov Zd.H, Pg/M, #imm  ·························································  (imm is float)
+	0b00000101, 0b01010000, 0b11000000, 0b00000000, 3, CmdR0, CmdR16, CmdSpecial, 5, SpecialImmFloat,
+	// fmov Zd.S, Pg/M, #imm  ·························································  (imm is float)
+	0b00000101, 0b10010000, 0b11000000, 0b00000000, 3, CmdR0, CmdR16, CmdSpecial, 5, SpecialImmFloat,
+	// fmov Zd.D, Pg/M, #imm  ·························································  (imm is float)
+	0b00000101, 0b11010000, 0b11000000, 0b00000000, 3, CmdR0, CmdR16, CmdSpecial, 5, SpecialImmFloat,
+	// fmov Zd.H, #imm  ·······························································  (imm is float)
+	0b00100101, 0b01111001, 0b11000000, 0b00000000, 2, CmdR0, CmdSpecial, 5, SpecialImmFloat,
+	// fmov Zd.S, #imm  ·······························································  (imm is float)
+	0b00100101, 0b10111001, 0b11000000, 0b00000000, 2, CmdR0, CmdSpecial, 5, SpecialImmFloat,
+	// fmov Zd.D, #imm  ·······························································  (imm is float)
+	0b00100101, 0b11111001, 0b11000000, 0b00000000, 2, CmdR0, CmdSpecial, 5, SpecialImmFloat,
 
 	// fmsub Hd, Hn, Hm, Ha
 	0b00011111, 0b11000000, 0b10000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
@@ -1761,6 +2421,24 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fmul Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fmul Zd.H, Zn.H, Zm.H
+	0b01100101, 0b01000000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fmul Zd.S, Zn.S, Zm.S
+	0b01100101, 0b10000000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fmul Zd.D, Zn.D, Zm.D
+	0b01100101, 0b11000000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fmul Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b01100101, 0b01000010, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmul Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b01100101, 0b10000010, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmul Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b01100101, 0b11000010, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmul Zd.H, Zn.H, Zm.H[i]  ·····························································  (m < 8)
+	0b01100100, 0b00100000, 0b00100000, 0b00000000, 7, CmdR0, CmdR5, CmdRLo8, 16, CmdChkUbits, 3, CmdUslice, 19, 2, 0, CmdUslice, 22, 1, 2, CmdAdv,
+	// fmul Zd.S, Zn.S, Zm.S[i]  ·····························································  (m < 8)
+	0b01100100, 0b10100000, 0b00100000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo8, 16, CmdUbits, 19, 2,
+	// fmul Zd.D, Zn.D, Zm.D[i]  ····························································  (m < 16)
+	0b01100100, 0b11100000, 0b00100000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUbits, 20, 1,
 
 	// fmulx Hd, Hn, Vm.H[i]  ·······························································  (m < 16)
 	0b01111111, 0b00000000, 0b10010000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -1790,6 +2468,12 @@ var Commands = [...]byte{
 	0b00001110, 0b00100000, 0b11011100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// fmulx Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b01100000, 0b11011100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// fmulx Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	0b01100101, 0b01001010, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmulx Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	0b01100101, 0b10001010, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fmulx Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	0b01100101, 0b11001010, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// fneg Vd.8H, Vn.8H
 	// fneg Vd.4H, Vn.4H
@@ -1805,6 +2489,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100001, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
 	// fneg Dd, Dn
 	0b00011110, 0b01100001, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// fneg Zd.H, Pg/M, Zn.H  ································································  (g < 8)
+	0b00000100, 0b01011101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fneg Zd.S, Pg/M, Zn.S  ································································  (g < 8)
+	0b00000100, 0b10011101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fneg Zd.D, Pg/M, Zn.D  ································································  (g < 8)
+	0b00000100, 0b11011101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fnmadd Hd, Hn, Hm, Ha
 	0b00011111, 0b11100000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
@@ -1813,6 +2503,20 @@ var Commands = [...]byte{
 	// fnmadd Dd, Dn, Dm, Da
 	0b00011111, 0b01100000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
 
+	// fnmla Zd.H, Pg/M, Zn.H, Zm.H  ·························································  (g < 8)
+	0b01100101, 0b01100000, 0b01000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fnmla Zd.S, Pg/M, Zn.S, Zm.S  ·························································  (g < 8)
+	0b01100101, 0b10100000, 0b01000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fnmla Zd.D, Pg/M, Zn.D, Zm.D  ·························································  (g < 8)
+	0b01100101, 0b11100000, 0b01000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+
+	// fnmls Zd.H, Pg/M, Zn.H, Zm.H  ·························································  (g < 8)
+	0b01100101, 0b01100000, 0b01100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fnmls Zd.S, Pg/M, Zn.S, Zm.S  ·························································  (g < 8)
+	0b01100101, 0b10100000, 0b01100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+	// fnmls Zd.D, Pg/M, Zn.D, Zm.D  ·························································  (g < 8)
+	0b01100101, 0b11100000, 0b01100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdR16,
+
 	// fnmsub Hd, Hn, Hm, Ha
 	0b00011111, 0b11100000, 0b10000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
 	// fnmsub Sd, Sn, Sm, Sa
@@ -1856,6 +2560,12 @@ var Commands = [...]byte{
 	0b00001110, 0b00100000, 0b11111100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// frecps Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b01100000, 0b11111100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// frecps Zd.H, Zn.H, Zm.H
+	0b01100101, 0b01000000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// frecps Zd.S, Zn.S, Zm.S
+	0b01100101, 0b10000000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// frecps Zd.D, Zn.D, Zm.D
+	0b01100101, 0b11000000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// frecpx Hd, Hn
 	0b01011110, 0b11111001, 0b11111000, 0b00000000, 2, CmdR0, CmdR5,
@@ -1863,6 +2573,52 @@ var Commands = [...]byte{
 	0b01011110, 0b10100001, 0b11111000, 0b00000000, 2, CmdR0, CmdR5,
 	// frecpx Dd, Dn
 	0b01011110, 0b11100001, 0b11111000, 0b00000000, 2, CmdR0, CmdR5,
+	// frecpx Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01001100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frecpx Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10001100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frecpx Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11001100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+
+	// frint32x Sd, Sn
+	0b00011110, 0b00101000, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint32x Dd, Dn
+	0b00011110, 0b01101000, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint32x Vd.4S, Vn.4S
+	// frint32x Vd.2S, Vn.2S
+	0b00101110, 0b00100001, 0b11101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// frint32x Vd.2D, Vn.2D
+	0b01101110, 0b01100001, 0b11101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+
+	// frint32z Sd, Sn
+	0b00011110, 0b00101000, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint32z Dd, Dn
+	0b00011110, 0b01101000, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint32z Vd.4S, Vn.4S
+	// frint32z Vd.2S, Vn.2S
+	0b00001110, 0b00100001, 0b11101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// frint32z Vd.2D, Vn.2D
+	0b01001110, 0b01100001, 0b11101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+
+	// frint64x Sd, Sn
+	0b00011110, 0b00101001, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint64x Dd, Dn
+	0b00011110, 0b01101001, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint64x Vd.4S, Vn.4S
+	// frint64x Vd.2S, Vn.2S
+	0b00101110, 0b00100001, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// frint64x Vd.2D, Vn.2D
+	0b01101110, 0b01100001, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+
+	// frint64z Sd, Sn
+	0b00011110, 0b00101001, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint64z Dd, Dn
+	0b00011110, 0b01101001, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frint64z Vd.4S, Vn.4S
+	// frint64z Vd.2S, Vn.2S
+	0b00001110, 0b00100001, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// frint64z Vd.2D, Vn.2D
+	0b01001110, 0b01100001, 0b11111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 
 	// frinta Vd.8H, Vn.8H
 	// frinta Vd.4H, Vn.4H
@@ -1878,6 +2634,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100110, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
 	// frinta Dd, Dn
 	0b00011110, 0b01100110, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frinta Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01000100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frinta Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10000100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frinta Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11000100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// frinti Vd.8H, Vn.8H
 	// frinti Vd.4H, Vn.4H
@@ -1893,6 +2655,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100111, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
 	// frinti Dd, Dn
 	0b00011110, 0b01100111, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frinti Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01000111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frinti Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10000111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frinti Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11000111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// frintm Vd.8H, Vn.8H
 	// frintm Vd.4H, Vn.4H
@@ -1908,6 +2676,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100101, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
 	// frintm Dd, Dn
 	0b00011110, 0b01100101, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frintm Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01000010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintm Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10000010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintm Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11000010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// frintn Vd.8H, Vn.8H
 	// frintn Vd.4H, Vn.4H
@@ -1923,6 +2697,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100100, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
 	// frintn Dd, Dn
 	0b00011110, 0b01100100, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frintn Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01000000, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintn Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10000000, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintn Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11000000, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// frintp Vd.8H, Vn.8H
 	// frintp Vd.4H, Vn.4H
@@ -1938,6 +2718,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100100, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
 	// frintp Dd, Dn
 	0b00011110, 0b01100100, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frintp Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01000001, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintp Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10000001, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintp Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11000001, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// frintx Vd.8H, Vn.8H
 	// frintx Vd.4H, Vn.4H
@@ -1953,6 +2739,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100111, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
 	// frintx Dd, Dn
 	0b00011110, 0b01100111, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frintx Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01000110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintx Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10000110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintx Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11000110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// frintz Vd.8H, Vn.8H
 	// frintz Vd.4H, Vn.4H
@@ -1968,6 +2760,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100101, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
 	// frintz Dd, Dn
 	0b00011110, 0b01100101, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// frintz Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	0b01100101, 0b01000011, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintz Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	0b01100101, 0b10000011, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// frintz Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	0b01100101, 0b11000011, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// frsqrte Hd, Hn
 	0b01111110, 0b11111001, 0b11011000, 0b00000000, 2, CmdR0, CmdR5,
@@ -1998,6 +2796,19 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b11111100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// frsqrts Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11100000, 0b11111100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// frsqrts Zd.H, Zn.H, Zm.H
+	0b01100101, 0b01000000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// frsqrts Zd.S, Zn.S, Zm.S
+	0b01100101, 0b10000000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// frsqrts Zd.D, Zn.D, Zm.D
+	0b01100101, 0b11000000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// fscale Zd.H, Pg/M, Zn.H, Zm.H  ················································  (g < 8, n == d)
+	0b01100101, 0b01001001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fscale Zd.S, Pg/M, Zn.S, Zm.S  ················································  (g < 8, n == d)
+	0b01100101, 0b10001001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fscale Zd.D, Pg/M, Zn.D, Zm.D  ················································  (g < 8, n == d)
+	0b01100101, 0b11001001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// fsqrt Vd.8H, Vn.8H
 	// fsqrt Vd.4H, Vn.4H
@@ -2013,6 +2824,12 @@ var Commands = [...]byte{
 	0b00011110, 0b00100001, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
 	// fsqrt Dd, Dn
 	0b00011110, 0b01100001, 0b11000000, 0b00000000, 2, CmdR0, CmdR5,
+	// fsqrt Zd.H, Pg/M, Zn.H  ·······························································  (g < 8)
+	0b01100101, 0b01001101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fsqrt Zd.S, Pg/M, Zn.S  ·······························································  (g < 8)
+	0b01100101, 0b10001101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// fsqrt Zd.D, Pg/M, Zn.D  ·······························································  (g < 8)
+	0b01100101, 0b11001101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// fsub Vd.8H, Vn.8H, Vm.8H
 	// fsub Vd.4H, Vn.4H, Vm.4H
@@ -2028,6 +2845,28 @@ var Commands = [...]byte{
 	0b00011110, 0b00100000, 0b00111000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// fsub Dd, Dn, Dm
 	0b00011110, 0b01100000, 0b00111000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fsub Zd.H, Zn.H, Zm.H
+	0b01100101, 0b01000000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fsub Zd.S, Zn.S, Zm.S
+	0b01100101, 0b10000000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fsub Zd.D, Zn.D, Zm.D
+	0b01100101, 0b11000000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// fsub Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b01100101, 0b01000001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fsub Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b01100101, 0b10000001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fsub Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b01100101, 0b11000001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+
+	// fsubr Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	0b01100101, 0b01000011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fsubr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	0b01100101, 0b10000011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// fsubr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	0b01100101, 0b11000011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+
+	// gmi Xd, Xn|SP, Xm
+	0b10011010, 0b11000000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// hint #imm  ···································································  (0 <= imm < 128)
 	0b11010101, 0b00000011, 0b00100000, 0b00011111, 1, CmdUbits, 5, 7,
@@ -2043,6 +2882,43 @@ var Commands = [...]byte{
 	// ic <symbol>
 	0b11010101, 0b00001000, 0b01110000, 0b00011111, 1, CmdLitList, 5, SymICOPS,
 
+	// incb Xd
+	0b00000100, 0b00110000, 0b11100011, 0b11100000, 1, CmdR0,
+	// incb Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b00110000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// incd Xd
+	0b00000100, 0b11110000, 0b11100011, 0b11100000, 1, CmdR0,
+	// incd Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b11110000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// inch Xd
+	0b00000100, 0b01110000, 0b11100011, 0b11100000, 1, CmdR0,
+	// inch Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b01110000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// incw Xd
+	0b00000100, 0b10110000, 0b11100011, 0b11100000, 1, CmdR0,
+	// incw Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	0b00000100, 0b10110000, 0b11100000, 0b00000000, 3, CmdR0, CmdLitList, 5, SymSVEPATTERNS, CmdUrange, 16, 1, 16,
+
+	// index Zd.B, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	0b00000100, 0b00100000, 0b01000000, 0b00000000, 3, CmdR0, CmdSfield, 5, 5, CmdSfield, 16, 5,
+	// index Zd.H, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	0b00000100, 0b01100000, 0b01000000, 0b00000000, 3, CmdR0, CmdSfield, 5, 5, CmdSfield, 16, 5,
+	// index Zd.S, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	0b00000100, 0b10100000, 0b01000000, 0b00000000, 3, CmdR0, CmdSfield, 5, 5, CmdSfield, 16, 5,
+	// index Zd.D, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	0b00000100, 0b11100000, 0b01000000, 0b00000000, 3, CmdR0, CmdSfield, 5, 5, CmdSfield, 16, 5,
+	// index Zd.B, Wn, Wm
+	0b00000100, 0b00100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// index Zd.H, Wn, Wm
+	0b00000100, 0b01100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// index Zd.S, Wn, Wm
+	0b00000100, 0b10100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// index Zd.D, Xn, Xm
+	0b00000100, 0b11100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
 	// ins Vd.B[i], Vn.B[i]
 	0b01101110, 0b00000001, 0b00000100, 0b00000000, 4, CmdR0, CmdUbits, 17, 4, CmdR5, CmdUbits, 11, 4,
 	// ins Vd.H[i], Vn.H[i]
@@ -2060,6 +2936,9 @@ var Commands = [...]byte{
 	// ins Vd.D[i], Xn
 	0b01001110, 0b00001000, 0b00011100, 0b00000000, 3, CmdR0, CmdUbits, 20, 1, CmdR5,
 
+	// irg Xd|SP, Xn|SP {, Xm }
+	0b10011010, 0b11000000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
 	// isb SY
 	0b11010101, 0b00000011, 0b00111111, 0b11011111, 0,
 	// isb #imm  ·····································································  (0 <= imm < 16)
@@ -2252,6 +3131,82 @@ var Commands = [...]byte{
 	// ld1 {Vd.D * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
 	0b00001101, 0b11000000, 0b10000100, 0b00000000, 4, CmdR0, CmdUfields30, 1, CmdR5, CmdRNz16,
 
+	// ld1b ZAdH|V.B[W12-W15, #imm], Pg/Z, [Xn|SP, Xm]  ·······················  (0 <= imm < 16, g < 8)
+	0b11100000, 0b00000000, 0b00000000, 0b00000000, 7, CmdAdv, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 4, CmdRLo8, 10, CmdR5, CmdR16,
+	// ld1b ZAdH|V.B[W12-W15, #imm], Pg/Z, [Xn|SP]  ···························  (0 <= imm < 16, g < 8)
+	0b11100000, 0b00011111, 0b00000000, 0b00000000, 6, CmdAdv, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 4, CmdRLo8, 10, CmdR5,
+	// ld1b {Zd.B * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100100, 0b00000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1b {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100100, 0b00100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1b {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100100, 0b01000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1b {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100100, 0b01100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1b {Zd.B * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	0b10100100, 0b00000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1b {Zd.H * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	0b10100100, 0b00100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1b {Zd.S * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	0b10100100, 0b01000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1b {Zd.D * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	0b10100100, 0b01100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1b {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	0b11000100, 0b01000000, 0b11000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// ld1b {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW]  ······································  (g < 8)
+	0b10000100, 0b00000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 22, CmdAdv,
+	// ld1b {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }]  ·····················  (g < 8, 0 <= imm < 32, imm >> 0)
+	0b10000100, 0b00100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 0,
+	// ld1b {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }]  ·····················  (g < 8, 0 <= imm < 32, imm >> 0)
+	0b11000100, 0b00100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 0,
+
+	// ld1d ZAdH|V.D[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #3]  ·········  (d < 8, 0 <= imm < 2, g < 8)
+	0b11100000, 0b11000000, 0b00000000, 0b00000000, 7, CmdRbits, 1, 3, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 1, CmdRLo8, 10, CmdR5, CmdR16,
+	// ld1d ZAdH|V.D[W12-W15, #imm], Pg/Z, [Xn|SP]  ·····················  (d < 8, 0 <= imm < 2, g < 8)
+	0b11100000, 0b11011111, 0b00000000, 0b00000000, 6, CmdRbits, 1, 3, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 1, CmdRLo8, 10, CmdR5,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100101, 0b11100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #3]  ··································  (g < 8, m != 31)
+	0b10100101, 0b11100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	0b11000101, 0b11000000, 0b11000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #3]  ·········································  (g < 8)
+	0b11000101, 0b11100000, 0b11000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+
+	// ld1h ZAdH|V.H[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #1]  ·········  (d < 2, 0 <= imm < 8, g < 8)
+	0b11100000, 0b01000000, 0b00000000, 0b00000000, 7, CmdRbits, 3, 1, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 3, CmdRLo8, 10, CmdR5, CmdR16,
+	// ld1h ZAdH|V.H[W12-W15, #imm], Pg/Z, [Xn|SP]  ·····················  (d < 2, 0 <= imm < 8, g < 8)
+	0b11100000, 0b01011111, 0b00000000, 0b00000000, 6, CmdRbits, 3, 1, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 3, CmdRLo8, 10, CmdR5,
+	// ld1h {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100100, 0b10100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100100, 0b11000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100100, 0b11100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1h {Zd.H * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ··································  (g < 8, m != 31)
+	0b10100100, 0b10100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ··································  (g < 8, m != 31)
+	0b10100100, 0b11000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ··································  (g < 8, m != 31)
+	0b10100100, 0b11100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	0b11000100, 0b11000000, 0b11000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #1]  ·········································  (g < 8)
+	0b11000100, 0b11100000, 0b11000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW]  ······································  (g < 8)
+	0b10000100, 0b10000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 22, CmdAdv,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW #1]  ···································  (g < 8)
+	0b10000100, 0b10100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 22, CmdAdv,
+	// ld1h {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }]  ·····················  (g < 8, 0 <= imm < 64, imm >> 1)
+	0b10000100, 0b10100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 1,
+	// ld1h {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }]  ·····················  (g < 8, 0 <= imm < 64, imm >> 1)
+	0b11000100, 0b10100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 1,
+
+	// ld1q ZAdH|V.Q[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #4]  ············  (d < 16, imm == 0, g < 8)
+	0b11100001, 0b11000000, 0b00000000, 0b00000000, 7, CmdRbits, 0, 4, CmdUbits, 15, 1, CmdRW12, 13, CmdUrange, 0, 0, 0, CmdRLo8, 10, CmdR5, CmdR16,
+	// ld1q ZAdH|V.Q[W12-W15, #imm], Pg/Z, [Xn|SP]  ························  (d < 16, imm == 0, g < 8)
+	0b11100001, 0b11011111, 0b00000000, 0b00000000, 6, CmdRbits, 0, 4, CmdUbits, 15, 1, CmdRW12, 13, CmdUrange, 0, 0, 0, CmdRLo8, 10, CmdR5,
+
 	// ld1r {Vd.16B * 1}, [Xn|SP]
 	// ld1r {Vd.8B * 1}, [Xn|SP]
 	0b00001101, 0b01000000, 0b11000000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
@@ -2289,6 +3244,43 @@ var Commands = [...]byte{
 	// ld1r {Vd.1D * 1}, [Xn|SP], Xm  ······················································  (m != 31)
 	0b00001101, 0b11000000, 0b11001100, 0b00000000, 4, CmdR0, CmdR5, CmdRNz16, CmdRwidth30,
 
+	// ld1rb {Zd.B * 1}, Pg/Z, [Xn|SP {, #imm }]  ···················  (g < 8, 0 <= imm < 64, imm >> 0)
+	0b10000100, 0b01000000, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 6, 0,
+
+	// ld1rd {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm }]  ··················  (g < 8, 0 <= imm < 512, imm >> 3)
+	0b10000101, 0b11000000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 6, 3,
+
+	// ld1rh {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm }]  ··················  (g < 8, 0 <= imm < 128, imm >> 1)
+	0b10000100, 0b11000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 6, 1,
+
+	// ld1rw {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm }]  ··················  (g < 8, 0 <= imm < 256, imm >> 2)
+	0b10000101, 0b01000000, 0b11000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 6, 2,
+
+	// ld1w ZAdH|V.S[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #2]  ·········  (d < 4, 0 <= imm < 4, g < 8)
+	0b11100000, 0b10000000, 0b00000000, 0b00000000, 7, CmdRbits, 2, 2, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 2, CmdRLo8, 10, CmdR5, CmdR16,
+	// ld1w ZAdH|V.S[W12-W15, #imm], Pg/Z, [Xn|SP]  ·····················  (d < 4, 0 <= imm < 4, g < 8)
+	0b11100000, 0b10011111, 0b00000000, 0b00000000, 6, CmdRbits, 2, 2, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 2, CmdRLo8, 10, CmdR5,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100101, 0b01000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	0b10100101, 0b01100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #2]  ··································  (g < 8, m != 31)
+	0b10100101, 0b01000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #2]  ··································  (g < 8, m != 31)
+	0b10100101, 0b01100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	0b11000101, 0b01000000, 0b11000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #2]  ·········································  (g < 8)
+	0b11000101, 0b01100000, 0b11000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW]  ······································  (g < 8)
+	0b10000101, 0b00000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 22, CmdAdv,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW #2]  ···································  (g < 8)
+	0b10000101, 0b00100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 22, CmdAdv,
+	// ld1w {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }]  ····················  (g < 8, 0 <= imm < 128, imm >> 2)
+	0b10000101, 0b00100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 2,
+	// ld1w {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }]  ····················  (g < 8, 0 <= imm < 128, imm >> 2)
+	0b11000101, 0b00100000, 0b11000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 2,
+
 	// ld2 {Vd.16B * 2}, [Xn|SP]
 	// ld2 {Vd.8B * 2}, [Xn|SP]
 	0b00001100, 0b01000000, 0b10000000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
@@ -2583,6 +3575,9 @@ var Commands = [...]byte{
 	// ld4r {Vd.1D * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	0b00001101, 0b11100000, 0b11101100, 0b00000000, 4, CmdR0, CmdR5, CmdRNz16, CmdRwidth30,
 
+	// ld64b Xn, [Xm|SP]  ································································  (n is even)
+	0b11111000, 0b00111111, 0b11010000, 0b00000000, 2, CmdREven, 0, CmdR5,
+
 	// ldadd Wd, Wn, [Xm|SP]
 	0b10111000, 0b00100000, 0b00000000, 0b00000000, 3, CmdR16, CmdR0, CmdR5,
 	// ldadd Xd, Xn, [Xm|SP]
@@ -2777,6 +3772,21 @@ var Commands = [...]byte{
 	// ldeorlh Wd, Wn, [Xm|SP]
 	0b01111000, 0b01100000, 0b00100000, 0b00000000, 3, CmdR16, CmdR0, CmdR5,
 
+	// ldff1b {Zd.B * 1}, Pg/Z, [Xn|SP, Xm]  ·················································  (g < 8)
+	0b10100100, 0b00000000, 0b01100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+
+	// ldff1d {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #3]  ·········································  (g < 8)
+	0b10100101, 0b11100000, 0b01100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+
+	// ldff1h {Zd.H * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ·········································  (g < 8)
+	0b10100100, 0b10100000, 0b01100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+
+	// ldff1w {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #2]  ·········································  (g < 8)
+	0b10100101, 0b01000000, 0b01100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+
+	// ldg Xd, [Xn|SP {, #imm }]  ····································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b01100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+
 	// ldlar Wd, [Xn|SP]
 	0b10001000, 0b11011111, 0b01111100, 0b00000000, 2, CmdR0, CmdR5,
 	// ldlar Xd, [Xn|SP]
@@ -2903,6 +3913,12 @@ var Commands = [...]byte{
 	0b10111000, 0b01100000, 0b00001000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdExtendsX, CmdUAlt2, 12, 2,
 	// ldr Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 3])
 	0b11111000, 0b01100000, 0b00001000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdExtendsX, CmdUAlt2, 12, 3,
+	// ldr ZA[W12-W15, #imm1], [Xn|SP {, #imm2, MUL VL }]  ············  (0 <= imm1 < 16, imm2 == imm1)
+	0b11100001, 0b00000000, 0b00000000, 0b00000000, 6, CmdAdv, CmdAdv, CmdRW12, 13, CmdUbits, 0, 4, CmdR5, CmdUsame, 2,
+	// ldr Zd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	0b10000101, 0b10000000, 0b01000000, 0b00000000, 6, CmdR0, CmdR5, CmdChkSbits, 9, CmdSslice, 10, 3, 0, CmdSslice, 16, 6, 3, CmdAdv,
+	// ldr Pd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	0b10000101, 0b10000000, 0b00000000, 0b00000000, 6, CmdR0, CmdR5, CmdChkSbits, 9, CmdSslice, 10, 3, 0, CmdSslice, 16, 6, 3, CmdAdv,
 
 	// ldraa Xd, [Xn|SP {, #imm }]  ··································  (-4096 <= imm < 4096, imm >> 3)
 	0b11111000, 0b00100000, 0b00000100, 0b00000000, 6, CmdR0, CmdR5, CmdChkSscaled, CmdSslice, 12, 9, 3, CmdSslice, 22, 1, 12, CmdAdv,
@@ -3279,6 +4295,30 @@ var Commands = [...]byte{
 	0b01010011, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdUnegmod, 16, 5, CmdBack, CmdUsub, 10, 5, 31,
 	// lsl Xd, Xn, #imm  ·····························································  (0 <= imm < 64)
 	0b11010011, 0b01000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdUnegmod, 16, 6, CmdBack, CmdUsub, 10, 6, 63,
+	// lsl Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00010011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsl Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01010011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsl Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10010011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsl Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11010011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsl Zd.B, Zn.B, #imm  ··························································  (0 <= imm < 8)
+	0b00000100, 0b00101000, 0b10011100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 16, 3,
+	// lsl Zd.H, Zn.H, #imm  ·························································  (0 <= imm < 16)
+	0b00000100, 0b00110000, 0b10011100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 16, 4,
+	// lsl Zd.S, Zn.S, #imm  ·························································  (0 <= imm < 32)
+	0b00000100, 0b01100000, 0b10011100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 16, 5,
+	// lsl Zd.D, Zn.D, #imm  ·························································  (0 <= imm < 64)
+	0b00000100, 0b10100000, 0b10011100, 0b00000000, 6, CmdR0, CmdR5, CmdChkUbits, 6, CmdUslice, 16, 5, 0, CmdUslice, 22, 1, 5, CmdAdv,
+	// lsl Zd.B, Pg/M, Zn.B, #imm  ·····································  (g < 8, n == d, 0 <= imm < 8)
+	0b00000100, 0b00000011, 0b10000001, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUbits, 5, 3,
+	// lsl Zd.H, Pg/M, Zn.H, #imm  ····································  (g < 8, n == d, 0 <= imm < 16)
+	0b00000100, 0b00000011, 0b10000010, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUbits, 5, 4,
+	// lsl Zd.S, Pg/M, Zn.S, #imm  ····································  (g < 8, n == d, 0 <= imm < 32)
+	0b00000100, 0b01000011, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUbits, 5, 5,
+	// lsl Zd.D, Pg/M, Zn.D, #imm  ····································  (g < 8, n == d, 0 <= imm < 64)
+	0b00000100, 0b10000011, 0b10000000, 0b00000000, 7, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdChkUbits, 6, CmdUslice, 5, 5, 0, CmdUslice, 22, 1, 5, CmdAdv,
 
 	// lslv Wd, Wn, Wm
 	0b00011010, 0b11000000, 0b00100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -3293,6 +4333,30 @@ var Commands = [...]byte{
 	0b01010011, 0b00000000, 0b01111100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 16, 5,
 	// lsr Xd, Xn, #imm  ·····························································  (0 <= imm < 64)
 	0b11010011, 0b01000000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 16, 6,
+	// lsr Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00010001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsr Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01010001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsr Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10010001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsr Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11010001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// lsr Zd.B, Zn.B, #imm  ··························································  (0 < imm <= 8)
+	0b00000100, 0b00101000, 0b10010100, 0b00000000, 3, CmdR0, CmdR5, CmdUsub, 16, 3, 8,
+	// lsr Zd.H, Zn.H, #imm  ·························································  (0 < imm <= 16)
+	0b00000100, 0b00110000, 0b10010100, 0b00000000, 3, CmdR0, CmdR5, CmdUsub, 16, 4, 16,
+	// lsr Zd.S, Zn.S, #imm  ·························································  (0 < imm <= 32)
+	0b00000100, 0b01100000, 0b10010100, 0b00000000, 3, CmdR0, CmdR5, CmdUsub, 16, 5, 32,
+	// lsr Zd.D, Zn.D, #imm  ·························································  (0 < imm <= 64)
+	0b00000100, 0b10100000, 0b10010100, 0b00000000, 3, CmdR0, CmdR5, CmdSpecial, 16, SpecialImmTszRight64,
+	// lsr Zd.B, Pg/M, Zn.B, #imm  ·····································  (g < 8, n == d, 0 < imm <= 8)
+	0b00000100, 0b00000001, 0b10000001, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUsub, 5, 3, 8,
+	// lsr Zd.H, Pg/M, Zn.H, #imm  ····································  (g < 8, n == d, 0 < imm <= 16)
+	0b00000100, 0b00000001, 0b10000010, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUsub, 5, 4, 16,
+	// lsr Zd.S, Pg/M, Zn.S, #imm  ····································  (g < 8, n == d, 0 < imm <= 32)
+	0b00000100, 0b01000001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdUsub, 5, 5, 32,
+	// lsr Zd.D, Pg/M, Zn.D, #imm  ····································  (g < 8, n == d, 0 < imm <= 64)
+	0b00000100, 0b10000001, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdSpecial, 5, SpecialImmTszRight64,
 
 	// lsrv Wd, Wn, Wm
 	0b00011010, 0b11000000, 0b00100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -3393,6 +4457,27 @@ var Commands = [...]byte{
 	// mov Xd, Vn.D[i]
 	0b01001110, 0b00001000, 0b00111100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 20, 1,
 
+	// mova ZAdH|V.B[W12-W15, #imm], Pg/M, Zn.B  ······························  (0 <= imm < 16, g < 8)
+	0b11000000, 0b00000000, 0b00000000, 0b00000000, 6, CmdAdv, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 4, CmdRLo8, 10, CmdR5,
+	// mova ZAdH|V.H[W12-W15, #imm], Pg/M, Zn.H  ························  (d < 2, 0 <= imm < 8, g < 8)
+	0b11000000, 0b01000000, 0b00000000, 0b00000000, 6, CmdRbits, 3, 1, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 3, CmdRLo8, 10, CmdR5,
+	// mova ZAdH|V.S[W12-W15, #imm], Pg/M, Zn.S  ························  (d < 4, 0 <= imm < 4, g < 8)
+	0b11000000, 0b10000000, 0b00000000, 0b00000000, 6, CmdRbits, 2, 2, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 2, CmdRLo8, 10, CmdR5,
+	// mova ZAdH|V.D[W12-W15, #imm], Pg/M, Zn.D  ························  (d < 8, 0 <= imm < 2, g < 8)
+	0b11000000, 0b11000000, 0b00000000, 0b00000000, 6, CmdRbits, 1, 3, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 1, CmdRLo8, 10, CmdR5,
+	// mova ZAdH|V.Q[W12-W15, #imm], Pg/M, Zn.Q  ···························  (d < 16, imm == 0, g < 8)
+	0b11000000, 0b11000001, 0b00000000, 0b00000000, 6, CmdRbits, 0, 4, CmdUbits, 15, 1, CmdRW12, 13, CmdUrange, 0, 0, 0, CmdRLo8, 10, CmdR5,
+	// mova Zd.B, Pg/M, ZAnH|V.B[W12-W15, #imm]  ······························  (g < 8, 0 <= imm < 16)
+	0b11000000, 0b00000010, 0b00000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdAdv, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 5, 4,
+	// mova Zd.H, Pg/M, ZAnH|V.H[W12-W15, #imm]  ························  (g < 8, n < 2, 0 <= imm < 8)
+	0b11000000, 0b01000010, 0b00000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdRbits, 8, 1, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 5, 3,
+	// mova Zd.S, Pg/M, ZAnH|V.S[W12-W15, #imm]  ························  (g < 8, n < 4, 0 <= imm < 4)
+	0b11000000, 0b10000010, 0b00000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdRbits, 7, 2, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 5, 2,
+	// mova Zd.D, Pg/M, ZAnH|V.D[W12-W15, #imm]  ························  (g < 8, n < 8, 0 <= imm < 2)
+	0b11000000, 0b11000010, 0b00000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdRbits, 6, 3, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 5, 1,
+	// mova Zd.Q, Pg/M, ZAnH|V.Q[W12-W15, #imm]  ···························  (g < 8, n < 16, imm == 0)
+	0b11000000, 0b11000011, 0b00000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdRbits, 5, 4, CmdUbits, 15, 1, CmdRW12, 13, CmdUrange, 5, 0, 0,
+
 	// movi Vd.16B, #imm1 {, LSL #imm2 }  ·······························  (0 <= imm1 < 256, imm2 == 0)
 	// movi Vd.8B, #imm1 {, LSL #imm2 }  ································  (0 <= imm1 < 256, imm2 == 0)
 	0b00001111, 0b00000000, 0b11100100, 0b00000000, 8, CmdR0, CmdChkUbits, 8, CmdUslice, 5, 5, 0, CmdUslice, 16, 3, 5, CmdAdv, CmdChkUbits, 0, CmdAdv, CmdRwidth30,
@@ -3420,6 +4505,25 @@ var Commands = [...]byte{
 	// movn Xd, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])
 	0b10010010, 0b10000000, 0b00000000, 0b00000000, 3, CmdR0, CmdUbits, 5, 16, CmdUAlt4, 21, 1,
 
+	// movprfx Zd, Zn
+	0b00000100, 0b00100000, 0b10111100, 0b00000000, 2, CmdR0, CmdR5,
+	// movprfx Zd.B, Pg/Z, Zn.B  ·····························································  (g < 8)
+	0b00000100, 0b00010000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// movprfx Zd.B, Pg/M, Zn.B  ·····························································  (g < 8)
+	0b00000100, 0b00010001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// movprfx Zd.H, Pg/Z, Zn.H  ·····························································  (g < 8)
+	0b00000100, 0b01010000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// movprfx Zd.H, Pg/M, Zn.H  ·····························································  (g < 8)
+	0b00000100, 0b01010001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// movprfx Zd.S, Pg/Z, Zn.S  ·····························································  (g < 8)
+	0b00000100, 0b10010000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// movprfx Zd.S, Pg/M, Zn.S  ·····························································  (g < 8)
+	0b00000100, 0b10010001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// movprfx Zd.D, Pg/Z, Zn.D  ·····························································  (g < 8)
+	0b00000100, 0b11010000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// movprfx Zd.D, Pg/M, Zn.D  ·····························································  (g < 8)
+	0b00000100, 0b11010001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+
 	// movz Wd, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 65536, imm2 in [0, 16])
 	0b01010010, 0b10000000, 0b00000000, 0b00000000, 3, CmdR0, CmdUbits, 5, 16, CmdUAlt2, 21, 7,
 	// movz Xd, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])
@@ -3427,11 +4531,37 @@ var Commands = [...]byte{
 
 	// mrs Xd, #imm  ······························································  (0 <= imm < 32768)
 	0b11010101, 0b00110000, 0b00000000, 0b00000000, 2, CmdR0, CmdUbits, 5, 15,
-
+	// mrs Xd, <sysreg>
+	0b11010101, 0b00110000, 0b00000000, 0b00000000, 2, CmdR0, CmdUbits, 5, 15,
+	// mrs Xd, SPSEL
+	0b11010101, 0b00111000, 0b01000010, 0b00000000, 1, CmdR0,
+	// mrs Xd, PAN
+	0b11010101, 0b00111000, 0b01000010, 0b01100000, 1, CmdR0,
+	// mrs Xd, UAO
+	0b11010101, 0b00111000, 0b01000010, 0b10000000, 1, CmdR0,
+	// mrs Xd, DIT
+	0b11010101, 0b00111011, 0b01000010, 0b10100000, 1, CmdR0,
+
+	// msr SVCRSM, #imm  ······························································  (0 <= imm < 2)
+	0b11010101, 0b00000011, 0b01000010, 0b01111111, 1, CmdUbits, 8, 1,
+	// msr SVCRZA, #imm  ······························································  (0 <= imm < 2)
+	0b11010101, 0b00000011, 0b01000100, 0b01111111, 1, CmdUbits, 8, 1,
+	// msr SVCRSMZA, #imm  ····························································  (0 <= imm < 2)
+	0b11010101, 0b00000011, 0b01000110, 0b01111111, 1, CmdUbits, 8, 1,
 	// msr <symbol>, #imm  ···························································  (0 <= imm < 16)
 	0b11010101, 0b00000000, 0b01000000, 0b00011111, 2, CmdLitList, 5, SymMSRIMMOPS, CmdUbits, 8, 4,
 	// msr #imm, Xn  ······························································  (0 <= imm < 32768)
 	0b11010101, 0b00010000, 0b00000000, 0b00000000, 2, CmdUbits, 5, 15, CmdR0,
+	// msr <sysreg>, Xn
+	0b11010101, 0b00010000, 0b00000000, 0b00000000, 2, CmdUbits, 5, 15, CmdR0,
+	// msr SPSEL, Xn
+	0b11010101, 0b00011000, 0b01000010, 0b00000000, 1, CmdR0,
+	// msr PAN, Xn
+	0b11010101, 0b00011000, 0b01000010, 0b01100000, 1, CmdR0,
+	// msr UAO, Xn
+	0b11010101, 0b00011000, 0b01000010, 0b10000000, 1, CmdR0,
+	// msr DIT, Xn
+	0b11010101, 0b00011011, 0b01000010, 0b10100000, 1, CmdR0,
 
 	// msub Wd, Wn, Wm, Wa
 	0b00011011, 0b00000000, 0b10000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
@@ -3457,6 +4587,30 @@ var Commands = [...]byte{
 	0b00011011, 0b00000000, 0b01111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// mul Xd, Xn, Xm
 	0b10011011, 0b00000000, 0b01111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// mul Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// mul Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// mul Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// mul Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// mul Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00010000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// mul Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01010000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// mul Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10010000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// mul Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11010000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// mul Zd.B, Zn.B, #imm  ·············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b00110000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// mul Zd.H, Zn.H, #imm  ·············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b01110000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// mul Zd.S, Zn.S, #imm  ·············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b10110000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// mul Zd.D, Zn.D, #imm  ·············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b11110000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
 
 	// mvn Wd, Wn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 32)
 	0b00101010, 0b00100000, 0b00000011, 0b11100000, 4, CmdR0, CmdR16, CmdRotates, CmdUbits, 10, 5,
@@ -3476,6 +4630,9 @@ var Commands = [...]byte{
 	// mvni Vd.2S, #imm1, MSL #imm2  ······························  (0 <= imm1 < 256, imm2 in [8, 16])
 	0b00101111, 0b00000000, 0b11000100, 0b00000000, 7, CmdR0, CmdChkUbits, 8, CmdUslice, 5, 5, 0, CmdUslice, 16, 3, 5, CmdAdv, CmdUAlt2, 12, 8, CmdRwidth30,
 
+	// nbsl Zd.D, Zn.D, Zm.D, Za.D  ·························································  (n == d)
+	0b00000100, 0b11100000, 0b00111100, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdR16, CmdR5,
+
 	// neg Wd, Wn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 32)
 	0b01001011, 0b00000000, 0b00000011, 0b11100000, 4, CmdR0, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// neg Xd, Xn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 64)
@@ -3493,6 +4650,14 @@ var Commands = [...]byte{
 	0b00101110, 0b10100000, 0b10111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// neg Vd.2D, Vn.2D
 	0b00101110, 0b11100000, 0b10111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// neg Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	0b00000100, 0b00010111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// neg Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	0b00000100, 0b01010111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// neg Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	0b00000100, 0b10010111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// neg Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	0b00000100, 0b11010111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// negs Wd, Wn {, LSL|LSR|ASR #imm }  ············································  (0 <= imm < 32)
 	0b01101011, 0b00000000, 0b00000011, 0b11100000, 4, CmdR0, CmdR16, CmdRotates, CmdUbits, 10, 5,
@@ -3515,6 +4680,14 @@ var Commands = [...]byte{
 	// not Vd.16B, Vn.16B
 	// not Vd.8B, Vn.8B
 	0b00101110, 0b00100000, 0b01011000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// not Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	0b00000100, 0b00011110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// not Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	0b00000100, 0b01011110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// not Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	0b00000100, 0b10011110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// not Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	0b00000100, 0b11011110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// orn Vd.16B, Vn.16B, Vm.16B
 	// orn Vd.8B, Vn.8B, Vm.8B
@@ -3541,6 +4714,29 @@ var Commands = [...]byte{
 	0b00101010, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// orr Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
 	0b10101010, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 6,
+	// orr Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00011000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// orr Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01011000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// orr Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10011000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// orr Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11011000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// orr Zd.D, Zn.D, Zm.D
+	0b00000100, 0b01100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// orr Zd.S, Zn.S, #imm  ·········································  (n == d, imm is 32-bit logical)
+	0b00000101, 0b00000000, 0b00000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSpecial, 5, SpecialImmLogical32,
+	// orr Zd.D, Zn.D, #imm  ·········································  (n == d, imm is 64-bit logical)
+	0b00000101, 0b00000010, 0b00000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSpecial, 5, SpecialImmLogical64,
+
+	// orv Bd, Pg, Zn.B  ·····································································  (g < 8)
+	0b00000100, 0b00011000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// orv Hd, Pg, Zn.H  ·····································································  (g < 8)
+	0b00000100, 0b01011000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// orv Sd, Pg, Zn.S  ·····································································  (g < 8)
+	0b00000100, 0b10011000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// orv Dd, Pg, Zn.D  ·····································································  (g < 8)
+	0b00000100, 0b11011000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// pacda Xd, Xn|SP
 	0b11011010, 0b11000001, 0b00001000, 0b00000000, 2, CmdR0, CmdR5,
@@ -3587,6 +4783,9 @@ var Commands = [...]byte{
 	// pacizb Xd
 	0b11011010, 0b11000001, 0b00100111, 0b11100000, 1, CmdR0,
 
+	// pfalse Pd.B
+	0b00100101, 0b00011000, 0b11100100, 0b00000000, 1, CmdR0,
+
 	// pmul Vd.16B, Vn.16B, Vm.16B
 	// pmul Vd.8B, Vn.8B, Vm.8B
 	0b00101110, 0b00100000, 0b10011100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
@@ -3601,13 +4800,23 @@ var Commands = [...]byte{
 	// pmull2 Vd.1Q, Vn.2D, Vm.2D
 	0b01001110, 0b11100000, 0b11100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// prfm #imm1, [Xn|SP {, #imm2 }]  ················  (0 <= imm1 < 32, 0 <= imm2 < 32768, imm2 >> 3)
+	0b11111001, 0b10000000, 0b00000000, 0b00000000, 3, CmdUbits, 0, 5, CmdR5, CmdUscaled, 10, 12, 3,
+	// prfm <symbol>, [Xn|SP {, #imm }]  ································  (0 <= imm < 32768, imm >> 3)
+	0b11111001, 0b10000000, 0b00000000, 0b00000000, 3, CmdLitList, 0, SymPRFOPS, CmdR5, CmdUscaled, 10, 12, 3,
 	// prfm #imm, <offset>  ························  (0 <= imm < 32, offset >> 2 is 19-bit (+/- 1 MB))
 	0b11011000, 0b00000000, 0b00000000, 0b00000000, 2, CmdUbits, 0, 5, CmdOffset, RelBCond,
+	// prfm <symbol>, <offset>  ···································  (offset >> 2 is 19-bit (+/- 1 MB))
+	0b11011000, 0b00000000, 0b00000000, 0b00000000, 2, CmdLitList, 0, SymPRFOPS, CmdOffset, RelBCond,
 	// prfm #imm1, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm2 }]  ····  (0 <= imm1 < 32, imm2 in [0, 3])
 	0b11111000, 0b10100000, 0b00001000, 0b00000000, 5, CmdUbits, 0, 5, CmdR5, CmdR16, CmdExtendsX, CmdUAlt2, 12, 3,
+	// prfm <symbol>, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ···················  (imm in [0, 3])
+	0b11111000, 0b10100000, 0b00001000, 0b00000000, 5, CmdLitList, 0, SymPRFOPS, CmdR5, CmdR16, CmdExtendsX, CmdUAlt2, 12, 3,
 
 	// prfum #imm1, [Xn|SP {, #imm2 }]  ·························  (0 <= imm1 < 32, -256 <= imm2 < 256)
 	0b11111000, 0b10000000, 0b00000000, 0b00000000, 3, CmdUbits, 0, 5, CmdR5, CmdSbits,
+	// prfum <symbol>, [Xn|SP {, #imm }]  ········································  (-256 <= imm < 256)
+	0b11111000, 0b10000000, 0b00000000, 0b00000000, 3, CmdLitList, 0, SymPRFOPS, CmdR5, CmdSbits,
 
 	// psb CSYNC
 	0b11010101, 0b00000011, 0b00100010, 0b00111111, 0,
@@ -3615,6 +4824,43 @@ var Commands = [...]byte{
 	// pssbb
 	0b11010101, 0b00000011, 0b00110100, 0b10011111, 0,
 
+	// ptest Pd, Pn.B
+	0b00100101, 0b01010000, 0b11000000, 0b00000000, 2, CmdR10, CmdR5,
+
+	// ptrue Pd.B
+	0b00100101, 0b00011000, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrue Pd.B, <symbol>
+	0b00100101, 0b00011000, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+	// ptrue Pd.H
+	0b00100101, 0b01011000, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrue Pd.H, <symbol>
+	0b00100101, 0b01011000, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+	// ptrue Pd.S
+	0b00100101, 0b10011000, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrue Pd.S, <symbol>
+	0b00100101, 0b10011000, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+	// ptrue Pd.D
+	0b00100101, 0b11011000, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrue Pd.D, <symbol>
+	0b00100101, 0b11011000, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+
+	// ptrues Pd.B
+	0b00100101, 0b00011001, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrues Pd.B, <symbol>
+	0b00100101, 0b00011001, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+	// ptrues Pd.H
+	0b00100101, 0b01011001, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrues Pd.H, <symbol>
+	0b00100101, 0b01011001, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+	// ptrues Pd.S
+	0b00100101, 0b10011001, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrues Pd.S, <symbol>
+	0b00100101, 0b10011001, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+	// ptrues Pd.D
+	0b00100101, 0b11011001, 0b11100011, 0b11100000, 1, CmdR0,
+	// ptrues Pd.D, <symbol>
+	0b00100101, 0b11011001, 0b11100000, 0b00000000, 2, CmdR0, CmdLitList, 5, SymSVEPATTERNS,
+
 	// raddhn Vd.8B, Vn.8H, Vm.8H
 	0b00101110, 0b00100000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// raddhn Vd.4H, Vn.4S, Vm.4S
@@ -3640,6 +4886,20 @@ var Commands = [...]byte{
 	// rbit Xd, Xn
 	0b11011010, 0b11000000, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
 
+	// rdffr Pd.B
+	0b00100101, 0b00011001, 0b11110000, 0b00000000, 1, CmdR0,
+	// rdffr Pd.B, Pg/Z
+	0b00100101, 0b00011000, 0b11110000, 0b00000000, 2, CmdR0, CmdR5,
+
+	// rdffrs Pd.B, Pg/Z
+	0b00100101, 0b01011000, 0b11110000, 0b00000000, 2, CmdR0, CmdR5,
+
+	// rdsvl Xd, #imm  ·····························································  (-32 <= imm < 32)
+	0b00000100, 0b10111111, 0b01011000, 0b00000000, 2, CmdR0, CmdSfield, 5, 6,
+
+	// rdvl Xd, #imm  ······························································  (-32 <= imm < 32)
+	0b00000100, 0b10111111, 0b01010000, 0b00000000, 2, CmdR0, CmdSfield, 5, 6,
+
 	// ret Xd
 	0b11010110, 0b01011111, 0b00000000, 0b00000000, 1, CmdR5,
 	// ret
@@ -3655,6 +4915,22 @@ var Commands = [...]byte{
 	0b01011010, 0b11000000, 0b00001000, 0b00000000, 2, CmdR0, CmdR5,
 	// rev Xd, Xn
 	0b11011010, 0b11000000, 0b00001100, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Zd.B, Zn.B
+	0b00000101, 0b00111000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Zd.H, Zn.H
+	0b00000101, 0b01111000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Zd.S, Zn.S
+	0b00000101, 0b10111000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Zd.D, Zn.D
+	0b00000101, 0b11111000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Pd.B, Pn.B
+	0b00000101, 0b00110100, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Pd.H, Pn.H
+	0b00000101, 0b01110100, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Pd.S, Pn.S
+	0b00000101, 0b10110100, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
+	// rev Pd.D, Pn.D
+	0b00000101, 0b11110100, 0b01000000, 0b00000000, 2, CmdR0, CmdR5,
 
 	// rev16 Vd.16B, Vn.16B
 	// rev16 Vd.8B, Vn.8B
@@ -3763,6 +5039,14 @@ var Commands = [...]byte{
 	// sabd Vd.4S, Vn.4S, Vm.4S
 	// sabd Vd.2S, Vn.2S, Vm.2S
 	0b00001110, 0b10100000, 0b01110100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// sabd Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000100, 0b00001100, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sabd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000100, 0b01001100, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sabd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10001100, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sabd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11001100, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// sabdl Vd.8H, Vn.8B, Vm.8B
 	0b00001110, 0b00100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -3821,6 +5105,13 @@ var Commands = [...]byte{
 	// saddlv Dd, Vn.4S
 	0b00001110, 0b10110000, 0b00111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 
+	// saddv Dd, Pg, Zn.B  ···································································  (g < 8)
+	0b00000100, 0b00000000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// saddv Dd, Pg, Zn.H  ···································································  (g < 8)
+	0b00000100, 0b01000000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// saddv Dd, Pg, Zn.S  ···································································  (g < 8)
+	0b00000100, 0b10000000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+
 	// saddw Vd.8H, Vn.8H, Vm.8B
 	0b00001110, 0b00100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// saddw Vd.4S, Vn.4S, Vm.4H
@@ -3915,11 +5206,26 @@ var Commands = [...]byte{
 	0b10011110, 0b00100010, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
 	// scvtf Dd, Xn
 	0b10011110, 0b01100010, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
+	// scvtf Zd.H, Pg/M, Zn.H  ·······························································  (g < 8)
+	0b01100101, 0b01010010, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// scvtf Zd.S, Pg/M, Zn.S  ·······························································  (g < 8)
+	0b01100101, 0b10010100, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// scvtf Zd.D, Pg/M, Zn.D  ·······························································  (g < 8)
+	0b01100101, 0b11010110, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// sdiv Wd, Wn, Wm
 	0b00011010, 0b11000000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// sdiv Xd, Xn, Xm
 	0b10011010, 0b11000000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sdiv Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10010100, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sdiv Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11010100, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+
+	// sdivr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	0b00000100, 0b10010110, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sdivr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	0b00000100, 0b11010110, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// sdot Vd.2S, Vn.8B, Vm.4B[i]
 	0b00001111, 0b10000000, 0b11100000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
@@ -3930,12 +5236,42 @@ var Commands = [...]byte{
 	// sdot Vd.4S, Vn.16B, Vm.16B
 	0b01001110, 0b10000000, 0b10010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// sel Zd.B, Pg, Zn.B, Zm.B
+	0b00000101, 0b00100000, 0b11000000, 0b00000000, 4, CmdR0, CmdR10, CmdR5, CmdR16,
+	// sel Zd.H, Pg, Zn.H, Zm.H
+	0b00000101, 0b01100000, 0b11000000, 0b00000000, 4, CmdR0, CmdR10, CmdR5, CmdR16,
+	// sel Zd.S, Pg, Zn.S, Zm.S
+	0b00000101, 0b10100000, 0b11000000, 0b00000000, 4, CmdR0, CmdR10, CmdR5, CmdR16,
+	// sel Zd.D, Pg, Zn.D, Zm.D
+	0b00000101, 0b11100000, 0b11000000, 0b00000000, 4, CmdR0, CmdR10, CmdR5, CmdR16,
+
+	// sete [Xd]!, Xn, Xm
+	0b00011001, 0b11000000, 0b10000100, 0b00000000, 4, CmdR0, CmdAdv, CmdR5, CmdR16,
+
 	// setf16 Wd
 	0b00111010, 0b00000000, 0b01001000, 0b00001101, 1, CmdR5,
 
 	// setf8 Wd
 	0b00111010, 0b00000000, 0b00001000, 0b00001101, 1, CmdR5,
 
+	// setffr
+	0b00100101, 0b00101100, 0b10010000, 0b00000000, 0,
+
+	// setge [Xd]!, Xn, Xm
+	0b00011101, 0b11000000, 0b10000100, 0b00000000, 4, CmdR0, CmdAdv, CmdR5, CmdR16,
+
+	// setgm [Xd]!, Xn, Xm
+	0b00011101, 0b11000000, 0b01000100, 0b00000000, 4, CmdR0, CmdAdv, CmdR5, CmdR16,
+
+	// setgp [Xd]!, Xn, Xm
+	0b00011101, 0b11000000, 0b00000100, 0b00000000, 4, CmdR0, CmdAdv, CmdR5, CmdR16,
+
+	// setm [Xd]!, Xn, Xm
+	0b00011001, 0b11000000, 0b01000100, 0b00000000, 4, CmdR0, CmdAdv, CmdR5, CmdR16,
+
+	// setp [Xd]!, Xn, Xm
+	0b00011001, 0b11000000, 0b00000100, 0b00000000, 4, CmdR0, CmdAdv, CmdR5, CmdR16,
+
 	// sev
 	0b11010101, 0b00000011, 0b00100000, 0b10011111, 0,
 
@@ -4099,6 +5435,30 @@ var Commands = [...]byte{
 	// smax Vd.4S, Vn.4S, Vm.4S
 	// smax Vd.2S, Vn.2S, Vm.2S
 	0b00001110, 0b10100000, 0b01100100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// smax Wd, Wn, #imm  ························································  (-128 <= imm < 128)
+	0b00010001, 0b11000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdSfield, 10, 8,
+	// smax Xd, Xn, #imm  ························································  (-128 <= imm < 128)
+	0b10010001, 0b11000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdSfield, 10, 8,
+	// smax Wd, Wn, Wm
+	0b00011010, 0b11000000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smax Xd, Xn, Xm
+	0b10011010, 0b11000000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smax Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000100, 0b00001000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smax Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000100, 0b01001000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smax Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10001000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smax Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11001000, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smax Zd.B, Zn.B, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b00101000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// smax Zd.H, Zn.H, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b01101000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// smax Zd.S, Zn.S, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b10101000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// smax Zd.D, Zn.D, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b11101000, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
 
 	// smaxp Vd.16B, Vn.16B, Vm.16B
 	// smaxp Vd.8B, Vn.8B, Vm.8B
@@ -4118,6 +5478,14 @@ var Commands = [...]byte{
 	0b00001110, 0b01110000, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// smaxv Sd, Vn.4S
 	0b00001110, 0b10110000, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// smaxv Bd, Pg, Zn.B  ···································································  (g < 8)
+	0b00000100, 0b00001000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// smaxv Hd, Pg, Zn.H  ···································································  (g < 8)
+	0b00000100, 0b01001000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// smaxv Sd, Pg, Zn.S  ···································································  (g < 8)
+	0b00000100, 0b10001000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// smaxv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b00000100, 0b11001000, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// smc #imm  ··································································  (0 <= imm < 65536)
 	0b11010100, 0b00000000, 0b00000000, 0b00000011, 1, CmdUbits, 5, 16,
@@ -4131,6 +5499,30 @@ var Commands = [...]byte{
 	// smin Vd.4S, Vn.4S, Vm.4S
 	// smin Vd.2S, Vn.2S, Vm.2S
 	0b00001110, 0b10100000, 0b01101100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// smin Wd, Wn, #imm  ························································  (-128 <= imm < 128)
+	0b00010001, 0b11001000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdSfield, 10, 8,
+	// smin Xd, Xn, #imm  ························································  (-128 <= imm < 128)
+	0b10010001, 0b11001000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdSfield, 10, 8,
+	// smin Wd, Wn, Wm
+	0b00011010, 0b11000000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smin Xd, Xn, Xm
+	0b10011010, 0b11000000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smin Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000100, 0b00001010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smin Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000100, 0b01001010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smin Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10001010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smin Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11001010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smin Zd.B, Zn.B, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b00101010, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// smin Zd.H, Zn.H, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b01101010, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// smin Zd.S, Zn.S, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b10101010, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
+	// smin Zd.D, Zn.D, #imm  ············································  (n == d, -128 <= imm < 128)
+	0b00100101, 0b11101010, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdSfield, 5, 8,
 
 	// sminp Vd.16B, Vn.16B, Vm.16B
 	// sminp Vd.8B, Vn.8B, Vm.8B
@@ -4150,6 +5542,14 @@ var Commands = [...]byte{
 	0b00001110, 0b01110001, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// sminv Sd, Vn.4S
 	0b00001110, 0b10110001, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// sminv Bd, Pg, Zn.B  ···································································  (g < 8)
+	0b00000100, 0b00001010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// sminv Hd, Pg, Zn.H  ···································································  (g < 8)
+	0b00000100, 0b01001010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// sminv Sd, Pg, Zn.S  ···································································  (g < 8)
+	0b00000100, 0b10001010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// sminv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b00000100, 0b11001010, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// smlal Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
 	0b00001111, 0b01000000, 0b00100000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -4195,9 +5595,22 @@ var Commands = [...]byte{
 	// smlsl2 Vd.2D, Vn.4S, Vm.4S
 	0b01001110, 0b10100000, 0b10100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// smmla Vd.4S, Vn.16B, Vm.16B
+	0b01001110, 0b10000000, 0b10100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
 	// smnegl Xd, Wn, Wm
 	0b10011011, 0b00100000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// smopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10100000, 0b10000000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// smopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	0b10100000, 0b11000000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
+	// smops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10100000, 0b10000000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// smops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	0b10100000, 0b11000000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
 	// smov Wd, Vn.B[i]
 	0b00001110, 0b00000001, 0b00101100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 17, 4,
 	// smov Wd, Vn.H[i]
@@ -4209,11 +5622,37 @@ var Commands = [...]byte{
 	// smov Xd, Vn.S[i]
 	0b01001110, 0b00000100, 0b00101100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 19, 2,
 
+	// smstart
+	0b11010101, 0b00000011, 0b01000111, 0b01111111, 0,
+	// smstart <symbol>
+	0b11010101, 0b00000000, 0b01000001, 0b00011111, 1, CmdLitList, 5, SymSVCRFIELDS,
+
+	// smstop
+	0b11010101, 0b00000011, 0b01000110, 0b01111111, 0,
+	// smstop <symbol>
+	0b11010101, 0b00000000, 0b01000000, 0b00011111, 1, CmdLitList, 5, SymSVCRFIELDS,
+
 	// smsubl Xd, Wn, Wm, Xa
 	0b10011011, 0b00100000, 0b10000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdR10,
 
 	// smulh Xd, Xn, Xm
 	0b10011011, 0b01000000, 0b01111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smulh Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smulh Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smulh Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smulh Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// smulh Zd.B, Pg/M, Zn.B, Zm.B  ·················································  (g < 8, n == d)
+	0b00000100, 0b00010010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smulh Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	0b00000100, 0b01010010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smulh Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	0b00000100, 0b10010010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// smulh Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	0b00000100, 0b11010010, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// smull Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
 	0b00001111, 0b01000000, 0b10100000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -4239,6 +5678,15 @@ var Commands = [...]byte{
 	// smull2 Vd.2D, Vn.4S, Vm.4S
 	0b01001110, 0b10100000, 0b11000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// splice Zd.B, Pg, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000101, 0b00101100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// splice Zd.H, Pg, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000101, 0b01101100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// splice Zd.S, Pg, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000101, 0b10101100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// splice Zd.D, Pg, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000101, 0b11101100, 0b10000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+
 	// sqabs Bd, Bn
 	0b01011110, 0b00100000, 0b01111000, 0b00000000, 2, CmdR0, CmdR5,
 	// sqabs Hd, Hn
@@ -4278,6 +5726,22 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b00001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// sqadd Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11100000, 0b00001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// sqadd Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqadd Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqadd Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqadd Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqadd Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00100100, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// sqadd Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b01100100, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// sqadd Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b10100100, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// sqadd Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b11100100, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
 
 	// sqdmlal Sd, Hn, Vm.H[i]  ·····························································  (m < 16)
 	0b01011111, 0b01000000, 0b00110000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -4351,6 +5815,14 @@ var Commands = [...]byte{
 	// sqdmulh Vd.4S, Vn.4S, Vm.4S
 	// sqdmulh Vd.2S, Vn.2S, Vm.2S
 	0b00001110, 0b10100000, 0b10110100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// sqdmulh Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqdmulh Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqdmulh Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqdmulh Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// sqdmull Sd, Hn, Vm.H[i]  ·····························································  (m < 16)
 	0b01011111, 0b01000000, 0b10110000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -4639,6 +6111,22 @@ var Commands = [...]byte{
 	0b00001110, 0b10100000, 0b00101100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// sqsub Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11100000, 0b00101100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// sqsub Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqsub Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqsub Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqsub Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sqsub Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00100110, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// sqsub Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b01100110, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// sqsub Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b10100110, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// sqsub Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b11100110, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
 
 	// sqxtn Bd, Hn
 	0b01011110, 0b00100001, 0b01001000, 0b00000000, 2, CmdR0, CmdR5,
@@ -5018,6 +6506,107 @@ var Commands = [...]byte{
 	// st1 {Vd.D * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
 	0b00001101, 0b10000000, 0b10000100, 0b00000000, 4, CmdR0, CmdUfields30, 1, CmdR5, CmdRNz16,
 
+	// st1b ZAdH|V.B[W12-W15, #imm], Pg, [Xn|SP, Xm]  ·························  (0 <= imm < 16, g < 8)
+	0b11100000, 0b00100000, 0b00000000, 0b00000000, 7, CmdAdv, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 4, CmdRLo8, 10, CmdR5, CmdR16,
+	// st1b ZAdH|V.B[W12-W15, #imm], Pg, [Xn|SP]  ·····························  (0 <= imm < 16, g < 8)
+	0b11100000, 0b00111111, 0b00000000, 0b00000000, 6, CmdAdv, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 4, CmdRLo8, 10, CmdR5,
+	// st1b {Zd.B * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100100, 0b00000000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1b {Zd.H * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100100, 0b00100000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1b {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100100, 0b01000000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1b {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100100, 0b01100000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1b {Zd.B * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	0b11100100, 0b00000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1b {Zd.H * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	0b11100100, 0b00100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1b {Zd.S * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	0b11100100, 0b01000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1b {Zd.D * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	0b11100100, 0b01100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1b {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	0b11100100, 0b00000000, 0b10100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// st1b {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW]  ········································  (g < 8)
+	0b11100100, 0b01000000, 0b10000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 14, CmdAdv,
+	// st1b {Zd.S * 1}, Pg, [Zn.S {, #imm }]  ·······················  (g < 8, 0 <= imm < 32, imm >> 0)
+	0b11100100, 0b01100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 0,
+	// st1b {Zd.D * 1}, Pg, [Zn.D {, #imm }]  ·······················  (g < 8, 0 <= imm < 32, imm >> 0)
+	0b11100100, 0b01000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 0,
+
+	// st1d ZAdH|V.D[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #3]  ···········  (d < 8, 0 <= imm < 2, g < 8)
+	0b11100000, 0b11100000, 0b00000000, 0b00000000, 7, CmdRbits, 1, 3, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 1, CmdRLo8, 10, CmdR5, CmdR16,
+	// st1d ZAdH|V.D[W12-W15, #imm], Pg, [Xn|SP]  ·······················  (d < 8, 0 <= imm < 2, g < 8)
+	0b11100000, 0b11111111, 0b00000000, 0b00000000, 6, CmdRbits, 1, 3, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 1, CmdRLo8, 10, CmdR5,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100101, 0b11100000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #3]  ····································  (g < 8, m != 31)
+	0b11100101, 0b11100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	0b11100101, 0b10000000, 0b10100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #3]  ···········································  (g < 8)
+	0b11100101, 0b10100000, 0b10100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+
+	// st1h ZAdH|V.H[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #1]  ···········  (d < 2, 0 <= imm < 8, g < 8)
+	0b11100000, 0b01100000, 0b00000000, 0b00000000, 7, CmdRbits, 3, 1, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 3, CmdRLo8, 10, CmdR5, CmdR16,
+	// st1h ZAdH|V.H[W12-W15, #imm], Pg, [Xn|SP]  ·······················  (d < 2, 0 <= imm < 8, g < 8)
+	0b11100000, 0b01111111, 0b00000000, 0b00000000, 6, CmdRbits, 3, 1, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 3, CmdRLo8, 10, CmdR5,
+	// st1h {Zd.H * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100100, 0b10100000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100100, 0b11000000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100100, 0b11100000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1h {Zd.H * 1}, Pg, [Xn|SP, Xm, LSL #1]  ····································  (g < 8, m != 31)
+	0b11100100, 0b10100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP, Xm, LSL #1]  ····································  (g < 8, m != 31)
+	0b11100100, 0b11000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #1]  ····································  (g < 8, m != 31)
+	0b11100100, 0b11100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	0b11100100, 0b10000000, 0b10100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #1]  ···········································  (g < 8)
+	0b11100100, 0b10100000, 0b10100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW]  ········································  (g < 8)
+	0b11100100, 0b11000000, 0b10000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 14, CmdAdv,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW #1]  ·····································  (g < 8)
+	0b11100100, 0b11100000, 0b10000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 14, CmdAdv,
+	// st1h {Zd.S * 1}, Pg, [Zn.S {, #imm }]  ·······················  (g < 8, 0 <= imm < 64, imm >> 1)
+	0b11100100, 0b11100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 1,
+	// st1h {Zd.D * 1}, Pg, [Zn.D {, #imm }]  ·······················  (g < 8, 0 <= imm < 64, imm >> 1)
+	0b11100100, 0b11000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 1,
+
+	// st1q ZAdH|V.Q[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #4]  ··············  (d < 16, imm == 0, g < 8)
+	0b11100001, 0b11100000, 0b00000000, 0b00000000, 7, CmdRbits, 0, 4, CmdUbits, 15, 1, CmdRW12, 13, CmdUrange, 0, 0, 0, CmdRLo8, 10, CmdR5, CmdR16,
+	// st1q ZAdH|V.Q[W12-W15, #imm], Pg, [Xn|SP]  ··························  (d < 16, imm == 0, g < 8)
+	0b11100001, 0b11111111, 0b00000000, 0b00000000, 6, CmdRbits, 0, 4, CmdUbits, 15, 1, CmdRW12, 13, CmdUrange, 0, 0, 0, CmdRLo8, 10, CmdR5,
+
+	// st1w ZAdH|V.S[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #2]  ···········  (d < 4, 0 <= imm < 4, g < 8)
+	0b11100000, 0b10100000, 0b00000000, 0b00000000, 7, CmdRbits, 2, 2, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 2, CmdRLo8, 10, CmdR5, CmdR16,
+	// st1w ZAdH|V.S[W12-W15, #imm], Pg, [Xn|SP]  ·······················  (d < 4, 0 <= imm < 4, g < 8)
+	0b11100000, 0b10111111, 0b00000000, 0b00000000, 6, CmdRbits, 2, 2, CmdUbits, 15, 1, CmdRW12, 13, CmdUbits, 0, 2, CmdRLo8, 10, CmdR5,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100101, 0b01000000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	0b11100101, 0b01100000, 0b11100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdSfield, 16, 4,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP, Xm, LSL #2]  ····································  (g < 8, m != 31)
+	0b11100101, 0b01000000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #2]  ····································  (g < 8, m != 31)
+	0b11100101, 0b01100000, 0b01000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdRNz16, CmdAdv, CmdAdv,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	0b11100101, 0b00000000, 0b10100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #2]  ···········································  (g < 8)
+	0b11100101, 0b00100000, 0b10100000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdAdv, CmdAdv,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW]  ········································  (g < 8)
+	0b11100101, 0b01000000, 0b10000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 14, CmdAdv,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW #2]  ·····································  (g < 8)
+	0b11100101, 0b01100000, 0b10000000, 0b00000000, 6, CmdR0, CmdRLo8, 10, CmdR5, CmdR16, CmdXs, 14, CmdAdv,
+	// st1w {Zd.S * 1}, Pg, [Zn.S {, #imm }]  ······················  (g < 8, 0 <= imm < 128, imm >> 2)
+	0b11100101, 0b01100000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 2,
+	// st1w {Zd.D * 1}, Pg, [Zn.D {, #imm }]  ······················  (g < 8, 0 <= imm < 128, imm >> 2)
+	0b11100101, 0b01000000, 0b10100000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdR5, CmdUscaled, 16, 5, 2,
+
 	// st2 {Vd.16B * 2}, [Xn|SP]
 	// st2 {Vd.8B * 2}, [Xn|SP]
 	0b00001100, 0b00000000, 0b10000000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
@@ -5079,6 +6668,13 @@ var Commands = [...]byte{
 	// st2 {Vd.D * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
 	0b00001101, 0b10100000, 0b10000100, 0b00000000, 4, CmdR0, CmdUfields30, 1, CmdR5, CmdRNz16,
 
+	// st2g Xd|SP, [Xn|SP {, #imm }]  ································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b10100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// st2g Xd|SP, [Xn|SP, #imm]!  ···································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b10100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// st2g Xd|SP, [Xn|SP], #imm  ····································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b10100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+
 	// st3 {Vd.16B * 3}, [Xn|SP]
 	// st3 {Vd.8B * 3}, [Xn|SP]
 	0b00001100, 0b00000000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
@@ -5201,6 +6797,15 @@ var Commands = [...]byte{
 	// st4 {Vd.D * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
 	0b00001101, 0b10100000, 0b10100100, 0b00000000, 4, CmdR0, CmdUfields30, 1, CmdR5, CmdRNz16,
 
+	// st64b Xn, [Xm|SP]  ································································  (n is even)
+	0b11111000, 0b00111111, 0b10010000, 0b00000000, 2, CmdREven, 0, CmdR5,
+
+	// st64bv Xd, Xn, [Xm|SP]  ···························································  (n is even)
+	0b11111000, 0b00100000, 0b10110000, 0b00000000, 3, CmdR16, CmdREven, 0, CmdR5,
+
+	// st64bv0 Xd, Xn, [Xm|SP]  ··························································  (n is even)
+	0b11111000, 0b00100000, 0b10100000, 0b00000000, 3, CmdR16, CmdREven, 0, CmdR5,
+
 	// stadd Wd, [Xn|SP]
 	0b10111000, 0b00100000, 0b00000000, 0b00011111, 2, CmdR16, CmdR5,
 	// stadd Xd, [Xn|SP]
@@ -5267,6 +6872,20 @@ var Commands = [...]byte{
 	// steorlh Wd, [Xn|SP]
 	0b01111000, 0b01100000, 0b00100000, 0b00011111, 2, CmdR16, CmdR5,
 
+	// stg Xd|SP, [Xn|SP {, #imm }]  ·································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b00100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// stg Xd|SP, [Xn|SP, #imm]!  ····································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b00100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// stg Xd|SP, [Xn|SP], #imm  ·····································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b00100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+
+	// stgp Xd, Xn, [Xm|SP {, #imm }]  ·······························  (-1024 <= imm < 1024, imm >> 4)
+	0b01101001, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR10, CmdR5, CmdSscaled, 4,
+	// stgp Xd, Xn, [Xm|SP, #imm]!  ··································  (-1024 <= imm < 1024, imm >> 4)
+	0b01101001, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR10, CmdR5, CmdSscaled, 4,
+	// stgp Xd, Xn, [Xm|SP], #imm  ···································  (-1024 <= imm < 1024, imm >> 4)
+	0b01101000, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR10, CmdR5, CmdSscaled, 4,
+
 	// stllr Wd, [Xn|SP]
 	0b10001000, 0b10011111, 0b01111100, 0b00000000, 2, CmdR0, CmdR5,
 	// stllr Xd, [Xn|SP]
@@ -5414,6 +7033,12 @@ var Commands = [...]byte{
 	0b10111000, 0b00100000, 0b00001000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdExtendsX, CmdUAlt2, 12, 2,
 	// str Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 3])
 	0b11111000, 0b00100000, 0b00001000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdExtendsX, CmdUAlt2, 12, 3,
+	// str ZA[W12-W15, #imm1], [Xn|SP {, #imm2, MUL VL }]  ············  (0 <= imm1 < 16, imm2 == imm1)
+	0b11100001, 0b00100000, 0b00000000, 0b00000000, 6, CmdAdv, CmdAdv, CmdRW12, 13, CmdUbits, 0, 4, CmdR5, CmdUsame, 2,
+	// str Zd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	0b11100101, 0b10000000, 0b01000000, 0b00000000, 6, CmdR0, CmdR5, CmdChkSbits, 9, CmdSslice, 10, 3, 0, CmdSslice, 16, 6, 3, CmdAdv,
+	// str Pd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	0b11100101, 0b10000000, 0b00000000, 0b00000000, 6, CmdR0, CmdR5, CmdChkSbits, 9, CmdSslice, 10, 3, 0, CmdSslice, 16, 6, 3, CmdAdv,
 
 	// strb Wd, [Xn|SP], #imm  ···················································  (-256 <= imm < 256)
 	0b00111000, 0b00000000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdSbits,
@@ -5591,6 +7216,20 @@ var Commands = [...]byte{
 	// stxrh Wd, Wn, [Xm|SP]
 	0b01001000, 0b00000000, 0b01111100, 0b00000000, 3, CmdR16, CmdR0, CmdR5,
 
+	// stz2g Xd|SP, [Xn|SP {, #imm }]  ·······························  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b11100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// stz2g Xd|SP, [Xn|SP, #imm]!  ··································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b11100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// stz2g Xd|SP, [Xn|SP], #imm  ···································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b11100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+
+	// stzg Xd|SP, [Xn|SP {, #imm }]  ································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b01100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// stzg Xd|SP, [Xn|SP, #imm]!  ···································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b01100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+	// stzg Xd|SP, [Xn|SP], #imm  ····································  (-4096 <= imm < 4096, imm >> 4)
+	0b11011001, 0b01100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdSscaled9, 4,
+
 	// sub Wd, Wn, Wm {, LSL|LSR|ASR #imm }  ·········································  (0 <= imm < 32)
 	0b01001011, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// sub Xd, Xn, Xm {, LSL|LSR|ASR #imm }  ·········································  (0 <= imm < 64)
@@ -5618,6 +7257,33 @@ var Commands = [...]byte{
 	0b00101110, 0b10100000, 0b10000100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// sub Vd.2D, Vn.2D, Vm.2D
 	0b00101110, 0b11100000, 0b10000100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// sub Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sub Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sub Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sub Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// sub Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	0b00000100, 0b00000001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sub Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	0b00000100, 0b01000001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sub Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	0b00000100, 0b10000001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sub Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	0b00000100, 0b11000001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// sub Zd.B, Zn.B, #imm  ················································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00100001, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// sub Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b01100001, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// sub Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b10100001, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// sub Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b11100001, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+
+	// subg Xd|SP, Xn|SP, #imm1, #imm2  ················  (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16)
+	0b11010001, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdUscaled, 16, 6, 4, CmdUbits, 10, 4,
 
 	// subhn Vd.8B, Vn.8H, Vm.8H
 	0b00001110, 0b00100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -5633,6 +7299,29 @@ var Commands = [...]byte{
 	// subhn2 Vd.4S, Vn.2D, Vm.2D
 	0b01001110, 0b10100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// subp Xd, Xn|SP, Xm|SP
+	0b10011010, 0b11000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// subps Xd, Xn|SP, Xm|SP
+	0b10111010, 0b11000000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// subr Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000100, 0b00000011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// subr Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000100, 0b01000011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// subr Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10000011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// subr Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11000011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// subr Zd.B, Zn.B, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00100011, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// subr Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ··············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b01100011, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// subr Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ··············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b10100011, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// subr Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ··············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b11100011, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+
 	// subs Wd, Wn, Wm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 32)
 	0b01101011, 0b00000000, 0b00000000, 0b00000000, 5, CmdR0, CmdR5, CmdR16, CmdRotates, CmdUbits, 10, 5,
 	// subs Xd, Xn, Xm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 64)
@@ -5648,6 +7337,35 @@ var Commands = [...]byte{
 	// subs Xd, Xn|SP, #imm1 {, LSL #imm2 }  ·····················  (0 <= imm1 < 4096, imm2 in [0, 12])
 	0b11110001, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdUbits, 10, 12, CmdUAlt2, 22, 6,
 
+	// sudot Vd.2S, Vn.8B, Vm.4B[i]
+	0b00001111, 0b00000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
+	// sudot Vd.4S, Vn.16B, Vm.4B[i]
+	0b01001111, 0b00000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
+
+	// sumopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	0b10100000, 0b10100000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// sumopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	0b10100000, 0b11100000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
+	// sumops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	0b10100000, 0b10100000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// sumops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	0b10100000, 0b11100000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
+	// sunpkhi Zd.H, Zn.B
+	0b00000101, 0b01110001, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// sunpkhi Zd.S, Zn.H
+	0b00000101, 0b10110001, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// sunpkhi Zd.D, Zn.S
+	0b00000101, 0b11110001, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+
+	// sunpklo Zd.H, Zn.B
+	0b00000101, 0b01110000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// sunpklo Zd.S, Zn.H
+	0b00000101, 0b10110000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// sunpklo Zd.D, Zn.S
+	0b00000101, 0b11110000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+
 	// suqadd Bd, Bn
 	0b01011110, 0b00100000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
 	// suqadd Hd, Hn
@@ -5760,6 +7478,14 @@ var Commands = [...]byte{
 	// tbl Vd.16B, {Vn.16B * 1}, Vm.16B
 	// tbl Vd.8B, {Vn.16B * 1}, Vm.8B
 	0b00001110, 0b00000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// tbl Zd.B, {Zn.B * 1}, Zm.B
+	0b00000101, 0b00100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// tbl Zd.H, {Zn.H * 1}, Zm.H
+	0b00000101, 0b01100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// tbl Zd.S, {Zn.S * 1}, Zm.S
+	0b00000101, 0b10100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// tbl Zd.D, {Zn.D * 1}, Zm.D
+	0b00000101, 0b11100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// tbnz Wd, #imm, <offset>  ···················  (0 <= imm < 32, offset >> 2 is 14-bit (+/- 32 KB))
 	0b00110111, 0b00000000, 0b00000000, 0b00000000, 3, CmdR0, CmdUbits, 19, 5, CmdOffset, RelTbz,
@@ -5798,6 +7524,22 @@ var Commands = [...]byte{
 	0b00001110, 0b10000000, 0b00101000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// trn1 Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11000000, 0b00101000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// trn1 Zd.B, Zn.B, Zm.B
+	0b00000101, 0b00100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn1 Zd.H, Zn.H, Zm.H
+	0b00000101, 0b01100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn1 Zd.S, Zn.S, Zm.S
+	0b00000101, 0b10100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn1 Zd.D, Zn.D, Zm.D
+	0b00000101, 0b11100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn1 Pd.B, Pn.B, Pm.B
+	0b00000101, 0b00100000, 0b01010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn1 Pd.H, Pn.H, Pm.H
+	0b00000101, 0b01100000, 0b01010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn1 Pd.S, Pn.S, Pm.S
+	0b00000101, 0b10100000, 0b01010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn1 Pd.D, Pn.D, Pm.D
+	0b00000101, 0b11100000, 0b01010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// trn2 Vd.16B, Vn.16B, Vm.16B
 	// trn2 Vd.8B, Vn.8B, Vm.8B
@@ -5810,6 +7552,22 @@ var Commands = [...]byte{
 	0b00001110, 0b10000000, 0b01101000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// trn2 Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11000000, 0b01101000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// trn2 Zd.B, Zn.B, Zm.B
+	0b00000101, 0b00100000, 0b01110100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn2 Zd.H, Zn.H, Zm.H
+	0b00000101, 0b01100000, 0b01110100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn2 Zd.S, Zn.S, Zm.S
+	0b00000101, 0b10100000, 0b01110100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn2 Zd.D, Zn.D, Zm.D
+	0b00000101, 0b11100000, 0b01110100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn2 Pd.B, Pn.B, Pm.B
+	0b00000101, 0b00100000, 0b01010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn2 Pd.H, Pn.H, Pm.H
+	0b00000101, 0b01100000, 0b01010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn2 Pd.S, Pn.S, Pm.S
+	0b00000101, 0b10100000, 0b01010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// trn2 Pd.D, Pn.D, Pm.D
+	0b00000101, 0b11100000, 0b01010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// tsb CSYNC
 	0b11010101, 0b00000011, 0b00100010, 0b01011111, 0,
@@ -5856,6 +7614,14 @@ var Commands = [...]byte{
 	// uabd Vd.4S, Vn.4S, Vm.4S
 	// uabd Vd.2S, Vn.2S, Vm.2S
 	0b00101110, 0b10100000, 0b01110100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// uabd Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000100, 0b00001101, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// uabd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000100, 0b01001101, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// uabd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10001101, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// uabd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11001101, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// uabdl Vd.8H, Vn.8B, Vm.8B
 	0b00101110, 0b00100000, 0b01110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -5914,6 +7680,15 @@ var Commands = [...]byte{
 	// uaddlv Dd, Vn.4S
 	0b00101110, 0b10110000, 0b00111000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 
+	// uaddv Dd, Pg, Zn.B  ···································································  (g < 8)
+	0b00000100, 0b00000001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// uaddv Dd, Pg, Zn.H  ···································································  (g < 8)
+	0b00000100, 0b01000001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// uaddv Dd, Pg, Zn.S  ···································································  (g < 8)
+	0b00000100, 0b10000001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// uaddv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b00000100, 0b11000001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+
 	// uaddw Vd.8H, Vn.8H, Vm.8B
 	0b00101110, 0b00100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// uaddw Vd.4S, Vn.4S, Vm.4H
@@ -5995,6 +7770,12 @@ var Commands = [...]byte{
 	0b10011110, 0b00100011, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
 	// ucvtf Dd, Xn
 	0b10011110, 0b01100011, 0b00000000, 0b00000000, 2, CmdR0, CmdR5,
+	// ucvtf Zd.H, Pg/M, Zn.H  ·······························································  (g < 8)
+	0b01100101, 0b01010011, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// ucvtf Zd.S, Pg/M, Zn.S  ·······························································  (g < 8)
+	0b01100101, 0b10010101, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// ucvtf Zd.D, Pg/M, Zn.D  ·······························································  (g < 8)
+	0b01100101, 0b11010111, 0b10100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// udf #imm  ··································································  (0 <= imm < 65536)
 	0b00000000, 0b00000000, 0b00000000, 0b00000000, 1, CmdUbits, 0, 16,
@@ -6003,6 +7784,15 @@ var Commands = [...]byte{
 	0b00011010, 0b11000000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// udiv Xd, Xn, Xm
 	0b10011010, 0b11000000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// udiv Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10010101, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// udiv Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11010101, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+
+	// udivr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	0b00000100, 0b10010111, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// udivr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	0b00000100, 0b11010111, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// udot Vd.2S, Vn.8B, Vm.4B[i]
 	0b00101111, 0b10000000, 0b11100000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
@@ -6045,6 +7835,30 @@ var Commands = [...]byte{
 	// umax Vd.4S, Vn.4S, Vm.4S
 	// umax Vd.2S, Vn.2S, Vm.2S
 	0b00101110, 0b10100000, 0b01100100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// umax Wd, Wn, #imm  ···························································  (0 <= imm < 256)
+	0b00010001, 0b11000100, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 10, 8,
+	// umax Xd, Xn, #imm  ···························································  (0 <= imm < 256)
+	0b10010001, 0b11000100, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 10, 8,
+	// umax Wd, Wn, Wm
+	0b00011010, 0b11000000, 0b01100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umax Xd, Xn, Xm
+	0b10011010, 0b11000000, 0b01100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umax Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000100, 0b00001001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umax Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000100, 0b01001001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umax Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10001001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umax Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11001001, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umax Zd.B, Zn.B, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00101001, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// umax Zd.H, Zn.H, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b01101001, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// umax Zd.S, Zn.S, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b10101001, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// umax Zd.D, Zn.D, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b11101001, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
 
 	// umaxp Vd.16B, Vn.16B, Vm.16B
 	// umaxp Vd.8B, Vn.8B, Vm.8B
@@ -6064,6 +7878,14 @@ var Commands = [...]byte{
 	0b00101110, 0b01110000, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// umaxv Sd, Vn.4S
 	0b00101110, 0b10110000, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// umaxv Bd, Pg, Zn.B  ···································································  (g < 8)
+	0b00000100, 0b00001001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// umaxv Hd, Pg, Zn.H  ···································································  (g < 8)
+	0b00000100, 0b01001001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// umaxv Sd, Pg, Zn.S  ···································································  (g < 8)
+	0b00000100, 0b10001001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// umaxv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b00000100, 0b11001001, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// umin Vd.16B, Vn.16B, Vm.16B
 	// umin Vd.8B, Vn.8B, Vm.8B
@@ -6074,6 +7896,30 @@ var Commands = [...]byte{
 	// umin Vd.4S, Vn.4S, Vm.4S
 	// umin Vd.2S, Vn.2S, Vm.2S
 	0b00101110, 0b10100000, 0b01101100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// umin Wd, Wn, #imm  ···························································  (0 <= imm < 256)
+	0b00010001, 0b11001100, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 10, 8,
+	// umin Xd, Xn, #imm  ···························································  (0 <= imm < 256)
+	0b10010001, 0b11001100, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 10, 8,
+	// umin Wd, Wn, Wm
+	0b00011010, 0b11000000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umin Xd, Xn, Xm
+	0b10011010, 0b11000000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umin Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	0b00000100, 0b00001011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umin Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	0b00000100, 0b01001011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umin Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	0b00000100, 0b10001011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umin Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	0b00000100, 0b11001011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umin Zd.B, Zn.B, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00101011, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// umin Zd.H, Zn.H, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b01101011, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// umin Zd.S, Zn.S, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b10101011, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// umin Zd.D, Zn.D, #imm  ···············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b11101011, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
 
 	// uminp Vd.16B, Vn.16B, Vm.16B
 	// uminp Vd.8B, Vn.8B, Vm.8B
@@ -6093,6 +7939,14 @@ var Commands = [...]byte{
 	0b00101110, 0b01110001, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
 	// uminv Sd, Vn.4S
 	0b00101110, 0b10110001, 0b10101000, 0b00000000, 3, CmdR0, CmdR5, CmdRwidth30,
+	// uminv Bd, Pg, Zn.B  ···································································  (g < 8)
+	0b00000100, 0b00001011, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// uminv Hd, Pg, Zn.H  ···································································  (g < 8)
+	0b00000100, 0b01001011, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// uminv Sd, Pg, Zn.S  ···································································  (g < 8)
+	0b00000100, 0b10001011, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
+	// uminv Dd, Pg, Zn.D  ···································································  (g < 8)
+	0b00000100, 0b11001011, 0b00100000, 0b00000000, 3, CmdR0, CmdRLo8, 10, CmdR5,
 
 	// umlal Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
 	0b00101111, 0b01000000, 0b00100000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -6138,9 +7992,22 @@ var Commands = [...]byte{
 	// umlsl2 Vd.2D, Vn.4S, Vm.4S
 	0b01101110, 0b10100000, 0b10100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// ummla Vd.4S, Vn.16B, Vm.16B
+	0b01101110, 0b10000000, 0b10100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
 	// umnegl Xd, Wn, Wm
 	0b10011011, 0b10100000, 0b11111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// umopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10100001, 0b10100000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// umopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	0b10100001, 0b11100000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
+	// umops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	0b10100001, 0b10100000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// umops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	0b10100001, 0b11100000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
 	// umov Wd, Vn.B[i]
 	0b00001110, 0b00000001, 0b00111100, 0b00000000, 3, CmdR0, CmdR5, CmdUbits, 17, 4,
 	// umov Wd, Vn.H[i]
@@ -6155,6 +8022,22 @@ var Commands = [...]byte{
 
 	// umulh Xd, Xn, Xm
 	0b10011011, 0b11000000, 0b01111100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umulh Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umulh Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umulh Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umulh Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// umulh Zd.B, Pg/M, Zn.B, Zm.B  ·················································  (g < 8, n == d)
+	0b00000100, 0b00010011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umulh Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	0b00000100, 0b01010011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umulh Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	0b00000100, 0b10010011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
+	// umulh Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	0b00000100, 0b11010011, 0b00000000, 0b00000000, 4, CmdR0, CmdRLo8, 10, CmdRSame, 2, CmdR5,
 
 	// umull Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
 	0b00101111, 0b01000000, 0b10100000, 0b00000000, 4, CmdR0, CmdR5, CmdRLo16, CmdUfields11, 3,
@@ -6199,6 +8082,22 @@ var Commands = [...]byte{
 	0b00101110, 0b10100000, 0b00001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// uqadd Vd.2D, Vn.2D, Vm.2D
 	0b00101110, 0b11100000, 0b00001100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// uqadd Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqadd Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqadd Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqadd Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqadd Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00100101, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// uqadd Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b01100101, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// uqadd Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b10100101, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// uqadd Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b11100101, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
 
 	// uqrshl Bd, Bn, Bm
 	0b01111110, 0b00100000, 0b01011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
@@ -6318,6 +8217,22 @@ var Commands = [...]byte{
 	0b00101110, 0b10100000, 0b00101100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// uqsub Vd.2D, Vn.2D, Vm.2D
 	0b00101110, 0b11100000, 0b00101100, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// uqsub Zd.B, Zn.B, Zm.B
+	0b00000100, 0b00100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqsub Zd.H, Zn.H, Zm.H
+	0b00000100, 0b01100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqsub Zd.S, Zn.S, Zm.S
+	0b00000100, 0b10100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqsub Zd.D, Zn.D, Zm.D
+	0b00000100, 0b11100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uqsub Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	0b00100101, 0b00100111, 0b11000000, 0b00000000, 3, CmdR0, CmdRSame, 1, CmdUbits, 5, 8,
+	// uqsub Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b01100111, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// uqsub Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b10100111, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
+	// uqsub Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	0b00100101, 0b11100111, 0b11000000, 0b00000000, 4, CmdR0, CmdRSame, 1, CmdUbits, 5, 8, CmdUAlt2, 13, 5,
 
 	// uqxtn Bd, Hn
 	0b01111110, 0b00100001, 0b01001000, 0b00000000, 2, CmdR0, CmdR5,
@@ -6399,6 +8314,15 @@ var Commands = [...]byte{
 	// ursra Vd.2D, Vn.2D, #imm  ·····················································  (0 < imm <= 64)
 	0b00101111, 0b01000000, 0b00110100, 0b00000000, 4, CmdR0, CmdR5, CmdUsub, 16, 6, 64, CmdRwidth30,
 
+	// usdot Vd.2S, Vn.8B, Vm.8B
+	0b00001110, 0b10000000, 0b10011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// usdot Vd.4S, Vn.16B, Vm.16B
+	0b01001110, 0b10000000, 0b10011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// usdot Vd.2S, Vn.8B, Vm.4B[i]
+	0b00001111, 0b10000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
+	// usdot Vd.4S, Vn.16B, Vm.4B[i]
+	0b01001111, 0b10000000, 0b11110000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUfields11, 2,
+
 	// ushl Dd, Dn, Dm
 	0b01111110, 0b11100000, 0b01000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 	// ushl Vd.16B, Vn.16B, Vm.16B
@@ -6441,6 +8365,19 @@ var Commands = [...]byte{
 	// ushr Vd.2D, Vn.2D, #imm  ······················································  (0 < imm <= 64)
 	0b00101111, 0b01000000, 0b00000100, 0b00000000, 4, CmdR0, CmdR5, CmdUsub, 16, 6, 64, CmdRwidth30,
 
+	// usmmla Vd.4S, Vn.16B, Vm.16B
+	0b01001110, 0b10000000, 0b10101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// usmopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	0b10100001, 0b10000000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// usmopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	0b10100001, 0b11000000, 0b00000000, 0b00000000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
+	// usmops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	0b10100001, 0b10000000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 2, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+	// usmops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	0b10100001, 0b11000000, 0b00000000, 0b00010000, 5, CmdRbits, 0, 3, CmdRLo8, 10, CmdRLo8, 13, CmdR5, CmdR16,
+
 	// usqadd Bd, Bn
 	0b01111110, 0b00100000, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
 	// usqadd Hd, Hn
@@ -6503,6 +8440,20 @@ var Commands = [...]byte{
 	// usubw2 Vd.2D, Vn.2D, Vm.4S
 	0b01101110, 0b10100000, 0b00110000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
+	// uunpkhi Zd.H, Zn.B
+	0b00000101, 0b01110011, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// uunpkhi Zd.S, Zn.H
+	0b00000101, 0b10110011, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// uunpkhi Zd.D, Zn.S
+	0b00000101, 0b11110011, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+
+	// uunpklo Zd.H, Zn.B
+	0b00000101, 0b01110010, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// uunpklo Zd.S, Zn.H
+	0b00000101, 0b10110010, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+	// uunpklo Zd.D, Zn.S
+	0b00000101, 0b11110010, 0b00111000, 0b00000000, 2, CmdR0, CmdR5,
+
 	// uxtb Wd, Wn
 	0b01010011, 0b00000000, 0b00011100, 0b00000000, 2, CmdR0, CmdR5,
 
@@ -6534,6 +8485,22 @@ var Commands = [...]byte{
 	0b00001110, 0b10000000, 0b00011000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// uzp1 Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11000000, 0b00011000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// uzp1 Zd.B, Zn.B, Zm.B
+	0b00000101, 0b00100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp1 Zd.H, Zn.H, Zm.H
+	0b00000101, 0b01100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp1 Zd.S, Zn.S, Zm.S
+	0b00000101, 0b10100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp1 Zd.D, Zn.D, Zm.D
+	0b00000101, 0b11100000, 0b01101000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp1 Pd.B, Pn.B, Pm.B
+	0b00000101, 0b00100000, 0b01001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp1 Pd.H, Pn.H, Pm.H
+	0b00000101, 0b01100000, 0b01001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp1 Pd.S, Pn.S, Pm.S
+	0b00000101, 0b10100000, 0b01001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp1 Pd.D, Pn.D, Pm.D
+	0b00000101, 0b11100000, 0b01001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// uzp2 Vd.16B, Vn.16B, Vm.16B
 	// uzp2 Vd.8B, Vn.8B, Vm.8B
@@ -6546,13 +8513,177 @@ var Commands = [...]byte{
 	0b00001110, 0b10000000, 0b01011000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// uzp2 Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11000000, 0b01011000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// uzp2 Zd.B, Zn.B, Zm.B
+	0b00000101, 0b00100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp2 Zd.H, Zn.H, Zm.H
+	0b00000101, 0b01100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp2 Zd.S, Zn.S, Zm.S
+	0b00000101, 0b10100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp2 Zd.D, Zn.D, Zm.D
+	0b00000101, 0b11100000, 0b01101100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp2 Pd.B, Pn.B, Pm.B
+	0b00000101, 0b00100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp2 Pd.H, Pn.H, Pm.H
+	0b00000101, 0b01100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp2 Pd.S, Pn.S, Pm.S
+	0b00000101, 0b10100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// uzp2 Pd.D, Pn.D, Pm.D
+	0b00000101, 0b11100000, 0b01001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// wfe
 	0b11010101, 0b00000011, 0b00100000, 0b01011111, 0,
 
+	// wfet Xd
+	0b11010101, 0b00000011, 0b00010000, 0b00000000, 1, CmdR0,
+
 	// wfi
 	0b11010101, 0b00000011, 0b00100000, 0b01111111, 0,
 
+	// wfit Xd
+	0b11010101, 0b00000011, 0b00010000, 0b00100000, 1, CmdR0,
+
+	// whilege Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilege Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilege Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilege Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilege Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilege Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilege Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilege Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00010000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// whilegt Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00000000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilegt Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00010000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilegt Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00000000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilegt Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00010000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilegt Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00000000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilegt Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00010000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilegt Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00000000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilegt Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00010000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+
+	// whilehi Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00001000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehi Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00011000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehi Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00001000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehi Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00011000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehi Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00001000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehi Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00011000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehi Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00001000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehi Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00011000, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+
+	// whilehs Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehs Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehs Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehs Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehs Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehs Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehs Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00001000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilehs Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00011000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// whilele Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00000100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilele Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00010100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilele Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00000100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilele Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00010100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilele Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00000100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilele Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00010100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilele Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00000100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilele Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00010100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+
+	// whilelo Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelo Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelo Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelo Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelo Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelo Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelo Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00001100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelo Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00011100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// whilels Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00001100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilels Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00011100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilels Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00001100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilels Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00011100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilels Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00001100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilels Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00011100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilels Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00001100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+	// whilels Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00011100, 0b00010000, 3, CmdR0, CmdR5, CmdR16,
+
+	// whilelt Pd.B, Wn, Wm
+	0b00100101, 0b00100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelt Pd.B, Xn, Xm
+	0b00100101, 0b00100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelt Pd.H, Wn, Wm
+	0b00100101, 0b01100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelt Pd.H, Xn, Xm
+	0b00100101, 0b01100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelt Pd.S, Wn, Wm
+	0b00100101, 0b10100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelt Pd.S, Xn, Xm
+	0b00100101, 0b10100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelt Pd.D, Wn, Wm
+	0b00100101, 0b11100000, 0b00000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// whilelt Pd.D, Xn, Xm
+	0b00100101, 0b11100000, 0b00010100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+
+	// wrffr Pd.B
+	0b00100101, 0b00101000, 0b10010000, 0b00000000, 1, CmdR5,
+
+	// xaflag
+	0b11010101, 0b00000000, 0b01000000, 0b00111111, 0,
+
 	// xar Vd.2D, Vn.2D, Vm.2D, #imm  ················································  (0 <= imm < 64)
 	0b11001110, 0b10000000, 0b00000000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdUbits, 10, 6,
 
@@ -6582,6 +8713,9 @@ var Commands = [...]byte{
 	// yield
 	0b11010101, 0b00000011, 0b00100000, 0b00111111, 0,
 
+	// zero ZA
+	0b11000000, 0b00001000, 0b00000000, 0b11111111, 0,
+
 	// zip1 Vd.16B, Vn.16B, Vm.16B
 	// zip1 Vd.8B, Vn.8B, Vm.8B
 	0b00001110, 0b00000000, 0b00111000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
@@ -6593,6 +8727,22 @@ var Commands = [...]byte{
 	0b00001110, 0b10000000, 0b00111000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// zip1 Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11000000, 0b00111000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// zip1 Zd.B, Zn.B, Zm.B
+	0b00000101, 0b00100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip1 Zd.H, Zn.H, Zm.H
+	0b00000101, 0b01100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip1 Zd.S, Zn.S, Zm.S
+	0b00000101, 0b10100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip1 Zd.D, Zn.D, Zm.D
+	0b00000101, 0b11100000, 0b01100000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip1 Pd.B, Pn.B, Pm.B
+	0b00000101, 0b00100000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip1 Pd.H, Pn.H, Pm.H
+	0b00000101, 0b01100000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip1 Pd.S, Pn.S, Pm.S
+	0b00000101, 0b10100000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip1 Pd.D, Pn.D, Pm.D
+	0b00000101, 0b11100000, 0b01000000, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 
 	// zip2 Vd.16B, Vn.16B, Vm.16B
 	// zip2 Vd.8B, Vn.8B, Vm.8B
@@ -6605,4 +8755,20 @@ var Commands = [...]byte{
 	0b00001110, 0b10000000, 0b01111000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
 	// zip2 Vd.2D, Vn.2D, Vm.2D
 	0b00001110, 0b11000000, 0b01111000, 0b00000000, 4, CmdR0, CmdR5, CmdR16, CmdRwidth30,
+	// zip2 Zd.B, Zn.B, Zm.B
+	0b00000101, 0b00100000, 0b01100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip2 Zd.H, Zn.H, Zm.H
+	0b00000101, 0b01100000, 0b01100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip2 Zd.S, Zn.S, Zm.S
+	0b00000101, 0b10100000, 0b01100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip2 Zd.D, Zn.D, Zm.D
+	0b00000101, 0b11100000, 0b01100100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip2 Pd.B, Pn.B, Pm.B
+	0b00000101, 0b00100000, 0b01000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip2 Pd.H, Pn.H, Pm.H
+	0b00000101, 0b01100000, 0b01000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip2 Pd.S, Pn.S, Pm.S
+	0b00000101, 0b10100000, 0b01000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
+	// zip2 Pd.D, Pn.D, Pm.D
+	0b00000101, 0b11100000, 0b01000100, 0b00000000, 3, CmdR0, CmdR5, CmdR16,
 }
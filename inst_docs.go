@@ -0,0 +1,4546 @@
+// Code generated by gen/inst/inst.go; DO NOT EDIT.
+
+package arm
+
+// The EncodingDocs table contains the syntax and constraints of each instruction encoding,
+// in the order of the Patterns table. Dual-width SIMD encodings list each width on a separate line.
+var EncodingDocs = [...]string{
+	"abs Dd, Dn",
+	"abs Vd.16B, Vn.16B\nabs Vd.8B, Vn.8B",
+	"abs Vd.8H, Vn.8H\nabs Vd.4H, Vn.4H",
+	"abs Vd.4S, Vn.4S\nabs Vd.2S, Vn.2S",
+	"abs Vd.2D, Vn.2D",
+	"abs Wd, Wn",
+	"abs Xd, Xn",
+	"abs Zd.B, Pg/M, Zn.B (g < 8)",
+	"abs Zd.H, Pg/M, Zn.H (g < 8)",
+	"abs Zd.S, Pg/M, Zn.S (g < 8)",
+	"abs Zd.D, Pg/M, Zn.D (g < 8)",
+	"adc Wd, Wn, Wm",
+	"adc Xd, Xn, Xm",
+	"adcs Wd, Wn, Wm",
+	"adcs Xd, Xn, Xm",
+	"add Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"add Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"add Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4)",
+	"add Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4)",
+	"add Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4)",
+	"add Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"add Xd|SP, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"add Dd, Dn, Dm",
+	"add Vd.16B, Vn.16B, Vm.16B\nadd Vd.8B, Vn.8B, Vm.8B",
+	"add Vd.8H, Vn.8H, Vm.8H\nadd Vd.4H, Vn.4H, Vm.4H",
+	"add Vd.4S, Vn.4S, Vm.4S\nadd Vd.2S, Vn.2S, Vm.2S",
+	"add Vd.2D, Vn.2D, Vm.2D",
+	"add Zd.B, Zn.B, Zm.B",
+	"add Zd.H, Zn.H, Zm.H",
+	"add Zd.S, Zn.S, Zm.S",
+	"add Zd.D, Zn.D, Zm.D",
+	"add Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"add Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"add Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"add Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"add Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"add Zd.H, Zn.H, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"add Zd.S, Zn.S, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"add Zd.D, Zn.D, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"addg Xd|SP, Xn|SP, #imm1, #imm2 (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16)",
+	"addha ZAd.S, Pg1/M, Pg2/M, Zn.S (d < 4, g1 < 8, g2 < 8)",
+	"addha ZAd.D, Pg1/M, Pg2/M, Zn.D (d < 8, g1 < 8, g2 < 8)",
+	"addhn Vd.8B, Vn.8H, Vm.8H",
+	"addhn Vd.4H, Vn.4S, Vm.4S",
+	"addhn Vd.2S, Vn.2D, Vm.2D",
+	"addhn2 Vd.16B, Vn.8H, Vm.8H",
+	"addhn2 Vd.8H, Vn.4S, Vm.4S",
+	"addhn2 Vd.4S, Vn.2D, Vm.2D",
+	"addp Dd, Vn.2D",
+	"addp Vd.16B, Vn.16B, Vm.16B\naddp Vd.8B, Vn.8B, Vm.8B",
+	"addp Vd.8H, Vn.8H, Vm.8H\naddp Vd.4H, Vn.4H, Vm.4H",
+	"addp Vd.4S, Vn.4S, Vm.4S\naddp Vd.2S, Vn.2S, Vm.2S",
+	"addp Vd.2D, Vn.2D, Vm.2D",
+	"addpl Xd|SP, Xn|SP, #imm (-32 <= imm < 32)",
+	"adds Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"adds Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"adds Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4)",
+	"adds Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4)",
+	"adds Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4)",
+	"adds Wd, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"adds Xd, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"addspl Xd|SP, Xn|SP, #imm (-32 <= imm < 32)",
+	"addsvl Xd|SP, Xn|SP, #imm (-32 <= imm < 32)",
+	"addv Bd, Vn.16B\naddv Bd, Vn.8B",
+	"addv Hd, Vn.8H\naddv Hd, Vn.4H",
+	"addv Sd, Vn.4S",
+	"addva ZAd.S, Pg1/M, Pg2/M, Zn.S (d < 4, g1 < 8, g2 < 8)",
+	"addva ZAd.D, Pg1/M, Pg2/M, Zn.D (d < 8, g1 < 8, g2 < 8)",
+	"addvl Xd|SP, Xn|SP, #imm (-32 <= imm < 32)",
+	"adr Xd, <offset> (offset is 21-bit (+/- 1 MB))",
+	"adrp Xd, <offset> (offset >> 12 is 21-bit (+/- 4 GB))",
+	"aesd Vd.16B, Vn.16B",
+	"aese Vd.16B, Vn.16B",
+	"aesimc Vd.16B, Vn.16B",
+	"aesmc Vd.16B, Vn.16B",
+	"and Vd.16B, Vn.16B, Vm.16B\nand Vd.8B, Vn.8B, Vm.8B",
+	"and Wd|WSP, Wn, #imm (imm is 32-bit logical)",
+	"and Xd|SP, Xn, #imm (imm is 64-bit logical)",
+	"and Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"and Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"and Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"and Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"and Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"and Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"and Zd.D, Zn.D, Zm.D",
+	"and Zd.S, Zn.S, #imm (n == d, imm is 32-bit logical)",
+	"and Zd.D, Zn.D, #imm (n == d, imm is 64-bit logical)",
+	"ands Wd, Wn, #imm (imm is 32-bit logical)",
+	"ands Xd, Xn, #imm (imm is 64-bit logical)",
+	"ands Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"ands Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"andv Bd, Pg, Zn.B (g < 8)",
+	"andv Hd, Pg, Zn.H (g < 8)",
+	"andv Sd, Pg, Zn.S (g < 8)",
+	"andv Dd, Pg, Zn.D (g < 8)",
+	"asr Wd, Wn, Wm",
+	"asr Xd, Xn, Xm",
+	"asr Wd, Wn, #imm (0 <= imm < 32)",
+	"asr Xd, Xn, #imm (0 <= imm < 64)",
+	"asr Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"asr Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"asr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"asr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"asr Zd.B, Zn.B, #imm (0 < imm <= 8)",
+	"asr Zd.H, Zn.H, #imm (0 < imm <= 16)",
+	"asr Zd.S, Zn.S, #imm (0 < imm <= 32)",
+	"asr Zd.D, Zn.D, #imm (0 < imm <= 64)",
+	"asr Zd.B, Pg/M, Zn.B, #imm (g < 8, n == d, 0 < imm <= 8)",
+	"asr Zd.H, Pg/M, Zn.H, #imm (g < 8, n == d, 0 < imm <= 16)",
+	"asr Zd.S, Pg/M, Zn.S, #imm (g < 8, n == d, 0 < imm <= 32)",
+	"asr Zd.D, Pg/M, Zn.D, #imm (g < 8, n == d, 0 < imm <= 64)",
+	"asrv Wd, Wn, Wm",
+	"asrv Xd, Xn, Xm",
+	"at <symbol>, Xn",
+	"autda Xd, Xn|SP",
+	"autdb Xd, Xn|SP",
+	"autdza Xd",
+	"autdzb Xd",
+	"autia Xd, Xn|SP",
+	"autia1716",
+	"autiasp",
+	"autiaz",
+	"autib Xd, Xn|SP",
+	"autib1716",
+	"autibsp",
+	"autibz",
+	"autiza Xd",
+	"autizb Xd",
+	"axflag",
+	"b <cond>, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"b <offset> (offset >> 2 is 26-bit (+/- 128 MB))",
+	"bcax Vd.16B, Vn.16B, Vm.16B, Va.16B",
+	"bcax Zd.D, Zn.D, Zm.D, Za.D (n == d)",
+	"bfc Wd, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)",
+	"bfc Xd, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)",
+	"bfcvt Hd, Sn",
+	"bfcvtn Vd.4H, Vn.4S",
+	"bfcvtn2 Vd.8H, Vn.4S",
+	"bfdot Vd.2S, Vn.4H, Vm.4H",
+	"bfdot Vd.2S, Vn.4H, Vm.2H[i]",
+	"bfdot Vd.4S, Vn.8H, Vm.8H",
+	"bfdot Vd.4S, Vn.8H, Vm.2H[i]",
+	"bfi Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)",
+	"bfi Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)",
+	"bfm Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 <= imm2 < 32)",
+	"bfm Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64)",
+	"bfmlalb Vd.4S, Vn.8H, Vm.8H",
+	"bfmlalb Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"bfmlalt Vd.4S, Vn.8H, Vm.8H",
+	"bfmlalt Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"bfmmla Vd.4S, Vn.8H, Vm.8H",
+	"bfmopa ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 4, g1 < 8, g2 < 8)",
+	"bfmops ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 4, g1 < 8, g2 < 8)",
+	"bfxil Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)",
+	"bfxil Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)",
+	"bic Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])\nbic Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])",
+	"bic Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])\nbic Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])",
+	"bic Vd.16B, Vn.16B, Vm.16B\nbic Vd.8B, Vn.8B, Vm.8B",
+	"bic Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"bic Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"bic Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"bic Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"bic Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"bic Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"bic Zd.D, Zn.D, Zm.D",
+	"bics Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"bics Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"bif Vd.16B, Vn.16B, Vm.16B\nbif Vd.8B, Vn.8B, Vm.8B",
+	"bit Vd.16B, Vn.16B, Vm.16B\nbit Vd.8B, Vn.8B, Vm.8B",
+	"bl <offset> (offset >> 2 is 26-bit (+/- 128 MB))",
+	"blr Xd",
+	"blraa Xd, Xn|SP",
+	"blraaz Xd",
+	"blrab Xd, Xn|SP",
+	"blrabz Xd",
+	"br Xd",
+	"braa Xd, Xn|SP",
+	"braaz Xd",
+	"brab Xd, Xn|SP",
+	"brabz Xd",
+	"brk #imm (0 <= imm < 65536)",
+	"bsl Vd.16B, Vn.16B, Vm.16B\nbsl Vd.8B, Vn.8B, Vm.8B",
+	"bsl Zd.D, Zn.D, Zm.D, Za.D (n == d)",
+	"bsl1n Zd.D, Zn.D, Zm.D, Za.D (n == d)",
+	"bsl2n Zd.D, Zn.D, Zm.D, Za.D (n == d)",
+	"bti",
+	"bti <symbol>",
+	"cas Wd, Wn, [Xm|SP]",
+	"cas Xd, Xn, [Xm|SP]",
+	"casa Wd, Wn, [Xm|SP]",
+	"casa Xd, Xn, [Xm|SP]",
+	"casab Wd, Wn, [Xm|SP]",
+	"casah Wd, Wn, [Xm|SP]",
+	"casal Wd, Wn, [Xm|SP]",
+	"casal Xd, Xn, [Xm|SP]",
+	"casalb Wd, Wn, [Xm|SP]",
+	"casalh Wd, Wn, [Xm|SP]",
+	"casb Wd, Wn, [Xm|SP]",
+	"cash Wd, Wn, [Xm|SP]",
+	"casl Wd, Wn, [Xm|SP]",
+	"casl Xd, Xn, [Xm|SP]",
+	"caslb Wd, Wn, [Xm|SP]",
+	"caslh Wd, Wn, [Xm|SP]",
+	"casp Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"casp Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"caspa Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"caspa Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"caspal Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"caspal Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"caspl Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"caspl Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1)",
+	"cbnz Wd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"cbnz Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"cbz Wd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"cbz Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"ccmn Wd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16)",
+	"ccmn Xd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16)",
+	"ccmn Wd, Wn, #imm, <cond> (0 <= imm < 16)",
+	"ccmn Xd, Xn, #imm, <cond> (0 <= imm < 16)",
+	"ccmp Wd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16)",
+	"ccmp Xd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16)",
+	"ccmp Wd, Wn, #imm, <cond> (0 <= imm < 16)",
+	"ccmp Xd, Xn, #imm, <cond> (0 <= imm < 16)",
+	"cfinv",
+	"cfp RCTX, Xn",
+	"cinc Wd, Wn, <cond>",
+	"cinc Xd, Xn, <cond>",
+	"cinv Wd, Wn, <cond>",
+	"cinv Xd, Xn, <cond>",
+	"clrbhb",
+	"clrex #imm (0 <= imm < 16)",
+	"clrex",
+	"cls Vd.16B, Vn.16B\ncls Vd.8B, Vn.8B",
+	"cls Vd.8H, Vn.8H\ncls Vd.4H, Vn.4H",
+	"cls Vd.4S, Vn.4S\ncls Vd.2S, Vn.2S",
+	"cls Wd, Wn",
+	"cls Xd, Xn",
+	"cls Zd.B, Pg/M, Zn.B (g < 8)",
+	"cls Zd.H, Pg/M, Zn.H (g < 8)",
+	"cls Zd.S, Pg/M, Zn.S (g < 8)",
+	"cls Zd.D, Pg/M, Zn.D (g < 8)",
+	"clz Vd.16B, Vn.16B\nclz Vd.8B, Vn.8B",
+	"clz Vd.8H, Vn.8H\nclz Vd.4H, Vn.4H",
+	"clz Vd.4S, Vn.4S\nclz Vd.2S, Vn.2S",
+	"clz Wd, Wn",
+	"clz Xd, Xn",
+	"clz Zd.B, Pg/M, Zn.B (g < 8)",
+	"clz Zd.H, Pg/M, Zn.H (g < 8)",
+	"clz Zd.S, Pg/M, Zn.S (g < 8)",
+	"clz Zd.D, Pg/M, Zn.D (g < 8)",
+	"cmeq Dd, Dn, Dm",
+	"cmeq Vd.16B, Vn.16B, Vm.16B\ncmeq Vd.8B, Vn.8B, Vm.8B",
+	"cmeq Vd.8H, Vn.8H, Vm.8H\ncmeq Vd.4H, Vn.4H, Vm.4H",
+	"cmeq Vd.4S, Vn.4S, Vm.4S\ncmeq Vd.2S, Vn.2S, Vm.2S",
+	"cmeq Vd.2D, Vn.2D, Vm.2D",
+	"cmeq Dd, Dn, #0",
+	"cmeq Vd.16B, Vn.16B, #0\ncmeq Vd.8B, Vn.8B, #0",
+	"cmeq Vd.8H, Vn.8H, #0\ncmeq Vd.4H, Vn.4H, #0",
+	"cmeq Vd.4S, Vn.4S, #0\ncmeq Vd.2S, Vn.2S, #0",
+	"cmeq Vd.2D, Vn.2D, #0",
+	"cmge Dd, Dn, Dm",
+	"cmge Vd.16B, Vn.16B, Vm.16B\ncmge Vd.8B, Vn.8B, Vm.8B",
+	"cmge Vd.8H, Vn.8H, Vm.8H\ncmge Vd.4H, Vn.4H, Vm.4H",
+	"cmge Vd.4S, Vn.4S, Vm.4S\ncmge Vd.2S, Vn.2S, Vm.2S",
+	"cmge Vd.2D, Vn.2D, Vm.2D",
+	"cmge Dd, Dn, #0",
+	"cmge Vd.16B, Vn.16B, #0\ncmge Vd.8B, Vn.8B, #0",
+	"cmge Vd.8H, Vn.8H, #0\ncmge Vd.4H, Vn.4H, #0",
+	"cmge Vd.4S, Vn.4S, #0\ncmge Vd.2S, Vn.2S, #0",
+	"cmge Vd.2D, Vn.2D, #0",
+	"cmgt Dd, Dn, Dm",
+	"cmgt Vd.16B, Vn.16B, Vm.16B\ncmgt Vd.8B, Vn.8B, Vm.8B",
+	"cmgt Vd.8H, Vn.8H, Vm.8H\ncmgt Vd.4H, Vn.4H, Vm.4H",
+	"cmgt Vd.4S, Vn.4S, Vm.4S\ncmgt Vd.2S, Vn.2S, Vm.2S",
+	"cmgt Vd.2D, Vn.2D, Vm.2D",
+	"cmgt Dd, Dn, #0",
+	"cmgt Vd.16B, Vn.16B, #0\ncmgt Vd.8B, Vn.8B, #0",
+	"cmgt Vd.8H, Vn.8H, #0\ncmgt Vd.4H, Vn.4H, #0",
+	"cmgt Vd.4S, Vn.4S, #0\ncmgt Vd.2S, Vn.2S, #0",
+	"cmgt Vd.2D, Vn.2D, #0",
+	"cmhi Dd, Dn, Dm",
+	"cmhi Vd.16B, Vn.16B, Vm.16B\ncmhi Vd.8B, Vn.8B, Vm.8B",
+	"cmhi Vd.8H, Vn.8H, Vm.8H\ncmhi Vd.4H, Vn.4H, Vm.4H",
+	"cmhi Vd.4S, Vn.4S, Vm.4S\ncmhi Vd.2S, Vn.2S, Vm.2S",
+	"cmhi Vd.2D, Vn.2D, Vm.2D",
+	"cmhs Dd, Dn, Dm",
+	"cmhs Vd.16B, Vn.16B, Vm.16B\ncmhs Vd.8B, Vn.8B, Vm.8B",
+	"cmhs Vd.8H, Vn.8H, Vm.8H\ncmhs Vd.4H, Vn.4H, Vm.4H",
+	"cmhs Vd.4S, Vn.4S, Vm.4S\ncmhs Vd.2S, Vn.2S, Vm.2S",
+	"cmhs Vd.2D, Vn.2D, Vm.2D",
+	"cmle Dd, Dn, #0",
+	"cmle Vd.16B, Vn.16B, #0\ncmle Vd.8B, Vn.8B, #0",
+	"cmle Vd.8H, Vn.8H, #0\ncmle Vd.4H, Vn.4H, #0",
+	"cmle Vd.4S, Vn.4S, #0\ncmle Vd.2S, Vn.2S, #0",
+	"cmle Vd.2D, Vn.2D, #0",
+	"cmlt Dd, Dn, #0",
+	"cmlt Vd.16B, Vn.16B, #0\ncmlt Vd.8B, Vn.8B, #0",
+	"cmlt Vd.8H, Vn.8H, #0\ncmlt Vd.4H, Vn.4H, #0",
+	"cmlt Vd.4S, Vn.4S, #0\ncmlt Vd.2S, Vn.2S, #0",
+	"cmlt Vd.2D, Vn.2D, #0",
+	"cmn Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"cmn Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"cmn Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4)",
+	"cmn Xd|SP, Wn, UXT[BHW]|SXT[BHW] #imm (0 <= imm <= 4)",
+	"cmn Xd|SP, Xn {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4)",
+	"cmn Wd|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"cmn Xd|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"cmp Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"cmp Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"cmp Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4)",
+	"cmp Xd|SP, Wn, UXT[BHW]|SXT[BHW] #imm (0 <= imm <= 4)",
+	"cmp Xd|SP, Xn {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4)",
+	"cmp Wd|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"cmp Xd|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"cmpeq Pd.B, Pg/Z, Zn.B, Zm.B (g < 8)",
+	"cmpeq Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"cmpeq Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"cmpeq Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"cmpeq Pd.B, Pg/Z, Zn.B, #imm (g < 8, -16 <= imm < 16)",
+	"cmpeq Pd.H, Pg/Z, Zn.H, #imm (g < 8, -16 <= imm < 16)",
+	"cmpeq Pd.S, Pg/Z, Zn.S, #imm (g < 8, -16 <= imm < 16)",
+	"cmpeq Pd.D, Pg/Z, Zn.D, #imm (g < 8, -16 <= imm < 16)",
+	"cmpge Pd.B, Pg/Z, Zn.B, Zm.B (g < 8)",
+	"cmpge Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"cmpge Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"cmpge Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"cmpge Pd.B, Pg/Z, Zn.B, #imm (g < 8, -16 <= imm < 16)",
+	"cmpge Pd.H, Pg/Z, Zn.H, #imm (g < 8, -16 <= imm < 16)",
+	"cmpge Pd.S, Pg/Z, Zn.S, #imm (g < 8, -16 <= imm < 16)",
+	"cmpge Pd.D, Pg/Z, Zn.D, #imm (g < 8, -16 <= imm < 16)",
+	"cmpgt Pd.B, Pg/Z, Zn.B, Zm.B (g < 8)",
+	"cmpgt Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"cmpgt Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"cmpgt Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"cmpgt Pd.B, Pg/Z, Zn.B, #imm (g < 8, -16 <= imm < 16)",
+	"cmpgt Pd.H, Pg/Z, Zn.H, #imm (g < 8, -16 <= imm < 16)",
+	"cmpgt Pd.S, Pg/Z, Zn.S, #imm (g < 8, -16 <= imm < 16)",
+	"cmpgt Pd.D, Pg/Z, Zn.D, #imm (g < 8, -16 <= imm < 16)",
+	"cmphi Pd.B, Pg/Z, Zn.B, Zm.B (g < 8)",
+	"cmphi Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"cmphi Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"cmphi Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"cmphi Pd.B, Pg/Z, Zn.B, #imm (g < 8, 0 <= imm < 128)",
+	"cmphi Pd.H, Pg/Z, Zn.H, #imm (g < 8, 0 <= imm < 128)",
+	"cmphi Pd.S, Pg/Z, Zn.S, #imm (g < 8, 0 <= imm < 128)",
+	"cmphi Pd.D, Pg/Z, Zn.D, #imm (g < 8, 0 <= imm < 128)",
+	"cmphs Pd.B, Pg/Z, Zn.B, Zm.B (g < 8)",
+	"cmphs Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"cmphs Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"cmphs Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"cmphs Pd.B, Pg/Z, Zn.B, #imm (g < 8, 0 <= imm < 128)",
+	"cmphs Pd.H, Pg/Z, Zn.H, #imm (g < 8, 0 <= imm < 128)",
+	"cmphs Pd.S, Pg/Z, Zn.S, #imm (g < 8, 0 <= imm < 128)",
+	"cmphs Pd.D, Pg/Z, Zn.D, #imm (g < 8, 0 <= imm < 128)",
+	"cmple Pd.B, Pg/Z, Zn.B, #imm (g < 8, -16 <= imm < 16)",
+	"cmple Pd.H, Pg/Z, Zn.H, #imm (g < 8, -16 <= imm < 16)",
+	"cmple Pd.S, Pg/Z, Zn.S, #imm (g < 8, -16 <= imm < 16)",
+	"cmple Pd.D, Pg/Z, Zn.D, #imm (g < 8, -16 <= imm < 16)",
+	"cmplo Pd.B, Pg/Z, Zn.B, #imm (g < 8, 0 <= imm < 128)",
+	"cmplo Pd.H, Pg/Z, Zn.H, #imm (g < 8, 0 <= imm < 128)",
+	"cmplo Pd.S, Pg/Z, Zn.S, #imm (g < 8, 0 <= imm < 128)",
+	"cmplo Pd.D, Pg/Z, Zn.D, #imm (g < 8, 0 <= imm < 128)",
+	"cmpls Pd.B, Pg/Z, Zn.B, #imm (g < 8, 0 <= imm < 128)",
+	"cmpls Pd.H, Pg/Z, Zn.H, #imm (g < 8, 0 <= imm < 128)",
+	"cmpls Pd.S, Pg/Z, Zn.S, #imm (g < 8, 0 <= imm < 128)",
+	"cmpls Pd.D, Pg/Z, Zn.D, #imm (g < 8, 0 <= imm < 128)",
+	"cmplt Pd.B, Pg/Z, Zn.B, #imm (g < 8, -16 <= imm < 16)",
+	"cmplt Pd.H, Pg/Z, Zn.H, #imm (g < 8, -16 <= imm < 16)",
+	"cmplt Pd.S, Pg/Z, Zn.S, #imm (g < 8, -16 <= imm < 16)",
+	"cmplt Pd.D, Pg/Z, Zn.D, #imm (g < 8, -16 <= imm < 16)",
+	"cmpne Pd.B, Pg/Z, Zn.B, Zm.B (g < 8)",
+	"cmpne Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"cmpne Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"cmpne Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"cmpne Pd.B, Pg/Z, Zn.B, #imm (g < 8, -16 <= imm < 16)",
+	"cmpne Pd.H, Pg/Z, Zn.H, #imm (g < 8, -16 <= imm < 16)",
+	"cmpne Pd.S, Pg/Z, Zn.S, #imm (g < 8, -16 <= imm < 16)",
+	"cmpne Pd.D, Pg/Z, Zn.D, #imm (g < 8, -16 <= imm < 16)",
+	"cmtst Dd, Dn, Dm",
+	"cmtst Vd.16B, Vn.16B, Vm.16B\ncmtst Vd.8B, Vn.8B, Vm.8B",
+	"cmtst Vd.8H, Vn.8H, Vm.8H\ncmtst Vd.4H, Vn.4H, Vm.4H",
+	"cmtst Vd.4S, Vn.4S, Vm.4S\ncmtst Vd.2S, Vn.2S, Vm.2S",
+	"cmtst Vd.2D, Vn.2D, Vm.2D",
+	"cneg Wd, Wn, <cond>",
+	"cneg Xd, Xn, <cond>",
+	"cnt Vd.16B, Vn.16B\ncnt Vd.8B, Vn.8B",
+	"cnt Wd, Wn",
+	"cnt Xd, Xn",
+	"cnt Zd.B, Pg/M, Zn.B (g < 8)",
+	"cnt Zd.H, Pg/M, Zn.H (g < 8)",
+	"cnt Zd.S, Pg/M, Zn.S (g < 8)",
+	"cnt Zd.D, Pg/M, Zn.D (g < 8)",
+	"cntb Xd",
+	"cntb Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"cntd Xd",
+	"cntd Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"cnth Xd",
+	"cnth Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"cntw Xd",
+	"cntw Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"compact Zd.S, Pg, Zn.S (g < 8)",
+	"compact Zd.D, Pg, Zn.D (g < 8)",
+	"cpp RCTX, Xn",
+	"cpye [Xd]!, [Xn]!, Xm",
+	"cpyfe [Xd]!, [Xn]!, Xm",
+	"cpyfm [Xd]!, [Xn]!, Xm",
+	"cpyfp [Xd]!, [Xn]!, Xm",
+	"cpym [Xd]!, [Xn]!, Xm",
+	"cpyp [Xd]!, [Xn]!, Xm",
+	"crc32b Wd, Wn, Wm",
+	"crc32cb Wd, Wn, Wm",
+	"crc32ch Wd, Wn, Wm",
+	"crc32cw Wd, Wn, Wm",
+	"crc32cx Wd, Wn, Xm",
+	"crc32h Wd, Wn, Wm",
+	"crc32w Wd, Wn, Wm",
+	"crc32x Wd, Wn, Xm",
+	"csdb",
+	"csel Wd, Wn, Wm, <cond>",
+	"csel Xd, Xn, Xm, <cond>",
+	"cset Wd, <cond>",
+	"cset Xd, <cond>",
+	"csetm Wd, <cond>",
+	"csetm Xd, <cond>",
+	"csinc Wd, Wn, Wm, <cond>",
+	"csinc Xd, Xn, Xm, <cond>",
+	"csinv Wd, Wn, Wm, <cond>",
+	"csinv Xd, Xn, Xm, <cond>",
+	"csneg Wd, Wn, Wm, <cond>",
+	"csneg Xd, Xn, Xm, <cond>",
+	"ctz Wd, Wn",
+	"ctz Xd, Xn",
+	"dc <symbol>, Xn",
+	"dcps1  {, #imm } (0 <= imm < 65536)",
+	"dcps2  {, #imm } (0 <= imm < 65536)",
+	"dcps3  {, #imm } (0 <= imm < 65536)",
+	"decb Xd",
+	"decb Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"decd Xd",
+	"decd Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"dech Xd",
+	"dech Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"decw Xd",
+	"decw Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"dgh",
+	"dmb <symbol>",
+	"dmb #imm (0 <= imm < 16)",
+	"drps",
+	"dsb <symbol>",
+	"dsb #imm (0 <= imm < 16)",
+	"dup Bd, Vn.B[i]",
+	"dup Hd, Vn.H[i]",
+	"dup Sd, Vn.S[i]",
+	"dup Dd, Vn.D[i]",
+	"dup Vd.16B, Vn.B[i]\ndup Vd.8B, Vn.B[i]",
+	"dup Vd.8H, Vn.H[i]\ndup Vd.4H, Vn.H[i]",
+	"dup Vd.4S, Vn.S[i]\ndup Vd.2S, Vn.S[i]",
+	"dup Vd.2D, Vn.D[i]",
+	"dup Vd.16B, Wn\ndup Vd.8B, Wn",
+	"dup Vd.8H, Wn\ndup Vd.4H, Wn",
+	"dup Vd.4S, Wn\ndup Vd.2S, Wn",
+	"dup Vd.2D, Xn",
+	"dup Zd.B, Wn|WSP",
+	"dup Zd.H, Wn|WSP",
+	"dup Zd.S, Wn|WSP",
+	"dup Zd.D, Xn|SP",
+	"dup Zd.B, #imm (-128 <= imm < 128)",
+	"dup Zd.H, #imm1 {, LSL #imm2 } (-128 <= imm1 < 128, imm2 in [0, 8])",
+	"dup Zd.S, #imm1 {, LSL #imm2 } (-128 <= imm1 < 128, imm2 in [0, 8])",
+	"dup Zd.D, #imm1 {, LSL #imm2 } (-128 <= imm1 < 128, imm2 in [0, 8])",
+	"dvp RCTX, Xn",
+	"eon Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"eon Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"eor Vd.16B, Vn.16B, Vm.16B\neor Vd.8B, Vn.8B, Vm.8B",
+	"eor Wd|WSP, Wn, #imm (imm is 32-bit logical)",
+	"eor Xd|SP, Xn, #imm (imm is 64-bit logical)",
+	"eor Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"eor Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"eor Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"eor Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"eor Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"eor Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"eor Zd.D, Zn.D, Zm.D",
+	"eor Zd.S, Zn.S, #imm (n == d, imm is 32-bit logical)",
+	"eor Zd.D, Zn.D, #imm (n == d, imm is 64-bit logical)",
+	"eor3 Vd.16B, Vn.16B, Vm.16B, Va.16B",
+	"eor3 Zd.D, Zn.D, Zm.D, Za.D (n == d)",
+	"eorv Bd, Pg, Zn.B (g < 8)",
+	"eorv Hd, Pg, Zn.H (g < 8)",
+	"eorv Sd, Pg, Zn.S (g < 8)",
+	"eorv Dd, Pg, Zn.D (g < 8)",
+	"eret",
+	"eretaa",
+	"eretab",
+	"esb",
+	"ext Vd.8B, Vn.8B, Vm.8B, #imm (0 <= imm < 8)",
+	"ext Vd.16B, Vn.16B, Vm.16B, #imm (0 <= imm < 16)",
+	"ext Zd.B, Zn.B, Zm.B, #imm (n == d, 0 <= imm < 256)",
+	"extr Wd, Wn, Wm, #imm (0 <= imm < 32)",
+	"extr Xd, Xn, Xm, #imm (0 <= imm < 64)",
+	"fabd Hd, Hn, Hm",
+	"fabd Sd, Sn, Sm",
+	"fabd Dd, Dn, Dm",
+	"fabd Vd.8H, Vn.8H, Vm.8H\nfabd Vd.4H, Vn.4H, Vm.4H",
+	"fabd Vd.4S, Vn.4S, Vm.4S\nfabd Vd.2S, Vn.2S, Vm.2S",
+	"fabd Vd.2D, Vn.2D, Vm.2D",
+	"fabd Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fabd Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fabd Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fabs Vd.8H, Vn.8H\nfabs Vd.4H, Vn.4H",
+	"fabs Vd.4S, Vn.4S\nfabs Vd.2S, Vn.2S",
+	"fabs Vd.2D, Vn.2D",
+	"fabs Hd, Hn",
+	"fabs Sd, Sn",
+	"fabs Dd, Dn",
+	"fabs Zd.H, Pg/M, Zn.H (g < 8)",
+	"fabs Zd.S, Pg/M, Zn.S (g < 8)",
+	"fabs Zd.D, Pg/M, Zn.D (g < 8)",
+	"facge Hd, Hn, Hm",
+	"facge Sd, Sn, Sm",
+	"facge Dd, Dn, Dm",
+	"facge Vd.8H, Vn.8H, Vm.8H\nfacge Vd.4H, Vn.4H, Vm.4H",
+	"facge Vd.4S, Vn.4S, Vm.4S\nfacge Vd.2S, Vn.2S, Vm.2S",
+	"facge Vd.2D, Vn.2D, Vm.2D",
+	"facgt Hd, Hn, Hm",
+	"facgt Sd, Sn, Sm",
+	"facgt Dd, Dn, Dm",
+	"facgt Vd.8H, Vn.8H, Vm.8H\nfacgt Vd.4H, Vn.4H, Vm.4H",
+	"facgt Vd.4S, Vn.4S, Vm.4S\nfacgt Vd.2S, Vn.2S, Vm.2S",
+	"facgt Vd.2D, Vn.2D, Vm.2D",
+	"fadd Vd.8H, Vn.8H, Vm.8H\nfadd Vd.4H, Vn.4H, Vm.4H",
+	"fadd Vd.4S, Vn.4S, Vm.4S\nfadd Vd.2S, Vn.2S, Vm.2S",
+	"fadd Vd.2D, Vn.2D, Vm.2D",
+	"fadd Hd, Hn, Hm",
+	"fadd Sd, Sn, Sm",
+	"fadd Dd, Dn, Dm",
+	"fadd Zd.H, Zn.H, Zm.H",
+	"fadd Zd.S, Zn.S, Zm.S",
+	"fadd Zd.D, Zn.D, Zm.D",
+	"fadd Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fadd Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fadd Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"faddp Hd, Vn.2H",
+	"faddp Sd, Vn.2S",
+	"faddp Dd, Vn.2D",
+	"faddp Vd.8H, Vn.8H, Vm.8H\nfaddp Vd.4H, Vn.4H, Vm.4H",
+	"faddp Vd.4S, Vn.4S, Vm.4S\nfaddp Vd.2S, Vn.2S, Vm.2S",
+	"faddp Vd.2D, Vn.2D, Vm.2D",
+	"faddv Hd, Pg, Zn.H (g < 8)",
+	"faddv Sd, Pg, Zn.S (g < 8)",
+	"faddv Dd, Pg, Zn.D (g < 8)",
+	"fcadd Vd.8H, Vn.8H, Vm.8H, #imm (imm in [90, 270])\nfcadd Vd.4H, Vn.4H, Vm.4H, #imm (imm in [90, 270])",
+	"fcadd Vd.4S, Vn.4S, Vm.4S, #imm (imm in [90, 270])\nfcadd Vd.2S, Vn.2S, Vm.2S, #imm (imm in [90, 270])",
+	"fcadd Vd.2D, Vn.2D, Vm.2D, #imm (imm in [90, 270])",
+	"fccmp Hd, Hn, #imm, <cond> (0 <= imm < 16)",
+	"fccmp Sd, Sn, #imm, <cond> (0 <= imm < 16)",
+	"fccmp Dd, Dn, #imm, <cond> (0 <= imm < 16)",
+	"fccmpe Hd, Hn, #imm, <cond> (0 <= imm < 16)",
+	"fccmpe Sd, Sn, #imm, <cond> (0 <= imm < 16)",
+	"fccmpe Dd, Dn, #imm, <cond> (0 <= imm < 16)",
+	"fcmeq Hd, Hn, Hm",
+	"fcmeq Sd, Sn, Sm",
+	"fcmeq Dd, Dn, Dm",
+	"fcmeq Vd.8H, Vn.8H, Vm.8H\nfcmeq Vd.4H, Vn.4H, Vm.4H",
+	"fcmeq Vd.4S, Vn.4S, Vm.4S\nfcmeq Vd.2S, Vn.2S, Vm.2S",
+	"fcmeq Vd.2D, Vn.2D, Vm.2D",
+	"fcmeq Hd, Hn, #0.0",
+	"fcmeq Sd, Sn, #0.0",
+	"fcmeq Dd, Dn, #0.0",
+	"fcmeq Vd.8H, Vn.8H, #0.0\nfcmeq Vd.4H, Vn.4H, #0.0",
+	"fcmeq Vd.4S, Vn.4S, #0.0\nfcmeq Vd.2S, Vn.2S, #0.0",
+	"fcmeq Vd.2D, Vn.2D, #0.0",
+	"fcmeq Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"fcmeq Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"fcmeq Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"fcmge Hd, Hn, Hm",
+	"fcmge Sd, Sn, Sm",
+	"fcmge Dd, Dn, Dm",
+	"fcmge Vd.8H, Vn.8H, Vm.8H\nfcmge Vd.4H, Vn.4H, Vm.4H",
+	"fcmge Vd.4S, Vn.4S, Vm.4S\nfcmge Vd.2S, Vn.2S, Vm.2S",
+	"fcmge Vd.2D, Vn.2D, Vm.2D",
+	"fcmge Hd, Hn, #0.0",
+	"fcmge Sd, Sn, #0.0",
+	"fcmge Dd, Dn, #0.0",
+	"fcmge Vd.8H, Vn.8H, #0.0\nfcmge Vd.4H, Vn.4H, #0.0",
+	"fcmge Vd.4S, Vn.4S, #0.0\nfcmge Vd.2S, Vn.2S, #0.0",
+	"fcmge Vd.2D, Vn.2D, #0.0",
+	"fcmge Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"fcmge Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"fcmge Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"fcmgt Hd, Hn, Hm",
+	"fcmgt Sd, Sn, Sm",
+	"fcmgt Dd, Dn, Dm",
+	"fcmgt Vd.8H, Vn.8H, Vm.8H\nfcmgt Vd.4H, Vn.4H, Vm.4H",
+	"fcmgt Vd.4S, Vn.4S, Vm.4S\nfcmgt Vd.2S, Vn.2S, Vm.2S",
+	"fcmgt Vd.2D, Vn.2D, Vm.2D",
+	"fcmgt Hd, Hn, #0.0",
+	"fcmgt Sd, Sn, #0.0",
+	"fcmgt Dd, Dn, #0.0",
+	"fcmgt Vd.8H, Vn.8H, #0.0\nfcmgt Vd.4H, Vn.4H, #0.0",
+	"fcmgt Vd.4S, Vn.4S, #0.0\nfcmgt Vd.2S, Vn.2S, #0.0",
+	"fcmgt Vd.2D, Vn.2D, #0.0",
+	"fcmgt Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"fcmgt Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"fcmgt Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"fcmla Vd.4H, Vn.4H, Vm.H[i], #imm (imm in [0, 90, 180, 270])",
+	"fcmla Vd.8H, Vn.8H, Vm.H[i], #imm (imm in [0, 90, 180, 270])",
+	"fcmla Vd.4S, Vn.4S, Vm.S[i], #imm (imm in [0, 90, 180, 270])",
+	"fcmla Vd.8H, Vn.8H, Vm.8H, #imm (imm in [0, 90, 180, 270])\nfcmla Vd.4H, Vn.4H, Vm.4H, #imm (imm in [0, 90, 180, 270])",
+	"fcmla Vd.4S, Vn.4S, Vm.4S, #imm (imm in [0, 90, 180, 270])\nfcmla Vd.2S, Vn.2S, Vm.2S, #imm (imm in [0, 90, 180, 270])",
+	"fcmla Vd.2D, Vn.2D, Vm.2D, #imm (imm in [0, 90, 180, 270])",
+	"fcmle Hd, Hn, #0.0",
+	"fcmle Sd, Sn, #0.0",
+	"fcmle Dd, Dn, #0.0",
+	"fcmle Vd.8H, Vn.8H, #0.0\nfcmle Vd.4H, Vn.4H, #0.0",
+	"fcmle Vd.4S, Vn.4S, #0.0\nfcmle Vd.2S, Vn.2S, #0.0",
+	"fcmle Vd.2D, Vn.2D, #0.0",
+	"fcmlt Hd, Hn, #0.0",
+	"fcmlt Sd, Sn, #0.0",
+	"fcmlt Dd, Dn, #0.0",
+	"fcmlt Vd.8H, Vn.8H, #0.0\nfcmlt Vd.4H, Vn.4H, #0.0",
+	"fcmlt Vd.4S, Vn.4S, #0.0\nfcmlt Vd.2S, Vn.2S, #0.0",
+	"fcmlt Vd.2D, Vn.2D, #0.0",
+	"fcmne Pd.H, Pg/Z, Zn.H, Zm.H (g < 8)",
+	"fcmne Pd.S, Pg/Z, Zn.S, Zm.S (g < 8)",
+	"fcmne Pd.D, Pg/Z, Zn.D, Zm.D (g < 8)",
+	"fcmp Hd, Hn",
+	"fcmp Hd, #0.0",
+	"fcmp Sd, Sn",
+	"fcmp Sd, #0.0",
+	"fcmp Dd, Dn",
+	"fcmp Dd, #0.0",
+	"fcmpe Hd, Hn",
+	"fcmpe Hd, #0.0",
+	"fcmpe Sd, Sn",
+	"fcmpe Sd, #0.0",
+	"fcmpe Dd, Dn",
+	"fcmpe Dd, #0.0",
+	"fcsel Hd, Hn, Hm, <cond>",
+	"fcsel Sd, Sn, Sm, <cond>",
+	"fcsel Dd, Dn, Dm, <cond>",
+	"fcvt Sd, Hn",
+	"fcvt Dd, Hn",
+	"fcvt Hd, Sn",
+	"fcvt Dd, Sn",
+	"fcvt Hd, Dn",
+	"fcvt Sd, Dn",
+	"fcvtas Hd, Hn",
+	"fcvtas Sd, Sn",
+	"fcvtas Dd, Dn",
+	"fcvtas Vd.8H, Vn.8H\nfcvtas Vd.4H, Vn.4H",
+	"fcvtas Vd.4S, Vn.4S\nfcvtas Vd.2S, Vn.2S",
+	"fcvtas Vd.2D, Vn.2D",
+	"fcvtas Wd, Hn",
+	"fcvtas Xd, Hn",
+	"fcvtas Wd, Sn",
+	"fcvtas Xd, Sn",
+	"fcvtas Wd, Dn",
+	"fcvtas Xd, Dn",
+	"fcvtau Hd, Hn",
+	"fcvtau Sd, Sn",
+	"fcvtau Dd, Dn",
+	"fcvtau Vd.8H, Vn.8H\nfcvtau Vd.4H, Vn.4H",
+	"fcvtau Vd.4S, Vn.4S\nfcvtau Vd.2S, Vn.2S",
+	"fcvtau Vd.2D, Vn.2D",
+	"fcvtau Wd, Hn",
+	"fcvtau Xd, Hn",
+	"fcvtau Wd, Sn",
+	"fcvtau Xd, Sn",
+	"fcvtau Wd, Dn",
+	"fcvtau Xd, Dn",
+	"fcvtl Vd.4S, Vn.4H",
+	"fcvtl Vd.2D, Vn.2S",
+	"fcvtl2 Vd.4S, Vn.8H",
+	"fcvtl2 Vd.2D, Vn.4S",
+	"fcvtms Hd, Hn",
+	"fcvtms Sd, Sn",
+	"fcvtms Dd, Dn",
+	"fcvtms Vd.8H, Vn.8H\nfcvtms Vd.4H, Vn.4H",
+	"fcvtms Vd.4S, Vn.4S\nfcvtms Vd.2S, Vn.2S",
+	"fcvtms Vd.2D, Vn.2D",
+	"fcvtms Wd, Hn",
+	"fcvtms Xd, Hn",
+	"fcvtms Wd, Sn",
+	"fcvtms Xd, Sn",
+	"fcvtms Wd, Dn",
+	"fcvtms Xd, Dn",
+	"fcvtmu Hd, Hn",
+	"fcvtmu Sd, Sn",
+	"fcvtmu Dd, Dn",
+	"fcvtmu Vd.8H, Vn.8H\nfcvtmu Vd.4H, Vn.4H",
+	"fcvtmu Vd.4S, Vn.4S\nfcvtmu Vd.2S, Vn.2S",
+	"fcvtmu Vd.2D, Vn.2D",
+	"fcvtmu Wd, Hn",
+	"fcvtmu Xd, Hn",
+	"fcvtmu Wd, Sn",
+	"fcvtmu Xd, Sn",
+	"fcvtmu Wd, Dn",
+	"fcvtmu Xd, Dn",
+	"fcvtn Vd.2S, Vn.2D",
+	"fcvtn2 Vd.4S, Vn.2D",
+	"fcvtns Hd, Hn",
+	"fcvtns Sd, Sn",
+	"fcvtns Dd, Dn",
+	"fcvtns Vd.8H, Vn.8H\nfcvtns Vd.4H, Vn.4H",
+	"fcvtns Vd.4S, Vn.4S\nfcvtns Vd.2S, Vn.2S",
+	"fcvtns Vd.2D, Vn.2D",
+	"fcvtns Wd, Hn",
+	"fcvtns Xd, Hn",
+	"fcvtns Wd, Sn",
+	"fcvtns Xd, Sn",
+	"fcvtns Wd, Dn",
+	"fcvtns Xd, Dn",
+	"fcvtnu Hd, Hn",
+	"fcvtnu Sd, Sn",
+	"fcvtnu Dd, Dn",
+	"fcvtnu Vd.8H, Vn.8H\nfcvtnu Vd.4H, Vn.4H",
+	"fcvtnu Vd.4S, Vn.4S\nfcvtnu Vd.2S, Vn.2S",
+	"fcvtnu Vd.2D, Vn.2D",
+	"fcvtnu Wd, Hn",
+	"fcvtnu Xd, Hn",
+	"fcvtnu Wd, Sn",
+	"fcvtnu Xd, Sn",
+	"fcvtnu Wd, Dn",
+	"fcvtnu Xd, Dn",
+	"fcvtps Hd, Hn",
+	"fcvtps Sd, Sn",
+	"fcvtps Dd, Dn",
+	"fcvtps Vd.8H, Vn.8H\nfcvtps Vd.4H, Vn.4H",
+	"fcvtps Vd.4S, Vn.4S\nfcvtps Vd.2S, Vn.2S",
+	"fcvtps Vd.2D, Vn.2D",
+	"fcvtps Wd, Hn",
+	"fcvtps Xd, Hn",
+	"fcvtps Wd, Sn",
+	"fcvtps Xd, Sn",
+	"fcvtps Wd, Dn",
+	"fcvtps Xd, Dn",
+	"fcvtpu Hd, Hn",
+	"fcvtpu Sd, Sn",
+	"fcvtpu Dd, Dn",
+	"fcvtpu Vd.8H, Vn.8H\nfcvtpu Vd.4H, Vn.4H",
+	"fcvtpu Vd.4S, Vn.4S\nfcvtpu Vd.2S, Vn.2S",
+	"fcvtpu Vd.2D, Vn.2D",
+	"fcvtpu Wd, Hn",
+	"fcvtpu Xd, Hn",
+	"fcvtpu Wd, Sn",
+	"fcvtpu Xd, Sn",
+	"fcvtpu Wd, Dn",
+	"fcvtpu Xd, Dn",
+	"fcvtxn Sd, Dn",
+	"fcvtxn Vd.2S, Vn.2D",
+	"fcvtxn2 Vd.4S, Vn.2D",
+	"fcvtzs Hd, Hn, #imm (0 < imm <= 16)",
+	"fcvtzs Sd, Sn, #imm (0 < imm <= 32)",
+	"fcvtzs Dd, Dn, #imm (0 < imm <= 64)",
+	"fcvtzs Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nfcvtzs Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"fcvtzs Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nfcvtzs Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"fcvtzs Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"fcvtzs Hd, Hn",
+	"fcvtzs Sd, Sn",
+	"fcvtzs Dd, Dn",
+	"fcvtzs Vd.8H, Vn.8H\nfcvtzs Vd.4H, Vn.4H",
+	"fcvtzs Vd.4S, Vn.4S\nfcvtzs Vd.2S, Vn.2S",
+	"fcvtzs Vd.2D, Vn.2D",
+	"fcvtzs Wd, Hn, #imm (0 < imm <= 32)",
+	"fcvtzs Xd, Hn, #imm (0 < imm <= 64)",
+	"fcvtzs Wd, Sn, #imm (0 < imm <= 32)",
+	"fcvtzs Xd, Sn, #imm (0 < imm <= 64)",
+	"fcvtzs Wd, Dn, #imm (0 < imm <= 32)",
+	"fcvtzs Xd, Dn, #imm (0 < imm <= 64)",
+	"fcvtzs Wd, Hn",
+	"fcvtzs Xd, Hn",
+	"fcvtzs Wd, Sn",
+	"fcvtzs Xd, Sn",
+	"fcvtzs Wd, Dn",
+	"fcvtzs Xd, Dn",
+	"fcvtzs Zd.H, Pg/M, Zn.H (g < 8)",
+	"fcvtzs Zd.S, Pg/M, Zn.S (g < 8)",
+	"fcvtzs Zd.D, Pg/M, Zn.D (g < 8)",
+	"fcvtzu Hd, Hn, #imm (0 < imm <= 16)",
+	"fcvtzu Sd, Sn, #imm (0 < imm <= 32)",
+	"fcvtzu Dd, Dn, #imm (0 < imm <= 64)",
+	"fcvtzu Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nfcvtzu Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"fcvtzu Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nfcvtzu Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"fcvtzu Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"fcvtzu Hd, Hn",
+	"fcvtzu Sd, Sn",
+	"fcvtzu Dd, Dn",
+	"fcvtzu Vd.8H, Vn.8H\nfcvtzu Vd.4H, Vn.4H",
+	"fcvtzu Vd.4S, Vn.4S\nfcvtzu Vd.2S, Vn.2S",
+	"fcvtzu Vd.2D, Vn.2D",
+	"fcvtzu Wd, Hn, #imm (0 < imm <= 32)",
+	"fcvtzu Xd, Hn, #imm (0 < imm <= 64)",
+	"fcvtzu Wd, Sn, #imm (0 < imm <= 32)",
+	"fcvtzu Xd, Sn, #imm (0 < imm <= 64)",
+	"fcvtzu Wd, Dn, #imm (0 < imm <= 32)",
+	"fcvtzu Xd, Dn, #imm (0 < imm <= 64)",
+	"fcvtzu Wd, Hn",
+	"fcvtzu Xd, Hn",
+	"fcvtzu Wd, Sn",
+	"fcvtzu Xd, Sn",
+	"fcvtzu Wd, Dn",
+	"fcvtzu Xd, Dn",
+	"fcvtzu Zd.H, Pg/M, Zn.H (g < 8)",
+	"fcvtzu Zd.S, Pg/M, Zn.S (g < 8)",
+	"fcvtzu Zd.D, Pg/M, Zn.D (g < 8)",
+	"fdiv Vd.8H, Vn.8H, Vm.8H\nfdiv Vd.4H, Vn.4H, Vm.4H",
+	"fdiv Vd.4S, Vn.4S, Vm.4S\nfdiv Vd.2S, Vn.2S, Vm.2S",
+	"fdiv Vd.2D, Vn.2D, Vm.2D",
+	"fdiv Hd, Hn, Hm",
+	"fdiv Sd, Sn, Sm",
+	"fdiv Dd, Dn, Dm",
+	"fdiv Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fdiv Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fdiv Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fdivr Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fdivr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fdivr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fdup Zd.H, #imm (imm is float)",
+	"fdup Zd.S, #imm (imm is float)",
+	"fdup Zd.D, #imm (imm is float)",
+	"fjcvtzs Wd, Dn",
+	"fmadd Hd, Hn, Hm, Ha",
+	"fmadd Sd, Sn, Sm, Sa",
+	"fmadd Dd, Dn, Dm, Da",
+	"fmax Vd.8H, Vn.8H, Vm.8H\nfmax Vd.4H, Vn.4H, Vm.4H",
+	"fmax Vd.4S, Vn.4S, Vm.4S\nfmax Vd.2S, Vn.2S, Vm.2S",
+	"fmax Vd.2D, Vn.2D, Vm.2D",
+	"fmax Hd, Hn, Hm",
+	"fmax Sd, Sn, Sm",
+	"fmax Dd, Dn, Dm",
+	"fmax Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fmax Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fmax Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fmaxnm Vd.8H, Vn.8H, Vm.8H\nfmaxnm Vd.4H, Vn.4H, Vm.4H",
+	"fmaxnm Vd.4S, Vn.4S, Vm.4S\nfmaxnm Vd.2S, Vn.2S, Vm.2S",
+	"fmaxnm Vd.2D, Vn.2D, Vm.2D",
+	"fmaxnm Hd, Hn, Hm",
+	"fmaxnm Sd, Sn, Sm",
+	"fmaxnm Dd, Dn, Dm",
+	"fmaxnm Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fmaxnm Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fmaxnm Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fmaxnmp Hd, Vn.2H",
+	"fmaxnmp Sd, Vn.2S",
+	"fmaxnmp Dd, Vn.2D",
+	"fmaxnmp Vd.8H, Vn.8H, Vm.8H\nfmaxnmp Vd.4H, Vn.4H, Vm.4H",
+	"fmaxnmp Vd.4S, Vn.4S, Vm.4S\nfmaxnmp Vd.2S, Vn.2S, Vm.2S",
+	"fmaxnmp Vd.2D, Vn.2D, Vm.2D",
+	"fmaxnmv Hd, Vn.8H\nfmaxnmv Hd, Vn.4H",
+	"fmaxnmv Sd, Vn.4S",
+	"fmaxnmv Hd, Pg, Zn.H (g < 8)",
+	"fmaxnmv Sd, Pg, Zn.S (g < 8)",
+	"fmaxnmv Dd, Pg, Zn.D (g < 8)",
+	"fmaxp Hd, Vn.2H",
+	"fmaxp Sd, Vn.2S",
+	"fmaxp Dd, Vn.2D",
+	"fmaxp Vd.8H, Vn.8H, Vm.8H\nfmaxp Vd.4H, Vn.4H, Vm.4H",
+	"fmaxp Vd.4S, Vn.4S, Vm.4S\nfmaxp Vd.2S, Vn.2S, Vm.2S",
+	"fmaxp Vd.2D, Vn.2D, Vm.2D",
+	"fmaxv Hd, Vn.8H\nfmaxv Hd, Vn.4H",
+	"fmaxv Sd, Vn.4S",
+	"fmaxv Hd, Pg, Zn.H (g < 8)",
+	"fmaxv Sd, Pg, Zn.S (g < 8)",
+	"fmaxv Dd, Pg, Zn.D (g < 8)",
+	"fmin Vd.8H, Vn.8H, Vm.8H\nfmin Vd.4H, Vn.4H, Vm.4H",
+	"fmin Vd.4S, Vn.4S, Vm.4S\nfmin Vd.2S, Vn.2S, Vm.2S",
+	"fmin Vd.2D, Vn.2D, Vm.2D",
+	"fmin Hd, Hn, Hm",
+	"fmin Sd, Sn, Sm",
+	"fmin Dd, Dn, Dm",
+	"fmin Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fmin Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fmin Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fminnm Vd.8H, Vn.8H, Vm.8H\nfminnm Vd.4H, Vn.4H, Vm.4H",
+	"fminnm Vd.4S, Vn.4S, Vm.4S\nfminnm Vd.2S, Vn.2S, Vm.2S",
+	"fminnm Vd.2D, Vn.2D, Vm.2D",
+	"fminnm Hd, Hn, Hm",
+	"fminnm Sd, Sn, Sm",
+	"fminnm Dd, Dn, Dm",
+	"fminnm Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fminnm Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fminnm Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fminnmp Hd, Vn.2H",
+	"fminnmp Sd, Vn.2S",
+	"fminnmp Dd, Vn.2D",
+	"fminnmp Vd.8H, Vn.8H, Vm.8H\nfminnmp Vd.4H, Vn.4H, Vm.4H",
+	"fminnmp Vd.4S, Vn.4S, Vm.4S\nfminnmp Vd.2S, Vn.2S, Vm.2S",
+	"fminnmp Vd.2D, Vn.2D, Vm.2D",
+	"fminnmv Hd, Vn.8H\nfminnmv Hd, Vn.4H",
+	"fminnmv Sd, Vn.4S",
+	"fminnmv Hd, Pg, Zn.H (g < 8)",
+	"fminnmv Sd, Pg, Zn.S (g < 8)",
+	"fminnmv Dd, Pg, Zn.D (g < 8)",
+	"fminp Hd, Vn.2H",
+	"fminp Sd, Vn.2S",
+	"fminp Dd, Vn.2D",
+	"fminp Vd.8H, Vn.8H, Vm.8H\nfminp Vd.4H, Vn.4H, Vm.4H",
+	"fminp Vd.4S, Vn.4S, Vm.4S\nfminp Vd.2S, Vn.2S, Vm.2S",
+	"fminp Vd.2D, Vn.2D, Vm.2D",
+	"fminv Hd, Vn.8H\nfminv Hd, Vn.4H",
+	"fminv Sd, Vn.4S",
+	"fminv Hd, Pg, Zn.H (g < 8)",
+	"fminv Sd, Pg, Zn.S (g < 8)",
+	"fminv Dd, Pg, Zn.D (g < 8)",
+	"fmla Hd, Hn, Vm.H[i] (m < 16)",
+	"fmla Sd, Sn, Vm.S[i]",
+	"fmla Dd, Dn, Vm.D[i]",
+	"fmla Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nfmla Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"fmla Vd.4S, Vn.4S, Vm.S[i]\nfmla Vd.2S, Vn.2S, Vm.S[i]",
+	"fmla Vd.2D, Vn.2D, Vm.D[i]",
+	"fmla Vd.8H, Vn.8H, Vm.8H\nfmla Vd.4H, Vn.4H, Vm.4H",
+	"fmla Vd.4S, Vn.4S, Vm.4S\nfmla Vd.2S, Vn.2S, Vm.2S",
+	"fmla Vd.2D, Vn.2D, Vm.2D",
+	"fmla Zd.H, Pg/M, Zn.H, Zm.H (g < 8)",
+	"fmla Zd.S, Pg/M, Zn.S, Zm.S (g < 8)",
+	"fmla Zd.D, Pg/M, Zn.D, Zm.D (g < 8)",
+	"fmla Zd.H, Zn.H, Zm.H[i] (m < 8)",
+	"fmla Zd.S, Zn.S, Zm.S[i] (m < 8)",
+	"fmla Zd.D, Zn.D, Zm.D[i] (m < 16)",
+	"fmlal Vd.2S, Vn.2H, Vm.H[i] (m < 16)",
+	"fmlal Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"fmlal Vd.2S, Vn.2H, Vm.2H",
+	"fmlal Vd.4S, Vn.4H, Vm.4H",
+	"fmlal2 Vd.2S, Vn.2H, Vm.H[i] (m < 16)",
+	"fmlal2 Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"fmlal2 Vd.2S, Vn.2H, Vm.2H",
+	"fmlal2 Vd.4S, Vn.4H, Vm.4H",
+	"fmls Hd, Hn, Vm.H[i] (m < 16)",
+	"fmls Sd, Sn, Vm.S[i]",
+	"fmls Dd, Dn, Vm.D[i]",
+	"fmls Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nfmls Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"fmls Vd.4S, Vn.4S, Vm.S[i]\nfmls Vd.2S, Vn.2S, Vm.S[i]",
+	"fmls Vd.2D, Vn.2D, Vm.D[i]",
+	"fmls Vd.8H, Vn.8H, Vm.8H\nfmls Vd.4H, Vn.4H, Vm.4H",
+	"fmls Vd.4S, Vn.4S, Vm.4S\nfmls Vd.2S, Vn.2S, Vm.2S",
+	"fmls Vd.2D, Vn.2D, Vm.2D",
+	"fmls Zd.H, Pg/M, Zn.H, Zm.H (g < 8)",
+	"fmls Zd.S, Pg/M, Zn.S, Zm.S (g < 8)",
+	"fmls Zd.D, Pg/M, Zn.D, Zm.D (g < 8)",
+	"fmls Zd.H, Zn.H, Zm.H[i] (m < 8)",
+	"fmls Zd.S, Zn.S, Zm.S[i] (m < 8)",
+	"fmls Zd.D, Zn.D, Zm.D[i] (m < 16)",
+	"fmlsl Vd.2S, Vn.2H, Vm.H[i] (m < 16)",
+	"fmlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"fmlsl Vd.2S, Vn.2H, Vm.2H",
+	"fmlsl Vd.4S, Vn.4H, Vm.4H",
+	"fmlsl2 Vd.2S, Vn.2H, Vm.H[i] (m < 16)",
+	"fmlsl2 Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"fmlsl2 Vd.2S, Vn.2H, Vm.2H",
+	"fmlsl2 Vd.4S, Vn.4H, Vm.4H",
+	"fmopa ZAd.S, Pg1/M, Pg2/M, Zn.S, Zm.S (d < 4, g1 < 8, g2 < 8)",
+	"fmopa ZAd.D, Pg1/M, Pg2/M, Zn.D, Zm.D (d < 8, g1 < 8, g2 < 8)",
+	"fmopa ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 4, g1 < 8, g2 < 8)",
+	"fmops ZAd.S, Pg1/M, Pg2/M, Zn.S, Zm.S (d < 4, g1 < 8, g2 < 8)",
+	"fmops ZAd.D, Pg1/M, Pg2/M, Zn.D, Zm.D (d < 8, g1 < 8, g2 < 8)",
+	"fmops ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 4, g1 < 8, g2 < 8)",
+	"fmov Vd.8H, #imm (imm is split float)\nfmov Vd.4H, #imm (imm is split float)",
+	"fmov Vd.4S, #imm (imm is split float)\nfmov Vd.2S, #imm (imm is split float)",
+	"fmov Vd.2D, #imm (imm is split float)",
+	"fmov Hd, Hn",
+	"fmov Sd, Sn",
+	"fmov Dd, Dn",
+	"fmov Wd, Hn",
+	"fmov Xd, Hn",
+	"fmov Hd, Wn",
+	"fmov Sd, Wn",
+	"fmov Wd, Sn",
+	"fmov Hd, Xn",
+	"fmov Dd, Xn",
+	"fmov Vd.D[1], Xn",
+	"fmov Xd, Dn",
+	"fmov Xd, Vn.D[1]",
+	"fmov Hd, #0.0",
+	"fmov Sd, #0.0",
+	"fmov Dd, #0.0",
+	"fmov Hd, #imm (imm is float)",
+	"fmov Sd, #imm (imm is float)",
+	"fmov Dd, #imm (imm is float)",
+	"fmov Zd.H, Pg/M, #0.0",
+	"fmov Zd.S, Pg/M, #0.0",
+	"fmov Zd.D, Pg/M, #0.0",
+	"fmov Zd.H, #0.0",
+	"fmov Zd.S, #0.0",
+	"fmov Zd.D, #0.0",
+	"fmov Zd.H, Pg/M, #imm (imm is float)",
+	"fmov Zd.S, Pg/M, #imm (imm is float)",
+	"fmov Zd.D, Pg/M, #imm (imm is float)",
+	"fmov Zd.H, #imm (imm is float)",
+	"fmov Zd.S, #imm (imm is float)",
+	"fmov Zd.D, #imm (imm is float)",
+	"fmsub Hd, Hn, Hm, Ha",
+	"fmsub Sd, Sn, Sm, Sa",
+	"fmsub Dd, Dn, Dm, Da",
+	"fmul Hd, Hn, Vm.H[i] (m < 16)",
+	"fmul Sd, Sn, Vm.S[i]",
+	"fmul Dd, Dn, Vm.D[i]",
+	"fmul Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nfmul Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"fmul Vd.4S, Vn.4S, Vm.S[i]\nfmul Vd.2S, Vn.2S, Vm.S[i]",
+	"fmul Vd.2D, Vn.2D, Vm.D[i]",
+	"fmul Vd.8H, Vn.8H, Vm.8H\nfmul Vd.4H, Vn.4H, Vm.4H",
+	"fmul Vd.4S, Vn.4S, Vm.4S\nfmul Vd.2S, Vn.2S, Vm.2S",
+	"fmul Vd.2D, Vn.2D, Vm.2D",
+	"fmul Hd, Hn, Hm",
+	"fmul Sd, Sn, Sm",
+	"fmul Dd, Dn, Dm",
+	"fmul Zd.H, Zn.H, Zm.H",
+	"fmul Zd.S, Zn.S, Zm.S",
+	"fmul Zd.D, Zn.D, Zm.D",
+	"fmul Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fmul Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fmul Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fmul Zd.H, Zn.H, Zm.H[i] (m < 8)",
+	"fmul Zd.S, Zn.S, Zm.S[i] (m < 8)",
+	"fmul Zd.D, Zn.D, Zm.D[i] (m < 16)",
+	"fmulx Hd, Hn, Vm.H[i] (m < 16)",
+	"fmulx Sd, Sn, Vm.S[i]",
+	"fmulx Dd, Dn, Vm.D[i]",
+	"fmulx Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nfmulx Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"fmulx Vd.4S, Vn.4S, Vm.S[i]\nfmulx Vd.2S, Vn.2S, Vm.S[i]",
+	"fmulx Vd.2D, Vn.2D, Vm.D[i]",
+	"fmulx Hd, Hn, Hm",
+	"fmulx Sd, Sn, Sm",
+	"fmulx Dd, Dn, Dm",
+	"fmulx Vd.8H, Vn.8H, Vm.8H\nfmulx Vd.4H, Vn.4H, Vm.4H",
+	"fmulx Vd.4S, Vn.4S, Vm.4S\nfmulx Vd.2S, Vn.2S, Vm.2S",
+	"fmulx Vd.2D, Vn.2D, Vm.2D",
+	"fmulx Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fmulx Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fmulx Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fneg Vd.8H, Vn.8H\nfneg Vd.4H, Vn.4H",
+	"fneg Vd.4S, Vn.4S\nfneg Vd.2S, Vn.2S",
+	"fneg Vd.2D, Vn.2D",
+	"fneg Hd, Hn",
+	"fneg Sd, Sn",
+	"fneg Dd, Dn",
+	"fneg Zd.H, Pg/M, Zn.H (g < 8)",
+	"fneg Zd.S, Pg/M, Zn.S (g < 8)",
+	"fneg Zd.D, Pg/M, Zn.D (g < 8)",
+	"fnmadd Hd, Hn, Hm, Ha",
+	"fnmadd Sd, Sn, Sm, Sa",
+	"fnmadd Dd, Dn, Dm, Da",
+	"fnmla Zd.H, Pg/M, Zn.H, Zm.H (g < 8)",
+	"fnmla Zd.S, Pg/M, Zn.S, Zm.S (g < 8)",
+	"fnmla Zd.D, Pg/M, Zn.D, Zm.D (g < 8)",
+	"fnmls Zd.H, Pg/M, Zn.H, Zm.H (g < 8)",
+	"fnmls Zd.S, Pg/M, Zn.S, Zm.S (g < 8)",
+	"fnmls Zd.D, Pg/M, Zn.D, Zm.D (g < 8)",
+	"fnmsub Hd, Hn, Hm, Ha",
+	"fnmsub Sd, Sn, Sm, Sa",
+	"fnmsub Dd, Dn, Dm, Da",
+	"fnmul Hd, Hn, Hm",
+	"fnmul Sd, Sn, Sm",
+	"fnmul Dd, Dn, Dm",
+	"frecpe Hd, Hn",
+	"frecpe Sd, Sn",
+	"frecpe Dd, Dn",
+	"frecpe Vd.8H, Vn.8H\nfrecpe Vd.4H, Vn.4H",
+	"frecpe Vd.4S, Vn.4S\nfrecpe Vd.2S, Vn.2S",
+	"frecpe Vd.2D, Vn.2D",
+	"frecps Hd, Hn, Hm",
+	"frecps Sd, Sn, Sm",
+	"frecps Dd, Dn, Dm",
+	"frecps Vd.8H, Vn.8H, Vm.8H\nfrecps Vd.4H, Vn.4H, Vm.4H",
+	"frecps Vd.4S, Vn.4S, Vm.4S\nfrecps Vd.2S, Vn.2S, Vm.2S",
+	"frecps Vd.2D, Vn.2D, Vm.2D",
+	"frecps Zd.H, Zn.H, Zm.H",
+	"frecps Zd.S, Zn.S, Zm.S",
+	"frecps Zd.D, Zn.D, Zm.D",
+	"frecpx Hd, Hn",
+	"frecpx Sd, Sn",
+	"frecpx Dd, Dn",
+	"frecpx Zd.H, Pg/M, Zn.H (g < 8)",
+	"frecpx Zd.S, Pg/M, Zn.S (g < 8)",
+	"frecpx Zd.D, Pg/M, Zn.D (g < 8)",
+	"frint32x Sd, Sn",
+	"frint32x Dd, Dn",
+	"frint32x Vd.4S, Vn.4S\nfrint32x Vd.2S, Vn.2S",
+	"frint32x Vd.2D, Vn.2D",
+	"frint32z Sd, Sn",
+	"frint32z Dd, Dn",
+	"frint32z Vd.4S, Vn.4S\nfrint32z Vd.2S, Vn.2S",
+	"frint32z Vd.2D, Vn.2D",
+	"frint64x Sd, Sn",
+	"frint64x Dd, Dn",
+	"frint64x Vd.4S, Vn.4S\nfrint64x Vd.2S, Vn.2S",
+	"frint64x Vd.2D, Vn.2D",
+	"frint64z Sd, Sn",
+	"frint64z Dd, Dn",
+	"frint64z Vd.4S, Vn.4S\nfrint64z Vd.2S, Vn.2S",
+	"frint64z Vd.2D, Vn.2D",
+	"frinta Vd.8H, Vn.8H\nfrinta Vd.4H, Vn.4H",
+	"frinta Vd.4S, Vn.4S\nfrinta Vd.2S, Vn.2S",
+	"frinta Vd.2D, Vn.2D",
+	"frinta Hd, Hn",
+	"frinta Sd, Sn",
+	"frinta Dd, Dn",
+	"frinta Zd.H, Pg/M, Zn.H (g < 8)",
+	"frinta Zd.S, Pg/M, Zn.S (g < 8)",
+	"frinta Zd.D, Pg/M, Zn.D (g < 8)",
+	"frinti Vd.8H, Vn.8H\nfrinti Vd.4H, Vn.4H",
+	"frinti Vd.4S, Vn.4S\nfrinti Vd.2S, Vn.2S",
+	"frinti Vd.2D, Vn.2D",
+	"frinti Hd, Hn",
+	"frinti Sd, Sn",
+	"frinti Dd, Dn",
+	"frinti Zd.H, Pg/M, Zn.H (g < 8)",
+	"frinti Zd.S, Pg/M, Zn.S (g < 8)",
+	"frinti Zd.D, Pg/M, Zn.D (g < 8)",
+	"frintm Vd.8H, Vn.8H\nfrintm Vd.4H, Vn.4H",
+	"frintm Vd.4S, Vn.4S\nfrintm Vd.2S, Vn.2S",
+	"frintm Vd.2D, Vn.2D",
+	"frintm Hd, Hn",
+	"frintm Sd, Sn",
+	"frintm Dd, Dn",
+	"frintm Zd.H, Pg/M, Zn.H (g < 8)",
+	"frintm Zd.S, Pg/M, Zn.S (g < 8)",
+	"frintm Zd.D, Pg/M, Zn.D (g < 8)",
+	"frintn Vd.8H, Vn.8H\nfrintn Vd.4H, Vn.4H",
+	"frintn Vd.4S, Vn.4S\nfrintn Vd.2S, Vn.2S",
+	"frintn Vd.2D, Vn.2D",
+	"frintn Hd, Hn",
+	"frintn Sd, Sn",
+	"frintn Dd, Dn",
+	"frintn Zd.H, Pg/M, Zn.H (g < 8)",
+	"frintn Zd.S, Pg/M, Zn.S (g < 8)",
+	"frintn Zd.D, Pg/M, Zn.D (g < 8)",
+	"frintp Vd.8H, Vn.8H\nfrintp Vd.4H, Vn.4H",
+	"frintp Vd.4S, Vn.4S\nfrintp Vd.2S, Vn.2S",
+	"frintp Vd.2D, Vn.2D",
+	"frintp Hd, Hn",
+	"frintp Sd, Sn",
+	"frintp Dd, Dn",
+	"frintp Zd.H, Pg/M, Zn.H (g < 8)",
+	"frintp Zd.S, Pg/M, Zn.S (g < 8)",
+	"frintp Zd.D, Pg/M, Zn.D (g < 8)",
+	"frintx Vd.8H, Vn.8H\nfrintx Vd.4H, Vn.4H",
+	"frintx Vd.4S, Vn.4S\nfrintx Vd.2S, Vn.2S",
+	"frintx Vd.2D, Vn.2D",
+	"frintx Hd, Hn",
+	"frintx Sd, Sn",
+	"frintx Dd, Dn",
+	"frintx Zd.H, Pg/M, Zn.H (g < 8)",
+	"frintx Zd.S, Pg/M, Zn.S (g < 8)",
+	"frintx Zd.D, Pg/M, Zn.D (g < 8)",
+	"frintz Vd.8H, Vn.8H\nfrintz Vd.4H, Vn.4H",
+	"frintz Vd.4S, Vn.4S\nfrintz Vd.2S, Vn.2S",
+	"frintz Vd.2D, Vn.2D",
+	"frintz Hd, Hn",
+	"frintz Sd, Sn",
+	"frintz Dd, Dn",
+	"frintz Zd.H, Pg/M, Zn.H (g < 8)",
+	"frintz Zd.S, Pg/M, Zn.S (g < 8)",
+	"frintz Zd.D, Pg/M, Zn.D (g < 8)",
+	"frsqrte Hd, Hn",
+	"frsqrte Sd, Sn",
+	"frsqrte Dd, Dn",
+	"frsqrte Vd.8H, Vn.8H\nfrsqrte Vd.4H, Vn.4H",
+	"frsqrte Vd.4S, Vn.4S\nfrsqrte Vd.2S, Vn.2S",
+	"frsqrte Vd.2D, Vn.2D",
+	"frsqrts Hd, Hn, Hm",
+	"frsqrts Sd, Sn, Sm",
+	"frsqrts Dd, Dn, Dm",
+	"frsqrts Vd.8H, Vn.8H, Vm.8H\nfrsqrts Vd.4H, Vn.4H, Vm.4H",
+	"frsqrts Vd.4S, Vn.4S, Vm.4S\nfrsqrts Vd.2S, Vn.2S, Vm.2S",
+	"frsqrts Vd.2D, Vn.2D, Vm.2D",
+	"frsqrts Zd.H, Zn.H, Zm.H",
+	"frsqrts Zd.S, Zn.S, Zm.S",
+	"frsqrts Zd.D, Zn.D, Zm.D",
+	"fscale Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fscale Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fscale Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fsqrt Vd.8H, Vn.8H\nfsqrt Vd.4H, Vn.4H",
+	"fsqrt Vd.4S, Vn.4S\nfsqrt Vd.2S, Vn.2S",
+	"fsqrt Vd.2D, Vn.2D",
+	"fsqrt Hd, Hn",
+	"fsqrt Sd, Sn",
+	"fsqrt Dd, Dn",
+	"fsqrt Zd.H, Pg/M, Zn.H (g < 8)",
+	"fsqrt Zd.S, Pg/M, Zn.S (g < 8)",
+	"fsqrt Zd.D, Pg/M, Zn.D (g < 8)",
+	"fsub Vd.8H, Vn.8H, Vm.8H\nfsub Vd.4H, Vn.4H, Vm.4H",
+	"fsub Vd.4S, Vn.4S, Vm.4S\nfsub Vd.2S, Vn.2S, Vm.2S",
+	"fsub Vd.2D, Vn.2D, Vm.2D",
+	"fsub Hd, Hn, Hm",
+	"fsub Sd, Sn, Sm",
+	"fsub Dd, Dn, Dm",
+	"fsub Zd.H, Zn.H, Zm.H",
+	"fsub Zd.S, Zn.S, Zm.S",
+	"fsub Zd.D, Zn.D, Zm.D",
+	"fsub Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fsub Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fsub Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"fsubr Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"fsubr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"fsubr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"gmi Xd, Xn|SP, Xm",
+	"hint #imm (0 <= imm < 128)",
+	"hlt #imm (0 <= imm < 65536)",
+	"hvc #imm (0 <= imm < 65536)",
+	"ic IVAU, Xn",
+	"ic <symbol>",
+	"incb Xd",
+	"incb Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"incd Xd",
+	"incd Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"inch Xd",
+	"inch Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"incw Xd",
+	"incw Xd, <symbol> {, MUL #imm } (0 < imm <= 16)",
+	"index Zd.B, #imm1, #imm2 (-16 <= imm1 < 16, -16 <= imm2 < 16)",
+	"index Zd.H, #imm1, #imm2 (-16 <= imm1 < 16, -16 <= imm2 < 16)",
+	"index Zd.S, #imm1, #imm2 (-16 <= imm1 < 16, -16 <= imm2 < 16)",
+	"index Zd.D, #imm1, #imm2 (-16 <= imm1 < 16, -16 <= imm2 < 16)",
+	"index Zd.B, Wn, Wm",
+	"index Zd.H, Wn, Wm",
+	"index Zd.S, Wn, Wm",
+	"index Zd.D, Xn, Xm",
+	"ins Vd.B[i], Vn.B[i]",
+	"ins Vd.H[i], Vn.H[i]",
+	"ins Vd.S[i], Vn.S[i]",
+	"ins Vd.D[i], Vn.D[i]",
+	"ins Vd.B[i], Wn",
+	"ins Vd.H[i], Wn",
+	"ins Vd.S[i], Wn",
+	"ins Vd.D[i], Xn",
+	"irg Xd|SP, Xn|SP {, Xm }",
+	"isb SY",
+	"isb #imm (0 <= imm < 16)",
+	"isb",
+	"ld1 {Vd.16B * 1}, [Xn|SP]\nld1 {Vd.8B * 1}, [Xn|SP]",
+	"ld1 {Vd.8H * 1}, [Xn|SP]\nld1 {Vd.4H * 1}, [Xn|SP]",
+	"ld1 {Vd.4S * 1}, [Xn|SP]\nld1 {Vd.2S * 1}, [Xn|SP]",
+	"ld1 {Vd.2D * 1}, [Xn|SP]\nld1 {Vd.1D * 1}, [Xn|SP]",
+	"ld1 {Vd.16B * 2}, [Xn|SP]\nld1 {Vd.8B * 2}, [Xn|SP]",
+	"ld1 {Vd.8H * 2}, [Xn|SP]\nld1 {Vd.4H * 2}, [Xn|SP]",
+	"ld1 {Vd.4S * 2}, [Xn|SP]\nld1 {Vd.2S * 2}, [Xn|SP]",
+	"ld1 {Vd.2D * 2}, [Xn|SP]\nld1 {Vd.1D * 2}, [Xn|SP]",
+	"ld1 {Vd.16B * 3}, [Xn|SP]\nld1 {Vd.8B * 3}, [Xn|SP]",
+	"ld1 {Vd.8H * 3}, [Xn|SP]\nld1 {Vd.4H * 3}, [Xn|SP]",
+	"ld1 {Vd.4S * 3}, [Xn|SP]\nld1 {Vd.2S * 3}, [Xn|SP]",
+	"ld1 {Vd.2D * 3}, [Xn|SP]\nld1 {Vd.1D * 3}, [Xn|SP]",
+	"ld1 {Vd.16B * 4}, [Xn|SP]\nld1 {Vd.8B * 4}, [Xn|SP]",
+	"ld1 {Vd.8H * 4}, [Xn|SP]\nld1 {Vd.4H * 4}, [Xn|SP]",
+	"ld1 {Vd.4S * 4}, [Xn|SP]\nld1 {Vd.2S * 4}, [Xn|SP]",
+	"ld1 {Vd.2D * 4}, [Xn|SP]\nld1 {Vd.1D * 4}, [Xn|SP]",
+	"ld1 {Vd.8B * 1}, [Xn|SP], #8",
+	"ld1 {Vd.4H * 1}, [Xn|SP], #8",
+	"ld1 {Vd.2S * 1}, [Xn|SP], #8",
+	"ld1 {Vd.1D * 1}, [Xn|SP], #8",
+	"ld1 {Vd.16B * 1}, [Xn|SP], #16",
+	"ld1 {Vd.8H * 1}, [Xn|SP], #16",
+	"ld1 {Vd.4S * 1}, [Xn|SP], #16",
+	"ld1 {Vd.2D * 1}, [Xn|SP], #16",
+	"ld1 {Vd.16B * 1}, [Xn|SP], Xm (m != 31)\nld1 {Vd.8B * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.8H * 1}, [Xn|SP], Xm (m != 31)\nld1 {Vd.4H * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.4S * 1}, [Xn|SP], Xm (m != 31)\nld1 {Vd.2S * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.2D * 1}, [Xn|SP], Xm (m != 31)\nld1 {Vd.1D * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.8B * 2}, [Xn|SP], #16",
+	"ld1 {Vd.4H * 2}, [Xn|SP], #16",
+	"ld1 {Vd.2S * 2}, [Xn|SP], #16",
+	"ld1 {Vd.1D * 2}, [Xn|SP], #16",
+	"ld1 {Vd.16B * 2}, [Xn|SP], #32",
+	"ld1 {Vd.8H * 2}, [Xn|SP], #32",
+	"ld1 {Vd.4S * 2}, [Xn|SP], #32",
+	"ld1 {Vd.2D * 2}, [Xn|SP], #32",
+	"ld1 {Vd.16B * 2}, [Xn|SP], Xm (m != 31)\nld1 {Vd.8B * 2}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.8H * 2}, [Xn|SP], Xm (m != 31)\nld1 {Vd.4H * 2}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.4S * 2}, [Xn|SP], Xm (m != 31)\nld1 {Vd.2S * 2}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.2D * 2}, [Xn|SP], Xm (m != 31)\nld1 {Vd.1D * 2}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.8B * 3}, [Xn|SP], #24",
+	"ld1 {Vd.4H * 3}, [Xn|SP], #24",
+	"ld1 {Vd.2S * 3}, [Xn|SP], #24",
+	"ld1 {Vd.1D * 3}, [Xn|SP], #24",
+	"ld1 {Vd.16B * 3}, [Xn|SP], #48",
+	"ld1 {Vd.8H * 3}, [Xn|SP], #48",
+	"ld1 {Vd.4S * 3}, [Xn|SP], #48",
+	"ld1 {Vd.2D * 3}, [Xn|SP], #48",
+	"ld1 {Vd.16B * 3}, [Xn|SP], Xm (m != 31)\nld1 {Vd.8B * 3}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.8H * 3}, [Xn|SP], Xm (m != 31)\nld1 {Vd.4H * 3}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.4S * 3}, [Xn|SP], Xm (m != 31)\nld1 {Vd.2S * 3}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.2D * 3}, [Xn|SP], Xm (m != 31)\nld1 {Vd.1D * 3}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.8B * 4}, [Xn|SP], #32",
+	"ld1 {Vd.4H * 4}, [Xn|SP], #32",
+	"ld1 {Vd.2S * 4}, [Xn|SP], #32",
+	"ld1 {Vd.1D * 4}, [Xn|SP], #32",
+	"ld1 {Vd.16B * 4}, [Xn|SP], #64",
+	"ld1 {Vd.8H * 4}, [Xn|SP], #64",
+	"ld1 {Vd.4S * 4}, [Xn|SP], #64",
+	"ld1 {Vd.2D * 4}, [Xn|SP], #64",
+	"ld1 {Vd.16B * 4}, [Xn|SP], Xm (m != 31)\nld1 {Vd.8B * 4}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.8H * 4}, [Xn|SP], Xm (m != 31)\nld1 {Vd.4H * 4}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.4S * 4}, [Xn|SP], Xm (m != 31)\nld1 {Vd.2S * 4}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.2D * 4}, [Xn|SP], Xm (m != 31)\nld1 {Vd.1D * 4}, [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.B * 1}[i], [Xn|SP]",
+	"ld1 {Vd.H * 1}[i], [Xn|SP]",
+	"ld1 {Vd.S * 1}[i], [Xn|SP]",
+	"ld1 {Vd.D * 1}[i], [Xn|SP]",
+	"ld1 {Vd.B * 1}[i], [Xn|SP], #1",
+	"ld1 {Vd.B * 1}[i], [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.H * 1}[i], [Xn|SP], #2",
+	"ld1 {Vd.H * 1}[i], [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.S * 1}[i], [Xn|SP], #4",
+	"ld1 {Vd.S * 1}[i], [Xn|SP], Xm (m != 31)",
+	"ld1 {Vd.D * 1}[i], [Xn|SP], #8",
+	"ld1 {Vd.D * 1}[i], [Xn|SP], Xm (m != 31)",
+	"ld1b ZAdH|V.B[W12-W15, #imm], Pg/Z, [Xn|SP, Xm] (0 <= imm < 16, g < 8)",
+	"ld1b ZAdH|V.B[W12-W15, #imm], Pg/Z, [Xn|SP] (0 <= imm < 16, g < 8)",
+	"ld1b {Zd.B * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1b {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1b {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1b {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1b {Zd.B * 1}, Pg/Z, [Xn|SP, Xm] (g < 8, m != 31)",
+	"ld1b {Zd.H * 1}, Pg/Z, [Xn|SP, Xm] (g < 8, m != 31)",
+	"ld1b {Zd.S * 1}, Pg/Z, [Xn|SP, Xm] (g < 8, m != 31)",
+	"ld1b {Zd.D * 1}, Pg/Z, [Xn|SP, Xm] (g < 8, m != 31)",
+	"ld1b {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D] (g < 8)",
+	"ld1b {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW] (g < 8)",
+	"ld1b {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }] (g < 8, 0 <= imm < 32, imm >> 0)",
+	"ld1b {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }] (g < 8, 0 <= imm < 32, imm >> 0)",
+	"ld1d ZAdH|V.D[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #3] (d < 8, 0 <= imm < 2, g < 8)",
+	"ld1d ZAdH|V.D[W12-W15, #imm], Pg/Z, [Xn|SP] (d < 8, 0 <= imm < 2, g < 8)",
+	"ld1d {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #3] (g < 8, m != 31)",
+	"ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D] (g < 8)",
+	"ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #3] (g < 8)",
+	"ld1h ZAdH|V.H[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #1] (d < 2, 0 <= imm < 8, g < 8)",
+	"ld1h ZAdH|V.H[W12-W15, #imm], Pg/Z, [Xn|SP] (d < 2, 0 <= imm < 8, g < 8)",
+	"ld1h {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1h {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1h {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1h {Zd.H * 1}, Pg/Z, [Xn|SP, Xm, LSL #1] (g < 8, m != 31)",
+	"ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #1] (g < 8, m != 31)",
+	"ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #1] (g < 8, m != 31)",
+	"ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D] (g < 8)",
+	"ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #1] (g < 8)",
+	"ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW] (g < 8)",
+	"ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW #1] (g < 8)",
+	"ld1h {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }] (g < 8, 0 <= imm < 64, imm >> 1)",
+	"ld1h {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }] (g < 8, 0 <= imm < 64, imm >> 1)",
+	"ld1q ZAdH|V.Q[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #4] (d < 16, imm == 0, g < 8)",
+	"ld1q ZAdH|V.Q[W12-W15, #imm], Pg/Z, [Xn|SP] (d < 16, imm == 0, g < 8)",
+	"ld1r {Vd.16B * 1}, [Xn|SP]\nld1r {Vd.8B * 1}, [Xn|SP]",
+	"ld1r {Vd.8H * 1}, [Xn|SP]\nld1r {Vd.4H * 1}, [Xn|SP]",
+	"ld1r {Vd.4S * 1}, [Xn|SP]\nld1r {Vd.2S * 1}, [Xn|SP]",
+	"ld1r {Vd.2D * 1}, [Xn|SP]\nld1r {Vd.1D * 1}, [Xn|SP]",
+	"ld1r {Vd.16B * 1}, [Xn|SP], #1\nld1r {Vd.8B * 1}, [Xn|SP], #1",
+	"ld1r {Vd.8H * 1}, [Xn|SP], #2\nld1r {Vd.4H * 1}, [Xn|SP], #2",
+	"ld1r {Vd.4S * 1}, [Xn|SP], #4\nld1r {Vd.2S * 1}, [Xn|SP], #4",
+	"ld1r {Vd.2D * 1}, [Xn|SP], #8\nld1r {Vd.1D * 1}, [Xn|SP], #8",
+	"ld1r {Vd.16B * 1}, [Xn|SP], Xm (m != 31)\nld1r {Vd.8B * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1r {Vd.8H * 1}, [Xn|SP], Xm (m != 31)\nld1r {Vd.4H * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1r {Vd.4S * 1}, [Xn|SP], Xm (m != 31)\nld1r {Vd.2S * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1r {Vd.2D * 1}, [Xn|SP], Xm (m != 31)\nld1r {Vd.1D * 1}, [Xn|SP], Xm (m != 31)",
+	"ld1rb {Zd.B * 1}, Pg/Z, [Xn|SP {, #imm }] (g < 8, 0 <= imm < 64, imm >> 0)",
+	"ld1rd {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm }] (g < 8, 0 <= imm < 512, imm >> 3)",
+	"ld1rh {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm }] (g < 8, 0 <= imm < 128, imm >> 1)",
+	"ld1rw {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm }] (g < 8, 0 <= imm < 256, imm >> 2)",
+	"ld1w ZAdH|V.S[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #2] (d < 4, 0 <= imm < 4, g < 8)",
+	"ld1w ZAdH|V.S[W12-W15, #imm], Pg/Z, [Xn|SP] (d < 4, 0 <= imm < 4, g < 8)",
+	"ld1w {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1w {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #2] (g < 8, m != 31)",
+	"ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #2] (g < 8, m != 31)",
+	"ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D] (g < 8)",
+	"ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #2] (g < 8)",
+	"ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW] (g < 8)",
+	"ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW #2] (g < 8)",
+	"ld1w {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }] (g < 8, 0 <= imm < 128, imm >> 2)",
+	"ld1w {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }] (g < 8, 0 <= imm < 128, imm >> 2)",
+	"ld2 {Vd.16B * 2}, [Xn|SP]\nld2 {Vd.8B * 2}, [Xn|SP]",
+	"ld2 {Vd.8H * 2}, [Xn|SP]\nld2 {Vd.4H * 2}, [Xn|SP]",
+	"ld2 {Vd.4S * 2}, [Xn|SP]\nld2 {Vd.2S * 2}, [Xn|SP]",
+	"ld2 {Vd.2D * 2}, [Xn|SP]",
+	"ld2 {Vd.8B * 2}, [Xn|SP], #16",
+	"ld2 {Vd.4H * 2}, [Xn|SP], #16",
+	"ld2 {Vd.2S * 2}, [Xn|SP], #16",
+	"ld2 {Vd.16B * 2}, [Xn|SP], #32",
+	"ld2 {Vd.8H * 2}, [Xn|SP], #32",
+	"ld2 {Vd.4S * 2}, [Xn|SP], #32",
+	"ld2 {Vd.2D * 2}, [Xn|SP], #32",
+	"ld2 {Vd.16B * 2}, [Xn|SP], Xm (m != 31)\nld2 {Vd.8B * 2}, [Xn|SP], Xm (m != 31)",
+	"ld2 {Vd.8H * 2}, [Xn|SP], Xm (m != 31)\nld2 {Vd.4H * 2}, [Xn|SP], Xm (m != 31)",
+	"ld2 {Vd.4S * 2}, [Xn|SP], Xm (m != 31)\nld2 {Vd.2S * 2}, [Xn|SP], Xm (m != 31)",
+	"ld2 {Vd.2D * 2}, [Xn|SP], Xm (m != 31)",
+	"ld2 {Vd.B * 2}[i], [Xn|SP]",
+	"ld2 {Vd.H * 2}[i], [Xn|SP]",
+	"ld2 {Vd.S * 2}[i], [Xn|SP]",
+	"ld2 {Vd.D * 2}[i], [Xn|SP]",
+	"ld2 {Vd.B * 2}[i], [Xn|SP], #2",
+	"ld2 {Vd.B * 2}[i], [Xn|SP], Xm (m != 31)",
+	"ld2 {Vd.H * 2}[i], [Xn|SP], #4",
+	"ld2 {Vd.H * 2}[i], [Xn|SP], Xm (m != 31)",
+	"ld2 {Vd.S * 2}[i], [Xn|SP], #8",
+	"ld2 {Vd.S * 2}[i], [Xn|SP], Xm (m != 31)",
+	"ld2 {Vd.D * 2}[i], [Xn|SP], #16",
+	"ld2 {Vd.D * 2}[i], [Xn|SP], Xm (m != 31)",
+	"ld2r {Vd.16B * 2}, [Xn|SP]\nld2r {Vd.8B * 2}, [Xn|SP]",
+	"ld2r {Vd.8H * 2}, [Xn|SP]\nld2r {Vd.4H * 2}, [Xn|SP]",
+	"ld2r {Vd.4S * 2}, [Xn|SP]\nld2r {Vd.2S * 2}, [Xn|SP]",
+	"ld2r {Vd.2D * 2}, [Xn|SP]\nld2r {Vd.1D * 2}, [Xn|SP]",
+	"ld2r {Vd.16B * 2}, [Xn|SP], #2\nld2r {Vd.8B * 2}, [Xn|SP], #2",
+	"ld2r {Vd.8H * 2}, [Xn|SP], #4\nld2r {Vd.4H * 2}, [Xn|SP], #4",
+	"ld2r {Vd.4S * 2}, [Xn|SP], #8\nld2r {Vd.2S * 2}, [Xn|SP], #8",
+	"ld2r {Vd.2D * 2}, [Xn|SP], #16\nld2r {Vd.1D * 2}, [Xn|SP], #16",
+	"ld2r {Vd.16B * 2}, [Xn|SP], Xm (m != 31)\nld2r {Vd.8B * 2}, [Xn|SP], Xm (m != 31)",
+	"ld2r {Vd.8H * 2}, [Xn|SP], Xm (m != 31)\nld2r {Vd.4H * 2}, [Xn|SP], Xm (m != 31)",
+	"ld2r {Vd.4S * 2}, [Xn|SP], Xm (m != 31)\nld2r {Vd.2S * 2}, [Xn|SP], Xm (m != 31)",
+	"ld2r {Vd.2D * 2}, [Xn|SP], Xm (m != 31)\nld2r {Vd.1D * 2}, [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.16B * 3}, [Xn|SP]\nld3 {Vd.8B * 3}, [Xn|SP]",
+	"ld3 {Vd.8H * 3}, [Xn|SP]\nld3 {Vd.4H * 3}, [Xn|SP]",
+	"ld3 {Vd.4S * 3}, [Xn|SP]\nld3 {Vd.2S * 3}, [Xn|SP]",
+	"ld3 {Vd.2D * 3}, [Xn|SP]",
+	"ld3 {Vd.8B * 3}, [Xn|SP], #24",
+	"ld3 {Vd.4H * 3}, [Xn|SP], #24",
+	"ld3 {Vd.2S * 3}, [Xn|SP], #24",
+	"ld3 {Vd.16B * 3}, [Xn|SP], #48",
+	"ld3 {Vd.8H * 3}, [Xn|SP], #48",
+	"ld3 {Vd.4S * 3}, [Xn|SP], #48",
+	"ld3 {Vd.2D * 3}, [Xn|SP], #48",
+	"ld3 {Vd.16B * 3}, [Xn|SP], Xm (m != 31)\nld3 {Vd.8B * 3}, [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.8H * 3}, [Xn|SP], Xm (m != 31)\nld3 {Vd.4H * 3}, [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.4S * 3}, [Xn|SP], Xm (m != 31)\nld3 {Vd.2S * 3}, [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.2D * 3}, [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.B * 3}[i], [Xn|SP]",
+	"ld3 {Vd.H * 3}[i], [Xn|SP]",
+	"ld3 {Vd.S * 3}[i], [Xn|SP]",
+	"ld3 {Vd.D * 3}[i], [Xn|SP]",
+	"ld3 {Vd.B * 3}[i], [Xn|SP], #3",
+	"ld3 {Vd.B * 3}[i], [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.H * 3}[i], [Xn|SP], #6",
+	"ld3 {Vd.H * 3}[i], [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.S * 3}[i], [Xn|SP], #12",
+	"ld3 {Vd.S * 3}[i], [Xn|SP], Xm (m != 31)",
+	"ld3 {Vd.D * 3}[i], [Xn|SP], #24",
+	"ld3 {Vd.D * 3}[i], [Xn|SP], Xm (m != 31)",
+	"ld3r {Vd.16B * 3}, [Xn|SP]\nld3r {Vd.8B * 3}, [Xn|SP]",
+	"ld3r {Vd.8H * 3}, [Xn|SP]\nld3r {Vd.4H * 3}, [Xn|SP]",
+	"ld3r {Vd.4S * 3}, [Xn|SP]\nld3r {Vd.2S * 3}, [Xn|SP]",
+	"ld3r {Vd.2D * 3}, [Xn|SP]\nld3r {Vd.1D * 3}, [Xn|SP]",
+	"ld3r {Vd.16B * 3}, [Xn|SP], #3\nld3r {Vd.8B * 3}, [Xn|SP], #3",
+	"ld3r {Vd.8H * 3}, [Xn|SP], #6\nld3r {Vd.4H * 3}, [Xn|SP], #6",
+	"ld3r {Vd.4S * 3}, [Xn|SP], #12\nld3r {Vd.2S * 3}, [Xn|SP], #12",
+	"ld3r {Vd.2D * 3}, [Xn|SP], #24\nld3r {Vd.1D * 3}, [Xn|SP], #24",
+	"ld3r {Vd.16B * 3}, [Xn|SP], Xm (m != 31)\nld3r {Vd.8B * 3}, [Xn|SP], Xm (m != 31)",
+	"ld3r {Vd.8H * 3}, [Xn|SP], Xm (m != 31)\nld3r {Vd.4H * 3}, [Xn|SP], Xm (m != 31)",
+	"ld3r {Vd.4S * 3}, [Xn|SP], Xm (m != 31)\nld3r {Vd.2S * 3}, [Xn|SP], Xm (m != 31)",
+	"ld3r {Vd.2D * 3}, [Xn|SP], Xm (m != 31)\nld3r {Vd.1D * 3}, [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.16B * 4}, [Xn|SP]\nld4 {Vd.8B * 4}, [Xn|SP]",
+	"ld4 {Vd.8H * 4}, [Xn|SP]\nld4 {Vd.4H * 4}, [Xn|SP]",
+	"ld4 {Vd.4S * 4}, [Xn|SP]\nld4 {Vd.2S * 4}, [Xn|SP]",
+	"ld4 {Vd.2D * 4}, [Xn|SP]",
+	"ld4 {Vd.8B * 4}, [Xn|SP], #32",
+	"ld4 {Vd.4H * 4}, [Xn|SP], #32",
+	"ld4 {Vd.2S * 4}, [Xn|SP], #32",
+	"ld4 {Vd.16B * 4}, [Xn|SP], #64",
+	"ld4 {Vd.8H * 4}, [Xn|SP], #64",
+	"ld4 {Vd.4S * 4}, [Xn|SP], #64",
+	"ld4 {Vd.2D * 4}, [Xn|SP], #64",
+	"ld4 {Vd.16B * 4}, [Xn|SP], Xm (m != 31)\nld4 {Vd.8B * 4}, [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.8H * 4}, [Xn|SP], Xm (m != 31)\nld4 {Vd.4H * 4}, [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.4S * 4}, [Xn|SP], Xm (m != 31)\nld4 {Vd.2S * 4}, [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.2D * 4}, [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.B * 4}[i], [Xn|SP]",
+	"ld4 {Vd.H * 4}[i], [Xn|SP]",
+	"ld4 {Vd.S * 4}[i], [Xn|SP]",
+	"ld4 {Vd.D * 4}[i], [Xn|SP]",
+	"ld4 {Vd.B * 4}[i], [Xn|SP], #4",
+	"ld4 {Vd.B * 4}[i], [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.H * 4}[i], [Xn|SP], #8",
+	"ld4 {Vd.H * 4}[i], [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.S * 4}[i], [Xn|SP], #16",
+	"ld4 {Vd.S * 4}[i], [Xn|SP], Xm (m != 31)",
+	"ld4 {Vd.D * 4}[i], [Xn|SP], #32",
+	"ld4 {Vd.D * 4}[i], [Xn|SP], Xm (m != 31)",
+	"ld4r {Vd.16B * 4}, [Xn|SP]\nld4r {Vd.8B * 4}, [Xn|SP]",
+	"ld4r {Vd.8H * 4}, [Xn|SP]\nld4r {Vd.4H * 4}, [Xn|SP]",
+	"ld4r {Vd.4S * 4}, [Xn|SP]\nld4r {Vd.2S * 4}, [Xn|SP]",
+	"ld4r {Vd.2D * 4}, [Xn|SP]\nld4r {Vd.1D * 4}, [Xn|SP]",
+	"ld4r {Vd.16B * 4}, [Xn|SP], #4\nld4r {Vd.8B * 4}, [Xn|SP], #4",
+	"ld4r {Vd.8H * 4}, [Xn|SP], #8\nld4r {Vd.4H * 4}, [Xn|SP], #8",
+	"ld4r {Vd.4S * 4}, [Xn|SP], #16\nld4r {Vd.2S * 4}, [Xn|SP], #16",
+	"ld4r {Vd.2D * 4}, [Xn|SP], #32\nld4r {Vd.1D * 4}, [Xn|SP], #32",
+	"ld4r {Vd.16B * 4}, [Xn|SP], Xm (m != 31)\nld4r {Vd.8B * 4}, [Xn|SP], Xm (m != 31)",
+	"ld4r {Vd.8H * 4}, [Xn|SP], Xm (m != 31)\nld4r {Vd.4H * 4}, [Xn|SP], Xm (m != 31)",
+	"ld4r {Vd.4S * 4}, [Xn|SP], Xm (m != 31)\nld4r {Vd.2S * 4}, [Xn|SP], Xm (m != 31)",
+	"ld4r {Vd.2D * 4}, [Xn|SP], Xm (m != 31)\nld4r {Vd.1D * 4}, [Xn|SP], Xm (m != 31)",
+	"ld64b Xn, [Xm|SP] (n is even)",
+	"ldadd Wd, Wn, [Xm|SP]",
+	"ldadd Xd, Xn, [Xm|SP]",
+	"ldadda Wd, Wn, [Xm|SP]",
+	"ldadda Xd, Xn, [Xm|SP]",
+	"ldaddab Wd, Wn, [Xm|SP]",
+	"ldaddah Wd, Wn, [Xm|SP]",
+	"ldaddal Wd, Wn, [Xm|SP]",
+	"ldaddal Xd, Xn, [Xm|SP]",
+	"ldaddalb Wd, Wn, [Xm|SP]",
+	"ldaddalh Wd, Wn, [Xm|SP]",
+	"ldaddb Wd, Wn, [Xm|SP]",
+	"ldaddh Wd, Wn, [Xm|SP]",
+	"ldaddl Wd, Wn, [Xm|SP]",
+	"ldaddl Xd, Xn, [Xm|SP]",
+	"ldaddlb Wd, Wn, [Xm|SP]",
+	"ldaddlh Wd, Wn, [Xm|SP]",
+	"ldapr Wd, [Xn|SP]",
+	"ldapr Xd, [Xn|SP]",
+	"ldaprb Wd, [Xn|SP]",
+	"ldaprh Wd, [Xn|SP]",
+	"ldapur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapurb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapurh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapursb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapursb Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapursh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapursh Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldapursw Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldar Wd, [Xn|SP]",
+	"ldar Xd, [Xn|SP]",
+	"ldarb Wd, [Xn|SP]",
+	"ldarh Wd, [Xn|SP]",
+	"ldaxp Wd, Wn, [Xm|SP]",
+	"ldaxp Xd, Xn, [Xm|SP]",
+	"ldaxr Wd, [Xn|SP]",
+	"ldaxr Xd, [Xn|SP]",
+	"ldaxrb Wd, [Xn|SP]",
+	"ldaxrh Wd, [Xn|SP]",
+	"ldclr Wd, Wn, [Xm|SP]",
+	"ldclr Xd, Xn, [Xm|SP]",
+	"ldclra Wd, Wn, [Xm|SP]",
+	"ldclra Xd, Xn, [Xm|SP]",
+	"ldclrab Wd, Wn, [Xm|SP]",
+	"ldclrah Wd, Wn, [Xm|SP]",
+	"ldclral Wd, Wn, [Xm|SP]",
+	"ldclral Xd, Xn, [Xm|SP]",
+	"ldclralb Wd, Wn, [Xm|SP]",
+	"ldclralh Wd, Wn, [Xm|SP]",
+	"ldclrb Wd, Wn, [Xm|SP]",
+	"ldclrh Wd, Wn, [Xm|SP]",
+	"ldclrl Wd, Wn, [Xm|SP]",
+	"ldclrl Xd, Xn, [Xm|SP]",
+	"ldclrlb Wd, Wn, [Xm|SP]",
+	"ldclrlh Wd, Wn, [Xm|SP]",
+	"ldeor Wd, Wn, [Xm|SP]",
+	"ldeor Xd, Xn, [Xm|SP]",
+	"ldeora Wd, Wn, [Xm|SP]",
+	"ldeora Xd, Xn, [Xm|SP]",
+	"ldeorab Wd, Wn, [Xm|SP]",
+	"ldeorah Wd, Wn, [Xm|SP]",
+	"ldeoral Wd, Wn, [Xm|SP]",
+	"ldeoral Xd, Xn, [Xm|SP]",
+	"ldeoralb Wd, Wn, [Xm|SP]",
+	"ldeoralh Wd, Wn, [Xm|SP]",
+	"ldeorb Wd, Wn, [Xm|SP]",
+	"ldeorh Wd, Wn, [Xm|SP]",
+	"ldeorl Wd, Wn, [Xm|SP]",
+	"ldeorl Xd, Xn, [Xm|SP]",
+	"ldeorlb Wd, Wn, [Xm|SP]",
+	"ldeorlh Wd, Wn, [Xm|SP]",
+	"ldff1b {Zd.B * 1}, Pg/Z, [Xn|SP, Xm] (g < 8)",
+	"ldff1d {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #3] (g < 8)",
+	"ldff1h {Zd.H * 1}, Pg/Z, [Xn|SP, Xm, LSL #1] (g < 8)",
+	"ldff1w {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #2] (g < 8)",
+	"ldg Xd, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4)",
+	"ldlar Wd, [Xn|SP]",
+	"ldlar Xd, [Xn|SP]",
+	"ldlarb Wd, [Xn|SP]",
+	"ldlarh Wd, [Xn|SP]",
+	"ldnp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"ldnp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"ldnp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4)",
+	"ldnp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"ldnp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"ldp Sd, Sn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2)",
+	"ldp Dd, Dn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3)",
+	"ldp Qd, Qn, [Xm|SP], #imm (-1024 <= imm < 1024, imm >> 4)",
+	"ldp Sd, Sn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2)",
+	"ldp Dd, Dn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3)",
+	"ldp Qd, Qn, [Xm|SP, #imm]! (-1024 <= imm < 1024, imm >> 4)",
+	"ldp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"ldp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"ldp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4)",
+	"ldp Wd, Wn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2)",
+	"ldp Xd, Xn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3)",
+	"ldp Wd, Wn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2)",
+	"ldp Xd, Xn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3)",
+	"ldp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"ldp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"ldpsw Xd, Xn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2)",
+	"ldpsw Xd, Xn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2)",
+	"ldpsw Xd, Xn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"ldr Bd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldr Hd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldr Sd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldr Dd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldr Qd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldr Bd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldr Hd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldr Sd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldr Dd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldr Qd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldr Bd, [Xn|SP {, #imm }] (0 <= imm < 4096)",
+	"ldr Hd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1)",
+	"ldr Sd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2)",
+	"ldr Dd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3)",
+	"ldr Qd, [Xn|SP {, #imm }] (0 <= imm < 65536, imm >> 4)",
+	"ldr Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldr Xd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldr Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldr Xd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldr Wd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2)",
+	"ldr Xd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3)",
+	"ldr Sd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"ldr Dd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"ldr Qd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"ldr Wd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"ldr Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"ldr Bd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm == 0)",
+	"ldr Hd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 1])",
+	"ldr Sd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 2])",
+	"ldr Dd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 3])",
+	"ldr Qd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 4])",
+	"ldr Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 2])",
+	"ldr Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 3])",
+	"ldr ZA[W12-W15, #imm1], [Xn|SP {, #imm2, MUL VL }] (0 <= imm1 < 16, imm2 == imm1)",
+	"ldr Zd, [Xn|SP {, #imm, MUL VL }] (-256 <= imm < 256)",
+	"ldr Pd, [Xn|SP {, #imm, MUL VL }] (-256 <= imm < 256)",
+	"ldraa Xd, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 3)",
+	"ldraa Xd, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 3)",
+	"ldrab Xd, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 3)",
+	"ldrab Xd, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 3)",
+	"ldrb Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldrb Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldrb Wd, [Xn|SP {, #imm }] (0 <= imm < 4096)",
+	"ldrb Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm == 0)",
+	"ldrh Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldrh Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldrh Wd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1)",
+	"ldrh Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 1])",
+	"ldrsb Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldrsb Xd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldrsb Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldrsb Xd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldrsb Wd, [Xn|SP {, #imm }] (0 <= imm < 4096)",
+	"ldrsb Xd, [Xn|SP {, #imm }] (0 <= imm < 4096)",
+	"ldrsb Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm == 0)",
+	"ldrsb Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm == 0)",
+	"ldrsh Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldrsh Xd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldrsh Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldrsh Xd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldrsh Wd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1)",
+	"ldrsh Xd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1)",
+	"ldrsh Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 1])",
+	"ldrsh Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 1])",
+	"ldrsw Xd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"ldrsw Xd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"ldrsw Xd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2)",
+	"ldrsw Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"ldrsw Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 2])",
+	"ldset Wd, Wn, [Xm|SP]",
+	"ldset Xd, Xn, [Xm|SP]",
+	"ldseta Wd, Wn, [Xm|SP]",
+	"ldseta Xd, Xn, [Xm|SP]",
+	"ldsetab Wd, Wn, [Xm|SP]",
+	"ldsetah Wd, Wn, [Xm|SP]",
+	"ldsetal Wd, Wn, [Xm|SP]",
+	"ldsetal Xd, Xn, [Xm|SP]",
+	"ldsetalb Wd, Wn, [Xm|SP]",
+	"ldsetalh Wd, Wn, [Xm|SP]",
+	"ldsetb Wd, Wn, [Xm|SP]",
+	"ldseth Wd, Wn, [Xm|SP]",
+	"ldsetl Wd, Wn, [Xm|SP]",
+	"ldsetl Xd, Xn, [Xm|SP]",
+	"ldsetlb Wd, Wn, [Xm|SP]",
+	"ldsetlh Wd, Wn, [Xm|SP]",
+	"ldsmax Wd, Wn, [Xm|SP]",
+	"ldsmax Xd, Xn, [Xm|SP]",
+	"ldsmaxa Wd, Wn, [Xm|SP]",
+	"ldsmaxa Xd, Xn, [Xm|SP]",
+	"ldsmaxab Wd, Wn, [Xm|SP]",
+	"ldsmaxah Wd, Wn, [Xm|SP]",
+	"ldsmaxal Wd, Wn, [Xm|SP]",
+	"ldsmaxal Xd, Xn, [Xm|SP]",
+	"ldsmaxalb Wd, Wn, [Xm|SP]",
+	"ldsmaxalh Wd, Wn, [Xm|SP]",
+	"ldsmaxb Wd, Wn, [Xm|SP]",
+	"ldsmaxh Wd, Wn, [Xm|SP]",
+	"ldsmaxl Wd, Wn, [Xm|SP]",
+	"ldsmaxl Xd, Xn, [Xm|SP]",
+	"ldsmaxlb Wd, Wn, [Xm|SP]",
+	"ldsmaxlh Wd, Wn, [Xm|SP]",
+	"ldsmin Wd, Wn, [Xm|SP]",
+	"ldsmin Xd, Xn, [Xm|SP]",
+	"ldsmina Wd, Wn, [Xm|SP]",
+	"ldsmina Xd, Xn, [Xm|SP]",
+	"ldsminab Wd, Wn, [Xm|SP]",
+	"ldsminah Wd, Wn, [Xm|SP]",
+	"ldsminal Wd, Wn, [Xm|SP]",
+	"ldsminal Xd, Xn, [Xm|SP]",
+	"ldsminalb Wd, Wn, [Xm|SP]",
+	"ldsminalh Wd, Wn, [Xm|SP]",
+	"ldsminb Wd, Wn, [Xm|SP]",
+	"ldsminh Wd, Wn, [Xm|SP]",
+	"ldsminl Wd, Wn, [Xm|SP]",
+	"ldsminl Xd, Xn, [Xm|SP]",
+	"ldsminlb Wd, Wn, [Xm|SP]",
+	"ldsminlh Wd, Wn, [Xm|SP]",
+	"ldtr Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtr Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtrb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtrh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtrsb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtrsb Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtrsh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtrsh Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldtrsw Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldumax Wd, Wn, [Xm|SP]",
+	"ldumax Xd, Xn, [Xm|SP]",
+	"ldumaxa Wd, Wn, [Xm|SP]",
+	"ldumaxa Xd, Xn, [Xm|SP]",
+	"ldumaxab Wd, Wn, [Xm|SP]",
+	"ldumaxah Wd, Wn, [Xm|SP]",
+	"ldumaxal Wd, Wn, [Xm|SP]",
+	"ldumaxal Xd, Xn, [Xm|SP]",
+	"ldumaxalb Wd, Wn, [Xm|SP]",
+	"ldumaxalh Wd, Wn, [Xm|SP]",
+	"ldumaxb Wd, Wn, [Xm|SP]",
+	"ldumaxh Wd, Wn, [Xm|SP]",
+	"ldumaxl Wd, Wn, [Xm|SP]",
+	"ldumaxl Xd, Xn, [Xm|SP]",
+	"ldumaxlb Wd, Wn, [Xm|SP]",
+	"ldumaxlh Wd, Wn, [Xm|SP]",
+	"ldumin Wd, Wn, [Xm|SP]",
+	"ldumin Xd, Xn, [Xm|SP]",
+	"ldumina Wd, Wn, [Xm|SP]",
+	"ldumina Xd, Xn, [Xm|SP]",
+	"lduminab Wd, Wn, [Xm|SP]",
+	"lduminah Wd, Wn, [Xm|SP]",
+	"lduminal Wd, Wn, [Xm|SP]",
+	"lduminal Xd, Xn, [Xm|SP]",
+	"lduminalb Wd, Wn, [Xm|SP]",
+	"lduminalh Wd, Wn, [Xm|SP]",
+	"lduminb Wd, Wn, [Xm|SP]",
+	"lduminh Wd, Wn, [Xm|SP]",
+	"lduminl Wd, Wn, [Xm|SP]",
+	"lduminl Xd, Xn, [Xm|SP]",
+	"lduminlb Wd, Wn, [Xm|SP]",
+	"lduminlh Wd, Wn, [Xm|SP]",
+	"ldur Bd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldur Hd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldur Sd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldur Dd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldur Qd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldurb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldurh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldursb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldursb Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldursh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldursh Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldursw Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"ldxp Wd, Wn, [Xm|SP]",
+	"ldxp Xd, Xn, [Xm|SP]",
+	"ldxr Wd, [Xn|SP]",
+	"ldxr Xd, [Xn|SP]",
+	"ldxrb Wd, [Xn|SP]",
+	"ldxrh Wd, [Xn|SP]",
+	"lsl Wd, Wn, Wm",
+	"lsl Xd, Xn, Xm",
+	"lsl Wd, Wn, #imm (0 <= imm < 32)",
+	"lsl Xd, Xn, #imm (0 <= imm < 64)",
+	"lsl Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"lsl Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"lsl Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"lsl Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"lsl Zd.B, Zn.B, #imm (0 <= imm < 8)",
+	"lsl Zd.H, Zn.H, #imm (0 <= imm < 16)",
+	"lsl Zd.S, Zn.S, #imm (0 <= imm < 32)",
+	"lsl Zd.D, Zn.D, #imm (0 <= imm < 64)",
+	"lsl Zd.B, Pg/M, Zn.B, #imm (g < 8, n == d, 0 <= imm < 8)",
+	"lsl Zd.H, Pg/M, Zn.H, #imm (g < 8, n == d, 0 <= imm < 16)",
+	"lsl Zd.S, Pg/M, Zn.S, #imm (g < 8, n == d, 0 <= imm < 32)",
+	"lsl Zd.D, Pg/M, Zn.D, #imm (g < 8, n == d, 0 <= imm < 64)",
+	"lslv Wd, Wn, Wm",
+	"lslv Xd, Xn, Xm",
+	"lsr Wd, Wn, Wm",
+	"lsr Xd, Xn, Xm",
+	"lsr Wd, Wn, #imm (0 <= imm < 32)",
+	"lsr Xd, Xn, #imm (0 <= imm < 64)",
+	"lsr Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"lsr Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"lsr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"lsr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"lsr Zd.B, Zn.B, #imm (0 < imm <= 8)",
+	"lsr Zd.H, Zn.H, #imm (0 < imm <= 16)",
+	"lsr Zd.S, Zn.S, #imm (0 < imm <= 32)",
+	"lsr Zd.D, Zn.D, #imm (0 < imm <= 64)",
+	"lsr Zd.B, Pg/M, Zn.B, #imm (g < 8, n == d, 0 < imm <= 8)",
+	"lsr Zd.H, Pg/M, Zn.H, #imm (g < 8, n == d, 0 < imm <= 16)",
+	"lsr Zd.S, Pg/M, Zn.S, #imm (g < 8, n == d, 0 < imm <= 32)",
+	"lsr Zd.D, Pg/M, Zn.D, #imm (g < 8, n == d, 0 < imm <= 64)",
+	"lsrv Wd, Wn, Wm",
+	"lsrv Xd, Xn, Xm",
+	"madd Wd, Wn, Wm, Wa",
+	"madd Xd, Xn, Xm, Xa",
+	"mla Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nmla Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"mla Vd.4S, Vn.4S, Vm.S[i]\nmla Vd.2S, Vn.2S, Vm.S[i]",
+	"mla Vd.16B, Vn.16B, Vm.16B\nmla Vd.8B, Vn.8B, Vm.8B",
+	"mla Vd.8H, Vn.8H, Vm.8H\nmla Vd.4H, Vn.4H, Vm.4H",
+	"mla Vd.4S, Vn.4S, Vm.4S\nmla Vd.2S, Vn.2S, Vm.2S",
+	"mls Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nmls Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"mls Vd.4S, Vn.4S, Vm.S[i]\nmls Vd.2S, Vn.2S, Vm.S[i]",
+	"mls Vd.16B, Vn.16B, Vm.16B\nmls Vd.8B, Vn.8B, Vm.8B",
+	"mls Vd.8H, Vn.8H, Vm.8H\nmls Vd.4H, Vn.4H, Vm.4H",
+	"mls Vd.4S, Vn.4S, Vm.4S\nmls Vd.2S, Vn.2S, Vm.2S",
+	"mneg Wd, Wn, Wm",
+	"mneg Xd, Xn, Xm",
+	"mov Wd, Wn",
+	"mov Xd, Xn",
+	"mov Wd|WSP, Wn|WSP",
+	"mov Xd|SP, Xn|SP",
+	"mov Bd, Vn.B[i]",
+	"mov Hd, Vn.H[i]",
+	"mov Sd, Vn.S[i]",
+	"mov Dd, Vn.D[i]",
+	"mov Vd.B[i], Vn.B[i]",
+	"mov Vd.H[i], Vn.H[i]",
+	"mov Vd.S[i], Vn.S[i]",
+	"mov Vd.D[i], Vn.D[i]",
+	"mov Vd.B[i], Wn",
+	"mov Vd.H[i], Wn",
+	"mov Vd.S[i], Wn",
+	"mov Vd.D[i], Xn",
+	"mov INVERTED, Wn, #imm (imm is 32-bit inverted wide)",
+	"mov INVERTED, Xn, #imm (imm is 64-bit inverted wide)",
+	"mov Wd, #imm (imm is 32-bit wide)",
+	"mov Xd, #imm (imm is 64-bit wide)",
+	"mov Vd.16B, Vn.16B\nmov Vd.8B, Vn.8B",
+	"mov LOGICAL, Wn|WSP, #imm (imm is 32-bit logical)",
+	"mov LOGICAL, Xn|SP, #imm (imm is 64-bit logical)",
+	"mov Wd, Vn.S[i]",
+	"mov Xd, Vn.D[i]",
+	"mova ZAdH|V.B[W12-W15, #imm], Pg/M, Zn.B (0 <= imm < 16, g < 8)",
+	"mova ZAdH|V.H[W12-W15, #imm], Pg/M, Zn.H (d < 2, 0 <= imm < 8, g < 8)",
+	"mova ZAdH|V.S[W12-W15, #imm], Pg/M, Zn.S (d < 4, 0 <= imm < 4, g < 8)",
+	"mova ZAdH|V.D[W12-W15, #imm], Pg/M, Zn.D (d < 8, 0 <= imm < 2, g < 8)",
+	"mova ZAdH|V.Q[W12-W15, #imm], Pg/M, Zn.Q (d < 16, imm == 0, g < 8)",
+	"mova Zd.B, Pg/M, ZAnH|V.B[W12-W15, #imm] (g < 8, 0 <= imm < 16)",
+	"mova Zd.H, Pg/M, ZAnH|V.H[W12-W15, #imm] (g < 8, n < 2, 0 <= imm < 8)",
+	"mova Zd.S, Pg/M, ZAnH|V.S[W12-W15, #imm] (g < 8, n < 4, 0 <= imm < 4)",
+	"mova Zd.D, Pg/M, ZAnH|V.D[W12-W15, #imm] (g < 8, n < 8, 0 <= imm < 2)",
+	"mova Zd.Q, Pg/M, ZAnH|V.Q[W12-W15, #imm] (g < 8, n < 16, imm == 0)",
+	"movi Vd.16B, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 == 0)\nmovi Vd.8B, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 == 0)",
+	"movi Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])\nmovi Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])",
+	"movi Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])\nmovi Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])",
+	"movi Vd.4S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16])\nmovi Vd.2S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16])",
+	"movi Dd, #imm (imm is stretched)",
+	"movi Vd.2D, #imm (imm is stretched)",
+	"movk Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16])",
+	"movk Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])",
+	"movn Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16])",
+	"movn Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])",
+	"movprfx Zd, Zn",
+	"movprfx Zd.B, Pg/Z, Zn.B (g < 8)",
+	"movprfx Zd.B, Pg/M, Zn.B (g < 8)",
+	"movprfx Zd.H, Pg/Z, Zn.H (g < 8)",
+	"movprfx Zd.H, Pg/M, Zn.H (g < 8)",
+	"movprfx Zd.S, Pg/Z, Zn.S (g < 8)",
+	"movprfx Zd.S, Pg/M, Zn.S (g < 8)",
+	"movprfx Zd.D, Pg/Z, Zn.D (g < 8)",
+	"movprfx Zd.D, Pg/M, Zn.D (g < 8)",
+	"movz Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16])",
+	"movz Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])",
+	"mrs Xd, #imm (0 <= imm < 32768)",
+	"mrs Xd, <sysreg>",
+	"mrs Xd, SPSEL",
+	"mrs Xd, PAN",
+	"mrs Xd, UAO",
+	"mrs Xd, DIT",
+	"msr SVCRSM, #imm (0 <= imm < 2)",
+	"msr SVCRZA, #imm (0 <= imm < 2)",
+	"msr SVCRSMZA, #imm (0 <= imm < 2)",
+	"msr <symbol>, #imm (0 <= imm < 16)",
+	"msr #imm, Xn (0 <= imm < 32768)",
+	"msr <sysreg>, Xn",
+	"msr SPSEL, Xn",
+	"msr PAN, Xn",
+	"msr UAO, Xn",
+	"msr DIT, Xn",
+	"msub Wd, Wn, Wm, Wa",
+	"msub Xd, Xn, Xm, Xa",
+	"mul Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nmul Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"mul Vd.4S, Vn.4S, Vm.S[i]\nmul Vd.2S, Vn.2S, Vm.S[i]",
+	"mul Vd.16B, Vn.16B, Vm.16B\nmul Vd.8B, Vn.8B, Vm.8B",
+	"mul Vd.8H, Vn.8H, Vm.8H\nmul Vd.4H, Vn.4H, Vm.4H",
+	"mul Vd.4S, Vn.4S, Vm.4S\nmul Vd.2S, Vn.2S, Vm.2S",
+	"mul Wd, Wn, Wm",
+	"mul Xd, Xn, Xm",
+	"mul Zd.B, Zn.B, Zm.B",
+	"mul Zd.H, Zn.H, Zm.H",
+	"mul Zd.S, Zn.S, Zm.S",
+	"mul Zd.D, Zn.D, Zm.D",
+	"mul Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"mul Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"mul Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"mul Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"mul Zd.B, Zn.B, #imm (n == d, -128 <= imm < 128)",
+	"mul Zd.H, Zn.H, #imm (n == d, -128 <= imm < 128)",
+	"mul Zd.S, Zn.S, #imm (n == d, -128 <= imm < 128)",
+	"mul Zd.D, Zn.D, #imm (n == d, -128 <= imm < 128)",
+	"mvn Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"mvn Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"mvn Vd.16B, Vn.16B\nmvn Vd.8B, Vn.8B",
+	"mvni Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])\nmvni Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])",
+	"mvni Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])\nmvni Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])",
+	"mvni Vd.4S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16])\nmvni Vd.2S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16])",
+	"nbsl Zd.D, Zn.D, Zm.D, Za.D (n == d)",
+	"neg Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"neg Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"neg Dd, Dn",
+	"neg Vd.16B, Vn.16B\nneg Vd.8B, Vn.8B",
+	"neg Vd.8H, Vn.8H\nneg Vd.4H, Vn.4H",
+	"neg Vd.4S, Vn.4S\nneg Vd.2S, Vn.2S",
+	"neg Vd.2D, Vn.2D",
+	"neg Zd.B, Pg/M, Zn.B (g < 8)",
+	"neg Zd.H, Pg/M, Zn.H (g < 8)",
+	"neg Zd.S, Pg/M, Zn.S (g < 8)",
+	"neg Zd.D, Pg/M, Zn.D (g < 8)",
+	"negs Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"negs Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"ngc Wd, Wn",
+	"ngc Xd, Xn",
+	"ngcs Wd, Wn",
+	"ngcs Xd, Xn",
+	"nop",
+	"not Vd.16B, Vn.16B\nnot Vd.8B, Vn.8B",
+	"not Zd.B, Pg/M, Zn.B (g < 8)",
+	"not Zd.H, Pg/M, Zn.H (g < 8)",
+	"not Zd.S, Pg/M, Zn.S (g < 8)",
+	"not Zd.D, Pg/M, Zn.D (g < 8)",
+	"orn Vd.16B, Vn.16B, Vm.16B\norn Vd.8B, Vn.8B, Vm.8B",
+	"orn Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"orn Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"orr Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])\norr Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8])",
+	"orr Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])\norr Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])",
+	"orr Vd.16B, Vn.16B, Vm.16B\norr Vd.8B, Vn.8B, Vm.8B",
+	"orr Wd|WSP, Wn, #imm (imm is 32-bit logical)",
+	"orr Xd|SP, Xn, #imm (imm is 64-bit logical)",
+	"orr Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"orr Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"orr Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"orr Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"orr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"orr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"orr Zd.D, Zn.D, Zm.D",
+	"orr Zd.S, Zn.S, #imm (n == d, imm is 32-bit logical)",
+	"orr Zd.D, Zn.D, #imm (n == d, imm is 64-bit logical)",
+	"orv Bd, Pg, Zn.B (g < 8)",
+	"orv Hd, Pg, Zn.H (g < 8)",
+	"orv Sd, Pg, Zn.S (g < 8)",
+	"orv Dd, Pg, Zn.D (g < 8)",
+	"pacda Xd, Xn|SP",
+	"pacdb Xd, Xn|SP",
+	"pacdza Xd",
+	"pacdzb Xd",
+	"pacga Xd, Xn, Xm|SP",
+	"pacia Xd, Xn|SP",
+	"pacia1716",
+	"paciasp",
+	"paciaz",
+	"pacib Xd, Xn|SP",
+	"pacib1716",
+	"pacibsp",
+	"pacibz",
+	"paciza Xd",
+	"pacizb Xd",
+	"pfalse Pd.B",
+	"pmul Vd.16B, Vn.16B, Vm.16B\npmul Vd.8B, Vn.8B, Vm.8B",
+	"pmull Vd.8H, Vn.8B, Vm.8B",
+	"pmull Vd.1Q, Vn.1D, Vm.1D",
+	"pmull2 Vd.8H, Vn.16B, Vm.16B",
+	"pmull2 Vd.1Q, Vn.2D, Vm.2D",
+	"prfm #imm1, [Xn|SP {, #imm2 }] (0 <= imm1 < 32, 0 <= imm2 < 32768, imm2 >> 3)",
+	"prfm <symbol>, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3)",
+	"prfm #imm, <offset> (0 <= imm < 32, offset >> 2 is 19-bit (+/- 1 MB))",
+	"prfm <symbol>, <offset> (offset >> 2 is 19-bit (+/- 1 MB))",
+	"prfm #imm1, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm2 }] (0 <= imm1 < 32, imm2 in [0, 3])",
+	"prfm <symbol>, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 3])",
+	"prfum #imm1, [Xn|SP {, #imm2 }] (0 <= imm1 < 32, -256 <= imm2 < 256)",
+	"prfum <symbol>, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"psb CSYNC",
+	"pssbb",
+	"ptest Pd, Pn.B",
+	"ptrue Pd.B",
+	"ptrue Pd.B, <symbol>",
+	"ptrue Pd.H",
+	"ptrue Pd.H, <symbol>",
+	"ptrue Pd.S",
+	"ptrue Pd.S, <symbol>",
+	"ptrue Pd.D",
+	"ptrue Pd.D, <symbol>",
+	"ptrues Pd.B",
+	"ptrues Pd.B, <symbol>",
+	"ptrues Pd.H",
+	"ptrues Pd.H, <symbol>",
+	"ptrues Pd.S",
+	"ptrues Pd.S, <symbol>",
+	"ptrues Pd.D",
+	"ptrues Pd.D, <symbol>",
+	"raddhn Vd.8B, Vn.8H, Vm.8H",
+	"raddhn Vd.4H, Vn.4S, Vm.4S",
+	"raddhn Vd.2S, Vn.2D, Vm.2D",
+	"raddhn2 Vd.16B, Vn.8H, Vm.8H",
+	"raddhn2 Vd.8H, Vn.4S, Vm.4S",
+	"raddhn2 Vd.4S, Vn.2D, Vm.2D",
+	"rax1 Vd.2D, Vn.2D, Vm.2D",
+	"rbit Vd.16B, Vn.16B\nrbit Vd.8B, Vn.8B",
+	"rbit Wd, Wn",
+	"rbit Xd, Xn",
+	"rdffr Pd.B",
+	"rdffr Pd.B, Pg/Z",
+	"rdffrs Pd.B, Pg/Z",
+	"rdsvl Xd, #imm (-32 <= imm < 32)",
+	"rdvl Xd, #imm (-32 <= imm < 32)",
+	"ret Xd",
+	"ret",
+	"retaa",
+	"retab",
+	"rev Wd, Wn",
+	"rev Xd, Xn",
+	"rev Zd.B, Zn.B",
+	"rev Zd.H, Zn.H",
+	"rev Zd.S, Zn.S",
+	"rev Zd.D, Zn.D",
+	"rev Pd.B, Pn.B",
+	"rev Pd.H, Pn.H",
+	"rev Pd.S, Pn.S",
+	"rev Pd.D, Pn.D",
+	"rev16 Vd.16B, Vn.16B\nrev16 Vd.8B, Vn.8B",
+	"rev16 Wd, Wn",
+	"rev16 Xd, Xn",
+	"rev32 Vd.16B, Vn.16B\nrev32 Vd.8B, Vn.8B",
+	"rev32 Vd.8H, Vn.8H\nrev32 Vd.4H, Vn.4H",
+	"rev32 Xd, Xn",
+	"rev64 Vd.16B, Vn.16B\nrev64 Vd.8B, Vn.8B",
+	"rev64 Vd.8H, Vn.8H\nrev64 Vd.4H, Vn.4H",
+	"rev64 Vd.4S, Vn.4S\nrev64 Vd.2S, Vn.2S",
+	"rev64 Xd, Xn",
+	"rmif Xd, #imm1, #imm2 (0 <= imm1 < 64, 0 <= imm2 < 16)",
+	"ror Wd, Wn, #imm (0 <= imm < 32)",
+	"ror Xd, Xn, #imm (0 <= imm < 64)",
+	"ror Wd, Wn, Wm",
+	"ror Xd, Xn, Xm",
+	"rorv Wd, Wn, Wm",
+	"rorv Xd, Xn, Xm",
+	"rshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"rshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"rshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"rshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"rshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"rshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"rsubhn Vd.8B, Vn.8H, Vm.8H",
+	"rsubhn Vd.4H, Vn.4S, Vm.4S",
+	"rsubhn Vd.2S, Vn.2D, Vm.2D",
+	"rsubhn2 Vd.16B, Vn.8H, Vm.8H",
+	"rsubhn2 Vd.8H, Vn.4S, Vm.4S",
+	"rsubhn2 Vd.4S, Vn.2D, Vm.2D",
+	"saba Vd.16B, Vn.16B, Vm.16B\nsaba Vd.8B, Vn.8B, Vm.8B",
+	"saba Vd.8H, Vn.8H, Vm.8H\nsaba Vd.4H, Vn.4H, Vm.4H",
+	"saba Vd.4S, Vn.4S, Vm.4S\nsaba Vd.2S, Vn.2S, Vm.2S",
+	"sabal Vd.8H, Vn.8B, Vm.8B",
+	"sabal Vd.4S, Vn.4H, Vm.4H",
+	"sabal Vd.2D, Vn.2S, Vm.2S",
+	"sabal2 Vd.8H, Vn.16B, Vm.16B",
+	"sabal2 Vd.4S, Vn.8H, Vm.8H",
+	"sabal2 Vd.2D, Vn.4S, Vm.4S",
+	"sabd Vd.16B, Vn.16B, Vm.16B\nsabd Vd.8B, Vn.8B, Vm.8B",
+	"sabd Vd.8H, Vn.8H, Vm.8H\nsabd Vd.4H, Vn.4H, Vm.4H",
+	"sabd Vd.4S, Vn.4S, Vm.4S\nsabd Vd.2S, Vn.2S, Vm.2S",
+	"sabd Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"sabd Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"sabd Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"sabd Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"sabdl Vd.8H, Vn.8B, Vm.8B",
+	"sabdl Vd.4S, Vn.4H, Vm.4H",
+	"sabdl Vd.2D, Vn.2S, Vm.2S",
+	"sabdl2 Vd.8H, Vn.16B, Vm.16B",
+	"sabdl2 Vd.4S, Vn.8H, Vm.8H",
+	"sabdl2 Vd.2D, Vn.4S, Vm.4S",
+	"sadalp Vd.8H, Vn.16B\nsadalp Vd.4H, Vn.8B",
+	"sadalp Vd.4S, Vn.8H\nsadalp Vd.2S, Vn.4H",
+	"sadalp Vd.2D, Vn.4S\nsadalp Vd.1D, Vn.2S",
+	"saddl Vd.8H, Vn.8B, Vm.8B",
+	"saddl Vd.4S, Vn.4H, Vm.4H",
+	"saddl Vd.2D, Vn.2S, Vm.2S",
+	"saddl2 Vd.8H, Vn.16B, Vm.16B",
+	"saddl2 Vd.4S, Vn.8H, Vm.8H",
+	"saddl2 Vd.2D, Vn.4S, Vm.4S",
+	"saddlp Vd.8H, Vn.16B\nsaddlp Vd.4H, Vn.8B",
+	"saddlp Vd.4S, Vn.8H\nsaddlp Vd.2S, Vn.4H",
+	"saddlp Vd.2D, Vn.4S\nsaddlp Vd.1D, Vn.2S",
+	"saddlv Hd, Vn.16B\nsaddlv Hd, Vn.8B",
+	"saddlv Sd, Vn.8H\nsaddlv Sd, Vn.4H",
+	"saddlv Dd, Vn.4S",
+	"saddv Dd, Pg, Zn.B (g < 8)",
+	"saddv Dd, Pg, Zn.H (g < 8)",
+	"saddv Dd, Pg, Zn.S (g < 8)",
+	"saddw Vd.8H, Vn.8H, Vm.8B",
+	"saddw Vd.4S, Vn.4S, Vm.4H",
+	"saddw Vd.2D, Vn.2D, Vm.2S",
+	"saddw2 Vd.8H, Vn.8H, Vm.16B",
+	"saddw2 Vd.4S, Vn.4S, Vm.8H",
+	"saddw2 Vd.2D, Vn.2D, Vm.4S",
+	"sb",
+	"sbc Wd, Wn, Wm",
+	"sbc Xd, Xn, Xm",
+	"sbcs Wd, Wn, Wm",
+	"sbcs Xd, Xn, Xm",
+	"sbfiz Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)",
+	"sbfiz Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)",
+	"sbfm Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 <= imm2 < 32)",
+	"sbfm Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64)",
+	"sbfx Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)",
+	"sbfx Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)",
+	"scvtf Hd, Hn, #imm (0 < imm <= 16)",
+	"scvtf Sd, Sn, #imm (0 < imm <= 32)",
+	"scvtf Dd, Dn, #imm (0 < imm <= 64)",
+	"scvtf Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nscvtf Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"scvtf Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nscvtf Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"scvtf Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"scvtf Hd, Hn",
+	"scvtf Sd, Sn",
+	"scvtf Dd, Dn",
+	"scvtf Vd.8H, Vn.8H\nscvtf Vd.4H, Vn.4H",
+	"scvtf Vd.4S, Vn.4S\nscvtf Vd.2S, Vn.2S",
+	"scvtf Vd.2D, Vn.2D",
+	"scvtf Hd, Wn, #imm (0 < imm <= 32)",
+	"scvtf Sd, Wn, #imm (0 < imm <= 32)",
+	"scvtf Dd, Wn, #imm (0 < imm <= 32)",
+	"scvtf Hd, Xn, #imm (0 < imm <= 64)",
+	"scvtf Sd, Xn, #imm (0 < imm <= 64)",
+	"scvtf Dd, Xn, #imm (0 < imm <= 64)",
+	"scvtf Hd, Wn",
+	"scvtf Sd, Wn",
+	"scvtf Dd, Wn",
+	"scvtf Hd, Xn",
+	"scvtf Sd, Xn",
+	"scvtf Dd, Xn",
+	"scvtf Zd.H, Pg/M, Zn.H (g < 8)",
+	"scvtf Zd.S, Pg/M, Zn.S (g < 8)",
+	"scvtf Zd.D, Pg/M, Zn.D (g < 8)",
+	"sdiv Wd, Wn, Wm",
+	"sdiv Xd, Xn, Xm",
+	"sdiv Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"sdiv Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"sdivr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"sdivr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"sdot Vd.2S, Vn.8B, Vm.4B[i]",
+	"sdot Vd.4S, Vn.16B, Vm.4B[i]",
+	"sdot Vd.2S, Vn.8B, Vm.8B",
+	"sdot Vd.4S, Vn.16B, Vm.16B",
+	"sel Zd.B, Pg, Zn.B, Zm.B",
+	"sel Zd.H, Pg, Zn.H, Zm.H",
+	"sel Zd.S, Pg, Zn.S, Zm.S",
+	"sel Zd.D, Pg, Zn.D, Zm.D",
+	"sete [Xd]!, Xn, Xm",
+	"setf16 Wd",
+	"setf8 Wd",
+	"setffr",
+	"setge [Xd]!, Xn, Xm",
+	"setgm [Xd]!, Xn, Xm",
+	"setgp [Xd]!, Xn, Xm",
+	"setm [Xd]!, Xn, Xm",
+	"setp [Xd]!, Xn, Xm",
+	"sev",
+	"sevl",
+	"sha1c Qd, Sn, Vm.4S",
+	"sha1h Sd, Sn",
+	"sha1m Qd, Sn, Vm.4S",
+	"sha1p Qd, Sn, Vm.4S",
+	"sha1su0 Vd.4S, Vn.4S, Vm.4S",
+	"sha1su1 Vd.4S, Vn.4S",
+	"sha256h Qd, Qn, Vm.4S",
+	"sha256h2 Qd, Qn, Vm.4S",
+	"sha256su0 Vd.4S, Vn.4S",
+	"sha256su1 Vd.4S, Vn.4S, Vm.4S",
+	"sha512h Qd, Qn, Vm.2D",
+	"sha512h2 Qd, Qn, Vm.2D",
+	"sha512su0 Vd.2D, Vn.2D",
+	"sha512su1 Vd.2D, Vn.2D, Vm.2D",
+	"shadd Vd.16B, Vn.16B, Vm.16B\nshadd Vd.8B, Vn.8B, Vm.8B",
+	"shadd Vd.8H, Vn.8H, Vm.8H\nshadd Vd.4H, Vn.4H, Vm.4H",
+	"shadd Vd.4S, Vn.4S, Vm.4S\nshadd Vd.2S, Vn.2S, Vm.2S",
+	"shl Dd, Dn, #imm (0 <= imm < 64)",
+	"shl Vd.16B, Vn.16B, #imm (0 <= imm < 8)\nshl Vd.8B, Vn.8B, #imm (0 <= imm < 8)",
+	"shl Vd.8H, Vn.8H, #imm (0 <= imm < 16)\nshl Vd.4H, Vn.4H, #imm (0 <= imm < 16)",
+	"shl Vd.4S, Vn.4S, #imm (0 <= imm < 32)\nshl Vd.2S, Vn.2S, #imm (0 <= imm < 32)",
+	"shl Vd.2D, Vn.2D, #imm (0 <= imm < 64)",
+	"shll Vd.8H, Vn.8B, #8",
+	"shll Vd.4S, Vn.4H, #16",
+	"shll Vd.2D, Vn.2S, #32",
+	"shll2 Vd.8H, Vn.16B, #8",
+	"shll2 Vd.4S, Vn.8H, #16",
+	"shll2 Vd.2D, Vn.4S, #32",
+	"shrn Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"shrn Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"shrn Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"shrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"shrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"shrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"shsub Vd.16B, Vn.16B, Vm.16B\nshsub Vd.8B, Vn.8B, Vm.8B",
+	"shsub Vd.8H, Vn.8H, Vm.8H\nshsub Vd.4H, Vn.4H, Vm.4H",
+	"shsub Vd.4S, Vn.4S, Vm.4S\nshsub Vd.2S, Vn.2S, Vm.2S",
+	"sli Dd, Dn, #imm (0 <= imm < 64)",
+	"sli Vd.16B, Vn.16B, #imm (0 <= imm < 8)\nsli Vd.8B, Vn.8B, #imm (0 <= imm < 8)",
+	"sli Vd.8H, Vn.8H, #imm (0 <= imm < 16)\nsli Vd.4H, Vn.4H, #imm (0 <= imm < 16)",
+	"sli Vd.4S, Vn.4S, #imm (0 <= imm < 32)\nsli Vd.2S, Vn.2S, #imm (0 <= imm < 32)",
+	"sli Vd.2D, Vn.2D, #imm (0 <= imm < 64)",
+	"sm3partw1 Vd.4S, Vn.4S, Vm.4S",
+	"sm3partw2 Vd.4S, Vn.4S, Vm.4S",
+	"sm3ss1 Vd.4S, Vn.4S, Vm.4S, Va.4S",
+	"sm3tt1a Vd.4S, Vn.4S, Vm.S[i]",
+	"sm3tt1b Vd.4S, Vn.4S, Vm.S[i]",
+	"sm3tt2a Vd.4S, Vn.4S, Vm.S[i]",
+	"sm3tt2b Vd.4S, Vn.4S, Vm.S[i]",
+	"sm4e Vd.4S, Vn.4S",
+	"sm4ekey Vd.4S, Vn.4S, Vm.4S",
+	"smaddl Xd, Wn, Wm, Xa",
+	"smax Vd.16B, Vn.16B, Vm.16B\nsmax Vd.8B, Vn.8B, Vm.8B",
+	"smax Vd.8H, Vn.8H, Vm.8H\nsmax Vd.4H, Vn.4H, Vm.4H",
+	"smax Vd.4S, Vn.4S, Vm.4S\nsmax Vd.2S, Vn.2S, Vm.2S",
+	"smax Wd, Wn, #imm (-128 <= imm < 128)",
+	"smax Xd, Xn, #imm (-128 <= imm < 128)",
+	"smax Wd, Wn, Wm",
+	"smax Xd, Xn, Xm",
+	"smax Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"smax Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"smax Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"smax Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"smax Zd.B, Zn.B, #imm (n == d, -128 <= imm < 128)",
+	"smax Zd.H, Zn.H, #imm (n == d, -128 <= imm < 128)",
+	"smax Zd.S, Zn.S, #imm (n == d, -128 <= imm < 128)",
+	"smax Zd.D, Zn.D, #imm (n == d, -128 <= imm < 128)",
+	"smaxp Vd.16B, Vn.16B, Vm.16B\nsmaxp Vd.8B, Vn.8B, Vm.8B",
+	"smaxp Vd.8H, Vn.8H, Vm.8H\nsmaxp Vd.4H, Vn.4H, Vm.4H",
+	"smaxp Vd.4S, Vn.4S, Vm.4S\nsmaxp Vd.2S, Vn.2S, Vm.2S",
+	"smaxv Bd, Vn.16B\nsmaxv Bd, Vn.8B",
+	"smaxv Hd, Vn.8H\nsmaxv Hd, Vn.4H",
+	"smaxv Sd, Vn.4S",
+	"smaxv Bd, Pg, Zn.B (g < 8)",
+	"smaxv Hd, Pg, Zn.H (g < 8)",
+	"smaxv Sd, Pg, Zn.S (g < 8)",
+	"smaxv Dd, Pg, Zn.D (g < 8)",
+	"smc #imm (0 <= imm < 65536)",
+	"smin Vd.16B, Vn.16B, Vm.16B\nsmin Vd.8B, Vn.8B, Vm.8B",
+	"smin Vd.8H, Vn.8H, Vm.8H\nsmin Vd.4H, Vn.4H, Vm.4H",
+	"smin Vd.4S, Vn.4S, Vm.4S\nsmin Vd.2S, Vn.2S, Vm.2S",
+	"smin Wd, Wn, #imm (-128 <= imm < 128)",
+	"smin Xd, Xn, #imm (-128 <= imm < 128)",
+	"smin Wd, Wn, Wm",
+	"smin Xd, Xn, Xm",
+	"smin Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"smin Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"smin Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"smin Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"smin Zd.B, Zn.B, #imm (n == d, -128 <= imm < 128)",
+	"smin Zd.H, Zn.H, #imm (n == d, -128 <= imm < 128)",
+	"smin Zd.S, Zn.S, #imm (n == d, -128 <= imm < 128)",
+	"smin Zd.D, Zn.D, #imm (n == d, -128 <= imm < 128)",
+	"sminp Vd.16B, Vn.16B, Vm.16B\nsminp Vd.8B, Vn.8B, Vm.8B",
+	"sminp Vd.8H, Vn.8H, Vm.8H\nsminp Vd.4H, Vn.4H, Vm.4H",
+	"sminp Vd.4S, Vn.4S, Vm.4S\nsminp Vd.2S, Vn.2S, Vm.2S",
+	"sminv Bd, Vn.16B\nsminv Bd, Vn.8B",
+	"sminv Hd, Vn.8H\nsminv Hd, Vn.4H",
+	"sminv Sd, Vn.4S",
+	"sminv Bd, Pg, Zn.B (g < 8)",
+	"sminv Hd, Pg, Zn.H (g < 8)",
+	"sminv Sd, Pg, Zn.S (g < 8)",
+	"sminv Dd, Pg, Zn.D (g < 8)",
+	"smlal Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"smlal Vd.2D, Vn.2S, Vm.S[i]",
+	"smlal Vd.8H, Vn.8B, Vm.8B",
+	"smlal Vd.4S, Vn.4H, Vm.4H",
+	"smlal Vd.2D, Vn.2S, Vm.2S",
+	"smlal2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"smlal2 Vd.2D, Vn.4S, Vm.S[i]",
+	"smlal2 Vd.8H, Vn.16B, Vm.16B",
+	"smlal2 Vd.4S, Vn.8H, Vm.8H",
+	"smlal2 Vd.2D, Vn.4S, Vm.4S",
+	"smlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"smlsl Vd.2D, Vn.2S, Vm.S[i]",
+	"smlsl Vd.8H, Vn.8B, Vm.8B",
+	"smlsl Vd.4S, Vn.4H, Vm.4H",
+	"smlsl Vd.2D, Vn.2S, Vm.2S",
+	"smlsl2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"smlsl2 Vd.2D, Vn.4S, Vm.S[i]",
+	"smlsl2 Vd.8H, Vn.16B, Vm.16B",
+	"smlsl2 Vd.4S, Vn.8H, Vm.8H",
+	"smlsl2 Vd.2D, Vn.4S, Vm.4S",
+	"smmla Vd.4S, Vn.16B, Vm.16B",
+	"smnegl Xd, Wn, Wm",
+	"smopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"smopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"smops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"smops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"smov Wd, Vn.B[i]",
+	"smov Wd, Vn.H[i]",
+	"smov Xd, Vn.B[i]",
+	"smov Xd, Vn.H[i]",
+	"smov Xd, Vn.S[i]",
+	"smstart",
+	"smstart <symbol>",
+	"smstop",
+	"smstop <symbol>",
+	"smsubl Xd, Wn, Wm, Xa",
+	"smulh Xd, Xn, Xm",
+	"smulh Zd.B, Zn.B, Zm.B",
+	"smulh Zd.H, Zn.H, Zm.H",
+	"smulh Zd.S, Zn.S, Zm.S",
+	"smulh Zd.D, Zn.D, Zm.D",
+	"smulh Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"smulh Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"smulh Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"smulh Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"smull Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"smull Vd.2D, Vn.2S, Vm.S[i]",
+	"smull Vd.8H, Vn.8B, Vm.8B",
+	"smull Vd.4S, Vn.4H, Vm.4H",
+	"smull Vd.2D, Vn.2S, Vm.2S",
+	"smull Xd, Wn, Wm",
+	"smull2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"smull2 Vd.2D, Vn.4S, Vm.S[i]",
+	"smull2 Vd.8H, Vn.16B, Vm.16B",
+	"smull2 Vd.4S, Vn.8H, Vm.8H",
+	"smull2 Vd.2D, Vn.4S, Vm.4S",
+	"splice Zd.B, Pg, Zn.B, Zm.B (g < 8, n == d)",
+	"splice Zd.H, Pg, Zn.H, Zm.H (g < 8, n == d)",
+	"splice Zd.S, Pg, Zn.S, Zm.S (g < 8, n == d)",
+	"splice Zd.D, Pg, Zn.D, Zm.D (g < 8, n == d)",
+	"sqabs Bd, Bn",
+	"sqabs Hd, Hn",
+	"sqabs Sd, Sn",
+	"sqabs Dd, Dn",
+	"sqabs Vd.16B, Vn.16B\nsqabs Vd.8B, Vn.8B",
+	"sqabs Vd.8H, Vn.8H\nsqabs Vd.4H, Vn.4H",
+	"sqabs Vd.4S, Vn.4S\nsqabs Vd.2S, Vn.2S",
+	"sqabs Vd.2D, Vn.2D",
+	"sqadd Bd, Bn, Bm",
+	"sqadd Hd, Hn, Hm",
+	"sqadd Sd, Sn, Sm",
+	"sqadd Dd, Dn, Dm",
+	"sqadd Vd.16B, Vn.16B, Vm.16B\nsqadd Vd.8B, Vn.8B, Vm.8B",
+	"sqadd Vd.8H, Vn.8H, Vm.8H\nsqadd Vd.4H, Vn.4H, Vm.4H",
+	"sqadd Vd.4S, Vn.4S, Vm.4S\nsqadd Vd.2S, Vn.2S, Vm.2S",
+	"sqadd Vd.2D, Vn.2D, Vm.2D",
+	"sqadd Zd.B, Zn.B, Zm.B",
+	"sqadd Zd.H, Zn.H, Zm.H",
+	"sqadd Zd.S, Zn.S, Zm.S",
+	"sqadd Zd.D, Zn.D, Zm.D",
+	"sqadd Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"sqadd Zd.H, Zn.H, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sqadd Zd.S, Zn.S, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sqadd Zd.D, Zn.D, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sqdmlal Sd, Hn, Vm.H[i] (m < 16)",
+	"sqdmlal Dd, Sn, Vm.S[i]",
+	"sqdmlal Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"sqdmlal Vd.2D, Vn.2S, Vm.S[i]",
+	"sqdmlal Sd, Hn, Hm",
+	"sqdmlal Dd, Sn, Sm",
+	"sqdmlal Vd.4S, Vn.4H, Vm.4H",
+	"sqdmlal Vd.2D, Vn.2S, Vm.2S",
+	"sqdmlal2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"sqdmlal2 Vd.2D, Vn.4S, Vm.S[i]",
+	"sqdmlal2 Vd.4S, Vn.8H, Vm.8H",
+	"sqdmlal2 Vd.2D, Vn.4S, Vm.4S",
+	"sqdmlsl Sd, Hn, Vm.H[i] (m < 16)",
+	"sqdmlsl Dd, Sn, Vm.S[i]",
+	"sqdmlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"sqdmlsl Vd.2D, Vn.2S, Vm.S[i]",
+	"sqdmlsl Sd, Hn, Hm",
+	"sqdmlsl Dd, Sn, Sm",
+	"sqdmlsl Vd.4S, Vn.4H, Vm.4H",
+	"sqdmlsl Vd.2D, Vn.2S, Vm.2S",
+	"sqdmlsl2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"sqdmlsl2 Vd.2D, Vn.4S, Vm.S[i]",
+	"sqdmlsl2 Vd.4S, Vn.8H, Vm.8H",
+	"sqdmlsl2 Vd.2D, Vn.4S, Vm.4S",
+	"sqdmulh Hd, Hn, Vm.H[i] (m < 16)",
+	"sqdmulh Sd, Sn, Vm.S[i]",
+	"sqdmulh Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nsqdmulh Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"sqdmulh Vd.4S, Vn.4S, Vm.S[i]\nsqdmulh Vd.2S, Vn.2S, Vm.S[i]",
+	"sqdmulh Hd, Hn, Hm",
+	"sqdmulh Sd, Sn, Sm",
+	"sqdmulh Vd.8H, Vn.8H, Vm.8H\nsqdmulh Vd.4H, Vn.4H, Vm.4H",
+	"sqdmulh Vd.4S, Vn.4S, Vm.4S\nsqdmulh Vd.2S, Vn.2S, Vm.2S",
+	"sqdmulh Zd.B, Zn.B, Zm.B",
+	"sqdmulh Zd.H, Zn.H, Zm.H",
+	"sqdmulh Zd.S, Zn.S, Zm.S",
+	"sqdmulh Zd.D, Zn.D, Zm.D",
+	"sqdmull Sd, Hn, Vm.H[i] (m < 16)",
+	"sqdmull Dd, Sn, Vm.S[i]",
+	"sqdmull Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"sqdmull Vd.2D, Vn.2S, Vm.S[i]",
+	"sqdmull Sd, Hn, Hm",
+	"sqdmull Dd, Sn, Sm",
+	"sqdmull Vd.4S, Vn.4H, Vm.4H",
+	"sqdmull Vd.2D, Vn.2S, Vm.2S",
+	"sqdmull2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"sqdmull2 Vd.2D, Vn.4S, Vm.S[i]",
+	"sqdmull2 Vd.4S, Vn.8H, Vm.8H",
+	"sqdmull2 Vd.2D, Vn.4S, Vm.4S",
+	"sqneg Bd, Bn",
+	"sqneg Hd, Hn",
+	"sqneg Sd, Sn",
+	"sqneg Dd, Dn",
+	"sqneg Vd.16B, Vn.16B\nsqneg Vd.8B, Vn.8B",
+	"sqneg Vd.8H, Vn.8H\nsqneg Vd.4H, Vn.4H",
+	"sqneg Vd.4S, Vn.4S\nsqneg Vd.2S, Vn.2S",
+	"sqneg Vd.2D, Vn.2D",
+	"sqrdmlah Hd, Hn, Vm.H[i] (m < 16)",
+	"sqrdmlah Sd, Sn, Vm.S[i]",
+	"sqrdmlah Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nsqrdmlah Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"sqrdmlah Vd.4S, Vn.4S, Vm.S[i]\nsqrdmlah Vd.2S, Vn.2S, Vm.S[i]",
+	"sqrdmlah Hd, Hn, Hm",
+	"sqrdmlah Sd, Sn, Sm",
+	"sqrdmlah Vd.8H, Vn.8H, Vm.8H\nsqrdmlah Vd.4H, Vn.4H, Vm.4H",
+	"sqrdmlah Vd.4S, Vn.4S, Vm.4S\nsqrdmlah Vd.2S, Vn.2S, Vm.2S",
+	"sqrdmlsh Hd, Hn, Vm.H[i] (m < 16)",
+	"sqrdmlsh Sd, Sn, Vm.S[i]",
+	"sqrdmlsh Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nsqrdmlsh Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"sqrdmlsh Vd.4S, Vn.4S, Vm.S[i]\nsqrdmlsh Vd.2S, Vn.2S, Vm.S[i]",
+	"sqrdmlsh Hd, Hn, Hm",
+	"sqrdmlsh Sd, Sn, Sm",
+	"sqrdmlsh Vd.8H, Vn.8H, Vm.8H\nsqrdmlsh Vd.4H, Vn.4H, Vm.4H",
+	"sqrdmlsh Vd.4S, Vn.4S, Vm.4S\nsqrdmlsh Vd.2S, Vn.2S, Vm.2S",
+	"sqrdmulh Hd, Hn, Vm.H[i] (m < 16)",
+	"sqrdmulh Sd, Sn, Vm.S[i]",
+	"sqrdmulh Vd.8H, Vn.8H, Vm.H[i] (m < 16)\nsqrdmulh Vd.4H, Vn.4H, Vm.H[i] (m < 16)",
+	"sqrdmulh Vd.4S, Vn.4S, Vm.S[i]\nsqrdmulh Vd.2S, Vn.2S, Vm.S[i]",
+	"sqrdmulh Hd, Hn, Hm",
+	"sqrdmulh Sd, Sn, Sm",
+	"sqrdmulh Vd.8H, Vn.8H, Vm.8H\nsqrdmulh Vd.4H, Vn.4H, Vm.4H",
+	"sqrdmulh Vd.4S, Vn.4S, Vm.4S\nsqrdmulh Vd.2S, Vn.2S, Vm.2S",
+	"sqrshl Bd, Bn, Bm",
+	"sqrshl Hd, Hn, Hm",
+	"sqrshl Sd, Sn, Sm",
+	"sqrshl Dd, Dn, Dm",
+	"sqrshl Vd.16B, Vn.16B, Vm.16B\nsqrshl Vd.8B, Vn.8B, Vm.8B",
+	"sqrshl Vd.8H, Vn.8H, Vm.8H\nsqrshl Vd.4H, Vn.4H, Vm.4H",
+	"sqrshl Vd.4S, Vn.4S, Vm.4S\nsqrshl Vd.2S, Vn.2S, Vm.2S",
+	"sqrshl Vd.2D, Vn.2D, Vm.2D",
+	"sqrshrn Bd, Hn, #imm (0 < imm <= 8)",
+	"sqrshrn Hd, Sn, #imm (0 < imm <= 16)",
+	"sqrshrn Sd, Dn, #imm (0 < imm <= 32)",
+	"sqrshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqrshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqrshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqrshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqrshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqrshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqrshrun Bd, Hn, #imm (0 < imm <= 8)",
+	"sqrshrun Hd, Sn, #imm (0 < imm <= 16)",
+	"sqrshrun Sd, Dn, #imm (0 < imm <= 32)",
+	"sqrshrun Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqrshrun Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqrshrun Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqrshrun2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqrshrun2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqrshrun2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqshl Bd, Bn, #imm (0 <= imm < 8)",
+	"sqshl Hd, Hn, #imm (0 <= imm < 16)",
+	"sqshl Sd, Sn, #imm (0 <= imm < 32)",
+	"sqshl Dd, Dn, #imm (0 <= imm < 64)",
+	"sqshl Vd.16B, Vn.16B, #imm (0 <= imm < 8)\nsqshl Vd.8B, Vn.8B, #imm (0 <= imm < 8)",
+	"sqshl Vd.8H, Vn.8H, #imm (0 <= imm < 16)\nsqshl Vd.4H, Vn.4H, #imm (0 <= imm < 16)",
+	"sqshl Vd.4S, Vn.4S, #imm (0 <= imm < 32)\nsqshl Vd.2S, Vn.2S, #imm (0 <= imm < 32)",
+	"sqshl Vd.2D, Vn.2D, #imm (0 <= imm < 64)",
+	"sqshl Bd, Bn, Bm",
+	"sqshl Hd, Hn, Hm",
+	"sqshl Sd, Sn, Sm",
+	"sqshl Dd, Dn, Dm",
+	"sqshl Vd.16B, Vn.16B, Vm.16B\nsqshl Vd.8B, Vn.8B, Vm.8B",
+	"sqshl Vd.8H, Vn.8H, Vm.8H\nsqshl Vd.4H, Vn.4H, Vm.4H",
+	"sqshl Vd.4S, Vn.4S, Vm.4S\nsqshl Vd.2S, Vn.2S, Vm.2S",
+	"sqshl Vd.2D, Vn.2D, Vm.2D",
+	"sqshlu Bd, Bn, #imm (0 <= imm < 8)",
+	"sqshlu Hd, Hn, #imm (0 <= imm < 16)",
+	"sqshlu Sd, Sn, #imm (0 <= imm < 32)",
+	"sqshlu Dd, Dn, #imm (0 <= imm < 64)",
+	"sqshlu Vd.16B, Vn.16B, #imm (0 <= imm < 8)\nsqshlu Vd.8B, Vn.8B, #imm (0 <= imm < 8)",
+	"sqshlu Vd.8H, Vn.8H, #imm (0 <= imm < 16)\nsqshlu Vd.4H, Vn.4H, #imm (0 <= imm < 16)",
+	"sqshlu Vd.4S, Vn.4S, #imm (0 <= imm < 32)\nsqshlu Vd.2S, Vn.2S, #imm (0 <= imm < 32)",
+	"sqshlu Vd.2D, Vn.2D, #imm (0 <= imm < 64)",
+	"sqshrn Bd, Hn, #imm (0 < imm <= 8)",
+	"sqshrn Hd, Sn, #imm (0 < imm <= 16)",
+	"sqshrn Sd, Dn, #imm (0 < imm <= 32)",
+	"sqshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqshrun Bd, Hn, #imm (0 < imm <= 8)",
+	"sqshrun Hd, Sn, #imm (0 < imm <= 16)",
+	"sqshrun Sd, Dn, #imm (0 < imm <= 32)",
+	"sqshrun Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqshrun Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqshrun Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqshrun2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"sqshrun2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"sqshrun2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"sqsub Bd, Bn, Bm",
+	"sqsub Hd, Hn, Hm",
+	"sqsub Sd, Sn, Sm",
+	"sqsub Dd, Dn, Dm",
+	"sqsub Vd.16B, Vn.16B, Vm.16B\nsqsub Vd.8B, Vn.8B, Vm.8B",
+	"sqsub Vd.8H, Vn.8H, Vm.8H\nsqsub Vd.4H, Vn.4H, Vm.4H",
+	"sqsub Vd.4S, Vn.4S, Vm.4S\nsqsub Vd.2S, Vn.2S, Vm.2S",
+	"sqsub Vd.2D, Vn.2D, Vm.2D",
+	"sqsub Zd.B, Zn.B, Zm.B",
+	"sqsub Zd.H, Zn.H, Zm.H",
+	"sqsub Zd.S, Zn.S, Zm.S",
+	"sqsub Zd.D, Zn.D, Zm.D",
+	"sqsub Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"sqsub Zd.H, Zn.H, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sqsub Zd.S, Zn.S, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sqsub Zd.D, Zn.D, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sqxtn Bd, Hn",
+	"sqxtn Hd, Sn",
+	"sqxtn Sd, Dn",
+	"sqxtn Vd.8B, Vn.8H",
+	"sqxtn Vd.4H, Vn.4S",
+	"sqxtn Vd.2S, Vn.2D",
+	"sqxtn2 Vd.16B, Vn.8H",
+	"sqxtn2 Vd.8H, Vn.4S",
+	"sqxtn2 Vd.4S, Vn.2D",
+	"sqxtun Bd, Hn",
+	"sqxtun Hd, Sn",
+	"sqxtun Sd, Dn",
+	"sqxtun Vd.8B, Vn.8H",
+	"sqxtun Vd.4H, Vn.4S",
+	"sqxtun Vd.2S, Vn.2D",
+	"sqxtun2 Vd.16B, Vn.8H",
+	"sqxtun2 Vd.8H, Vn.4S",
+	"sqxtun2 Vd.4S, Vn.2D",
+	"srhadd Vd.16B, Vn.16B, Vm.16B\nsrhadd Vd.8B, Vn.8B, Vm.8B",
+	"srhadd Vd.8H, Vn.8H, Vm.8H\nsrhadd Vd.4H, Vn.4H, Vm.4H",
+	"srhadd Vd.4S, Vn.4S, Vm.4S\nsrhadd Vd.2S, Vn.2S, Vm.2S",
+	"sri Dd, Dn, #imm (0 < imm <= 64)",
+	"sri Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nsri Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"sri Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nsri Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"sri Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nsri Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"sri Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"srshl Dd, Dn, Dm",
+	"srshl Vd.16B, Vn.16B, Vm.16B\nsrshl Vd.8B, Vn.8B, Vm.8B",
+	"srshl Vd.8H, Vn.8H, Vm.8H\nsrshl Vd.4H, Vn.4H, Vm.4H",
+	"srshl Vd.4S, Vn.4S, Vm.4S\nsrshl Vd.2S, Vn.2S, Vm.2S",
+	"srshl Vd.2D, Vn.2D, Vm.2D",
+	"srshr Dd, Dn, #imm (0 < imm <= 64)",
+	"srshr Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nsrshr Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"srshr Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nsrshr Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"srshr Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nsrshr Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"srshr Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"srsra Dd, Dn, #imm (0 < imm <= 64)",
+	"srsra Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nsrsra Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"srsra Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nsrsra Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"srsra Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nsrsra Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"srsra Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"ssbb",
+	"sshl Dd, Dn, Dm",
+	"sshl Vd.16B, Vn.16B, Vm.16B\nsshl Vd.8B, Vn.8B, Vm.8B",
+	"sshl Vd.8H, Vn.8H, Vm.8H\nsshl Vd.4H, Vn.4H, Vm.4H",
+	"sshl Vd.4S, Vn.4S, Vm.4S\nsshl Vd.2S, Vn.2S, Vm.2S",
+	"sshl Vd.2D, Vn.2D, Vm.2D",
+	"sshll Vd.8H, Vn.8B, #imm (0 <= imm < 8)",
+	"sshll Vd.4S, Vn.4H, #imm (0 <= imm < 16)",
+	"sshll Vd.2D, Vn.2S, #imm (0 <= imm < 32)",
+	"sshll2 Vd.8H, Vn.16B, #imm (0 <= imm < 8)",
+	"sshll2 Vd.4S, Vn.8H, #imm (0 <= imm < 16)",
+	"sshll2 Vd.2D, Vn.4S, #imm (0 <= imm < 32)",
+	"sshr Dd, Dn, #imm (0 < imm <= 64)",
+	"sshr Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nsshr Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"sshr Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nsshr Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"sshr Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nsshr Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"sshr Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"ssra Dd, Dn, #imm (0 < imm <= 64)",
+	"ssra Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nssra Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"ssra Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nssra Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"ssra Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nssra Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"ssra Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"ssubl Vd.8H, Vn.8B, Vm.8B",
+	"ssubl Vd.4S, Vn.4H, Vm.4H",
+	"ssubl Vd.2D, Vn.2S, Vm.2S",
+	"ssubl2 Vd.8H, Vn.16B, Vm.16B",
+	"ssubl2 Vd.4S, Vn.8H, Vm.8H",
+	"ssubl2 Vd.2D, Vn.4S, Vm.4S",
+	"ssubw Vd.8H, Vn.8H, Vm.8B",
+	"ssubw Vd.4S, Vn.4S, Vm.4H",
+	"ssubw Vd.2D, Vn.2D, Vm.2S",
+	"ssubw2 Vd.8H, Vn.8H, Vm.16B",
+	"ssubw2 Vd.4S, Vn.4S, Vm.8H",
+	"ssubw2 Vd.2D, Vn.2D, Vm.4S",
+	"st1 {Vd.16B * 1}, [Xn|SP]\nst1 {Vd.8B * 1}, [Xn|SP]",
+	"st1 {Vd.8H * 1}, [Xn|SP]\nst1 {Vd.4H * 1}, [Xn|SP]",
+	"st1 {Vd.4S * 1}, [Xn|SP]\nst1 {Vd.2S * 1}, [Xn|SP]",
+	"st1 {Vd.2D * 1}, [Xn|SP]\nst1 {Vd.1D * 1}, [Xn|SP]",
+	"st1 {Vd.16B * 2}, [Xn|SP]\nst1 {Vd.8B * 2}, [Xn|SP]",
+	"st1 {Vd.8H * 2}, [Xn|SP]\nst1 {Vd.4H * 2}, [Xn|SP]",
+	"st1 {Vd.4S * 2}, [Xn|SP]\nst1 {Vd.2S * 2}, [Xn|SP]",
+	"st1 {Vd.2D * 2}, [Xn|SP]\nst1 {Vd.1D * 2}, [Xn|SP]",
+	"st1 {Vd.16B * 3}, [Xn|SP]\nst1 {Vd.8B * 3}, [Xn|SP]",
+	"st1 {Vd.8H * 3}, [Xn|SP]\nst1 {Vd.4H * 3}, [Xn|SP]",
+	"st1 {Vd.4S * 3}, [Xn|SP]\nst1 {Vd.2S * 3}, [Xn|SP]",
+	"st1 {Vd.2D * 3}, [Xn|SP]\nst1 {Vd.1D * 3}, [Xn|SP]",
+	"st1 {Vd.16B * 4}, [Xn|SP]\nst1 {Vd.8B * 4}, [Xn|SP]",
+	"st1 {Vd.8H * 4}, [Xn|SP]\nst1 {Vd.4H * 4}, [Xn|SP]",
+	"st1 {Vd.4S * 4}, [Xn|SP]\nst1 {Vd.2S * 4}, [Xn|SP]",
+	"st1 {Vd.2D * 4}, [Xn|SP]\nst1 {Vd.1D * 4}, [Xn|SP]",
+	"st1 {Vd.8B * 1}, [Xn|SP], #8",
+	"st1 {Vd.4H * 1}, [Xn|SP], #8",
+	"st1 {Vd.2S * 1}, [Xn|SP], #8",
+	"st1 {Vd.1D * 1}, [Xn|SP], #8",
+	"st1 {Vd.16B * 1}, [Xn|SP], #16",
+	"st1 {Vd.8H * 1}, [Xn|SP], #16",
+	"st1 {Vd.4S * 1}, [Xn|SP], #16",
+	"st1 {Vd.2D * 1}, [Xn|SP], #16",
+	"st1 {Vd.16B * 1}, [Xn|SP], Xm (m != 31)\nst1 {Vd.8B * 1}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.8H * 1}, [Xn|SP], Xm (m != 31)\nst1 {Vd.4H * 1}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.4S * 1}, [Xn|SP], Xm (m != 31)\nst1 {Vd.2S * 1}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.2D * 1}, [Xn|SP], Xm (m != 31)\nst1 {Vd.1D * 1}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.8B * 2}, [Xn|SP], #16",
+	"st1 {Vd.4H * 2}, [Xn|SP], #16",
+	"st1 {Vd.2S * 2}, [Xn|SP], #16",
+	"st1 {Vd.1D * 2}, [Xn|SP], #16",
+	"st1 {Vd.16B * 2}, [Xn|SP], #32",
+	"st1 {Vd.8H * 2}, [Xn|SP], #32",
+	"st1 {Vd.4S * 2}, [Xn|SP], #32",
+	"st1 {Vd.2D * 2}, [Xn|SP], #32",
+	"st1 {Vd.16B * 2}, [Xn|SP], Xm (m != 31)\nst1 {Vd.8B * 2}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.8H * 2}, [Xn|SP], Xm (m != 31)\nst1 {Vd.4H * 2}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.4S * 2}, [Xn|SP], Xm (m != 31)\nst1 {Vd.2S * 2}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.2D * 2}, [Xn|SP], Xm (m != 31)\nst1 {Vd.1D * 2}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.8B * 3}, [Xn|SP], #24",
+	"st1 {Vd.4H * 3}, [Xn|SP], #24",
+	"st1 {Vd.2S * 3}, [Xn|SP], #24",
+	"st1 {Vd.1D * 3}, [Xn|SP], #24",
+	"st1 {Vd.16B * 3}, [Xn|SP], #48",
+	"st1 {Vd.8H * 3}, [Xn|SP], #48",
+	"st1 {Vd.4S * 3}, [Xn|SP], #48",
+	"st1 {Vd.2D * 3}, [Xn|SP], #48",
+	"st1 {Vd.16B * 3}, [Xn|SP], Xm (m != 31)\nst1 {Vd.8B * 3}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.8H * 3}, [Xn|SP], Xm (m != 31)\nst1 {Vd.4H * 3}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.4S * 3}, [Xn|SP], Xm (m != 31)\nst1 {Vd.2S * 3}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.2D * 3}, [Xn|SP], Xm (m != 31)\nst1 {Vd.1D * 3}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.8B * 4}, [Xn|SP], #32",
+	"st1 {Vd.4H * 4}, [Xn|SP], #32",
+	"st1 {Vd.2S * 4}, [Xn|SP], #32",
+	"st1 {Vd.1D * 4}, [Xn|SP], #32",
+	"st1 {Vd.16B * 4}, [Xn|SP], #64",
+	"st1 {Vd.8H * 4}, [Xn|SP], #64",
+	"st1 {Vd.4S * 4}, [Xn|SP], #64",
+	"st1 {Vd.2D * 4}, [Xn|SP], #64",
+	"st1 {Vd.16B * 4}, [Xn|SP], Xm (m != 31)\nst1 {Vd.8B * 4}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.8H * 4}, [Xn|SP], Xm (m != 31)\nst1 {Vd.4H * 4}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.4S * 4}, [Xn|SP], Xm (m != 31)\nst1 {Vd.2S * 4}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.2D * 4}, [Xn|SP], Xm (m != 31)\nst1 {Vd.1D * 4}, [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.B * 1}[i], [Xn|SP]",
+	"st1 {Vd.H * 1}[i], [Xn|SP]",
+	"st1 {Vd.S * 1}[i], [Xn|SP]",
+	"st1 {Vd.D * 1}[i], [Xn|SP]",
+	"st1 {Vd.B * 1}[i], [Xn|SP], #1",
+	"st1 {Vd.B * 1}[i], [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.H * 1}[i], [Xn|SP], #2",
+	"st1 {Vd.H * 1}[i], [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.S * 1}[i], [Xn|SP], #4",
+	"st1 {Vd.S * 1}[i], [Xn|SP], Xm (m != 31)",
+	"st1 {Vd.D * 1}[i], [Xn|SP], #8",
+	"st1 {Vd.D * 1}[i], [Xn|SP], Xm (m != 31)",
+	"st1b ZAdH|V.B[W12-W15, #imm], Pg, [Xn|SP, Xm] (0 <= imm < 16, g < 8)",
+	"st1b ZAdH|V.B[W12-W15, #imm], Pg, [Xn|SP] (0 <= imm < 16, g < 8)",
+	"st1b {Zd.B * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1b {Zd.H * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1b {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1b {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1b {Zd.B * 1}, Pg, [Xn|SP, Xm] (g < 8, m != 31)",
+	"st1b {Zd.H * 1}, Pg, [Xn|SP, Xm] (g < 8, m != 31)",
+	"st1b {Zd.S * 1}, Pg, [Xn|SP, Xm] (g < 8, m != 31)",
+	"st1b {Zd.D * 1}, Pg, [Xn|SP, Xm] (g < 8, m != 31)",
+	"st1b {Zd.D * 1}, Pg, [Xn|SP, Zm.D] (g < 8)",
+	"st1b {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW] (g < 8)",
+	"st1b {Zd.S * 1}, Pg, [Zn.S {, #imm }] (g < 8, 0 <= imm < 32, imm >> 0)",
+	"st1b {Zd.D * 1}, Pg, [Zn.D {, #imm }] (g < 8, 0 <= imm < 32, imm >> 0)",
+	"st1d ZAdH|V.D[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #3] (d < 8, 0 <= imm < 2, g < 8)",
+	"st1d ZAdH|V.D[W12-W15, #imm], Pg, [Xn|SP] (d < 8, 0 <= imm < 2, g < 8)",
+	"st1d {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1d {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #3] (g < 8, m != 31)",
+	"st1d {Zd.D * 1}, Pg, [Xn|SP, Zm.D] (g < 8)",
+	"st1d {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #3] (g < 8)",
+	"st1h ZAdH|V.H[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #1] (d < 2, 0 <= imm < 8, g < 8)",
+	"st1h ZAdH|V.H[W12-W15, #imm], Pg, [Xn|SP] (d < 2, 0 <= imm < 8, g < 8)",
+	"st1h {Zd.H * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1h {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1h {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1h {Zd.H * 1}, Pg, [Xn|SP, Xm, LSL #1] (g < 8, m != 31)",
+	"st1h {Zd.S * 1}, Pg, [Xn|SP, Xm, LSL #1] (g < 8, m != 31)",
+	"st1h {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #1] (g < 8, m != 31)",
+	"st1h {Zd.D * 1}, Pg, [Xn|SP, Zm.D] (g < 8)",
+	"st1h {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #1] (g < 8)",
+	"st1h {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW] (g < 8)",
+	"st1h {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW #1] (g < 8)",
+	"st1h {Zd.S * 1}, Pg, [Zn.S {, #imm }] (g < 8, 0 <= imm < 64, imm >> 1)",
+	"st1h {Zd.D * 1}, Pg, [Zn.D {, #imm }] (g < 8, 0 <= imm < 64, imm >> 1)",
+	"st1q ZAdH|V.Q[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #4] (d < 16, imm == 0, g < 8)",
+	"st1q ZAdH|V.Q[W12-W15, #imm], Pg, [Xn|SP] (d < 16, imm == 0, g < 8)",
+	"st1w ZAdH|V.S[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #2] (d < 4, 0 <= imm < 4, g < 8)",
+	"st1w ZAdH|V.S[W12-W15, #imm], Pg, [Xn|SP] (d < 4, 0 <= imm < 4, g < 8)",
+	"st1w {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1w {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }] (g < 8, -8 <= imm < 8)",
+	"st1w {Zd.S * 1}, Pg, [Xn|SP, Xm, LSL #2] (g < 8, m != 31)",
+	"st1w {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #2] (g < 8, m != 31)",
+	"st1w {Zd.D * 1}, Pg, [Xn|SP, Zm.D] (g < 8)",
+	"st1w {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #2] (g < 8)",
+	"st1w {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW] (g < 8)",
+	"st1w {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW #2] (g < 8)",
+	"st1w {Zd.S * 1}, Pg, [Zn.S {, #imm }] (g < 8, 0 <= imm < 128, imm >> 2)",
+	"st1w {Zd.D * 1}, Pg, [Zn.D {, #imm }] (g < 8, 0 <= imm < 128, imm >> 2)",
+	"st2 {Vd.16B * 2}, [Xn|SP]\nst2 {Vd.8B * 2}, [Xn|SP]",
+	"st2 {Vd.8H * 2}, [Xn|SP]\nst2 {Vd.4H * 2}, [Xn|SP]",
+	"st2 {Vd.4S * 2}, [Xn|SP]\nst2 {Vd.2S * 2}, [Xn|SP]",
+	"st2 {Vd.2D * 2}, [Xn|SP]",
+	"st2 {Vd.8B * 2}, [Xn|SP], #16",
+	"st2 {Vd.4H * 2}, [Xn|SP], #16",
+	"st2 {Vd.2S * 2}, [Xn|SP], #16",
+	"st2 {Vd.16B * 2}, [Xn|SP], #32",
+	"st2 {Vd.8H * 2}, [Xn|SP], #32",
+	"st2 {Vd.4S * 2}, [Xn|SP], #32",
+	"st2 {Vd.2D * 2}, [Xn|SP], #32",
+	"st2 {Vd.16B * 2}, [Xn|SP], Xm (m != 31)\nst2 {Vd.8B * 2}, [Xn|SP], Xm (m != 31)",
+	"st2 {Vd.8H * 2}, [Xn|SP], Xm (m != 31)\nst2 {Vd.4H * 2}, [Xn|SP], Xm (m != 31)",
+	"st2 {Vd.4S * 2}, [Xn|SP], Xm (m != 31)\nst2 {Vd.2S * 2}, [Xn|SP], Xm (m != 31)",
+	"st2 {Vd.2D * 2}, [Xn|SP], Xm (m != 31)",
+	"st2 {Vd.B * 2}[i], [Xn|SP]",
+	"st2 {Vd.H * 2}[i], [Xn|SP]",
+	"st2 {Vd.S * 2}[i], [Xn|SP]",
+	"st2 {Vd.D * 2}[i], [Xn|SP]",
+	"st2 {Vd.B * 2}[i], [Xn|SP], #2",
+	"st2 {Vd.B * 2}[i], [Xn|SP], Xm (m != 31)",
+	"st2 {Vd.H * 2}[i], [Xn|SP], #4",
+	"st2 {Vd.H * 2}[i], [Xn|SP], Xm (m != 31)",
+	"st2 {Vd.S * 2}[i], [Xn|SP], #8",
+	"st2 {Vd.S * 2}[i], [Xn|SP], Xm (m != 31)",
+	"st2 {Vd.D * 2}[i], [Xn|SP], #16",
+	"st2 {Vd.D * 2}[i], [Xn|SP], Xm (m != 31)",
+	"st2g Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4)",
+	"st2g Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4)",
+	"st2g Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4)",
+	"st3 {Vd.16B * 3}, [Xn|SP]\nst3 {Vd.8B * 3}, [Xn|SP]",
+	"st3 {Vd.8H * 3}, [Xn|SP]\nst3 {Vd.4H * 3}, [Xn|SP]",
+	"st3 {Vd.4S * 3}, [Xn|SP]\nst3 {Vd.2S * 3}, [Xn|SP]",
+	"st3 {Vd.2D * 3}, [Xn|SP]",
+	"st3 {Vd.8B * 3}, [Xn|SP], #24",
+	"st3 {Vd.4H * 3}, [Xn|SP], #24",
+	"st3 {Vd.2S * 3}, [Xn|SP], #24",
+	"st3 {Vd.16B * 3}, [Xn|SP], #48",
+	"st3 {Vd.8H * 3}, [Xn|SP], #48",
+	"st3 {Vd.4S * 3}, [Xn|SP], #48",
+	"st3 {Vd.2D * 3}, [Xn|SP], #48",
+	"st3 {Vd.16B * 3}, [Xn|SP], Xm (m != 31)\nst3 {Vd.8B * 3}, [Xn|SP], Xm (m != 31)",
+	"st3 {Vd.8H * 3}, [Xn|SP], Xm (m != 31)\nst3 {Vd.4H * 3}, [Xn|SP], Xm (m != 31)",
+	"st3 {Vd.4S * 3}, [Xn|SP], Xm (m != 31)\nst3 {Vd.2S * 3}, [Xn|SP], Xm (m != 31)",
+	"st3 {Vd.2D * 3}, [Xn|SP], Xm (m != 31)",
+	"st3 {Vd.B * 3}[i], [Xn|SP]",
+	"st3 {Vd.H * 3}[i], [Xn|SP]",
+	"st3 {Vd.S * 3}[i], [Xn|SP]",
+	"st3 {Vd.D * 3}[i], [Xn|SP]",
+	"st3 {Vd.B * 3}[i], [Xn|SP], #3",
+	"st3 {Vd.B * 3}[i], [Xn|SP], Xm (m != 31)",
+	"st3 {Vd.H * 3}[i], [Xn|SP], #6",
+	"st3 {Vd.H * 3}[i], [Xn|SP], Xm (m != 31)",
+	"st3 {Vd.S * 3}[i], [Xn|SP], #12",
+	"st3 {Vd.S * 3}[i], [Xn|SP], Xm (m != 31)",
+	"st3 {Vd.D * 3}[i], [Xn|SP], #24",
+	"st3 {Vd.D * 3}[i], [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.16B * 4}, [Xn|SP]\nst4 {Vd.8B * 4}, [Xn|SP]",
+	"st4 {Vd.8H * 4}, [Xn|SP]\nst4 {Vd.4H * 4}, [Xn|SP]",
+	"st4 {Vd.4S * 4}, [Xn|SP]\nst4 {Vd.2S * 4}, [Xn|SP]",
+	"st4 {Vd.2D * 4}, [Xn|SP]",
+	"st4 {Vd.8B * 4}, [Xn|SP], #32",
+	"st4 {Vd.4H * 4}, [Xn|SP], #32",
+	"st4 {Vd.2S * 4}, [Xn|SP], #32",
+	"st4 {Vd.16B * 4}, [Xn|SP], #64",
+	"st4 {Vd.8H * 4}, [Xn|SP], #64",
+	"st4 {Vd.4S * 4}, [Xn|SP], #64",
+	"st4 {Vd.2D * 4}, [Xn|SP], #64",
+	"st4 {Vd.16B * 4}, [Xn|SP], Xm (m != 31)\nst4 {Vd.8B * 4}, [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.8H * 4}, [Xn|SP], Xm (m != 31)\nst4 {Vd.4H * 4}, [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.4S * 4}, [Xn|SP], Xm (m != 31)\nst4 {Vd.2S * 4}, [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.2D * 4}, [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.B * 4}[i], [Xn|SP]",
+	"st4 {Vd.H * 4}[i], [Xn|SP]",
+	"st4 {Vd.S * 4}[i], [Xn|SP]",
+	"st4 {Vd.D * 4}[i], [Xn|SP]",
+	"st4 {Vd.B * 4}[i], [Xn|SP], #4",
+	"st4 {Vd.B * 4}[i], [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.H * 4}[i], [Xn|SP], #8",
+	"st4 {Vd.H * 4}[i], [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.S * 4}[i], [Xn|SP], #16",
+	"st4 {Vd.S * 4}[i], [Xn|SP], Xm (m != 31)",
+	"st4 {Vd.D * 4}[i], [Xn|SP], #32",
+	"st4 {Vd.D * 4}[i], [Xn|SP], Xm (m != 31)",
+	"st64b Xn, [Xm|SP] (n is even)",
+	"st64bv Xd, Xn, [Xm|SP] (n is even)",
+	"st64bv0 Xd, Xn, [Xm|SP] (n is even)",
+	"stadd Wd, [Xn|SP]",
+	"stadd Xd, [Xn|SP]",
+	"staddb Wd, [Xn|SP]",
+	"staddh Wd, [Xn|SP]",
+	"staddl Wd, [Xn|SP]",
+	"staddl Xd, [Xn|SP]",
+	"staddlb Wd, [Xn|SP]",
+	"staddlh Wd, [Xn|SP]",
+	"stclr Wd, [Xn|SP]",
+	"stclr Xd, [Xn|SP]",
+	"stclrb Wd, [Xn|SP]",
+	"stclrh Wd, [Xn|SP]",
+	"stclrl Wd, [Xn|SP]",
+	"stclrl Xd, [Xn|SP]",
+	"stclrlb Wd, [Xn|SP]",
+	"stclrlh Wd, [Xn|SP]",
+	"steor Wd, [Xn|SP]",
+	"steor Xd, [Xn|SP]",
+	"steorb Wd, [Xn|SP]",
+	"steorh Wd, [Xn|SP]",
+	"steorl Wd, [Xn|SP]",
+	"steorl Xd, [Xn|SP]",
+	"steorlb Wd, [Xn|SP]",
+	"steorlh Wd, [Xn|SP]",
+	"stg Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4)",
+	"stg Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4)",
+	"stg Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4)",
+	"stgp Xd, Xn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4)",
+	"stgp Xd, Xn, [Xm|SP, #imm]! (-1024 <= imm < 1024, imm >> 4)",
+	"stgp Xd, Xn, [Xm|SP], #imm (-1024 <= imm < 1024, imm >> 4)",
+	"stllr Wd, [Xn|SP]",
+	"stllr Xd, [Xn|SP]",
+	"stllrb Wd, [Xn|SP]",
+	"stllrh Wd, [Xn|SP]",
+	"stlr Wd, [Xn|SP]",
+	"stlr Xd, [Xn|SP]",
+	"stlrb Wd, [Xn|SP]",
+	"stlrh Wd, [Xn|SP]",
+	"stlur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stlur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stlurb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stlurh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stlxp Wd, Wn, Wm, [Xa|SP]",
+	"stlxp Wd, Xn, Xm, [Xa|SP]",
+	"stlxr Wd, Wn, [Xm|SP]",
+	"stlxr Wd, Xn, [Xm|SP]",
+	"stlxrb Wd, Wn, [Xm|SP]",
+	"stlxrh Wd, Wn, [Xm|SP]",
+	"stnp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"stnp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"stnp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4)",
+	"stnp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"stnp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"stp Sd, Sn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2)",
+	"stp Dd, Dn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3)",
+	"stp Qd, Qn, [Xm|SP], #imm (-1024 <= imm < 1024, imm >> 4)",
+	"stp Sd, Sn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2)",
+	"stp Dd, Dn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3)",
+	"stp Qd, Qn, [Xm|SP, #imm]! (-1024 <= imm < 1024, imm >> 4)",
+	"stp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"stp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"stp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4)",
+	"stp Wd, Wn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2)",
+	"stp Xd, Xn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3)",
+	"stp Wd, Wn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2)",
+	"stp Xd, Xn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3)",
+	"stp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2)",
+	"stp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3)",
+	"str Bd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"str Hd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"str Sd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"str Dd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"str Qd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"str Bd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"str Hd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"str Sd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"str Dd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"str Qd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"str Bd, [Xn|SP {, #imm }] (0 <= imm < 4096)",
+	"str Hd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1)",
+	"str Sd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2)",
+	"str Dd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3)",
+	"str Qd, [Xn|SP {, #imm }] (0 <= imm < 65536, imm >> 4)",
+	"str Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"str Xd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"str Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"str Xd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"str Wd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2)",
+	"str Xd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3)",
+	"str Bd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm == 0)",
+	"str Hd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 1])",
+	"str Sd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 2])",
+	"str Dd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 3])",
+	"str Qd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 4])",
+	"str Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 2])",
+	"str Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 3])",
+	"str ZA[W12-W15, #imm1], [Xn|SP {, #imm2, MUL VL }] (0 <= imm1 < 16, imm2 == imm1)",
+	"str Zd, [Xn|SP {, #imm, MUL VL }] (-256 <= imm < 256)",
+	"str Pd, [Xn|SP {, #imm, MUL VL }] (-256 <= imm < 256)",
+	"strb Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"strb Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"strb Wd, [Xn|SP {, #imm }] (0 <= imm < 4096)",
+	"strb Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm == 0)",
+	"strh Wd, [Xn|SP], #imm (-256 <= imm < 256)",
+	"strh Wd, [Xn|SP, #imm]! (-256 <= imm < 256)",
+	"strh Wd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1)",
+	"strh Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }] (imm in [0, 1])",
+	"stset Wd, [Xn|SP]",
+	"stset Xd, [Xn|SP]",
+	"stsetb Wd, [Xn|SP]",
+	"stseth Wd, [Xn|SP]",
+	"stsetl Wd, [Xn|SP]",
+	"stsetl Xd, [Xn|SP]",
+	"stsetlb Wd, [Xn|SP]",
+	"stsetlh Wd, [Xn|SP]",
+	"stsmax Wd, [Xn|SP]",
+	"stsmax Xd, [Xn|SP]",
+	"stsmaxb Wd, [Xn|SP]",
+	"stsmaxh Wd, [Xn|SP]",
+	"stsmaxl Wd, [Xn|SP]",
+	"stsmaxl Xd, [Xn|SP]",
+	"stsmaxlb Wd, [Xn|SP]",
+	"stsmaxlh Wd, [Xn|SP]",
+	"stsmin Wd, [Xn|SP]",
+	"stsmin Xd, [Xn|SP]",
+	"stsminb Wd, [Xn|SP]",
+	"stsminh Wd, [Xn|SP]",
+	"stsminl Wd, [Xn|SP]",
+	"stsminl Xd, [Xn|SP]",
+	"stsminlb Wd, [Xn|SP]",
+	"stsminlh Wd, [Xn|SP]",
+	"sttr Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"sttr Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"sttrb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"sttrh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stumax Wd, [Xn|SP]",
+	"stumax Xd, [Xn|SP]",
+	"stumaxb Wd, [Xn|SP]",
+	"stumaxh Wd, [Xn|SP]",
+	"stumaxl Wd, [Xn|SP]",
+	"stumaxl Xd, [Xn|SP]",
+	"stumaxlb Wd, [Xn|SP]",
+	"stumaxlh Wd, [Xn|SP]",
+	"stumin Wd, [Xn|SP]",
+	"stumin Xd, [Xn|SP]",
+	"stuminb Wd, [Xn|SP]",
+	"stuminh Wd, [Xn|SP]",
+	"stuminl Wd, [Xn|SP]",
+	"stuminl Xd, [Xn|SP]",
+	"stuminlb Wd, [Xn|SP]",
+	"stuminlh Wd, [Xn|SP]",
+	"stur Bd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stur Hd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stur Sd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stur Dd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stur Qd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"sturb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"sturh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256)",
+	"stxp Wd, Wn, Wm, [Xa|SP]",
+	"stxp Wd, Xn, Xm, [Xa|SP]",
+	"stxr Wd, Wn, [Xm|SP]",
+	"stxr Wd, Xn, [Xm|SP]",
+	"stxrb Wd, Wn, [Xm|SP]",
+	"stxrh Wd, Wn, [Xm|SP]",
+	"stz2g Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4)",
+	"stz2g Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4)",
+	"stz2g Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4)",
+	"stzg Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4)",
+	"stzg Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4)",
+	"stzg Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4)",
+	"sub Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"sub Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"sub Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4)",
+	"sub Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4)",
+	"sub Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4)",
+	"sub Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"sub Xd|SP, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"sub Dd, Dn, Dm",
+	"sub Vd.16B, Vn.16B, Vm.16B\nsub Vd.8B, Vn.8B, Vm.8B",
+	"sub Vd.8H, Vn.8H, Vm.8H\nsub Vd.4H, Vn.4H, Vm.4H",
+	"sub Vd.4S, Vn.4S, Vm.4S\nsub Vd.2S, Vn.2S, Vm.2S",
+	"sub Vd.2D, Vn.2D, Vm.2D",
+	"sub Zd.B, Zn.B, Zm.B",
+	"sub Zd.H, Zn.H, Zm.H",
+	"sub Zd.S, Zn.S, Zm.S",
+	"sub Zd.D, Zn.D, Zm.D",
+	"sub Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"sub Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"sub Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"sub Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"sub Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"sub Zd.H, Zn.H, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sub Zd.S, Zn.S, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"sub Zd.D, Zn.D, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"subg Xd|SP, Xn|SP, #imm1, #imm2 (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16)",
+	"subhn Vd.8B, Vn.8H, Vm.8H",
+	"subhn Vd.4H, Vn.4S, Vm.4S",
+	"subhn Vd.2S, Vn.2D, Vm.2D",
+	"subhn2 Vd.16B, Vn.8H, Vm.8H",
+	"subhn2 Vd.8H, Vn.4S, Vm.4S",
+	"subhn2 Vd.4S, Vn.2D, Vm.2D",
+	"subp Xd, Xn|SP, Xm|SP",
+	"subps Xd, Xn|SP, Xm|SP",
+	"subr Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"subr Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"subr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"subr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"subr Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"subr Zd.H, Zn.H, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"subr Zd.S, Zn.S, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"subr Zd.D, Zn.D, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"subs Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32)",
+	"subs Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64)",
+	"subs Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4)",
+	"subs Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4)",
+	"subs Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4)",
+	"subs Wd, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"subs Xd, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12])",
+	"sudot Vd.2S, Vn.8B, Vm.4B[i]",
+	"sudot Vd.4S, Vn.16B, Vm.4B[i]",
+	"sumopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"sumopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"sumops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"sumops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"sunpkhi Zd.H, Zn.B",
+	"sunpkhi Zd.S, Zn.H",
+	"sunpkhi Zd.D, Zn.S",
+	"sunpklo Zd.H, Zn.B",
+	"sunpklo Zd.S, Zn.H",
+	"sunpklo Zd.D, Zn.S",
+	"suqadd Bd, Bn",
+	"suqadd Hd, Hn",
+	"suqadd Sd, Sn",
+	"suqadd Dd, Dn",
+	"suqadd Vd.16B, Vn.16B\nsuqadd Vd.8B, Vn.8B",
+	"suqadd Vd.8H, Vn.8H\nsuqadd Vd.4H, Vn.4H",
+	"suqadd Vd.4S, Vn.4S\nsuqadd Vd.2S, Vn.2S",
+	"suqadd Vd.2D, Vn.2D",
+	"svc #imm (0 <= imm < 65536)",
+	"swp Wd, Wn, [Xm|SP]",
+	"swp Xd, Xn, [Xm|SP]",
+	"swpa Wd, Wn, [Xm|SP]",
+	"swpa Xd, Xn, [Xm|SP]",
+	"swpab Wd, Wn, [Xm|SP]",
+	"swpah Wd, Wn, [Xm|SP]",
+	"swpal Wd, Wn, [Xm|SP]",
+	"swpal Xd, Xn, [Xm|SP]",
+	"swpalb Wd, Wn, [Xm|SP]",
+	"swpalh Wd, Wn, [Xm|SP]",
+	"swpb Wd, Wn, [Xm|SP]",
+	"swph Wd, Wn, [Xm|SP]",
+	"swpl Wd, Wn, [Xm|SP]",
+	"swpl Xd, Xn, [Xm|SP]",
+	"swplb Wd, Wn, [Xm|SP]",
+	"swplh Wd, Wn, [Xm|SP]",
+	"sxtb Wd, Wn",
+	"sxtb Xd, Wn",
+	"sxth Wd, Wn",
+	"sxth Xd, Wn",
+	"sxtl Vd.8H, Vn.8B",
+	"sxtl Vd.4S, Vn.4H",
+	"sxtl Vd.2D, Vn.2S",
+	"sxtl2 Vd.8H, Vn.16B",
+	"sxtl2 Vd.4S, Vn.8H",
+	"sxtl2 Vd.2D, Vn.4S",
+	"sxtw Xd, Wn",
+	"sys #imm1, <symbol>, <symbol>, #imm2 {, Xn } (0 <= imm1 < 8, 0 <= imm2 < 8)",
+	"sysl Xd, #imm1, <symbol>, <symbol>, #imm2 (0 <= imm1 < 8, 0 <= imm2 < 8)",
+	"tbl Vd.16B, {Vn.16B * 2}, Vm.16B\ntbl Vd.8B, {Vn.16B * 2}, Vm.8B",
+	"tbl Vd.16B, {Vn.16B * 3}, Vm.16B\ntbl Vd.8B, {Vn.16B * 3}, Vm.8B",
+	"tbl Vd.16B, {Vn.16B * 4}, Vm.16B\ntbl Vd.8B, {Vn.16B * 4}, Vm.8B",
+	"tbl Vd.16B, {Vn.16B * 1}, Vm.16B\ntbl Vd.8B, {Vn.16B * 1}, Vm.8B",
+	"tbl Zd.B, {Zn.B * 1}, Zm.B",
+	"tbl Zd.H, {Zn.H * 1}, Zm.H",
+	"tbl Zd.S, {Zn.S * 1}, Zm.S",
+	"tbl Zd.D, {Zn.D * 1}, Zm.D",
+	"tbnz Wd, #imm, <offset> (0 <= imm < 32, offset >> 2 is 14-bit (+/- 32 KB))",
+	"tbnz Xd, #imm, <offset> (0 <= imm < 64, offset >> 2 is 14-bit (+/- 32 KB))",
+	"tbx Vd.16B, {Vn.16B * 2}, Vm.16B\ntbx Vd.8B, {Vn.16B * 2}, Vm.8B",
+	"tbx Vd.16B, {Vn.16B * 3}, Vm.16B\ntbx Vd.8B, {Vn.16B * 3}, Vm.8B",
+	"tbx Vd.16B, {Vn.16B * 4}, Vm.16B\ntbx Vd.8B, {Vn.16B * 4}, Vm.8B",
+	"tbx Vd.16B, {Vn.16B * 1}, Vm.16B\ntbx Vd.8B, {Vn.16B * 1}, Vm.8B",
+	"tbz Wd, #imm, <offset> (0 <= imm < 32, offset >> 2 is 14-bit (+/- 32 KB))",
+	"tbz Xd, #imm, <offset> (0 <= imm < 64, offset >> 2 is 14-bit (+/- 32 KB))",
+	"tlbi <symbol> {, Xn }",
+	"trn1 Vd.16B, Vn.16B, Vm.16B\ntrn1 Vd.8B, Vn.8B, Vm.8B",
+	"trn1 Vd.8H, Vn.8H, Vm.8H\ntrn1 Vd.4H, Vn.4H, Vm.4H",
+	"trn1 Vd.4S, Vn.4S, Vm.4S\ntrn1 Vd.2S, Vn.2S, Vm.2S",
+	"trn1 Vd.2D, Vn.2D, Vm.2D",
+	"trn1 Zd.B, Zn.B, Zm.B",
+	"trn1 Zd.H, Zn.H, Zm.H",
+	"trn1 Zd.S, Zn.S, Zm.S",
+	"trn1 Zd.D, Zn.D, Zm.D",
+	"trn1 Pd.B, Pn.B, Pm.B",
+	"trn1 Pd.H, Pn.H, Pm.H",
+	"trn1 Pd.S, Pn.S, Pm.S",
+	"trn1 Pd.D, Pn.D, Pm.D",
+	"trn2 Vd.16B, Vn.16B, Vm.16B\ntrn2 Vd.8B, Vn.8B, Vm.8B",
+	"trn2 Vd.8H, Vn.8H, Vm.8H\ntrn2 Vd.4H, Vn.4H, Vm.4H",
+	"trn2 Vd.4S, Vn.4S, Vm.4S\ntrn2 Vd.2S, Vn.2S, Vm.2S",
+	"trn2 Vd.2D, Vn.2D, Vm.2D",
+	"trn2 Zd.B, Zn.B, Zm.B",
+	"trn2 Zd.H, Zn.H, Zm.H",
+	"trn2 Zd.S, Zn.S, Zm.S",
+	"trn2 Zd.D, Zn.D, Zm.D",
+	"trn2 Pd.B, Pn.B, Pm.B",
+	"trn2 Pd.H, Pn.H, Pm.H",
+	"trn2 Pd.S, Pn.S, Pm.S",
+	"trn2 Pd.D, Pn.D, Pm.D",
+	"tsb CSYNC",
+	"tst Wd, #imm (imm is 32-bit logical)",
+	"tst Xd, #imm (imm is 64-bit logical)",
+	"tst Wd, Wn {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32)",
+	"tst Xd, Xn {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64)",
+	"uaba Vd.16B, Vn.16B, Vm.16B\nuaba Vd.8B, Vn.8B, Vm.8B",
+	"uaba Vd.8H, Vn.8H, Vm.8H\nuaba Vd.4H, Vn.4H, Vm.4H",
+	"uaba Vd.4S, Vn.4S, Vm.4S\nuaba Vd.2S, Vn.2S, Vm.2S",
+	"uabal Vd.8H, Vn.8B, Vm.8B",
+	"uabal Vd.4S, Vn.4H, Vm.4H",
+	"uabal Vd.2D, Vn.2S, Vm.2S",
+	"uabal2 Vd.8H, Vn.16B, Vm.16B",
+	"uabal2 Vd.4S, Vn.8H, Vm.8H",
+	"uabal2 Vd.2D, Vn.4S, Vm.4S",
+	"uabd Vd.16B, Vn.16B, Vm.16B\nuabd Vd.8B, Vn.8B, Vm.8B",
+	"uabd Vd.8H, Vn.8H, Vm.8H\nuabd Vd.4H, Vn.4H, Vm.4H",
+	"uabd Vd.4S, Vn.4S, Vm.4S\nuabd Vd.2S, Vn.2S, Vm.2S",
+	"uabd Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"uabd Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"uabd Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"uabd Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"uabdl Vd.8H, Vn.8B, Vm.8B",
+	"uabdl Vd.4S, Vn.4H, Vm.4H",
+	"uabdl Vd.2D, Vn.2S, Vm.2S",
+	"uabdl2 Vd.8H, Vn.16B, Vm.16B",
+	"uabdl2 Vd.4S, Vn.8H, Vm.8H",
+	"uabdl2 Vd.2D, Vn.4S, Vm.4S",
+	"uadalp Vd.8H, Vn.16B\nuadalp Vd.4H, Vn.8B",
+	"uadalp Vd.4S, Vn.8H\nuadalp Vd.2S, Vn.4H",
+	"uadalp Vd.2D, Vn.4S\nuadalp Vd.1D, Vn.2S",
+	"uaddl Vd.8H, Vn.8B, Vm.8B",
+	"uaddl Vd.4S, Vn.4H, Vm.4H",
+	"uaddl Vd.2D, Vn.2S, Vm.2S",
+	"uaddl2 Vd.8H, Vn.16B, Vm.16B",
+	"uaddl2 Vd.4S, Vn.8H, Vm.8H",
+	"uaddl2 Vd.2D, Vn.4S, Vm.4S",
+	"uaddlp Vd.8H, Vn.16B\nuaddlp Vd.4H, Vn.8B",
+	"uaddlp Vd.4S, Vn.8H\nuaddlp Vd.2S, Vn.4H",
+	"uaddlp Vd.2D, Vn.4S\nuaddlp Vd.1D, Vn.2S",
+	"uaddlv Hd, Vn.16B\nuaddlv Hd, Vn.8B",
+	"uaddlv Sd, Vn.8H\nuaddlv Sd, Vn.4H",
+	"uaddlv Dd, Vn.4S",
+	"uaddv Dd, Pg, Zn.B (g < 8)",
+	"uaddv Dd, Pg, Zn.H (g < 8)",
+	"uaddv Dd, Pg, Zn.S (g < 8)",
+	"uaddv Dd, Pg, Zn.D (g < 8)",
+	"uaddw Vd.8H, Vn.8H, Vm.8B",
+	"uaddw Vd.4S, Vn.4S, Vm.4H",
+	"uaddw Vd.2D, Vn.2D, Vm.2S",
+	"uaddw2 Vd.8H, Vn.8H, Vm.16B",
+	"uaddw2 Vd.4S, Vn.4S, Vm.8H",
+	"uaddw2 Vd.2D, Vn.2D, Vm.4S",
+	"ubfiz Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)",
+	"ubfiz Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)",
+	"ubfm Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 <= imm2 < 32)",
+	"ubfm Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64)",
+	"ubfx Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)",
+	"ubfx Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)",
+	"ucvtf Hd, Hn, #imm (0 < imm <= 16)",
+	"ucvtf Sd, Sn, #imm (0 < imm <= 32)",
+	"ucvtf Dd, Dn, #imm (0 < imm <= 64)",
+	"ucvtf Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nucvtf Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"ucvtf Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nucvtf Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"ucvtf Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"ucvtf Hd, Hn",
+	"ucvtf Sd, Sn",
+	"ucvtf Dd, Dn",
+	"ucvtf Vd.8H, Vn.8H\nucvtf Vd.4H, Vn.4H",
+	"ucvtf Vd.4S, Vn.4S\nucvtf Vd.2S, Vn.2S",
+	"ucvtf Vd.2D, Vn.2D",
+	"ucvtf Hd, Wn, #imm (0 < imm <= 32)",
+	"ucvtf Sd, Wn, #imm (0 < imm <= 32)",
+	"ucvtf Dd, Wn, #imm (0 < imm <= 32)",
+	"ucvtf Hd, Xn, #imm (0 < imm <= 64)",
+	"ucvtf Sd, Xn, #imm (0 < imm <= 64)",
+	"ucvtf Dd, Xn, #imm (0 < imm <= 64)",
+	"ucvtf Hd, Wn",
+	"ucvtf Sd, Wn",
+	"ucvtf Dd, Wn",
+	"ucvtf Hd, Xn",
+	"ucvtf Sd, Xn",
+	"ucvtf Dd, Xn",
+	"ucvtf Zd.H, Pg/M, Zn.H (g < 8)",
+	"ucvtf Zd.S, Pg/M, Zn.S (g < 8)",
+	"ucvtf Zd.D, Pg/M, Zn.D (g < 8)",
+	"udf #imm (0 <= imm < 65536)",
+	"udiv Wd, Wn, Wm",
+	"udiv Xd, Xn, Xm",
+	"udiv Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"udiv Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"udivr Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"udivr Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"udot Vd.2S, Vn.8B, Vm.4B[i]",
+	"udot Vd.4S, Vn.16B, Vm.4B[i]",
+	"udot Vd.2S, Vn.8B, Vm.8B",
+	"udot Vd.4S, Vn.16B, Vm.16B",
+	"uhadd Vd.16B, Vn.16B, Vm.16B\nuhadd Vd.8B, Vn.8B, Vm.8B",
+	"uhadd Vd.8H, Vn.8H, Vm.8H\nuhadd Vd.4H, Vn.4H, Vm.4H",
+	"uhadd Vd.4S, Vn.4S, Vm.4S\nuhadd Vd.2S, Vn.2S, Vm.2S",
+	"uhsub Vd.16B, Vn.16B, Vm.16B\nuhsub Vd.8B, Vn.8B, Vm.8B",
+	"uhsub Vd.8H, Vn.8H, Vm.8H\nuhsub Vd.4H, Vn.4H, Vm.4H",
+	"uhsub Vd.4S, Vn.4S, Vm.4S\nuhsub Vd.2S, Vn.2S, Vm.2S",
+	"umaddl Xd, Wn, Wm, Xa",
+	"umax Vd.16B, Vn.16B, Vm.16B\numax Vd.8B, Vn.8B, Vm.8B",
+	"umax Vd.8H, Vn.8H, Vm.8H\numax Vd.4H, Vn.4H, Vm.4H",
+	"umax Vd.4S, Vn.4S, Vm.4S\numax Vd.2S, Vn.2S, Vm.2S",
+	"umax Wd, Wn, #imm (0 <= imm < 256)",
+	"umax Xd, Xn, #imm (0 <= imm < 256)",
+	"umax Wd, Wn, Wm",
+	"umax Xd, Xn, Xm",
+	"umax Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"umax Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"umax Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"umax Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"umax Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"umax Zd.H, Zn.H, #imm (n == d, 0 <= imm < 256)",
+	"umax Zd.S, Zn.S, #imm (n == d, 0 <= imm < 256)",
+	"umax Zd.D, Zn.D, #imm (n == d, 0 <= imm < 256)",
+	"umaxp Vd.16B, Vn.16B, Vm.16B\numaxp Vd.8B, Vn.8B, Vm.8B",
+	"umaxp Vd.8H, Vn.8H, Vm.8H\numaxp Vd.4H, Vn.4H, Vm.4H",
+	"umaxp Vd.4S, Vn.4S, Vm.4S\numaxp Vd.2S, Vn.2S, Vm.2S",
+	"umaxv Bd, Vn.16B\numaxv Bd, Vn.8B",
+	"umaxv Hd, Vn.8H\numaxv Hd, Vn.4H",
+	"umaxv Sd, Vn.4S",
+	"umaxv Bd, Pg, Zn.B (g < 8)",
+	"umaxv Hd, Pg, Zn.H (g < 8)",
+	"umaxv Sd, Pg, Zn.S (g < 8)",
+	"umaxv Dd, Pg, Zn.D (g < 8)",
+	"umin Vd.16B, Vn.16B, Vm.16B\numin Vd.8B, Vn.8B, Vm.8B",
+	"umin Vd.8H, Vn.8H, Vm.8H\numin Vd.4H, Vn.4H, Vm.4H",
+	"umin Vd.4S, Vn.4S, Vm.4S\numin Vd.2S, Vn.2S, Vm.2S",
+	"umin Wd, Wn, #imm (0 <= imm < 256)",
+	"umin Xd, Xn, #imm (0 <= imm < 256)",
+	"umin Wd, Wn, Wm",
+	"umin Xd, Xn, Xm",
+	"umin Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"umin Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"umin Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"umin Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"umin Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"umin Zd.H, Zn.H, #imm (n == d, 0 <= imm < 256)",
+	"umin Zd.S, Zn.S, #imm (n == d, 0 <= imm < 256)",
+	"umin Zd.D, Zn.D, #imm (n == d, 0 <= imm < 256)",
+	"uminp Vd.16B, Vn.16B, Vm.16B\numinp Vd.8B, Vn.8B, Vm.8B",
+	"uminp Vd.8H, Vn.8H, Vm.8H\numinp Vd.4H, Vn.4H, Vm.4H",
+	"uminp Vd.4S, Vn.4S, Vm.4S\numinp Vd.2S, Vn.2S, Vm.2S",
+	"uminv Bd, Vn.16B\numinv Bd, Vn.8B",
+	"uminv Hd, Vn.8H\numinv Hd, Vn.4H",
+	"uminv Sd, Vn.4S",
+	"uminv Bd, Pg, Zn.B (g < 8)",
+	"uminv Hd, Pg, Zn.H (g < 8)",
+	"uminv Sd, Pg, Zn.S (g < 8)",
+	"uminv Dd, Pg, Zn.D (g < 8)",
+	"umlal Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"umlal Vd.2D, Vn.2S, Vm.S[i]",
+	"umlal Vd.8H, Vn.8B, Vm.8B",
+	"umlal Vd.4S, Vn.4H, Vm.4H",
+	"umlal Vd.2D, Vn.2S, Vm.2S",
+	"umlal2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"umlal2 Vd.2D, Vn.4S, Vm.S[i]",
+	"umlal2 Vd.8H, Vn.16B, Vm.16B",
+	"umlal2 Vd.4S, Vn.8H, Vm.8H",
+	"umlal2 Vd.2D, Vn.4S, Vm.4S",
+	"umlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"umlsl Vd.2D, Vn.2S, Vm.S[i]",
+	"umlsl Vd.8H, Vn.8B, Vm.8B",
+	"umlsl Vd.4S, Vn.4H, Vm.4H",
+	"umlsl Vd.2D, Vn.2S, Vm.2S",
+	"umlsl2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"umlsl2 Vd.2D, Vn.4S, Vm.S[i]",
+	"umlsl2 Vd.8H, Vn.16B, Vm.16B",
+	"umlsl2 Vd.4S, Vn.8H, Vm.8H",
+	"umlsl2 Vd.2D, Vn.4S, Vm.4S",
+	"ummla Vd.4S, Vn.16B, Vm.16B",
+	"umnegl Xd, Wn, Wm",
+	"umopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"umopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"umops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"umops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"umov Wd, Vn.B[i]",
+	"umov Wd, Vn.H[i]",
+	"umov Wd, Vn.S[i]",
+	"umov Xd, Vn.D[i]",
+	"umsubl Xd, Wn, Wm, Xa",
+	"umulh Xd, Xn, Xm",
+	"umulh Zd.B, Zn.B, Zm.B",
+	"umulh Zd.H, Zn.H, Zm.H",
+	"umulh Zd.S, Zn.S, Zm.S",
+	"umulh Zd.D, Zn.D, Zm.D",
+	"umulh Zd.B, Pg/M, Zn.B, Zm.B (g < 8, n == d)",
+	"umulh Zd.H, Pg/M, Zn.H, Zm.H (g < 8, n == d)",
+	"umulh Zd.S, Pg/M, Zn.S, Zm.S (g < 8, n == d)",
+	"umulh Zd.D, Pg/M, Zn.D, Zm.D (g < 8, n == d)",
+	"umull Vd.4S, Vn.4H, Vm.H[i] (m < 16)",
+	"umull Vd.2D, Vn.2S, Vm.S[i]",
+	"umull Vd.8H, Vn.8B, Vm.8B",
+	"umull Vd.4S, Vn.4H, Vm.4H",
+	"umull Vd.2D, Vn.2S, Vm.2S",
+	"umull Xd, Wn, Wm",
+	"umull2 Vd.4S, Vn.8H, Vm.H[i] (m < 16)",
+	"umull2 Vd.2D, Vn.4S, Vm.S[i]",
+	"umull2 Vd.8H, Vn.16B, Vm.16B",
+	"umull2 Vd.4S, Vn.8H, Vm.8H",
+	"umull2 Vd.2D, Vn.4S, Vm.4S",
+	"uqadd Bd, Bn, Bm",
+	"uqadd Hd, Hn, Hm",
+	"uqadd Sd, Sn, Sm",
+	"uqadd Dd, Dn, Dm",
+	"uqadd Vd.16B, Vn.16B, Vm.16B\nuqadd Vd.8B, Vn.8B, Vm.8B",
+	"uqadd Vd.8H, Vn.8H, Vm.8H\nuqadd Vd.4H, Vn.4H, Vm.4H",
+	"uqadd Vd.4S, Vn.4S, Vm.4S\nuqadd Vd.2S, Vn.2S, Vm.2S",
+	"uqadd Vd.2D, Vn.2D, Vm.2D",
+	"uqadd Zd.B, Zn.B, Zm.B",
+	"uqadd Zd.H, Zn.H, Zm.H",
+	"uqadd Zd.S, Zn.S, Zm.S",
+	"uqadd Zd.D, Zn.D, Zm.D",
+	"uqadd Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"uqadd Zd.H, Zn.H, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"uqadd Zd.S, Zn.S, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"uqadd Zd.D, Zn.D, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"uqrshl Bd, Bn, Bm",
+	"uqrshl Hd, Hn, Hm",
+	"uqrshl Sd, Sn, Sm",
+	"uqrshl Dd, Dn, Dm",
+	"uqrshl Vd.16B, Vn.16B, Vm.16B\nuqrshl Vd.8B, Vn.8B, Vm.8B",
+	"uqrshl Vd.8H, Vn.8H, Vm.8H\nuqrshl Vd.4H, Vn.4H, Vm.4H",
+	"uqrshl Vd.4S, Vn.4S, Vm.4S\nuqrshl Vd.2S, Vn.2S, Vm.2S",
+	"uqrshl Vd.2D, Vn.2D, Vm.2D",
+	"uqrshrn Bd, Hn, #imm (0 < imm <= 8)",
+	"uqrshrn Hd, Sn, #imm (0 < imm <= 16)",
+	"uqrshrn Sd, Dn, #imm (0 < imm <= 32)",
+	"uqrshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"uqrshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"uqrshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"uqrshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"uqrshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"uqrshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"uqshl Bd, Bn, #imm (0 <= imm < 8)",
+	"uqshl Hd, Hn, #imm (0 <= imm < 16)",
+	"uqshl Sd, Sn, #imm (0 <= imm < 32)",
+	"uqshl Dd, Dn, #imm (0 <= imm < 64)",
+	"uqshl Vd.16B, Vn.16B, #imm (0 <= imm < 8)\nuqshl Vd.8B, Vn.8B, #imm (0 <= imm < 8)",
+	"uqshl Vd.8H, Vn.8H, #imm (0 <= imm < 16)\nuqshl Vd.4H, Vn.4H, #imm (0 <= imm < 16)",
+	"uqshl Vd.4S, Vn.4S, #imm (0 <= imm < 32)\nuqshl Vd.2S, Vn.2S, #imm (0 <= imm < 32)",
+	"uqshl Vd.2D, Vn.2D, #imm (0 <= imm < 64)",
+	"uqshl Bd, Bn, Bm",
+	"uqshl Hd, Hn, Hm",
+	"uqshl Sd, Sn, Sm",
+	"uqshl Dd, Dn, Dm",
+	"uqshl Vd.16B, Vn.16B, Vm.16B\nuqshl Vd.8B, Vn.8B, Vm.8B",
+	"uqshl Vd.8H, Vn.8H, Vm.8H\nuqshl Vd.4H, Vn.4H, Vm.4H",
+	"uqshl Vd.4S, Vn.4S, Vm.4S\nuqshl Vd.2S, Vn.2S, Vm.2S",
+	"uqshl Vd.2D, Vn.2D, Vm.2D",
+	"uqshrn Bd, Hn, #imm (0 < imm <= 8)",
+	"uqshrn Hd, Sn, #imm (0 < imm <= 16)",
+	"uqshrn Sd, Dn, #imm (0 < imm <= 32)",
+	"uqshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8)",
+	"uqshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16)",
+	"uqshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32)",
+	"uqshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8)",
+	"uqshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16)",
+	"uqshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32)",
+	"uqsub Bd, Bn, Bm",
+	"uqsub Hd, Hn, Hm",
+	"uqsub Sd, Sn, Sm",
+	"uqsub Dd, Dn, Dm",
+	"uqsub Vd.16B, Vn.16B, Vm.16B\nuqsub Vd.8B, Vn.8B, Vm.8B",
+	"uqsub Vd.8H, Vn.8H, Vm.8H\nuqsub Vd.4H, Vn.4H, Vm.4H",
+	"uqsub Vd.4S, Vn.4S, Vm.4S\nuqsub Vd.2S, Vn.2S, Vm.2S",
+	"uqsub Vd.2D, Vn.2D, Vm.2D",
+	"uqsub Zd.B, Zn.B, Zm.B",
+	"uqsub Zd.H, Zn.H, Zm.H",
+	"uqsub Zd.S, Zn.S, Zm.S",
+	"uqsub Zd.D, Zn.D, Zm.D",
+	"uqsub Zd.B, Zn.B, #imm (n == d, 0 <= imm < 256)",
+	"uqsub Zd.H, Zn.H, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"uqsub Zd.S, Zn.S, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"uqsub Zd.D, Zn.D, #imm1 {, LSL #imm2 } (n == d, 0 <= imm1 < 256, imm2 in [0, 8])",
+	"uqxtn Bd, Hn",
+	"uqxtn Hd, Sn",
+	"uqxtn Sd, Dn",
+	"uqxtn Vd.8B, Vn.8H",
+	"uqxtn Vd.4H, Vn.4S",
+	"uqxtn Vd.2S, Vn.2D",
+	"uqxtn2 Vd.16B, Vn.8H",
+	"uqxtn2 Vd.8H, Vn.4S",
+	"uqxtn2 Vd.4S, Vn.2D",
+	"urecpe Vd.4S, Vn.4S\nurecpe Vd.2S, Vn.2S",
+	"urhadd Vd.16B, Vn.16B, Vm.16B\nurhadd Vd.8B, Vn.8B, Vm.8B",
+	"urhadd Vd.8H, Vn.8H, Vm.8H\nurhadd Vd.4H, Vn.4H, Vm.4H",
+	"urhadd Vd.4S, Vn.4S, Vm.4S\nurhadd Vd.2S, Vn.2S, Vm.2S",
+	"urshl Dd, Dn, Dm",
+	"urshl Vd.16B, Vn.16B, Vm.16B\nurshl Vd.8B, Vn.8B, Vm.8B",
+	"urshl Vd.8H, Vn.8H, Vm.8H\nurshl Vd.4H, Vn.4H, Vm.4H",
+	"urshl Vd.4S, Vn.4S, Vm.4S\nurshl Vd.2S, Vn.2S, Vm.2S",
+	"urshl Vd.2D, Vn.2D, Vm.2D",
+	"urshr Dd, Dn, #imm (0 < imm <= 64)",
+	"urshr Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nurshr Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"urshr Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nurshr Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"urshr Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nurshr Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"urshr Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"ursqrte Vd.4S, Vn.4S\nursqrte Vd.2S, Vn.2S",
+	"ursra Dd, Dn, #imm (0 < imm <= 64)",
+	"ursra Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nursra Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"ursra Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nursra Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"ursra Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nursra Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"ursra Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"usdot Vd.2S, Vn.8B, Vm.8B",
+	"usdot Vd.4S, Vn.16B, Vm.16B",
+	"usdot Vd.2S, Vn.8B, Vm.4B[i]",
+	"usdot Vd.4S, Vn.16B, Vm.4B[i]",
+	"ushl Dd, Dn, Dm",
+	"ushl Vd.16B, Vn.16B, Vm.16B\nushl Vd.8B, Vn.8B, Vm.8B",
+	"ushl Vd.8H, Vn.8H, Vm.8H\nushl Vd.4H, Vn.4H, Vm.4H",
+	"ushl Vd.4S, Vn.4S, Vm.4S\nushl Vd.2S, Vn.2S, Vm.2S",
+	"ushl Vd.2D, Vn.2D, Vm.2D",
+	"ushll Vd.8H, Vn.8B, #imm (0 <= imm < 8)",
+	"ushll Vd.4S, Vn.4H, #imm (0 <= imm < 16)",
+	"ushll Vd.2D, Vn.2S, #imm (0 <= imm < 32)",
+	"ushll2 Vd.8H, Vn.16B, #imm (0 <= imm < 8)",
+	"ushll2 Vd.4S, Vn.8H, #imm (0 <= imm < 16)",
+	"ushll2 Vd.2D, Vn.4S, #imm (0 <= imm < 32)",
+	"ushr Dd, Dn, #imm (0 < imm <= 64)",
+	"ushr Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nushr Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"ushr Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nushr Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"ushr Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nushr Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"ushr Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"usmmla Vd.4S, Vn.16B, Vm.16B",
+	"usmopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"usmopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"usmops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B (d < 4, g1 < 8, g2 < 8)",
+	"usmops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H (d < 8, g1 < 8, g2 < 8)",
+	"usqadd Bd, Bn",
+	"usqadd Hd, Hn",
+	"usqadd Sd, Sn",
+	"usqadd Dd, Dn",
+	"usqadd Vd.16B, Vn.16B\nusqadd Vd.8B, Vn.8B",
+	"usqadd Vd.8H, Vn.8H\nusqadd Vd.4H, Vn.4H",
+	"usqadd Vd.4S, Vn.4S\nusqadd Vd.2S, Vn.2S",
+	"usqadd Vd.2D, Vn.2D",
+	"usra Dd, Dn, #imm (0 < imm <= 64)",
+	"usra Vd.16B, Vn.16B, #imm (0 < imm <= 8)\nusra Vd.8B, Vn.8B, #imm (0 < imm <= 8)",
+	"usra Vd.8H, Vn.8H, #imm (0 < imm <= 16)\nusra Vd.4H, Vn.4H, #imm (0 < imm <= 16)",
+	"usra Vd.4S, Vn.4S, #imm (0 < imm <= 32)\nusra Vd.2S, Vn.2S, #imm (0 < imm <= 32)",
+	"usra Vd.2D, Vn.2D, #imm (0 < imm <= 64)",
+	"usubl Vd.8H, Vn.8B, Vm.8B",
+	"usubl Vd.4S, Vn.4H, Vm.4H",
+	"usubl Vd.2D, Vn.2S, Vm.2S",
+	"usubl2 Vd.8H, Vn.16B, Vm.16B",
+	"usubl2 Vd.4S, Vn.8H, Vm.8H",
+	"usubl2 Vd.2D, Vn.4S, Vm.4S",
+	"usubw Vd.8H, Vn.8H, Vm.8B",
+	"usubw Vd.4S, Vn.4S, Vm.4H",
+	"usubw Vd.2D, Vn.2D, Vm.2S",
+	"usubw2 Vd.8H, Vn.8H, Vm.16B",
+	"usubw2 Vd.4S, Vn.4S, Vm.8H",
+	"usubw2 Vd.2D, Vn.2D, Vm.4S",
+	"uunpkhi Zd.H, Zn.B",
+	"uunpkhi Zd.S, Zn.H",
+	"uunpkhi Zd.D, Zn.S",
+	"uunpklo Zd.H, Zn.B",
+	"uunpklo Zd.S, Zn.H",
+	"uunpklo Zd.D, Zn.S",
+	"uxtb Wd, Wn",
+	"uxth Wd, Wn",
+	"uxtl Vd.8H, Vn.8B",
+	"uxtl Vd.4S, Vn.4H",
+	"uxtl Vd.2D, Vn.2S",
+	"uxtl2 Vd.8H, Vn.16B",
+	"uxtl2 Vd.4S, Vn.8H",
+	"uxtl2 Vd.2D, Vn.4S",
+	"uzp1 Vd.16B, Vn.16B, Vm.16B\nuzp1 Vd.8B, Vn.8B, Vm.8B",
+	"uzp1 Vd.8H, Vn.8H, Vm.8H\nuzp1 Vd.4H, Vn.4H, Vm.4H",
+	"uzp1 Vd.4S, Vn.4S, Vm.4S\nuzp1 Vd.2S, Vn.2S, Vm.2S",
+	"uzp1 Vd.2D, Vn.2D, Vm.2D",
+	"uzp1 Zd.B, Zn.B, Zm.B",
+	"uzp1 Zd.H, Zn.H, Zm.H",
+	"uzp1 Zd.S, Zn.S, Zm.S",
+	"uzp1 Zd.D, Zn.D, Zm.D",
+	"uzp1 Pd.B, Pn.B, Pm.B",
+	"uzp1 Pd.H, Pn.H, Pm.H",
+	"uzp1 Pd.S, Pn.S, Pm.S",
+	"uzp1 Pd.D, Pn.D, Pm.D",
+	"uzp2 Vd.16B, Vn.16B, Vm.16B\nuzp2 Vd.8B, Vn.8B, Vm.8B",
+	"uzp2 Vd.8H, Vn.8H, Vm.8H\nuzp2 Vd.4H, Vn.4H, Vm.4H",
+	"uzp2 Vd.4S, Vn.4S, Vm.4S\nuzp2 Vd.2S, Vn.2S, Vm.2S",
+	"uzp2 Vd.2D, Vn.2D, Vm.2D",
+	"uzp2 Zd.B, Zn.B, Zm.B",
+	"uzp2 Zd.H, Zn.H, Zm.H",
+	"uzp2 Zd.S, Zn.S, Zm.S",
+	"uzp2 Zd.D, Zn.D, Zm.D",
+	"uzp2 Pd.B, Pn.B, Pm.B",
+	"uzp2 Pd.H, Pn.H, Pm.H",
+	"uzp2 Pd.S, Pn.S, Pm.S",
+	"uzp2 Pd.D, Pn.D, Pm.D",
+	"wfe",
+	"wfet Xd",
+	"wfi",
+	"wfit Xd",
+	"whilege Pd.B, Wn, Wm",
+	"whilege Pd.B, Xn, Xm",
+	"whilege Pd.H, Wn, Wm",
+	"whilege Pd.H, Xn, Xm",
+	"whilege Pd.S, Wn, Wm",
+	"whilege Pd.S, Xn, Xm",
+	"whilege Pd.D, Wn, Wm",
+	"whilege Pd.D, Xn, Xm",
+	"whilegt Pd.B, Wn, Wm",
+	"whilegt Pd.B, Xn, Xm",
+	"whilegt Pd.H, Wn, Wm",
+	"whilegt Pd.H, Xn, Xm",
+	"whilegt Pd.S, Wn, Wm",
+	"whilegt Pd.S, Xn, Xm",
+	"whilegt Pd.D, Wn, Wm",
+	"whilegt Pd.D, Xn, Xm",
+	"whilehi Pd.B, Wn, Wm",
+	"whilehi Pd.B, Xn, Xm",
+	"whilehi Pd.H, Wn, Wm",
+	"whilehi Pd.H, Xn, Xm",
+	"whilehi Pd.S, Wn, Wm",
+	"whilehi Pd.S, Xn, Xm",
+	"whilehi Pd.D, Wn, Wm",
+	"whilehi Pd.D, Xn, Xm",
+	"whilehs Pd.B, Wn, Wm",
+	"whilehs Pd.B, Xn, Xm",
+	"whilehs Pd.H, Wn, Wm",
+	"whilehs Pd.H, Xn, Xm",
+	"whilehs Pd.S, Wn, Wm",
+	"whilehs Pd.S, Xn, Xm",
+	"whilehs Pd.D, Wn, Wm",
+	"whilehs Pd.D, Xn, Xm",
+	"whilele Pd.B, Wn, Wm",
+	"whilele Pd.B, Xn, Xm",
+	"whilele Pd.H, Wn, Wm",
+	"whilele Pd.H, Xn, Xm",
+	"whilele Pd.S, Wn, Wm",
+	"whilele Pd.S, Xn, Xm",
+	"whilele Pd.D, Wn, Wm",
+	"whilele Pd.D, Xn, Xm",
+	"whilelo Pd.B, Wn, Wm",
+	"whilelo Pd.B, Xn, Xm",
+	"whilelo Pd.H, Wn, Wm",
+	"whilelo Pd.H, Xn, Xm",
+	"whilelo Pd.S, Wn, Wm",
+	"whilelo Pd.S, Xn, Xm",
+	"whilelo Pd.D, Wn, Wm",
+	"whilelo Pd.D, Xn, Xm",
+	"whilels Pd.B, Wn, Wm",
+	"whilels Pd.B, Xn, Xm",
+	"whilels Pd.H, Wn, Wm",
+	"whilels Pd.H, Xn, Xm",
+	"whilels Pd.S, Wn, Wm",
+	"whilels Pd.S, Xn, Xm",
+	"whilels Pd.D, Wn, Wm",
+	"whilels Pd.D, Xn, Xm",
+	"whilelt Pd.B, Wn, Wm",
+	"whilelt Pd.B, Xn, Xm",
+	"whilelt Pd.H, Wn, Wm",
+	"whilelt Pd.H, Xn, Xm",
+	"whilelt Pd.S, Wn, Wm",
+	"whilelt Pd.S, Xn, Xm",
+	"whilelt Pd.D, Wn, Wm",
+	"whilelt Pd.D, Xn, Xm",
+	"wrffr Pd.B",
+	"xaflag",
+	"xar Vd.2D, Vn.2D, Vm.2D, #imm (0 <= imm < 64)",
+	"xpacd Xd",
+	"xpaci Xd",
+	"xpaclri",
+	"xtn Vd.8B, Vn.8H",
+	"xtn Vd.4H, Vn.4S",
+	"xtn Vd.2S, Vn.2D",
+	"xtn2 Vd.16B, Vn.8H",
+	"xtn2 Vd.8H, Vn.4S",
+	"xtn2 Vd.4S, Vn.2D",
+	"yield",
+	"zero ZA",
+	"zip1 Vd.16B, Vn.16B, Vm.16B\nzip1 Vd.8B, Vn.8B, Vm.8B",
+	"zip1 Vd.8H, Vn.8H, Vm.8H\nzip1 Vd.4H, Vn.4H, Vm.4H",
+	"zip1 Vd.4S, Vn.4S, Vm.4S\nzip1 Vd.2S, Vn.2S, Vm.2S",
+	"zip1 Vd.2D, Vn.2D, Vm.2D",
+	"zip1 Zd.B, Zn.B, Zm.B",
+	"zip1 Zd.H, Zn.H, Zm.H",
+	"zip1 Zd.S, Zn.S, Zm.S",
+	"zip1 Zd.D, Zn.D, Zm.D",
+	"zip1 Pd.B, Pn.B, Pm.B",
+	"zip1 Pd.H, Pn.H, Pm.H",
+	"zip1 Pd.S, Pn.S, Pm.S",
+	"zip1 Pd.D, Pn.D, Pm.D",
+	"zip2 Vd.16B, Vn.16B, Vm.16B\nzip2 Vd.8B, Vn.8B, Vm.8B",
+	"zip2 Vd.8H, Vn.8H, Vm.8H\nzip2 Vd.4H, Vn.4H, Vm.4H",
+	"zip2 Vd.4S, Vn.4S, Vm.4S\nzip2 Vd.2S, Vn.2S, Vm.2S",
+	"zip2 Vd.2D, Vn.2D, Vm.2D",
+	"zip2 Zd.B, Zn.B, Zm.B",
+	"zip2 Zd.H, Zn.H, Zm.H",
+	"zip2 Zd.S, Zn.S, Zm.S",
+	"zip2 Zd.D, Zn.D, Zm.D",
+	"zip2 Pd.B, Pn.B, Pm.B",
+	"zip2 Pd.H, Pn.H, Pm.H",
+	"zip2 Pd.S, Pn.S, Pm.S",
+	"zip2 Pd.D, Pn.D, Pm.D",
+}
+
+// The EncodingDocOffsets table maps each Inst to the index of its first encoding in the EncodingDocs table.
+var EncodingDocOffsets = [...]uint16{
+	0,
+	0,    // ABS
+	11,   // ADC
+	13,   // ADCS
+	15,   // ADD
+	39,   // ADDG
+	40,   // ADDHA
+	42,   // ADDHN
+	45,   // ADDHN2
+	48,   // ADDP
+	53,   // ADDPL
+	54,   // ADDS
+	61,   // ADDSPL
+	62,   // ADDSVL
+	63,   // ADDV
+	66,   // ADDVA
+	68,   // ADDVL
+	69,   // ADR
+	70,   // ADRP
+	71,   // AESD
+	72,   // AESE
+	73,   // AESIMC
+	74,   // AESMC
+	75,   // AND
+	87,   // ANDS
+	91,   // ANDV
+	95,   // ASR
+	111,  // ASRV
+	113,  // AT
+	114,  // AUTDA
+	115,  // AUTDB
+	116,  // AUTDZA
+	117,  // AUTDZB
+	118,  // AUTIA
+	119,  // AUTIA1716
+	120,  // AUTIASP
+	121,  // AUTIAZ
+	122,  // AUTIB
+	123,  // AUTIB1716
+	124,  // AUTIBSP
+	125,  // AUTIBZ
+	126,  // AUTIZA
+	127,  // AUTIZB
+	128,  // AXFLAG
+	129,  // B
+	131,  // BCAX
+	133,  // BFC
+	135,  // BFCVT
+	136,  // BFCVTN
+	137,  // BFCVTN2
+	138,  // BFDOT
+	142,  // BFI
+	144,  // BFM
+	146,  // BFMLALB
+	148,  // BFMLALT
+	150,  // BFMMLA
+	151,  // BFMOPA
+	152,  // BFMOPS
+	153,  // BFXIL
+	155,  // BIC
+	165,  // BICS
+	167,  // BIF
+	168,  // BIT
+	169,  // BL
+	170,  // BLR
+	171,  // BLRAA
+	172,  // BLRAAZ
+	173,  // BLRAB
+	174,  // BLRABZ
+	175,  // BR
+	176,  // BRAA
+	177,  // BRAAZ
+	178,  // BRAB
+	179,  // BRABZ
+	180,  // BRK
+	181,  // BSL
+	183,  // BSL1N
+	184,  // BSL2N
+	185,  // BTI
+	187,  // CAS
+	189,  // CASA
+	191,  // CASAB
+	192,  // CASAH
+	193,  // CASAL
+	195,  // CASALB
+	196,  // CASALH
+	197,  // CASB
+	198,  // CASH
+	199,  // CASL
+	201,  // CASLB
+	202,  // CASLH
+	203,  // CASP
+	205,  // CASPA
+	207,  // CASPAL
+	209,  // CASPL
+	211,  // CBNZ
+	213,  // CBZ
+	215,  // CCMN
+	219,  // CCMP
+	223,  // CFINV
+	224,  // CFP
+	225,  // CINC
+	227,  // CINV
+	229,  // CLRBHB
+	230,  // CLREX
+	232,  // CLS
+	241,  // CLZ
+	250,  // CMEQ
+	260,  // CMGE
+	270,  // CMGT
+	280,  // CMHI
+	285,  // CMHS
+	290,  // CMLE
+	295,  // CMLT
+	300,  // CMN
+	307,  // CMP
+	314,  // CMPEQ
+	322,  // CMPGE
+	330,  // CMPGT
+	338,  // CMPHI
+	346,  // CMPHS
+	354,  // CMPLE
+	358,  // CMPLO
+	362,  // CMPLS
+	366,  // CMPLT
+	370,  // CMPNE
+	378,  // CMTST
+	383,  // CNEG
+	385,  // CNT
+	392,  // CNTB
+	394,  // CNTD
+	396,  // CNTH
+	398,  // CNTW
+	400,  // COMPACT
+	402,  // CPP
+	403,  // CPYE
+	404,  // CPYFE
+	405,  // CPYFM
+	406,  // CPYFP
+	407,  // CPYM
+	408,  // CPYP
+	409,  // CRC32B
+	410,  // CRC32CB
+	411,  // CRC32CH
+	412,  // CRC32CW
+	413,  // CRC32CX
+	414,  // CRC32H
+	415,  // CRC32W
+	416,  // CRC32X
+	417,  // CSDB
+	418,  // CSEL
+	420,  // CSET
+	422,  // CSETM
+	424,  // CSINC
+	426,  // CSINV
+	428,  // CSNEG
+	430,  // CTZ
+	432,  // DC
+	433,  // DCPS1
+	434,  // DCPS2
+	435,  // DCPS3
+	436,  // DECB
+	438,  // DECD
+	440,  // DECH
+	442,  // DECW
+	444,  // DGH
+	445,  // DMB
+	447,  // DRPS
+	448,  // DSB
+	450,  // DUP
+	470,  // DVP
+	471,  // EON
+	473,  // EOR
+	485,  // EOR3
+	487,  // EORV
+	491,  // ERET
+	492,  // ERETAA
+	493,  // ERETAB
+	494,  // ESB
+	495,  // EXT
+	498,  // EXTR
+	500,  // FABD
+	509,  // FABS
+	518,  // FACGE
+	524,  // FACGT
+	530,  // FADD
+	542,  // FADDP
+	548,  // FADDV
+	551,  // FCADD
+	554,  // FCCMP
+	557,  // FCCMPE
+	560,  // FCMEQ
+	575,  // FCMGE
+	590,  // FCMGT
+	605,  // FCMLA
+	611,  // FCMLE
+	617,  // FCMLT
+	623,  // FCMNE
+	626,  // FCMP
+	632,  // FCMPE
+	638,  // FCSEL
+	641,  // FCVT
+	647,  // FCVTAS
+	659,  // FCVTAU
+	671,  // FCVTL
+	673,  // FCVTL2
+	675,  // FCVTMS
+	687,  // FCVTMU
+	699,  // FCVTN
+	700,  // FCVTN2
+	701,  // FCVTNS
+	713,  // FCVTNU
+	725,  // FCVTPS
+	737,  // FCVTPU
+	749,  // FCVTXN
+	751,  // FCVTXN2
+	752,  // FCVTZS
+	779,  // FCVTZU
+	806,  // FDIV
+	815,  // FDIVR
+	818,  // FDUP
+	821,  // FJCVTZS
+	822,  // FMADD
+	825,  // FMAX
+	834,  // FMAXNM
+	843,  // FMAXNMP
+	849,  // FMAXNMV
+	854,  // FMAXP
+	860,  // FMAXV
+	865,  // FMIN
+	874,  // FMINNM
+	883,  // FMINNMP
+	889,  // FMINNMV
+	894,  // FMINP
+	900,  // FMINV
+	905,  // FMLA
+	920,  // FMLAL
+	924,  // FMLAL2
+	928,  // FMLS
+	943,  // FMLSL
+	947,  // FMLSL2
+	951,  // FMOPA
+	954,  // FMOPS
+	957,  // FMOV
+	991,  // FMSUB
+	994,  // FMUL
+	1015, // FMULX
+	1030, // FNEG
+	1039, // FNMADD
+	1042, // FNMLA
+	1045, // FNMLS
+	1048, // FNMSUB
+	1051, // FNMUL
+	1054, // FRECPE
+	1060, // FRECPS
+	1069, // FRECPX
+	1075, // FRINT32X
+	1079, // FRINT32Z
+	1083, // FRINT64X
+	1087, // FRINT64Z
+	1091, // FRINTA
+	1100, // FRINTI
+	1109, // FRINTM
+	1118, // FRINTN
+	1127, // FRINTP
+	1136, // FRINTX
+	1145, // FRINTZ
+	1154, // FRSQRTE
+	1160, // FRSQRTS
+	1169, // FSCALE
+	1172, // FSQRT
+	1181, // FSUB
+	1193, // FSUBR
+	1196, // GMI
+	1197, // HINT
+	1198, // HLT
+	1199, // HVC
+	1200, // IC
+	1202, // INCB
+	1204, // INCD
+	1206, // INCH
+	1208, // INCW
+	1210, // INDEX
+	1218, // INS
+	1226, // IRG
+	1227, // ISB
+	1230, // LD1
+	1306, // LD1B
+	1320, // LD1D
+	1326, // LD1H
+	1340, // LD1Q
+	1342, // LD1R
+	1354, // LD1RB
+	1355, // LD1RD
+	1356, // LD1RH
+	1357, // LD1RW
+	1358, // LD1W
+	1370, // LD2
+	1397, // LD2R
+	1409, // LD3
+	1436, // LD3R
+	1448, // LD4
+	1475, // LD4R
+	1487, // LD64B
+	1488, // LDADD
+	1490, // LDADDA
+	1492, // LDADDAB
+	1493, // LDADDAH
+	1494, // LDADDAL
+	1496, // LDADDALB
+	1497, // LDADDALH
+	1498, // LDADDB
+	1499, // LDADDH
+	1500, // LDADDL
+	1502, // LDADDLB
+	1503, // LDADDLH
+	1504, // LDAPR
+	1506, // LDAPRB
+	1507, // LDAPRH
+	1508, // LDAPUR
+	1510, // LDAPURB
+	1511, // LDAPURH
+	1512, // LDAPURSB
+	1514, // LDAPURSH
+	1516, // LDAPURSW
+	1517, // LDAR
+	1519, // LDARB
+	1520, // LDARH
+	1521, // LDAXP
+	1523, // LDAXR
+	1525, // LDAXRB
+	1526, // LDAXRH
+	1527, // LDCLR
+	1529, // LDCLRA
+	1531, // LDCLRAB
+	1532, // LDCLRAH
+	1533, // LDCLRAL
+	1535, // LDCLRALB
+	1536, // LDCLRALH
+	1537, // LDCLRB
+	1538, // LDCLRH
+	1539, // LDCLRL
+	1541, // LDCLRLB
+	1542, // LDCLRLH
+	1543, // LDEOR
+	1545, // LDEORA
+	1547, // LDEORAB
+	1548, // LDEORAH
+	1549, // LDEORAL
+	1551, // LDEORALB
+	1552, // LDEORALH
+	1553, // LDEORB
+	1554, // LDEORH
+	1555, // LDEORL
+	1557, // LDEORLB
+	1558, // LDEORLH
+	1559, // LDFF1B
+	1560, // LDFF1D
+	1561, // LDFF1H
+	1562, // LDFF1W
+	1563, // LDG
+	1564, // LDLAR
+	1566, // LDLARB
+	1567, // LDLARH
+	1568, // LDNP
+	1573, // LDP
+	1588, // LDPSW
+	1591, // LDR
+	1627, // LDRAA
+	1629, // LDRAB
+	1631, // LDRB
+	1635, // LDRH
+	1639, // LDRSB
+	1647, // LDRSH
+	1655, // LDRSW
+	1660, // LDSET
+	1662, // LDSETA
+	1664, // LDSETAB
+	1665, // LDSETAH
+	1666, // LDSETAL
+	1668, // LDSETALB
+	1669, // LDSETALH
+	1670, // LDSETB
+	1671, // LDSETH
+	1672, // LDSETL
+	1674, // LDSETLB
+	1675, // LDSETLH
+	1676, // LDSMAX
+	1678, // LDSMAXA
+	1680, // LDSMAXAB
+	1681, // LDSMAXAH
+	1682, // LDSMAXAL
+	1684, // LDSMAXALB
+	1685, // LDSMAXALH
+	1686, // LDSMAXB
+	1687, // LDSMAXH
+	1688, // LDSMAXL
+	1690, // LDSMAXLB
+	1691, // LDSMAXLH
+	1692, // LDSMIN
+	1694, // LDSMINA
+	1696, // LDSMINAB
+	1697, // LDSMINAH
+	1698, // LDSMINAL
+	1700, // LDSMINALB
+	1701, // LDSMINALH
+	1702, // LDSMINB
+	1703, // LDSMINH
+	1704, // LDSMINL
+	1706, // LDSMINLB
+	1707, // LDSMINLH
+	1708, // LDTR
+	1710, // LDTRB
+	1711, // LDTRH
+	1712, // LDTRSB
+	1714, // LDTRSH
+	1716, // LDTRSW
+	1717, // LDUMAX
+	1719, // LDUMAXA
+	1721, // LDUMAXAB
+	1722, // LDUMAXAH
+	1723, // LDUMAXAL
+	1725, // LDUMAXALB
+	1726, // LDUMAXALH
+	1727, // LDUMAXB
+	1728, // LDUMAXH
+	1729, // LDUMAXL
+	1731, // LDUMAXLB
+	1732, // LDUMAXLH
+	1733, // LDUMIN
+	1735, // LDUMINA
+	1737, // LDUMINAB
+	1738, // LDUMINAH
+	1739, // LDUMINAL
+	1741, // LDUMINALB
+	1742, // LDUMINALH
+	1743, // LDUMINB
+	1744, // LDUMINH
+	1745, // LDUMINL
+	1747, // LDUMINLB
+	1748, // LDUMINLH
+	1749, // LDUR
+	1756, // LDURB
+	1757, // LDURH
+	1758, // LDURSB
+	1760, // LDURSH
+	1762, // LDURSW
+	1763, // LDXP
+	1765, // LDXR
+	1767, // LDXRB
+	1768, // LDXRH
+	1769, // LSL
+	1785, // LSLV
+	1787, // LSR
+	1803, // LSRV
+	1805, // MADD
+	1807, // MLA
+	1812, // MLS
+	1817, // MNEG
+	1819, // MOV
+	1844, // MOVA
+	1854, // MOVI
+	1860, // MOVK
+	1862, // MOVN
+	1864, // MOVPRFX
+	1873, // MOVZ
+	1875, // MRS
+	1881, // MSR
+	1891, // MSUB
+	1893, // MUL
+	1912, // MVN
+	1915, // MVNI
+	1918, // NBSL
+	1919, // NEG
+	1930, // NEGS
+	1932, // NGC
+	1934, // NGCS
+	1936, // NOP
+	1937, // NOT
+	1942, // ORN
+	1945, // ORR
+	1959, // ORV
+	1963, // PACDA
+	1964, // PACDB
+	1965, // PACDZA
+	1966, // PACDZB
+	1967, // PACGA
+	1968, // PACIA
+	1969, // PACIA1716
+	1970, // PACIASP
+	1971, // PACIAZ
+	1972, // PACIB
+	1973, // PACIB1716
+	1974, // PACIBSP
+	1975, // PACIBZ
+	1976, // PACIZA
+	1977, // PACIZB
+	1978, // PFALSE
+	1979, // PMUL
+	1980, // PMULL
+	1982, // PMULL2
+	1984, // PRFM
+	1990, // PRFUM
+	1992, // PSB
+	1993, // PSSBB
+	1994, // PTEST
+	1995, // PTRUE
+	2003, // PTRUES
+	2011, // RADDHN
+	2014, // RADDHN2
+	2017, // RAX1
+	2018, // RBIT
+	2021, // RDFFR
+	2023, // RDFFRS
+	2024, // RDSVL
+	2025, // RDVL
+	2026, // RET
+	2028, // RETAA
+	2029, // RETAB
+	2030, // REV
+	2040, // REV16
+	2043, // REV32
+	2046, // REV64
+	2050, // RMIF
+	2051, // ROR
+	2055, // RORV
+	2057, // RSHRN
+	2060, // RSHRN2
+	2063, // RSUBHN
+	2066, // RSUBHN2
+	2069, // SABA
+	2072, // SABAL
+	2075, // SABAL2
+	2078, // SABD
+	2085, // SABDL
+	2088, // SABDL2
+	2091, // SADALP
+	2094, // SADDL
+	2097, // SADDL2
+	2100, // SADDLP
+	2103, // SADDLV
+	2106, // SADDV
+	2109, // SADDW
+	2112, // SADDW2
+	2115, // SB
+	2116, // SBC
+	2118, // SBCS
+	2120, // SBFIZ
+	2122, // SBFM
+	2124, // SBFX
+	2126, // SCVTF
+	2153, // SDIV
+	2157, // SDIVR
+	2159, // SDOT
+	2163, // SEL
+	2167, // SETE
+	2168, // SETF16
+	2169, // SETF8
+	2170, // SETFFR
+	2171, // SETGE
+	2172, // SETGM
+	2173, // SETGP
+	2174, // SETM
+	2175, // SETP
+	2176, // SEV
+	2177, // SEVL
+	2178, // SHA1C
+	2179, // SHA1H
+	2180, // SHA1M
+	2181, // SHA1P
+	2182, // SHA1SU0
+	2183, // SHA1SU1
+	2184, // SHA256H
+	2185, // SHA256H2
+	2186, // SHA256SU0
+	2187, // SHA256SU1
+	2188, // SHA512H
+	2189, // SHA512H2
+	2190, // SHA512SU0
+	2191, // SHA512SU1
+	2192, // SHADD
+	2195, // SHL
+	2200, // SHLL
+	2203, // SHLL2
+	2206, // SHRN
+	2209, // SHRN2
+	2212, // SHSUB
+	2215, // SLI
+	2220, // SM3PARTW1
+	2221, // SM3PARTW2
+	2222, // SM3SS1
+	2223, // SM3TT1A
+	2224, // SM3TT1B
+	2225, // SM3TT2A
+	2226, // SM3TT2B
+	2227, // SM4E
+	2228, // SM4EKEY
+	2229, // SMADDL
+	2230, // SMAX
+	2245, // SMAXP
+	2248, // SMAXV
+	2255, // SMC
+	2256, // SMIN
+	2271, // SMINP
+	2274, // SMINV
+	2281, // SMLAL
+	2286, // SMLAL2
+	2291, // SMLSL
+	2296, // SMLSL2
+	2301, // SMMLA
+	2302, // SMNEGL
+	2303, // SMOPA
+	2305, // SMOPS
+	2307, // SMOV
+	2312, // SMSTART
+	2314, // SMSTOP
+	2316, // SMSUBL
+	2317, // SMULH
+	2326, // SMULL
+	2332, // SMULL2
+	2337, // SPLICE
+	2341, // SQABS
+	2349, // SQADD
+	2365, // SQDMLAL
+	2373, // SQDMLAL2
+	2377, // SQDMLSL
+	2385, // SQDMLSL2
+	2389, // SQDMULH
+	2401, // SQDMULL
+	2409, // SQDMULL2
+	2413, // SQNEG
+	2421, // SQRDMLAH
+	2429, // SQRDMLSH
+	2437, // SQRDMULH
+	2445, // SQRSHL
+	2453, // SQRSHRN
+	2459, // SQRSHRN2
+	2462, // SQRSHRUN
+	2468, // SQRSHRUN2
+	2471, // SQSHL
+	2487, // SQSHLU
+	2495, // SQSHRN
+	2501, // SQSHRN2
+	2504, // SQSHRUN
+	2510, // SQSHRUN2
+	2513, // SQSUB
+	2529, // SQXTN
+	2535, // SQXTN2
+	2538, // SQXTUN
+	2544, // SQXTUN2
+	2547, // SRHADD
+	2550, // SRI
+	2555, // SRSHL
+	2560, // SRSHR
+	2565, // SRSRA
+	2570, // SSBB
+	2571, // SSHL
+	2576, // SSHLL
+	2579, // SSHLL2
+	2582, // SSHR
+	2587, // SSRA
+	2592, // SSUBL
+	2595, // SSUBL2
+	2598, // SSUBW
+	2601, // SSUBW2
+	2604, // ST1
+	2680, // ST1B
+	2694, // ST1D
+	2700, // ST1H
+	2714, // ST1Q
+	2716, // ST1W
+	2728, // ST2
+	2755, // ST2G
+	2758, // ST3
+	2785, // ST4
+	2812, // ST64B
+	2813, // ST64BV
+	2814, // ST64BV0
+	2815, // STADD
+	2817, // STADDB
+	2818, // STADDH
+	2819, // STADDL
+	2821, // STADDLB
+	2822, // STADDLH
+	2823, // STCLR
+	2825, // STCLRB
+	2826, // STCLRH
+	2827, // STCLRL
+	2829, // STCLRLB
+	2830, // STCLRLH
+	2831, // STEOR
+	2833, // STEORB
+	2834, // STEORH
+	2835, // STEORL
+	2837, // STEORLB
+	2838, // STEORLH
+	2839, // STG
+	2842, // STGP
+	2845, // STLLR
+	2847, // STLLRB
+	2848, // STLLRH
+	2849, // STLR
+	2851, // STLRB
+	2852, // STLRH
+	2853, // STLUR
+	2855, // STLURB
+	2856, // STLURH
+	2857, // STLXP
+	2859, // STLXR
+	2861, // STLXRB
+	2862, // STLXRH
+	2863, // STNP
+	2868, // STP
+	2883, // STR
+	2914, // STRB
+	2918, // STRH
+	2922, // STSET
+	2924, // STSETB
+	2925, // STSETH
+	2926, // STSETL
+	2928, // STSETLB
+	2929, // STSETLH
+	2930, // STSMAX
+	2932, // STSMAXB
+	2933, // STSMAXH
+	2934, // STSMAXL
+	2936, // STSMAXLB
+	2937, // STSMAXLH
+	2938, // STSMIN
+	2940, // STSMINB
+	2941, // STSMINH
+	2942, // STSMINL
+	2944, // STSMINLB
+	2945, // STSMINLH
+	2946, // STTR
+	2948, // STTRB
+	2949, // STTRH
+	2950, // STUMAX
+	2952, // STUMAXB
+	2953, // STUMAXH
+	2954, // STUMAXL
+	2956, // STUMAXLB
+	2957, // STUMAXLH
+	2958, // STUMIN
+	2960, // STUMINB
+	2961, // STUMINH
+	2962, // STUMINL
+	2964, // STUMINLB
+	2965, // STUMINLH
+	2966, // STUR
+	2973, // STURB
+	2974, // STURH
+	2975, // STXP
+	2977, // STXR
+	2979, // STXRB
+	2980, // STXRH
+	2981, // STZ2G
+	2984, // STZG
+	2987, // SUB
+	3011, // SUBG
+	3012, // SUBHN
+	3015, // SUBHN2
+	3018, // SUBP
+	3019, // SUBPS
+	3020, // SUBR
+	3028, // SUBS
+	3035, // SUDOT
+	3037, // SUMOPA
+	3039, // SUMOPS
+	3041, // SUNPKHI
+	3044, // SUNPKLO
+	3047, // SUQADD
+	3055, // SVC
+	3056, // SWP
+	3058, // SWPA
+	3060, // SWPAB
+	3061, // SWPAH
+	3062, // SWPAL
+	3064, // SWPALB
+	3065, // SWPALH
+	3066, // SWPB
+	3067, // SWPH
+	3068, // SWPL
+	3070, // SWPLB
+	3071, // SWPLH
+	3072, // SXTB
+	3074, // SXTH
+	3076, // SXTL
+	3079, // SXTL2
+	3082, // SXTW
+	3083, // SYS
+	3084, // SYSL
+	3085, // TBL
+	3093, // TBNZ
+	3095, // TBX
+	3099, // TBZ
+	3101, // TLBI
+	3102, // TRN1
+	3114, // TRN2
+	3126, // TSB
+	3127, // TST
+	3131, // UABA
+	3134, // UABAL
+	3137, // UABAL2
+	3140, // UABD
+	3147, // UABDL
+	3150, // UABDL2
+	3153, // UADALP
+	3156, // UADDL
+	3159, // UADDL2
+	3162, // UADDLP
+	3165, // UADDLV
+	3168, // UADDV
+	3172, // UADDW
+	3175, // UADDW2
+	3178, // UBFIZ
+	3180, // UBFM
+	3182, // UBFX
+	3184, // UCVTF
+	3211, // UDF
+	3212, // UDIV
+	3216, // UDIVR
+	3218, // UDOT
+	3222, // UHADD
+	3225, // UHSUB
+	3228, // UMADDL
+	3229, // UMAX
+	3244, // UMAXP
+	3247, // UMAXV
+	3254, // UMIN
+	3269, // UMINP
+	3272, // UMINV
+	3279, // UMLAL
+	3284, // UMLAL2
+	3289, // UMLSL
+	3294, // UMLSL2
+	3299, // UMMLA
+	3300, // UMNEGL
+	3301, // UMOPA
+	3303, // UMOPS
+	3305, // UMOV
+	3309, // UMSUBL
+	3310, // UMULH
+	3319, // UMULL
+	3325, // UMULL2
+	3330, // UQADD
+	3346, // UQRSHL
+	3354, // UQRSHRN
+	3360, // UQRSHRN2
+	3363, // UQSHL
+	3379, // UQSHRN
+	3385, // UQSHRN2
+	3388, // UQSUB
+	3404, // UQXTN
+	3410, // UQXTN2
+	3413, // URECPE
+	3414, // URHADD
+	3417, // URSHL
+	3422, // URSHR
+	3427, // URSQRTE
+	3428, // URSRA
+	3433, // USDOT
+	3437, // USHL
+	3442, // USHLL
+	3445, // USHLL2
+	3448, // USHR
+	3453, // USMMLA
+	3454, // USMOPA
+	3456, // USMOPS
+	3458, // USQADD
+	3466, // USRA
+	3471, // USUBL
+	3474, // USUBL2
+	3477, // USUBW
+	3480, // USUBW2
+	3483, // UUNPKHI
+	3486, // UUNPKLO
+	3489, // UXTB
+	3490, // UXTH
+	3491, // UXTL
+	3494, // UXTL2
+	3497, // UZP1
+	3509, // UZP2
+	3521, // WFE
+	3522, // WFET
+	3523, // WFI
+	3524, // WFIT
+	3525, // WHILEGE
+	3533, // WHILEGT
+	3541, // WHILEHI
+	3549, // WHILEHS
+	3557, // WHILELE
+	3565, // WHILELO
+	3573, // WHILELS
+	3581, // WHILELT
+	3589, // WRFFR
+	3590, // XAFLAG
+	3591, // XAR
+	3592, // XPACD
+	3593, // XPACI
+	3594, // XPACLRI
+	3595, // XTN
+	3598, // XTN2
+	3601, // YIELD
+	3602, // ZERO
+	3603, // ZIP1
+	3615, // ZIP2
+}
@@ -0,0 +1,24040 @@
+// Code generated by gen/inst/inst.go; DO NOT EDIT.
+
+package arm
+
+// ABS_DD encodes abs Dd, Dn.
+func (a *Assembler) ABS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 0, 0, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_V16BV16B encodes abs Vd.16B, Vn.16B.
+func (a *Assembler) ABS_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 1, 7, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_V8BV8B encodes abs Vd.8B, Vn.8B.
+func (a *Assembler) ABS_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 1, 7, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_V8HV8H encodes abs Vd.8H, Vn.8H.
+func (a *Assembler) ABS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 2, 15, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_V4HV4H encodes abs Vd.4H, Vn.4H.
+func (a *Assembler) ABS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 2, 15, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_V4SV4S encodes abs Vd.4S, Vn.4S.
+func (a *Assembler) ABS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 3, 23, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_V2SV2S encodes abs Vd.2S, Vn.2S.
+func (a *Assembler) ABS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 3, 23, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_V2DV2D encodes abs Vd.2D, Vn.2D.
+func (a *Assembler) ABS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 4, 31, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_WW encodes abs Wd, Wn.
+// Requires FEAT_CSSC.
+func (a *Assembler) ABS_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 5, 39, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ABS_XX encodes abs Xd, Xn.
+// Requires FEAT_CSSC.
+func (a *Assembler) ABS_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ABS, ErrNoMatch)
+	}
+	return a.emit(ABS, 6, 46, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ADC_WWW encodes adc Wd, Wn, Wm.
+func (a *Assembler) ADC_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADC, ErrNoMatch)
+	}
+	return a.emit(ADC, 0, 89, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADC_XXX encodes adc Xd, Xn, Xm.
+func (a *Assembler) ADC_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADC, ErrNoMatch)
+	}
+	return a.emit(ADC, 1, 97, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADCS_WWW encodes adcs Wd, Wn, Wm.
+func (a *Assembler) ADCS_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADCS, ErrNoMatch)
+	}
+	return a.emit(ADCS, 0, 105, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADCS_XXX encodes adcs Xd, Xn, Xm.
+func (a *Assembler) ADCS_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADCS, ErrNoMatch)
+	}
+	return a.emit(ADCS, 1, 113, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_WWW encodes add Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) ADD_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 0, 121, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADD_WWW_Mod encodes add Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) ADD_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 0, 121, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADD_XXX encodes add Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) ADD_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 1, 133, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADD_XXX_Mod encodes add Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) ADD_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 1, 133, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADD_WspWspW encodes add Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADD_WspWspW(rd, rn WSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 2, 145, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADD_WspWspW_Mod encodes add Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADD_WspWspW_Mod(rd, rn WSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtends], mod.ID) {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 2, 145, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADD_XspXspW encodes add Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADD_XspXspW(rd, rn XSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 3, 158, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADD_XspXspW_Mod encodes add Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADD_XspXspW_Mod(rd, rn XSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsW], mod.ID) {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 3, 158, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADD_XspXspX encodes add Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) ADD_XspXspX(rd, rn XSPReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 4, 171, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADD_XspXspX_Mod encodes add Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) ADD_XspXspX_Mod(rd, rn XSPReg, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsX], mod.ID) {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 4, 171, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADD_WspWsp_Imm encodes add Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADD_WspWsp_Imm(rd, rn WSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 5, 184, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ADD_WspWsp_Imm_LSL encodes add Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADD_WspWsp_Imm_LSL(rd, rn WSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 5, 184, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ADD_XspXsp_Imm encodes add Xd|SP, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADD_XspXsp_Imm(rd, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 6, 197, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ADD_XspXsp_Imm_LSL encodes add Xd|SP, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADD_XspXsp_Imm_LSL(rd, rn XSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 6, 197, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ADD_DDD encodes add Dd, Dn, Dm.
+func (a *Assembler) ADD_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 7, 210, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_V16BV16BV16B encodes add Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) ADD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 8, 218, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_V8BV8BV8B encodes add Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) ADD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 8, 218, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_V8HV8HV8H encodes add Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) ADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 9, 227, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_V4HV4HV4H encodes add Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) ADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 9, 227, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_V4SV4SV4S encodes add Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) ADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 10, 236, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_V2SV2SV2S encodes add Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) ADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 10, 236, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADD_V2DV2DV2D encodes add Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) ADD_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADD, ErrNoMatch)
+	}
+	return a.emit(ADD, 11, 245, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDG_XspXsp_Imm_Imm encodes addg Xd|SP, Xn|SP, #imm1, #imm2 (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16).
+// Requires FEAT_MTE.
+func (a *Assembler) ADDG_XspXsp_Imm_Imm(rd, rn XSPReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDG, ErrNoMatch)
+	}
+	return a.emit(ADDG, 0, 383, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// ADDHN_V8BV8HV8H encodes addhn Vd.8B, Vn.8H, Vm.8H.
+func (a *Assembler) ADDHN_V8BV8HV8H(rd V8BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDHN, ErrNoMatch)
+	}
+	return a.emit(ADDHN, 0, 423, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDHN_V4HV4SV4S encodes addhn Vd.4H, Vn.4S, Vm.4S.
+func (a *Assembler) ADDHN_V4HV4SV4S(rd V4HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDHN, ErrNoMatch)
+	}
+	return a.emit(ADDHN, 1, 431, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDHN_V2SV2DV2D encodes addhn Vd.2S, Vn.2D, Vm.2D.
+func (a *Assembler) ADDHN_V2SV2DV2D(rd V2SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDHN, ErrNoMatch)
+	}
+	return a.emit(ADDHN, 2, 439, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDHN2_V16BV8HV8H encodes addhn2 Vd.16B, Vn.8H, Vm.8H.
+func (a *Assembler) ADDHN2_V16BV8HV8H(rd V16BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDHN2, ErrNoMatch)
+	}
+	return a.emit(ADDHN2, 0, 447, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDHN2_V8HV4SV4S encodes addhn2 Vd.8H, Vn.4S, Vm.4S.
+func (a *Assembler) ADDHN2_V8HV4SV4S(rd V8HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDHN2, ErrNoMatch)
+	}
+	return a.emit(ADDHN2, 1, 455, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDHN2_V4SV2DV2D encodes addhn2 Vd.4S, Vn.2D, Vm.2D.
+func (a *Assembler) ADDHN2_V4SV2DV2D(rd V4SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDHN2, ErrNoMatch)
+	}
+	return a.emit(ADDHN2, 2, 463, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDP_DV2D encodes addp Dd, Vn.2D.
+func (a *Assembler) ADDP_DV2D(rd DReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 0, 471, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ADDP_V16BV16BV16B encodes addp Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) ADDP_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 1, 478, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDP_V8BV8BV8B encodes addp Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) ADDP_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 1, 478, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDP_V8HV8HV8H encodes addp Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) ADDP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 2, 487, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDP_V4HV4HV4H encodes addp Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) ADDP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 2, 487, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDP_V4SV4SV4S encodes addp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) ADDP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 3, 496, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDP_V2SV2SV2S encodes addp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) ADDP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 3, 496, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDP_V2DV2DV2D encodes addp Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) ADDP_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDP, ErrNoMatch)
+	}
+	return a.emit(ADDP, 4, 505, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ADDPL_XspXsp_Imm encodes addpl Xd|SP, Xn|SP, #imm (-32 <= imm < 32).
+// Requires FEAT_SVE.
+func (a *Assembler) ADDPL_XspXsp_Imm(rd, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDPL, ErrNoMatch)
+	}
+	return a.emit(ADDPL, 0, 514, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ADDS_WWW encodes adds Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) ADDS_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 0, 524, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADDS_WWW_Mod encodes adds Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) ADDS_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 0, 524, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADDS_XXX encodes adds Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) ADDS_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 1, 536, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADDS_XXX_Mod encodes adds Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) ADDS_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 1, 536, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADDS_WWspW encodes adds Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADDS_WWspW(rd WReg, rn WSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 2, 548, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADDS_WWspW_Mod encodes adds Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADDS_WWspW_Mod(rd WReg, rn WSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtends], mod.ID) {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 2, 548, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADDS_XXspW encodes adds Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADDS_XXspW(rd XReg, rn XSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 3, 561, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADDS_XXspW_Mod encodes adds Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) ADDS_XXspW_Mod(rd XReg, rn XSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsW], mod.ID) {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 3, 561, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADDS_XXspX encodes adds Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) ADDS_XXspX(rd XReg, rn XSPReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 4, 574, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ADDS_XXspX_Mod encodes adds Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) ADDS_XXspX_Mod(rd XReg, rn XSPReg, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsX], mod.ID) {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 4, 574, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ADDS_WWsp_Imm encodes adds Wd, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADDS_WWsp_Imm(rd WReg, rn WSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 5, 587, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ADDS_WWsp_Imm_LSL encodes adds Wd, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADDS_WWsp_Imm_LSL(rd WReg, rn WSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 5, 587, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ADDS_XXsp_Imm encodes adds Xd, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADDS_XXsp_Imm(rd XReg, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 6, 600, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ADDS_XXsp_Imm_LSL encodes adds Xd, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) ADDS_XXsp_Imm_LSL(rd XReg, rn XSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDS, ErrNoMatch)
+	}
+	return a.emit(ADDS, 6, 600, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ADDSPL_XspXsp_Imm encodes addspl Xd|SP, Xn|SP, #imm (-32 <= imm < 32).
+// Requires FEAT_SME.
+func (a *Assembler) ADDSPL_XspXsp_Imm(rd, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDSPL, ErrNoMatch)
+	}
+	return a.emit(ADDSPL, 0, 613, FeatSME, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ADDSVL_XspXsp_Imm encodes addsvl Xd|SP, Xn|SP, #imm (-32 <= imm < 32).
+// Requires FEAT_SME.
+func (a *Assembler) ADDSVL_XspXsp_Imm(rd, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDSVL, ErrNoMatch)
+	}
+	return a.emit(ADDSVL, 0, 623, FeatSME, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ADDV_BV16B encodes addv Bd, Vn.16B.
+func (a *Assembler) ADDV_BV16B(rd BReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDV, ErrNoMatch)
+	}
+	return a.emit(ADDV, 0, 633, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ADDV_BV8B encodes addv Bd, Vn.8B.
+func (a *Assembler) ADDV_BV8B(rd BReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDV, ErrNoMatch)
+	}
+	return a.emit(ADDV, 0, 633, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ADDV_HV8H encodes addv Hd, Vn.8H.
+func (a *Assembler) ADDV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDV, ErrNoMatch)
+	}
+	return a.emit(ADDV, 1, 641, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ADDV_HV4H encodes addv Hd, Vn.4H.
+func (a *Assembler) ADDV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDV, ErrNoMatch)
+	}
+	return a.emit(ADDV, 1, 641, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ADDV_SV4S encodes addv Sd, Vn.4S.
+func (a *Assembler) ADDV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDV, ErrNoMatch)
+	}
+	return a.emit(ADDV, 2, 649, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ADDVL_XspXsp_Imm encodes addvl Xd|SP, Xn|SP, #imm (-32 <= imm < 32).
+// Requires FEAT_SVE.
+func (a *Assembler) ADDVL_XspXsp_Imm(rd, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ADDVL, ErrNoMatch)
+	}
+	return a.emit(ADDVL, 0, 683, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ADR_X_Label encodes adr Xd, <offset> (offset is 21-bit (+/- 1 MB)).
+func (a *Assembler) ADR_X_Label(rd XReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(ADR, ErrNoMatch)
+	}
+	return a.emit(ADR, 0, 693, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// ADRP_X_Label encodes adrp Xd, <offset> (offset >> 12 is 21-bit (+/- 4 GB)).
+func (a *Assembler) ADRP_X_Label(rd XReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(ADRP, ErrNoMatch)
+	}
+	return a.emit(ADRP, 0, 701, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// AESD_V16BV16B encodes aesd Vd.16B, Vn.16B.
+// Requires FEAT_AES.
+func (a *Assembler) AESD_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AESD, ErrNoMatch)
+	}
+	return a.emit(AESD, 0, 709, FeatAES, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AESE_V16BV16B encodes aese Vd.16B, Vn.16B.
+// Requires FEAT_AES.
+func (a *Assembler) AESE_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AESE, ErrNoMatch)
+	}
+	return a.emit(AESE, 0, 716, FeatAES, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AESIMC_V16BV16B encodes aesimc Vd.16B, Vn.16B.
+// Requires FEAT_AES.
+func (a *Assembler) AESIMC_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AESIMC, ErrNoMatch)
+	}
+	return a.emit(AESIMC, 0, 723, FeatAES, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AESMC_V16BV16B encodes aesmc Vd.16B, Vn.16B.
+// Requires FEAT_AES.
+func (a *Assembler) AESMC_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AESMC, ErrNoMatch)
+	}
+	return a.emit(AESMC, 0, 730, FeatAES, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AND_V16BV16BV16B encodes and Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) AND_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 0, 737, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// AND_V8BV8BV8B encodes and Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) AND_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 0, 737, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// AND_WspW_Imm encodes and Wd|WSP, Wn, #imm (imm is 32-bit logical).
+func (a *Assembler) AND_WspW_Imm(rd WSPReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 1, 746, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// AND_XspX_Imm encodes and Xd|SP, Xn, #imm (imm is 64-bit logical).
+func (a *Assembler) AND_XspX_Imm(rd XSPReg, rn XReg, imm uint64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 2, 756, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// AND_WWW encodes and Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) AND_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 3, 766, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// AND_WWW_Mod encodes and Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) AND_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 3, 766, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// AND_XXX encodes and Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) AND_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 4, 778, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// AND_XXX_Mod encodes and Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) AND_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(AND, ErrNoMatch)
+	}
+	return a.emit(AND, 4, 778, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ANDS_WW_Imm encodes ands Wd, Wn, #imm (imm is 32-bit logical).
+func (a *Assembler) ANDS_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ANDS, ErrNoMatch)
+	}
+	return a.emit(ANDS, 0, 864, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ANDS_XX_Imm encodes ands Xd, Xn, #imm (imm is 64-bit logical).
+func (a *Assembler) ANDS_XX_Imm(rd, rn XReg, imm uint64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ANDS, ErrNoMatch)
+	}
+	return a.emit(ANDS, 1, 874, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ANDS_WWW encodes ands Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) ANDS_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ANDS, ErrNoMatch)
+	}
+	return a.emit(ANDS, 2, 884, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ANDS_WWW_Mod encodes ands Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) ANDS_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(ANDS, ErrNoMatch)
+	}
+	return a.emit(ANDS, 2, 884, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ANDS_XXX encodes ands Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) ANDS_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ANDS, ErrNoMatch)
+	}
+	return a.emit(ANDS, 3, 896, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ANDS_XXX_Mod encodes ands Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) ANDS_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(ANDS, ErrNoMatch)
+	}
+	return a.emit(ANDS, 3, 896, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ASR_WWW encodes asr Wd, Wn, Wm.
+func (a *Assembler) ASR_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ASR, ErrNoMatch)
+	}
+	return a.emit(ASR, 0, 944, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ASR_XXX encodes asr Xd, Xn, Xm.
+func (a *Assembler) ASR_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ASR, ErrNoMatch)
+	}
+	return a.emit(ASR, 1, 952, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ASR_WW_Imm encodes asr Wd, Wn, #imm (0 <= imm < 32).
+func (a *Assembler) ASR_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ASR, ErrNoMatch)
+	}
+	return a.emit(ASR, 2, 960, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ASR_XX_Imm encodes asr Xd, Xn, #imm (0 <= imm < 64).
+func (a *Assembler) ASR_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ASR, ErrNoMatch)
+	}
+	return a.emit(ASR, 3, 970, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ASRV_WWW encodes asrv Wd, Wn, Wm.
+func (a *Assembler) ASRV_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ASRV, ErrNoMatch)
+	}
+	return a.emit(ASRV, 0, 1122, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ASRV_XXX encodes asrv Xd, Xn, Xm.
+func (a *Assembler) ASRV_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ASRV, ErrNoMatch)
+	}
+	return a.emit(ASRV, 1, 1130, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// AT_Sym_X encodes at <symbol>, Xn.
+func (a *Assembler) AT_Sym_X(sym Symbol, rn XReg) bool {
+	if rn >= 32 {
+		return a.emitErr(AT, ErrNoMatch)
+	}
+	return a.emit(AT, 0, 1138, 0, 0, 0, Flat{FlatImm, uint64(sym)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AUTDA_XXsp encodes autda Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTDA_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AUTDA, ErrNoMatch)
+	}
+	return a.emit(AUTDA, 0, 1147, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AUTDB_XXsp encodes autdb Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTDB_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AUTDB, ErrNoMatch)
+	}
+	return a.emit(AUTDB, 0, 1154, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AUTDZA_X encodes autdza Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTDZA_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(AUTDZA, ErrNoMatch)
+	}
+	return a.emit(AUTDZA, 0, 1161, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// AUTDZB_X encodes autdzb Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTDZB_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(AUTDZB, ErrNoMatch)
+	}
+	return a.emit(AUTDZB, 0, 1167, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// AUTIA_XXsp encodes autia Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTIA_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AUTIA, ErrNoMatch)
+	}
+	return a.emit(AUTIA, 0, 1173, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AUTIA1716 encodes autia1716.
+func (a *Assembler) AUTIA1716() bool {
+	return a.emit(AUTIA1716, 0, 1180, 0, 0, 0)
+}
+
+// AUTIASP encodes autiasp.
+func (a *Assembler) AUTIASP() bool {
+	return a.emit(AUTIASP, 0, 1185, 0, 0, 0)
+}
+
+// AUTIAZ encodes autiaz.
+func (a *Assembler) AUTIAZ() bool {
+	return a.emit(AUTIAZ, 0, 1190, 0, 0, 0)
+}
+
+// AUTIB_XXsp encodes autib Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTIB_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(AUTIB, ErrNoMatch)
+	}
+	return a.emit(AUTIB, 0, 1195, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// AUTIB1716 encodes autib1716.
+func (a *Assembler) AUTIB1716() bool {
+	return a.emit(AUTIB1716, 0, 1202, 0, 0, 0)
+}
+
+// AUTIBSP encodes autibsp.
+func (a *Assembler) AUTIBSP() bool {
+	return a.emit(AUTIBSP, 0, 1207, 0, 0, 0)
+}
+
+// AUTIBZ encodes autibz.
+func (a *Assembler) AUTIBZ() bool {
+	return a.emit(AUTIBZ, 0, 1212, 0, 0, 0)
+}
+
+// AUTIZA_X encodes autiza Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTIZA_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(AUTIZA, ErrNoMatch)
+	}
+	return a.emit(AUTIZA, 0, 1217, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// AUTIZB_X encodes autizb Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) AUTIZB_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(AUTIZB, ErrNoMatch)
+	}
+	return a.emit(AUTIZB, 0, 1223, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// AXFLAG encodes axflag.
+// Requires FEAT_FlagM2.
+func (a *Assembler) AXFLAG() bool {
+	return a.emit(AXFLAG, 0, 1229, FeatFlagM2, 0, 0)
+}
+
+// B_Cond_Label encodes b <cond>, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) B_Cond_Label(cond Symbol, label Label) bool {
+	if !a.validLabel(label) {
+		return a.emitErr(B, ErrNoMatch)
+	}
+	return a.emit(B, 0, 1234, 0, 0, 0, Flat{FlatImm, uint64(cond)}, flatLabel(label))
+}
+
+// B_Label encodes b <offset> (offset >> 2 is 26-bit (+/- 128 MB)).
+func (a *Assembler) B_Label(label Label) bool {
+	if !a.validLabel(label) {
+		return a.emitErr(B, ErrNoMatch)
+	}
+	return a.emit(B, 1, 1243, 0, 0, 0, flatLabel(label))
+}
+
+// BCAX_V16BV16BV16BV16B encodes bcax Vd.16B, Vn.16B, Vm.16B, Va.16B.
+// Requires FEAT_SHA3.
+func (a *Assembler) BCAX_V16BV16BV16BV16B(rd, rn, rm, ra V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(BCAX, ErrNoMatch)
+	}
+	return a.emit(BCAX, 0, 1250, FeatSHA3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// BFC_W_Imm_Imm encodes bfc Wd, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32).
+func (a *Assembler) BFC_W_Imm_Imm(rd WReg, imm, imm2 int64) bool {
+	if rd >= 32 {
+		return a.emitErr(BFC, ErrNoMatch)
+	}
+	return a.emit(BFC, 0, 1269, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BFC_X_Imm_Imm encodes bfc Xd, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64).
+func (a *Assembler) BFC_X_Imm_Imm(rd XReg, imm, imm2 int64) bool {
+	if rd >= 32 {
+		return a.emitErr(BFC, ErrNoMatch)
+	}
+	return a.emit(BFC, 1, 1284, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BFCVT_HS encodes bfcvt Hd, Sn.
+// Requires FEAT_BF16.
+func (a *Assembler) BFCVT_HS(rd HReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFCVT, ErrNoMatch)
+	}
+	return a.emit(BFCVT, 0, 1299, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// BFCVTN_V4HV4S encodes bfcvtn Vd.4H, Vn.4S.
+// Requires FEAT_BF16.
+func (a *Assembler) BFCVTN_V4HV4S(rd V4HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFCVTN, ErrNoMatch)
+	}
+	return a.emit(BFCVTN, 0, 1306, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// BFCVTN2_V8HV4S encodes bfcvtn2 Vd.8H, Vn.4S.
+// Requires FEAT_BF16.
+func (a *Assembler) BFCVTN2_V8HV4S(rd V8HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFCVTN2, ErrNoMatch)
+	}
+	return a.emit(BFCVTN2, 0, 1313, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// BFDOT_V2SV4HV4H encodes bfdot Vd.2S, Vn.4H, Vm.4H.
+// Requires FEAT_BF16.
+func (a *Assembler) BFDOT_V2SV4HV4H(rd V2SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BFDOT, ErrNoMatch)
+	}
+	return a.emit(BFDOT, 0, 1320, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BFDOT_V2SV4HV2Hi encodes bfdot Vd.2S, Vn.4H, Vm.2H[i].
+// Requires FEAT_BF16.
+func (a *Assembler) BFDOT_V2SV4HV2Hi(rd V2SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(BFDOT, ErrNoMatch)
+	}
+	return a.emit(BFDOT, 1, 1328, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// BFDOT_V4SV8HV8H encodes bfdot Vd.4S, Vn.8H, Vm.8H.
+// Requires FEAT_BF16.
+func (a *Assembler) BFDOT_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BFDOT, ErrNoMatch)
+	}
+	return a.emit(BFDOT, 2, 1338, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BFDOT_V4SV8HV2Hi encodes bfdot Vd.4S, Vn.8H, Vm.2H[i].
+// Requires FEAT_BF16.
+func (a *Assembler) BFDOT_V4SV8HV2Hi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(BFDOT, ErrNoMatch)
+	}
+	return a.emit(BFDOT, 3, 1346, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// BFI_WW_Imm_Imm encodes bfi Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32).
+func (a *Assembler) BFI_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFI, ErrNoMatch)
+	}
+	return a.emit(BFI, 0, 1356, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BFI_XX_Imm_Imm encodes bfi Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64).
+func (a *Assembler) BFI_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFI, ErrNoMatch)
+	}
+	return a.emit(BFI, 1, 1372, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BFM_WW_Imm_Imm encodes bfm Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 <= imm2 < 32).
+func (a *Assembler) BFM_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFM, ErrNoMatch)
+	}
+	return a.emit(BFM, 0, 1388, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BFM_XX_Imm_Imm encodes bfm Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64).
+func (a *Assembler) BFM_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFM, ErrNoMatch)
+	}
+	return a.emit(BFM, 1, 1401, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BFMLALB_V4SV8HV8H encodes bfmlalb Vd.4S, Vn.8H, Vm.8H.
+// Requires FEAT_BF16.
+func (a *Assembler) BFMLALB_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BFMLALB, ErrNoMatch)
+	}
+	return a.emit(BFMLALB, 0, 1416, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BFMLALB_V4SV8HVHi encodes bfmlalb Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_BF16.
+func (a *Assembler) BFMLALB_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(BFMLALB, ErrNoMatch)
+	}
+	return a.emit(BFMLALB, 1, 1424, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// BFMLALT_V4SV8HV8H encodes bfmlalt Vd.4S, Vn.8H, Vm.8H.
+// Requires FEAT_BF16.
+func (a *Assembler) BFMLALT_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BFMLALT, ErrNoMatch)
+	}
+	return a.emit(BFMLALT, 0, 1434, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BFMLALT_V4SV8HVHi encodes bfmlalt Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_BF16.
+func (a *Assembler) BFMLALT_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(BFMLALT, ErrNoMatch)
+	}
+	return a.emit(BFMLALT, 1, 1442, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// BFMMLA_V4SV8HV8H encodes bfmmla Vd.4S, Vn.8H, Vm.8H.
+// Requires FEAT_BF16.
+func (a *Assembler) BFMMLA_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BFMMLA, ErrNoMatch)
+	}
+	return a.emit(BFMMLA, 0, 1452, FeatBF16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BFXIL_WW_Imm_Imm encodes bfxil Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32).
+func (a *Assembler) BFXIL_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFXIL, ErrNoMatch)
+	}
+	return a.emit(BFXIL, 0, 1488, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BFXIL_XX_Imm_Imm encodes bfxil Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64).
+func (a *Assembler) BFXIL_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BFXIL, ErrNoMatch)
+	}
+	return a.emit(BFXIL, 1, 1503, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// BIC_V8H_Imm encodes bic Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) BIC_V8H_Imm(rd V8HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 0, 1518, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// BIC_V8H_Imm_LSL encodes bic Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) BIC_V8H_Imm_LSL(rd V8HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 0, 1518, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// BIC_V4H_Imm encodes bic Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) BIC_V4H_Imm(rd V4HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 0, 1518, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// BIC_V4H_Imm_LSL encodes bic Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) BIC_V4H_Imm_LSL(rd V4HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 0, 1518, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// BIC_V4S_Imm encodes bic Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) BIC_V4S_Imm(rd V4SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 1, 1539, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// BIC_V4S_Imm_LSL encodes bic Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) BIC_V4S_Imm_LSL(rd V4SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 1, 1539, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// BIC_V2S_Imm encodes bic Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) BIC_V2S_Imm(rd V2SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 1, 1539, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// BIC_V2S_Imm_LSL encodes bic Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) BIC_V2S_Imm_LSL(rd V2SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 1, 1539, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// BIC_V16BV16BV16B encodes bic Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) BIC_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 2, 1560, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BIC_V8BV8BV8B encodes bic Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) BIC_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 2, 1560, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BIC_WWW encodes bic Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) BIC_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 3, 1569, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// BIC_WWW_Mod encodes bic Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) BIC_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 3, 1569, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// BIC_XXX encodes bic Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) BIC_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 4, 1581, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// BIC_XXX_Mod encodes bic Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) BIC_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(BIC, ErrNoMatch)
+	}
+	return a.emit(BIC, 4, 1581, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// BICS_WWW encodes bics Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) BICS_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BICS, ErrNoMatch)
+	}
+	return a.emit(BICS, 0, 1645, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// BICS_WWW_Mod encodes bics Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) BICS_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(BICS, ErrNoMatch)
+	}
+	return a.emit(BICS, 0, 1645, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// BICS_XXX encodes bics Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) BICS_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BICS, ErrNoMatch)
+	}
+	return a.emit(BICS, 1, 1657, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// BICS_XXX_Mod encodes bics Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) BICS_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(BICS, ErrNoMatch)
+	}
+	return a.emit(BICS, 1, 1657, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// BIF_V16BV16BV16B encodes bif Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) BIF_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIF, ErrNoMatch)
+	}
+	return a.emit(BIF, 0, 1669, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BIF_V8BV8BV8B encodes bif Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) BIF_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIF, ErrNoMatch)
+	}
+	return a.emit(BIF, 0, 1669, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BIT_V16BV16BV16B encodes bit Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) BIT_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIT, ErrNoMatch)
+	}
+	return a.emit(BIT, 0, 1678, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BIT_V8BV8BV8B encodes bit Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) BIT_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BIT, ErrNoMatch)
+	}
+	return a.emit(BIT, 0, 1678, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BL_Label encodes bl <offset> (offset >> 2 is 26-bit (+/- 128 MB)).
+func (a *Assembler) BL_Label(label Label) bool {
+	if !a.validLabel(label) {
+		return a.emitErr(BL, ErrNoMatch)
+	}
+	return a.emit(BL, 0, 1687, 0, 0, 0, flatLabel(label))
+}
+
+// BLR_X encodes blr Xd.
+func (a *Assembler) BLR_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(BLR, ErrNoMatch)
+	}
+	return a.emit(BLR, 0, 1694, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// BLRAA_XXsp encodes blraa Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) BLRAA_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BLRAA, ErrNoMatch)
+	}
+	return a.emit(BLRAA, 0, 1700, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// BLRAAZ_X encodes blraaz Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) BLRAAZ_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(BLRAAZ, ErrNoMatch)
+	}
+	return a.emit(BLRAAZ, 0, 1707, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// BLRAB_XXsp encodes blrab Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) BLRAB_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BLRAB, ErrNoMatch)
+	}
+	return a.emit(BLRAB, 0, 1713, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// BLRABZ_X encodes blrabz Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) BLRABZ_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(BLRABZ, ErrNoMatch)
+	}
+	return a.emit(BLRABZ, 0, 1720, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// BR_X encodes br Xd.
+func (a *Assembler) BR_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(BR, ErrNoMatch)
+	}
+	return a.emit(BR, 0, 1726, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// BRAA_XXsp encodes braa Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) BRAA_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BRAA, ErrNoMatch)
+	}
+	return a.emit(BRAA, 0, 1732, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// BRAAZ_X encodes braaz Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) BRAAZ_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(BRAAZ, ErrNoMatch)
+	}
+	return a.emit(BRAAZ, 0, 1739, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// BRAB_XXsp encodes brab Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) BRAB_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(BRAB, ErrNoMatch)
+	}
+	return a.emit(BRAB, 0, 1745, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// BRABZ_X encodes brabz Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) BRABZ_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(BRABZ, ErrNoMatch)
+	}
+	return a.emit(BRABZ, 0, 1752, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// BRK_Imm encodes brk #imm (0 <= imm < 65536).
+func (a *Assembler) BRK_Imm(imm int64) bool {
+	return a.emit(BRK, 0, 1758, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// BSL_V16BV16BV16B encodes bsl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) BSL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BSL, ErrNoMatch)
+	}
+	return a.emit(BSL, 0, 1766, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BSL_V8BV8BV8B encodes bsl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) BSL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(BSL, ErrNoMatch)
+	}
+	return a.emit(BSL, 0, 1766, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// BTI encodes bti.
+func (a *Assembler) BTI() bool {
+	return a.emit(BTI, 0, 1805, 0, 0, 0)
+}
+
+// BTI_Sym encodes bti <symbol>.
+func (a *Assembler) BTI_Sym(sym Symbol) bool {
+	return a.emit(BTI, 1, 1810, 0, 0, 0, Flat{FlatImm, uint64(sym)})
+}
+
+// CAS_WW_Ref encodes cas Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CAS_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CAS, ErrNoMatch)
+	}
+	return a.emit(CAS, 0, 1818, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CAS_XX_Ref encodes cas Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CAS_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CAS, ErrNoMatch)
+	}
+	return a.emit(CAS, 1, 1826, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASA_WW_Ref encodes casa Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASA, ErrNoMatch)
+	}
+	return a.emit(CASA, 0, 1834, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASA_XX_Ref encodes casa Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASA, ErrNoMatch)
+	}
+	return a.emit(CASA, 1, 1842, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASAB_WW_Ref encodes casab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASAB, ErrNoMatch)
+	}
+	return a.emit(CASAB, 0, 1850, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASAH_WW_Ref encodes casah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASAH, ErrNoMatch)
+	}
+	return a.emit(CASAH, 0, 1858, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASAL_WW_Ref encodes casal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASAL, ErrNoMatch)
+	}
+	return a.emit(CASAL, 0, 1866, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASAL_XX_Ref encodes casal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASAL, ErrNoMatch)
+	}
+	return a.emit(CASAL, 1, 1874, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASALB_WW_Ref encodes casalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASALB, ErrNoMatch)
+	}
+	return a.emit(CASALB, 0, 1882, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASALH_WW_Ref encodes casalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASALH, ErrNoMatch)
+	}
+	return a.emit(CASALH, 0, 1890, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASB_WW_Ref encodes casb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASB, ErrNoMatch)
+	}
+	return a.emit(CASB, 0, 1898, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASH_WW_Ref encodes cash Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASH, ErrNoMatch)
+	}
+	return a.emit(CASH, 0, 1906, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASL_WW_Ref encodes casl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASL, ErrNoMatch)
+	}
+	return a.emit(CASL, 0, 1914, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASL_XX_Ref encodes casl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASL, ErrNoMatch)
+	}
+	return a.emit(CASL, 1, 1922, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASLB_WW_Ref encodes caslb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASLB, ErrNoMatch)
+	}
+	return a.emit(CASLB, 0, 1930, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASLH_WW_Ref encodes caslh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) CASLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(CASLH, ErrNoMatch)
+	}
+	return a.emit(CASLH, 0, 1938, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASP_WWWW_Ref encodes casp Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASP_WWWW_Ref(rd, rn, rm, ra WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASP, ErrNoMatch)
+	}
+	return a.emit(CASP, 0, 1946, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASP_XXXX_Ref encodes casp Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASP_XXXX_Ref(rd, rn, rm, ra XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASP, ErrNoMatch)
+	}
+	return a.emit(CASP, 1, 1958, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASPA_WWWW_Ref encodes caspa Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASPA_WWWW_Ref(rd, rn, rm, ra WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASPA, ErrNoMatch)
+	}
+	return a.emit(CASPA, 0, 1970, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASPA_XXXX_Ref encodes caspa Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASPA_XXXX_Ref(rd, rn, rm, ra XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASPA, ErrNoMatch)
+	}
+	return a.emit(CASPA, 1, 1982, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASPAL_WWWW_Ref encodes caspal Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASPAL_WWWW_Ref(rd, rn, rm, ra WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASPAL, ErrNoMatch)
+	}
+	return a.emit(CASPAL, 0, 1994, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASPAL_XXXX_Ref encodes caspal Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASPAL_XXXX_Ref(rd, rn, rm, ra XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASPAL, ErrNoMatch)
+	}
+	return a.emit(CASPAL, 1, 2006, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASPL_WWWW_Ref encodes caspl Wn, Wm, Wa, Wb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASPL_WWWW_Ref(rd, rn, rm, ra WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASPL, ErrNoMatch)
+	}
+	return a.emit(CASPL, 0, 2018, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CASPL_XXXX_Ref encodes caspl Xn, Xm, Xa, Xb, [Xd|SP] (n is even, m == n + 1, a is even, b == a + 1).
+// Requires FEAT_LSE.
+func (a *Assembler) CASPL_XXXX_Ref(rd, rn, rm, ra XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra) | uint8(base)) >= 32 {
+		return a.emitErr(CASPL, ErrNoMatch)
+	}
+	return a.emit(CASPL, 1, 2030, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)}, Flat{FlatReg, uint64(base)})
+}
+
+// CBNZ_W_Label encodes cbnz Wd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) CBNZ_W_Label(rd WReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(CBNZ, ErrNoMatch)
+	}
+	return a.emit(CBNZ, 0, 2042, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// CBNZ_X_Label encodes cbnz Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) CBNZ_X_Label(rd XReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(CBNZ, ErrNoMatch)
+	}
+	return a.emit(CBNZ, 1, 2050, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// CBZ_W_Label encodes cbz Wd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) CBZ_W_Label(rd WReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(CBZ, ErrNoMatch)
+	}
+	return a.emit(CBZ, 0, 2058, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// CBZ_X_Label encodes cbz Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) CBZ_X_Label(rd XReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(CBZ, ErrNoMatch)
+	}
+	return a.emit(CBZ, 1, 2066, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// CCMN_W_Imm_Imm_Cond encodes ccmn Wd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16).
+func (a *Assembler) CCMN_W_Imm_Imm_Cond(rd WReg, imm, imm2 int64, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CCMN, ErrNoMatch)
+	}
+	return a.emit(CCMN, 0, 2074, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CCMN_X_Imm_Imm_Cond encodes ccmn Xd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16).
+func (a *Assembler) CCMN_X_Imm_Imm_Cond(rd XReg, imm, imm2 int64, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CCMN, ErrNoMatch)
+	}
+	return a.emit(CCMN, 1, 2088, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CCMN_WW_Imm_Cond encodes ccmn Wd, Wn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) CCMN_WW_Imm_Cond(rd, rn WReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CCMN, ErrNoMatch)
+	}
+	return a.emit(CCMN, 2, 2102, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CCMN_XX_Imm_Cond encodes ccmn Xd, Xn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) CCMN_XX_Imm_Cond(rd, rn XReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CCMN, ErrNoMatch)
+	}
+	return a.emit(CCMN, 3, 2114, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CCMP_W_Imm_Imm_Cond encodes ccmp Wd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16).
+func (a *Assembler) CCMP_W_Imm_Imm_Cond(rd WReg, imm, imm2 int64, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CCMP, ErrNoMatch)
+	}
+	return a.emit(CCMP, 0, 2126, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CCMP_X_Imm_Imm_Cond encodes ccmp Xd, #imm1, #imm2, <cond> (0 <= imm1 < 32, 0 <= imm2 < 16).
+func (a *Assembler) CCMP_X_Imm_Imm_Cond(rd XReg, imm, imm2 int64, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CCMP, ErrNoMatch)
+	}
+	return a.emit(CCMP, 1, 2140, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CCMP_WW_Imm_Cond encodes ccmp Wd, Wn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) CCMP_WW_Imm_Cond(rd, rn WReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CCMP, ErrNoMatch)
+	}
+	return a.emit(CCMP, 2, 2154, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CCMP_XX_Imm_Cond encodes ccmp Xd, Xn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) CCMP_XX_Imm_Cond(rd, rn XReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CCMP, ErrNoMatch)
+	}
+	return a.emit(CCMP, 3, 2166, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CFINV encodes cfinv.
+// Requires FEAT_FlagM.
+func (a *Assembler) CFINV() bool {
+	return a.emit(CFINV, 0, 2178, FeatFlagM, 0, 0)
+}
+
+// CFP_RCTX_X encodes cfp RCTX, Xn.
+// Requires FEAT_SPECRES.
+func (a *Assembler) CFP_RCTX_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(CFP, ErrNoMatch)
+	}
+	return a.emit(CFP, 0, 2183, FeatSPECRES, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// CINC_WW_Cond encodes cinc Wd, Wn, <cond>.
+func (a *Assembler) CINC_WW_Cond(rd, rn WReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CINC, ErrNoMatch)
+	}
+	return a.emit(CINC, 0, 2189, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CINC_XX_Cond encodes cinc Xd, Xn, <cond>.
+func (a *Assembler) CINC_XX_Cond(rd, rn XReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CINC, ErrNoMatch)
+	}
+	return a.emit(CINC, 1, 2200, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CINV_WW_Cond encodes cinv Wd, Wn, <cond>.
+func (a *Assembler) CINV_WW_Cond(rd, rn WReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CINV, ErrNoMatch)
+	}
+	return a.emit(CINV, 0, 2211, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CINV_XX_Cond encodes cinv Xd, Xn, <cond>.
+func (a *Assembler) CINV_XX_Cond(rd, rn XReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CINV, ErrNoMatch)
+	}
+	return a.emit(CINV, 1, 2222, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CLRBHB encodes clrbhb.
+func (a *Assembler) CLRBHB() bool {
+	return a.emit(CLRBHB, 0, 2233, 0, 0, 0)
+}
+
+// CLREX_Imm encodes clrex #imm (0 <= imm < 16).
+func (a *Assembler) CLREX_Imm(imm int64) bool {
+	return a.emit(CLREX, 0, 2238, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// CLREX encodes clrex.
+func (a *Assembler) CLREX() bool {
+	return a.emit(CLREX, 1, 2246, 0, 0, 0)
+}
+
+// CLS_V16BV16B encodes cls Vd.16B, Vn.16B.
+func (a *Assembler) CLS_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 0, 2251, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLS_V8BV8B encodes cls Vd.8B, Vn.8B.
+func (a *Assembler) CLS_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 0, 2251, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLS_V8HV8H encodes cls Vd.8H, Vn.8H.
+func (a *Assembler) CLS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 1, 2259, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLS_V4HV4H encodes cls Vd.4H, Vn.4H.
+func (a *Assembler) CLS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 1, 2259, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLS_V4SV4S encodes cls Vd.4S, Vn.4S.
+func (a *Assembler) CLS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 2, 2267, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLS_V2SV2S encodes cls Vd.2S, Vn.2S.
+func (a *Assembler) CLS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 2, 2267, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLS_WW encodes cls Wd, Wn.
+func (a *Assembler) CLS_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 3, 2275, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLS_XX encodes cls Xd, Xn.
+func (a *Assembler) CLS_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLS, ErrNoMatch)
+	}
+	return a.emit(CLS, 4, 2282, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_V16BV16B encodes clz Vd.16B, Vn.16B.
+func (a *Assembler) CLZ_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 0, 2325, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_V8BV8B encodes clz Vd.8B, Vn.8B.
+func (a *Assembler) CLZ_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 0, 2325, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_V8HV8H encodes clz Vd.8H, Vn.8H.
+func (a *Assembler) CLZ_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 1, 2333, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_V4HV4H encodes clz Vd.4H, Vn.4H.
+func (a *Assembler) CLZ_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 1, 2333, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_V4SV4S encodes clz Vd.4S, Vn.4S.
+func (a *Assembler) CLZ_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 2, 2341, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_V2SV2S encodes clz Vd.2S, Vn.2S.
+func (a *Assembler) CLZ_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 2, 2341, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_WW encodes clz Wd, Wn.
+func (a *Assembler) CLZ_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 3, 2349, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CLZ_XX encodes clz Xd, Xn.
+func (a *Assembler) CLZ_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CLZ, ErrNoMatch)
+	}
+	return a.emit(CLZ, 4, 2356, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_DDD encodes cmeq Dd, Dn, Dm.
+func (a *Assembler) CMEQ_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 0, 2399, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_V16BV16BV16B encodes cmeq Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) CMEQ_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 1, 2407, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_V8BV8BV8B encodes cmeq Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) CMEQ_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 1, 2407, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_V8HV8HV8H encodes cmeq Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) CMEQ_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 2, 2416, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_V4HV4HV4H encodes cmeq Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) CMEQ_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 2, 2416, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_V4SV4SV4S encodes cmeq Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) CMEQ_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 3, 2425, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_V2SV2SV2S encodes cmeq Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) CMEQ_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 3, 2425, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_V2DV2DV2D encodes cmeq Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) CMEQ_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 4, 2434, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMEQ_DD_0 encodes cmeq Dd, Dn, #0.
+func (a *Assembler) CMEQ_DD_0(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 5, 2443, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_V16BV16B_0 encodes cmeq Vd.16B, Vn.16B, #0.
+func (a *Assembler) CMEQ_V16BV16B_0(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 6, 2450, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_V8BV8B_0 encodes cmeq Vd.8B, Vn.8B, #0.
+func (a *Assembler) CMEQ_V8BV8B_0(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 6, 2450, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_V8HV8H_0 encodes cmeq Vd.8H, Vn.8H, #0.
+func (a *Assembler) CMEQ_V8HV8H_0(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 7, 2458, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_V4HV4H_0 encodes cmeq Vd.4H, Vn.4H, #0.
+func (a *Assembler) CMEQ_V4HV4H_0(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 7, 2458, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_V4SV4S_0 encodes cmeq Vd.4S, Vn.4S, #0.
+func (a *Assembler) CMEQ_V4SV4S_0(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 8, 2466, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_V2SV2S_0 encodes cmeq Vd.2S, Vn.2S, #0.
+func (a *Assembler) CMEQ_V2SV2S_0(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 8, 2466, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMEQ_V2DV2D_0 encodes cmeq Vd.2D, Vn.2D, #0.
+func (a *Assembler) CMEQ_V2DV2D_0(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMEQ, ErrNoMatch)
+	}
+	return a.emit(CMEQ, 9, 2474, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_DDD encodes cmge Dd, Dn, Dm.
+func (a *Assembler) CMGE_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 0, 2482, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_V16BV16BV16B encodes cmge Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) CMGE_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 1, 2490, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_V8BV8BV8B encodes cmge Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) CMGE_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 1, 2490, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_V8HV8HV8H encodes cmge Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) CMGE_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 2, 2499, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_V4HV4HV4H encodes cmge Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) CMGE_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 2, 2499, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_V4SV4SV4S encodes cmge Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) CMGE_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 3, 2508, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_V2SV2SV2S encodes cmge Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) CMGE_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 3, 2508, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_V2DV2DV2D encodes cmge Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) CMGE_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 4, 2517, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGE_DD_0 encodes cmge Dd, Dn, #0.
+func (a *Assembler) CMGE_DD_0(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 5, 2526, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_V16BV16B_0 encodes cmge Vd.16B, Vn.16B, #0.
+func (a *Assembler) CMGE_V16BV16B_0(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 6, 2533, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_V8BV8B_0 encodes cmge Vd.8B, Vn.8B, #0.
+func (a *Assembler) CMGE_V8BV8B_0(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 6, 2533, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_V8HV8H_0 encodes cmge Vd.8H, Vn.8H, #0.
+func (a *Assembler) CMGE_V8HV8H_0(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 7, 2541, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_V4HV4H_0 encodes cmge Vd.4H, Vn.4H, #0.
+func (a *Assembler) CMGE_V4HV4H_0(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 7, 2541, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_V4SV4S_0 encodes cmge Vd.4S, Vn.4S, #0.
+func (a *Assembler) CMGE_V4SV4S_0(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 8, 2549, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_V2SV2S_0 encodes cmge Vd.2S, Vn.2S, #0.
+func (a *Assembler) CMGE_V2SV2S_0(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 8, 2549, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGE_V2DV2D_0 encodes cmge Vd.2D, Vn.2D, #0.
+func (a *Assembler) CMGE_V2DV2D_0(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGE, ErrNoMatch)
+	}
+	return a.emit(CMGE, 9, 2557, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_DDD encodes cmgt Dd, Dn, Dm.
+func (a *Assembler) CMGT_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 0, 2565, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_V16BV16BV16B encodes cmgt Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) CMGT_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 1, 2573, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_V8BV8BV8B encodes cmgt Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) CMGT_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 1, 2573, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_V8HV8HV8H encodes cmgt Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) CMGT_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 2, 2582, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_V4HV4HV4H encodes cmgt Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) CMGT_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 2, 2582, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_V4SV4SV4S encodes cmgt Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) CMGT_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 3, 2591, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_V2SV2SV2S encodes cmgt Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) CMGT_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 3, 2591, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_V2DV2DV2D encodes cmgt Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) CMGT_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 4, 2600, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMGT_DD_0 encodes cmgt Dd, Dn, #0.
+func (a *Assembler) CMGT_DD_0(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 5, 2609, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_V16BV16B_0 encodes cmgt Vd.16B, Vn.16B, #0.
+func (a *Assembler) CMGT_V16BV16B_0(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 6, 2616, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_V8BV8B_0 encodes cmgt Vd.8B, Vn.8B, #0.
+func (a *Assembler) CMGT_V8BV8B_0(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 6, 2616, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_V8HV8H_0 encodes cmgt Vd.8H, Vn.8H, #0.
+func (a *Assembler) CMGT_V8HV8H_0(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 7, 2624, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_V4HV4H_0 encodes cmgt Vd.4H, Vn.4H, #0.
+func (a *Assembler) CMGT_V4HV4H_0(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 7, 2624, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_V4SV4S_0 encodes cmgt Vd.4S, Vn.4S, #0.
+func (a *Assembler) CMGT_V4SV4S_0(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 8, 2632, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_V2SV2S_0 encodes cmgt Vd.2S, Vn.2S, #0.
+func (a *Assembler) CMGT_V2SV2S_0(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 8, 2632, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMGT_V2DV2D_0 encodes cmgt Vd.2D, Vn.2D, #0.
+func (a *Assembler) CMGT_V2DV2D_0(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMGT, ErrNoMatch)
+	}
+	return a.emit(CMGT, 9, 2640, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMHI_DDD encodes cmhi Dd, Dn, Dm.
+func (a *Assembler) CMHI_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 0, 2648, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHI_V16BV16BV16B encodes cmhi Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) CMHI_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 1, 2656, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHI_V8BV8BV8B encodes cmhi Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) CMHI_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 1, 2656, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHI_V8HV8HV8H encodes cmhi Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) CMHI_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 2, 2665, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHI_V4HV4HV4H encodes cmhi Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) CMHI_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 2, 2665, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHI_V4SV4SV4S encodes cmhi Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) CMHI_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 3, 2674, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHI_V2SV2SV2S encodes cmhi Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) CMHI_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 3, 2674, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHI_V2DV2DV2D encodes cmhi Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) CMHI_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHI, ErrNoMatch)
+	}
+	return a.emit(CMHI, 4, 2683, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_DDD encodes cmhs Dd, Dn, Dm.
+func (a *Assembler) CMHS_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 0, 2692, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_V16BV16BV16B encodes cmhs Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) CMHS_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 1, 2700, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_V8BV8BV8B encodes cmhs Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) CMHS_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 1, 2700, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_V8HV8HV8H encodes cmhs Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) CMHS_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 2, 2709, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_V4HV4HV4H encodes cmhs Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) CMHS_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 2, 2709, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_V4SV4SV4S encodes cmhs Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) CMHS_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 3, 2718, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_V2SV2SV2S encodes cmhs Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) CMHS_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 3, 2718, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMHS_V2DV2DV2D encodes cmhs Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) CMHS_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMHS, ErrNoMatch)
+	}
+	return a.emit(CMHS, 4, 2727, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMLE_DD_0 encodes cmle Dd, Dn, #0.
+func (a *Assembler) CMLE_DD_0(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 0, 2736, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLE_V16BV16B_0 encodes cmle Vd.16B, Vn.16B, #0.
+func (a *Assembler) CMLE_V16BV16B_0(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 1, 2743, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLE_V8BV8B_0 encodes cmle Vd.8B, Vn.8B, #0.
+func (a *Assembler) CMLE_V8BV8B_0(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 1, 2743, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLE_V8HV8H_0 encodes cmle Vd.8H, Vn.8H, #0.
+func (a *Assembler) CMLE_V8HV8H_0(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 2, 2751, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLE_V4HV4H_0 encodes cmle Vd.4H, Vn.4H, #0.
+func (a *Assembler) CMLE_V4HV4H_0(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 2, 2751, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLE_V4SV4S_0 encodes cmle Vd.4S, Vn.4S, #0.
+func (a *Assembler) CMLE_V4SV4S_0(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 3, 2759, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLE_V2SV2S_0 encodes cmle Vd.2S, Vn.2S, #0.
+func (a *Assembler) CMLE_V2SV2S_0(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 3, 2759, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLE_V2DV2D_0 encodes cmle Vd.2D, Vn.2D, #0.
+func (a *Assembler) CMLE_V2DV2D_0(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLE, ErrNoMatch)
+	}
+	return a.emit(CMLE, 4, 2767, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_DD_0 encodes cmlt Dd, Dn, #0.
+func (a *Assembler) CMLT_DD_0(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 0, 2775, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_V16BV16B_0 encodes cmlt Vd.16B, Vn.16B, #0.
+func (a *Assembler) CMLT_V16BV16B_0(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 1, 2782, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_V8BV8B_0 encodes cmlt Vd.8B, Vn.8B, #0.
+func (a *Assembler) CMLT_V8BV8B_0(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 1, 2782, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_V8HV8H_0 encodes cmlt Vd.8H, Vn.8H, #0.
+func (a *Assembler) CMLT_V8HV8H_0(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 2, 2790, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_V4HV4H_0 encodes cmlt Vd.4H, Vn.4H, #0.
+func (a *Assembler) CMLT_V4HV4H_0(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 2, 2790, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_V4SV4S_0 encodes cmlt Vd.4S, Vn.4S, #0.
+func (a *Assembler) CMLT_V4SV4S_0(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 3, 2798, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_V2SV2S_0 encodes cmlt Vd.2S, Vn.2S, #0.
+func (a *Assembler) CMLT_V2SV2S_0(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 3, 2798, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMLT_V2DV2D_0 encodes cmlt Vd.2D, Vn.2D, #0.
+func (a *Assembler) CMLT_V2DV2D_0(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMLT, ErrNoMatch)
+	}
+	return a.emit(CMLT, 4, 2806, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CMN_WW encodes cmn Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) CMN_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 0, 2814, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMN_WW_Mod encodes cmn Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) CMN_WW_Mod(rd, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 0, 2814, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMN_XX encodes cmn Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) CMN_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 1, 2825, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMN_XX_Mod encodes cmn Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) CMN_XX_Mod(rd, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 1, 2825, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMN_WspW encodes cmn Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) CMN_WspW(rd WSPReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 2, 2836, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMN_WspW_Mod encodes cmn Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) CMN_WspW_Mod(rd WSPReg, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymExtends], mod.ID) {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 2, 2836, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMN_XspW_Mod encodes cmn Xd|SP, Wn, UXT[BHW]|SXT[BHW] #imm (0 <= imm <= 4).
+func (a *Assembler) CMN_XspW_Mod(rd XSPReg, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymExtendsW], mod.ID) {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 3, 2848, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMN_XspX encodes cmn Xd|SP, Xn {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) CMN_XspX(rd XSPReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 4, 2860, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMN_XspX_Mod encodes cmn Xd|SP, Xn {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) CMN_XspX_Mod(rd XSPReg, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymExtendsX], mod.ID) {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 4, 2860, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMN_Wsp_Imm encodes cmn Wd|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMN_Wsp_Imm(rd WSPReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 5, 2872, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// CMN_Wsp_Imm_LSL encodes cmn Wd|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMN_Wsp_Imm_LSL(rd WSPReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 5, 2872, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CMN_Xsp_Imm encodes cmn Xd|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMN_Xsp_Imm(rd XSPReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 6, 2884, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// CMN_Xsp_Imm_LSL encodes cmn Xd|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMN_Xsp_Imm_LSL(rd XSPReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CMN, ErrNoMatch)
+	}
+	return a.emit(CMN, 6, 2884, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CMP_WW encodes cmp Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) CMP_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 0, 2896, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMP_WW_Mod encodes cmp Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) CMP_WW_Mod(rd, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 0, 2896, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMP_XX encodes cmp Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) CMP_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 1, 2907, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMP_XX_Mod encodes cmp Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) CMP_XX_Mod(rd, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 1, 2907, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMP_WspW encodes cmp Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) CMP_WspW(rd WSPReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 2, 2918, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMP_WspW_Mod encodes cmp Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) CMP_WspW_Mod(rd WSPReg, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymExtends], mod.ID) {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 2, 2918, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMP_XspW_Mod encodes cmp Xd|SP, Wn, UXT[BHW]|SXT[BHW] #imm (0 <= imm <= 4).
+func (a *Assembler) CMP_XspW_Mod(rd XSPReg, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymExtendsW], mod.ID) {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 3, 2930, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMP_XspX encodes cmp Xd|SP, Xn {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) CMP_XspX(rd XSPReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 4, 2942, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// CMP_XspX_Mod encodes cmp Xd|SP, Xn {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) CMP_XspX_Mod(rd XSPReg, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymExtendsX], mod.ID) {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 4, 2942, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// CMP_Wsp_Imm encodes cmp Wd|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMP_Wsp_Imm(rd WSPReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 5, 2954, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// CMP_Wsp_Imm_LSL encodes cmp Wd|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMP_Wsp_Imm_LSL(rd WSPReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 5, 2954, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CMP_Xsp_Imm encodes cmp Xd|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMP_Xsp_Imm(rd XSPReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 6, 2966, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// CMP_Xsp_Imm_LSL encodes cmp Xd|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) CMP_Xsp_Imm_LSL(rd XSPReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CMP, ErrNoMatch)
+	}
+	return a.emit(CMP, 6, 2966, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CMTST_DDD encodes cmtst Dd, Dn, Dm.
+func (a *Assembler) CMTST_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 0, 3698, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMTST_V16BV16BV16B encodes cmtst Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) CMTST_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 1, 3706, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMTST_V8BV8BV8B encodes cmtst Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) CMTST_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 1, 3706, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMTST_V8HV8HV8H encodes cmtst Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) CMTST_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 2, 3715, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMTST_V4HV4HV4H encodes cmtst Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) CMTST_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 2, 3715, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMTST_V4SV4SV4S encodes cmtst Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) CMTST_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 3, 3724, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMTST_V2SV2SV2S encodes cmtst Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) CMTST_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 3, 3724, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CMTST_V2DV2DV2D encodes cmtst Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) CMTST_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CMTST, ErrNoMatch)
+	}
+	return a.emit(CMTST, 4, 3733, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CNEG_WW_Cond encodes cneg Wd, Wn, <cond>.
+func (a *Assembler) CNEG_WW_Cond(rd, rn WReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CNEG, ErrNoMatch)
+	}
+	return a.emit(CNEG, 0, 3742, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CNEG_XX_Cond encodes cneg Xd, Xn, <cond>.
+func (a *Assembler) CNEG_XX_Cond(rd, rn XReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CNEG, ErrNoMatch)
+	}
+	return a.emit(CNEG, 1, 3753, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CNT_V16BV16B encodes cnt Vd.16B, Vn.16B.
+func (a *Assembler) CNT_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CNT, ErrNoMatch)
+	}
+	return a.emit(CNT, 0, 3764, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CNT_V8BV8B encodes cnt Vd.8B, Vn.8B.
+func (a *Assembler) CNT_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CNT, ErrNoMatch)
+	}
+	return a.emit(CNT, 0, 3764, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CNT_WW encodes cnt Wd, Wn.
+// Requires FEAT_CSSC.
+func (a *Assembler) CNT_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CNT, ErrNoMatch)
+	}
+	return a.emit(CNT, 1, 3772, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CNT_XX encodes cnt Xd, Xn.
+// Requires FEAT_CSSC.
+func (a *Assembler) CNT_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CNT, ErrNoMatch)
+	}
+	return a.emit(CNT, 2, 3779, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CNTB_X encodes cntb Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) CNTB_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTB, ErrNoMatch)
+	}
+	return a.emit(CNTB, 0, 3822, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// CNTB_X_Sym encodes cntb Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTB_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTB, ErrNoMatch)
+	}
+	return a.emit(CNTB, 1, 3828, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// CNTB_X_Sym_MUL encodes cntb Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTB_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTB, ErrNoMatch)
+	}
+	return a.emit(CNTB, 1, 3828, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CNTD_X encodes cntd Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) CNTD_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTD, ErrNoMatch)
+	}
+	return a.emit(CNTD, 0, 3841, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// CNTD_X_Sym encodes cntd Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTD_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTD, ErrNoMatch)
+	}
+	return a.emit(CNTD, 1, 3847, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// CNTD_X_Sym_MUL encodes cntd Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTD_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTD, ErrNoMatch)
+	}
+	return a.emit(CNTD, 1, 3847, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CNTH_X encodes cnth Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) CNTH_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTH, ErrNoMatch)
+	}
+	return a.emit(CNTH, 0, 3860, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// CNTH_X_Sym encodes cnth Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTH_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTH, ErrNoMatch)
+	}
+	return a.emit(CNTH, 1, 3866, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// CNTH_X_Sym_MUL encodes cnth Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTH_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTH, ErrNoMatch)
+	}
+	return a.emit(CNTH, 1, 3866, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CNTW_X encodes cntw Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) CNTW_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTW, ErrNoMatch)
+	}
+	return a.emit(CNTW, 0, 3879, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// CNTW_X_Sym encodes cntw Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTW_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTW, ErrNoMatch)
+	}
+	return a.emit(CNTW, 1, 3885, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// CNTW_X_Sym_MUL encodes cntw Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) CNTW_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(CNTW, ErrNoMatch)
+	}
+	return a.emit(CNTW, 1, 3885, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// CPP_RCTX_X encodes cpp RCTX, Xn.
+// Requires FEAT_SPECRES.
+func (a *Assembler) CPP_RCTX_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(CPP, ErrNoMatch)
+	}
+	return a.emit(CPP, 0, 3916, FeatSPECRES, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// CRC32B_WWW encodes crc32b Wd, Wn, Wm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32B_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32B, ErrNoMatch)
+	}
+	return a.emit(CRC32B, 0, 3982, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CRC32CB_WWW encodes crc32cb Wd, Wn, Wm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32CB_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32CB, ErrNoMatch)
+	}
+	return a.emit(CRC32CB, 0, 3990, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CRC32CH_WWW encodes crc32ch Wd, Wn, Wm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32CH_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32CH, ErrNoMatch)
+	}
+	return a.emit(CRC32CH, 0, 3998, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CRC32CW_WWW encodes crc32cw Wd, Wn, Wm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32CW_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32CW, ErrNoMatch)
+	}
+	return a.emit(CRC32CW, 0, 4006, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CRC32CX_WWX encodes crc32cx Wd, Wn, Xm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32CX_WWX(rd, rn WReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32CX, ErrNoMatch)
+	}
+	return a.emit(CRC32CX, 0, 4014, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CRC32H_WWW encodes crc32h Wd, Wn, Wm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32H_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32H, ErrNoMatch)
+	}
+	return a.emit(CRC32H, 0, 4022, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CRC32W_WWW encodes crc32w Wd, Wn, Wm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32W_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32W, ErrNoMatch)
+	}
+	return a.emit(CRC32W, 0, 4030, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CRC32X_WWX encodes crc32x Wd, Wn, Xm.
+// Requires FEAT_CRC32.
+func (a *Assembler) CRC32X_WWX(rd, rn WReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CRC32X, ErrNoMatch)
+	}
+	return a.emit(CRC32X, 0, 4038, FeatCRC32, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// CSDB encodes csdb.
+func (a *Assembler) CSDB() bool {
+	return a.emit(CSDB, 0, 4046, 0, 0, 0)
+}
+
+// CSEL_WWW_Cond encodes csel Wd, Wn, Wm, <cond>.
+func (a *Assembler) CSEL_WWW_Cond(rd, rn, rm WReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSEL, ErrNoMatch)
+	}
+	return a.emit(CSEL, 0, 4051, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSEL_XXX_Cond encodes csel Xd, Xn, Xm, <cond>.
+func (a *Assembler) CSEL_XXX_Cond(rd, rn, rm XReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSEL, ErrNoMatch)
+	}
+	return a.emit(CSEL, 1, 4061, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSET_W_Cond encodes cset Wd, <cond>.
+func (a *Assembler) CSET_W_Cond(rd WReg, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CSET, ErrNoMatch)
+	}
+	return a.emit(CSET, 0, 4071, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSET_X_Cond encodes cset Xd, <cond>.
+func (a *Assembler) CSET_X_Cond(rd XReg, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CSET, ErrNoMatch)
+	}
+	return a.emit(CSET, 1, 4079, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSETM_W_Cond encodes csetm Wd, <cond>.
+func (a *Assembler) CSETM_W_Cond(rd WReg, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CSETM, ErrNoMatch)
+	}
+	return a.emit(CSETM, 0, 4087, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSETM_X_Cond encodes csetm Xd, <cond>.
+func (a *Assembler) CSETM_X_Cond(rd XReg, cond Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(CSETM, ErrNoMatch)
+	}
+	return a.emit(CSETM, 1, 4095, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSINC_WWW_Cond encodes csinc Wd, Wn, Wm, <cond>.
+func (a *Assembler) CSINC_WWW_Cond(rd, rn, rm WReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSINC, ErrNoMatch)
+	}
+	return a.emit(CSINC, 0, 4103, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSINC_XXX_Cond encodes csinc Xd, Xn, Xm, <cond>.
+func (a *Assembler) CSINC_XXX_Cond(rd, rn, rm XReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSINC, ErrNoMatch)
+	}
+	return a.emit(CSINC, 1, 4113, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSINV_WWW_Cond encodes csinv Wd, Wn, Wm, <cond>.
+func (a *Assembler) CSINV_WWW_Cond(rd, rn, rm WReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSINV, ErrNoMatch)
+	}
+	return a.emit(CSINV, 0, 4123, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSINV_XXX_Cond encodes csinv Xd, Xn, Xm, <cond>.
+func (a *Assembler) CSINV_XXX_Cond(rd, rn, rm XReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSINV, ErrNoMatch)
+	}
+	return a.emit(CSINV, 1, 4133, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSNEG_WWW_Cond encodes csneg Wd, Wn, Wm, <cond>.
+func (a *Assembler) CSNEG_WWW_Cond(rd, rn, rm WReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSNEG, ErrNoMatch)
+	}
+	return a.emit(CSNEG, 0, 4143, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CSNEG_XXX_Cond encodes csneg Xd, Xn, Xm, <cond>.
+func (a *Assembler) CSNEG_XXX_Cond(rd, rn, rm XReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(CSNEG, ErrNoMatch)
+	}
+	return a.emit(CSNEG, 1, 4153, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// CTZ_WW encodes ctz Wd, Wn.
+// Requires FEAT_CSSC.
+func (a *Assembler) CTZ_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CTZ, ErrNoMatch)
+	}
+	return a.emit(CTZ, 0, 4163, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// CTZ_XX encodes ctz Xd, Xn.
+// Requires FEAT_CSSC.
+func (a *Assembler) CTZ_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(CTZ, ErrNoMatch)
+	}
+	return a.emit(CTZ, 1, 4170, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DC_Sym_X encodes dc <symbol>, Xn.
+func (a *Assembler) DC_Sym_X(sym Symbol, rn XReg) bool {
+	if rn >= 32 {
+		return a.emitErr(DC, ErrNoMatch)
+	}
+	return a.emit(DC, 0, 4177, 0, 0, 0, Flat{FlatImm, uint64(sym)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DCPS1 encodes dcps1  {, #imm } (0 <= imm < 65536).
+func (a *Assembler) DCPS1() bool {
+	return a.emit(DCPS1, 0, 4186, 0, 0, 0, Flat{})
+}
+
+// DCPS1_Imm encodes dcps1  {, #imm } (0 <= imm < 65536).
+func (a *Assembler) DCPS1_Imm(imm int64) bool {
+	return a.emit(DCPS1, 0, 4186, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// DCPS2 encodes dcps2  {, #imm } (0 <= imm < 65536).
+func (a *Assembler) DCPS2() bool {
+	return a.emit(DCPS2, 0, 4194, 0, 0, 0, Flat{})
+}
+
+// DCPS2_Imm encodes dcps2  {, #imm } (0 <= imm < 65536).
+func (a *Assembler) DCPS2_Imm(imm int64) bool {
+	return a.emit(DCPS2, 0, 4194, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// DCPS3 encodes dcps3  {, #imm } (0 <= imm < 65536).
+func (a *Assembler) DCPS3() bool {
+	return a.emit(DCPS3, 0, 4202, 0, 0, 0, Flat{})
+}
+
+// DCPS3_Imm encodes dcps3  {, #imm } (0 <= imm < 65536).
+func (a *Assembler) DCPS3_Imm(imm int64) bool {
+	return a.emit(DCPS3, 0, 4202, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// DECB_X encodes decb Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) DECB_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(DECB, ErrNoMatch)
+	}
+	return a.emit(DECB, 0, 4210, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// DECB_X_Sym encodes decb Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECB_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(DECB, ErrNoMatch)
+	}
+	return a.emit(DECB, 1, 4216, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// DECB_X_Sym_MUL encodes decb Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECB_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(DECB, ErrNoMatch)
+	}
+	return a.emit(DECB, 1, 4216, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// DECD_X encodes decd Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) DECD_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(DECD, ErrNoMatch)
+	}
+	return a.emit(DECD, 0, 4229, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// DECD_X_Sym encodes decd Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECD_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(DECD, ErrNoMatch)
+	}
+	return a.emit(DECD, 1, 4235, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// DECD_X_Sym_MUL encodes decd Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECD_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(DECD, ErrNoMatch)
+	}
+	return a.emit(DECD, 1, 4235, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// DECH_X encodes dech Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) DECH_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(DECH, ErrNoMatch)
+	}
+	return a.emit(DECH, 0, 4248, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// DECH_X_Sym encodes dech Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECH_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(DECH, ErrNoMatch)
+	}
+	return a.emit(DECH, 1, 4254, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// DECH_X_Sym_MUL encodes dech Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECH_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(DECH, ErrNoMatch)
+	}
+	return a.emit(DECH, 1, 4254, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// DECW_X encodes decw Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) DECW_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(DECW, ErrNoMatch)
+	}
+	return a.emit(DECW, 0, 4267, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// DECW_X_Sym encodes decw Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECW_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(DECW, ErrNoMatch)
+	}
+	return a.emit(DECW, 1, 4273, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// DECW_X_Sym_MUL encodes decw Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) DECW_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(DECW, ErrNoMatch)
+	}
+	return a.emit(DECW, 1, 4273, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// DGH encodes dgh.
+func (a *Assembler) DGH() bool {
+	return a.emit(DGH, 0, 4286, 0, 0, 0)
+}
+
+// DMB_Sym encodes dmb <symbol>.
+func (a *Assembler) DMB_Sym(sym Symbol) bool {
+	return a.emit(DMB, 0, 4291, 0, 0, 0, Flat{FlatImm, uint64(sym)})
+}
+
+// DMB_Imm encodes dmb #imm (0 <= imm < 16).
+func (a *Assembler) DMB_Imm(imm int64) bool {
+	return a.emit(DMB, 1, 4299, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// DRPS encodes drps.
+func (a *Assembler) DRPS() bool {
+	return a.emit(DRPS, 0, 4307, 0, 0, 0)
+}
+
+// DSB_Sym encodes dsb <symbol>.
+func (a *Assembler) DSB_Sym(sym Symbol) bool {
+	return a.emit(DSB, 0, 4312, 0, 0, 0, Flat{FlatImm, uint64(sym)})
+}
+
+// DSB_Imm encodes dsb #imm (0 <= imm < 16).
+func (a *Assembler) DSB_Imm(imm int64) bool {
+	return a.emit(DSB, 1, 4320, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// DUP_BVBi encodes dup Bd, Vn.B[i].
+func (a *Assembler) DUP_BVBi(rd BReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 0, 4328, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_HVHi encodes dup Hd, Vn.H[i].
+func (a *Assembler) DUP_HVHi(rd HReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 1, 4338, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_SVSi encodes dup Sd, Vn.S[i].
+func (a *Assembler) DUP_SVSi(rd SReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 2, 4348, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_DVDi encodes dup Dd, Vn.D[i].
+func (a *Assembler) DUP_DVDi(rd DReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 3, 4358, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V16BVBi encodes dup Vd.16B, Vn.B[i].
+func (a *Assembler) DUP_V16BVBi(rd V16BReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 4, 4368, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V8BVBi encodes dup Vd.8B, Vn.B[i].
+func (a *Assembler) DUP_V8BVBi(rd V8BReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 4, 4368, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V8HVHi encodes dup Vd.8H, Vn.H[i].
+func (a *Assembler) DUP_V8HVHi(rd V8HReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 5, 4379, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V4HVHi encodes dup Vd.4H, Vn.H[i].
+func (a *Assembler) DUP_V4HVHi(rd V4HReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 5, 4379, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V4SVSi encodes dup Vd.4S, Vn.S[i].
+func (a *Assembler) DUP_V4SVSi(rd V4SReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 6, 4390, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V2SVSi encodes dup Vd.2S, Vn.S[i].
+func (a *Assembler) DUP_V2SVSi(rd V2SReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 6, 4390, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V2DVDi encodes dup Vd.2D, Vn.D[i].
+func (a *Assembler) DUP_V2DVDi(rd V2DReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 7, 4401, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// DUP_V16BW encodes dup Vd.16B, Wn.
+func (a *Assembler) DUP_V16BW(rd V16BReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 8, 4412, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DUP_V8BW encodes dup Vd.8B, Wn.
+func (a *Assembler) DUP_V8BW(rd V8BReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 8, 4412, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DUP_V8HW encodes dup Vd.8H, Wn.
+func (a *Assembler) DUP_V8HW(rd V8HReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 9, 4420, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DUP_V4HW encodes dup Vd.4H, Wn.
+func (a *Assembler) DUP_V4HW(rd V4HReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 9, 4420, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DUP_V4SW encodes dup Vd.4S, Wn.
+func (a *Assembler) DUP_V4SW(rd V4SReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 10, 4428, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DUP_V2SW encodes dup Vd.2S, Wn.
+func (a *Assembler) DUP_V2SW(rd V2SReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 10, 4428, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DUP_V2DX encodes dup Vd.2D, Xn.
+func (a *Assembler) DUP_V2DX(rd V2DReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(DUP, ErrNoMatch)
+	}
+	return a.emit(DUP, 11, 4436, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// DVP_RCTX_X encodes dvp RCTX, Xn.
+// Requires FEAT_SPECRES.
+func (a *Assembler) DVP_RCTX_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(DVP, ErrNoMatch)
+	}
+	return a.emit(DVP, 0, 4517, FeatSPECRES, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// EON_WWW encodes eon Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) EON_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EON, ErrNoMatch)
+	}
+	return a.emit(EON, 0, 4523, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// EON_WWW_Mod encodes eon Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) EON_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(EON, ErrNoMatch)
+	}
+	return a.emit(EON, 0, 4523, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// EON_XXX encodes eon Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) EON_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EON, ErrNoMatch)
+	}
+	return a.emit(EON, 1, 4535, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// EON_XXX_Mod encodes eon Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) EON_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(EON, ErrNoMatch)
+	}
+	return a.emit(EON, 1, 4535, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// EOR_V16BV16BV16B encodes eor Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) EOR_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 0, 4547, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// EOR_V8BV8BV8B encodes eor Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) EOR_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 0, 4547, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// EOR_WspW_Imm encodes eor Wd|WSP, Wn, #imm (imm is 32-bit logical).
+func (a *Assembler) EOR_WspW_Imm(rd WSPReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 1, 4556, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// EOR_XspX_Imm encodes eor Xd|SP, Xn, #imm (imm is 64-bit logical).
+func (a *Assembler) EOR_XspX_Imm(rd XSPReg, rn XReg, imm uint64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 2, 4566, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// EOR_WWW encodes eor Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) EOR_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 3, 4576, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// EOR_WWW_Mod encodes eor Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) EOR_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 3, 4576, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// EOR_XXX encodes eor Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) EOR_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 4, 4588, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// EOR_XXX_Mod encodes eor Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) EOR_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(EOR, ErrNoMatch)
+	}
+	return a.emit(EOR, 4, 4588, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// EOR3_V16BV16BV16BV16B encodes eor3 Vd.16B, Vn.16B, Vm.16B, Va.16B.
+// Requires FEAT_SHA3.
+func (a *Assembler) EOR3_V16BV16BV16BV16B(rd, rn, rm, ra V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(EOR3, ErrNoMatch)
+	}
+	return a.emit(EOR3, 0, 4674, FeatSHA3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// ERET encodes eret.
+func (a *Assembler) ERET() bool {
+	return a.emit(ERET, 0, 4729, 0, 0, 0)
+}
+
+// ERETAA encodes eretaa.
+// Requires FEAT_PAuth.
+func (a *Assembler) ERETAA() bool {
+	return a.emit(ERETAA, 0, 4734, FeatPAuth, 0, 0)
+}
+
+// ERETAB encodes eretab.
+// Requires FEAT_PAuth.
+func (a *Assembler) ERETAB() bool {
+	return a.emit(ERETAB, 0, 4739, FeatPAuth, 0, 0)
+}
+
+// ESB encodes esb.
+func (a *Assembler) ESB() bool {
+	return a.emit(ESB, 0, 4744, 0, 0, 0)
+}
+
+// EXT_V8BV8BV8B_Imm encodes ext Vd.8B, Vn.8B, Vm.8B, #imm (0 <= imm < 8).
+func (a *Assembler) EXT_V8BV8BV8B_Imm(rd, rn, rm V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EXT, ErrNoMatch)
+	}
+	return a.emit(EXT, 0, 4749, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// EXT_V16BV16BV16B_Imm encodes ext Vd.16B, Vn.16B, Vm.16B, #imm (0 <= imm < 16).
+func (a *Assembler) EXT_V16BV16BV16B_Imm(rd, rn, rm V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EXT, ErrNoMatch)
+	}
+	return a.emit(EXT, 1, 4760, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// EXTR_WWW_Imm encodes extr Wd, Wn, Wm, #imm (0 <= imm < 32).
+func (a *Assembler) EXTR_WWW_Imm(rd, rn, rm WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EXTR, ErrNoMatch)
+	}
+	return a.emit(EXTR, 0, 4791, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// EXTR_XXX_Imm encodes extr Xd, Xn, Xm, #imm (0 <= imm < 64).
+func (a *Assembler) EXTR_XXX_Imm(rd, rn, rm XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(EXTR, ErrNoMatch)
+	}
+	return a.emit(EXTR, 1, 4802, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FABD_HHH encodes fabd Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FABD_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 0, 4813, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABD_SSS encodes fabd Sd, Sn, Sm.
+func (a *Assembler) FABD_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 1, 4821, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABD_DDD encodes fabd Dd, Dn, Dm.
+func (a *Assembler) FABD_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 2, 4829, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABD_V8HV8HV8H encodes fabd Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FABD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 3, 4837, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABD_V4HV4HV4H encodes fabd Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FABD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 3, 4837, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABD_V4SV4SV4S encodes fabd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FABD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 4, 4846, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABD_V2SV2SV2S encodes fabd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FABD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 4, 4846, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABD_V2DV2DV2D encodes fabd Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FABD_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FABD, ErrNoMatch)
+	}
+	return a.emit(FABD, 5, 4855, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FABS_V8HV8H encodes fabs Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FABS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 0, 4897, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FABS_V4HV4H encodes fabs Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FABS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 0, 4897, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FABS_V4SV4S encodes fabs Vd.4S, Vn.4S.
+func (a *Assembler) FABS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 1, 4905, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FABS_V2SV2S encodes fabs Vd.2S, Vn.2S.
+func (a *Assembler) FABS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 1, 4905, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FABS_V2DV2D encodes fabs Vd.2D, Vn.2D.
+func (a *Assembler) FABS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 2, 4913, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FABS_HH encodes fabs Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FABS_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 3, 4921, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FABS_SS encodes fabs Sd, Sn.
+func (a *Assembler) FABS_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 4, 4928, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FABS_DD encodes fabs Dd, Dn.
+func (a *Assembler) FABS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FABS, ErrNoMatch)
+	}
+	return a.emit(FABS, 5, 4935, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FACGE_HHH encodes facge Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FACGE_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 0, 4969, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGE_SSS encodes facge Sd, Sn, Sm.
+func (a *Assembler) FACGE_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 1, 4977, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGE_DDD encodes facge Dd, Dn, Dm.
+func (a *Assembler) FACGE_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 2, 4985, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGE_V8HV8HV8H encodes facge Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FACGE_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 3, 4993, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGE_V4HV4HV4H encodes facge Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FACGE_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 3, 4993, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGE_V4SV4SV4S encodes facge Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FACGE_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 4, 5002, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGE_V2SV2SV2S encodes facge Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FACGE_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 4, 5002, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGE_V2DV2DV2D encodes facge Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FACGE_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGE, ErrNoMatch)
+	}
+	return a.emit(FACGE, 5, 5011, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_HHH encodes facgt Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FACGT_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 0, 5020, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_SSS encodes facgt Sd, Sn, Sm.
+func (a *Assembler) FACGT_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 1, 5028, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_DDD encodes facgt Dd, Dn, Dm.
+func (a *Assembler) FACGT_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 2, 5036, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_V8HV8HV8H encodes facgt Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FACGT_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 3, 5044, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_V4HV4HV4H encodes facgt Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FACGT_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 3, 5044, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_V4SV4SV4S encodes facgt Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FACGT_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 4, 5053, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_V2SV2SV2S encodes facgt Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FACGT_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 4, 5053, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FACGT_V2DV2DV2D encodes facgt Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FACGT_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FACGT, ErrNoMatch)
+	}
+	return a.emit(FACGT, 5, 5062, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_V8HV8HV8H encodes fadd Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 0, 5071, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_V4HV4HV4H encodes fadd Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 0, 5071, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_V4SV4SV4S encodes fadd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 1, 5080, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_V2SV2SV2S encodes fadd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 1, 5080, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_V2DV2DV2D encodes fadd Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FADD_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 2, 5089, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_HHH encodes fadd Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FADD_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 3, 5098, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_SSS encodes fadd Sd, Sn, Sm.
+func (a *Assembler) FADD_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 4, 5106, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADD_DDD encodes fadd Dd, Dn, Dm.
+func (a *Assembler) FADD_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADD, ErrNoMatch)
+	}
+	return a.emit(FADD, 5, 5114, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADDP_HV2H encodes faddp Hd, Vn.2H.
+// Requires FEAT_FP16.
+func (a *Assembler) FADDP_HV2H(rd HReg, rn V2HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 0, 5179, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FADDP_SV2S encodes faddp Sd, Vn.2S.
+func (a *Assembler) FADDP_SV2S(rd SReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 1, 5186, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FADDP_DV2D encodes faddp Dd, Vn.2D.
+func (a *Assembler) FADDP_DV2D(rd DReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 2, 5193, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FADDP_V8HV8HV8H encodes faddp Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FADDP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 3, 5200, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADDP_V4HV4HV4H encodes faddp Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FADDP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 3, 5200, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADDP_V4SV4SV4S encodes faddp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FADDP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 4, 5209, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADDP_V2SV2SV2S encodes faddp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FADDP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 4, 5209, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FADDP_V2DV2DV2D encodes faddp Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FADDP_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FADDP, ErrNoMatch)
+	}
+	return a.emit(FADDP, 5, 5218, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCADD_V8HV8HV8H_Imm encodes fcadd Vd.8H, Vn.8H, Vm.8H, #imm (imm in [90, 270]).
+// Requires FEAT_FCMA and FEAT_FP16.
+func (a *Assembler) FCADD_V8HV8HV8H_Imm(rd, rn, rm V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCADD, ErrNoMatch)
+	}
+	return a.emit(FCADD, 0, 5254, FeatFCMA, FeatFP16, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCADD_V4HV4HV4H_Imm encodes fcadd Vd.4H, Vn.4H, Vm.4H, #imm (imm in [90, 270]).
+// Requires FEAT_FCMA and FEAT_FP16.
+func (a *Assembler) FCADD_V4HV4HV4H_Imm(rd, rn, rm V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCADD, ErrNoMatch)
+	}
+	return a.emit(FCADD, 0, 5254, FeatFCMA, FeatFP16, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCADD_V4SV4SV4S_Imm encodes fcadd Vd.4S, Vn.4S, Vm.4S, #imm (imm in [90, 270]).
+// Requires FEAT_FCMA.
+func (a *Assembler) FCADD_V4SV4SV4S_Imm(rd, rn, rm V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCADD, ErrNoMatch)
+	}
+	return a.emit(FCADD, 1, 5266, FeatFCMA, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCADD_V2SV2SV2S_Imm encodes fcadd Vd.2S, Vn.2S, Vm.2S, #imm (imm in [90, 270]).
+// Requires FEAT_FCMA.
+func (a *Assembler) FCADD_V2SV2SV2S_Imm(rd, rn, rm V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCADD, ErrNoMatch)
+	}
+	return a.emit(FCADD, 1, 5266, FeatFCMA, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCADD_V2DV2DV2D_Imm encodes fcadd Vd.2D, Vn.2D, Vm.2D, #imm (imm in [90, 270]).
+// Requires FEAT_FCMA.
+func (a *Assembler) FCADD_V2DV2DV2D_Imm(rd, rn, rm V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCADD, ErrNoMatch)
+	}
+	return a.emit(FCADD, 2, 5278, FeatFCMA, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCCMP_HH_Imm_Cond encodes fccmp Hd, Hn, #imm, <cond> (0 <= imm < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCCMP_HH_Imm_Cond(rd, rn HReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCCMP, ErrNoMatch)
+	}
+	return a.emit(FCCMP, 0, 5290, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCCMP_SS_Imm_Cond encodes fccmp Sd, Sn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) FCCMP_SS_Imm_Cond(rd, rn SReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCCMP, ErrNoMatch)
+	}
+	return a.emit(FCCMP, 1, 5302, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCCMP_DD_Imm_Cond encodes fccmp Dd, Dn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) FCCMP_DD_Imm_Cond(rd, rn DReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCCMP, ErrNoMatch)
+	}
+	return a.emit(FCCMP, 2, 5314, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCCMPE_HH_Imm_Cond encodes fccmpe Hd, Hn, #imm, <cond> (0 <= imm < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCCMPE_HH_Imm_Cond(rd, rn HReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCCMPE, ErrNoMatch)
+	}
+	return a.emit(FCCMPE, 0, 5326, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCCMPE_SS_Imm_Cond encodes fccmpe Sd, Sn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) FCCMPE_SS_Imm_Cond(rd, rn SReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCCMPE, ErrNoMatch)
+	}
+	return a.emit(FCCMPE, 1, 5338, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCCMPE_DD_Imm_Cond encodes fccmpe Dd, Dn, #imm, <cond> (0 <= imm < 16).
+func (a *Assembler) FCCMPE_DD_Imm_Cond(rd, rn DReg, imm int64, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCCMPE, ErrNoMatch)
+	}
+	return a.emit(FCCMPE, 2, 5350, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCMEQ_HHH encodes fcmeq Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMEQ_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 0, 5362, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_SSS encodes fcmeq Sd, Sn, Sm.
+func (a *Assembler) FCMEQ_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 1, 5370, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_DDD encodes fcmeq Dd, Dn, Dm.
+func (a *Assembler) FCMEQ_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 2, 5378, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_V8HV8HV8H encodes fcmeq Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMEQ_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 3, 5386, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_V4HV4HV4H encodes fcmeq Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMEQ_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 3, 5386, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_V4SV4SV4S encodes fcmeq Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FCMEQ_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 4, 5395, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_V2SV2SV2S encodes fcmeq Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FCMEQ_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 4, 5395, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_V2DV2DV2D encodes fcmeq Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FCMEQ_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 5, 5404, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMEQ_HH_Zero encodes fcmeq Hd, Hn, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMEQ_HH_Zero(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 6, 5413, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMEQ_SS_Zero encodes fcmeq Sd, Sn, #0.0.
+func (a *Assembler) FCMEQ_SS_Zero(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 7, 5420, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMEQ_DD_Zero encodes fcmeq Dd, Dn, #0.0.
+func (a *Assembler) FCMEQ_DD_Zero(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 8, 5427, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMEQ_V8HV8H_Zero encodes fcmeq Vd.8H, Vn.8H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMEQ_V8HV8H_Zero(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 9, 5434, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMEQ_V4HV4H_Zero encodes fcmeq Vd.4H, Vn.4H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMEQ_V4HV4H_Zero(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 9, 5434, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMEQ_V4SV4S_Zero encodes fcmeq Vd.4S, Vn.4S, #0.0.
+func (a *Assembler) FCMEQ_V4SV4S_Zero(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 10, 5442, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMEQ_V2SV2S_Zero encodes fcmeq Vd.2S, Vn.2S, #0.0.
+func (a *Assembler) FCMEQ_V2SV2S_Zero(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 10, 5442, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMEQ_V2DV2D_Zero encodes fcmeq Vd.2D, Vn.2D, #0.0.
+func (a *Assembler) FCMEQ_V2DV2D_Zero(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMEQ, ErrNoMatch)
+	}
+	return a.emit(FCMEQ, 11, 5450, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_HHH encodes fcmge Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGE_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 0, 5488, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_SSS encodes fcmge Sd, Sn, Sm.
+func (a *Assembler) FCMGE_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 1, 5496, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_DDD encodes fcmge Dd, Dn, Dm.
+func (a *Assembler) FCMGE_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 2, 5504, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_V8HV8HV8H encodes fcmge Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGE_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 3, 5512, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_V4HV4HV4H encodes fcmge Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGE_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 3, 5512, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_V4SV4SV4S encodes fcmge Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FCMGE_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 4, 5521, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_V2SV2SV2S encodes fcmge Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FCMGE_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 4, 5521, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_V2DV2DV2D encodes fcmge Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FCMGE_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 5, 5530, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGE_HH_Zero encodes fcmge Hd, Hn, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGE_HH_Zero(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 6, 5539, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_SS_Zero encodes fcmge Sd, Sn, #0.0.
+func (a *Assembler) FCMGE_SS_Zero(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 7, 5546, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_DD_Zero encodes fcmge Dd, Dn, #0.0.
+func (a *Assembler) FCMGE_DD_Zero(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 8, 5553, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_V8HV8H_Zero encodes fcmge Vd.8H, Vn.8H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGE_V8HV8H_Zero(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 9, 5560, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_V4HV4H_Zero encodes fcmge Vd.4H, Vn.4H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGE_V4HV4H_Zero(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 9, 5560, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_V4SV4S_Zero encodes fcmge Vd.4S, Vn.4S, #0.0.
+func (a *Assembler) FCMGE_V4SV4S_Zero(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 10, 5568, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_V2SV2S_Zero encodes fcmge Vd.2S, Vn.2S, #0.0.
+func (a *Assembler) FCMGE_V2SV2S_Zero(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 10, 5568, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGE_V2DV2D_Zero encodes fcmge Vd.2D, Vn.2D, #0.0.
+func (a *Assembler) FCMGE_V2DV2D_Zero(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGE, ErrNoMatch)
+	}
+	return a.emit(FCMGE, 11, 5576, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_HHH encodes fcmgt Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGT_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 0, 5614, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_SSS encodes fcmgt Sd, Sn, Sm.
+func (a *Assembler) FCMGT_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 1, 5622, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_DDD encodes fcmgt Dd, Dn, Dm.
+func (a *Assembler) FCMGT_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 2, 5630, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_V8HV8HV8H encodes fcmgt Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGT_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 3, 5638, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_V4HV4HV4H encodes fcmgt Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGT_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 3, 5638, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_V4SV4SV4S encodes fcmgt Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FCMGT_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 4, 5647, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_V2SV2SV2S encodes fcmgt Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FCMGT_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 4, 5647, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_V2DV2DV2D encodes fcmgt Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FCMGT_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 5, 5656, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FCMGT_HH_Zero encodes fcmgt Hd, Hn, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGT_HH_Zero(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 6, 5665, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_SS_Zero encodes fcmgt Sd, Sn, #0.0.
+func (a *Assembler) FCMGT_SS_Zero(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 7, 5672, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_DD_Zero encodes fcmgt Dd, Dn, #0.0.
+func (a *Assembler) FCMGT_DD_Zero(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 8, 5679, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_V8HV8H_Zero encodes fcmgt Vd.8H, Vn.8H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGT_V8HV8H_Zero(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 9, 5686, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_V4HV4H_Zero encodes fcmgt Vd.4H, Vn.4H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMGT_V4HV4H_Zero(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 9, 5686, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_V4SV4S_Zero encodes fcmgt Vd.4S, Vn.4S, #0.0.
+func (a *Assembler) FCMGT_V4SV4S_Zero(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 10, 5694, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_V2SV2S_Zero encodes fcmgt Vd.2S, Vn.2S, #0.0.
+func (a *Assembler) FCMGT_V2SV2S_Zero(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 10, 5694, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMGT_V2DV2D_Zero encodes fcmgt Vd.2D, Vn.2D, #0.0.
+func (a *Assembler) FCMGT_V2DV2D_Zero(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMGT, ErrNoMatch)
+	}
+	return a.emit(FCMGT, 11, 5702, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLA_V4HV4HVHi_Imm encodes fcmla Vd.4H, Vn.4H, Vm.H[i], #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA and FEAT_FP16.
+func (a *Assembler) FCMLA_V4HV4HVHi_Imm(rd, rn V4HReg, rm VReg, idx uint8, imm int64) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 0, 5740, FeatFCMA, FeatFP16, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLA_V8HV8HVHi_Imm encodes fcmla Vd.8H, Vn.8H, Vm.H[i], #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA and FEAT_FP16.
+func (a *Assembler) FCMLA_V8HV8HVHi_Imm(rd, rn V8HReg, rm VReg, idx uint8, imm int64) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 1, 5752, FeatFCMA, FeatFP16, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLA_V4SV4SVSi_Imm encodes fcmla Vd.4S, Vn.4S, Vm.S[i], #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA.
+func (a *Assembler) FCMLA_V4SV4SVSi_Imm(rd, rn V4SReg, rm VReg, idx uint8, imm int64) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 2, 5765, FeatFCMA, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLA_V8HV8HV8H_Imm encodes fcmla Vd.8H, Vn.8H, Vm.8H, #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA and FEAT_FP16.
+func (a *Assembler) FCMLA_V8HV8HV8H_Imm(rd, rn, rm V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 3, 5779, FeatFCMA, FeatFP16, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLA_V4HV4HV4H_Imm encodes fcmla Vd.4H, Vn.4H, Vm.4H, #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA and FEAT_FP16.
+func (a *Assembler) FCMLA_V4HV4HV4H_Imm(rd, rn, rm V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 3, 5779, FeatFCMA, FeatFP16, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLA_V4SV4SV4S_Imm encodes fcmla Vd.4S, Vn.4S, Vm.4S, #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA.
+func (a *Assembler) FCMLA_V4SV4SV4S_Imm(rd, rn, rm V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 4, 5791, FeatFCMA, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLA_V2SV2SV2S_Imm encodes fcmla Vd.2S, Vn.2S, Vm.2S, #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA.
+func (a *Assembler) FCMLA_V2SV2SV2S_Imm(rd, rn, rm V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 4, 5791, FeatFCMA, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLA_V2DV2DV2D_Imm encodes fcmla Vd.2D, Vn.2D, Vm.2D, #imm (imm in [0, 90, 180, 270]).
+// Requires FEAT_FCMA.
+func (a *Assembler) FCMLA_V2DV2DV2D_Imm(rd, rn, rm V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCMLA, ErrNoMatch)
+	}
+	return a.emit(FCMLA, 5, 5803, FeatFCMA, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCMLE_HH_Zero encodes fcmle Hd, Hn, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMLE_HH_Zero(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 0, 5815, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLE_SS_Zero encodes fcmle Sd, Sn, #0.0.
+func (a *Assembler) FCMLE_SS_Zero(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 1, 5822, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLE_DD_Zero encodes fcmle Dd, Dn, #0.0.
+func (a *Assembler) FCMLE_DD_Zero(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 2, 5829, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLE_V8HV8H_Zero encodes fcmle Vd.8H, Vn.8H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMLE_V8HV8H_Zero(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 3, 5836, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLE_V4HV4H_Zero encodes fcmle Vd.4H, Vn.4H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMLE_V4HV4H_Zero(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 3, 5836, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLE_V4SV4S_Zero encodes fcmle Vd.4S, Vn.4S, #0.0.
+func (a *Assembler) FCMLE_V4SV4S_Zero(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 4, 5844, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLE_V2SV2S_Zero encodes fcmle Vd.2S, Vn.2S, #0.0.
+func (a *Assembler) FCMLE_V2SV2S_Zero(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 4, 5844, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLE_V2DV2D_Zero encodes fcmle Vd.2D, Vn.2D, #0.0.
+func (a *Assembler) FCMLE_V2DV2D_Zero(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLE, ErrNoMatch)
+	}
+	return a.emit(FCMLE, 5, 5852, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_HH_Zero encodes fcmlt Hd, Hn, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMLT_HH_Zero(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 0, 5860, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_SS_Zero encodes fcmlt Sd, Sn, #0.0.
+func (a *Assembler) FCMLT_SS_Zero(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 1, 5867, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_DD_Zero encodes fcmlt Dd, Dn, #0.0.
+func (a *Assembler) FCMLT_DD_Zero(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 2, 5874, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_V8HV8H_Zero encodes fcmlt Vd.8H, Vn.8H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMLT_V8HV8H_Zero(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 3, 5881, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_V4HV4H_Zero encodes fcmlt Vd.4H, Vn.4H, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMLT_V4HV4H_Zero(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 3, 5881, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_V4SV4S_Zero encodes fcmlt Vd.4S, Vn.4S, #0.0.
+func (a *Assembler) FCMLT_V4SV4S_Zero(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 4, 5889, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_V2SV2S_Zero encodes fcmlt Vd.2S, Vn.2S, #0.0.
+func (a *Assembler) FCMLT_V2SV2S_Zero(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 4, 5889, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMLT_V2DV2D_Zero encodes fcmlt Vd.2D, Vn.2D, #0.0.
+func (a *Assembler) FCMLT_V2DV2D_Zero(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMLT, ErrNoMatch)
+	}
+	return a.emit(FCMLT, 5, 5897, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMP_HH encodes fcmp Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMP_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMP, ErrNoMatch)
+	}
+	return a.emit(FCMP, 0, 5935, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMP_H_Zero encodes fcmp Hd, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMP_H_Zero(rd HReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FCMP, ErrNoMatch)
+	}
+	return a.emit(FCMP, 1, 5942, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FCMP_SS encodes fcmp Sd, Sn.
+func (a *Assembler) FCMP_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMP, ErrNoMatch)
+	}
+	return a.emit(FCMP, 2, 5948, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMP_S_Zero encodes fcmp Sd, #0.0.
+func (a *Assembler) FCMP_S_Zero(rd SReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FCMP, ErrNoMatch)
+	}
+	return a.emit(FCMP, 3, 5955, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FCMP_DD encodes fcmp Dd, Dn.
+func (a *Assembler) FCMP_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMP, ErrNoMatch)
+	}
+	return a.emit(FCMP, 4, 5961, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMP_D_Zero encodes fcmp Dd, #0.0.
+func (a *Assembler) FCMP_D_Zero(rd DReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FCMP, ErrNoMatch)
+	}
+	return a.emit(FCMP, 5, 5968, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FCMPE_HH encodes fcmpe Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMPE_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMPE, ErrNoMatch)
+	}
+	return a.emit(FCMPE, 0, 5974, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMPE_H_Zero encodes fcmpe Hd, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FCMPE_H_Zero(rd HReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FCMPE, ErrNoMatch)
+	}
+	return a.emit(FCMPE, 1, 5981, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FCMPE_SS encodes fcmpe Sd, Sn.
+func (a *Assembler) FCMPE_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMPE, ErrNoMatch)
+	}
+	return a.emit(FCMPE, 2, 5987, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMPE_S_Zero encodes fcmpe Sd, #0.0.
+func (a *Assembler) FCMPE_S_Zero(rd SReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FCMPE, ErrNoMatch)
+	}
+	return a.emit(FCMPE, 3, 5994, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FCMPE_DD encodes fcmpe Dd, Dn.
+func (a *Assembler) FCMPE_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCMPE, ErrNoMatch)
+	}
+	return a.emit(FCMPE, 4, 6000, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCMPE_D_Zero encodes fcmpe Dd, #0.0.
+func (a *Assembler) FCMPE_D_Zero(rd DReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FCMPE, ErrNoMatch)
+	}
+	return a.emit(FCMPE, 5, 6007, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FCSEL_HHH_Cond encodes fcsel Hd, Hn, Hm, <cond>.
+// Requires FEAT_FP16.
+func (a *Assembler) FCSEL_HHH_Cond(rd, rn, rm HReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCSEL, ErrNoMatch)
+	}
+	return a.emit(FCSEL, 0, 6013, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCSEL_SSS_Cond encodes fcsel Sd, Sn, Sm, <cond>.
+func (a *Assembler) FCSEL_SSS_Cond(rd, rn, rm SReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCSEL, ErrNoMatch)
+	}
+	return a.emit(FCSEL, 1, 6023, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCSEL_DDD_Cond encodes fcsel Dd, Dn, Dm, <cond>.
+func (a *Assembler) FCSEL_DDD_Cond(rd, rn, rm DReg, cond Symbol) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FCSEL, ErrNoMatch)
+	}
+	return a.emit(FCSEL, 2, 6033, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(cond)})
+}
+
+// FCVT_SH encodes fcvt Sd, Hn.
+func (a *Assembler) FCVT_SH(rd SReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVT, ErrNoMatch)
+	}
+	return a.emit(FCVT, 0, 6043, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVT_DH encodes fcvt Dd, Hn.
+func (a *Assembler) FCVT_DH(rd DReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVT, ErrNoMatch)
+	}
+	return a.emit(FCVT, 1, 6050, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVT_HS encodes fcvt Hd, Sn.
+func (a *Assembler) FCVT_HS(rd HReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVT, ErrNoMatch)
+	}
+	return a.emit(FCVT, 2, 6057, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVT_DS encodes fcvt Dd, Sn.
+func (a *Assembler) FCVT_DS(rd DReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVT, ErrNoMatch)
+	}
+	return a.emit(FCVT, 3, 6064, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVT_HD encodes fcvt Hd, Dn.
+func (a *Assembler) FCVT_HD(rd HReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVT, ErrNoMatch)
+	}
+	return a.emit(FCVT, 4, 6071, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVT_SD encodes fcvt Sd, Dn.
+func (a *Assembler) FCVT_SD(rd SReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVT, ErrNoMatch)
+	}
+	return a.emit(FCVT, 5, 6078, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_HH encodes fcvtas Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAS_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 0, 6085, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_SS encodes fcvtas Sd, Sn.
+func (a *Assembler) FCVTAS_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 1, 6092, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_DD encodes fcvtas Dd, Dn.
+func (a *Assembler) FCVTAS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 2, 6099, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_V8HV8H encodes fcvtas Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 3, 6106, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_V4HV4H encodes fcvtas Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 3, 6106, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_V4SV4S encodes fcvtas Vd.4S, Vn.4S.
+func (a *Assembler) FCVTAS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 4, 6114, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_V2SV2S encodes fcvtas Vd.2S, Vn.2S.
+func (a *Assembler) FCVTAS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 4, 6114, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_V2DV2D encodes fcvtas Vd.2D, Vn.2D.
+func (a *Assembler) FCVTAS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 5, 6122, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_WH encodes fcvtas Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAS_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 6, 6130, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_XH encodes fcvtas Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAS_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 7, 6137, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_WS encodes fcvtas Wd, Sn.
+func (a *Assembler) FCVTAS_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 8, 6144, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_XS encodes fcvtas Xd, Sn.
+func (a *Assembler) FCVTAS_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 9, 6151, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_WD encodes fcvtas Wd, Dn.
+func (a *Assembler) FCVTAS_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 10, 6158, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAS_XD encodes fcvtas Xd, Dn.
+func (a *Assembler) FCVTAS_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAS, ErrNoMatch)
+	}
+	return a.emit(FCVTAS, 11, 6165, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_HH encodes fcvtau Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAU_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 0, 6172, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_SS encodes fcvtau Sd, Sn.
+func (a *Assembler) FCVTAU_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 1, 6179, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_DD encodes fcvtau Dd, Dn.
+func (a *Assembler) FCVTAU_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 2, 6186, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_V8HV8H encodes fcvtau Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAU_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 3, 6193, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_V4HV4H encodes fcvtau Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAU_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 3, 6193, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_V4SV4S encodes fcvtau Vd.4S, Vn.4S.
+func (a *Assembler) FCVTAU_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 4, 6201, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_V2SV2S encodes fcvtau Vd.2S, Vn.2S.
+func (a *Assembler) FCVTAU_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 4, 6201, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_V2DV2D encodes fcvtau Vd.2D, Vn.2D.
+func (a *Assembler) FCVTAU_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 5, 6209, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_WH encodes fcvtau Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAU_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 6, 6217, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_XH encodes fcvtau Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTAU_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 7, 6224, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_WS encodes fcvtau Wd, Sn.
+func (a *Assembler) FCVTAU_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 8, 6231, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_XS encodes fcvtau Xd, Sn.
+func (a *Assembler) FCVTAU_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 9, 6238, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_WD encodes fcvtau Wd, Dn.
+func (a *Assembler) FCVTAU_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 10, 6245, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTAU_XD encodes fcvtau Xd, Dn.
+func (a *Assembler) FCVTAU_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTAU, ErrNoMatch)
+	}
+	return a.emit(FCVTAU, 11, 6252, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTL_V4SV4H encodes fcvtl Vd.4S, Vn.4H.
+func (a *Assembler) FCVTL_V4SV4H(rd V4SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTL, ErrNoMatch)
+	}
+	return a.emit(FCVTL, 0, 6259, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTL_V2DV2S encodes fcvtl Vd.2D, Vn.2S.
+func (a *Assembler) FCVTL_V2DV2S(rd V2DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTL, ErrNoMatch)
+	}
+	return a.emit(FCVTL, 1, 6266, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTL2_V4SV8H encodes fcvtl2 Vd.4S, Vn.8H.
+func (a *Assembler) FCVTL2_V4SV8H(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTL2, ErrNoMatch)
+	}
+	return a.emit(FCVTL2, 0, 6273, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTL2_V2DV4S encodes fcvtl2 Vd.2D, Vn.4S.
+func (a *Assembler) FCVTL2_V2DV4S(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTL2, ErrNoMatch)
+	}
+	return a.emit(FCVTL2, 1, 6280, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_HH encodes fcvtms Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMS_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 0, 6287, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_SS encodes fcvtms Sd, Sn.
+func (a *Assembler) FCVTMS_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 1, 6294, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_DD encodes fcvtms Dd, Dn.
+func (a *Assembler) FCVTMS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 2, 6301, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_V8HV8H encodes fcvtms Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 3, 6308, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_V4HV4H encodes fcvtms Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 3, 6308, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_V4SV4S encodes fcvtms Vd.4S, Vn.4S.
+func (a *Assembler) FCVTMS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 4, 6316, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_V2SV2S encodes fcvtms Vd.2S, Vn.2S.
+func (a *Assembler) FCVTMS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 4, 6316, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_V2DV2D encodes fcvtms Vd.2D, Vn.2D.
+func (a *Assembler) FCVTMS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 5, 6324, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_WH encodes fcvtms Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMS_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 6, 6332, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_XH encodes fcvtms Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMS_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 7, 6339, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_WS encodes fcvtms Wd, Sn.
+func (a *Assembler) FCVTMS_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 8, 6346, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_XS encodes fcvtms Xd, Sn.
+func (a *Assembler) FCVTMS_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 9, 6353, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_WD encodes fcvtms Wd, Dn.
+func (a *Assembler) FCVTMS_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 10, 6360, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMS_XD encodes fcvtms Xd, Dn.
+func (a *Assembler) FCVTMS_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMS, ErrNoMatch)
+	}
+	return a.emit(FCVTMS, 11, 6367, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_HH encodes fcvtmu Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMU_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 0, 6374, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_SS encodes fcvtmu Sd, Sn.
+func (a *Assembler) FCVTMU_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 1, 6381, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_DD encodes fcvtmu Dd, Dn.
+func (a *Assembler) FCVTMU_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 2, 6388, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_V8HV8H encodes fcvtmu Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMU_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 3, 6395, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_V4HV4H encodes fcvtmu Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMU_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 3, 6395, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_V4SV4S encodes fcvtmu Vd.4S, Vn.4S.
+func (a *Assembler) FCVTMU_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 4, 6403, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_V2SV2S encodes fcvtmu Vd.2S, Vn.2S.
+func (a *Assembler) FCVTMU_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 4, 6403, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_V2DV2D encodes fcvtmu Vd.2D, Vn.2D.
+func (a *Assembler) FCVTMU_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 5, 6411, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_WH encodes fcvtmu Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMU_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 6, 6419, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_XH encodes fcvtmu Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTMU_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 7, 6426, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_WS encodes fcvtmu Wd, Sn.
+func (a *Assembler) FCVTMU_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 8, 6433, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_XS encodes fcvtmu Xd, Sn.
+func (a *Assembler) FCVTMU_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 9, 6440, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_WD encodes fcvtmu Wd, Dn.
+func (a *Assembler) FCVTMU_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 10, 6447, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTMU_XD encodes fcvtmu Xd, Dn.
+func (a *Assembler) FCVTMU_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTMU, ErrNoMatch)
+	}
+	return a.emit(FCVTMU, 11, 6454, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTN_V2SV2D encodes fcvtn Vd.2S, Vn.2D.
+func (a *Assembler) FCVTN_V2SV2D(rd V2SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTN, ErrNoMatch)
+	}
+	return a.emit(FCVTN, 0, 6461, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTN2_V4SV2D encodes fcvtn2 Vd.4S, Vn.2D.
+func (a *Assembler) FCVTN2_V4SV2D(rd V4SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTN2, ErrNoMatch)
+	}
+	return a.emit(FCVTN2, 0, 6468, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_HH encodes fcvtns Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNS_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 0, 6475, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_SS encodes fcvtns Sd, Sn.
+func (a *Assembler) FCVTNS_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 1, 6482, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_DD encodes fcvtns Dd, Dn.
+func (a *Assembler) FCVTNS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 2, 6489, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_V8HV8H encodes fcvtns Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 3, 6496, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_V4HV4H encodes fcvtns Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 3, 6496, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_V4SV4S encodes fcvtns Vd.4S, Vn.4S.
+func (a *Assembler) FCVTNS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 4, 6504, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_V2SV2S encodes fcvtns Vd.2S, Vn.2S.
+func (a *Assembler) FCVTNS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 4, 6504, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_V2DV2D encodes fcvtns Vd.2D, Vn.2D.
+func (a *Assembler) FCVTNS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 5, 6512, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_WH encodes fcvtns Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNS_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 6, 6520, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_XH encodes fcvtns Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNS_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 7, 6527, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_WS encodes fcvtns Wd, Sn.
+func (a *Assembler) FCVTNS_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 8, 6534, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_XS encodes fcvtns Xd, Sn.
+func (a *Assembler) FCVTNS_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 9, 6541, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_WD encodes fcvtns Wd, Dn.
+func (a *Assembler) FCVTNS_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 10, 6548, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNS_XD encodes fcvtns Xd, Dn.
+func (a *Assembler) FCVTNS_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNS, ErrNoMatch)
+	}
+	return a.emit(FCVTNS, 11, 6555, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_HH encodes fcvtnu Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNU_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 0, 6562, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_SS encodes fcvtnu Sd, Sn.
+func (a *Assembler) FCVTNU_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 1, 6569, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_DD encodes fcvtnu Dd, Dn.
+func (a *Assembler) FCVTNU_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 2, 6576, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_V8HV8H encodes fcvtnu Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNU_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 3, 6583, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_V4HV4H encodes fcvtnu Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNU_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 3, 6583, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_V4SV4S encodes fcvtnu Vd.4S, Vn.4S.
+func (a *Assembler) FCVTNU_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 4, 6591, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_V2SV2S encodes fcvtnu Vd.2S, Vn.2S.
+func (a *Assembler) FCVTNU_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 4, 6591, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_V2DV2D encodes fcvtnu Vd.2D, Vn.2D.
+func (a *Assembler) FCVTNU_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 5, 6599, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_WH encodes fcvtnu Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNU_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 6, 6607, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_XH encodes fcvtnu Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTNU_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 7, 6614, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_WS encodes fcvtnu Wd, Sn.
+func (a *Assembler) FCVTNU_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 8, 6621, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_XS encodes fcvtnu Xd, Sn.
+func (a *Assembler) FCVTNU_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 9, 6628, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_WD encodes fcvtnu Wd, Dn.
+func (a *Assembler) FCVTNU_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 10, 6635, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTNU_XD encodes fcvtnu Xd, Dn.
+func (a *Assembler) FCVTNU_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTNU, ErrNoMatch)
+	}
+	return a.emit(FCVTNU, 11, 6642, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_HH encodes fcvtps Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPS_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 0, 6649, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_SS encodes fcvtps Sd, Sn.
+func (a *Assembler) FCVTPS_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 1, 6656, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_DD encodes fcvtps Dd, Dn.
+func (a *Assembler) FCVTPS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 2, 6663, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_V8HV8H encodes fcvtps Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 3, 6670, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_V4HV4H encodes fcvtps Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 3, 6670, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_V4SV4S encodes fcvtps Vd.4S, Vn.4S.
+func (a *Assembler) FCVTPS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 4, 6678, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_V2SV2S encodes fcvtps Vd.2S, Vn.2S.
+func (a *Assembler) FCVTPS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 4, 6678, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_V2DV2D encodes fcvtps Vd.2D, Vn.2D.
+func (a *Assembler) FCVTPS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 5, 6686, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_WH encodes fcvtps Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPS_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 6, 6694, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_XH encodes fcvtps Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPS_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 7, 6701, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_WS encodes fcvtps Wd, Sn.
+func (a *Assembler) FCVTPS_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 8, 6708, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_XS encodes fcvtps Xd, Sn.
+func (a *Assembler) FCVTPS_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 9, 6715, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_WD encodes fcvtps Wd, Dn.
+func (a *Assembler) FCVTPS_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 10, 6722, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPS_XD encodes fcvtps Xd, Dn.
+func (a *Assembler) FCVTPS_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPS, ErrNoMatch)
+	}
+	return a.emit(FCVTPS, 11, 6729, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_HH encodes fcvtpu Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPU_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 0, 6736, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_SS encodes fcvtpu Sd, Sn.
+func (a *Assembler) FCVTPU_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 1, 6743, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_DD encodes fcvtpu Dd, Dn.
+func (a *Assembler) FCVTPU_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 2, 6750, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_V8HV8H encodes fcvtpu Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPU_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 3, 6757, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_V4HV4H encodes fcvtpu Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPU_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 3, 6757, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_V4SV4S encodes fcvtpu Vd.4S, Vn.4S.
+func (a *Assembler) FCVTPU_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 4, 6765, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_V2SV2S encodes fcvtpu Vd.2S, Vn.2S.
+func (a *Assembler) FCVTPU_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 4, 6765, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_V2DV2D encodes fcvtpu Vd.2D, Vn.2D.
+func (a *Assembler) FCVTPU_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 5, 6773, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_WH encodes fcvtpu Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPU_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 6, 6781, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_XH encodes fcvtpu Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTPU_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 7, 6788, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_WS encodes fcvtpu Wd, Sn.
+func (a *Assembler) FCVTPU_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 8, 6795, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_XS encodes fcvtpu Xd, Sn.
+func (a *Assembler) FCVTPU_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 9, 6802, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_WD encodes fcvtpu Wd, Dn.
+func (a *Assembler) FCVTPU_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 10, 6809, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTPU_XD encodes fcvtpu Xd, Dn.
+func (a *Assembler) FCVTPU_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTPU, ErrNoMatch)
+	}
+	return a.emit(FCVTPU, 11, 6816, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTXN_SD encodes fcvtxn Sd, Dn.
+func (a *Assembler) FCVTXN_SD(rd SReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTXN, ErrNoMatch)
+	}
+	return a.emit(FCVTXN, 0, 6823, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTXN_V2SV2D encodes fcvtxn Vd.2S, Vn.2D.
+func (a *Assembler) FCVTXN_V2SV2D(rd V2SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTXN, ErrNoMatch)
+	}
+	return a.emit(FCVTXN, 1, 6830, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTXN2_V4SV2D encodes fcvtxn2 Vd.4S, Vn.2D.
+func (a *Assembler) FCVTXN2_V4SV2D(rd V4SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTXN2, ErrNoMatch)
+	}
+	return a.emit(FCVTXN2, 0, 6837, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_HH_Imm encodes fcvtzs Hd, Hn, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_HH_Imm(rd, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 0, 6844, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_SS_Imm encodes fcvtzs Sd, Sn, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZS_SS_Imm(rd, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 1, 6857, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_DD_Imm encodes fcvtzs Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZS_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 2, 6870, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_V8HV8H_Imm encodes fcvtzs Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 3, 6883, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_V4HV4H_Imm encodes fcvtzs Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 3, 6883, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_V4SV4S_Imm encodes fcvtzs Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZS_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 4, 6895, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_V2SV2S_Imm encodes fcvtzs Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZS_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 4, 6895, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_V2DV2D_Imm encodes fcvtzs Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZS_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 5, 6907, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_HH encodes fcvtzs Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 6, 6919, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_SS encodes fcvtzs Sd, Sn.
+func (a *Assembler) FCVTZS_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 7, 6926, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_DD encodes fcvtzs Dd, Dn.
+func (a *Assembler) FCVTZS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 8, 6933, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_V8HV8H encodes fcvtzs Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 9, 6940, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_V4HV4H encodes fcvtzs Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 9, 6940, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_V4SV4S encodes fcvtzs Vd.4S, Vn.4S.
+func (a *Assembler) FCVTZS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 10, 6948, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_V2SV2S encodes fcvtzs Vd.2S, Vn.2S.
+func (a *Assembler) FCVTZS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 10, 6948, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_V2DV2D encodes fcvtzs Vd.2D, Vn.2D.
+func (a *Assembler) FCVTZS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 11, 6956, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_WH_Imm encodes fcvtzs Wd, Hn, #imm (0 < imm <= 32).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_WH_Imm(rd WReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 12, 6964, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_XH_Imm encodes fcvtzs Xd, Hn, #imm (0 < imm <= 64).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_XH_Imm(rd XReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 13, 6977, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_WS_Imm encodes fcvtzs Wd, Sn, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZS_WS_Imm(rd WReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 14, 6988, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_XS_Imm encodes fcvtzs Xd, Sn, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZS_XS_Imm(rd XReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 15, 7001, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_WD_Imm encodes fcvtzs Wd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZS_WD_Imm(rd WReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 16, 7012, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_XD_Imm encodes fcvtzs Xd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZS_XD_Imm(rd XReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 17, 7025, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZS_WH encodes fcvtzs Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 18, 7036, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_XH encodes fcvtzs Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZS_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 19, 7043, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_WS encodes fcvtzs Wd, Sn.
+func (a *Assembler) FCVTZS_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 20, 7050, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_XS encodes fcvtzs Xd, Sn.
+func (a *Assembler) FCVTZS_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 21, 7057, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_WD encodes fcvtzs Wd, Dn.
+func (a *Assembler) FCVTZS_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 22, 7064, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZS_XD encodes fcvtzs Xd, Dn.
+func (a *Assembler) FCVTZS_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZS, ErrNoMatch)
+	}
+	return a.emit(FCVTZS, 23, 7071, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_HH_Imm encodes fcvtzu Hd, Hn, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_HH_Imm(rd, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 0, 7105, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_SS_Imm encodes fcvtzu Sd, Sn, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZU_SS_Imm(rd, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 1, 7118, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_DD_Imm encodes fcvtzu Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZU_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 2, 7131, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_V8HV8H_Imm encodes fcvtzu Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 3, 7144, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_V4HV4H_Imm encodes fcvtzu Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 3, 7144, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_V4SV4S_Imm encodes fcvtzu Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZU_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 4, 7156, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_V2SV2S_Imm encodes fcvtzu Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZU_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 4, 7156, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_V2DV2D_Imm encodes fcvtzu Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZU_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 5, 7168, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_HH encodes fcvtzu Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 6, 7180, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_SS encodes fcvtzu Sd, Sn.
+func (a *Assembler) FCVTZU_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 7, 7187, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_DD encodes fcvtzu Dd, Dn.
+func (a *Assembler) FCVTZU_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 8, 7194, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_V8HV8H encodes fcvtzu Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 9, 7201, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_V4HV4H encodes fcvtzu Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 9, 7201, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_V4SV4S encodes fcvtzu Vd.4S, Vn.4S.
+func (a *Assembler) FCVTZU_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 10, 7209, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_V2SV2S encodes fcvtzu Vd.2S, Vn.2S.
+func (a *Assembler) FCVTZU_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 10, 7209, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_V2DV2D encodes fcvtzu Vd.2D, Vn.2D.
+func (a *Assembler) FCVTZU_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 11, 7217, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_WH_Imm encodes fcvtzu Wd, Hn, #imm (0 < imm <= 32).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_WH_Imm(rd WReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 12, 7225, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_XH_Imm encodes fcvtzu Xd, Hn, #imm (0 < imm <= 64).
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_XH_Imm(rd XReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 13, 7238, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_WS_Imm encodes fcvtzu Wd, Sn, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZU_WS_Imm(rd WReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 14, 7249, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_XS_Imm encodes fcvtzu Xd, Sn, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZU_XS_Imm(rd XReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 15, 7262, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_WD_Imm encodes fcvtzu Wd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) FCVTZU_WD_Imm(rd WReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 16, 7273, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_XD_Imm encodes fcvtzu Xd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) FCVTZU_XD_Imm(rd XReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 17, 7286, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// FCVTZU_WH encodes fcvtzu Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 18, 7297, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_XH encodes fcvtzu Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FCVTZU_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 19, 7304, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_WS encodes fcvtzu Wd, Sn.
+func (a *Assembler) FCVTZU_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 20, 7311, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_XS encodes fcvtzu Xd, Sn.
+func (a *Assembler) FCVTZU_XS(rd XReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 21, 7318, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_WD encodes fcvtzu Wd, Dn.
+func (a *Assembler) FCVTZU_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 22, 7325, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FCVTZU_XD encodes fcvtzu Xd, Dn.
+func (a *Assembler) FCVTZU_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FCVTZU, ErrNoMatch)
+	}
+	return a.emit(FCVTZU, 23, 7332, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FDIV_V8HV8HV8H encodes fdiv Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FDIV_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 0, 7366, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FDIV_V4HV4HV4H encodes fdiv Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FDIV_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 0, 7366, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FDIV_V4SV4SV4S encodes fdiv Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FDIV_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 1, 7375, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FDIV_V2SV2SV2S encodes fdiv Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FDIV_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 1, 7375, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FDIV_V2DV2DV2D encodes fdiv Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FDIV_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 2, 7384, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FDIV_HHH encodes fdiv Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FDIV_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 3, 7393, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FDIV_SSS encodes fdiv Sd, Sn, Sm.
+func (a *Assembler) FDIV_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 4, 7401, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FDIV_DDD encodes fdiv Dd, Dn, Dm.
+func (a *Assembler) FDIV_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FDIV, ErrNoMatch)
+	}
+	return a.emit(FDIV, 5, 7409, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FJCVTZS_WD encodes fjcvtzs Wd, Dn.
+// Requires FEAT_JSCVT.
+func (a *Assembler) FJCVTZS_WD(rd WReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FJCVTZS, ErrNoMatch)
+	}
+	return a.emit(FJCVTZS, 0, 7510, FeatJSCVT, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMADD_HHHH encodes fmadd Hd, Hn, Hm, Ha.
+// Requires FEAT_FP16.
+func (a *Assembler) FMADD_HHHH(rd, rn, rm, ra HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FMADD, ErrNoMatch)
+	}
+	return a.emit(FMADD, 0, 7517, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FMADD_SSSS encodes fmadd Sd, Sn, Sm, Sa.
+func (a *Assembler) FMADD_SSSS(rd, rn, rm, ra SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FMADD, ErrNoMatch)
+	}
+	return a.emit(FMADD, 1, 7526, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FMADD_DDDD encodes fmadd Dd, Dn, Dm, Da.
+func (a *Assembler) FMADD_DDDD(rd, rn, rm, ra DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FMADD, ErrNoMatch)
+	}
+	return a.emit(FMADD, 2, 7535, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FMAX_V8HV8HV8H encodes fmax Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAX_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 0, 7544, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAX_V4HV4HV4H encodes fmax Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAX_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 0, 7544, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAX_V4SV4SV4S encodes fmax Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMAX_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 1, 7553, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAX_V2SV2SV2S encodes fmax Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMAX_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 1, 7553, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAX_V2DV2DV2D encodes fmax Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMAX_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 2, 7562, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAX_HHH encodes fmax Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAX_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 3, 7571, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAX_SSS encodes fmax Sd, Sn, Sm.
+func (a *Assembler) FMAX_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 4, 7579, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAX_DDD encodes fmax Dd, Dn, Dm.
+func (a *Assembler) FMAX_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAX, ErrNoMatch)
+	}
+	return a.emit(FMAX, 5, 7587, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_V8HV8HV8H encodes fmaxnm Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNM_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 0, 7628, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_V4HV4HV4H encodes fmaxnm Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNM_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 0, 7628, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_V4SV4SV4S encodes fmaxnm Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMAXNM_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 1, 7637, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_V2SV2SV2S encodes fmaxnm Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMAXNM_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 1, 7637, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_V2DV2DV2D encodes fmaxnm Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMAXNM_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 2, 7646, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_HHH encodes fmaxnm Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNM_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 3, 7655, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_SSS encodes fmaxnm Sd, Sn, Sm.
+func (a *Assembler) FMAXNM_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 4, 7663, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNM_DDD encodes fmaxnm Dd, Dn, Dm.
+func (a *Assembler) FMAXNM_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNM, ErrNoMatch)
+	}
+	return a.emit(FMAXNM, 5, 7671, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNMP_HV2H encodes fmaxnmp Hd, Vn.2H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNMP_HV2H(rd HReg, rn V2HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 0, 7712, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXNMP_SV2S encodes fmaxnmp Sd, Vn.2S.
+func (a *Assembler) FMAXNMP_SV2S(rd SReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 1, 7719, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXNMP_DV2D encodes fmaxnmp Dd, Vn.2D.
+func (a *Assembler) FMAXNMP_DV2D(rd DReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 2, 7726, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXNMP_V8HV8HV8H encodes fmaxnmp Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNMP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 3, 7733, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNMP_V4HV4HV4H encodes fmaxnmp Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNMP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 3, 7733, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNMP_V4SV4SV4S encodes fmaxnmp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMAXNMP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 4, 7742, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNMP_V2SV2SV2S encodes fmaxnmp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMAXNMP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 4, 7742, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNMP_V2DV2DV2D encodes fmaxnmp Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMAXNMP_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXNMP, ErrNoMatch)
+	}
+	return a.emit(FMAXNMP, 5, 7751, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXNMV_HV8H encodes fmaxnmv Hd, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNMV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXNMV, ErrNoMatch)
+	}
+	return a.emit(FMAXNMV, 0, 7760, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXNMV_HV4H encodes fmaxnmv Hd, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXNMV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXNMV, ErrNoMatch)
+	}
+	return a.emit(FMAXNMV, 0, 7760, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXNMV_SV4S encodes fmaxnmv Sd, Vn.4S.
+func (a *Assembler) FMAXNMV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXNMV, ErrNoMatch)
+	}
+	return a.emit(FMAXNMV, 1, 7768, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXP_HV2H encodes fmaxp Hd, Vn.2H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXP_HV2H(rd HReg, rn V2HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 0, 7803, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXP_SV2S encodes fmaxp Sd, Vn.2S.
+func (a *Assembler) FMAXP_SV2S(rd SReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 1, 7810, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXP_DV2D encodes fmaxp Dd, Vn.2D.
+func (a *Assembler) FMAXP_DV2D(rd DReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 2, 7817, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXP_V8HV8HV8H encodes fmaxp Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 3, 7824, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXP_V4HV4HV4H encodes fmaxp Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 3, 7824, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXP_V4SV4SV4S encodes fmaxp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMAXP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 4, 7833, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXP_V2SV2SV2S encodes fmaxp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMAXP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 4, 7833, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXP_V2DV2DV2D encodes fmaxp Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMAXP_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMAXP, ErrNoMatch)
+	}
+	return a.emit(FMAXP, 5, 7842, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMAXV_HV8H encodes fmaxv Hd, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXV, ErrNoMatch)
+	}
+	return a.emit(FMAXV, 0, 7851, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXV_HV4H encodes fmaxv Hd, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMAXV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXV, ErrNoMatch)
+	}
+	return a.emit(FMAXV, 0, 7851, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMAXV_SV4S encodes fmaxv Sd, Vn.4S.
+func (a *Assembler) FMAXV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMAXV, ErrNoMatch)
+	}
+	return a.emit(FMAXV, 1, 7859, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMIN_V8HV8HV8H encodes fmin Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMIN_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 0, 7894, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMIN_V4HV4HV4H encodes fmin Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMIN_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 0, 7894, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMIN_V4SV4SV4S encodes fmin Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMIN_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 1, 7903, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMIN_V2SV2SV2S encodes fmin Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMIN_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 1, 7903, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMIN_V2DV2DV2D encodes fmin Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMIN_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 2, 7912, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMIN_HHH encodes fmin Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FMIN_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 3, 7921, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMIN_SSS encodes fmin Sd, Sn, Sm.
+func (a *Assembler) FMIN_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 4, 7929, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMIN_DDD encodes fmin Dd, Dn, Dm.
+func (a *Assembler) FMIN_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMIN, ErrNoMatch)
+	}
+	return a.emit(FMIN, 5, 7937, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_V8HV8HV8H encodes fminnm Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNM_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 0, 7978, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_V4HV4HV4H encodes fminnm Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNM_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 0, 7978, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_V4SV4SV4S encodes fminnm Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMINNM_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 1, 7987, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_V2SV2SV2S encodes fminnm Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMINNM_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 1, 7987, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_V2DV2DV2D encodes fminnm Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMINNM_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 2, 7996, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_HHH encodes fminnm Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNM_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 3, 8005, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_SSS encodes fminnm Sd, Sn, Sm.
+func (a *Assembler) FMINNM_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 4, 8013, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNM_DDD encodes fminnm Dd, Dn, Dm.
+func (a *Assembler) FMINNM_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNM, ErrNoMatch)
+	}
+	return a.emit(FMINNM, 5, 8021, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNMP_HV2H encodes fminnmp Hd, Vn.2H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNMP_HV2H(rd HReg, rn V2HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 0, 8062, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINNMP_SV2S encodes fminnmp Sd, Vn.2S.
+func (a *Assembler) FMINNMP_SV2S(rd SReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 1, 8069, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINNMP_DV2D encodes fminnmp Dd, Vn.2D.
+func (a *Assembler) FMINNMP_DV2D(rd DReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 2, 8076, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINNMP_V8HV8HV8H encodes fminnmp Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNMP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 3, 8083, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNMP_V4HV4HV4H encodes fminnmp Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNMP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 3, 8083, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNMP_V4SV4SV4S encodes fminnmp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMINNMP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 4, 8092, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNMP_V2SV2SV2S encodes fminnmp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMINNMP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 4, 8092, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNMP_V2DV2DV2D encodes fminnmp Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMINNMP_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINNMP, ErrNoMatch)
+	}
+	return a.emit(FMINNMP, 5, 8101, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINNMV_HV8H encodes fminnmv Hd, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNMV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINNMV, ErrNoMatch)
+	}
+	return a.emit(FMINNMV, 0, 8110, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINNMV_HV4H encodes fminnmv Hd, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINNMV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINNMV, ErrNoMatch)
+	}
+	return a.emit(FMINNMV, 0, 8110, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINNMV_SV4S encodes fminnmv Sd, Vn.4S.
+func (a *Assembler) FMINNMV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINNMV, ErrNoMatch)
+	}
+	return a.emit(FMINNMV, 1, 8118, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINP_HV2H encodes fminp Hd, Vn.2H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINP_HV2H(rd HReg, rn V2HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 0, 8153, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINP_SV2S encodes fminp Sd, Vn.2S.
+func (a *Assembler) FMINP_SV2S(rd SReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 1, 8160, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINP_DV2D encodes fminp Dd, Vn.2D.
+func (a *Assembler) FMINP_DV2D(rd DReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 2, 8167, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINP_V8HV8HV8H encodes fminp Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 3, 8174, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINP_V4HV4HV4H encodes fminp Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 3, 8174, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINP_V4SV4SV4S encodes fminp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMINP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 4, 8183, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINP_V2SV2SV2S encodes fminp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMINP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 4, 8183, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINP_V2DV2DV2D encodes fminp Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMINP_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMINP, ErrNoMatch)
+	}
+	return a.emit(FMINP, 5, 8192, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMINV_HV8H encodes fminv Hd, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINV, ErrNoMatch)
+	}
+	return a.emit(FMINV, 0, 8201, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINV_HV4H encodes fminv Hd, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMINV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINV, ErrNoMatch)
+	}
+	return a.emit(FMINV, 0, 8201, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMINV_SV4S encodes fminv Sd, Vn.4S.
+func (a *Assembler) FMINV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMINV, ErrNoMatch)
+	}
+	return a.emit(FMINV, 1, 8209, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMLA_HHVHi encodes fmla Hd, Hn, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMLA_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 0, 8244, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_SSVSi encodes fmla Sd, Sn, Vm.S[i].
+func (a *Assembler) FMLA_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 1, 8254, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_DDVDi encodes fmla Dd, Dn, Vm.D[i].
+func (a *Assembler) FMLA_DDVDi(rd, rn DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 2, 8264, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_V8HV8HVHi encodes fmla Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMLA_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 3, 8274, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_V4HV4HVHi encodes fmla Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMLA_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 3, 8274, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_V4SV4SVSi encodes fmla Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) FMLA_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 4, 8285, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_V2SV2SVSi encodes fmla Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) FMLA_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 4, 8285, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_V2DV2DVDi encodes fmla Vd.2D, Vn.2D, Vm.D[i].
+func (a *Assembler) FMLA_V2DV2DVDi(rd, rn V2DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 5, 8296, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLA_V8HV8HV8H encodes fmla Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMLA_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 6, 8307, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLA_V4HV4HV4H encodes fmla Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMLA_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 6, 8307, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLA_V4SV4SV4S encodes fmla Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMLA_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 7, 8316, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLA_V2SV2SV2S encodes fmla Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMLA_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 7, 8316, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLA_V2DV2DV2D encodes fmla Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMLA_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLA, ErrNoMatch)
+	}
+	return a.emit(FMLA, 8, 8325, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLAL_V2SV2HVHi encodes fmlal Vd.2S, Vn.2H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL_V2SV2HVHi(rd V2SReg, rn V2HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLAL, ErrNoMatch)
+	}
+	return a.emit(FMLAL, 0, 8407, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLAL_V4SV4HVHi encodes fmlal Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLAL, ErrNoMatch)
+	}
+	return a.emit(FMLAL, 1, 8417, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLAL_V2SV2HV2H encodes fmlal Vd.2S, Vn.2H, Vm.2H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL_V2SV2HV2H(rd V2SReg, rn, rm V2HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLAL, ErrNoMatch)
+	}
+	return a.emit(FMLAL, 2, 8427, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLAL_V4SV4HV4H encodes fmlal Vd.4S, Vn.4H, Vm.4H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLAL, ErrNoMatch)
+	}
+	return a.emit(FMLAL, 3, 8435, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLAL2_V2SV2HVHi encodes fmlal2 Vd.2S, Vn.2H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL2_V2SV2HVHi(rd V2SReg, rn V2HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLAL2, ErrNoMatch)
+	}
+	return a.emit(FMLAL2, 0, 8443, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLAL2_V4SV4HVHi encodes fmlal2 Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL2_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLAL2, ErrNoMatch)
+	}
+	return a.emit(FMLAL2, 1, 8453, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLAL2_V2SV2HV2H encodes fmlal2 Vd.2S, Vn.2H, Vm.2H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL2_V2SV2HV2H(rd V2SReg, rn, rm V2HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLAL2, ErrNoMatch)
+	}
+	return a.emit(FMLAL2, 2, 8463, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLAL2_V4SV4HV4H encodes fmlal2 Vd.4S, Vn.4H, Vm.4H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLAL2_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLAL2, ErrNoMatch)
+	}
+	return a.emit(FMLAL2, 3, 8471, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLS_HHVHi encodes fmls Hd, Hn, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMLS_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 0, 8479, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_SSVSi encodes fmls Sd, Sn, Vm.S[i].
+func (a *Assembler) FMLS_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 1, 8489, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_DDVDi encodes fmls Dd, Dn, Vm.D[i].
+func (a *Assembler) FMLS_DDVDi(rd, rn DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 2, 8499, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_V8HV8HVHi encodes fmls Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMLS_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 3, 8509, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_V4HV4HVHi encodes fmls Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMLS_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 3, 8509, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_V4SV4SVSi encodes fmls Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) FMLS_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 4, 8520, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_V2SV2SVSi encodes fmls Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) FMLS_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 4, 8520, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_V2DV2DVDi encodes fmls Vd.2D, Vn.2D, Vm.D[i].
+func (a *Assembler) FMLS_V2DV2DVDi(rd, rn V2DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 5, 8531, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLS_V8HV8HV8H encodes fmls Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMLS_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 6, 8542, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLS_V4HV4HV4H encodes fmls Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMLS_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 6, 8542, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLS_V4SV4SV4S encodes fmls Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMLS_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 7, 8551, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLS_V2SV2SV2S encodes fmls Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMLS_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 7, 8551, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLS_V2DV2DV2D encodes fmls Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMLS_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLS, ErrNoMatch)
+	}
+	return a.emit(FMLS, 8, 8560, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLSL_V2SV2HVHi encodes fmlsl Vd.2S, Vn.2H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL_V2SV2HVHi(rd V2SReg, rn V2HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLSL, ErrNoMatch)
+	}
+	return a.emit(FMLSL, 0, 8642, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLSL_V4SV4HVHi encodes fmlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLSL, ErrNoMatch)
+	}
+	return a.emit(FMLSL, 1, 8652, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLSL_V2SV2HV2H encodes fmlsl Vd.2S, Vn.2H, Vm.2H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL_V2SV2HV2H(rd V2SReg, rn, rm V2HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLSL, ErrNoMatch)
+	}
+	return a.emit(FMLSL, 2, 8662, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLSL_V4SV4HV4H encodes fmlsl Vd.4S, Vn.4H, Vm.4H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLSL, ErrNoMatch)
+	}
+	return a.emit(FMLSL, 3, 8670, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLSL2_V2SV2HVHi encodes fmlsl2 Vd.2S, Vn.2H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL2_V2SV2HVHi(rd V2SReg, rn V2HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLSL2, ErrNoMatch)
+	}
+	return a.emit(FMLSL2, 0, 8678, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLSL2_V4SV4HVHi encodes fmlsl2 Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL2_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMLSL2, ErrNoMatch)
+	}
+	return a.emit(FMLSL2, 1, 8688, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMLSL2_V2SV2HV2H encodes fmlsl2 Vd.2S, Vn.2H, Vm.2H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL2_V2SV2HV2H(rd V2SReg, rn, rm V2HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLSL2, ErrNoMatch)
+	}
+	return a.emit(FMLSL2, 2, 8698, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMLSL2_V4SV4HV4H encodes fmlsl2 Vd.4S, Vn.4H, Vm.4H.
+// Requires FEAT_FHM.
+func (a *Assembler) FMLSL2_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMLSL2, ErrNoMatch)
+	}
+	return a.emit(FMLSL2, 3, 8706, FeatFHM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMOV_V8H_Float encodes fmov Vd.8H, #imm (imm is split float).
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_V8H_Float(rd V8HReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 0, 8798, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMOV_V4H_Float encodes fmov Vd.4H, #imm (imm is split float).
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_V4H_Float(rd V4HReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 0, 8798, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMOV_V4S_Float encodes fmov Vd.4S, #imm (imm is split float).
+func (a *Assembler) FMOV_V4S_Float(rd V4SReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 1, 8808, 0, 0, 16, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMOV_V2S_Float encodes fmov Vd.2S, #imm (imm is split float).
+func (a *Assembler) FMOV_V2S_Float(rd V2SReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 1, 8808, 0, 0, 8, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMOV_V2D_Float encodes fmov Vd.2D, #imm (imm is split float).
+func (a *Assembler) FMOV_V2D_Float(rd V2DReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 2, 8818, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMOV_HH encodes fmov Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 3, 8827, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_SS encodes fmov Sd, Sn.
+func (a *Assembler) FMOV_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 4, 8834, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_DD encodes fmov Dd, Dn.
+func (a *Assembler) FMOV_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 5, 8841, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_WH encodes fmov Wd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_WH(rd WReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 6, 8848, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_XH encodes fmov Xd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_XH(rd XReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 7, 8855, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_HW encodes fmov Hd, Wn.
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_HW(rd HReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 8, 8862, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_SW encodes fmov Sd, Wn.
+func (a *Assembler) FMOV_SW(rd SReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 9, 8869, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_WS encodes fmov Wd, Sn.
+func (a *Assembler) FMOV_WS(rd WReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 10, 8876, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_HX encodes fmov Hd, Xn.
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_HX(rd HReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 11, 8883, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_DX encodes fmov Dd, Xn.
+func (a *Assembler) FMOV_DX(rd DReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 12, 8890, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_VD1X encodes fmov Vd.D[1], Xn.
+func (a *Assembler) FMOV_VD1X(rd VReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 13, 8897, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_XD encodes fmov Xd, Dn.
+func (a *Assembler) FMOV_XD(rd XReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 14, 8904, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_XVD1 encodes fmov Xd, Vn.D[1].
+func (a *Assembler) FMOV_XVD1(rd XReg, rn VReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 15, 8911, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FMOV_H_Zero encodes fmov Hd, #0.0.
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_H_Zero(rd HReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 16, 8918, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FMOV_S_Zero encodes fmov Sd, #0.0.
+func (a *Assembler) FMOV_S_Zero(rd SReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 17, 8924, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FMOV_D_Zero encodes fmov Dd, #0.0.
+func (a *Assembler) FMOV_D_Zero(rd DReg) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 18, 8930, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// FMOV_H_Float encodes fmov Hd, #imm (imm is float).
+// Requires FEAT_FP16.
+func (a *Assembler) FMOV_H_Float(rd HReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 19, 8936, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMOV_S_Float encodes fmov Sd, #imm (imm is float).
+func (a *Assembler) FMOV_S_Float(rd SReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 20, 8945, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMOV_D_Float encodes fmov Dd, #imm (imm is float).
+func (a *Assembler) FMOV_D_Float(rd DReg, f float64) bool {
+	if rd >= 32 {
+		return a.emitErr(FMOV, ErrNoMatch)
+	}
+	return a.emit(FMOV, 21, 8954, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatFloat(f))
+}
+
+// FMSUB_HHHH encodes fmsub Hd, Hn, Hm, Ha.
+// Requires FEAT_FP16.
+func (a *Assembler) FMSUB_HHHH(rd, rn, rm, ra HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FMSUB, ErrNoMatch)
+	}
+	return a.emit(FMSUB, 0, 9059, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FMSUB_SSSS encodes fmsub Sd, Sn, Sm, Sa.
+func (a *Assembler) FMSUB_SSSS(rd, rn, rm, ra SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FMSUB, ErrNoMatch)
+	}
+	return a.emit(FMSUB, 1, 9068, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FMSUB_DDDD encodes fmsub Dd, Dn, Dm, Da.
+func (a *Assembler) FMSUB_DDDD(rd, rn, rm, ra DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FMSUB, ErrNoMatch)
+	}
+	return a.emit(FMSUB, 2, 9077, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FMUL_HHVHi encodes fmul Hd, Hn, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMUL_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 0, 9086, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_SSVSi encodes fmul Sd, Sn, Vm.S[i].
+func (a *Assembler) FMUL_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 1, 9096, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_DDVDi encodes fmul Dd, Dn, Vm.D[i].
+func (a *Assembler) FMUL_DDVDi(rd, rn DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 2, 9106, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_V8HV8HVHi encodes fmul Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMUL_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 3, 9116, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_V4HV4HVHi encodes fmul Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMUL_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 3, 9116, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_V4SV4SVSi encodes fmul Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) FMUL_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 4, 9127, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_V2SV2SVSi encodes fmul Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) FMUL_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 4, 9127, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_V2DV2DVDi encodes fmul Vd.2D, Vn.2D, Vm.D[i].
+func (a *Assembler) FMUL_V2DV2DVDi(rd, rn V2DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 5, 9138, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMUL_V8HV8HV8H encodes fmul Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMUL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 6, 9149, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMUL_V4HV4HV4H encodes fmul Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMUL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 6, 9149, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMUL_V4SV4SV4S encodes fmul Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMUL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 7, 9158, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMUL_V2SV2SV2S encodes fmul Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMUL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 7, 9158, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMUL_V2DV2DV2D encodes fmul Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMUL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 8, 9167, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMUL_HHH encodes fmul Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FMUL_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 9, 9176, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMUL_SSS encodes fmul Sd, Sn, Sm.
+func (a *Assembler) FMUL_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 10, 9184, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMUL_DDD encodes fmul Dd, Dn, Dm.
+func (a *Assembler) FMUL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMUL, ErrNoMatch)
+	}
+	return a.emit(FMUL, 11, 9192, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_HHVHi encodes fmulx Hd, Hn, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMULX_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 0, 9300, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_SSVSi encodes fmulx Sd, Sn, Vm.S[i].
+func (a *Assembler) FMULX_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 1, 9310, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_DDVDi encodes fmulx Dd, Dn, Vm.D[i].
+func (a *Assembler) FMULX_DDVDi(rd, rn DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 2, 9320, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_V8HV8HVHi encodes fmulx Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMULX_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 3, 9330, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_V4HV4HVHi encodes fmulx Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_FP16.
+func (a *Assembler) FMULX_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 3, 9330, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_V4SV4SVSi encodes fmulx Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) FMULX_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 4, 9341, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_V2SV2SVSi encodes fmulx Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) FMULX_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 4, 9341, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_V2DV2DVDi encodes fmulx Vd.2D, Vn.2D, Vm.D[i].
+func (a *Assembler) FMULX_V2DV2DVDi(rd, rn V2DReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 2 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 5, 9352, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// FMULX_HHH encodes fmulx Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FMULX_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 6, 9363, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_SSS encodes fmulx Sd, Sn, Sm.
+func (a *Assembler) FMULX_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 7, 9371, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_DDD encodes fmulx Dd, Dn, Dm.
+func (a *Assembler) FMULX_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 8, 9379, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_V8HV8HV8H encodes fmulx Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMULX_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 9, 9387, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_V4HV4HV4H encodes fmulx Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FMULX_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 9, 9387, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_V4SV4SV4S encodes fmulx Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FMULX_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 10, 9396, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_V2SV2SV2S encodes fmulx Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FMULX_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 10, 9396, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FMULX_V2DV2DV2D encodes fmulx Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FMULX_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FMULX, ErrNoMatch)
+	}
+	return a.emit(FMULX, 11, 9405, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FNEG_V8HV8H encodes fneg Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FNEG_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 0, 9447, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNEG_V4HV4H encodes fneg Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FNEG_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 0, 9447, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNEG_V4SV4S encodes fneg Vd.4S, Vn.4S.
+func (a *Assembler) FNEG_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 1, 9455, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNEG_V2SV2S encodes fneg Vd.2S, Vn.2S.
+func (a *Assembler) FNEG_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 1, 9455, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNEG_V2DV2D encodes fneg Vd.2D, Vn.2D.
+func (a *Assembler) FNEG_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 2, 9463, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNEG_HH encodes fneg Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FNEG_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 3, 9471, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNEG_SS encodes fneg Sd, Sn.
+func (a *Assembler) FNEG_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 4, 9478, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNEG_DD encodes fneg Dd, Dn.
+func (a *Assembler) FNEG_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FNEG, ErrNoMatch)
+	}
+	return a.emit(FNEG, 5, 9485, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FNMADD_HHHH encodes fnmadd Hd, Hn, Hm, Ha.
+// Requires FEAT_FP16.
+func (a *Assembler) FNMADD_HHHH(rd, rn, rm, ra HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FNMADD, ErrNoMatch)
+	}
+	return a.emit(FNMADD, 0, 9519, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FNMADD_SSSS encodes fnmadd Sd, Sn, Sm, Sa.
+func (a *Assembler) FNMADD_SSSS(rd, rn, rm, ra SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FNMADD, ErrNoMatch)
+	}
+	return a.emit(FNMADD, 1, 9528, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FNMADD_DDDD encodes fnmadd Dd, Dn, Dm, Da.
+func (a *Assembler) FNMADD_DDDD(rd, rn, rm, ra DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FNMADD, ErrNoMatch)
+	}
+	return a.emit(FNMADD, 2, 9537, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FNMSUB_HHHH encodes fnmsub Hd, Hn, Hm, Ha.
+// Requires FEAT_FP16.
+func (a *Assembler) FNMSUB_HHHH(rd, rn, rm, ra HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FNMSUB, ErrNoMatch)
+	}
+	return a.emit(FNMSUB, 0, 9606, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FNMSUB_SSSS encodes fnmsub Sd, Sn, Sm, Sa.
+func (a *Assembler) FNMSUB_SSSS(rd, rn, rm, ra SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FNMSUB, ErrNoMatch)
+	}
+	return a.emit(FNMSUB, 1, 9615, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FNMSUB_DDDD encodes fnmsub Dd, Dn, Dm, Da.
+func (a *Assembler) FNMSUB_DDDD(rd, rn, rm, ra DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(FNMSUB, ErrNoMatch)
+	}
+	return a.emit(FNMSUB, 2, 9624, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// FNMUL_HHH encodes fnmul Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FNMUL_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FNMUL, ErrNoMatch)
+	}
+	return a.emit(FNMUL, 0, 9633, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FNMUL_SSS encodes fnmul Sd, Sn, Sm.
+func (a *Assembler) FNMUL_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FNMUL, ErrNoMatch)
+	}
+	return a.emit(FNMUL, 1, 9641, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FNMUL_DDD encodes fnmul Dd, Dn, Dm.
+func (a *Assembler) FNMUL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FNMUL, ErrNoMatch)
+	}
+	return a.emit(FNMUL, 2, 9649, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPE_HH encodes frecpe Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRECPE_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 0, 9657, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPE_SS encodes frecpe Sd, Sn.
+func (a *Assembler) FRECPE_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 1, 9664, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPE_DD encodes frecpe Dd, Dn.
+func (a *Assembler) FRECPE_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 2, 9671, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPE_V8HV8H encodes frecpe Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRECPE_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 3, 9678, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPE_V4HV4H encodes frecpe Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRECPE_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 3, 9678, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPE_V4SV4S encodes frecpe Vd.4S, Vn.4S.
+func (a *Assembler) FRECPE_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 4, 9686, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPE_V2SV2S encodes frecpe Vd.2S, Vn.2S.
+func (a *Assembler) FRECPE_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 4, 9686, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPE_V2DV2D encodes frecpe Vd.2D, Vn.2D.
+func (a *Assembler) FRECPE_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPE, ErrNoMatch)
+	}
+	return a.emit(FRECPE, 5, 9694, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPS_HHH encodes frecps Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FRECPS_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 0, 9702, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPS_SSS encodes frecps Sd, Sn, Sm.
+func (a *Assembler) FRECPS_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 1, 9710, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPS_DDD encodes frecps Dd, Dn, Dm.
+func (a *Assembler) FRECPS_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 2, 9718, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPS_V8HV8HV8H encodes frecps Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRECPS_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 3, 9726, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPS_V4HV4HV4H encodes frecps Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRECPS_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 3, 9726, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPS_V4SV4SV4S encodes frecps Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FRECPS_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 4, 9735, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPS_V2SV2SV2S encodes frecps Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FRECPS_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 4, 9735, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPS_V2DV2DV2D encodes frecps Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FRECPS_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRECPS, ErrNoMatch)
+	}
+	return a.emit(FRECPS, 5, 9744, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRECPX_HH encodes frecpx Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRECPX_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPX, ErrNoMatch)
+	}
+	return a.emit(FRECPX, 0, 9777, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPX_SS encodes frecpx Sd, Sn.
+func (a *Assembler) FRECPX_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPX, ErrNoMatch)
+	}
+	return a.emit(FRECPX, 1, 9784, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRECPX_DD encodes frecpx Dd, Dn.
+func (a *Assembler) FRECPX_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRECPX, ErrNoMatch)
+	}
+	return a.emit(FRECPX, 2, 9791, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32X_SS encodes frint32x Sd, Sn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32X_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32X, ErrNoMatch)
+	}
+	return a.emit(FRINT32X, 0, 9825, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32X_DD encodes frint32x Dd, Dn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32X_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32X, ErrNoMatch)
+	}
+	return a.emit(FRINT32X, 1, 9832, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32X_V4SV4S encodes frint32x Vd.4S, Vn.4S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32X_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32X, ErrNoMatch)
+	}
+	return a.emit(FRINT32X, 2, 9839, FeatFRINTTS, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32X_V2SV2S encodes frint32x Vd.2S, Vn.2S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32X_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32X, ErrNoMatch)
+	}
+	return a.emit(FRINT32X, 2, 9839, FeatFRINTTS, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32X_V2DV2D encodes frint32x Vd.2D, Vn.2D.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32X_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32X, ErrNoMatch)
+	}
+	return a.emit(FRINT32X, 3, 9847, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32Z_SS encodes frint32z Sd, Sn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32Z_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32Z, ErrNoMatch)
+	}
+	return a.emit(FRINT32Z, 0, 9855, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32Z_DD encodes frint32z Dd, Dn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32Z_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32Z, ErrNoMatch)
+	}
+	return a.emit(FRINT32Z, 1, 9862, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32Z_V4SV4S encodes frint32z Vd.4S, Vn.4S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32Z_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32Z, ErrNoMatch)
+	}
+	return a.emit(FRINT32Z, 2, 9869, FeatFRINTTS, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32Z_V2SV2S encodes frint32z Vd.2S, Vn.2S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32Z_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32Z, ErrNoMatch)
+	}
+	return a.emit(FRINT32Z, 2, 9869, FeatFRINTTS, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT32Z_V2DV2D encodes frint32z Vd.2D, Vn.2D.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT32Z_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT32Z, ErrNoMatch)
+	}
+	return a.emit(FRINT32Z, 3, 9877, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64X_SS encodes frint64x Sd, Sn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64X_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64X, ErrNoMatch)
+	}
+	return a.emit(FRINT64X, 0, 9885, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64X_DD encodes frint64x Dd, Dn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64X_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64X, ErrNoMatch)
+	}
+	return a.emit(FRINT64X, 1, 9892, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64X_V4SV4S encodes frint64x Vd.4S, Vn.4S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64X_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64X, ErrNoMatch)
+	}
+	return a.emit(FRINT64X, 2, 9899, FeatFRINTTS, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64X_V2SV2S encodes frint64x Vd.2S, Vn.2S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64X_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64X, ErrNoMatch)
+	}
+	return a.emit(FRINT64X, 2, 9899, FeatFRINTTS, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64X_V2DV2D encodes frint64x Vd.2D, Vn.2D.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64X_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64X, ErrNoMatch)
+	}
+	return a.emit(FRINT64X, 3, 9907, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64Z_SS encodes frint64z Sd, Sn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64Z_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64Z, ErrNoMatch)
+	}
+	return a.emit(FRINT64Z, 0, 9915, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64Z_DD encodes frint64z Dd, Dn.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64Z_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64Z, ErrNoMatch)
+	}
+	return a.emit(FRINT64Z, 1, 9922, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64Z_V4SV4S encodes frint64z Vd.4S, Vn.4S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64Z_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64Z, ErrNoMatch)
+	}
+	return a.emit(FRINT64Z, 2, 9929, FeatFRINTTS, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64Z_V2SV2S encodes frint64z Vd.2S, Vn.2S.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64Z_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64Z, ErrNoMatch)
+	}
+	return a.emit(FRINT64Z, 2, 9929, FeatFRINTTS, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINT64Z_V2DV2D encodes frint64z Vd.2D, Vn.2D.
+// Requires FEAT_FRINTTS.
+func (a *Assembler) FRINT64Z_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINT64Z, ErrNoMatch)
+	}
+	return a.emit(FRINT64Z, 3, 9937, FeatFRINTTS, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_V8HV8H encodes frinta Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTA_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 0, 9945, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_V4HV4H encodes frinta Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTA_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 0, 9945, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_V4SV4S encodes frinta Vd.4S, Vn.4S.
+func (a *Assembler) FRINTA_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 1, 9953, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_V2SV2S encodes frinta Vd.2S, Vn.2S.
+func (a *Assembler) FRINTA_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 1, 9953, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_V2DV2D encodes frinta Vd.2D, Vn.2D.
+func (a *Assembler) FRINTA_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 2, 9961, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_HH encodes frinta Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTA_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 3, 9969, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_SS encodes frinta Sd, Sn.
+func (a *Assembler) FRINTA_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 4, 9976, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTA_DD encodes frinta Dd, Dn.
+func (a *Assembler) FRINTA_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTA, ErrNoMatch)
+	}
+	return a.emit(FRINTA, 5, 9983, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_V8HV8H encodes frinti Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTI_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 0, 10017, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_V4HV4H encodes frinti Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTI_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 0, 10017, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_V4SV4S encodes frinti Vd.4S, Vn.4S.
+func (a *Assembler) FRINTI_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 1, 10025, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_V2SV2S encodes frinti Vd.2S, Vn.2S.
+func (a *Assembler) FRINTI_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 1, 10025, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_V2DV2D encodes frinti Vd.2D, Vn.2D.
+func (a *Assembler) FRINTI_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 2, 10033, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_HH encodes frinti Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTI_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 3, 10041, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_SS encodes frinti Sd, Sn.
+func (a *Assembler) FRINTI_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 4, 10048, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTI_DD encodes frinti Dd, Dn.
+func (a *Assembler) FRINTI_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTI, ErrNoMatch)
+	}
+	return a.emit(FRINTI, 5, 10055, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_V8HV8H encodes frintm Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTM_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 0, 10089, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_V4HV4H encodes frintm Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTM_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 0, 10089, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_V4SV4S encodes frintm Vd.4S, Vn.4S.
+func (a *Assembler) FRINTM_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 1, 10097, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_V2SV2S encodes frintm Vd.2S, Vn.2S.
+func (a *Assembler) FRINTM_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 1, 10097, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_V2DV2D encodes frintm Vd.2D, Vn.2D.
+func (a *Assembler) FRINTM_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 2, 10105, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_HH encodes frintm Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTM_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 3, 10113, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_SS encodes frintm Sd, Sn.
+func (a *Assembler) FRINTM_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 4, 10120, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTM_DD encodes frintm Dd, Dn.
+func (a *Assembler) FRINTM_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTM, ErrNoMatch)
+	}
+	return a.emit(FRINTM, 5, 10127, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_V8HV8H encodes frintn Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTN_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 0, 10161, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_V4HV4H encodes frintn Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTN_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 0, 10161, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_V4SV4S encodes frintn Vd.4S, Vn.4S.
+func (a *Assembler) FRINTN_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 1, 10169, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_V2SV2S encodes frintn Vd.2S, Vn.2S.
+func (a *Assembler) FRINTN_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 1, 10169, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_V2DV2D encodes frintn Vd.2D, Vn.2D.
+func (a *Assembler) FRINTN_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 2, 10177, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_HH encodes frintn Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTN_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 3, 10185, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_SS encodes frintn Sd, Sn.
+func (a *Assembler) FRINTN_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 4, 10192, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTN_DD encodes frintn Dd, Dn.
+func (a *Assembler) FRINTN_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTN, ErrNoMatch)
+	}
+	return a.emit(FRINTN, 5, 10199, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_V8HV8H encodes frintp Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTP_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 0, 10233, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_V4HV4H encodes frintp Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTP_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 0, 10233, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_V4SV4S encodes frintp Vd.4S, Vn.4S.
+func (a *Assembler) FRINTP_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 1, 10241, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_V2SV2S encodes frintp Vd.2S, Vn.2S.
+func (a *Assembler) FRINTP_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 1, 10241, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_V2DV2D encodes frintp Vd.2D, Vn.2D.
+func (a *Assembler) FRINTP_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 2, 10249, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_HH encodes frintp Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTP_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 3, 10257, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_SS encodes frintp Sd, Sn.
+func (a *Assembler) FRINTP_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 4, 10264, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTP_DD encodes frintp Dd, Dn.
+func (a *Assembler) FRINTP_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTP, ErrNoMatch)
+	}
+	return a.emit(FRINTP, 5, 10271, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_V8HV8H encodes frintx Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTX_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 0, 10305, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_V4HV4H encodes frintx Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTX_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 0, 10305, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_V4SV4S encodes frintx Vd.4S, Vn.4S.
+func (a *Assembler) FRINTX_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 1, 10313, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_V2SV2S encodes frintx Vd.2S, Vn.2S.
+func (a *Assembler) FRINTX_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 1, 10313, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_V2DV2D encodes frintx Vd.2D, Vn.2D.
+func (a *Assembler) FRINTX_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 2, 10321, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_HH encodes frintx Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTX_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 3, 10329, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_SS encodes frintx Sd, Sn.
+func (a *Assembler) FRINTX_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 4, 10336, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTX_DD encodes frintx Dd, Dn.
+func (a *Assembler) FRINTX_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTX, ErrNoMatch)
+	}
+	return a.emit(FRINTX, 5, 10343, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_V8HV8H encodes frintz Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTZ_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 0, 10377, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_V4HV4H encodes frintz Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTZ_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 0, 10377, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_V4SV4S encodes frintz Vd.4S, Vn.4S.
+func (a *Assembler) FRINTZ_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 1, 10385, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_V2SV2S encodes frintz Vd.2S, Vn.2S.
+func (a *Assembler) FRINTZ_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 1, 10385, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_V2DV2D encodes frintz Vd.2D, Vn.2D.
+func (a *Assembler) FRINTZ_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 2, 10393, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_HH encodes frintz Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRINTZ_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 3, 10401, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_SS encodes frintz Sd, Sn.
+func (a *Assembler) FRINTZ_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 4, 10408, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRINTZ_DD encodes frintz Dd, Dn.
+func (a *Assembler) FRINTZ_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRINTZ, ErrNoMatch)
+	}
+	return a.emit(FRINTZ, 5, 10415, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_HH encodes frsqrte Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FRSQRTE_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 0, 10449, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_SS encodes frsqrte Sd, Sn.
+func (a *Assembler) FRSQRTE_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 1, 10456, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_DD encodes frsqrte Dd, Dn.
+func (a *Assembler) FRSQRTE_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 2, 10463, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_V8HV8H encodes frsqrte Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRSQRTE_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 3, 10470, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_V4HV4H encodes frsqrte Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRSQRTE_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 3, 10470, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_V4SV4S encodes frsqrte Vd.4S, Vn.4S.
+func (a *Assembler) FRSQRTE_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 4, 10478, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_V2SV2S encodes frsqrte Vd.2S, Vn.2S.
+func (a *Assembler) FRSQRTE_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 4, 10478, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTE_V2DV2D encodes frsqrte Vd.2D, Vn.2D.
+func (a *Assembler) FRSQRTE_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FRSQRTE, ErrNoMatch)
+	}
+	return a.emit(FRSQRTE, 5, 10486, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FRSQRTS_HHH encodes frsqrts Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FRSQRTS_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 0, 10494, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRSQRTS_SSS encodes frsqrts Sd, Sn, Sm.
+func (a *Assembler) FRSQRTS_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 1, 10502, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRSQRTS_DDD encodes frsqrts Dd, Dn, Dm.
+func (a *Assembler) FRSQRTS_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 2, 10510, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRSQRTS_V8HV8HV8H encodes frsqrts Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRSQRTS_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 3, 10518, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRSQRTS_V4HV4HV4H encodes frsqrts Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FRSQRTS_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 3, 10518, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRSQRTS_V4SV4SV4S encodes frsqrts Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FRSQRTS_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 4, 10527, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRSQRTS_V2SV2SV2S encodes frsqrts Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FRSQRTS_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 4, 10527, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FRSQRTS_V2DV2DV2D encodes frsqrts Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FRSQRTS_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FRSQRTS, ErrNoMatch)
+	}
+	return a.emit(FRSQRTS, 5, 10536, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSQRT_V8HV8H encodes fsqrt Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FSQRT_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 0, 10602, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSQRT_V4HV4H encodes fsqrt Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FSQRT_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 0, 10602, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSQRT_V4SV4S encodes fsqrt Vd.4S, Vn.4S.
+func (a *Assembler) FSQRT_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 1, 10610, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSQRT_V2SV2S encodes fsqrt Vd.2S, Vn.2S.
+func (a *Assembler) FSQRT_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 1, 10610, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSQRT_V2DV2D encodes fsqrt Vd.2D, Vn.2D.
+func (a *Assembler) FSQRT_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 2, 10618, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSQRT_HH encodes fsqrt Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) FSQRT_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 3, 10626, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSQRT_SS encodes fsqrt Sd, Sn.
+func (a *Assembler) FSQRT_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 4, 10633, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSQRT_DD encodes fsqrt Dd, Dn.
+func (a *Assembler) FSQRT_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(FSQRT, ErrNoMatch)
+	}
+	return a.emit(FSQRT, 5, 10640, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// FSUB_V8HV8HV8H encodes fsub Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) FSUB_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 0, 10674, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSUB_V4HV4HV4H encodes fsub Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) FSUB_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 0, 10674, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSUB_V4SV4SV4S encodes fsub Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) FSUB_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 1, 10683, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSUB_V2SV2SV2S encodes fsub Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) FSUB_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 1, 10683, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSUB_V2DV2DV2D encodes fsub Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) FSUB_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 2, 10692, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSUB_HHH encodes fsub Hd, Hn, Hm.
+// Requires FEAT_FP16.
+func (a *Assembler) FSUB_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 3, 10701, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSUB_SSS encodes fsub Sd, Sn, Sm.
+func (a *Assembler) FSUB_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 4, 10709, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// FSUB_DDD encodes fsub Dd, Dn, Dm.
+func (a *Assembler) FSUB_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(FSUB, ErrNoMatch)
+	}
+	return a.emit(FSUB, 5, 10717, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// GMI_XXspX encodes gmi Xd, Xn|SP, Xm.
+// Requires FEAT_MTE.
+func (a *Assembler) GMI_XXspX(rd XReg, rn XSPReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(GMI, ErrNoMatch)
+	}
+	return a.emit(GMI, 0, 10815, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// HINT_Imm encodes hint #imm (0 <= imm < 128).
+func (a *Assembler) HINT_Imm(imm int64) bool {
+	return a.emit(HINT, 0, 10823, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// HLT_Imm encodes hlt #imm (0 <= imm < 65536).
+func (a *Assembler) HLT_Imm(imm int64) bool {
+	return a.emit(HLT, 0, 10831, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// HVC_Imm encodes hvc #imm (0 <= imm < 65536).
+func (a *Assembler) HVC_Imm(imm int64) bool {
+	return a.emit(HVC, 0, 10839, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// IC_IVAU_X encodes ic IVAU, Xn.
+func (a *Assembler) IC_IVAU_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(IC, ErrNoMatch)
+	}
+	return a.emit(IC, 0, 10847, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// IC_Sym encodes ic <symbol>.
+func (a *Assembler) IC_Sym(sym Symbol) bool {
+	return a.emit(IC, 1, 10853, 0, 0, 0, Flat{FlatImm, uint64(sym)})
+}
+
+// INCB_X encodes incb Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) INCB_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(INCB, ErrNoMatch)
+	}
+	return a.emit(INCB, 0, 10861, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// INCB_X_Sym encodes incb Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCB_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(INCB, ErrNoMatch)
+	}
+	return a.emit(INCB, 1, 10867, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// INCB_X_Sym_MUL encodes incb Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCB_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(INCB, ErrNoMatch)
+	}
+	return a.emit(INCB, 1, 10867, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// INCD_X encodes incd Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) INCD_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(INCD, ErrNoMatch)
+	}
+	return a.emit(INCD, 0, 10880, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// INCD_X_Sym encodes incd Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCD_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(INCD, ErrNoMatch)
+	}
+	return a.emit(INCD, 1, 10886, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// INCD_X_Sym_MUL encodes incd Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCD_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(INCD, ErrNoMatch)
+	}
+	return a.emit(INCD, 1, 10886, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// INCH_X encodes inch Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) INCH_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(INCH, ErrNoMatch)
+	}
+	return a.emit(INCH, 0, 10899, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// INCH_X_Sym encodes inch Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCH_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(INCH, ErrNoMatch)
+	}
+	return a.emit(INCH, 1, 10905, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// INCH_X_Sym_MUL encodes inch Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCH_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(INCH, ErrNoMatch)
+	}
+	return a.emit(INCH, 1, 10905, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// INCW_X encodes incw Xd.
+// Requires FEAT_SVE.
+func (a *Assembler) INCW_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(INCW, ErrNoMatch)
+	}
+	return a.emit(INCW, 0, 10918, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// INCW_X_Sym encodes incw Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCW_X_Sym(rd XReg, sym Symbol) bool {
+	if rd >= 32 {
+		return a.emitErr(INCW, ErrNoMatch)
+	}
+	return a.emit(INCW, 1, 10924, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// INCW_X_Sym_MUL encodes incw Xd, <symbol> {, MUL #imm } (0 < imm <= 16).
+// Requires FEAT_SVE.
+func (a *Assembler) INCW_X_Sym_MUL(rd XReg, sym Symbol, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(INCW, ErrNoMatch)
+	}
+	return a.emit(INCW, 1, 10924, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(amount)})
+}
+
+// INS_VBiVBi encodes ins Vd.B[i], Vn.B[i].
+func (a *Assembler) INS_VBiVBi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 || idx2 >= 16 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 0, 11017, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// INS_VHiVHi encodes ins Vd.H[i], Vn.H[i].
+func (a *Assembler) INS_VHiVHi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 || idx2 >= 8 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 1, 11030, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// INS_VSiVSi encodes ins Vd.S[i], Vn.S[i].
+func (a *Assembler) INS_VSiVSi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 || idx2 >= 4 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 2, 11043, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// INS_VDiVDi encodes ins Vd.D[i], Vn.D[i].
+func (a *Assembler) INS_VDiVDi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 || idx2 >= 2 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 3, 11056, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// INS_VBiW encodes ins Vd.B[i], Wn.
+func (a *Assembler) INS_VBiW(rd VReg, idx uint8, rn WReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 4, 11069, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// INS_VHiW encodes ins Vd.H[i], Wn.
+func (a *Assembler) INS_VHiW(rd VReg, idx uint8, rn WReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 5, 11079, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// INS_VSiW encodes ins Vd.S[i], Wn.
+func (a *Assembler) INS_VSiW(rd VReg, idx uint8, rn WReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 6, 11089, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// INS_VDiX encodes ins Vd.D[i], Xn.
+func (a *Assembler) INS_VDiX(rd VReg, idx uint8, rn XReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 {
+		return a.emitErr(INS, ErrNoMatch)
+	}
+	return a.emit(INS, 7, 11099, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// IRG_XspXsp encodes irg Xd|SP, Xn|SP {, Xm }.
+// Requires FEAT_MTE.
+func (a *Assembler) IRG_XspXsp(rd, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(IRG, ErrNoMatch)
+	}
+	return a.emit(IRG, 0, 11109, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{})
+}
+
+// IRG_XspXspX encodes irg Xd|SP, Xn|SP {, Xm }.
+// Requires FEAT_MTE.
+func (a *Assembler) IRG_XspXspX(rd, rn XSPReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(IRG, ErrNoMatch)
+	}
+	return a.emit(IRG, 0, 11109, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ISB_SY encodes isb SY.
+func (a *Assembler) ISB_SY() bool {
+	return a.emit(ISB, 0, 11117, 0, 0, 0)
+}
+
+// ISB_Imm encodes isb #imm (0 <= imm < 16).
+func (a *Assembler) ISB_Imm(imm int64) bool {
+	return a.emit(ISB, 1, 11122, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// ISB encodes isb.
+func (a *Assembler) ISB() bool {
+	return a.emit(ISB, 2, 11130, 0, 0, 0)
+}
+
+// LD64B_X_Ref encodes ld64b Xn, [Xm|SP] (n is even).
+// Requires FEAT_LS64.
+func (a *Assembler) LD64B_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LD64B, ErrNoMatch)
+	}
+	return a.emit(LD64B, 0, 13542, FeatLS64, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADD_WW_Ref encodes ldadd Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADD_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADD, ErrNoMatch)
+	}
+	return a.emit(LDADD, 0, 13550, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADD_XX_Ref encodes ldadd Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADD_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADD, ErrNoMatch)
+	}
+	return a.emit(LDADD, 1, 13558, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDA_WW_Ref encodes ldadda Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDA, ErrNoMatch)
+	}
+	return a.emit(LDADDA, 0, 13566, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDA_XX_Ref encodes ldadda Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDA, ErrNoMatch)
+	}
+	return a.emit(LDADDA, 1, 13574, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDAB_WW_Ref encodes ldaddab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDAB, ErrNoMatch)
+	}
+	return a.emit(LDADDAB, 0, 13582, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDAH_WW_Ref encodes ldaddah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDAH, ErrNoMatch)
+	}
+	return a.emit(LDADDAH, 0, 13590, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDAL_WW_Ref encodes ldaddal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDAL, ErrNoMatch)
+	}
+	return a.emit(LDADDAL, 0, 13598, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDAL_XX_Ref encodes ldaddal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDAL, ErrNoMatch)
+	}
+	return a.emit(LDADDAL, 1, 13606, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDALB_WW_Ref encodes ldaddalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDALB, ErrNoMatch)
+	}
+	return a.emit(LDADDALB, 0, 13614, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDALH_WW_Ref encodes ldaddalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDALH, ErrNoMatch)
+	}
+	return a.emit(LDADDALH, 0, 13622, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDB_WW_Ref encodes ldaddb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDB, ErrNoMatch)
+	}
+	return a.emit(LDADDB, 0, 13630, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDH_WW_Ref encodes ldaddh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDH, ErrNoMatch)
+	}
+	return a.emit(LDADDH, 0, 13638, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDL_WW_Ref encodes ldaddl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDL, ErrNoMatch)
+	}
+	return a.emit(LDADDL, 0, 13646, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDL_XX_Ref encodes ldaddl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDL, ErrNoMatch)
+	}
+	return a.emit(LDADDL, 1, 13654, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDLB_WW_Ref encodes ldaddlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDLB, ErrNoMatch)
+	}
+	return a.emit(LDADDLB, 0, 13662, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDADDLH_WW_Ref encodes ldaddlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDADDLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDADDLH, ErrNoMatch)
+	}
+	return a.emit(LDADDLH, 0, 13670, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAPR_W_Ref encodes ldapr Wd, [Xn|SP].
+// Requires FEAT_LRCPC.
+func (a *Assembler) LDAPR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPR, ErrNoMatch)
+	}
+	return a.emit(LDAPR, 0, 13678, FeatLRCPC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAPR_X_Ref encodes ldapr Xd, [Xn|SP].
+// Requires FEAT_LRCPC.
+func (a *Assembler) LDAPR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPR, ErrNoMatch)
+	}
+	return a.emit(LDAPR, 1, 13685, FeatLRCPC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAPRB_W_Ref encodes ldaprb Wd, [Xn|SP].
+// Requires FEAT_LRCPC.
+func (a *Assembler) LDAPRB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPRB, ErrNoMatch)
+	}
+	return a.emit(LDAPRB, 0, 13692, FeatLRCPC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAPRH_W_Ref encodes ldaprh Wd, [Xn|SP].
+// Requires FEAT_LRCPC.
+func (a *Assembler) LDAPRH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPRH, ErrNoMatch)
+	}
+	return a.emit(LDAPRH, 0, 13699, FeatLRCPC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAPUR_W_RefOffset encodes ldapur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPUR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPUR, ErrNoMatch)
+	}
+	return a.emit(LDAPUR, 0, 13706, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPUR_X_RefOffset encodes ldapur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPUR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPUR, ErrNoMatch)
+	}
+	return a.emit(LDAPUR, 1, 13714, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPURB_W_RefOffset encodes ldapurb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPURB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPURB, ErrNoMatch)
+	}
+	return a.emit(LDAPURB, 0, 13722, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPURH_W_RefOffset encodes ldapurh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPURH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPURH, ErrNoMatch)
+	}
+	return a.emit(LDAPURH, 0, 13730, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPURSB_W_RefOffset encodes ldapursb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPURSB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPURSB, ErrNoMatch)
+	}
+	return a.emit(LDAPURSB, 0, 13738, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPURSB_X_RefOffset encodes ldapursb Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPURSB_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPURSB, ErrNoMatch)
+	}
+	return a.emit(LDAPURSB, 1, 13746, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPURSH_W_RefOffset encodes ldapursh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPURSH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPURSH, ErrNoMatch)
+	}
+	return a.emit(LDAPURSH, 0, 13754, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPURSH_X_RefOffset encodes ldapursh Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPURSH_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPURSH, ErrNoMatch)
+	}
+	return a.emit(LDAPURSH, 1, 13762, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAPURSW_X_RefOffset encodes ldapursw Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) LDAPURSW_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAPURSW, ErrNoMatch)
+	}
+	return a.emit(LDAPURSW, 0, 13770, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDAR_W_Ref encodes ldar Wd, [Xn|SP].
+func (a *Assembler) LDAR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAR, ErrNoMatch)
+	}
+	return a.emit(LDAR, 0, 13778, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAR_X_Ref encodes ldar Xd, [Xn|SP].
+func (a *Assembler) LDAR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAR, ErrNoMatch)
+	}
+	return a.emit(LDAR, 1, 13785, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDARB_W_Ref encodes ldarb Wd, [Xn|SP].
+func (a *Assembler) LDARB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDARB, ErrNoMatch)
+	}
+	return a.emit(LDARB, 0, 13792, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDARH_W_Ref encodes ldarh Wd, [Xn|SP].
+func (a *Assembler) LDARH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDARH, ErrNoMatch)
+	}
+	return a.emit(LDARH, 0, 13799, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAXP_WW_Ref encodes ldaxp Wd, Wn, [Xm|SP].
+func (a *Assembler) LDAXP_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDAXP, ErrNoMatch)
+	}
+	return a.emit(LDAXP, 0, 13806, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAXP_XX_Ref encodes ldaxp Xd, Xn, [Xm|SP].
+func (a *Assembler) LDAXP_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDAXP, ErrNoMatch)
+	}
+	return a.emit(LDAXP, 1, 13814, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAXR_W_Ref encodes ldaxr Wd, [Xn|SP].
+func (a *Assembler) LDAXR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAXR, ErrNoMatch)
+	}
+	return a.emit(LDAXR, 0, 13822, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAXR_X_Ref encodes ldaxr Xd, [Xn|SP].
+func (a *Assembler) LDAXR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAXR, ErrNoMatch)
+	}
+	return a.emit(LDAXR, 1, 13829, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAXRB_W_Ref encodes ldaxrb Wd, [Xn|SP].
+func (a *Assembler) LDAXRB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAXRB, ErrNoMatch)
+	}
+	return a.emit(LDAXRB, 0, 13836, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDAXRH_W_Ref encodes ldaxrh Wd, [Xn|SP].
+func (a *Assembler) LDAXRH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDAXRH, ErrNoMatch)
+	}
+	return a.emit(LDAXRH, 0, 13843, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLR_WW_Ref encodes ldclr Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLR_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLR, ErrNoMatch)
+	}
+	return a.emit(LDCLR, 0, 13850, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLR_XX_Ref encodes ldclr Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLR_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLR, ErrNoMatch)
+	}
+	return a.emit(LDCLR, 1, 13858, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRA_WW_Ref encodes ldclra Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRA, ErrNoMatch)
+	}
+	return a.emit(LDCLRA, 0, 13866, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRA_XX_Ref encodes ldclra Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRA, ErrNoMatch)
+	}
+	return a.emit(LDCLRA, 1, 13874, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRAB_WW_Ref encodes ldclrab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRAB, ErrNoMatch)
+	}
+	return a.emit(LDCLRAB, 0, 13882, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRAH_WW_Ref encodes ldclrah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRAH, ErrNoMatch)
+	}
+	return a.emit(LDCLRAH, 0, 13890, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRAL_WW_Ref encodes ldclral Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRAL, ErrNoMatch)
+	}
+	return a.emit(LDCLRAL, 0, 13898, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRAL_XX_Ref encodes ldclral Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRAL, ErrNoMatch)
+	}
+	return a.emit(LDCLRAL, 1, 13906, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRALB_WW_Ref encodes ldclralb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRALB, ErrNoMatch)
+	}
+	return a.emit(LDCLRALB, 0, 13914, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRALH_WW_Ref encodes ldclralh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRALH, ErrNoMatch)
+	}
+	return a.emit(LDCLRALH, 0, 13922, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRB_WW_Ref encodes ldclrb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRB, ErrNoMatch)
+	}
+	return a.emit(LDCLRB, 0, 13930, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRH_WW_Ref encodes ldclrh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRH, ErrNoMatch)
+	}
+	return a.emit(LDCLRH, 0, 13938, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRL_WW_Ref encodes ldclrl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRL, ErrNoMatch)
+	}
+	return a.emit(LDCLRL, 0, 13946, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRL_XX_Ref encodes ldclrl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRL, ErrNoMatch)
+	}
+	return a.emit(LDCLRL, 1, 13954, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRLB_WW_Ref encodes ldclrlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRLB, ErrNoMatch)
+	}
+	return a.emit(LDCLRLB, 0, 13962, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDCLRLH_WW_Ref encodes ldclrlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDCLRLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDCLRLH, ErrNoMatch)
+	}
+	return a.emit(LDCLRLH, 0, 13970, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEOR_WW_Ref encodes ldeor Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEOR_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEOR, ErrNoMatch)
+	}
+	return a.emit(LDEOR, 0, 13978, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEOR_XX_Ref encodes ldeor Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEOR_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEOR, ErrNoMatch)
+	}
+	return a.emit(LDEOR, 1, 13986, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORA_WW_Ref encodes ldeora Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORA, ErrNoMatch)
+	}
+	return a.emit(LDEORA, 0, 13994, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORA_XX_Ref encodes ldeora Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORA, ErrNoMatch)
+	}
+	return a.emit(LDEORA, 1, 14002, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORAB_WW_Ref encodes ldeorab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORAB, ErrNoMatch)
+	}
+	return a.emit(LDEORAB, 0, 14010, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORAH_WW_Ref encodes ldeorah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORAH, ErrNoMatch)
+	}
+	return a.emit(LDEORAH, 0, 14018, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORAL_WW_Ref encodes ldeoral Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORAL, ErrNoMatch)
+	}
+	return a.emit(LDEORAL, 0, 14026, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORAL_XX_Ref encodes ldeoral Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORAL, ErrNoMatch)
+	}
+	return a.emit(LDEORAL, 1, 14034, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORALB_WW_Ref encodes ldeoralb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORALB, ErrNoMatch)
+	}
+	return a.emit(LDEORALB, 0, 14042, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORALH_WW_Ref encodes ldeoralh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORALH, ErrNoMatch)
+	}
+	return a.emit(LDEORALH, 0, 14050, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORB_WW_Ref encodes ldeorb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORB, ErrNoMatch)
+	}
+	return a.emit(LDEORB, 0, 14058, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORH_WW_Ref encodes ldeorh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORH, ErrNoMatch)
+	}
+	return a.emit(LDEORH, 0, 14066, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORL_WW_Ref encodes ldeorl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORL, ErrNoMatch)
+	}
+	return a.emit(LDEORL, 0, 14074, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORL_XX_Ref encodes ldeorl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORL, ErrNoMatch)
+	}
+	return a.emit(LDEORL, 1, 14082, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORLB_WW_Ref encodes ldeorlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORLB, ErrNoMatch)
+	}
+	return a.emit(LDEORLB, 0, 14090, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDEORLH_WW_Ref encodes ldeorlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDEORLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDEORLH, ErrNoMatch)
+	}
+	return a.emit(LDEORLH, 0, 14098, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDG_X_RefOffset encodes ldg Xd, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) LDG_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDG, ErrNoMatch)
+	}
+	return a.emit(LDG, 0, 14154, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDLAR_W_Ref encodes ldlar Wd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) LDLAR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDLAR, ErrNoMatch)
+	}
+	return a.emit(LDLAR, 0, 14163, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDLAR_X_Ref encodes ldlar Xd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) LDLAR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDLAR, ErrNoMatch)
+	}
+	return a.emit(LDLAR, 1, 14170, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDLARB_W_Ref encodes ldlarb Wd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) LDLARB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDLARB, ErrNoMatch)
+	}
+	return a.emit(LDLARB, 0, 14177, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDLARH_W_Ref encodes ldlarh Wd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) LDLARH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDLARH, ErrNoMatch)
+	}
+	return a.emit(LDLARH, 0, 14184, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDNP_SS_RefOffset encodes ldnp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDNP_SS_RefOffset(rd, rn SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDNP, ErrNoMatch)
+	}
+	return a.emit(LDNP, 0, 14191, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDNP_DD_RefOffset encodes ldnp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDNP_DD_RefOffset(rd, rn DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDNP, ErrNoMatch)
+	}
+	return a.emit(LDNP, 1, 14201, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDNP_QQ_RefOffset encodes ldnp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) LDNP_QQ_RefOffset(rd, rn QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDNP, ErrNoMatch)
+	}
+	return a.emit(LDNP, 2, 14211, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDNP_WW_RefOffset encodes ldnp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDNP_WW_RefOffset(rd, rn WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDNP, ErrNoMatch)
+	}
+	return a.emit(LDNP, 3, 14221, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDNP_XX_RefOffset encodes ldnp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDNP_XX_RefOffset(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDNP, ErrNoMatch)
+	}
+	return a.emit(LDNP, 4, 14231, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_SS_Ref_Imm encodes ldp Sd, Sn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDP_SS_Ref_Imm(rd, rn SReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 0, 14241, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDP_DD_Ref_Imm encodes ldp Dd, Dn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDP_DD_Ref_Imm(rd, rn DReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 1, 14251, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDP_QQ_Ref_Imm encodes ldp Qd, Qn, [Xm|SP], #imm (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) LDP_QQ_Ref_Imm(rd, rn QReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 2, 14261, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDP_SS_RefPre encodes ldp Sd, Sn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDP_SS_RefPre(rd, rn SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 3, 14271, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_DD_RefPre encodes ldp Dd, Dn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDP_DD_RefPre(rd, rn DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 4, 14281, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_QQ_RefPre encodes ldp Qd, Qn, [Xm|SP, #imm]! (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) LDP_QQ_RefPre(rd, rn QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 5, 14291, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_SS_RefOffset encodes ldp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDP_SS_RefOffset(rd, rn SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 6, 14301, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_DD_RefOffset encodes ldp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDP_DD_RefOffset(rd, rn DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 7, 14311, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_QQ_RefOffset encodes ldp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) LDP_QQ_RefOffset(rd, rn QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 8, 14321, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_WW_Ref_Imm encodes ldp Wd, Wn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDP_WW_Ref_Imm(rd, rn WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 9, 14331, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDP_XX_Ref_Imm encodes ldp Xd, Xn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDP_XX_Ref_Imm(rd, rn XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 10, 14341, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDP_WW_RefPre encodes ldp Wd, Wn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDP_WW_RefPre(rd, rn WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 11, 14351, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_XX_RefPre encodes ldp Xd, Xn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDP_XX_RefPre(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 12, 14361, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_WW_RefOffset encodes ldp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDP_WW_RefOffset(rd, rn WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 13, 14371, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDP_XX_RefOffset encodes ldp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) LDP_XX_RefOffset(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDP, ErrNoMatch)
+	}
+	return a.emit(LDP, 14, 14381, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDPSW_XX_Ref_Imm encodes ldpsw Xd, Xn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDPSW_XX_Ref_Imm(rd, rn XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDPSW, ErrNoMatch)
+	}
+	return a.emit(LDPSW, 0, 14391, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDPSW_XX_RefPre encodes ldpsw Xd, Xn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDPSW_XX_RefPre(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDPSW, ErrNoMatch)
+	}
+	return a.emit(LDPSW, 1, 14401, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDPSW_XX_RefOffset encodes ldpsw Xd, Xn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) LDPSW_XX_RefOffset(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDPSW, ErrNoMatch)
+	}
+	return a.emit(LDPSW, 2, 14411, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_B_Ref_Imm encodes ldr Bd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDR_B_Ref_Imm(rd BReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 0, 14421, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDR_H_Ref_Imm encodes ldr Hd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDR_H_Ref_Imm(rd HReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 1, 14429, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDR_S_Ref_Imm encodes ldr Sd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDR_S_Ref_Imm(rd SReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 2, 14437, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDR_D_Ref_Imm encodes ldr Dd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDR_D_Ref_Imm(rd DReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 3, 14445, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDR_Q_Ref_Imm encodes ldr Qd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDR_Q_Ref_Imm(rd QReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 4, 14453, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDR_B_RefPre encodes ldr Bd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDR_B_RefPre(rd BReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 5, 14461, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_H_RefPre encodes ldr Hd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDR_H_RefPre(rd HReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 6, 14469, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_S_RefPre encodes ldr Sd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDR_S_RefPre(rd SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 7, 14477, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_D_RefPre encodes ldr Dd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDR_D_RefPre(rd DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 8, 14485, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_Q_RefPre encodes ldr Qd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDR_Q_RefPre(rd QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 9, 14493, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_B_RefOffset encodes ldr Bd, [Xn|SP {, #imm }] (0 <= imm < 4096).
+func (a *Assembler) LDR_B_RefOffset(rd BReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 10, 14501, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_H_RefOffset encodes ldr Hd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1).
+func (a *Assembler) LDR_H_RefOffset(rd HReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 11, 14511, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_S_RefOffset encodes ldr Sd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2).
+func (a *Assembler) LDR_S_RefOffset(rd SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 12, 14522, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_D_RefOffset encodes ldr Dd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3).
+func (a *Assembler) LDR_D_RefOffset(rd DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 13, 14533, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_Q_RefOffset encodes ldr Qd, [Xn|SP {, #imm }] (0 <= imm < 65536, imm >> 4).
+func (a *Assembler) LDR_Q_RefOffset(rd QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 14, 14544, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_W_Ref_Imm encodes ldr Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDR_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 15, 14555, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDR_X_Ref_Imm encodes ldr Xd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDR_X_Ref_Imm(rd XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 16, 14563, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDR_W_RefPre encodes ldr Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDR_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 17, 14571, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_X_RefPre encodes ldr Xd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDR_X_RefPre(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 18, 14579, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_W_RefOffset encodes ldr Wd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2).
+func (a *Assembler) LDR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 19, 14587, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_X_RefOffset encodes ldr Xd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3).
+func (a *Assembler) LDR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 20, 14598, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDR_S_Label encodes ldr Sd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) LDR_S_Label(rd SReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 21, 14609, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// LDR_D_Label encodes ldr Dd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) LDR_D_Label(rd DReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 22, 14617, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// LDR_Q_Label encodes ldr Qd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) LDR_Q_Label(rd QReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 23, 14625, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// LDR_W_Label encodes ldr Wd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) LDR_W_Label(rd WReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 24, 14633, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// LDR_X_Label encodes ldr Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) LDR_X_Label(rd XReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(LDR, ErrNoMatch)
+	}
+	return a.emit(LDR, 25, 14641, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// LDRAA_X_RefOffset encodes ldraa Xd, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 3).
+// Requires FEAT_PAuth.
+func (a *Assembler) LDRAA_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRAA, ErrNoMatch)
+	}
+	return a.emit(LDRAA, 0, 14784, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRAA_X_RefPre encodes ldraa Xd, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 3).
+// Requires FEAT_PAuth.
+func (a *Assembler) LDRAA_X_RefPre(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRAA, ErrNoMatch)
+	}
+	return a.emit(LDRAA, 1, 14801, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRAB_X_RefOffset encodes ldrab Xd, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 3).
+// Requires FEAT_PAuth.
+func (a *Assembler) LDRAB_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRAB, ErrNoMatch)
+	}
+	return a.emit(LDRAB, 0, 14818, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRAB_X_RefPre encodes ldrab Xd, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 3).
+// Requires FEAT_PAuth.
+func (a *Assembler) LDRAB_X_RefPre(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRAB, ErrNoMatch)
+	}
+	return a.emit(LDRAB, 1, 14835, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRB_W_Ref_Imm encodes ldrb Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDRB_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRB, ErrNoMatch)
+	}
+	return a.emit(LDRB, 0, 14852, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDRB_W_RefPre encodes ldrb Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDRB_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRB, ErrNoMatch)
+	}
+	return a.emit(LDRB, 1, 14860, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRB_W_RefOffset encodes ldrb Wd, [Xn|SP {, #imm }] (0 <= imm < 4096).
+func (a *Assembler) LDRB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRB, ErrNoMatch)
+	}
+	return a.emit(LDRB, 2, 14868, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRH_W_Ref_Imm encodes ldrh Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDRH_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRH, ErrNoMatch)
+	}
+	return a.emit(LDRH, 0, 14890, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDRH_W_RefPre encodes ldrh Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDRH_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRH, ErrNoMatch)
+	}
+	return a.emit(LDRH, 1, 14898, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRH_W_RefOffset encodes ldrh Wd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1).
+func (a *Assembler) LDRH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRH, ErrNoMatch)
+	}
+	return a.emit(LDRH, 2, 14906, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSB_W_Ref_Imm encodes ldrsb Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDRSB_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSB, ErrNoMatch)
+	}
+	return a.emit(LDRSB, 0, 14929, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDRSB_X_Ref_Imm encodes ldrsb Xd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDRSB_X_Ref_Imm(rd XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSB, ErrNoMatch)
+	}
+	return a.emit(LDRSB, 1, 14937, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDRSB_W_RefPre encodes ldrsb Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDRSB_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSB, ErrNoMatch)
+	}
+	return a.emit(LDRSB, 2, 14945, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSB_X_RefPre encodes ldrsb Xd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDRSB_X_RefPre(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSB, ErrNoMatch)
+	}
+	return a.emit(LDRSB, 3, 14953, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSB_W_RefOffset encodes ldrsb Wd, [Xn|SP {, #imm }] (0 <= imm < 4096).
+func (a *Assembler) LDRSB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSB, ErrNoMatch)
+	}
+	return a.emit(LDRSB, 4, 14961, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSB_X_RefOffset encodes ldrsb Xd, [Xn|SP {, #imm }] (0 <= imm < 4096).
+func (a *Assembler) LDRSB_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSB, ErrNoMatch)
+	}
+	return a.emit(LDRSB, 5, 14971, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSH_W_Ref_Imm encodes ldrsh Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDRSH_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSH, ErrNoMatch)
+	}
+	return a.emit(LDRSH, 0, 15005, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDRSH_X_Ref_Imm encodes ldrsh Xd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDRSH_X_Ref_Imm(rd XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSH, ErrNoMatch)
+	}
+	return a.emit(LDRSH, 1, 15013, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDRSH_W_RefPre encodes ldrsh Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDRSH_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSH, ErrNoMatch)
+	}
+	return a.emit(LDRSH, 2, 15021, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSH_X_RefPre encodes ldrsh Xd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDRSH_X_RefPre(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSH, ErrNoMatch)
+	}
+	return a.emit(LDRSH, 3, 15029, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSH_W_RefOffset encodes ldrsh Wd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1).
+func (a *Assembler) LDRSH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSH, ErrNoMatch)
+	}
+	return a.emit(LDRSH, 4, 15037, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSH_X_RefOffset encodes ldrsh Xd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1).
+func (a *Assembler) LDRSH_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSH, ErrNoMatch)
+	}
+	return a.emit(LDRSH, 5, 15048, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSW_X_Ref_Imm encodes ldrsw Xd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) LDRSW_X_Ref_Imm(rd XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSW, ErrNoMatch)
+	}
+	return a.emit(LDRSW, 0, 15083, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LDRSW_X_RefPre encodes ldrsw Xd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) LDRSW_X_RefPre(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSW, ErrNoMatch)
+	}
+	return a.emit(LDRSW, 1, 15091, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSW_X_RefOffset encodes ldrsw Xd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2).
+func (a *Assembler) LDRSW_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDRSW, ErrNoMatch)
+	}
+	return a.emit(LDRSW, 2, 15099, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDRSW_X_Label encodes ldrsw Xd, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) LDRSW_X_Label(rd XReg, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(LDRSW, ErrNoMatch)
+	}
+	return a.emit(LDRSW, 3, 15110, 0, 0, 0, Flat{FlatReg, uint64(rd)}, flatLabel(label))
+}
+
+// LDSET_WW_Ref encodes ldset Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSET_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSET, ErrNoMatch)
+	}
+	return a.emit(LDSET, 0, 15130, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSET_XX_Ref encodes ldset Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSET_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSET, ErrNoMatch)
+	}
+	return a.emit(LDSET, 1, 15138, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETA_WW_Ref encodes ldseta Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETA, ErrNoMatch)
+	}
+	return a.emit(LDSETA, 0, 15146, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETA_XX_Ref encodes ldseta Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETA, ErrNoMatch)
+	}
+	return a.emit(LDSETA, 1, 15154, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETAB_WW_Ref encodes ldsetab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETAB, ErrNoMatch)
+	}
+	return a.emit(LDSETAB, 0, 15162, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETAH_WW_Ref encodes ldsetah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETAH, ErrNoMatch)
+	}
+	return a.emit(LDSETAH, 0, 15170, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETAL_WW_Ref encodes ldsetal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETAL, ErrNoMatch)
+	}
+	return a.emit(LDSETAL, 0, 15178, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETAL_XX_Ref encodes ldsetal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETAL, ErrNoMatch)
+	}
+	return a.emit(LDSETAL, 1, 15186, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETALB_WW_Ref encodes ldsetalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETALB, ErrNoMatch)
+	}
+	return a.emit(LDSETALB, 0, 15194, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETALH_WW_Ref encodes ldsetalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETALH, ErrNoMatch)
+	}
+	return a.emit(LDSETALH, 0, 15202, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETB_WW_Ref encodes ldsetb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETB, ErrNoMatch)
+	}
+	return a.emit(LDSETB, 0, 15210, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETH_WW_Ref encodes ldseth Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETH, ErrNoMatch)
+	}
+	return a.emit(LDSETH, 0, 15218, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETL_WW_Ref encodes ldsetl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETL, ErrNoMatch)
+	}
+	return a.emit(LDSETL, 0, 15226, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETL_XX_Ref encodes ldsetl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETL, ErrNoMatch)
+	}
+	return a.emit(LDSETL, 1, 15234, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETLB_WW_Ref encodes ldsetlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETLB, ErrNoMatch)
+	}
+	return a.emit(LDSETLB, 0, 15242, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSETLH_WW_Ref encodes ldsetlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSETLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSETLH, ErrNoMatch)
+	}
+	return a.emit(LDSETLH, 0, 15250, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAX_WW_Ref encodes ldsmax Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAX_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAX, ErrNoMatch)
+	}
+	return a.emit(LDSMAX, 0, 15258, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAX_XX_Ref encodes ldsmax Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAX_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAX, ErrNoMatch)
+	}
+	return a.emit(LDSMAX, 1, 15266, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXA_WW_Ref encodes ldsmaxa Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXA, ErrNoMatch)
+	}
+	return a.emit(LDSMAXA, 0, 15274, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXA_XX_Ref encodes ldsmaxa Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXA, ErrNoMatch)
+	}
+	return a.emit(LDSMAXA, 1, 15282, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXAB_WW_Ref encodes ldsmaxab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXAB, ErrNoMatch)
+	}
+	return a.emit(LDSMAXAB, 0, 15290, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXAH_WW_Ref encodes ldsmaxah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXAH, ErrNoMatch)
+	}
+	return a.emit(LDSMAXAH, 0, 15298, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXAL_WW_Ref encodes ldsmaxal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXAL, ErrNoMatch)
+	}
+	return a.emit(LDSMAXAL, 0, 15306, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXAL_XX_Ref encodes ldsmaxal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXAL, ErrNoMatch)
+	}
+	return a.emit(LDSMAXAL, 1, 15314, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXALB_WW_Ref encodes ldsmaxalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXALB, ErrNoMatch)
+	}
+	return a.emit(LDSMAXALB, 0, 15322, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXALH_WW_Ref encodes ldsmaxalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXALH, ErrNoMatch)
+	}
+	return a.emit(LDSMAXALH, 0, 15330, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXB_WW_Ref encodes ldsmaxb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXB, ErrNoMatch)
+	}
+	return a.emit(LDSMAXB, 0, 15338, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXH_WW_Ref encodes ldsmaxh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXH, ErrNoMatch)
+	}
+	return a.emit(LDSMAXH, 0, 15346, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXL_WW_Ref encodes ldsmaxl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXL, ErrNoMatch)
+	}
+	return a.emit(LDSMAXL, 0, 15354, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXL_XX_Ref encodes ldsmaxl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXL, ErrNoMatch)
+	}
+	return a.emit(LDSMAXL, 1, 15362, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXLB_WW_Ref encodes ldsmaxlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXLB, ErrNoMatch)
+	}
+	return a.emit(LDSMAXLB, 0, 15370, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMAXLH_WW_Ref encodes ldsmaxlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMAXLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMAXLH, ErrNoMatch)
+	}
+	return a.emit(LDSMAXLH, 0, 15378, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMIN_WW_Ref encodes ldsmin Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMIN_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMIN, ErrNoMatch)
+	}
+	return a.emit(LDSMIN, 0, 15386, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMIN_XX_Ref encodes ldsmin Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMIN_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMIN, ErrNoMatch)
+	}
+	return a.emit(LDSMIN, 1, 15394, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINA_WW_Ref encodes ldsmina Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINA, ErrNoMatch)
+	}
+	return a.emit(LDSMINA, 0, 15402, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINA_XX_Ref encodes ldsmina Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINA, ErrNoMatch)
+	}
+	return a.emit(LDSMINA, 1, 15410, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINAB_WW_Ref encodes ldsminab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINAB, ErrNoMatch)
+	}
+	return a.emit(LDSMINAB, 0, 15418, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINAH_WW_Ref encodes ldsminah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINAH, ErrNoMatch)
+	}
+	return a.emit(LDSMINAH, 0, 15426, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINAL_WW_Ref encodes ldsminal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINAL, ErrNoMatch)
+	}
+	return a.emit(LDSMINAL, 0, 15434, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINAL_XX_Ref encodes ldsminal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINAL, ErrNoMatch)
+	}
+	return a.emit(LDSMINAL, 1, 15442, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINALB_WW_Ref encodes ldsminalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINALB, ErrNoMatch)
+	}
+	return a.emit(LDSMINALB, 0, 15450, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINALH_WW_Ref encodes ldsminalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINALH, ErrNoMatch)
+	}
+	return a.emit(LDSMINALH, 0, 15458, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINB_WW_Ref encodes ldsminb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINB, ErrNoMatch)
+	}
+	return a.emit(LDSMINB, 0, 15466, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINH_WW_Ref encodes ldsminh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINH, ErrNoMatch)
+	}
+	return a.emit(LDSMINH, 0, 15474, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINL_WW_Ref encodes ldsminl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINL, ErrNoMatch)
+	}
+	return a.emit(LDSMINL, 0, 15482, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINL_XX_Ref encodes ldsminl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINL, ErrNoMatch)
+	}
+	return a.emit(LDSMINL, 1, 15490, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINLB_WW_Ref encodes ldsminlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINLB, ErrNoMatch)
+	}
+	return a.emit(LDSMINLB, 0, 15498, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDSMINLH_WW_Ref encodes ldsminlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDSMINLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDSMINLH, ErrNoMatch)
+	}
+	return a.emit(LDSMINLH, 0, 15506, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDTR_W_RefOffset encodes ldtr Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTR, ErrNoMatch)
+	}
+	return a.emit(LDTR, 0, 15514, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTR_X_RefOffset encodes ldtr Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTR, ErrNoMatch)
+	}
+	return a.emit(LDTR, 1, 15522, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTRB_W_RefOffset encodes ldtrb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTRB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTRB, ErrNoMatch)
+	}
+	return a.emit(LDTRB, 0, 15530, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTRH_W_RefOffset encodes ldtrh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTRH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTRH, ErrNoMatch)
+	}
+	return a.emit(LDTRH, 0, 15538, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTRSB_W_RefOffset encodes ldtrsb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTRSB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTRSB, ErrNoMatch)
+	}
+	return a.emit(LDTRSB, 0, 15546, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTRSB_X_RefOffset encodes ldtrsb Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTRSB_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTRSB, ErrNoMatch)
+	}
+	return a.emit(LDTRSB, 1, 15554, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTRSH_W_RefOffset encodes ldtrsh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTRSH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTRSH, ErrNoMatch)
+	}
+	return a.emit(LDTRSH, 0, 15562, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTRSH_X_RefOffset encodes ldtrsh Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTRSH_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTRSH, ErrNoMatch)
+	}
+	return a.emit(LDTRSH, 1, 15570, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDTRSW_X_RefOffset encodes ldtrsw Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDTRSW_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDTRSW, ErrNoMatch)
+	}
+	return a.emit(LDTRSW, 0, 15578, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDUMAX_WW_Ref encodes ldumax Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAX_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAX, ErrNoMatch)
+	}
+	return a.emit(LDUMAX, 0, 15586, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAX_XX_Ref encodes ldumax Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAX_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAX, ErrNoMatch)
+	}
+	return a.emit(LDUMAX, 1, 15594, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXA_WW_Ref encodes ldumaxa Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXA, ErrNoMatch)
+	}
+	return a.emit(LDUMAXA, 0, 15602, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXA_XX_Ref encodes ldumaxa Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXA, ErrNoMatch)
+	}
+	return a.emit(LDUMAXA, 1, 15610, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXAB_WW_Ref encodes ldumaxab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXAB, ErrNoMatch)
+	}
+	return a.emit(LDUMAXAB, 0, 15618, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXAH_WW_Ref encodes ldumaxah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXAH, ErrNoMatch)
+	}
+	return a.emit(LDUMAXAH, 0, 15626, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXAL_WW_Ref encodes ldumaxal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXAL, ErrNoMatch)
+	}
+	return a.emit(LDUMAXAL, 0, 15634, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXAL_XX_Ref encodes ldumaxal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXAL, ErrNoMatch)
+	}
+	return a.emit(LDUMAXAL, 1, 15642, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXALB_WW_Ref encodes ldumaxalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXALB, ErrNoMatch)
+	}
+	return a.emit(LDUMAXALB, 0, 15650, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXALH_WW_Ref encodes ldumaxalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXALH, ErrNoMatch)
+	}
+	return a.emit(LDUMAXALH, 0, 15658, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXB_WW_Ref encodes ldumaxb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXB, ErrNoMatch)
+	}
+	return a.emit(LDUMAXB, 0, 15666, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXH_WW_Ref encodes ldumaxh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXH, ErrNoMatch)
+	}
+	return a.emit(LDUMAXH, 0, 15674, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXL_WW_Ref encodes ldumaxl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXL, ErrNoMatch)
+	}
+	return a.emit(LDUMAXL, 0, 15682, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXL_XX_Ref encodes ldumaxl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXL, ErrNoMatch)
+	}
+	return a.emit(LDUMAXL, 1, 15690, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXLB_WW_Ref encodes ldumaxlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXLB, ErrNoMatch)
+	}
+	return a.emit(LDUMAXLB, 0, 15698, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMAXLH_WW_Ref encodes ldumaxlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMAXLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMAXLH, ErrNoMatch)
+	}
+	return a.emit(LDUMAXLH, 0, 15706, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMIN_WW_Ref encodes ldumin Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMIN_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMIN, ErrNoMatch)
+	}
+	return a.emit(LDUMIN, 0, 15714, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMIN_XX_Ref encodes ldumin Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMIN_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMIN, ErrNoMatch)
+	}
+	return a.emit(LDUMIN, 1, 15722, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINA_WW_Ref encodes ldumina Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINA, ErrNoMatch)
+	}
+	return a.emit(LDUMINA, 0, 15730, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINA_XX_Ref encodes ldumina Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINA, ErrNoMatch)
+	}
+	return a.emit(LDUMINA, 1, 15738, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINAB_WW_Ref encodes lduminab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINAB, ErrNoMatch)
+	}
+	return a.emit(LDUMINAB, 0, 15746, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINAH_WW_Ref encodes lduminah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINAH, ErrNoMatch)
+	}
+	return a.emit(LDUMINAH, 0, 15754, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINAL_WW_Ref encodes lduminal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINAL, ErrNoMatch)
+	}
+	return a.emit(LDUMINAL, 0, 15762, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINAL_XX_Ref encodes lduminal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINAL, ErrNoMatch)
+	}
+	return a.emit(LDUMINAL, 1, 15770, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINALB_WW_Ref encodes lduminalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINALB, ErrNoMatch)
+	}
+	return a.emit(LDUMINALB, 0, 15778, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINALH_WW_Ref encodes lduminalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINALH, ErrNoMatch)
+	}
+	return a.emit(LDUMINALH, 0, 15786, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINB_WW_Ref encodes lduminb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINB, ErrNoMatch)
+	}
+	return a.emit(LDUMINB, 0, 15794, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINH_WW_Ref encodes lduminh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINH, ErrNoMatch)
+	}
+	return a.emit(LDUMINH, 0, 15802, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINL_WW_Ref encodes lduminl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINL, ErrNoMatch)
+	}
+	return a.emit(LDUMINL, 0, 15810, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINL_XX_Ref encodes lduminl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINL, ErrNoMatch)
+	}
+	return a.emit(LDUMINL, 1, 15818, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINLB_WW_Ref encodes lduminlb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINLB, ErrNoMatch)
+	}
+	return a.emit(LDUMINLB, 0, 15826, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUMINLH_WW_Ref encodes lduminlh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) LDUMINLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDUMINLH, ErrNoMatch)
+	}
+	return a.emit(LDUMINLH, 0, 15834, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDUR_B_RefOffset encodes ldur Bd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDUR_B_RefOffset(rd BReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDUR, ErrNoMatch)
+	}
+	return a.emit(LDUR, 0, 15842, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDUR_H_RefOffset encodes ldur Hd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDUR_H_RefOffset(rd HReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDUR, ErrNoMatch)
+	}
+	return a.emit(LDUR, 1, 15850, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDUR_S_RefOffset encodes ldur Sd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDUR_S_RefOffset(rd SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDUR, ErrNoMatch)
+	}
+	return a.emit(LDUR, 2, 15858, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDUR_D_RefOffset encodes ldur Dd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDUR_D_RefOffset(rd DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDUR, ErrNoMatch)
+	}
+	return a.emit(LDUR, 3, 15866, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDUR_Q_RefOffset encodes ldur Qd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDUR_Q_RefOffset(rd QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDUR, ErrNoMatch)
+	}
+	return a.emit(LDUR, 4, 15874, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDUR_W_RefOffset encodes ldur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDUR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDUR, ErrNoMatch)
+	}
+	return a.emit(LDUR, 5, 15882, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDUR_X_RefOffset encodes ldur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDUR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDUR, ErrNoMatch)
+	}
+	return a.emit(LDUR, 6, 15890, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDURB_W_RefOffset encodes ldurb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDURB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDURB, ErrNoMatch)
+	}
+	return a.emit(LDURB, 0, 15898, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDURH_W_RefOffset encodes ldurh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDURH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDURH, ErrNoMatch)
+	}
+	return a.emit(LDURH, 0, 15906, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDURSB_W_RefOffset encodes ldursb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDURSB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDURSB, ErrNoMatch)
+	}
+	return a.emit(LDURSB, 0, 15914, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDURSB_X_RefOffset encodes ldursb Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDURSB_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDURSB, ErrNoMatch)
+	}
+	return a.emit(LDURSB, 1, 15922, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDURSH_W_RefOffset encodes ldursh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDURSH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDURSH, ErrNoMatch)
+	}
+	return a.emit(LDURSH, 0, 15930, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDURSH_X_RefOffset encodes ldursh Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDURSH_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDURSH, ErrNoMatch)
+	}
+	return a.emit(LDURSH, 1, 15938, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDURSW_X_RefOffset encodes ldursw Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) LDURSW_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDURSW, ErrNoMatch)
+	}
+	return a.emit(LDURSW, 0, 15946, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// LDXP_WW_Ref encodes ldxp Wd, Wn, [Xm|SP].
+func (a *Assembler) LDXP_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDXP, ErrNoMatch)
+	}
+	return a.emit(LDXP, 0, 15954, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDXP_XX_Ref encodes ldxp Xd, Xn, [Xm|SP].
+func (a *Assembler) LDXP_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(LDXP, ErrNoMatch)
+	}
+	return a.emit(LDXP, 1, 15962, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDXR_W_Ref encodes ldxr Wd, [Xn|SP].
+func (a *Assembler) LDXR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDXR, ErrNoMatch)
+	}
+	return a.emit(LDXR, 0, 15970, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDXR_X_Ref encodes ldxr Xd, [Xn|SP].
+func (a *Assembler) LDXR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDXR, ErrNoMatch)
+	}
+	return a.emit(LDXR, 1, 15977, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDXRB_W_Ref encodes ldxrb Wd, [Xn|SP].
+func (a *Assembler) LDXRB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDXRB, ErrNoMatch)
+	}
+	return a.emit(LDXRB, 0, 15984, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LDXRH_W_Ref encodes ldxrh Wd, [Xn|SP].
+func (a *Assembler) LDXRH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(LDXRH, ErrNoMatch)
+	}
+	return a.emit(LDXRH, 0, 15991, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// LSL_WWW encodes lsl Wd, Wn, Wm.
+func (a *Assembler) LSL_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSL, ErrNoMatch)
+	}
+	return a.emit(LSL, 0, 15998, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// LSL_XXX encodes lsl Xd, Xn, Xm.
+func (a *Assembler) LSL_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSL, ErrNoMatch)
+	}
+	return a.emit(LSL, 1, 16006, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// LSL_WW_Imm encodes lsl Wd, Wn, #imm (0 <= imm < 32).
+func (a *Assembler) LSL_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(LSL, ErrNoMatch)
+	}
+	return a.emit(LSL, 2, 16014, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LSL_XX_Imm encodes lsl Xd, Xn, #imm (0 <= imm < 64).
+func (a *Assembler) LSL_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(LSL, ErrNoMatch)
+	}
+	return a.emit(LSL, 3, 16029, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LSLV_WWW encodes lslv Wd, Wn, Wm.
+func (a *Assembler) LSLV_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSLV, ErrNoMatch)
+	}
+	return a.emit(LSLV, 0, 16196, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// LSLV_XXX encodes lslv Xd, Xn, Xm.
+func (a *Assembler) LSLV_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSLV, ErrNoMatch)
+	}
+	return a.emit(LSLV, 1, 16204, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// LSR_WWW encodes lsr Wd, Wn, Wm.
+func (a *Assembler) LSR_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSR, ErrNoMatch)
+	}
+	return a.emit(LSR, 0, 16212, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// LSR_XXX encodes lsr Xd, Xn, Xm.
+func (a *Assembler) LSR_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSR, ErrNoMatch)
+	}
+	return a.emit(LSR, 1, 16220, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// LSR_WW_Imm encodes lsr Wd, Wn, #imm (0 <= imm < 32).
+func (a *Assembler) LSR_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(LSR, ErrNoMatch)
+	}
+	return a.emit(LSR, 2, 16228, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LSR_XX_Imm encodes lsr Xd, Xn, #imm (0 <= imm < 64).
+func (a *Assembler) LSR_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(LSR, ErrNoMatch)
+	}
+	return a.emit(LSR, 3, 16238, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// LSRV_WWW encodes lsrv Wd, Wn, Wm.
+func (a *Assembler) LSRV_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSRV, ErrNoMatch)
+	}
+	return a.emit(LSRV, 0, 16390, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// LSRV_XXX encodes lsrv Xd, Xn, Xm.
+func (a *Assembler) LSRV_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(LSRV, ErrNoMatch)
+	}
+	return a.emit(LSRV, 1, 16398, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MADD_WWWW encodes madd Wd, Wn, Wm, Wa.
+func (a *Assembler) MADD_WWWW(rd, rn, rm, ra WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(MADD, ErrNoMatch)
+	}
+	return a.emit(MADD, 0, 16406, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// MADD_XXXX encodes madd Xd, Xn, Xm, Xa.
+func (a *Assembler) MADD_XXXX(rd, rn, rm, ra XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(MADD, ErrNoMatch)
+	}
+	return a.emit(MADD, 1, 16415, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// MLA_V8HV8HVHi encodes mla Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) MLA_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 0, 16424, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLA_V4HV4HVHi encodes mla Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) MLA_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 0, 16424, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLA_V4SV4SVSi encodes mla Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) MLA_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 1, 16435, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLA_V2SV2SVSi encodes mla Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) MLA_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 1, 16435, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLA_V16BV16BV16B encodes mla Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) MLA_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 2, 16446, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLA_V8BV8BV8B encodes mla Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) MLA_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 2, 16446, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLA_V8HV8HV8H encodes mla Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) MLA_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 3, 16455, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLA_V4HV4HV4H encodes mla Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) MLA_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 3, 16455, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLA_V4SV4SV4S encodes mla Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) MLA_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 4, 16464, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLA_V2SV2SV2S encodes mla Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) MLA_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLA, ErrNoMatch)
+	}
+	return a.emit(MLA, 4, 16464, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLS_V8HV8HVHi encodes mls Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) MLS_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 0, 16473, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLS_V4HV4HVHi encodes mls Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) MLS_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 0, 16473, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLS_V4SV4SVSi encodes mls Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) MLS_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 1, 16484, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLS_V2SV2SVSi encodes mls Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) MLS_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 1, 16484, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MLS_V16BV16BV16B encodes mls Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) MLS_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 2, 16495, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLS_V8BV8BV8B encodes mls Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) MLS_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 2, 16495, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLS_V8HV8HV8H encodes mls Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) MLS_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 3, 16504, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLS_V4HV4HV4H encodes mls Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) MLS_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 3, 16504, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLS_V4SV4SV4S encodes mls Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) MLS_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 4, 16513, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MLS_V2SV2SV2S encodes mls Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) MLS_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MLS, ErrNoMatch)
+	}
+	return a.emit(MLS, 4, 16513, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MNEG_WWW encodes mneg Wd, Wn, Wm.
+func (a *Assembler) MNEG_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MNEG, ErrNoMatch)
+	}
+	return a.emit(MNEG, 0, 16522, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MNEG_XXX encodes mneg Xd, Xn, Xm.
+func (a *Assembler) MNEG_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MNEG, ErrNoMatch)
+	}
+	return a.emit(MNEG, 1, 16530, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MOV_WW encodes mov Wd, Wn.
+func (a *Assembler) MOV_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 0, 16538, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_XX encodes mov Xd, Xn.
+func (a *Assembler) MOV_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 1, 16545, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_WspWsp encodes mov Wd|WSP, Wn|WSP.
+func (a *Assembler) MOV_WspWsp(rd, rn WSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 2, 16552, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_XspXsp encodes mov Xd|SP, Xn|SP.
+func (a *Assembler) MOV_XspXsp(rd, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 3, 16559, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_BVBi encodes mov Bd, Vn.B[i].
+func (a *Assembler) MOV_BVBi(rd BReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 4, 16566, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MOV_HVHi encodes mov Hd, Vn.H[i].
+func (a *Assembler) MOV_HVHi(rd HReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 5, 16576, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MOV_SVSi encodes mov Sd, Vn.S[i].
+func (a *Assembler) MOV_SVSi(rd SReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 6, 16586, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MOV_DVDi encodes mov Dd, Vn.D[i].
+func (a *Assembler) MOV_DVDi(rd DReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 7, 16596, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MOV_VBiVBi encodes mov Vd.B[i], Vn.B[i].
+func (a *Assembler) MOV_VBiVBi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 || idx2 >= 16 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 8, 16606, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// MOV_VHiVHi encodes mov Vd.H[i], Vn.H[i].
+func (a *Assembler) MOV_VHiVHi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 || idx2 >= 8 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 9, 16619, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// MOV_VSiVSi encodes mov Vd.S[i], Vn.S[i].
+func (a *Assembler) MOV_VSiVSi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 || idx2 >= 4 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 10, 16632, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// MOV_VDiVDi encodes mov Vd.D[i], Vn.D[i].
+func (a *Assembler) MOV_VDiVDi(rd VReg, idx uint8, rn VReg, idx2 uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 || idx2 >= 2 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 11, 16645, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx2)})
+}
+
+// MOV_VBiW encodes mov Vd.B[i], Wn.
+func (a *Assembler) MOV_VBiW(rd VReg, idx uint8, rn WReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 12, 16658, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_VHiW encodes mov Vd.H[i], Wn.
+func (a *Assembler) MOV_VHiW(rd VReg, idx uint8, rn WReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 13, 16668, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_VSiW encodes mov Vd.S[i], Wn.
+func (a *Assembler) MOV_VSiW(rd VReg, idx uint8, rn WReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 14, 16678, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_VDiX encodes mov Vd.D[i], Xn.
+func (a *Assembler) MOV_VDiX(rd VReg, idx uint8, rn XReg) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 15, 16688, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(idx)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_INVERTED_W_Imm encodes mov INVERTED, Wn, #imm (imm is 32-bit inverted wide).
+func (a *Assembler) MOV_INVERTED_W_Imm(rd WReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 16, 16698, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOV_INVERTED_X_Imm encodes mov INVERTED, Xn, #imm (imm is 64-bit inverted wide).
+func (a *Assembler) MOV_INVERTED_X_Imm(rd XReg, imm uint64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 17, 16707, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOV_W_Imm encodes mov Wd, #imm (imm is 32-bit wide).
+func (a *Assembler) MOV_W_Imm(rd WReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 18, 16716, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOV_X_Imm encodes mov Xd, #imm (imm is 64-bit wide).
+func (a *Assembler) MOV_X_Imm(rd XReg, imm uint64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 19, 16725, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOV_V16BV16B encodes mov Vd.16B, Vn.16B.
+func (a *Assembler) MOV_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 20, 16734, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_V8BV8B encodes mov Vd.8B, Vn.8B.
+func (a *Assembler) MOV_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 20, 16734, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MOV_LOGICAL_Wsp_Imm encodes mov LOGICAL, Wn|WSP, #imm (imm is 32-bit logical).
+func (a *Assembler) MOV_LOGICAL_Wsp_Imm(rd WSPReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 21, 16744, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOV_LOGICAL_Xsp_Imm encodes mov LOGICAL, Xn|SP, #imm (imm is 64-bit logical).
+func (a *Assembler) MOV_LOGICAL_Xsp_Imm(rd XSPReg, imm uint64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 22, 16753, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOV_WVSi encodes mov Wd, Vn.S[i].
+func (a *Assembler) MOV_WVSi(rd WReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 23, 16762, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MOV_XVDi encodes mov Xd, Vn.D[i].
+func (a *Assembler) MOV_XVDi(rd XReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 {
+		return a.emitErr(MOV, ErrNoMatch)
+	}
+	return a.emit(MOV, 24, 16772, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MOVI_V16B_Imm encodes movi Vd.16B, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 == 0).
+func (a *Assembler) MOVI_V16B_Imm(rd V16BReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 0, 16970, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVI_V16B_Imm_LSL encodes movi Vd.16B, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 == 0).
+func (a *Assembler) MOVI_V16B_Imm_LSL(rd V16BReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 0, 16970, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_V8B_Imm encodes movi Vd.8B, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 == 0).
+func (a *Assembler) MOVI_V8B_Imm(rd V8BReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 0, 16970, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVI_V8B_Imm_LSL encodes movi Vd.8B, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 == 0).
+func (a *Assembler) MOVI_V8B_Imm_LSL(rd V8BReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 0, 16970, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_V8H_Imm encodes movi Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MOVI_V8H_Imm(rd V8HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 1, 16991, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVI_V8H_Imm_LSL encodes movi Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MOVI_V8H_Imm_LSL(rd V8HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 1, 16991, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_V4H_Imm encodes movi Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MOVI_V4H_Imm(rd V4HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 1, 16991, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVI_V4H_Imm_LSL encodes movi Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MOVI_V4H_Imm_LSL(rd V4HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 1, 16991, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_V4S_Imm encodes movi Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MOVI_V4S_Imm(rd V4SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 2, 17012, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVI_V4S_Imm_LSL encodes movi Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MOVI_V4S_Imm_LSL(rd V4SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 2, 17012, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_V2S_Imm encodes movi Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MOVI_V2S_Imm(rd V2SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 2, 17012, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVI_V2S_Imm_LSL encodes movi Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MOVI_V2S_Imm_LSL(rd V2SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 2, 17012, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_V4S_Imm_MSL encodes movi Vd.4S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16]).
+func (a *Assembler) MOVI_V4S_Imm_MSL(rd V4SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 3, 17033, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_V2S_Imm_MSL encodes movi Vd.2S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16]).
+func (a *Assembler) MOVI_V2S_Imm_MSL(rd V2SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 3, 17033, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVI_D_Imm encodes movi Dd, #imm (imm is stretched).
+func (a *Assembler) MOVI_D_Imm(rd DReg, imm uint64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 4, 17054, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOVI_V2D_Imm encodes movi Vd.2D, #imm (imm is stretched).
+func (a *Assembler) MOVI_V2D_Imm(rd V2DReg, imm uint64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVI, ErrNoMatch)
+	}
+	return a.emit(MOVI, 5, 17063, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MOVK_W_Imm encodes movk Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16]).
+func (a *Assembler) MOVK_W_Imm(rd WReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVK, ErrNoMatch)
+	}
+	return a.emit(MOVK, 0, 17072, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVK_W_Imm_LSL encodes movk Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16]).
+func (a *Assembler) MOVK_W_Imm_LSL(rd WReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVK, ErrNoMatch)
+	}
+	return a.emit(MOVK, 0, 17072, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVK_X_Imm encodes movk Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48]).
+func (a *Assembler) MOVK_X_Imm(rd XReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVK, ErrNoMatch)
+	}
+	return a.emit(MOVK, 1, 17084, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVK_X_Imm_LSL encodes movk Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48]).
+func (a *Assembler) MOVK_X_Imm_LSL(rd XReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVK, ErrNoMatch)
+	}
+	return a.emit(MOVK, 1, 17084, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVN_W_Imm encodes movn Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16]).
+func (a *Assembler) MOVN_W_Imm(rd WReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVN, ErrNoMatch)
+	}
+	return a.emit(MOVN, 0, 17096, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVN_W_Imm_LSL encodes movn Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16]).
+func (a *Assembler) MOVN_W_Imm_LSL(rd WReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVN, ErrNoMatch)
+	}
+	return a.emit(MOVN, 0, 17096, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVN_X_Imm encodes movn Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48]).
+func (a *Assembler) MOVN_X_Imm(rd XReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVN, ErrNoMatch)
+	}
+	return a.emit(MOVN, 1, 17108, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVN_X_Imm_LSL encodes movn Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48]).
+func (a *Assembler) MOVN_X_Imm_LSL(rd XReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVN, ErrNoMatch)
+	}
+	return a.emit(MOVN, 1, 17108, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVZ_W_Imm encodes movz Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16]).
+func (a *Assembler) MOVZ_W_Imm(rd WReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVZ, ErrNoMatch)
+	}
+	return a.emit(MOVZ, 0, 17199, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVZ_W_Imm_LSL encodes movz Wd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16]).
+func (a *Assembler) MOVZ_W_Imm_LSL(rd WReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVZ, ErrNoMatch)
+	}
+	return a.emit(MOVZ, 0, 17199, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MOVZ_X_Imm encodes movz Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48]).
+func (a *Assembler) MOVZ_X_Imm(rd XReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVZ, ErrNoMatch)
+	}
+	return a.emit(MOVZ, 1, 17211, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MOVZ_X_Imm_LSL encodes movz Xd, #imm1 {, LSL #imm2 } (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48]).
+func (a *Assembler) MOVZ_X_Imm_LSL(rd XReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MOVZ, ErrNoMatch)
+	}
+	return a.emit(MOVZ, 1, 17211, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MRS_X_Imm encodes mrs Xd, #imm (0 <= imm < 32768).
+func (a *Assembler) MRS_X_Imm(rd XReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MRS, ErrNoMatch)
+	}
+	return a.emit(MRS, 0, 17223, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MRS_X_SysReg encodes mrs Xd, <sysreg>.
+func (a *Assembler) MRS_X_SysReg(rd XReg, sysreg SystemReg) bool {
+	if rd >= 32 || sysreg>>14 < 2 {
+		return a.emitErr(MRS, ErrNoMatch)
+	}
+	return a.emit(MRS, 1, 17232, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(sysreg & 0x7FFF)})
+}
+
+// MRS_X_SPSEL encodes mrs Xd, SPSEL.
+func (a *Assembler) MRS_X_SPSEL(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MRS, ErrNoMatch)
+	}
+	return a.emit(MRS, 2, 17241, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MRS_X_PAN encodes mrs Xd, PAN.
+func (a *Assembler) MRS_X_PAN(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MRS, ErrNoMatch)
+	}
+	return a.emit(MRS, 3, 17247, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MRS_X_UAO encodes mrs Xd, UAO.
+func (a *Assembler) MRS_X_UAO(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MRS, ErrNoMatch)
+	}
+	return a.emit(MRS, 4, 17253, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MRS_X_DIT encodes mrs Xd, DIT.
+func (a *Assembler) MRS_X_DIT(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MRS, ErrNoMatch)
+	}
+	return a.emit(MRS, 5, 17259, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MSR_SVCRSM_Imm encodes msr SVCRSM, #imm (0 <= imm < 2).
+func (a *Assembler) MSR_SVCRSM_Imm(imm int64) bool {
+	return a.emit(MSR, 0, 17265, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// MSR_SVCRZA_Imm encodes msr SVCRZA, #imm (0 <= imm < 2).
+func (a *Assembler) MSR_SVCRZA_Imm(imm int64) bool {
+	return a.emit(MSR, 1, 17273, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// MSR_SVCRSMZA_Imm encodes msr SVCRSMZA, #imm (0 <= imm < 2).
+func (a *Assembler) MSR_SVCRSMZA_Imm(imm int64) bool {
+	return a.emit(MSR, 2, 17281, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// MSR_Sym_Imm encodes msr <symbol>, #imm (0 <= imm < 16).
+func (a *Assembler) MSR_Sym_Imm(sym Symbol, imm int64) bool {
+	return a.emit(MSR, 3, 17289, 0, 0, 0, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(imm)})
+}
+
+// MSR_Imm_X encodes msr #imm, Xn (0 <= imm < 32768).
+func (a *Assembler) MSR_Imm_X(imm int64, rn XReg) bool {
+	if rn >= 32 {
+		return a.emitErr(MSR, ErrNoMatch)
+	}
+	return a.emit(MSR, 4, 17300, 0, 0, 0, Flat{FlatImm, uint64(imm)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MSR_SysReg_X encodes msr <sysreg>, Xn.
+func (a *Assembler) MSR_SysReg_X(sysreg SystemReg, rn XReg) bool {
+	if rn >= 32 || sysreg>>14 < 2 {
+		return a.emitErr(MSR, ErrNoMatch)
+	}
+	return a.emit(MSR, 5, 17309, 0, 0, 0, Flat{FlatImm, uint64(sysreg & 0x7FFF)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MSR_SPSEL_X encodes msr SPSEL, Xn.
+func (a *Assembler) MSR_SPSEL_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MSR, ErrNoMatch)
+	}
+	return a.emit(MSR, 6, 17318, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MSR_PAN_X encodes msr PAN, Xn.
+func (a *Assembler) MSR_PAN_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MSR, ErrNoMatch)
+	}
+	return a.emit(MSR, 7, 17324, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MSR_UAO_X encodes msr UAO, Xn.
+func (a *Assembler) MSR_UAO_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MSR, ErrNoMatch)
+	}
+	return a.emit(MSR, 8, 17330, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MSR_DIT_X encodes msr DIT, Xn.
+func (a *Assembler) MSR_DIT_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(MSR, ErrNoMatch)
+	}
+	return a.emit(MSR, 9, 17336, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// MSUB_WWWW encodes msub Wd, Wn, Wm, Wa.
+func (a *Assembler) MSUB_WWWW(rd, rn, rm, ra WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(MSUB, ErrNoMatch)
+	}
+	return a.emit(MSUB, 0, 17342, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// MSUB_XXXX encodes msub Xd, Xn, Xm, Xa.
+func (a *Assembler) MSUB_XXXX(rd, rn, rm, ra XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(MSUB, ErrNoMatch)
+	}
+	return a.emit(MSUB, 1, 17351, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// MUL_V8HV8HVHi encodes mul Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) MUL_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 0, 17360, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MUL_V4HV4HVHi encodes mul Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) MUL_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 0, 17360, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MUL_V4SV4SVSi encodes mul Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) MUL_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 1, 17371, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MUL_V2SV2SVSi encodes mul Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) MUL_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 1, 17371, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// MUL_V16BV16BV16B encodes mul Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) MUL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 2, 17382, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MUL_V8BV8BV8B encodes mul Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) MUL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 2, 17382, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MUL_V8HV8HV8H encodes mul Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) MUL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 3, 17391, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MUL_V4HV4HV4H encodes mul Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) MUL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 3, 17391, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MUL_V4SV4SV4S encodes mul Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) MUL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 4, 17400, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MUL_V2SV2SV2S encodes mul Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) MUL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 4, 17400, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MUL_WWW encodes mul Wd, Wn, Wm.
+func (a *Assembler) MUL_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 5, 17409, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MUL_XXX encodes mul Xd, Xn, Xm.
+func (a *Assembler) MUL_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(MUL, ErrNoMatch)
+	}
+	return a.emit(MUL, 6, 17417, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// MVN_WW encodes mvn Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) MVN_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MVN, ErrNoMatch)
+	}
+	return a.emit(MVN, 0, 17545, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// MVN_WW_Mod encodes mvn Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) MVN_WW_Mod(rd, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(MVN, ErrNoMatch)
+	}
+	return a.emit(MVN, 0, 17545, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// MVN_XX encodes mvn Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) MVN_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MVN, ErrNoMatch)
+	}
+	return a.emit(MVN, 1, 17556, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// MVN_XX_Mod encodes mvn Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) MVN_XX_Mod(rd, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(MVN, ErrNoMatch)
+	}
+	return a.emit(MVN, 1, 17556, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// MVN_V16BV16B encodes mvn Vd.16B, Vn.16B.
+func (a *Assembler) MVN_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MVN, ErrNoMatch)
+	}
+	return a.emit(MVN, 2, 17567, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MVN_V8BV8B encodes mvn Vd.8B, Vn.8B.
+func (a *Assembler) MVN_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(MVN, ErrNoMatch)
+	}
+	return a.emit(MVN, 2, 17567, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// MVNI_V8H_Imm encodes mvni Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MVNI_V8H_Imm(rd V8HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 0, 17575, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MVNI_V8H_Imm_LSL encodes mvni Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MVNI_V8H_Imm_LSL(rd V8HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 0, 17575, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MVNI_V4H_Imm encodes mvni Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MVNI_V4H_Imm(rd V4HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 0, 17575, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MVNI_V4H_Imm_LSL encodes mvni Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) MVNI_V4H_Imm_LSL(rd V4HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 0, 17575, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MVNI_V4S_Imm encodes mvni Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MVNI_V4S_Imm(rd V4SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 1, 17596, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MVNI_V4S_Imm_LSL encodes mvni Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MVNI_V4S_Imm_LSL(rd V4SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 1, 17596, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MVNI_V2S_Imm encodes mvni Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MVNI_V2S_Imm(rd V2SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 1, 17596, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// MVNI_V2S_Imm_LSL encodes mvni Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) MVNI_V2S_Imm_LSL(rd V2SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 1, 17596, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MVNI_V4S_Imm_MSL encodes mvni Vd.4S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16]).
+func (a *Assembler) MVNI_V4S_Imm_MSL(rd V4SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 2, 17617, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// MVNI_V2S_Imm_MSL encodes mvni Vd.2S, #imm1, MSL #imm2 (0 <= imm1 < 256, imm2 in [8, 16]).
+func (a *Assembler) MVNI_V2S_Imm_MSL(rd V2SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(MVNI, ErrNoMatch)
+	}
+	return a.emit(MVNI, 2, 17617, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// NEG_WW encodes neg Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) NEG_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 0, 17648, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// NEG_WW_Mod encodes neg Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) NEG_WW_Mod(rd, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 0, 17648, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// NEG_XX encodes neg Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) NEG_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 1, 17659, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// NEG_XX_Mod encodes neg Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) NEG_XX_Mod(rd, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 1, 17659, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// NEG_DD encodes neg Dd, Dn.
+func (a *Assembler) NEG_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 2, 17670, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEG_V16BV16B encodes neg Vd.16B, Vn.16B.
+func (a *Assembler) NEG_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 3, 17677, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEG_V8BV8B encodes neg Vd.8B, Vn.8B.
+func (a *Assembler) NEG_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 3, 17677, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEG_V8HV8H encodes neg Vd.8H, Vn.8H.
+func (a *Assembler) NEG_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 4, 17685, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEG_V4HV4H encodes neg Vd.4H, Vn.4H.
+func (a *Assembler) NEG_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 4, 17685, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEG_V4SV4S encodes neg Vd.4S, Vn.4S.
+func (a *Assembler) NEG_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 5, 17693, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEG_V2SV2S encodes neg Vd.2S, Vn.2S.
+func (a *Assembler) NEG_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 5, 17693, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEG_V2DV2D encodes neg Vd.2D, Vn.2D.
+func (a *Assembler) NEG_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEG, ErrNoMatch)
+	}
+	return a.emit(NEG, 6, 17701, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NEGS_WW encodes negs Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) NEGS_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEGS, ErrNoMatch)
+	}
+	return a.emit(NEGS, 0, 17745, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// NEGS_WW_Mod encodes negs Wd, Wn {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) NEGS_WW_Mod(rd, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(NEGS, ErrNoMatch)
+	}
+	return a.emit(NEGS, 0, 17745, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// NEGS_XX encodes negs Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) NEGS_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NEGS, ErrNoMatch)
+	}
+	return a.emit(NEGS, 1, 17756, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// NEGS_XX_Mod encodes negs Xd, Xn {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) NEGS_XX_Mod(rd, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(NEGS, ErrNoMatch)
+	}
+	return a.emit(NEGS, 1, 17756, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// NGC_WW encodes ngc Wd, Wn.
+func (a *Assembler) NGC_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NGC, ErrNoMatch)
+	}
+	return a.emit(NGC, 0, 17767, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NGC_XX encodes ngc Xd, Xn.
+func (a *Assembler) NGC_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NGC, ErrNoMatch)
+	}
+	return a.emit(NGC, 1, 17774, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NGCS_WW encodes ngcs Wd, Wn.
+func (a *Assembler) NGCS_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NGCS, ErrNoMatch)
+	}
+	return a.emit(NGCS, 0, 17781, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NGCS_XX encodes ngcs Xd, Xn.
+func (a *Assembler) NGCS_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NGCS, ErrNoMatch)
+	}
+	return a.emit(NGCS, 1, 17788, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NOP encodes nop.
+func (a *Assembler) NOP() bool {
+	return a.emit(NOP, 0, 17795, 0, 0, 0)
+}
+
+// NOT_V16BV16B encodes not Vd.16B, Vn.16B.
+func (a *Assembler) NOT_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NOT, ErrNoMatch)
+	}
+	return a.emit(NOT, 0, 17800, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// NOT_V8BV8B encodes not Vd.8B, Vn.8B.
+func (a *Assembler) NOT_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(NOT, ErrNoMatch)
+	}
+	return a.emit(NOT, 0, 17800, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// ORN_V16BV16BV16B encodes orn Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) ORN_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORN, ErrNoMatch)
+	}
+	return a.emit(ORN, 0, 17844, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ORN_V8BV8BV8B encodes orn Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) ORN_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORN, ErrNoMatch)
+	}
+	return a.emit(ORN, 0, 17844, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ORN_WWW encodes orn Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) ORN_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORN, ErrNoMatch)
+	}
+	return a.emit(ORN, 1, 17853, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ORN_WWW_Mod encodes orn Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) ORN_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(ORN, ErrNoMatch)
+	}
+	return a.emit(ORN, 1, 17853, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ORN_XXX encodes orn Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) ORN_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORN, ErrNoMatch)
+	}
+	return a.emit(ORN, 2, 17865, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ORN_XXX_Mod encodes orn Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) ORN_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(ORN, ErrNoMatch)
+	}
+	return a.emit(ORN, 2, 17865, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ORR_V8H_Imm encodes orr Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) ORR_V8H_Imm(rd V8HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 0, 17877, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ORR_V8H_Imm_LSL encodes orr Vd.8H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) ORR_V8H_Imm_LSL(rd V8HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 0, 17877, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ORR_V4H_Imm encodes orr Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) ORR_V4H_Imm(rd V4HReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 0, 17877, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ORR_V4H_Imm_LSL encodes orr Vd.4H, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8]).
+func (a *Assembler) ORR_V4H_Imm_LSL(rd V4HReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 0, 17877, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ORR_V4S_Imm encodes orr Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) ORR_V4S_Imm(rd V4SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 1, 17898, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ORR_V4S_Imm_LSL encodes orr Vd.4S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) ORR_V4S_Imm_LSL(rd V4SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 1, 17898, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ORR_V2S_Imm encodes orr Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) ORR_V2S_Imm(rd V2SReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 1, 17898, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// ORR_V2S_Imm_LSL encodes orr Vd.2S, #imm1 {, LSL #imm2 } (0 <= imm1 < 256, imm2 in [0, 8, 16, 24]).
+func (a *Assembler) ORR_V2S_Imm_LSL(rd V2SReg, imm int64, amount uint8) bool {
+	if rd >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 1, 17898, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// ORR_V16BV16BV16B encodes orr Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) ORR_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 2, 17919, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ORR_V8BV8BV8B encodes orr Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) ORR_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 2, 17919, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ORR_WspW_Imm encodes orr Wd|WSP, Wn, #imm (imm is 32-bit logical).
+func (a *Assembler) ORR_WspW_Imm(rd WSPReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 3, 17928, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ORR_XspX_Imm encodes orr Xd|SP, Xn, #imm (imm is 64-bit logical).
+func (a *Assembler) ORR_XspX_Imm(rd XSPReg, rn XReg, imm uint64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 4, 17938, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ORR_WWW encodes orr Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) ORR_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 5, 17948, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ORR_WWW_Mod encodes orr Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) ORR_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 5, 17948, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// ORR_XXX encodes orr Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) ORR_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 6, 17960, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// ORR_XXX_Mod encodes orr Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) ORR_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(ORR, ErrNoMatch)
+	}
+	return a.emit(ORR, 6, 17960, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// PACDA_XXsp encodes pacda Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACDA_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(PACDA, ErrNoMatch)
+	}
+	return a.emit(PACDA, 0, 18082, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// PACDB_XXsp encodes pacdb Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACDB_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(PACDB, ErrNoMatch)
+	}
+	return a.emit(PACDB, 0, 18089, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// PACDZA_X encodes pacdza Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACDZA_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(PACDZA, ErrNoMatch)
+	}
+	return a.emit(PACDZA, 0, 18096, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// PACDZB_X encodes pacdzb Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACDZB_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(PACDZB, ErrNoMatch)
+	}
+	return a.emit(PACDZB, 0, 18102, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// PACGA_XXXsp encodes pacga Xd, Xn, Xm|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACGA_XXXsp(rd, rn XReg, rm XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(PACGA, ErrNoMatch)
+	}
+	return a.emit(PACGA, 0, 18108, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// PACIA_XXsp encodes pacia Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACIA_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(PACIA, ErrNoMatch)
+	}
+	return a.emit(PACIA, 0, 18116, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// PACIA1716 encodes pacia1716.
+func (a *Assembler) PACIA1716() bool {
+	return a.emit(PACIA1716, 0, 18123, 0, 0, 0)
+}
+
+// PACIASP encodes paciasp.
+func (a *Assembler) PACIASP() bool {
+	return a.emit(PACIASP, 0, 18128, 0, 0, 0)
+}
+
+// PACIAZ encodes paciaz.
+func (a *Assembler) PACIAZ() bool {
+	return a.emit(PACIAZ, 0, 18133, 0, 0, 0)
+}
+
+// PACIB_XXsp encodes pacib Xd, Xn|SP.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACIB_XXsp(rd XReg, rn XSPReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(PACIB, ErrNoMatch)
+	}
+	return a.emit(PACIB, 0, 18138, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// PACIB1716 encodes pacib1716.
+func (a *Assembler) PACIB1716() bool {
+	return a.emit(PACIB1716, 0, 18145, 0, 0, 0)
+}
+
+// PACIBSP encodes pacibsp.
+func (a *Assembler) PACIBSP() bool {
+	return a.emit(PACIBSP, 0, 18150, 0, 0, 0)
+}
+
+// PACIBZ encodes pacibz.
+func (a *Assembler) PACIBZ() bool {
+	return a.emit(PACIBZ, 0, 18155, 0, 0, 0)
+}
+
+// PACIZA_X encodes paciza Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACIZA_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(PACIZA, ErrNoMatch)
+	}
+	return a.emit(PACIZA, 0, 18160, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// PACIZB_X encodes pacizb Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) PACIZB_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(PACIZB, ErrNoMatch)
+	}
+	return a.emit(PACIZB, 0, 18166, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// PMUL_V16BV16BV16B encodes pmul Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) PMUL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(PMUL, ErrNoMatch)
+	}
+	return a.emit(PMUL, 0, 18178, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// PMUL_V8BV8BV8B encodes pmul Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) PMUL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(PMUL, ErrNoMatch)
+	}
+	return a.emit(PMUL, 0, 18178, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// PMULL_V8HV8BV8B encodes pmull Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) PMULL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(PMULL, ErrNoMatch)
+	}
+	return a.emit(PMULL, 0, 18187, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// PMULL_V1QV1DV1D encodes pmull Vd.1Q, Vn.1D, Vm.1D.
+// Requires FEAT_AES.
+func (a *Assembler) PMULL_V1QV1DV1D(rd V1QReg, rn, rm V1DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(PMULL, ErrNoMatch)
+	}
+	return a.emit(PMULL, 1, 18195, FeatAES, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// PMULL2_V8HV16BV16B encodes pmull2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) PMULL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(PMULL2, ErrNoMatch)
+	}
+	return a.emit(PMULL2, 0, 18203, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// PMULL2_V1QV2DV2D encodes pmull2 Vd.1Q, Vn.2D, Vm.2D.
+// Requires FEAT_AES.
+func (a *Assembler) PMULL2_V1QV2DV2D(rd V1QReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(PMULL2, ErrNoMatch)
+	}
+	return a.emit(PMULL2, 1, 18211, FeatAES, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// PRFM_Imm_RefOffset encodes prfm #imm1, [Xn|SP {, #imm2 }] (0 <= imm1 < 32, 0 <= imm2 < 32768, imm2 >> 3).
+func (a *Assembler) PRFM_Imm_RefOffset(imm int64, base XSPReg, offset int32) bool {
+	if base >= 32 {
+		return a.emitErr(PRFM, ErrNoMatch)
+	}
+	return a.emit(PRFM, 0, 18219, 0, 0, 0, Flat{FlatImm, uint64(imm)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// PRFM_Sym_RefOffset encodes prfm <symbol>, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3).
+func (a *Assembler) PRFM_Sym_RefOffset(sym Symbol, base XSPReg, offset int32) bool {
+	if base >= 32 {
+		return a.emitErr(PRFM, ErrNoMatch)
+	}
+	return a.emit(PRFM, 1, 18232, 0, 0, 0, Flat{FlatImm, uint64(sym)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// PRFM_Imm_Label encodes prfm #imm, <offset> (0 <= imm < 32, offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) PRFM_Imm_Label(imm int64, label Label) bool {
+	if !a.validLabel(label) {
+		return a.emitErr(PRFM, ErrNoMatch)
+	}
+	return a.emit(PRFM, 2, 18245, 0, 0, 0, Flat{FlatImm, uint64(imm)}, flatLabel(label))
+}
+
+// PRFM_Sym_Label encodes prfm <symbol>, <offset> (offset >> 2 is 19-bit (+/- 1 MB)).
+func (a *Assembler) PRFM_Sym_Label(sym Symbol, label Label) bool {
+	if !a.validLabel(label) {
+		return a.emitErr(PRFM, ErrNoMatch)
+	}
+	return a.emit(PRFM, 3, 18255, 0, 0, 0, Flat{FlatImm, uint64(sym)}, flatLabel(label))
+}
+
+// PRFUM_Imm_RefOffset encodes prfum #imm1, [Xn|SP {, #imm2 }] (0 <= imm1 < 32, -256 <= imm2 < 256).
+func (a *Assembler) PRFUM_Imm_RefOffset(imm int64, base XSPReg, offset int32) bool {
+	if base >= 32 {
+		return a.emitErr(PRFUM, ErrNoMatch)
+	}
+	return a.emit(PRFUM, 0, 18293, 0, 0, 0, Flat{FlatImm, uint64(imm)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// PRFUM_Sym_RefOffset encodes prfum <symbol>, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) PRFUM_Sym_RefOffset(sym Symbol, base XSPReg, offset int32) bool {
+	if base >= 32 {
+		return a.emitErr(PRFUM, ErrNoMatch)
+	}
+	return a.emit(PRFUM, 1, 18303, 0, 0, 0, Flat{FlatImm, uint64(sym)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// PSB_CSYNC encodes psb CSYNC.
+func (a *Assembler) PSB_CSYNC() bool {
+	return a.emit(PSB, 0, 18313, 0, 0, 0)
+}
+
+// PSSBB encodes pssbb.
+func (a *Assembler) PSSBB() bool {
+	return a.emit(PSSBB, 0, 18318, 0, 0, 0)
+}
+
+// RADDHN_V8BV8HV8H encodes raddhn Vd.8B, Vn.8H, Vm.8H.
+func (a *Assembler) RADDHN_V8BV8HV8H(rd V8BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RADDHN, ErrNoMatch)
+	}
+	return a.emit(RADDHN, 0, 18450, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RADDHN_V4HV4SV4S encodes raddhn Vd.4H, Vn.4S, Vm.4S.
+func (a *Assembler) RADDHN_V4HV4SV4S(rd V4HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RADDHN, ErrNoMatch)
+	}
+	return a.emit(RADDHN, 1, 18458, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RADDHN_V2SV2DV2D encodes raddhn Vd.2S, Vn.2D, Vm.2D.
+func (a *Assembler) RADDHN_V2SV2DV2D(rd V2SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RADDHN, ErrNoMatch)
+	}
+	return a.emit(RADDHN, 2, 18466, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RADDHN2_V16BV8HV8H encodes raddhn2 Vd.16B, Vn.8H, Vm.8H.
+func (a *Assembler) RADDHN2_V16BV8HV8H(rd V16BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RADDHN2, ErrNoMatch)
+	}
+	return a.emit(RADDHN2, 0, 18474, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RADDHN2_V8HV4SV4S encodes raddhn2 Vd.8H, Vn.4S, Vm.4S.
+func (a *Assembler) RADDHN2_V8HV4SV4S(rd V8HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RADDHN2, ErrNoMatch)
+	}
+	return a.emit(RADDHN2, 1, 18482, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RADDHN2_V4SV2DV2D encodes raddhn2 Vd.4S, Vn.2D, Vm.2D.
+func (a *Assembler) RADDHN2_V4SV2DV2D(rd V4SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RADDHN2, ErrNoMatch)
+	}
+	return a.emit(RADDHN2, 2, 18490, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RAX1_V2DV2DV2D encodes rax1 Vd.2D, Vn.2D, Vm.2D.
+// Requires FEAT_SHA3.
+func (a *Assembler) RAX1_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RAX1, ErrNoMatch)
+	}
+	return a.emit(RAX1, 0, 18498, FeatSHA3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RBIT_V16BV16B encodes rbit Vd.16B, Vn.16B.
+func (a *Assembler) RBIT_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RBIT, ErrNoMatch)
+	}
+	return a.emit(RBIT, 0, 18506, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// RBIT_V8BV8B encodes rbit Vd.8B, Vn.8B.
+func (a *Assembler) RBIT_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RBIT, ErrNoMatch)
+	}
+	return a.emit(RBIT, 0, 18506, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// RBIT_WW encodes rbit Wd, Wn.
+func (a *Assembler) RBIT_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RBIT, ErrNoMatch)
+	}
+	return a.emit(RBIT, 1, 18514, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// RBIT_XX encodes rbit Xd, Xn.
+func (a *Assembler) RBIT_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RBIT, ErrNoMatch)
+	}
+	return a.emit(RBIT, 2, 18521, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// RDSVL_X_Imm encodes rdsvl Xd, #imm (-32 <= imm < 32).
+// Requires FEAT_SME.
+func (a *Assembler) RDSVL_X_Imm(rd XReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(RDSVL, ErrNoMatch)
+	}
+	return a.emit(RDSVL, 0, 18548, FeatSME, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RDVL_X_Imm encodes rdvl Xd, #imm (-32 <= imm < 32).
+// Requires FEAT_SVE.
+func (a *Assembler) RDVL_X_Imm(rd XReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(RDVL, ErrNoMatch)
+	}
+	return a.emit(RDVL, 0, 18557, FeatSVE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RET_X encodes ret Xd.
+func (a *Assembler) RET_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(RET, ErrNoMatch)
+	}
+	return a.emit(RET, 0, 18566, 0, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// RET encodes ret.
+func (a *Assembler) RET() bool {
+	return a.emit(RET, 1, 18572, 0, 0, 0)
+}
+
+// RETAA encodes retaa.
+// Requires FEAT_PAuth.
+func (a *Assembler) RETAA() bool {
+	return a.emit(RETAA, 0, 18577, FeatPAuth, 0, 0)
+}
+
+// RETAB encodes retab.
+// Requires FEAT_PAuth.
+func (a *Assembler) RETAB() bool {
+	return a.emit(RETAB, 0, 18582, FeatPAuth, 0, 0)
+}
+
+// REV_WW encodes rev Wd, Wn.
+func (a *Assembler) REV_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV, ErrNoMatch)
+	}
+	return a.emit(REV, 0, 18587, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV_XX encodes rev Xd, Xn.
+func (a *Assembler) REV_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV, ErrNoMatch)
+	}
+	return a.emit(REV, 1, 18594, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV16_V16BV16B encodes rev16 Vd.16B, Vn.16B.
+func (a *Assembler) REV16_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV16, ErrNoMatch)
+	}
+	return a.emit(REV16, 0, 18657, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV16_V8BV8B encodes rev16 Vd.8B, Vn.8B.
+func (a *Assembler) REV16_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV16, ErrNoMatch)
+	}
+	return a.emit(REV16, 0, 18657, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV16_WW encodes rev16 Wd, Wn.
+func (a *Assembler) REV16_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV16, ErrNoMatch)
+	}
+	return a.emit(REV16, 1, 18665, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV16_XX encodes rev16 Xd, Xn.
+func (a *Assembler) REV16_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV16, ErrNoMatch)
+	}
+	return a.emit(REV16, 2, 18672, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV32_V16BV16B encodes rev32 Vd.16B, Vn.16B.
+func (a *Assembler) REV32_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV32, ErrNoMatch)
+	}
+	return a.emit(REV32, 0, 18679, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV32_V8BV8B encodes rev32 Vd.8B, Vn.8B.
+func (a *Assembler) REV32_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV32, ErrNoMatch)
+	}
+	return a.emit(REV32, 0, 18679, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV32_V8HV8H encodes rev32 Vd.8H, Vn.8H.
+func (a *Assembler) REV32_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV32, ErrNoMatch)
+	}
+	return a.emit(REV32, 1, 18687, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV32_V4HV4H encodes rev32 Vd.4H, Vn.4H.
+func (a *Assembler) REV32_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV32, ErrNoMatch)
+	}
+	return a.emit(REV32, 1, 18687, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV32_XX encodes rev32 Xd, Xn.
+func (a *Assembler) REV32_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV32, ErrNoMatch)
+	}
+	return a.emit(REV32, 2, 18695, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV64_V16BV16B encodes rev64 Vd.16B, Vn.16B.
+func (a *Assembler) REV64_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV64, ErrNoMatch)
+	}
+	return a.emit(REV64, 0, 18702, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV64_V8BV8B encodes rev64 Vd.8B, Vn.8B.
+func (a *Assembler) REV64_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV64, ErrNoMatch)
+	}
+	return a.emit(REV64, 0, 18702, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV64_V8HV8H encodes rev64 Vd.8H, Vn.8H.
+func (a *Assembler) REV64_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV64, ErrNoMatch)
+	}
+	return a.emit(REV64, 1, 18710, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV64_V4HV4H encodes rev64 Vd.4H, Vn.4H.
+func (a *Assembler) REV64_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV64, ErrNoMatch)
+	}
+	return a.emit(REV64, 1, 18710, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV64_V4SV4S encodes rev64 Vd.4S, Vn.4S.
+func (a *Assembler) REV64_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV64, ErrNoMatch)
+	}
+	return a.emit(REV64, 2, 18718, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV64_V2SV2S encodes rev64 Vd.2S, Vn.2S.
+func (a *Assembler) REV64_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV64, ErrNoMatch)
+	}
+	return a.emit(REV64, 2, 18718, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// REV64_XX encodes rev64 Xd, Xn.
+func (a *Assembler) REV64_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(REV64, ErrNoMatch)
+	}
+	return a.emit(REV64, 3, 18726, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// RMIF_X_Imm_Imm encodes rmif Xd, #imm1, #imm2 (0 <= imm1 < 64, 0 <= imm2 < 16).
+// Requires FEAT_FlagM.
+func (a *Assembler) RMIF_X_Imm_Imm(rd XReg, imm, imm2 int64) bool {
+	if rd >= 32 {
+		return a.emitErr(RMIF, ErrNoMatch)
+	}
+	return a.emit(RMIF, 0, 18733, FeatFlagM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// ROR_WW_Imm encodes ror Wd, Wn, #imm (0 <= imm < 32).
+func (a *Assembler) ROR_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ROR, ErrNoMatch)
+	}
+	return a.emit(ROR, 0, 18745, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ROR_XX_Imm encodes ror Xd, Xn, #imm (0 <= imm < 64).
+func (a *Assembler) ROR_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(ROR, ErrNoMatch)
+	}
+	return a.emit(ROR, 1, 18757, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ROR_WWW encodes ror Wd, Wn, Wm.
+func (a *Assembler) ROR_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ROR, ErrNoMatch)
+	}
+	return a.emit(ROR, 2, 18769, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ROR_XXX encodes ror Xd, Xn, Xm.
+func (a *Assembler) ROR_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ROR, ErrNoMatch)
+	}
+	return a.emit(ROR, 3, 18777, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RORV_WWW encodes rorv Wd, Wn, Wm.
+func (a *Assembler) RORV_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RORV, ErrNoMatch)
+	}
+	return a.emit(RORV, 0, 18785, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RORV_XXX encodes rorv Xd, Xn, Xm.
+func (a *Assembler) RORV_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RORV, ErrNoMatch)
+	}
+	return a.emit(RORV, 1, 18793, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RSHRN_V8BV8H_Imm encodes rshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) RSHRN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RSHRN, ErrNoMatch)
+	}
+	return a.emit(RSHRN, 0, 18801, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RSHRN_V4HV4S_Imm encodes rshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) RSHRN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RSHRN, ErrNoMatch)
+	}
+	return a.emit(RSHRN, 1, 18812, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RSHRN_V2SV2D_Imm encodes rshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) RSHRN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RSHRN, ErrNoMatch)
+	}
+	return a.emit(RSHRN, 2, 18823, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RSHRN2_V16BV8H_Imm encodes rshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) RSHRN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RSHRN2, ErrNoMatch)
+	}
+	return a.emit(RSHRN2, 0, 18834, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RSHRN2_V8HV4S_Imm encodes rshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) RSHRN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RSHRN2, ErrNoMatch)
+	}
+	return a.emit(RSHRN2, 1, 18845, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RSHRN2_V4SV2D_Imm encodes rshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) RSHRN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(RSHRN2, ErrNoMatch)
+	}
+	return a.emit(RSHRN2, 2, 18856, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// RSUBHN_V8BV8HV8H encodes rsubhn Vd.8B, Vn.8H, Vm.8H.
+func (a *Assembler) RSUBHN_V8BV8HV8H(rd V8BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RSUBHN, ErrNoMatch)
+	}
+	return a.emit(RSUBHN, 0, 18867, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RSUBHN_V4HV4SV4S encodes rsubhn Vd.4H, Vn.4S, Vm.4S.
+func (a *Assembler) RSUBHN_V4HV4SV4S(rd V4HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RSUBHN, ErrNoMatch)
+	}
+	return a.emit(RSUBHN, 1, 18875, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RSUBHN_V2SV2DV2D encodes rsubhn Vd.2S, Vn.2D, Vm.2D.
+func (a *Assembler) RSUBHN_V2SV2DV2D(rd V2SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RSUBHN, ErrNoMatch)
+	}
+	return a.emit(RSUBHN, 2, 18883, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RSUBHN2_V16BV8HV8H encodes rsubhn2 Vd.16B, Vn.8H, Vm.8H.
+func (a *Assembler) RSUBHN2_V16BV8HV8H(rd V16BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RSUBHN2, ErrNoMatch)
+	}
+	return a.emit(RSUBHN2, 0, 18891, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RSUBHN2_V8HV4SV4S encodes rsubhn2 Vd.8H, Vn.4S, Vm.4S.
+func (a *Assembler) RSUBHN2_V8HV4SV4S(rd V8HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RSUBHN2, ErrNoMatch)
+	}
+	return a.emit(RSUBHN2, 1, 18899, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// RSUBHN2_V4SV2DV2D encodes rsubhn2 Vd.4S, Vn.2D, Vm.2D.
+func (a *Assembler) RSUBHN2_V4SV2DV2D(rd V4SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(RSUBHN2, ErrNoMatch)
+	}
+	return a.emit(RSUBHN2, 2, 18907, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABA_V16BV16BV16B encodes saba Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SABA_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABA, ErrNoMatch)
+	}
+	return a.emit(SABA, 0, 18915, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABA_V8BV8BV8B encodes saba Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SABA_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABA, ErrNoMatch)
+	}
+	return a.emit(SABA, 0, 18915, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABA_V8HV8HV8H encodes saba Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SABA_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABA, ErrNoMatch)
+	}
+	return a.emit(SABA, 1, 18924, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABA_V4HV4HV4H encodes saba Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SABA_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABA, ErrNoMatch)
+	}
+	return a.emit(SABA, 1, 18924, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABA_V4SV4SV4S encodes saba Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SABA_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABA, ErrNoMatch)
+	}
+	return a.emit(SABA, 2, 18933, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABA_V2SV2SV2S encodes saba Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SABA_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABA, ErrNoMatch)
+	}
+	return a.emit(SABA, 2, 18933, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABAL_V8HV8BV8B encodes sabal Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) SABAL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABAL, ErrNoMatch)
+	}
+	return a.emit(SABAL, 0, 18942, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABAL_V4SV4HV4H encodes sabal Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SABAL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABAL, ErrNoMatch)
+	}
+	return a.emit(SABAL, 1, 18950, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABAL_V2DV2SV2S encodes sabal Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SABAL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABAL, ErrNoMatch)
+	}
+	return a.emit(SABAL, 2, 18958, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABAL2_V8HV16BV16B encodes sabal2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) SABAL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABAL2, ErrNoMatch)
+	}
+	return a.emit(SABAL2, 0, 18966, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABAL2_V4SV8HV8H encodes sabal2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SABAL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABAL2, ErrNoMatch)
+	}
+	return a.emit(SABAL2, 1, 18974, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABAL2_V2DV4SV4S encodes sabal2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SABAL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABAL2, ErrNoMatch)
+	}
+	return a.emit(SABAL2, 2, 18982, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABD_V16BV16BV16B encodes sabd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SABD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABD, ErrNoMatch)
+	}
+	return a.emit(SABD, 0, 18990, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABD_V8BV8BV8B encodes sabd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SABD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABD, ErrNoMatch)
+	}
+	return a.emit(SABD, 0, 18990, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABD_V8HV8HV8H encodes sabd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SABD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABD, ErrNoMatch)
+	}
+	return a.emit(SABD, 1, 18999, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABD_V4HV4HV4H encodes sabd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SABD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABD, ErrNoMatch)
+	}
+	return a.emit(SABD, 1, 18999, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABD_V4SV4SV4S encodes sabd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SABD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABD, ErrNoMatch)
+	}
+	return a.emit(SABD, 2, 19008, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABD_V2SV2SV2S encodes sabd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SABD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABD, ErrNoMatch)
+	}
+	return a.emit(SABD, 2, 19008, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABDL_V8HV8BV8B encodes sabdl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) SABDL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABDL, ErrNoMatch)
+	}
+	return a.emit(SABDL, 0, 19061, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABDL_V4SV4HV4H encodes sabdl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SABDL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABDL, ErrNoMatch)
+	}
+	return a.emit(SABDL, 1, 19069, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABDL_V2DV2SV2S encodes sabdl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SABDL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABDL, ErrNoMatch)
+	}
+	return a.emit(SABDL, 2, 19077, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABDL2_V8HV16BV16B encodes sabdl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) SABDL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABDL2, ErrNoMatch)
+	}
+	return a.emit(SABDL2, 0, 19085, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABDL2_V4SV8HV8H encodes sabdl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SABDL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABDL2, ErrNoMatch)
+	}
+	return a.emit(SABDL2, 1, 19093, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SABDL2_V2DV4SV4S encodes sabdl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SABDL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SABDL2, ErrNoMatch)
+	}
+	return a.emit(SABDL2, 2, 19101, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADALP_V8HV16B encodes sadalp Vd.8H, Vn.16B.
+func (a *Assembler) SADALP_V8HV16B(rd V8HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADALP, ErrNoMatch)
+	}
+	return a.emit(SADALP, 0, 19109, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADALP_V4HV8B encodes sadalp Vd.4H, Vn.8B.
+func (a *Assembler) SADALP_V4HV8B(rd V4HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADALP, ErrNoMatch)
+	}
+	return a.emit(SADALP, 0, 19109, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADALP_V4SV8H encodes sadalp Vd.4S, Vn.8H.
+func (a *Assembler) SADALP_V4SV8H(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADALP, ErrNoMatch)
+	}
+	return a.emit(SADALP, 1, 19117, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADALP_V2SV4H encodes sadalp Vd.2S, Vn.4H.
+func (a *Assembler) SADALP_V2SV4H(rd V2SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADALP, ErrNoMatch)
+	}
+	return a.emit(SADALP, 1, 19117, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADALP_V2DV4S encodes sadalp Vd.2D, Vn.4S.
+func (a *Assembler) SADALP_V2DV4S(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADALP, ErrNoMatch)
+	}
+	return a.emit(SADALP, 2, 19125, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADALP_V1DV2S encodes sadalp Vd.1D, Vn.2S.
+func (a *Assembler) SADALP_V1DV2S(rd V1DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADALP, ErrNoMatch)
+	}
+	return a.emit(SADALP, 2, 19125, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDL_V8HV8BV8B encodes saddl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) SADDL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDL, ErrNoMatch)
+	}
+	return a.emit(SADDL, 0, 19133, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDL_V4SV4HV4H encodes saddl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SADDL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDL, ErrNoMatch)
+	}
+	return a.emit(SADDL, 1, 19141, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDL_V2DV2SV2S encodes saddl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SADDL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDL, ErrNoMatch)
+	}
+	return a.emit(SADDL, 2, 19149, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDL2_V8HV16BV16B encodes saddl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) SADDL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDL2, ErrNoMatch)
+	}
+	return a.emit(SADDL2, 0, 19157, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDL2_V4SV8HV8H encodes saddl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SADDL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDL2, ErrNoMatch)
+	}
+	return a.emit(SADDL2, 1, 19165, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDL2_V2DV4SV4S encodes saddl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SADDL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDL2, ErrNoMatch)
+	}
+	return a.emit(SADDL2, 2, 19173, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDLP_V8HV16B encodes saddlp Vd.8H, Vn.16B.
+func (a *Assembler) SADDLP_V8HV16B(rd V8HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLP, ErrNoMatch)
+	}
+	return a.emit(SADDLP, 0, 19181, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLP_V4HV8B encodes saddlp Vd.4H, Vn.8B.
+func (a *Assembler) SADDLP_V4HV8B(rd V4HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLP, ErrNoMatch)
+	}
+	return a.emit(SADDLP, 0, 19181, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLP_V4SV8H encodes saddlp Vd.4S, Vn.8H.
+func (a *Assembler) SADDLP_V4SV8H(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLP, ErrNoMatch)
+	}
+	return a.emit(SADDLP, 1, 19189, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLP_V2SV4H encodes saddlp Vd.2S, Vn.4H.
+func (a *Assembler) SADDLP_V2SV4H(rd V2SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLP, ErrNoMatch)
+	}
+	return a.emit(SADDLP, 1, 19189, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLP_V2DV4S encodes saddlp Vd.2D, Vn.4S.
+func (a *Assembler) SADDLP_V2DV4S(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLP, ErrNoMatch)
+	}
+	return a.emit(SADDLP, 2, 19197, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLP_V1DV2S encodes saddlp Vd.1D, Vn.2S.
+func (a *Assembler) SADDLP_V1DV2S(rd V1DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLP, ErrNoMatch)
+	}
+	return a.emit(SADDLP, 2, 19197, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLV_HV16B encodes saddlv Hd, Vn.16B.
+func (a *Assembler) SADDLV_HV16B(rd HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLV, ErrNoMatch)
+	}
+	return a.emit(SADDLV, 0, 19205, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLV_HV8B encodes saddlv Hd, Vn.8B.
+func (a *Assembler) SADDLV_HV8B(rd HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLV, ErrNoMatch)
+	}
+	return a.emit(SADDLV, 0, 19205, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLV_SV8H encodes saddlv Sd, Vn.8H.
+func (a *Assembler) SADDLV_SV8H(rd SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLV, ErrNoMatch)
+	}
+	return a.emit(SADDLV, 1, 19213, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLV_SV4H encodes saddlv Sd, Vn.4H.
+func (a *Assembler) SADDLV_SV4H(rd SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLV, ErrNoMatch)
+	}
+	return a.emit(SADDLV, 1, 19213, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDLV_DV4S encodes saddlv Dd, Vn.4S.
+func (a *Assembler) SADDLV_DV4S(rd DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SADDLV, ErrNoMatch)
+	}
+	return a.emit(SADDLV, 2, 19221, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SADDW_V8HV8HV8B encodes saddw Vd.8H, Vn.8H, Vm.8B.
+func (a *Assembler) SADDW_V8HV8HV8B(rd, rn V8HReg, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDW, ErrNoMatch)
+	}
+	return a.emit(SADDW, 0, 19256, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDW_V4SV4SV4H encodes saddw Vd.4S, Vn.4S, Vm.4H.
+func (a *Assembler) SADDW_V4SV4SV4H(rd, rn V4SReg, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDW, ErrNoMatch)
+	}
+	return a.emit(SADDW, 1, 19264, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDW_V2DV2DV2S encodes saddw Vd.2D, Vn.2D, Vm.2S.
+func (a *Assembler) SADDW_V2DV2DV2S(rd, rn V2DReg, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDW, ErrNoMatch)
+	}
+	return a.emit(SADDW, 2, 19272, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDW2_V8HV8HV16B encodes saddw2 Vd.8H, Vn.8H, Vm.16B.
+func (a *Assembler) SADDW2_V8HV8HV16B(rd, rn V8HReg, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDW2, ErrNoMatch)
+	}
+	return a.emit(SADDW2, 0, 19280, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDW2_V4SV4SV8H encodes saddw2 Vd.4S, Vn.4S, Vm.8H.
+func (a *Assembler) SADDW2_V4SV4SV8H(rd, rn V4SReg, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDW2, ErrNoMatch)
+	}
+	return a.emit(SADDW2, 1, 19288, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SADDW2_V2DV2DV4S encodes saddw2 Vd.2D, Vn.2D, Vm.4S.
+func (a *Assembler) SADDW2_V2DV2DV4S(rd, rn V2DReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SADDW2, ErrNoMatch)
+	}
+	return a.emit(SADDW2, 2, 19296, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SB encodes sb.
+// Requires FEAT_SB.
+func (a *Assembler) SB() bool {
+	return a.emit(SB, 0, 19304, FeatSB, 0, 0)
+}
+
+// SBC_WWW encodes sbc Wd, Wn, Wm.
+func (a *Assembler) SBC_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SBC, ErrNoMatch)
+	}
+	return a.emit(SBC, 0, 19309, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SBC_XXX encodes sbc Xd, Xn, Xm.
+func (a *Assembler) SBC_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SBC, ErrNoMatch)
+	}
+	return a.emit(SBC, 1, 19317, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SBCS_WWW encodes sbcs Wd, Wn, Wm.
+func (a *Assembler) SBCS_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SBCS, ErrNoMatch)
+	}
+	return a.emit(SBCS, 0, 19325, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SBCS_XXX encodes sbcs Xd, Xn, Xm.
+func (a *Assembler) SBCS_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SBCS, ErrNoMatch)
+	}
+	return a.emit(SBCS, 1, 19333, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SBFIZ_WW_Imm_Imm encodes sbfiz Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32).
+func (a *Assembler) SBFIZ_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SBFIZ, ErrNoMatch)
+	}
+	return a.emit(SBFIZ, 0, 19341, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// SBFIZ_XX_Imm_Imm encodes sbfiz Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64).
+func (a *Assembler) SBFIZ_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SBFIZ, ErrNoMatch)
+	}
+	return a.emit(SBFIZ, 1, 19357, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// SBFM_WW_Imm_Imm encodes sbfm Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 <= imm2 < 32).
+func (a *Assembler) SBFM_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SBFM, ErrNoMatch)
+	}
+	return a.emit(SBFM, 0, 19373, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// SBFM_XX_Imm_Imm encodes sbfm Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64).
+func (a *Assembler) SBFM_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SBFM, ErrNoMatch)
+	}
+	return a.emit(SBFM, 1, 19386, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// SBFX_WW_Imm_Imm encodes sbfx Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32).
+func (a *Assembler) SBFX_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SBFX, ErrNoMatch)
+	}
+	return a.emit(SBFX, 0, 19401, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// SBFX_XX_Imm_Imm encodes sbfx Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64).
+func (a *Assembler) SBFX_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SBFX, ErrNoMatch)
+	}
+	return a.emit(SBFX, 1, 19416, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// SCVTF_HH_Imm encodes scvtf Hd, Hn, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_HH_Imm(rd, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 0, 19431, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_SS_Imm encodes scvtf Sd, Sn, #imm (0 < imm <= 32).
+func (a *Assembler) SCVTF_SS_Imm(rd, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 1, 19444, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_DD_Imm encodes scvtf Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) SCVTF_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 2, 19457, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_V8HV8H_Imm encodes scvtf Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 3, 19470, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_V4HV4H_Imm encodes scvtf Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 3, 19470, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_V4SV4S_Imm encodes scvtf Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) SCVTF_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 4, 19482, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_V2SV2S_Imm encodes scvtf Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) SCVTF_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 4, 19482, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_V2DV2D_Imm encodes scvtf Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) SCVTF_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 5, 19494, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_HH encodes scvtf Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 6, 19506, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_SS encodes scvtf Sd, Sn.
+func (a *Assembler) SCVTF_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 7, 19513, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_DD encodes scvtf Dd, Dn.
+func (a *Assembler) SCVTF_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 8, 19520, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_V8HV8H encodes scvtf Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 9, 19527, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_V4HV4H encodes scvtf Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 9, 19527, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_V4SV4S encodes scvtf Vd.4S, Vn.4S.
+func (a *Assembler) SCVTF_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 10, 19535, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_V2SV2S encodes scvtf Vd.2S, Vn.2S.
+func (a *Assembler) SCVTF_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 10, 19535, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_V2DV2D encodes scvtf Vd.2D, Vn.2D.
+func (a *Assembler) SCVTF_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 11, 19543, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_HW_Imm encodes scvtf Hd, Wn, #imm (0 < imm <= 32).
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_HW_Imm(rd HReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 12, 19551, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_SW_Imm encodes scvtf Sd, Wn, #imm (0 < imm <= 32).
+func (a *Assembler) SCVTF_SW_Imm(rd SReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 13, 19564, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_DW_Imm encodes scvtf Dd, Wn, #imm (0 < imm <= 32).
+func (a *Assembler) SCVTF_DW_Imm(rd DReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 14, 19577, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_HX_Imm encodes scvtf Hd, Xn, #imm (0 < imm <= 64).
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_HX_Imm(rd HReg, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 15, 19590, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_SX_Imm encodes scvtf Sd, Xn, #imm (0 < imm <= 64).
+func (a *Assembler) SCVTF_SX_Imm(rd SReg, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 16, 19601, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_DX_Imm encodes scvtf Dd, Xn, #imm (0 < imm <= 64).
+func (a *Assembler) SCVTF_DX_Imm(rd DReg, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 17, 19612, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SCVTF_HW encodes scvtf Hd, Wn.
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_HW(rd HReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 18, 19623, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_SW encodes scvtf Sd, Wn.
+func (a *Assembler) SCVTF_SW(rd SReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 19, 19630, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_DW encodes scvtf Dd, Wn.
+func (a *Assembler) SCVTF_DW(rd DReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 20, 19637, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_HX encodes scvtf Hd, Xn.
+// Requires FEAT_FP16.
+func (a *Assembler) SCVTF_HX(rd HReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 21, 19644, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_SX encodes scvtf Sd, Xn.
+func (a *Assembler) SCVTF_SX(rd SReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 22, 19651, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SCVTF_DX encodes scvtf Dd, Xn.
+func (a *Assembler) SCVTF_DX(rd DReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SCVTF, ErrNoMatch)
+	}
+	return a.emit(SCVTF, 23, 19658, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SDIV_WWW encodes sdiv Wd, Wn, Wm.
+func (a *Assembler) SDIV_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SDIV, ErrNoMatch)
+	}
+	return a.emit(SDIV, 0, 19692, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SDIV_XXX encodes sdiv Xd, Xn, Xm.
+func (a *Assembler) SDIV_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SDIV, ErrNoMatch)
+	}
+	return a.emit(SDIV, 1, 19700, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SDOT_V2SV8BV4Bi encodes sdot Vd.2S, Vn.8B, Vm.4B[i].
+// Requires FEAT_DotProd.
+func (a *Assembler) SDOT_V2SV8BV4Bi(rd V2SReg, rn V8BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SDOT, ErrNoMatch)
+	}
+	return a.emit(SDOT, 0, 19752, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SDOT_V4SV16BV4Bi encodes sdot Vd.4S, Vn.16B, Vm.4B[i].
+// Requires FEAT_DotProd.
+func (a *Assembler) SDOT_V4SV16BV4Bi(rd V4SReg, rn V16BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SDOT, ErrNoMatch)
+	}
+	return a.emit(SDOT, 1, 19762, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SDOT_V2SV8BV8B encodes sdot Vd.2S, Vn.8B, Vm.8B.
+// Requires FEAT_DotProd.
+func (a *Assembler) SDOT_V2SV8BV8B(rd V2SReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SDOT, ErrNoMatch)
+	}
+	return a.emit(SDOT, 2, 19772, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SDOT_V4SV16BV16B encodes sdot Vd.4S, Vn.16B, Vm.16B.
+// Requires FEAT_DotProd.
+func (a *Assembler) SDOT_V4SV16BV16B(rd V4SReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SDOT, ErrNoMatch)
+	}
+	return a.emit(SDOT, 3, 19780, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SETF16_W encodes setf16 Wd.
+// Requires FEAT_FlagM.
+func (a *Assembler) SETF16_W(rd WReg) bool {
+	if rd >= 32 {
+		return a.emitErr(SETF16, ErrNoMatch)
+	}
+	return a.emit(SETF16, 0, 19833, FeatFlagM, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// SETF8_W encodes setf8 Wd.
+// Requires FEAT_FlagM.
+func (a *Assembler) SETF8_W(rd WReg) bool {
+	if rd >= 32 {
+		return a.emitErr(SETF8, ErrNoMatch)
+	}
+	return a.emit(SETF8, 0, 19839, FeatFlagM, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// SETFFR encodes setffr.
+// Requires FEAT_SVE.
+func (a *Assembler) SETFFR() bool {
+	return a.emit(SETFFR, 0, 19845, FeatSVE, 0, 0)
+}
+
+// SEV encodes sev.
+func (a *Assembler) SEV() bool {
+	return a.emit(SEV, 0, 19895, 0, 0, 0)
+}
+
+// SEVL encodes sevl.
+func (a *Assembler) SEVL() bool {
+	return a.emit(SEVL, 0, 19900, 0, 0, 0)
+}
+
+// SHA1C_QSV4S encodes sha1c Qd, Sn, Vm.4S.
+// Requires FEAT_SHA1.
+func (a *Assembler) SHA1C_QSV4S(rd QReg, rn SReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA1C, ErrNoMatch)
+	}
+	return a.emit(SHA1C, 0, 19905, FeatSHA1, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA1H_SS encodes sha1h Sd, Sn.
+// Requires FEAT_SHA1.
+func (a *Assembler) SHA1H_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHA1H, ErrNoMatch)
+	}
+	return a.emit(SHA1H, 0, 19913, FeatSHA1, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHA1M_QSV4S encodes sha1m Qd, Sn, Vm.4S.
+// Requires FEAT_SHA1.
+func (a *Assembler) SHA1M_QSV4S(rd QReg, rn SReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA1M, ErrNoMatch)
+	}
+	return a.emit(SHA1M, 0, 19920, FeatSHA1, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA1P_QSV4S encodes sha1p Qd, Sn, Vm.4S.
+// Requires FEAT_SHA1.
+func (a *Assembler) SHA1P_QSV4S(rd QReg, rn SReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA1P, ErrNoMatch)
+	}
+	return a.emit(SHA1P, 0, 19928, FeatSHA1, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA1SU0_V4SV4SV4S encodes sha1su0 Vd.4S, Vn.4S, Vm.4S.
+// Requires FEAT_SHA1.
+func (a *Assembler) SHA1SU0_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA1SU0, ErrNoMatch)
+	}
+	return a.emit(SHA1SU0, 0, 19936, FeatSHA1, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA1SU1_V4SV4S encodes sha1su1 Vd.4S, Vn.4S.
+// Requires FEAT_SHA1.
+func (a *Assembler) SHA1SU1_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHA1SU1, ErrNoMatch)
+	}
+	return a.emit(SHA1SU1, 0, 19944, FeatSHA1, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHA256H_QQV4S encodes sha256h Qd, Qn, Vm.4S.
+// Requires FEAT_SHA256.
+func (a *Assembler) SHA256H_QQV4S(rd, rn QReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA256H, ErrNoMatch)
+	}
+	return a.emit(SHA256H, 0, 19951, FeatSHA256, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA256H2_QQV4S encodes sha256h2 Qd, Qn, Vm.4S.
+// Requires FEAT_SHA256.
+func (a *Assembler) SHA256H2_QQV4S(rd, rn QReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA256H2, ErrNoMatch)
+	}
+	return a.emit(SHA256H2, 0, 19959, FeatSHA256, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA256SU0_V4SV4S encodes sha256su0 Vd.4S, Vn.4S.
+// Requires FEAT_SHA256.
+func (a *Assembler) SHA256SU0_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHA256SU0, ErrNoMatch)
+	}
+	return a.emit(SHA256SU0, 0, 19967, FeatSHA256, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHA256SU1_V4SV4SV4S encodes sha256su1 Vd.4S, Vn.4S, Vm.4S.
+// Requires FEAT_SHA256.
+func (a *Assembler) SHA256SU1_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA256SU1, ErrNoMatch)
+	}
+	return a.emit(SHA256SU1, 0, 19974, FeatSHA256, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA512H_QQV2D encodes sha512h Qd, Qn, Vm.2D.
+// Requires FEAT_SHA512.
+func (a *Assembler) SHA512H_QQV2D(rd, rn QReg, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA512H, ErrNoMatch)
+	}
+	return a.emit(SHA512H, 0, 19982, FeatSHA512, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA512H2_QQV2D encodes sha512h2 Qd, Qn, Vm.2D.
+// Requires FEAT_SHA512.
+func (a *Assembler) SHA512H2_QQV2D(rd, rn QReg, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA512H2, ErrNoMatch)
+	}
+	return a.emit(SHA512H2, 0, 19990, FeatSHA512, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHA512SU0_V2DV2D encodes sha512su0 Vd.2D, Vn.2D.
+// Requires FEAT_SHA512.
+func (a *Assembler) SHA512SU0_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHA512SU0, ErrNoMatch)
+	}
+	return a.emit(SHA512SU0, 0, 19998, FeatSHA512, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHA512SU1_V2DV2DV2D encodes sha512su1 Vd.2D, Vn.2D, Vm.2D.
+// Requires FEAT_SHA512.
+func (a *Assembler) SHA512SU1_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHA512SU1, ErrNoMatch)
+	}
+	return a.emit(SHA512SU1, 0, 20005, FeatSHA512, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHADD_V16BV16BV16B encodes shadd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SHADD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHADD, ErrNoMatch)
+	}
+	return a.emit(SHADD, 0, 20013, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHADD_V8BV8BV8B encodes shadd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SHADD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHADD, ErrNoMatch)
+	}
+	return a.emit(SHADD, 0, 20013, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHADD_V8HV8HV8H encodes shadd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SHADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHADD, ErrNoMatch)
+	}
+	return a.emit(SHADD, 1, 20022, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHADD_V4HV4HV4H encodes shadd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SHADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHADD, ErrNoMatch)
+	}
+	return a.emit(SHADD, 1, 20022, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHADD_V4SV4SV4S encodes shadd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SHADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHADD, ErrNoMatch)
+	}
+	return a.emit(SHADD, 2, 20031, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHADD_V2SV2SV2S encodes shadd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SHADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHADD, ErrNoMatch)
+	}
+	return a.emit(SHADD, 2, 20031, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHL_DD_Imm encodes shl Dd, Dn, #imm (0 <= imm < 64).
+func (a *Assembler) SHL_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 0, 20040, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHL_V16BV16B_Imm encodes shl Vd.16B, Vn.16B, #imm (0 <= imm < 8).
+func (a *Assembler) SHL_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 1, 20050, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHL_V8BV8B_Imm encodes shl Vd.8B, Vn.8B, #imm (0 <= imm < 8).
+func (a *Assembler) SHL_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 1, 20050, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHL_V8HV8H_Imm encodes shl Vd.8H, Vn.8H, #imm (0 <= imm < 16).
+func (a *Assembler) SHL_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 2, 20061, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHL_V4HV4H_Imm encodes shl Vd.4H, Vn.4H, #imm (0 <= imm < 16).
+func (a *Assembler) SHL_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 2, 20061, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHL_V4SV4S_Imm encodes shl Vd.4S, Vn.4S, #imm (0 <= imm < 32).
+func (a *Assembler) SHL_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 3, 20072, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHL_V2SV2S_Imm encodes shl Vd.2S, Vn.2S, #imm (0 <= imm < 32).
+func (a *Assembler) SHL_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 3, 20072, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHL_V2DV2D_Imm encodes shl Vd.2D, Vn.2D, #imm (0 <= imm < 64).
+func (a *Assembler) SHL_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHL, ErrNoMatch)
+	}
+	return a.emit(SHL, 4, 20083, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHLL_V8HV8B_8 encodes shll Vd.8H, Vn.8B, #8.
+func (a *Assembler) SHLL_V8HV8B_8(rd V8HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHLL, ErrNoMatch)
+	}
+	return a.emit(SHLL, 0, 20094, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHLL_V4SV4H_16 encodes shll Vd.4S, Vn.4H, #16.
+func (a *Assembler) SHLL_V4SV4H_16(rd V4SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHLL, ErrNoMatch)
+	}
+	return a.emit(SHLL, 1, 20101, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHLL_V2DV2S_32 encodes shll Vd.2D, Vn.2S, #32.
+func (a *Assembler) SHLL_V2DV2S_32(rd V2DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHLL, ErrNoMatch)
+	}
+	return a.emit(SHLL, 2, 20108, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHLL2_V8HV16B_8 encodes shll2 Vd.8H, Vn.16B, #8.
+func (a *Assembler) SHLL2_V8HV16B_8(rd V8HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHLL2, ErrNoMatch)
+	}
+	return a.emit(SHLL2, 0, 20115, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHLL2_V4SV8H_16 encodes shll2 Vd.4S, Vn.8H, #16.
+func (a *Assembler) SHLL2_V4SV8H_16(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHLL2, ErrNoMatch)
+	}
+	return a.emit(SHLL2, 1, 20122, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHLL2_V2DV4S_32 encodes shll2 Vd.2D, Vn.4S, #32.
+func (a *Assembler) SHLL2_V2DV4S_32(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHLL2, ErrNoMatch)
+	}
+	return a.emit(SHLL2, 2, 20129, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SHRN_V8BV8H_Imm encodes shrn Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SHRN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHRN, ErrNoMatch)
+	}
+	return a.emit(SHRN, 0, 20136, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHRN_V4HV4S_Imm encodes shrn Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SHRN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHRN, ErrNoMatch)
+	}
+	return a.emit(SHRN, 1, 20147, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHRN_V2SV2D_Imm encodes shrn Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SHRN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHRN, ErrNoMatch)
+	}
+	return a.emit(SHRN, 2, 20158, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHRN2_V16BV8H_Imm encodes shrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SHRN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHRN2, ErrNoMatch)
+	}
+	return a.emit(SHRN2, 0, 20169, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHRN2_V8HV4S_Imm encodes shrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SHRN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHRN2, ErrNoMatch)
+	}
+	return a.emit(SHRN2, 1, 20180, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHRN2_V4SV2D_Imm encodes shrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SHRN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SHRN2, ErrNoMatch)
+	}
+	return a.emit(SHRN2, 2, 20191, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SHSUB_V16BV16BV16B encodes shsub Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SHSUB_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHSUB, ErrNoMatch)
+	}
+	return a.emit(SHSUB, 0, 20202, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHSUB_V8BV8BV8B encodes shsub Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SHSUB_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHSUB, ErrNoMatch)
+	}
+	return a.emit(SHSUB, 0, 20202, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHSUB_V8HV8HV8H encodes shsub Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SHSUB_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHSUB, ErrNoMatch)
+	}
+	return a.emit(SHSUB, 1, 20211, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHSUB_V4HV4HV4H encodes shsub Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SHSUB_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHSUB, ErrNoMatch)
+	}
+	return a.emit(SHSUB, 1, 20211, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHSUB_V4SV4SV4S encodes shsub Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SHSUB_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHSUB, ErrNoMatch)
+	}
+	return a.emit(SHSUB, 2, 20220, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SHSUB_V2SV2SV2S encodes shsub Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SHSUB_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SHSUB, ErrNoMatch)
+	}
+	return a.emit(SHSUB, 2, 20220, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SLI_DD_Imm encodes sli Dd, Dn, #imm (0 <= imm < 64).
+func (a *Assembler) SLI_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 0, 20229, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SLI_V16BV16B_Imm encodes sli Vd.16B, Vn.16B, #imm (0 <= imm < 8).
+func (a *Assembler) SLI_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 1, 20239, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SLI_V8BV8B_Imm encodes sli Vd.8B, Vn.8B, #imm (0 <= imm < 8).
+func (a *Assembler) SLI_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 1, 20239, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SLI_V8HV8H_Imm encodes sli Vd.8H, Vn.8H, #imm (0 <= imm < 16).
+func (a *Assembler) SLI_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 2, 20250, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SLI_V4HV4H_Imm encodes sli Vd.4H, Vn.4H, #imm (0 <= imm < 16).
+func (a *Assembler) SLI_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 2, 20250, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SLI_V4SV4S_Imm encodes sli Vd.4S, Vn.4S, #imm (0 <= imm < 32).
+func (a *Assembler) SLI_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 3, 20261, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SLI_V2SV2S_Imm encodes sli Vd.2S, Vn.2S, #imm (0 <= imm < 32).
+func (a *Assembler) SLI_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 3, 20261, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SLI_V2DV2D_Imm encodes sli Vd.2D, Vn.2D, #imm (0 <= imm < 64).
+func (a *Assembler) SLI_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SLI, ErrNoMatch)
+	}
+	return a.emit(SLI, 4, 20272, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SM3PARTW1_V4SV4SV4S encodes sm3partw1 Vd.4S, Vn.4S, Vm.4S.
+// Requires FEAT_SM3.
+func (a *Assembler) SM3PARTW1_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SM3PARTW1, ErrNoMatch)
+	}
+	return a.emit(SM3PARTW1, 0, 20283, FeatSM3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SM3PARTW2_V4SV4SV4S encodes sm3partw2 Vd.4S, Vn.4S, Vm.4S.
+// Requires FEAT_SM3.
+func (a *Assembler) SM3PARTW2_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SM3PARTW2, ErrNoMatch)
+	}
+	return a.emit(SM3PARTW2, 0, 20291, FeatSM3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SM3SS1_V4SV4SV4SV4S encodes sm3ss1 Vd.4S, Vn.4S, Vm.4S, Va.4S.
+// Requires FEAT_SM3.
+func (a *Assembler) SM3SS1_V4SV4SV4SV4S(rd, rn, rm, ra V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(SM3SS1, ErrNoMatch)
+	}
+	return a.emit(SM3SS1, 0, 20299, FeatSM3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// SM3TT1A_V4SV4SVSi encodes sm3tt1a Vd.4S, Vn.4S, Vm.S[i].
+// Requires FEAT_SM3.
+func (a *Assembler) SM3TT1A_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SM3TT1A, ErrNoMatch)
+	}
+	return a.emit(SM3TT1A, 0, 20308, FeatSM3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SM3TT1B_V4SV4SVSi encodes sm3tt1b Vd.4S, Vn.4S, Vm.S[i].
+// Requires FEAT_SM3.
+func (a *Assembler) SM3TT1B_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SM3TT1B, ErrNoMatch)
+	}
+	return a.emit(SM3TT1B, 0, 20319, FeatSM3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SM3TT2A_V4SV4SVSi encodes sm3tt2a Vd.4S, Vn.4S, Vm.S[i].
+// Requires FEAT_SM3.
+func (a *Assembler) SM3TT2A_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SM3TT2A, ErrNoMatch)
+	}
+	return a.emit(SM3TT2A, 0, 20330, FeatSM3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SM3TT2B_V4SV4SVSi encodes sm3tt2b Vd.4S, Vn.4S, Vm.S[i].
+// Requires FEAT_SM3.
+func (a *Assembler) SM3TT2B_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SM3TT2B, ErrNoMatch)
+	}
+	return a.emit(SM3TT2B, 0, 20341, FeatSM3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SM4E_V4SV4S encodes sm4e Vd.4S, Vn.4S.
+// Requires FEAT_SM4.
+func (a *Assembler) SM4E_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SM4E, ErrNoMatch)
+	}
+	return a.emit(SM4E, 0, 20352, FeatSM4, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SM4EKEY_V4SV4SV4S encodes sm4ekey Vd.4S, Vn.4S, Vm.4S.
+// Requires FEAT_SM4.
+func (a *Assembler) SM4EKEY_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SM4EKEY, ErrNoMatch)
+	}
+	return a.emit(SM4EKEY, 0, 20359, FeatSM4, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMADDL_XWWX encodes smaddl Xd, Wn, Wm, Xa.
+func (a *Assembler) SMADDL_XWWX(rd XReg, rn, rm WReg, ra XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(SMADDL, ErrNoMatch)
+	}
+	return a.emit(SMADDL, 0, 20367, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// SMAX_V16BV16BV16B encodes smax Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SMAX_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 0, 20376, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAX_V8BV8BV8B encodes smax Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SMAX_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 0, 20376, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAX_V8HV8HV8H encodes smax Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SMAX_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 1, 20385, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAX_V4HV4HV4H encodes smax Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SMAX_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 1, 20385, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAX_V4SV4SV4S encodes smax Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SMAX_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 2, 20394, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAX_V2SV2SV2S encodes smax Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SMAX_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 2, 20394, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAX_WW_Imm encodes smax Wd, Wn, #imm (-128 <= imm < 128).
+// Requires FEAT_CSSC.
+func (a *Assembler) SMAX_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 3, 20403, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SMAX_XX_Imm encodes smax Xd, Xn, #imm (-128 <= imm < 128).
+// Requires FEAT_CSSC.
+func (a *Assembler) SMAX_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 4, 20413, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SMAX_WWW encodes smax Wd, Wn, Wm.
+// Requires FEAT_CSSC.
+func (a *Assembler) SMAX_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 5, 20423, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAX_XXX encodes smax Xd, Xn, Xm.
+// Requires FEAT_CSSC.
+func (a *Assembler) SMAX_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAX, ErrNoMatch)
+	}
+	return a.emit(SMAX, 6, 20431, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAXP_V16BV16BV16B encodes smaxp Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SMAXP_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAXP, ErrNoMatch)
+	}
+	return a.emit(SMAXP, 0, 20527, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAXP_V8BV8BV8B encodes smaxp Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SMAXP_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAXP, ErrNoMatch)
+	}
+	return a.emit(SMAXP, 0, 20527, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAXP_V8HV8HV8H encodes smaxp Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SMAXP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAXP, ErrNoMatch)
+	}
+	return a.emit(SMAXP, 1, 20536, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAXP_V4HV4HV4H encodes smaxp Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SMAXP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAXP, ErrNoMatch)
+	}
+	return a.emit(SMAXP, 1, 20536, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAXP_V4SV4SV4S encodes smaxp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SMAXP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAXP, ErrNoMatch)
+	}
+	return a.emit(SMAXP, 2, 20545, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAXP_V2SV2SV2S encodes smaxp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SMAXP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMAXP, ErrNoMatch)
+	}
+	return a.emit(SMAXP, 2, 20545, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMAXV_BV16B encodes smaxv Bd, Vn.16B.
+func (a *Assembler) SMAXV_BV16B(rd BReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMAXV, ErrNoMatch)
+	}
+	return a.emit(SMAXV, 0, 20554, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMAXV_BV8B encodes smaxv Bd, Vn.8B.
+func (a *Assembler) SMAXV_BV8B(rd BReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMAXV, ErrNoMatch)
+	}
+	return a.emit(SMAXV, 0, 20554, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMAXV_HV8H encodes smaxv Hd, Vn.8H.
+func (a *Assembler) SMAXV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMAXV, ErrNoMatch)
+	}
+	return a.emit(SMAXV, 1, 20562, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMAXV_HV4H encodes smaxv Hd, Vn.4H.
+func (a *Assembler) SMAXV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMAXV, ErrNoMatch)
+	}
+	return a.emit(SMAXV, 1, 20562, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMAXV_SV4S encodes smaxv Sd, Vn.4S.
+func (a *Assembler) SMAXV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMAXV, ErrNoMatch)
+	}
+	return a.emit(SMAXV, 2, 20570, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMC_Imm encodes smc #imm (0 <= imm < 65536).
+func (a *Assembler) SMC_Imm(imm int64) bool {
+	return a.emit(SMC, 0, 20614, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// SMIN_V16BV16BV16B encodes smin Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SMIN_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 0, 20622, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMIN_V8BV8BV8B encodes smin Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SMIN_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 0, 20622, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMIN_V8HV8HV8H encodes smin Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SMIN_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 1, 20631, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMIN_V4HV4HV4H encodes smin Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SMIN_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 1, 20631, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMIN_V4SV4SV4S encodes smin Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SMIN_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 2, 20640, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMIN_V2SV2SV2S encodes smin Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SMIN_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 2, 20640, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMIN_WW_Imm encodes smin Wd, Wn, #imm (-128 <= imm < 128).
+// Requires FEAT_CSSC.
+func (a *Assembler) SMIN_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 3, 20649, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SMIN_XX_Imm encodes smin Xd, Xn, #imm (-128 <= imm < 128).
+// Requires FEAT_CSSC.
+func (a *Assembler) SMIN_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 4, 20659, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SMIN_WWW encodes smin Wd, Wn, Wm.
+// Requires FEAT_CSSC.
+func (a *Assembler) SMIN_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 5, 20669, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMIN_XXX encodes smin Xd, Xn, Xm.
+// Requires FEAT_CSSC.
+func (a *Assembler) SMIN_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMIN, ErrNoMatch)
+	}
+	return a.emit(SMIN, 6, 20677, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMINP_V16BV16BV16B encodes sminp Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SMINP_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMINP, ErrNoMatch)
+	}
+	return a.emit(SMINP, 0, 20773, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMINP_V8BV8BV8B encodes sminp Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SMINP_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMINP, ErrNoMatch)
+	}
+	return a.emit(SMINP, 0, 20773, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMINP_V8HV8HV8H encodes sminp Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SMINP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMINP, ErrNoMatch)
+	}
+	return a.emit(SMINP, 1, 20782, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMINP_V4HV4HV4H encodes sminp Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SMINP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMINP, ErrNoMatch)
+	}
+	return a.emit(SMINP, 1, 20782, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMINP_V4SV4SV4S encodes sminp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SMINP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMINP, ErrNoMatch)
+	}
+	return a.emit(SMINP, 2, 20791, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMINP_V2SV2SV2S encodes sminp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SMINP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMINP, ErrNoMatch)
+	}
+	return a.emit(SMINP, 2, 20791, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMINV_BV16B encodes sminv Bd, Vn.16B.
+func (a *Assembler) SMINV_BV16B(rd BReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMINV, ErrNoMatch)
+	}
+	return a.emit(SMINV, 0, 20800, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMINV_BV8B encodes sminv Bd, Vn.8B.
+func (a *Assembler) SMINV_BV8B(rd BReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMINV, ErrNoMatch)
+	}
+	return a.emit(SMINV, 0, 20800, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMINV_HV8H encodes sminv Hd, Vn.8H.
+func (a *Assembler) SMINV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMINV, ErrNoMatch)
+	}
+	return a.emit(SMINV, 1, 20808, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMINV_HV4H encodes sminv Hd, Vn.4H.
+func (a *Assembler) SMINV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMINV, ErrNoMatch)
+	}
+	return a.emit(SMINV, 1, 20808, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMINV_SV4S encodes sminv Sd, Vn.4S.
+func (a *Assembler) SMINV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SMINV, ErrNoMatch)
+	}
+	return a.emit(SMINV, 2, 20816, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SMLAL_V4SV4HVHi encodes smlal Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SMLAL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SMLAL, ErrNoMatch)
+	}
+	return a.emit(SMLAL, 0, 20860, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLAL_V2DV2SVSi encodes smlal Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) SMLAL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SMLAL, ErrNoMatch)
+	}
+	return a.emit(SMLAL, 1, 20870, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLAL_V8HV8BV8B encodes smlal Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) SMLAL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLAL, ErrNoMatch)
+	}
+	return a.emit(SMLAL, 2, 20880, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLAL_V4SV4HV4H encodes smlal Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SMLAL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLAL, ErrNoMatch)
+	}
+	return a.emit(SMLAL, 3, 20888, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLAL_V2DV2SV2S encodes smlal Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SMLAL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLAL, ErrNoMatch)
+	}
+	return a.emit(SMLAL, 4, 20896, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLAL2_V4SV8HVHi encodes smlal2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SMLAL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SMLAL2, ErrNoMatch)
+	}
+	return a.emit(SMLAL2, 0, 20904, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLAL2_V2DV4SVSi encodes smlal2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) SMLAL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SMLAL2, ErrNoMatch)
+	}
+	return a.emit(SMLAL2, 1, 20914, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLAL2_V8HV16BV16B encodes smlal2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) SMLAL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLAL2, ErrNoMatch)
+	}
+	return a.emit(SMLAL2, 2, 20924, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLAL2_V4SV8HV8H encodes smlal2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SMLAL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLAL2, ErrNoMatch)
+	}
+	return a.emit(SMLAL2, 3, 20932, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLAL2_V2DV4SV4S encodes smlal2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SMLAL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLAL2, ErrNoMatch)
+	}
+	return a.emit(SMLAL2, 4, 20940, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLSL_V4SV4HVHi encodes smlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SMLSL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SMLSL, ErrNoMatch)
+	}
+	return a.emit(SMLSL, 0, 20948, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLSL_V2DV2SVSi encodes smlsl Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) SMLSL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SMLSL, ErrNoMatch)
+	}
+	return a.emit(SMLSL, 1, 20958, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLSL_V8HV8BV8B encodes smlsl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) SMLSL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLSL, ErrNoMatch)
+	}
+	return a.emit(SMLSL, 2, 20968, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLSL_V4SV4HV4H encodes smlsl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SMLSL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLSL, ErrNoMatch)
+	}
+	return a.emit(SMLSL, 3, 20976, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLSL_V2DV2SV2S encodes smlsl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SMLSL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLSL, ErrNoMatch)
+	}
+	return a.emit(SMLSL, 4, 20984, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLSL2_V4SV8HVHi encodes smlsl2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SMLSL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SMLSL2, ErrNoMatch)
+	}
+	return a.emit(SMLSL2, 0, 20992, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLSL2_V2DV4SVSi encodes smlsl2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) SMLSL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SMLSL2, ErrNoMatch)
+	}
+	return a.emit(SMLSL2, 1, 21002, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMLSL2_V8HV16BV16B encodes smlsl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) SMLSL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLSL2, ErrNoMatch)
+	}
+	return a.emit(SMLSL2, 2, 21012, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLSL2_V4SV8HV8H encodes smlsl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SMLSL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLSL2, ErrNoMatch)
+	}
+	return a.emit(SMLSL2, 3, 21020, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMLSL2_V2DV4SV4S encodes smlsl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SMLSL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMLSL2, ErrNoMatch)
+	}
+	return a.emit(SMLSL2, 4, 21028, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMMLA_V4SV16BV16B encodes smmla Vd.4S, Vn.16B, Vm.16B.
+// Requires FEAT_I8MM.
+func (a *Assembler) SMMLA_V4SV16BV16B(rd V4SReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMMLA, ErrNoMatch)
+	}
+	return a.emit(SMMLA, 0, 21036, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMNEGL_XWW encodes smnegl Xd, Wn, Wm.
+func (a *Assembler) SMNEGL_XWW(rd XReg, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMNEGL, ErrNoMatch)
+	}
+	return a.emit(SMNEGL, 0, 21044, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMOV_WVBi encodes smov Wd, Vn.B[i].
+func (a *Assembler) SMOV_WVBi(rd WReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(SMOV, ErrNoMatch)
+	}
+	return a.emit(SMOV, 0, 21108, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMOV_WVHi encodes smov Wd, Vn.H[i].
+func (a *Assembler) SMOV_WVHi(rd WReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(SMOV, ErrNoMatch)
+	}
+	return a.emit(SMOV, 1, 21118, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMOV_XVBi encodes smov Xd, Vn.B[i].
+func (a *Assembler) SMOV_XVBi(rd XReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(SMOV, ErrNoMatch)
+	}
+	return a.emit(SMOV, 2, 21128, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMOV_XVHi encodes smov Xd, Vn.H[i].
+func (a *Assembler) SMOV_XVHi(rd XReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(SMOV, ErrNoMatch)
+	}
+	return a.emit(SMOV, 3, 21138, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMOV_XVSi encodes smov Xd, Vn.S[i].
+func (a *Assembler) SMOV_XVSi(rd XReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(SMOV, ErrNoMatch)
+	}
+	return a.emit(SMOV, 4, 21148, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMSTART encodes smstart.
+// Requires FEAT_SME.
+func (a *Assembler) SMSTART() bool {
+	return a.emit(SMSTART, 0, 21158, FeatSME, 0, 0)
+}
+
+// SMSTART_Sym encodes smstart <symbol>.
+// Requires FEAT_SME.
+func (a *Assembler) SMSTART_Sym(sym Symbol) bool {
+	return a.emit(SMSTART, 1, 21163, FeatSME, 0, 0, Flat{FlatImm, uint64(sym)})
+}
+
+// SMSTOP encodes smstop.
+// Requires FEAT_SME.
+func (a *Assembler) SMSTOP() bool {
+	return a.emit(SMSTOP, 0, 21171, FeatSME, 0, 0)
+}
+
+// SMSTOP_Sym encodes smstop <symbol>.
+// Requires FEAT_SME.
+func (a *Assembler) SMSTOP_Sym(sym Symbol) bool {
+	return a.emit(SMSTOP, 1, 21176, FeatSME, 0, 0, Flat{FlatImm, uint64(sym)})
+}
+
+// SMSUBL_XWWX encodes smsubl Xd, Wn, Wm, Xa.
+func (a *Assembler) SMSUBL_XWWX(rd XReg, rn, rm WReg, ra XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(SMSUBL, ErrNoMatch)
+	}
+	return a.emit(SMSUBL, 0, 21184, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// SMULH_XXX encodes smulh Xd, Xn, Xm.
+func (a *Assembler) SMULH_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULH, ErrNoMatch)
+	}
+	return a.emit(SMULH, 0, 21193, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMULL_V4SV4HVHi encodes smull Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SMULL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SMULL, ErrNoMatch)
+	}
+	return a.emit(SMULL, 0, 21277, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMULL_V2DV2SVSi encodes smull Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) SMULL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SMULL, ErrNoMatch)
+	}
+	return a.emit(SMULL, 1, 21287, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMULL_V8HV8BV8B encodes smull Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) SMULL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULL, ErrNoMatch)
+	}
+	return a.emit(SMULL, 2, 21297, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMULL_V4SV4HV4H encodes smull Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SMULL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULL, ErrNoMatch)
+	}
+	return a.emit(SMULL, 3, 21305, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMULL_V2DV2SV2S encodes smull Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SMULL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULL, ErrNoMatch)
+	}
+	return a.emit(SMULL, 4, 21313, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMULL_XWW encodes smull Xd, Wn, Wm.
+func (a *Assembler) SMULL_XWW(rd XReg, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULL, ErrNoMatch)
+	}
+	return a.emit(SMULL, 5, 21321, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMULL2_V4SV8HVHi encodes smull2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SMULL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SMULL2, ErrNoMatch)
+	}
+	return a.emit(SMULL2, 0, 21329, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMULL2_V2DV4SVSi encodes smull2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) SMULL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SMULL2, ErrNoMatch)
+	}
+	return a.emit(SMULL2, 1, 21339, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SMULL2_V8HV16BV16B encodes smull2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) SMULL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULL2, ErrNoMatch)
+	}
+	return a.emit(SMULL2, 2, 21349, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMULL2_V4SV8HV8H encodes smull2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SMULL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULL2, ErrNoMatch)
+	}
+	return a.emit(SMULL2, 3, 21357, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SMULL2_V2DV4SV4S encodes smull2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SMULL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SMULL2, ErrNoMatch)
+	}
+	return a.emit(SMULL2, 4, 21365, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQABS_BB encodes sqabs Bd, Bn.
+func (a *Assembler) SQABS_BB(rd, rn BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 0, 21417, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_HH encodes sqabs Hd, Hn.
+func (a *Assembler) SQABS_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 1, 21424, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_SS encodes sqabs Sd, Sn.
+func (a *Assembler) SQABS_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 2, 21431, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_DD encodes sqabs Dd, Dn.
+func (a *Assembler) SQABS_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 3, 21438, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_V16BV16B encodes sqabs Vd.16B, Vn.16B.
+func (a *Assembler) SQABS_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 4, 21445, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_V8BV8B encodes sqabs Vd.8B, Vn.8B.
+func (a *Assembler) SQABS_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 4, 21445, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_V8HV8H encodes sqabs Vd.8H, Vn.8H.
+func (a *Assembler) SQABS_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 5, 21453, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_V4HV4H encodes sqabs Vd.4H, Vn.4H.
+func (a *Assembler) SQABS_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 5, 21453, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_V4SV4S encodes sqabs Vd.4S, Vn.4S.
+func (a *Assembler) SQABS_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 6, 21461, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_V2SV2S encodes sqabs Vd.2S, Vn.2S.
+func (a *Assembler) SQABS_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 6, 21461, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQABS_V2DV2D encodes sqabs Vd.2D, Vn.2D.
+func (a *Assembler) SQABS_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQABS, ErrNoMatch)
+	}
+	return a.emit(SQABS, 7, 21469, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQADD_BBB encodes sqadd Bd, Bn, Bm.
+func (a *Assembler) SQADD_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 0, 21477, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_HHH encodes sqadd Hd, Hn, Hm.
+func (a *Assembler) SQADD_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 1, 21485, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_SSS encodes sqadd Sd, Sn, Sm.
+func (a *Assembler) SQADD_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 2, 21493, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_DDD encodes sqadd Dd, Dn, Dm.
+func (a *Assembler) SQADD_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 3, 21501, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_V16BV16BV16B encodes sqadd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SQADD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 4, 21509, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_V8BV8BV8B encodes sqadd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SQADD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 4, 21509, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_V8HV8HV8H encodes sqadd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SQADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 5, 21518, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_V4HV4HV4H encodes sqadd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SQADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 5, 21518, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_V4SV4SV4S encodes sqadd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SQADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 6, 21527, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_V2SV2SV2S encodes sqadd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SQADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 6, 21527, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQADD_V2DV2DV2D encodes sqadd Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) SQADD_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQADD, ErrNoMatch)
+	}
+	return a.emit(SQADD, 7, 21536, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLAL_SHVHi encodes sqdmlal Sd, Hn, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMLAL_SHVHi(rd SReg, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 0, 21630, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLAL_DSVSi encodes sqdmlal Dd, Sn, Vm.S[i].
+func (a *Assembler) SQDMLAL_DSVSi(rd DReg, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 1, 21640, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLAL_V4SV4HVHi encodes sqdmlal Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMLAL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 2, 21650, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLAL_V2DV2SVSi encodes sqdmlal Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) SQDMLAL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 3, 21660, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLAL_SHH encodes sqdmlal Sd, Hn, Hm.
+func (a *Assembler) SQDMLAL_SHH(rd SReg, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 4, 21670, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLAL_DSS encodes sqdmlal Dd, Sn, Sm.
+func (a *Assembler) SQDMLAL_DSS(rd DReg, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 5, 21678, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLAL_V4SV4HV4H encodes sqdmlal Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SQDMLAL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 6, 21686, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLAL_V2DV2SV2S encodes sqdmlal Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SQDMLAL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLAL, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL, 7, 21694, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLAL2_V4SV8HVHi encodes sqdmlal2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMLAL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMLAL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL2, 0, 21702, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLAL2_V2DV4SVSi encodes sqdmlal2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) SQDMLAL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMLAL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL2, 1, 21712, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLAL2_V4SV8HV8H encodes sqdmlal2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SQDMLAL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLAL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL2, 2, 21722, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLAL2_V2DV4SV4S encodes sqdmlal2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SQDMLAL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLAL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLAL2, 3, 21730, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLSL_SHVHi encodes sqdmlsl Sd, Hn, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMLSL_SHVHi(rd SReg, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 0, 21738, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLSL_DSVSi encodes sqdmlsl Dd, Sn, Vm.S[i].
+func (a *Assembler) SQDMLSL_DSVSi(rd DReg, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 1, 21748, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLSL_V4SV4HVHi encodes sqdmlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMLSL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 2, 21758, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLSL_V2DV2SVSi encodes sqdmlsl Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) SQDMLSL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 3, 21768, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLSL_SHH encodes sqdmlsl Sd, Hn, Hm.
+func (a *Assembler) SQDMLSL_SHH(rd SReg, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 4, 21778, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLSL_DSS encodes sqdmlsl Dd, Sn, Sm.
+func (a *Assembler) SQDMLSL_DSS(rd DReg, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 5, 21786, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLSL_V4SV4HV4H encodes sqdmlsl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SQDMLSL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 6, 21794, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLSL_V2DV2SV2S encodes sqdmlsl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SQDMLSL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLSL, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL, 7, 21802, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLSL2_V4SV8HVHi encodes sqdmlsl2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMLSL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMLSL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL2, 0, 21810, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLSL2_V2DV4SVSi encodes sqdmlsl2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) SQDMLSL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMLSL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL2, 1, 21820, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMLSL2_V4SV8HV8H encodes sqdmlsl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SQDMLSL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLSL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL2, 2, 21830, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMLSL2_V2DV4SV4S encodes sqdmlsl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SQDMLSL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMLSL2, ErrNoMatch)
+	}
+	return a.emit(SQDMLSL2, 3, 21838, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULH_HHVHi encodes sqdmulh Hd, Hn, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMULH_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 0, 21846, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULH_SSVSi encodes sqdmulh Sd, Sn, Vm.S[i].
+func (a *Assembler) SQDMULH_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 1, 21856, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULH_V8HV8HVHi encodes sqdmulh Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMULH_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 2, 21866, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULH_V4HV4HVHi encodes sqdmulh Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMULH_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 2, 21866, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULH_V4SV4SVSi encodes sqdmulh Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) SQDMULH_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 3, 21877, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULH_V2SV2SVSi encodes sqdmulh Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) SQDMULH_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 3, 21877, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULH_HHH encodes sqdmulh Hd, Hn, Hm.
+func (a *Assembler) SQDMULH_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 4, 21888, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULH_SSS encodes sqdmulh Sd, Sn, Sm.
+func (a *Assembler) SQDMULH_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 5, 21896, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULH_V8HV8HV8H encodes sqdmulh Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SQDMULH_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 6, 21904, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULH_V4HV4HV4H encodes sqdmulh Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SQDMULH_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 6, 21904, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULH_V4SV4SV4S encodes sqdmulh Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SQDMULH_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 7, 21913, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULH_V2SV2SV2S encodes sqdmulh Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SQDMULH_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULH, ErrNoMatch)
+	}
+	return a.emit(SQDMULH, 7, 21913, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULL_SHVHi encodes sqdmull Sd, Hn, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMULL_SHVHi(rd SReg, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 0, 21954, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULL_DSVSi encodes sqdmull Dd, Sn, Vm.S[i].
+func (a *Assembler) SQDMULL_DSVSi(rd DReg, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 1, 21964, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULL_V4SV4HVHi encodes sqdmull Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMULL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 2, 21974, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULL_V2DV2SVSi encodes sqdmull Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) SQDMULL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 3, 21984, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULL_SHH encodes sqdmull Sd, Hn, Hm.
+func (a *Assembler) SQDMULL_SHH(rd SReg, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 4, 21994, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULL_DSS encodes sqdmull Dd, Sn, Sm.
+func (a *Assembler) SQDMULL_DSS(rd DReg, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 5, 22002, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULL_V4SV4HV4H encodes sqdmull Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SQDMULL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 6, 22010, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULL_V2DV2SV2S encodes sqdmull Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SQDMULL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULL, ErrNoMatch)
+	}
+	return a.emit(SQDMULL, 7, 22018, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULL2_V4SV8HVHi encodes sqdmull2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SQDMULL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQDMULL2, ErrNoMatch)
+	}
+	return a.emit(SQDMULL2, 0, 22026, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULL2_V2DV4SVSi encodes sqdmull2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) SQDMULL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQDMULL2, ErrNoMatch)
+	}
+	return a.emit(SQDMULL2, 1, 22036, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQDMULL2_V4SV8HV8H encodes sqdmull2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SQDMULL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULL2, ErrNoMatch)
+	}
+	return a.emit(SQDMULL2, 2, 22046, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQDMULL2_V2DV4SV4S encodes sqdmull2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SQDMULL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQDMULL2, ErrNoMatch)
+	}
+	return a.emit(SQDMULL2, 3, 22054, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQNEG_BB encodes sqneg Bd, Bn.
+func (a *Assembler) SQNEG_BB(rd, rn BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 0, 22062, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_HH encodes sqneg Hd, Hn.
+func (a *Assembler) SQNEG_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 1, 22069, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_SS encodes sqneg Sd, Sn.
+func (a *Assembler) SQNEG_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 2, 22076, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_DD encodes sqneg Dd, Dn.
+func (a *Assembler) SQNEG_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 3, 22083, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_V16BV16B encodes sqneg Vd.16B, Vn.16B.
+func (a *Assembler) SQNEG_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 4, 22090, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_V8BV8B encodes sqneg Vd.8B, Vn.8B.
+func (a *Assembler) SQNEG_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 4, 22090, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_V8HV8H encodes sqneg Vd.8H, Vn.8H.
+func (a *Assembler) SQNEG_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 5, 22098, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_V4HV4H encodes sqneg Vd.4H, Vn.4H.
+func (a *Assembler) SQNEG_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 5, 22098, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_V4SV4S encodes sqneg Vd.4S, Vn.4S.
+func (a *Assembler) SQNEG_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 6, 22106, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_V2SV2S encodes sqneg Vd.2S, Vn.2S.
+func (a *Assembler) SQNEG_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 6, 22106, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQNEG_V2DV2D encodes sqneg Vd.2D, Vn.2D.
+func (a *Assembler) SQNEG_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQNEG, ErrNoMatch)
+	}
+	return a.emit(SQNEG, 7, 22114, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQRDMLAH_HHVHi encodes sqrdmlah Hd, Hn, Vm.H[i] (m < 16).
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 0, 22122, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLAH_SSVSi encodes sqrdmlah Sd, Sn, Vm.S[i].
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 1, 22132, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLAH_V8HV8HVHi encodes sqrdmlah Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 2, 22142, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLAH_V4HV4HVHi encodes sqrdmlah Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 2, 22142, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLAH_V4SV4SVSi encodes sqrdmlah Vd.4S, Vn.4S, Vm.S[i].
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 3, 22153, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLAH_V2SV2SVSi encodes sqrdmlah Vd.2S, Vn.2S, Vm.S[i].
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 3, 22153, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLAH_HHH encodes sqrdmlah Hd, Hn, Hm.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 4, 22164, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLAH_SSS encodes sqrdmlah Sd, Sn, Sm.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 5, 22172, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLAH_V8HV8HV8H encodes sqrdmlah Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 6, 22180, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLAH_V4HV4HV4H encodes sqrdmlah Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 6, 22180, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLAH_V4SV4SV4S encodes sqrdmlah Vd.4S, Vn.4S, Vm.4S.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 7, 22189, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLAH_V2SV2SV2S encodes sqrdmlah Vd.2S, Vn.2S, Vm.2S.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLAH_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLAH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLAH, 7, 22189, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLSH_HHVHi encodes sqrdmlsh Hd, Hn, Vm.H[i] (m < 16).
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 0, 22198, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLSH_SSVSi encodes sqrdmlsh Sd, Sn, Vm.S[i].
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 1, 22208, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLSH_V8HV8HVHi encodes sqrdmlsh Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 2, 22218, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLSH_V4HV4HVHi encodes sqrdmlsh Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 2, 22218, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLSH_V4SV4SVSi encodes sqrdmlsh Vd.4S, Vn.4S, Vm.S[i].
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 3, 22229, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLSH_V2SV2SVSi encodes sqrdmlsh Vd.2S, Vn.2S, Vm.S[i].
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 3, 22229, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMLSH_HHH encodes sqrdmlsh Hd, Hn, Hm.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 4, 22240, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLSH_SSS encodes sqrdmlsh Sd, Sn, Sm.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 5, 22248, FeatRDM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLSH_V8HV8HV8H encodes sqrdmlsh Vd.8H, Vn.8H, Vm.8H.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 6, 22256, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLSH_V4HV4HV4H encodes sqrdmlsh Vd.4H, Vn.4H, Vm.4H.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 6, 22256, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLSH_V4SV4SV4S encodes sqrdmlsh Vd.4S, Vn.4S, Vm.4S.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 7, 22265, FeatRDM, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMLSH_V2SV2SV2S encodes sqrdmlsh Vd.2S, Vn.2S, Vm.2S.
+// Requires FEAT_RDM.
+func (a *Assembler) SQRDMLSH_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMLSH, ErrNoMatch)
+	}
+	return a.emit(SQRDMLSH, 7, 22265, FeatRDM, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMULH_HHVHi encodes sqrdmulh Hd, Hn, Vm.H[i] (m < 16).
+func (a *Assembler) SQRDMULH_HHVHi(rd, rn HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 0, 22274, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMULH_SSVSi encodes sqrdmulh Sd, Sn, Vm.S[i].
+func (a *Assembler) SQRDMULH_SSVSi(rd, rn SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 1, 22284, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMULH_V8HV8HVHi encodes sqrdmulh Vd.8H, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) SQRDMULH_V8HV8HVHi(rd, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 2, 22294, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMULH_V4HV4HVHi encodes sqrdmulh Vd.4H, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) SQRDMULH_V4HV4HVHi(rd, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 2, 22294, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMULH_V4SV4SVSi encodes sqrdmulh Vd.4S, Vn.4S, Vm.S[i].
+func (a *Assembler) SQRDMULH_V4SV4SVSi(rd, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 3, 22305, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMULH_V2SV2SVSi encodes sqrdmulh Vd.2S, Vn.2S, Vm.S[i].
+func (a *Assembler) SQRDMULH_V2SV2SVSi(rd, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 3, 22305, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SQRDMULH_HHH encodes sqrdmulh Hd, Hn, Hm.
+func (a *Assembler) SQRDMULH_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 4, 22316, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMULH_SSS encodes sqrdmulh Sd, Sn, Sm.
+func (a *Assembler) SQRDMULH_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 5, 22324, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMULH_V8HV8HV8H encodes sqrdmulh Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SQRDMULH_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 6, 22332, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMULH_V4HV4HV4H encodes sqrdmulh Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SQRDMULH_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 6, 22332, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMULH_V4SV4SV4S encodes sqrdmulh Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SQRDMULH_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 7, 22341, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRDMULH_V2SV2SV2S encodes sqrdmulh Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SQRDMULH_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRDMULH, ErrNoMatch)
+	}
+	return a.emit(SQRDMULH, 7, 22341, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_BBB encodes sqrshl Bd, Bn, Bm.
+func (a *Assembler) SQRSHL_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 0, 22350, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_HHH encodes sqrshl Hd, Hn, Hm.
+func (a *Assembler) SQRSHL_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 1, 22358, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_SSS encodes sqrshl Sd, Sn, Sm.
+func (a *Assembler) SQRSHL_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 2, 22366, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_DDD encodes sqrshl Dd, Dn, Dm.
+func (a *Assembler) SQRSHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 3, 22374, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_V16BV16BV16B encodes sqrshl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SQRSHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 4, 22382, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_V8BV8BV8B encodes sqrshl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SQRSHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 4, 22382, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_V8HV8HV8H encodes sqrshl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SQRSHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 5, 22391, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_V4HV4HV4H encodes sqrshl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SQRSHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 5, 22391, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_V4SV4SV4S encodes sqrshl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SQRSHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 6, 22400, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_V2SV2SV2S encodes sqrshl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SQRSHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 6, 22400, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHL_V2DV2DV2D encodes sqrshl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) SQRSHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQRSHL, ErrNoMatch)
+	}
+	return a.emit(SQRSHL, 7, 22409, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQRSHRN_BH_Imm encodes sqrshrn Bd, Hn, #imm (0 < imm <= 8).
+func (a *Assembler) SQRSHRN_BH_Imm(rd BReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN, 0, 22418, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN_HS_Imm encodes sqrshrn Hd, Sn, #imm (0 < imm <= 16).
+func (a *Assembler) SQRSHRN_HS_Imm(rd HReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN, 1, 22431, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN_SD_Imm encodes sqrshrn Sd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) SQRSHRN_SD_Imm(rd SReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN, 2, 22444, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN_V8BV8H_Imm encodes sqrshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQRSHRN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN, 3, 22457, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN_V4HV4S_Imm encodes sqrshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQRSHRN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN, 4, 22468, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN_V2SV2D_Imm encodes sqrshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQRSHRN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN, 5, 22479, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN2_V16BV8H_Imm encodes sqrshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQRSHRN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN2, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN2, 0, 22490, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN2_V8HV4S_Imm encodes sqrshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQRSHRN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN2, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN2, 1, 22501, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRN2_V4SV2D_Imm encodes sqrshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQRSHRN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRN2, ErrNoMatch)
+	}
+	return a.emit(SQRSHRN2, 2, 22512, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN_BH_Imm encodes sqrshrun Bd, Hn, #imm (0 < imm <= 8).
+func (a *Assembler) SQRSHRUN_BH_Imm(rd BReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN, 0, 22523, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN_HS_Imm encodes sqrshrun Hd, Sn, #imm (0 < imm <= 16).
+func (a *Assembler) SQRSHRUN_HS_Imm(rd HReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN, 1, 22536, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN_SD_Imm encodes sqrshrun Sd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) SQRSHRUN_SD_Imm(rd SReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN, 2, 22549, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN_V8BV8H_Imm encodes sqrshrun Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQRSHRUN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN, 3, 22562, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN_V4HV4S_Imm encodes sqrshrun Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQRSHRUN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN, 4, 22573, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN_V2SV2D_Imm encodes sqrshrun Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQRSHRUN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN, 5, 22584, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN2_V16BV8H_Imm encodes sqrshrun2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQRSHRUN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN2, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN2, 0, 22595, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN2_V8HV4S_Imm encodes sqrshrun2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQRSHRUN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN2, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN2, 1, 22606, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQRSHRUN2_V4SV2D_Imm encodes sqrshrun2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQRSHRUN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQRSHRUN2, ErrNoMatch)
+	}
+	return a.emit(SQRSHRUN2, 2, 22617, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_BB_Imm encodes sqshl Bd, Bn, #imm (0 <= imm < 8).
+func (a *Assembler) SQSHL_BB_Imm(rd, rn BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 0, 22628, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_HH_Imm encodes sqshl Hd, Hn, #imm (0 <= imm < 16).
+func (a *Assembler) SQSHL_HH_Imm(rd, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 1, 22638, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_SS_Imm encodes sqshl Sd, Sn, #imm (0 <= imm < 32).
+func (a *Assembler) SQSHL_SS_Imm(rd, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 2, 22648, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_DD_Imm encodes sqshl Dd, Dn, #imm (0 <= imm < 64).
+func (a *Assembler) SQSHL_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 3, 22658, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_V16BV16B_Imm encodes sqshl Vd.16B, Vn.16B, #imm (0 <= imm < 8).
+func (a *Assembler) SQSHL_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 4, 22668, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_V8BV8B_Imm encodes sqshl Vd.8B, Vn.8B, #imm (0 <= imm < 8).
+func (a *Assembler) SQSHL_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 4, 22668, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_V8HV8H_Imm encodes sqshl Vd.8H, Vn.8H, #imm (0 <= imm < 16).
+func (a *Assembler) SQSHL_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 5, 22679, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_V4HV4H_Imm encodes sqshl Vd.4H, Vn.4H, #imm (0 <= imm < 16).
+func (a *Assembler) SQSHL_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 5, 22679, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_V4SV4S_Imm encodes sqshl Vd.4S, Vn.4S, #imm (0 <= imm < 32).
+func (a *Assembler) SQSHL_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 6, 22690, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_V2SV2S_Imm encodes sqshl Vd.2S, Vn.2S, #imm (0 <= imm < 32).
+func (a *Assembler) SQSHL_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 6, 22690, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_V2DV2D_Imm encodes sqshl Vd.2D, Vn.2D, #imm (0 <= imm < 64).
+func (a *Assembler) SQSHL_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 7, 22701, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHL_BBB encodes sqshl Bd, Bn, Bm.
+func (a *Assembler) SQSHL_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 8, 22712, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_HHH encodes sqshl Hd, Hn, Hm.
+func (a *Assembler) SQSHL_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 9, 22720, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_SSS encodes sqshl Sd, Sn, Sm.
+func (a *Assembler) SQSHL_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 10, 22728, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_DDD encodes sqshl Dd, Dn, Dm.
+func (a *Assembler) SQSHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 11, 22736, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_V16BV16BV16B encodes sqshl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SQSHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 12, 22744, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_V8BV8BV8B encodes sqshl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SQSHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 12, 22744, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_V8HV8HV8H encodes sqshl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SQSHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 13, 22753, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_V4HV4HV4H encodes sqshl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SQSHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 13, 22753, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_V4SV4SV4S encodes sqshl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SQSHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 14, 22762, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_V2SV2SV2S encodes sqshl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SQSHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 14, 22762, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHL_V2DV2DV2D encodes sqshl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) SQSHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSHL, ErrNoMatch)
+	}
+	return a.emit(SQSHL, 15, 22771, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSHLU_BB_Imm encodes sqshlu Bd, Bn, #imm (0 <= imm < 8).
+func (a *Assembler) SQSHLU_BB_Imm(rd, rn BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 0, 22780, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_HH_Imm encodes sqshlu Hd, Hn, #imm (0 <= imm < 16).
+func (a *Assembler) SQSHLU_HH_Imm(rd, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 1, 22790, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_SS_Imm encodes sqshlu Sd, Sn, #imm (0 <= imm < 32).
+func (a *Assembler) SQSHLU_SS_Imm(rd, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 2, 22800, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_DD_Imm encodes sqshlu Dd, Dn, #imm (0 <= imm < 64).
+func (a *Assembler) SQSHLU_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 3, 22810, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_V16BV16B_Imm encodes sqshlu Vd.16B, Vn.16B, #imm (0 <= imm < 8).
+func (a *Assembler) SQSHLU_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 4, 22820, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_V8BV8B_Imm encodes sqshlu Vd.8B, Vn.8B, #imm (0 <= imm < 8).
+func (a *Assembler) SQSHLU_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 4, 22820, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_V8HV8H_Imm encodes sqshlu Vd.8H, Vn.8H, #imm (0 <= imm < 16).
+func (a *Assembler) SQSHLU_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 5, 22831, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_V4HV4H_Imm encodes sqshlu Vd.4H, Vn.4H, #imm (0 <= imm < 16).
+func (a *Assembler) SQSHLU_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 5, 22831, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_V4SV4S_Imm encodes sqshlu Vd.4S, Vn.4S, #imm (0 <= imm < 32).
+func (a *Assembler) SQSHLU_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 6, 22842, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_V2SV2S_Imm encodes sqshlu Vd.2S, Vn.2S, #imm (0 <= imm < 32).
+func (a *Assembler) SQSHLU_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 6, 22842, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHLU_V2DV2D_Imm encodes sqshlu Vd.2D, Vn.2D, #imm (0 <= imm < 64).
+func (a *Assembler) SQSHLU_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHLU, ErrNoMatch)
+	}
+	return a.emit(SQSHLU, 7, 22853, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN_BH_Imm encodes sqshrn Bd, Hn, #imm (0 < imm <= 8).
+func (a *Assembler) SQSHRN_BH_Imm(rd BReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN, ErrNoMatch)
+	}
+	return a.emit(SQSHRN, 0, 22864, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN_HS_Imm encodes sqshrn Hd, Sn, #imm (0 < imm <= 16).
+func (a *Assembler) SQSHRN_HS_Imm(rd HReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN, ErrNoMatch)
+	}
+	return a.emit(SQSHRN, 1, 22877, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN_SD_Imm encodes sqshrn Sd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) SQSHRN_SD_Imm(rd SReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN, ErrNoMatch)
+	}
+	return a.emit(SQSHRN, 2, 22890, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN_V8BV8H_Imm encodes sqshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQSHRN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN, ErrNoMatch)
+	}
+	return a.emit(SQSHRN, 3, 22903, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN_V4HV4S_Imm encodes sqshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQSHRN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN, ErrNoMatch)
+	}
+	return a.emit(SQSHRN, 4, 22914, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN_V2SV2D_Imm encodes sqshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQSHRN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN, ErrNoMatch)
+	}
+	return a.emit(SQSHRN, 5, 22925, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN2_V16BV8H_Imm encodes sqshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQSHRN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN2, ErrNoMatch)
+	}
+	return a.emit(SQSHRN2, 0, 22936, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN2_V8HV4S_Imm encodes sqshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQSHRN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN2, ErrNoMatch)
+	}
+	return a.emit(SQSHRN2, 1, 22947, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRN2_V4SV2D_Imm encodes sqshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQSHRN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRN2, ErrNoMatch)
+	}
+	return a.emit(SQSHRN2, 2, 22958, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN_BH_Imm encodes sqshrun Bd, Hn, #imm (0 < imm <= 8).
+func (a *Assembler) SQSHRUN_BH_Imm(rd BReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN, 0, 22969, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN_HS_Imm encodes sqshrun Hd, Sn, #imm (0 < imm <= 16).
+func (a *Assembler) SQSHRUN_HS_Imm(rd HReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN, 1, 22982, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN_SD_Imm encodes sqshrun Sd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) SQSHRUN_SD_Imm(rd SReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN, 2, 22995, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN_V8BV8H_Imm encodes sqshrun Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQSHRUN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN, 3, 23008, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN_V4HV4S_Imm encodes sqshrun Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQSHRUN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN, 4, 23019, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN_V2SV2D_Imm encodes sqshrun Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQSHRUN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN, 5, 23030, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN2_V16BV8H_Imm encodes sqshrun2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) SQSHRUN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN2, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN2, 0, 23041, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN2_V8HV4S_Imm encodes sqshrun2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) SQSHRUN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN2, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN2, 1, 23052, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSHRUN2_V4SV2D_Imm encodes sqshrun2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) SQSHRUN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQSHRUN2, ErrNoMatch)
+	}
+	return a.emit(SQSHRUN2, 2, 23063, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SQSUB_BBB encodes sqsub Bd, Bn, Bm.
+func (a *Assembler) SQSUB_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 0, 23074, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_HHH encodes sqsub Hd, Hn, Hm.
+func (a *Assembler) SQSUB_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 1, 23082, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_SSS encodes sqsub Sd, Sn, Sm.
+func (a *Assembler) SQSUB_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 2, 23090, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_DDD encodes sqsub Dd, Dn, Dm.
+func (a *Assembler) SQSUB_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 3, 23098, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_V16BV16BV16B encodes sqsub Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SQSUB_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 4, 23106, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_V8BV8BV8B encodes sqsub Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SQSUB_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 4, 23106, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_V8HV8HV8H encodes sqsub Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SQSUB_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 5, 23115, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_V4HV4HV4H encodes sqsub Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SQSUB_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 5, 23115, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_V4SV4SV4S encodes sqsub Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SQSUB_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 6, 23124, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_V2SV2SV2S encodes sqsub Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SQSUB_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 6, 23124, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQSUB_V2DV2DV2D encodes sqsub Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) SQSUB_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SQSUB, ErrNoMatch)
+	}
+	return a.emit(SQSUB, 7, 23133, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SQXTN_BH encodes sqxtn Bd, Hn.
+func (a *Assembler) SQXTN_BH(rd BReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN, ErrNoMatch)
+	}
+	return a.emit(SQXTN, 0, 23227, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN_HS encodes sqxtn Hd, Sn.
+func (a *Assembler) SQXTN_HS(rd HReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN, ErrNoMatch)
+	}
+	return a.emit(SQXTN, 1, 23234, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN_SD encodes sqxtn Sd, Dn.
+func (a *Assembler) SQXTN_SD(rd SReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN, ErrNoMatch)
+	}
+	return a.emit(SQXTN, 2, 23241, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN_V8BV8H encodes sqxtn Vd.8B, Vn.8H.
+func (a *Assembler) SQXTN_V8BV8H(rd V8BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN, ErrNoMatch)
+	}
+	return a.emit(SQXTN, 3, 23248, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN_V4HV4S encodes sqxtn Vd.4H, Vn.4S.
+func (a *Assembler) SQXTN_V4HV4S(rd V4HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN, ErrNoMatch)
+	}
+	return a.emit(SQXTN, 4, 23255, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN_V2SV2D encodes sqxtn Vd.2S, Vn.2D.
+func (a *Assembler) SQXTN_V2SV2D(rd V2SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN, ErrNoMatch)
+	}
+	return a.emit(SQXTN, 5, 23262, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN2_V16BV8H encodes sqxtn2 Vd.16B, Vn.8H.
+func (a *Assembler) SQXTN2_V16BV8H(rd V16BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN2, ErrNoMatch)
+	}
+	return a.emit(SQXTN2, 0, 23269, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN2_V8HV4S encodes sqxtn2 Vd.8H, Vn.4S.
+func (a *Assembler) SQXTN2_V8HV4S(rd V8HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN2, ErrNoMatch)
+	}
+	return a.emit(SQXTN2, 1, 23276, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTN2_V4SV2D encodes sqxtn2 Vd.4S, Vn.2D.
+func (a *Assembler) SQXTN2_V4SV2D(rd V4SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTN2, ErrNoMatch)
+	}
+	return a.emit(SQXTN2, 2, 23283, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN_BH encodes sqxtun Bd, Hn.
+func (a *Assembler) SQXTUN_BH(rd BReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN, ErrNoMatch)
+	}
+	return a.emit(SQXTUN, 0, 23290, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN_HS encodes sqxtun Hd, Sn.
+func (a *Assembler) SQXTUN_HS(rd HReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN, ErrNoMatch)
+	}
+	return a.emit(SQXTUN, 1, 23297, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN_SD encodes sqxtun Sd, Dn.
+func (a *Assembler) SQXTUN_SD(rd SReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN, ErrNoMatch)
+	}
+	return a.emit(SQXTUN, 2, 23304, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN_V8BV8H encodes sqxtun Vd.8B, Vn.8H.
+func (a *Assembler) SQXTUN_V8BV8H(rd V8BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN, ErrNoMatch)
+	}
+	return a.emit(SQXTUN, 3, 23311, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN_V4HV4S encodes sqxtun Vd.4H, Vn.4S.
+func (a *Assembler) SQXTUN_V4HV4S(rd V4HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN, ErrNoMatch)
+	}
+	return a.emit(SQXTUN, 4, 23318, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN_V2SV2D encodes sqxtun Vd.2S, Vn.2D.
+func (a *Assembler) SQXTUN_V2SV2D(rd V2SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN, ErrNoMatch)
+	}
+	return a.emit(SQXTUN, 5, 23325, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN2_V16BV8H encodes sqxtun2 Vd.16B, Vn.8H.
+func (a *Assembler) SQXTUN2_V16BV8H(rd V16BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN2, ErrNoMatch)
+	}
+	return a.emit(SQXTUN2, 0, 23332, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN2_V8HV4S encodes sqxtun2 Vd.8H, Vn.4S.
+func (a *Assembler) SQXTUN2_V8HV4S(rd V8HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN2, ErrNoMatch)
+	}
+	return a.emit(SQXTUN2, 1, 23339, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SQXTUN2_V4SV2D encodes sqxtun2 Vd.4S, Vn.2D.
+func (a *Assembler) SQXTUN2_V4SV2D(rd V4SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SQXTUN2, ErrNoMatch)
+	}
+	return a.emit(SQXTUN2, 2, 23346, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SRHADD_V16BV16BV16B encodes srhadd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SRHADD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRHADD, ErrNoMatch)
+	}
+	return a.emit(SRHADD, 0, 23353, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRHADD_V8BV8BV8B encodes srhadd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SRHADD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRHADD, ErrNoMatch)
+	}
+	return a.emit(SRHADD, 0, 23353, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRHADD_V8HV8HV8H encodes srhadd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SRHADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRHADD, ErrNoMatch)
+	}
+	return a.emit(SRHADD, 1, 23362, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRHADD_V4HV4HV4H encodes srhadd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SRHADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRHADD, ErrNoMatch)
+	}
+	return a.emit(SRHADD, 1, 23362, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRHADD_V4SV4SV4S encodes srhadd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SRHADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRHADD, ErrNoMatch)
+	}
+	return a.emit(SRHADD, 2, 23371, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRHADD_V2SV2SV2S encodes srhadd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SRHADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRHADD, ErrNoMatch)
+	}
+	return a.emit(SRHADD, 2, 23371, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRI_DD_Imm encodes sri Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) SRI_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 0, 23380, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRI_V16BV16B_Imm encodes sri Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) SRI_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 1, 23393, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRI_V8BV8B_Imm encodes sri Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) SRI_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 1, 23393, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRI_V8HV8H_Imm encodes sri Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) SRI_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 2, 23405, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRI_V4HV4H_Imm encodes sri Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) SRI_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 2, 23405, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRI_V4SV4S_Imm encodes sri Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) SRI_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 3, 23417, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRI_V2SV2S_Imm encodes sri Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) SRI_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 3, 23417, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRI_V2DV2D_Imm encodes sri Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) SRI_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRI, ErrNoMatch)
+	}
+	return a.emit(SRI, 4, 23429, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHL_DDD encodes srshl Dd, Dn, Dm.
+func (a *Assembler) SRSHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 0, 23441, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHL_V16BV16BV16B encodes srshl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SRSHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 1, 23449, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHL_V8BV8BV8B encodes srshl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SRSHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 1, 23449, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHL_V8HV8HV8H encodes srshl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SRSHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 2, 23458, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHL_V4HV4HV4H encodes srshl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SRSHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 2, 23458, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHL_V4SV4SV4S encodes srshl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SRSHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 3, 23467, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHL_V2SV2SV2S encodes srshl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SRSHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 3, 23467, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHL_V2DV2DV2D encodes srshl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) SRSHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SRSHL, ErrNoMatch)
+	}
+	return a.emit(SRSHL, 4, 23476, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SRSHR_DD_Imm encodes srshr Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) SRSHR_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 0, 23485, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHR_V16BV16B_Imm encodes srshr Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) SRSHR_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 1, 23498, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHR_V8BV8B_Imm encodes srshr Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) SRSHR_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 1, 23498, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHR_V8HV8H_Imm encodes srshr Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) SRSHR_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 2, 23510, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHR_V4HV4H_Imm encodes srshr Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) SRSHR_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 2, 23510, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHR_V4SV4S_Imm encodes srshr Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) SRSHR_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 3, 23522, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHR_V2SV2S_Imm encodes srshr Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) SRSHR_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 3, 23522, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSHR_V2DV2D_Imm encodes srshr Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) SRSHR_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSHR, ErrNoMatch)
+	}
+	return a.emit(SRSHR, 4, 23534, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_DD_Imm encodes srsra Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) SRSRA_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 0, 23546, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_V16BV16B_Imm encodes srsra Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) SRSRA_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 1, 23559, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_V8BV8B_Imm encodes srsra Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) SRSRA_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 1, 23559, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_V8HV8H_Imm encodes srsra Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) SRSRA_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 2, 23571, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_V4HV4H_Imm encodes srsra Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) SRSRA_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 2, 23571, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_V4SV4S_Imm encodes srsra Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) SRSRA_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 3, 23583, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_V2SV2S_Imm encodes srsra Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) SRSRA_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 3, 23583, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SRSRA_V2DV2D_Imm encodes srsra Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) SRSRA_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SRSRA, ErrNoMatch)
+	}
+	return a.emit(SRSRA, 4, 23595, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSBB encodes ssbb.
+func (a *Assembler) SSBB() bool {
+	return a.emit(SSBB, 0, 23607, 0, 0, 0)
+}
+
+// SSHL_DDD encodes sshl Dd, Dn, Dm.
+func (a *Assembler) SSHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 0, 23612, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHL_V16BV16BV16B encodes sshl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SSHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 1, 23620, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHL_V8BV8BV8B encodes sshl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SSHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 1, 23620, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHL_V8HV8HV8H encodes sshl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SSHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 2, 23629, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHL_V4HV4HV4H encodes sshl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SSHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 2, 23629, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHL_V4SV4SV4S encodes sshl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SSHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 3, 23638, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHL_V2SV2SV2S encodes sshl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SSHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 3, 23638, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHL_V2DV2DV2D encodes sshl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) SSHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSHL, ErrNoMatch)
+	}
+	return a.emit(SSHL, 4, 23647, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSHLL_V8HV8B_Imm encodes sshll Vd.8H, Vn.8B, #imm (0 <= imm < 8).
+func (a *Assembler) SSHLL_V8HV8B_Imm(rd V8HReg, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHLL, ErrNoMatch)
+	}
+	return a.emit(SSHLL, 0, 23656, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHLL_V4SV4H_Imm encodes sshll Vd.4S, Vn.4H, #imm (0 <= imm < 16).
+func (a *Assembler) SSHLL_V4SV4H_Imm(rd V4SReg, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHLL, ErrNoMatch)
+	}
+	return a.emit(SSHLL, 1, 23666, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHLL_V2DV2S_Imm encodes sshll Vd.2D, Vn.2S, #imm (0 <= imm < 32).
+func (a *Assembler) SSHLL_V2DV2S_Imm(rd V2DReg, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHLL, ErrNoMatch)
+	}
+	return a.emit(SSHLL, 2, 23676, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHLL2_V8HV16B_Imm encodes sshll2 Vd.8H, Vn.16B, #imm (0 <= imm < 8).
+func (a *Assembler) SSHLL2_V8HV16B_Imm(rd V8HReg, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHLL2, ErrNoMatch)
+	}
+	return a.emit(SSHLL2, 0, 23686, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHLL2_V4SV8H_Imm encodes sshll2 Vd.4S, Vn.8H, #imm (0 <= imm < 16).
+func (a *Assembler) SSHLL2_V4SV8H_Imm(rd V4SReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHLL2, ErrNoMatch)
+	}
+	return a.emit(SSHLL2, 1, 23696, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHLL2_V2DV4S_Imm encodes sshll2 Vd.2D, Vn.4S, #imm (0 <= imm < 32).
+func (a *Assembler) SSHLL2_V2DV4S_Imm(rd V2DReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHLL2, ErrNoMatch)
+	}
+	return a.emit(SSHLL2, 2, 23706, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_DD_Imm encodes sshr Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) SSHR_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 0, 23716, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_V16BV16B_Imm encodes sshr Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) SSHR_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 1, 23729, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_V8BV8B_Imm encodes sshr Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) SSHR_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 1, 23729, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_V8HV8H_Imm encodes sshr Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) SSHR_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 2, 23741, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_V4HV4H_Imm encodes sshr Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) SSHR_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 2, 23741, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_V4SV4S_Imm encodes sshr Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) SSHR_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 3, 23753, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_V2SV2S_Imm encodes sshr Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) SSHR_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 3, 23753, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSHR_V2DV2D_Imm encodes sshr Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) SSHR_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSHR, ErrNoMatch)
+	}
+	return a.emit(SSHR, 4, 23765, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_DD_Imm encodes ssra Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) SSRA_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 0, 23777, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_V16BV16B_Imm encodes ssra Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) SSRA_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 1, 23790, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_V8BV8B_Imm encodes ssra Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) SSRA_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 1, 23790, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_V8HV8H_Imm encodes ssra Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) SSRA_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 2, 23802, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_V4HV4H_Imm encodes ssra Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) SSRA_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 2, 23802, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_V4SV4S_Imm encodes ssra Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) SSRA_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 3, 23814, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_V2SV2S_Imm encodes ssra Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) SSRA_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 3, 23814, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSRA_V2DV2D_Imm encodes ssra Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) SSRA_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SSRA, ErrNoMatch)
+	}
+	return a.emit(SSRA, 4, 23826, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SSUBL_V8HV8BV8B encodes ssubl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) SSUBL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBL, ErrNoMatch)
+	}
+	return a.emit(SSUBL, 0, 23838, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBL_V4SV4HV4H encodes ssubl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) SSUBL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBL, ErrNoMatch)
+	}
+	return a.emit(SSUBL, 1, 23846, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBL_V2DV2SV2S encodes ssubl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) SSUBL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBL, ErrNoMatch)
+	}
+	return a.emit(SSUBL, 2, 23854, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBL2_V8HV16BV16B encodes ssubl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) SSUBL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBL2, ErrNoMatch)
+	}
+	return a.emit(SSUBL2, 0, 23862, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBL2_V4SV8HV8H encodes ssubl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) SSUBL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBL2, ErrNoMatch)
+	}
+	return a.emit(SSUBL2, 1, 23870, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBL2_V2DV4SV4S encodes ssubl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) SSUBL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBL2, ErrNoMatch)
+	}
+	return a.emit(SSUBL2, 2, 23878, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBW_V8HV8HV8B encodes ssubw Vd.8H, Vn.8H, Vm.8B.
+func (a *Assembler) SSUBW_V8HV8HV8B(rd, rn V8HReg, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBW, ErrNoMatch)
+	}
+	return a.emit(SSUBW, 0, 23886, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBW_V4SV4SV4H encodes ssubw Vd.4S, Vn.4S, Vm.4H.
+func (a *Assembler) SSUBW_V4SV4SV4H(rd, rn V4SReg, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBW, ErrNoMatch)
+	}
+	return a.emit(SSUBW, 1, 23894, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBW_V2DV2DV2S encodes ssubw Vd.2D, Vn.2D, Vm.2S.
+func (a *Assembler) SSUBW_V2DV2DV2S(rd, rn V2DReg, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBW, ErrNoMatch)
+	}
+	return a.emit(SSUBW, 2, 23902, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBW2_V8HV8HV16B encodes ssubw2 Vd.8H, Vn.8H, Vm.16B.
+func (a *Assembler) SSUBW2_V8HV8HV16B(rd, rn V8HReg, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBW2, ErrNoMatch)
+	}
+	return a.emit(SSUBW2, 0, 23910, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBW2_V4SV4SV8H encodes ssubw2 Vd.4S, Vn.4S, Vm.8H.
+func (a *Assembler) SSUBW2_V4SV4SV8H(rd, rn V4SReg, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBW2, ErrNoMatch)
+	}
+	return a.emit(SSUBW2, 1, 23918, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SSUBW2_V2DV2DV4S encodes ssubw2 Vd.2D, Vn.2D, Vm.4S.
+func (a *Assembler) SSUBW2_V2DV2DV4S(rd, rn V2DReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SSUBW2, ErrNoMatch)
+	}
+	return a.emit(SSUBW2, 2, 23926, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ST2G_Xsp_RefOffset encodes st2g Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) ST2G_Xsp_RefOffset(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(ST2G, ErrNoMatch)
+	}
+	return a.emit(ST2G, 0, 25431, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// ST2G_Xsp_RefPre encodes st2g Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) ST2G_Xsp_RefPre(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(ST2G, ErrNoMatch)
+	}
+	return a.emit(ST2G, 1, 25440, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// ST2G_Xsp_Ref_Imm encodes st2g Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) ST2G_Xsp_Ref_Imm(rd, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(ST2G, ErrNoMatch)
+	}
+	return a.emit(ST2G, 2, 25449, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// ST64B_X_Ref encodes st64b Xn, [Xm|SP] (n is even).
+// Requires FEAT_LS64.
+func (a *Assembler) ST64B_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(ST64B, ErrNoMatch)
+	}
+	return a.emit(ST64B, 0, 25916, FeatLS64, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// ST64BV_XX_Ref encodes st64bv Xd, Xn, [Xm|SP] (n is even).
+// Requires FEAT_LS64.
+func (a *Assembler) ST64BV_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(ST64BV, ErrNoMatch)
+	}
+	return a.emit(ST64BV, 0, 25924, FeatLS64, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// ST64BV0_XX_Ref encodes st64bv0 Xd, Xn, [Xm|SP] (n is even).
+// Requires FEAT_LS64.
+func (a *Assembler) ST64BV0_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(ST64BV0, ErrNoMatch)
+	}
+	return a.emit(ST64BV0, 0, 25933, FeatLS64, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADD_W_Ref encodes stadd Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADD_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADD, ErrNoMatch)
+	}
+	return a.emit(STADD, 0, 25942, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADD_X_Ref encodes stadd Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADD_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADD, ErrNoMatch)
+	}
+	return a.emit(STADD, 1, 25949, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADDB_W_Ref encodes staddb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADDB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADDB, ErrNoMatch)
+	}
+	return a.emit(STADDB, 0, 25956, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADDH_W_Ref encodes staddh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADDH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADDH, ErrNoMatch)
+	}
+	return a.emit(STADDH, 0, 25963, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADDL_W_Ref encodes staddl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADDL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADDL, ErrNoMatch)
+	}
+	return a.emit(STADDL, 0, 25970, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADDL_X_Ref encodes staddl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADDL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADDL, ErrNoMatch)
+	}
+	return a.emit(STADDL, 1, 25977, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADDLB_W_Ref encodes staddlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADDLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADDLB, ErrNoMatch)
+	}
+	return a.emit(STADDLB, 0, 25984, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STADDLH_W_Ref encodes staddlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STADDLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STADDLH, ErrNoMatch)
+	}
+	return a.emit(STADDLH, 0, 25991, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLR_W_Ref encodes stclr Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLR, ErrNoMatch)
+	}
+	return a.emit(STCLR, 0, 25998, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLR_X_Ref encodes stclr Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLR, ErrNoMatch)
+	}
+	return a.emit(STCLR, 1, 26005, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLRB_W_Ref encodes stclrb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLRB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLRB, ErrNoMatch)
+	}
+	return a.emit(STCLRB, 0, 26012, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLRH_W_Ref encodes stclrh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLRH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLRH, ErrNoMatch)
+	}
+	return a.emit(STCLRH, 0, 26019, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLRL_W_Ref encodes stclrl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLRL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLRL, ErrNoMatch)
+	}
+	return a.emit(STCLRL, 0, 26026, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLRL_X_Ref encodes stclrl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLRL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLRL, ErrNoMatch)
+	}
+	return a.emit(STCLRL, 1, 26033, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLRLB_W_Ref encodes stclrlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLRLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLRLB, ErrNoMatch)
+	}
+	return a.emit(STCLRLB, 0, 26040, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STCLRLH_W_Ref encodes stclrlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STCLRLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STCLRLH, ErrNoMatch)
+	}
+	return a.emit(STCLRLH, 0, 26047, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEOR_W_Ref encodes steor Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEOR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEOR, ErrNoMatch)
+	}
+	return a.emit(STEOR, 0, 26054, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEOR_X_Ref encodes steor Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEOR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEOR, ErrNoMatch)
+	}
+	return a.emit(STEOR, 1, 26061, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEORB_W_Ref encodes steorb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEORB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEORB, ErrNoMatch)
+	}
+	return a.emit(STEORB, 0, 26068, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEORH_W_Ref encodes steorh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEORH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEORH, ErrNoMatch)
+	}
+	return a.emit(STEORH, 0, 26075, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEORL_W_Ref encodes steorl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEORL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEORL, ErrNoMatch)
+	}
+	return a.emit(STEORL, 0, 26082, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEORL_X_Ref encodes steorl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEORL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEORL, ErrNoMatch)
+	}
+	return a.emit(STEORL, 1, 26089, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEORLB_W_Ref encodes steorlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEORLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEORLB, ErrNoMatch)
+	}
+	return a.emit(STEORLB, 0, 26096, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STEORLH_W_Ref encodes steorlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STEORLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STEORLH, ErrNoMatch)
+	}
+	return a.emit(STEORLH, 0, 26103, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STG_Xsp_RefOffset encodes stg Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STG_Xsp_RefOffset(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STG, ErrNoMatch)
+	}
+	return a.emit(STG, 0, 26110, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STG_Xsp_RefPre encodes stg Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STG_Xsp_RefPre(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STG, ErrNoMatch)
+	}
+	return a.emit(STG, 1, 26119, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STG_Xsp_Ref_Imm encodes stg Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STG_Xsp_Ref_Imm(rd, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STG, ErrNoMatch)
+	}
+	return a.emit(STG, 2, 26128, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STGP_XX_RefOffset encodes stgp Xd, Xn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STGP_XX_RefOffset(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STGP, ErrNoMatch)
+	}
+	return a.emit(STGP, 0, 26137, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STGP_XX_RefPre encodes stgp Xd, Xn, [Xm|SP, #imm]! (-1024 <= imm < 1024, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STGP_XX_RefPre(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STGP, ErrNoMatch)
+	}
+	return a.emit(STGP, 1, 26147, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STGP_XX_Ref_Imm encodes stgp Xd, Xn, [Xm|SP], #imm (-1024 <= imm < 1024, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STGP_XX_Ref_Imm(rd, rn XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STGP, ErrNoMatch)
+	}
+	return a.emit(STGP, 2, 26157, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STLLR_W_Ref encodes stllr Wd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) STLLR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLLR, ErrNoMatch)
+	}
+	return a.emit(STLLR, 0, 26167, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLLR_X_Ref encodes stllr Xd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) STLLR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLLR, ErrNoMatch)
+	}
+	return a.emit(STLLR, 1, 26174, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLLRB_W_Ref encodes stllrb Wd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) STLLRB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLLRB, ErrNoMatch)
+	}
+	return a.emit(STLLRB, 0, 26181, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLLRH_W_Ref encodes stllrh Wd, [Xn|SP].
+// Requires FEAT_LOR.
+func (a *Assembler) STLLRH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLLRH, ErrNoMatch)
+	}
+	return a.emit(STLLRH, 0, 26188, FeatLOR, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLR_W_Ref encodes stlr Wd, [Xn|SP].
+func (a *Assembler) STLR_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLR, ErrNoMatch)
+	}
+	return a.emit(STLR, 0, 26195, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLR_X_Ref encodes stlr Xd, [Xn|SP].
+func (a *Assembler) STLR_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLR, ErrNoMatch)
+	}
+	return a.emit(STLR, 1, 26202, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLRB_W_Ref encodes stlrb Wd, [Xn|SP].
+func (a *Assembler) STLRB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLRB, ErrNoMatch)
+	}
+	return a.emit(STLRB, 0, 26209, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLRH_W_Ref encodes stlrh Wd, [Xn|SP].
+func (a *Assembler) STLRH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLRH, ErrNoMatch)
+	}
+	return a.emit(STLRH, 0, 26216, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLUR_W_RefOffset encodes stlur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) STLUR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLUR, ErrNoMatch)
+	}
+	return a.emit(STLUR, 0, 26223, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STLUR_X_RefOffset encodes stlur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) STLUR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLUR, ErrNoMatch)
+	}
+	return a.emit(STLUR, 1, 26231, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STLURB_W_RefOffset encodes stlurb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) STLURB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLURB, ErrNoMatch)
+	}
+	return a.emit(STLURB, 0, 26239, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STLURH_W_RefOffset encodes stlurh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+// Requires FEAT_LRCPC2.
+func (a *Assembler) STLURH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STLURH, ErrNoMatch)
+	}
+	return a.emit(STLURH, 0, 26247, FeatLRCPC2, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STLXP_WWW_Ref encodes stlxp Wd, Wn, Wm, [Xa|SP].
+func (a *Assembler) STLXP_WWW_Ref(rd, rn, rm WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(base)) >= 32 {
+		return a.emitErr(STLXP, ErrNoMatch)
+	}
+	return a.emit(STLXP, 0, 26255, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLXP_WXX_Ref encodes stlxp Wd, Xn, Xm, [Xa|SP].
+func (a *Assembler) STLXP_WXX_Ref(rd WReg, rn, rm XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(base)) >= 32 {
+		return a.emitErr(STLXP, ErrNoMatch)
+	}
+	return a.emit(STLXP, 1, 26264, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLXR_WW_Ref encodes stlxr Wd, Wn, [Xm|SP].
+func (a *Assembler) STLXR_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STLXR, ErrNoMatch)
+	}
+	return a.emit(STLXR, 0, 26273, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLXR_WX_Ref encodes stlxr Wd, Xn, [Xm|SP].
+func (a *Assembler) STLXR_WX_Ref(rd WReg, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STLXR, ErrNoMatch)
+	}
+	return a.emit(STLXR, 1, 26281, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLXRB_WW_Ref encodes stlxrb Wd, Wn, [Xm|SP].
+func (a *Assembler) STLXRB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STLXRB, ErrNoMatch)
+	}
+	return a.emit(STLXRB, 0, 26289, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STLXRH_WW_Ref encodes stlxrh Wd, Wn, [Xm|SP].
+func (a *Assembler) STLXRH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STLXRH, ErrNoMatch)
+	}
+	return a.emit(STLXRH, 0, 26297, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STNP_SS_RefOffset encodes stnp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STNP_SS_RefOffset(rd, rn SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STNP, ErrNoMatch)
+	}
+	return a.emit(STNP, 0, 26305, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STNP_DD_RefOffset encodes stnp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STNP_DD_RefOffset(rd, rn DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STNP, ErrNoMatch)
+	}
+	return a.emit(STNP, 1, 26315, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STNP_QQ_RefOffset encodes stnp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) STNP_QQ_RefOffset(rd, rn QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STNP, ErrNoMatch)
+	}
+	return a.emit(STNP, 2, 26325, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STNP_WW_RefOffset encodes stnp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STNP_WW_RefOffset(rd, rn WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STNP, ErrNoMatch)
+	}
+	return a.emit(STNP, 3, 26335, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STNP_XX_RefOffset encodes stnp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STNP_XX_RefOffset(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STNP, ErrNoMatch)
+	}
+	return a.emit(STNP, 4, 26345, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_SS_Ref_Imm encodes stp Sd, Sn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STP_SS_Ref_Imm(rd, rn SReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 0, 26355, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STP_DD_Ref_Imm encodes stp Dd, Dn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STP_DD_Ref_Imm(rd, rn DReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 1, 26365, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STP_QQ_Ref_Imm encodes stp Qd, Qn, [Xm|SP], #imm (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) STP_QQ_Ref_Imm(rd, rn QReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 2, 26375, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STP_SS_RefPre encodes stp Sd, Sn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STP_SS_RefPre(rd, rn SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 3, 26385, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_DD_RefPre encodes stp Dd, Dn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STP_DD_RefPre(rd, rn DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 4, 26395, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_QQ_RefPre encodes stp Qd, Qn, [Xm|SP, #imm]! (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) STP_QQ_RefPre(rd, rn QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 5, 26405, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_SS_RefOffset encodes stp Sd, Sn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STP_SS_RefOffset(rd, rn SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 6, 26415, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_DD_RefOffset encodes stp Dd, Dn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STP_DD_RefOffset(rd, rn DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 7, 26425, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_QQ_RefOffset encodes stp Qd, Qn, [Xm|SP {, #imm }] (-1024 <= imm < 1024, imm >> 4).
+func (a *Assembler) STP_QQ_RefOffset(rd, rn QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 8, 26435, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_WW_Ref_Imm encodes stp Wd, Wn, [Xm|SP], #imm (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STP_WW_Ref_Imm(rd, rn WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 9, 26445, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STP_XX_Ref_Imm encodes stp Xd, Xn, [Xm|SP], #imm (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STP_XX_Ref_Imm(rd, rn XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 10, 26455, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STP_WW_RefPre encodes stp Wd, Wn, [Xm|SP, #imm]! (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STP_WW_RefPre(rd, rn WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 11, 26465, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_XX_RefPre encodes stp Xd, Xn, [Xm|SP, #imm]! (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STP_XX_RefPre(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 12, 26475, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_WW_RefOffset encodes stp Wd, Wn, [Xm|SP {, #imm }] (-256 <= imm < 256, imm >> 2).
+func (a *Assembler) STP_WW_RefOffset(rd, rn WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 13, 26485, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STP_XX_RefOffset encodes stp Xd, Xn, [Xm|SP {, #imm }] (-512 <= imm < 512, imm >> 3).
+func (a *Assembler) STP_XX_RefOffset(rd, rn XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STP, ErrNoMatch)
+	}
+	return a.emit(STP, 14, 26495, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_B_Ref_Imm encodes str Bd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STR_B_Ref_Imm(rd BReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 0, 26505, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STR_H_Ref_Imm encodes str Hd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STR_H_Ref_Imm(rd HReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 1, 26513, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STR_S_Ref_Imm encodes str Sd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STR_S_Ref_Imm(rd SReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 2, 26521, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STR_D_Ref_Imm encodes str Dd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STR_D_Ref_Imm(rd DReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 3, 26529, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STR_Q_Ref_Imm encodes str Qd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STR_Q_Ref_Imm(rd QReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 4, 26537, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STR_B_RefPre encodes str Bd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STR_B_RefPre(rd BReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 5, 26545, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_H_RefPre encodes str Hd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STR_H_RefPre(rd HReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 6, 26553, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_S_RefPre encodes str Sd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STR_S_RefPre(rd SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 7, 26561, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_D_RefPre encodes str Dd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STR_D_RefPre(rd DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 8, 26569, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_Q_RefPre encodes str Qd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STR_Q_RefPre(rd QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 9, 26577, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_B_RefOffset encodes str Bd, [Xn|SP {, #imm }] (0 <= imm < 4096).
+func (a *Assembler) STR_B_RefOffset(rd BReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 10, 26585, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_H_RefOffset encodes str Hd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1).
+func (a *Assembler) STR_H_RefOffset(rd HReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 11, 26595, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_S_RefOffset encodes str Sd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2).
+func (a *Assembler) STR_S_RefOffset(rd SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 12, 26606, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_D_RefOffset encodes str Dd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3).
+func (a *Assembler) STR_D_RefOffset(rd DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 13, 26617, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_Q_RefOffset encodes str Qd, [Xn|SP {, #imm }] (0 <= imm < 65536, imm >> 4).
+func (a *Assembler) STR_Q_RefOffset(rd QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 14, 26628, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_W_Ref_Imm encodes str Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STR_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 15, 26639, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STR_X_Ref_Imm encodes str Xd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STR_X_Ref_Imm(rd XReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 16, 26647, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STR_W_RefPre encodes str Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STR_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 17, 26655, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_X_RefPre encodes str Xd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STR_X_RefPre(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 18, 26663, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_W_RefOffset encodes str Wd, [Xn|SP {, #imm }] (0 <= imm < 16384, imm >> 2).
+func (a *Assembler) STR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 19, 26671, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STR_X_RefOffset encodes str Xd, [Xn|SP {, #imm }] (0 <= imm < 32768, imm >> 3).
+func (a *Assembler) STR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STR, ErrNoMatch)
+	}
+	return a.emit(STR, 20, 26682, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STRB_W_Ref_Imm encodes strb Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STRB_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STRB, ErrNoMatch)
+	}
+	return a.emit(STRB, 0, 26828, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STRB_W_RefPre encodes strb Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STRB_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STRB, ErrNoMatch)
+	}
+	return a.emit(STRB, 1, 26836, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STRB_W_RefOffset encodes strb Wd, [Xn|SP {, #imm }] (0 <= imm < 4096).
+func (a *Assembler) STRB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STRB, ErrNoMatch)
+	}
+	return a.emit(STRB, 2, 26844, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STRH_W_Ref_Imm encodes strh Wd, [Xn|SP], #imm (-256 <= imm < 256).
+func (a *Assembler) STRH_W_Ref_Imm(rd WReg, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STRH, ErrNoMatch)
+	}
+	return a.emit(STRH, 0, 26866, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STRH_W_RefPre encodes strh Wd, [Xn|SP, #imm]! (-256 <= imm < 256).
+func (a *Assembler) STRH_W_RefPre(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STRH, ErrNoMatch)
+	}
+	return a.emit(STRH, 1, 26874, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STRH_W_RefOffset encodes strh Wd, [Xn|SP {, #imm }] (0 <= imm < 8192, imm >> 1).
+func (a *Assembler) STRH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STRH, ErrNoMatch)
+	}
+	return a.emit(STRH, 2, 26882, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STSET_W_Ref encodes stset Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSET_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSET, ErrNoMatch)
+	}
+	return a.emit(STSET, 0, 26905, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSET_X_Ref encodes stset Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSET_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSET, ErrNoMatch)
+	}
+	return a.emit(STSET, 1, 26912, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSETB_W_Ref encodes stsetb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSETB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSETB, ErrNoMatch)
+	}
+	return a.emit(STSETB, 0, 26919, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSETH_W_Ref encodes stseth Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSETH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSETH, ErrNoMatch)
+	}
+	return a.emit(STSETH, 0, 26926, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSETL_W_Ref encodes stsetl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSETL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSETL, ErrNoMatch)
+	}
+	return a.emit(STSETL, 0, 26933, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSETL_X_Ref encodes stsetl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSETL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSETL, ErrNoMatch)
+	}
+	return a.emit(STSETL, 1, 26940, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSETLB_W_Ref encodes stsetlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSETLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSETLB, ErrNoMatch)
+	}
+	return a.emit(STSETLB, 0, 26947, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSETLH_W_Ref encodes stsetlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSETLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSETLH, ErrNoMatch)
+	}
+	return a.emit(STSETLH, 0, 26954, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAX_W_Ref encodes stsmax Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAX_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAX, ErrNoMatch)
+	}
+	return a.emit(STSMAX, 0, 26961, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAX_X_Ref encodes stsmax Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAX_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAX, ErrNoMatch)
+	}
+	return a.emit(STSMAX, 1, 26968, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAXB_W_Ref encodes stsmaxb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAXB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAXB, ErrNoMatch)
+	}
+	return a.emit(STSMAXB, 0, 26975, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAXH_W_Ref encodes stsmaxh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAXH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAXH, ErrNoMatch)
+	}
+	return a.emit(STSMAXH, 0, 26982, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAXL_W_Ref encodes stsmaxl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAXL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAXL, ErrNoMatch)
+	}
+	return a.emit(STSMAXL, 0, 26989, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAXL_X_Ref encodes stsmaxl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAXL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAXL, ErrNoMatch)
+	}
+	return a.emit(STSMAXL, 1, 26996, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAXLB_W_Ref encodes stsmaxlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAXLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAXLB, ErrNoMatch)
+	}
+	return a.emit(STSMAXLB, 0, 27003, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMAXLH_W_Ref encodes stsmaxlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMAXLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMAXLH, ErrNoMatch)
+	}
+	return a.emit(STSMAXLH, 0, 27010, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMIN_W_Ref encodes stsmin Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMIN_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMIN, ErrNoMatch)
+	}
+	return a.emit(STSMIN, 0, 27017, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMIN_X_Ref encodes stsmin Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMIN_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMIN, ErrNoMatch)
+	}
+	return a.emit(STSMIN, 1, 27024, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMINB_W_Ref encodes stsminb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMINB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMINB, ErrNoMatch)
+	}
+	return a.emit(STSMINB, 0, 27031, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMINH_W_Ref encodes stsminh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMINH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMINH, ErrNoMatch)
+	}
+	return a.emit(STSMINH, 0, 27038, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMINL_W_Ref encodes stsminl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMINL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMINL, ErrNoMatch)
+	}
+	return a.emit(STSMINL, 0, 27045, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMINL_X_Ref encodes stsminl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMINL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMINL, ErrNoMatch)
+	}
+	return a.emit(STSMINL, 1, 27052, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMINLB_W_Ref encodes stsminlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMINLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMINLB, ErrNoMatch)
+	}
+	return a.emit(STSMINLB, 0, 27059, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STSMINLH_W_Ref encodes stsminlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STSMINLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STSMINLH, ErrNoMatch)
+	}
+	return a.emit(STSMINLH, 0, 27066, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STTR_W_RefOffset encodes sttr Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STTR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STTR, ErrNoMatch)
+	}
+	return a.emit(STTR, 0, 27073, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STTR_X_RefOffset encodes sttr Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STTR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STTR, ErrNoMatch)
+	}
+	return a.emit(STTR, 1, 27081, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STTRB_W_RefOffset encodes sttrb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STTRB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STTRB, ErrNoMatch)
+	}
+	return a.emit(STTRB, 0, 27089, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STTRH_W_RefOffset encodes sttrh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STTRH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STTRH, ErrNoMatch)
+	}
+	return a.emit(STTRH, 0, 27097, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STUMAX_W_Ref encodes stumax Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAX_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAX, ErrNoMatch)
+	}
+	return a.emit(STUMAX, 0, 27105, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMAX_X_Ref encodes stumax Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAX_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAX, ErrNoMatch)
+	}
+	return a.emit(STUMAX, 1, 27112, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMAXB_W_Ref encodes stumaxb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAXB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAXB, ErrNoMatch)
+	}
+	return a.emit(STUMAXB, 0, 27119, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMAXH_W_Ref encodes stumaxh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAXH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAXH, ErrNoMatch)
+	}
+	return a.emit(STUMAXH, 0, 27126, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMAXL_W_Ref encodes stumaxl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAXL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAXL, ErrNoMatch)
+	}
+	return a.emit(STUMAXL, 0, 27133, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMAXL_X_Ref encodes stumaxl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAXL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAXL, ErrNoMatch)
+	}
+	return a.emit(STUMAXL, 1, 27140, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMAXLB_W_Ref encodes stumaxlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAXLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAXLB, ErrNoMatch)
+	}
+	return a.emit(STUMAXLB, 0, 27147, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMAXLH_W_Ref encodes stumaxlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMAXLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMAXLH, ErrNoMatch)
+	}
+	return a.emit(STUMAXLH, 0, 27154, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMIN_W_Ref encodes stumin Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMIN_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMIN, ErrNoMatch)
+	}
+	return a.emit(STUMIN, 0, 27161, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMIN_X_Ref encodes stumin Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMIN_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMIN, ErrNoMatch)
+	}
+	return a.emit(STUMIN, 1, 27168, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMINB_W_Ref encodes stuminb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMINB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMINB, ErrNoMatch)
+	}
+	return a.emit(STUMINB, 0, 27175, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMINH_W_Ref encodes stuminh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMINH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMINH, ErrNoMatch)
+	}
+	return a.emit(STUMINH, 0, 27182, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMINL_W_Ref encodes stuminl Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMINL_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMINL, ErrNoMatch)
+	}
+	return a.emit(STUMINL, 0, 27189, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMINL_X_Ref encodes stuminl Xd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMINL_X_Ref(rd XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMINL, ErrNoMatch)
+	}
+	return a.emit(STUMINL, 1, 27196, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMINLB_W_Ref encodes stuminlb Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMINLB_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMINLB, ErrNoMatch)
+	}
+	return a.emit(STUMINLB, 0, 27203, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUMINLH_W_Ref encodes stuminlh Wd, [Xn|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) STUMINLH_W_Ref(rd WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUMINLH, ErrNoMatch)
+	}
+	return a.emit(STUMINLH, 0, 27210, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)})
+}
+
+// STUR_B_RefOffset encodes stur Bd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STUR_B_RefOffset(rd BReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUR, ErrNoMatch)
+	}
+	return a.emit(STUR, 0, 27217, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STUR_H_RefOffset encodes stur Hd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STUR_H_RefOffset(rd HReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUR, ErrNoMatch)
+	}
+	return a.emit(STUR, 1, 27225, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STUR_S_RefOffset encodes stur Sd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STUR_S_RefOffset(rd SReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUR, ErrNoMatch)
+	}
+	return a.emit(STUR, 2, 27233, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STUR_D_RefOffset encodes stur Dd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STUR_D_RefOffset(rd DReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUR, ErrNoMatch)
+	}
+	return a.emit(STUR, 3, 27241, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STUR_Q_RefOffset encodes stur Qd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STUR_Q_RefOffset(rd QReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUR, ErrNoMatch)
+	}
+	return a.emit(STUR, 4, 27249, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STUR_W_RefOffset encodes stur Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STUR_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUR, ErrNoMatch)
+	}
+	return a.emit(STUR, 5, 27257, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STUR_X_RefOffset encodes stur Xd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STUR_X_RefOffset(rd XReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STUR, ErrNoMatch)
+	}
+	return a.emit(STUR, 6, 27265, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STURB_W_RefOffset encodes sturb Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STURB_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STURB, ErrNoMatch)
+	}
+	return a.emit(STURB, 0, 27273, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STURH_W_RefOffset encodes sturh Wd, [Xn|SP {, #imm }] (-256 <= imm < 256).
+func (a *Assembler) STURH_W_RefOffset(rd WReg, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STURH, ErrNoMatch)
+	}
+	return a.emit(STURH, 0, 27281, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STXP_WWW_Ref encodes stxp Wd, Wn, Wm, [Xa|SP].
+func (a *Assembler) STXP_WWW_Ref(rd, rn, rm WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(base)) >= 32 {
+		return a.emitErr(STXP, ErrNoMatch)
+	}
+	return a.emit(STXP, 0, 27289, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(base)})
+}
+
+// STXP_WXX_Ref encodes stxp Wd, Xn, Xm, [Xa|SP].
+func (a *Assembler) STXP_WXX_Ref(rd WReg, rn, rm XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(base)) >= 32 {
+		return a.emitErr(STXP, ErrNoMatch)
+	}
+	return a.emit(STXP, 1, 27298, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(base)})
+}
+
+// STXR_WW_Ref encodes stxr Wd, Wn, [Xm|SP].
+func (a *Assembler) STXR_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STXR, ErrNoMatch)
+	}
+	return a.emit(STXR, 0, 27307, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STXR_WX_Ref encodes stxr Wd, Xn, [Xm|SP].
+func (a *Assembler) STXR_WX_Ref(rd WReg, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STXR, ErrNoMatch)
+	}
+	return a.emit(STXR, 1, 27315, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STXRB_WW_Ref encodes stxrb Wd, Wn, [Xm|SP].
+func (a *Assembler) STXRB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STXRB, ErrNoMatch)
+	}
+	return a.emit(STXRB, 0, 27323, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STXRH_WW_Ref encodes stxrh Wd, Wn, [Xm|SP].
+func (a *Assembler) STXRH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(STXRH, ErrNoMatch)
+	}
+	return a.emit(STXRH, 0, 27331, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// STZ2G_Xsp_RefOffset encodes stz2g Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STZ2G_Xsp_RefOffset(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STZ2G, ErrNoMatch)
+	}
+	return a.emit(STZ2G, 0, 27339, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STZ2G_Xsp_RefPre encodes stz2g Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STZ2G_Xsp_RefPre(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STZ2G, ErrNoMatch)
+	}
+	return a.emit(STZ2G, 1, 27348, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STZ2G_Xsp_Ref_Imm encodes stz2g Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STZ2G_Xsp_Ref_Imm(rd, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STZ2G, ErrNoMatch)
+	}
+	return a.emit(STZ2G, 2, 27357, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// STZG_Xsp_RefOffset encodes stzg Xd|SP, [Xn|SP {, #imm }] (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STZG_Xsp_RefOffset(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STZG, ErrNoMatch)
+	}
+	return a.emit(STZG, 0, 27366, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STZG_Xsp_RefPre encodes stzg Xd|SP, [Xn|SP, #imm]! (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STZG_Xsp_RefPre(rd, base XSPReg, offset int32) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STZG, ErrNoMatch)
+	}
+	return a.emit(STZG, 1, 27375, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(offset)})
+}
+
+// STZG_Xsp_Ref_Imm encodes stzg Xd|SP, [Xn|SP], #imm (-4096 <= imm < 4096, imm >> 4).
+// Requires FEAT_MTE.
+func (a *Assembler) STZG_Xsp_Ref_Imm(rd, base XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(base)) >= 32 {
+		return a.emitErr(STZG, ErrNoMatch)
+	}
+	return a.emit(STZG, 2, 27384, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(base)}, Flat{FlatImm, uint64(imm)})
+}
+
+// SUB_WWW encodes sub Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) SUB_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 0, 27393, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUB_WWW_Mod encodes sub Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) SUB_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 0, 27393, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUB_XXX encodes sub Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) SUB_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 1, 27405, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUB_XXX_Mod encodes sub Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) SUB_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 1, 27405, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUB_WspWspW encodes sub Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUB_WspWspW(rd, rn WSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 2, 27417, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUB_WspWspW_Mod encodes sub Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUB_WspWspW_Mod(rd, rn WSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtends], mod.ID) {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 2, 27417, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUB_XspXspW encodes sub Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUB_XspXspW(rd, rn XSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 3, 27430, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUB_XspXspW_Mod encodes sub Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUB_XspXspW_Mod(rd, rn XSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsW], mod.ID) {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 3, 27430, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUB_XspXspX encodes sub Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) SUB_XspXspX(rd, rn XSPReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 4, 27443, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUB_XspXspX_Mod encodes sub Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) SUB_XspXspX_Mod(rd, rn XSPReg, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsX], mod.ID) {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 4, 27443, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUB_WspWsp_Imm encodes sub Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUB_WspWsp_Imm(rd, rn WSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 5, 27456, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// SUB_WspWsp_Imm_LSL encodes sub Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUB_WspWsp_Imm_LSL(rd, rn WSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 5, 27456, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// SUB_XspXsp_Imm encodes sub Xd|SP, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUB_XspXsp_Imm(rd, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 6, 27469, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// SUB_XspXsp_Imm_LSL encodes sub Xd|SP, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUB_XspXsp_Imm_LSL(rd, rn XSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 6, 27469, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// SUB_DDD encodes sub Dd, Dn, Dm.
+func (a *Assembler) SUB_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 7, 27482, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUB_V16BV16BV16B encodes sub Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) SUB_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 8, 27490, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUB_V8BV8BV8B encodes sub Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) SUB_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 8, 27490, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUB_V8HV8HV8H encodes sub Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) SUB_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 9, 27499, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUB_V4HV4HV4H encodes sub Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) SUB_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 9, 27499, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUB_V4SV4SV4S encodes sub Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) SUB_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 10, 27508, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUB_V2SV2SV2S encodes sub Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) SUB_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 10, 27508, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUB_V2DV2DV2D encodes sub Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) SUB_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUB, ErrNoMatch)
+	}
+	return a.emit(SUB, 11, 27517, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBG_XspXsp_Imm_Imm encodes subg Xd|SP, Xn|SP, #imm1, #imm2 (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16).
+// Requires FEAT_MTE.
+func (a *Assembler) SUBG_XspXsp_Imm_Imm(rd, rn XSPReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUBG, ErrNoMatch)
+	}
+	return a.emit(SUBG, 0, 27655, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// SUBHN_V8BV8HV8H encodes subhn Vd.8B, Vn.8H, Vm.8H.
+func (a *Assembler) SUBHN_V8BV8HV8H(rd V8BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBHN, ErrNoMatch)
+	}
+	return a.emit(SUBHN, 0, 27669, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBHN_V4HV4SV4S encodes subhn Vd.4H, Vn.4S, Vm.4S.
+func (a *Assembler) SUBHN_V4HV4SV4S(rd V4HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBHN, ErrNoMatch)
+	}
+	return a.emit(SUBHN, 1, 27677, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBHN_V2SV2DV2D encodes subhn Vd.2S, Vn.2D, Vm.2D.
+func (a *Assembler) SUBHN_V2SV2DV2D(rd V2SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBHN, ErrNoMatch)
+	}
+	return a.emit(SUBHN, 2, 27685, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBHN2_V16BV8HV8H encodes subhn2 Vd.16B, Vn.8H, Vm.8H.
+func (a *Assembler) SUBHN2_V16BV8HV8H(rd V16BReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBHN2, ErrNoMatch)
+	}
+	return a.emit(SUBHN2, 0, 27693, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBHN2_V8HV4SV4S encodes subhn2 Vd.8H, Vn.4S, Vm.4S.
+func (a *Assembler) SUBHN2_V8HV4SV4S(rd V8HReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBHN2, ErrNoMatch)
+	}
+	return a.emit(SUBHN2, 1, 27701, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBHN2_V4SV2DV2D encodes subhn2 Vd.4S, Vn.2D, Vm.2D.
+func (a *Assembler) SUBHN2_V4SV2DV2D(rd V4SReg, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBHN2, ErrNoMatch)
+	}
+	return a.emit(SUBHN2, 2, 27709, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBP_XXspXsp encodes subp Xd, Xn|SP, Xm|SP.
+// Requires FEAT_MTE.
+func (a *Assembler) SUBP_XXspXsp(rd XReg, rn, rm XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBP, ErrNoMatch)
+	}
+	return a.emit(SUBP, 0, 27717, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBPS_XXspXsp encodes subps Xd, Xn|SP, Xm|SP.
+// Requires FEAT_MTE.
+func (a *Assembler) SUBPS_XXspXsp(rd XReg, rn, rm XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBPS, ErrNoMatch)
+	}
+	return a.emit(SUBPS, 0, 27725, FeatMTE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// SUBS_WWW encodes subs Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) SUBS_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 0, 27830, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUBS_WWW_Mod encodes subs Wd, Wn, Wm {, LSL|LSR|ASR #imm } (0 <= imm < 32).
+func (a *Assembler) SUBS_WWW_Mod(rd, rn, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 0, 27830, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUBS_XXX encodes subs Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) SUBS_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 1, 27842, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUBS_XXX_Mod encodes subs Xd, Xn, Xm {, LSL|LSR|ASR #imm } (0 <= imm < 64).
+func (a *Assembler) SUBS_XXX_Mod(rd, rn, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymShifts], mod.ID) {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 1, 27842, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUBS_WWspW encodes subs Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUBS_WWspW(rd WReg, rn WSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 2, 27854, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUBS_WWspW_Mod encodes subs Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUBS_WWspW_Mod(rd WReg, rn WSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtends], mod.ID) {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 2, 27854, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUBS_XXspW encodes subs Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUBS_XXspW(rd XReg, rn XSPReg, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 3, 27867, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUBS_XXspW_Mod encodes subs Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm } (0 <= imm <= 4).
+func (a *Assembler) SUBS_XXspW_Mod(rd XReg, rn XSPReg, rm WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsW], mod.ID) {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 3, 27867, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUBS_XXspX encodes subs Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) SUBS_XXspX(rd XReg, rn XSPReg, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 4, 27880, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{}, Flat{})
+}
+
+// SUBS_XXspX_Mod encodes subs Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm } (0 <= imm <= 4).
+func (a *Assembler) SUBS_XXspX_Mod(rd XReg, rn XSPReg, rm XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || !checkMod(ModList[SymExtendsX], mod.ID) {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 4, 27880, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// SUBS_WWsp_Imm encodes subs Wd, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUBS_WWsp_Imm(rd WReg, rn WSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 5, 27893, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// SUBS_WWsp_Imm_LSL encodes subs Wd, Wn|WSP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUBS_WWsp_Imm_LSL(rd WReg, rn WSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 5, 27893, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// SUBS_XXsp_Imm encodes subs Xd, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUBS_XXsp_Imm(rd XReg, rn XSPReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 6, 27906, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{})
+}
+
+// SUBS_XXsp_Imm_LSL encodes subs Xd, Xn|SP, #imm1 {, LSL #imm2 } (0 <= imm1 < 4096, imm2 in [0, 12]).
+func (a *Assembler) SUBS_XXsp_Imm_LSL(rd XReg, rn XSPReg, imm int64, amount uint8) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUBS, ErrNoMatch)
+	}
+	return a.emit(SUBS, 6, 27906, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(amount)})
+}
+
+// SUDOT_V2SV8BV4Bi encodes sudot Vd.2S, Vn.8B, Vm.4B[i].
+// Requires FEAT_I8MM.
+func (a *Assembler) SUDOT_V2SV8BV4Bi(rd V2SReg, rn V8BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SUDOT, ErrNoMatch)
+	}
+	return a.emit(SUDOT, 0, 27919, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SUDOT_V4SV16BV4Bi encodes sudot Vd.4S, Vn.16B, Vm.4B[i].
+// Requires FEAT_I8MM.
+func (a *Assembler) SUDOT_V4SV16BV4Bi(rd V4SReg, rn V16BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(SUDOT, ErrNoMatch)
+	}
+	return a.emit(SUDOT, 1, 27929, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// SUQADD_BB encodes suqadd Bd, Bn.
+func (a *Assembler) SUQADD_BB(rd, rn BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 0, 28037, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_HH encodes suqadd Hd, Hn.
+func (a *Assembler) SUQADD_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 1, 28044, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_SS encodes suqadd Sd, Sn.
+func (a *Assembler) SUQADD_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 2, 28051, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_DD encodes suqadd Dd, Dn.
+func (a *Assembler) SUQADD_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 3, 28058, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_V16BV16B encodes suqadd Vd.16B, Vn.16B.
+func (a *Assembler) SUQADD_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 4, 28065, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_V8BV8B encodes suqadd Vd.8B, Vn.8B.
+func (a *Assembler) SUQADD_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 4, 28065, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_V8HV8H encodes suqadd Vd.8H, Vn.8H.
+func (a *Assembler) SUQADD_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 5, 28073, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_V4HV4H encodes suqadd Vd.4H, Vn.4H.
+func (a *Assembler) SUQADD_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 5, 28073, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_V4SV4S encodes suqadd Vd.4S, Vn.4S.
+func (a *Assembler) SUQADD_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 6, 28081, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_V2SV2S encodes suqadd Vd.2S, Vn.2S.
+func (a *Assembler) SUQADD_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 6, 28081, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SUQADD_V2DV2D encodes suqadd Vd.2D, Vn.2D.
+func (a *Assembler) SUQADD_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SUQADD, ErrNoMatch)
+	}
+	return a.emit(SUQADD, 7, 28089, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SVC_Imm encodes svc #imm (0 <= imm < 65536).
+func (a *Assembler) SVC_Imm(imm int64) bool {
+	return a.emit(SVC, 0, 28097, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// SWP_WW_Ref encodes swp Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWP_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWP, ErrNoMatch)
+	}
+	return a.emit(SWP, 0, 28105, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWP_XX_Ref encodes swp Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWP_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWP, ErrNoMatch)
+	}
+	return a.emit(SWP, 1, 28113, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPA_WW_Ref encodes swpa Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPA_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPA, ErrNoMatch)
+	}
+	return a.emit(SWPA, 0, 28121, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPA_XX_Ref encodes swpa Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPA_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPA, ErrNoMatch)
+	}
+	return a.emit(SWPA, 1, 28129, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPAB_WW_Ref encodes swpab Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPAB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPAB, ErrNoMatch)
+	}
+	return a.emit(SWPAB, 0, 28137, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPAH_WW_Ref encodes swpah Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPAH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPAH, ErrNoMatch)
+	}
+	return a.emit(SWPAH, 0, 28145, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPAL_WW_Ref encodes swpal Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPAL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPAL, ErrNoMatch)
+	}
+	return a.emit(SWPAL, 0, 28153, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPAL_XX_Ref encodes swpal Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPAL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPAL, ErrNoMatch)
+	}
+	return a.emit(SWPAL, 1, 28161, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPALB_WW_Ref encodes swpalb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPALB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPALB, ErrNoMatch)
+	}
+	return a.emit(SWPALB, 0, 28169, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPALH_WW_Ref encodes swpalh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPALH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPALH, ErrNoMatch)
+	}
+	return a.emit(SWPALH, 0, 28177, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPB_WW_Ref encodes swpb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPB, ErrNoMatch)
+	}
+	return a.emit(SWPB, 0, 28185, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPH_WW_Ref encodes swph Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPH, ErrNoMatch)
+	}
+	return a.emit(SWPH, 0, 28193, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPL_WW_Ref encodes swpl Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPL_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPL, ErrNoMatch)
+	}
+	return a.emit(SWPL, 0, 28201, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPL_XX_Ref encodes swpl Xd, Xn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPL_XX_Ref(rd, rn XReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPL, ErrNoMatch)
+	}
+	return a.emit(SWPL, 1, 28209, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPLB_WW_Ref encodes swplb Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPLB_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPLB, ErrNoMatch)
+	}
+	return a.emit(SWPLB, 0, 28217, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SWPLH_WW_Ref encodes swplh Wd, Wn, [Xm|SP].
+// Requires FEAT_LSE.
+func (a *Assembler) SWPLH_WW_Ref(rd, rn WReg, base XSPReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(base)) >= 32 {
+		return a.emitErr(SWPLH, ErrNoMatch)
+	}
+	return a.emit(SWPLH, 0, 28225, FeatLSE, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(base)})
+}
+
+// SXTB_WW encodes sxtb Wd, Wn.
+func (a *Assembler) SXTB_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTB, ErrNoMatch)
+	}
+	return a.emit(SXTB, 0, 28233, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTB_XW encodes sxtb Xd, Wn.
+func (a *Assembler) SXTB_XW(rd XReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTB, ErrNoMatch)
+	}
+	return a.emit(SXTB, 1, 28240, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTH_WW encodes sxth Wd, Wn.
+func (a *Assembler) SXTH_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTH, ErrNoMatch)
+	}
+	return a.emit(SXTH, 0, 28247, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTH_XW encodes sxth Xd, Wn.
+func (a *Assembler) SXTH_XW(rd XReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTH, ErrNoMatch)
+	}
+	return a.emit(SXTH, 1, 28254, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTL_V8HV8B encodes sxtl Vd.8H, Vn.8B.
+func (a *Assembler) SXTL_V8HV8B(rd V8HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTL, ErrNoMatch)
+	}
+	return a.emit(SXTL, 0, 28261, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTL_V4SV4H encodes sxtl Vd.4S, Vn.4H.
+func (a *Assembler) SXTL_V4SV4H(rd V4SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTL, ErrNoMatch)
+	}
+	return a.emit(SXTL, 1, 28268, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTL_V2DV2S encodes sxtl Vd.2D, Vn.2S.
+func (a *Assembler) SXTL_V2DV2S(rd V2DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTL, ErrNoMatch)
+	}
+	return a.emit(SXTL, 2, 28275, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTL2_V8HV16B encodes sxtl2 Vd.8H, Vn.16B.
+func (a *Assembler) SXTL2_V8HV16B(rd V8HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTL2, ErrNoMatch)
+	}
+	return a.emit(SXTL2, 0, 28282, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTL2_V4SV8H encodes sxtl2 Vd.4S, Vn.8H.
+func (a *Assembler) SXTL2_V4SV8H(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTL2, ErrNoMatch)
+	}
+	return a.emit(SXTL2, 1, 28289, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTL2_V2DV4S encodes sxtl2 Vd.2D, Vn.4S.
+func (a *Assembler) SXTL2_V2DV4S(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTL2, ErrNoMatch)
+	}
+	return a.emit(SXTL2, 2, 28296, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SXTW_XW encodes sxtw Xd, Wn.
+func (a *Assembler) SXTW_XW(rd XReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(SXTW, ErrNoMatch)
+	}
+	return a.emit(SXTW, 0, 28303, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SYS_Imm_Sym_Sym_Imm encodes sys #imm1, <symbol>, <symbol>, #imm2 {, Xn } (0 <= imm1 < 8, 0 <= imm2 < 8).
+func (a *Assembler) SYS_Imm_Sym_Sym_Imm(imm int64, sym, sym2 Symbol, imm2 int64) bool {
+	return a.emit(SYS, 0, 28310, 0, 0, 0, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(sym2)}, Flat{FlatImm, uint64(imm2)}, Flat{})
+}
+
+// SYS_Imm_Sym_Sym_Imm_X encodes sys #imm1, <symbol>, <symbol>, #imm2 {, Xn } (0 <= imm1 < 8, 0 <= imm2 < 8).
+func (a *Assembler) SYS_Imm_Sym_Sym_Imm_X(imm int64, sym, sym2 Symbol, imm2 int64, rn XReg) bool {
+	if rn >= 32 {
+		return a.emitErr(SYS, ErrNoMatch)
+	}
+	return a.emit(SYS, 0, 28310, 0, 0, 0, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(sym2)}, Flat{FlatImm, uint64(imm2)}, Flat{FlatReg, uint64(rn)})
+}
+
+// SYSL_X_Imm_Sym_Sym_Imm encodes sysl Xd, #imm1, <symbol>, <symbol>, #imm2 (0 <= imm1 < 8, 0 <= imm2 < 8).
+func (a *Assembler) SYSL_X_Imm_Sym_Sym_Imm(rd XReg, imm int64, sym, sym2 Symbol, imm2 int64) bool {
+	if rd >= 32 {
+		return a.emitErr(SYSL, ErrNoMatch)
+	}
+	return a.emit(SYSL, 0, 28328, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(sym)}, Flat{FlatImm, uint64(sym2)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// TBNZ_W_Imm_Label encodes tbnz Wd, #imm, <offset> (0 <= imm < 32, offset >> 2 is 14-bit (+/- 32 KB)).
+func (a *Assembler) TBNZ_W_Imm_Label(rd WReg, imm int64, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(TBNZ, ErrNoMatch)
+	}
+	return a.emit(TBNZ, 0, 28414, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, flatLabel(label))
+}
+
+// TBNZ_X_Imm_Label encodes tbnz Xd, #imm, <offset> (0 <= imm < 64, offset >> 2 is 14-bit (+/- 32 KB)).
+func (a *Assembler) TBNZ_X_Imm_Label(rd XReg, imm int64, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(TBNZ, ErrNoMatch)
+	}
+	return a.emit(TBNZ, 1, 28425, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, flatLabel(label))
+}
+
+// TBZ_W_Imm_Label encodes tbz Wd, #imm, <offset> (0 <= imm < 32, offset >> 2 is 14-bit (+/- 32 KB)).
+func (a *Assembler) TBZ_W_Imm_Label(rd WReg, imm int64, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(TBZ, ErrNoMatch)
+	}
+	return a.emit(TBZ, 0, 28480, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, flatLabel(label))
+}
+
+// TBZ_X_Imm_Label encodes tbz Xd, #imm, <offset> (0 <= imm < 64, offset >> 2 is 14-bit (+/- 32 KB)).
+func (a *Assembler) TBZ_X_Imm_Label(rd XReg, imm int64, label Label) bool {
+	if rd >= 32 || !a.validLabel(label) {
+		return a.emitErr(TBZ, ErrNoMatch)
+	}
+	return a.emit(TBZ, 1, 28491, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)}, flatLabel(label))
+}
+
+// TLBI_Sym encodes tlbi <symbol> {, Xn }.
+func (a *Assembler) TLBI_Sym(sym Symbol) bool {
+	return a.emit(TLBI, 0, 28510, 0, 0, 0, Flat{FlatImm, uint64(sym)}, Flat{})
+}
+
+// TLBI_Sym_X encodes tlbi <symbol> {, Xn }.
+func (a *Assembler) TLBI_Sym_X(sym Symbol, rn XReg) bool {
+	if rn >= 32 {
+		return a.emitErr(TLBI, ErrNoMatch)
+	}
+	return a.emit(TLBI, 0, 28510, 0, 0, 0, Flat{FlatImm, uint64(sym)}, Flat{FlatReg, uint64(rn)})
+}
+
+// TRN1_V16BV16BV16B encodes trn1 Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) TRN1_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN1, ErrNoMatch)
+	}
+	return a.emit(TRN1, 0, 28519, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN1_V8BV8BV8B encodes trn1 Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) TRN1_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN1, ErrNoMatch)
+	}
+	return a.emit(TRN1, 0, 28519, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN1_V8HV8HV8H encodes trn1 Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) TRN1_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN1, ErrNoMatch)
+	}
+	return a.emit(TRN1, 1, 28528, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN1_V4HV4HV4H encodes trn1 Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) TRN1_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN1, ErrNoMatch)
+	}
+	return a.emit(TRN1, 1, 28528, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN1_V4SV4SV4S encodes trn1 Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) TRN1_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN1, ErrNoMatch)
+	}
+	return a.emit(TRN1, 2, 28537, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN1_V2SV2SV2S encodes trn1 Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) TRN1_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN1, ErrNoMatch)
+	}
+	return a.emit(TRN1, 2, 28537, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN1_V2DV2DV2D encodes trn1 Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) TRN1_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN1, ErrNoMatch)
+	}
+	return a.emit(TRN1, 3, 28546, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN2_V16BV16BV16B encodes trn2 Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) TRN2_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN2, ErrNoMatch)
+	}
+	return a.emit(TRN2, 0, 28619, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN2_V8BV8BV8B encodes trn2 Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) TRN2_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN2, ErrNoMatch)
+	}
+	return a.emit(TRN2, 0, 28619, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN2_V8HV8HV8H encodes trn2 Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) TRN2_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN2, ErrNoMatch)
+	}
+	return a.emit(TRN2, 1, 28628, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN2_V4HV4HV4H encodes trn2 Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) TRN2_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN2, ErrNoMatch)
+	}
+	return a.emit(TRN2, 1, 28628, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN2_V4SV4SV4S encodes trn2 Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) TRN2_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN2, ErrNoMatch)
+	}
+	return a.emit(TRN2, 2, 28637, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN2_V2SV2SV2S encodes trn2 Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) TRN2_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN2, ErrNoMatch)
+	}
+	return a.emit(TRN2, 2, 28637, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TRN2_V2DV2DV2D encodes trn2 Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) TRN2_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(TRN2, ErrNoMatch)
+	}
+	return a.emit(TRN2, 3, 28646, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// TSB_CSYNC encodes tsb CSYNC.
+func (a *Assembler) TSB_CSYNC() bool {
+	return a.emit(TSB, 0, 28719, 0, 0, 0)
+}
+
+// TST_W_Imm encodes tst Wd, #imm (imm is 32-bit logical).
+func (a *Assembler) TST_W_Imm(rd WReg, imm int64) bool {
+	if rd >= 32 {
+		return a.emitErr(TST, ErrNoMatch)
+	}
+	return a.emit(TST, 0, 28724, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// TST_X_Imm encodes tst Xd, #imm (imm is 64-bit logical).
+func (a *Assembler) TST_X_Imm(rd XReg, imm uint64) bool {
+	if rd >= 32 {
+		return a.emitErr(TST, ErrNoMatch)
+	}
+	return a.emit(TST, 1, 28733, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatImm, uint64(imm)})
+}
+
+// TST_WW encodes tst Wd, Wn {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) TST_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(TST, ErrNoMatch)
+	}
+	return a.emit(TST, 2, 28742, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// TST_WW_Mod encodes tst Wd, Wn {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 32).
+func (a *Assembler) TST_WW_Mod(rd, rn WReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(TST, ErrNoMatch)
+	}
+	return a.emit(TST, 2, 28742, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// TST_XX encodes tst Xd, Xn {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) TST_XX(rd, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(TST, ErrNoMatch)
+	}
+	return a.emit(TST, 3, 28753, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{}, Flat{})
+}
+
+// TST_XX_Mod encodes tst Xd, Xn {, LSL|LSR|ASR|ROR #imm } (0 <= imm < 64).
+func (a *Assembler) TST_XX_Mod(rd, rn XReg, mod Mod) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || !checkMod(ModList[SymRotates], mod.ID) {
+		return a.emitErr(TST, ErrNoMatch)
+	}
+	return a.emit(TST, 3, 28753, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatMod, uint64(mod.ID)}, flatModImm(mod))
+}
+
+// UABA_V16BV16BV16B encodes uaba Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UABA_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABA, ErrNoMatch)
+	}
+	return a.emit(UABA, 0, 28764, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABA_V8BV8BV8B encodes uaba Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UABA_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABA, ErrNoMatch)
+	}
+	return a.emit(UABA, 0, 28764, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABA_V8HV8HV8H encodes uaba Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UABA_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABA, ErrNoMatch)
+	}
+	return a.emit(UABA, 1, 28773, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABA_V4HV4HV4H encodes uaba Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UABA_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABA, ErrNoMatch)
+	}
+	return a.emit(UABA, 1, 28773, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABA_V4SV4SV4S encodes uaba Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UABA_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABA, ErrNoMatch)
+	}
+	return a.emit(UABA, 2, 28782, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABA_V2SV2SV2S encodes uaba Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UABA_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABA, ErrNoMatch)
+	}
+	return a.emit(UABA, 2, 28782, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABAL_V8HV8BV8B encodes uabal Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) UABAL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABAL, ErrNoMatch)
+	}
+	return a.emit(UABAL, 0, 28791, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABAL_V4SV4HV4H encodes uabal Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) UABAL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABAL, ErrNoMatch)
+	}
+	return a.emit(UABAL, 1, 28799, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABAL_V2DV2SV2S encodes uabal Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) UABAL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABAL, ErrNoMatch)
+	}
+	return a.emit(UABAL, 2, 28807, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABAL2_V8HV16BV16B encodes uabal2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) UABAL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABAL2, ErrNoMatch)
+	}
+	return a.emit(UABAL2, 0, 28815, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABAL2_V4SV8HV8H encodes uabal2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) UABAL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABAL2, ErrNoMatch)
+	}
+	return a.emit(UABAL2, 1, 28823, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABAL2_V2DV4SV4S encodes uabal2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) UABAL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABAL2, ErrNoMatch)
+	}
+	return a.emit(UABAL2, 2, 28831, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABD_V16BV16BV16B encodes uabd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UABD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABD, ErrNoMatch)
+	}
+	return a.emit(UABD, 0, 28839, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABD_V8BV8BV8B encodes uabd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UABD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABD, ErrNoMatch)
+	}
+	return a.emit(UABD, 0, 28839, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABD_V8HV8HV8H encodes uabd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UABD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABD, ErrNoMatch)
+	}
+	return a.emit(UABD, 1, 28848, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABD_V4HV4HV4H encodes uabd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UABD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABD, ErrNoMatch)
+	}
+	return a.emit(UABD, 1, 28848, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABD_V4SV4SV4S encodes uabd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UABD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABD, ErrNoMatch)
+	}
+	return a.emit(UABD, 2, 28857, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABD_V2SV2SV2S encodes uabd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UABD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABD, ErrNoMatch)
+	}
+	return a.emit(UABD, 2, 28857, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABDL_V8HV8BV8B encodes uabdl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) UABDL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABDL, ErrNoMatch)
+	}
+	return a.emit(UABDL, 0, 28910, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABDL_V4SV4HV4H encodes uabdl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) UABDL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABDL, ErrNoMatch)
+	}
+	return a.emit(UABDL, 1, 28918, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABDL_V2DV2SV2S encodes uabdl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) UABDL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABDL, ErrNoMatch)
+	}
+	return a.emit(UABDL, 2, 28926, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABDL2_V8HV16BV16B encodes uabdl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) UABDL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABDL2, ErrNoMatch)
+	}
+	return a.emit(UABDL2, 0, 28934, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABDL2_V4SV8HV8H encodes uabdl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) UABDL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABDL2, ErrNoMatch)
+	}
+	return a.emit(UABDL2, 1, 28942, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UABDL2_V2DV4SV4S encodes uabdl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) UABDL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UABDL2, ErrNoMatch)
+	}
+	return a.emit(UABDL2, 2, 28950, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADALP_V8HV16B encodes uadalp Vd.8H, Vn.16B.
+func (a *Assembler) UADALP_V8HV16B(rd V8HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADALP, ErrNoMatch)
+	}
+	return a.emit(UADALP, 0, 28958, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADALP_V4HV8B encodes uadalp Vd.4H, Vn.8B.
+func (a *Assembler) UADALP_V4HV8B(rd V4HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADALP, ErrNoMatch)
+	}
+	return a.emit(UADALP, 0, 28958, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADALP_V4SV8H encodes uadalp Vd.4S, Vn.8H.
+func (a *Assembler) UADALP_V4SV8H(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADALP, ErrNoMatch)
+	}
+	return a.emit(UADALP, 1, 28966, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADALP_V2SV4H encodes uadalp Vd.2S, Vn.4H.
+func (a *Assembler) UADALP_V2SV4H(rd V2SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADALP, ErrNoMatch)
+	}
+	return a.emit(UADALP, 1, 28966, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADALP_V2DV4S encodes uadalp Vd.2D, Vn.4S.
+func (a *Assembler) UADALP_V2DV4S(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADALP, ErrNoMatch)
+	}
+	return a.emit(UADALP, 2, 28974, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADALP_V1DV2S encodes uadalp Vd.1D, Vn.2S.
+func (a *Assembler) UADALP_V1DV2S(rd V1DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADALP, ErrNoMatch)
+	}
+	return a.emit(UADALP, 2, 28974, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDL_V8HV8BV8B encodes uaddl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) UADDL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDL, ErrNoMatch)
+	}
+	return a.emit(UADDL, 0, 28982, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDL_V4SV4HV4H encodes uaddl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) UADDL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDL, ErrNoMatch)
+	}
+	return a.emit(UADDL, 1, 28990, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDL_V2DV2SV2S encodes uaddl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) UADDL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDL, ErrNoMatch)
+	}
+	return a.emit(UADDL, 2, 28998, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDL2_V8HV16BV16B encodes uaddl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) UADDL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDL2, ErrNoMatch)
+	}
+	return a.emit(UADDL2, 0, 29006, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDL2_V4SV8HV8H encodes uaddl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) UADDL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDL2, ErrNoMatch)
+	}
+	return a.emit(UADDL2, 1, 29014, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDL2_V2DV4SV4S encodes uaddl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) UADDL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDL2, ErrNoMatch)
+	}
+	return a.emit(UADDL2, 2, 29022, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDLP_V8HV16B encodes uaddlp Vd.8H, Vn.16B.
+func (a *Assembler) UADDLP_V8HV16B(rd V8HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLP, ErrNoMatch)
+	}
+	return a.emit(UADDLP, 0, 29030, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLP_V4HV8B encodes uaddlp Vd.4H, Vn.8B.
+func (a *Assembler) UADDLP_V4HV8B(rd V4HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLP, ErrNoMatch)
+	}
+	return a.emit(UADDLP, 0, 29030, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLP_V4SV8H encodes uaddlp Vd.4S, Vn.8H.
+func (a *Assembler) UADDLP_V4SV8H(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLP, ErrNoMatch)
+	}
+	return a.emit(UADDLP, 1, 29038, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLP_V2SV4H encodes uaddlp Vd.2S, Vn.4H.
+func (a *Assembler) UADDLP_V2SV4H(rd V2SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLP, ErrNoMatch)
+	}
+	return a.emit(UADDLP, 1, 29038, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLP_V2DV4S encodes uaddlp Vd.2D, Vn.4S.
+func (a *Assembler) UADDLP_V2DV4S(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLP, ErrNoMatch)
+	}
+	return a.emit(UADDLP, 2, 29046, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLP_V1DV2S encodes uaddlp Vd.1D, Vn.2S.
+func (a *Assembler) UADDLP_V1DV2S(rd V1DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLP, ErrNoMatch)
+	}
+	return a.emit(UADDLP, 2, 29046, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLV_HV16B encodes uaddlv Hd, Vn.16B.
+func (a *Assembler) UADDLV_HV16B(rd HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLV, ErrNoMatch)
+	}
+	return a.emit(UADDLV, 0, 29054, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLV_HV8B encodes uaddlv Hd, Vn.8B.
+func (a *Assembler) UADDLV_HV8B(rd HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLV, ErrNoMatch)
+	}
+	return a.emit(UADDLV, 0, 29054, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLV_SV8H encodes uaddlv Sd, Vn.8H.
+func (a *Assembler) UADDLV_SV8H(rd SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLV, ErrNoMatch)
+	}
+	return a.emit(UADDLV, 1, 29062, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLV_SV4H encodes uaddlv Sd, Vn.4H.
+func (a *Assembler) UADDLV_SV4H(rd SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLV, ErrNoMatch)
+	}
+	return a.emit(UADDLV, 1, 29062, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDLV_DV4S encodes uaddlv Dd, Vn.4S.
+func (a *Assembler) UADDLV_DV4S(rd DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UADDLV, ErrNoMatch)
+	}
+	return a.emit(UADDLV, 2, 29070, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UADDW_V8HV8HV8B encodes uaddw Vd.8H, Vn.8H, Vm.8B.
+func (a *Assembler) UADDW_V8HV8HV8B(rd, rn V8HReg, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDW, ErrNoMatch)
+	}
+	return a.emit(UADDW, 0, 29114, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDW_V4SV4SV4H encodes uaddw Vd.4S, Vn.4S, Vm.4H.
+func (a *Assembler) UADDW_V4SV4SV4H(rd, rn V4SReg, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDW, ErrNoMatch)
+	}
+	return a.emit(UADDW, 1, 29122, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDW_V2DV2DV2S encodes uaddw Vd.2D, Vn.2D, Vm.2S.
+func (a *Assembler) UADDW_V2DV2DV2S(rd, rn V2DReg, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDW, ErrNoMatch)
+	}
+	return a.emit(UADDW, 2, 29130, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDW2_V8HV8HV16B encodes uaddw2 Vd.8H, Vn.8H, Vm.16B.
+func (a *Assembler) UADDW2_V8HV8HV16B(rd, rn V8HReg, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDW2, ErrNoMatch)
+	}
+	return a.emit(UADDW2, 0, 29138, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDW2_V4SV4SV8H encodes uaddw2 Vd.4S, Vn.4S, Vm.8H.
+func (a *Assembler) UADDW2_V4SV4SV8H(rd, rn V4SReg, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDW2, ErrNoMatch)
+	}
+	return a.emit(UADDW2, 1, 29146, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UADDW2_V2DV2DV4S encodes uaddw2 Vd.2D, Vn.2D, Vm.4S.
+func (a *Assembler) UADDW2_V2DV2DV4S(rd, rn V2DReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UADDW2, ErrNoMatch)
+	}
+	return a.emit(UADDW2, 2, 29154, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UBFIZ_WW_Imm_Imm encodes ubfiz Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32).
+func (a *Assembler) UBFIZ_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UBFIZ, ErrNoMatch)
+	}
+	return a.emit(UBFIZ, 0, 29162, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// UBFIZ_XX_Imm_Imm encodes ubfiz Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64).
+func (a *Assembler) UBFIZ_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UBFIZ, ErrNoMatch)
+	}
+	return a.emit(UBFIZ, 1, 29178, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// UBFM_WW_Imm_Imm encodes ubfm Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 <= imm2 < 32).
+func (a *Assembler) UBFM_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UBFM, ErrNoMatch)
+	}
+	return a.emit(UBFM, 0, 29194, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// UBFM_XX_Imm_Imm encodes ubfm Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64).
+func (a *Assembler) UBFM_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UBFM, ErrNoMatch)
+	}
+	return a.emit(UBFM, 1, 29207, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// UBFX_WW_Imm_Imm encodes ubfx Wd, Wn, #imm1, #imm2 (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32).
+func (a *Assembler) UBFX_WW_Imm_Imm(rd, rn WReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UBFX, ErrNoMatch)
+	}
+	return a.emit(UBFX, 0, 29222, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// UBFX_XX_Imm_Imm encodes ubfx Xd, Xn, #imm1, #imm2 (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64).
+func (a *Assembler) UBFX_XX_Imm_Imm(rd, rn XReg, imm, imm2 int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UBFX, ErrNoMatch)
+	}
+	return a.emit(UBFX, 1, 29237, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)}, Flat{FlatImm, uint64(imm2)})
+}
+
+// UCVTF_HH_Imm encodes ucvtf Hd, Hn, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_HH_Imm(rd, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 0, 29252, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_SS_Imm encodes ucvtf Sd, Sn, #imm (0 < imm <= 32).
+func (a *Assembler) UCVTF_SS_Imm(rd, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 1, 29265, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_DD_Imm encodes ucvtf Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) UCVTF_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 2, 29278, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_V8HV8H_Imm encodes ucvtf Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 3, 29291, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_V4HV4H_Imm encodes ucvtf Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 3, 29291, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_V4SV4S_Imm encodes ucvtf Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) UCVTF_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 4, 29303, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_V2SV2S_Imm encodes ucvtf Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) UCVTF_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 4, 29303, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_V2DV2D_Imm encodes ucvtf Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) UCVTF_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 5, 29315, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_HH encodes ucvtf Hd, Hn.
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 6, 29327, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_SS encodes ucvtf Sd, Sn.
+func (a *Assembler) UCVTF_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 7, 29334, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_DD encodes ucvtf Dd, Dn.
+func (a *Assembler) UCVTF_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 8, 29341, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_V8HV8H encodes ucvtf Vd.8H, Vn.8H.
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 9, 29348, FeatFP16, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_V4HV4H encodes ucvtf Vd.4H, Vn.4H.
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 9, 29348, FeatFP16, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_V4SV4S encodes ucvtf Vd.4S, Vn.4S.
+func (a *Assembler) UCVTF_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 10, 29356, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_V2SV2S encodes ucvtf Vd.2S, Vn.2S.
+func (a *Assembler) UCVTF_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 10, 29356, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_V2DV2D encodes ucvtf Vd.2D, Vn.2D.
+func (a *Assembler) UCVTF_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 11, 29364, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_HW_Imm encodes ucvtf Hd, Wn, #imm (0 < imm <= 32).
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_HW_Imm(rd HReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 12, 29372, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_SW_Imm encodes ucvtf Sd, Wn, #imm (0 < imm <= 32).
+func (a *Assembler) UCVTF_SW_Imm(rd SReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 13, 29385, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_DW_Imm encodes ucvtf Dd, Wn, #imm (0 < imm <= 32).
+func (a *Assembler) UCVTF_DW_Imm(rd DReg, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 14, 29398, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_HX_Imm encodes ucvtf Hd, Xn, #imm (0 < imm <= 64).
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_HX_Imm(rd HReg, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 15, 29411, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_SX_Imm encodes ucvtf Sd, Xn, #imm (0 < imm <= 64).
+func (a *Assembler) UCVTF_SX_Imm(rd SReg, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 16, 29422, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_DX_Imm encodes ucvtf Dd, Xn, #imm (0 < imm <= 64).
+func (a *Assembler) UCVTF_DX_Imm(rd DReg, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 17, 29433, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UCVTF_HW encodes ucvtf Hd, Wn.
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_HW(rd HReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 18, 29444, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_SW encodes ucvtf Sd, Wn.
+func (a *Assembler) UCVTF_SW(rd SReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 19, 29451, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_DW encodes ucvtf Dd, Wn.
+func (a *Assembler) UCVTF_DW(rd DReg, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 20, 29458, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_HX encodes ucvtf Hd, Xn.
+// Requires FEAT_FP16.
+func (a *Assembler) UCVTF_HX(rd HReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 21, 29465, FeatFP16, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_SX encodes ucvtf Sd, Xn.
+func (a *Assembler) UCVTF_SX(rd SReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 22, 29472, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UCVTF_DX encodes ucvtf Dd, Xn.
+func (a *Assembler) UCVTF_DX(rd DReg, rn XReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UCVTF, ErrNoMatch)
+	}
+	return a.emit(UCVTF, 23, 29479, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UDF_Imm encodes udf #imm (0 <= imm < 65536).
+func (a *Assembler) UDF_Imm(imm int64) bool {
+	return a.emit(UDF, 0, 29513, 0, 0, 0, Flat{FlatImm, uint64(imm)})
+}
+
+// UDIV_WWW encodes udiv Wd, Wn, Wm.
+func (a *Assembler) UDIV_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UDIV, ErrNoMatch)
+	}
+	return a.emit(UDIV, 0, 29521, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UDIV_XXX encodes udiv Xd, Xn, Xm.
+func (a *Assembler) UDIV_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UDIV, ErrNoMatch)
+	}
+	return a.emit(UDIV, 1, 29529, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UDOT_V2SV8BV4Bi encodes udot Vd.2S, Vn.8B, Vm.4B[i].
+// Requires FEAT_DotProd.
+func (a *Assembler) UDOT_V2SV8BV4Bi(rd V2SReg, rn V8BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UDOT, ErrNoMatch)
+	}
+	return a.emit(UDOT, 0, 29581, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UDOT_V4SV16BV4Bi encodes udot Vd.4S, Vn.16B, Vm.4B[i].
+// Requires FEAT_DotProd.
+func (a *Assembler) UDOT_V4SV16BV4Bi(rd V4SReg, rn V16BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UDOT, ErrNoMatch)
+	}
+	return a.emit(UDOT, 1, 29591, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UDOT_V2SV8BV8B encodes udot Vd.2S, Vn.8B, Vm.8B.
+// Requires FEAT_DotProd.
+func (a *Assembler) UDOT_V2SV8BV8B(rd V2SReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UDOT, ErrNoMatch)
+	}
+	return a.emit(UDOT, 2, 29601, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UDOT_V4SV16BV16B encodes udot Vd.4S, Vn.16B, Vm.16B.
+// Requires FEAT_DotProd.
+func (a *Assembler) UDOT_V4SV16BV16B(rd V4SReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UDOT, ErrNoMatch)
+	}
+	return a.emit(UDOT, 3, 29609, FeatDotProd, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHADD_V16BV16BV16B encodes uhadd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UHADD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHADD, ErrNoMatch)
+	}
+	return a.emit(UHADD, 0, 29617, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHADD_V8BV8BV8B encodes uhadd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UHADD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHADD, ErrNoMatch)
+	}
+	return a.emit(UHADD, 0, 29617, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHADD_V8HV8HV8H encodes uhadd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UHADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHADD, ErrNoMatch)
+	}
+	return a.emit(UHADD, 1, 29626, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHADD_V4HV4HV4H encodes uhadd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UHADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHADD, ErrNoMatch)
+	}
+	return a.emit(UHADD, 1, 29626, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHADD_V4SV4SV4S encodes uhadd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UHADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHADD, ErrNoMatch)
+	}
+	return a.emit(UHADD, 2, 29635, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHADD_V2SV2SV2S encodes uhadd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UHADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHADD, ErrNoMatch)
+	}
+	return a.emit(UHADD, 2, 29635, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHSUB_V16BV16BV16B encodes uhsub Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UHSUB_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHSUB, ErrNoMatch)
+	}
+	return a.emit(UHSUB, 0, 29644, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHSUB_V8BV8BV8B encodes uhsub Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UHSUB_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHSUB, ErrNoMatch)
+	}
+	return a.emit(UHSUB, 0, 29644, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHSUB_V8HV8HV8H encodes uhsub Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UHSUB_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHSUB, ErrNoMatch)
+	}
+	return a.emit(UHSUB, 1, 29653, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHSUB_V4HV4HV4H encodes uhsub Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UHSUB_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHSUB, ErrNoMatch)
+	}
+	return a.emit(UHSUB, 1, 29653, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHSUB_V4SV4SV4S encodes uhsub Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UHSUB_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHSUB, ErrNoMatch)
+	}
+	return a.emit(UHSUB, 2, 29662, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UHSUB_V2SV2SV2S encodes uhsub Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UHSUB_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UHSUB, ErrNoMatch)
+	}
+	return a.emit(UHSUB, 2, 29662, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMADDL_XWWX encodes umaddl Xd, Wn, Wm, Xa.
+func (a *Assembler) UMADDL_XWWX(rd XReg, rn, rm WReg, ra XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(UMADDL, ErrNoMatch)
+	}
+	return a.emit(UMADDL, 0, 29671, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// UMAX_V16BV16BV16B encodes umax Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UMAX_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 0, 29680, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAX_V8BV8BV8B encodes umax Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UMAX_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 0, 29680, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAX_V8HV8HV8H encodes umax Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UMAX_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 1, 29689, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAX_V4HV4HV4H encodes umax Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UMAX_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 1, 29689, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAX_V4SV4SV4S encodes umax Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UMAX_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 2, 29698, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAX_V2SV2SV2S encodes umax Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UMAX_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 2, 29698, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAX_WW_Imm encodes umax Wd, Wn, #imm (0 <= imm < 256).
+// Requires FEAT_CSSC.
+func (a *Assembler) UMAX_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 3, 29707, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UMAX_XX_Imm encodes umax Xd, Xn, #imm (0 <= imm < 256).
+// Requires FEAT_CSSC.
+func (a *Assembler) UMAX_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 4, 29717, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UMAX_WWW encodes umax Wd, Wn, Wm.
+// Requires FEAT_CSSC.
+func (a *Assembler) UMAX_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 5, 29727, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAX_XXX encodes umax Xd, Xn, Xm.
+// Requires FEAT_CSSC.
+func (a *Assembler) UMAX_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAX, ErrNoMatch)
+	}
+	return a.emit(UMAX, 6, 29735, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAXP_V16BV16BV16B encodes umaxp Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UMAXP_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAXP, ErrNoMatch)
+	}
+	return a.emit(UMAXP, 0, 29831, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAXP_V8BV8BV8B encodes umaxp Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UMAXP_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAXP, ErrNoMatch)
+	}
+	return a.emit(UMAXP, 0, 29831, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAXP_V8HV8HV8H encodes umaxp Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UMAXP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAXP, ErrNoMatch)
+	}
+	return a.emit(UMAXP, 1, 29840, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAXP_V4HV4HV4H encodes umaxp Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UMAXP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAXP, ErrNoMatch)
+	}
+	return a.emit(UMAXP, 1, 29840, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAXP_V4SV4SV4S encodes umaxp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UMAXP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAXP, ErrNoMatch)
+	}
+	return a.emit(UMAXP, 2, 29849, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAXP_V2SV2SV2S encodes umaxp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UMAXP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMAXP, ErrNoMatch)
+	}
+	return a.emit(UMAXP, 2, 29849, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMAXV_BV16B encodes umaxv Bd, Vn.16B.
+func (a *Assembler) UMAXV_BV16B(rd BReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMAXV, ErrNoMatch)
+	}
+	return a.emit(UMAXV, 0, 29858, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMAXV_BV8B encodes umaxv Bd, Vn.8B.
+func (a *Assembler) UMAXV_BV8B(rd BReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMAXV, ErrNoMatch)
+	}
+	return a.emit(UMAXV, 0, 29858, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMAXV_HV8H encodes umaxv Hd, Vn.8H.
+func (a *Assembler) UMAXV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMAXV, ErrNoMatch)
+	}
+	return a.emit(UMAXV, 1, 29866, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMAXV_HV4H encodes umaxv Hd, Vn.4H.
+func (a *Assembler) UMAXV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMAXV, ErrNoMatch)
+	}
+	return a.emit(UMAXV, 1, 29866, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMAXV_SV4S encodes umaxv Sd, Vn.4S.
+func (a *Assembler) UMAXV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMAXV, ErrNoMatch)
+	}
+	return a.emit(UMAXV, 2, 29874, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMIN_V16BV16BV16B encodes umin Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UMIN_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 0, 29918, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMIN_V8BV8BV8B encodes umin Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UMIN_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 0, 29918, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMIN_V8HV8HV8H encodes umin Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UMIN_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 1, 29927, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMIN_V4HV4HV4H encodes umin Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UMIN_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 1, 29927, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMIN_V4SV4SV4S encodes umin Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UMIN_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 2, 29936, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMIN_V2SV2SV2S encodes umin Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UMIN_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 2, 29936, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMIN_WW_Imm encodes umin Wd, Wn, #imm (0 <= imm < 256).
+// Requires FEAT_CSSC.
+func (a *Assembler) UMIN_WW_Imm(rd, rn WReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 3, 29945, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UMIN_XX_Imm encodes umin Xd, Xn, #imm (0 <= imm < 256).
+// Requires FEAT_CSSC.
+func (a *Assembler) UMIN_XX_Imm(rd, rn XReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 4, 29955, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UMIN_WWW encodes umin Wd, Wn, Wm.
+// Requires FEAT_CSSC.
+func (a *Assembler) UMIN_WWW(rd, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 5, 29965, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMIN_XXX encodes umin Xd, Xn, Xm.
+// Requires FEAT_CSSC.
+func (a *Assembler) UMIN_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMIN, ErrNoMatch)
+	}
+	return a.emit(UMIN, 6, 29973, FeatCSSC, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMINP_V16BV16BV16B encodes uminp Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UMINP_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMINP, ErrNoMatch)
+	}
+	return a.emit(UMINP, 0, 30069, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMINP_V8BV8BV8B encodes uminp Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UMINP_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMINP, ErrNoMatch)
+	}
+	return a.emit(UMINP, 0, 30069, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMINP_V8HV8HV8H encodes uminp Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UMINP_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMINP, ErrNoMatch)
+	}
+	return a.emit(UMINP, 1, 30078, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMINP_V4HV4HV4H encodes uminp Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UMINP_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMINP, ErrNoMatch)
+	}
+	return a.emit(UMINP, 1, 30078, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMINP_V4SV4SV4S encodes uminp Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UMINP_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMINP, ErrNoMatch)
+	}
+	return a.emit(UMINP, 2, 30087, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMINP_V2SV2SV2S encodes uminp Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UMINP_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMINP, ErrNoMatch)
+	}
+	return a.emit(UMINP, 2, 30087, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMINV_BV16B encodes uminv Bd, Vn.16B.
+func (a *Assembler) UMINV_BV16B(rd BReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMINV, ErrNoMatch)
+	}
+	return a.emit(UMINV, 0, 30096, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMINV_BV8B encodes uminv Bd, Vn.8B.
+func (a *Assembler) UMINV_BV8B(rd BReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMINV, ErrNoMatch)
+	}
+	return a.emit(UMINV, 0, 30096, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMINV_HV8H encodes uminv Hd, Vn.8H.
+func (a *Assembler) UMINV_HV8H(rd HReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMINV, ErrNoMatch)
+	}
+	return a.emit(UMINV, 1, 30104, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMINV_HV4H encodes uminv Hd, Vn.4H.
+func (a *Assembler) UMINV_HV4H(rd HReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMINV, ErrNoMatch)
+	}
+	return a.emit(UMINV, 1, 30104, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMINV_SV4S encodes uminv Sd, Vn.4S.
+func (a *Assembler) UMINV_SV4S(rd SReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UMINV, ErrNoMatch)
+	}
+	return a.emit(UMINV, 2, 30112, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UMLAL_V4SV4HVHi encodes umlal Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) UMLAL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(UMLAL, ErrNoMatch)
+	}
+	return a.emit(UMLAL, 0, 30156, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLAL_V2DV2SVSi encodes umlal Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) UMLAL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UMLAL, ErrNoMatch)
+	}
+	return a.emit(UMLAL, 1, 30166, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLAL_V8HV8BV8B encodes umlal Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) UMLAL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLAL, ErrNoMatch)
+	}
+	return a.emit(UMLAL, 2, 30176, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLAL_V4SV4HV4H encodes umlal Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) UMLAL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLAL, ErrNoMatch)
+	}
+	return a.emit(UMLAL, 3, 30184, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLAL_V2DV2SV2S encodes umlal Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) UMLAL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLAL, ErrNoMatch)
+	}
+	return a.emit(UMLAL, 4, 30192, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLAL2_V4SV8HVHi encodes umlal2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) UMLAL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(UMLAL2, ErrNoMatch)
+	}
+	return a.emit(UMLAL2, 0, 30200, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLAL2_V2DV4SVSi encodes umlal2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) UMLAL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UMLAL2, ErrNoMatch)
+	}
+	return a.emit(UMLAL2, 1, 30210, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLAL2_V8HV16BV16B encodes umlal2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) UMLAL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLAL2, ErrNoMatch)
+	}
+	return a.emit(UMLAL2, 2, 30220, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLAL2_V4SV8HV8H encodes umlal2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) UMLAL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLAL2, ErrNoMatch)
+	}
+	return a.emit(UMLAL2, 3, 30228, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLAL2_V2DV4SV4S encodes umlal2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) UMLAL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLAL2, ErrNoMatch)
+	}
+	return a.emit(UMLAL2, 4, 30236, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLSL_V4SV4HVHi encodes umlsl Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) UMLSL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(UMLSL, ErrNoMatch)
+	}
+	return a.emit(UMLSL, 0, 30244, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLSL_V2DV2SVSi encodes umlsl Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) UMLSL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UMLSL, ErrNoMatch)
+	}
+	return a.emit(UMLSL, 1, 30254, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLSL_V8HV8BV8B encodes umlsl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) UMLSL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLSL, ErrNoMatch)
+	}
+	return a.emit(UMLSL, 2, 30264, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLSL_V4SV4HV4H encodes umlsl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) UMLSL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLSL, ErrNoMatch)
+	}
+	return a.emit(UMLSL, 3, 30272, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLSL_V2DV2SV2S encodes umlsl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) UMLSL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLSL, ErrNoMatch)
+	}
+	return a.emit(UMLSL, 4, 30280, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLSL2_V4SV8HVHi encodes umlsl2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) UMLSL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(UMLSL2, ErrNoMatch)
+	}
+	return a.emit(UMLSL2, 0, 30288, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLSL2_V2DV4SVSi encodes umlsl2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) UMLSL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UMLSL2, ErrNoMatch)
+	}
+	return a.emit(UMLSL2, 1, 30298, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMLSL2_V8HV16BV16B encodes umlsl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) UMLSL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLSL2, ErrNoMatch)
+	}
+	return a.emit(UMLSL2, 2, 30308, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLSL2_V4SV8HV8H encodes umlsl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) UMLSL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLSL2, ErrNoMatch)
+	}
+	return a.emit(UMLSL2, 3, 30316, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMLSL2_V2DV4SV4S encodes umlsl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) UMLSL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMLSL2, ErrNoMatch)
+	}
+	return a.emit(UMLSL2, 4, 30324, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMMLA_V4SV16BV16B encodes ummla Vd.4S, Vn.16B, Vm.16B.
+// Requires FEAT_I8MM.
+func (a *Assembler) UMMLA_V4SV16BV16B(rd V4SReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMMLA, ErrNoMatch)
+	}
+	return a.emit(UMMLA, 0, 30332, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMNEGL_XWW encodes umnegl Xd, Wn, Wm.
+func (a *Assembler) UMNEGL_XWW(rd XReg, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMNEGL, ErrNoMatch)
+	}
+	return a.emit(UMNEGL, 0, 30340, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMOV_WVBi encodes umov Wd, Vn.B[i].
+func (a *Assembler) UMOV_WVBi(rd WReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 16 {
+		return a.emitErr(UMOV, ErrNoMatch)
+	}
+	return a.emit(UMOV, 0, 30404, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMOV_WVHi encodes umov Wd, Vn.H[i].
+func (a *Assembler) UMOV_WVHi(rd WReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 8 {
+		return a.emitErr(UMOV, ErrNoMatch)
+	}
+	return a.emit(UMOV, 1, 30414, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMOV_WVSi encodes umov Wd, Vn.S[i].
+func (a *Assembler) UMOV_WVSi(rd WReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 4 {
+		return a.emitErr(UMOV, ErrNoMatch)
+	}
+	return a.emit(UMOV, 2, 30424, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMOV_XVDi encodes umov Xd, Vn.D[i].
+func (a *Assembler) UMOV_XVDi(rd XReg, rn VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)) >= 32 || idx >= 2 {
+		return a.emitErr(UMOV, ErrNoMatch)
+	}
+	return a.emit(UMOV, 3, 30434, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMSUBL_XWWX encodes umsubl Xd, Wn, Wm, Xa.
+func (a *Assembler) UMSUBL_XWWX(rd XReg, rn, rm WReg, ra XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm) | uint8(ra)) >= 32 {
+		return a.emitErr(UMSUBL, ErrNoMatch)
+	}
+	return a.emit(UMSUBL, 0, 30444, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatReg, uint64(ra)})
+}
+
+// UMULH_XXX encodes umulh Xd, Xn, Xm.
+func (a *Assembler) UMULH_XXX(rd, rn, rm XReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULH, ErrNoMatch)
+	}
+	return a.emit(UMULH, 0, 30453, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMULL_V4SV4HVHi encodes umull Vd.4S, Vn.4H, Vm.H[i] (m < 16).
+func (a *Assembler) UMULL_V4SV4HVHi(rd V4SReg, rn V4HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(UMULL, ErrNoMatch)
+	}
+	return a.emit(UMULL, 0, 30537, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMULL_V2DV2SVSi encodes umull Vd.2D, Vn.2S, Vm.S[i].
+func (a *Assembler) UMULL_V2DV2SVSi(rd V2DReg, rn V2SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UMULL, ErrNoMatch)
+	}
+	return a.emit(UMULL, 1, 30547, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMULL_V8HV8BV8B encodes umull Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) UMULL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULL, ErrNoMatch)
+	}
+	return a.emit(UMULL, 2, 30557, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMULL_V4SV4HV4H encodes umull Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) UMULL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULL, ErrNoMatch)
+	}
+	return a.emit(UMULL, 3, 30565, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMULL_V2DV2SV2S encodes umull Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) UMULL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULL, ErrNoMatch)
+	}
+	return a.emit(UMULL, 4, 30573, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMULL_XWW encodes umull Xd, Wn, Wm.
+func (a *Assembler) UMULL_XWW(rd XReg, rn, rm WReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULL, ErrNoMatch)
+	}
+	return a.emit(UMULL, 5, 30581, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMULL2_V4SV8HVHi encodes umull2 Vd.4S, Vn.8H, Vm.H[i] (m < 16).
+func (a *Assembler) UMULL2_V4SV8HVHi(rd V4SReg, rn V8HReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 8 {
+		return a.emitErr(UMULL2, ErrNoMatch)
+	}
+	return a.emit(UMULL2, 0, 30589, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMULL2_V2DV4SVSi encodes umull2 Vd.2D, Vn.4S, Vm.S[i].
+func (a *Assembler) UMULL2_V2DV4SVSi(rd V2DReg, rn V4SReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(UMULL2, ErrNoMatch)
+	}
+	return a.emit(UMULL2, 1, 30599, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// UMULL2_V8HV16BV16B encodes umull2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) UMULL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULL2, ErrNoMatch)
+	}
+	return a.emit(UMULL2, 2, 30609, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMULL2_V4SV8HV8H encodes umull2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) UMULL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULL2, ErrNoMatch)
+	}
+	return a.emit(UMULL2, 3, 30617, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UMULL2_V2DV4SV4S encodes umull2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) UMULL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UMULL2, ErrNoMatch)
+	}
+	return a.emit(UMULL2, 4, 30625, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_BBB encodes uqadd Bd, Bn, Bm.
+func (a *Assembler) UQADD_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 0, 30633, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_HHH encodes uqadd Hd, Hn, Hm.
+func (a *Assembler) UQADD_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 1, 30641, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_SSS encodes uqadd Sd, Sn, Sm.
+func (a *Assembler) UQADD_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 2, 30649, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_DDD encodes uqadd Dd, Dn, Dm.
+func (a *Assembler) UQADD_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 3, 30657, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_V16BV16BV16B encodes uqadd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UQADD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 4, 30665, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_V8BV8BV8B encodes uqadd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UQADD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 4, 30665, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_V8HV8HV8H encodes uqadd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UQADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 5, 30674, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_V4HV4HV4H encodes uqadd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UQADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 5, 30674, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_V4SV4SV4S encodes uqadd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UQADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 6, 30683, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_V2SV2SV2S encodes uqadd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UQADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 6, 30683, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQADD_V2DV2DV2D encodes uqadd Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) UQADD_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQADD, ErrNoMatch)
+	}
+	return a.emit(UQADD, 7, 30692, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_BBB encodes uqrshl Bd, Bn, Bm.
+func (a *Assembler) UQRSHL_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 0, 30786, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_HHH encodes uqrshl Hd, Hn, Hm.
+func (a *Assembler) UQRSHL_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 1, 30794, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_SSS encodes uqrshl Sd, Sn, Sm.
+func (a *Assembler) UQRSHL_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 2, 30802, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_DDD encodes uqrshl Dd, Dn, Dm.
+func (a *Assembler) UQRSHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 3, 30810, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_V16BV16BV16B encodes uqrshl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UQRSHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 4, 30818, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_V8BV8BV8B encodes uqrshl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UQRSHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 4, 30818, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_V8HV8HV8H encodes uqrshl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UQRSHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 5, 30827, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_V4HV4HV4H encodes uqrshl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UQRSHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 5, 30827, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_V4SV4SV4S encodes uqrshl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UQRSHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 6, 30836, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_V2SV2SV2S encodes uqrshl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UQRSHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 6, 30836, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHL_V2DV2DV2D encodes uqrshl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) UQRSHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQRSHL, ErrNoMatch)
+	}
+	return a.emit(UQRSHL, 7, 30845, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQRSHRN_BH_Imm encodes uqrshrn Bd, Hn, #imm (0 < imm <= 8).
+func (a *Assembler) UQRSHRN_BH_Imm(rd BReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN, 0, 30854, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN_HS_Imm encodes uqrshrn Hd, Sn, #imm (0 < imm <= 16).
+func (a *Assembler) UQRSHRN_HS_Imm(rd HReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN, 1, 30867, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN_SD_Imm encodes uqrshrn Sd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) UQRSHRN_SD_Imm(rd SReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN, 2, 30880, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN_V8BV8H_Imm encodes uqrshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) UQRSHRN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN, 3, 30893, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN_V4HV4S_Imm encodes uqrshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) UQRSHRN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN, 4, 30904, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN_V2SV2D_Imm encodes uqrshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) UQRSHRN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN, 5, 30915, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN2_V16BV8H_Imm encodes uqrshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) UQRSHRN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN2, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN2, 0, 30926, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN2_V8HV4S_Imm encodes uqrshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) UQRSHRN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN2, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN2, 1, 30937, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQRSHRN2_V4SV2D_Imm encodes uqrshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) UQRSHRN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQRSHRN2, ErrNoMatch)
+	}
+	return a.emit(UQRSHRN2, 2, 30948, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_BB_Imm encodes uqshl Bd, Bn, #imm (0 <= imm < 8).
+func (a *Assembler) UQSHL_BB_Imm(rd, rn BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 0, 30959, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_HH_Imm encodes uqshl Hd, Hn, #imm (0 <= imm < 16).
+func (a *Assembler) UQSHL_HH_Imm(rd, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 1, 30969, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_SS_Imm encodes uqshl Sd, Sn, #imm (0 <= imm < 32).
+func (a *Assembler) UQSHL_SS_Imm(rd, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 2, 30979, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_DD_Imm encodes uqshl Dd, Dn, #imm (0 <= imm < 64).
+func (a *Assembler) UQSHL_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 3, 30989, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_V16BV16B_Imm encodes uqshl Vd.16B, Vn.16B, #imm (0 <= imm < 8).
+func (a *Assembler) UQSHL_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 4, 30999, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_V8BV8B_Imm encodes uqshl Vd.8B, Vn.8B, #imm (0 <= imm < 8).
+func (a *Assembler) UQSHL_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 4, 30999, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_V8HV8H_Imm encodes uqshl Vd.8H, Vn.8H, #imm (0 <= imm < 16).
+func (a *Assembler) UQSHL_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 5, 31010, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_V4HV4H_Imm encodes uqshl Vd.4H, Vn.4H, #imm (0 <= imm < 16).
+func (a *Assembler) UQSHL_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 5, 31010, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_V4SV4S_Imm encodes uqshl Vd.4S, Vn.4S, #imm (0 <= imm < 32).
+func (a *Assembler) UQSHL_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 6, 31021, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_V2SV2S_Imm encodes uqshl Vd.2S, Vn.2S, #imm (0 <= imm < 32).
+func (a *Assembler) UQSHL_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 6, 31021, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_V2DV2D_Imm encodes uqshl Vd.2D, Vn.2D, #imm (0 <= imm < 64).
+func (a *Assembler) UQSHL_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 7, 31032, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHL_BBB encodes uqshl Bd, Bn, Bm.
+func (a *Assembler) UQSHL_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 8, 31043, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_HHH encodes uqshl Hd, Hn, Hm.
+func (a *Assembler) UQSHL_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 9, 31051, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_SSS encodes uqshl Sd, Sn, Sm.
+func (a *Assembler) UQSHL_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 10, 31059, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_DDD encodes uqshl Dd, Dn, Dm.
+func (a *Assembler) UQSHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 11, 31067, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_V16BV16BV16B encodes uqshl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UQSHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 12, 31075, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_V8BV8BV8B encodes uqshl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UQSHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 12, 31075, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_V8HV8HV8H encodes uqshl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UQSHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 13, 31084, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_V4HV4HV4H encodes uqshl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UQSHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 13, 31084, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_V4SV4SV4S encodes uqshl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UQSHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 14, 31093, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_V2SV2SV2S encodes uqshl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UQSHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 14, 31093, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHL_V2DV2DV2D encodes uqshl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) UQSHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSHL, ErrNoMatch)
+	}
+	return a.emit(UQSHL, 15, 31102, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSHRN_BH_Imm encodes uqshrn Bd, Hn, #imm (0 < imm <= 8).
+func (a *Assembler) UQSHRN_BH_Imm(rd BReg, rn HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN, ErrNoMatch)
+	}
+	return a.emit(UQSHRN, 0, 31111, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN_HS_Imm encodes uqshrn Hd, Sn, #imm (0 < imm <= 16).
+func (a *Assembler) UQSHRN_HS_Imm(rd HReg, rn SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN, ErrNoMatch)
+	}
+	return a.emit(UQSHRN, 1, 31124, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN_SD_Imm encodes uqshrn Sd, Dn, #imm (0 < imm <= 32).
+func (a *Assembler) UQSHRN_SD_Imm(rd SReg, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN, ErrNoMatch)
+	}
+	return a.emit(UQSHRN, 2, 31137, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN_V8BV8H_Imm encodes uqshrn Vd.8B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) UQSHRN_V8BV8H_Imm(rd V8BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN, ErrNoMatch)
+	}
+	return a.emit(UQSHRN, 3, 31150, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN_V4HV4S_Imm encodes uqshrn Vd.4H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) UQSHRN_V4HV4S_Imm(rd V4HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN, ErrNoMatch)
+	}
+	return a.emit(UQSHRN, 4, 31161, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN_V2SV2D_Imm encodes uqshrn Vd.2S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) UQSHRN_V2SV2D_Imm(rd V2SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN, ErrNoMatch)
+	}
+	return a.emit(UQSHRN, 5, 31172, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN2_V16BV8H_Imm encodes uqshrn2 Vd.16B, Vn.8H, #imm (0 < imm <= 8).
+func (a *Assembler) UQSHRN2_V16BV8H_Imm(rd V16BReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN2, ErrNoMatch)
+	}
+	return a.emit(UQSHRN2, 0, 31183, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN2_V8HV4S_Imm encodes uqshrn2 Vd.8H, Vn.4S, #imm (0 < imm <= 16).
+func (a *Assembler) UQSHRN2_V8HV4S_Imm(rd V8HReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN2, ErrNoMatch)
+	}
+	return a.emit(UQSHRN2, 1, 31194, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSHRN2_V4SV2D_Imm encodes uqshrn2 Vd.4S, Vn.2D, #imm (0 < imm <= 32).
+func (a *Assembler) UQSHRN2_V4SV2D_Imm(rd V4SReg, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQSHRN2, ErrNoMatch)
+	}
+	return a.emit(UQSHRN2, 2, 31205, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// UQSUB_BBB encodes uqsub Bd, Bn, Bm.
+func (a *Assembler) UQSUB_BBB(rd, rn, rm BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 0, 31216, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_HHH encodes uqsub Hd, Hn, Hm.
+func (a *Assembler) UQSUB_HHH(rd, rn, rm HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 1, 31224, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_SSS encodes uqsub Sd, Sn, Sm.
+func (a *Assembler) UQSUB_SSS(rd, rn, rm SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 2, 31232, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_DDD encodes uqsub Dd, Dn, Dm.
+func (a *Assembler) UQSUB_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 3, 31240, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_V16BV16BV16B encodes uqsub Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UQSUB_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 4, 31248, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_V8BV8BV8B encodes uqsub Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UQSUB_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 4, 31248, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_V8HV8HV8H encodes uqsub Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UQSUB_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 5, 31257, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_V4HV4HV4H encodes uqsub Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UQSUB_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 5, 31257, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_V4SV4SV4S encodes uqsub Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UQSUB_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 6, 31266, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_V2SV2SV2S encodes uqsub Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UQSUB_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 6, 31266, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQSUB_V2DV2DV2D encodes uqsub Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) UQSUB_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UQSUB, ErrNoMatch)
+	}
+	return a.emit(UQSUB, 7, 31275, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UQXTN_BH encodes uqxtn Bd, Hn.
+func (a *Assembler) UQXTN_BH(rd BReg, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN, ErrNoMatch)
+	}
+	return a.emit(UQXTN, 0, 31369, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN_HS encodes uqxtn Hd, Sn.
+func (a *Assembler) UQXTN_HS(rd HReg, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN, ErrNoMatch)
+	}
+	return a.emit(UQXTN, 1, 31376, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN_SD encodes uqxtn Sd, Dn.
+func (a *Assembler) UQXTN_SD(rd SReg, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN, ErrNoMatch)
+	}
+	return a.emit(UQXTN, 2, 31383, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN_V8BV8H encodes uqxtn Vd.8B, Vn.8H.
+func (a *Assembler) UQXTN_V8BV8H(rd V8BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN, ErrNoMatch)
+	}
+	return a.emit(UQXTN, 3, 31390, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN_V4HV4S encodes uqxtn Vd.4H, Vn.4S.
+func (a *Assembler) UQXTN_V4HV4S(rd V4HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN, ErrNoMatch)
+	}
+	return a.emit(UQXTN, 4, 31397, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN_V2SV2D encodes uqxtn Vd.2S, Vn.2D.
+func (a *Assembler) UQXTN_V2SV2D(rd V2SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN, ErrNoMatch)
+	}
+	return a.emit(UQXTN, 5, 31404, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN2_V16BV8H encodes uqxtn2 Vd.16B, Vn.8H.
+func (a *Assembler) UQXTN2_V16BV8H(rd V16BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN2, ErrNoMatch)
+	}
+	return a.emit(UQXTN2, 0, 31411, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN2_V8HV4S encodes uqxtn2 Vd.8H, Vn.4S.
+func (a *Assembler) UQXTN2_V8HV4S(rd V8HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN2, ErrNoMatch)
+	}
+	return a.emit(UQXTN2, 1, 31418, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UQXTN2_V4SV2D encodes uqxtn2 Vd.4S, Vn.2D.
+func (a *Assembler) UQXTN2_V4SV2D(rd V4SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UQXTN2, ErrNoMatch)
+	}
+	return a.emit(UQXTN2, 2, 31425, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// URECPE_V4SV4S encodes urecpe Vd.4S, Vn.4S.
+func (a *Assembler) URECPE_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URECPE, ErrNoMatch)
+	}
+	return a.emit(URECPE, 0, 31432, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// URECPE_V2SV2S encodes urecpe Vd.2S, Vn.2S.
+func (a *Assembler) URECPE_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URECPE, ErrNoMatch)
+	}
+	return a.emit(URECPE, 0, 31432, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// URHADD_V16BV16BV16B encodes urhadd Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) URHADD_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URHADD, ErrNoMatch)
+	}
+	return a.emit(URHADD, 0, 31440, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URHADD_V8BV8BV8B encodes urhadd Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) URHADD_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URHADD, ErrNoMatch)
+	}
+	return a.emit(URHADD, 0, 31440, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URHADD_V8HV8HV8H encodes urhadd Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) URHADD_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URHADD, ErrNoMatch)
+	}
+	return a.emit(URHADD, 1, 31449, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URHADD_V4HV4HV4H encodes urhadd Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) URHADD_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URHADD, ErrNoMatch)
+	}
+	return a.emit(URHADD, 1, 31449, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URHADD_V4SV4SV4S encodes urhadd Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) URHADD_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URHADD, ErrNoMatch)
+	}
+	return a.emit(URHADD, 2, 31458, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URHADD_V2SV2SV2S encodes urhadd Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) URHADD_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URHADD, ErrNoMatch)
+	}
+	return a.emit(URHADD, 2, 31458, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_DDD encodes urshl Dd, Dn, Dm.
+func (a *Assembler) URSHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 0, 31467, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_V16BV16BV16B encodes urshl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) URSHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 1, 31475, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_V8BV8BV8B encodes urshl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) URSHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 1, 31475, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_V8HV8HV8H encodes urshl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) URSHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 2, 31484, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_V4HV4HV4H encodes urshl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) URSHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 2, 31484, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_V4SV4SV4S encodes urshl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) URSHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 3, 31493, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_V2SV2SV2S encodes urshl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) URSHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 3, 31493, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHL_V2DV2DV2D encodes urshl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) URSHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(URSHL, ErrNoMatch)
+	}
+	return a.emit(URSHL, 4, 31502, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// URSHR_DD_Imm encodes urshr Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) URSHR_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 0, 31511, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSHR_V16BV16B_Imm encodes urshr Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) URSHR_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 1, 31524, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSHR_V8BV8B_Imm encodes urshr Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) URSHR_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 1, 31524, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSHR_V8HV8H_Imm encodes urshr Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) URSHR_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 2, 31536, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSHR_V4HV4H_Imm encodes urshr Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) URSHR_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 2, 31536, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSHR_V4SV4S_Imm encodes urshr Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) URSHR_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 3, 31548, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSHR_V2SV2S_Imm encodes urshr Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) URSHR_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 3, 31548, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSHR_V2DV2D_Imm encodes urshr Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) URSHR_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSHR, ErrNoMatch)
+	}
+	return a.emit(URSHR, 4, 31560, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSQRTE_V4SV4S encodes ursqrte Vd.4S, Vn.4S.
+func (a *Assembler) URSQRTE_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSQRTE, ErrNoMatch)
+	}
+	return a.emit(URSQRTE, 0, 31572, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// URSQRTE_V2SV2S encodes ursqrte Vd.2S, Vn.2S.
+func (a *Assembler) URSQRTE_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSQRTE, ErrNoMatch)
+	}
+	return a.emit(URSQRTE, 0, 31572, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// URSRA_DD_Imm encodes ursra Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) URSRA_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 0, 31580, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSRA_V16BV16B_Imm encodes ursra Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) URSRA_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 1, 31593, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSRA_V8BV8B_Imm encodes ursra Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) URSRA_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 1, 31593, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSRA_V8HV8H_Imm encodes ursra Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) URSRA_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 2, 31605, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSRA_V4HV4H_Imm encodes ursra Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) URSRA_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 2, 31605, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSRA_V4SV4S_Imm encodes ursra Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) URSRA_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 3, 31617, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSRA_V2SV2S_Imm encodes ursra Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) URSRA_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 3, 31617, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// URSRA_V2DV2D_Imm encodes ursra Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) URSRA_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(URSRA, ErrNoMatch)
+	}
+	return a.emit(URSRA, 4, 31629, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USDOT_V2SV8BV8B encodes usdot Vd.2S, Vn.8B, Vm.8B.
+// Requires FEAT_I8MM.
+func (a *Assembler) USDOT_V2SV8BV8B(rd V2SReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USDOT, ErrNoMatch)
+	}
+	return a.emit(USDOT, 0, 31641, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USDOT_V4SV16BV16B encodes usdot Vd.4S, Vn.16B, Vm.16B.
+// Requires FEAT_I8MM.
+func (a *Assembler) USDOT_V4SV16BV16B(rd V4SReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USDOT, ErrNoMatch)
+	}
+	return a.emit(USDOT, 1, 31649, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USDOT_V2SV8BV4Bi encodes usdot Vd.2S, Vn.8B, Vm.4B[i].
+// Requires FEAT_I8MM.
+func (a *Assembler) USDOT_V2SV8BV4Bi(rd V2SReg, rn V8BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(USDOT, ErrNoMatch)
+	}
+	return a.emit(USDOT, 2, 31657, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// USDOT_V4SV16BV4Bi encodes usdot Vd.4S, Vn.16B, Vm.4B[i].
+// Requires FEAT_I8MM.
+func (a *Assembler) USDOT_V4SV16BV4Bi(rd V4SReg, rn V16BReg, rm VReg, idx uint8) bool {
+	if (uint8(rd)|uint8(rn)|uint8(rm)) >= 32 || idx >= 4 {
+		return a.emitErr(USDOT, ErrNoMatch)
+	}
+	return a.emit(USDOT, 3, 31667, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(idx)})
+}
+
+// USHL_DDD encodes ushl Dd, Dn, Dm.
+func (a *Assembler) USHL_DDD(rd, rn, rm DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 0, 31677, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHL_V16BV16BV16B encodes ushl Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) USHL_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 1, 31685, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHL_V8BV8BV8B encodes ushl Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) USHL_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 1, 31685, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHL_V8HV8HV8H encodes ushl Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) USHL_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 2, 31694, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHL_V4HV4HV4H encodes ushl Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) USHL_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 2, 31694, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHL_V4SV4SV4S encodes ushl Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) USHL_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 3, 31703, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHL_V2SV2SV2S encodes ushl Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) USHL_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 3, 31703, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHL_V2DV2DV2D encodes ushl Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) USHL_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USHL, ErrNoMatch)
+	}
+	return a.emit(USHL, 4, 31712, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USHLL_V8HV8B_Imm encodes ushll Vd.8H, Vn.8B, #imm (0 <= imm < 8).
+func (a *Assembler) USHLL_V8HV8B_Imm(rd V8HReg, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHLL, ErrNoMatch)
+	}
+	return a.emit(USHLL, 0, 31721, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHLL_V4SV4H_Imm encodes ushll Vd.4S, Vn.4H, #imm (0 <= imm < 16).
+func (a *Assembler) USHLL_V4SV4H_Imm(rd V4SReg, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHLL, ErrNoMatch)
+	}
+	return a.emit(USHLL, 1, 31731, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHLL_V2DV2S_Imm encodes ushll Vd.2D, Vn.2S, #imm (0 <= imm < 32).
+func (a *Assembler) USHLL_V2DV2S_Imm(rd V2DReg, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHLL, ErrNoMatch)
+	}
+	return a.emit(USHLL, 2, 31741, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHLL2_V8HV16B_Imm encodes ushll2 Vd.8H, Vn.16B, #imm (0 <= imm < 8).
+func (a *Assembler) USHLL2_V8HV16B_Imm(rd V8HReg, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHLL2, ErrNoMatch)
+	}
+	return a.emit(USHLL2, 0, 31751, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHLL2_V4SV8H_Imm encodes ushll2 Vd.4S, Vn.8H, #imm (0 <= imm < 16).
+func (a *Assembler) USHLL2_V4SV8H_Imm(rd V4SReg, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHLL2, ErrNoMatch)
+	}
+	return a.emit(USHLL2, 1, 31761, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHLL2_V2DV4S_Imm encodes ushll2 Vd.2D, Vn.4S, #imm (0 <= imm < 32).
+func (a *Assembler) USHLL2_V2DV4S_Imm(rd V2DReg, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHLL2, ErrNoMatch)
+	}
+	return a.emit(USHLL2, 2, 31771, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_DD_Imm encodes ushr Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) USHR_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 0, 31781, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_V16BV16B_Imm encodes ushr Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) USHR_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 1, 31794, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_V8BV8B_Imm encodes ushr Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) USHR_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 1, 31794, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_V8HV8H_Imm encodes ushr Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) USHR_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 2, 31806, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_V4HV4H_Imm encodes ushr Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) USHR_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 2, 31806, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_V4SV4S_Imm encodes ushr Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) USHR_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 3, 31818, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_V2SV2S_Imm encodes ushr Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) USHR_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 3, 31818, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USHR_V2DV2D_Imm encodes ushr Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) USHR_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USHR, ErrNoMatch)
+	}
+	return a.emit(USHR, 4, 31830, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USMMLA_V4SV16BV16B encodes usmmla Vd.4S, Vn.16B, Vm.16B.
+// Requires FEAT_I8MM.
+func (a *Assembler) USMMLA_V4SV16BV16B(rd V4SReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USMMLA, ErrNoMatch)
+	}
+	return a.emit(USMMLA, 0, 31842, FeatI8MM, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USQADD_BB encodes usqadd Bd, Bn.
+func (a *Assembler) USQADD_BB(rd, rn BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 0, 31906, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_HH encodes usqadd Hd, Hn.
+func (a *Assembler) USQADD_HH(rd, rn HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 1, 31913, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_SS encodes usqadd Sd, Sn.
+func (a *Assembler) USQADD_SS(rd, rn SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 2, 31920, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_DD encodes usqadd Dd, Dn.
+func (a *Assembler) USQADD_DD(rd, rn DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 3, 31927, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_V16BV16B encodes usqadd Vd.16B, Vn.16B.
+func (a *Assembler) USQADD_V16BV16B(rd, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 4, 31934, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_V8BV8B encodes usqadd Vd.8B, Vn.8B.
+func (a *Assembler) USQADD_V8BV8B(rd, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 4, 31934, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_V8HV8H encodes usqadd Vd.8H, Vn.8H.
+func (a *Assembler) USQADD_V8HV8H(rd, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 5, 31942, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_V4HV4H encodes usqadd Vd.4H, Vn.4H.
+func (a *Assembler) USQADD_V4HV4H(rd, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 5, 31942, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_V4SV4S encodes usqadd Vd.4S, Vn.4S.
+func (a *Assembler) USQADD_V4SV4S(rd, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 6, 31950, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_V2SV2S encodes usqadd Vd.2S, Vn.2S.
+func (a *Assembler) USQADD_V2SV2S(rd, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 6, 31950, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USQADD_V2DV2D encodes usqadd Vd.2D, Vn.2D.
+func (a *Assembler) USQADD_V2DV2D(rd, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USQADD, ErrNoMatch)
+	}
+	return a.emit(USQADD, 7, 31958, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// USRA_DD_Imm encodes usra Dd, Dn, #imm (0 < imm <= 64).
+func (a *Assembler) USRA_DD_Imm(rd, rn DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 0, 31966, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USRA_V16BV16B_Imm encodes usra Vd.16B, Vn.16B, #imm (0 < imm <= 8).
+func (a *Assembler) USRA_V16BV16B_Imm(rd, rn V16BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 1, 31979, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USRA_V8BV8B_Imm encodes usra Vd.8B, Vn.8B, #imm (0 < imm <= 8).
+func (a *Assembler) USRA_V8BV8B_Imm(rd, rn V8BReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 1, 31979, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USRA_V8HV8H_Imm encodes usra Vd.8H, Vn.8H, #imm (0 < imm <= 16).
+func (a *Assembler) USRA_V8HV8H_Imm(rd, rn V8HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 2, 31991, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USRA_V4HV4H_Imm encodes usra Vd.4H, Vn.4H, #imm (0 < imm <= 16).
+func (a *Assembler) USRA_V4HV4H_Imm(rd, rn V4HReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 2, 31991, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USRA_V4SV4S_Imm encodes usra Vd.4S, Vn.4S, #imm (0 < imm <= 32).
+func (a *Assembler) USRA_V4SV4S_Imm(rd, rn V4SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 3, 32003, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USRA_V2SV2S_Imm encodes usra Vd.2S, Vn.2S, #imm (0 < imm <= 32).
+func (a *Assembler) USRA_V2SV2S_Imm(rd, rn V2SReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 3, 32003, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USRA_V2DV2D_Imm encodes usra Vd.2D, Vn.2D, #imm (0 < imm <= 64).
+func (a *Assembler) USRA_V2DV2D_Imm(rd, rn V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(USRA, ErrNoMatch)
+	}
+	return a.emit(USRA, 4, 32015, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatImm, uint64(imm)})
+}
+
+// USUBL_V8HV8BV8B encodes usubl Vd.8H, Vn.8B, Vm.8B.
+func (a *Assembler) USUBL_V8HV8BV8B(rd V8HReg, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBL, ErrNoMatch)
+	}
+	return a.emit(USUBL, 0, 32027, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBL_V4SV4HV4H encodes usubl Vd.4S, Vn.4H, Vm.4H.
+func (a *Assembler) USUBL_V4SV4HV4H(rd V4SReg, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBL, ErrNoMatch)
+	}
+	return a.emit(USUBL, 1, 32035, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBL_V2DV2SV2S encodes usubl Vd.2D, Vn.2S, Vm.2S.
+func (a *Assembler) USUBL_V2DV2SV2S(rd V2DReg, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBL, ErrNoMatch)
+	}
+	return a.emit(USUBL, 2, 32043, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBL2_V8HV16BV16B encodes usubl2 Vd.8H, Vn.16B, Vm.16B.
+func (a *Assembler) USUBL2_V8HV16BV16B(rd V8HReg, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBL2, ErrNoMatch)
+	}
+	return a.emit(USUBL2, 0, 32051, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBL2_V4SV8HV8H encodes usubl2 Vd.4S, Vn.8H, Vm.8H.
+func (a *Assembler) USUBL2_V4SV8HV8H(rd V4SReg, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBL2, ErrNoMatch)
+	}
+	return a.emit(USUBL2, 1, 32059, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBL2_V2DV4SV4S encodes usubl2 Vd.2D, Vn.4S, Vm.4S.
+func (a *Assembler) USUBL2_V2DV4SV4S(rd V2DReg, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBL2, ErrNoMatch)
+	}
+	return a.emit(USUBL2, 2, 32067, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBW_V8HV8HV8B encodes usubw Vd.8H, Vn.8H, Vm.8B.
+func (a *Assembler) USUBW_V8HV8HV8B(rd, rn V8HReg, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBW, ErrNoMatch)
+	}
+	return a.emit(USUBW, 0, 32075, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBW_V4SV4SV4H encodes usubw Vd.4S, Vn.4S, Vm.4H.
+func (a *Assembler) USUBW_V4SV4SV4H(rd, rn V4SReg, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBW, ErrNoMatch)
+	}
+	return a.emit(USUBW, 1, 32083, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBW_V2DV2DV2S encodes usubw Vd.2D, Vn.2D, Vm.2S.
+func (a *Assembler) USUBW_V2DV2DV2S(rd, rn V2DReg, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBW, ErrNoMatch)
+	}
+	return a.emit(USUBW, 2, 32091, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBW2_V8HV8HV16B encodes usubw2 Vd.8H, Vn.8H, Vm.16B.
+func (a *Assembler) USUBW2_V8HV8HV16B(rd, rn V8HReg, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBW2, ErrNoMatch)
+	}
+	return a.emit(USUBW2, 0, 32099, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBW2_V4SV4SV8H encodes usubw2 Vd.4S, Vn.4S, Vm.8H.
+func (a *Assembler) USUBW2_V4SV4SV8H(rd, rn V4SReg, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBW2, ErrNoMatch)
+	}
+	return a.emit(USUBW2, 1, 32107, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// USUBW2_V2DV2DV4S encodes usubw2 Vd.2D, Vn.2D, Vm.4S.
+func (a *Assembler) USUBW2_V2DV2DV4S(rd, rn V2DReg, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(USUBW2, ErrNoMatch)
+	}
+	return a.emit(USUBW2, 2, 32115, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UXTB_WW encodes uxtb Wd, Wn.
+func (a *Assembler) UXTB_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTB, ErrNoMatch)
+	}
+	return a.emit(UXTB, 0, 32165, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UXTH_WW encodes uxth Wd, Wn.
+func (a *Assembler) UXTH_WW(rd, rn WReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTH, ErrNoMatch)
+	}
+	return a.emit(UXTH, 0, 32172, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UXTL_V8HV8B encodes uxtl Vd.8H, Vn.8B.
+func (a *Assembler) UXTL_V8HV8B(rd V8HReg, rn V8BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTL, ErrNoMatch)
+	}
+	return a.emit(UXTL, 0, 32179, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UXTL_V4SV4H encodes uxtl Vd.4S, Vn.4H.
+func (a *Assembler) UXTL_V4SV4H(rd V4SReg, rn V4HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTL, ErrNoMatch)
+	}
+	return a.emit(UXTL, 1, 32186, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UXTL_V2DV2S encodes uxtl Vd.2D, Vn.2S.
+func (a *Assembler) UXTL_V2DV2S(rd V2DReg, rn V2SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTL, ErrNoMatch)
+	}
+	return a.emit(UXTL, 2, 32193, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UXTL2_V8HV16B encodes uxtl2 Vd.8H, Vn.16B.
+func (a *Assembler) UXTL2_V8HV16B(rd V8HReg, rn V16BReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTL2, ErrNoMatch)
+	}
+	return a.emit(UXTL2, 0, 32200, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UXTL2_V4SV8H encodes uxtl2 Vd.4S, Vn.8H.
+func (a *Assembler) UXTL2_V4SV8H(rd V4SReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTL2, ErrNoMatch)
+	}
+	return a.emit(UXTL2, 1, 32207, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UXTL2_V2DV4S encodes uxtl2 Vd.2D, Vn.4S.
+func (a *Assembler) UXTL2_V2DV4S(rd V2DReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(UXTL2, ErrNoMatch)
+	}
+	return a.emit(UXTL2, 2, 32214, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// UZP1_V16BV16BV16B encodes uzp1 Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UZP1_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP1, ErrNoMatch)
+	}
+	return a.emit(UZP1, 0, 32221, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP1_V8BV8BV8B encodes uzp1 Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UZP1_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP1, ErrNoMatch)
+	}
+	return a.emit(UZP1, 0, 32221, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP1_V8HV8HV8H encodes uzp1 Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UZP1_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP1, ErrNoMatch)
+	}
+	return a.emit(UZP1, 1, 32230, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP1_V4HV4HV4H encodes uzp1 Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UZP1_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP1, ErrNoMatch)
+	}
+	return a.emit(UZP1, 1, 32230, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP1_V4SV4SV4S encodes uzp1 Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UZP1_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP1, ErrNoMatch)
+	}
+	return a.emit(UZP1, 2, 32239, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP1_V2SV2SV2S encodes uzp1 Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UZP1_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP1, ErrNoMatch)
+	}
+	return a.emit(UZP1, 2, 32239, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP1_V2DV2DV2D encodes uzp1 Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) UZP1_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP1, ErrNoMatch)
+	}
+	return a.emit(UZP1, 3, 32248, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP2_V16BV16BV16B encodes uzp2 Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) UZP2_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP2, ErrNoMatch)
+	}
+	return a.emit(UZP2, 0, 32321, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP2_V8BV8BV8B encodes uzp2 Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) UZP2_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP2, ErrNoMatch)
+	}
+	return a.emit(UZP2, 0, 32321, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP2_V8HV8HV8H encodes uzp2 Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) UZP2_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP2, ErrNoMatch)
+	}
+	return a.emit(UZP2, 1, 32330, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP2_V4HV4HV4H encodes uzp2 Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) UZP2_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP2, ErrNoMatch)
+	}
+	return a.emit(UZP2, 1, 32330, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP2_V4SV4SV4S encodes uzp2 Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) UZP2_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP2, ErrNoMatch)
+	}
+	return a.emit(UZP2, 2, 32339, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP2_V2SV2SV2S encodes uzp2 Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) UZP2_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP2, ErrNoMatch)
+	}
+	return a.emit(UZP2, 2, 32339, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// UZP2_V2DV2DV2D encodes uzp2 Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) UZP2_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(UZP2, ErrNoMatch)
+	}
+	return a.emit(UZP2, 3, 32348, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// WFE encodes wfe.
+func (a *Assembler) WFE() bool {
+	return a.emit(WFE, 0, 32421, 0, 0, 0)
+}
+
+// WFET_X encodes wfet Xd.
+// Requires FEAT_WFxT.
+func (a *Assembler) WFET_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(WFET, ErrNoMatch)
+	}
+	return a.emit(WFET, 0, 32426, FeatWFxT, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// WFI encodes wfi.
+func (a *Assembler) WFI() bool {
+	return a.emit(WFI, 0, 32432, 0, 0, 0)
+}
+
+// WFIT_X encodes wfit Xd.
+// Requires FEAT_WFxT.
+func (a *Assembler) WFIT_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(WFIT, ErrNoMatch)
+	}
+	return a.emit(WFIT, 0, 32437, FeatWFxT, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// XAFLAG encodes xaflag.
+// Requires FEAT_FlagM2.
+func (a *Assembler) XAFLAG() bool {
+	return a.emit(XAFLAG, 0, 32961, FeatFlagM2, 0, 0)
+}
+
+// XAR_V2DV2DV2D_Imm encodes xar Vd.2D, Vn.2D, Vm.2D, #imm (0 <= imm < 64).
+// Requires FEAT_SHA3.
+func (a *Assembler) XAR_V2DV2DV2D_Imm(rd, rn, rm V2DReg, imm int64) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(XAR, ErrNoMatch)
+	}
+	return a.emit(XAR, 0, 32966, FeatSHA3, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)}, Flat{FlatImm, uint64(imm)})
+}
+
+// XPACD_X encodes xpacd Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) XPACD_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(XPACD, ErrNoMatch)
+	}
+	return a.emit(XPACD, 0, 32977, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// XPACI_X encodes xpaci Xd.
+// Requires FEAT_PAuth.
+func (a *Assembler) XPACI_X(rd XReg) bool {
+	if rd >= 32 {
+		return a.emitErr(XPACI, ErrNoMatch)
+	}
+	return a.emit(XPACI, 0, 32983, FeatPAuth, 0, 0, Flat{FlatReg, uint64(rd)})
+}
+
+// XPACLRI encodes xpaclri.
+func (a *Assembler) XPACLRI() bool {
+	return a.emit(XPACLRI, 0, 32989, 0, 0, 0)
+}
+
+// XTN_V8BV8H encodes xtn Vd.8B, Vn.8H.
+func (a *Assembler) XTN_V8BV8H(rd V8BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(XTN, ErrNoMatch)
+	}
+	return a.emit(XTN, 0, 32994, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// XTN_V4HV4S encodes xtn Vd.4H, Vn.4S.
+func (a *Assembler) XTN_V4HV4S(rd V4HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(XTN, ErrNoMatch)
+	}
+	return a.emit(XTN, 1, 33001, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// XTN_V2SV2D encodes xtn Vd.2S, Vn.2D.
+func (a *Assembler) XTN_V2SV2D(rd V2SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(XTN, ErrNoMatch)
+	}
+	return a.emit(XTN, 2, 33008, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// XTN2_V16BV8H encodes xtn2 Vd.16B, Vn.8H.
+func (a *Assembler) XTN2_V16BV8H(rd V16BReg, rn V8HReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(XTN2, ErrNoMatch)
+	}
+	return a.emit(XTN2, 0, 33015, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// XTN2_V8HV4S encodes xtn2 Vd.8H, Vn.4S.
+func (a *Assembler) XTN2_V8HV4S(rd V8HReg, rn V4SReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(XTN2, ErrNoMatch)
+	}
+	return a.emit(XTN2, 1, 33022, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// XTN2_V4SV2D encodes xtn2 Vd.4S, Vn.2D.
+func (a *Assembler) XTN2_V4SV2D(rd V4SReg, rn V2DReg) bool {
+	if (uint8(rd) | uint8(rn)) >= 32 {
+		return a.emitErr(XTN2, ErrNoMatch)
+	}
+	return a.emit(XTN2, 2, 33029, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)})
+}
+
+// YIELD encodes yield.
+func (a *Assembler) YIELD() bool {
+	return a.emit(YIELD, 0, 33036, 0, 0, 0)
+}
+
+// ZIP1_V16BV16BV16B encodes zip1 Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) ZIP1_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP1, ErrNoMatch)
+	}
+	return a.emit(ZIP1, 0, 33046, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP1_V8BV8BV8B encodes zip1 Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) ZIP1_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP1, ErrNoMatch)
+	}
+	return a.emit(ZIP1, 0, 33046, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP1_V8HV8HV8H encodes zip1 Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) ZIP1_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP1, ErrNoMatch)
+	}
+	return a.emit(ZIP1, 1, 33055, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP1_V4HV4HV4H encodes zip1 Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) ZIP1_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP1, ErrNoMatch)
+	}
+	return a.emit(ZIP1, 1, 33055, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP1_V4SV4SV4S encodes zip1 Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) ZIP1_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP1, ErrNoMatch)
+	}
+	return a.emit(ZIP1, 2, 33064, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP1_V2SV2SV2S encodes zip1 Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) ZIP1_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP1, ErrNoMatch)
+	}
+	return a.emit(ZIP1, 2, 33064, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP1_V2DV2DV2D encodes zip1 Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) ZIP1_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP1, ErrNoMatch)
+	}
+	return a.emit(ZIP1, 3, 33073, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP2_V16BV16BV16B encodes zip2 Vd.16B, Vn.16B, Vm.16B.
+func (a *Assembler) ZIP2_V16BV16BV16B(rd, rn, rm V16BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP2, ErrNoMatch)
+	}
+	return a.emit(ZIP2, 0, 33146, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP2_V8BV8BV8B encodes zip2 Vd.8B, Vn.8B, Vm.8B.
+func (a *Assembler) ZIP2_V8BV8BV8B(rd, rn, rm V8BReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP2, ErrNoMatch)
+	}
+	return a.emit(ZIP2, 0, 33146, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP2_V8HV8HV8H encodes zip2 Vd.8H, Vn.8H, Vm.8H.
+func (a *Assembler) ZIP2_V8HV8HV8H(rd, rn, rm V8HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP2, ErrNoMatch)
+	}
+	return a.emit(ZIP2, 1, 33155, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP2_V4HV4HV4H encodes zip2 Vd.4H, Vn.4H, Vm.4H.
+func (a *Assembler) ZIP2_V4HV4HV4H(rd, rn, rm V4HReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP2, ErrNoMatch)
+	}
+	return a.emit(ZIP2, 1, 33155, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP2_V4SV4SV4S encodes zip2 Vd.4S, Vn.4S, Vm.4S.
+func (a *Assembler) ZIP2_V4SV4SV4S(rd, rn, rm V4SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP2, ErrNoMatch)
+	}
+	return a.emit(ZIP2, 2, 33164, 0, 0, 16, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP2_V2SV2SV2S encodes zip2 Vd.2S, Vn.2S, Vm.2S.
+func (a *Assembler) ZIP2_V2SV2SV2S(rd, rn, rm V2SReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP2, ErrNoMatch)
+	}
+	return a.emit(ZIP2, 2, 33164, 0, 0, 8, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
+
+// ZIP2_V2DV2DV2D encodes zip2 Vd.2D, Vn.2D, Vm.2D.
+func (a *Assembler) ZIP2_V2DV2DV2D(rd, rn, rm V2DReg) bool {
+	if (uint8(rd) | uint8(rn) | uint8(rm)) >= 32 {
+		return a.emitErr(ZIP2, ErrNoMatch)
+	}
+	return a.emit(ZIP2, 3, 33173, 0, 0, 0, Flat{FlatReg, uint64(rd)}, Flat{FlatReg, uint64(rn)}, Flat{FlatReg, uint64(rm)})
+}
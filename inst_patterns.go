@@ -5,7351 +5,11502 @@ package arm
 // The Patterns table contains matching info for all instruction encodings.
 // Each instruction's list of encodings is prefixed with the encoding count,
 // followed by a length-prefixed list of matching operators and commands offset
-// for each encoding; the commands offset is a 16-bit index into the Commands table.
+// for each encoding; the commands offset is a 24-bit big-endian index into the
+// Commands table, followed by the Feature required by the encoding (or 0) and an extra
+// Feature required in addition to the first (or 0).
 // Matching operators apply to unflattened Arg types.
+// The table is indexed through the PatternOffsets table.
 var Patterns = [...]byte{
 	0,
-	5,
+	11,
 	// abs Dd, Dn
-	2, MatD, MatD, 0x0, 0x0,
+	2, MatD, MatD, 0x0, 0x0, 0x0, 0, 0,
 	// abs Vd.16B, Vn.16B
 	// abs Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x0, 0x7, 0, 0,
 	// abs Vd.8H, Vn.8H
 	// abs Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0xf,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x0, 0xf, 0, 0,
 	// abs Vd.4S, Vn.4S
 	// abs Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x17,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x0, 0x17, 0, 0,
 	// abs Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1f,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x0, 0x1f, 0, 0,
+	// abs Wd, Wn
+	2, MatW, MatW, 0x0, 0x0, 0x27, byte(FeatCSSC), 0,
+	// abs Xd, Xn
+	2, MatX, MatX, 0x0, 0x0, 0x2e, byte(FeatCSSC), 0,
+	// abs Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	3, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0x0, 0x35, byte(FeatSVE), 0,
+	// abs Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x0, 0x3e, byte(FeatSVE), 0,
+	// abs Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x0, 0x47, byte(FeatSVE), 0,
+	// abs Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x0, 0x50, byte(FeatSVE), 0,
 
 	2,
 	// adc Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x0, 0x27,
+	3, MatW, MatW, MatW, 0x0, 0x0, 0x59, 0, 0,
 	// adc Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x0, 0x2f,
+	3, MatX, MatX, MatX, 0x0, 0x0, 0x61, 0, 0,
 
 	2,
 	// adcs Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x0, 0x37,
+	3, MatW, MatW, MatW, 0x0, 0x0, 0x69, 0, 0,
 	// adcs Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x0, 0x3f,
+	3, MatX, MatX, MatX, 0x0, 0x0, 0x71, 0, 0,
 
-	12,
+	24,
 	// add Wd, Wn, Wm {, LSL|LSR|ASR #imm }  ·········································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x47,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x0, 0x79, 0, 0,
 	// add Xd, Xn, Xm {, LSL|LSR|ASR #imm }  ·········································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x53,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x0, 0x85, 0, 0,
 	// add Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm }  ·····················  (0 <= imm <= 4)
-	5, MatWSP, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x0, 0x5f,
+	5, MatWSP, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x0, 0x0, 0x91, 0, 0,
 	// add Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm }  ·····························  (0 <= imm <= 4)
-	5, MatXSP, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x0, 0x6c,
+	5, MatXSP, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x0, 0x0, 0x9e, 0, 0,
 	// add Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm }  ·································  (0 <= imm <= 4)
-	5, MatXSP, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x0, 0x79,
+	5, MatXSP, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x0, 0x0, 0xab, 0, 0,
 	// add Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 }  ·················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatWSP, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x86,
+	5, MatWSP, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x0, 0xb8, 0, 0,
 	// add Xd|SP, Xn|SP, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatXSP, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x93,
+	5, MatXSP, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x0, 0xc5, 0, 0,
 	// add Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x0, 0xa0,
+	3, MatD, MatD, MatD, 0x0, 0x0, 0xd2, 0, 0,
 	// add Vd.16B, Vn.16B, Vm.16B
 	// add Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0xa8,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x0, 0xda, 0, 0,
 	// add Vd.8H, Vn.8H, Vm.8H
 	// add Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0xb1,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x0, 0xe3, 0, 0,
 	// add Vd.4S, Vn.4S, Vm.4S
 	// add Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0xba,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x0, 0xec, 0, 0,
 	// add Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0xc3,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x0, 0xf5, 0, 0,
+	// add Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x0, 0xfe, byte(FeatSVE), 0,
+	// add Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1, 0x6, byte(FeatSVE), 0,
+	// add Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1, 0xe, byte(FeatSVE), 0,
+	// add Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1, 0x16, byte(FeatSVE), 0,
+	// add Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x1, 0x1e, byte(FeatSVE), 0,
+	// add Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1, 0x29, byte(FeatSVE), 0,
+	// add Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1, 0x34, byte(FeatSVE), 0,
+	// add Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1, 0x3f, byte(FeatSVE), 0,
+	// add Zd.B, Zn.B, #imm  ················································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x1, 0x4a, byte(FeatSVE), 0,
+	// add Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x1, 0x55, byte(FeatSVE), 0,
+	// add Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x1, 0x63, byte(FeatSVE), 0,
+	// add Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x1, 0x71, byte(FeatSVE), 0,
+
+	1,
+	// addg Xd|SP, Xn|SP, #imm1, #imm2  ················  (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16)
+	4, MatXSP, MatXSP, MatImm, MatImm, 0x0, 0x1, 0x7f, byte(FeatMTE), 0,
+
+	2,
+	// addha ZAd.S, Pg1/M, Pg2/M, Zn.S  ······································  (d < 4, g1 < 8, g2 < 8)
+	4, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(DWORD), 0x0, 0x1, 0x8d, byte(FeatSME), 0,
+	// addha ZAd.D, Pg1/M, Pg2/M, Zn.D  ······································  (d < 8, g1 < 8, g2 < 8)
+	4, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(QWORD), 0x0, 0x1, 0x9a, byte(FeatSMEI16I64), 0,
 
 	3,
 	// addhn Vd.8B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0xcc,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x1, 0xa7, 0, 0,
 	// addhn Vd.4H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0xd4,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x1, 0xaf, 0, 0,
 	// addhn Vd.2S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0xdc,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1, 0xb7, 0, 0,
 
 	3,
 	// addhn2 Vd.16B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0xe4,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x1, 0xbf, 0, 0,
 	// addhn2 Vd.8H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0xec,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x1, 0xc7, 0, 0,
 	// addhn2 Vd.4S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0xf4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1, 0xcf, 0, 0,
 
 	5,
 	// addp Dd, Vn.2D
-	2, MatD, MatVStatic, byte(QWORD), 2, 0x0, 0xfc,
+	2, MatD, MatVStatic, byte(QWORD), 2, 0x0, 0x1, 0xd7, 0, 0,
 	// addp Vd.16B, Vn.16B, Vm.16B
 	// addp Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x1, 0x3,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x1, 0xde, 0, 0,
 	// addp Vd.8H, Vn.8H, Vm.8H
 	// addp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x1, 0xc,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1, 0xe7, 0, 0,
 	// addp Vd.4S, Vn.4S, Vm.4S
 	// addp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x1, 0x15,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1, 0xf0, 0, 0,
 	// addp Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1, 0x1e,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1, 0xf9, 0, 0,
+
+	1,
+	// addpl Xd|SP, Xn|SP, #imm  ···················································  (-32 <= imm < 32)
+	3, MatXSP, MatXSP, MatImm, 0x0, 0x2, 0x2, byte(FeatSVE), 0,
 
 	7,
 	// adds Wd, Wn, Wm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x1, 0x27,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x2, 0xc, 0, 0,
 	// adds Xd, Xn, Xm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x1, 0x33,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x2, 0x18, 0, 0,
 	// adds Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm }  ························  (0 <= imm <= 4)
-	5, MatW, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x1, 0x3f,
+	5, MatW, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x0, 0x2, 0x24, 0, 0,
 	// adds Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm }  ·······························  (0 <= imm <= 4)
-	5, MatX, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x1, 0x4c,
+	5, MatX, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x0, 0x2, 0x31, 0, 0,
 	// adds Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm }  ···································  (0 <= imm <= 4)
-	5, MatX, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x1, 0x59,
+	5, MatX, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x0, 0x2, 0x3e, 0, 0,
 	// adds Wd, Wn|WSP, #imm1 {, LSL #imm2 }  ····················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatW, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x1, 0x66,
+	5, MatW, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x2, 0x4b, 0, 0,
 	// adds Xd, Xn|SP, #imm1 {, LSL #imm2 }  ·····················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatX, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x1, 0x73,
+	5, MatX, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x2, 0x58, 0, 0,
+
+	1,
+	// addspl Xd|SP, Xn|SP, #imm  ··················································  (-32 <= imm < 32)
+	3, MatXSP, MatXSP, MatImm, 0x0, 0x2, 0x65, byte(FeatSME), 0,
+
+	1,
+	// addsvl Xd|SP, Xn|SP, #imm  ··················································  (-32 <= imm < 32)
+	3, MatXSP, MatXSP, MatImm, 0x0, 0x2, 0x6f, byte(FeatSME), 0,
 
 	3,
 	// addv Bd, Vn.16B
 	// addv Bd, Vn.8B
-	2, MatB, MatV, byte(BYTE), 0x1, 0x80,
+	2, MatB, MatV, byte(BYTE), 0x0, 0x2, 0x79, 0, 0,
 	// addv Hd, Vn.8H
 	// addv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x1, 0x88,
+	2, MatH, MatV, byte(WORD), 0x0, 0x2, 0x81, 0, 0,
 	// addv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x1, 0x90,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x2, 0x89, 0, 0,
+
+	2,
+	// addva ZAd.S, Pg1/M, Pg2/M, Zn.S  ······································  (d < 4, g1 < 8, g2 < 8)
+	4, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(DWORD), 0x0, 0x2, 0x91, byte(FeatSME), 0,
+	// addva ZAd.D, Pg1/M, Pg2/M, Zn.D  ······································  (d < 8, g1 < 8, g2 < 8)
+	4, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(QWORD), 0x0, 0x2, 0x9e, byte(FeatSMEI16I64), 0,
+
+	1,
+	// addvl Xd|SP, Xn|SP, #imm  ···················································  (-32 <= imm < 32)
+	3, MatXSP, MatXSP, MatImm, 0x0, 0x2, 0xab, byte(FeatSVE), 0,
 
 	1,
 	// adr Xd, <offset>  ···············································  (offset is 21-bit (+/- 1 MB))
-	2, MatX, MatOffset, 0x1, 0x98,
+	2, MatX, MatOffset, 0x0, 0x2, 0xb5, 0, 0,
 
 	1,
 	// adrp Xd, <offset>  ········································  (offset >> 12 is 21-bit (+/- 4 GB))
-	2, MatX, MatOffset, 0x1, 0xa0,
+	2, MatX, MatOffset, 0x0, 0x2, 0xbd, 0, 0,
 
 	1,
 	// aesd Vd.16B, Vn.16B
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x1, 0xa8,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x2, 0xc5, byte(FeatAES), 0,
 
 	1,
 	// aese Vd.16B, Vn.16B
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x1, 0xaf,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x2, 0xcc, byte(FeatAES), 0,
 
 	1,
 	// aesimc Vd.16B, Vn.16B
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x1, 0xb6,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x2, 0xd3, byte(FeatAES), 0,
 
 	1,
 	// aesmc Vd.16B, Vn.16B
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x1, 0xbd,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x2, 0xda, byte(FeatAES), 0,
 
-	5,
+	12,
 	// and Vd.16B, Vn.16B, Vm.16B
 	// and Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x1, 0xc4,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x2, 0xe1, 0, 0,
 	// and Wd|WSP, Wn, #imm  ·················································  (imm is 32-bit logical)
-	3, MatWSP, MatW, MatImm, 0x1, 0xcd,
+	3, MatWSP, MatW, MatImm, 0x0, 0x2, 0xea, 0, 0,
 	// and Xd|SP, Xn, #imm  ··················································  (imm is 64-bit logical)
-	3, MatXSP, MatX, MatImm, 0x1, 0xd7,
+	3, MatXSP, MatX, MatImm, 0x0, 0x2, 0xf4, 0, 0,
 	// and Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x1, 0xe1,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x2, 0xfe, 0, 0,
 	// and Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x1, 0xed,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x3, 0xa, 0, 0,
+	// and Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x3, 0x16, byte(FeatSVE), 0,
+	// and Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x3, 0x21, byte(FeatSVE), 0,
+	// and Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x3, 0x2c, byte(FeatSVE), 0,
+	// and Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x3, 0x37, byte(FeatSVE), 0,
+	// and Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x3, 0x42, byte(FeatSVE), 0,
+	// and Zd.S, Zn.S, #imm  ·········································  (n == d, imm is 32-bit logical)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x3, 0x4a, byte(FeatSVE), 0,
+	// and Zd.D, Zn.D, #imm  ·········································  (n == d, imm is 64-bit logical)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x3, 0x55, byte(FeatSVE), 0,
 
 	4,
 	// ands Wd, Wn, #imm  ····················································  (imm is 32-bit logical)
-	3, MatW, MatW, MatImm, 0x1, 0xf9,
+	3, MatW, MatW, MatImm, 0x0, 0x3, 0x60, 0, 0,
 	// ands Xd, Xn, #imm  ····················································  (imm is 64-bit logical)
-	3, MatX, MatX, MatImm, 0x2, 0x3,
+	3, MatX, MatX, MatImm, 0x0, 0x3, 0x6a, 0, 0,
 	// ands Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x2, 0xd,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x3, 0x74, 0, 0,
 	// ands Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x2, 0x19,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x3, 0x80, 0, 0,
 
 	4,
+	// andv Bd, Pg, Zn.B  ····································································  (g < 8)
+	3, MatB, MatP, 0, MatZ, byte(BYTE), 0x0, 0x3, 0x8c, byte(FeatSVE), 0,
+	// andv Hd, Pg, Zn.H  ····································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x3, 0x95, byte(FeatSVE), 0,
+	// andv Sd, Pg, Zn.S  ····································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x3, 0x9e, byte(FeatSVE), 0,
+	// andv Dd, Pg, Zn.D  ····································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x3, 0xa7, byte(FeatSVE), 0,
+
+	16,
 	// asr Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2, 0x25,
+	3, MatW, MatW, MatW, 0x0, 0x3, 0xb0, 0, 0,
 	// asr Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2, 0x2d,
+	3, MatX, MatX, MatX, 0x0, 0x3, 0xb8, 0, 0,
 	// asr Wd, Wn, #imm  ·····························································  (0 <= imm < 32)
-	3, MatW, MatW, MatImm, 0x2, 0x35,
+	3, MatW, MatW, MatImm, 0x0, 0x3, 0xc0, 0, 0,
 	// asr Xd, Xn, #imm  ·····························································  (0 <= imm < 64)
-	3, MatX, MatX, MatImm, 0x2, 0x3f,
+	3, MatX, MatX, MatImm, 0x0, 0x3, 0xca, 0, 0,
+	// asr Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x3, 0xd4, byte(FeatSVE), 0,
+	// asr Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x3, 0xdf, byte(FeatSVE), 0,
+	// asr Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x3, 0xea, byte(FeatSVE), 0,
+	// asr Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x3, 0xf5, byte(FeatSVE), 0,
+	// asr Zd.B, Zn.B, #imm  ··························································  (0 < imm <= 8)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x4, 0x0, byte(FeatSVE), 0,
+	// asr Zd.H, Zn.H, #imm  ·························································  (0 < imm <= 16)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x4, 0xb, byte(FeatSVE), 0,
+	// asr Zd.S, Zn.S, #imm  ·························································  (0 < imm <= 32)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x4, 0x16, byte(FeatSVE), 0,
+	// asr Zd.D, Zn.D, #imm  ·························································  (0 < imm <= 64)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x4, 0x21, byte(FeatSVE), 0,
+	// asr Zd.B, Pg/M, Zn.B, #imm  ·····································  (g < 8, n == d, 0 < imm <= 8)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatImm, 0x0, 0x4, 0x2b, byte(FeatSVE), 0,
+	// asr Zd.H, Pg/M, Zn.H, #imm  ····································  (g < 8, n == d, 0 < imm <= 16)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatImm, 0x0, 0x4, 0x39, byte(FeatSVE), 0,
+	// asr Zd.S, Pg/M, Zn.S, #imm  ····································  (g < 8, n == d, 0 < imm <= 32)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatImm, 0x0, 0x4, 0x47, byte(FeatSVE), 0,
+	// asr Zd.D, Pg/M, Zn.D, #imm  ····································  (g < 8, n == d, 0 < imm <= 64)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatImm, 0x0, 0x4, 0x55, byte(FeatSVE), 0,
 
 	2,
 	// asrv Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2, 0x49,
+	3, MatW, MatW, MatW, 0x0, 0x4, 0x62, 0, 0,
 	// asrv Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2, 0x51,
+	3, MatX, MatX, MatX, 0x0, 0x4, 0x6a, 0, 0,
 
 	1,
 	// at <symbol>, Xn
-	2, MatSymbol, MatX, 0x2, 0x59,
+	2, MatSymbol, MatX, 0x0, 0x4, 0x72, 0, 0,
 
 	1,
 	// autda Xd, Xn|SP
-	2, MatX, MatXSP, 0x2, 0x62,
+	2, MatX, MatXSP, 0x0, 0x4, 0x7b, byte(FeatPAuth), 0,
 
 	1,
 	// autdb Xd, Xn|SP
-	2, MatX, MatXSP, 0x2, 0x69,
+	2, MatX, MatXSP, 0x0, 0x4, 0x82, byte(FeatPAuth), 0,
 
 	1,
 	// autdza Xd
-	1, MatX, 0x2, 0x70,
+	1, MatX, 0x0, 0x4, 0x89, byte(FeatPAuth), 0,
 
 	1,
 	// autdzb Xd
-	1, MatX, 0x2, 0x76,
+	1, MatX, 0x0, 0x4, 0x8f, byte(FeatPAuth), 0,
 
 	1,
 	// autia Xd, Xn|SP
-	2, MatX, MatXSP, 0x2, 0x7c,
+	2, MatX, MatXSP, 0x0, 0x4, 0x95, byte(FeatPAuth), 0,
 
 	1,
 	// autia1716
-	0, 0x2, 0x83,
+	0, 0x0, 0x4, 0x9c, 0, 0,
 
 	1,
 	// autiasp
-	0, 0x2, 0x88,
+	0, 0x0, 0x4, 0xa1, 0, 0,
 
 	1,
 	// autiaz
-	0, 0x2, 0x8d,
+	0, 0x0, 0x4, 0xa6, 0, 0,
 
 	1,
 	// autib Xd, Xn|SP
-	2, MatX, MatXSP, 0x2, 0x92,
+	2, MatX, MatXSP, 0x0, 0x4, 0xab, byte(FeatPAuth), 0,
 
 	1,
 	// autib1716
-	0, 0x2, 0x99,
+	0, 0x0, 0x4, 0xb2, 0, 0,
 
 	1,
 	// autibsp
-	0, 0x2, 0x9e,
+	0, 0x0, 0x4, 0xb7, 0, 0,
 
 	1,
 	// autibz
-	0, 0x2, 0xa3,
+	0, 0x0, 0x4, 0xbc, 0, 0,
 
 	1,
 	// autiza Xd
-	1, MatX, 0x2, 0xa8,
+	1, MatX, 0x0, 0x4, 0xc1, byte(FeatPAuth), 0,
 
 	1,
 	// autizb Xd
-	1, MatX, 0x2, 0xae,
+	1, MatX, 0x0, 0x4, 0xc7, byte(FeatPAuth), 0,
+
+	1,
+	// axflag
+	0, 0x0, 0x4, 0xcd, byte(FeatFlagM2), 0,
 
 	2,
 	// b <cond>, <offset>  ········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatCond, MatOffset, 0x2, 0xb4,
+	2, MatCond, MatOffset, 0x0, 0x4, 0xd2, 0, 0,
 	// b <offset>  ··············································  (offset >> 2 is 26-bit (+/- 128 MB))
-	1, MatOffset, 0x2, 0xbd,
+	1, MatOffset, 0x0, 0x4, 0xdb, 0, 0,
 
-	1,
+	2,
 	// bcax Vd.16B, Vn.16B, Vm.16B, Va.16B
-	4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x2, 0xc4,
+	4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x4, 0xe2, byte(FeatSHA3), 0,
+	// bcax Zd.D, Zn.D, Zm.D, Za.D  ·························································  (n == d)
+	4, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x4, 0xeb, byte(FeatSVE2), 0,
 
 	2,
 	// bfc Wd, #imm1, #imm2  ·····················  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
-	3, MatW, MatImm, MatImm, 0x2, 0xcd,
+	3, MatW, MatImm, MatImm, 0x0, 0x4, 0xf5, 0, 0,
 	// bfc Xd, #imm1, #imm2  ·····················  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
-	3, MatX, MatImm, MatImm, 0x2, 0xdc,
+	3, MatX, MatImm, MatImm, 0x0, 0x5, 0x4, 0, 0,
+
+	1,
+	// bfcvt Hd, Sn
+	2, MatH, MatS, 0x0, 0x5, 0x13, byte(FeatBF16), 0,
+
+	1,
+	// bfcvtn Vd.4H, Vn.4S
+	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x5, 0x1a, byte(FeatBF16), 0,
+
+	1,
+	// bfcvtn2 Vd.8H, Vn.4S
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x0, 0x5, 0x21, byte(FeatBF16), 0,
+
+	4,
+	// bfdot Vd.2S, Vn.4H, Vm.4H
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x5, 0x28, byte(FeatBF16), 0,
+	// bfdot Vd.2S, Vn.4H, Vm.2H[i]
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 4, MatVStaticElement, byte(WORD), 2, 0x0, 0x5, 0x30, byte(FeatBF16), 0,
+	// bfdot Vd.4S, Vn.8H, Vm.8H
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x5, 0x3a, byte(FeatBF16), 0,
+	// bfdot Vd.4S, Vn.8H, Vm.2H[i]
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStaticElement, byte(WORD), 2, 0x0, 0x5, 0x42, byte(FeatBF16), 0,
 
 	2,
 	// bfi Wd, Wn, #imm1, #imm2  ·················  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
-	4, MatW, MatW, MatImm, MatImm, 0x2, 0xeb,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x5, 0x4c, 0, 0,
 	// bfi Xd, Xn, #imm1, #imm2  ·················  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x2, 0xfb,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x5, 0x5c, 0, 0,
 
 	2,
 	// bfm Wd, Wn, #imm1, #imm2  ····································  (0 <= imm1 < 32, 0 <= imm2 < 32)
-	4, MatW, MatW, MatImm, MatImm, 0x3, 0xb,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x5, 0x6c, 0, 0,
 	// bfm Xd, Xn, #imm1, #imm2  ··················  (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x3, 0x18,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x5, 0x79, 0, 0,
+
+	2,
+	// bfmlalb Vd.4S, Vn.8H, Vm.8H
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x5, 0x88, byte(FeatBF16), 0,
+	// bfmlalb Vd.4S, Vn.8H, Vm.H[i]  ·······················································  (m < 16)
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x5, 0x90, byte(FeatBF16), 0,
+
+	2,
+	// bfmlalt Vd.4S, Vn.8H, Vm.8H
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x5, 0x9a, byte(FeatBF16), 0,
+	// bfmlalt Vd.4S, Vn.8H, Vm.H[i]  ·······················································  (m < 16)
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x5, 0xa2, byte(FeatBF16), 0,
+
+	1,
+	// bfmmla Vd.4S, Vn.8H, Vm.8H
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x5, 0xac, byte(FeatBF16), 0,
+
+	1,
+	// bfmopa ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x5, 0xb4, byte(FeatSME), 0,
+
+	1,
+	// bfmops ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x5, 0xc2, byte(FeatSME), 0,
 
 	2,
 	// bfxil Wd, Wn, #imm1, #imm2  ···············  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
-	4, MatW, MatW, MatImm, MatImm, 0x3, 0x27,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x5, 0xd0, 0, 0,
 	// bfxil Xd, Xn, #imm1, #imm2  ···············  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x3, 0x36,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x5, 0xdf, 0, 0,
 
-	5,
+	10,
 	// bic Vd.8H, #imm1 {, LSL #imm2 }  ····························  (0 <= imm1 < 256, imm2 in [0, 8])
 	// bic Vd.4H, #imm1 {, LSL #imm2 }  ····························  (0 <= imm1 < 256, imm2 in [0, 8])
-	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x3, 0x45,
+	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x5, 0xee, 0, 0,
 	// bic Vd.4S, #imm1 {, LSL #imm2 }  ····················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
 	// bic Vd.2S, #imm1 {, LSL #imm2 }  ····················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
-	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x3, 0x5a,
+	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6, 0x3, 0, 0,
 	// bic Vd.16B, Vn.16B, Vm.16B
 	// bic Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x3, 0x6f,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6, 0x18, 0, 0,
 	// bic Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x3, 0x78,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x6, 0x21, 0, 0,
 	// bic Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x3, 0x84,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x6, 0x2d, 0, 0,
+	// bic Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6, 0x39, byte(FeatSVE), 0,
+	// bic Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6, 0x44, byte(FeatSVE), 0,
+	// bic Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x6, 0x4f, byte(FeatSVE), 0,
+	// bic Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6, 0x5a, byte(FeatSVE), 0,
+	// bic Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6, 0x65, byte(FeatSVE), 0,
 
 	2,
 	// bics Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x3, 0x90,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x6, 0x6d, 0, 0,
 	// bics Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x3, 0x9c,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x6, 0x79, 0, 0,
 
 	1,
 	// bif Vd.16B, Vn.16B, Vm.16B
 	// bif Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x3, 0xa8,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6, 0x85, 0, 0,
 
 	1,
 	// bit Vd.16B, Vn.16B, Vm.16B
 	// bit Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x3, 0xb1,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6, 0x8e, 0, 0,
 
 	1,
 	// bl <offset>  ·············································  (offset >> 2 is 26-bit (+/- 128 MB))
-	1, MatOffset, 0x3, 0xba,
+	1, MatOffset, 0x0, 0x6, 0x97, 0, 0,
 
 	1,
 	// blr Xd
-	1, MatX, 0x3, 0xc1,
+	1, MatX, 0x0, 0x6, 0x9e, 0, 0,
 
 	1,
 	// blraa Xd, Xn|SP
-	2, MatX, MatXSP, 0x3, 0xc7,
+	2, MatX, MatXSP, 0x0, 0x6, 0xa4, byte(FeatPAuth), 0,
 
 	1,
 	// blraaz Xd
-	1, MatX, 0x3, 0xce,
+	1, MatX, 0x0, 0x6, 0xab, byte(FeatPAuth), 0,
 
 	1,
 	// blrab Xd, Xn|SP
-	2, MatX, MatXSP, 0x3, 0xd4,
+	2, MatX, MatXSP, 0x0, 0x6, 0xb1, byte(FeatPAuth), 0,
 
 	1,
 	// blrabz Xd
-	1, MatX, 0x3, 0xdb,
+	1, MatX, 0x0, 0x6, 0xb8, byte(FeatPAuth), 0,
 
 	1,
 	// br Xd
-	1, MatX, 0x3, 0xe1,
+	1, MatX, 0x0, 0x6, 0xbe, 0, 0,
 
 	1,
 	// braa Xd, Xn|SP
-	2, MatX, MatXSP, 0x3, 0xe7,
+	2, MatX, MatXSP, 0x0, 0x6, 0xc4, byte(FeatPAuth), 0,
 
 	1,
 	// braaz Xd
-	1, MatX, 0x3, 0xee,
+	1, MatX, 0x0, 0x6, 0xcb, byte(FeatPAuth), 0,
 
 	1,
 	// brab Xd, Xn|SP
-	2, MatX, MatXSP, 0x3, 0xf4,
+	2, MatX, MatXSP, 0x0, 0x6, 0xd1, byte(FeatPAuth), 0,
 
 	1,
 	// brabz Xd
-	1, MatX, 0x3, 0xfb,
+	1, MatX, 0x0, 0x6, 0xd8, byte(FeatPAuth), 0,
 
 	1,
 	// brk #imm  ··································································  (0 <= imm < 65536)
-	1, MatImm, 0x4, 0x1,
+	1, MatImm, 0x0, 0x6, 0xde, 0, 0,
 
-	1,
+	2,
 	// bsl Vd.16B, Vn.16B, Vm.16B
 	// bsl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x4, 0x9,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6, 0xe6, 0, 0,
+	// bsl Zd.D, Zn.D, Zm.D, Za.D  ··························································  (n == d)
+	4, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6, 0xef, byte(FeatSVE2), 0,
+
+	1,
+	// bsl1n Zd.D, Zn.D, Zm.D, Za.D  ························································  (n == d)
+	4, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6, 0xf9, byte(FeatSVE2), 0,
+
+	1,
+	// bsl2n Zd.D, Zn.D, Zm.D, Za.D  ························································  (n == d)
+	4, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x7, 0x3, byte(FeatSVE2), 0,
+
+	2,
+	// bti
+	0, 0x0, 0x7, 0xd, 0, 0,
+	// bti <symbol>
+	1, MatSymbol, 0x0, 0x7, 0x12, 0, 0,
 
 	2,
 	// cas Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x12,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x1a, byte(FeatLSE), 0,
 	// cas Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4, 0x1a,
+	3, MatX, MatX, MatRefBase, 0x0, 0x7, 0x22, byte(FeatLSE), 0,
 
 	2,
 	// casa Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x22,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x2a, byte(FeatLSE), 0,
 	// casa Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4, 0x2a,
+	3, MatX, MatX, MatRefBase, 0x0, 0x7, 0x32, byte(FeatLSE), 0,
 
 	1,
 	// casab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x32,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x3a, byte(FeatLSE), 0,
 
 	1,
 	// casah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x3a,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x42, byte(FeatLSE), 0,
 
 	2,
 	// casal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x42,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x4a, byte(FeatLSE), 0,
 	// casal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4, 0x4a,
+	3, MatX, MatX, MatRefBase, 0x0, 0x7, 0x52, byte(FeatLSE), 0,
 
 	1,
 	// casalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x52,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x5a, byte(FeatLSE), 0,
 
 	1,
 	// casalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x5a,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x62, byte(FeatLSE), 0,
 
 	1,
 	// casb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x62,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x6a, byte(FeatLSE), 0,
 
 	1,
 	// cash Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x6a,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x72, byte(FeatLSE), 0,
 
 	2,
 	// casl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x72,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x7a, byte(FeatLSE), 0,
 	// casl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4, 0x7a,
+	3, MatX, MatX, MatRefBase, 0x0, 0x7, 0x82, byte(FeatLSE), 0,
 
 	1,
 	// caslb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x82,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x8a, byte(FeatLSE), 0,
 
 	1,
 	// caslh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4, 0x8a,
+	3, MatW, MatW, MatRefBase, 0x0, 0x7, 0x92, byte(FeatLSE), 0,
 
 	2,
 	// casp Wn, Wm, Wa, Wb, [Xd|SP]  ··················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatW, MatW, MatW, MatW, MatRefBase, 0x4, 0x92,
+	5, MatW, MatW, MatW, MatW, MatRefBase, 0x0, 0x7, 0x9a, byte(FeatLSE), 0,
 	// casp Xn, Xm, Xa, Xb, [Xd|SP]  ··················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatX, MatX, MatX, MatX, MatRefBase, 0x4, 0x9e,
+	5, MatX, MatX, MatX, MatX, MatRefBase, 0x0, 0x7, 0xa6, byte(FeatLSE), 0,
 
 	2,
 	// caspa Wn, Wm, Wa, Wb, [Xd|SP]  ·················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatW, MatW, MatW, MatW, MatRefBase, 0x4, 0xaa,
+	5, MatW, MatW, MatW, MatW, MatRefBase, 0x0, 0x7, 0xb2, byte(FeatLSE), 0,
 	// caspa Xn, Xm, Xa, Xb, [Xd|SP]  ·················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatX, MatX, MatX, MatX, MatRefBase, 0x4, 0xb6,
+	5, MatX, MatX, MatX, MatX, MatRefBase, 0x0, 0x7, 0xbe, byte(FeatLSE), 0,
 
 	2,
 	// caspal Wn, Wm, Wa, Wb, [Xd|SP]  ················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatW, MatW, MatW, MatW, MatRefBase, 0x4, 0xc2,
+	5, MatW, MatW, MatW, MatW, MatRefBase, 0x0, 0x7, 0xca, byte(FeatLSE), 0,
 	// caspal Xn, Xm, Xa, Xb, [Xd|SP]  ················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatX, MatX, MatX, MatX, MatRefBase, 0x4, 0xce,
+	5, MatX, MatX, MatX, MatX, MatRefBase, 0x0, 0x7, 0xd6, byte(FeatLSE), 0,
 
 	2,
 	// caspl Wn, Wm, Wa, Wb, [Xd|SP]  ·················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatW, MatW, MatW, MatW, MatRefBase, 0x4, 0xda,
+	5, MatW, MatW, MatW, MatW, MatRefBase, 0x0, 0x7, 0xe2, byte(FeatLSE), 0,
 	// caspl Xn, Xm, Xa, Xb, [Xd|SP]  ·················  (n is even, m == n + 1, a is even, b == a + 1)
-	5, MatX, MatX, MatX, MatX, MatRefBase, 0x4, 0xe6,
+	5, MatX, MatX, MatX, MatX, MatRefBase, 0x0, 0x7, 0xee, byte(FeatLSE), 0,
 
 	2,
 	// cbnz Wd, <offset>  ·········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatW, MatOffset, 0x4, 0xf2,
+	2, MatW, MatOffset, 0x0, 0x7, 0xfa, 0, 0,
 	// cbnz Xd, <offset>  ·········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatX, MatOffset, 0x4, 0xfa,
+	2, MatX, MatOffset, 0x0, 0x8, 0x2, 0, 0,
 
 	2,
 	// cbz Wd, <offset>  ··········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatW, MatOffset, 0x5, 0x2,
+	2, MatW, MatOffset, 0x0, 0x8, 0xa, 0, 0,
 	// cbz Xd, <offset>  ··········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatX, MatOffset, 0x5, 0xa,
+	2, MatX, MatOffset, 0x0, 0x8, 0x12, 0, 0,
 
 	4,
 	// ccmn Wd, #imm1, #imm2, <cond>  ·······························  (0 <= imm1 < 32, 0 <= imm2 < 16)
-	4, MatW, MatImm, MatImm, MatCond, 0x5, 0x12,
+	4, MatW, MatImm, MatImm, MatCond, 0x0, 0x8, 0x1a, 0, 0,
 	// ccmn Xd, #imm1, #imm2, <cond>  ·······························  (0 <= imm1 < 32, 0 <= imm2 < 16)
-	4, MatX, MatImm, MatImm, MatCond, 0x5, 0x20,
+	4, MatX, MatImm, MatImm, MatCond, 0x0, 0x8, 0x28, 0, 0,
 	// ccmn Wd, Wn, #imm, <cond>  ····················································  (0 <= imm < 16)
-	4, MatW, MatW, MatImm, MatCond, 0x5, 0x2e,
+	4, MatW, MatW, MatImm, MatCond, 0x0, 0x8, 0x36, 0, 0,
 	// ccmn Xd, Xn, #imm, <cond>  ····················································  (0 <= imm < 16)
-	4, MatX, MatX, MatImm, MatCond, 0x5, 0x3a,
+	4, MatX, MatX, MatImm, MatCond, 0x0, 0x8, 0x42, 0, 0,
 
 	4,
 	// ccmp Wd, #imm1, #imm2, <cond>  ·······························  (0 <= imm1 < 32, 0 <= imm2 < 16)
-	4, MatW, MatImm, MatImm, MatCond, 0x5, 0x46,
+	4, MatW, MatImm, MatImm, MatCond, 0x0, 0x8, 0x4e, 0, 0,
 	// ccmp Xd, #imm1, #imm2, <cond>  ·······························  (0 <= imm1 < 32, 0 <= imm2 < 16)
-	4, MatX, MatImm, MatImm, MatCond, 0x5, 0x54,
+	4, MatX, MatImm, MatImm, MatCond, 0x0, 0x8, 0x5c, 0, 0,
 	// ccmp Wd, Wn, #imm, <cond>  ····················································  (0 <= imm < 16)
-	4, MatW, MatW, MatImm, MatCond, 0x5, 0x62,
+	4, MatW, MatW, MatImm, MatCond, 0x0, 0x8, 0x6a, 0, 0,
 	// ccmp Xd, Xn, #imm, <cond>  ····················································  (0 <= imm < 16)
-	4, MatX, MatX, MatImm, MatCond, 0x5, 0x6e,
+	4, MatX, MatX, MatImm, MatCond, 0x0, 0x8, 0x76, 0, 0,
 
 	1,
 	// cfinv
-	0, 0x5, 0x7a,
+	0, 0x0, 0x8, 0x82, byte(FeatFlagM), 0,
 
 	1,
 	// cfp RCTX, Xn
-	2, MatLitSymbol, byte(RCTX), MatX, 0x5, 0x7f,
+	2, MatLitSymbol, byte(RCTX), MatX, 0x0, 0x8, 0x87, byte(FeatSPECRES), 0,
 
 	2,
 	// cinc Wd, Wn, <cond>
-	3, MatW, MatW, MatCond, 0x5, 0x85,
+	3, MatW, MatW, MatCond, 0x0, 0x8, 0x8d, 0, 0,
 	// cinc Xd, Xn, <cond>
-	3, MatX, MatX, MatCond, 0x5, 0x90,
+	3, MatX, MatX, MatCond, 0x0, 0x8, 0x98, 0, 0,
 
 	2,
 	// cinv Wd, Wn, <cond>
-	3, MatW, MatW, MatCond, 0x5, 0x9b,
+	3, MatW, MatW, MatCond, 0x0, 0x8, 0xa3, 0, 0,
 	// cinv Xd, Xn, <cond>
-	3, MatX, MatX, MatCond, 0x5, 0xa6,
+	3, MatX, MatX, MatCond, 0x0, 0x8, 0xae, 0, 0,
+
+	1,
+	// clrbhb
+	0, 0x0, 0x8, 0xb9, 0, 0,
 
 	2,
 	// clrex #imm  ···································································  (0 <= imm < 16)
-	1, MatImm, 0x5, 0xb1,
+	1, MatImm, 0x0, 0x8, 0xbe, 0, 0,
 	// clrex
-	0, 0x5, 0xb9,
+	0, 0x0, 0x8, 0xc6, 0, 0,
 
-	5,
+	9,
 	// cls Vd.16B, Vn.16B
 	// cls Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x5, 0xbe,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x8, 0xcb, 0, 0,
 	// cls Vd.8H, Vn.8H
 	// cls Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x5, 0xc6,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x8, 0xd3, 0, 0,
 	// cls Vd.4S, Vn.4S
 	// cls Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x5, 0xce,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x8, 0xdb, 0, 0,
 	// cls Wd, Wn
-	2, MatW, MatW, 0x5, 0xd6,
+	2, MatW, MatW, 0x0, 0x8, 0xe3, 0, 0,
 	// cls Xd, Xn
-	2, MatX, MatX, 0x5, 0xdd,
+	2, MatX, MatX, 0x0, 0x8, 0xea, 0, 0,
+	// cls Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	3, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0x8, 0xf1, byte(FeatSVE), 0,
+	// cls Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x8, 0xfa, byte(FeatSVE), 0,
+	// cls Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x9, 0x3, byte(FeatSVE), 0,
+	// cls Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x9, 0xc, byte(FeatSVE), 0,
 
-	5,
+	9,
 	// clz Vd.16B, Vn.16B
 	// clz Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x5, 0xe4,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x9, 0x15, 0, 0,
 	// clz Vd.8H, Vn.8H
 	// clz Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x5, 0xec,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x9, 0x1d, 0, 0,
 	// clz Vd.4S, Vn.4S
 	// clz Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x5, 0xf4,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x9, 0x25, 0, 0,
 	// clz Wd, Wn
-	2, MatW, MatW, 0x5, 0xfc,
+	2, MatW, MatW, 0x0, 0x9, 0x2d, 0, 0,
 	// clz Xd, Xn
-	2, MatX, MatX, 0x6, 0x3,
+	2, MatX, MatX, 0x0, 0x9, 0x34, 0, 0,
+	// clz Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	3, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0x9, 0x3b, byte(FeatSVE), 0,
+	// clz Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x9, 0x44, byte(FeatSVE), 0,
+	// clz Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x9, 0x4d, byte(FeatSVE), 0,
+	// clz Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x9, 0x56, byte(FeatSVE), 0,
 
 	10,
 	// cmeq Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x6, 0xa,
+	3, MatD, MatD, MatD, 0x0, 0x9, 0x5f, 0, 0,
 	// cmeq Vd.16B, Vn.16B, Vm.16B
 	// cmeq Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x6, 0x12,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x9, 0x67, 0, 0,
 	// cmeq Vd.8H, Vn.8H, Vm.8H
 	// cmeq Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x6, 0x1b,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x9, 0x70, 0, 0,
 	// cmeq Vd.4S, Vn.4S, Vm.4S
 	// cmeq Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x6, 0x24,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x9, 0x79, 0, 0,
 	// cmeq Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x6, 0x2d,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x9, 0x82, 0, 0,
 	// cmeq Dd, Dn, #0
-	3, MatD, MatD, MatLitInt, 0, 0x6, 0x36,
+	3, MatD, MatD, MatLitInt, 0, 0x0, 0x9, 0x8b, 0, 0,
 	// cmeq Vd.16B, Vn.16B, #0
 	// cmeq Vd.8B, Vn.8B, #0
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x6, 0x3d,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x0, 0x9, 0x92, 0, 0,
 	// cmeq Vd.8H, Vn.8H, #0
 	// cmeq Vd.4H, Vn.4H, #0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x6, 0x45,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x0, 0x9, 0x9a, 0, 0,
 	// cmeq Vd.4S, Vn.4S, #0
 	// cmeq Vd.2S, Vn.2S, #0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x6, 0x4d,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x0, 0x9, 0xa2, 0, 0,
 	// cmeq Vd.2D, Vn.2D, #0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x6, 0x55,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x0, 0x9, 0xaa, 0, 0,
 
 	10,
 	// cmge Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x6, 0x5d,
+	3, MatD, MatD, MatD, 0x0, 0x9, 0xb2, 0, 0,
 	// cmge Vd.16B, Vn.16B, Vm.16B
 	// cmge Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x6, 0x65,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x9, 0xba, 0, 0,
 	// cmge Vd.8H, Vn.8H, Vm.8H
 	// cmge Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x6, 0x6e,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x9, 0xc3, 0, 0,
 	// cmge Vd.4S, Vn.4S, Vm.4S
 	// cmge Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x6, 0x77,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x9, 0xcc, 0, 0,
 	// cmge Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x6, 0x80,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x9, 0xd5, 0, 0,
 	// cmge Dd, Dn, #0
-	3, MatD, MatD, MatLitInt, 0, 0x6, 0x89,
+	3, MatD, MatD, MatLitInt, 0, 0x0, 0x9, 0xde, 0, 0,
 	// cmge Vd.16B, Vn.16B, #0
 	// cmge Vd.8B, Vn.8B, #0
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x6, 0x90,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x0, 0x9, 0xe5, 0, 0,
 	// cmge Vd.8H, Vn.8H, #0
 	// cmge Vd.4H, Vn.4H, #0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x6, 0x98,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x0, 0x9, 0xed, 0, 0,
 	// cmge Vd.4S, Vn.4S, #0
 	// cmge Vd.2S, Vn.2S, #0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x6, 0xa0,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x0, 0x9, 0xf5, 0, 0,
 	// cmge Vd.2D, Vn.2D, #0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x6, 0xa8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x0, 0x9, 0xfd, 0, 0,
 
 	10,
 	// cmgt Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x6, 0xb0,
+	3, MatD, MatD, MatD, 0x0, 0xa, 0x5, 0, 0,
 	// cmgt Vd.16B, Vn.16B, Vm.16B
 	// cmgt Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x6, 0xb8,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0xa, 0xd, 0, 0,
 	// cmgt Vd.8H, Vn.8H, Vm.8H
 	// cmgt Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x6, 0xc1,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0xa, 0x16, 0, 0,
 	// cmgt Vd.4S, Vn.4S, Vm.4S
 	// cmgt Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x6, 0xca,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0xa, 0x1f, 0, 0,
 	// cmgt Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x6, 0xd3,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0xa, 0x28, 0, 0,
 	// cmgt Dd, Dn, #0
-	3, MatD, MatD, MatLitInt, 0, 0x6, 0xdc,
+	3, MatD, MatD, MatLitInt, 0, 0x0, 0xa, 0x31, 0, 0,
 	// cmgt Vd.16B, Vn.16B, #0
 	// cmgt Vd.8B, Vn.8B, #0
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x6, 0xe3,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x0, 0xa, 0x38, 0, 0,
 	// cmgt Vd.8H, Vn.8H, #0
 	// cmgt Vd.4H, Vn.4H, #0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x6, 0xeb,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x0, 0xa, 0x40, 0, 0,
 	// cmgt Vd.4S, Vn.4S, #0
 	// cmgt Vd.2S, Vn.2S, #0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x6, 0xf3,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x0, 0xa, 0x48, 0, 0,
 	// cmgt Vd.2D, Vn.2D, #0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x6, 0xfb,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x0, 0xa, 0x50, 0, 0,
 
 	5,
 	// cmhi Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x7, 0x3,
+	3, MatD, MatD, MatD, 0x0, 0xa, 0x58, 0, 0,
 	// cmhi Vd.16B, Vn.16B, Vm.16B
 	// cmhi Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x7, 0xb,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0xa, 0x60, 0, 0,
 	// cmhi Vd.8H, Vn.8H, Vm.8H
 	// cmhi Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x7, 0x14,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0xa, 0x69, 0, 0,
 	// cmhi Vd.4S, Vn.4S, Vm.4S
 	// cmhi Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x7, 0x1d,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0xa, 0x72, 0, 0,
 	// cmhi Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x7, 0x26,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0xa, 0x7b, 0, 0,
 
 	5,
 	// cmhs Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x7, 0x2f,
+	3, MatD, MatD, MatD, 0x0, 0xa, 0x84, 0, 0,
 	// cmhs Vd.16B, Vn.16B, Vm.16B
 	// cmhs Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x7, 0x37,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0xa, 0x8c, 0, 0,
 	// cmhs Vd.8H, Vn.8H, Vm.8H
 	// cmhs Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x7, 0x40,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0xa, 0x95, 0, 0,
 	// cmhs Vd.4S, Vn.4S, Vm.4S
 	// cmhs Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x7, 0x49,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0xa, 0x9e, 0, 0,
 	// cmhs Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x7, 0x52,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0xa, 0xa7, 0, 0,
 
 	5,
 	// cmle Dd, Dn, #0
-	3, MatD, MatD, MatLitInt, 0, 0x7, 0x5b,
+	3, MatD, MatD, MatLitInt, 0, 0x0, 0xa, 0xb0, 0, 0,
 	// cmle Vd.16B, Vn.16B, #0
 	// cmle Vd.8B, Vn.8B, #0
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x7, 0x62,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x0, 0xa, 0xb7, 0, 0,
 	// cmle Vd.8H, Vn.8H, #0
 	// cmle Vd.4H, Vn.4H, #0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x7, 0x6a,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x0, 0xa, 0xbf, 0, 0,
 	// cmle Vd.4S, Vn.4S, #0
 	// cmle Vd.2S, Vn.2S, #0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x7, 0x72,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x0, 0xa, 0xc7, 0, 0,
 	// cmle Vd.2D, Vn.2D, #0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x7, 0x7a,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x0, 0xa, 0xcf, 0, 0,
 
 	5,
 	// cmlt Dd, Dn, #0
-	3, MatD, MatD, MatLitInt, 0, 0x7, 0x82,
+	3, MatD, MatD, MatLitInt, 0, 0x0, 0xa, 0xd7, 0, 0,
 	// cmlt Vd.16B, Vn.16B, #0
 	// cmlt Vd.8B, Vn.8B, #0
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x7, 0x89,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatLitInt, 0, 0x0, 0xa, 0xde, 0, 0,
 	// cmlt Vd.8H, Vn.8H, #0
 	// cmlt Vd.4H, Vn.4H, #0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x7, 0x91,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitInt, 0, 0x0, 0xa, 0xe6, 0, 0,
 	// cmlt Vd.4S, Vn.4S, #0
 	// cmlt Vd.2S, Vn.2S, #0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x7, 0x99,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitInt, 0, 0x0, 0xa, 0xee, 0, 0,
 	// cmlt Vd.2D, Vn.2D, #0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x7, 0xa1,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitInt, 0, 0x0, 0xa, 0xf6, 0, 0,
 
 	7,
 	// cmn Wd, Wn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 32)
-	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x7, 0xa9,
+	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0xa, 0xfe, 0, 0,
 	// cmn Xd, Xn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 64)
-	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x7, 0xb4,
+	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0xb, 0x9, 0, 0,
 	// cmn Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm }  ·····························  (0 <= imm <= 4)
-	4, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x7, 0xbf,
+	4, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x0, 0xb, 0x14, 0, 0,
 	// cmn Xd|SP, Wn, UXT[BHW]|SXT[BHW] #imm  ········································  (0 <= imm <= 4)
-	3, MatXSP, MatW, MatMod, SymExtendsW, 0x7, 0xcb,
+	3, MatXSP, MatW, MatMod, SymExtendsW, 0x0, 0xb, 0x20, 0, 0,
 	// cmn Xd|SP, Xn {, LSL|UXTX|SXTX #imm }  ········································  (0 <= imm <= 4)
-	4, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x7, 0xd7,
+	4, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x0, 0xb, 0x2c, 0, 0,
 	// cmn Wd|WSP, #imm1 {, LSL #imm2 }  ·························  (0 <= imm1 < 4096, imm2 in [0, 12])
-	4, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x7, 0xe3,
+	4, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0xb, 0x38, 0, 0,
 	// cmn Xd|SP, #imm1 {, LSL #imm2 }  ··························  (0 <= imm1 < 4096, imm2 in [0, 12])
-	4, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x7, 0xef,
+	4, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0xb, 0x44, 0, 0,
 
 	7,
 	// cmp Wd, Wn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 32)
-	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x7, 0xfb,
+	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0xb, 0x50, 0, 0,
 	// cmp Xd, Xn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 64)
-	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x8, 0x6,
+	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0xb, 0x5b, 0, 0,
 	// cmp Wd|WSP, Wn {, LSL|UXT[BHWX]|SXT[BHWX] #imm }  ·····························  (0 <= imm <= 4)
-	4, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x8, 0x11,
+	4, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x0, 0xb, 0x66, 0, 0,
 	// cmp Xd|SP, Wn, UXT[BHW]|SXT[BHW] #imm  ········································  (0 <= imm <= 4)
-	3, MatXSP, MatW, MatMod, SymExtendsW, 0x8, 0x1d,
+	3, MatXSP, MatW, MatMod, SymExtendsW, 0x0, 0xb, 0x72, 0, 0,
 	// cmp Xd|SP, Xn {, LSL|UXTX|SXTX #imm }  ········································  (0 <= imm <= 4)
-	4, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x8, 0x29,
+	4, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x0, 0xb, 0x7e, 0, 0,
 	// cmp Wd|WSP, #imm1 {, LSL #imm2 }  ·························  (0 <= imm1 < 4096, imm2 in [0, 12])
-	4, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x8, 0x35,
+	4, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0xb, 0x8a, 0, 0,
 	// cmp Xd|SP, #imm1 {, LSL #imm2 }  ··························  (0 <= imm1 < 4096, imm2 in [0, 12])
-	4, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x8, 0x41,
+	4, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0xb, 0x96, 0, 0,
+
+	8,
+	// cmpeq Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0xb, 0xa2, byte(FeatSVE), 0,
+	// cmpeq Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0xb, 0xac, byte(FeatSVE), 0,
+	// cmpeq Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0xb, 0xb6, byte(FeatSVE), 0,
+	// cmpeq Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0xb, 0xc0, byte(FeatSVE), 0,
+	// cmpeq Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xb, 0xca, byte(FeatSVE), 0,
+	// cmpeq Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xb, 0xd6, byte(FeatSVE), 0,
+	// cmpeq Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xb, 0xe2, byte(FeatSVE), 0,
+	// cmpeq Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xb, 0xee, byte(FeatSVE), 0,
+
+	8,
+	// cmpge Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0xb, 0xfa, byte(FeatSVE), 0,
+	// cmpge Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0xc, 0x4, byte(FeatSVE), 0,
+	// cmpge Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0xc, 0xe, byte(FeatSVE), 0,
+	// cmpge Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0xc, 0x18, byte(FeatSVE), 0,
+	// cmpge Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xc, 0x22, byte(FeatSVE), 0,
+	// cmpge Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xc, 0x2e, byte(FeatSVE), 0,
+	// cmpge Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xc, 0x3a, byte(FeatSVE), 0,
+	// cmpge Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xc, 0x46, byte(FeatSVE), 0,
+
+	8,
+	// cmpgt Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0xc, 0x52, byte(FeatSVE), 0,
+	// cmpgt Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0xc, 0x5c, byte(FeatSVE), 0,
+	// cmpgt Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0xc, 0x66, byte(FeatSVE), 0,
+	// cmpgt Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0xc, 0x70, byte(FeatSVE), 0,
+	// cmpgt Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xc, 0x7a, byte(FeatSVE), 0,
+	// cmpgt Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xc, 0x86, byte(FeatSVE), 0,
+	// cmpgt Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xc, 0x92, byte(FeatSVE), 0,
+	// cmpgt Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xc, 0x9e, byte(FeatSVE), 0,
+
+	8,
+	// cmphi Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0xc, 0xaa, byte(FeatSVE), 0,
+	// cmphi Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0xc, 0xb4, byte(FeatSVE), 0,
+	// cmphi Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0xc, 0xbe, byte(FeatSVE), 0,
+	// cmphi Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0xc, 0xc8, byte(FeatSVE), 0,
+	// cmphi Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xc, 0xd2, byte(FeatSVE), 0,
+	// cmphi Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xc, 0xde, byte(FeatSVE), 0,
+	// cmphi Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xc, 0xea, byte(FeatSVE), 0,
+	// cmphi Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xc, 0xf6, byte(FeatSVE), 0,
+
+	8,
+	// cmphs Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0xd, 0x2, byte(FeatSVE), 0,
+	// cmphs Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0xd, 0xc, byte(FeatSVE), 0,
+	// cmphs Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0xd, 0x16, byte(FeatSVE), 0,
+	// cmphs Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0xd, 0x20, byte(FeatSVE), 0,
+	// cmphs Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xd, 0x2a, byte(FeatSVE), 0,
+	// cmphs Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xd, 0x36, byte(FeatSVE), 0,
+	// cmphs Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xd, 0x42, byte(FeatSVE), 0,
+	// cmphs Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xd, 0x4e, byte(FeatSVE), 0,
+
+	4,
+	// cmple Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xd, 0x5a, byte(FeatSVE), 0,
+	// cmple Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xd, 0x66, byte(FeatSVE), 0,
+	// cmple Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xd, 0x72, byte(FeatSVE), 0,
+	// cmple Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xd, 0x7e, byte(FeatSVE), 0,
+
+	4,
+	// cmplo Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xd, 0x8a, byte(FeatSVE), 0,
+	// cmplo Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xd, 0x96, byte(FeatSVE), 0,
+	// cmplo Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xd, 0xa2, byte(FeatSVE), 0,
+	// cmplo Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xd, 0xae, byte(FeatSVE), 0,
+
+	4,
+	// cmpls Pd.B, Pg/Z, Zn.B, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xd, 0xba, byte(FeatSVE), 0,
+	// cmpls Pd.H, Pg/Z, Zn.H, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xd, 0xc6, byte(FeatSVE), 0,
+	// cmpls Pd.S, Pg/Z, Zn.S, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xd, 0xd2, byte(FeatSVE), 0,
+	// cmpls Pd.D, Pg/Z, Zn.D, #imm  ·········································  (g < 8, 0 <= imm < 128)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xd, 0xde, byte(FeatSVE), 0,
+
+	4,
+	// cmplt Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xd, 0xea, byte(FeatSVE), 0,
+	// cmplt Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xd, 0xf6, byte(FeatSVE), 0,
+	// cmplt Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xe, 0x2, byte(FeatSVE), 0,
+	// cmplt Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xe, 0xe, byte(FeatSVE), 0,
+
+	8,
+	// cmpne Pd.B, Pg/Z, Zn.B, Zm.B  ·························································  (g < 8)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0xe, 0x1a, byte(FeatSVE), 0,
+	// cmpne Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0xe, 0x24, byte(FeatSVE), 0,
+	// cmpne Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0xe, 0x2e, byte(FeatSVE), 0,
+	// cmpne Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0xe, 0x38, byte(FeatSVE), 0,
+	// cmpne Pd.B, Pg/Z, Zn.B, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(BYTE), MatPZ, MatZ, byte(BYTE), MatImm, 0x0, 0xe, 0x42, byte(FeatSVE), 0,
+	// cmpne Pd.H, Pg/Z, Zn.H, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatImm, 0x0, 0xe, 0x4e, byte(FeatSVE), 0,
+	// cmpne Pd.S, Pg/Z, Zn.S, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatImm, 0x0, 0xe, 0x5a, byte(FeatSVE), 0,
+	// cmpne Pd.D, Pg/Z, Zn.D, #imm  ········································  (g < 8, -16 <= imm < 16)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatImm, 0x0, 0xe, 0x66, byte(FeatSVE), 0,
 
 	5,
 	// cmtst Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x8, 0x4d,
+	3, MatD, MatD, MatD, 0x0, 0xe, 0x72, 0, 0,
 	// cmtst Vd.16B, Vn.16B, Vm.16B
 	// cmtst Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x8, 0x55,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0xe, 0x7a, 0, 0,
 	// cmtst Vd.8H, Vn.8H, Vm.8H
 	// cmtst Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x8, 0x5e,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0xe, 0x83, 0, 0,
 	// cmtst Vd.4S, Vn.4S, Vm.4S
 	// cmtst Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x8, 0x67,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0xe, 0x8c, 0, 0,
 	// cmtst Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x8, 0x70,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0xe, 0x95, 0, 0,
 
 	2,
 	// cneg Wd, Wn, <cond>
-	3, MatW, MatW, MatCond, 0x8, 0x79,
+	3, MatW, MatW, MatCond, 0x0, 0xe, 0x9e, 0, 0,
 	// cneg Xd, Xn, <cond>
-	3, MatX, MatX, MatCond, 0x8, 0x84,
+	3, MatX, MatX, MatCond, 0x0, 0xe, 0xa9, 0, 0,
 
-	1,
+	7,
 	// cnt Vd.16B, Vn.16B
 	// cnt Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x8, 0x8f,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0xe, 0xb4, 0, 0,
+	// cnt Wd, Wn
+	2, MatW, MatW, 0x0, 0xe, 0xbc, byte(FeatCSSC), 0,
+	// cnt Xd, Xn
+	2, MatX, MatX, 0x0, 0xe, 0xc3, byte(FeatCSSC), 0,
+	// cnt Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	3, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0xe, 0xca, byte(FeatSVE), 0,
+	// cnt Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0xe, 0xd3, byte(FeatSVE), 0,
+	// cnt Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0xe, 0xdc, byte(FeatSVE), 0,
+	// cnt Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0xe, 0xe5, byte(FeatSVE), 0,
+
+	2,
+	// cntb Xd
+	1, MatX, 0x0, 0xe, 0xee, byte(FeatSVE), 0,
+	// cntb Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0xe, 0xf4, byte(FeatSVE), 0,
+
+	2,
+	// cntd Xd
+	1, MatX, 0x0, 0xf, 0x1, byte(FeatSVE), 0,
+	// cntd Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0xf, 0x7, byte(FeatSVE), 0,
+
+	2,
+	// cnth Xd
+	1, MatX, 0x0, 0xf, 0x14, byte(FeatSVE), 0,
+	// cnth Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0xf, 0x1a, byte(FeatSVE), 0,
+
+	2,
+	// cntw Xd
+	1, MatX, 0x0, 0xf, 0x27, byte(FeatSVE), 0,
+	// cntw Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0xf, 0x2d, byte(FeatSVE), 0,
+
+	2,
+	// compact Zd.S, Pg, Zn.S  ·······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatP, 0, MatZ, byte(DWORD), 0x0, 0xf, 0x3a, byte(FeatSVE), 0,
+	// compact Zd.D, Pg, Zn.D  ·······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatP, 0, MatZ, byte(QWORD), 0x0, 0xf, 0x43, byte(FeatSVE), 0,
 
 	1,
 	// cpp RCTX, Xn
-	2, MatLitSymbol, byte(RCTX), MatX, 0x8, 0x97,
+	2, MatLitSymbol, byte(RCTX), MatX, 0x0, 0xf, 0x4c, byte(FeatSPECRES), 0,
+
+	1,
+	// cpye [Xd]!, [Xn]!, Xm
+	3, MatRefWback, MatRefWback, MatX, 0x0, 0xf, 0x52, byte(FeatMOPS), 0,
+
+	1,
+	// cpyfe [Xd]!, [Xn]!, Xm
+	3, MatRefWback, MatRefWback, MatX, 0x0, 0xf, 0x5c, byte(FeatMOPS), 0,
+
+	1,
+	// cpyfm [Xd]!, [Xn]!, Xm
+	3, MatRefWback, MatRefWback, MatX, 0x0, 0xf, 0x66, byte(FeatMOPS), 0,
+
+	1,
+	// cpyfp [Xd]!, [Xn]!, Xm
+	3, MatRefWback, MatRefWback, MatX, 0x0, 0xf, 0x70, byte(FeatMOPS), 0,
+
+	1,
+	// cpym [Xd]!, [Xn]!, Xm
+	3, MatRefWback, MatRefWback, MatX, 0x0, 0xf, 0x7a, byte(FeatMOPS), 0,
+
+	1,
+	// cpyp [Xd]!, [Xn]!, Xm
+	3, MatRefWback, MatRefWback, MatX, 0x0, 0xf, 0x84, byte(FeatMOPS), 0,
 
 	1,
 	// crc32b Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x8, 0x9d,
+	3, MatW, MatW, MatW, 0x0, 0xf, 0x8e, byte(FeatCRC32), 0,
 
 	1,
 	// crc32cb Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x8, 0xa5,
+	3, MatW, MatW, MatW, 0x0, 0xf, 0x96, byte(FeatCRC32), 0,
 
 	1,
 	// crc32ch Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x8, 0xad,
+	3, MatW, MatW, MatW, 0x0, 0xf, 0x9e, byte(FeatCRC32), 0,
 
 	1,
 	// crc32cw Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x8, 0xb5,
+	3, MatW, MatW, MatW, 0x0, 0xf, 0xa6, byte(FeatCRC32), 0,
 
 	1,
 	// crc32cx Wd, Wn, Xm
-	3, MatW, MatW, MatX, 0x8, 0xbd,
+	3, MatW, MatW, MatX, 0x0, 0xf, 0xae, byte(FeatCRC32), 0,
 
 	1,
 	// crc32h Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x8, 0xc5,
+	3, MatW, MatW, MatW, 0x0, 0xf, 0xb6, byte(FeatCRC32), 0,
 
 	1,
 	// crc32w Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x8, 0xcd,
+	3, MatW, MatW, MatW, 0x0, 0xf, 0xbe, byte(FeatCRC32), 0,
 
 	1,
 	// crc32x Wd, Wn, Xm
-	3, MatW, MatW, MatX, 0x8, 0xd5,
+	3, MatW, MatW, MatX, 0x0, 0xf, 0xc6, byte(FeatCRC32), 0,
 
 	1,
 	// csdb
-	0, 0x8, 0xdd,
+	0, 0x0, 0xf, 0xce, 0, 0,
 
 	2,
 	// csel Wd, Wn, Wm, <cond>
-	4, MatW, MatW, MatW, MatCond, 0x8, 0xe2,
+	4, MatW, MatW, MatW, MatCond, 0x0, 0xf, 0xd3, 0, 0,
 	// csel Xd, Xn, Xm, <cond>
-	4, MatX, MatX, MatX, MatCond, 0x8, 0xec,
+	4, MatX, MatX, MatX, MatCond, 0x0, 0xf, 0xdd, 0, 0,
 
 	2,
 	// cset Wd, <cond>
-	2, MatW, MatCond, 0x8, 0xf6,
+	2, MatW, MatCond, 0x0, 0xf, 0xe7, 0, 0,
 	// cset Xd, <cond>
-	2, MatX, MatCond, 0x8, 0xfe,
+	2, MatX, MatCond, 0x0, 0xf, 0xef, 0, 0,
 
 	2,
 	// csetm Wd, <cond>
-	2, MatW, MatCond, 0x9, 0x6,
+	2, MatW, MatCond, 0x0, 0xf, 0xf7, 0, 0,
 	// csetm Xd, <cond>
-	2, MatX, MatCond, 0x9, 0xe,
+	2, MatX, MatCond, 0x0, 0xf, 0xff, 0, 0,
 
 	2,
 	// csinc Wd, Wn, Wm, <cond>
-	4, MatW, MatW, MatW, MatCond, 0x9, 0x16,
+	4, MatW, MatW, MatW, MatCond, 0x0, 0x10, 0x7, 0, 0,
 	// csinc Xd, Xn, Xm, <cond>
-	4, MatX, MatX, MatX, MatCond, 0x9, 0x20,
+	4, MatX, MatX, MatX, MatCond, 0x0, 0x10, 0x11, 0, 0,
 
 	2,
 	// csinv Wd, Wn, Wm, <cond>
-	4, MatW, MatW, MatW, MatCond, 0x9, 0x2a,
+	4, MatW, MatW, MatW, MatCond, 0x0, 0x10, 0x1b, 0, 0,
 	// csinv Xd, Xn, Xm, <cond>
-	4, MatX, MatX, MatX, MatCond, 0x9, 0x34,
+	4, MatX, MatX, MatX, MatCond, 0x0, 0x10, 0x25, 0, 0,
 
 	2,
 	// csneg Wd, Wn, Wm, <cond>
-	4, MatW, MatW, MatW, MatCond, 0x9, 0x3e,
+	4, MatW, MatW, MatW, MatCond, 0x0, 0x10, 0x2f, 0, 0,
 	// csneg Xd, Xn, Xm, <cond>
-	4, MatX, MatX, MatX, MatCond, 0x9, 0x48,
+	4, MatX, MatX, MatX, MatCond, 0x0, 0x10, 0x39, 0, 0,
+
+	2,
+	// ctz Wd, Wn
+	2, MatW, MatW, 0x0, 0x10, 0x43, byte(FeatCSSC), 0,
+	// ctz Xd, Xn
+	2, MatX, MatX, 0x0, 0x10, 0x4a, byte(FeatCSSC), 0,
 
 	1,
 	// dc <symbol>, Xn
-	2, MatSymbol, MatX, 0x9, 0x52,
+	2, MatSymbol, MatX, 0x0, 0x10, 0x51, 0, 0,
 
 	1,
 	// dcps1  {, #imm }  ··························································  (0 <= imm < 65536)
-	2, MatEnd, MatImm, 0x9, 0x5b,
+	2, MatEnd, MatImm, 0x0, 0x10, 0x5a, 0, 0,
 
 	1,
 	// dcps2  {, #imm }  ··························································  (0 <= imm < 65536)
-	2, MatEnd, MatImm, 0x9, 0x63,
+	2, MatEnd, MatImm, 0x0, 0x10, 0x62, 0, 0,
 
 	1,
 	// dcps3  {, #imm }  ··························································  (0 <= imm < 65536)
-	2, MatEnd, MatImm, 0x9, 0x6b,
+	2, MatEnd, MatImm, 0x0, 0x10, 0x6a, 0, 0,
+
+	2,
+	// decb Xd
+	1, MatX, 0x0, 0x10, 0x72, byte(FeatSVE), 0,
+	// decb Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x10, 0x78, byte(FeatSVE), 0,
+
+	2,
+	// decd Xd
+	1, MatX, 0x0, 0x10, 0x85, byte(FeatSVE), 0,
+	// decd Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x10, 0x8b, byte(FeatSVE), 0,
+
+	2,
+	// dech Xd
+	1, MatX, 0x0, 0x10, 0x98, byte(FeatSVE), 0,
+	// dech Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x10, 0x9e, byte(FeatSVE), 0,
+
+	2,
+	// decw Xd
+	1, MatX, 0x0, 0x10, 0xab, byte(FeatSVE), 0,
+	// decw Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x10, 0xb1, byte(FeatSVE), 0,
+
+	1,
+	// dgh
+	0, 0x0, 0x10, 0xbe, 0, 0,
 
 	2,
 	// dmb <symbol>
-	1, MatSymbol, 0x9, 0x73,
+	1, MatSymbol, 0x0, 0x10, 0xc3, 0, 0,
 	// dmb #imm  ·····································································  (0 <= imm < 16)
-	1, MatImm, 0x9, 0x7b,
+	1, MatImm, 0x0, 0x10, 0xcb, 0, 0,
 
 	1,
 	// drps
-	0, 0x9, 0x83,
+	0, 0x0, 0x10, 0xd3, 0, 0,
 
 	2,
 	// dsb <symbol>
-	1, MatSymbol, 0x9, 0x88,
+	1, MatSymbol, 0x0, 0x10, 0xd8, 0, 0,
 	// dsb #imm  ·····································································  (0 <= imm < 16)
-	1, MatImm, 0x9, 0x90,
+	1, MatImm, 0x0, 0x10, 0xe0, 0, 0,
 
-	12,
+	20,
 	// dup Bd, Vn.B[i]
-	2, MatB, MatVElement, byte(BYTE), 0x9, 0x98,
+	2, MatB, MatVElement, byte(BYTE), 0x0, 0x10, 0xe8, 0, 0,
 	// dup Hd, Vn.H[i]
-	2, MatH, MatVElement, byte(WORD), 0x9, 0xa2,
+	2, MatH, MatVElement, byte(WORD), 0x0, 0x10, 0xf2, 0, 0,
 	// dup Sd, Vn.S[i]
-	2, MatS, MatVElement, byte(DWORD), 0x9, 0xac,
+	2, MatS, MatVElement, byte(DWORD), 0x0, 0x10, 0xfc, 0, 0,
 	// dup Dd, Vn.D[i]
-	2, MatD, MatVElement, byte(QWORD), 0x9, 0xb6,
+	2, MatD, MatVElement, byte(QWORD), 0x0, 0x11, 0x6, 0, 0,
 	// dup Vd.16B, Vn.B[i]
 	// dup Vd.8B, Vn.B[i]
-	2, MatV, byte(BYTE), MatVElement, byte(BYTE), 0x9, 0xc0,
+	2, MatV, byte(BYTE), MatVElement, byte(BYTE), 0x0, 0x11, 0x10, 0, 0,
 	// dup Vd.8H, Vn.H[i]
 	// dup Vd.4H, Vn.H[i]
-	2, MatV, byte(WORD), MatVElement, byte(WORD), 0x9, 0xcb,
+	2, MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x11, 0x1b, 0, 0,
 	// dup Vd.4S, Vn.S[i]
 	// dup Vd.2S, Vn.S[i]
-	2, MatV, byte(DWORD), MatVElement, byte(DWORD), 0x9, 0xd6,
+	2, MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x11, 0x26, 0, 0,
 	// dup Vd.2D, Vn.D[i]
-	2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x9, 0xe1,
+	2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x0, 0x11, 0x31, 0, 0,
 	// dup Vd.16B, Wn
 	// dup Vd.8B, Wn
-	2, MatV, byte(BYTE), MatW, 0x9, 0xec,
+	2, MatV, byte(BYTE), MatW, 0x0, 0x11, 0x3c, 0, 0,
 	// dup Vd.8H, Wn
 	// dup Vd.4H, Wn
-	2, MatV, byte(WORD), MatW, 0x9, 0xf4,
+	2, MatV, byte(WORD), MatW, 0x0, 0x11, 0x44, 0, 0,
 	// dup Vd.4S, Wn
 	// dup Vd.2S, Wn
-	2, MatV, byte(DWORD), MatW, 0x9, 0xfc,
+	2, MatV, byte(DWORD), MatW, 0x0, 0x11, 0x4c, 0, 0,
 	// dup Vd.2D, Xn
-	2, MatVStatic, byte(QWORD), 2, MatX, 0xa, 0x4,
+	2, MatVStatic, byte(QWORD), 2, MatX, 0x0, 0x11, 0x54, 0, 0,
+	// dup Zd.B, Wn|WSP
+	2, MatZ, byte(BYTE), MatWSP, 0x0, 0x11, 0x5c, byte(FeatSVE), 0,
+	// dup Zd.H, Wn|WSP
+	2, MatZ, byte(WORD), MatWSP, 0x0, 0x11, 0x63, byte(FeatSVE), 0,
+	// dup Zd.S, Wn|WSP
+	2, MatZ, byte(DWORD), MatWSP, 0x0, 0x11, 0x6a, byte(FeatSVE), 0,
+	// dup Zd.D, Xn|SP
+	2, MatZ, byte(QWORD), MatXSP, 0x0, 0x11, 0x71, byte(FeatSVE), 0,
+	// dup Zd.B, #imm  ···························································  (-128 <= imm < 128)
+	2, MatZ, byte(BYTE), MatImm, 0x0, 0x11, 0x78, byte(FeatSVE), 0,
+	// dup Zd.H, #imm1 {, LSL #imm2 }  ··························  (-128 <= imm1 < 128, imm2 in [0, 8])
+	4, MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x11, 0x81, byte(FeatSVE), 0,
+	// dup Zd.S, #imm1 {, LSL #imm2 }  ··························  (-128 <= imm1 < 128, imm2 in [0, 8])
+	4, MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x11, 0x8d, byte(FeatSVE), 0,
+	// dup Zd.D, #imm1 {, LSL #imm2 }  ··························  (-128 <= imm1 < 128, imm2 in [0, 8])
+	4, MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x11, 0x99, byte(FeatSVE), 0,
 
 	1,
 	// dvp RCTX, Xn
-	2, MatLitSymbol, byte(RCTX), MatX, 0xa, 0xc,
+	2, MatLitSymbol, byte(RCTX), MatX, 0x0, 0x11, 0xa5, byte(FeatSPECRES), 0,
 
 	2,
 	// eon Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0xa, 0x12,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x11, 0xab, 0, 0,
 	// eon Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0xa, 0x1e,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x11, 0xb7, 0, 0,
 
-	5,
+	12,
 	// eor Vd.16B, Vn.16B, Vm.16B
 	// eor Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0xa, 0x2a,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x11, 0xc3, 0, 0,
 	// eor Wd|WSP, Wn, #imm  ·················································  (imm is 32-bit logical)
-	3, MatWSP, MatW, MatImm, 0xa, 0x33,
+	3, MatWSP, MatW, MatImm, 0x0, 0x11, 0xcc, 0, 0,
 	// eor Xd|SP, Xn, #imm  ··················································  (imm is 64-bit logical)
-	3, MatXSP, MatX, MatImm, 0xa, 0x3d,
+	3, MatXSP, MatX, MatImm, 0x0, 0x11, 0xd6, 0, 0,
 	// eor Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0xa, 0x47,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x11, 0xe0, 0, 0,
 	// eor Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0xa, 0x53,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x11, 0xec, 0, 0,
+	// eor Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x11, 0xf8, byte(FeatSVE), 0,
+	// eor Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x12, 0x3, byte(FeatSVE), 0,
+	// eor Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x12, 0xe, byte(FeatSVE), 0,
+	// eor Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x12, 0x19, byte(FeatSVE), 0,
+	// eor Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x12, 0x24, byte(FeatSVE), 0,
+	// eor Zd.S, Zn.S, #imm  ·········································  (n == d, imm is 32-bit logical)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x12, 0x2c, byte(FeatSVE), 0,
+	// eor Zd.D, Zn.D, #imm  ·········································  (n == d, imm is 64-bit logical)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x12, 0x37, byte(FeatSVE), 0,
 
-	1,
+	2,
 	// eor3 Vd.16B, Vn.16B, Vm.16B, Va.16B
-	4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0xa, 0x5f,
+	4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x12, 0x42, byte(FeatSHA3), 0,
+	// eor3 Zd.D, Zn.D, Zm.D, Za.D  ·························································  (n == d)
+	4, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x12, 0x4b, byte(FeatSVE2), 0,
+
+	4,
+	// eorv Bd, Pg, Zn.B  ····································································  (g < 8)
+	3, MatB, MatP, 0, MatZ, byte(BYTE), 0x0, 0x12, 0x55, byte(FeatSVE), 0,
+	// eorv Hd, Pg, Zn.H  ····································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x12, 0x5e, byte(FeatSVE), 0,
+	// eorv Sd, Pg, Zn.S  ····································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x12, 0x67, byte(FeatSVE), 0,
+	// eorv Dd, Pg, Zn.D  ····································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x12, 0x70, byte(FeatSVE), 0,
 
 	1,
 	// eret
-	0, 0xa, 0x68,
+	0, 0x0, 0x12, 0x79, 0, 0,
 
 	1,
 	// eretaa
-	0, 0xa, 0x6d,
+	0, 0x0, 0x12, 0x7e, byte(FeatPAuth), 0,
 
 	1,
 	// eretab
-	0, 0xa, 0x72,
+	0, 0x0, 0x12, 0x83, byte(FeatPAuth), 0,
 
 	1,
 	// esb
-	0, 0xa, 0x77,
+	0, 0x0, 0x12, 0x88, 0, 0,
 
-	2,
+	3,
 	// ext Vd.8B, Vn.8B, Vm.8B, #imm  ·················································  (0 <= imm < 8)
-	4, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, MatImm, 0xa, 0x7c,
+	4, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, MatImm, 0x0, 0x12, 0x8d, 0, 0,
 	// ext Vd.16B, Vn.16B, Vm.16B, #imm  ·············································  (0 <= imm < 16)
-	4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatImm, 0xa, 0x87,
+	4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, MatImm, 0x0, 0x12, 0x98, 0, 0,
+	// ext Zd.B, Zn.B, Zm.B, #imm  ··········································  (n == d, 0 <= imm < 256)
+	4, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x12, 0xa3, byte(FeatSVE), 0,
 
 	2,
 	// extr Wd, Wn, Wm, #imm  ························································  (0 <= imm < 32)
-	4, MatW, MatW, MatW, MatImm, 0xa, 0x92,
+	4, MatW, MatW, MatW, MatImm, 0x0, 0x12, 0xb7, 0, 0,
 	// extr Xd, Xn, Xm, #imm  ························································  (0 <= imm < 64)
-	4, MatX, MatX, MatX, MatImm, 0xa, 0x9d,
+	4, MatX, MatX, MatX, MatImm, 0x0, 0x12, 0xc2, 0, 0,
 
-	6,
+	9,
 	// fabd Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0xa, 0xa8,
+	3, MatH, MatH, MatH, 0x0, 0x12, 0xcd, byte(FeatFP16), 0,
 	// fabd Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0xa, 0xb0,
+	3, MatS, MatS, MatS, 0x0, 0x12, 0xd5, 0, 0,
 	// fabd Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0xa, 0xb8,
+	3, MatD, MatD, MatD, 0x0, 0x12, 0xdd, 0, 0,
 	// fabd Vd.8H, Vn.8H, Vm.8H
 	// fabd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xa, 0xc0,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x12, 0xe5, byte(FeatFP16), 0,
 	// fabd Vd.4S, Vn.4S, Vm.4S
 	// fabd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xa, 0xc9,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x12, 0xee, 0, 0,
 	// fabd Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xa, 0xd2,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x12, 0xf7, 0, 0,
+	// fabd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x13, 0x0, byte(FeatSVE), 0,
+	// fabd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x13, 0xb, byte(FeatSVE), 0,
+	// fabd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x13, 0x16, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// fabs Vd.8H, Vn.8H
 	// fabs Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0xa, 0xdb,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x13, 0x21, byte(FeatFP16), 0,
 	// fabs Vd.4S, Vn.4S
 	// fabs Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0xa, 0xe3,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x13, 0x29, 0, 0,
 	// fabs Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xa, 0xeb,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x13, 0x31, 0, 0,
 	// fabs Hd, Hn
-	2, MatH, MatH, 0xa, 0xf3,
+	2, MatH, MatH, 0x0, 0x13, 0x39, byte(FeatFP16), 0,
 	// fabs Sd, Sn
-	2, MatS, MatS, 0xa, 0xfa,
+	2, MatS, MatS, 0x0, 0x13, 0x40, 0, 0,
 	// fabs Dd, Dn
-	2, MatD, MatD, 0xb, 0x1,
+	2, MatD, MatD, 0x0, 0x13, 0x47, 0, 0,
+	// fabs Zd.H, Pg/M, Zn.H  ································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x13, 0x4e, byte(FeatSVE), 0,
+	// fabs Zd.S, Pg/M, Zn.S  ································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x13, 0x57, byte(FeatSVE), 0,
+	// fabs Zd.D, Pg/M, Zn.D  ································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x13, 0x60, byte(FeatSVE), 0,
 
 	6,
 	// facge Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0xb, 0x8,
+	3, MatH, MatH, MatH, 0x0, 0x13, 0x69, byte(FeatFP16), 0,
 	// facge Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0xb, 0x10,
+	3, MatS, MatS, MatS, 0x0, 0x13, 0x71, 0, 0,
 	// facge Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0xb, 0x18,
+	3, MatD, MatD, MatD, 0x0, 0x13, 0x79, 0, 0,
 	// facge Vd.8H, Vn.8H, Vm.8H
 	// facge Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xb, 0x20,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x13, 0x81, byte(FeatFP16), 0,
 	// facge Vd.4S, Vn.4S, Vm.4S
 	// facge Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xb, 0x29,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x13, 0x8a, 0, 0,
 	// facge Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xb, 0x32,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x13, 0x93, 0, 0,
 
 	6,
 	// facgt Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0xb, 0x3b,
+	3, MatH, MatH, MatH, 0x0, 0x13, 0x9c, byte(FeatFP16), 0,
 	// facgt Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0xb, 0x43,
+	3, MatS, MatS, MatS, 0x0, 0x13, 0xa4, 0, 0,
 	// facgt Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0xb, 0x4b,
+	3, MatD, MatD, MatD, 0x0, 0x13, 0xac, 0, 0,
 	// facgt Vd.8H, Vn.8H, Vm.8H
 	// facgt Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xb, 0x53,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x13, 0xb4, byte(FeatFP16), 0,
 	// facgt Vd.4S, Vn.4S, Vm.4S
 	// facgt Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xb, 0x5c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x13, 0xbd, 0, 0,
 	// facgt Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xb, 0x65,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x13, 0xc6, 0, 0,
 
-	6,
+	12,
 	// fadd Vd.8H, Vn.8H, Vm.8H
 	// fadd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xb, 0x6e,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x13, 0xcf, byte(FeatFP16), 0,
 	// fadd Vd.4S, Vn.4S, Vm.4S
 	// fadd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xb, 0x77,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x13, 0xd8, 0, 0,
 	// fadd Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xb, 0x80,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x13, 0xe1, 0, 0,
 	// fadd Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0xb, 0x89,
+	3, MatH, MatH, MatH, 0x0, 0x13, 0xea, byte(FeatFP16), 0,
 	// fadd Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0xb, 0x91,
+	3, MatS, MatS, MatS, 0x0, 0x13, 0xf2, 0, 0,
 	// fadd Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0xb, 0x99,
+	3, MatD, MatD, MatD, 0x0, 0x13, 0xfa, 0, 0,
+	// fadd Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x14, 0x2, byte(FeatSVE), 0,
+	// fadd Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x14, 0xa, byte(FeatSVE), 0,
+	// fadd Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x14, 0x12, byte(FeatSVE), 0,
+	// fadd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x14, 0x1a, byte(FeatSVE), 0,
+	// fadd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x14, 0x25, byte(FeatSVE), 0,
+	// fadd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x14, 0x30, byte(FeatSVE), 0,
 
 	6,
 	// faddp Hd, Vn.2H
-	2, MatH, MatVStatic, byte(WORD), 2, 0xb, 0xa1,
+	2, MatH, MatVStatic, byte(WORD), 2, 0x0, 0x14, 0x3b, byte(FeatFP16), 0,
 	// faddp Sd, Vn.2S
-	2, MatS, MatVStatic, byte(DWORD), 2, 0xb, 0xa8,
+	2, MatS, MatVStatic, byte(DWORD), 2, 0x0, 0x14, 0x42, 0, 0,
 	// faddp Dd, Vn.2D
-	2, MatD, MatVStatic, byte(QWORD), 2, 0xb, 0xaf,
+	2, MatD, MatVStatic, byte(QWORD), 2, 0x0, 0x14, 0x49, 0, 0,
 	// faddp Vd.8H, Vn.8H, Vm.8H
 	// faddp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xb, 0xb6,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x14, 0x50, byte(FeatFP16), 0,
 	// faddp Vd.4S, Vn.4S, Vm.4S
 	// faddp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xb, 0xbf,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x14, 0x59, 0, 0,
 	// faddp Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xb, 0xc8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x14, 0x62, 0, 0,
+
+	3,
+	// faddv Hd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x14, 0x6b, byte(FeatSVE), 0,
+	// faddv Sd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x14, 0x74, byte(FeatSVE), 0,
+	// faddv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x14, 0x7d, byte(FeatSVE), 0,
 
 	3,
 	// fcadd Vd.8H, Vn.8H, Vm.8H, #imm  ···········································  (imm in [90, 270])
 	// fcadd Vd.4H, Vn.4H, Vm.4H, #imm  ···········································  (imm in [90, 270])
-	4, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), MatImm, 0xb, 0xd1,
+	4, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x14, 0x86, byte(FeatFCMA), byte(FeatFP16),
 	// fcadd Vd.4S, Vn.4S, Vm.4S, #imm  ···········································  (imm in [90, 270])
 	// fcadd Vd.2S, Vn.2S, Vm.2S, #imm  ···········································  (imm in [90, 270])
-	4, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0xb, 0xdd,
+	4, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x14, 0x92, byte(FeatFCMA), 0,
 	// fcadd Vd.2D, Vn.2D, Vm.2D, #imm  ···········································  (imm in [90, 270])
-	4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0xb, 0xe9,
+	4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x14, 0x9e, byte(FeatFCMA), 0,
 
 	3,
 	// fccmp Hd, Hn, #imm, <cond>  ···················································  (0 <= imm < 16)
-	4, MatH, MatH, MatImm, MatCond, 0xb, 0xf5,
+	4, MatH, MatH, MatImm, MatCond, 0x0, 0x14, 0xaa, byte(FeatFP16), 0,
 	// fccmp Sd, Sn, #imm, <cond>  ···················································  (0 <= imm < 16)
-	4, MatS, MatS, MatImm, MatCond, 0xc, 0x1,
+	4, MatS, MatS, MatImm, MatCond, 0x0, 0x14, 0xb6, 0, 0,
 	// fccmp Dd, Dn, #imm, <cond>  ···················································  (0 <= imm < 16)
-	4, MatD, MatD, MatImm, MatCond, 0xc, 0xd,
+	4, MatD, MatD, MatImm, MatCond, 0x0, 0x14, 0xc2, 0, 0,
 
 	3,
 	// fccmpe Hd, Hn, #imm, <cond>  ··················································  (0 <= imm < 16)
-	4, MatH, MatH, MatImm, MatCond, 0xc, 0x19,
+	4, MatH, MatH, MatImm, MatCond, 0x0, 0x14, 0xce, byte(FeatFP16), 0,
 	// fccmpe Sd, Sn, #imm, <cond>  ··················································  (0 <= imm < 16)
-	4, MatS, MatS, MatImm, MatCond, 0xc, 0x25,
+	4, MatS, MatS, MatImm, MatCond, 0x0, 0x14, 0xda, 0, 0,
 	// fccmpe Dd, Dn, #imm, <cond>  ··················································  (0 <= imm < 16)
-	4, MatD, MatD, MatImm, MatCond, 0xc, 0x31,
+	4, MatD, MatD, MatImm, MatCond, 0x0, 0x14, 0xe6, 0, 0,
 
-	12,
+	15,
 	// fcmeq Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0xc, 0x3d,
+	3, MatH, MatH, MatH, 0x0, 0x14, 0xf2, byte(FeatFP16), 0,
 	// fcmeq Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0xc, 0x45,
+	3, MatS, MatS, MatS, 0x0, 0x14, 0xfa, 0, 0,
 	// fcmeq Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0xc, 0x4d,
+	3, MatD, MatD, MatD, 0x0, 0x15, 0x2, 0, 0,
 	// fcmeq Vd.8H, Vn.8H, Vm.8H
 	// fcmeq Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xc, 0x55,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x15, 0xa, byte(FeatFP16), 0,
 	// fcmeq Vd.4S, Vn.4S, Vm.4S
 	// fcmeq Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xc, 0x5e,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x15, 0x13, 0, 0,
 	// fcmeq Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xc, 0x67,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x15, 0x1c, 0, 0,
 	// fcmeq Hd, Hn, #0.0
-	3, MatH, MatH, MatLitFloat, 0, 0xc, 0x70,
+	3, MatH, MatH, MatLitFloat, 0, 0x0, 0x15, 0x25, byte(FeatFP16), 0,
 	// fcmeq Sd, Sn, #0.0
-	3, MatS, MatS, MatLitFloat, 0, 0xc, 0x77,
+	3, MatS, MatS, MatLitFloat, 0, 0x0, 0x15, 0x2c, 0, 0,
 	// fcmeq Dd, Dn, #0.0
-	3, MatD, MatD, MatLitFloat, 0, 0xc, 0x7e,
+	3, MatD, MatD, MatLitFloat, 0, 0x0, 0x15, 0x33, 0, 0,
 	// fcmeq Vd.8H, Vn.8H, #0.0
 	// fcmeq Vd.4H, Vn.4H, #0.0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0xc, 0x85,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0x0, 0x15, 0x3a, byte(FeatFP16), 0,
 	// fcmeq Vd.4S, Vn.4S, #0.0
 	// fcmeq Vd.2S, Vn.2S, #0.0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0xc, 0x8d,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0x0, 0x15, 0x42, 0, 0,
 	// fcmeq Vd.2D, Vn.2D, #0.0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0xc, 0x95,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0x0, 0x15, 0x4a, 0, 0,
+	// fcmeq Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x15, 0x52, byte(FeatSVE), 0,
+	// fcmeq Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x15, 0x5c, byte(FeatSVE), 0,
+	// fcmeq Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x15, 0x66, byte(FeatSVE), 0,
 
-	12,
+	15,
 	// fcmge Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0xc, 0x9d,
+	3, MatH, MatH, MatH, 0x0, 0x15, 0x70, byte(FeatFP16), 0,
 	// fcmge Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0xc, 0xa5,
+	3, MatS, MatS, MatS, 0x0, 0x15, 0x78, 0, 0,
 	// fcmge Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0xc, 0xad,
+	3, MatD, MatD, MatD, 0x0, 0x15, 0x80, 0, 0,
 	// fcmge Vd.8H, Vn.8H, Vm.8H
 	// fcmge Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xc, 0xb5,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x15, 0x88, byte(FeatFP16), 0,
 	// fcmge Vd.4S, Vn.4S, Vm.4S
 	// fcmge Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xc, 0xbe,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x15, 0x91, 0, 0,
 	// fcmge Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xc, 0xc7,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x15, 0x9a, 0, 0,
 	// fcmge Hd, Hn, #0.0
-	3, MatH, MatH, MatLitFloat, 0, 0xc, 0xd0,
+	3, MatH, MatH, MatLitFloat, 0, 0x0, 0x15, 0xa3, byte(FeatFP16), 0,
 	// fcmge Sd, Sn, #0.0
-	3, MatS, MatS, MatLitFloat, 0, 0xc, 0xd7,
+	3, MatS, MatS, MatLitFloat, 0, 0x0, 0x15, 0xaa, 0, 0,
 	// fcmge Dd, Dn, #0.0
-	3, MatD, MatD, MatLitFloat, 0, 0xc, 0xde,
+	3, MatD, MatD, MatLitFloat, 0, 0x0, 0x15, 0xb1, 0, 0,
 	// fcmge Vd.8H, Vn.8H, #0.0
 	// fcmge Vd.4H, Vn.4H, #0.0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0xc, 0xe5,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0x0, 0x15, 0xb8, byte(FeatFP16), 0,
 	// fcmge Vd.4S, Vn.4S, #0.0
 	// fcmge Vd.2S, Vn.2S, #0.0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0xc, 0xed,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0x0, 0x15, 0xc0, 0, 0,
 	// fcmge Vd.2D, Vn.2D, #0.0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0xc, 0xf5,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0x0, 0x15, 0xc8, 0, 0,
+	// fcmge Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x15, 0xd0, byte(FeatSVE), 0,
+	// fcmge Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x15, 0xda, byte(FeatSVE), 0,
+	// fcmge Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x15, 0xe4, byte(FeatSVE), 0,
 
-	12,
+	15,
 	// fcmgt Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0xc, 0xfd,
+	3, MatH, MatH, MatH, 0x0, 0x15, 0xee, byte(FeatFP16), 0,
 	// fcmgt Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0xd, 0x5,
+	3, MatS, MatS, MatS, 0x0, 0x15, 0xf6, 0, 0,
 	// fcmgt Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0xd, 0xd,
+	3, MatD, MatD, MatD, 0x0, 0x15, 0xfe, 0, 0,
 	// fcmgt Vd.8H, Vn.8H, Vm.8H
 	// fcmgt Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0xd, 0x15,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x16, 0x6, byte(FeatFP16), 0,
 	// fcmgt Vd.4S, Vn.4S, Vm.4S
 	// fcmgt Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0xd, 0x1e,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x16, 0xf, 0, 0,
 	// fcmgt Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xd, 0x27,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x16, 0x18, 0, 0,
 	// fcmgt Hd, Hn, #0.0
-	3, MatH, MatH, MatLitFloat, 0, 0xd, 0x30,
+	3, MatH, MatH, MatLitFloat, 0, 0x0, 0x16, 0x21, byte(FeatFP16), 0,
 	// fcmgt Sd, Sn, #0.0
-	3, MatS, MatS, MatLitFloat, 0, 0xd, 0x37,
+	3, MatS, MatS, MatLitFloat, 0, 0x0, 0x16, 0x28, 0, 0,
 	// fcmgt Dd, Dn, #0.0
-	3, MatD, MatD, MatLitFloat, 0, 0xd, 0x3e,
+	3, MatD, MatD, MatLitFloat, 0, 0x0, 0x16, 0x2f, 0, 0,
 	// fcmgt Vd.8H, Vn.8H, #0.0
 	// fcmgt Vd.4H, Vn.4H, #0.0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0xd, 0x45,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0x0, 0x16, 0x36, byte(FeatFP16), 0,
 	// fcmgt Vd.4S, Vn.4S, #0.0
 	// fcmgt Vd.2S, Vn.2S, #0.0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0xd, 0x4d,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0x0, 0x16, 0x3e, 0, 0,
 	// fcmgt Vd.2D, Vn.2D, #0.0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0xd, 0x55,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0x0, 0x16, 0x46, 0, 0,
+	// fcmgt Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x16, 0x4e, byte(FeatSVE), 0,
+	// fcmgt Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x16, 0x58, byte(FeatSVE), 0,
+	// fcmgt Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x16, 0x62, byte(FeatSVE), 0,
 
 	6,
 	// fcmla Vd.4H, Vn.4H, Vm.H[i], #imm  ·································  (imm in [0, 90, 180, 270])
-	4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), MatImm, 0xd, 0x5d,
+	4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), MatImm, 0x0, 0x16, 0x6c, byte(FeatFCMA), byte(FeatFP16),
 	// fcmla Vd.8H, Vn.8H, Vm.H[i], #imm  ·································  (imm in [0, 90, 180, 270])
-	4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), MatImm, 0xd, 0x69,
+	4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), MatImm, 0x0, 0x16, 0x78, byte(FeatFCMA), byte(FeatFP16),
 	// fcmla Vd.4S, Vn.4S, Vm.S[i], #imm  ·································  (imm in [0, 90, 180, 270])
-	4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), MatImm, 0xd, 0x76,
+	4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), MatImm, 0x0, 0x16, 0x85, byte(FeatFCMA), 0,
 	// fcmla Vd.8H, Vn.8H, Vm.8H, #imm  ···································  (imm in [0, 90, 180, 270])
 	// fcmla Vd.4H, Vn.4H, Vm.4H, #imm  ···································  (imm in [0, 90, 180, 270])
-	4, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), MatImm, 0xd, 0x84,
+	4, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x16, 0x93, byte(FeatFCMA), byte(FeatFP16),
 	// fcmla Vd.4S, Vn.4S, Vm.4S, #imm  ···································  (imm in [0, 90, 180, 270])
 	// fcmla Vd.2S, Vn.2S, Vm.2S, #imm  ···································  (imm in [0, 90, 180, 270])
-	4, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0xd, 0x90,
+	4, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x16, 0x9f, byte(FeatFCMA), 0,
 	// fcmla Vd.2D, Vn.2D, Vm.2D, #imm  ···································  (imm in [0, 90, 180, 270])
-	4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0xd, 0x9c,
+	4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x16, 0xab, byte(FeatFCMA), 0,
 
 	6,
 	// fcmle Hd, Hn, #0.0
-	3, MatH, MatH, MatLitFloat, 0, 0xd, 0xa8,
+	3, MatH, MatH, MatLitFloat, 0, 0x0, 0x16, 0xb7, byte(FeatFP16), 0,
 	// fcmle Sd, Sn, #0.0
-	3, MatS, MatS, MatLitFloat, 0, 0xd, 0xaf,
+	3, MatS, MatS, MatLitFloat, 0, 0x0, 0x16, 0xbe, 0, 0,
 	// fcmle Dd, Dn, #0.0
-	3, MatD, MatD, MatLitFloat, 0, 0xd, 0xb6,
+	3, MatD, MatD, MatLitFloat, 0, 0x0, 0x16, 0xc5, 0, 0,
 	// fcmle Vd.8H, Vn.8H, #0.0
 	// fcmle Vd.4H, Vn.4H, #0.0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0xd, 0xbd,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0x0, 0x16, 0xcc, byte(FeatFP16), 0,
 	// fcmle Vd.4S, Vn.4S, #0.0
 	// fcmle Vd.2S, Vn.2S, #0.0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0xd, 0xc5,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0x0, 0x16, 0xd4, 0, 0,
 	// fcmle Vd.2D, Vn.2D, #0.0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0xd, 0xcd,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0x0, 0x16, 0xdc, 0, 0,
 
 	6,
 	// fcmlt Hd, Hn, #0.0
-	3, MatH, MatH, MatLitFloat, 0, 0xd, 0xd5,
+	3, MatH, MatH, MatLitFloat, 0, 0x0, 0x16, 0xe4, byte(FeatFP16), 0,
 	// fcmlt Sd, Sn, #0.0
-	3, MatS, MatS, MatLitFloat, 0, 0xd, 0xdc,
+	3, MatS, MatS, MatLitFloat, 0, 0x0, 0x16, 0xeb, 0, 0,
 	// fcmlt Dd, Dn, #0.0
-	3, MatD, MatD, MatLitFloat, 0, 0xd, 0xe3,
+	3, MatD, MatD, MatLitFloat, 0, 0x0, 0x16, 0xf2, 0, 0,
 	// fcmlt Vd.8H, Vn.8H, #0.0
 	// fcmlt Vd.4H, Vn.4H, #0.0
-	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0xd, 0xea,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatLitFloat, 0, 0x0, 0x16, 0xf9, byte(FeatFP16), 0,
 	// fcmlt Vd.4S, Vn.4S, #0.0
 	// fcmlt Vd.2S, Vn.2S, #0.0
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0xd, 0xf2,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatLitFloat, 0, 0x0, 0x17, 0x1, 0, 0,
 	// fcmlt Vd.2D, Vn.2D, #0.0
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0xd, 0xfa,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatLitFloat, 0, 0x0, 0x17, 0x9, 0, 0,
+
+	3,
+	// fcmne Pd.H, Pg/Z, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatP, byte(WORD), MatPZ, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x17, 0x11, byte(FeatSVE), 0,
+	// fcmne Pd.S, Pg/Z, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatP, byte(DWORD), MatPZ, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x17, 0x1b, byte(FeatSVE), 0,
+	// fcmne Pd.D, Pg/Z, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatP, byte(QWORD), MatPZ, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x17, 0x25, byte(FeatSVE), 0,
 
 	6,
 	// fcmp Hd, Hn
-	2, MatH, MatH, 0xe, 0x2,
+	2, MatH, MatH, 0x0, 0x17, 0x2f, byte(FeatFP16), 0,
 	// fcmp Hd, #0.0
-	2, MatH, MatLitFloat, 0, 0xe, 0x9,
+	2, MatH, MatLitFloat, 0, 0x0, 0x17, 0x36, byte(FeatFP16), 0,
 	// fcmp Sd, Sn
-	2, MatS, MatS, 0xe, 0xf,
+	2, MatS, MatS, 0x0, 0x17, 0x3c, 0, 0,
 	// fcmp Sd, #0.0
-	2, MatS, MatLitFloat, 0, 0xe, 0x16,
+	2, MatS, MatLitFloat, 0, 0x0, 0x17, 0x43, 0, 0,
 	// fcmp Dd, Dn
-	2, MatD, MatD, 0xe, 0x1c,
+	2, MatD, MatD, 0x0, 0x17, 0x49, 0, 0,
 	// fcmp Dd, #0.0
-	2, MatD, MatLitFloat, 0, 0xe, 0x23,
+	2, MatD, MatLitFloat, 0, 0x0, 0x17, 0x50, 0, 0,
 
 	6,
 	// fcmpe Hd, Hn
-	2, MatH, MatH, 0xe, 0x29,
+	2, MatH, MatH, 0x0, 0x17, 0x56, byte(FeatFP16), 0,
 	// fcmpe Hd, #0.0
-	2, MatH, MatLitFloat, 0, 0xe, 0x30,
+	2, MatH, MatLitFloat, 0, 0x0, 0x17, 0x5d, byte(FeatFP16), 0,
 	// fcmpe Sd, Sn
-	2, MatS, MatS, 0xe, 0x36,
+	2, MatS, MatS, 0x0, 0x17, 0x63, 0, 0,
 	// fcmpe Sd, #0.0
-	2, MatS, MatLitFloat, 0, 0xe, 0x3d,
+	2, MatS, MatLitFloat, 0, 0x0, 0x17, 0x6a, 0, 0,
 	// fcmpe Dd, Dn
-	2, MatD, MatD, 0xe, 0x43,
+	2, MatD, MatD, 0x0, 0x17, 0x70, 0, 0,
 	// fcmpe Dd, #0.0
-	2, MatD, MatLitFloat, 0, 0xe, 0x4a,
+	2, MatD, MatLitFloat, 0, 0x0, 0x17, 0x77, 0, 0,
 
 	3,
 	// fcsel Hd, Hn, Hm, <cond>
-	4, MatH, MatH, MatH, MatCond, 0xe, 0x50,
+	4, MatH, MatH, MatH, MatCond, 0x0, 0x17, 0x7d, byte(FeatFP16), 0,
 	// fcsel Sd, Sn, Sm, <cond>
-	4, MatS, MatS, MatS, MatCond, 0xe, 0x5a,
+	4, MatS, MatS, MatS, MatCond, 0x0, 0x17, 0x87, 0, 0,
 	// fcsel Dd, Dn, Dm, <cond>
-	4, MatD, MatD, MatD, MatCond, 0xe, 0x64,
+	4, MatD, MatD, MatD, MatCond, 0x0, 0x17, 0x91, 0, 0,
 
 	6,
 	// fcvt Sd, Hn
-	2, MatS, MatH, 0xe, 0x6e,
+	2, MatS, MatH, 0x0, 0x17, 0x9b, 0, 0,
 	// fcvt Dd, Hn
-	2, MatD, MatH, 0xe, 0x75,
+	2, MatD, MatH, 0x0, 0x17, 0xa2, 0, 0,
 	// fcvt Hd, Sn
-	2, MatH, MatS, 0xe, 0x7c,
+	2, MatH, MatS, 0x0, 0x17, 0xa9, 0, 0,
 	// fcvt Dd, Sn
-	2, MatD, MatS, 0xe, 0x83,
+	2, MatD, MatS, 0x0, 0x17, 0xb0, 0, 0,
 	// fcvt Hd, Dn
-	2, MatH, MatD, 0xe, 0x8a,
+	2, MatH, MatD, 0x0, 0x17, 0xb7, 0, 0,
 	// fcvt Sd, Dn
-	2, MatS, MatD, 0xe, 0x91,
+	2, MatS, MatD, 0x0, 0x17, 0xbe, 0, 0,
 
 	12,
 	// fcvtas Hd, Hn
-	2, MatH, MatH, 0xe, 0x98,
+	2, MatH, MatH, 0x0, 0x17, 0xc5, byte(FeatFP16), 0,
 	// fcvtas Sd, Sn
-	2, MatS, MatS, 0xe, 0x9f,
+	2, MatS, MatS, 0x0, 0x17, 0xcc, 0, 0,
 	// fcvtas Dd, Dn
-	2, MatD, MatD, 0xe, 0xa6,
+	2, MatD, MatD, 0x0, 0x17, 0xd3, 0, 0,
 	// fcvtas Vd.8H, Vn.8H
 	// fcvtas Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0xe, 0xad,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x17, 0xda, byte(FeatFP16), 0,
 	// fcvtas Vd.4S, Vn.4S
 	// fcvtas Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0xe, 0xb5,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x17, 0xe2, 0, 0,
 	// fcvtas Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xe, 0xbd,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x17, 0xea, 0, 0,
 	// fcvtas Wd, Hn
-	2, MatW, MatH, 0xe, 0xc5,
+	2, MatW, MatH, 0x0, 0x17, 0xf2, byte(FeatFP16), 0,
 	// fcvtas Xd, Hn
-	2, MatX, MatH, 0xe, 0xcc,
+	2, MatX, MatH, 0x0, 0x17, 0xf9, byte(FeatFP16), 0,
 	// fcvtas Wd, Sn
-	2, MatW, MatS, 0xe, 0xd3,
+	2, MatW, MatS, 0x0, 0x18, 0x0, 0, 0,
 	// fcvtas Xd, Sn
-	2, MatX, MatS, 0xe, 0xda,
+	2, MatX, MatS, 0x0, 0x18, 0x7, 0, 0,
 	// fcvtas Wd, Dn
-	2, MatW, MatD, 0xe, 0xe1,
+	2, MatW, MatD, 0x0, 0x18, 0xe, 0, 0,
 	// fcvtas Xd, Dn
-	2, MatX, MatD, 0xe, 0xe8,
+	2, MatX, MatD, 0x0, 0x18, 0x15, 0, 0,
 
 	12,
 	// fcvtau Hd, Hn
-	2, MatH, MatH, 0xe, 0xef,
+	2, MatH, MatH, 0x0, 0x18, 0x1c, byte(FeatFP16), 0,
 	// fcvtau Sd, Sn
-	2, MatS, MatS, 0xe, 0xf6,
+	2, MatS, MatS, 0x0, 0x18, 0x23, 0, 0,
 	// fcvtau Dd, Dn
-	2, MatD, MatD, 0xe, 0xfd,
+	2, MatD, MatD, 0x0, 0x18, 0x2a, 0, 0,
 	// fcvtau Vd.8H, Vn.8H
 	// fcvtau Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0xf, 0x4,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x18, 0x31, byte(FeatFP16), 0,
 	// fcvtau Vd.4S, Vn.4S
 	// fcvtau Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0xf, 0xc,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x18, 0x39, 0, 0,
 	// fcvtau Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xf, 0x14,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x18, 0x41, 0, 0,
 	// fcvtau Wd, Hn
-	2, MatW, MatH, 0xf, 0x1c,
+	2, MatW, MatH, 0x0, 0x18, 0x49, byte(FeatFP16), 0,
 	// fcvtau Xd, Hn
-	2, MatX, MatH, 0xf, 0x23,
+	2, MatX, MatH, 0x0, 0x18, 0x50, byte(FeatFP16), 0,
 	// fcvtau Wd, Sn
-	2, MatW, MatS, 0xf, 0x2a,
+	2, MatW, MatS, 0x0, 0x18, 0x57, 0, 0,
 	// fcvtau Xd, Sn
-	2, MatX, MatS, 0xf, 0x31,
+	2, MatX, MatS, 0x0, 0x18, 0x5e, 0, 0,
 	// fcvtau Wd, Dn
-	2, MatW, MatD, 0xf, 0x38,
+	2, MatW, MatD, 0x0, 0x18, 0x65, 0, 0,
 	// fcvtau Xd, Dn
-	2, MatX, MatD, 0xf, 0x3f,
+	2, MatX, MatD, 0x0, 0x18, 0x6c, 0, 0,
 
 	2,
 	// fcvtl Vd.4S, Vn.4H
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0xf, 0x46,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x18, 0x73, 0, 0,
 	// fcvtl Vd.2D, Vn.2S
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0xf, 0x4d,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x18, 0x7a, 0, 0,
 
 	2,
 	// fcvtl2 Vd.4S, Vn.8H
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0xf, 0x54,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x0, 0x18, 0x81, 0, 0,
 	// fcvtl2 Vd.2D, Vn.4S
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0xf, 0x5b,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x0, 0x18, 0x88, 0, 0,
 
 	12,
 	// fcvtms Hd, Hn
-	2, MatH, MatH, 0xf, 0x62,
+	2, MatH, MatH, 0x0, 0x18, 0x8f, byte(FeatFP16), 0,
 	// fcvtms Sd, Sn
-	2, MatS, MatS, 0xf, 0x69,
+	2, MatS, MatS, 0x0, 0x18, 0x96, 0, 0,
 	// fcvtms Dd, Dn
-	2, MatD, MatD, 0xf, 0x70,
+	2, MatD, MatD, 0x0, 0x18, 0x9d, 0, 0,
 	// fcvtms Vd.8H, Vn.8H
 	// fcvtms Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0xf, 0x77,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x18, 0xa4, byte(FeatFP16), 0,
 	// fcvtms Vd.4S, Vn.4S
 	// fcvtms Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0xf, 0x7f,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x18, 0xac, 0, 0,
 	// fcvtms Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xf, 0x87,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x18, 0xb4, 0, 0,
 	// fcvtms Wd, Hn
-	2, MatW, MatH, 0xf, 0x8f,
+	2, MatW, MatH, 0x0, 0x18, 0xbc, byte(FeatFP16), 0,
 	// fcvtms Xd, Hn
-	2, MatX, MatH, 0xf, 0x96,
+	2, MatX, MatH, 0x0, 0x18, 0xc3, byte(FeatFP16), 0,
 	// fcvtms Wd, Sn
-	2, MatW, MatS, 0xf, 0x9d,
+	2, MatW, MatS, 0x0, 0x18, 0xca, 0, 0,
 	// fcvtms Xd, Sn
-	2, MatX, MatS, 0xf, 0xa4,
+	2, MatX, MatS, 0x0, 0x18, 0xd1, 0, 0,
 	// fcvtms Wd, Dn
-	2, MatW, MatD, 0xf, 0xab,
+	2, MatW, MatD, 0x0, 0x18, 0xd8, 0, 0,
 	// fcvtms Xd, Dn
-	2, MatX, MatD, 0xf, 0xb2,
+	2, MatX, MatD, 0x0, 0x18, 0xdf, 0, 0,
 
 	12,
 	// fcvtmu Hd, Hn
-	2, MatH, MatH, 0xf, 0xb9,
+	2, MatH, MatH, 0x0, 0x18, 0xe6, byte(FeatFP16), 0,
 	// fcvtmu Sd, Sn
-	2, MatS, MatS, 0xf, 0xc0,
+	2, MatS, MatS, 0x0, 0x18, 0xed, 0, 0,
 	// fcvtmu Dd, Dn
-	2, MatD, MatD, 0xf, 0xc7,
+	2, MatD, MatD, 0x0, 0x18, 0xf4, 0, 0,
 	// fcvtmu Vd.8H, Vn.8H
 	// fcvtmu Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0xf, 0xce,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x18, 0xfb, byte(FeatFP16), 0,
 	// fcvtmu Vd.4S, Vn.4S
 	// fcvtmu Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0xf, 0xd6,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x19, 0x3, 0, 0,
 	// fcvtmu Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0xf, 0xde,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x19, 0xb, 0, 0,
 	// fcvtmu Wd, Hn
-	2, MatW, MatH, 0xf, 0xe6,
+	2, MatW, MatH, 0x0, 0x19, 0x13, byte(FeatFP16), 0,
 	// fcvtmu Xd, Hn
-	2, MatX, MatH, 0xf, 0xed,
+	2, MatX, MatH, 0x0, 0x19, 0x1a, byte(FeatFP16), 0,
 	// fcvtmu Wd, Sn
-	2, MatW, MatS, 0xf, 0xf4,
+	2, MatW, MatS, 0x0, 0x19, 0x21, 0, 0,
 	// fcvtmu Xd, Sn
-	2, MatX, MatS, 0xf, 0xfb,
+	2, MatX, MatS, 0x0, 0x19, 0x28, 0, 0,
 	// fcvtmu Wd, Dn
-	2, MatW, MatD, 0x10, 0x2,
+	2, MatW, MatD, 0x0, 0x19, 0x2f, 0, 0,
 	// fcvtmu Xd, Dn
-	2, MatX, MatD, 0x10, 0x9,
+	2, MatX, MatD, 0x0, 0x19, 0x36, 0, 0,
 
 	1,
 	// fcvtn Vd.2S, Vn.2D
-	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x10, 0x10,
+	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x19, 0x3d, 0, 0,
 
 	1,
 	// fcvtn2 Vd.4S, Vn.2D
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x10, 0x17,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x0, 0x19, 0x44, 0, 0,
 
 	12,
 	// fcvtns Hd, Hn
-	2, MatH, MatH, 0x10, 0x1e,
+	2, MatH, MatH, 0x0, 0x19, 0x4b, byte(FeatFP16), 0,
 	// fcvtns Sd, Sn
-	2, MatS, MatS, 0x10, 0x25,
+	2, MatS, MatS, 0x0, 0x19, 0x52, 0, 0,
 	// fcvtns Dd, Dn
-	2, MatD, MatD, 0x10, 0x2c,
+	2, MatD, MatD, 0x0, 0x19, 0x59, 0, 0,
 	// fcvtns Vd.8H, Vn.8H
 	// fcvtns Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x10, 0x33,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x19, 0x60, byte(FeatFP16), 0,
 	// fcvtns Vd.4S, Vn.4S
 	// fcvtns Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x10, 0x3b,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x19, 0x68, 0, 0,
 	// fcvtns Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x10, 0x43,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x19, 0x70, 0, 0,
 	// fcvtns Wd, Hn
-	2, MatW, MatH, 0x10, 0x4b,
+	2, MatW, MatH, 0x0, 0x19, 0x78, byte(FeatFP16), 0,
 	// fcvtns Xd, Hn
-	2, MatX, MatH, 0x10, 0x52,
+	2, MatX, MatH, 0x0, 0x19, 0x7f, byte(FeatFP16), 0,
 	// fcvtns Wd, Sn
-	2, MatW, MatS, 0x10, 0x59,
+	2, MatW, MatS, 0x0, 0x19, 0x86, 0, 0,
 	// fcvtns Xd, Sn
-	2, MatX, MatS, 0x10, 0x60,
+	2, MatX, MatS, 0x0, 0x19, 0x8d, 0, 0,
 	// fcvtns Wd, Dn
-	2, MatW, MatD, 0x10, 0x67,
+	2, MatW, MatD, 0x0, 0x19, 0x94, 0, 0,
 	// fcvtns Xd, Dn
-	2, MatX, MatD, 0x10, 0x6e,
+	2, MatX, MatD, 0x0, 0x19, 0x9b, 0, 0,
 
 	12,
 	// fcvtnu Hd, Hn
-	2, MatH, MatH, 0x10, 0x75,
+	2, MatH, MatH, 0x0, 0x19, 0xa2, byte(FeatFP16), 0,
 	// fcvtnu Sd, Sn
-	2, MatS, MatS, 0x10, 0x7c,
+	2, MatS, MatS, 0x0, 0x19, 0xa9, 0, 0,
 	// fcvtnu Dd, Dn
-	2, MatD, MatD, 0x10, 0x83,
+	2, MatD, MatD, 0x0, 0x19, 0xb0, 0, 0,
 	// fcvtnu Vd.8H, Vn.8H
 	// fcvtnu Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x10, 0x8a,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x19, 0xb7, byte(FeatFP16), 0,
 	// fcvtnu Vd.4S, Vn.4S
 	// fcvtnu Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x10, 0x92,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x19, 0xbf, 0, 0,
 	// fcvtnu Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x10, 0x9a,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x19, 0xc7, 0, 0,
 	// fcvtnu Wd, Hn
-	2, MatW, MatH, 0x10, 0xa2,
+	2, MatW, MatH, 0x0, 0x19, 0xcf, byte(FeatFP16), 0,
 	// fcvtnu Xd, Hn
-	2, MatX, MatH, 0x10, 0xa9,
+	2, MatX, MatH, 0x0, 0x19, 0xd6, byte(FeatFP16), 0,
 	// fcvtnu Wd, Sn
-	2, MatW, MatS, 0x10, 0xb0,
+	2, MatW, MatS, 0x0, 0x19, 0xdd, 0, 0,
 	// fcvtnu Xd, Sn
-	2, MatX, MatS, 0x10, 0xb7,
+	2, MatX, MatS, 0x0, 0x19, 0xe4, 0, 0,
 	// fcvtnu Wd, Dn
-	2, MatW, MatD, 0x10, 0xbe,
+	2, MatW, MatD, 0x0, 0x19, 0xeb, 0, 0,
 	// fcvtnu Xd, Dn
-	2, MatX, MatD, 0x10, 0xc5,
+	2, MatX, MatD, 0x0, 0x19, 0xf2, 0, 0,
 
 	12,
 	// fcvtps Hd, Hn
-	2, MatH, MatH, 0x10, 0xcc,
+	2, MatH, MatH, 0x0, 0x19, 0xf9, byte(FeatFP16), 0,
 	// fcvtps Sd, Sn
-	2, MatS, MatS, 0x10, 0xd3,
+	2, MatS, MatS, 0x0, 0x1a, 0x0, 0, 0,
 	// fcvtps Dd, Dn
-	2, MatD, MatD, 0x10, 0xda,
+	2, MatD, MatD, 0x0, 0x1a, 0x7, 0, 0,
 	// fcvtps Vd.8H, Vn.8H
 	// fcvtps Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x10, 0xe1,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1a, 0xe, byte(FeatFP16), 0,
 	// fcvtps Vd.4S, Vn.4S
 	// fcvtps Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x10, 0xe9,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1a, 0x16, 0, 0,
 	// fcvtps Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x10, 0xf1,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1a, 0x1e, 0, 0,
 	// fcvtps Wd, Hn
-	2, MatW, MatH, 0x10, 0xf9,
+	2, MatW, MatH, 0x0, 0x1a, 0x26, byte(FeatFP16), 0,
 	// fcvtps Xd, Hn
-	2, MatX, MatH, 0x11, 0x0,
+	2, MatX, MatH, 0x0, 0x1a, 0x2d, byte(FeatFP16), 0,
 	// fcvtps Wd, Sn
-	2, MatW, MatS, 0x11, 0x7,
+	2, MatW, MatS, 0x0, 0x1a, 0x34, 0, 0,
 	// fcvtps Xd, Sn
-	2, MatX, MatS, 0x11, 0xe,
+	2, MatX, MatS, 0x0, 0x1a, 0x3b, 0, 0,
 	// fcvtps Wd, Dn
-	2, MatW, MatD, 0x11, 0x15,
+	2, MatW, MatD, 0x0, 0x1a, 0x42, 0, 0,
 	// fcvtps Xd, Dn
-	2, MatX, MatD, 0x11, 0x1c,
+	2, MatX, MatD, 0x0, 0x1a, 0x49, 0, 0,
 
 	12,
 	// fcvtpu Hd, Hn
-	2, MatH, MatH, 0x11, 0x23,
+	2, MatH, MatH, 0x0, 0x1a, 0x50, byte(FeatFP16), 0,
 	// fcvtpu Sd, Sn
-	2, MatS, MatS, 0x11, 0x2a,
+	2, MatS, MatS, 0x0, 0x1a, 0x57, 0, 0,
 	// fcvtpu Dd, Dn
-	2, MatD, MatD, 0x11, 0x31,
+	2, MatD, MatD, 0x0, 0x1a, 0x5e, 0, 0,
 	// fcvtpu Vd.8H, Vn.8H
 	// fcvtpu Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x11, 0x38,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1a, 0x65, byte(FeatFP16), 0,
 	// fcvtpu Vd.4S, Vn.4S
 	// fcvtpu Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x11, 0x40,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1a, 0x6d, 0, 0,
 	// fcvtpu Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x11, 0x48,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1a, 0x75, 0, 0,
 	// fcvtpu Wd, Hn
-	2, MatW, MatH, 0x11, 0x50,
+	2, MatW, MatH, 0x0, 0x1a, 0x7d, byte(FeatFP16), 0,
 	// fcvtpu Xd, Hn
-	2, MatX, MatH, 0x11, 0x57,
+	2, MatX, MatH, 0x0, 0x1a, 0x84, byte(FeatFP16), 0,
 	// fcvtpu Wd, Sn
-	2, MatW, MatS, 0x11, 0x5e,
+	2, MatW, MatS, 0x0, 0x1a, 0x8b, 0, 0,
 	// fcvtpu Xd, Sn
-	2, MatX, MatS, 0x11, 0x65,
+	2, MatX, MatS, 0x0, 0x1a, 0x92, 0, 0,
 	// fcvtpu Wd, Dn
-	2, MatW, MatD, 0x11, 0x6c,
+	2, MatW, MatD, 0x0, 0x1a, 0x99, 0, 0,
 	// fcvtpu Xd, Dn
-	2, MatX, MatD, 0x11, 0x73,
+	2, MatX, MatD, 0x0, 0x1a, 0xa0, 0, 0,
 
 	2,
 	// fcvtxn Sd, Dn
-	2, MatS, MatD, 0x11, 0x7a,
+	2, MatS, MatD, 0x0, 0x1a, 0xa7, 0, 0,
 	// fcvtxn Vd.2S, Vn.2D
-	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x11, 0x81,
+	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1a, 0xae, 0, 0,
 
 	1,
 	// fcvtxn2 Vd.4S, Vn.2D
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x11, 0x88,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x0, 0x1a, 0xb5, 0, 0,
 
-	24,
+	27,
 	// fcvtzs Hd, Hn, #imm  ··························································  (0 < imm <= 16)
-	3, MatH, MatH, MatImm, 0x11, 0x8f,
+	3, MatH, MatH, MatImm, 0x0, 0x1a, 0xbc, byte(FeatFP16), 0,
 	// fcvtzs Sd, Sn, #imm  ··························································  (0 < imm <= 32)
-	3, MatS, MatS, MatImm, 0x11, 0x9c,
+	3, MatS, MatS, MatImm, 0x0, 0x1a, 0xc9, 0, 0,
 	// fcvtzs Dd, Dn, #imm  ··························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x11, 0xa9,
+	3, MatD, MatD, MatImm, 0x0, 0x1a, 0xd6, 0, 0,
 	// fcvtzs Vd.8H, Vn.8H, #imm  ····················································  (0 < imm <= 16)
 	// fcvtzs Vd.4H, Vn.4H, #imm  ····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x11, 0xb6,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x1a, 0xe3, byte(FeatFP16), 0,
 	// fcvtzs Vd.4S, Vn.4S, #imm  ····················································  (0 < imm <= 32)
 	// fcvtzs Vd.2S, Vn.2S, #imm  ····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x11, 0xc2,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x1a, 0xef, 0, 0,
 	// fcvtzs Vd.2D, Vn.2D, #imm  ····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x11, 0xce,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x1a, 0xfb, 0, 0,
 	// fcvtzs Hd, Hn
-	2, MatH, MatH, 0x11, 0xda,
+	2, MatH, MatH, 0x0, 0x1b, 0x7, byte(FeatFP16), 0,
 	// fcvtzs Sd, Sn
-	2, MatS, MatS, 0x11, 0xe1,
+	2, MatS, MatS, 0x0, 0x1b, 0xe, 0, 0,
 	// fcvtzs Dd, Dn
-	2, MatD, MatD, 0x11, 0xe8,
+	2, MatD, MatD, 0x0, 0x1b, 0x15, 0, 0,
 	// fcvtzs Vd.8H, Vn.8H
 	// fcvtzs Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x11, 0xef,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1b, 0x1c, byte(FeatFP16), 0,
 	// fcvtzs Vd.4S, Vn.4S
 	// fcvtzs Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x11, 0xf7,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1b, 0x24, 0, 0,
 	// fcvtzs Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x11, 0xff,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1b, 0x2c, 0, 0,
 	// fcvtzs Wd, Hn, #imm  ··························································  (0 < imm <= 32)
-	3, MatW, MatH, MatImm, 0x12, 0x7,
+	3, MatW, MatH, MatImm, 0x0, 0x1b, 0x34, byte(FeatFP16), 0,
 	// fcvtzs Xd, Hn, #imm  ··························································  (0 < imm <= 64)
-	3, MatX, MatH, MatImm, 0x12, 0x14,
+	3, MatX, MatH, MatImm, 0x0, 0x1b, 0x41, byte(FeatFP16), 0,
 	// fcvtzs Wd, Sn, #imm  ··························································  (0 < imm <= 32)
-	3, MatW, MatS, MatImm, 0x12, 0x1f,
+	3, MatW, MatS, MatImm, 0x0, 0x1b, 0x4c, 0, 0,
 	// fcvtzs Xd, Sn, #imm  ··························································  (0 < imm <= 64)
-	3, MatX, MatS, MatImm, 0x12, 0x2c,
+	3, MatX, MatS, MatImm, 0x0, 0x1b, 0x59, 0, 0,
 	// fcvtzs Wd, Dn, #imm  ··························································  (0 < imm <= 32)
-	3, MatW, MatD, MatImm, 0x12, 0x37,
+	3, MatW, MatD, MatImm, 0x0, 0x1b, 0x64, 0, 0,
 	// fcvtzs Xd, Dn, #imm  ··························································  (0 < imm <= 64)
-	3, MatX, MatD, MatImm, 0x12, 0x44,
+	3, MatX, MatD, MatImm, 0x0, 0x1b, 0x71, 0, 0,
 	// fcvtzs Wd, Hn
-	2, MatW, MatH, 0x12, 0x4f,
+	2, MatW, MatH, 0x0, 0x1b, 0x7c, byte(FeatFP16), 0,
 	// fcvtzs Xd, Hn
-	2, MatX, MatH, 0x12, 0x56,
+	2, MatX, MatH, 0x0, 0x1b, 0x83, byte(FeatFP16), 0,
 	// fcvtzs Wd, Sn
-	2, MatW, MatS, 0x12, 0x5d,
+	2, MatW, MatS, 0x0, 0x1b, 0x8a, 0, 0,
 	// fcvtzs Xd, Sn
-	2, MatX, MatS, 0x12, 0x64,
+	2, MatX, MatS, 0x0, 0x1b, 0x91, 0, 0,
 	// fcvtzs Wd, Dn
-	2, MatW, MatD, 0x12, 0x6b,
+	2, MatW, MatD, 0x0, 0x1b, 0x98, 0, 0,
 	// fcvtzs Xd, Dn
-	2, MatX, MatD, 0x12, 0x72,
+	2, MatX, MatD, 0x0, 0x1b, 0x9f, 0, 0,
+	// fcvtzs Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x1b, 0xa6, byte(FeatSVE), 0,
+	// fcvtzs Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x1b, 0xaf, byte(FeatSVE), 0,
+	// fcvtzs Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x1b, 0xb8, byte(FeatSVE), 0,
 
-	24,
+	27,
 	// fcvtzu Hd, Hn, #imm  ··························································  (0 < imm <= 16)
-	3, MatH, MatH, MatImm, 0x12, 0x79,
+	3, MatH, MatH, MatImm, 0x0, 0x1b, 0xc1, byte(FeatFP16), 0,
 	// fcvtzu Sd, Sn, #imm  ··························································  (0 < imm <= 32)
-	3, MatS, MatS, MatImm, 0x12, 0x86,
+	3, MatS, MatS, MatImm, 0x0, 0x1b, 0xce, 0, 0,
 	// fcvtzu Dd, Dn, #imm  ··························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x12, 0x93,
+	3, MatD, MatD, MatImm, 0x0, 0x1b, 0xdb, 0, 0,
 	// fcvtzu Vd.8H, Vn.8H, #imm  ····················································  (0 < imm <= 16)
 	// fcvtzu Vd.4H, Vn.4H, #imm  ····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x12, 0xa0,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x1b, 0xe8, byte(FeatFP16), 0,
 	// fcvtzu Vd.4S, Vn.4S, #imm  ····················································  (0 < imm <= 32)
 	// fcvtzu Vd.2S, Vn.2S, #imm  ····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x12, 0xac,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x1b, 0xf4, 0, 0,
 	// fcvtzu Vd.2D, Vn.2D, #imm  ····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x12, 0xb8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x1c, 0x0, 0, 0,
 	// fcvtzu Hd, Hn
-	2, MatH, MatH, 0x12, 0xc4,
+	2, MatH, MatH, 0x0, 0x1c, 0xc, byte(FeatFP16), 0,
 	// fcvtzu Sd, Sn
-	2, MatS, MatS, 0x12, 0xcb,
+	2, MatS, MatS, 0x0, 0x1c, 0x13, 0, 0,
 	// fcvtzu Dd, Dn
-	2, MatD, MatD, 0x12, 0xd2,
+	2, MatD, MatD, 0x0, 0x1c, 0x1a, 0, 0,
 	// fcvtzu Vd.8H, Vn.8H
 	// fcvtzu Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x12, 0xd9,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1c, 0x21, byte(FeatFP16), 0,
 	// fcvtzu Vd.4S, Vn.4S
 	// fcvtzu Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x12, 0xe1,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1c, 0x29, 0, 0,
 	// fcvtzu Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x12, 0xe9,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1c, 0x31, 0, 0,
 	// fcvtzu Wd, Hn, #imm  ··························································  (0 < imm <= 32)
-	3, MatW, MatH, MatImm, 0x12, 0xf1,
+	3, MatW, MatH, MatImm, 0x0, 0x1c, 0x39, byte(FeatFP16), 0,
 	// fcvtzu Xd, Hn, #imm  ··························································  (0 < imm <= 64)
-	3, MatX, MatH, MatImm, 0x12, 0xfe,
+	3, MatX, MatH, MatImm, 0x0, 0x1c, 0x46, byte(FeatFP16), 0,
 	// fcvtzu Wd, Sn, #imm  ··························································  (0 < imm <= 32)
-	3, MatW, MatS, MatImm, 0x13, 0x9,
+	3, MatW, MatS, MatImm, 0x0, 0x1c, 0x51, 0, 0,
 	// fcvtzu Xd, Sn, #imm  ··························································  (0 < imm <= 64)
-	3, MatX, MatS, MatImm, 0x13, 0x16,
+	3, MatX, MatS, MatImm, 0x0, 0x1c, 0x5e, 0, 0,
 	// fcvtzu Wd, Dn, #imm  ··························································  (0 < imm <= 32)
-	3, MatW, MatD, MatImm, 0x13, 0x21,
+	3, MatW, MatD, MatImm, 0x0, 0x1c, 0x69, 0, 0,
 	// fcvtzu Xd, Dn, #imm  ··························································  (0 < imm <= 64)
-	3, MatX, MatD, MatImm, 0x13, 0x2e,
+	3, MatX, MatD, MatImm, 0x0, 0x1c, 0x76, 0, 0,
 	// fcvtzu Wd, Hn
-	2, MatW, MatH, 0x13, 0x39,
+	2, MatW, MatH, 0x0, 0x1c, 0x81, byte(FeatFP16), 0,
 	// fcvtzu Xd, Hn
-	2, MatX, MatH, 0x13, 0x40,
+	2, MatX, MatH, 0x0, 0x1c, 0x88, byte(FeatFP16), 0,
 	// fcvtzu Wd, Sn
-	2, MatW, MatS, 0x13, 0x47,
+	2, MatW, MatS, 0x0, 0x1c, 0x8f, 0, 0,
 	// fcvtzu Xd, Sn
-	2, MatX, MatS, 0x13, 0x4e,
+	2, MatX, MatS, 0x0, 0x1c, 0x96, 0, 0,
 	// fcvtzu Wd, Dn
-	2, MatW, MatD, 0x13, 0x55,
+	2, MatW, MatD, 0x0, 0x1c, 0x9d, 0, 0,
 	// fcvtzu Xd, Dn
-	2, MatX, MatD, 0x13, 0x5c,
+	2, MatX, MatD, 0x0, 0x1c, 0xa4, 0, 0,
+	// fcvtzu Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x1c, 0xab, byte(FeatSVE), 0,
+	// fcvtzu Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x1c, 0xb4, byte(FeatSVE), 0,
+	// fcvtzu Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x1c, 0xbd, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// fdiv Vd.8H, Vn.8H, Vm.8H
 	// fdiv Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x13, 0x63,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1c, 0xc6, byte(FeatFP16), 0,
 	// fdiv Vd.4S, Vn.4S, Vm.4S
 	// fdiv Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x13, 0x6c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1c, 0xcf, 0, 0,
 	// fdiv Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x13, 0x75,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1c, 0xd8, 0, 0,
 	// fdiv Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x13, 0x7e,
+	3, MatH, MatH, MatH, 0x0, 0x1c, 0xe1, byte(FeatFP16), 0,
 	// fdiv Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x13, 0x86,
+	3, MatS, MatS, MatS, 0x0, 0x1c, 0xe9, 0, 0,
 	// fdiv Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x13, 0x8e,
+	3, MatD, MatD, MatD, 0x0, 0x1c, 0xf1, 0, 0,
+	// fdiv Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1c, 0xf9, byte(FeatSVE), 0,
+	// fdiv Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1d, 0x4, byte(FeatSVE), 0,
+	// fdiv Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1d, 0xf, byte(FeatSVE), 0,
+
+	3,
+	// fdivr Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1d, 0x1a, byte(FeatSVE), 0,
+	// fdivr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1d, 0x25, byte(FeatSVE), 0,
+	// fdivr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1d, 0x30, byte(FeatSVE), 0,
+
+	3,
+	// fdup Zd.H, #imm  ·······························································  (imm is float)
+	2, MatZ, byte(WORD), MatFloat, 0x0, 0x1d, 0x3b, byte(FeatSVE), 0,
+	// fdup Zd.S, #imm  ·······························································  (imm is float)
+	2, MatZ, byte(DWORD), MatFloat, 0x0, 0x1d, 0x44, byte(FeatSVE), 0,
+	// fdup Zd.D, #imm  ·······························································  (imm is float)
+	2, MatZ, byte(QWORD), MatFloat, 0x0, 0x1d, 0x4d, byte(FeatSVE), 0,
 
 	1,
 	// fjcvtzs Wd, Dn
-	2, MatW, MatD, 0x13, 0x96,
+	2, MatW, MatD, 0x0, 0x1d, 0x56, byte(FeatJSCVT), 0,
 
 	3,
 	// fmadd Hd, Hn, Hm, Ha
-	4, MatH, MatH, MatH, MatH, 0x13, 0x9d,
+	4, MatH, MatH, MatH, MatH, 0x0, 0x1d, 0x5d, byte(FeatFP16), 0,
 	// fmadd Sd, Sn, Sm, Sa
-	4, MatS, MatS, MatS, MatS, 0x13, 0xa6,
+	4, MatS, MatS, MatS, MatS, 0x0, 0x1d, 0x66, 0, 0,
 	// fmadd Dd, Dn, Dm, Da
-	4, MatD, MatD, MatD, MatD, 0x13, 0xaf,
+	4, MatD, MatD, MatD, MatD, 0x0, 0x1d, 0x6f, 0, 0,
 
-	6,
+	9,
 	// fmax Vd.8H, Vn.8H, Vm.8H
 	// fmax Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x13, 0xb8,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1d, 0x78, byte(FeatFP16), 0,
 	// fmax Vd.4S, Vn.4S, Vm.4S
 	// fmax Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x13, 0xc1,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1d, 0x81, 0, 0,
 	// fmax Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x13, 0xca,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1d, 0x8a, 0, 0,
 	// fmax Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x13, 0xd3,
+	3, MatH, MatH, MatH, 0x0, 0x1d, 0x93, byte(FeatFP16), 0,
 	// fmax Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x13, 0xdb,
+	3, MatS, MatS, MatS, 0x0, 0x1d, 0x9b, 0, 0,
 	// fmax Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x13, 0xe3,
+	3, MatD, MatD, MatD, 0x0, 0x1d, 0xa3, 0, 0,
+	// fmax Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1d, 0xab, byte(FeatSVE), 0,
+	// fmax Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1d, 0xb6, byte(FeatSVE), 0,
+	// fmax Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1d, 0xc1, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// fmaxnm Vd.8H, Vn.8H, Vm.8H
 	// fmaxnm Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x13, 0xeb,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1d, 0xcc, byte(FeatFP16), 0,
 	// fmaxnm Vd.4S, Vn.4S, Vm.4S
 	// fmaxnm Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x13, 0xf4,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1d, 0xd5, 0, 0,
 	// fmaxnm Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x13, 0xfd,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1d, 0xde, 0, 0,
 	// fmaxnm Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x14, 0x6,
+	3, MatH, MatH, MatH, 0x0, 0x1d, 0xe7, byte(FeatFP16), 0,
 	// fmaxnm Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x14, 0xe,
+	3, MatS, MatS, MatS, 0x0, 0x1d, 0xef, 0, 0,
 	// fmaxnm Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x14, 0x16,
+	3, MatD, MatD, MatD, 0x0, 0x1d, 0xf7, 0, 0,
+	// fmaxnm Zd.H, Pg/M, Zn.H, Zm.H  ················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1d, 0xff, byte(FeatSVE), 0,
+	// fmaxnm Zd.S, Pg/M, Zn.S, Zm.S  ················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1e, 0xa, byte(FeatSVE), 0,
+	// fmaxnm Zd.D, Pg/M, Zn.D, Zm.D  ················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1e, 0x15, byte(FeatSVE), 0,
 
 	6,
 	// fmaxnmp Hd, Vn.2H
-	2, MatH, MatVStatic, byte(WORD), 2, 0x14, 0x1e,
+	2, MatH, MatVStatic, byte(WORD), 2, 0x0, 0x1e, 0x20, byte(FeatFP16), 0,
 	// fmaxnmp Sd, Vn.2S
-	2, MatS, MatVStatic, byte(DWORD), 2, 0x14, 0x25,
+	2, MatS, MatVStatic, byte(DWORD), 2, 0x0, 0x1e, 0x27, 0, 0,
 	// fmaxnmp Dd, Vn.2D
-	2, MatD, MatVStatic, byte(QWORD), 2, 0x14, 0x2c,
+	2, MatD, MatVStatic, byte(QWORD), 2, 0x0, 0x1e, 0x2e, 0, 0,
 	// fmaxnmp Vd.8H, Vn.8H, Vm.8H
 	// fmaxnmp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x14, 0x33,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1e, 0x35, byte(FeatFP16), 0,
 	// fmaxnmp Vd.4S, Vn.4S, Vm.4S
 	// fmaxnmp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x14, 0x3c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1e, 0x3e, 0, 0,
 	// fmaxnmp Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x14, 0x45,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1e, 0x47, 0, 0,
 
-	2,
+	5,
 	// fmaxnmv Hd, Vn.8H
 	// fmaxnmv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x14, 0x4e,
+	2, MatH, MatV, byte(WORD), 0x0, 0x1e, 0x50, byte(FeatFP16), 0,
 	// fmaxnmv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x14, 0x56,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x1e, 0x58, 0, 0,
+	// fmaxnmv Hd, Pg, Zn.H  ·································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x1e, 0x60, byte(FeatSVE), 0,
+	// fmaxnmv Sd, Pg, Zn.S  ·································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x1e, 0x69, byte(FeatSVE), 0,
+	// fmaxnmv Dd, Pg, Zn.D  ·································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x1e, 0x72, byte(FeatSVE), 0,
 
 	6,
 	// fmaxp Hd, Vn.2H
-	2, MatH, MatVStatic, byte(WORD), 2, 0x14, 0x5e,
+	2, MatH, MatVStatic, byte(WORD), 2, 0x0, 0x1e, 0x7b, byte(FeatFP16), 0,
 	// fmaxp Sd, Vn.2S
-	2, MatS, MatVStatic, byte(DWORD), 2, 0x14, 0x65,
+	2, MatS, MatVStatic, byte(DWORD), 2, 0x0, 0x1e, 0x82, 0, 0,
 	// fmaxp Dd, Vn.2D
-	2, MatD, MatVStatic, byte(QWORD), 2, 0x14, 0x6c,
+	2, MatD, MatVStatic, byte(QWORD), 2, 0x0, 0x1e, 0x89, 0, 0,
 	// fmaxp Vd.8H, Vn.8H, Vm.8H
 	// fmaxp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x14, 0x73,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1e, 0x90, byte(FeatFP16), 0,
 	// fmaxp Vd.4S, Vn.4S, Vm.4S
 	// fmaxp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x14, 0x7c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1e, 0x99, 0, 0,
 	// fmaxp Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x14, 0x85,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1e, 0xa2, 0, 0,
 
-	2,
+	5,
 	// fmaxv Hd, Vn.8H
 	// fmaxv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x14, 0x8e,
+	2, MatH, MatV, byte(WORD), 0x0, 0x1e, 0xab, byte(FeatFP16), 0,
 	// fmaxv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x14, 0x96,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x1e, 0xb3, 0, 0,
+	// fmaxv Hd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x1e, 0xbb, byte(FeatSVE), 0,
+	// fmaxv Sd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x1e, 0xc4, byte(FeatSVE), 0,
+	// fmaxv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x1e, 0xcd, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// fmin Vd.8H, Vn.8H, Vm.8H
 	// fmin Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x14, 0x9e,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1e, 0xd6, byte(FeatFP16), 0,
 	// fmin Vd.4S, Vn.4S, Vm.4S
 	// fmin Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x14, 0xa7,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1e, 0xdf, 0, 0,
 	// fmin Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x14, 0xb0,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1e, 0xe8, 0, 0,
 	// fmin Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x14, 0xb9,
+	3, MatH, MatH, MatH, 0x0, 0x1e, 0xf1, byte(FeatFP16), 0,
 	// fmin Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x14, 0xc1,
+	3, MatS, MatS, MatS, 0x0, 0x1e, 0xf9, 0, 0,
 	// fmin Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x14, 0xc9,
+	3, MatD, MatD, MatD, 0x0, 0x1f, 0x1, 0, 0,
+	// fmin Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1f, 0x9, byte(FeatSVE), 0,
+	// fmin Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1f, 0x14, byte(FeatSVE), 0,
+	// fmin Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1f, 0x1f, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// fminnm Vd.8H, Vn.8H, Vm.8H
 	// fminnm Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x14, 0xd1,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1f, 0x2a, byte(FeatFP16), 0,
 	// fminnm Vd.4S, Vn.4S, Vm.4S
 	// fminnm Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x14, 0xda,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1f, 0x33, 0, 0,
 	// fminnm Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x14, 0xe3,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1f, 0x3c, 0, 0,
 	// fminnm Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x14, 0xec,
+	3, MatH, MatH, MatH, 0x0, 0x1f, 0x45, byte(FeatFP16), 0,
 	// fminnm Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x14, 0xf4,
+	3, MatS, MatS, MatS, 0x0, 0x1f, 0x4d, 0, 0,
 	// fminnm Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x14, 0xfc,
+	3, MatD, MatD, MatD, 0x0, 0x1f, 0x55, 0, 0,
+	// fminnm Zd.H, Pg/M, Zn.H, Zm.H  ················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x1f, 0x5d, byte(FeatSVE), 0,
+	// fminnm Zd.S, Pg/M, Zn.S, Zm.S  ················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x1f, 0x68, byte(FeatSVE), 0,
+	// fminnm Zd.D, Pg/M, Zn.D, Zm.D  ················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x1f, 0x73, byte(FeatSVE), 0,
 
 	6,
 	// fminnmp Hd, Vn.2H
-	2, MatH, MatVStatic, byte(WORD), 2, 0x15, 0x4,
+	2, MatH, MatVStatic, byte(WORD), 2, 0x0, 0x1f, 0x7e, byte(FeatFP16), 0,
 	// fminnmp Sd, Vn.2S
-	2, MatS, MatVStatic, byte(DWORD), 2, 0x15, 0xb,
+	2, MatS, MatVStatic, byte(DWORD), 2, 0x0, 0x1f, 0x85, 0, 0,
 	// fminnmp Dd, Vn.2D
-	2, MatD, MatVStatic, byte(QWORD), 2, 0x15, 0x12,
+	2, MatD, MatVStatic, byte(QWORD), 2, 0x0, 0x1f, 0x8c, 0, 0,
 	// fminnmp Vd.8H, Vn.8H, Vm.8H
 	// fminnmp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x15, 0x19,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1f, 0x93, byte(FeatFP16), 0,
 	// fminnmp Vd.4S, Vn.4S, Vm.4S
 	// fminnmp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x15, 0x22,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1f, 0x9c, 0, 0,
 	// fminnmp Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x15, 0x2b,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x1f, 0xa5, 0, 0,
 
-	2,
+	5,
 	// fminnmv Hd, Vn.8H
 	// fminnmv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x15, 0x34,
+	2, MatH, MatV, byte(WORD), 0x0, 0x1f, 0xae, byte(FeatFP16), 0,
 	// fminnmv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x15, 0x3c,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x1f, 0xb6, 0, 0,
+	// fminnmv Hd, Pg, Zn.H  ·································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x1f, 0xbe, byte(FeatSVE), 0,
+	// fminnmv Sd, Pg, Zn.S  ·································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x1f, 0xc7, byte(FeatSVE), 0,
+	// fminnmv Dd, Pg, Zn.D  ·································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x1f, 0xd0, byte(FeatSVE), 0,
 
 	6,
 	// fminp Hd, Vn.2H
-	2, MatH, MatVStatic, byte(WORD), 2, 0x15, 0x44,
+	2, MatH, MatVStatic, byte(WORD), 2, 0x0, 0x1f, 0xd9, byte(FeatFP16), 0,
 	// fminp Sd, Vn.2S
-	2, MatS, MatVStatic, byte(DWORD), 2, 0x15, 0x4b,
+	2, MatS, MatVStatic, byte(DWORD), 2, 0x0, 0x1f, 0xe0, 0, 0,
 	// fminp Dd, Vn.2D
-	2, MatD, MatVStatic, byte(QWORD), 2, 0x15, 0x52,
+	2, MatD, MatVStatic, byte(QWORD), 2, 0x0, 0x1f, 0xe7, 0, 0,
 	// fminp Vd.8H, Vn.8H, Vm.8H
 	// fminp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x15, 0x59,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x1f, 0xee, byte(FeatFP16), 0,
 	// fminp Vd.4S, Vn.4S, Vm.4S
 	// fminp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x15, 0x62,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x1f, 0xf7, 0, 0,
 	// fminp Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x15, 0x6b,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x20, 0x0, 0, 0,
 
-	2,
+	5,
 	// fminv Hd, Vn.8H
 	// fminv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x15, 0x74,
+	2, MatH, MatV, byte(WORD), 0x0, 0x20, 0x9, byte(FeatFP16), 0,
 	// fminv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x15, 0x7c,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x20, 0x11, 0, 0,
+	// fminv Hd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x20, 0x19, byte(FeatSVE), 0,
+	// fminv Sd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x20, 0x22, byte(FeatSVE), 0,
+	// fminv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x20, 0x2b, byte(FeatSVE), 0,
 
-	9,
+	15,
 	// fmla Hd, Hn, Vm.H[i]  ································································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x15, 0x84,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x20, 0x34, byte(FeatFP16), 0,
 	// fmla Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x15, 0x8e,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x20, 0x3e, 0, 0,
 	// fmla Dd, Dn, Vm.D[i]
-	3, MatD, MatD, MatVElement, byte(QWORD), 0x15, 0x98,
+	3, MatD, MatD, MatVElement, byte(QWORD), 0x0, 0x20, 0x48, 0, 0,
 	// fmla Vd.8H, Vn.8H, Vm.H[i]  ··························································  (m < 16)
 	// fmla Vd.4H, Vn.4H, Vm.H[i]  ··························································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x15, 0xa2,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x20, 0x52, byte(FeatFP16), 0,
 	// fmla Vd.4S, Vn.4S, Vm.S[i]
 	// fmla Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x15, 0xad,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x20, 0x5d, 0, 0,
 	// fmla Vd.2D, Vn.2D, Vm.D[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x15, 0xb8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x0, 0x20, 0x68, 0, 0,
 	// fmla Vd.8H, Vn.8H, Vm.8H
 	// fmla Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x15, 0xc3,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x20, 0x73, byte(FeatFP16), 0,
 	// fmla Vd.4S, Vn.4S, Vm.4S
 	// fmla Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x15, 0xcc,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x20, 0x7c, 0, 0,
 	// fmla Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x15, 0xd5,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x20, 0x85, 0, 0,
+	// fmla Zd.H, Pg/M, Zn.H, Zm.H  ··························································  (g < 8)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x20, 0x8e, byte(FeatSVE), 0,
+	// fmla Zd.S, Pg/M, Zn.S, Zm.S  ··························································  (g < 8)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x20, 0x98, byte(FeatSVE), 0,
+	// fmla Zd.D, Pg/M, Zn.D, Zm.D  ··························································  (g < 8)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x20, 0xa2, byte(FeatSVE), 0,
+	// fmla Zd.H, Zn.H, Zm.H[i]  ·····························································  (m < 8)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZElement, byte(WORD), 0x0, 0x20, 0xac, byte(FeatSVE), 0,
+	// fmla Zd.S, Zn.S, Zm.S[i]  ·····························································  (m < 8)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZElement, byte(DWORD), 0x0, 0x20, 0xc0, byte(FeatSVE), 0,
+	// fmla Zd.D, Zn.D, Zm.D[i]  ····························································  (m < 16)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZElement, byte(QWORD), 0x0, 0x20, 0xcc, byte(FeatSVE), 0,
 
 	4,
 	// fmlal Vd.2S, Vn.2H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x15, 0xde,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x0, 0x20, 0xd7, byte(FeatFHM), 0,
 	// fmlal Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x15, 0xe8,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x20, 0xe1, byte(FeatFHM), 0,
 	// fmlal Vd.2S, Vn.2H, Vm.2H
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x15, 0xf2,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x0, 0x20, 0xeb, byte(FeatFHM), 0,
 	// fmlal Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x15, 0xfa,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x20, 0xf3, byte(FeatFHM), 0,
 
 	4,
 	// fmlal2 Vd.2S, Vn.2H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x16, 0x2,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x0, 0x20, 0xfb, byte(FeatFHM), 0,
 	// fmlal2 Vd.4S, Vn.4H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x16, 0xc,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x21, 0x5, byte(FeatFHM), 0,
 	// fmlal2 Vd.2S, Vn.2H, Vm.2H
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x16, 0x16,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x0, 0x21, 0xf, byte(FeatFHM), 0,
 	// fmlal2 Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x16, 0x1e,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x21, 0x17, byte(FeatFHM), 0,
 
-	9,
+	15,
 	// fmls Hd, Hn, Vm.H[i]  ································································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x16, 0x26,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x21, 0x1f, byte(FeatFP16), 0,
 	// fmls Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x16, 0x30,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x21, 0x29, 0, 0,
 	// fmls Dd, Dn, Vm.D[i]
-	3, MatD, MatD, MatVElement, byte(QWORD), 0x16, 0x3a,
+	3, MatD, MatD, MatVElement, byte(QWORD), 0x0, 0x21, 0x33, 0, 0,
 	// fmls Vd.8H, Vn.8H, Vm.H[i]  ··························································  (m < 16)
 	// fmls Vd.4H, Vn.4H, Vm.H[i]  ··························································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x16, 0x44,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x21, 0x3d, byte(FeatFP16), 0,
 	// fmls Vd.4S, Vn.4S, Vm.S[i]
 	// fmls Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x16, 0x4f,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x21, 0x48, 0, 0,
 	// fmls Vd.2D, Vn.2D, Vm.D[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x16, 0x5a,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x0, 0x21, 0x53, 0, 0,
 	// fmls Vd.8H, Vn.8H, Vm.8H
 	// fmls Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x16, 0x65,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x21, 0x5e, byte(FeatFP16), 0,
 	// fmls Vd.4S, Vn.4S, Vm.4S
 	// fmls Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x16, 0x6e,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x21, 0x67, 0, 0,
 	// fmls Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x16, 0x77,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x21, 0x70, 0, 0,
+	// fmls Zd.H, Pg/M, Zn.H, Zm.H  ··························································  (g < 8)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x21, 0x79, byte(FeatSVE), 0,
+	// fmls Zd.S, Pg/M, Zn.S, Zm.S  ··························································  (g < 8)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x21, 0x83, byte(FeatSVE), 0,
+	// fmls Zd.D, Pg/M, Zn.D, Zm.D  ··························································  (g < 8)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x21, 0x8d, byte(FeatSVE), 0,
+	// fmls Zd.H, Zn.H, Zm.H[i]  ·····························································  (m < 8)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZElement, byte(WORD), 0x0, 0x21, 0x97, byte(FeatSVE), 0,
+	// fmls Zd.S, Zn.S, Zm.S[i]  ·····························································  (m < 8)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZElement, byte(DWORD), 0x0, 0x21, 0xab, byte(FeatSVE), 0,
+	// fmls Zd.D, Zn.D, Zm.D[i]  ····························································  (m < 16)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZElement, byte(QWORD), 0x0, 0x21, 0xb7, byte(FeatSVE), 0,
 
 	4,
 	// fmlsl Vd.2S, Vn.2H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x16, 0x80,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x0, 0x21, 0xc2, byte(FeatFHM), 0,
 	// fmlsl Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x16, 0x8a,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x21, 0xcc, byte(FeatFHM), 0,
 	// fmlsl Vd.2S, Vn.2H, Vm.2H
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x16, 0x94,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x0, 0x21, 0xd6, byte(FeatFHM), 0,
 	// fmlsl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x16, 0x9c,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x21, 0xde, byte(FeatFHM), 0,
 
 	4,
 	// fmlsl2 Vd.2S, Vn.2H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x16, 0xa4,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVElement, byte(WORD), 0x0, 0x21, 0xe6, byte(FeatFHM), 0,
 	// fmlsl2 Vd.4S, Vn.4H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x16, 0xae,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x21, 0xf0, byte(FeatFHM), 0,
 	// fmlsl2 Vd.2S, Vn.2H, Vm.2H
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x16, 0xb8,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(WORD), 2, MatVStatic, byte(WORD), 2, 0x0, 0x21, 0xfa, byte(FeatFHM), 0,
 	// fmlsl2 Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x16, 0xc0,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x22, 0x2, byte(FeatFHM), 0,
 
-	19,
+	3,
+	// fmopa ZAd.S, Pg1/M, Pg2/M, Zn.S, Zm.S  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x22, 0xa, byte(FeatSME), 0,
+	// fmopa ZAd.D, Pg1/M, Pg2/M, Zn.D, Zm.D  ································  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x22, 0x18, byte(FeatSMEF64F64), 0,
+	// fmopa ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x22, 0x26, byte(FeatSME), 0,
+
+	3,
+	// fmops ZAd.S, Pg1/M, Pg2/M, Zn.S, Zm.S  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x22, 0x34, byte(FeatSME), 0,
+	// fmops ZAd.D, Pg1/M, Pg2/M, Zn.D, Zm.D  ································  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x22, 0x42, byte(FeatSMEF64F64), 0,
+	// fmops ZAd.S, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x22, 0x50, byte(FeatSME), 0,
+
+	34,
 	// fmov Vd.8H, #imm  ························································  (imm is split float)
 	// fmov Vd.4H, #imm  ························································  (imm is split float)
-	2, MatV, byte(WORD), MatFloat, 0x16, 0xc8,
+	2, MatV, byte(WORD), MatFloat, 0x0, 0x22, 0x5e, byte(FeatFP16), 0,
 	// fmov Vd.4S, #imm  ························································  (imm is split float)
 	// fmov Vd.2S, #imm  ························································  (imm is split float)
-	2, MatV, byte(DWORD), MatFloat, 0x16, 0xd2,
+	2, MatV, byte(DWORD), MatFloat, 0x0, 0x22, 0x68, 0, 0,
 	// fmov Vd.2D, #imm  ························································  (imm is split float)
-	2, MatVStatic, byte(QWORD), 2, MatFloat, 0x16, 0xdc,
+	2, MatVStatic, byte(QWORD), 2, MatFloat, 0x0, 0x22, 0x72, 0, 0,
 	// fmov Hd, Hn
-	2, MatH, MatH, 0x16, 0xe5,
+	2, MatH, MatH, 0x0, 0x22, 0x7b, byte(FeatFP16), 0,
 	// fmov Sd, Sn
-	2, MatS, MatS, 0x16, 0xec,
+	2, MatS, MatS, 0x0, 0x22, 0x82, 0, 0,
 	// fmov Dd, Dn
-	2, MatD, MatD, 0x16, 0xf3,
+	2, MatD, MatD, 0x0, 0x22, 0x89, 0, 0,
 	// fmov Wd, Hn
-	2, MatW, MatH, 0x16, 0xfa,
+	2, MatW, MatH, 0x0, 0x22, 0x90, byte(FeatFP16), 0,
 	// fmov Xd, Hn
-	2, MatX, MatH, 0x17, 0x1,
+	2, MatX, MatH, 0x0, 0x22, 0x97, byte(FeatFP16), 0,
 	// fmov Hd, Wn
-	2, MatH, MatW, 0x17, 0x8,
+	2, MatH, MatW, 0x0, 0x22, 0x9e, byte(FeatFP16), 0,
 	// fmov Sd, Wn
-	2, MatS, MatW, 0x17, 0xf,
+	2, MatS, MatW, 0x0, 0x22, 0xa5, 0, 0,
 	// fmov Wd, Sn
-	2, MatW, MatS, 0x17, 0x16,
+	2, MatW, MatS, 0x0, 0x22, 0xac, 0, 0,
 	// fmov Hd, Xn
-	2, MatH, MatX, 0x17, 0x1d,
+	2, MatH, MatX, 0x0, 0x22, 0xb3, byte(FeatFP16), 0,
 	// fmov Dd, Xn
-	2, MatD, MatX, 0x17, 0x24,
+	2, MatD, MatX, 0x0, 0x22, 0xba, 0, 0,
 	// fmov Vd.D[1], Xn
-	2, MatVElementStatic, byte(QWORD), 1, MatX, 0x17, 0x2b,
+	2, MatVElementStatic, byte(QWORD), 1, MatX, 0x0, 0x22, 0xc1, 0, 0,
 	// fmov Xd, Dn
-	2, MatX, MatD, 0x17, 0x32,
+	2, MatX, MatD, 0x0, 0x22, 0xc8, 0, 0,
 	// fmov Xd, Vn.D[1]
-	2, MatX, MatVElementStatic, byte(QWORD), 1, 0x17, 0x39,
+	2, MatX, MatVElementStatic, byte(QWORD), 1, 0x0, 0x22, 0xcf, 0, 0,
+	// fmov Hd, #0.0
+	2, MatH, MatLitFloat, 0, 0x0, 0x22, 0xd6, byte(FeatFP16), 0,
+	// fmov Sd, #0.0
+	2, MatS, MatLitFloat, 0, 0x0, 0x22, 0xdc, 0, 0,
+	// fmov Dd, #0.0
+	2, MatD, MatLitFloat, 0, 0x0, 0x22, 0xe2, 0, 0,
 	// fmov Hd, #imm  ·································································  (imm is float)
-	2, MatH, MatFloat, 0x17, 0x40,
+	2, MatH, MatFloat, 0x0, 0x22, 0xe8, byte(FeatFP16), 0,
 	// fmov Sd, #imm  ·································································  (imm is float)
-	2, MatS, MatFloat, 0x17, 0x49,
+	2, MatS, MatFloat, 0x0, 0x22, 0xf1, 0, 0,
 	// fmov Dd, #imm  ·································································  (imm is float)
-	2, MatD, MatFloat, 0x17, 0x52,
+	2, MatD, MatFloat, 0x0, 0x22, 0xfa, 0, 0,
+	// fmov Zd.H, Pg/M, #0.0
+	3, MatZ, byte(WORD), MatPM, MatLitFloat, 0, 0x0, 0x23, 0x3, byte(FeatSVE), 0,
+	// fmov Zd.S, Pg/M, #0.0
+	3, MatZ, byte(DWORD), MatPM, MatLitFloat, 0, 0x0, 0x23, 0xa, byte(FeatSVE), 0,
+	// fmov Zd.D, Pg/M, #0.0
+	3, MatZ, byte(QWORD), MatPM, MatLitFloat, 0, 0x0, 0x23, 0x11, byte(FeatSVE), 0,
+	// fmov Zd.H, #0.0
+	2, MatZ, byte(WORD), MatLitFloat, 0, 0x0, 0x23, 0x18, byte(FeatSVE), 0,
+	// fmov Zd.S, #0.0
+	2, MatZ, byte(DWORD), MatLitFloat, 0, 0x0, 0x23, 0x1e, byte(FeatSVE), 0,
+	// fmov Zd.D, #0.0
+	2, MatZ, byte(QWORD), MatLitFloat, 0, 0x0, 0x23, 0x24, byte(FeatSVE), 0,
+	// fmov Zd.H, Pg/M, #imm  ·························································  (imm is float)
+	3, MatZ, byte(WORD), MatPM, MatFloat, 0x0, 0x23, 0x2a, byte(FeatSVE), 0,
+	// fmov Zd.S, Pg/M, #imm  ·························································  (imm is float)
+	3, MatZ, byte(DWORD), MatPM, MatFloat, 0x0, 0x23, 0x34, byte(FeatSVE), 0,
+	// fmov Zd.D, Pg/M, #imm  ·························································  (imm is float)
+	3, MatZ, byte(QWORD), MatPM, MatFloat, 0x0, 0x23, 0x3e, byte(FeatSVE), 0,
+	// fmov Zd.H, #imm  ·······························································  (imm is float)
+	2, MatZ, byte(WORD), MatFloat, 0x0, 0x23, 0x48, byte(FeatSVE), 0,
+	// fmov Zd.S, #imm  ·······························································  (imm is float)
+	2, MatZ, byte(DWORD), MatFloat, 0x0, 0x23, 0x51, byte(FeatSVE), 0,
+	// fmov Zd.D, #imm  ·······························································  (imm is float)
+	2, MatZ, byte(QWORD), MatFloat, 0x0, 0x23, 0x5a, byte(FeatSVE), 0,
 
 	3,
 	// fmsub Hd, Hn, Hm, Ha
-	4, MatH, MatH, MatH, MatH, 0x17, 0x5b,
+	4, MatH, MatH, MatH, MatH, 0x0, 0x23, 0x63, byte(FeatFP16), 0,
 	// fmsub Sd, Sn, Sm, Sa
-	4, MatS, MatS, MatS, MatS, 0x17, 0x64,
+	4, MatS, MatS, MatS, MatS, 0x0, 0x23, 0x6c, 0, 0,
 	// fmsub Dd, Dn, Dm, Da
-	4, MatD, MatD, MatD, MatD, 0x17, 0x6d,
+	4, MatD, MatD, MatD, MatD, 0x0, 0x23, 0x75, 0, 0,
 
-	12,
+	21,
 	// fmul Hd, Hn, Vm.H[i]  ································································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x17, 0x76,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x23, 0x7e, byte(FeatFP16), 0,
 	// fmul Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x17, 0x80,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x23, 0x88, 0, 0,
 	// fmul Dd, Dn, Vm.D[i]
-	3, MatD, MatD, MatVElement, byte(QWORD), 0x17, 0x8a,
+	3, MatD, MatD, MatVElement, byte(QWORD), 0x0, 0x23, 0x92, 0, 0,
 	// fmul Vd.8H, Vn.8H, Vm.H[i]  ··························································  (m < 16)
 	// fmul Vd.4H, Vn.4H, Vm.H[i]  ··························································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x17, 0x94,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x23, 0x9c, byte(FeatFP16), 0,
 	// fmul Vd.4S, Vn.4S, Vm.S[i]
 	// fmul Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x17, 0x9f,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x23, 0xa7, 0, 0,
 	// fmul Vd.2D, Vn.2D, Vm.D[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x17, 0xaa,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x0, 0x23, 0xb2, 0, 0,
 	// fmul Vd.8H, Vn.8H, Vm.8H
 	// fmul Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x17, 0xb5,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x23, 0xbd, byte(FeatFP16), 0,
 	// fmul Vd.4S, Vn.4S, Vm.4S
 	// fmul Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x17, 0xbe,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x23, 0xc6, 0, 0,
 	// fmul Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x17, 0xc7,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x23, 0xcf, 0, 0,
 	// fmul Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x17, 0xd0,
+	3, MatH, MatH, MatH, 0x0, 0x23, 0xd8, byte(FeatFP16), 0,
 	// fmul Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x17, 0xd8,
+	3, MatS, MatS, MatS, 0x0, 0x23, 0xe0, 0, 0,
 	// fmul Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x17, 0xe0,
+	3, MatD, MatD, MatD, 0x0, 0x23, 0xe8, 0, 0,
+	// fmul Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x23, 0xf0, byte(FeatSVE), 0,
+	// fmul Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x23, 0xf8, byte(FeatSVE), 0,
+	// fmul Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x24, 0x0, byte(FeatSVE), 0,
+	// fmul Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x24, 0x8, byte(FeatSVE), 0,
+	// fmul Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x24, 0x13, byte(FeatSVE), 0,
+	// fmul Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x24, 0x1e, byte(FeatSVE), 0,
+	// fmul Zd.H, Zn.H, Zm.H[i]  ·····························································  (m < 8)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZElement, byte(WORD), 0x0, 0x24, 0x29, byte(FeatSVE), 0,
+	// fmul Zd.S, Zn.S, Zm.S[i]  ·····························································  (m < 8)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZElement, byte(DWORD), 0x0, 0x24, 0x3d, byte(FeatSVE), 0,
+	// fmul Zd.D, Zn.D, Zm.D[i]  ····························································  (m < 16)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZElement, byte(QWORD), 0x0, 0x24, 0x49, byte(FeatSVE), 0,
 
-	12,
+	15,
 	// fmulx Hd, Hn, Vm.H[i]  ·······························································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x17, 0xe8,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x24, 0x54, byte(FeatFP16), 0,
 	// fmulx Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x17, 0xf2,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x24, 0x5e, 0, 0,
 	// fmulx Dd, Dn, Vm.D[i]
-	3, MatD, MatD, MatVElement, byte(QWORD), 0x17, 0xfc,
+	3, MatD, MatD, MatVElement, byte(QWORD), 0x0, 0x24, 0x68, 0, 0,
 	// fmulx Vd.8H, Vn.8H, Vm.H[i]  ·························································  (m < 16)
 	// fmulx Vd.4H, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x18, 0x6,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x24, 0x72, byte(FeatFP16), 0,
 	// fmulx Vd.4S, Vn.4S, Vm.S[i]
 	// fmulx Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x18, 0x11,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x24, 0x7d, 0, 0,
 	// fmulx Vd.2D, Vn.2D, Vm.D[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x18, 0x1c,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVElement, byte(QWORD), 0x0, 0x24, 0x88, 0, 0,
 	// fmulx Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x18, 0x27,
+	3, MatH, MatH, MatH, 0x0, 0x24, 0x93, byte(FeatFP16), 0,
 	// fmulx Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x18, 0x2f,
+	3, MatS, MatS, MatS, 0x0, 0x24, 0x9b, 0, 0,
 	// fmulx Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x18, 0x37,
+	3, MatD, MatD, MatD, 0x0, 0x24, 0xa3, 0, 0,
 	// fmulx Vd.8H, Vn.8H, Vm.8H
 	// fmulx Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x18, 0x3f,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x24, 0xab, byte(FeatFP16), 0,
 	// fmulx Vd.4S, Vn.4S, Vm.4S
 	// fmulx Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x18, 0x48,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x24, 0xb4, 0, 0,
 	// fmulx Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x18, 0x51,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x24, 0xbd, 0, 0,
+	// fmulx Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x24, 0xc6, byte(FeatSVE), 0,
+	// fmulx Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x24, 0xd1, byte(FeatSVE), 0,
+	// fmulx Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x24, 0xdc, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// fneg Vd.8H, Vn.8H
 	// fneg Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x18, 0x5a,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x24, 0xe7, byte(FeatFP16), 0,
 	// fneg Vd.4S, Vn.4S
 	// fneg Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x18, 0x62,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x24, 0xef, 0, 0,
 	// fneg Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x18, 0x6a,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x24, 0xf7, 0, 0,
 	// fneg Hd, Hn
-	2, MatH, MatH, 0x18, 0x72,
+	2, MatH, MatH, 0x0, 0x24, 0xff, byte(FeatFP16), 0,
 	// fneg Sd, Sn
-	2, MatS, MatS, 0x18, 0x79,
+	2, MatS, MatS, 0x0, 0x25, 0x6, 0, 0,
 	// fneg Dd, Dn
-	2, MatD, MatD, 0x18, 0x80,
+	2, MatD, MatD, 0x0, 0x25, 0xd, 0, 0,
+	// fneg Zd.H, Pg/M, Zn.H  ································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x25, 0x14, byte(FeatSVE), 0,
+	// fneg Zd.S, Pg/M, Zn.S  ································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x25, 0x1d, byte(FeatSVE), 0,
+	// fneg Zd.D, Pg/M, Zn.D  ································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x25, 0x26, byte(FeatSVE), 0,
 
 	3,
 	// fnmadd Hd, Hn, Hm, Ha
-	4, MatH, MatH, MatH, MatH, 0x18, 0x87,
+	4, MatH, MatH, MatH, MatH, 0x0, 0x25, 0x2f, byte(FeatFP16), 0,
 	// fnmadd Sd, Sn, Sm, Sa
-	4, MatS, MatS, MatS, MatS, 0x18, 0x90,
+	4, MatS, MatS, MatS, MatS, 0x0, 0x25, 0x38, 0, 0,
 	// fnmadd Dd, Dn, Dm, Da
-	4, MatD, MatD, MatD, MatD, 0x18, 0x99,
+	4, MatD, MatD, MatD, MatD, 0x0, 0x25, 0x41, 0, 0,
+
+	3,
+	// fnmla Zd.H, Pg/M, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x25, 0x4a, byte(FeatSVE), 0,
+	// fnmla Zd.S, Pg/M, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x25, 0x54, byte(FeatSVE), 0,
+	// fnmla Zd.D, Pg/M, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x25, 0x5e, byte(FeatSVE), 0,
+
+	3,
+	// fnmls Zd.H, Pg/M, Zn.H, Zm.H  ·························································  (g < 8)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x25, 0x68, byte(FeatSVE), 0,
+	// fnmls Zd.S, Pg/M, Zn.S, Zm.S  ·························································  (g < 8)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x25, 0x72, byte(FeatSVE), 0,
+	// fnmls Zd.D, Pg/M, Zn.D, Zm.D  ·························································  (g < 8)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x25, 0x7c, byte(FeatSVE), 0,
 
 	3,
 	// fnmsub Hd, Hn, Hm, Ha
-	4, MatH, MatH, MatH, MatH, 0x18, 0xa2,
+	4, MatH, MatH, MatH, MatH, 0x0, 0x25, 0x86, byte(FeatFP16), 0,
 	// fnmsub Sd, Sn, Sm, Sa
-	4, MatS, MatS, MatS, MatS, 0x18, 0xab,
+	4, MatS, MatS, MatS, MatS, 0x0, 0x25, 0x8f, 0, 0,
 	// fnmsub Dd, Dn, Dm, Da
-	4, MatD, MatD, MatD, MatD, 0x18, 0xb4,
+	4, MatD, MatD, MatD, MatD, 0x0, 0x25, 0x98, 0, 0,
 
 	3,
 	// fnmul Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x18, 0xbd,
+	3, MatH, MatH, MatH, 0x0, 0x25, 0xa1, byte(FeatFP16), 0,
 	// fnmul Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x18, 0xc5,
+	3, MatS, MatS, MatS, 0x0, 0x25, 0xa9, 0, 0,
 	// fnmul Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x18, 0xcd,
+	3, MatD, MatD, MatD, 0x0, 0x25, 0xb1, 0, 0,
 
 	6,
 	// frecpe Hd, Hn
-	2, MatH, MatH, 0x18, 0xd5,
+	2, MatH, MatH, 0x0, 0x25, 0xb9, byte(FeatFP16), 0,
 	// frecpe Sd, Sn
-	2, MatS, MatS, 0x18, 0xdc,
+	2, MatS, MatS, 0x0, 0x25, 0xc0, 0, 0,
 	// frecpe Dd, Dn
-	2, MatD, MatD, 0x18, 0xe3,
+	2, MatD, MatD, 0x0, 0x25, 0xc7, 0, 0,
 	// frecpe Vd.8H, Vn.8H
 	// frecpe Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x18, 0xea,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x25, 0xce, byte(FeatFP16), 0,
 	// frecpe Vd.4S, Vn.4S
 	// frecpe Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x18, 0xf2,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x25, 0xd6, 0, 0,
 	// frecpe Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x18, 0xfa,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x25, 0xde, 0, 0,
 
-	6,
+	9,
 	// frecps Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x19, 0x2,
+	3, MatH, MatH, MatH, 0x0, 0x25, 0xe6, byte(FeatFP16), 0,
 	// frecps Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x19, 0xa,
+	3, MatS, MatS, MatS, 0x0, 0x25, 0xee, 0, 0,
 	// frecps Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x19, 0x12,
+	3, MatD, MatD, MatD, 0x0, 0x25, 0xf6, 0, 0,
 	// frecps Vd.8H, Vn.8H, Vm.8H
 	// frecps Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x19, 0x1a,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x25, 0xfe, byte(FeatFP16), 0,
 	// frecps Vd.4S, Vn.4S, Vm.4S
 	// frecps Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x19, 0x23,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x26, 0x7, 0, 0,
 	// frecps Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x19, 0x2c,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x26, 0x10, 0, 0,
+	// frecps Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x26, 0x19, byte(FeatSVE), 0,
+	// frecps Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x26, 0x21, byte(FeatSVE), 0,
+	// frecps Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x26, 0x29, byte(FeatSVE), 0,
 
-	3,
+	6,
 	// frecpx Hd, Hn
-	2, MatH, MatH, 0x19, 0x35,
+	2, MatH, MatH, 0x0, 0x26, 0x31, byte(FeatFP16), 0,
 	// frecpx Sd, Sn
-	2, MatS, MatS, 0x19, 0x3c,
+	2, MatS, MatS, 0x0, 0x26, 0x38, 0, 0,
 	// frecpx Dd, Dn
-	2, MatD, MatD, 0x19, 0x43,
+	2, MatD, MatD, 0x0, 0x26, 0x3f, 0, 0,
+	// frecpx Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x26, 0x46, byte(FeatSVE), 0,
+	// frecpx Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x26, 0x4f, byte(FeatSVE), 0,
+	// frecpx Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x26, 0x58, byte(FeatSVE), 0,
 
-	6,
+	4,
+	// frint32x Sd, Sn
+	2, MatS, MatS, 0x0, 0x26, 0x61, byte(FeatFRINTTS), 0,
+	// frint32x Dd, Dn
+	2, MatD, MatD, 0x0, 0x26, 0x68, byte(FeatFRINTTS), 0,
+	// frint32x Vd.4S, Vn.4S
+	// frint32x Vd.2S, Vn.2S
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x26, 0x6f, byte(FeatFRINTTS), 0,
+	// frint32x Vd.2D, Vn.2D
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x26, 0x77, byte(FeatFRINTTS), 0,
+
+	4,
+	// frint32z Sd, Sn
+	2, MatS, MatS, 0x0, 0x26, 0x7f, byte(FeatFRINTTS), 0,
+	// frint32z Dd, Dn
+	2, MatD, MatD, 0x0, 0x26, 0x86, byte(FeatFRINTTS), 0,
+	// frint32z Vd.4S, Vn.4S
+	// frint32z Vd.2S, Vn.2S
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x26, 0x8d, byte(FeatFRINTTS), 0,
+	// frint32z Vd.2D, Vn.2D
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x26, 0x95, byte(FeatFRINTTS), 0,
+
+	4,
+	// frint64x Sd, Sn
+	2, MatS, MatS, 0x0, 0x26, 0x9d, byte(FeatFRINTTS), 0,
+	// frint64x Dd, Dn
+	2, MatD, MatD, 0x0, 0x26, 0xa4, byte(FeatFRINTTS), 0,
+	// frint64x Vd.4S, Vn.4S
+	// frint64x Vd.2S, Vn.2S
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x26, 0xab, byte(FeatFRINTTS), 0,
+	// frint64x Vd.2D, Vn.2D
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x26, 0xb3, byte(FeatFRINTTS), 0,
+
+	4,
+	// frint64z Sd, Sn
+	2, MatS, MatS, 0x0, 0x26, 0xbb, byte(FeatFRINTTS), 0,
+	// frint64z Dd, Dn
+	2, MatD, MatD, 0x0, 0x26, 0xc2, byte(FeatFRINTTS), 0,
+	// frint64z Vd.4S, Vn.4S
+	// frint64z Vd.2S, Vn.2S
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x26, 0xc9, byte(FeatFRINTTS), 0,
+	// frint64z Vd.2D, Vn.2D
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x26, 0xd1, byte(FeatFRINTTS), 0,
+
+	9,
 	// frinta Vd.8H, Vn.8H
 	// frinta Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x19, 0x4a,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x26, 0xd9, byte(FeatFP16), 0,
 	// frinta Vd.4S, Vn.4S
 	// frinta Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x19, 0x52,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x26, 0xe1, 0, 0,
 	// frinta Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x19, 0x5a,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x26, 0xe9, 0, 0,
 	// frinta Hd, Hn
-	2, MatH, MatH, 0x19, 0x62,
+	2, MatH, MatH, 0x0, 0x26, 0xf1, byte(FeatFP16), 0,
 	// frinta Sd, Sn
-	2, MatS, MatS, 0x19, 0x69,
+	2, MatS, MatS, 0x0, 0x26, 0xf8, 0, 0,
 	// frinta Dd, Dn
-	2, MatD, MatD, 0x19, 0x70,
+	2, MatD, MatD, 0x0, 0x26, 0xff, 0, 0,
+	// frinta Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x27, 0x6, byte(FeatSVE), 0,
+	// frinta Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x27, 0xf, byte(FeatSVE), 0,
+	// frinta Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x27, 0x18, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// frinti Vd.8H, Vn.8H
 	// frinti Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x19, 0x77,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x27, 0x21, byte(FeatFP16), 0,
 	// frinti Vd.4S, Vn.4S
 	// frinti Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x19, 0x7f,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x27, 0x29, 0, 0,
 	// frinti Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x19, 0x87,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x27, 0x31, 0, 0,
 	// frinti Hd, Hn
-	2, MatH, MatH, 0x19, 0x8f,
+	2, MatH, MatH, 0x0, 0x27, 0x39, byte(FeatFP16), 0,
 	// frinti Sd, Sn
-	2, MatS, MatS, 0x19, 0x96,
+	2, MatS, MatS, 0x0, 0x27, 0x40, 0, 0,
 	// frinti Dd, Dn
-	2, MatD, MatD, 0x19, 0x9d,
+	2, MatD, MatD, 0x0, 0x27, 0x47, 0, 0,
+	// frinti Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x27, 0x4e, byte(FeatSVE), 0,
+	// frinti Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x27, 0x57, byte(FeatSVE), 0,
+	// frinti Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x27, 0x60, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// frintm Vd.8H, Vn.8H
 	// frintm Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x19, 0xa4,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x27, 0x69, byte(FeatFP16), 0,
 	// frintm Vd.4S, Vn.4S
 	// frintm Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x19, 0xac,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x27, 0x71, 0, 0,
 	// frintm Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x19, 0xb4,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x27, 0x79, 0, 0,
 	// frintm Hd, Hn
-	2, MatH, MatH, 0x19, 0xbc,
+	2, MatH, MatH, 0x0, 0x27, 0x81, byte(FeatFP16), 0,
 	// frintm Sd, Sn
-	2, MatS, MatS, 0x19, 0xc3,
+	2, MatS, MatS, 0x0, 0x27, 0x88, 0, 0,
 	// frintm Dd, Dn
-	2, MatD, MatD, 0x19, 0xca,
+	2, MatD, MatD, 0x0, 0x27, 0x8f, 0, 0,
+	// frintm Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x27, 0x96, byte(FeatSVE), 0,
+	// frintm Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x27, 0x9f, byte(FeatSVE), 0,
+	// frintm Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x27, 0xa8, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// frintn Vd.8H, Vn.8H
 	// frintn Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x19, 0xd1,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x27, 0xb1, byte(FeatFP16), 0,
 	// frintn Vd.4S, Vn.4S
 	// frintn Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x19, 0xd9,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x27, 0xb9, 0, 0,
 	// frintn Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x19, 0xe1,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x27, 0xc1, 0, 0,
 	// frintn Hd, Hn
-	2, MatH, MatH, 0x19, 0xe9,
+	2, MatH, MatH, 0x0, 0x27, 0xc9, byte(FeatFP16), 0,
 	// frintn Sd, Sn
-	2, MatS, MatS, 0x19, 0xf0,
+	2, MatS, MatS, 0x0, 0x27, 0xd0, 0, 0,
 	// frintn Dd, Dn
-	2, MatD, MatD, 0x19, 0xf7,
+	2, MatD, MatD, 0x0, 0x27, 0xd7, 0, 0,
+	// frintn Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x27, 0xde, byte(FeatSVE), 0,
+	// frintn Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x27, 0xe7, byte(FeatSVE), 0,
+	// frintn Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x27, 0xf0, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// frintp Vd.8H, Vn.8H
 	// frintp Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x19, 0xfe,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x27, 0xf9, byte(FeatFP16), 0,
 	// frintp Vd.4S, Vn.4S
 	// frintp Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x1a, 0x6,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x28, 0x1, 0, 0,
 	// frintp Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1a, 0xe,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x28, 0x9, 0, 0,
 	// frintp Hd, Hn
-	2, MatH, MatH, 0x1a, 0x16,
+	2, MatH, MatH, 0x0, 0x28, 0x11, byte(FeatFP16), 0,
 	// frintp Sd, Sn
-	2, MatS, MatS, 0x1a, 0x1d,
+	2, MatS, MatS, 0x0, 0x28, 0x18, 0, 0,
 	// frintp Dd, Dn
-	2, MatD, MatD, 0x1a, 0x24,
+	2, MatD, MatD, 0x0, 0x28, 0x1f, 0, 0,
+	// frintp Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x28, 0x26, byte(FeatSVE), 0,
+	// frintp Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x28, 0x2f, byte(FeatSVE), 0,
+	// frintp Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x28, 0x38, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// frintx Vd.8H, Vn.8H
 	// frintx Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x1a, 0x2b,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x28, 0x41, byte(FeatFP16), 0,
 	// frintx Vd.4S, Vn.4S
 	// frintx Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x1a, 0x33,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x28, 0x49, 0, 0,
 	// frintx Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1a, 0x3b,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x28, 0x51, 0, 0,
 	// frintx Hd, Hn
-	2, MatH, MatH, 0x1a, 0x43,
+	2, MatH, MatH, 0x0, 0x28, 0x59, byte(FeatFP16), 0,
 	// frintx Sd, Sn
-	2, MatS, MatS, 0x1a, 0x4a,
+	2, MatS, MatS, 0x0, 0x28, 0x60, 0, 0,
 	// frintx Dd, Dn
-	2, MatD, MatD, 0x1a, 0x51,
+	2, MatD, MatD, 0x0, 0x28, 0x67, 0, 0,
+	// frintx Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x28, 0x6e, byte(FeatSVE), 0,
+	// frintx Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x28, 0x77, byte(FeatSVE), 0,
+	// frintx Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x28, 0x80, byte(FeatSVE), 0,
 
-	6,
+	9,
 	// frintz Vd.8H, Vn.8H
 	// frintz Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x1a, 0x58,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x28, 0x89, byte(FeatFP16), 0,
 	// frintz Vd.4S, Vn.4S
 	// frintz Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x1a, 0x60,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x28, 0x91, 0, 0,
 	// frintz Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1a, 0x68,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x28, 0x99, 0, 0,
 	// frintz Hd, Hn
-	2, MatH, MatH, 0x1a, 0x70,
+	2, MatH, MatH, 0x0, 0x28, 0xa1, byte(FeatFP16), 0,
 	// frintz Sd, Sn
-	2, MatS, MatS, 0x1a, 0x77,
+	2, MatS, MatS, 0x0, 0x28, 0xa8, 0, 0,
 	// frintz Dd, Dn
-	2, MatD, MatD, 0x1a, 0x7e,
+	2, MatD, MatD, 0x0, 0x28, 0xaf, 0, 0,
+	// frintz Zd.H, Pg/M, Zn.H  ······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x28, 0xb6, byte(FeatSVE), 0,
+	// frintz Zd.S, Pg/M, Zn.S  ······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x28, 0xbf, byte(FeatSVE), 0,
+	// frintz Zd.D, Pg/M, Zn.D  ······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x28, 0xc8, byte(FeatSVE), 0,
 
 	6,
 	// frsqrte Hd, Hn
-	2, MatH, MatH, 0x1a, 0x85,
+	2, MatH, MatH, 0x0, 0x28, 0xd1, byte(FeatFP16), 0,
 	// frsqrte Sd, Sn
-	2, MatS, MatS, 0x1a, 0x8c,
+	2, MatS, MatS, 0x0, 0x28, 0xd8, 0, 0,
 	// frsqrte Dd, Dn
-	2, MatD, MatD, 0x1a, 0x93,
+	2, MatD, MatD, 0x0, 0x28, 0xdf, 0, 0,
 	// frsqrte Vd.8H, Vn.8H
 	// frsqrte Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x1a, 0x9a,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x28, 0xe6, byte(FeatFP16), 0,
 	// frsqrte Vd.4S, Vn.4S
 	// frsqrte Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x1a, 0xa2,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x28, 0xee, 0, 0,
 	// frsqrte Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1a, 0xaa,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x28, 0xf6, 0, 0,
 
-	6,
+	9,
 	// frsqrts Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x1a, 0xb2,
+	3, MatH, MatH, MatH, 0x0, 0x28, 0xfe, byte(FeatFP16), 0,
 	// frsqrts Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x1a, 0xba,
+	3, MatS, MatS, MatS, 0x0, 0x29, 0x6, 0, 0,
 	// frsqrts Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x1a, 0xc2,
+	3, MatD, MatD, MatD, 0x0, 0x29, 0xe, 0, 0,
 	// frsqrts Vd.8H, Vn.8H, Vm.8H
 	// frsqrts Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x1a, 0xca,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x29, 0x16, byte(FeatFP16), 0,
 	// frsqrts Vd.4S, Vn.4S, Vm.4S
 	// frsqrts Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x1a, 0xd3,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x29, 0x1f, 0, 0,
 	// frsqrts Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1a, 0xdc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x29, 0x28, 0, 0,
+	// frsqrts Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x29, 0x31, byte(FeatSVE), 0,
+	// frsqrts Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x29, 0x39, byte(FeatSVE), 0,
+	// frsqrts Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x29, 0x41, byte(FeatSVE), 0,
 
-	6,
+	3,
+	// fscale Zd.H, Pg/M, Zn.H, Zm.H  ················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x29, 0x49, byte(FeatSVE), 0,
+	// fscale Zd.S, Pg/M, Zn.S, Zm.S  ················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x29, 0x54, byte(FeatSVE), 0,
+	// fscale Zd.D, Pg/M, Zn.D, Zm.D  ················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x29, 0x5f, byte(FeatSVE), 0,
+
+	9,
 	// fsqrt Vd.8H, Vn.8H
 	// fsqrt Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x1a, 0xe5,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x29, 0x6a, byte(FeatFP16), 0,
 	// fsqrt Vd.4S, Vn.4S
 	// fsqrt Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x1a, 0xed,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x29, 0x72, 0, 0,
 	// fsqrt Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1a, 0xf5,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x29, 0x7a, 0, 0,
 	// fsqrt Hd, Hn
-	2, MatH, MatH, 0x1a, 0xfd,
+	2, MatH, MatH, 0x0, 0x29, 0x82, byte(FeatFP16), 0,
 	// fsqrt Sd, Sn
-	2, MatS, MatS, 0x1b, 0x4,
+	2, MatS, MatS, 0x0, 0x29, 0x89, 0, 0,
 	// fsqrt Dd, Dn
-	2, MatD, MatD, 0x1b, 0xb,
+	2, MatD, MatD, 0x0, 0x29, 0x90, 0, 0,
+	// fsqrt Zd.H, Pg/M, Zn.H  ·······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x29, 0x97, byte(FeatSVE), 0,
+	// fsqrt Zd.S, Pg/M, Zn.S  ·······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x29, 0xa0, byte(FeatSVE), 0,
+	// fsqrt Zd.D, Pg/M, Zn.D  ·······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x29, 0xa9, byte(FeatSVE), 0,
 
-	6,
+	12,
 	// fsub Vd.8H, Vn.8H, Vm.8H
 	// fsub Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x1b, 0x12,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x29, 0xb2, byte(FeatFP16), 0,
 	// fsub Vd.4S, Vn.4S, Vm.4S
 	// fsub Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x1b, 0x1b,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x29, 0xbb, 0, 0,
 	// fsub Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x1b, 0x24,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x29, 0xc4, 0, 0,
 	// fsub Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x1b, 0x2d,
+	3, MatH, MatH, MatH, 0x0, 0x29, 0xcd, byte(FeatFP16), 0,
 	// fsub Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x1b, 0x35,
+	3, MatS, MatS, MatS, 0x0, 0x29, 0xd5, 0, 0,
 	// fsub Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x1b, 0x3d,
+	3, MatD, MatD, MatD, 0x0, 0x29, 0xdd, 0, 0,
+	// fsub Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x29, 0xe5, byte(FeatSVE), 0,
+	// fsub Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x29, 0xed, byte(FeatSVE), 0,
+	// fsub Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x29, 0xf5, byte(FeatSVE), 0,
+	// fsub Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x29, 0xfd, byte(FeatSVE), 0,
+	// fsub Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x2a, 0x8, byte(FeatSVE), 0,
+	// fsub Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x2a, 0x13, byte(FeatSVE), 0,
+
+	3,
+	// fsubr Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x2a, 0x1e, byte(FeatSVE), 0,
+	// fsubr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x2a, 0x29, byte(FeatSVE), 0,
+	// fsubr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x2a, 0x34, byte(FeatSVE), 0,
+
+	1,
+	// gmi Xd, Xn|SP, Xm
+	3, MatX, MatXSP, MatX, 0x0, 0x2a, 0x3f, byte(FeatMTE), 0,
 
 	1,
 	// hint #imm  ···································································  (0 <= imm < 128)
-	1, MatImm, 0x1b, 0x45,
+	1, MatImm, 0x0, 0x2a, 0x47, 0, 0,
 
 	1,
 	// hlt #imm  ··································································  (0 <= imm < 65536)
-	1, MatImm, 0x1b, 0x4d,
+	1, MatImm, 0x0, 0x2a, 0x4f, 0, 0,
 
 	1,
 	// hvc #imm  ··································································  (0 <= imm < 65536)
-	1, MatImm, 0x1b, 0x55,
+	1, MatImm, 0x0, 0x2a, 0x57, 0, 0,
 
 	2,
 	// ic IVAU, Xn
-	2, MatLitSymbol, byte(IVAU), MatX, 0x1b, 0x5d,
+	2, MatLitSymbol, byte(IVAU), MatX, 0x0, 0x2a, 0x5f, 0, 0,
 	// ic <symbol>
-	1, MatSymbol, 0x1b, 0x63,
+	1, MatSymbol, 0x0, 0x2a, 0x65, 0, 0,
+
+	2,
+	// incb Xd
+	1, MatX, 0x0, 0x2a, 0x6d, byte(FeatSVE), 0,
+	// incb Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x2a, 0x73, byte(FeatSVE), 0,
+
+	2,
+	// incd Xd
+	1, MatX, 0x0, 0x2a, 0x80, byte(FeatSVE), 0,
+	// incd Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x2a, 0x86, byte(FeatSVE), 0,
+
+	2,
+	// inch Xd
+	1, MatX, 0x0, 0x2a, 0x93, byte(FeatSVE), 0,
+	// inch Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x2a, 0x99, byte(FeatSVE), 0,
+
+	2,
+	// incw Xd
+	1, MatX, 0x0, 0x2a, 0xa6, byte(FeatSVE), 0,
+	// incw Xd, <symbol> {, MUL #imm }  ··············································  (0 < imm <= 16)
+	4, MatX, MatSymbol, MatEnd, MatLitMod, SymMUL, 0x0, 0x2a, 0xac, byte(FeatSVE), 0,
+
+	8,
+	// index Zd.B, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	3, MatZ, byte(BYTE), MatImm, MatImm, 0x0, 0x2a, 0xb9, byte(FeatSVE), 0,
+	// index Zd.H, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	3, MatZ, byte(WORD), MatImm, MatImm, 0x0, 0x2a, 0xc5, byte(FeatSVE), 0,
+	// index Zd.S, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	3, MatZ, byte(DWORD), MatImm, MatImm, 0x0, 0x2a, 0xd1, byte(FeatSVE), 0,
+	// index Zd.D, #imm1, #imm2  ································  (-16 <= imm1 < 16, -16 <= imm2 < 16)
+	3, MatZ, byte(QWORD), MatImm, MatImm, 0x0, 0x2a, 0xdd, byte(FeatSVE), 0,
+	// index Zd.B, Wn, Wm
+	3, MatZ, byte(BYTE), MatW, MatW, 0x0, 0x2a, 0xe9, byte(FeatSVE), 0,
+	// index Zd.H, Wn, Wm
+	3, MatZ, byte(WORD), MatW, MatW, 0x0, 0x2a, 0xf1, byte(FeatSVE), 0,
+	// index Zd.S, Wn, Wm
+	3, MatZ, byte(DWORD), MatW, MatW, 0x0, 0x2a, 0xf9, byte(FeatSVE), 0,
+	// index Zd.D, Xn, Xm
+	3, MatZ, byte(QWORD), MatX, MatX, 0x0, 0x2b, 0x1, byte(FeatSVE), 0,
 
 	8,
 	// ins Vd.B[i], Vn.B[i]
-	2, MatVElement, byte(BYTE), MatVElement, byte(BYTE), 0x1b, 0x6b,
+	2, MatVElement, byte(BYTE), MatVElement, byte(BYTE), 0x0, 0x2b, 0x9, 0, 0,
 	// ins Vd.H[i], Vn.H[i]
-	2, MatVElement, byte(WORD), MatVElement, byte(WORD), 0x1b, 0x78,
+	2, MatVElement, byte(WORD), MatVElement, byte(WORD), 0x0, 0x2b, 0x16, 0, 0,
 	// ins Vd.S[i], Vn.S[i]
-	2, MatVElement, byte(DWORD), MatVElement, byte(DWORD), 0x1b, 0x85,
+	2, MatVElement, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x2b, 0x23, 0, 0,
 	// ins Vd.D[i], Vn.D[i]
-	2, MatVElement, byte(QWORD), MatVElement, byte(QWORD), 0x1b, 0x92,
+	2, MatVElement, byte(QWORD), MatVElement, byte(QWORD), 0x0, 0x2b, 0x30, 0, 0,
 	// ins Vd.B[i], Wn
-	2, MatVElement, byte(BYTE), MatW, 0x1b, 0x9f,
+	2, MatVElement, byte(BYTE), MatW, 0x0, 0x2b, 0x3d, 0, 0,
 	// ins Vd.H[i], Wn
-	2, MatVElement, byte(WORD), MatW, 0x1b, 0xa9,
+	2, MatVElement, byte(WORD), MatW, 0x0, 0x2b, 0x47, 0, 0,
 	// ins Vd.S[i], Wn
-	2, MatVElement, byte(DWORD), MatW, 0x1b, 0xb3,
+	2, MatVElement, byte(DWORD), MatW, 0x0, 0x2b, 0x51, 0, 0,
 	// ins Vd.D[i], Xn
-	2, MatVElement, byte(QWORD), MatX, 0x1b, 0xbd,
+	2, MatVElement, byte(QWORD), MatX, 0x0, 0x2b, 0x5b, 0, 0,
+
+	1,
+	// irg Xd|SP, Xn|SP {, Xm }
+	4, MatXSP, MatXSP, MatEnd, MatX, 0x0, 0x2b, 0x65, byte(FeatMTE), 0,
 
 	3,
 	// isb SY
-	1, MatLitSymbol, byte(SY), 0x1b, 0xc7,
+	1, MatLitSymbol, byte(SY), 0x0, 0x2b, 0x6d, 0, 0,
 	// isb #imm  ·····································································  (0 <= imm < 16)
-	1, MatImm, 0x1b, 0xcc,
+	1, MatImm, 0x0, 0x2b, 0x72, 0, 0,
 	// isb
-	0, 0x1b, 0xd4,
+	0, 0x0, 0x2b, 0x7a, 0, 0,
 
 	76,
 	// ld1 {Vd.16B * 1}, [Xn|SP]
 	// ld1 {Vd.8B * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(BYTE), MatRefBase, 0x1b, 0xd9,
+	2, MatRegList, 1, byte(BYTE), MatRefBase, 0x0, 0x2b, 0x7f, 0, 0,
 	// ld1 {Vd.8H * 1}, [Xn|SP]
 	// ld1 {Vd.4H * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(WORD), MatRefBase, 0x1b, 0xe1,
+	2, MatRegList, 1, byte(WORD), MatRefBase, 0x0, 0x2b, 0x87, 0, 0,
 	// ld1 {Vd.4S * 1}, [Xn|SP]
 	// ld1 {Vd.2S * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(DWORD), MatRefBase, 0x1b, 0xe9,
+	2, MatRegList, 1, byte(DWORD), MatRefBase, 0x0, 0x2b, 0x8f, 0, 0,
 	// ld1 {Vd.2D * 1}, [Xn|SP]
 	// ld1 {Vd.1D * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(QWORD), MatRefBase, 0x1b, 0xf1,
+	2, MatRegList, 1, byte(QWORD), MatRefBase, 0x0, 0x2b, 0x97, 0, 0,
 	// ld1 {Vd.16B * 2}, [Xn|SP]
 	// ld1 {Vd.8B * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x1b, 0xf9,
+	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x0, 0x2b, 0x9f, 0, 0,
 	// ld1 {Vd.8H * 2}, [Xn|SP]
 	// ld1 {Vd.4H * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(WORD), MatRefBase, 0x1c, 0x1,
+	2, MatRegList, 2, byte(WORD), MatRefBase, 0x0, 0x2b, 0xa7, 0, 0,
 	// ld1 {Vd.4S * 2}, [Xn|SP]
 	// ld1 {Vd.2S * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x1c, 0x9,
+	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x0, 0x2b, 0xaf, 0, 0,
 	// ld1 {Vd.2D * 2}, [Xn|SP]
 	// ld1 {Vd.1D * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(QWORD), MatRefBase, 0x1c, 0x11,
+	2, MatRegList, 2, byte(QWORD), MatRefBase, 0x0, 0x2b, 0xb7, 0, 0,
 	// ld1 {Vd.16B * 3}, [Xn|SP]
 	// ld1 {Vd.8B * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x1c, 0x19,
+	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x0, 0x2b, 0xbf, 0, 0,
 	// ld1 {Vd.8H * 3}, [Xn|SP]
 	// ld1 {Vd.4H * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(WORD), MatRefBase, 0x1c, 0x21,
+	2, MatRegList, 3, byte(WORD), MatRefBase, 0x0, 0x2b, 0xc7, 0, 0,
 	// ld1 {Vd.4S * 3}, [Xn|SP]
 	// ld1 {Vd.2S * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x1c, 0x29,
+	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x0, 0x2b, 0xcf, 0, 0,
 	// ld1 {Vd.2D * 3}, [Xn|SP]
 	// ld1 {Vd.1D * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(QWORD), MatRefBase, 0x1c, 0x31,
+	2, MatRegList, 3, byte(QWORD), MatRefBase, 0x0, 0x2b, 0xd7, 0, 0,
 	// ld1 {Vd.16B * 4}, [Xn|SP]
 	// ld1 {Vd.8B * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x1c, 0x39,
+	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x0, 0x2b, 0xdf, 0, 0,
 	// ld1 {Vd.8H * 4}, [Xn|SP]
 	// ld1 {Vd.4H * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(WORD), MatRefBase, 0x1c, 0x41,
+	2, MatRegList, 4, byte(WORD), MatRefBase, 0x0, 0x2b, 0xe7, 0, 0,
 	// ld1 {Vd.4S * 4}, [Xn|SP]
 	// ld1 {Vd.2S * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x1c, 0x49,
+	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x0, 0x2b, 0xef, 0, 0,
 	// ld1 {Vd.2D * 4}, [Xn|SP]
 	// ld1 {Vd.1D * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(QWORD), MatRefBase, 0x1c, 0x51,
+	2, MatRegList, 4, byte(QWORD), MatRefBase, 0x0, 0x2b, 0xf7, 0, 0,
 	// ld1 {Vd.8B * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(BYTE), 8, MatRefBase, MatLitInt, 8, 0x1c, 0x59,
+	3, MatRegListStatic, 1, byte(BYTE), 8, MatRefBase, MatLitInt, 8, 0x0, 0x2b, 0xff, 0, 0,
 	// ld1 {Vd.4H * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(WORD), 4, MatRefBase, MatLitInt, 8, 0x1c, 0x60,
+	3, MatRegListStatic, 1, byte(WORD), 4, MatRefBase, MatLitInt, 8, 0x0, 0x2c, 0x6, 0, 0,
 	// ld1 {Vd.2S * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(DWORD), 2, MatRefBase, MatLitInt, 8, 0x1c, 0x67,
+	3, MatRegListStatic, 1, byte(DWORD), 2, MatRefBase, MatLitInt, 8, 0x0, 0x2c, 0xd, 0, 0,
 	// ld1 {Vd.1D * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(QWORD), 1, MatRefBase, MatLitInt, 8, 0x1c, 0x6e,
+	3, MatRegListStatic, 1, byte(QWORD), 1, MatRefBase, MatLitInt, 8, 0x0, 0x2c, 0x14, 0, 0,
 	// ld1 {Vd.16B * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(BYTE), 16, MatRefBase, MatLitInt, 16, 0x1c, 0x75,
+	3, MatRegListStatic, 1, byte(BYTE), 16, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x1b, 0, 0,
 	// ld1 {Vd.8H * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(WORD), 8, MatRefBase, MatLitInt, 16, 0x1c, 0x7c,
+	3, MatRegListStatic, 1, byte(WORD), 8, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x22, 0, 0,
 	// ld1 {Vd.4S * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(DWORD), 4, MatRefBase, MatLitInt, 16, 0x1c, 0x83,
+	3, MatRegListStatic, 1, byte(DWORD), 4, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x29, 0, 0,
 	// ld1 {Vd.2D * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(QWORD), 2, MatRefBase, MatLitInt, 16, 0x1c, 0x8a,
+	3, MatRegListStatic, 1, byte(QWORD), 2, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x30, 0, 0,
 	// ld1 {Vd.16B * 1}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld1 {Vd.8B * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(BYTE), MatRefBase, MatX, 0x1c, 0x91,
+	3, MatRegList, 1, byte(BYTE), MatRefBase, MatX, 0x0, 0x2c, 0x37, 0, 0,
 	// ld1 {Vd.8H * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.4H * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(WORD), MatRefBase, MatX, 0x1c, 0x9a,
+	3, MatRegList, 1, byte(WORD), MatRefBase, MatX, 0x0, 0x2c, 0x40, 0, 0,
 	// ld1 {Vd.4S * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.2S * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(DWORD), MatRefBase, MatX, 0x1c, 0xa3,
+	3, MatRegList, 1, byte(DWORD), MatRefBase, MatX, 0x0, 0x2c, 0x49, 0, 0,
 	// ld1 {Vd.2D * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.1D * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(QWORD), MatRefBase, MatX, 0x1c, 0xac,
+	3, MatRegList, 1, byte(QWORD), MatRefBase, MatX, 0x0, 0x2c, 0x52, 0, 0,
 	// ld1 {Vd.8B * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x1c, 0xb5,
+	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x5b, 0, 0,
 	// ld1 {Vd.4H * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x1c, 0xbc,
+	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x62, 0, 0,
 	// ld1 {Vd.2S * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x1c, 0xc3,
+	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x69, 0, 0,
 	// ld1 {Vd.1D * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(QWORD), 1, MatRefBase, MatLitInt, 16, 0x1c, 0xca,
+	3, MatRegListStatic, 2, byte(QWORD), 1, MatRefBase, MatLitInt, 16, 0x0, 0x2c, 0x70, 0, 0,
 	// ld1 {Vd.16B * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x1c, 0xd1,
+	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x0, 0x2c, 0x77, 0, 0,
 	// ld1 {Vd.8H * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x1c, 0xd8,
+	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x0, 0x2c, 0x7e, 0, 0,
 	// ld1 {Vd.4S * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x1c, 0xdf,
+	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x2c, 0x85, 0, 0,
 	// ld1 {Vd.2D * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x1c, 0xe6,
+	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x2c, 0x8c, 0, 0,
 	// ld1 {Vd.16B * 2}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld1 {Vd.8B * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x1c, 0xed,
+	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x0, 0x2c, 0x93, 0, 0,
 	// ld1 {Vd.8H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.4H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x1c, 0xf6,
+	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x0, 0x2c, 0x9c, 0, 0,
 	// ld1 {Vd.4S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.2S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x1c, 0xff,
+	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x0, 0x2c, 0xa5, 0, 0,
 	// ld1 {Vd.2D * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.1D * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(QWORD), MatRefBase, MatX, 0x1d, 0x8,
+	3, MatRegList, 2, byte(QWORD), MatRefBase, MatX, 0x0, 0x2c, 0xae, 0, 0,
 	// ld1 {Vd.8B * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x1d, 0x11,
+	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x0, 0x2c, 0xb7, 0, 0,
 	// ld1 {Vd.4H * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x1d, 0x18,
+	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x0, 0x2c, 0xbe, 0, 0,
 	// ld1 {Vd.2S * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x1d, 0x1f,
+	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x0, 0x2c, 0xc5, 0, 0,
 	// ld1 {Vd.1D * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(QWORD), 1, MatRefBase, MatLitInt, 24, 0x1d, 0x26,
+	3, MatRegListStatic, 3, byte(QWORD), 1, MatRefBase, MatLitInt, 24, 0x0, 0x2c, 0xcc, 0, 0,
 	// ld1 {Vd.16B * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x1d, 0x2d,
+	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x0, 0x2c, 0xd3, 0, 0,
 	// ld1 {Vd.8H * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x1d, 0x34,
+	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x0, 0x2c, 0xda, 0, 0,
 	// ld1 {Vd.4S * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x1d, 0x3b,
+	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x0, 0x2c, 0xe1, 0, 0,
 	// ld1 {Vd.2D * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x1d, 0x42,
+	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x0, 0x2c, 0xe8, 0, 0,
 	// ld1 {Vd.16B * 3}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld1 {Vd.8B * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x1d, 0x49,
+	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x0, 0x2c, 0xef, 0, 0,
 	// ld1 {Vd.8H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.4H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x1d, 0x52,
+	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x0, 0x2c, 0xf8, 0, 0,
 	// ld1 {Vd.4S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.2S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x1d, 0x5b,
+	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x0, 0x2d, 0x1, 0, 0,
 	// ld1 {Vd.2D * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.1D * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(QWORD), MatRefBase, MatX, 0x1d, 0x64,
+	3, MatRegList, 3, byte(QWORD), MatRefBase, MatX, 0x0, 0x2d, 0xa, 0, 0,
 	// ld1 {Vd.8B * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x1d, 0x6d,
+	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x0, 0x2d, 0x13, 0, 0,
 	// ld1 {Vd.4H * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x1d, 0x74,
+	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x2d, 0x1a, 0, 0,
 	// ld1 {Vd.2S * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x1d, 0x7b,
+	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x2d, 0x21, 0, 0,
 	// ld1 {Vd.1D * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(QWORD), 1, MatRefBase, MatLitInt, 32, 0x1d, 0x82,
+	3, MatRegListStatic, 4, byte(QWORD), 1, MatRefBase, MatLitInt, 32, 0x0, 0x2d, 0x28, 0, 0,
 	// ld1 {Vd.16B * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x1d, 0x89,
+	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x0, 0x2d, 0x2f, 0, 0,
 	// ld1 {Vd.8H * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x1d, 0x90,
+	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x0, 0x2d, 0x36, 0, 0,
 	// ld1 {Vd.4S * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x1d, 0x97,
+	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x0, 0x2d, 0x3d, 0, 0,
 	// ld1 {Vd.2D * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x1d, 0x9e,
+	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x0, 0x2d, 0x44, 0, 0,
 	// ld1 {Vd.16B * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld1 {Vd.8B * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x1d, 0xa5,
+	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x0, 0x2d, 0x4b, 0, 0,
 	// ld1 {Vd.8H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.4H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x1d, 0xae,
+	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x0, 0x2d, 0x54, 0, 0,
 	// ld1 {Vd.4S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.2S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x1d, 0xb7,
+	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x0, 0x2d, 0x5d, 0, 0,
 	// ld1 {Vd.2D * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld1 {Vd.1D * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(QWORD), MatRefBase, MatX, 0x1d, 0xc0,
+	3, MatRegList, 4, byte(QWORD), MatRefBase, MatX, 0x0, 0x2d, 0x66, 0, 0,
 	// ld1 {Vd.B * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(BYTE), MatRefBase, 0x1d, 0xc9,
+	2, MatRegListElement, 1, byte(BYTE), MatRefBase, 0x0, 0x2d, 0x6f, 0, 0,
 	// ld1 {Vd.H * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(WORD), MatRefBase, 0x1d, 0xd2,
+	2, MatRegListElement, 1, byte(WORD), MatRefBase, 0x0, 0x2d, 0x78, 0, 0,
 	// ld1 {Vd.S * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(DWORD), MatRefBase, 0x1d, 0xdb,
+	2, MatRegListElement, 1, byte(DWORD), MatRefBase, 0x0, 0x2d, 0x81, 0, 0,
 	// ld1 {Vd.D * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(QWORD), MatRefBase, 0x1d, 0xe4,
+	2, MatRegListElement, 1, byte(QWORD), MatRefBase, 0x0, 0x2d, 0x8a, 0, 0,
 	// ld1 {Vd.B * 1}[i], [Xn|SP], #1
-	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatLitInt, 1, 0x1d, 0xed,
+	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatLitInt, 1, 0x0, 0x2d, 0x93, 0, 0,
 	// ld1 {Vd.B * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatX, 0x1d, 0xf6,
+	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatX, 0x0, 0x2d, 0x9c, 0, 0,
 	// ld1 {Vd.H * 1}[i], [Xn|SP], #2
-	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatLitInt, 2, 0x1e, 0x0,
+	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatLitInt, 2, 0x0, 0x2d, 0xa6, 0, 0,
 	// ld1 {Vd.H * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatX, 0x1e, 0x9,
+	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatX, 0x0, 0x2d, 0xaf, 0, 0,
 	// ld1 {Vd.S * 1}[i], [Xn|SP], #4
-	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatLitInt, 4, 0x1e, 0x13,
+	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatLitInt, 4, 0x0, 0x2d, 0xb9, 0, 0,
 	// ld1 {Vd.S * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatX, 0x1e, 0x1c,
+	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatX, 0x0, 0x2d, 0xc2, 0, 0,
 	// ld1 {Vd.D * 1}[i], [Xn|SP], #8
-	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatLitInt, 8, 0x1e, 0x26,
+	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatLitInt, 8, 0x0, 0x2d, 0xcc, 0, 0,
 	// ld1 {Vd.D * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatX, 0x1e, 0x2f,
+	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatX, 0x0, 0x2d, 0xd5, 0, 0,
+
+	14,
+	// ld1b ZAdH|V.B[W12-W15, #imm], Pg/Z, [Xn|SP, Xm]  ·······················  (0 <= imm < 16, g < 8)
+	3, MatZASlice, byte(BYTE), MatPZ, MatRefIndexLSL, 0, 0x0, 0x2d, 0xdf, byte(FeatSME), 0,
+	// ld1b ZAdH|V.B[W12-W15, #imm], Pg/Z, [Xn|SP]  ···························  (0 <= imm < 16, g < 8)
+	3, MatZASlice, byte(BYTE), MatPZ, MatRefBase, 0x0, 0x2d, 0xf1, byte(FeatSME), 0,
+	// ld1b {Zd.B * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(BYTE), MatPZ, MatRefVL, 0x0, 0x2e, 0x2, byte(FeatSVE), 0,
+	// ld1b {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(WORD), MatPZ, MatRefVL, 0x0, 0x2e, 0xe, byte(FeatSVE), 0,
+	// ld1b {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefVL, 0x0, 0x2e, 0x1a, byte(FeatSVE), 0,
+	// ld1b {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefVL, 0x0, 0x2e, 0x26, byte(FeatSVE), 0,
+	// ld1b {Zd.B * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	3, MatZList, 1, byte(BYTE), MatPZ, MatRefIndexLSL, 0, 0x0, 0x2e, 0x32, byte(FeatSVE), 0,
+	// ld1b {Zd.H * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	3, MatZList, 1, byte(WORD), MatPZ, MatRefIndexLSL, 0, 0x0, 0x2e, 0x3e, byte(FeatSVE), 0,
+	// ld1b {Zd.S * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexLSL, 0, 0x0, 0x2e, 0x4a, byte(FeatSVE), 0,
+	// ld1b {Zd.D * 1}, Pg/Z, [Xn|SP, Xm]  ··········································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexLSL, 0, 0x0, 0x2e, 0x56, byte(FeatSVE), 0,
+	// ld1b {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x2e, 0x62, byte(FeatSVE), 0,
+	// ld1b {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW]  ······································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexZ, byte(DWORD), 0, 0x0, 0x2e, 0x6e, byte(FeatSVE), 0,
+	// ld1b {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }]  ·····················  (g < 8, 0 <= imm < 32, imm >> 0)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefZ, byte(DWORD), 0x0, 0x2e, 0x7b, byte(FeatSVE), 0,
+	// ld1b {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }]  ·····················  (g < 8, 0 <= imm < 32, imm >> 0)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefZ, byte(QWORD), 0x0, 0x2e, 0x88, byte(FeatSVE), 0,
+
+	6,
+	// ld1d ZAdH|V.D[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #3]  ·········  (d < 8, 0 <= imm < 2, g < 8)
+	3, MatZASlice, byte(QWORD), MatPZ, MatRefIndexLSL, 3, 0x0, 0x2e, 0x95, byte(FeatSME), 0,
+	// ld1d ZAdH|V.D[W12-W15, #imm], Pg/Z, [Xn|SP]  ·····················  (d < 8, 0 <= imm < 2, g < 8)
+	3, MatZASlice, byte(QWORD), MatPZ, MatRefBase, 0x0, 0x2e, 0xa9, byte(FeatSME), 0,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefVL, 0x0, 0x2e, 0xbc, byte(FeatSVE), 0,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #3]  ··································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexLSL, 3, 0x0, 0x2e, 0xc8, byte(FeatSVE), 0,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x2e, 0xd4, byte(FeatSVE), 0,
+	// ld1d {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #3]  ·········································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexZ, byte(QWORD), 3, 0x0, 0x2e, 0xe0, byte(FeatSVE), 0,
+
+	14,
+	// ld1h ZAdH|V.H[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #1]  ·········  (d < 2, 0 <= imm < 8, g < 8)
+	3, MatZASlice, byte(WORD), MatPZ, MatRefIndexLSL, 1, 0x0, 0x2e, 0xec, byte(FeatSME), 0,
+	// ld1h ZAdH|V.H[W12-W15, #imm], Pg/Z, [Xn|SP]  ·····················  (d < 2, 0 <= imm < 8, g < 8)
+	3, MatZASlice, byte(WORD), MatPZ, MatRefBase, 0x0, 0x2f, 0x0, byte(FeatSME), 0,
+	// ld1h {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(WORD), MatPZ, MatRefVL, 0x0, 0x2f, 0x13, byte(FeatSVE), 0,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefVL, 0x0, 0x2f, 0x1f, byte(FeatSVE), 0,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefVL, 0x0, 0x2f, 0x2b, byte(FeatSVE), 0,
+	// ld1h {Zd.H * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ··································  (g < 8, m != 31)
+	3, MatZList, 1, byte(WORD), MatPZ, MatRefIndexLSL, 1, 0x0, 0x2f, 0x37, byte(FeatSVE), 0,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ··································  (g < 8, m != 31)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexLSL, 1, 0x0, 0x2f, 0x43, byte(FeatSVE), 0,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ··································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexLSL, 1, 0x0, 0x2f, 0x4f, byte(FeatSVE), 0,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x2f, 0x5b, byte(FeatSVE), 0,
+	// ld1h {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #1]  ·········································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexZ, byte(QWORD), 1, 0x0, 0x2f, 0x67, byte(FeatSVE), 0,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW]  ······································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexZ, byte(DWORD), 0, 0x0, 0x2f, 0x73, byte(FeatSVE), 0,
+	// ld1h {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW #1]  ···································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexZ, byte(DWORD), 1, 0x0, 0x2f, 0x80, byte(FeatSVE), 0,
+	// ld1h {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }]  ·····················  (g < 8, 0 <= imm < 64, imm >> 1)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefZ, byte(DWORD), 0x0, 0x2f, 0x8d, byte(FeatSVE), 0,
+	// ld1h {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }]  ·····················  (g < 8, 0 <= imm < 64, imm >> 1)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefZ, byte(QWORD), 0x0, 0x2f, 0x9a, byte(FeatSVE), 0,
+
+	2,
+	// ld1q ZAdH|V.Q[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #4]  ············  (d < 16, imm == 0, g < 8)
+	3, MatZASlice, byte(OWORD), MatPZ, MatRefIndexLSL, 4, 0x0, 0x2f, 0xa7, byte(FeatSME), 0,
+	// ld1q ZAdH|V.Q[W12-W15, #imm], Pg/Z, [Xn|SP]  ························  (d < 16, imm == 0, g < 8)
+	3, MatZASlice, byte(OWORD), MatPZ, MatRefBase, 0x0, 0x2f, 0xbc, byte(FeatSME), 0,
 
 	12,
 	// ld1r {Vd.16B * 1}, [Xn|SP]
 	// ld1r {Vd.8B * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(BYTE), MatRefBase, 0x1e, 0x39,
+	2, MatRegList, 1, byte(BYTE), MatRefBase, 0x0, 0x2f, 0xd0, 0, 0,
 	// ld1r {Vd.8H * 1}, [Xn|SP]
 	// ld1r {Vd.4H * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(WORD), MatRefBase, 0x1e, 0x41,
+	2, MatRegList, 1, byte(WORD), MatRefBase, 0x0, 0x2f, 0xd8, 0, 0,
 	// ld1r {Vd.4S * 1}, [Xn|SP]
 	// ld1r {Vd.2S * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(DWORD), MatRefBase, 0x1e, 0x49,
+	2, MatRegList, 1, byte(DWORD), MatRefBase, 0x0, 0x2f, 0xe0, 0, 0,
 	// ld1r {Vd.2D * 1}, [Xn|SP]
 	// ld1r {Vd.1D * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(QWORD), MatRefBase, 0x1e, 0x51,
+	2, MatRegList, 1, byte(QWORD), MatRefBase, 0x0, 0x2f, 0xe8, 0, 0,
 	// ld1r {Vd.16B * 1}, [Xn|SP], #1
 	// ld1r {Vd.8B * 1}, [Xn|SP], #1
-	3, MatRegList, 1, byte(BYTE), MatRefBase, MatLitInt, 1, 0x1e, 0x59,
+	3, MatRegList, 1, byte(BYTE), MatRefBase, MatLitInt, 1, 0x0, 0x2f, 0xf0, 0, 0,
 	// ld1r {Vd.8H * 1}, [Xn|SP], #2
 	// ld1r {Vd.4H * 1}, [Xn|SP], #2
-	3, MatRegList, 1, byte(WORD), MatRefBase, MatLitInt, 2, 0x1e, 0x61,
+	3, MatRegList, 1, byte(WORD), MatRefBase, MatLitInt, 2, 0x0, 0x2f, 0xf8, 0, 0,
 	// ld1r {Vd.4S * 1}, [Xn|SP], #4
 	// ld1r {Vd.2S * 1}, [Xn|SP], #4
-	3, MatRegList, 1, byte(DWORD), MatRefBase, MatLitInt, 4, 0x1e, 0x69,
+	3, MatRegList, 1, byte(DWORD), MatRefBase, MatLitInt, 4, 0x0, 0x30, 0x0, 0, 0,
 	// ld1r {Vd.2D * 1}, [Xn|SP], #8
 	// ld1r {Vd.1D * 1}, [Xn|SP], #8
-	3, MatRegList, 1, byte(QWORD), MatRefBase, MatLitInt, 8, 0x1e, 0x71,
+	3, MatRegList, 1, byte(QWORD), MatRefBase, MatLitInt, 8, 0x0, 0x30, 0x8, 0, 0,
 	// ld1r {Vd.16B * 1}, [Xn|SP], Xm  ·····················································  (m != 31)
 	// ld1r {Vd.8B * 1}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 1, byte(BYTE), MatRefBase, MatX, 0x1e, 0x79,
+	3, MatRegList, 1, byte(BYTE), MatRefBase, MatX, 0x0, 0x30, 0x10, 0, 0,
 	// ld1r {Vd.8H * 1}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld1r {Vd.4H * 1}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 1, byte(WORD), MatRefBase, MatX, 0x1e, 0x82,
+	3, MatRegList, 1, byte(WORD), MatRefBase, MatX, 0x0, 0x30, 0x19, 0, 0,
 	// ld1r {Vd.4S * 1}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld1r {Vd.2S * 1}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 1, byte(DWORD), MatRefBase, MatX, 0x1e, 0x8b,
+	3, MatRegList, 1, byte(DWORD), MatRefBase, MatX, 0x0, 0x30, 0x22, 0, 0,
 	// ld1r {Vd.2D * 1}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld1r {Vd.1D * 1}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 1, byte(QWORD), MatRefBase, MatX, 0x1e, 0x94,
+	3, MatRegList, 1, byte(QWORD), MatRefBase, MatX, 0x0, 0x30, 0x2b, 0, 0,
+
+	1,
+	// ld1rb {Zd.B * 1}, Pg/Z, [Xn|SP {, #imm }]  ···················  (g < 8, 0 <= imm < 64, imm >> 0)
+	3, MatZList, 1, byte(BYTE), MatPZ, MatRefOffset, 0x0, 0x30, 0x34, byte(FeatSVE), 0,
+
+	1,
+	// ld1rd {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm }]  ··················  (g < 8, 0 <= imm < 512, imm >> 3)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefOffset, 0x0, 0x30, 0x41, byte(FeatSVE), 0,
+
+	1,
+	// ld1rh {Zd.H * 1}, Pg/Z, [Xn|SP {, #imm }]  ··················  (g < 8, 0 <= imm < 128, imm >> 1)
+	3, MatZList, 1, byte(WORD), MatPZ, MatRefOffset, 0x0, 0x30, 0x4e, byte(FeatSVE), 0,
+
+	1,
+	// ld1rw {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm }]  ··················  (g < 8, 0 <= imm < 256, imm >> 2)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefOffset, 0x0, 0x30, 0x5b, byte(FeatSVE), 0,
+
+	12,
+	// ld1w ZAdH|V.S[W12-W15, #imm], Pg/Z, [Xn|SP, Xm, LSL #2]  ·········  (d < 4, 0 <= imm < 4, g < 8)
+	3, MatZASlice, byte(DWORD), MatPZ, MatRefIndexLSL, 2, 0x0, 0x30, 0x68, byte(FeatSME), 0,
+	// ld1w ZAdH|V.S[W12-W15, #imm], Pg/Z, [Xn|SP]  ·····················  (d < 4, 0 <= imm < 4, g < 8)
+	3, MatZASlice, byte(DWORD), MatPZ, MatRefBase, 0x0, 0x30, 0x7c, byte(FeatSME), 0,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefVL, 0x0, 0x30, 0x8f, byte(FeatSVE), 0,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP {, #imm, MUL VL }]  ······················  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefVL, 0x0, 0x30, 0x9b, byte(FeatSVE), 0,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #2]  ··································  (g < 8, m != 31)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexLSL, 2, 0x0, 0x30, 0xa7, byte(FeatSVE), 0,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #2]  ··································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexLSL, 2, 0x0, 0x30, 0xb3, byte(FeatSVE), 0,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D]  ·················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x30, 0xbf, byte(FeatSVE), 0,
+	// ld1w {Zd.D * 1}, Pg/Z, [Xn|SP, Zm.D, LSL #2]  ·········································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexZ, byte(QWORD), 2, 0x0, 0x30, 0xcb, byte(FeatSVE), 0,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW]  ······································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexZ, byte(DWORD), 0, 0x0, 0x30, 0xd7, byte(FeatSVE), 0,
+	// ld1w {Zd.S * 1}, Pg/Z, [Xn|SP, Zm.S, UXTW|SXTW #2]  ···································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexZ, byte(DWORD), 2, 0x0, 0x30, 0xe4, byte(FeatSVE), 0,
+	// ld1w {Zd.S * 1}, Pg/Z, [Zn.S {, #imm }]  ····················  (g < 8, 0 <= imm < 128, imm >> 2)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefZ, byte(DWORD), 0x0, 0x30, 0xf1, byte(FeatSVE), 0,
+	// ld1w {Zd.D * 1}, Pg/Z, [Zn.D {, #imm }]  ····················  (g < 8, 0 <= imm < 128, imm >> 2)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefZ, byte(QWORD), 0x0, 0x30, 0xfe, byte(FeatSVE), 0,
 
 	27,
 	// ld2 {Vd.16B * 2}, [Xn|SP]
 	// ld2 {Vd.8B * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x1e, 0x9d,
+	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x0, 0x31, 0xb, 0, 0,
 	// ld2 {Vd.8H * 2}, [Xn|SP]
 	// ld2 {Vd.4H * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(WORD), MatRefBase, 0x1e, 0xa5,
+	2, MatRegList, 2, byte(WORD), MatRefBase, 0x0, 0x31, 0x13, 0, 0,
 	// ld2 {Vd.4S * 2}, [Xn|SP]
 	// ld2 {Vd.2S * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x1e, 0xad,
+	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x0, 0x31, 0x1b, 0, 0,
 	// ld2 {Vd.2D * 2}, [Xn|SP]
-	2, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, 0x1e, 0xb5,
+	2, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, 0x0, 0x31, 0x23, 0, 0,
 	// ld2 {Vd.8B * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x1e, 0xbd,
+	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x0, 0x31, 0x2b, 0, 0,
 	// ld2 {Vd.4H * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x1e, 0xc4,
+	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x0, 0x31, 0x32, 0, 0,
 	// ld2 {Vd.2S * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x1e, 0xcb,
+	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x0, 0x31, 0x39, 0, 0,
 	// ld2 {Vd.16B * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x1e, 0xd2,
+	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x0, 0x31, 0x40, 0, 0,
 	// ld2 {Vd.8H * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x1e, 0xd9,
+	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x0, 0x31, 0x47, 0, 0,
 	// ld2 {Vd.4S * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x1e, 0xe0,
+	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x31, 0x4e, 0, 0,
 	// ld2 {Vd.2D * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x1e, 0xe7,
+	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x31, 0x55, 0, 0,
 	// ld2 {Vd.16B * 2}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld2 {Vd.8B * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x1e, 0xee,
+	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x0, 0x31, 0x5c, 0, 0,
 	// ld2 {Vd.8H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld2 {Vd.4H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x1e, 0xf7,
+	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x0, 0x31, 0x65, 0, 0,
 	// ld2 {Vd.4S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld2 {Vd.2S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x1f, 0x0,
+	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x0, 0x31, 0x6e, 0, 0,
 	// ld2 {Vd.2D * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatX, 0x1f, 0x9,
+	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatX, 0x0, 0x31, 0x77, 0, 0,
 	// ld2 {Vd.B * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(BYTE), MatRefBase, 0x1f, 0x12,
+	2, MatRegListElement, 2, byte(BYTE), MatRefBase, 0x0, 0x31, 0x80, 0, 0,
 	// ld2 {Vd.H * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(WORD), MatRefBase, 0x1f, 0x1b,
+	2, MatRegListElement, 2, byte(WORD), MatRefBase, 0x0, 0x31, 0x89, 0, 0,
 	// ld2 {Vd.S * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(DWORD), MatRefBase, 0x1f, 0x24,
+	2, MatRegListElement, 2, byte(DWORD), MatRefBase, 0x0, 0x31, 0x92, 0, 0,
 	// ld2 {Vd.D * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(QWORD), MatRefBase, 0x1f, 0x2d,
+	2, MatRegListElement, 2, byte(QWORD), MatRefBase, 0x0, 0x31, 0x9b, 0, 0,
 	// ld2 {Vd.B * 2}[i], [Xn|SP], #2
-	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatLitInt, 2, 0x1f, 0x36,
+	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatLitInt, 2, 0x0, 0x31, 0xa4, 0, 0,
 	// ld2 {Vd.B * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatX, 0x1f, 0x3f,
+	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatX, 0x0, 0x31, 0xad, 0, 0,
 	// ld2 {Vd.H * 2}[i], [Xn|SP], #4
-	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatLitInt, 4, 0x1f, 0x49,
+	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatLitInt, 4, 0x0, 0x31, 0xb7, 0, 0,
 	// ld2 {Vd.H * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatX, 0x1f, 0x52,
+	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatX, 0x0, 0x31, 0xc0, 0, 0,
 	// ld2 {Vd.S * 2}[i], [Xn|SP], #8
-	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatLitInt, 8, 0x1f, 0x5c,
+	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatLitInt, 8, 0x0, 0x31, 0xca, 0, 0,
 	// ld2 {Vd.S * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatX, 0x1f, 0x65,
+	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatX, 0x0, 0x31, 0xd3, 0, 0,
 	// ld2 {Vd.D * 2}[i], [Xn|SP], #16
-	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatLitInt, 16, 0x1f, 0x6f,
+	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatLitInt, 16, 0x0, 0x31, 0xdd, 0, 0,
 	// ld2 {Vd.D * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatX, 0x1f, 0x78,
+	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatX, 0x0, 0x31, 0xe6, 0, 0,
 
 	12,
 	// ld2r {Vd.16B * 2}, [Xn|SP]
 	// ld2r {Vd.8B * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x1f, 0x82,
+	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x0, 0x31, 0xf0, 0, 0,
 	// ld2r {Vd.8H * 2}, [Xn|SP]
 	// ld2r {Vd.4H * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(WORD), MatRefBase, 0x1f, 0x8a,
+	2, MatRegList, 2, byte(WORD), MatRefBase, 0x0, 0x31, 0xf8, 0, 0,
 	// ld2r {Vd.4S * 2}, [Xn|SP]
 	// ld2r {Vd.2S * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x1f, 0x92,
+	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x0, 0x32, 0x0, 0, 0,
 	// ld2r {Vd.2D * 2}, [Xn|SP]
 	// ld2r {Vd.1D * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(QWORD), MatRefBase, 0x1f, 0x9a,
+	2, MatRegList, 2, byte(QWORD), MatRefBase, 0x0, 0x32, 0x8, 0, 0,
 	// ld2r {Vd.16B * 2}, [Xn|SP], #2
 	// ld2r {Vd.8B * 2}, [Xn|SP], #2
-	3, MatRegList, 2, byte(BYTE), MatRefBase, MatLitInt, 2, 0x1f, 0xa2,
+	3, MatRegList, 2, byte(BYTE), MatRefBase, MatLitInt, 2, 0x0, 0x32, 0x10, 0, 0,
 	// ld2r {Vd.8H * 2}, [Xn|SP], #4
 	// ld2r {Vd.4H * 2}, [Xn|SP], #4
-	3, MatRegList, 2, byte(WORD), MatRefBase, MatLitInt, 4, 0x1f, 0xaa,
+	3, MatRegList, 2, byte(WORD), MatRefBase, MatLitInt, 4, 0x0, 0x32, 0x18, 0, 0,
 	// ld2r {Vd.4S * 2}, [Xn|SP], #8
 	// ld2r {Vd.2S * 2}, [Xn|SP], #8
-	3, MatRegList, 2, byte(DWORD), MatRefBase, MatLitInt, 8, 0x1f, 0xb2,
+	3, MatRegList, 2, byte(DWORD), MatRefBase, MatLitInt, 8, 0x0, 0x32, 0x20, 0, 0,
 	// ld2r {Vd.2D * 2}, [Xn|SP], #16
 	// ld2r {Vd.1D * 2}, [Xn|SP], #16
-	3, MatRegList, 2, byte(QWORD), MatRefBase, MatLitInt, 16, 0x1f, 0xba,
+	3, MatRegList, 2, byte(QWORD), MatRefBase, MatLitInt, 16, 0x0, 0x32, 0x28, 0, 0,
 	// ld2r {Vd.16B * 2}, [Xn|SP], Xm  ·····················································  (m != 31)
 	// ld2r {Vd.8B * 2}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x1f, 0xc2,
+	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x0, 0x32, 0x30, 0, 0,
 	// ld2r {Vd.8H * 2}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld2r {Vd.4H * 2}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x1f, 0xcb,
+	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x0, 0x32, 0x39, 0, 0,
 	// ld2r {Vd.4S * 2}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld2r {Vd.2S * 2}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x1f, 0xd4,
+	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x0, 0x32, 0x42, 0, 0,
 	// ld2r {Vd.2D * 2}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld2r {Vd.1D * 2}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 2, byte(QWORD), MatRefBase, MatX, 0x1f, 0xdd,
+	3, MatRegList, 2, byte(QWORD), MatRefBase, MatX, 0x0, 0x32, 0x4b, 0, 0,
 
 	27,
 	// ld3 {Vd.16B * 3}, [Xn|SP]
 	// ld3 {Vd.8B * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x1f, 0xe6,
+	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x0, 0x32, 0x54, 0, 0,
 	// ld3 {Vd.8H * 3}, [Xn|SP]
 	// ld3 {Vd.4H * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(WORD), MatRefBase, 0x1f, 0xee,
+	2, MatRegList, 3, byte(WORD), MatRefBase, 0x0, 0x32, 0x5c, 0, 0,
 	// ld3 {Vd.4S * 3}, [Xn|SP]
 	// ld3 {Vd.2S * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x1f, 0xf6,
+	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x0, 0x32, 0x64, 0, 0,
 	// ld3 {Vd.2D * 3}, [Xn|SP]
-	2, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, 0x1f, 0xfe,
+	2, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, 0x0, 0x32, 0x6c, 0, 0,
 	// ld3 {Vd.8B * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x20, 0x6,
+	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x0, 0x32, 0x74, 0, 0,
 	// ld3 {Vd.4H * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x20, 0xd,
+	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x0, 0x32, 0x7b, 0, 0,
 	// ld3 {Vd.2S * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x20, 0x14,
+	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x0, 0x32, 0x82, 0, 0,
 	// ld3 {Vd.16B * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x20, 0x1b,
+	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x0, 0x32, 0x89, 0, 0,
 	// ld3 {Vd.8H * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x20, 0x22,
+	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x0, 0x32, 0x90, 0, 0,
 	// ld3 {Vd.4S * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x20, 0x29,
+	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x0, 0x32, 0x97, 0, 0,
 	// ld3 {Vd.2D * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x20, 0x30,
+	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x0, 0x32, 0x9e, 0, 0,
 	// ld3 {Vd.16B * 3}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld3 {Vd.8B * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x20, 0x37,
+	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x0, 0x32, 0xa5, 0, 0,
 	// ld3 {Vd.8H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld3 {Vd.4H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x20, 0x40,
+	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x0, 0x32, 0xae, 0, 0,
 	// ld3 {Vd.4S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld3 {Vd.2S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x20, 0x49,
+	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x0, 0x32, 0xb7, 0, 0,
 	// ld3 {Vd.2D * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatX, 0x20, 0x52,
+	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatX, 0x0, 0x32, 0xc0, 0, 0,
 	// ld3 {Vd.B * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(BYTE), MatRefBase, 0x20, 0x5b,
+	2, MatRegListElement, 3, byte(BYTE), MatRefBase, 0x0, 0x32, 0xc9, 0, 0,
 	// ld3 {Vd.H * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(WORD), MatRefBase, 0x20, 0x64,
+	2, MatRegListElement, 3, byte(WORD), MatRefBase, 0x0, 0x32, 0xd2, 0, 0,
 	// ld3 {Vd.S * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(DWORD), MatRefBase, 0x20, 0x6d,
+	2, MatRegListElement, 3, byte(DWORD), MatRefBase, 0x0, 0x32, 0xdb, 0, 0,
 	// ld3 {Vd.D * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(QWORD), MatRefBase, 0x20, 0x76,
+	2, MatRegListElement, 3, byte(QWORD), MatRefBase, 0x0, 0x32, 0xe4, 0, 0,
 	// ld3 {Vd.B * 3}[i], [Xn|SP], #3
-	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatLitInt, 3, 0x20, 0x7f,
+	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatLitInt, 3, 0x0, 0x32, 0xed, 0, 0,
 	// ld3 {Vd.B * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatX, 0x20, 0x88,
+	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatX, 0x0, 0x32, 0xf6, 0, 0,
 	// ld3 {Vd.H * 3}[i], [Xn|SP], #6
-	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatLitInt, 6, 0x20, 0x92,
+	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatLitInt, 6, 0x0, 0x33, 0x0, 0, 0,
 	// ld3 {Vd.H * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatX, 0x20, 0x9b,
+	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatX, 0x0, 0x33, 0x9, 0, 0,
 	// ld3 {Vd.S * 3}[i], [Xn|SP], #12
-	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatLitInt, 12, 0x20, 0xa5,
+	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatLitInt, 12, 0x0, 0x33, 0x13, 0, 0,
 	// ld3 {Vd.S * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatX, 0x20, 0xae,
+	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatX, 0x0, 0x33, 0x1c, 0, 0,
 	// ld3 {Vd.D * 3}[i], [Xn|SP], #24
-	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatLitInt, 24, 0x20, 0xb8,
+	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatLitInt, 24, 0x0, 0x33, 0x26, 0, 0,
 	// ld3 {Vd.D * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatX, 0x20, 0xc1,
+	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatX, 0x0, 0x33, 0x2f, 0, 0,
 
 	12,
 	// ld3r {Vd.16B * 3}, [Xn|SP]
 	// ld3r {Vd.8B * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x20, 0xcb,
+	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x0, 0x33, 0x39, 0, 0,
 	// ld3r {Vd.8H * 3}, [Xn|SP]
 	// ld3r {Vd.4H * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(WORD), MatRefBase, 0x20, 0xd3,
+	2, MatRegList, 3, byte(WORD), MatRefBase, 0x0, 0x33, 0x41, 0, 0,
 	// ld3r {Vd.4S * 3}, [Xn|SP]
 	// ld3r {Vd.2S * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x20, 0xdb,
+	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x0, 0x33, 0x49, 0, 0,
 	// ld3r {Vd.2D * 3}, [Xn|SP]
 	// ld3r {Vd.1D * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(QWORD), MatRefBase, 0x20, 0xe3,
+	2, MatRegList, 3, byte(QWORD), MatRefBase, 0x0, 0x33, 0x51, 0, 0,
 	// ld3r {Vd.16B * 3}, [Xn|SP], #3
 	// ld3r {Vd.8B * 3}, [Xn|SP], #3
-	3, MatRegList, 3, byte(BYTE), MatRefBase, MatLitInt, 3, 0x20, 0xeb,
+	3, MatRegList, 3, byte(BYTE), MatRefBase, MatLitInt, 3, 0x0, 0x33, 0x59, 0, 0,
 	// ld3r {Vd.8H * 3}, [Xn|SP], #6
 	// ld3r {Vd.4H * 3}, [Xn|SP], #6
-	3, MatRegList, 3, byte(WORD), MatRefBase, MatLitInt, 6, 0x20, 0xf3,
+	3, MatRegList, 3, byte(WORD), MatRefBase, MatLitInt, 6, 0x0, 0x33, 0x61, 0, 0,
 	// ld3r {Vd.4S * 3}, [Xn|SP], #12
 	// ld3r {Vd.2S * 3}, [Xn|SP], #12
-	3, MatRegList, 3, byte(DWORD), MatRefBase, MatLitInt, 12, 0x20, 0xfb,
+	3, MatRegList, 3, byte(DWORD), MatRefBase, MatLitInt, 12, 0x0, 0x33, 0x69, 0, 0,
 	// ld3r {Vd.2D * 3}, [Xn|SP], #24
 	// ld3r {Vd.1D * 3}, [Xn|SP], #24
-	3, MatRegList, 3, byte(QWORD), MatRefBase, MatLitInt, 24, 0x21, 0x3,
+	3, MatRegList, 3, byte(QWORD), MatRefBase, MatLitInt, 24, 0x0, 0x33, 0x71, 0, 0,
 	// ld3r {Vd.16B * 3}, [Xn|SP], Xm  ·····················································  (m != 31)
 	// ld3r {Vd.8B * 3}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x21, 0xb,
+	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x0, 0x33, 0x79, 0, 0,
 	// ld3r {Vd.8H * 3}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld3r {Vd.4H * 3}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x21, 0x14,
+	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x0, 0x33, 0x82, 0, 0,
 	// ld3r {Vd.4S * 3}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld3r {Vd.2S * 3}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x21, 0x1d,
+	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x0, 0x33, 0x8b, 0, 0,
 	// ld3r {Vd.2D * 3}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld3r {Vd.1D * 3}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 3, byte(QWORD), MatRefBase, MatX, 0x21, 0x26,
+	3, MatRegList, 3, byte(QWORD), MatRefBase, MatX, 0x0, 0x33, 0x94, 0, 0,
 
 	27,
 	// ld4 {Vd.16B * 4}, [Xn|SP]
 	// ld4 {Vd.8B * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x21, 0x2f,
+	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x0, 0x33, 0x9d, 0, 0,
 	// ld4 {Vd.8H * 4}, [Xn|SP]
 	// ld4 {Vd.4H * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(WORD), MatRefBase, 0x21, 0x37,
+	2, MatRegList, 4, byte(WORD), MatRefBase, 0x0, 0x33, 0xa5, 0, 0,
 	// ld4 {Vd.4S * 4}, [Xn|SP]
 	// ld4 {Vd.2S * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x21, 0x3f,
+	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x0, 0x33, 0xad, 0, 0,
 	// ld4 {Vd.2D * 4}, [Xn|SP]
-	2, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, 0x21, 0x47,
+	2, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, 0x0, 0x33, 0xb5, 0, 0,
 	// ld4 {Vd.8B * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x21, 0x4f,
+	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x0, 0x33, 0xbd, 0, 0,
 	// ld4 {Vd.4H * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x21, 0x56,
+	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x33, 0xc4, 0, 0,
 	// ld4 {Vd.2S * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x21, 0x5d,
+	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x33, 0xcb, 0, 0,
 	// ld4 {Vd.16B * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x21, 0x64,
+	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x0, 0x33, 0xd2, 0, 0,
 	// ld4 {Vd.8H * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x21, 0x6b,
+	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x0, 0x33, 0xd9, 0, 0,
 	// ld4 {Vd.4S * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x21, 0x72,
+	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x0, 0x33, 0xe0, 0, 0,
 	// ld4 {Vd.2D * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x21, 0x79,
+	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x0, 0x33, 0xe7, 0, 0,
 	// ld4 {Vd.16B * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld4 {Vd.8B * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x21, 0x80,
+	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x0, 0x33, 0xee, 0, 0,
 	// ld4 {Vd.8H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld4 {Vd.4H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x21, 0x89,
+	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x0, 0x33, 0xf7, 0, 0,
 	// ld4 {Vd.4S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// ld4 {Vd.2S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x21, 0x92,
+	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x0, 0x34, 0x0, 0, 0,
 	// ld4 {Vd.2D * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatX, 0x21, 0x9b,
+	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatX, 0x0, 0x34, 0x9, 0, 0,
 	// ld4 {Vd.B * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(BYTE), MatRefBase, 0x21, 0xa4,
+	2, MatRegListElement, 4, byte(BYTE), MatRefBase, 0x0, 0x34, 0x12, 0, 0,
 	// ld4 {Vd.H * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(WORD), MatRefBase, 0x21, 0xad,
+	2, MatRegListElement, 4, byte(WORD), MatRefBase, 0x0, 0x34, 0x1b, 0, 0,
 	// ld4 {Vd.S * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(DWORD), MatRefBase, 0x21, 0xb6,
+	2, MatRegListElement, 4, byte(DWORD), MatRefBase, 0x0, 0x34, 0x24, 0, 0,
 	// ld4 {Vd.D * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(QWORD), MatRefBase, 0x21, 0xbf,
+	2, MatRegListElement, 4, byte(QWORD), MatRefBase, 0x0, 0x34, 0x2d, 0, 0,
 	// ld4 {Vd.B * 4}[i], [Xn|SP], #4
-	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatLitInt, 4, 0x21, 0xc8,
+	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatLitInt, 4, 0x0, 0x34, 0x36, 0, 0,
 	// ld4 {Vd.B * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatX, 0x21, 0xd1,
+	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatX, 0x0, 0x34, 0x3f, 0, 0,
 	// ld4 {Vd.H * 4}[i], [Xn|SP], #8
-	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatLitInt, 8, 0x21, 0xdb,
+	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatLitInt, 8, 0x0, 0x34, 0x49, 0, 0,
 	// ld4 {Vd.H * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatX, 0x21, 0xe4,
+	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatX, 0x0, 0x34, 0x52, 0, 0,
 	// ld4 {Vd.S * 4}[i], [Xn|SP], #16
-	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatLitInt, 16, 0x21, 0xee,
+	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatLitInt, 16, 0x0, 0x34, 0x5c, 0, 0,
 	// ld4 {Vd.S * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatX, 0x21, 0xf7,
+	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatX, 0x0, 0x34, 0x65, 0, 0,
 	// ld4 {Vd.D * 4}[i], [Xn|SP], #32
-	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatLitInt, 32, 0x22, 0x1,
+	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatLitInt, 32, 0x0, 0x34, 0x6f, 0, 0,
 	// ld4 {Vd.D * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatX, 0x22, 0xa,
+	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatX, 0x0, 0x34, 0x78, 0, 0,
 
 	12,
 	// ld4r {Vd.16B * 4}, [Xn|SP]
 	// ld4r {Vd.8B * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x22, 0x14,
+	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x0, 0x34, 0x82, 0, 0,
 	// ld4r {Vd.8H * 4}, [Xn|SP]
 	// ld4r {Vd.4H * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(WORD), MatRefBase, 0x22, 0x1c,
+	2, MatRegList, 4, byte(WORD), MatRefBase, 0x0, 0x34, 0x8a, 0, 0,
 	// ld4r {Vd.4S * 4}, [Xn|SP]
 	// ld4r {Vd.2S * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x22, 0x24,
+	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x0, 0x34, 0x92, 0, 0,
 	// ld4r {Vd.2D * 4}, [Xn|SP]
 	// ld4r {Vd.1D * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(QWORD), MatRefBase, 0x22, 0x2c,
+	2, MatRegList, 4, byte(QWORD), MatRefBase, 0x0, 0x34, 0x9a, 0, 0,
 	// ld4r {Vd.16B * 4}, [Xn|SP], #4
 	// ld4r {Vd.8B * 4}, [Xn|SP], #4
-	3, MatRegList, 4, byte(BYTE), MatRefBase, MatLitInt, 4, 0x22, 0x34,
+	3, MatRegList, 4, byte(BYTE), MatRefBase, MatLitInt, 4, 0x0, 0x34, 0xa2, 0, 0,
 	// ld4r {Vd.8H * 4}, [Xn|SP], #8
 	// ld4r {Vd.4H * 4}, [Xn|SP], #8
-	3, MatRegList, 4, byte(WORD), MatRefBase, MatLitInt, 8, 0x22, 0x3c,
+	3, MatRegList, 4, byte(WORD), MatRefBase, MatLitInt, 8, 0x0, 0x34, 0xaa, 0, 0,
 	// ld4r {Vd.4S * 4}, [Xn|SP], #16
 	// ld4r {Vd.2S * 4}, [Xn|SP], #16
-	3, MatRegList, 4, byte(DWORD), MatRefBase, MatLitInt, 16, 0x22, 0x44,
+	3, MatRegList, 4, byte(DWORD), MatRefBase, MatLitInt, 16, 0x0, 0x34, 0xb2, 0, 0,
 	// ld4r {Vd.2D * 4}, [Xn|SP], #32
 	// ld4r {Vd.1D * 4}, [Xn|SP], #32
-	3, MatRegList, 4, byte(QWORD), MatRefBase, MatLitInt, 32, 0x22, 0x4c,
+	3, MatRegList, 4, byte(QWORD), MatRefBase, MatLitInt, 32, 0x0, 0x34, 0xba, 0, 0,
 	// ld4r {Vd.16B * 4}, [Xn|SP], Xm  ·····················································  (m != 31)
 	// ld4r {Vd.8B * 4}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x22, 0x54,
+	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x0, 0x34, 0xc2, 0, 0,
 	// ld4r {Vd.8H * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld4r {Vd.4H * 4}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x22, 0x5d,
+	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x0, 0x34, 0xcb, 0, 0,
 	// ld4r {Vd.4S * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld4r {Vd.2S * 4}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x22, 0x66,
+	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x0, 0x34, 0xd4, 0, 0,
 	// ld4r {Vd.2D * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	// ld4r {Vd.1D * 4}, [Xn|SP], Xm  ······················································  (m != 31)
-	3, MatRegList, 4, byte(QWORD), MatRefBase, MatX, 0x22, 0x6f,
+	3, MatRegList, 4, byte(QWORD), MatRefBase, MatX, 0x0, 0x34, 0xdd, 0, 0,
+
+	1,
+	// ld64b Xn, [Xm|SP]  ································································  (n is even)
+	2, MatX, MatRefBase, 0x0, 0x34, 0xe6, byte(FeatLS64), 0,
 
 	2,
 	// ldadd Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0x78,
+	3, MatW, MatW, MatRefBase, 0x0, 0x34, 0xee, byte(FeatLSE), 0,
 	// ldadd Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x22, 0x80,
+	3, MatX, MatX, MatRefBase, 0x0, 0x34, 0xf6, byte(FeatLSE), 0,
 
 	2,
 	// ldadda Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0x88,
+	3, MatW, MatW, MatRefBase, 0x0, 0x34, 0xfe, byte(FeatLSE), 0,
 	// ldadda Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x22, 0x90,
+	3, MatX, MatX, MatRefBase, 0x0, 0x35, 0x6, byte(FeatLSE), 0,
 
 	1,
 	// ldaddab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0x98,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0xe, byte(FeatLSE), 0,
 
 	1,
 	// ldaddah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xa0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x16, byte(FeatLSE), 0,
 
 	2,
 	// ldaddal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xa8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x1e, byte(FeatLSE), 0,
 	// ldaddal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x22, 0xb0,
+	3, MatX, MatX, MatRefBase, 0x0, 0x35, 0x26, byte(FeatLSE), 0,
 
 	1,
 	// ldaddalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xb8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x2e, byte(FeatLSE), 0,
 
 	1,
 	// ldaddalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xc0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x36, byte(FeatLSE), 0,
 
 	1,
 	// ldaddb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xc8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x3e, byte(FeatLSE), 0,
 
 	1,
 	// ldaddh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xd0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x46, byte(FeatLSE), 0,
 
 	2,
 	// ldaddl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xd8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x4e, byte(FeatLSE), 0,
 	// ldaddl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x22, 0xe0,
+	3, MatX, MatX, MatRefBase, 0x0, 0x35, 0x56, byte(FeatLSE), 0,
 
 	1,
 	// ldaddlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xe8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x5e, byte(FeatLSE), 0,
 
 	1,
 	// ldaddlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x22, 0xf0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0x66, byte(FeatLSE), 0,
 
 	2,
 	// ldapr Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x22, 0xf8,
+	2, MatW, MatRefBase, 0x0, 0x35, 0x6e, byte(FeatLRCPC), 0,
 	// ldapr Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x22, 0xff,
+	2, MatX, MatRefBase, 0x0, 0x35, 0x75, byte(FeatLRCPC), 0,
 
 	1,
 	// ldaprb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0x6,
+	2, MatW, MatRefBase, 0x0, 0x35, 0x7c, byte(FeatLRCPC), 0,
 
 	1,
 	// ldaprh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0xd,
+	2, MatW, MatRefBase, 0x0, 0x35, 0x83, byte(FeatLRCPC), 0,
 
 	2,
 	// ldapur Wd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x23, 0x14,
+	2, MatW, MatRefOffset, 0x0, 0x35, 0x8a, byte(FeatLRCPC2), 0,
 	// ldapur Xd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x23, 0x1c,
+	2, MatX, MatRefOffset, 0x0, 0x35, 0x92, byte(FeatLRCPC2), 0,
 
 	1,
 	// ldapurb Wd, [Xn|SP {, #imm }]  ············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x23, 0x24,
+	2, MatW, MatRefOffset, 0x0, 0x35, 0x9a, byte(FeatLRCPC2), 0,
 
 	1,
 	// ldapurh Wd, [Xn|SP {, #imm }]  ············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x23, 0x2c,
+	2, MatW, MatRefOffset, 0x0, 0x35, 0xa2, byte(FeatLRCPC2), 0,
 
 	2,
 	// ldapursb Wd, [Xn|SP {, #imm }]  ···········································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x23, 0x34,
+	2, MatW, MatRefOffset, 0x0, 0x35, 0xaa, byte(FeatLRCPC2), 0,
 	// ldapursb Xd, [Xn|SP {, #imm }]  ···········································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x23, 0x3c,
+	2, MatX, MatRefOffset, 0x0, 0x35, 0xb2, byte(FeatLRCPC2), 0,
 
 	2,
 	// ldapursh Wd, [Xn|SP {, #imm }]  ···········································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x23, 0x44,
+	2, MatW, MatRefOffset, 0x0, 0x35, 0xba, byte(FeatLRCPC2), 0,
 	// ldapursh Xd, [Xn|SP {, #imm }]  ···········································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x23, 0x4c,
+	2, MatX, MatRefOffset, 0x0, 0x35, 0xc2, byte(FeatLRCPC2), 0,
 
 	1,
 	// ldapursw Xd, [Xn|SP {, #imm }]  ···········································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x23, 0x54,
+	2, MatX, MatRefOffset, 0x0, 0x35, 0xca, byte(FeatLRCPC2), 0,
 
 	2,
 	// ldar Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0x5c,
+	2, MatW, MatRefBase, 0x0, 0x35, 0xd2, 0, 0,
 	// ldar Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x23, 0x63,
+	2, MatX, MatRefBase, 0x0, 0x35, 0xd9, 0, 0,
 
 	1,
 	// ldarb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0x6a,
+	2, MatW, MatRefBase, 0x0, 0x35, 0xe0, 0, 0,
 
 	1,
 	// ldarh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0x71,
+	2, MatW, MatRefBase, 0x0, 0x35, 0xe7, 0, 0,
 
 	2,
 	// ldaxp Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0x78,
+	3, MatW, MatW, MatRefBase, 0x0, 0x35, 0xee, 0, 0,
 	// ldaxp Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x23, 0x80,
+	3, MatX, MatX, MatRefBase, 0x0, 0x35, 0xf6, 0, 0,
 
 	2,
 	// ldaxr Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0x88,
+	2, MatW, MatRefBase, 0x0, 0x35, 0xfe, 0, 0,
 	// ldaxr Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x23, 0x8f,
+	2, MatX, MatRefBase, 0x0, 0x36, 0x5, 0, 0,
 
 	1,
 	// ldaxrb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0x96,
+	2, MatW, MatRefBase, 0x0, 0x36, 0xc, 0, 0,
 
 	1,
 	// ldaxrh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x23, 0x9d,
+	2, MatW, MatRefBase, 0x0, 0x36, 0x13, 0, 0,
 
 	2,
 	// ldclr Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xa4,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x1a, byte(FeatLSE), 0,
 	// ldclr Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x23, 0xac,
+	3, MatX, MatX, MatRefBase, 0x0, 0x36, 0x22, byte(FeatLSE), 0,
 
 	2,
 	// ldclra Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xb4,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x2a, byte(FeatLSE), 0,
 	// ldclra Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x23, 0xbc,
+	3, MatX, MatX, MatRefBase, 0x0, 0x36, 0x32, byte(FeatLSE), 0,
 
 	1,
 	// ldclrab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xc4,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x3a, byte(FeatLSE), 0,
 
 	1,
 	// ldclrah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xcc,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x42, byte(FeatLSE), 0,
 
 	2,
 	// ldclral Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xd4,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x4a, byte(FeatLSE), 0,
 	// ldclral Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x23, 0xdc,
+	3, MatX, MatX, MatRefBase, 0x0, 0x36, 0x52, byte(FeatLSE), 0,
 
 	1,
 	// ldclralb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xe4,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x5a, byte(FeatLSE), 0,
 
 	1,
 	// ldclralh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xec,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x62, byte(FeatLSE), 0,
 
 	1,
 	// ldclrb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xf4,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x6a, byte(FeatLSE), 0,
 
 	1,
 	// ldclrh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x23, 0xfc,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x72, byte(FeatLSE), 0,
 
 	2,
 	// ldclrl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x4,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x7a, byte(FeatLSE), 0,
 	// ldclrl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x24, 0xc,
+	3, MatX, MatX, MatRefBase, 0x0, 0x36, 0x82, byte(FeatLSE), 0,
 
 	1,
 	// ldclrlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x14,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x8a, byte(FeatLSE), 0,
 
 	1,
 	// ldclrlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x1c,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x92, byte(FeatLSE), 0,
 
 	2,
 	// ldeor Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x24,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0x9a, byte(FeatLSE), 0,
 	// ldeor Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x24, 0x2c,
+	3, MatX, MatX, MatRefBase, 0x0, 0x36, 0xa2, byte(FeatLSE), 0,
 
 	2,
 	// ldeora Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x34,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xaa, byte(FeatLSE), 0,
 	// ldeora Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x24, 0x3c,
+	3, MatX, MatX, MatRefBase, 0x0, 0x36, 0xb2, byte(FeatLSE), 0,
 
 	1,
 	// ldeorab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x44,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xba, byte(FeatLSE), 0,
 
 	1,
 	// ldeorah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x4c,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xc2, byte(FeatLSE), 0,
 
 	2,
 	// ldeoral Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x54,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xca, byte(FeatLSE), 0,
 	// ldeoral Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x24, 0x5c,
+	3, MatX, MatX, MatRefBase, 0x0, 0x36, 0xd2, byte(FeatLSE), 0,
 
 	1,
 	// ldeoralb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x64,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xda, byte(FeatLSE), 0,
 
 	1,
 	// ldeoralh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x6c,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xe2, byte(FeatLSE), 0,
 
 	1,
 	// ldeorb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x74,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xea, byte(FeatLSE), 0,
 
 	1,
 	// ldeorh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x7c,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xf2, byte(FeatLSE), 0,
 
 	2,
 	// ldeorl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x84,
+	3, MatW, MatW, MatRefBase, 0x0, 0x36, 0xfa, byte(FeatLSE), 0,
 	// ldeorl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x24, 0x8c,
+	3, MatX, MatX, MatRefBase, 0x0, 0x37, 0x2, byte(FeatLSE), 0,
 
 	1,
 	// ldeorlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x94,
+	3, MatW, MatW, MatRefBase, 0x0, 0x37, 0xa, byte(FeatLSE), 0,
 
 	1,
 	// ldeorlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x24, 0x9c,
+	3, MatW, MatW, MatRefBase, 0x0, 0x37, 0x12, byte(FeatLSE), 0,
+
+	1,
+	// ldff1b {Zd.B * 1}, Pg/Z, [Xn|SP, Xm]  ·················································  (g < 8)
+	3, MatZList, 1, byte(BYTE), MatPZ, MatRefIndexLSL, 0, 0x0, 0x37, 0x1a, byte(FeatSVE), 0,
+
+	1,
+	// ldff1d {Zd.D * 1}, Pg/Z, [Xn|SP, Xm, LSL #3]  ·········································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatPZ, MatRefIndexLSL, 3, 0x0, 0x37, 0x26, byte(FeatSVE), 0,
+
+	1,
+	// ldff1h {Zd.H * 1}, Pg/Z, [Xn|SP, Xm, LSL #1]  ·········································  (g < 8)
+	3, MatZList, 1, byte(WORD), MatPZ, MatRefIndexLSL, 1, 0x0, 0x37, 0x32, byte(FeatSVE), 0,
+
+	1,
+	// ldff1w {Zd.S * 1}, Pg/Z, [Xn|SP, Xm, LSL #2]  ·········································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatPZ, MatRefIndexLSL, 2, 0x0, 0x37, 0x3e, byte(FeatSVE), 0,
+
+	1,
+	// ldg Xd, [Xn|SP {, #imm }]  ····································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatX, MatRefOffset, 0x0, 0x37, 0x4a, byte(FeatMTE), 0,
 
 	2,
 	// ldlar Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x24, 0xa4,
+	2, MatW, MatRefBase, 0x0, 0x37, 0x53, byte(FeatLOR), 0,
 	// ldlar Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x24, 0xab,
+	2, MatX, MatRefBase, 0x0, 0x37, 0x5a, byte(FeatLOR), 0,
 
 	1,
 	// ldlarb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x24, 0xb2,
+	2, MatW, MatRefBase, 0x0, 0x37, 0x61, byte(FeatLOR), 0,
 
 	1,
 	// ldlarh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x24, 0xb9,
+	2, MatW, MatRefBase, 0x0, 0x37, 0x68, byte(FeatLOR), 0,
 
 	5,
 	// ldnp Sd, Sn, [Xm|SP {, #imm }]  ·································  (-256 <= imm < 256, imm >> 2)
-	3, MatS, MatS, MatRefOffset, 0x24, 0xc0,
+	3, MatS, MatS, MatRefOffset, 0x0, 0x37, 0x6f, 0, 0,
 	// ldnp Dd, Dn, [Xm|SP {, #imm }]  ·································  (-512 <= imm < 512, imm >> 3)
-	3, MatD, MatD, MatRefOffset, 0x24, 0xca,
+	3, MatD, MatD, MatRefOffset, 0x0, 0x37, 0x79, 0, 0,
 	// ldnp Qd, Qn, [Xm|SP {, #imm }]  ·······························  (-1024 <= imm < 1024, imm >> 4)
-	3, MatQ, MatQ, MatRefOffset, 0x24, 0xd4,
+	3, MatQ, MatQ, MatRefOffset, 0x0, 0x37, 0x83, 0, 0,
 	// ldnp Wd, Wn, [Xm|SP {, #imm }]  ·································  (-256 <= imm < 256, imm >> 2)
-	3, MatW, MatW, MatRefOffset, 0x24, 0xde,
+	3, MatW, MatW, MatRefOffset, 0x0, 0x37, 0x8d, 0, 0,
 	// ldnp Xd, Xn, [Xm|SP {, #imm }]  ·································  (-512 <= imm < 512, imm >> 3)
-	3, MatX, MatX, MatRefOffset, 0x24, 0xe8,
+	3, MatX, MatX, MatRefOffset, 0x0, 0x37, 0x97, 0, 0,
 
 	15,
 	// ldp Sd, Sn, [Xm|SP], #imm  ······································  (-256 <= imm < 256, imm >> 2)
-	4, MatS, MatS, MatRefBase, MatImm, 0x24, 0xf2,
+	4, MatS, MatS, MatRefBase, MatImm, 0x0, 0x37, 0xa1, 0, 0,
 	// ldp Dd, Dn, [Xm|SP], #imm  ······································  (-512 <= imm < 512, imm >> 3)
-	4, MatD, MatD, MatRefBase, MatImm, 0x24, 0xfc,
+	4, MatD, MatD, MatRefBase, MatImm, 0x0, 0x37, 0xab, 0, 0,
 	// ldp Qd, Qn, [Xm|SP], #imm  ····································  (-1024 <= imm < 1024, imm >> 4)
-	4, MatQ, MatQ, MatRefBase, MatImm, 0x25, 0x6,
+	4, MatQ, MatQ, MatRefBase, MatImm, 0x0, 0x37, 0xb5, 0, 0,
 	// ldp Sd, Sn, [Xm|SP, #imm]!  ·····································  (-256 <= imm < 256, imm >> 2)
-	3, MatS, MatS, MatRefPre, 0x25, 0x10,
+	3, MatS, MatS, MatRefPre, 0x0, 0x37, 0xbf, 0, 0,
 	// ldp Dd, Dn, [Xm|SP, #imm]!  ·····································  (-512 <= imm < 512, imm >> 3)
-	3, MatD, MatD, MatRefPre, 0x25, 0x1a,
+	3, MatD, MatD, MatRefPre, 0x0, 0x37, 0xc9, 0, 0,
 	// ldp Qd, Qn, [Xm|SP, #imm]!  ···································  (-1024 <= imm < 1024, imm >> 4)
-	3, MatQ, MatQ, MatRefPre, 0x25, 0x24,
+	3, MatQ, MatQ, MatRefPre, 0x0, 0x37, 0xd3, 0, 0,
 	// ldp Sd, Sn, [Xm|SP {, #imm }]  ··································  (-256 <= imm < 256, imm >> 2)
-	3, MatS, MatS, MatRefOffset, 0x25, 0x2e,
+	3, MatS, MatS, MatRefOffset, 0x0, 0x37, 0xdd, 0, 0,
 	// ldp Dd, Dn, [Xm|SP {, #imm }]  ··································  (-512 <= imm < 512, imm >> 3)
-	3, MatD, MatD, MatRefOffset, 0x25, 0x38,
+	3, MatD, MatD, MatRefOffset, 0x0, 0x37, 0xe7, 0, 0,
 	// ldp Qd, Qn, [Xm|SP {, #imm }]  ································  (-1024 <= imm < 1024, imm >> 4)
-	3, MatQ, MatQ, MatRefOffset, 0x25, 0x42,
+	3, MatQ, MatQ, MatRefOffset, 0x0, 0x37, 0xf1, 0, 0,
 	// ldp Wd, Wn, [Xm|SP], #imm  ······································  (-256 <= imm < 256, imm >> 2)
-	4, MatW, MatW, MatRefBase, MatImm, 0x25, 0x4c,
+	4, MatW, MatW, MatRefBase, MatImm, 0x0, 0x37, 0xfb, 0, 0,
 	// ldp Xd, Xn, [Xm|SP], #imm  ······································  (-512 <= imm < 512, imm >> 3)
-	4, MatX, MatX, MatRefBase, MatImm, 0x25, 0x56,
+	4, MatX, MatX, MatRefBase, MatImm, 0x0, 0x38, 0x5, 0, 0,
 	// ldp Wd, Wn, [Xm|SP, #imm]!  ·····································  (-256 <= imm < 256, imm >> 2)
-	3, MatW, MatW, MatRefPre, 0x25, 0x60,
+	3, MatW, MatW, MatRefPre, 0x0, 0x38, 0xf, 0, 0,
 	// ldp Xd, Xn, [Xm|SP, #imm]!  ·····································  (-512 <= imm < 512, imm >> 3)
-	3, MatX, MatX, MatRefPre, 0x25, 0x6a,
+	3, MatX, MatX, MatRefPre, 0x0, 0x38, 0x19, 0, 0,
 	// ldp Wd, Wn, [Xm|SP {, #imm }]  ··································  (-256 <= imm < 256, imm >> 2)
-	3, MatW, MatW, MatRefOffset, 0x25, 0x74,
+	3, MatW, MatW, MatRefOffset, 0x0, 0x38, 0x23, 0, 0,
 	// ldp Xd, Xn, [Xm|SP {, #imm }]  ··································  (-512 <= imm < 512, imm >> 3)
-	3, MatX, MatX, MatRefOffset, 0x25, 0x7e,
+	3, MatX, MatX, MatRefOffset, 0x0, 0x38, 0x2d, 0, 0,
 
 	3,
 	// ldpsw Xd, Xn, [Xm|SP], #imm  ····································  (-256 <= imm < 256, imm >> 2)
-	4, MatX, MatX, MatRefBase, MatImm, 0x25, 0x88,
+	4, MatX, MatX, MatRefBase, MatImm, 0x0, 0x38, 0x37, 0, 0,
 	// ldpsw Xd, Xn, [Xm|SP, #imm]!  ···································  (-256 <= imm < 256, imm >> 2)
-	3, MatX, MatX, MatRefPre, 0x25, 0x92,
+	3, MatX, MatX, MatRefPre, 0x0, 0x38, 0x41, 0, 0,
 	// ldpsw Xd, Xn, [Xm|SP {, #imm }]  ································  (-256 <= imm < 256, imm >> 2)
-	3, MatX, MatX, MatRefOffset, 0x25, 0x9c,
+	3, MatX, MatX, MatRefOffset, 0x0, 0x38, 0x4b, 0, 0,
 
-	33,
+	36,
 	// ldr Bd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatB, MatRefBase, MatImm, 0x25, 0xa6,
+	3, MatB, MatRefBase, MatImm, 0x0, 0x38, 0x55, 0, 0,
 	// ldr Hd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatH, MatRefBase, MatImm, 0x25, 0xae,
+	3, MatH, MatRefBase, MatImm, 0x0, 0x38, 0x5d, 0, 0,
 	// ldr Sd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatS, MatRefBase, MatImm, 0x25, 0xb6,
+	3, MatS, MatRefBase, MatImm, 0x0, 0x38, 0x65, 0, 0,
 	// ldr Dd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatD, MatRefBase, MatImm, 0x25, 0xbe,
+	3, MatD, MatRefBase, MatImm, 0x0, 0x38, 0x6d, 0, 0,
 	// ldr Qd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatQ, MatRefBase, MatImm, 0x25, 0xc6,
+	3, MatQ, MatRefBase, MatImm, 0x0, 0x38, 0x75, 0, 0,
 	// ldr Bd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatB, MatRefPre, 0x25, 0xce,
+	2, MatB, MatRefPre, 0x0, 0x38, 0x7d, 0, 0,
 	// ldr Hd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatH, MatRefPre, 0x25, 0xd6,
+	2, MatH, MatRefPre, 0x0, 0x38, 0x85, 0, 0,
 	// ldr Sd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatS, MatRefPre, 0x25, 0xde,
+	2, MatS, MatRefPre, 0x0, 0x38, 0x8d, 0, 0,
 	// ldr Dd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatD, MatRefPre, 0x25, 0xe6,
+	2, MatD, MatRefPre, 0x0, 0x38, 0x95, 0, 0,
 	// ldr Qd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatQ, MatRefPre, 0x25, 0xee,
+	2, MatQ, MatRefPre, 0x0, 0x38, 0x9d, 0, 0,
 	// ldr Bd, [Xn|SP {, #imm }]  ··················································  (0 <= imm < 4096)
-	2, MatB, MatRefOffset, 0x25, 0xf6,
+	2, MatB, MatRefOffset, 0x0, 0x38, 0xa5, 0, 0,
 	// ldr Hd, [Xn|SP {, #imm }]  ········································  (0 <= imm < 8192, imm >> 1)
-	2, MatH, MatRefOffset, 0x26, 0x0,
+	2, MatH, MatRefOffset, 0x0, 0x38, 0xaf, 0, 0,
 	// ldr Sd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 16384, imm >> 2)
-	2, MatS, MatRefOffset, 0x26, 0xb,
+	2, MatS, MatRefOffset, 0x0, 0x38, 0xba, 0, 0,
 	// ldr Dd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 32768, imm >> 3)
-	2, MatD, MatRefOffset, 0x26, 0x16,
+	2, MatD, MatRefOffset, 0x0, 0x38, 0xc5, 0, 0,
 	// ldr Qd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 65536, imm >> 4)
-	2, MatQ, MatRefOffset, 0x26, 0x21,
+	2, MatQ, MatRefOffset, 0x0, 0x38, 0xd0, 0, 0,
 	// ldr Wd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x26, 0x2c,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x38, 0xdb, 0, 0,
 	// ldr Xd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatX, MatRefBase, MatImm, 0x26, 0x34,
+	3, MatX, MatRefBase, MatImm, 0x0, 0x38, 0xe3, 0, 0,
 	// ldr Wd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x26, 0x3c,
+	2, MatW, MatRefPre, 0x0, 0x38, 0xeb, 0, 0,
 	// ldr Xd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatX, MatRefPre, 0x26, 0x44,
+	2, MatX, MatRefPre, 0x0, 0x38, 0xf3, 0, 0,
 	// ldr Wd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 16384, imm >> 2)
-	2, MatW, MatRefOffset, 0x26, 0x4c,
+	2, MatW, MatRefOffset, 0x0, 0x38, 0xfb, 0, 0,
 	// ldr Xd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 32768, imm >> 3)
-	2, MatX, MatRefOffset, 0x26, 0x57,
+	2, MatX, MatRefOffset, 0x0, 0x39, 0x6, 0, 0,
 	// ldr Sd, <offset>  ··········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatS, MatOffset, 0x26, 0x62,
+	2, MatS, MatOffset, 0x0, 0x39, 0x11, 0, 0,
 	// ldr Dd, <offset>  ··········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatD, MatOffset, 0x26, 0x6a,
+	2, MatD, MatOffset, 0x0, 0x39, 0x19, 0, 0,
 	// ldr Qd, <offset>  ··········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatQ, MatOffset, 0x26, 0x72,
+	2, MatQ, MatOffset, 0x0, 0x39, 0x21, 0, 0,
 	// ldr Wd, <offset>  ··········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatW, MatOffset, 0x26, 0x7a,
+	2, MatW, MatOffset, 0x0, 0x39, 0x29, 0, 0,
 	// ldr Xd, <offset>  ··········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatX, MatOffset, 0x26, 0x82,
+	2, MatX, MatOffset, 0x0, 0x39, 0x31, 0, 0,
 	// ldr Bd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ·······························  (imm == 0)
-	2, MatB, MatRefIndex, 0x26, 0x8a,
+	2, MatB, MatRefIndex, 0x0, 0x39, 0x39, 0, 0,
 	// ldr Hd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 1])
-	2, MatH, MatRefIndex, 0x26, 0x96,
+	2, MatH, MatRefIndex, 0x0, 0x39, 0x45, 0, 0,
 	// ldr Sd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 2])
-	2, MatS, MatRefIndex, 0x26, 0xa2,
+	2, MatS, MatRefIndex, 0x0, 0x39, 0x51, 0, 0,
 	// ldr Dd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 3])
-	2, MatD, MatRefIndex, 0x26, 0xae,
+	2, MatD, MatRefIndex, 0x0, 0x39, 0x5d, 0, 0,
 	// ldr Qd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 4])
-	2, MatQ, MatRefIndex, 0x26, 0xba,
+	2, MatQ, MatRefIndex, 0x0, 0x39, 0x69, 0, 0,
 	// ldr Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 2])
-	2, MatW, MatRefIndex, 0x26, 0xc6,
+	2, MatW, MatRefIndex, 0x0, 0x39, 0x75, 0, 0,
 	// ldr Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 3])
-	2, MatX, MatRefIndex, 0x26, 0xd2,
+	2, MatX, MatRefIndex, 0x0, 0x39, 0x81, 0, 0,
+	// ldr ZA[W12-W15, #imm1], [Xn|SP {, #imm2, MUL VL }]  ············  (0 <= imm1 < 16, imm2 == imm1)
+	2, MatZASlice, 0, MatRefVL, 0x0, 0x39, 0x8d, byte(FeatSME), 0,
+	// ldr Zd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	2, MatZ, 0, MatRefVL, 0x0, 0x39, 0x9c, byte(FeatSVE), 0,
+	// ldr Pd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	2, MatP, 0, MatRefVL, 0x0, 0x39, 0xae, byte(FeatSVE), 0,
 
 	2,
 	// ldraa Xd, [Xn|SP {, #imm }]  ··································  (-4096 <= imm < 4096, imm >> 3)
-	2, MatX, MatRefOffset, 0x26, 0xde,
+	2, MatX, MatRefOffset, 0x0, 0x39, 0xc0, byte(FeatPAuth), 0,
 	// ldraa Xd, [Xn|SP, #imm]!  ·····································  (-4096 <= imm < 4096, imm >> 3)
-	2, MatX, MatRefPre, 0x26, 0xef,
+	2, MatX, MatRefPre, 0x0, 0x39, 0xd1, byte(FeatPAuth), 0,
 
 	2,
 	// ldrab Xd, [Xn|SP {, #imm }]  ··································  (-4096 <= imm < 4096, imm >> 3)
-	2, MatX, MatRefOffset, 0x27, 0x0,
+	2, MatX, MatRefOffset, 0x0, 0x39, 0xe2, byte(FeatPAuth), 0,
 	// ldrab Xd, [Xn|SP, #imm]!  ·····································  (-4096 <= imm < 4096, imm >> 3)
-	2, MatX, MatRefPre, 0x27, 0x11,
+	2, MatX, MatRefPre, 0x0, 0x39, 0xf3, byte(FeatPAuth), 0,
 
 	4,
 	// ldrb Wd, [Xn|SP], #imm  ···················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x27, 0x22,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x3a, 0x4, 0, 0,
 	// ldrb Wd, [Xn|SP, #imm]!  ··················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x27, 0x2a,
+	2, MatW, MatRefPre, 0x0, 0x3a, 0xc, 0, 0,
 	// ldrb Wd, [Xn|SP {, #imm }]  ·················································  (0 <= imm < 4096)
-	2, MatW, MatRefOffset, 0x27, 0x32,
+	2, MatW, MatRefOffset, 0x0, 0x3a, 0x14, 0, 0,
 	// ldrb Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ······························  (imm == 0)
-	2, MatW, MatRefIndex, 0x27, 0x3c,
+	2, MatW, MatRefIndex, 0x0, 0x3a, 0x1e, 0, 0,
 
 	4,
 	// ldrh Wd, [Xn|SP], #imm  ···················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x27, 0x48,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x3a, 0x2a, 0, 0,
 	// ldrh Wd, [Xn|SP, #imm]!  ··················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x27, 0x50,
+	2, MatW, MatRefPre, 0x0, 0x3a, 0x32, 0, 0,
 	// ldrh Wd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 8192, imm >> 1)
-	2, MatW, MatRefOffset, 0x27, 0x58,
+	2, MatW, MatRefOffset, 0x0, 0x3a, 0x3a, 0, 0,
 	// ldrh Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ·························  (imm in [0, 1])
-	2, MatW, MatRefIndex, 0x27, 0x63,
+	2, MatW, MatRefIndex, 0x0, 0x3a, 0x45, 0, 0,
 
 	8,
 	// ldrsb Wd, [Xn|SP], #imm  ··················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x27, 0x6f,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x3a, 0x51, 0, 0,
 	// ldrsb Xd, [Xn|SP], #imm  ··················································  (-256 <= imm < 256)
-	3, MatX, MatRefBase, MatImm, 0x27, 0x77,
+	3, MatX, MatRefBase, MatImm, 0x0, 0x3a, 0x59, 0, 0,
 	// ldrsb Wd, [Xn|SP, #imm]!  ·················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x27, 0x7f,
+	2, MatW, MatRefPre, 0x0, 0x3a, 0x61, 0, 0,
 	// ldrsb Xd, [Xn|SP, #imm]!  ·················································  (-256 <= imm < 256)
-	2, MatX, MatRefPre, 0x27, 0x87,
+	2, MatX, MatRefPre, 0x0, 0x3a, 0x69, 0, 0,
 	// ldrsb Wd, [Xn|SP {, #imm }]  ················································  (0 <= imm < 4096)
-	2, MatW, MatRefOffset, 0x27, 0x8f,
+	2, MatW, MatRefOffset, 0x0, 0x3a, 0x71, 0, 0,
 	// ldrsb Xd, [Xn|SP {, #imm }]  ················································  (0 <= imm < 4096)
-	2, MatX, MatRefOffset, 0x27, 0x99,
+	2, MatX, MatRefOffset, 0x0, 0x3a, 0x7b, 0, 0,
 	// ldrsb Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ·····························  (imm == 0)
-	2, MatW, MatRefIndex, 0x27, 0xa3,
+	2, MatW, MatRefIndex, 0x0, 0x3a, 0x85, 0, 0,
 	// ldrsb Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ·····························  (imm == 0)
-	2, MatX, MatRefIndex, 0x27, 0xaf,
+	2, MatX, MatRefIndex, 0x0, 0x3a, 0x91, 0, 0,
 
 	8,
 	// ldrsh Wd, [Xn|SP], #imm  ··················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x27, 0xbb,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x3a, 0x9d, 0, 0,
 	// ldrsh Xd, [Xn|SP], #imm  ··················································  (-256 <= imm < 256)
-	3, MatX, MatRefBase, MatImm, 0x27, 0xc3,
+	3, MatX, MatRefBase, MatImm, 0x0, 0x3a, 0xa5, 0, 0,
 	// ldrsh Wd, [Xn|SP, #imm]!  ·················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x27, 0xcb,
+	2, MatW, MatRefPre, 0x0, 0x3a, 0xad, 0, 0,
 	// ldrsh Xd, [Xn|SP, #imm]!  ·················································  (-256 <= imm < 256)
-	2, MatX, MatRefPre, 0x27, 0xd3,
+	2, MatX, MatRefPre, 0x0, 0x3a, 0xb5, 0, 0,
 	// ldrsh Wd, [Xn|SP {, #imm }]  ······································  (0 <= imm < 8192, imm >> 1)
-	2, MatW, MatRefOffset, 0x27, 0xdb,
+	2, MatW, MatRefOffset, 0x0, 0x3a, 0xbd, 0, 0,
 	// ldrsh Xd, [Xn|SP {, #imm }]  ······································  (0 <= imm < 8192, imm >> 1)
-	2, MatX, MatRefOffset, 0x27, 0xe6,
+	2, MatX, MatRefOffset, 0x0, 0x3a, 0xc8, 0, 0,
 	// ldrsh Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ························  (imm in [0, 1])
-	2, MatW, MatRefIndex, 0x27, 0xf1,
+	2, MatW, MatRefIndex, 0x0, 0x3a, 0xd3, 0, 0,
 	// ldrsh Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ························  (imm in [0, 1])
-	2, MatX, MatRefIndex, 0x27, 0xfd,
+	2, MatX, MatRefIndex, 0x0, 0x3a, 0xdf, 0, 0,
 
 	5,
 	// ldrsw Xd, [Xn|SP], #imm  ··················································  (-256 <= imm < 256)
-	3, MatX, MatRefBase, MatImm, 0x28, 0x9,
+	3, MatX, MatRefBase, MatImm, 0x0, 0x3a, 0xeb, 0, 0,
 	// ldrsw Xd, [Xn|SP, #imm]!  ·················································  (-256 <= imm < 256)
-	2, MatX, MatRefPre, 0x28, 0x11,
+	2, MatX, MatRefPre, 0x0, 0x3a, 0xf3, 0, 0,
 	// ldrsw Xd, [Xn|SP {, #imm }]  ·····································  (0 <= imm < 16384, imm >> 2)
-	2, MatX, MatRefOffset, 0x28, 0x19,
+	2, MatX, MatRefOffset, 0x0, 0x3a, 0xfb, 0, 0,
 	// ldrsw Xd, <offset>  ········································  (offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatX, MatOffset, 0x28, 0x24,
+	2, MatX, MatOffset, 0x0, 0x3b, 0x6, 0, 0,
 	// ldrsw Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ························  (imm in [0, 2])
-	2, MatX, MatRefIndex, 0x28, 0x2c,
+	2, MatX, MatRefIndex, 0x0, 0x3b, 0xe, 0, 0,
 
 	2,
 	// ldset Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x38,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x1a, byte(FeatLSE), 0,
 	// ldset Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x28, 0x40,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3b, 0x22, byte(FeatLSE), 0,
 
 	2,
 	// ldseta Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x48,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x2a, byte(FeatLSE), 0,
 	// ldseta Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x28, 0x50,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3b, 0x32, byte(FeatLSE), 0,
 
 	1,
 	// ldsetab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x58,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x3a, byte(FeatLSE), 0,
 
 	1,
 	// ldsetah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x60,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x42, byte(FeatLSE), 0,
 
 	2,
 	// ldsetal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x68,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x4a, byte(FeatLSE), 0,
 	// ldsetal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x28, 0x70,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3b, 0x52, byte(FeatLSE), 0,
 
 	1,
 	// ldsetalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x78,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x5a, byte(FeatLSE), 0,
 
 	1,
 	// ldsetalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x80,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x62, byte(FeatLSE), 0,
 
 	1,
 	// ldsetb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x88,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x6a, byte(FeatLSE), 0,
 
 	1,
 	// ldseth Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x90,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x72, byte(FeatLSE), 0,
 
 	2,
 	// ldsetl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0x98,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x7a, byte(FeatLSE), 0,
 	// ldsetl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x28, 0xa0,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3b, 0x82, byte(FeatLSE), 0,
 
 	1,
 	// ldsetlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xa8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x8a, byte(FeatLSE), 0,
 
 	1,
 	// ldsetlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xb0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x92, byte(FeatLSE), 0,
 
 	2,
 	// ldsmax Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xb8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0x9a, byte(FeatLSE), 0,
 	// ldsmax Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x28, 0xc0,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3b, 0xa2, byte(FeatLSE), 0,
 
 	2,
 	// ldsmaxa Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xc8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xaa, byte(FeatLSE), 0,
 	// ldsmaxa Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x28, 0xd0,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3b, 0xb2, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xd8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xba, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xe0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xc2, byte(FeatLSE), 0,
 
 	2,
 	// ldsmaxal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xe8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xca, byte(FeatLSE), 0,
 	// ldsmaxal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x28, 0xf0,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3b, 0xd2, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x28, 0xf8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xda, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xe2, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xea, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x10,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xf2, byte(FeatLSE), 0,
 
 	2,
 	// ldsmaxl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x18,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3b, 0xfa, byte(FeatLSE), 0,
 	// ldsmaxl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x29, 0x20,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3c, 0x2, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x28,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0xa, byte(FeatLSE), 0,
 
 	1,
 	// ldsmaxlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x30,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x12, byte(FeatLSE), 0,
 
 	2,
 	// ldsmin Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x38,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x1a, byte(FeatLSE), 0,
 	// ldsmin Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x29, 0x40,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3c, 0x22, byte(FeatLSE), 0,
 
 	2,
 	// ldsmina Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x48,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x2a, byte(FeatLSE), 0,
 	// ldsmina Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x29, 0x50,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3c, 0x32, byte(FeatLSE), 0,
 
 	1,
 	// ldsminab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x58,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x3a, byte(FeatLSE), 0,
 
 	1,
 	// ldsminah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x60,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x42, byte(FeatLSE), 0,
 
 	2,
 	// ldsminal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x68,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x4a, byte(FeatLSE), 0,
 	// ldsminal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x29, 0x70,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3c, 0x52, byte(FeatLSE), 0,
 
 	1,
 	// ldsminalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x78,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x5a, byte(FeatLSE), 0,
 
 	1,
 	// ldsminalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x80,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x62, byte(FeatLSE), 0,
 
 	1,
 	// ldsminb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x88,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x6a, byte(FeatLSE), 0,
 
 	1,
 	// ldsminh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x90,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x72, byte(FeatLSE), 0,
 
 	2,
 	// ldsminl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0x98,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x7a, byte(FeatLSE), 0,
 	// ldsminl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x29, 0xa0,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3c, 0x82, byte(FeatLSE), 0,
 
 	1,
 	// ldsminlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0xa8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x8a, byte(FeatLSE), 0,
 
 	1,
 	// ldsminlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x29, 0xb0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0x92, byte(FeatLSE), 0,
 
 	2,
 	// ldtr Wd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x29, 0xb8,
+	2, MatW, MatRefOffset, 0x0, 0x3c, 0x9a, 0, 0,
 	// ldtr Xd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x29, 0xc0,
+	2, MatX, MatRefOffset, 0x0, 0x3c, 0xa2, 0, 0,
 
 	1,
 	// ldtrb Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x29, 0xc8,
+	2, MatW, MatRefOffset, 0x0, 0x3c, 0xaa, 0, 0,
 
 	1,
 	// ldtrh Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x29, 0xd0,
+	2, MatW, MatRefOffset, 0x0, 0x3c, 0xb2, 0, 0,
 
 	2,
 	// ldtrsb Wd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x29, 0xd8,
+	2, MatW, MatRefOffset, 0x0, 0x3c, 0xba, 0, 0,
 	// ldtrsb Xd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x29, 0xe0,
+	2, MatX, MatRefOffset, 0x0, 0x3c, 0xc2, 0, 0,
 
 	2,
 	// ldtrsh Wd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x29, 0xe8,
+	2, MatW, MatRefOffset, 0x0, 0x3c, 0xca, 0, 0,
 	// ldtrsh Xd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x29, 0xf0,
+	2, MatX, MatRefOffset, 0x0, 0x3c, 0xd2, 0, 0,
 
 	1,
 	// ldtrsw Xd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x29, 0xf8,
+	2, MatX, MatRefOffset, 0x0, 0x3c, 0xda, 0, 0,
 
 	2,
 	// ldumax Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0xe2, byte(FeatLSE), 0,
 	// ldumax Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0x8,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3c, 0xea, byte(FeatLSE), 0,
 
 	2,
 	// ldumaxa Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x10,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3c, 0xf2, byte(FeatLSE), 0,
 	// ldumaxa Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0x18,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3c, 0xfa, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x20,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x2, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x28,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xa, byte(FeatLSE), 0,
 
 	2,
 	// ldumaxal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x30,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x12, byte(FeatLSE), 0,
 	// ldumaxal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0x38,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3d, 0x1a, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x40,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x22, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x48,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x2a, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x50,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x32, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x58,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x3a, byte(FeatLSE), 0,
 
 	2,
 	// ldumaxl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x60,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x42, byte(FeatLSE), 0,
 	// ldumaxl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0x68,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3d, 0x4a, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x70,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x52, byte(FeatLSE), 0,
 
 	1,
 	// ldumaxlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x78,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x5a, byte(FeatLSE), 0,
 
 	2,
 	// ldumin Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x80,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x62, byte(FeatLSE), 0,
 	// ldumin Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0x88,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3d, 0x6a, byte(FeatLSE), 0,
 
 	2,
 	// ldumina Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0x90,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x72, byte(FeatLSE), 0,
 	// ldumina Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0x98,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3d, 0x7a, byte(FeatLSE), 0,
 
 	1,
 	// lduminab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xa0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x82, byte(FeatLSE), 0,
 
 	1,
 	// lduminah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xa8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x8a, byte(FeatLSE), 0,
 
 	2,
 	// lduminal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xb0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0x92, byte(FeatLSE), 0,
 	// lduminal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0xb8,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3d, 0x9a, byte(FeatLSE), 0,
 
 	1,
 	// lduminalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xc0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xa2, byte(FeatLSE), 0,
 
 	1,
 	// lduminalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xc8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xaa, byte(FeatLSE), 0,
 
 	1,
 	// lduminb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xd0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xb2, byte(FeatLSE), 0,
 
 	1,
 	// lduminh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xd8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xba, byte(FeatLSE), 0,
 
 	2,
 	// lduminl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xe0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xc2, byte(FeatLSE), 0,
 	// lduminl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2a, 0xe8,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3d, 0xca, byte(FeatLSE), 0,
 
 	1,
 	// lduminlb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xf0,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xd2, byte(FeatLSE), 0,
 
 	1,
 	// lduminlh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2a, 0xf8,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3d, 0xda, byte(FeatLSE), 0,
 
 	7,
 	// ldur Bd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatB, MatRefOffset, 0x2b, 0x0,
+	2, MatB, MatRefOffset, 0x0, 0x3d, 0xe2, 0, 0,
 	// ldur Hd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatH, MatRefOffset, 0x2b, 0x8,
+	2, MatH, MatRefOffset, 0x0, 0x3d, 0xea, 0, 0,
 	// ldur Sd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatS, MatRefOffset, 0x2b, 0x10,
+	2, MatS, MatRefOffset, 0x0, 0x3d, 0xf2, 0, 0,
 	// ldur Dd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatD, MatRefOffset, 0x2b, 0x18,
+	2, MatD, MatRefOffset, 0x0, 0x3d, 0xfa, 0, 0,
 	// ldur Qd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatQ, MatRefOffset, 0x2b, 0x20,
+	2, MatQ, MatRefOffset, 0x0, 0x3e, 0x2, 0, 0,
 	// ldur Wd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x2b, 0x28,
+	2, MatW, MatRefOffset, 0x0, 0x3e, 0xa, 0, 0,
 	// ldur Xd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x2b, 0x30,
+	2, MatX, MatRefOffset, 0x0, 0x3e, 0x12, 0, 0,
 
 	1,
 	// ldurb Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x2b, 0x38,
+	2, MatW, MatRefOffset, 0x0, 0x3e, 0x1a, 0, 0,
 
 	1,
 	// ldurh Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x2b, 0x40,
+	2, MatW, MatRefOffset, 0x0, 0x3e, 0x22, 0, 0,
 
 	2,
 	// ldursb Wd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x2b, 0x48,
+	2, MatW, MatRefOffset, 0x0, 0x3e, 0x2a, 0, 0,
 	// ldursb Xd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x2b, 0x50,
+	2, MatX, MatRefOffset, 0x0, 0x3e, 0x32, 0, 0,
 
 	2,
 	// ldursh Wd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x2b, 0x58,
+	2, MatW, MatRefOffset, 0x0, 0x3e, 0x3a, 0, 0,
 	// ldursh Xd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x2b, 0x60,
+	2, MatX, MatRefOffset, 0x0, 0x3e, 0x42, 0, 0,
 
 	1,
 	// ldursw Xd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x2b, 0x68,
+	2, MatX, MatRefOffset, 0x0, 0x3e, 0x4a, 0, 0,
 
 	2,
 	// ldxp Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x2b, 0x70,
+	3, MatW, MatW, MatRefBase, 0x0, 0x3e, 0x52, 0, 0,
 	// ldxp Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x2b, 0x78,
+	3, MatX, MatX, MatRefBase, 0x0, 0x3e, 0x5a, 0, 0,
 
 	2,
 	// ldxr Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x2b, 0x80,
+	2, MatW, MatRefBase, 0x0, 0x3e, 0x62, 0, 0,
 	// ldxr Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x2b, 0x87,
+	2, MatX, MatRefBase, 0x0, 0x3e, 0x69, 0, 0,
 
 	1,
 	// ldxrb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x2b, 0x8e,
+	2, MatW, MatRefBase, 0x0, 0x3e, 0x70, 0, 0,
 
 	1,
 	// ldxrh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x2b, 0x95,
+	2, MatW, MatRefBase, 0x0, 0x3e, 0x77, 0, 0,
 
-	4,
+	16,
 	// lsl Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2b, 0x9c,
+	3, MatW, MatW, MatW, 0x0, 0x3e, 0x7e, 0, 0,
 	// lsl Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2b, 0xa4,
+	3, MatX, MatX, MatX, 0x0, 0x3e, 0x86, 0, 0,
 	// lsl Wd, Wn, #imm  ·····························································  (0 <= imm < 32)
-	3, MatW, MatW, MatImm, 0x2b, 0xac,
+	3, MatW, MatW, MatImm, 0x0, 0x3e, 0x8e, 0, 0,
 	// lsl Xd, Xn, #imm  ·····························································  (0 <= imm < 64)
-	3, MatX, MatX, MatImm, 0x2b, 0xbb,
+	3, MatX, MatX, MatImm, 0x0, 0x3e, 0x9d, 0, 0,
+	// lsl Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x3e, 0xac, byte(FeatSVE), 0,
+	// lsl Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x3e, 0xb7, byte(FeatSVE), 0,
+	// lsl Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x3e, 0xc2, byte(FeatSVE), 0,
+	// lsl Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x3e, 0xcd, byte(FeatSVE), 0,
+	// lsl Zd.B, Zn.B, #imm  ··························································  (0 <= imm < 8)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x3e, 0xd8, byte(FeatSVE), 0,
+	// lsl Zd.H, Zn.H, #imm  ·························································  (0 <= imm < 16)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x3e, 0xe2, byte(FeatSVE), 0,
+	// lsl Zd.S, Zn.S, #imm  ·························································  (0 <= imm < 32)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x3e, 0xec, byte(FeatSVE), 0,
+	// lsl Zd.D, Zn.D, #imm  ·························································  (0 <= imm < 64)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x3e, 0xf6, byte(FeatSVE), 0,
+	// lsl Zd.B, Pg/M, Zn.B, #imm  ·····································  (g < 8, n == d, 0 <= imm < 8)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatImm, 0x0, 0x3f, 0x8, byte(FeatSVE), 0,
+	// lsl Zd.H, Pg/M, Zn.H, #imm  ····································  (g < 8, n == d, 0 <= imm < 16)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatImm, 0x0, 0x3f, 0x15, byte(FeatSVE), 0,
+	// lsl Zd.S, Pg/M, Zn.S, #imm  ····································  (g < 8, n == d, 0 <= imm < 32)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatImm, 0x0, 0x3f, 0x22, byte(FeatSVE), 0,
+	// lsl Zd.D, Pg/M, Zn.D, #imm  ····································  (g < 8, n == d, 0 <= imm < 64)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatImm, 0x0, 0x3f, 0x2f, byte(FeatSVE), 0,
 
 	2,
 	// lslv Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2b, 0xca,
+	3, MatW, MatW, MatW, 0x0, 0x3f, 0x44, 0, 0,
 	// lslv Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2b, 0xd2,
+	3, MatX, MatX, MatX, 0x0, 0x3f, 0x4c, 0, 0,
 
-	4,
+	16,
 	// lsr Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2b, 0xda,
+	3, MatW, MatW, MatW, 0x0, 0x3f, 0x54, 0, 0,
 	// lsr Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2b, 0xe2,
+	3, MatX, MatX, MatX, 0x0, 0x3f, 0x5c, 0, 0,
 	// lsr Wd, Wn, #imm  ·····························································  (0 <= imm < 32)
-	3, MatW, MatW, MatImm, 0x2b, 0xea,
+	3, MatW, MatW, MatImm, 0x0, 0x3f, 0x64, 0, 0,
 	// lsr Xd, Xn, #imm  ·····························································  (0 <= imm < 64)
-	3, MatX, MatX, MatImm, 0x2b, 0xf4,
+	3, MatX, MatX, MatImm, 0x0, 0x3f, 0x6e, 0, 0,
+	// lsr Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x3f, 0x78, byte(FeatSVE), 0,
+	// lsr Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x3f, 0x83, byte(FeatSVE), 0,
+	// lsr Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x3f, 0x8e, byte(FeatSVE), 0,
+	// lsr Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x3f, 0x99, byte(FeatSVE), 0,
+	// lsr Zd.B, Zn.B, #imm  ··························································  (0 < imm <= 8)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x3f, 0xa4, byte(FeatSVE), 0,
+	// lsr Zd.H, Zn.H, #imm  ·························································  (0 < imm <= 16)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x3f, 0xaf, byte(FeatSVE), 0,
+	// lsr Zd.S, Zn.S, #imm  ·························································  (0 < imm <= 32)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x3f, 0xba, byte(FeatSVE), 0,
+	// lsr Zd.D, Zn.D, #imm  ·························································  (0 < imm <= 64)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x3f, 0xc5, byte(FeatSVE), 0,
+	// lsr Zd.B, Pg/M, Zn.B, #imm  ·····································  (g < 8, n == d, 0 < imm <= 8)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatImm, 0x0, 0x3f, 0xcf, byte(FeatSVE), 0,
+	// lsr Zd.H, Pg/M, Zn.H, #imm  ····································  (g < 8, n == d, 0 < imm <= 16)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatImm, 0x0, 0x3f, 0xdd, byte(FeatSVE), 0,
+	// lsr Zd.S, Pg/M, Zn.S, #imm  ····································  (g < 8, n == d, 0 < imm <= 32)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatImm, 0x0, 0x3f, 0xeb, byte(FeatSVE), 0,
+	// lsr Zd.D, Pg/M, Zn.D, #imm  ····································  (g < 8, n == d, 0 < imm <= 64)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatImm, 0x0, 0x3f, 0xf9, byte(FeatSVE), 0,
 
 	2,
 	// lsrv Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2b, 0xfe,
+	3, MatW, MatW, MatW, 0x0, 0x40, 0x6, 0, 0,
 	// lsrv Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2c, 0x6,
+	3, MatX, MatX, MatX, 0x0, 0x40, 0xe, 0, 0,
 
 	2,
 	// madd Wd, Wn, Wm, Wa
-	4, MatW, MatW, MatW, MatW, 0x2c, 0xe,
+	4, MatW, MatW, MatW, MatW, 0x0, 0x40, 0x16, 0, 0,
 	// madd Xd, Xn, Xm, Xa
-	4, MatX, MatX, MatX, MatX, 0x2c, 0x17,
+	4, MatX, MatX, MatX, MatX, 0x0, 0x40, 0x1f, 0, 0,
 
 	5,
 	// mla Vd.8H, Vn.8H, Vm.H[i]  ···························································  (m < 16)
 	// mla Vd.4H, Vn.4H, Vm.H[i]  ···························································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x2c, 0x20,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x40, 0x28, 0, 0,
 	// mla Vd.4S, Vn.4S, Vm.S[i]
 	// mla Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x2c, 0x2b,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x40, 0x33, 0, 0,
 	// mla Vd.16B, Vn.16B, Vm.16B
 	// mla Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x2c, 0x36,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x40, 0x3e, 0, 0,
 	// mla Vd.8H, Vn.8H, Vm.8H
 	// mla Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x2c, 0x3f,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x40, 0x47, 0, 0,
 	// mla Vd.4S, Vn.4S, Vm.4S
 	// mla Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x2c, 0x48,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x40, 0x50, 0, 0,
 
 	5,
 	// mls Vd.8H, Vn.8H, Vm.H[i]  ···························································  (m < 16)
 	// mls Vd.4H, Vn.4H, Vm.H[i]  ···························································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x2c, 0x51,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x40, 0x59, 0, 0,
 	// mls Vd.4S, Vn.4S, Vm.S[i]
 	// mls Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x2c, 0x5c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x40, 0x64, 0, 0,
 	// mls Vd.16B, Vn.16B, Vm.16B
 	// mls Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x2c, 0x67,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x40, 0x6f, 0, 0,
 	// mls Vd.8H, Vn.8H, Vm.8H
 	// mls Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x2c, 0x70,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x40, 0x78, 0, 0,
 	// mls Vd.4S, Vn.4S, Vm.4S
 	// mls Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x2c, 0x79,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x40, 0x81, 0, 0,
 
 	2,
 	// mneg Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2c, 0x82,
+	3, MatW, MatW, MatW, 0x0, 0x40, 0x8a, 0, 0,
 	// mneg Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2c, 0x8a,
+	3, MatX, MatX, MatX, 0x0, 0x40, 0x92, 0, 0,
 
 	25,
 	// mov Wd, Wn
-	2, MatW, MatW, 0x2c, 0x92,
+	2, MatW, MatW, 0x0, 0x40, 0x9a, 0, 0,
 	// mov Xd, Xn
-	2, MatX, MatX, 0x2c, 0x99,
+	2, MatX, MatX, 0x0, 0x40, 0xa1, 0, 0,
 	// mov Wd|WSP, Wn|WSP
-	2, MatWSP, MatWSP, 0x2c, 0xa0,
+	2, MatWSP, MatWSP, 0x0, 0x40, 0xa8, 0, 0,
 	// mov Xd|SP, Xn|SP
-	2, MatXSP, MatXSP, 0x2c, 0xa7,
+	2, MatXSP, MatXSP, 0x0, 0x40, 0xaf, 0, 0,
 	// mov Bd, Vn.B[i]
-	2, MatB, MatVElement, byte(BYTE), 0x2c, 0xae,
+	2, MatB, MatVElement, byte(BYTE), 0x0, 0x40, 0xb6, 0, 0,
 	// mov Hd, Vn.H[i]
-	2, MatH, MatVElement, byte(WORD), 0x2c, 0xb8,
+	2, MatH, MatVElement, byte(WORD), 0x0, 0x40, 0xc0, 0, 0,
 	// mov Sd, Vn.S[i]
-	2, MatS, MatVElement, byte(DWORD), 0x2c, 0xc2,
+	2, MatS, MatVElement, byte(DWORD), 0x0, 0x40, 0xca, 0, 0,
 	// mov Dd, Vn.D[i]
-	2, MatD, MatVElement, byte(QWORD), 0x2c, 0xcc,
+	2, MatD, MatVElement, byte(QWORD), 0x0, 0x40, 0xd4, 0, 0,
 	// mov Vd.B[i], Vn.B[i]
-	2, MatVElement, byte(BYTE), MatVElement, byte(BYTE), 0x2c, 0xd6,
+	2, MatVElement, byte(BYTE), MatVElement, byte(BYTE), 0x0, 0x40, 0xde, 0, 0,
 	// mov Vd.H[i], Vn.H[i]
-	2, MatVElement, byte(WORD), MatVElement, byte(WORD), 0x2c, 0xe3,
+	2, MatVElement, byte(WORD), MatVElement, byte(WORD), 0x0, 0x40, 0xeb, 0, 0,
 	// mov Vd.S[i], Vn.S[i]
-	2, MatVElement, byte(DWORD), MatVElement, byte(DWORD), 0x2c, 0xf0,
+	2, MatVElement, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x40, 0xf8, 0, 0,
 	// mov Vd.D[i], Vn.D[i]
-	2, MatVElement, byte(QWORD), MatVElement, byte(QWORD), 0x2c, 0xfd,
+	2, MatVElement, byte(QWORD), MatVElement, byte(QWORD), 0x0, 0x41, 0x5, 0, 0,
 	// mov Vd.B[i], Wn
-	2, MatVElement, byte(BYTE), MatW, 0x2d, 0xa,
+	2, MatVElement, byte(BYTE), MatW, 0x0, 0x41, 0x12, 0, 0,
 	// mov Vd.H[i], Wn
-	2, MatVElement, byte(WORD), MatW, 0x2d, 0x14,
+	2, MatVElement, byte(WORD), MatW, 0x0, 0x41, 0x1c, 0, 0,
 	// mov Vd.S[i], Wn
-	2, MatVElement, byte(DWORD), MatW, 0x2d, 0x1e,
+	2, MatVElement, byte(DWORD), MatW, 0x0, 0x41, 0x26, 0, 0,
 	// mov Vd.D[i], Xn
-	2, MatVElement, byte(QWORD), MatX, 0x2d, 0x28,
+	2, MatVElement, byte(QWORD), MatX, 0x0, 0x41, 0x30, 0, 0,
 	// mov INVERTED, Wn, #imm  ·········································  (imm is 32-bit inverted wide)
-	3, MatLitSymbol, byte(INVERTED), MatW, MatImm, 0x2d, 0x32,
+	3, MatLitSymbol, byte(INVERTED), MatW, MatImm, 0x0, 0x41, 0x3a, 0, 0,
 	// mov INVERTED, Xn, #imm  ·········································  (imm is 64-bit inverted wide)
-	3, MatLitSymbol, byte(INVERTED), MatX, MatImm, 0x2d, 0x3b,
+	3, MatLitSymbol, byte(INVERTED), MatX, MatImm, 0x0, 0x41, 0x43, 0, 0,
 	// mov Wd, #imm  ····························································  (imm is 32-bit wide)
-	2, MatW, MatImm, 0x2d, 0x44,
+	2, MatW, MatImm, 0x0, 0x41, 0x4c, 0, 0,
 	// mov Xd, #imm  ····························································  (imm is 64-bit wide)
-	2, MatX, MatImm, 0x2d, 0x4d,
+	2, MatX, MatImm, 0x0, 0x41, 0x55, 0, 0,
 	// mov Vd.16B, Vn.16B
 	// mov Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x2d, 0x56,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x41, 0x5e, 0, 0,
 	// mov LOGICAL, Wn|WSP, #imm  ············································  (imm is 32-bit logical)
-	3, MatLitSymbol, byte(LOGICAL), MatWSP, MatImm, 0x2d, 0x60,
+	3, MatLitSymbol, byte(LOGICAL), MatWSP, MatImm, 0x0, 0x41, 0x68, 0, 0,
 	// mov LOGICAL, Xn|SP, #imm  ·············································  (imm is 64-bit logical)
-	3, MatLitSymbol, byte(LOGICAL), MatXSP, MatImm, 0x2d, 0x69,
+	3, MatLitSymbol, byte(LOGICAL), MatXSP, MatImm, 0x0, 0x41, 0x71, 0, 0,
 	// mov Wd, Vn.S[i]
-	2, MatW, MatVElement, byte(DWORD), 0x2d, 0x72,
+	2, MatW, MatVElement, byte(DWORD), 0x0, 0x41, 0x7a, 0, 0,
 	// mov Xd, Vn.D[i]
-	2, MatX, MatVElement, byte(QWORD), 0x2d, 0x7c,
+	2, MatX, MatVElement, byte(QWORD), 0x0, 0x41, 0x84, 0, 0,
+
+	10,
+	// mova ZAdH|V.B[W12-W15, #imm], Pg/M, Zn.B  ······························  (0 <= imm < 16, g < 8)
+	3, MatZASlice, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0x41, 0x8e, byte(FeatSME), 0,
+	// mova ZAdH|V.H[W12-W15, #imm], Pg/M, Zn.H  ························  (d < 2, 0 <= imm < 8, g < 8)
+	3, MatZASlice, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x41, 0x9f, byte(FeatSME), 0,
+	// mova ZAdH|V.S[W12-W15, #imm], Pg/M, Zn.S  ························  (d < 4, 0 <= imm < 4, g < 8)
+	3, MatZASlice, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x41, 0xb2, byte(FeatSME), 0,
+	// mova ZAdH|V.D[W12-W15, #imm], Pg/M, Zn.D  ························  (d < 8, 0 <= imm < 2, g < 8)
+	3, MatZASlice, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x41, 0xc5, byte(FeatSME), 0,
+	// mova ZAdH|V.Q[W12-W15, #imm], Pg/M, Zn.Q  ···························  (d < 16, imm == 0, g < 8)
+	3, MatZASlice, byte(OWORD), MatPM, MatZ, byte(OWORD), 0x0, 0x41, 0xd8, byte(FeatSME), 0,
+	// mova Zd.B, Pg/M, ZAnH|V.B[W12-W15, #imm]  ······························  (g < 8, 0 <= imm < 16)
+	3, MatZ, byte(BYTE), MatPM, MatZASlice, byte(BYTE), 0x0, 0x41, 0xec, byte(FeatSME), 0,
+	// mova Zd.H, Pg/M, ZAnH|V.H[W12-W15, #imm]  ························  (g < 8, n < 2, 0 <= imm < 8)
+	3, MatZ, byte(WORD), MatPM, MatZASlice, byte(WORD), 0x0, 0x41, 0xfd, byte(FeatSME), 0,
+	// mova Zd.S, Pg/M, ZAnH|V.S[W12-W15, #imm]  ························  (g < 8, n < 4, 0 <= imm < 4)
+	3, MatZ, byte(DWORD), MatPM, MatZASlice, byte(DWORD), 0x0, 0x42, 0x10, byte(FeatSME), 0,
+	// mova Zd.D, Pg/M, ZAnH|V.D[W12-W15, #imm]  ························  (g < 8, n < 8, 0 <= imm < 2)
+	3, MatZ, byte(QWORD), MatPM, MatZASlice, byte(QWORD), 0x0, 0x42, 0x23, byte(FeatSME), 0,
+	// mova Zd.Q, Pg/M, ZAnH|V.Q[W12-W15, #imm]  ···························  (g < 8, n < 16, imm == 0)
+	3, MatZ, byte(OWORD), MatPM, MatZASlice, byte(OWORD), 0x0, 0x42, 0x36, byte(FeatSME), 0,
 
 	6,
 	// movi Vd.16B, #imm1 {, LSL #imm2 }  ·······························  (0 <= imm1 < 256, imm2 == 0)
 	// movi Vd.8B, #imm1 {, LSL #imm2 }  ································  (0 <= imm1 < 256, imm2 == 0)
-	4, MatV, byte(BYTE), MatImm, MatEnd, MatLitMod, SymLSL, 0x2d, 0x86,
+	4, MatV, byte(BYTE), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x42, 0x4a, 0, 0,
 	// movi Vd.8H, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 256, imm2 in [0, 8])
 	// movi Vd.4H, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 256, imm2 in [0, 8])
-	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x2d, 0x9b,
+	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x42, 0x5f, 0, 0,
 	// movi Vd.4S, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
 	// movi Vd.2S, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
-	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x2d, 0xb0,
+	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x42, 0x74, 0, 0,
 	// movi Vd.4S, #imm1, MSL #imm2  ······························  (0 <= imm1 < 256, imm2 in [8, 16])
 	// movi Vd.2S, #imm1, MSL #imm2  ······························  (0 <= imm1 < 256, imm2 in [8, 16])
-	3, MatV, byte(DWORD), MatImm, MatLitMod, SymMSL, 0x2d, 0xc5,
+	3, MatV, byte(DWORD), MatImm, MatLitMod, SymMSL, 0x0, 0x42, 0x89, 0, 0,
 	// movi Dd, #imm  ·····························································  (imm is stretched)
-	2, MatD, MatImm, 0x2d, 0xda,
+	2, MatD, MatImm, 0x0, 0x42, 0x9e, 0, 0,
 	// movi Vd.2D, #imm  ··························································  (imm is stretched)
-	2, MatVStatic, byte(QWORD), 2, MatImm, 0x2d, 0xe3,
+	2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x42, 0xa7, 0, 0,
 
 	2,
 	// movk Wd, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 65536, imm2 in [0, 16])
-	4, MatW, MatImm, MatEnd, MatLitMod, SymLSL, 0x2d, 0xec,
+	4, MatW, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x42, 0xb0, 0, 0,
 	// movk Xd, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])
-	4, MatX, MatImm, MatEnd, MatLitMod, SymLSL, 0x2d, 0xf8,
+	4, MatX, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x42, 0xbc, 0, 0,
 
 	2,
 	// movn Wd, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 65536, imm2 in [0, 16])
-	4, MatW, MatImm, MatEnd, MatLitMod, SymLSL, 0x2e, 0x4,
+	4, MatW, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x42, 0xc8, 0, 0,
 	// movn Xd, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])
-	4, MatX, MatImm, MatEnd, MatLitMod, SymLSL, 0x2e, 0x10,
+	4, MatX, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x42, 0xd4, 0, 0,
+
+	9,
+	// movprfx Zd, Zn
+	2, MatZ, 0, MatZ, 0, 0x0, 0x42, 0xe0, byte(FeatSVE), 0,
+	// movprfx Zd.B, Pg/Z, Zn.B  ·····························································  (g < 8)
+	3, MatZ, byte(BYTE), MatPZ, MatZ, byte(BYTE), 0x0, 0x42, 0xe7, byte(FeatSVE), 0,
+	// movprfx Zd.B, Pg/M, Zn.B  ·····························································  (g < 8)
+	3, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0x42, 0xf0, byte(FeatSVE), 0,
+	// movprfx Zd.H, Pg/Z, Zn.H  ·····························································  (g < 8)
+	3, MatZ, byte(WORD), MatPZ, MatZ, byte(WORD), 0x0, 0x42, 0xf9, byte(FeatSVE), 0,
+	// movprfx Zd.H, Pg/M, Zn.H  ·····························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x43, 0x2, byte(FeatSVE), 0,
+	// movprfx Zd.S, Pg/Z, Zn.S  ·····························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPZ, MatZ, byte(DWORD), 0x0, 0x43, 0xb, byte(FeatSVE), 0,
+	// movprfx Zd.S, Pg/M, Zn.S  ·····························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x43, 0x14, byte(FeatSVE), 0,
+	// movprfx Zd.D, Pg/Z, Zn.D  ·····························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPZ, MatZ, byte(QWORD), 0x0, 0x43, 0x1d, byte(FeatSVE), 0,
+	// movprfx Zd.D, Pg/M, Zn.D  ·····························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x43, 0x26, byte(FeatSVE), 0,
 
 	2,
 	// movz Wd, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 65536, imm2 in [0, 16])
-	4, MatW, MatImm, MatEnd, MatLitMod, SymLSL, 0x2e, 0x1c,
+	4, MatW, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x43, 0x2f, 0, 0,
 	// movz Xd, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 65536, imm2 in [0, 16, 32, 48])
-	4, MatX, MatImm, MatEnd, MatLitMod, SymLSL, 0x2e, 0x28,
+	4, MatX, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x43, 0x3b, 0, 0,
 
-	1,
+	6,
 	// mrs Xd, #imm  ······························································  (0 <= imm < 32768)
-	2, MatX, MatImm, 0x2e, 0x34,
+	2, MatX, MatImm, 0x0, 0x43, 0x47, 0, 0,
+	// mrs Xd, <sysreg>
+	2, MatX, MatSysReg, 0x0, 0x43, 0x50, 0, 0,
+	// mrs Xd, SPSEL
+	2, MatX, MatLitSymbol, byte(SPSEL), 0x0, 0x43, 0x59, 0, 0,
+	// mrs Xd, PAN
+	2, MatX, MatLitSymbol, byte(PAN), 0x0, 0x43, 0x5f, 0, 0,
+	// mrs Xd, UAO
+	2, MatX, MatLitSymbol, byte(UAO), 0x0, 0x43, 0x65, 0, 0,
+	// mrs Xd, DIT
+	2, MatX, MatLitSymbol, byte(DIT), 0x0, 0x43, 0x6b, 0, 0,
 
-	2,
+	10,
+	// msr SVCRSM, #imm  ······························································  (0 <= imm < 2)
+	2, MatLitSymbol, byte(SVCRSM), MatImm, 0x0, 0x43, 0x71, 0, 0,
+	// msr SVCRZA, #imm  ······························································  (0 <= imm < 2)
+	2, MatLitSymbol, byte(SVCRZA), MatImm, 0x0, 0x43, 0x79, 0, 0,
+	// msr SVCRSMZA, #imm  ····························································  (0 <= imm < 2)
+	2, MatLitSymbol, byte(SVCRSMZA), MatImm, 0x0, 0x43, 0x81, 0, 0,
 	// msr <symbol>, #imm  ···························································  (0 <= imm < 16)
-	2, MatSymbol, MatImm, 0x2e, 0x3d,
+	2, MatSymbol, MatImm, 0x0, 0x43, 0x89, 0, 0,
 	// msr #imm, Xn  ······························································  (0 <= imm < 32768)
-	2, MatImm, MatX, 0x2e, 0x48,
+	2, MatImm, MatX, 0x0, 0x43, 0x94, 0, 0,
+	// msr <sysreg>, Xn
+	2, MatSysReg, MatX, 0x0, 0x43, 0x9d, 0, 0,
+	// msr SPSEL, Xn
+	2, MatLitSymbol, byte(SPSEL), MatX, 0x0, 0x43, 0xa6, 0, 0,
+	// msr PAN, Xn
+	2, MatLitSymbol, byte(PAN), MatX, 0x0, 0x43, 0xac, 0, 0,
+	// msr UAO, Xn
+	2, MatLitSymbol, byte(UAO), MatX, 0x0, 0x43, 0xb2, 0, 0,
+	// msr DIT, Xn
+	2, MatLitSymbol, byte(DIT), MatX, 0x0, 0x43, 0xb8, 0, 0,
 
 	2,
 	// msub Wd, Wn, Wm, Wa
-	4, MatW, MatW, MatW, MatW, 0x2e, 0x51,
+	4, MatW, MatW, MatW, MatW, 0x0, 0x43, 0xbe, 0, 0,
 	// msub Xd, Xn, Xm, Xa
-	4, MatX, MatX, MatX, MatX, 0x2e, 0x5a,
+	4, MatX, MatX, MatX, MatX, 0x0, 0x43, 0xc7, 0, 0,
 
-	7,
+	19,
 	// mul Vd.8H, Vn.8H, Vm.H[i]  ···························································  (m < 16)
 	// mul Vd.4H, Vn.4H, Vm.H[i]  ···························································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x2e, 0x63,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x43, 0xd0, 0, 0,
 	// mul Vd.4S, Vn.4S, Vm.S[i]
 	// mul Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x2e, 0x6e,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x43, 0xdb, 0, 0,
 	// mul Vd.16B, Vn.16B, Vm.16B
 	// mul Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x2e, 0x79,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x43, 0xe6, 0, 0,
 	// mul Vd.8H, Vn.8H, Vm.8H
 	// mul Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x2e, 0x82,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x43, 0xef, 0, 0,
 	// mul Vd.4S, Vn.4S, Vm.4S
 	// mul Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x2e, 0x8b,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x43, 0xf8, 0, 0,
 	// mul Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x2e, 0x94,
+	3, MatW, MatW, MatW, 0x0, 0x44, 0x1, 0, 0,
 	// mul Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x2e, 0x9c,
+	3, MatX, MatX, MatX, 0x0, 0x44, 0x9, 0, 0,
+	// mul Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x44, 0x11, byte(FeatSVE2), 0,
+	// mul Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x44, 0x19, byte(FeatSVE2), 0,
+	// mul Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x44, 0x21, byte(FeatSVE2), 0,
+	// mul Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x44, 0x29, byte(FeatSVE2), 0,
+	// mul Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x44, 0x31, byte(FeatSVE), 0,
+	// mul Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x44, 0x3c, byte(FeatSVE), 0,
+	// mul Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x44, 0x47, byte(FeatSVE), 0,
+	// mul Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x44, 0x52, byte(FeatSVE), 0,
+	// mul Zd.B, Zn.B, #imm  ·············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x44, 0x5d, byte(FeatSVE), 0,
+	// mul Zd.H, Zn.H, #imm  ·············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x44, 0x68, byte(FeatSVE), 0,
+	// mul Zd.S, Zn.S, #imm  ·············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x44, 0x73, byte(FeatSVE), 0,
+	// mul Zd.D, Zn.D, #imm  ·············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x44, 0x7e, byte(FeatSVE), 0,
 
 	3,
 	// mvn Wd, Wn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 32)
-	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x2e, 0xa4,
+	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x44, 0x89, 0, 0,
 	// mvn Xd, Xn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 64)
-	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x2e, 0xaf,
+	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x44, 0x94, 0, 0,
 	// mvn Vd.16B, Vn.16B
 	// mvn Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x2e, 0xba,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x44, 0x9f, 0, 0,
 
 	3,
 	// mvni Vd.8H, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 256, imm2 in [0, 8])
 	// mvni Vd.4H, #imm1 {, LSL #imm2 }  ···························  (0 <= imm1 < 256, imm2 in [0, 8])
-	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x2e, 0xc2,
+	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x44, 0xa7, 0, 0,
 	// mvni Vd.4S, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
 	// mvni Vd.2S, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
-	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x2e, 0xd7,
+	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x44, 0xbc, 0, 0,
 	// mvni Vd.4S, #imm1, MSL #imm2  ······························  (0 <= imm1 < 256, imm2 in [8, 16])
 	// mvni Vd.2S, #imm1, MSL #imm2  ······························  (0 <= imm1 < 256, imm2 in [8, 16])
-	3, MatV, byte(DWORD), MatImm, MatLitMod, SymMSL, 0x2e, 0xec,
+	3, MatV, byte(DWORD), MatImm, MatLitMod, SymMSL, 0x0, 0x44, 0xd1, 0, 0,
 
-	7,
+	1,
+	// nbsl Zd.D, Zn.D, Zm.D, Za.D  ·························································  (n == d)
+	4, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x44, 0xe6, byte(FeatSVE2), 0,
+
+	11,
 	// neg Wd, Wn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 32)
-	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x2f, 0x1,
+	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x44, 0xf0, 0, 0,
 	// neg Xd, Xn {, LSL|LSR|ASR #imm }  ·············································  (0 <= imm < 64)
-	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x2f, 0xc,
+	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x44, 0xfb, 0, 0,
 	// neg Dd, Dn
-	2, MatD, MatD, 0x2f, 0x17,
+	2, MatD, MatD, 0x0, 0x45, 0x6, 0, 0,
 	// neg Vd.16B, Vn.16B
 	// neg Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x2f, 0x1e,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x45, 0xd, 0, 0,
 	// neg Vd.8H, Vn.8H
 	// neg Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x2f, 0x26,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x45, 0x15, 0, 0,
 	// neg Vd.4S, Vn.4S
 	// neg Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x2f, 0x2e,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x45, 0x1d, 0, 0,
 	// neg Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x2f, 0x36,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x45, 0x25, 0, 0,
+	// neg Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	3, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0x45, 0x2d, byte(FeatSVE), 0,
+	// neg Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x45, 0x36, byte(FeatSVE), 0,
+	// neg Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x45, 0x3f, byte(FeatSVE), 0,
+	// neg Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x45, 0x48, byte(FeatSVE), 0,
 
 	2,
 	// negs Wd, Wn {, LSL|LSR|ASR #imm }  ············································  (0 <= imm < 32)
-	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x2f, 0x3e,
+	4, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x45, 0x51, 0, 0,
 	// negs Xd, Xn {, LSL|LSR|ASR #imm }  ············································  (0 <= imm < 64)
-	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x2f, 0x49,
+	4, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x45, 0x5c, 0, 0,
 
 	2,
 	// ngc Wd, Wn
-	2, MatW, MatW, 0x2f, 0x54,
+	2, MatW, MatW, 0x0, 0x45, 0x67, 0, 0,
 	// ngc Xd, Xn
-	2, MatX, MatX, 0x2f, 0x5b,
+	2, MatX, MatX, 0x0, 0x45, 0x6e, 0, 0,
 
 	2,
 	// ngcs Wd, Wn
-	2, MatW, MatW, 0x2f, 0x62,
+	2, MatW, MatW, 0x0, 0x45, 0x75, 0, 0,
 	// ngcs Xd, Xn
-	2, MatX, MatX, 0x2f, 0x69,
+	2, MatX, MatX, 0x0, 0x45, 0x7c, 0, 0,
 
 	1,
 	// nop
-	0, 0x2f, 0x70,
+	0, 0x0, 0x45, 0x83, 0, 0,
 
-	1,
+	5,
 	// not Vd.16B, Vn.16B
 	// not Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x2f, 0x75,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x45, 0x88, 0, 0,
+	// not Zd.B, Pg/M, Zn.B  ·································································  (g < 8)
+	3, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), 0x0, 0x45, 0x90, byte(FeatSVE), 0,
+	// not Zd.H, Pg/M, Zn.H  ·································································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x45, 0x99, byte(FeatSVE), 0,
+	// not Zd.S, Pg/M, Zn.S  ·································································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x45, 0xa2, byte(FeatSVE), 0,
+	// not Zd.D, Pg/M, Zn.D  ·································································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x45, 0xab, byte(FeatSVE), 0,
 
 	3,
 	// orn Vd.16B, Vn.16B, Vm.16B
 	// orn Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x2f, 0x7d,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x45, 0xb4, 0, 0,
 	// orn Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x2f, 0x86,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x45, 0xbd, 0, 0,
 	// orn Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x2f, 0x92,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x45, 0xc9, 0, 0,
 
-	7,
+	14,
 	// orr Vd.8H, #imm1 {, LSL #imm2 }  ····························  (0 <= imm1 < 256, imm2 in [0, 8])
 	// orr Vd.4H, #imm1 {, LSL #imm2 }  ····························  (0 <= imm1 < 256, imm2 in [0, 8])
-	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x2f, 0x9e,
+	4, MatV, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x45, 0xd5, 0, 0,
 	// orr Vd.4S, #imm1 {, LSL #imm2 }  ····················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
 	// orr Vd.2S, #imm1 {, LSL #imm2 }  ····················  (0 <= imm1 < 256, imm2 in [0, 8, 16, 24])
-	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x2f, 0xb3,
+	4, MatV, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x45, 0xea, 0, 0,
 	// orr Vd.16B, Vn.16B, Vm.16B
 	// orr Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x2f, 0xc8,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x45, 0xff, 0, 0,
 	// orr Wd|WSP, Wn, #imm  ·················································  (imm is 32-bit logical)
-	3, MatWSP, MatW, MatImm, 0x2f, 0xd1,
+	3, MatWSP, MatW, MatImm, 0x0, 0x46, 0x8, 0, 0,
 	// orr Xd|SP, Xn, #imm  ··················································  (imm is 64-bit logical)
-	3, MatXSP, MatX, MatImm, 0x2f, 0xdb,
+	3, MatXSP, MatX, MatImm, 0x0, 0x46, 0x12, 0, 0,
 	// orr Wd, Wn, Wm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x2f, 0xe5,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x46, 0x1c, 0, 0,
 	// orr Xd, Xn, Xm {, LSL|LSR|ASR|ROR #imm }  ·····································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x2f, 0xf1,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x46, 0x28, 0, 0,
+	// orr Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x46, 0x34, byte(FeatSVE), 0,
+	// orr Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x46, 0x3f, byte(FeatSVE), 0,
+	// orr Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x46, 0x4a, byte(FeatSVE), 0,
+	// orr Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x46, 0x55, byte(FeatSVE), 0,
+	// orr Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x46, 0x60, byte(FeatSVE), 0,
+	// orr Zd.S, Zn.S, #imm  ·········································  (n == d, imm is 32-bit logical)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x46, 0x68, byte(FeatSVE), 0,
+	// orr Zd.D, Zn.D, #imm  ·········································  (n == d, imm is 64-bit logical)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x46, 0x73, byte(FeatSVE), 0,
+
+	4,
+	// orv Bd, Pg, Zn.B  ·····································································  (g < 8)
+	3, MatB, MatP, 0, MatZ, byte(BYTE), 0x0, 0x46, 0x7e, byte(FeatSVE), 0,
+	// orv Hd, Pg, Zn.H  ·····································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x46, 0x87, byte(FeatSVE), 0,
+	// orv Sd, Pg, Zn.S  ·····································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x46, 0x90, byte(FeatSVE), 0,
+	// orv Dd, Pg, Zn.D  ·····································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x46, 0x99, byte(FeatSVE), 0,
 
 	1,
 	// pacda Xd, Xn|SP
-	2, MatX, MatXSP, 0x2f, 0xfd,
+	2, MatX, MatXSP, 0x0, 0x46, 0xa2, byte(FeatPAuth), 0,
 
 	1,
 	// pacdb Xd, Xn|SP
-	2, MatX, MatXSP, 0x30, 0x4,
+	2, MatX, MatXSP, 0x0, 0x46, 0xa9, byte(FeatPAuth), 0,
 
 	1,
 	// pacdza Xd
-	1, MatX, 0x30, 0xb,
+	1, MatX, 0x0, 0x46, 0xb0, byte(FeatPAuth), 0,
 
 	1,
 	// pacdzb Xd
-	1, MatX, 0x30, 0x11,
+	1, MatX, 0x0, 0x46, 0xb6, byte(FeatPAuth), 0,
 
 	1,
 	// pacga Xd, Xn, Xm|SP
-	3, MatX, MatX, MatXSP, 0x30, 0x17,
+	3, MatX, MatX, MatXSP, 0x0, 0x46, 0xbc, byte(FeatPAuth), 0,
 
 	1,
 	// pacia Xd, Xn|SP
-	2, MatX, MatXSP, 0x30, 0x1f,
+	2, MatX, MatXSP, 0x0, 0x46, 0xc4, byte(FeatPAuth), 0,
 
 	1,
 	// pacia1716
-	0, 0x30, 0x26,
+	0, 0x0, 0x46, 0xcb, 0, 0,
 
 	1,
 	// paciasp
-	0, 0x30, 0x2b,
+	0, 0x0, 0x46, 0xd0, 0, 0,
 
 	1,
 	// paciaz
-	0, 0x30, 0x30,
+	0, 0x0, 0x46, 0xd5, 0, 0,
 
 	1,
 	// pacib Xd, Xn|SP
-	2, MatX, MatXSP, 0x30, 0x35,
+	2, MatX, MatXSP, 0x0, 0x46, 0xda, byte(FeatPAuth), 0,
 
 	1,
 	// pacib1716
-	0, 0x30, 0x3c,
+	0, 0x0, 0x46, 0xe1, 0, 0,
 
 	1,
 	// pacibsp
-	0, 0x30, 0x41,
+	0, 0x0, 0x46, 0xe6, 0, 0,
 
 	1,
 	// pacibz
-	0, 0x30, 0x46,
+	0, 0x0, 0x46, 0xeb, 0, 0,
 
 	1,
 	// paciza Xd
-	1, MatX, 0x30, 0x4b,
+	1, MatX, 0x0, 0x46, 0xf0, byte(FeatPAuth), 0,
 
 	1,
 	// pacizb Xd
-	1, MatX, 0x30, 0x51,
+	1, MatX, 0x0, 0x46, 0xf6, byte(FeatPAuth), 0,
+
+	1,
+	// pfalse Pd.B
+	1, MatP, byte(BYTE), 0x0, 0x46, 0xfc, byte(FeatSVE), 0,
 
 	1,
 	// pmul Vd.16B, Vn.16B, Vm.16B
 	// pmul Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x30, 0x57,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x47, 0x2, 0, 0,
 
 	2,
 	// pmull Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x30, 0x60,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x47, 0xb, 0, 0,
 	// pmull Vd.1Q, Vn.1D, Vm.1D
-	3, MatVStatic, byte(OWORD), 1, MatVStatic, byte(QWORD), 1, MatVStatic, byte(QWORD), 1, 0x30, 0x68,
+	3, MatVStatic, byte(OWORD), 1, MatVStatic, byte(QWORD), 1, MatVStatic, byte(QWORD), 1, 0x0, 0x47, 0x13, byte(FeatAES), 0,
 
 	2,
 	// pmull2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x30, 0x70,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x47, 0x1b, 0, 0,
 	// pmull2 Vd.1Q, Vn.2D, Vm.2D
-	3, MatVStatic, byte(OWORD), 1, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x30, 0x78,
+	3, MatVStatic, byte(OWORD), 1, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x47, 0x23, byte(FeatAES), 0,
 
-	2,
+	6,
+	// prfm #imm1, [Xn|SP {, #imm2 }]  ················  (0 <= imm1 < 32, 0 <= imm2 < 32768, imm2 >> 3)
+	2, MatImm, MatRefOffset, 0x0, 0x47, 0x2b, 0, 0,
+	// prfm <symbol>, [Xn|SP {, #imm }]  ································  (0 <= imm < 32768, imm >> 3)
+	2, MatSymbol, MatRefOffset, 0x0, 0x47, 0x38, 0, 0,
 	// prfm #imm, <offset>  ························  (0 <= imm < 32, offset >> 2 is 19-bit (+/- 1 MB))
-	2, MatImm, MatOffset, 0x30, 0x80,
+	2, MatImm, MatOffset, 0x0, 0x47, 0x45, 0, 0,
+	// prfm <symbol>, <offset>  ···································  (offset >> 2 is 19-bit (+/- 1 MB))
+	2, MatSymbol, MatOffset, 0x0, 0x47, 0x4f, 0, 0,
 	// prfm #imm1, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm2 }]  ····  (0 <= imm1 < 32, imm2 in [0, 3])
-	2, MatImm, MatRefIndex, 0x30, 0x8a,
+	2, MatImm, MatRefIndex, 0x0, 0x47, 0x59, 0, 0,
+	// prfm <symbol>, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ···················  (imm in [0, 3])
+	2, MatSymbol, MatRefIndex, 0x0, 0x47, 0x67, 0, 0,
 
-	1,
+	2,
 	// prfum #imm1, [Xn|SP {, #imm2 }]  ·························  (0 <= imm1 < 32, -256 <= imm2 < 256)
-	2, MatImm, MatRefOffset, 0x30, 0x98,
+	2, MatImm, MatRefOffset, 0x0, 0x47, 0x75, 0, 0,
+	// prfum <symbol>, [Xn|SP {, #imm }]  ········································  (-256 <= imm < 256)
+	2, MatSymbol, MatRefOffset, 0x0, 0x47, 0x7f, 0, 0,
 
 	1,
 	// psb CSYNC
-	1, MatLitSymbol, byte(CSYNC), 0x30, 0xa2,
+	1, MatLitSymbol, byte(CSYNC), 0x0, 0x47, 0x89, 0, 0,
 
 	1,
 	// pssbb
-	0, 0x30, 0xa7,
+	0, 0x0, 0x47, 0x8e, 0, 0,
+
+	1,
+	// ptest Pd, Pn.B
+	2, MatP, 0, MatP, byte(BYTE), 0x0, 0x47, 0x93, byte(FeatSVE), 0,
+
+	8,
+	// ptrue Pd.B
+	1, MatP, byte(BYTE), 0x0, 0x47, 0x9a, byte(FeatSVE), 0,
+	// ptrue Pd.B, <symbol>
+	2, MatP, byte(BYTE), MatSymbol, 0x0, 0x47, 0xa0, byte(FeatSVE), 0,
+	// ptrue Pd.H
+	1, MatP, byte(WORD), 0x0, 0x47, 0xa9, byte(FeatSVE), 0,
+	// ptrue Pd.H, <symbol>
+	2, MatP, byte(WORD), MatSymbol, 0x0, 0x47, 0xaf, byte(FeatSVE), 0,
+	// ptrue Pd.S
+	1, MatP, byte(DWORD), 0x0, 0x47, 0xb8, byte(FeatSVE), 0,
+	// ptrue Pd.S, <symbol>
+	2, MatP, byte(DWORD), MatSymbol, 0x0, 0x47, 0xbe, byte(FeatSVE), 0,
+	// ptrue Pd.D
+	1, MatP, byte(QWORD), 0x0, 0x47, 0xc7, byte(FeatSVE), 0,
+	// ptrue Pd.D, <symbol>
+	2, MatP, byte(QWORD), MatSymbol, 0x0, 0x47, 0xcd, byte(FeatSVE), 0,
+
+	8,
+	// ptrues Pd.B
+	1, MatP, byte(BYTE), 0x0, 0x47, 0xd6, byte(FeatSVE), 0,
+	// ptrues Pd.B, <symbol>
+	2, MatP, byte(BYTE), MatSymbol, 0x0, 0x47, 0xdc, byte(FeatSVE), 0,
+	// ptrues Pd.H
+	1, MatP, byte(WORD), 0x0, 0x47, 0xe5, byte(FeatSVE), 0,
+	// ptrues Pd.H, <symbol>
+	2, MatP, byte(WORD), MatSymbol, 0x0, 0x47, 0xeb, byte(FeatSVE), 0,
+	// ptrues Pd.S
+	1, MatP, byte(DWORD), 0x0, 0x47, 0xf4, byte(FeatSVE), 0,
+	// ptrues Pd.S, <symbol>
+	2, MatP, byte(DWORD), MatSymbol, 0x0, 0x47, 0xfa, byte(FeatSVE), 0,
+	// ptrues Pd.D
+	1, MatP, byte(QWORD), 0x0, 0x48, 0x3, byte(FeatSVE), 0,
+	// ptrues Pd.D, <symbol>
+	2, MatP, byte(QWORD), MatSymbol, 0x0, 0x48, 0x9, byte(FeatSVE), 0,
 
 	3,
 	// raddhn Vd.8B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x30, 0xac,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x48, 0x12, 0, 0,
 	// raddhn Vd.4H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x30, 0xb4,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x48, 0x1a, 0, 0,
 	// raddhn Vd.2S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x30, 0xbc,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x48, 0x22, 0, 0,
 
 	3,
 	// raddhn2 Vd.16B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x30, 0xc4,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x48, 0x2a, 0, 0,
 	// raddhn2 Vd.8H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x30, 0xcc,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x48, 0x32, 0, 0,
 	// raddhn2 Vd.4S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x30, 0xd4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x48, 0x3a, 0, 0,
 
 	1,
 	// rax1 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x30, 0xdc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x48, 0x42, byte(FeatSHA3), 0,
 
 	3,
 	// rbit Vd.16B, Vn.16B
 	// rbit Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x30, 0xe4,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x48, 0x4a, 0, 0,
 	// rbit Wd, Wn
-	2, MatW, MatW, 0x30, 0xec,
+	2, MatW, MatW, 0x0, 0x48, 0x52, 0, 0,
 	// rbit Xd, Xn
-	2, MatX, MatX, 0x30, 0xf3,
+	2, MatX, MatX, 0x0, 0x48, 0x59, 0, 0,
+
+	2,
+	// rdffr Pd.B
+	1, MatP, byte(BYTE), 0x0, 0x48, 0x60, byte(FeatSVE), 0,
+	// rdffr Pd.B, Pg/Z
+	2, MatP, byte(BYTE), MatPZ, 0x0, 0x48, 0x66, byte(FeatSVE), 0,
+
+	1,
+	// rdffrs Pd.B, Pg/Z
+	2, MatP, byte(BYTE), MatPZ, 0x0, 0x48, 0x6d, byte(FeatSVE), 0,
+
+	1,
+	// rdsvl Xd, #imm  ·····························································  (-32 <= imm < 32)
+	2, MatX, MatImm, 0x0, 0x48, 0x74, byte(FeatSME), 0,
+
+	1,
+	// rdvl Xd, #imm  ······························································  (-32 <= imm < 32)
+	2, MatX, MatImm, 0x0, 0x48, 0x7d, byte(FeatSVE), 0,
 
 	2,
 	// ret Xd
-	1, MatX, 0x30, 0xfa,
+	1, MatX, 0x0, 0x48, 0x86, 0, 0,
 	// ret
-	0, 0x31, 0x0,
+	0, 0x0, 0x48, 0x8c, 0, 0,
 
 	1,
 	// retaa
-	0, 0x31, 0x5,
+	0, 0x0, 0x48, 0x91, byte(FeatPAuth), 0,
 
 	1,
 	// retab
-	0, 0x31, 0xa,
+	0, 0x0, 0x48, 0x96, byte(FeatPAuth), 0,
 
-	2,
+	10,
 	// rev Wd, Wn
-	2, MatW, MatW, 0x31, 0xf,
+	2, MatW, MatW, 0x0, 0x48, 0x9b, 0, 0,
 	// rev Xd, Xn
-	2, MatX, MatX, 0x31, 0x16,
+	2, MatX, MatX, 0x0, 0x48, 0xa2, 0, 0,
+	// rev Zd.B, Zn.B
+	2, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x48, 0xa9, byte(FeatSVE), 0,
+	// rev Zd.H, Zn.H
+	2, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x48, 0xb0, byte(FeatSVE), 0,
+	// rev Zd.S, Zn.S
+	2, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x48, 0xb7, byte(FeatSVE), 0,
+	// rev Zd.D, Zn.D
+	2, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x48, 0xbe, byte(FeatSVE), 0,
+	// rev Pd.B, Pn.B
+	2, MatP, byte(BYTE), MatP, byte(BYTE), 0x0, 0x48, 0xc5, byte(FeatSVE), 0,
+	// rev Pd.H, Pn.H
+	2, MatP, byte(WORD), MatP, byte(WORD), 0x0, 0x48, 0xcc, byte(FeatSVE), 0,
+	// rev Pd.S, Pn.S
+	2, MatP, byte(DWORD), MatP, byte(DWORD), 0x0, 0x48, 0xd3, byte(FeatSVE), 0,
+	// rev Pd.D, Pn.D
+	2, MatP, byte(QWORD), MatP, byte(QWORD), 0x0, 0x48, 0xda, byte(FeatSVE), 0,
 
 	3,
 	// rev16 Vd.16B, Vn.16B
 	// rev16 Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x31, 0x1d,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x48, 0xe1, 0, 0,
 	// rev16 Wd, Wn
-	2, MatW, MatW, 0x31, 0x25,
+	2, MatW, MatW, 0x0, 0x48, 0xe9, 0, 0,
 	// rev16 Xd, Xn
-	2, MatX, MatX, 0x31, 0x2c,
+	2, MatX, MatX, 0x0, 0x48, 0xf0, 0, 0,
 
 	3,
 	// rev32 Vd.16B, Vn.16B
 	// rev32 Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x31, 0x33,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x48, 0xf7, 0, 0,
 	// rev32 Vd.8H, Vn.8H
 	// rev32 Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x31, 0x3b,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x48, 0xff, 0, 0,
 	// rev32 Xd, Xn
-	2, MatX, MatX, 0x31, 0x43,
+	2, MatX, MatX, 0x0, 0x49, 0x7, 0, 0,
 
 	4,
 	// rev64 Vd.16B, Vn.16B
 	// rev64 Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x31, 0x4a,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x49, 0xe, 0, 0,
 	// rev64 Vd.8H, Vn.8H
 	// rev64 Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x31, 0x52,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x49, 0x16, 0, 0,
 	// rev64 Vd.4S, Vn.4S
 	// rev64 Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x31, 0x5a,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x49, 0x1e, 0, 0,
 	// rev64 Xd, Xn
-	2, MatX, MatX, 0x31, 0x62,
+	2, MatX, MatX, 0x0, 0x49, 0x26, 0, 0,
 
 	1,
 	// rmif Xd, #imm1, #imm2  ·······································  (0 <= imm1 < 64, 0 <= imm2 < 16)
-	3, MatX, MatImm, MatImm, 0x31, 0x69,
+	3, MatX, MatImm, MatImm, 0x0, 0x49, 0x2d, byte(FeatFlagM), 0,
 
 	4,
 	// ror Wd, Wn, #imm  ·····························································  (0 <= imm < 32)
-	3, MatW, MatW, MatImm, 0x31, 0x75,
+	3, MatW, MatW, MatImm, 0x0, 0x49, 0x39, 0, 0,
 	// ror Xd, Xn, #imm  ·····························································  (0 <= imm < 64)
-	3, MatX, MatX, MatImm, 0x31, 0x81,
+	3, MatX, MatX, MatImm, 0x0, 0x49, 0x45, 0, 0,
 	// ror Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x31, 0x8d,
+	3, MatW, MatW, MatW, 0x0, 0x49, 0x51, 0, 0,
 	// ror Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x31, 0x95,
+	3, MatX, MatX, MatX, 0x0, 0x49, 0x59, 0, 0,
 
 	2,
 	// rorv Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x31, 0x9d,
+	3, MatW, MatW, MatW, 0x0, 0x49, 0x61, 0, 0,
 	// rorv Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x31, 0xa5,
+	3, MatX, MatX, MatX, 0x0, 0x49, 0x69, 0, 0,
 
 	3,
 	// rshrn Vd.8B, Vn.8H, #imm  ······················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x31, 0xad,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x49, 0x71, 0, 0,
 	// rshrn Vd.4H, Vn.4S, #imm  ·····················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x31, 0xb8,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x49, 0x7c, 0, 0,
 	// rshrn Vd.2S, Vn.2D, #imm  ·····················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x31, 0xc3,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x49, 0x87, 0, 0,
 
 	3,
 	// rshrn2 Vd.16B, Vn.8H, #imm  ····················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x31, 0xce,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x49, 0x92, 0, 0,
 	// rshrn2 Vd.8H, Vn.4S, #imm  ····················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x31, 0xd9,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x49, 0x9d, 0, 0,
 	// rshrn2 Vd.4S, Vn.2D, #imm  ····················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x31, 0xe4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x49, 0xa8, 0, 0,
 
 	3,
 	// rsubhn Vd.8B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x31, 0xef,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x49, 0xb3, 0, 0,
 	// rsubhn Vd.4H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x31, 0xf7,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x49, 0xbb, 0, 0,
 	// rsubhn Vd.2S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x31, 0xff,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x49, 0xc3, 0, 0,
 
 	3,
 	// rsubhn2 Vd.16B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x32, 0x7,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x49, 0xcb, 0, 0,
 	// rsubhn2 Vd.8H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x32, 0xf,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x49, 0xd3, 0, 0,
 	// rsubhn2 Vd.4S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x32, 0x17,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x49, 0xdb, 0, 0,
 
 	3,
 	// saba Vd.16B, Vn.16B, Vm.16B
 	// saba Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x32, 0x1f,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x49, 0xe3, 0, 0,
 	// saba Vd.8H, Vn.8H, Vm.8H
 	// saba Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x32, 0x28,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x49, 0xec, 0, 0,
 	// saba Vd.4S, Vn.4S, Vm.4S
 	// saba Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x32, 0x31,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x49, 0xf5, 0, 0,
 
 	3,
 	// sabal Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x32, 0x3a,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x49, 0xfe, 0, 0,
 	// sabal Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x32, 0x42,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x4a, 0x6, 0, 0,
 	// sabal Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x32, 0x4a,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x4a, 0xe, 0, 0,
 
 	3,
 	// sabal2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x32, 0x52,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x4a, 0x16, 0, 0,
 	// sabal2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x32, 0x5a,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x4a, 0x1e, 0, 0,
 	// sabal2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x32, 0x62,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4a, 0x26, 0, 0,
 
-	3,
+	7,
 	// sabd Vd.16B, Vn.16B, Vm.16B
 	// sabd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x32, 0x6a,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x4a, 0x2e, 0, 0,
 	// sabd Vd.8H, Vn.8H, Vm.8H
 	// sabd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x32, 0x73,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x4a, 0x37, 0, 0,
 	// sabd Vd.4S, Vn.4S, Vm.4S
 	// sabd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x32, 0x7c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x4a, 0x40, 0, 0,
+	// sabd Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x4a, 0x49, byte(FeatSVE), 0,
+	// sabd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x4a, 0x54, byte(FeatSVE), 0,
+	// sabd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x4a, 0x5f, byte(FeatSVE), 0,
+	// sabd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x4a, 0x6a, byte(FeatSVE), 0,
 
 	3,
 	// sabdl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x32, 0x85,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x4a, 0x75, 0, 0,
 	// sabdl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x32, 0x8d,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x4a, 0x7d, 0, 0,
 	// sabdl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x32, 0x95,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x4a, 0x85, 0, 0,
 
 	3,
 	// sabdl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x32, 0x9d,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x4a, 0x8d, 0, 0,
 	// sabdl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x32, 0xa5,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x4a, 0x95, 0, 0,
 	// sabdl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x32, 0xad,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4a, 0x9d, 0, 0,
 
 	3,
 	// sadalp Vd.8H, Vn.16B
 	// sadalp Vd.4H, Vn.8B
-	2, MatV, byte(WORD), MatV, byte(BYTE), 0x32, 0xb5,
+	2, MatV, byte(WORD), MatV, byte(BYTE), 0x0, 0x4a, 0xa5, 0, 0,
 	// sadalp Vd.4S, Vn.8H
 	// sadalp Vd.2S, Vn.4H
-	2, MatV, byte(DWORD), MatV, byte(WORD), 0x32, 0xbd,
+	2, MatV, byte(DWORD), MatV, byte(WORD), 0x0, 0x4a, 0xad, 0, 0,
 	// sadalp Vd.2D, Vn.4S
 	// sadalp Vd.1D, Vn.2S
-	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x32, 0xc5,
+	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x0, 0x4a, 0xb5, 0, 0,
 
 	3,
 	// saddl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x32, 0xcd,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x4a, 0xbd, 0, 0,
 	// saddl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x32, 0xd5,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x4a, 0xc5, 0, 0,
 	// saddl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x32, 0xdd,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x4a, 0xcd, 0, 0,
 
 	3,
 	// saddl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x32, 0xe5,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x4a, 0xd5, 0, 0,
 	// saddl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x32, 0xed,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x4a, 0xdd, 0, 0,
 	// saddl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x32, 0xf5,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4a, 0xe5, 0, 0,
 
 	3,
 	// saddlp Vd.8H, Vn.16B
 	// saddlp Vd.4H, Vn.8B
-	2, MatV, byte(WORD), MatV, byte(BYTE), 0x32, 0xfd,
+	2, MatV, byte(WORD), MatV, byte(BYTE), 0x0, 0x4a, 0xed, 0, 0,
 	// saddlp Vd.4S, Vn.8H
 	// saddlp Vd.2S, Vn.4H
-	2, MatV, byte(DWORD), MatV, byte(WORD), 0x33, 0x5,
+	2, MatV, byte(DWORD), MatV, byte(WORD), 0x0, 0x4a, 0xf5, 0, 0,
 	// saddlp Vd.2D, Vn.4S
 	// saddlp Vd.1D, Vn.2S
-	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x33, 0xd,
+	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x0, 0x4a, 0xfd, 0, 0,
 
 	3,
 	// saddlv Hd, Vn.16B
 	// saddlv Hd, Vn.8B
-	2, MatH, MatV, byte(BYTE), 0x33, 0x15,
+	2, MatH, MatV, byte(BYTE), 0x0, 0x4b, 0x5, 0, 0,
 	// saddlv Sd, Vn.8H
 	// saddlv Sd, Vn.4H
-	2, MatS, MatV, byte(WORD), 0x33, 0x1d,
+	2, MatS, MatV, byte(WORD), 0x0, 0x4b, 0xd, 0, 0,
 	// saddlv Dd, Vn.4S
-	2, MatD, MatVStatic, byte(DWORD), 4, 0x33, 0x25,
+	2, MatD, MatVStatic, byte(DWORD), 4, 0x0, 0x4b, 0x15, 0, 0,
+
+	3,
+	// saddv Dd, Pg, Zn.B  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(BYTE), 0x0, 0x4b, 0x1d, byte(FeatSVE), 0,
+	// saddv Dd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(WORD), 0x0, 0x4b, 0x26, byte(FeatSVE), 0,
+	// saddv Dd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(DWORD), 0x0, 0x4b, 0x2f, byte(FeatSVE), 0,
 
 	3,
 	// saddw Vd.8H, Vn.8H, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x33, 0x2d,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x4b, 0x38, 0, 0,
 	// saddw Vd.4S, Vn.4S, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x33, 0x35,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x4b, 0x40, 0, 0,
 	// saddw Vd.2D, Vn.2D, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x33, 0x3d,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x4b, 0x48, 0, 0,
 
 	3,
 	// saddw2 Vd.8H, Vn.8H, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x33, 0x45,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x0, 0x4b, 0x50, 0, 0,
 	// saddw2 Vd.4S, Vn.4S, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x33, 0x4d,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x0, 0x4b, 0x58, 0, 0,
 	// saddw2 Vd.2D, Vn.2D, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x33, 0x55,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x0, 0x4b, 0x60, 0, 0,
 
 	1,
 	// sb
-	0, 0x33, 0x5d,
+	0, 0x0, 0x4b, 0x68, byte(FeatSB), 0,
 
 	2,
 	// sbc Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x33, 0x62,
+	3, MatW, MatW, MatW, 0x0, 0x4b, 0x6d, 0, 0,
 	// sbc Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x33, 0x6a,
+	3, MatX, MatX, MatX, 0x0, 0x4b, 0x75, 0, 0,
 
 	2,
 	// sbcs Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x33, 0x72,
+	3, MatW, MatW, MatW, 0x0, 0x4b, 0x7d, 0, 0,
 	// sbcs Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x33, 0x7a,
+	3, MatX, MatX, MatX, 0x0, 0x4b, 0x85, 0, 0,
 
 	2,
 	// sbfiz Wd, Wn, #imm1, #imm2  ···············  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
-	4, MatW, MatW, MatImm, MatImm, 0x33, 0x82,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x4b, 0x8d, 0, 0,
 	// sbfiz Xd, Xn, #imm1, #imm2  ···············  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x33, 0x92,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x4b, 0x9d, 0, 0,
 
 	2,
 	// sbfm Wd, Wn, #imm1, #imm2  ···································  (0 <= imm1 < 32, 0 <= imm2 < 32)
-	4, MatW, MatW, MatImm, MatImm, 0x33, 0xa2,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x4b, 0xad, 0, 0,
 	// sbfm Xd, Xn, #imm1, #imm2  ·················  (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x33, 0xaf,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x4b, 0xba, 0, 0,
 
 	2,
 	// sbfx Wd, Wn, #imm1, #imm2  ················  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
-	4, MatW, MatW, MatImm, MatImm, 0x33, 0xbe,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x4b, 0xc9, 0, 0,
 	// sbfx Xd, Xn, #imm1, #imm2  ················  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x33, 0xcd,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x4b, 0xd8, 0, 0,
 
-	24,
+	27,
 	// scvtf Hd, Hn, #imm  ···························································  (0 < imm <= 16)
-	3, MatH, MatH, MatImm, 0x33, 0xdc,
+	3, MatH, MatH, MatImm, 0x0, 0x4b, 0xe7, byte(FeatFP16), 0,
 	// scvtf Sd, Sn, #imm  ···························································  (0 < imm <= 32)
-	3, MatS, MatS, MatImm, 0x33, 0xe9,
+	3, MatS, MatS, MatImm, 0x0, 0x4b, 0xf4, 0, 0,
 	// scvtf Dd, Dn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x33, 0xf6,
+	3, MatD, MatD, MatImm, 0x0, 0x4c, 0x1, 0, 0,
 	// scvtf Vd.8H, Vn.8H, #imm  ·····················································  (0 < imm <= 16)
 	// scvtf Vd.4H, Vn.4H, #imm  ·····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x34, 0x3,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x4c, 0xe, byte(FeatFP16), 0,
 	// scvtf Vd.4S, Vn.4S, #imm  ·····················································  (0 < imm <= 32)
 	// scvtf Vd.2S, Vn.2S, #imm  ·····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x34, 0xf,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x4c, 0x1a, 0, 0,
 	// scvtf Vd.2D, Vn.2D, #imm  ·····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x34, 0x1b,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x4c, 0x26, 0, 0,
 	// scvtf Hd, Hn
-	2, MatH, MatH, 0x34, 0x27,
+	2, MatH, MatH, 0x0, 0x4c, 0x32, byte(FeatFP16), 0,
 	// scvtf Sd, Sn
-	2, MatS, MatS, 0x34, 0x2e,
+	2, MatS, MatS, 0x0, 0x4c, 0x39, 0, 0,
 	// scvtf Dd, Dn
-	2, MatD, MatD, 0x34, 0x35,
+	2, MatD, MatD, 0x0, 0x4c, 0x40, 0, 0,
 	// scvtf Vd.8H, Vn.8H
 	// scvtf Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x34, 0x3c,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x4c, 0x47, byte(FeatFP16), 0,
 	// scvtf Vd.4S, Vn.4S
 	// scvtf Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x34, 0x44,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x4c, 0x4f, 0, 0,
 	// scvtf Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x34, 0x4c,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x4c, 0x57, 0, 0,
 	// scvtf Hd, Wn, #imm  ···························································  (0 < imm <= 32)
-	3, MatH, MatW, MatImm, 0x34, 0x54,
+	3, MatH, MatW, MatImm, 0x0, 0x4c, 0x5f, byte(FeatFP16), 0,
 	// scvtf Sd, Wn, #imm  ···························································  (0 < imm <= 32)
-	3, MatS, MatW, MatImm, 0x34, 0x61,
+	3, MatS, MatW, MatImm, 0x0, 0x4c, 0x6c, 0, 0,
 	// scvtf Dd, Wn, #imm  ···························································  (0 < imm <= 32)
-	3, MatD, MatW, MatImm, 0x34, 0x6e,
+	3, MatD, MatW, MatImm, 0x0, 0x4c, 0x79, 0, 0,
 	// scvtf Hd, Xn, #imm  ···························································  (0 < imm <= 64)
-	3, MatH, MatX, MatImm, 0x34, 0x7b,
+	3, MatH, MatX, MatImm, 0x0, 0x4c, 0x86, byte(FeatFP16), 0,
 	// scvtf Sd, Xn, #imm  ···························································  (0 < imm <= 64)
-	3, MatS, MatX, MatImm, 0x34, 0x86,
+	3, MatS, MatX, MatImm, 0x0, 0x4c, 0x91, 0, 0,
 	// scvtf Dd, Xn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatX, MatImm, 0x34, 0x91,
+	3, MatD, MatX, MatImm, 0x0, 0x4c, 0x9c, 0, 0,
 	// scvtf Hd, Wn
-	2, MatH, MatW, 0x34, 0x9c,
+	2, MatH, MatW, 0x0, 0x4c, 0xa7, byte(FeatFP16), 0,
 	// scvtf Sd, Wn
-	2, MatS, MatW, 0x34, 0xa3,
+	2, MatS, MatW, 0x0, 0x4c, 0xae, 0, 0,
 	// scvtf Dd, Wn
-	2, MatD, MatW, 0x34, 0xaa,
+	2, MatD, MatW, 0x0, 0x4c, 0xb5, 0, 0,
 	// scvtf Hd, Xn
-	2, MatH, MatX, 0x34, 0xb1,
+	2, MatH, MatX, 0x0, 0x4c, 0xbc, byte(FeatFP16), 0,
 	// scvtf Sd, Xn
-	2, MatS, MatX, 0x34, 0xb8,
+	2, MatS, MatX, 0x0, 0x4c, 0xc3, 0, 0,
 	// scvtf Dd, Xn
-	2, MatD, MatX, 0x34, 0xbf,
+	2, MatD, MatX, 0x0, 0x4c, 0xca, 0, 0,
+	// scvtf Zd.H, Pg/M, Zn.H  ·······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x4c, 0xd1, byte(FeatSVE), 0,
+	// scvtf Zd.S, Pg/M, Zn.S  ·······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x4c, 0xda, byte(FeatSVE), 0,
+	// scvtf Zd.D, Pg/M, Zn.D  ·······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x4c, 0xe3, byte(FeatSVE), 0,
 
-	2,
+	4,
 	// sdiv Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x34, 0xc6,
+	3, MatW, MatW, MatW, 0x0, 0x4c, 0xec, 0, 0,
 	// sdiv Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x34, 0xce,
+	3, MatX, MatX, MatX, 0x0, 0x4c, 0xf4, 0, 0,
+	// sdiv Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x4c, 0xfc, byte(FeatSVE), 0,
+	// sdiv Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x4d, 0x7, byte(FeatSVE), 0,
+
+	2,
+	// sdivr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x4d, 0x12, byte(FeatSVE), 0,
+	// sdivr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x4d, 0x1d, byte(FeatSVE), 0,
 
 	4,
 	// sdot Vd.2S, Vn.8B, Vm.4B[i]
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStaticElement, byte(BYTE), 4, 0x34, 0xd6,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStaticElement, byte(BYTE), 4, 0x0, 0x4d, 0x28, byte(FeatDotProd), 0,
 	// sdot Vd.4S, Vn.16B, Vm.4B[i]
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStaticElement, byte(BYTE), 4, 0x34, 0xe0,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStaticElement, byte(BYTE), 4, 0x0, 0x4d, 0x32, byte(FeatDotProd), 0,
 	// sdot Vd.2S, Vn.8B, Vm.8B
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x34, 0xea,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x4d, 0x3c, byte(FeatDotProd), 0,
 	// sdot Vd.4S, Vn.16B, Vm.16B
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x34, 0xf2,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x4d, 0x44, byte(FeatDotProd), 0,
+
+	4,
+	// sel Zd.B, Pg, Zn.B, Zm.B
+	4, MatZ, byte(BYTE), MatP, 0, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x4d, 0x4c, byte(FeatSVE), 0,
+	// sel Zd.H, Pg, Zn.H, Zm.H
+	4, MatZ, byte(WORD), MatP, 0, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x4d, 0x55, byte(FeatSVE), 0,
+	// sel Zd.S, Pg, Zn.S, Zm.S
+	4, MatZ, byte(DWORD), MatP, 0, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x4d, 0x5e, byte(FeatSVE), 0,
+	// sel Zd.D, Pg, Zn.D, Zm.D
+	4, MatZ, byte(QWORD), MatP, 0, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x4d, 0x67, byte(FeatSVE), 0,
+
+	1,
+	// sete [Xd]!, Xn, Xm
+	3, MatRefWback, MatX, MatX, 0x0, 0x4d, 0x70, byte(FeatMOPS), 0,
 
 	1,
 	// setf16 Wd
-	1, MatW, 0x34, 0xfa,
+	1, MatW, 0x0, 0x4d, 0x79, byte(FeatFlagM), 0,
 
 	1,
 	// setf8 Wd
-	1, MatW, 0x35, 0x0,
+	1, MatW, 0x0, 0x4d, 0x7f, byte(FeatFlagM), 0,
+
+	1,
+	// setffr
+	0, 0x0, 0x4d, 0x85, byte(FeatSVE), 0,
+
+	1,
+	// setge [Xd]!, Xn, Xm
+	3, MatRefWback, MatX, MatX, 0x0, 0x4d, 0x8a, byte(FeatMOPS), byte(FeatMTE),
+
+	1,
+	// setgm [Xd]!, Xn, Xm
+	3, MatRefWback, MatX, MatX, 0x0, 0x4d, 0x93, byte(FeatMOPS), byte(FeatMTE),
+
+	1,
+	// setgp [Xd]!, Xn, Xm
+	3, MatRefWback, MatX, MatX, 0x0, 0x4d, 0x9c, byte(FeatMOPS), byte(FeatMTE),
+
+	1,
+	// setm [Xd]!, Xn, Xm
+	3, MatRefWback, MatX, MatX, 0x0, 0x4d, 0xa5, byte(FeatMOPS), 0,
+
+	1,
+	// setp [Xd]!, Xn, Xm
+	3, MatRefWback, MatX, MatX, 0x0, 0x4d, 0xae, byte(FeatMOPS), 0,
 
 	1,
 	// sev
-	0, 0x35, 0x6,
+	0, 0x0, 0x4d, 0xb7, 0, 0,
 
 	1,
 	// sevl
-	0, 0x35, 0xb,
+	0, 0x0, 0x4d, 0xbc, 0, 0,
 
 	1,
 	// sha1c Qd, Sn, Vm.4S
-	3, MatQ, MatS, MatVStatic, byte(DWORD), 4, 0x35, 0x10,
+	3, MatQ, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xc1, byte(FeatSHA1), 0,
 
 	1,
 	// sha1h Sd, Sn
-	2, MatS, MatS, 0x35, 0x18,
+	2, MatS, MatS, 0x0, 0x4d, 0xc9, byte(FeatSHA1), 0,
 
 	1,
 	// sha1m Qd, Sn, Vm.4S
-	3, MatQ, MatS, MatVStatic, byte(DWORD), 4, 0x35, 0x1f,
+	3, MatQ, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xd0, byte(FeatSHA1), 0,
 
 	1,
 	// sha1p Qd, Sn, Vm.4S
-	3, MatQ, MatS, MatVStatic, byte(DWORD), 4, 0x35, 0x27,
+	3, MatQ, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xd8, byte(FeatSHA1), 0,
 
 	1,
 	// sha1su0 Vd.4S, Vn.4S, Vm.4S
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x35, 0x2f,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xe0, byte(FeatSHA1), 0,
 
 	1,
 	// sha1su1 Vd.4S, Vn.4S
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x35, 0x37,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xe8, byte(FeatSHA1), 0,
 
 	1,
 	// sha256h Qd, Qn, Vm.4S
-	3, MatQ, MatQ, MatVStatic, byte(DWORD), 4, 0x35, 0x3e,
+	3, MatQ, MatQ, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xef, byte(FeatSHA256), 0,
 
 	1,
 	// sha256h2 Qd, Qn, Vm.4S
-	3, MatQ, MatQ, MatVStatic, byte(DWORD), 4, 0x35, 0x46,
+	3, MatQ, MatQ, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xf7, byte(FeatSHA256), 0,
 
 	1,
 	// sha256su0 Vd.4S, Vn.4S
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x35, 0x4e,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4d, 0xff, byte(FeatSHA256), 0,
 
 	1,
 	// sha256su1 Vd.4S, Vn.4S, Vm.4S
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x35, 0x55,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4e, 0x6, byte(FeatSHA256), 0,
 
 	1,
 	// sha512h Qd, Qn, Vm.2D
-	3, MatQ, MatQ, MatVStatic, byte(QWORD), 2, 0x35, 0x5d,
+	3, MatQ, MatQ, MatVStatic, byte(QWORD), 2, 0x0, 0x4e, 0xe, byte(FeatSHA512), 0,
 
 	1,
 	// sha512h2 Qd, Qn, Vm.2D
-	3, MatQ, MatQ, MatVStatic, byte(QWORD), 2, 0x35, 0x65,
+	3, MatQ, MatQ, MatVStatic, byte(QWORD), 2, 0x0, 0x4e, 0x16, byte(FeatSHA512), 0,
 
 	1,
 	// sha512su0 Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x35, 0x6d,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x4e, 0x1e, byte(FeatSHA512), 0,
 
 	1,
 	// sha512su1 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x35, 0x74,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x4e, 0x25, byte(FeatSHA512), 0,
 
 	3,
 	// shadd Vd.16B, Vn.16B, Vm.16B
 	// shadd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x35, 0x7c,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x4e, 0x2d, 0, 0,
 	// shadd Vd.8H, Vn.8H, Vm.8H
 	// shadd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x35, 0x85,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x4e, 0x36, 0, 0,
 	// shadd Vd.4S, Vn.4S, Vm.4S
 	// shadd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x35, 0x8e,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x4e, 0x3f, 0, 0,
 
 	5,
 	// shl Dd, Dn, #imm  ·····························································  (0 <= imm < 64)
-	3, MatD, MatD, MatImm, 0x35, 0x97,
+	3, MatD, MatD, MatImm, 0x0, 0x4e, 0x48, 0, 0,
 	// shl Vd.16B, Vn.16B, #imm  ······················································  (0 <= imm < 8)
 	// shl Vd.8B, Vn.8B, #imm  ························································  (0 <= imm < 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x35, 0xa1,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x4e, 0x52, 0, 0,
 	// shl Vd.8H, Vn.8H, #imm  ·······················································  (0 <= imm < 16)
 	// shl Vd.4H, Vn.4H, #imm  ·······················································  (0 <= imm < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x35, 0xac,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x4e, 0x5d, 0, 0,
 	// shl Vd.4S, Vn.4S, #imm  ·······················································  (0 <= imm < 32)
 	// shl Vd.2S, Vn.2S, #imm  ·······················································  (0 <= imm < 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x35, 0xb7,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x4e, 0x68, 0, 0,
 	// shl Vd.2D, Vn.2D, #imm  ·······················································  (0 <= imm < 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x35, 0xc2,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x4e, 0x73, 0, 0,
 
 	3,
 	// shll Vd.8H, Vn.8B, #8
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatLitInt, 8, 0x35, 0xcd,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatLitInt, 8, 0x0, 0x4e, 0x7e, 0, 0,
 	// shll Vd.4S, Vn.4H, #16
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatLitInt, 16, 0x35, 0xd4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatLitInt, 16, 0x0, 0x4e, 0x85, 0, 0,
 	// shll Vd.2D, Vn.2S, #32
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatLitInt, 32, 0x35, 0xdb,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatLitInt, 32, 0x0, 0x4e, 0x8c, 0, 0,
 
 	3,
 	// shll2 Vd.8H, Vn.16B, #8
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatLitInt, 8, 0x35, 0xe2,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatLitInt, 8, 0x0, 0x4e, 0x93, 0, 0,
 	// shll2 Vd.4S, Vn.8H, #16
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatLitInt, 16, 0x35, 0xe9,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatLitInt, 16, 0x0, 0x4e, 0x9a, 0, 0,
 	// shll2 Vd.2D, Vn.4S, #32
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatLitInt, 32, 0x35, 0xf0,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatLitInt, 32, 0x0, 0x4e, 0xa1, 0, 0,
 
 	3,
 	// shrn Vd.8B, Vn.8H, #imm  ·······················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x35, 0xf7,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x4e, 0xa8, 0, 0,
 	// shrn Vd.4H, Vn.4S, #imm  ······················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x36, 0x2,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x4e, 0xb3, 0, 0,
 	// shrn Vd.2S, Vn.2D, #imm  ······················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x36, 0xd,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x4e, 0xbe, 0, 0,
 
 	3,
 	// shrn2 Vd.16B, Vn.8H, #imm  ·····················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x36, 0x18,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x4e, 0xc9, 0, 0,
 	// shrn2 Vd.8H, Vn.4S, #imm  ·····················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x36, 0x23,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x4e, 0xd4, 0, 0,
 	// shrn2 Vd.4S, Vn.2D, #imm  ·····················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x36, 0x2e,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x4e, 0xdf, 0, 0,
 
 	3,
 	// shsub Vd.16B, Vn.16B, Vm.16B
 	// shsub Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x36, 0x39,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x4e, 0xea, 0, 0,
 	// shsub Vd.8H, Vn.8H, Vm.8H
 	// shsub Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x36, 0x42,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x4e, 0xf3, 0, 0,
 	// shsub Vd.4S, Vn.4S, Vm.4S
 	// shsub Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x36, 0x4b,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x4e, 0xfc, 0, 0,
 
 	5,
 	// sli Dd, Dn, #imm  ·····························································  (0 <= imm < 64)
-	3, MatD, MatD, MatImm, 0x36, 0x54,
+	3, MatD, MatD, MatImm, 0x0, 0x4f, 0x5, 0, 0,
 	// sli Vd.16B, Vn.16B, #imm  ······················································  (0 <= imm < 8)
 	// sli Vd.8B, Vn.8B, #imm  ························································  (0 <= imm < 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x36, 0x5e,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x4f, 0xf, 0, 0,
 	// sli Vd.8H, Vn.8H, #imm  ·······················································  (0 <= imm < 16)
 	// sli Vd.4H, Vn.4H, #imm  ·······················································  (0 <= imm < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x36, 0x69,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x4f, 0x1a, 0, 0,
 	// sli Vd.4S, Vn.4S, #imm  ·······················································  (0 <= imm < 32)
 	// sli Vd.2S, Vn.2S, #imm  ·······················································  (0 <= imm < 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x36, 0x74,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x4f, 0x25, 0, 0,
 	// sli Vd.2D, Vn.2D, #imm  ·······················································  (0 <= imm < 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x36, 0x7f,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x4f, 0x30, 0, 0,
 
 	1,
 	// sm3partw1 Vd.4S, Vn.4S, Vm.4S
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x36, 0x8a,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4f, 0x3b, byte(FeatSM3), 0,
 
 	1,
 	// sm3partw2 Vd.4S, Vn.4S, Vm.4S
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x36, 0x92,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4f, 0x43, byte(FeatSM3), 0,
 
 	1,
 	// sm3ss1 Vd.4S, Vn.4S, Vm.4S, Va.4S
-	4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x36, 0x9a,
+	4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4f, 0x4b, byte(FeatSM3), 0,
 
 	1,
 	// sm3tt1a Vd.4S, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x36, 0xa3,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x4f, 0x54, byte(FeatSM3), 0,
 
 	1,
 	// sm3tt1b Vd.4S, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x36, 0xae,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x4f, 0x5f, byte(FeatSM3), 0,
 
 	1,
 	// sm3tt2a Vd.4S, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x36, 0xb9,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x4f, 0x6a, byte(FeatSM3), 0,
 
 	1,
 	// sm3tt2b Vd.4S, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x36, 0xc4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x4f, 0x75, byte(FeatSM3), 0,
 
 	1,
 	// sm4e Vd.4S, Vn.4S
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x36, 0xcf,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4f, 0x80, byte(FeatSM4), 0,
 
 	1,
 	// sm4ekey Vd.4S, Vn.4S, Vm.4S
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x36, 0xd6,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x4f, 0x87, byte(FeatSM4), 0,
 
 	1,
 	// smaddl Xd, Wn, Wm, Xa
-	4, MatX, MatW, MatW, MatX, 0x36, 0xde,
+	4, MatX, MatW, MatW, MatX, 0x0, 0x4f, 0x8f, 0, 0,
 
-	3,
+	15,
 	// smax Vd.16B, Vn.16B, Vm.16B
 	// smax Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x36, 0xe7,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x4f, 0x98, 0, 0,
 	// smax Vd.8H, Vn.8H, Vm.8H
 	// smax Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x36, 0xf0,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x4f, 0xa1, 0, 0,
 	// smax Vd.4S, Vn.4S, Vm.4S
 	// smax Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x36, 0xf9,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x4f, 0xaa, 0, 0,
+	// smax Wd, Wn, #imm  ························································  (-128 <= imm < 128)
+	3, MatW, MatW, MatImm, 0x0, 0x4f, 0xb3, byte(FeatCSSC), 0,
+	// smax Xd, Xn, #imm  ························································  (-128 <= imm < 128)
+	3, MatX, MatX, MatImm, 0x0, 0x4f, 0xbd, byte(FeatCSSC), 0,
+	// smax Wd, Wn, Wm
+	3, MatW, MatW, MatW, 0x0, 0x4f, 0xc7, byte(FeatCSSC), 0,
+	// smax Xd, Xn, Xm
+	3, MatX, MatX, MatX, 0x0, 0x4f, 0xcf, byte(FeatCSSC), 0,
+	// smax Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x4f, 0xd7, byte(FeatSVE), 0,
+	// smax Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x4f, 0xe2, byte(FeatSVE), 0,
+	// smax Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x4f, 0xed, byte(FeatSVE), 0,
+	// smax Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x4f, 0xf8, byte(FeatSVE), 0,
+	// smax Zd.B, Zn.B, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x50, 0x3, byte(FeatSVE), 0,
+	// smax Zd.H, Zn.H, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x50, 0xe, byte(FeatSVE), 0,
+	// smax Zd.S, Zn.S, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x50, 0x19, byte(FeatSVE), 0,
+	// smax Zd.D, Zn.D, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x50, 0x24, byte(FeatSVE), 0,
 
 	3,
 	// smaxp Vd.16B, Vn.16B, Vm.16B
 	// smaxp Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x37, 0x2,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x50, 0x2f, 0, 0,
 	// smaxp Vd.8H, Vn.8H, Vm.8H
 	// smaxp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x37, 0xb,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x50, 0x38, 0, 0,
 	// smaxp Vd.4S, Vn.4S, Vm.4S
 	// smaxp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x37, 0x14,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x50, 0x41, 0, 0,
 
-	3,
+	7,
 	// smaxv Bd, Vn.16B
 	// smaxv Bd, Vn.8B
-	2, MatB, MatV, byte(BYTE), 0x37, 0x1d,
+	2, MatB, MatV, byte(BYTE), 0x0, 0x50, 0x4a, 0, 0,
 	// smaxv Hd, Vn.8H
 	// smaxv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x37, 0x25,
+	2, MatH, MatV, byte(WORD), 0x0, 0x50, 0x52, 0, 0,
 	// smaxv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x37, 0x2d,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x50, 0x5a, 0, 0,
+	// smaxv Bd, Pg, Zn.B  ···································································  (g < 8)
+	3, MatB, MatP, 0, MatZ, byte(BYTE), 0x0, 0x50, 0x62, byte(FeatSVE), 0,
+	// smaxv Hd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x50, 0x6b, byte(FeatSVE), 0,
+	// smaxv Sd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x50, 0x74, byte(FeatSVE), 0,
+	// smaxv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x50, 0x7d, byte(FeatSVE), 0,
 
 	1,
 	// smc #imm  ··································································  (0 <= imm < 65536)
-	1, MatImm, 0x37, 0x35,
+	1, MatImm, 0x0, 0x50, 0x86, 0, 0,
 
-	3,
+	15,
 	// smin Vd.16B, Vn.16B, Vm.16B
 	// smin Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x37, 0x3d,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x50, 0x8e, 0, 0,
 	// smin Vd.8H, Vn.8H, Vm.8H
 	// smin Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x37, 0x46,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x50, 0x97, 0, 0,
 	// smin Vd.4S, Vn.4S, Vm.4S
 	// smin Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x37, 0x4f,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x50, 0xa0, 0, 0,
+	// smin Wd, Wn, #imm  ························································  (-128 <= imm < 128)
+	3, MatW, MatW, MatImm, 0x0, 0x50, 0xa9, byte(FeatCSSC), 0,
+	// smin Xd, Xn, #imm  ························································  (-128 <= imm < 128)
+	3, MatX, MatX, MatImm, 0x0, 0x50, 0xb3, byte(FeatCSSC), 0,
+	// smin Wd, Wn, Wm
+	3, MatW, MatW, MatW, 0x0, 0x50, 0xbd, byte(FeatCSSC), 0,
+	// smin Xd, Xn, Xm
+	3, MatX, MatX, MatX, 0x0, 0x50, 0xc5, byte(FeatCSSC), 0,
+	// smin Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x50, 0xcd, byte(FeatSVE), 0,
+	// smin Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x50, 0xd8, byte(FeatSVE), 0,
+	// smin Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x50, 0xe3, byte(FeatSVE), 0,
+	// smin Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x50, 0xee, byte(FeatSVE), 0,
+	// smin Zd.B, Zn.B, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x50, 0xf9, byte(FeatSVE), 0,
+	// smin Zd.H, Zn.H, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x51, 0x4, byte(FeatSVE), 0,
+	// smin Zd.S, Zn.S, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x51, 0xf, byte(FeatSVE), 0,
+	// smin Zd.D, Zn.D, #imm  ············································  (n == d, -128 <= imm < 128)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x51, 0x1a, byte(FeatSVE), 0,
 
 	3,
 	// sminp Vd.16B, Vn.16B, Vm.16B
 	// sminp Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x37, 0x58,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x51, 0x25, 0, 0,
 	// sminp Vd.8H, Vn.8H, Vm.8H
 	// sminp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x37, 0x61,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x51, 0x2e, 0, 0,
 	// sminp Vd.4S, Vn.4S, Vm.4S
 	// sminp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x37, 0x6a,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x51, 0x37, 0, 0,
 
-	3,
+	7,
 	// sminv Bd, Vn.16B
 	// sminv Bd, Vn.8B
-	2, MatB, MatV, byte(BYTE), 0x37, 0x73,
+	2, MatB, MatV, byte(BYTE), 0x0, 0x51, 0x40, 0, 0,
 	// sminv Hd, Vn.8H
 	// sminv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x37, 0x7b,
+	2, MatH, MatV, byte(WORD), 0x0, 0x51, 0x48, 0, 0,
 	// sminv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x37, 0x83,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x51, 0x50, 0, 0,
+	// sminv Bd, Pg, Zn.B  ···································································  (g < 8)
+	3, MatB, MatP, 0, MatZ, byte(BYTE), 0x0, 0x51, 0x58, byte(FeatSVE), 0,
+	// sminv Hd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x51, 0x61, byte(FeatSVE), 0,
+	// sminv Sd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x51, 0x6a, byte(FeatSVE), 0,
+	// sminv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x51, 0x73, byte(FeatSVE), 0,
 
 	5,
 	// smlal Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x37, 0x8b,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x51, 0x7c, 0, 0,
 	// smlal Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x37, 0x95,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x51, 0x86, 0, 0,
 	// smlal Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x37, 0x9f,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x51, 0x90, 0, 0,
 	// smlal Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x37, 0xa7,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x51, 0x98, 0, 0,
 	// smlal Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x37, 0xaf,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x51, 0xa0, 0, 0,
 
 	5,
 	// smlal2 Vd.4S, Vn.8H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x37, 0xb7,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x51, 0xa8, 0, 0,
 	// smlal2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x37, 0xc1,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x51, 0xb2, 0, 0,
 	// smlal2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x37, 0xcb,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x51, 0xbc, 0, 0,
 	// smlal2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x37, 0xd3,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x51, 0xc4, 0, 0,
 	// smlal2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x37, 0xdb,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x51, 0xcc, 0, 0,
 
 	5,
 	// smlsl Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x37, 0xe3,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x51, 0xd4, 0, 0,
 	// smlsl Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x37, 0xed,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x51, 0xde, 0, 0,
 	// smlsl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x37, 0xf7,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x51, 0xe8, 0, 0,
 	// smlsl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x37, 0xff,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x51, 0xf0, 0, 0,
 	// smlsl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x38, 0x7,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x51, 0xf8, 0, 0,
 
 	5,
 	// smlsl2 Vd.4S, Vn.8H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x38, 0xf,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x52, 0x0, 0, 0,
 	// smlsl2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x38, 0x19,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x52, 0xa, 0, 0,
 	// smlsl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x38, 0x23,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x52, 0x14, 0, 0,
 	// smlsl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x38, 0x2b,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x52, 0x1c, 0, 0,
 	// smlsl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x38, 0x33,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x52, 0x24, 0, 0,
+
+	1,
+	// smmla Vd.4S, Vn.16B, Vm.16B
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x52, 0x2c, byte(FeatI8MM), 0,
 
 	1,
 	// smnegl Xd, Wn, Wm
-	3, MatX, MatW, MatW, 0x38, 0x3b,
+	3, MatX, MatW, MatW, 0x0, 0x52, 0x34, 0, 0,
+
+	2,
+	// smopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x52, 0x3c, byte(FeatSME), 0,
+	// smopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x52, 0x4a, byte(FeatSMEI16I64), 0,
+
+	2,
+	// smops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x52, 0x58, byte(FeatSME), 0,
+	// smops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x52, 0x66, byte(FeatSMEI16I64), 0,
 
 	5,
 	// smov Wd, Vn.B[i]
-	2, MatW, MatVElement, byte(BYTE), 0x38, 0x43,
+	2, MatW, MatVElement, byte(BYTE), 0x0, 0x52, 0x74, 0, 0,
 	// smov Wd, Vn.H[i]
-	2, MatW, MatVElement, byte(WORD), 0x38, 0x4d,
+	2, MatW, MatVElement, byte(WORD), 0x0, 0x52, 0x7e, 0, 0,
 	// smov Xd, Vn.B[i]
-	2, MatX, MatVElement, byte(BYTE), 0x38, 0x57,
+	2, MatX, MatVElement, byte(BYTE), 0x0, 0x52, 0x88, 0, 0,
 	// smov Xd, Vn.H[i]
-	2, MatX, MatVElement, byte(WORD), 0x38, 0x61,
+	2, MatX, MatVElement, byte(WORD), 0x0, 0x52, 0x92, 0, 0,
 	// smov Xd, Vn.S[i]
-	2, MatX, MatVElement, byte(DWORD), 0x38, 0x6b,
+	2, MatX, MatVElement, byte(DWORD), 0x0, 0x52, 0x9c, 0, 0,
+
+	2,
+	// smstart
+	0, 0x0, 0x52, 0xa6, byte(FeatSME), 0,
+	// smstart <symbol>
+	1, MatSymbol, 0x0, 0x52, 0xab, byte(FeatSME), 0,
+
+	2,
+	// smstop
+	0, 0x0, 0x52, 0xb3, byte(FeatSME), 0,
+	// smstop <symbol>
+	1, MatSymbol, 0x0, 0x52, 0xb8, byte(FeatSME), 0,
 
 	1,
 	// smsubl Xd, Wn, Wm, Xa
-	4, MatX, MatW, MatW, MatX, 0x38, 0x75,
+	4, MatX, MatW, MatW, MatX, 0x0, 0x52, 0xc0, 0, 0,
 
-	1,
+	9,
 	// smulh Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x38, 0x7e,
+	3, MatX, MatX, MatX, 0x0, 0x52, 0xc9, 0, 0,
+	// smulh Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x52, 0xd1, byte(FeatSVE2), 0,
+	// smulh Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x52, 0xd9, byte(FeatSVE2), 0,
+	// smulh Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x52, 0xe1, byte(FeatSVE2), 0,
+	// smulh Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x52, 0xe9, byte(FeatSVE2), 0,
+	// smulh Zd.B, Pg/M, Zn.B, Zm.B  ·················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x52, 0xf1, byte(FeatSVE), 0,
+	// smulh Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x52, 0xfc, byte(FeatSVE), 0,
+	// smulh Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x53, 0x7, byte(FeatSVE), 0,
+	// smulh Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x53, 0x12, byte(FeatSVE), 0,
 
 	6,
 	// smull Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x38, 0x86,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x53, 0x1d, 0, 0,
 	// smull Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x38, 0x90,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x53, 0x27, 0, 0,
 	// smull Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x38, 0x9a,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x53, 0x31, 0, 0,
 	// smull Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x38, 0xa2,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x53, 0x39, 0, 0,
 	// smull Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x38, 0xaa,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x53, 0x41, 0, 0,
 	// smull Xd, Wn, Wm
-	3, MatX, MatW, MatW, 0x38, 0xb2,
+	3, MatX, MatW, MatW, 0x0, 0x53, 0x49, 0, 0,
 
 	5,
 	// smull2 Vd.4S, Vn.8H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x38, 0xba,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x53, 0x51, 0, 0,
 	// smull2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x38, 0xc4,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x53, 0x5b, 0, 0,
 	// smull2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x38, 0xce,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x53, 0x65, 0, 0,
 	// smull2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x38, 0xd6,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x53, 0x6d, 0, 0,
 	// smull2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x38, 0xde,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x53, 0x75, 0, 0,
+
+	4,
+	// splice Zd.B, Pg, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatP, 0, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x53, 0x7d, byte(FeatSVE), 0,
+	// splice Zd.H, Pg, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatP, 0, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x53, 0x88, byte(FeatSVE), 0,
+	// splice Zd.S, Pg, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatP, 0, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x53, 0x93, byte(FeatSVE), 0,
+	// splice Zd.D, Pg, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatP, 0, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x53, 0x9e, byte(FeatSVE), 0,
 
 	8,
 	// sqabs Bd, Bn
-	2, MatB, MatB, 0x38, 0xe6,
+	2, MatB, MatB, 0x0, 0x53, 0xa9, 0, 0,
 	// sqabs Hd, Hn
-	2, MatH, MatH, 0x38, 0xed,
+	2, MatH, MatH, 0x0, 0x53, 0xb0, 0, 0,
 	// sqabs Sd, Sn
-	2, MatS, MatS, 0x38, 0xf4,
+	2, MatS, MatS, 0x0, 0x53, 0xb7, 0, 0,
 	// sqabs Dd, Dn
-	2, MatD, MatD, 0x38, 0xfb,
+	2, MatD, MatD, 0x0, 0x53, 0xbe, 0, 0,
 	// sqabs Vd.16B, Vn.16B
 	// sqabs Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x39, 0x2,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x53, 0xc5, 0, 0,
 	// sqabs Vd.8H, Vn.8H
 	// sqabs Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x39, 0xa,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x53, 0xcd, 0, 0,
 	// sqabs Vd.4S, Vn.4S
 	// sqabs Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x39, 0x12,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x53, 0xd5, 0, 0,
 	// sqabs Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x39, 0x1a,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x53, 0xdd, 0, 0,
 
-	8,
+	16,
 	// sqadd Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x39, 0x22,
+	3, MatB, MatB, MatB, 0x0, 0x53, 0xe5, 0, 0,
 	// sqadd Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x39, 0x2a,
+	3, MatH, MatH, MatH, 0x0, 0x53, 0xed, 0, 0,
 	// sqadd Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x39, 0x32,
+	3, MatS, MatS, MatS, 0x0, 0x53, 0xf5, 0, 0,
 	// sqadd Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x39, 0x3a,
+	3, MatD, MatD, MatD, 0x0, 0x53, 0xfd, 0, 0,
 	// sqadd Vd.16B, Vn.16B, Vm.16B
 	// sqadd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x39, 0x42,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x54, 0x5, 0, 0,
 	// sqadd Vd.8H, Vn.8H, Vm.8H
 	// sqadd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x39, 0x4b,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x54, 0xe, 0, 0,
 	// sqadd Vd.4S, Vn.4S, Vm.4S
 	// sqadd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x39, 0x54,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x54, 0x17, 0, 0,
 	// sqadd Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x39, 0x5d,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x54, 0x20, 0, 0,
+	// sqadd Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x54, 0x29, byte(FeatSVE), 0,
+	// sqadd Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x54, 0x31, byte(FeatSVE), 0,
+	// sqadd Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x54, 0x39, byte(FeatSVE), 0,
+	// sqadd Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x54, 0x41, byte(FeatSVE), 0,
+	// sqadd Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x54, 0x49, byte(FeatSVE), 0,
+	// sqadd Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x54, 0x54, byte(FeatSVE), 0,
+	// sqadd Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x54, 0x62, byte(FeatSVE), 0,
+	// sqadd Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x54, 0x70, byte(FeatSVE), 0,
 
 	8,
 	// sqdmlal Sd, Hn, Vm.H[i]  ·····························································  (m < 16)
-	3, MatS, MatH, MatVElement, byte(WORD), 0x39, 0x66,
+	3, MatS, MatH, MatVElement, byte(WORD), 0x0, 0x54, 0x7e, 0, 0,
 	// sqdmlal Dd, Sn, Vm.S[i]
-	3, MatD, MatS, MatVElement, byte(DWORD), 0x39, 0x70,
+	3, MatD, MatS, MatVElement, byte(DWORD), 0x0, 0x54, 0x88, 0, 0,
 	// sqdmlal Vd.4S, Vn.4H, Vm.H[i]  ·······················································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x39, 0x7a,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x54, 0x92, 0, 0,
 	// sqdmlal Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x39, 0x84,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x54, 0x9c, 0, 0,
 	// sqdmlal Sd, Hn, Hm
-	3, MatS, MatH, MatH, 0x39, 0x8e,
+	3, MatS, MatH, MatH, 0x0, 0x54, 0xa6, 0, 0,
 	// sqdmlal Dd, Sn, Sm
-	3, MatD, MatS, MatS, 0x39, 0x96,
+	3, MatD, MatS, MatS, 0x0, 0x54, 0xae, 0, 0,
 	// sqdmlal Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x39, 0x9e,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x54, 0xb6, 0, 0,
 	// sqdmlal Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x39, 0xa6,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x54, 0xbe, 0, 0,
 
 	4,
 	// sqdmlal2 Vd.4S, Vn.8H, Vm.H[i]  ······················································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x39, 0xae,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x54, 0xc6, 0, 0,
 	// sqdmlal2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x39, 0xb8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x54, 0xd0, 0, 0,
 	// sqdmlal2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x39, 0xc2,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x54, 0xda, 0, 0,
 	// sqdmlal2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x39, 0xca,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x54, 0xe2, 0, 0,
 
 	8,
 	// sqdmlsl Sd, Hn, Vm.H[i]  ·····························································  (m < 16)
-	3, MatS, MatH, MatVElement, byte(WORD), 0x39, 0xd2,
+	3, MatS, MatH, MatVElement, byte(WORD), 0x0, 0x54, 0xea, 0, 0,
 	// sqdmlsl Dd, Sn, Vm.S[i]
-	3, MatD, MatS, MatVElement, byte(DWORD), 0x39, 0xdc,
+	3, MatD, MatS, MatVElement, byte(DWORD), 0x0, 0x54, 0xf4, 0, 0,
 	// sqdmlsl Vd.4S, Vn.4H, Vm.H[i]  ·······················································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x39, 0xe6,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x54, 0xfe, 0, 0,
 	// sqdmlsl Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x39, 0xf0,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x55, 0x8, 0, 0,
 	// sqdmlsl Sd, Hn, Hm
-	3, MatS, MatH, MatH, 0x39, 0xfa,
+	3, MatS, MatH, MatH, 0x0, 0x55, 0x12, 0, 0,
 	// sqdmlsl Dd, Sn, Sm
-	3, MatD, MatS, MatS, 0x3a, 0x2,
+	3, MatD, MatS, MatS, 0x0, 0x55, 0x1a, 0, 0,
 	// sqdmlsl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x3a, 0xa,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x55, 0x22, 0, 0,
 	// sqdmlsl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x3a, 0x12,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x55, 0x2a, 0, 0,
 
 	4,
 	// sqdmlsl2 Vd.4S, Vn.8H, Vm.H[i]  ······················································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x3a, 0x1a,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x55, 0x32, 0, 0,
 	// sqdmlsl2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x3a, 0x24,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x55, 0x3c, 0, 0,
 	// sqdmlsl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x3a, 0x2e,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x55, 0x46, 0, 0,
 	// sqdmlsl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x3a, 0x36,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x55, 0x4e, 0, 0,
 
-	8,
+	12,
 	// sqdmulh Hd, Hn, Vm.H[i]  ·····························································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x3a, 0x3e,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x55, 0x56, 0, 0,
 	// sqdmulh Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x3a, 0x48,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x55, 0x60, 0, 0,
 	// sqdmulh Vd.8H, Vn.8H, Vm.H[i]  ·······················································  (m < 16)
 	// sqdmulh Vd.4H, Vn.4H, Vm.H[i]  ·······················································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x3a, 0x52,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x55, 0x6a, 0, 0,
 	// sqdmulh Vd.4S, Vn.4S, Vm.S[i]
 	// sqdmulh Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x3a, 0x5d,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x55, 0x75, 0, 0,
 	// sqdmulh Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x3a, 0x68,
+	3, MatH, MatH, MatH, 0x0, 0x55, 0x80, 0, 0,
 	// sqdmulh Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x3a, 0x70,
+	3, MatS, MatS, MatS, 0x0, 0x55, 0x88, 0, 0,
 	// sqdmulh Vd.8H, Vn.8H, Vm.8H
 	// sqdmulh Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3a, 0x78,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x55, 0x90, 0, 0,
 	// sqdmulh Vd.4S, Vn.4S, Vm.4S
 	// sqdmulh Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3a, 0x81,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x55, 0x99, 0, 0,
+	// sqdmulh Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x55, 0xa2, byte(FeatSVE2), 0,
+	// sqdmulh Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x55, 0xaa, byte(FeatSVE2), 0,
+	// sqdmulh Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x55, 0xb2, byte(FeatSVE2), 0,
+	// sqdmulh Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x55, 0xba, byte(FeatSVE2), 0,
 
 	8,
 	// sqdmull Sd, Hn, Vm.H[i]  ·····························································  (m < 16)
-	3, MatS, MatH, MatVElement, byte(WORD), 0x3a, 0x8a,
+	3, MatS, MatH, MatVElement, byte(WORD), 0x0, 0x55, 0xc2, 0, 0,
 	// sqdmull Dd, Sn, Vm.S[i]
-	3, MatD, MatS, MatVElement, byte(DWORD), 0x3a, 0x94,
+	3, MatD, MatS, MatVElement, byte(DWORD), 0x0, 0x55, 0xcc, 0, 0,
 	// sqdmull Vd.4S, Vn.4H, Vm.H[i]  ·······················································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x3a, 0x9e,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x55, 0xd6, 0, 0,
 	// sqdmull Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x3a, 0xa8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x55, 0xe0, 0, 0,
 	// sqdmull Sd, Hn, Hm
-	3, MatS, MatH, MatH, 0x3a, 0xb2,
+	3, MatS, MatH, MatH, 0x0, 0x55, 0xea, 0, 0,
 	// sqdmull Dd, Sn, Sm
-	3, MatD, MatS, MatS, 0x3a, 0xba,
+	3, MatD, MatS, MatS, 0x0, 0x55, 0xf2, 0, 0,
 	// sqdmull Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x3a, 0xc2,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x55, 0xfa, 0, 0,
 	// sqdmull Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x3a, 0xca,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x56, 0x2, 0, 0,
 
 	4,
 	// sqdmull2 Vd.4S, Vn.8H, Vm.H[i]  ······················································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x3a, 0xd2,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x56, 0xa, 0, 0,
 	// sqdmull2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x3a, 0xdc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x56, 0x14, 0, 0,
 	// sqdmull2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x3a, 0xe6,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x56, 0x1e, 0, 0,
 	// sqdmull2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x3a, 0xee,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x56, 0x26, 0, 0,
 
 	8,
 	// sqneg Bd, Bn
-	2, MatB, MatB, 0x3a, 0xf6,
+	2, MatB, MatB, 0x0, 0x56, 0x2e, 0, 0,
 	// sqneg Hd, Hn
-	2, MatH, MatH, 0x3a, 0xfd,
+	2, MatH, MatH, 0x0, 0x56, 0x35, 0, 0,
 	// sqneg Sd, Sn
-	2, MatS, MatS, 0x3b, 0x4,
+	2, MatS, MatS, 0x0, 0x56, 0x3c, 0, 0,
 	// sqneg Dd, Dn
-	2, MatD, MatD, 0x3b, 0xb,
+	2, MatD, MatD, 0x0, 0x56, 0x43, 0, 0,
 	// sqneg Vd.16B, Vn.16B
 	// sqneg Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x3b, 0x12,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x56, 0x4a, 0, 0,
 	// sqneg Vd.8H, Vn.8H
 	// sqneg Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x3b, 0x1a,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x56, 0x52, 0, 0,
 	// sqneg Vd.4S, Vn.4S
 	// sqneg Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x3b, 0x22,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x56, 0x5a, 0, 0,
 	// sqneg Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x3b, 0x2a,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x56, 0x62, 0, 0,
 
 	8,
 	// sqrdmlah Hd, Hn, Vm.H[i]  ····························································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x3b, 0x32,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x56, 0x6a, byte(FeatRDM), 0,
 	// sqrdmlah Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x3b, 0x3c,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x56, 0x74, byte(FeatRDM), 0,
 	// sqrdmlah Vd.8H, Vn.8H, Vm.H[i]  ······················································  (m < 16)
 	// sqrdmlah Vd.4H, Vn.4H, Vm.H[i]  ······················································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x3b, 0x46,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x56, 0x7e, byte(FeatRDM), 0,
 	// sqrdmlah Vd.4S, Vn.4S, Vm.S[i]
 	// sqrdmlah Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x3b, 0x51,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x56, 0x89, byte(FeatRDM), 0,
 	// sqrdmlah Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x3b, 0x5c,
+	3, MatH, MatH, MatH, 0x0, 0x56, 0x94, byte(FeatRDM), 0,
 	// sqrdmlah Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x3b, 0x64,
+	3, MatS, MatS, MatS, 0x0, 0x56, 0x9c, byte(FeatRDM), 0,
 	// sqrdmlah Vd.8H, Vn.8H, Vm.8H
 	// sqrdmlah Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3b, 0x6c,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x56, 0xa4, byte(FeatRDM), 0,
 	// sqrdmlah Vd.4S, Vn.4S, Vm.4S
 	// sqrdmlah Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3b, 0x75,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x56, 0xad, byte(FeatRDM), 0,
 
 	8,
 	// sqrdmlsh Hd, Hn, Vm.H[i]  ····························································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x3b, 0x7e,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x56, 0xb6, byte(FeatRDM), 0,
 	// sqrdmlsh Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x3b, 0x88,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x56, 0xc0, byte(FeatRDM), 0,
 	// sqrdmlsh Vd.8H, Vn.8H, Vm.H[i]  ······················································  (m < 16)
 	// sqrdmlsh Vd.4H, Vn.4H, Vm.H[i]  ······················································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x3b, 0x92,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x56, 0xca, byte(FeatRDM), 0,
 	// sqrdmlsh Vd.4S, Vn.4S, Vm.S[i]
 	// sqrdmlsh Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x3b, 0x9d,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x56, 0xd5, byte(FeatRDM), 0,
 	// sqrdmlsh Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x3b, 0xa8,
+	3, MatH, MatH, MatH, 0x0, 0x56, 0xe0, byte(FeatRDM), 0,
 	// sqrdmlsh Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x3b, 0xb0,
+	3, MatS, MatS, MatS, 0x0, 0x56, 0xe8, byte(FeatRDM), 0,
 	// sqrdmlsh Vd.8H, Vn.8H, Vm.8H
 	// sqrdmlsh Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3b, 0xb8,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x56, 0xf0, byte(FeatRDM), 0,
 	// sqrdmlsh Vd.4S, Vn.4S, Vm.4S
 	// sqrdmlsh Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3b, 0xc1,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x56, 0xf9, byte(FeatRDM), 0,
 
 	8,
 	// sqrdmulh Hd, Hn, Vm.H[i]  ····························································  (m < 16)
-	3, MatH, MatH, MatVElement, byte(WORD), 0x3b, 0xca,
+	3, MatH, MatH, MatVElement, byte(WORD), 0x0, 0x57, 0x2, 0, 0,
 	// sqrdmulh Sd, Sn, Vm.S[i]
-	3, MatS, MatS, MatVElement, byte(DWORD), 0x3b, 0xd4,
+	3, MatS, MatS, MatVElement, byte(DWORD), 0x0, 0x57, 0xc, 0, 0,
 	// sqrdmulh Vd.8H, Vn.8H, Vm.H[i]  ······················································  (m < 16)
 	// sqrdmulh Vd.4H, Vn.4H, Vm.H[i]  ······················································  (m < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x3b, 0xde,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatVElement, byte(WORD), 0x0, 0x57, 0x16, 0, 0,
 	// sqrdmulh Vd.4S, Vn.4S, Vm.S[i]
 	// sqrdmulh Vd.2S, Vn.2S, Vm.S[i]
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x3b, 0xe9,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatVElement, byte(DWORD), 0x0, 0x57, 0x21, 0, 0,
 	// sqrdmulh Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x3b, 0xf4,
+	3, MatH, MatH, MatH, 0x0, 0x57, 0x2c, 0, 0,
 	// sqrdmulh Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x3b, 0xfc,
+	3, MatS, MatS, MatS, 0x0, 0x57, 0x34, 0, 0,
 	// sqrdmulh Vd.8H, Vn.8H, Vm.8H
 	// sqrdmulh Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3c, 0x4,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x57, 0x3c, 0, 0,
 	// sqrdmulh Vd.4S, Vn.4S, Vm.4S
 	// sqrdmulh Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3c, 0xd,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x57, 0x45, 0, 0,
 
 	8,
 	// sqrshl Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x3c, 0x16,
+	3, MatB, MatB, MatB, 0x0, 0x57, 0x4e, 0, 0,
 	// sqrshl Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x3c, 0x1e,
+	3, MatH, MatH, MatH, 0x0, 0x57, 0x56, 0, 0,
 	// sqrshl Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x3c, 0x26,
+	3, MatS, MatS, MatS, 0x0, 0x57, 0x5e, 0, 0,
 	// sqrshl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x3c, 0x2e,
+	3, MatD, MatD, MatD, 0x0, 0x57, 0x66, 0, 0,
 	// sqrshl Vd.16B, Vn.16B, Vm.16B
 	// sqrshl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x3c, 0x36,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x57, 0x6e, 0, 0,
 	// sqrshl Vd.8H, Vn.8H, Vm.8H
 	// sqrshl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3c, 0x3f,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x57, 0x77, 0, 0,
 	// sqrshl Vd.4S, Vn.4S, Vm.4S
 	// sqrshl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3c, 0x48,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x57, 0x80, 0, 0,
 	// sqrshl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x3c, 0x51,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x57, 0x89, 0, 0,
 
 	6,
 	// sqrshrn Bd, Hn, #imm  ··························································  (0 < imm <= 8)
-	3, MatB, MatH, MatImm, 0x3c, 0x5a,
+	3, MatB, MatH, MatImm, 0x0, 0x57, 0x92, 0, 0,
 	// sqrshrn Hd, Sn, #imm  ·························································  (0 < imm <= 16)
-	3, MatH, MatS, MatImm, 0x3c, 0x67,
+	3, MatH, MatS, MatImm, 0x0, 0x57, 0x9f, 0, 0,
 	// sqrshrn Sd, Dn, #imm  ·························································  (0 < imm <= 32)
-	3, MatS, MatD, MatImm, 0x3c, 0x74,
+	3, MatS, MatD, MatImm, 0x0, 0x57, 0xac, 0, 0,
 	// sqrshrn Vd.8B, Vn.8H, #imm  ····················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x3c, 0x81,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x57, 0xb9, 0, 0,
 	// sqrshrn Vd.4H, Vn.4S, #imm  ···················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x3c, 0x8c,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x57, 0xc4, 0, 0,
 	// sqrshrn Vd.2S, Vn.2D, #imm  ···················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x3c, 0x97,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x57, 0xcf, 0, 0,
 
 	3,
 	// sqrshrn2 Vd.16B, Vn.8H, #imm  ··················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x3c, 0xa2,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x57, 0xda, 0, 0,
 	// sqrshrn2 Vd.8H, Vn.4S, #imm  ··················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x3c, 0xad,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x57, 0xe5, 0, 0,
 	// sqrshrn2 Vd.4S, Vn.2D, #imm  ··················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x3c, 0xb8,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x57, 0xf0, 0, 0,
 
 	6,
 	// sqrshrun Bd, Hn, #imm  ·························································  (0 < imm <= 8)
-	3, MatB, MatH, MatImm, 0x3c, 0xc3,
+	3, MatB, MatH, MatImm, 0x0, 0x57, 0xfb, 0, 0,
 	// sqrshrun Hd, Sn, #imm  ························································  (0 < imm <= 16)
-	3, MatH, MatS, MatImm, 0x3c, 0xd0,
+	3, MatH, MatS, MatImm, 0x0, 0x58, 0x8, 0, 0,
 	// sqrshrun Sd, Dn, #imm  ························································  (0 < imm <= 32)
-	3, MatS, MatD, MatImm, 0x3c, 0xdd,
+	3, MatS, MatD, MatImm, 0x0, 0x58, 0x15, 0, 0,
 	// sqrshrun Vd.8B, Vn.8H, #imm  ···················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x3c, 0xea,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x58, 0x22, 0, 0,
 	// sqrshrun Vd.4H, Vn.4S, #imm  ··················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x3c, 0xf5,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x58, 0x2d, 0, 0,
 	// sqrshrun Vd.2S, Vn.2D, #imm  ··················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x3d, 0x0,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x58, 0x38, 0, 0,
 
 	3,
 	// sqrshrun2 Vd.16B, Vn.8H, #imm  ·················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x3d, 0xb,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x58, 0x43, 0, 0,
 	// sqrshrun2 Vd.8H, Vn.4S, #imm  ·················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x3d, 0x16,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x58, 0x4e, 0, 0,
 	// sqrshrun2 Vd.4S, Vn.2D, #imm  ·················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x3d, 0x21,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x58, 0x59, 0, 0,
 
 	16,
 	// sqshl Bd, Bn, #imm  ····························································  (0 <= imm < 8)
-	3, MatB, MatB, MatImm, 0x3d, 0x2c,
+	3, MatB, MatB, MatImm, 0x0, 0x58, 0x64, 0, 0,
 	// sqshl Hd, Hn, #imm  ···························································  (0 <= imm < 16)
-	3, MatH, MatH, MatImm, 0x3d, 0x36,
+	3, MatH, MatH, MatImm, 0x0, 0x58, 0x6e, 0, 0,
 	// sqshl Sd, Sn, #imm  ···························································  (0 <= imm < 32)
-	3, MatS, MatS, MatImm, 0x3d, 0x40,
+	3, MatS, MatS, MatImm, 0x0, 0x58, 0x78, 0, 0,
 	// sqshl Dd, Dn, #imm  ···························································  (0 <= imm < 64)
-	3, MatD, MatD, MatImm, 0x3d, 0x4a,
+	3, MatD, MatD, MatImm, 0x0, 0x58, 0x82, 0, 0,
 	// sqshl Vd.16B, Vn.16B, #imm  ····················································  (0 <= imm < 8)
 	// sqshl Vd.8B, Vn.8B, #imm  ······················································  (0 <= imm < 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x3d, 0x54,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x58, 0x8c, 0, 0,
 	// sqshl Vd.8H, Vn.8H, #imm  ·····················································  (0 <= imm < 16)
 	// sqshl Vd.4H, Vn.4H, #imm  ·····················································  (0 <= imm < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x3d, 0x5f,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x58, 0x97, 0, 0,
 	// sqshl Vd.4S, Vn.4S, #imm  ·····················································  (0 <= imm < 32)
 	// sqshl Vd.2S, Vn.2S, #imm  ·····················································  (0 <= imm < 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x3d, 0x6a,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x58, 0xa2, 0, 0,
 	// sqshl Vd.2D, Vn.2D, #imm  ·····················································  (0 <= imm < 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x3d, 0x75,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x58, 0xad, 0, 0,
 	// sqshl Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x3d, 0x80,
+	3, MatB, MatB, MatB, 0x0, 0x58, 0xb8, 0, 0,
 	// sqshl Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x3d, 0x88,
+	3, MatH, MatH, MatH, 0x0, 0x58, 0xc0, 0, 0,
 	// sqshl Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x3d, 0x90,
+	3, MatS, MatS, MatS, 0x0, 0x58, 0xc8, 0, 0,
 	// sqshl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x3d, 0x98,
+	3, MatD, MatD, MatD, 0x0, 0x58, 0xd0, 0, 0,
 	// sqshl Vd.16B, Vn.16B, Vm.16B
 	// sqshl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x3d, 0xa0,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x58, 0xd8, 0, 0,
 	// sqshl Vd.8H, Vn.8H, Vm.8H
 	// sqshl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3d, 0xa9,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x58, 0xe1, 0, 0,
 	// sqshl Vd.4S, Vn.4S, Vm.4S
 	// sqshl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3d, 0xb2,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x58, 0xea, 0, 0,
 	// sqshl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x3d, 0xbb,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x58, 0xf3, 0, 0,
 
 	8,
 	// sqshlu Bd, Bn, #imm  ···························································  (0 <= imm < 8)
-	3, MatB, MatB, MatImm, 0x3d, 0xc4,
+	3, MatB, MatB, MatImm, 0x0, 0x58, 0xfc, 0, 0,
 	// sqshlu Hd, Hn, #imm  ··························································  (0 <= imm < 16)
-	3, MatH, MatH, MatImm, 0x3d, 0xce,
+	3, MatH, MatH, MatImm, 0x0, 0x59, 0x6, 0, 0,
 	// sqshlu Sd, Sn, #imm  ··························································  (0 <= imm < 32)
-	3, MatS, MatS, MatImm, 0x3d, 0xd8,
+	3, MatS, MatS, MatImm, 0x0, 0x59, 0x10, 0, 0,
 	// sqshlu Dd, Dn, #imm  ··························································  (0 <= imm < 64)
-	3, MatD, MatD, MatImm, 0x3d, 0xe2,
+	3, MatD, MatD, MatImm, 0x0, 0x59, 0x1a, 0, 0,
 	// sqshlu Vd.16B, Vn.16B, #imm  ···················································  (0 <= imm < 8)
 	// sqshlu Vd.8B, Vn.8B, #imm  ·····················································  (0 <= imm < 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x3d, 0xec,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x59, 0x24, 0, 0,
 	// sqshlu Vd.8H, Vn.8H, #imm  ····················································  (0 <= imm < 16)
 	// sqshlu Vd.4H, Vn.4H, #imm  ····················································  (0 <= imm < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x3d, 0xf7,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x59, 0x2f, 0, 0,
 	// sqshlu Vd.4S, Vn.4S, #imm  ····················································  (0 <= imm < 32)
 	// sqshlu Vd.2S, Vn.2S, #imm  ····················································  (0 <= imm < 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x3e, 0x2,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x59, 0x3a, 0, 0,
 	// sqshlu Vd.2D, Vn.2D, #imm  ····················································  (0 <= imm < 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x3e, 0xd,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x59, 0x45, 0, 0,
 
 	6,
 	// sqshrn Bd, Hn, #imm  ···························································  (0 < imm <= 8)
-	3, MatB, MatH, MatImm, 0x3e, 0x18,
+	3, MatB, MatH, MatImm, 0x0, 0x59, 0x50, 0, 0,
 	// sqshrn Hd, Sn, #imm  ··························································  (0 < imm <= 16)
-	3, MatH, MatS, MatImm, 0x3e, 0x25,
+	3, MatH, MatS, MatImm, 0x0, 0x59, 0x5d, 0, 0,
 	// sqshrn Sd, Dn, #imm  ··························································  (0 < imm <= 32)
-	3, MatS, MatD, MatImm, 0x3e, 0x32,
+	3, MatS, MatD, MatImm, 0x0, 0x59, 0x6a, 0, 0,
 	// sqshrn Vd.8B, Vn.8H, #imm  ·····················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x3e, 0x3f,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x59, 0x77, 0, 0,
 	// sqshrn Vd.4H, Vn.4S, #imm  ····················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x3e, 0x4a,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x59, 0x82, 0, 0,
 	// sqshrn Vd.2S, Vn.2D, #imm  ····················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x3e, 0x55,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x59, 0x8d, 0, 0,
 
 	3,
 	// sqshrn2 Vd.16B, Vn.8H, #imm  ···················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x3e, 0x60,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x59, 0x98, 0, 0,
 	// sqshrn2 Vd.8H, Vn.4S, #imm  ···················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x3e, 0x6b,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x59, 0xa3, 0, 0,
 	// sqshrn2 Vd.4S, Vn.2D, #imm  ···················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x3e, 0x76,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x59, 0xae, 0, 0,
 
 	6,
 	// sqshrun Bd, Hn, #imm  ··························································  (0 < imm <= 8)
-	3, MatB, MatH, MatImm, 0x3e, 0x81,
+	3, MatB, MatH, MatImm, 0x0, 0x59, 0xb9, 0, 0,
 	// sqshrun Hd, Sn, #imm  ·························································  (0 < imm <= 16)
-	3, MatH, MatS, MatImm, 0x3e, 0x8e,
+	3, MatH, MatS, MatImm, 0x0, 0x59, 0xc6, 0, 0,
 	// sqshrun Sd, Dn, #imm  ·························································  (0 < imm <= 32)
-	3, MatS, MatD, MatImm, 0x3e, 0x9b,
+	3, MatS, MatD, MatImm, 0x0, 0x59, 0xd3, 0, 0,
 	// sqshrun Vd.8B, Vn.8H, #imm  ····················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x3e, 0xa8,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x59, 0xe0, 0, 0,
 	// sqshrun Vd.4H, Vn.4S, #imm  ···················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x3e, 0xb3,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x59, 0xeb, 0, 0,
 	// sqshrun Vd.2S, Vn.2D, #imm  ···················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x3e, 0xbe,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x59, 0xf6, 0, 0,
 
 	3,
 	// sqshrun2 Vd.16B, Vn.8H, #imm  ··················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x3e, 0xc9,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x5a, 0x1, 0, 0,
 	// sqshrun2 Vd.8H, Vn.4S, #imm  ··················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x3e, 0xd4,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x5a, 0xc, 0, 0,
 	// sqshrun2 Vd.4S, Vn.2D, #imm  ··················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x3e, 0xdf,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x5a, 0x17, 0, 0,
 
-	8,
+	16,
 	// sqsub Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x3e, 0xea,
+	3, MatB, MatB, MatB, 0x0, 0x5a, 0x22, 0, 0,
 	// sqsub Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x3e, 0xf2,
+	3, MatH, MatH, MatH, 0x0, 0x5a, 0x2a, 0, 0,
 	// sqsub Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x3e, 0xfa,
+	3, MatS, MatS, MatS, 0x0, 0x5a, 0x32, 0, 0,
 	// sqsub Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x3f, 0x2,
+	3, MatD, MatD, MatD, 0x0, 0x5a, 0x3a, 0, 0,
 	// sqsub Vd.16B, Vn.16B, Vm.16B
 	// sqsub Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x3f, 0xa,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x5a, 0x42, 0, 0,
 	// sqsub Vd.8H, Vn.8H, Vm.8H
 	// sqsub Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3f, 0x13,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x5a, 0x4b, 0, 0,
 	// sqsub Vd.4S, Vn.4S, Vm.4S
 	// sqsub Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3f, 0x1c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x5a, 0x54, 0, 0,
 	// sqsub Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x3f, 0x25,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x5a, 0x5d, 0, 0,
+	// sqsub Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x5a, 0x66, byte(FeatSVE), 0,
+	// sqsub Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x5a, 0x6e, byte(FeatSVE), 0,
+	// sqsub Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x5a, 0x76, byte(FeatSVE), 0,
+	// sqsub Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x5a, 0x7e, byte(FeatSVE), 0,
+	// sqsub Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x5a, 0x86, byte(FeatSVE), 0,
+	// sqsub Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x5a, 0x91, byte(FeatSVE), 0,
+	// sqsub Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x5a, 0x9f, byte(FeatSVE), 0,
+	// sqsub Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x5a, 0xad, byte(FeatSVE), 0,
 
 	6,
 	// sqxtn Bd, Hn
-	2, MatB, MatH, 0x3f, 0x2e,
+	2, MatB, MatH, 0x0, 0x5a, 0xbb, 0, 0,
 	// sqxtn Hd, Sn
-	2, MatH, MatS, 0x3f, 0x35,
+	2, MatH, MatS, 0x0, 0x5a, 0xc2, 0, 0,
 	// sqxtn Sd, Dn
-	2, MatS, MatD, 0x3f, 0x3c,
+	2, MatS, MatD, 0x0, 0x5a, 0xc9, 0, 0,
 	// sqxtn Vd.8B, Vn.8H
-	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x3f, 0x43,
+	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x0, 0x5a, 0xd0, 0, 0,
 	// sqxtn Vd.4H, Vn.4S
-	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x3f, 0x4a,
+	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x5a, 0xd7, 0, 0,
 	// sqxtn Vd.2S, Vn.2D
-	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x3f, 0x51,
+	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x5a, 0xde, 0, 0,
 
 	3,
 	// sqxtn2 Vd.16B, Vn.8H
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x3f, 0x58,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x0, 0x5a, 0xe5, 0, 0,
 	// sqxtn2 Vd.8H, Vn.4S
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x3f, 0x5f,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x0, 0x5a, 0xec, 0, 0,
 	// sqxtn2 Vd.4S, Vn.2D
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x3f, 0x66,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x0, 0x5a, 0xf3, 0, 0,
 
 	6,
 	// sqxtun Bd, Hn
-	2, MatB, MatH, 0x3f, 0x6d,
+	2, MatB, MatH, 0x0, 0x5a, 0xfa, 0, 0,
 	// sqxtun Hd, Sn
-	2, MatH, MatS, 0x3f, 0x74,
+	2, MatH, MatS, 0x0, 0x5b, 0x1, 0, 0,
 	// sqxtun Sd, Dn
-	2, MatS, MatD, 0x3f, 0x7b,
+	2, MatS, MatD, 0x0, 0x5b, 0x8, 0, 0,
 	// sqxtun Vd.8B, Vn.8H
-	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x3f, 0x82,
+	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x0, 0x5b, 0xf, 0, 0,
 	// sqxtun Vd.4H, Vn.4S
-	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x3f, 0x89,
+	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x5b, 0x16, 0, 0,
 	// sqxtun Vd.2S, Vn.2D
-	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x3f, 0x90,
+	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x5b, 0x1d, 0, 0,
 
 	3,
 	// sqxtun2 Vd.16B, Vn.8H
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x3f, 0x97,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x0, 0x5b, 0x24, 0, 0,
 	// sqxtun2 Vd.8H, Vn.4S
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x3f, 0x9e,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x0, 0x5b, 0x2b, 0, 0,
 	// sqxtun2 Vd.4S, Vn.2D
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x3f, 0xa5,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x0, 0x5b, 0x32, 0, 0,
 
 	3,
 	// srhadd Vd.16B, Vn.16B, Vm.16B
 	// srhadd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x3f, 0xac,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x5b, 0x39, 0, 0,
 	// srhadd Vd.8H, Vn.8H, Vm.8H
 	// srhadd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x3f, 0xb5,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x5b, 0x42, 0, 0,
 	// srhadd Vd.4S, Vn.4S, Vm.4S
 	// srhadd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x3f, 0xbe,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x5b, 0x4b, 0, 0,
 
 	5,
 	// sri Dd, Dn, #imm  ·····························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x3f, 0xc7,
+	3, MatD, MatD, MatImm, 0x0, 0x5b, 0x54, 0, 0,
 	// sri Vd.16B, Vn.16B, #imm  ······················································  (0 < imm <= 8)
 	// sri Vd.8B, Vn.8B, #imm  ························································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x3f, 0xd4,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x5b, 0x61, 0, 0,
 	// sri Vd.8H, Vn.8H, #imm  ·······················································  (0 < imm <= 16)
 	// sri Vd.4H, Vn.4H, #imm  ·······················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x3f, 0xe0,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x5b, 0x6d, 0, 0,
 	// sri Vd.4S, Vn.4S, #imm  ·······················································  (0 < imm <= 32)
 	// sri Vd.2S, Vn.2S, #imm  ·······················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x3f, 0xec,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x5b, 0x79, 0, 0,
 	// sri Vd.2D, Vn.2D, #imm  ·······················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x3f, 0xf8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x5b, 0x85, 0, 0,
 
 	5,
 	// srshl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x40, 0x4,
+	3, MatD, MatD, MatD, 0x0, 0x5b, 0x91, 0, 0,
 	// srshl Vd.16B, Vn.16B, Vm.16B
 	// srshl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x40, 0xc,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x5b, 0x99, 0, 0,
 	// srshl Vd.8H, Vn.8H, Vm.8H
 	// srshl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x40, 0x15,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x5b, 0xa2, 0, 0,
 	// srshl Vd.4S, Vn.4S, Vm.4S
 	// srshl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x40, 0x1e,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x5b, 0xab, 0, 0,
 	// srshl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x40, 0x27,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x5b, 0xb4, 0, 0,
 
 	5,
 	// srshr Dd, Dn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x40, 0x30,
+	3, MatD, MatD, MatImm, 0x0, 0x5b, 0xbd, 0, 0,
 	// srshr Vd.16B, Vn.16B, #imm  ····················································  (0 < imm <= 8)
 	// srshr Vd.8B, Vn.8B, #imm  ······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x40, 0x3d,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x5b, 0xca, 0, 0,
 	// srshr Vd.8H, Vn.8H, #imm  ·····················································  (0 < imm <= 16)
 	// srshr Vd.4H, Vn.4H, #imm  ·····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x40, 0x49,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x5b, 0xd6, 0, 0,
 	// srshr Vd.4S, Vn.4S, #imm  ·····················································  (0 < imm <= 32)
 	// srshr Vd.2S, Vn.2S, #imm  ·····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x40, 0x55,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x5b, 0xe2, 0, 0,
 	// srshr Vd.2D, Vn.2D, #imm  ·····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x40, 0x61,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x5b, 0xee, 0, 0,
 
 	5,
 	// srsra Dd, Dn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x40, 0x6d,
+	3, MatD, MatD, MatImm, 0x0, 0x5b, 0xfa, 0, 0,
 	// srsra Vd.16B, Vn.16B, #imm  ····················································  (0 < imm <= 8)
 	// srsra Vd.8B, Vn.8B, #imm  ······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x40, 0x7a,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x5c, 0x7, 0, 0,
 	// srsra Vd.8H, Vn.8H, #imm  ·····················································  (0 < imm <= 16)
 	// srsra Vd.4H, Vn.4H, #imm  ·····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x40, 0x86,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x5c, 0x13, 0, 0,
 	// srsra Vd.4S, Vn.4S, #imm  ·····················································  (0 < imm <= 32)
 	// srsra Vd.2S, Vn.2S, #imm  ·····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x40, 0x92,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x5c, 0x1f, 0, 0,
 	// srsra Vd.2D, Vn.2D, #imm  ·····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x40, 0x9e,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x5c, 0x2b, 0, 0,
 
 	1,
 	// ssbb
-	0, 0x40, 0xaa,
+	0, 0x0, 0x5c, 0x37, 0, 0,
 
 	5,
 	// sshl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x40, 0xaf,
+	3, MatD, MatD, MatD, 0x0, 0x5c, 0x3c, 0, 0,
 	// sshl Vd.16B, Vn.16B, Vm.16B
 	// sshl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x40, 0xb7,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x5c, 0x44, 0, 0,
 	// sshl Vd.8H, Vn.8H, Vm.8H
 	// sshl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x40, 0xc0,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x5c, 0x4d, 0, 0,
 	// sshl Vd.4S, Vn.4S, Vm.4S
 	// sshl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x40, 0xc9,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x5c, 0x56, 0, 0,
 	// sshl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x40, 0xd2,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x5c, 0x5f, 0, 0,
 
 	3,
 	// sshll Vd.8H, Vn.8B, #imm  ······················································  (0 <= imm < 8)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatImm, 0x40, 0xdb,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatImm, 0x0, 0x5c, 0x68, 0, 0,
 	// sshll Vd.4S, Vn.4H, #imm  ·····················································  (0 <= imm < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatImm, 0x40, 0xe5,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatImm, 0x0, 0x5c, 0x72, 0, 0,
 	// sshll Vd.2D, Vn.2S, #imm  ·····················································  (0 <= imm < 32)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatImm, 0x40, 0xef,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatImm, 0x0, 0x5c, 0x7c, 0, 0,
 
 	3,
 	// sshll2 Vd.8H, Vn.16B, #imm  ····················································  (0 <= imm < 8)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatImm, 0x40, 0xf9,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatImm, 0x0, 0x5c, 0x86, 0, 0,
 	// sshll2 Vd.4S, Vn.8H, #imm  ····················································  (0 <= imm < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatImm, 0x41, 0x3,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x5c, 0x90, 0, 0,
 	// sshll2 Vd.2D, Vn.4S, #imm  ····················································  (0 <= imm < 32)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatImm, 0x41, 0xd,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x5c, 0x9a, 0, 0,
 
 	5,
 	// sshr Dd, Dn, #imm  ····························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x41, 0x17,
+	3, MatD, MatD, MatImm, 0x0, 0x5c, 0xa4, 0, 0,
 	// sshr Vd.16B, Vn.16B, #imm  ·····················································  (0 < imm <= 8)
 	// sshr Vd.8B, Vn.8B, #imm  ·······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x41, 0x24,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x5c, 0xb1, 0, 0,
 	// sshr Vd.8H, Vn.8H, #imm  ······················································  (0 < imm <= 16)
 	// sshr Vd.4H, Vn.4H, #imm  ······················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x41, 0x30,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x5c, 0xbd, 0, 0,
 	// sshr Vd.4S, Vn.4S, #imm  ······················································  (0 < imm <= 32)
 	// sshr Vd.2S, Vn.2S, #imm  ······················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x41, 0x3c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x5c, 0xc9, 0, 0,
 	// sshr Vd.2D, Vn.2D, #imm  ······················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x41, 0x48,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x5c, 0xd5, 0, 0,
 
 	5,
 	// ssra Dd, Dn, #imm  ····························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x41, 0x54,
+	3, MatD, MatD, MatImm, 0x0, 0x5c, 0xe1, 0, 0,
 	// ssra Vd.16B, Vn.16B, #imm  ·····················································  (0 < imm <= 8)
 	// ssra Vd.8B, Vn.8B, #imm  ·······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x41, 0x61,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x5c, 0xee, 0, 0,
 	// ssra Vd.8H, Vn.8H, #imm  ······················································  (0 < imm <= 16)
 	// ssra Vd.4H, Vn.4H, #imm  ······················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x41, 0x6d,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x5c, 0xfa, 0, 0,
 	// ssra Vd.4S, Vn.4S, #imm  ······················································  (0 < imm <= 32)
 	// ssra Vd.2S, Vn.2S, #imm  ······················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x41, 0x79,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x5d, 0x6, 0, 0,
 	// ssra Vd.2D, Vn.2D, #imm  ······················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x41, 0x85,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x5d, 0x12, 0, 0,
 
 	3,
 	// ssubl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x41, 0x91,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x5d, 0x1e, 0, 0,
 	// ssubl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x41, 0x99,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x5d, 0x26, 0, 0,
 	// ssubl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x41, 0xa1,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x5d, 0x2e, 0, 0,
 
 	3,
 	// ssubl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x41, 0xa9,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x5d, 0x36, 0, 0,
 	// ssubl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x41, 0xb1,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x5d, 0x3e, 0, 0,
 	// ssubl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x41, 0xb9,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x5d, 0x46, 0, 0,
 
 	3,
 	// ssubw Vd.8H, Vn.8H, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x41, 0xc1,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x5d, 0x4e, 0, 0,
 	// ssubw Vd.4S, Vn.4S, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x41, 0xc9,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x5d, 0x56, 0, 0,
 	// ssubw Vd.2D, Vn.2D, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x41, 0xd1,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x5d, 0x5e, 0, 0,
 
 	3,
 	// ssubw2 Vd.8H, Vn.8H, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x41, 0xd9,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x0, 0x5d, 0x66, 0, 0,
 	// ssubw2 Vd.4S, Vn.4S, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x41, 0xe1,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x0, 0x5d, 0x6e, 0, 0,
 	// ssubw2 Vd.2D, Vn.2D, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x41, 0xe9,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x0, 0x5d, 0x76, 0, 0,
 
 	76,
 	// st1 {Vd.16B * 1}, [Xn|SP]
 	// st1 {Vd.8B * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(BYTE), MatRefBase, 0x41, 0xf1,
+	2, MatRegList, 1, byte(BYTE), MatRefBase, 0x0, 0x5d, 0x7e, 0, 0,
 	// st1 {Vd.8H * 1}, [Xn|SP]
 	// st1 {Vd.4H * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(WORD), MatRefBase, 0x41, 0xf9,
+	2, MatRegList, 1, byte(WORD), MatRefBase, 0x0, 0x5d, 0x86, 0, 0,
 	// st1 {Vd.4S * 1}, [Xn|SP]
 	// st1 {Vd.2S * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(DWORD), MatRefBase, 0x42, 0x1,
+	2, MatRegList, 1, byte(DWORD), MatRefBase, 0x0, 0x5d, 0x8e, 0, 0,
 	// st1 {Vd.2D * 1}, [Xn|SP]
 	// st1 {Vd.1D * 1}, [Xn|SP]
-	2, MatRegList, 1, byte(QWORD), MatRefBase, 0x42, 0x9,
+	2, MatRegList, 1, byte(QWORD), MatRefBase, 0x0, 0x5d, 0x96, 0, 0,
 	// st1 {Vd.16B * 2}, [Xn|SP]
 	// st1 {Vd.8B * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x42, 0x11,
+	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x0, 0x5d, 0x9e, 0, 0,
 	// st1 {Vd.8H * 2}, [Xn|SP]
 	// st1 {Vd.4H * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(WORD), MatRefBase, 0x42, 0x19,
+	2, MatRegList, 2, byte(WORD), MatRefBase, 0x0, 0x5d, 0xa6, 0, 0,
 	// st1 {Vd.4S * 2}, [Xn|SP]
 	// st1 {Vd.2S * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x42, 0x21,
+	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x0, 0x5d, 0xae, 0, 0,
 	// st1 {Vd.2D * 2}, [Xn|SP]
 	// st1 {Vd.1D * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(QWORD), MatRefBase, 0x42, 0x29,
+	2, MatRegList, 2, byte(QWORD), MatRefBase, 0x0, 0x5d, 0xb6, 0, 0,
 	// st1 {Vd.16B * 3}, [Xn|SP]
 	// st1 {Vd.8B * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x42, 0x31,
+	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x0, 0x5d, 0xbe, 0, 0,
 	// st1 {Vd.8H * 3}, [Xn|SP]
 	// st1 {Vd.4H * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(WORD), MatRefBase, 0x42, 0x39,
+	2, MatRegList, 3, byte(WORD), MatRefBase, 0x0, 0x5d, 0xc6, 0, 0,
 	// st1 {Vd.4S * 3}, [Xn|SP]
 	// st1 {Vd.2S * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x42, 0x41,
+	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x0, 0x5d, 0xce, 0, 0,
 	// st1 {Vd.2D * 3}, [Xn|SP]
 	// st1 {Vd.1D * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(QWORD), MatRefBase, 0x42, 0x49,
+	2, MatRegList, 3, byte(QWORD), MatRefBase, 0x0, 0x5d, 0xd6, 0, 0,
 	// st1 {Vd.16B * 4}, [Xn|SP]
 	// st1 {Vd.8B * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x42, 0x51,
+	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x0, 0x5d, 0xde, 0, 0,
 	// st1 {Vd.8H * 4}, [Xn|SP]
 	// st1 {Vd.4H * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(WORD), MatRefBase, 0x42, 0x59,
+	2, MatRegList, 4, byte(WORD), MatRefBase, 0x0, 0x5d, 0xe6, 0, 0,
 	// st1 {Vd.4S * 4}, [Xn|SP]
 	// st1 {Vd.2S * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x42, 0x61,
+	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x0, 0x5d, 0xee, 0, 0,
 	// st1 {Vd.2D * 4}, [Xn|SP]
 	// st1 {Vd.1D * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(QWORD), MatRefBase, 0x42, 0x69,
+	2, MatRegList, 4, byte(QWORD), MatRefBase, 0x0, 0x5d, 0xf6, 0, 0,
 	// st1 {Vd.8B * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(BYTE), 8, MatRefBase, MatLitInt, 8, 0x42, 0x71,
+	3, MatRegListStatic, 1, byte(BYTE), 8, MatRefBase, MatLitInt, 8, 0x0, 0x5d, 0xfe, 0, 0,
 	// st1 {Vd.4H * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(WORD), 4, MatRefBase, MatLitInt, 8, 0x42, 0x78,
+	3, MatRegListStatic, 1, byte(WORD), 4, MatRefBase, MatLitInt, 8, 0x0, 0x5e, 0x5, 0, 0,
 	// st1 {Vd.2S * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(DWORD), 2, MatRefBase, MatLitInt, 8, 0x42, 0x7f,
+	3, MatRegListStatic, 1, byte(DWORD), 2, MatRefBase, MatLitInt, 8, 0x0, 0x5e, 0xc, 0, 0,
 	// st1 {Vd.1D * 1}, [Xn|SP], #8
-	3, MatRegListStatic, 1, byte(QWORD), 1, MatRefBase, MatLitInt, 8, 0x42, 0x86,
+	3, MatRegListStatic, 1, byte(QWORD), 1, MatRefBase, MatLitInt, 8, 0x0, 0x5e, 0x13, 0, 0,
 	// st1 {Vd.16B * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(BYTE), 16, MatRefBase, MatLitInt, 16, 0x42, 0x8d,
+	3, MatRegListStatic, 1, byte(BYTE), 16, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x1a, 0, 0,
 	// st1 {Vd.8H * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(WORD), 8, MatRefBase, MatLitInt, 16, 0x42, 0x94,
+	3, MatRegListStatic, 1, byte(WORD), 8, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x21, 0, 0,
 	// st1 {Vd.4S * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(DWORD), 4, MatRefBase, MatLitInt, 16, 0x42, 0x9b,
+	3, MatRegListStatic, 1, byte(DWORD), 4, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x28, 0, 0,
 	// st1 {Vd.2D * 1}, [Xn|SP], #16
-	3, MatRegListStatic, 1, byte(QWORD), 2, MatRefBase, MatLitInt, 16, 0x42, 0xa2,
+	3, MatRegListStatic, 1, byte(QWORD), 2, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x2f, 0, 0,
 	// st1 {Vd.16B * 1}, [Xn|SP], Xm  ······················································  (m != 31)
 	// st1 {Vd.8B * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(BYTE), MatRefBase, MatX, 0x42, 0xa9,
+	3, MatRegList, 1, byte(BYTE), MatRefBase, MatX, 0x0, 0x5e, 0x36, 0, 0,
 	// st1 {Vd.8H * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.4H * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(WORD), MatRefBase, MatX, 0x42, 0xb2,
+	3, MatRegList, 1, byte(WORD), MatRefBase, MatX, 0x0, 0x5e, 0x3f, 0, 0,
 	// st1 {Vd.4S * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.2S * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(DWORD), MatRefBase, MatX, 0x42, 0xbb,
+	3, MatRegList, 1, byte(DWORD), MatRefBase, MatX, 0x0, 0x5e, 0x48, 0, 0,
 	// st1 {Vd.2D * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.1D * 1}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 1, byte(QWORD), MatRefBase, MatX, 0x42, 0xc4,
+	3, MatRegList, 1, byte(QWORD), MatRefBase, MatX, 0x0, 0x5e, 0x51, 0, 0,
 	// st1 {Vd.8B * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x42, 0xcd,
+	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x5a, 0, 0,
 	// st1 {Vd.4H * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x42, 0xd4,
+	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x61, 0, 0,
 	// st1 {Vd.2S * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x42, 0xdb,
+	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x68, 0, 0,
 	// st1 {Vd.1D * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(QWORD), 1, MatRefBase, MatLitInt, 16, 0x42, 0xe2,
+	3, MatRegListStatic, 2, byte(QWORD), 1, MatRefBase, MatLitInt, 16, 0x0, 0x5e, 0x6f, 0, 0,
 	// st1 {Vd.16B * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x42, 0xe9,
+	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x0, 0x5e, 0x76, 0, 0,
 	// st1 {Vd.8H * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x42, 0xf0,
+	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x0, 0x5e, 0x7d, 0, 0,
 	// st1 {Vd.4S * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x42, 0xf7,
+	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x5e, 0x84, 0, 0,
 	// st1 {Vd.2D * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x42, 0xfe,
+	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x5e, 0x8b, 0, 0,
 	// st1 {Vd.16B * 2}, [Xn|SP], Xm  ······················································  (m != 31)
 	// st1 {Vd.8B * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x43, 0x5,
+	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x0, 0x5e, 0x92, 0, 0,
 	// st1 {Vd.8H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.4H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x43, 0xe,
+	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x0, 0x5e, 0x9b, 0, 0,
 	// st1 {Vd.4S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.2S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x43, 0x17,
+	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x0, 0x5e, 0xa4, 0, 0,
 	// st1 {Vd.2D * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.1D * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(QWORD), MatRefBase, MatX, 0x43, 0x20,
+	3, MatRegList, 2, byte(QWORD), MatRefBase, MatX, 0x0, 0x5e, 0xad, 0, 0,
 	// st1 {Vd.8B * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x43, 0x29,
+	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x0, 0x5e, 0xb6, 0, 0,
 	// st1 {Vd.4H * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x43, 0x30,
+	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x0, 0x5e, 0xbd, 0, 0,
 	// st1 {Vd.2S * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x43, 0x37,
+	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x0, 0x5e, 0xc4, 0, 0,
 	// st1 {Vd.1D * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(QWORD), 1, MatRefBase, MatLitInt, 24, 0x43, 0x3e,
+	3, MatRegListStatic, 3, byte(QWORD), 1, MatRefBase, MatLitInt, 24, 0x0, 0x5e, 0xcb, 0, 0,
 	// st1 {Vd.16B * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x43, 0x45,
+	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x0, 0x5e, 0xd2, 0, 0,
 	// st1 {Vd.8H * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x43, 0x4c,
+	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x0, 0x5e, 0xd9, 0, 0,
 	// st1 {Vd.4S * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x43, 0x53,
+	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x0, 0x5e, 0xe0, 0, 0,
 	// st1 {Vd.2D * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x43, 0x5a,
+	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x0, 0x5e, 0xe7, 0, 0,
 	// st1 {Vd.16B * 3}, [Xn|SP], Xm  ······················································  (m != 31)
 	// st1 {Vd.8B * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x43, 0x61,
+	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x0, 0x5e, 0xee, 0, 0,
 	// st1 {Vd.8H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.4H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x43, 0x6a,
+	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x0, 0x5e, 0xf7, 0, 0,
 	// st1 {Vd.4S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.2S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x43, 0x73,
+	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x0, 0x5f, 0x0, 0, 0,
 	// st1 {Vd.2D * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.1D * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(QWORD), MatRefBase, MatX, 0x43, 0x7c,
+	3, MatRegList, 3, byte(QWORD), MatRefBase, MatX, 0x0, 0x5f, 0x9, 0, 0,
 	// st1 {Vd.8B * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x43, 0x85,
+	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x0, 0x5f, 0x12, 0, 0,
 	// st1 {Vd.4H * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x43, 0x8c,
+	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x5f, 0x19, 0, 0,
 	// st1 {Vd.2S * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x43, 0x93,
+	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x5f, 0x20, 0, 0,
 	// st1 {Vd.1D * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(QWORD), 1, MatRefBase, MatLitInt, 32, 0x43, 0x9a,
+	3, MatRegListStatic, 4, byte(QWORD), 1, MatRefBase, MatLitInt, 32, 0x0, 0x5f, 0x27, 0, 0,
 	// st1 {Vd.16B * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x43, 0xa1,
+	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x0, 0x5f, 0x2e, 0, 0,
 	// st1 {Vd.8H * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x43, 0xa8,
+	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x0, 0x5f, 0x35, 0, 0,
 	// st1 {Vd.4S * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x43, 0xaf,
+	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x0, 0x5f, 0x3c, 0, 0,
 	// st1 {Vd.2D * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x43, 0xb6,
+	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x0, 0x5f, 0x43, 0, 0,
 	// st1 {Vd.16B * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	// st1 {Vd.8B * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x43, 0xbd,
+	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x0, 0x5f, 0x4a, 0, 0,
 	// st1 {Vd.8H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.4H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x43, 0xc6,
+	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x0, 0x5f, 0x53, 0, 0,
 	// st1 {Vd.4S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.2S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x43, 0xcf,
+	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x0, 0x5f, 0x5c, 0, 0,
 	// st1 {Vd.2D * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st1 {Vd.1D * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(QWORD), MatRefBase, MatX, 0x43, 0xd8,
+	3, MatRegList, 4, byte(QWORD), MatRefBase, MatX, 0x0, 0x5f, 0x65, 0, 0,
 	// st1 {Vd.B * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(BYTE), MatRefBase, 0x43, 0xe1,
+	2, MatRegListElement, 1, byte(BYTE), MatRefBase, 0x0, 0x5f, 0x6e, 0, 0,
 	// st1 {Vd.H * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(WORD), MatRefBase, 0x43, 0xea,
+	2, MatRegListElement, 1, byte(WORD), MatRefBase, 0x0, 0x5f, 0x77, 0, 0,
 	// st1 {Vd.S * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(DWORD), MatRefBase, 0x43, 0xf3,
+	2, MatRegListElement, 1, byte(DWORD), MatRefBase, 0x0, 0x5f, 0x80, 0, 0,
 	// st1 {Vd.D * 1}[i], [Xn|SP]
-	2, MatRegListElement, 1, byte(QWORD), MatRefBase, 0x43, 0xfc,
+	2, MatRegListElement, 1, byte(QWORD), MatRefBase, 0x0, 0x5f, 0x89, 0, 0,
 	// st1 {Vd.B * 1}[i], [Xn|SP], #1
-	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatLitInt, 1, 0x44, 0x5,
+	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatLitInt, 1, 0x0, 0x5f, 0x92, 0, 0,
 	// st1 {Vd.B * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatX, 0x44, 0xe,
+	3, MatRegListElement, 1, byte(BYTE), MatRefBase, MatX, 0x0, 0x5f, 0x9b, 0, 0,
 	// st1 {Vd.H * 1}[i], [Xn|SP], #2
-	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatLitInt, 2, 0x44, 0x18,
+	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatLitInt, 2, 0x0, 0x5f, 0xa5, 0, 0,
 	// st1 {Vd.H * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatX, 0x44, 0x21,
+	3, MatRegListElement, 1, byte(WORD), MatRefBase, MatX, 0x0, 0x5f, 0xae, 0, 0,
 	// st1 {Vd.S * 1}[i], [Xn|SP], #4
-	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatLitInt, 4, 0x44, 0x2b,
+	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatLitInt, 4, 0x0, 0x5f, 0xb8, 0, 0,
 	// st1 {Vd.S * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatX, 0x44, 0x34,
+	3, MatRegListElement, 1, byte(DWORD), MatRefBase, MatX, 0x0, 0x5f, 0xc1, 0, 0,
 	// st1 {Vd.D * 1}[i], [Xn|SP], #8
-	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatLitInt, 8, 0x44, 0x3e,
+	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatLitInt, 8, 0x0, 0x5f, 0xcb, 0, 0,
 	// st1 {Vd.D * 1}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatX, 0x44, 0x47,
+	3, MatRegListElement, 1, byte(QWORD), MatRefBase, MatX, 0x0, 0x5f, 0xd4, 0, 0,
+
+	14,
+	// st1b ZAdH|V.B[W12-W15, #imm], Pg, [Xn|SP, Xm]  ·························  (0 <= imm < 16, g < 8)
+	3, MatZASlice, byte(BYTE), MatP, 0, MatRefIndexLSL, 0, 0x0, 0x5f, 0xde, byte(FeatSME), 0,
+	// st1b ZAdH|V.B[W12-W15, #imm], Pg, [Xn|SP]  ·····························  (0 <= imm < 16, g < 8)
+	3, MatZASlice, byte(BYTE), MatP, 0, MatRefBase, 0x0, 0x5f, 0xf0, byte(FeatSME), 0,
+	// st1b {Zd.B * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(BYTE), MatP, 0, MatRefVL, 0x0, 0x60, 0x1, byte(FeatSVE), 0,
+	// st1b {Zd.H * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(WORD), MatP, 0, MatRefVL, 0x0, 0x60, 0xd, byte(FeatSVE), 0,
+	// st1b {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefVL, 0x0, 0x60, 0x19, byte(FeatSVE), 0,
+	// st1b {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefVL, 0x0, 0x60, 0x25, byte(FeatSVE), 0,
+	// st1b {Zd.B * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	3, MatZList, 1, byte(BYTE), MatP, 0, MatRefIndexLSL, 0, 0x0, 0x60, 0x31, byte(FeatSVE), 0,
+	// st1b {Zd.H * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	3, MatZList, 1, byte(WORD), MatP, 0, MatRefIndexLSL, 0, 0x0, 0x60, 0x3d, byte(FeatSVE), 0,
+	// st1b {Zd.S * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexLSL, 0, 0x0, 0x60, 0x49, byte(FeatSVE), 0,
+	// st1b {Zd.D * 1}, Pg, [Xn|SP, Xm]  ············································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexLSL, 0, 0x0, 0x60, 0x55, byte(FeatSVE), 0,
+	// st1b {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x60, 0x61, byte(FeatSVE), 0,
+	// st1b {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW]  ········································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexZ, byte(DWORD), 0, 0x0, 0x60, 0x6d, byte(FeatSVE), 0,
+	// st1b {Zd.S * 1}, Pg, [Zn.S {, #imm }]  ·······················  (g < 8, 0 <= imm < 32, imm >> 0)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefZ, byte(DWORD), 0x0, 0x60, 0x7a, byte(FeatSVE), 0,
+	// st1b {Zd.D * 1}, Pg, [Zn.D {, #imm }]  ·······················  (g < 8, 0 <= imm < 32, imm >> 0)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefZ, byte(QWORD), 0x0, 0x60, 0x87, byte(FeatSVE), 0,
+
+	6,
+	// st1d ZAdH|V.D[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #3]  ···········  (d < 8, 0 <= imm < 2, g < 8)
+	3, MatZASlice, byte(QWORD), MatP, 0, MatRefIndexLSL, 3, 0x0, 0x60, 0x94, byte(FeatSME), 0,
+	// st1d ZAdH|V.D[W12-W15, #imm], Pg, [Xn|SP]  ·······················  (d < 8, 0 <= imm < 2, g < 8)
+	3, MatZASlice, byte(QWORD), MatP, 0, MatRefBase, 0x0, 0x60, 0xa8, byte(FeatSME), 0,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefVL, 0x0, 0x60, 0xbb, byte(FeatSVE), 0,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #3]  ····································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexLSL, 3, 0x0, 0x60, 0xc7, byte(FeatSVE), 0,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x60, 0xd3, byte(FeatSVE), 0,
+	// st1d {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #3]  ···········································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexZ, byte(QWORD), 3, 0x0, 0x60, 0xdf, byte(FeatSVE), 0,
+
+	14,
+	// st1h ZAdH|V.H[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #1]  ···········  (d < 2, 0 <= imm < 8, g < 8)
+	3, MatZASlice, byte(WORD), MatP, 0, MatRefIndexLSL, 1, 0x0, 0x60, 0xeb, byte(FeatSME), 0,
+	// st1h ZAdH|V.H[W12-W15, #imm], Pg, [Xn|SP]  ·······················  (d < 2, 0 <= imm < 8, g < 8)
+	3, MatZASlice, byte(WORD), MatP, 0, MatRefBase, 0x0, 0x60, 0xff, byte(FeatSME), 0,
+	// st1h {Zd.H * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(WORD), MatP, 0, MatRefVL, 0x0, 0x61, 0x12, byte(FeatSVE), 0,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefVL, 0x0, 0x61, 0x1e, byte(FeatSVE), 0,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefVL, 0x0, 0x61, 0x2a, byte(FeatSVE), 0,
+	// st1h {Zd.H * 1}, Pg, [Xn|SP, Xm, LSL #1]  ····································  (g < 8, m != 31)
+	3, MatZList, 1, byte(WORD), MatP, 0, MatRefIndexLSL, 1, 0x0, 0x61, 0x36, byte(FeatSVE), 0,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP, Xm, LSL #1]  ····································  (g < 8, m != 31)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexLSL, 1, 0x0, 0x61, 0x42, byte(FeatSVE), 0,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #1]  ····································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexLSL, 1, 0x0, 0x61, 0x4e, byte(FeatSVE), 0,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x61, 0x5a, byte(FeatSVE), 0,
+	// st1h {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #1]  ···········································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexZ, byte(QWORD), 1, 0x0, 0x61, 0x66, byte(FeatSVE), 0,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW]  ········································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexZ, byte(DWORD), 0, 0x0, 0x61, 0x72, byte(FeatSVE), 0,
+	// st1h {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW #1]  ·····································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexZ, byte(DWORD), 1, 0x0, 0x61, 0x7f, byte(FeatSVE), 0,
+	// st1h {Zd.S * 1}, Pg, [Zn.S {, #imm }]  ·······················  (g < 8, 0 <= imm < 64, imm >> 1)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefZ, byte(DWORD), 0x0, 0x61, 0x8c, byte(FeatSVE), 0,
+	// st1h {Zd.D * 1}, Pg, [Zn.D {, #imm }]  ·······················  (g < 8, 0 <= imm < 64, imm >> 1)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefZ, byte(QWORD), 0x0, 0x61, 0x99, byte(FeatSVE), 0,
+
+	2,
+	// st1q ZAdH|V.Q[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #4]  ··············  (d < 16, imm == 0, g < 8)
+	3, MatZASlice, byte(OWORD), MatP, 0, MatRefIndexLSL, 4, 0x0, 0x61, 0xa6, byte(FeatSME), 0,
+	// st1q ZAdH|V.Q[W12-W15, #imm], Pg, [Xn|SP]  ··························  (d < 16, imm == 0, g < 8)
+	3, MatZASlice, byte(OWORD), MatP, 0, MatRefBase, 0x0, 0x61, 0xbb, byte(FeatSME), 0,
+
+	12,
+	// st1w ZAdH|V.S[W12-W15, #imm], Pg, [Xn|SP, Xm, LSL #2]  ···········  (d < 4, 0 <= imm < 4, g < 8)
+	3, MatZASlice, byte(DWORD), MatP, 0, MatRefIndexLSL, 2, 0x0, 0x61, 0xcf, byte(FeatSME), 0,
+	// st1w ZAdH|V.S[W12-W15, #imm], Pg, [Xn|SP]  ·······················  (d < 4, 0 <= imm < 4, g < 8)
+	3, MatZASlice, byte(DWORD), MatP, 0, MatRefBase, 0x0, 0x61, 0xe3, byte(FeatSME), 0,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefVL, 0x0, 0x61, 0xf6, byte(FeatSVE), 0,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP {, #imm, MUL VL }]  ························  (g < 8, -8 <= imm < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefVL, 0x0, 0x62, 0x2, byte(FeatSVE), 0,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP, Xm, LSL #2]  ····································  (g < 8, m != 31)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexLSL, 2, 0x0, 0x62, 0xe, byte(FeatSVE), 0,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP, Xm, LSL #2]  ····································  (g < 8, m != 31)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexLSL, 2, 0x0, 0x62, 0x1a, byte(FeatSVE), 0,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP, Zm.D]  ···················································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexZ, byte(QWORD), 0, 0x0, 0x62, 0x26, byte(FeatSVE), 0,
+	// st1w {Zd.D * 1}, Pg, [Xn|SP, Zm.D, LSL #2]  ···········································  (g < 8)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefIndexZ, byte(QWORD), 2, 0x0, 0x62, 0x32, byte(FeatSVE), 0,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW]  ········································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexZ, byte(DWORD), 0, 0x0, 0x62, 0x3e, byte(FeatSVE), 0,
+	// st1w {Zd.S * 1}, Pg, [Xn|SP, Zm.S, UXTW|SXTW #2]  ·····································  (g < 8)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefIndexZ, byte(DWORD), 2, 0x0, 0x62, 0x4b, byte(FeatSVE), 0,
+	// st1w {Zd.S * 1}, Pg, [Zn.S {, #imm }]  ······················  (g < 8, 0 <= imm < 128, imm >> 2)
+	3, MatZList, 1, byte(DWORD), MatP, 0, MatRefZ, byte(DWORD), 0x0, 0x62, 0x58, byte(FeatSVE), 0,
+	// st1w {Zd.D * 1}, Pg, [Zn.D {, #imm }]  ······················  (g < 8, 0 <= imm < 128, imm >> 2)
+	3, MatZList, 1, byte(QWORD), MatP, 0, MatRefZ, byte(QWORD), 0x0, 0x62, 0x65, byte(FeatSVE), 0,
 
 	27,
 	// st2 {Vd.16B * 2}, [Xn|SP]
 	// st2 {Vd.8B * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x44, 0x51,
+	2, MatRegList, 2, byte(BYTE), MatRefBase, 0x0, 0x62, 0x72, 0, 0,
 	// st2 {Vd.8H * 2}, [Xn|SP]
 	// st2 {Vd.4H * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(WORD), MatRefBase, 0x44, 0x59,
+	2, MatRegList, 2, byte(WORD), MatRefBase, 0x0, 0x62, 0x7a, 0, 0,
 	// st2 {Vd.4S * 2}, [Xn|SP]
 	// st2 {Vd.2S * 2}, [Xn|SP]
-	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x44, 0x61,
+	2, MatRegList, 2, byte(DWORD), MatRefBase, 0x0, 0x62, 0x82, 0, 0,
 	// st2 {Vd.2D * 2}, [Xn|SP]
-	2, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, 0x44, 0x69,
+	2, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, 0x0, 0x62, 0x8a, 0, 0,
 	// st2 {Vd.8B * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x44, 0x71,
+	3, MatRegListStatic, 2, byte(BYTE), 8, MatRefBase, MatLitInt, 16, 0x0, 0x62, 0x92, 0, 0,
 	// st2 {Vd.4H * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x44, 0x78,
+	3, MatRegListStatic, 2, byte(WORD), 4, MatRefBase, MatLitInt, 16, 0x0, 0x62, 0x99, 0, 0,
 	// st2 {Vd.2S * 2}, [Xn|SP], #16
-	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x44, 0x7f,
+	3, MatRegListStatic, 2, byte(DWORD), 2, MatRefBase, MatLitInt, 16, 0x0, 0x62, 0xa0, 0, 0,
 	// st2 {Vd.16B * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x44, 0x86,
+	3, MatRegListStatic, 2, byte(BYTE), 16, MatRefBase, MatLitInt, 32, 0x0, 0x62, 0xa7, 0, 0,
 	// st2 {Vd.8H * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x44, 0x8d,
+	3, MatRegListStatic, 2, byte(WORD), 8, MatRefBase, MatLitInt, 32, 0x0, 0x62, 0xae, 0, 0,
 	// st2 {Vd.4S * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x44, 0x94,
+	3, MatRegListStatic, 2, byte(DWORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x62, 0xb5, 0, 0,
 	// st2 {Vd.2D * 2}, [Xn|SP], #32
-	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x44, 0x9b,
+	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x62, 0xbc, 0, 0,
 	// st2 {Vd.16B * 2}, [Xn|SP], Xm  ······················································  (m != 31)
 	// st2 {Vd.8B * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x44, 0xa2,
+	3, MatRegList, 2, byte(BYTE), MatRefBase, MatX, 0x0, 0x62, 0xc3, 0, 0,
 	// st2 {Vd.8H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st2 {Vd.4H * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x44, 0xab,
+	3, MatRegList, 2, byte(WORD), MatRefBase, MatX, 0x0, 0x62, 0xcc, 0, 0,
 	// st2 {Vd.4S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st2 {Vd.2S * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x44, 0xb4,
+	3, MatRegList, 2, byte(DWORD), MatRefBase, MatX, 0x0, 0x62, 0xd5, 0, 0,
 	// st2 {Vd.2D * 2}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatX, 0x44, 0xbd,
+	3, MatRegListStatic, 2, byte(QWORD), 2, MatRefBase, MatX, 0x0, 0x62, 0xde, 0, 0,
 	// st2 {Vd.B * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(BYTE), MatRefBase, 0x44, 0xc6,
+	2, MatRegListElement, 2, byte(BYTE), MatRefBase, 0x0, 0x62, 0xe7, 0, 0,
 	// st2 {Vd.H * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(WORD), MatRefBase, 0x44, 0xcf,
+	2, MatRegListElement, 2, byte(WORD), MatRefBase, 0x0, 0x62, 0xf0, 0, 0,
 	// st2 {Vd.S * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(DWORD), MatRefBase, 0x44, 0xd8,
+	2, MatRegListElement, 2, byte(DWORD), MatRefBase, 0x0, 0x62, 0xf9, 0, 0,
 	// st2 {Vd.D * 2}[i], [Xn|SP]
-	2, MatRegListElement, 2, byte(QWORD), MatRefBase, 0x44, 0xe1,
+	2, MatRegListElement, 2, byte(QWORD), MatRefBase, 0x0, 0x63, 0x2, 0, 0,
 	// st2 {Vd.B * 2}[i], [Xn|SP], #2
-	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatLitInt, 2, 0x44, 0xea,
+	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatLitInt, 2, 0x0, 0x63, 0xb, 0, 0,
 	// st2 {Vd.B * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatX, 0x44, 0xf3,
+	3, MatRegListElement, 2, byte(BYTE), MatRefBase, MatX, 0x0, 0x63, 0x14, 0, 0,
 	// st2 {Vd.H * 2}[i], [Xn|SP], #4
-	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatLitInt, 4, 0x44, 0xfd,
+	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatLitInt, 4, 0x0, 0x63, 0x1e, 0, 0,
 	// st2 {Vd.H * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatX, 0x45, 0x6,
+	3, MatRegListElement, 2, byte(WORD), MatRefBase, MatX, 0x0, 0x63, 0x27, 0, 0,
 	// st2 {Vd.S * 2}[i], [Xn|SP], #8
-	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatLitInt, 8, 0x45, 0x10,
+	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatLitInt, 8, 0x0, 0x63, 0x31, 0, 0,
 	// st2 {Vd.S * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatX, 0x45, 0x19,
+	3, MatRegListElement, 2, byte(DWORD), MatRefBase, MatX, 0x0, 0x63, 0x3a, 0, 0,
 	// st2 {Vd.D * 2}[i], [Xn|SP], #16
-	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatLitInt, 16, 0x45, 0x23,
+	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatLitInt, 16, 0x0, 0x63, 0x44, 0, 0,
 	// st2 {Vd.D * 2}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatX, 0x45, 0x2c,
+	3, MatRegListElement, 2, byte(QWORD), MatRefBase, MatX, 0x0, 0x63, 0x4d, 0, 0,
+
+	3,
+	// st2g Xd|SP, [Xn|SP {, #imm }]  ································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefOffset, 0x0, 0x63, 0x57, byte(FeatMTE), 0,
+	// st2g Xd|SP, [Xn|SP, #imm]!  ···································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefPre, 0x0, 0x63, 0x60, byte(FeatMTE), 0,
+	// st2g Xd|SP, [Xn|SP], #imm  ····································  (-4096 <= imm < 4096, imm >> 4)
+	3, MatXSP, MatRefBase, MatImm, 0x0, 0x63, 0x69, byte(FeatMTE), 0,
 
 	27,
 	// st3 {Vd.16B * 3}, [Xn|SP]
 	// st3 {Vd.8B * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x45, 0x36,
+	2, MatRegList, 3, byte(BYTE), MatRefBase, 0x0, 0x63, 0x72, 0, 0,
 	// st3 {Vd.8H * 3}, [Xn|SP]
 	// st3 {Vd.4H * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(WORD), MatRefBase, 0x45, 0x3e,
+	2, MatRegList, 3, byte(WORD), MatRefBase, 0x0, 0x63, 0x7a, 0, 0,
 	// st3 {Vd.4S * 3}, [Xn|SP]
 	// st3 {Vd.2S * 3}, [Xn|SP]
-	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x45, 0x46,
+	2, MatRegList, 3, byte(DWORD), MatRefBase, 0x0, 0x63, 0x82, 0, 0,
 	// st3 {Vd.2D * 3}, [Xn|SP]
-	2, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, 0x45, 0x4e,
+	2, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, 0x0, 0x63, 0x8a, 0, 0,
 	// st3 {Vd.8B * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x45, 0x56,
+	3, MatRegListStatic, 3, byte(BYTE), 8, MatRefBase, MatLitInt, 24, 0x0, 0x63, 0x92, 0, 0,
 	// st3 {Vd.4H * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x45, 0x5d,
+	3, MatRegListStatic, 3, byte(WORD), 4, MatRefBase, MatLitInt, 24, 0x0, 0x63, 0x99, 0, 0,
 	// st3 {Vd.2S * 3}, [Xn|SP], #24
-	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x45, 0x64,
+	3, MatRegListStatic, 3, byte(DWORD), 2, MatRefBase, MatLitInt, 24, 0x0, 0x63, 0xa0, 0, 0,
 	// st3 {Vd.16B * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x45, 0x6b,
+	3, MatRegListStatic, 3, byte(BYTE), 16, MatRefBase, MatLitInt, 48, 0x0, 0x63, 0xa7, 0, 0,
 	// st3 {Vd.8H * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x45, 0x72,
+	3, MatRegListStatic, 3, byte(WORD), 8, MatRefBase, MatLitInt, 48, 0x0, 0x63, 0xae, 0, 0,
 	// st3 {Vd.4S * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x45, 0x79,
+	3, MatRegListStatic, 3, byte(DWORD), 4, MatRefBase, MatLitInt, 48, 0x0, 0x63, 0xb5, 0, 0,
 	// st3 {Vd.2D * 3}, [Xn|SP], #48
-	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x45, 0x80,
+	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatLitInt, 48, 0x0, 0x63, 0xbc, 0, 0,
 	// st3 {Vd.16B * 3}, [Xn|SP], Xm  ······················································  (m != 31)
 	// st3 {Vd.8B * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x45, 0x87,
+	3, MatRegList, 3, byte(BYTE), MatRefBase, MatX, 0x0, 0x63, 0xc3, 0, 0,
 	// st3 {Vd.8H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st3 {Vd.4H * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x45, 0x90,
+	3, MatRegList, 3, byte(WORD), MatRefBase, MatX, 0x0, 0x63, 0xcc, 0, 0,
 	// st3 {Vd.4S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st3 {Vd.2S * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x45, 0x99,
+	3, MatRegList, 3, byte(DWORD), MatRefBase, MatX, 0x0, 0x63, 0xd5, 0, 0,
 	// st3 {Vd.2D * 3}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatX, 0x45, 0xa2,
+	3, MatRegListStatic, 3, byte(QWORD), 2, MatRefBase, MatX, 0x0, 0x63, 0xde, 0, 0,
 	// st3 {Vd.B * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(BYTE), MatRefBase, 0x45, 0xab,
+	2, MatRegListElement, 3, byte(BYTE), MatRefBase, 0x0, 0x63, 0xe7, 0, 0,
 	// st3 {Vd.H * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(WORD), MatRefBase, 0x45, 0xb4,
+	2, MatRegListElement, 3, byte(WORD), MatRefBase, 0x0, 0x63, 0xf0, 0, 0,
 	// st3 {Vd.S * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(DWORD), MatRefBase, 0x45, 0xbd,
+	2, MatRegListElement, 3, byte(DWORD), MatRefBase, 0x0, 0x63, 0xf9, 0, 0,
 	// st3 {Vd.D * 3}[i], [Xn|SP]
-	2, MatRegListElement, 3, byte(QWORD), MatRefBase, 0x45, 0xc6,
+	2, MatRegListElement, 3, byte(QWORD), MatRefBase, 0x0, 0x64, 0x2, 0, 0,
 	// st3 {Vd.B * 3}[i], [Xn|SP], #3
-	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatLitInt, 3, 0x45, 0xcf,
+	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatLitInt, 3, 0x0, 0x64, 0xb, 0, 0,
 	// st3 {Vd.B * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatX, 0x45, 0xd8,
+	3, MatRegListElement, 3, byte(BYTE), MatRefBase, MatX, 0x0, 0x64, 0x14, 0, 0,
 	// st3 {Vd.H * 3}[i], [Xn|SP], #6
-	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatLitInt, 6, 0x45, 0xe2,
+	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatLitInt, 6, 0x0, 0x64, 0x1e, 0, 0,
 	// st3 {Vd.H * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatX, 0x45, 0xeb,
+	3, MatRegListElement, 3, byte(WORD), MatRefBase, MatX, 0x0, 0x64, 0x27, 0, 0,
 	// st3 {Vd.S * 3}[i], [Xn|SP], #12
-	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatLitInt, 12, 0x45, 0xf5,
+	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatLitInt, 12, 0x0, 0x64, 0x31, 0, 0,
 	// st3 {Vd.S * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatX, 0x45, 0xfe,
+	3, MatRegListElement, 3, byte(DWORD), MatRefBase, MatX, 0x0, 0x64, 0x3a, 0, 0,
 	// st3 {Vd.D * 3}[i], [Xn|SP], #24
-	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatLitInt, 24, 0x46, 0x8,
+	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatLitInt, 24, 0x0, 0x64, 0x44, 0, 0,
 	// st3 {Vd.D * 3}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatX, 0x46, 0x11,
+	3, MatRegListElement, 3, byte(QWORD), MatRefBase, MatX, 0x0, 0x64, 0x4d, 0, 0,
 
 	27,
 	// st4 {Vd.16B * 4}, [Xn|SP]
 	// st4 {Vd.8B * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x46, 0x1b,
+	2, MatRegList, 4, byte(BYTE), MatRefBase, 0x0, 0x64, 0x57, 0, 0,
 	// st4 {Vd.8H * 4}, [Xn|SP]
 	// st4 {Vd.4H * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(WORD), MatRefBase, 0x46, 0x23,
+	2, MatRegList, 4, byte(WORD), MatRefBase, 0x0, 0x64, 0x5f, 0, 0,
 	// st4 {Vd.4S * 4}, [Xn|SP]
 	// st4 {Vd.2S * 4}, [Xn|SP]
-	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x46, 0x2b,
+	2, MatRegList, 4, byte(DWORD), MatRefBase, 0x0, 0x64, 0x67, 0, 0,
 	// st4 {Vd.2D * 4}, [Xn|SP]
-	2, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, 0x46, 0x33,
+	2, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, 0x0, 0x64, 0x6f, 0, 0,
 	// st4 {Vd.8B * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x46, 0x3b,
+	3, MatRegListStatic, 4, byte(BYTE), 8, MatRefBase, MatLitInt, 32, 0x0, 0x64, 0x77, 0, 0,
 	// st4 {Vd.4H * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x46, 0x42,
+	3, MatRegListStatic, 4, byte(WORD), 4, MatRefBase, MatLitInt, 32, 0x0, 0x64, 0x7e, 0, 0,
 	// st4 {Vd.2S * 4}, [Xn|SP], #32
-	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x46, 0x49,
+	3, MatRegListStatic, 4, byte(DWORD), 2, MatRefBase, MatLitInt, 32, 0x0, 0x64, 0x85, 0, 0,
 	// st4 {Vd.16B * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x46, 0x50,
+	3, MatRegListStatic, 4, byte(BYTE), 16, MatRefBase, MatLitInt, 64, 0x0, 0x64, 0x8c, 0, 0,
 	// st4 {Vd.8H * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x46, 0x57,
+	3, MatRegListStatic, 4, byte(WORD), 8, MatRefBase, MatLitInt, 64, 0x0, 0x64, 0x93, 0, 0,
 	// st4 {Vd.4S * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x46, 0x5e,
+	3, MatRegListStatic, 4, byte(DWORD), 4, MatRefBase, MatLitInt, 64, 0x0, 0x64, 0x9a, 0, 0,
 	// st4 {Vd.2D * 4}, [Xn|SP], #64
-	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x46, 0x65,
+	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatLitInt, 64, 0x0, 0x64, 0xa1, 0, 0,
 	// st4 {Vd.16B * 4}, [Xn|SP], Xm  ······················································  (m != 31)
 	// st4 {Vd.8B * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x46, 0x6c,
+	3, MatRegList, 4, byte(BYTE), MatRefBase, MatX, 0x0, 0x64, 0xa8, 0, 0,
 	// st4 {Vd.8H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st4 {Vd.4H * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x46, 0x75,
+	3, MatRegList, 4, byte(WORD), MatRefBase, MatX, 0x0, 0x64, 0xb1, 0, 0,
 	// st4 {Vd.4S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
 	// st4 {Vd.2S * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x46, 0x7e,
+	3, MatRegList, 4, byte(DWORD), MatRefBase, MatX, 0x0, 0x64, 0xba, 0, 0,
 	// st4 {Vd.2D * 4}, [Xn|SP], Xm  ·······················································  (m != 31)
-	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatX, 0x46, 0x87,
+	3, MatRegListStatic, 4, byte(QWORD), 2, MatRefBase, MatX, 0x0, 0x64, 0xc3, 0, 0,
 	// st4 {Vd.B * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(BYTE), MatRefBase, 0x46, 0x90,
+	2, MatRegListElement, 4, byte(BYTE), MatRefBase, 0x0, 0x64, 0xcc, 0, 0,
 	// st4 {Vd.H * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(WORD), MatRefBase, 0x46, 0x99,
+	2, MatRegListElement, 4, byte(WORD), MatRefBase, 0x0, 0x64, 0xd5, 0, 0,
 	// st4 {Vd.S * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(DWORD), MatRefBase, 0x46, 0xa2,
+	2, MatRegListElement, 4, byte(DWORD), MatRefBase, 0x0, 0x64, 0xde, 0, 0,
 	// st4 {Vd.D * 4}[i], [Xn|SP]
-	2, MatRegListElement, 4, byte(QWORD), MatRefBase, 0x46, 0xab,
+	2, MatRegListElement, 4, byte(QWORD), MatRefBase, 0x0, 0x64, 0xe7, 0, 0,
 	// st4 {Vd.B * 4}[i], [Xn|SP], #4
-	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatLitInt, 4, 0x46, 0xb4,
+	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatLitInt, 4, 0x0, 0x64, 0xf0, 0, 0,
 	// st4 {Vd.B * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatX, 0x46, 0xbd,
+	3, MatRegListElement, 4, byte(BYTE), MatRefBase, MatX, 0x0, 0x64, 0xf9, 0, 0,
 	// st4 {Vd.H * 4}[i], [Xn|SP], #8
-	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatLitInt, 8, 0x46, 0xc7,
+	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatLitInt, 8, 0x0, 0x65, 0x3, 0, 0,
 	// st4 {Vd.H * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatX, 0x46, 0xd0,
+	3, MatRegListElement, 4, byte(WORD), MatRefBase, MatX, 0x0, 0x65, 0xc, 0, 0,
 	// st4 {Vd.S * 4}[i], [Xn|SP], #16
-	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatLitInt, 16, 0x46, 0xda,
+	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatLitInt, 16, 0x0, 0x65, 0x16, 0, 0,
 	// st4 {Vd.S * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatX, 0x46, 0xe3,
+	3, MatRegListElement, 4, byte(DWORD), MatRefBase, MatX, 0x0, 0x65, 0x1f, 0, 0,
 	// st4 {Vd.D * 4}[i], [Xn|SP], #32
-	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatLitInt, 32, 0x46, 0xed,
+	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatLitInt, 32, 0x0, 0x65, 0x29, 0, 0,
 	// st4 {Vd.D * 4}[i], [Xn|SP], Xm  ·····················································  (m != 31)
-	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatX, 0x46, 0xf6,
+	3, MatRegListElement, 4, byte(QWORD), MatRefBase, MatX, 0x0, 0x65, 0x32, 0, 0,
+
+	1,
+	// st64b Xn, [Xm|SP]  ································································  (n is even)
+	2, MatX, MatRefBase, 0x0, 0x65, 0x3c, byte(FeatLS64), 0,
+
+	1,
+	// st64bv Xd, Xn, [Xm|SP]  ···························································  (n is even)
+	3, MatX, MatX, MatRefBase, 0x0, 0x65, 0x44, byte(FeatLS64), 0,
+
+	1,
+	// st64bv0 Xd, Xn, [Xm|SP]  ··························································  (n is even)
+	3, MatX, MatX, MatRefBase, 0x0, 0x65, 0x4d, byte(FeatLS64), 0,
 
 	2,
 	// stadd Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x0,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x56, byte(FeatLSE), 0,
 	// stadd Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0x7,
+	2, MatX, MatRefBase, 0x0, 0x65, 0x5d, byte(FeatLSE), 0,
 
 	1,
 	// staddb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xe,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x64, byte(FeatLSE), 0,
 
 	1,
 	// staddh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x15,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x6b, byte(FeatLSE), 0,
 
 	2,
 	// staddl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x1c,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x72, byte(FeatLSE), 0,
 	// staddl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0x23,
+	2, MatX, MatRefBase, 0x0, 0x65, 0x79, byte(FeatLSE), 0,
 
 	1,
 	// staddlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x2a,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x80, byte(FeatLSE), 0,
 
 	1,
 	// staddlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x31,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x87, byte(FeatLSE), 0,
 
 	2,
 	// stclr Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x38,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x8e, byte(FeatLSE), 0,
 	// stclr Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0x3f,
+	2, MatX, MatRefBase, 0x0, 0x65, 0x95, byte(FeatLSE), 0,
 
 	1,
 	// stclrb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x46,
+	2, MatW, MatRefBase, 0x0, 0x65, 0x9c, byte(FeatLSE), 0,
 
 	1,
 	// stclrh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x4d,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xa3, byte(FeatLSE), 0,
 
 	2,
 	// stclrl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x54,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xaa, byte(FeatLSE), 0,
 	// stclrl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0x5b,
+	2, MatX, MatRefBase, 0x0, 0x65, 0xb1, byte(FeatLSE), 0,
 
 	1,
 	// stclrlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x62,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xb8, byte(FeatLSE), 0,
 
 	1,
 	// stclrlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x69,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xbf, byte(FeatLSE), 0,
 
 	2,
 	// steor Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x70,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xc6, byte(FeatLSE), 0,
 	// steor Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0x77,
+	2, MatX, MatRefBase, 0x0, 0x65, 0xcd, byte(FeatLSE), 0,
 
 	1,
 	// steorb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x7e,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xd4, byte(FeatLSE), 0,
 
 	1,
 	// steorh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x85,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xdb, byte(FeatLSE), 0,
 
 	2,
 	// steorl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x8c,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xe2, byte(FeatLSE), 0,
 	// steorl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0x93,
+	2, MatX, MatRefBase, 0x0, 0x65, 0xe9, byte(FeatLSE), 0,
 
 	1,
 	// steorlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0x9a,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xf0, byte(FeatLSE), 0,
 
 	1,
 	// steorlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xa1,
+	2, MatW, MatRefBase, 0x0, 0x65, 0xf7, byte(FeatLSE), 0,
+
+	3,
+	// stg Xd|SP, [Xn|SP {, #imm }]  ·································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefOffset, 0x0, 0x65, 0xfe, byte(FeatMTE), 0,
+	// stg Xd|SP, [Xn|SP, #imm]!  ····································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefPre, 0x0, 0x66, 0x7, byte(FeatMTE), 0,
+	// stg Xd|SP, [Xn|SP], #imm  ·····································  (-4096 <= imm < 4096, imm >> 4)
+	3, MatXSP, MatRefBase, MatImm, 0x0, 0x66, 0x10, byte(FeatMTE), 0,
+
+	3,
+	// stgp Xd, Xn, [Xm|SP {, #imm }]  ·······························  (-1024 <= imm < 1024, imm >> 4)
+	3, MatX, MatX, MatRefOffset, 0x0, 0x66, 0x19, byte(FeatMTE), 0,
+	// stgp Xd, Xn, [Xm|SP, #imm]!  ··································  (-1024 <= imm < 1024, imm >> 4)
+	3, MatX, MatX, MatRefPre, 0x0, 0x66, 0x23, byte(FeatMTE), 0,
+	// stgp Xd, Xn, [Xm|SP], #imm  ···································  (-1024 <= imm < 1024, imm >> 4)
+	4, MatX, MatX, MatRefBase, MatImm, 0x0, 0x66, 0x2d, byte(FeatMTE), 0,
 
 	2,
 	// stllr Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xa8,
+	2, MatW, MatRefBase, 0x0, 0x66, 0x37, byte(FeatLOR), 0,
 	// stllr Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0xaf,
+	2, MatX, MatRefBase, 0x0, 0x66, 0x3e, byte(FeatLOR), 0,
 
 	1,
 	// stllrb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xb6,
+	2, MatW, MatRefBase, 0x0, 0x66, 0x45, byte(FeatLOR), 0,
 
 	1,
 	// stllrh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xbd,
+	2, MatW, MatRefBase, 0x0, 0x66, 0x4c, byte(FeatLOR), 0,
 
 	2,
 	// stlr Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xc4,
+	2, MatW, MatRefBase, 0x0, 0x66, 0x53, 0, 0,
 	// stlr Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x47, 0xcb,
+	2, MatX, MatRefBase, 0x0, 0x66, 0x5a, 0, 0,
 
 	1,
 	// stlrb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xd2,
+	2, MatW, MatRefBase, 0x0, 0x66, 0x61, 0, 0,
 
 	1,
 	// stlrh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x47, 0xd9,
+	2, MatW, MatRefBase, 0x0, 0x66, 0x68, 0, 0,
 
 	2,
 	// stlur Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x47, 0xe0,
+	2, MatW, MatRefOffset, 0x0, 0x66, 0x6f, byte(FeatLRCPC2), 0,
 	// stlur Xd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x47, 0xe8,
+	2, MatX, MatRefOffset, 0x0, 0x66, 0x77, byte(FeatLRCPC2), 0,
 
 	1,
 	// stlurb Wd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x47, 0xf0,
+	2, MatW, MatRefOffset, 0x0, 0x66, 0x7f, byte(FeatLRCPC2), 0,
 
 	1,
 	// stlurh Wd, [Xn|SP {, #imm }]  ·············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x47, 0xf8,
+	2, MatW, MatRefOffset, 0x0, 0x66, 0x87, byte(FeatLRCPC2), 0,
 
 	2,
 	// stlxp Wd, Wn, Wm, [Xa|SP]
-	4, MatW, MatW, MatW, MatRefBase, 0x48, 0x0,
+	4, MatW, MatW, MatW, MatRefBase, 0x0, 0x66, 0x8f, 0, 0,
 	// stlxp Wd, Xn, Xm, [Xa|SP]
-	4, MatW, MatX, MatX, MatRefBase, 0x48, 0x9,
+	4, MatW, MatX, MatX, MatRefBase, 0x0, 0x66, 0x98, 0, 0,
 
 	2,
 	// stlxr Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x48, 0x12,
+	3, MatW, MatW, MatRefBase, 0x0, 0x66, 0xa1, 0, 0,
 	// stlxr Wd, Xn, [Xm|SP]
-	3, MatW, MatX, MatRefBase, 0x48, 0x1a,
+	3, MatW, MatX, MatRefBase, 0x0, 0x66, 0xa9, 0, 0,
 
 	1,
 	// stlxrb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x48, 0x22,
+	3, MatW, MatW, MatRefBase, 0x0, 0x66, 0xb1, 0, 0,
 
 	1,
 	// stlxrh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x48, 0x2a,
+	3, MatW, MatW, MatRefBase, 0x0, 0x66, 0xb9, 0, 0,
 
 	5,
 	// stnp Sd, Sn, [Xm|SP {, #imm }]  ·································  (-256 <= imm < 256, imm >> 2)
-	3, MatS, MatS, MatRefOffset, 0x48, 0x32,
+	3, MatS, MatS, MatRefOffset, 0x0, 0x66, 0xc1, 0, 0,
 	// stnp Dd, Dn, [Xm|SP {, #imm }]  ·································  (-512 <= imm < 512, imm >> 3)
-	3, MatD, MatD, MatRefOffset, 0x48, 0x3c,
+	3, MatD, MatD, MatRefOffset, 0x0, 0x66, 0xcb, 0, 0,
 	// stnp Qd, Qn, [Xm|SP {, #imm }]  ·······························  (-1024 <= imm < 1024, imm >> 4)
-	3, MatQ, MatQ, MatRefOffset, 0x48, 0x46,
+	3, MatQ, MatQ, MatRefOffset, 0x0, 0x66, 0xd5, 0, 0,
 	// stnp Wd, Wn, [Xm|SP {, #imm }]  ·································  (-256 <= imm < 256, imm >> 2)
-	3, MatW, MatW, MatRefOffset, 0x48, 0x50,
+	3, MatW, MatW, MatRefOffset, 0x0, 0x66, 0xdf, 0, 0,
 	// stnp Xd, Xn, [Xm|SP {, #imm }]  ·································  (-512 <= imm < 512, imm >> 3)
-	3, MatX, MatX, MatRefOffset, 0x48, 0x5a,
+	3, MatX, MatX, MatRefOffset, 0x0, 0x66, 0xe9, 0, 0,
 
 	15,
 	// stp Sd, Sn, [Xm|SP], #imm  ······································  (-256 <= imm < 256, imm >> 2)
-	4, MatS, MatS, MatRefBase, MatImm, 0x48, 0x64,
+	4, MatS, MatS, MatRefBase, MatImm, 0x0, 0x66, 0xf3, 0, 0,
 	// stp Dd, Dn, [Xm|SP], #imm  ······································  (-512 <= imm < 512, imm >> 3)
-	4, MatD, MatD, MatRefBase, MatImm, 0x48, 0x6e,
+	4, MatD, MatD, MatRefBase, MatImm, 0x0, 0x66, 0xfd, 0, 0,
 	// stp Qd, Qn, [Xm|SP], #imm  ····································  (-1024 <= imm < 1024, imm >> 4)
-	4, MatQ, MatQ, MatRefBase, MatImm, 0x48, 0x78,
+	4, MatQ, MatQ, MatRefBase, MatImm, 0x0, 0x67, 0x7, 0, 0,
 	// stp Sd, Sn, [Xm|SP, #imm]!  ·····································  (-256 <= imm < 256, imm >> 2)
-	3, MatS, MatS, MatRefPre, 0x48, 0x82,
+	3, MatS, MatS, MatRefPre, 0x0, 0x67, 0x11, 0, 0,
 	// stp Dd, Dn, [Xm|SP, #imm]!  ·····································  (-512 <= imm < 512, imm >> 3)
-	3, MatD, MatD, MatRefPre, 0x48, 0x8c,
+	3, MatD, MatD, MatRefPre, 0x0, 0x67, 0x1b, 0, 0,
 	// stp Qd, Qn, [Xm|SP, #imm]!  ···································  (-1024 <= imm < 1024, imm >> 4)
-	3, MatQ, MatQ, MatRefPre, 0x48, 0x96,
+	3, MatQ, MatQ, MatRefPre, 0x0, 0x67, 0x25, 0, 0,
 	// stp Sd, Sn, [Xm|SP {, #imm }]  ··································  (-256 <= imm < 256, imm >> 2)
-	3, MatS, MatS, MatRefOffset, 0x48, 0xa0,
+	3, MatS, MatS, MatRefOffset, 0x0, 0x67, 0x2f, 0, 0,
 	// stp Dd, Dn, [Xm|SP {, #imm }]  ··································  (-512 <= imm < 512, imm >> 3)
-	3, MatD, MatD, MatRefOffset, 0x48, 0xaa,
+	3, MatD, MatD, MatRefOffset, 0x0, 0x67, 0x39, 0, 0,
 	// stp Qd, Qn, [Xm|SP {, #imm }]  ································  (-1024 <= imm < 1024, imm >> 4)
-	3, MatQ, MatQ, MatRefOffset, 0x48, 0xb4,
+	3, MatQ, MatQ, MatRefOffset, 0x0, 0x67, 0x43, 0, 0,
 	// stp Wd, Wn, [Xm|SP], #imm  ······································  (-256 <= imm < 256, imm >> 2)
-	4, MatW, MatW, MatRefBase, MatImm, 0x48, 0xbe,
+	4, MatW, MatW, MatRefBase, MatImm, 0x0, 0x67, 0x4d, 0, 0,
 	// stp Xd, Xn, [Xm|SP], #imm  ······································  (-512 <= imm < 512, imm >> 3)
-	4, MatX, MatX, MatRefBase, MatImm, 0x48, 0xc8,
+	4, MatX, MatX, MatRefBase, MatImm, 0x0, 0x67, 0x57, 0, 0,
 	// stp Wd, Wn, [Xm|SP, #imm]!  ·····································  (-256 <= imm < 256, imm >> 2)
-	3, MatW, MatW, MatRefPre, 0x48, 0xd2,
+	3, MatW, MatW, MatRefPre, 0x0, 0x67, 0x61, 0, 0,
 	// stp Xd, Xn, [Xm|SP, #imm]!  ·····································  (-512 <= imm < 512, imm >> 3)
-	3, MatX, MatX, MatRefPre, 0x48, 0xdc,
+	3, MatX, MatX, MatRefPre, 0x0, 0x67, 0x6b, 0, 0,
 	// stp Wd, Wn, [Xm|SP {, #imm }]  ··································  (-256 <= imm < 256, imm >> 2)
-	3, MatW, MatW, MatRefOffset, 0x48, 0xe6,
+	3, MatW, MatW, MatRefOffset, 0x0, 0x67, 0x75, 0, 0,
 	// stp Xd, Xn, [Xm|SP {, #imm }]  ··································  (-512 <= imm < 512, imm >> 3)
-	3, MatX, MatX, MatRefOffset, 0x48, 0xf0,
+	3, MatX, MatX, MatRefOffset, 0x0, 0x67, 0x7f, 0, 0,
 
-	28,
+	31,
 	// str Bd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatB, MatRefBase, MatImm, 0x48, 0xfa,
+	3, MatB, MatRefBase, MatImm, 0x0, 0x67, 0x89, 0, 0,
 	// str Hd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatH, MatRefBase, MatImm, 0x49, 0x2,
+	3, MatH, MatRefBase, MatImm, 0x0, 0x67, 0x91, 0, 0,
 	// str Sd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatS, MatRefBase, MatImm, 0x49, 0xa,
+	3, MatS, MatRefBase, MatImm, 0x0, 0x67, 0x99, 0, 0,
 	// str Dd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatD, MatRefBase, MatImm, 0x49, 0x12,
+	3, MatD, MatRefBase, MatImm, 0x0, 0x67, 0xa1, 0, 0,
 	// str Qd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatQ, MatRefBase, MatImm, 0x49, 0x1a,
+	3, MatQ, MatRefBase, MatImm, 0x0, 0x67, 0xa9, 0, 0,
 	// str Bd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatB, MatRefPre, 0x49, 0x22,
+	2, MatB, MatRefPre, 0x0, 0x67, 0xb1, 0, 0,
 	// str Hd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatH, MatRefPre, 0x49, 0x2a,
+	2, MatH, MatRefPre, 0x0, 0x67, 0xb9, 0, 0,
 	// str Sd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatS, MatRefPre, 0x49, 0x32,
+	2, MatS, MatRefPre, 0x0, 0x67, 0xc1, 0, 0,
 	// str Dd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatD, MatRefPre, 0x49, 0x3a,
+	2, MatD, MatRefPre, 0x0, 0x67, 0xc9, 0, 0,
 	// str Qd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatQ, MatRefPre, 0x49, 0x42,
+	2, MatQ, MatRefPre, 0x0, 0x67, 0xd1, 0, 0,
 	// str Bd, [Xn|SP {, #imm }]  ··················································  (0 <= imm < 4096)
-	2, MatB, MatRefOffset, 0x49, 0x4a,
+	2, MatB, MatRefOffset, 0x0, 0x67, 0xd9, 0, 0,
 	// str Hd, [Xn|SP {, #imm }]  ········································  (0 <= imm < 8192, imm >> 1)
-	2, MatH, MatRefOffset, 0x49, 0x54,
+	2, MatH, MatRefOffset, 0x0, 0x67, 0xe3, 0, 0,
 	// str Sd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 16384, imm >> 2)
-	2, MatS, MatRefOffset, 0x49, 0x5f,
+	2, MatS, MatRefOffset, 0x0, 0x67, 0xee, 0, 0,
 	// str Dd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 32768, imm >> 3)
-	2, MatD, MatRefOffset, 0x49, 0x6a,
+	2, MatD, MatRefOffset, 0x0, 0x67, 0xf9, 0, 0,
 	// str Qd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 65536, imm >> 4)
-	2, MatQ, MatRefOffset, 0x49, 0x75,
+	2, MatQ, MatRefOffset, 0x0, 0x68, 0x4, 0, 0,
 	// str Wd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x49, 0x80,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x68, 0xf, 0, 0,
 	// str Xd, [Xn|SP], #imm  ····················································  (-256 <= imm < 256)
-	3, MatX, MatRefBase, MatImm, 0x49, 0x88,
+	3, MatX, MatRefBase, MatImm, 0x0, 0x68, 0x17, 0, 0,
 	// str Wd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x49, 0x90,
+	2, MatW, MatRefPre, 0x0, 0x68, 0x1f, 0, 0,
 	// str Xd, [Xn|SP, #imm]!  ···················································  (-256 <= imm < 256)
-	2, MatX, MatRefPre, 0x49, 0x98,
+	2, MatX, MatRefPre, 0x0, 0x68, 0x27, 0, 0,
 	// str Wd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 16384, imm >> 2)
-	2, MatW, MatRefOffset, 0x49, 0xa0,
+	2, MatW, MatRefOffset, 0x0, 0x68, 0x2f, 0, 0,
 	// str Xd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 32768, imm >> 3)
-	2, MatX, MatRefOffset, 0x49, 0xab,
+	2, MatX, MatRefOffset, 0x0, 0x68, 0x3a, 0, 0,
 	// str Bd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ·······························  (imm == 0)
-	2, MatB, MatRefIndex, 0x49, 0xb6,
+	2, MatB, MatRefIndex, 0x0, 0x68, 0x45, 0, 0,
 	// str Hd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 1])
-	2, MatH, MatRefIndex, 0x49, 0xc2,
+	2, MatH, MatRefIndex, 0x0, 0x68, 0x51, 0, 0,
 	// str Sd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 2])
-	2, MatS, MatRefIndex, 0x49, 0xce,
+	2, MatS, MatRefIndex, 0x0, 0x68, 0x5d, 0, 0,
 	// str Dd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 3])
-	2, MatD, MatRefIndex, 0x49, 0xda,
+	2, MatD, MatRefIndex, 0x0, 0x68, 0x69, 0, 0,
 	// str Qd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 4])
-	2, MatQ, MatRefIndex, 0x49, 0xe6,
+	2, MatQ, MatRefIndex, 0x0, 0x68, 0x75, 0, 0,
 	// str Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 2])
-	2, MatW, MatRefIndex, 0x49, 0xf2,
+	2, MatW, MatRefIndex, 0x0, 0x68, 0x81, 0, 0,
 	// str Xd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ··························  (imm in [0, 3])
-	2, MatX, MatRefIndex, 0x49, 0xfe,
+	2, MatX, MatRefIndex, 0x0, 0x68, 0x8d, 0, 0,
+	// str ZA[W12-W15, #imm1], [Xn|SP {, #imm2, MUL VL }]  ············  (0 <= imm1 < 16, imm2 == imm1)
+	2, MatZASlice, 0, MatRefVL, 0x0, 0x68, 0x99, byte(FeatSME), 0,
+	// str Zd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	2, MatZ, 0, MatRefVL, 0x0, 0x68, 0xa8, byte(FeatSVE), 0,
+	// str Pd, [Xn|SP {, #imm, MUL VL }]  ········································  (-256 <= imm < 256)
+	2, MatP, 0, MatRefVL, 0x0, 0x68, 0xba, byte(FeatSVE), 0,
 
 	4,
 	// strb Wd, [Xn|SP], #imm  ···················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x4a, 0xa,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x68, 0xcc, 0, 0,
 	// strb Wd, [Xn|SP, #imm]!  ··················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x4a, 0x12,
+	2, MatW, MatRefPre, 0x0, 0x68, 0xd4, 0, 0,
 	// strb Wd, [Xn|SP {, #imm }]  ·················································  (0 <= imm < 4096)
-	2, MatW, MatRefOffset, 0x4a, 0x1a,
+	2, MatW, MatRefOffset, 0x0, 0x68, 0xdc, 0, 0,
 	// strb Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ······························  (imm == 0)
-	2, MatW, MatRefIndex, 0x4a, 0x24,
+	2, MatW, MatRefIndex, 0x0, 0x68, 0xe6, 0, 0,
 
 	4,
 	// strh Wd, [Xn|SP], #imm  ···················································  (-256 <= imm < 256)
-	3, MatW, MatRefBase, MatImm, 0x4a, 0x30,
+	3, MatW, MatRefBase, MatImm, 0x0, 0x68, 0xf2, 0, 0,
 	// strh Wd, [Xn|SP, #imm]!  ··················································  (-256 <= imm < 256)
-	2, MatW, MatRefPre, 0x4a, 0x38,
+	2, MatW, MatRefPre, 0x0, 0x68, 0xfa, 0, 0,
 	// strh Wd, [Xn|SP {, #imm }]  ·······································  (0 <= imm < 8192, imm >> 1)
-	2, MatW, MatRefOffset, 0x4a, 0x40,
+	2, MatW, MatRefOffset, 0x0, 0x69, 0x2, 0, 0,
 	// strh Wd, [Xn|SP, Wm|Xm {, LSL|UXTW|SXTW|SXTX #imm }]  ·························  (imm in [0, 1])
-	2, MatW, MatRefIndex, 0x4a, 0x4b,
+	2, MatW, MatRefIndex, 0x0, 0x69, 0xd, 0, 0,
 
 	2,
 	// stset Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x57,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x19, byte(FeatLSE), 0,
 	// stset Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4a, 0x5e,
+	2, MatX, MatRefBase, 0x0, 0x69, 0x20, byte(FeatLSE), 0,
 
 	1,
 	// stsetb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x65,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x27, byte(FeatLSE), 0,
 
 	1,
 	// stseth Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x6c,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x2e, byte(FeatLSE), 0,
 
 	2,
 	// stsetl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x73,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x35, byte(FeatLSE), 0,
 	// stsetl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4a, 0x7a,
+	2, MatX, MatRefBase, 0x0, 0x69, 0x3c, byte(FeatLSE), 0,
 
 	1,
 	// stsetlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x81,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x43, byte(FeatLSE), 0,
 
 	1,
 	// stsetlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x88,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x4a, byte(FeatLSE), 0,
 
 	2,
 	// stsmax Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x8f,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x51, byte(FeatLSE), 0,
 	// stsmax Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4a, 0x96,
+	2, MatX, MatRefBase, 0x0, 0x69, 0x58, byte(FeatLSE), 0,
 
 	1,
 	// stsmaxb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0x9d,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x5f, byte(FeatLSE), 0,
 
 	1,
 	// stsmaxh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xa4,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x66, byte(FeatLSE), 0,
 
 	2,
 	// stsmaxl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xab,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x6d, byte(FeatLSE), 0,
 	// stsmaxl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4a, 0xb2,
+	2, MatX, MatRefBase, 0x0, 0x69, 0x74, byte(FeatLSE), 0,
 
 	1,
 	// stsmaxlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xb9,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x7b, byte(FeatLSE), 0,
 
 	1,
 	// stsmaxlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xc0,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x82, byte(FeatLSE), 0,
 
 	2,
 	// stsmin Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xc7,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x89, byte(FeatLSE), 0,
 	// stsmin Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4a, 0xce,
+	2, MatX, MatRefBase, 0x0, 0x69, 0x90, byte(FeatLSE), 0,
 
 	1,
 	// stsminb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xd5,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x97, byte(FeatLSE), 0,
 
 	1,
 	// stsminh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xdc,
+	2, MatW, MatRefBase, 0x0, 0x69, 0x9e, byte(FeatLSE), 0,
 
 	2,
 	// stsminl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xe3,
+	2, MatW, MatRefBase, 0x0, 0x69, 0xa5, byte(FeatLSE), 0,
 	// stsminl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4a, 0xea,
+	2, MatX, MatRefBase, 0x0, 0x69, 0xac, byte(FeatLSE), 0,
 
 	1,
 	// stsminlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xf1,
+	2, MatW, MatRefBase, 0x0, 0x69, 0xb3, byte(FeatLSE), 0,
 
 	1,
 	// stsminlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4a, 0xf8,
+	2, MatW, MatRefBase, 0x0, 0x69, 0xba, byte(FeatLSE), 0,
 
 	2,
 	// sttr Wd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x4a, 0xff,
+	2, MatW, MatRefOffset, 0x0, 0x69, 0xc1, 0, 0,
 	// sttr Xd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x4b, 0x7,
+	2, MatX, MatRefOffset, 0x0, 0x69, 0xc9, 0, 0,
 
 	1,
 	// sttrb Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x4b, 0xf,
+	2, MatW, MatRefOffset, 0x0, 0x69, 0xd1, 0, 0,
 
 	1,
 	// sttrh Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x4b, 0x17,
+	2, MatW, MatRefOffset, 0x0, 0x69, 0xd9, 0, 0,
 
 	2,
 	// stumax Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x1f,
+	2, MatW, MatRefBase, 0x0, 0x69, 0xe1, byte(FeatLSE), 0,
 	// stumax Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4b, 0x26,
+	2, MatX, MatRefBase, 0x0, 0x69, 0xe8, byte(FeatLSE), 0,
 
 	1,
 	// stumaxb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x2d,
+	2, MatW, MatRefBase, 0x0, 0x69, 0xef, byte(FeatLSE), 0,
 
 	1,
 	// stumaxh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x34,
+	2, MatW, MatRefBase, 0x0, 0x69, 0xf6, byte(FeatLSE), 0,
 
 	2,
 	// stumaxl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x3b,
+	2, MatW, MatRefBase, 0x0, 0x69, 0xfd, byte(FeatLSE), 0,
 	// stumaxl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4b, 0x42,
+	2, MatX, MatRefBase, 0x0, 0x6a, 0x4, byte(FeatLSE), 0,
 
 	1,
 	// stumaxlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x49,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0xb, byte(FeatLSE), 0,
 
 	1,
 	// stumaxlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x50,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0x12, byte(FeatLSE), 0,
 
 	2,
 	// stumin Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x57,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0x19, byte(FeatLSE), 0,
 	// stumin Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4b, 0x5e,
+	2, MatX, MatRefBase, 0x0, 0x6a, 0x20, byte(FeatLSE), 0,
 
 	1,
 	// stuminb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x65,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0x27, byte(FeatLSE), 0,
 
 	1,
 	// stuminh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x6c,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0x2e, byte(FeatLSE), 0,
 
 	2,
 	// stuminl Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x73,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0x35, byte(FeatLSE), 0,
 	// stuminl Xd, [Xn|SP]
-	2, MatX, MatRefBase, 0x4b, 0x7a,
+	2, MatX, MatRefBase, 0x0, 0x6a, 0x3c, byte(FeatLSE), 0,
 
 	1,
 	// stuminlb Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x81,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0x43, byte(FeatLSE), 0,
 
 	1,
 	// stuminlh Wd, [Xn|SP]
-	2, MatW, MatRefBase, 0x4b, 0x88,
+	2, MatW, MatRefBase, 0x0, 0x6a, 0x4a, byte(FeatLSE), 0,
 
 	7,
 	// stur Bd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatB, MatRefOffset, 0x4b, 0x8f,
+	2, MatB, MatRefOffset, 0x0, 0x6a, 0x51, 0, 0,
 	// stur Hd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatH, MatRefOffset, 0x4b, 0x97,
+	2, MatH, MatRefOffset, 0x0, 0x6a, 0x59, 0, 0,
 	// stur Sd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatS, MatRefOffset, 0x4b, 0x9f,
+	2, MatS, MatRefOffset, 0x0, 0x6a, 0x61, 0, 0,
 	// stur Dd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatD, MatRefOffset, 0x4b, 0xa7,
+	2, MatD, MatRefOffset, 0x0, 0x6a, 0x69, 0, 0,
 	// stur Qd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatQ, MatRefOffset, 0x4b, 0xaf,
+	2, MatQ, MatRefOffset, 0x0, 0x6a, 0x71, 0, 0,
 	// stur Wd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x4b, 0xb7,
+	2, MatW, MatRefOffset, 0x0, 0x6a, 0x79, 0, 0,
 	// stur Xd, [Xn|SP {, #imm }]  ···············································  (-256 <= imm < 256)
-	2, MatX, MatRefOffset, 0x4b, 0xbf,
+	2, MatX, MatRefOffset, 0x0, 0x6a, 0x81, 0, 0,
 
 	1,
 	// sturb Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x4b, 0xc7,
+	2, MatW, MatRefOffset, 0x0, 0x6a, 0x89, 0, 0,
 
 	1,
 	// sturh Wd, [Xn|SP {, #imm }]  ··············································  (-256 <= imm < 256)
-	2, MatW, MatRefOffset, 0x4b, 0xcf,
+	2, MatW, MatRefOffset, 0x0, 0x6a, 0x91, 0, 0,
 
 	2,
 	// stxp Wd, Wn, Wm, [Xa|SP]
-	4, MatW, MatW, MatW, MatRefBase, 0x4b, 0xd7,
+	4, MatW, MatW, MatW, MatRefBase, 0x0, 0x6a, 0x99, 0, 0,
 	// stxp Wd, Xn, Xm, [Xa|SP]
-	4, MatW, MatX, MatX, MatRefBase, 0x4b, 0xe0,
+	4, MatW, MatX, MatX, MatRefBase, 0x0, 0x6a, 0xa2, 0, 0,
 
 	2,
 	// stxr Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4b, 0xe9,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6a, 0xab, 0, 0,
 	// stxr Wd, Xn, [Xm|SP]
-	3, MatW, MatX, MatRefBase, 0x4b, 0xf1,
+	3, MatW, MatX, MatRefBase, 0x0, 0x6a, 0xb3, 0, 0,
 
 	1,
 	// stxrb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4b, 0xf9,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6a, 0xbb, 0, 0,
 
 	1,
 	// stxrh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4c, 0x1,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6a, 0xc3, 0, 0,
 
-	12,
+	3,
+	// stz2g Xd|SP, [Xn|SP {, #imm }]  ·······························  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefOffset, 0x0, 0x6a, 0xcb, byte(FeatMTE), 0,
+	// stz2g Xd|SP, [Xn|SP, #imm]!  ··································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefPre, 0x0, 0x6a, 0xd4, byte(FeatMTE), 0,
+	// stz2g Xd|SP, [Xn|SP], #imm  ···································  (-4096 <= imm < 4096, imm >> 4)
+	3, MatXSP, MatRefBase, MatImm, 0x0, 0x6a, 0xdd, byte(FeatMTE), 0,
+
+	3,
+	// stzg Xd|SP, [Xn|SP {, #imm }]  ································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefOffset, 0x0, 0x6a, 0xe6, byte(FeatMTE), 0,
+	// stzg Xd|SP, [Xn|SP, #imm]!  ···································  (-4096 <= imm < 4096, imm >> 4)
+	2, MatXSP, MatRefPre, 0x0, 0x6a, 0xef, byte(FeatMTE), 0,
+	// stzg Xd|SP, [Xn|SP], #imm  ····································  (-4096 <= imm < 4096, imm >> 4)
+	3, MatXSP, MatRefBase, MatImm, 0x0, 0x6a, 0xf8, byte(FeatMTE), 0,
+
+	24,
 	// sub Wd, Wn, Wm {, LSL|LSR|ASR #imm }  ·········································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x4c, 0x9,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x6b, 0x1, 0, 0,
 	// sub Xd, Xn, Xm {, LSL|LSR|ASR #imm }  ·········································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x4c, 0x15,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x6b, 0xd, 0, 0,
 	// sub Wd|WSP, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm }  ·····················  (0 <= imm <= 4)
-	5, MatWSP, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x4c, 0x21,
+	5, MatWSP, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x0, 0x6b, 0x19, 0, 0,
 	// sub Xd|SP, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm }  ·····························  (0 <= imm <= 4)
-	5, MatXSP, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x4c, 0x2e,
+	5, MatXSP, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x0, 0x6b, 0x26, 0, 0,
 	// sub Xd|SP, Xn|SP, Xm {, LSL|UXTX|SXTX #imm }  ·································  (0 <= imm <= 4)
-	5, MatXSP, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x4c, 0x3b,
+	5, MatXSP, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x0, 0x6b, 0x33, 0, 0,
 	// sub Wd|WSP, Wn|WSP, #imm1 {, LSL #imm2 }  ·················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatWSP, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x4c, 0x48,
+	5, MatWSP, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6b, 0x40, 0, 0,
 	// sub Xd|SP, Xn|SP, #imm1 {, LSL #imm2 }  ···················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatXSP, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x4c, 0x55,
+	5, MatXSP, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6b, 0x4d, 0, 0,
 	// sub Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x4c, 0x62,
+	3, MatD, MatD, MatD, 0x0, 0x6b, 0x5a, 0, 0,
 	// sub Vd.16B, Vn.16B, Vm.16B
 	// sub Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x4c, 0x6a,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6b, 0x62, 0, 0,
 	// sub Vd.8H, Vn.8H, Vm.8H
 	// sub Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x4c, 0x73,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x6b, 0x6b, 0, 0,
 	// sub Vd.4S, Vn.4S, Vm.4S
 	// sub Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x4c, 0x7c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x6b, 0x74, 0, 0,
 	// sub Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x4c, 0x85,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x6b, 0x7d, 0, 0,
+	// sub Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6b, 0x86, byte(FeatSVE), 0,
+	// sub Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6b, 0x8e, byte(FeatSVE), 0,
+	// sub Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x6b, 0x96, byte(FeatSVE), 0,
+	// sub Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6b, 0x9e, byte(FeatSVE), 0,
+	// sub Zd.B, Pg/M, Zn.B, Zm.B  ···················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6b, 0xa6, byte(FeatSVE), 0,
+	// sub Zd.H, Pg/M, Zn.H, Zm.H  ···················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6b, 0xb1, byte(FeatSVE), 0,
+	// sub Zd.S, Pg/M, Zn.S, Zm.S  ···················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x6b, 0xbc, byte(FeatSVE), 0,
+	// sub Zd.D, Pg/M, Zn.D, Zm.D  ···················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6b, 0xc7, byte(FeatSVE), 0,
+	// sub Zd.B, Zn.B, #imm  ················································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x6b, 0xd2, byte(FeatSVE), 0,
+	// sub Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6b, 0xdd, byte(FeatSVE), 0,
+	// sub Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6b, 0xeb, byte(FeatSVE), 0,
+	// sub Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ···············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6b, 0xf9, byte(FeatSVE), 0,
+
+	1,
+	// subg Xd|SP, Xn|SP, #imm1, #imm2  ················  (0 <= imm1 < 1024, imm1 >> 4, 0 <= imm2 < 16)
+	4, MatXSP, MatXSP, MatImm, MatImm, 0x0, 0x6c, 0x7, byte(FeatMTE), 0,
 
 	3,
 	// subhn Vd.8B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x4c, 0x8e,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x6c, 0x15, 0, 0,
 	// subhn Vd.4H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x4c, 0x96,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x6c, 0x1d, 0, 0,
 	// subhn Vd.2S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x4c, 0x9e,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x6c, 0x25, 0, 0,
 
 	3,
 	// subhn2 Vd.16B, Vn.8H, Vm.8H
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x4c, 0xa6,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x6c, 0x2d, 0, 0,
 	// subhn2 Vd.8H, Vn.4S, Vm.4S
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x4c, 0xae,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x6c, 0x35, 0, 0,
 	// subhn2 Vd.4S, Vn.2D, Vm.2D
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x4c, 0xb6,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x6c, 0x3d, 0, 0,
+
+	1,
+	// subp Xd, Xn|SP, Xm|SP
+	3, MatX, MatXSP, MatXSP, 0x0, 0x6c, 0x45, byte(FeatMTE), 0,
+
+	1,
+	// subps Xd, Xn|SP, Xm|SP
+	3, MatX, MatXSP, MatXSP, 0x0, 0x6c, 0x4d, byte(FeatMTE), 0,
+
+	8,
+	// subr Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6c, 0x55, byte(FeatSVE), 0,
+	// subr Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6c, 0x60, byte(FeatSVE), 0,
+	// subr Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x6c, 0x6b, byte(FeatSVE), 0,
+	// subr Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6c, 0x76, byte(FeatSVE), 0,
+	// subr Zd.B, Zn.B, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x6c, 0x81, byte(FeatSVE), 0,
+	// subr Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ··············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6c, 0x8c, byte(FeatSVE), 0,
+	// subr Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ··············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6c, 0x9a, byte(FeatSVE), 0,
+	// subr Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ··············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6c, 0xa8, byte(FeatSVE), 0,
 
 	7,
 	// subs Wd, Wn, Wm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 32)
-	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x4c, 0xbe,
+	5, MatW, MatW, MatW, MatEnd, MatMod, SymShifts, 0x0, 0x6c, 0xb6, 0, 0,
 	// subs Xd, Xn, Xm {, LSL|LSR|ASR #imm }  ········································  (0 <= imm < 64)
-	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x4c, 0xca,
+	5, MatX, MatX, MatX, MatEnd, MatMod, SymShifts, 0x0, 0x6c, 0xc2, 0, 0,
 	// subs Wd, Wn|WSP, Wm {, LSL|UXT[BHWX]|SXT[BHWX] #imm }  ························  (0 <= imm <= 4)
-	5, MatW, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x4c, 0xd6,
+	5, MatW, MatWSP, MatW, MatEnd, MatMod, SymExtends, 0x0, 0x6c, 0xce, 0, 0,
 	// subs Xd, Xn|SP, Wm {, UXT[BHW]|SXT[BHW] #imm }  ·······························  (0 <= imm <= 4)
-	5, MatX, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x4c, 0xe3,
+	5, MatX, MatXSP, MatW, MatEnd, MatMod, SymExtendsW, 0x0, 0x6c, 0xdb, 0, 0,
 	// subs Xd, Xn|SP, Xm {, LSL|UXTX|SXTX #imm }  ···································  (0 <= imm <= 4)
-	5, MatX, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x4c, 0xf0,
+	5, MatX, MatXSP, MatX, MatEnd, MatMod, SymExtendsX, 0x0, 0x6c, 0xe8, 0, 0,
 	// subs Wd, Wn|WSP, #imm1 {, LSL #imm2 }  ····················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatW, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x4c, 0xfd,
+	5, MatW, MatWSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6c, 0xf5, 0, 0,
 	// subs Xd, Xn|SP, #imm1 {, LSL #imm2 }  ·····················  (0 <= imm1 < 4096, imm2 in [0, 12])
-	5, MatX, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x4d, 0xa,
+	5, MatX, MatXSP, MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x6d, 0x2, 0, 0,
+
+	2,
+	// sudot Vd.2S, Vn.8B, Vm.4B[i]
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStaticElement, byte(BYTE), 4, 0x0, 0x6d, 0xf, byte(FeatI8MM), 0,
+	// sudot Vd.4S, Vn.16B, Vm.4B[i]
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStaticElement, byte(BYTE), 4, 0x0, 0x6d, 0x19, byte(FeatI8MM), 0,
+
+	2,
+	// sumopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6d, 0x23, byte(FeatSME), 0,
+	// sumopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6d, 0x31, byte(FeatSMEI16I64), 0,
+
+	2,
+	// sumops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6d, 0x3f, byte(FeatSME), 0,
+	// sumops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6d, 0x4d, byte(FeatSMEI16I64), 0,
+
+	3,
+	// sunpkhi Zd.H, Zn.B
+	2, MatZ, byte(WORD), MatZ, byte(BYTE), 0x0, 0x6d, 0x5b, byte(FeatSVE), 0,
+	// sunpkhi Zd.S, Zn.H
+	2, MatZ, byte(DWORD), MatZ, byte(WORD), 0x0, 0x6d, 0x62, byte(FeatSVE), 0,
+	// sunpkhi Zd.D, Zn.S
+	2, MatZ, byte(QWORD), MatZ, byte(DWORD), 0x0, 0x6d, 0x69, byte(FeatSVE), 0,
+
+	3,
+	// sunpklo Zd.H, Zn.B
+	2, MatZ, byte(WORD), MatZ, byte(BYTE), 0x0, 0x6d, 0x70, byte(FeatSVE), 0,
+	// sunpklo Zd.S, Zn.H
+	2, MatZ, byte(DWORD), MatZ, byte(WORD), 0x0, 0x6d, 0x77, byte(FeatSVE), 0,
+	// sunpklo Zd.D, Zn.S
+	2, MatZ, byte(QWORD), MatZ, byte(DWORD), 0x0, 0x6d, 0x7e, byte(FeatSVE), 0,
 
 	8,
 	// suqadd Bd, Bn
-	2, MatB, MatB, 0x4d, 0x17,
+	2, MatB, MatB, 0x0, 0x6d, 0x85, 0, 0,
 	// suqadd Hd, Hn
-	2, MatH, MatH, 0x4d, 0x1e,
+	2, MatH, MatH, 0x0, 0x6d, 0x8c, 0, 0,
 	// suqadd Sd, Sn
-	2, MatS, MatS, 0x4d, 0x25,
+	2, MatS, MatS, 0x0, 0x6d, 0x93, 0, 0,
 	// suqadd Dd, Dn
-	2, MatD, MatD, 0x4d, 0x2c,
+	2, MatD, MatD, 0x0, 0x6d, 0x9a, 0, 0,
 	// suqadd Vd.16B, Vn.16B
 	// suqadd Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x4d, 0x33,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6d, 0xa1, 0, 0,
 	// suqadd Vd.8H, Vn.8H
 	// suqadd Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x4d, 0x3b,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x6d, 0xa9, 0, 0,
 	// suqadd Vd.4S, Vn.4S
 	// suqadd Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x4d, 0x43,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x6d, 0xb1, 0, 0,
 	// suqadd Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x4d, 0x4b,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x6d, 0xb9, 0, 0,
 
 	1,
 	// svc #imm  ··································································  (0 <= imm < 65536)
-	1, MatImm, 0x4d, 0x53,
+	1, MatImm, 0x0, 0x6d, 0xc1, 0, 0,
 
 	2,
 	// swp Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0x5b,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6d, 0xc9, byte(FeatLSE), 0,
 	// swp Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4d, 0x63,
+	3, MatX, MatX, MatRefBase, 0x0, 0x6d, 0xd1, byte(FeatLSE), 0,
 
 	2,
 	// swpa Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0x6b,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6d, 0xd9, byte(FeatLSE), 0,
 	// swpa Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4d, 0x73,
+	3, MatX, MatX, MatRefBase, 0x0, 0x6d, 0xe1, byte(FeatLSE), 0,
 
 	1,
 	// swpab Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0x7b,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6d, 0xe9, byte(FeatLSE), 0,
 
 	1,
 	// swpah Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0x83,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6d, 0xf1, byte(FeatLSE), 0,
 
 	2,
 	// swpal Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0x8b,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6d, 0xf9, byte(FeatLSE), 0,
 	// swpal Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4d, 0x93,
+	3, MatX, MatX, MatRefBase, 0x0, 0x6e, 0x1, byte(FeatLSE), 0,
 
 	1,
 	// swpalb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0x9b,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6e, 0x9, byte(FeatLSE), 0,
 
 	1,
 	// swpalh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0xa3,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6e, 0x11, byte(FeatLSE), 0,
 
 	1,
 	// swpb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0xab,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6e, 0x19, byte(FeatLSE), 0,
 
 	1,
 	// swph Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0xb3,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6e, 0x21, byte(FeatLSE), 0,
 
 	2,
 	// swpl Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0xbb,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6e, 0x29, byte(FeatLSE), 0,
 	// swpl Xd, Xn, [Xm|SP]
-	3, MatX, MatX, MatRefBase, 0x4d, 0xc3,
+	3, MatX, MatX, MatRefBase, 0x0, 0x6e, 0x31, byte(FeatLSE), 0,
 
 	1,
 	// swplb Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0xcb,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6e, 0x39, byte(FeatLSE), 0,
 
 	1,
 	// swplh Wd, Wn, [Xm|SP]
-	3, MatW, MatW, MatRefBase, 0x4d, 0xd3,
+	3, MatW, MatW, MatRefBase, 0x0, 0x6e, 0x41, byte(FeatLSE), 0,
 
 	2,
 	// sxtb Wd, Wn
-	2, MatW, MatW, 0x4d, 0xdb,
+	2, MatW, MatW, 0x0, 0x6e, 0x49, 0, 0,
 	// sxtb Xd, Wn
-	2, MatX, MatW, 0x4d, 0xe2,
+	2, MatX, MatW, 0x0, 0x6e, 0x50, 0, 0,
 
 	2,
 	// sxth Wd, Wn
-	2, MatW, MatW, 0x4d, 0xe9,
+	2, MatW, MatW, 0x0, 0x6e, 0x57, 0, 0,
 	// sxth Xd, Wn
-	2, MatX, MatW, 0x4d, 0xf0,
+	2, MatX, MatW, 0x0, 0x6e, 0x5e, 0, 0,
 
 	3,
 	// sxtl Vd.8H, Vn.8B
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x4d, 0xf7,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x6e, 0x65, 0, 0,
 	// sxtl Vd.4S, Vn.4H
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x4d, 0xfe,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x6e, 0x6c, 0, 0,
 	// sxtl Vd.2D, Vn.2S
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x4e, 0x5,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x6e, 0x73, 0, 0,
 
 	3,
 	// sxtl2 Vd.8H, Vn.16B
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x4e, 0xc,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x0, 0x6e, 0x7a, 0, 0,
 	// sxtl2 Vd.4S, Vn.8H
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x4e, 0x13,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x0, 0x6e, 0x81, 0, 0,
 	// sxtl2 Vd.2D, Vn.4S
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x4e, 0x1a,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x0, 0x6e, 0x88, 0, 0,
 
 	1,
 	// sxtw Xd, Wn
-	2, MatX, MatW, 0x4e, 0x21,
+	2, MatX, MatW, 0x0, 0x6e, 0x8f, 0, 0,
 
 	1,
 	// sys #imm1, <symbol>, <symbol>, #imm2 {, Xn }  ··················  (0 <= imm1 < 8, 0 <= imm2 < 8)
-	6, MatImm, MatSymbol, MatSymbol, MatImm, MatEnd, MatX, 0x4e, 0x28,
+	6, MatImm, MatSymbol, MatSymbol, MatImm, MatEnd, MatX, 0x0, 0x6e, 0x96, 0, 0,
 
 	1,
 	// sysl Xd, #imm1, <symbol>, <symbol>, #imm2  ·····················  (0 <= imm1 < 8, 0 <= imm2 < 8)
-	5, MatX, MatImm, MatSymbol, MatSymbol, MatImm, 0x4e, 0x3a,
+	5, MatX, MatImm, MatSymbol, MatSymbol, MatImm, 0x0, 0x6e, 0xa8, 0, 0,
 
-	4,
+	8,
 	// tbl Vd.16B, {Vn.16B * 2}, Vm.16B
 	// tbl Vd.8B, {Vn.16B * 2}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 2, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0x4c,
+	3, MatV, byte(BYTE), MatRegListStatic, 2, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6e, 0xba, 0, 0,
 	// tbl Vd.16B, {Vn.16B * 3}, Vm.16B
 	// tbl Vd.8B, {Vn.16B * 3}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 3, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0x55,
+	3, MatV, byte(BYTE), MatRegListStatic, 3, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6e, 0xc3, 0, 0,
 	// tbl Vd.16B, {Vn.16B * 4}, Vm.16B
 	// tbl Vd.8B, {Vn.16B * 4}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 4, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0x5e,
+	3, MatV, byte(BYTE), MatRegListStatic, 4, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6e, 0xcc, 0, 0,
 	// tbl Vd.16B, {Vn.16B * 1}, Vm.16B
 	// tbl Vd.8B, {Vn.16B * 1}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 1, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0x67,
+	3, MatV, byte(BYTE), MatRegListStatic, 1, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6e, 0xd5, 0, 0,
+	// tbl Zd.B, {Zn.B * 1}, Zm.B
+	3, MatZ, byte(BYTE), MatZList, 1, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6e, 0xde, byte(FeatSVE), 0,
+	// tbl Zd.H, {Zn.H * 1}, Zm.H
+	3, MatZ, byte(WORD), MatZList, 1, byte(WORD), MatZ, byte(WORD), 0x0, 0x6e, 0xe6, byte(FeatSVE), 0,
+	// tbl Zd.S, {Zn.S * 1}, Zm.S
+	3, MatZ, byte(DWORD), MatZList, 1, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x6e, 0xee, byte(FeatSVE), 0,
+	// tbl Zd.D, {Zn.D * 1}, Zm.D
+	3, MatZ, byte(QWORD), MatZList, 1, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6e, 0xf6, byte(FeatSVE), 0,
 
 	2,
 	// tbnz Wd, #imm, <offset>  ···················  (0 <= imm < 32, offset >> 2 is 14-bit (+/- 32 KB))
-	3, MatW, MatImm, MatOffset, 0x4e, 0x70,
+	3, MatW, MatImm, MatOffset, 0x0, 0x6e, 0xfe, 0, 0,
 	// tbnz Xd, #imm, <offset>  ···················  (0 <= imm < 64, offset >> 2 is 14-bit (+/- 32 KB))
-	3, MatX, MatImm, MatOffset, 0x4e, 0x7b,
+	3, MatX, MatImm, MatOffset, 0x0, 0x6f, 0x9, 0, 0,
 
 	4,
 	// tbx Vd.16B, {Vn.16B * 2}, Vm.16B
 	// tbx Vd.8B, {Vn.16B * 2}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 2, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0x8e,
+	3, MatV, byte(BYTE), MatRegListStatic, 2, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6f, 0x1c, 0, 0,
 	// tbx Vd.16B, {Vn.16B * 3}, Vm.16B
 	// tbx Vd.8B, {Vn.16B * 3}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 3, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0x97,
+	3, MatV, byte(BYTE), MatRegListStatic, 3, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6f, 0x25, 0, 0,
 	// tbx Vd.16B, {Vn.16B * 4}, Vm.16B
 	// tbx Vd.8B, {Vn.16B * 4}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 4, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0xa0,
+	3, MatV, byte(BYTE), MatRegListStatic, 4, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6f, 0x2e, 0, 0,
 	// tbx Vd.16B, {Vn.16B * 1}, Vm.16B
 	// tbx Vd.8B, {Vn.16B * 1}, Vm.8B
-	3, MatV, byte(BYTE), MatRegListStatic, 1, byte(BYTE), 16, MatV, byte(BYTE), 0x4e, 0xa9,
+	3, MatV, byte(BYTE), MatRegListStatic, 1, byte(BYTE), 16, MatV, byte(BYTE), 0x0, 0x6f, 0x37, 0, 0,
 
 	2,
 	// tbz Wd, #imm, <offset>  ····················  (0 <= imm < 32, offset >> 2 is 14-bit (+/- 32 KB))
-	3, MatW, MatImm, MatOffset, 0x4e, 0xb2,
+	3, MatW, MatImm, MatOffset, 0x0, 0x6f, 0x40, 0, 0,
 	// tbz Xd, #imm, <offset>  ····················  (0 <= imm < 64, offset >> 2 is 14-bit (+/- 32 KB))
-	3, MatX, MatImm, MatOffset, 0x4e, 0xbd,
+	3, MatX, MatImm, MatOffset, 0x0, 0x6f, 0x4b, 0, 0,
 
 	1,
 	// tlbi <symbol> {, Xn }
-	3, MatSymbol, MatEnd, MatX, 0x4e, 0xd0,
+	3, MatSymbol, MatEnd, MatX, 0x0, 0x6f, 0x5e, 0, 0,
 
-	4,
+	12,
 	// trn1 Vd.16B, Vn.16B, Vm.16B
 	// trn1 Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x4e, 0xd9,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6f, 0x67, 0, 0,
 	// trn1 Vd.8H, Vn.8H, Vm.8H
 	// trn1 Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x4e, 0xe2,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x6f, 0x70, 0, 0,
 	// trn1 Vd.4S, Vn.4S, Vm.4S
 	// trn1 Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x4e, 0xeb,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x6f, 0x79, 0, 0,
 	// trn1 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x4e, 0xf4,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x6f, 0x82, 0, 0,
+	// trn1 Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6f, 0x8b, byte(FeatSVE), 0,
+	// trn1 Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6f, 0x93, byte(FeatSVE), 0,
+	// trn1 Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x6f, 0x9b, byte(FeatSVE), 0,
+	// trn1 Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x6f, 0xa3, byte(FeatSVE), 0,
+	// trn1 Pd.B, Pn.B, Pm.B
+	3, MatP, byte(BYTE), MatP, byte(BYTE), MatP, byte(BYTE), 0x0, 0x6f, 0xab, byte(FeatSVE), 0,
+	// trn1 Pd.H, Pn.H, Pm.H
+	3, MatP, byte(WORD), MatP, byte(WORD), MatP, byte(WORD), 0x0, 0x6f, 0xb3, byte(FeatSVE), 0,
+	// trn1 Pd.S, Pn.S, Pm.S
+	3, MatP, byte(DWORD), MatP, byte(DWORD), MatP, byte(DWORD), 0x0, 0x6f, 0xbb, byte(FeatSVE), 0,
+	// trn1 Pd.D, Pn.D, Pm.D
+	3, MatP, byte(QWORD), MatP, byte(QWORD), MatP, byte(QWORD), 0x0, 0x6f, 0xc3, byte(FeatSVE), 0,
 
-	4,
+	12,
 	// trn2 Vd.16B, Vn.16B, Vm.16B
 	// trn2 Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x4e, 0xfd,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x6f, 0xcb, 0, 0,
 	// trn2 Vd.8H, Vn.8H, Vm.8H
 	// trn2 Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x4f, 0x6,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x6f, 0xd4, 0, 0,
 	// trn2 Vd.4S, Vn.4S, Vm.4S
 	// trn2 Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x4f, 0xf,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x6f, 0xdd, 0, 0,
 	// trn2 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x4f, 0x18,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x6f, 0xe6, 0, 0,
+	// trn2 Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x6f, 0xef, byte(FeatSVE), 0,
+	// trn2 Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x6f, 0xf7, byte(FeatSVE), 0,
+	// trn2 Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x6f, 0xff, byte(FeatSVE), 0,
+	// trn2 Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x70, 0x7, byte(FeatSVE), 0,
+	// trn2 Pd.B, Pn.B, Pm.B
+	3, MatP, byte(BYTE), MatP, byte(BYTE), MatP, byte(BYTE), 0x0, 0x70, 0xf, byte(FeatSVE), 0,
+	// trn2 Pd.H, Pn.H, Pm.H
+	3, MatP, byte(WORD), MatP, byte(WORD), MatP, byte(WORD), 0x0, 0x70, 0x17, byte(FeatSVE), 0,
+	// trn2 Pd.S, Pn.S, Pm.S
+	3, MatP, byte(DWORD), MatP, byte(DWORD), MatP, byte(DWORD), 0x0, 0x70, 0x1f, byte(FeatSVE), 0,
+	// trn2 Pd.D, Pn.D, Pm.D
+	3, MatP, byte(QWORD), MatP, byte(QWORD), MatP, byte(QWORD), 0x0, 0x70, 0x27, byte(FeatSVE), 0,
 
 	1,
 	// tsb CSYNC
-	1, MatLitSymbol, byte(CSYNC), 0x4f, 0x21,
+	1, MatLitSymbol, byte(CSYNC), 0x0, 0x70, 0x2f, 0, 0,
 
 	4,
 	// tst Wd, #imm  ·························································  (imm is 32-bit logical)
-	2, MatW, MatImm, 0x4f, 0x26,
+	2, MatW, MatImm, 0x0, 0x70, 0x34, 0, 0,
 	// tst Xd, #imm  ·························································  (imm is 64-bit logical)
-	2, MatX, MatImm, 0x4f, 0x2f,
+	2, MatX, MatImm, 0x0, 0x70, 0x3d, 0, 0,
 	// tst Wd, Wn {, LSL|LSR|ASR|ROR #imm }  ·········································  (0 <= imm < 32)
-	4, MatW, MatW, MatEnd, MatMod, SymRotates, 0x4f, 0x38,
+	4, MatW, MatW, MatEnd, MatMod, SymRotates, 0x0, 0x70, 0x46, 0, 0,
 	// tst Xd, Xn {, LSL|LSR|ASR|ROR #imm }  ·········································  (0 <= imm < 64)
-	4, MatX, MatX, MatEnd, MatMod, SymRotates, 0x4f, 0x43,
+	4, MatX, MatX, MatEnd, MatMod, SymRotates, 0x0, 0x70, 0x51, 0, 0,
 
 	3,
 	// uaba Vd.16B, Vn.16B, Vm.16B
 	// uaba Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x4f, 0x4e,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x70, 0x5c, 0, 0,
 	// uaba Vd.8H, Vn.8H, Vm.8H
 	// uaba Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x4f, 0x57,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x70, 0x65, 0, 0,
 	// uaba Vd.4S, Vn.4S, Vm.4S
 	// uaba Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x4f, 0x60,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x70, 0x6e, 0, 0,
 
 	3,
 	// uabal Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x4f, 0x69,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x70, 0x77, 0, 0,
 	// uabal Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x4f, 0x71,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x70, 0x7f, 0, 0,
 	// uabal Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x4f, 0x79,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x70, 0x87, 0, 0,
 
 	3,
 	// uabal2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x4f, 0x81,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x70, 0x8f, 0, 0,
 	// uabal2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x4f, 0x89,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x70, 0x97, 0, 0,
 	// uabal2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x4f, 0x91,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x70, 0x9f, 0, 0,
 
-	3,
+	7,
 	// uabd Vd.16B, Vn.16B, Vm.16B
 	// uabd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x4f, 0x99,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x70, 0xa7, 0, 0,
 	// uabd Vd.8H, Vn.8H, Vm.8H
 	// uabd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x4f, 0xa2,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x70, 0xb0, 0, 0,
 	// uabd Vd.4S, Vn.4S, Vm.4S
 	// uabd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x4f, 0xab,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x70, 0xb9, 0, 0,
+	// uabd Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x70, 0xc2, byte(FeatSVE), 0,
+	// uabd Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x70, 0xcd, byte(FeatSVE), 0,
+	// uabd Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x70, 0xd8, byte(FeatSVE), 0,
+	// uabd Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x70, 0xe3, byte(FeatSVE), 0,
 
 	3,
 	// uabdl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x4f, 0xb4,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x70, 0xee, 0, 0,
 	// uabdl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x4f, 0xbc,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x70, 0xf6, 0, 0,
 	// uabdl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x4f, 0xc4,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x70, 0xfe, 0, 0,
 
 	3,
 	// uabdl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x4f, 0xcc,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x71, 0x6, 0, 0,
 	// uabdl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x4f, 0xd4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x71, 0xe, 0, 0,
 	// uabdl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x4f, 0xdc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x71, 0x16, 0, 0,
 
 	3,
 	// uadalp Vd.8H, Vn.16B
 	// uadalp Vd.4H, Vn.8B
-	2, MatV, byte(WORD), MatV, byte(BYTE), 0x4f, 0xe4,
+	2, MatV, byte(WORD), MatV, byte(BYTE), 0x0, 0x71, 0x1e, 0, 0,
 	// uadalp Vd.4S, Vn.8H
 	// uadalp Vd.2S, Vn.4H
-	2, MatV, byte(DWORD), MatV, byte(WORD), 0x4f, 0xec,
+	2, MatV, byte(DWORD), MatV, byte(WORD), 0x0, 0x71, 0x26, 0, 0,
 	// uadalp Vd.2D, Vn.4S
 	// uadalp Vd.1D, Vn.2S
-	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x4f, 0xf4,
+	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x0, 0x71, 0x2e, 0, 0,
 
 	3,
 	// uaddl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x4f, 0xfc,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x71, 0x36, 0, 0,
 	// uaddl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x50, 0x4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x71, 0x3e, 0, 0,
 	// uaddl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x50, 0xc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x71, 0x46, 0, 0,
 
 	3,
 	// uaddl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x50, 0x14,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x71, 0x4e, 0, 0,
 	// uaddl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x50, 0x1c,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x71, 0x56, 0, 0,
 	// uaddl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x50, 0x24,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x71, 0x5e, 0, 0,
 
 	3,
 	// uaddlp Vd.8H, Vn.16B
 	// uaddlp Vd.4H, Vn.8B
-	2, MatV, byte(WORD), MatV, byte(BYTE), 0x50, 0x2c,
+	2, MatV, byte(WORD), MatV, byte(BYTE), 0x0, 0x71, 0x66, 0, 0,
 	// uaddlp Vd.4S, Vn.8H
 	// uaddlp Vd.2S, Vn.4H
-	2, MatV, byte(DWORD), MatV, byte(WORD), 0x50, 0x34,
+	2, MatV, byte(DWORD), MatV, byte(WORD), 0x0, 0x71, 0x6e, 0, 0,
 	// uaddlp Vd.2D, Vn.4S
 	// uaddlp Vd.1D, Vn.2S
-	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x50, 0x3c,
+	2, MatV, byte(QWORD), MatV, byte(DWORD), 0x0, 0x71, 0x76, 0, 0,
 
 	3,
 	// uaddlv Hd, Vn.16B
 	// uaddlv Hd, Vn.8B
-	2, MatH, MatV, byte(BYTE), 0x50, 0x44,
+	2, MatH, MatV, byte(BYTE), 0x0, 0x71, 0x7e, 0, 0,
 	// uaddlv Sd, Vn.8H
 	// uaddlv Sd, Vn.4H
-	2, MatS, MatV, byte(WORD), 0x50, 0x4c,
+	2, MatS, MatV, byte(WORD), 0x0, 0x71, 0x86, 0, 0,
 	// uaddlv Dd, Vn.4S
-	2, MatD, MatVStatic, byte(DWORD), 4, 0x50, 0x54,
+	2, MatD, MatVStatic, byte(DWORD), 4, 0x0, 0x71, 0x8e, 0, 0,
+
+	4,
+	// uaddv Dd, Pg, Zn.B  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(BYTE), 0x0, 0x71, 0x96, byte(FeatSVE), 0,
+	// uaddv Dd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(WORD), 0x0, 0x71, 0x9f, byte(FeatSVE), 0,
+	// uaddv Dd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(DWORD), 0x0, 0x71, 0xa8, byte(FeatSVE), 0,
+	// uaddv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x71, 0xb1, byte(FeatSVE), 0,
 
 	3,
 	// uaddw Vd.8H, Vn.8H, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x50, 0x5c,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x71, 0xba, 0, 0,
 	// uaddw Vd.4S, Vn.4S, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x50, 0x64,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x71, 0xc2, 0, 0,
 	// uaddw Vd.2D, Vn.2D, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x50, 0x6c,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x71, 0xca, 0, 0,
 
 	3,
 	// uaddw2 Vd.8H, Vn.8H, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x50, 0x74,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x0, 0x71, 0xd2, 0, 0,
 	// uaddw2 Vd.4S, Vn.4S, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x50, 0x7c,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x0, 0x71, 0xda, 0, 0,
 	// uaddw2 Vd.2D, Vn.2D, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x50, 0x84,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x0, 0x71, 0xe2, 0, 0,
 
 	2,
 	// ubfiz Wd, Wn, #imm1, #imm2  ···············  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
-	4, MatW, MatW, MatImm, MatImm, 0x50, 0x8c,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x71, 0xea, 0, 0,
 	// ubfiz Xd, Xn, #imm1, #imm2  ···············  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x50, 0x9c,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x71, 0xfa, 0, 0,
 
 	2,
 	// ubfm Wd, Wn, #imm1, #imm2  ···································  (0 <= imm1 < 32, 0 <= imm2 < 32)
-	4, MatW, MatW, MatImm, MatImm, 0x50, 0xac,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x72, 0xa, 0, 0,
 	// ubfm Xd, Xn, #imm1, #imm2  ·················  (0 <= imm1 < 64, 0 < imm2 < 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x50, 0xb9,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x72, 0x17, 0, 0,
 
 	2,
 	// ubfx Wd, Wn, #imm1, #imm2  ················  (0 <= imm1 < 32, 0 < imm2 <= 32, imm1 + imm2 <= 32)
-	4, MatW, MatW, MatImm, MatImm, 0x50, 0xc8,
+	4, MatW, MatW, MatImm, MatImm, 0x0, 0x72, 0x26, 0, 0,
 	// ubfx Xd, Xn, #imm1, #imm2  ················  (0 <= imm1 < 64, 0 < imm2 <= 64, imm1 + imm2 <= 64)
-	4, MatX, MatX, MatImm, MatImm, 0x50, 0xd7,
+	4, MatX, MatX, MatImm, MatImm, 0x0, 0x72, 0x35, 0, 0,
 
-	24,
+	27,
 	// ucvtf Hd, Hn, #imm  ···························································  (0 < imm <= 16)
-	3, MatH, MatH, MatImm, 0x50, 0xe6,
+	3, MatH, MatH, MatImm, 0x0, 0x72, 0x44, byte(FeatFP16), 0,
 	// ucvtf Sd, Sn, #imm  ···························································  (0 < imm <= 32)
-	3, MatS, MatS, MatImm, 0x50, 0xf3,
+	3, MatS, MatS, MatImm, 0x0, 0x72, 0x51, 0, 0,
 	// ucvtf Dd, Dn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x51, 0x0,
+	3, MatD, MatD, MatImm, 0x0, 0x72, 0x5e, 0, 0,
 	// ucvtf Vd.8H, Vn.8H, #imm  ·····················································  (0 < imm <= 16)
 	// ucvtf Vd.4H, Vn.4H, #imm  ·····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x51, 0xd,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x72, 0x6b, byte(FeatFP16), 0,
 	// ucvtf Vd.4S, Vn.4S, #imm  ·····················································  (0 < imm <= 32)
 	// ucvtf Vd.2S, Vn.2S, #imm  ·····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x51, 0x19,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x72, 0x77, 0, 0,
 	// ucvtf Vd.2D, Vn.2D, #imm  ·····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x51, 0x25,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x72, 0x83, 0, 0,
 	// ucvtf Hd, Hn
-	2, MatH, MatH, 0x51, 0x31,
+	2, MatH, MatH, 0x0, 0x72, 0x8f, byte(FeatFP16), 0,
 	// ucvtf Sd, Sn
-	2, MatS, MatS, 0x51, 0x38,
+	2, MatS, MatS, 0x0, 0x72, 0x96, 0, 0,
 	// ucvtf Dd, Dn
-	2, MatD, MatD, 0x51, 0x3f,
+	2, MatD, MatD, 0x0, 0x72, 0x9d, 0, 0,
 	// ucvtf Vd.8H, Vn.8H
 	// ucvtf Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x51, 0x46,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x72, 0xa4, byte(FeatFP16), 0,
 	// ucvtf Vd.4S, Vn.4S
 	// ucvtf Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x51, 0x4e,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x72, 0xac, 0, 0,
 	// ucvtf Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x51, 0x56,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x72, 0xb4, 0, 0,
 	// ucvtf Hd, Wn, #imm  ···························································  (0 < imm <= 32)
-	3, MatH, MatW, MatImm, 0x51, 0x5e,
+	3, MatH, MatW, MatImm, 0x0, 0x72, 0xbc, byte(FeatFP16), 0,
 	// ucvtf Sd, Wn, #imm  ···························································  (0 < imm <= 32)
-	3, MatS, MatW, MatImm, 0x51, 0x6b,
+	3, MatS, MatW, MatImm, 0x0, 0x72, 0xc9, 0, 0,
 	// ucvtf Dd, Wn, #imm  ···························································  (0 < imm <= 32)
-	3, MatD, MatW, MatImm, 0x51, 0x78,
+	3, MatD, MatW, MatImm, 0x0, 0x72, 0xd6, 0, 0,
 	// ucvtf Hd, Xn, #imm  ···························································  (0 < imm <= 64)
-	3, MatH, MatX, MatImm, 0x51, 0x85,
+	3, MatH, MatX, MatImm, 0x0, 0x72, 0xe3, byte(FeatFP16), 0,
 	// ucvtf Sd, Xn, #imm  ···························································  (0 < imm <= 64)
-	3, MatS, MatX, MatImm, 0x51, 0x90,
+	3, MatS, MatX, MatImm, 0x0, 0x72, 0xee, 0, 0,
 	// ucvtf Dd, Xn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatX, MatImm, 0x51, 0x9b,
+	3, MatD, MatX, MatImm, 0x0, 0x72, 0xf9, 0, 0,
 	// ucvtf Hd, Wn
-	2, MatH, MatW, 0x51, 0xa6,
+	2, MatH, MatW, 0x0, 0x73, 0x4, byte(FeatFP16), 0,
 	// ucvtf Sd, Wn
-	2, MatS, MatW, 0x51, 0xad,
+	2, MatS, MatW, 0x0, 0x73, 0xb, 0, 0,
 	// ucvtf Dd, Wn
-	2, MatD, MatW, 0x51, 0xb4,
+	2, MatD, MatW, 0x0, 0x73, 0x12, 0, 0,
 	// ucvtf Hd, Xn
-	2, MatH, MatX, 0x51, 0xbb,
+	2, MatH, MatX, 0x0, 0x73, 0x19, byte(FeatFP16), 0,
 	// ucvtf Sd, Xn
-	2, MatS, MatX, 0x51, 0xc2,
+	2, MatS, MatX, 0x0, 0x73, 0x20, 0, 0,
 	// ucvtf Dd, Xn
-	2, MatD, MatX, 0x51, 0xc9,
+	2, MatD, MatX, 0x0, 0x73, 0x27, 0, 0,
+	// ucvtf Zd.H, Pg/M, Zn.H  ·······························································  (g < 8)
+	3, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), 0x0, 0x73, 0x2e, byte(FeatSVE), 0,
+	// ucvtf Zd.S, Pg/M, Zn.S  ·······························································  (g < 8)
+	3, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), 0x0, 0x73, 0x37, byte(FeatSVE), 0,
+	// ucvtf Zd.D, Pg/M, Zn.D  ·······························································  (g < 8)
+	3, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), 0x0, 0x73, 0x40, byte(FeatSVE), 0,
 
 	1,
 	// udf #imm  ··································································  (0 <= imm < 65536)
-	1, MatImm, 0x51, 0xd0,
+	1, MatImm, 0x0, 0x73, 0x49, 0, 0,
 
-	2,
+	4,
 	// udiv Wd, Wn, Wm
-	3, MatW, MatW, MatW, 0x51, 0xd8,
+	3, MatW, MatW, MatW, 0x0, 0x73, 0x51, 0, 0,
 	// udiv Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x51, 0xe0,
+	3, MatX, MatX, MatX, 0x0, 0x73, 0x59, 0, 0,
+	// udiv Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x73, 0x61, byte(FeatSVE), 0,
+	// udiv Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x73, 0x6c, byte(FeatSVE), 0,
+
+	2,
+	// udivr Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x73, 0x77, byte(FeatSVE), 0,
+	// udivr Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x73, 0x82, byte(FeatSVE), 0,
 
 	4,
 	// udot Vd.2S, Vn.8B, Vm.4B[i]
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStaticElement, byte(BYTE), 4, 0x51, 0xe8,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStaticElement, byte(BYTE), 4, 0x0, 0x73, 0x8d, byte(FeatDotProd), 0,
 	// udot Vd.4S, Vn.16B, Vm.4B[i]
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStaticElement, byte(BYTE), 4, 0x51, 0xf2,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStaticElement, byte(BYTE), 4, 0x0, 0x73, 0x97, byte(FeatDotProd), 0,
 	// udot Vd.2S, Vn.8B, Vm.8B
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x51, 0xfc,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x73, 0xa1, byte(FeatDotProd), 0,
 	// udot Vd.4S, Vn.16B, Vm.16B
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x52, 0x4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x73, 0xa9, byte(FeatDotProd), 0,
 
 	3,
 	// uhadd Vd.16B, Vn.16B, Vm.16B
 	// uhadd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x52, 0xc,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x73, 0xb1, 0, 0,
 	// uhadd Vd.8H, Vn.8H, Vm.8H
 	// uhadd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x52, 0x15,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x73, 0xba, 0, 0,
 	// uhadd Vd.4S, Vn.4S, Vm.4S
 	// uhadd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x52, 0x1e,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x73, 0xc3, 0, 0,
 
 	3,
 	// uhsub Vd.16B, Vn.16B, Vm.16B
 	// uhsub Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x52, 0x27,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x73, 0xcc, 0, 0,
 	// uhsub Vd.8H, Vn.8H, Vm.8H
 	// uhsub Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x52, 0x30,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x73, 0xd5, 0, 0,
 	// uhsub Vd.4S, Vn.4S, Vm.4S
 	// uhsub Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x52, 0x39,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x73, 0xde, 0, 0,
 
 	1,
 	// umaddl Xd, Wn, Wm, Xa
-	4, MatX, MatW, MatW, MatX, 0x52, 0x42,
+	4, MatX, MatW, MatW, MatX, 0x0, 0x73, 0xe7, 0, 0,
 
-	3,
+	15,
 	// umax Vd.16B, Vn.16B, Vm.16B
 	// umax Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x52, 0x4b,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x73, 0xf0, 0, 0,
 	// umax Vd.8H, Vn.8H, Vm.8H
 	// umax Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x52, 0x54,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x73, 0xf9, 0, 0,
 	// umax Vd.4S, Vn.4S, Vm.4S
 	// umax Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x52, 0x5d,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x74, 0x2, 0, 0,
+	// umax Wd, Wn, #imm  ···························································  (0 <= imm < 256)
+	3, MatW, MatW, MatImm, 0x0, 0x74, 0xb, byte(FeatCSSC), 0,
+	// umax Xd, Xn, #imm  ···························································  (0 <= imm < 256)
+	3, MatX, MatX, MatImm, 0x0, 0x74, 0x15, byte(FeatCSSC), 0,
+	// umax Wd, Wn, Wm
+	3, MatW, MatW, MatW, 0x0, 0x74, 0x1f, byte(FeatCSSC), 0,
+	// umax Xd, Xn, Xm
+	3, MatX, MatX, MatX, 0x0, 0x74, 0x27, byte(FeatCSSC), 0,
+	// umax Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x74, 0x2f, byte(FeatSVE), 0,
+	// umax Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x74, 0x3a, byte(FeatSVE), 0,
+	// umax Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x74, 0x45, byte(FeatSVE), 0,
+	// umax Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x74, 0x50, byte(FeatSVE), 0,
+	// umax Zd.B, Zn.B, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x74, 0x5b, byte(FeatSVE), 0,
+	// umax Zd.H, Zn.H, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x74, 0x66, byte(FeatSVE), 0,
+	// umax Zd.S, Zn.S, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x74, 0x71, byte(FeatSVE), 0,
+	// umax Zd.D, Zn.D, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x74, 0x7c, byte(FeatSVE), 0,
 
 	3,
 	// umaxp Vd.16B, Vn.16B, Vm.16B
 	// umaxp Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x52, 0x66,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x74, 0x87, 0, 0,
 	// umaxp Vd.8H, Vn.8H, Vm.8H
 	// umaxp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x52, 0x6f,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x74, 0x90, 0, 0,
 	// umaxp Vd.4S, Vn.4S, Vm.4S
 	// umaxp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x52, 0x78,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x74, 0x99, 0, 0,
 
-	3,
+	7,
 	// umaxv Bd, Vn.16B
 	// umaxv Bd, Vn.8B
-	2, MatB, MatV, byte(BYTE), 0x52, 0x81,
+	2, MatB, MatV, byte(BYTE), 0x0, 0x74, 0xa2, 0, 0,
 	// umaxv Hd, Vn.8H
 	// umaxv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x52, 0x89,
+	2, MatH, MatV, byte(WORD), 0x0, 0x74, 0xaa, 0, 0,
 	// umaxv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x52, 0x91,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x74, 0xb2, 0, 0,
+	// umaxv Bd, Pg, Zn.B  ···································································  (g < 8)
+	3, MatB, MatP, 0, MatZ, byte(BYTE), 0x0, 0x74, 0xba, byte(FeatSVE), 0,
+	// umaxv Hd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x74, 0xc3, byte(FeatSVE), 0,
+	// umaxv Sd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x74, 0xcc, byte(FeatSVE), 0,
+	// umaxv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x74, 0xd5, byte(FeatSVE), 0,
 
-	3,
+	15,
 	// umin Vd.16B, Vn.16B, Vm.16B
 	// umin Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x52, 0x99,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x74, 0xde, 0, 0,
 	// umin Vd.8H, Vn.8H, Vm.8H
 	// umin Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x52, 0xa2,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x74, 0xe7, 0, 0,
 	// umin Vd.4S, Vn.4S, Vm.4S
 	// umin Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x52, 0xab,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x74, 0xf0, 0, 0,
+	// umin Wd, Wn, #imm  ···························································  (0 <= imm < 256)
+	3, MatW, MatW, MatImm, 0x0, 0x74, 0xf9, byte(FeatCSSC), 0,
+	// umin Xd, Xn, #imm  ···························································  (0 <= imm < 256)
+	3, MatX, MatX, MatImm, 0x0, 0x75, 0x3, byte(FeatCSSC), 0,
+	// umin Wd, Wn, Wm
+	3, MatW, MatW, MatW, 0x0, 0x75, 0xd, byte(FeatCSSC), 0,
+	// umin Xd, Xn, Xm
+	3, MatX, MatX, MatX, 0x0, 0x75, 0x15, byte(FeatCSSC), 0,
+	// umin Zd.B, Pg/M, Zn.B, Zm.B  ··················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x75, 0x1d, byte(FeatSVE), 0,
+	// umin Zd.H, Pg/M, Zn.H, Zm.H  ··················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x75, 0x28, byte(FeatSVE), 0,
+	// umin Zd.S, Pg/M, Zn.S, Zm.S  ··················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x75, 0x33, byte(FeatSVE), 0,
+	// umin Zd.D, Pg/M, Zn.D, Zm.D  ··················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x75, 0x3e, byte(FeatSVE), 0,
+	// umin Zd.B, Zn.B, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x75, 0x49, byte(FeatSVE), 0,
+	// umin Zd.H, Zn.H, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, 0x0, 0x75, 0x54, byte(FeatSVE), 0,
+	// umin Zd.S, Zn.S, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, 0x0, 0x75, 0x5f, byte(FeatSVE), 0,
+	// umin Zd.D, Zn.D, #imm  ···············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, 0x0, 0x75, 0x6a, byte(FeatSVE), 0,
 
 	3,
 	// uminp Vd.16B, Vn.16B, Vm.16B
 	// uminp Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x52, 0xb4,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x75, 0x75, 0, 0,
 	// uminp Vd.8H, Vn.8H, Vm.8H
 	// uminp Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x52, 0xbd,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x75, 0x7e, 0, 0,
 	// uminp Vd.4S, Vn.4S, Vm.4S
 	// uminp Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x52, 0xc6,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x75, 0x87, 0, 0,
 
-	3,
+	7,
 	// uminv Bd, Vn.16B
 	// uminv Bd, Vn.8B
-	2, MatB, MatV, byte(BYTE), 0x52, 0xcf,
+	2, MatB, MatV, byte(BYTE), 0x0, 0x75, 0x90, 0, 0,
 	// uminv Hd, Vn.8H
 	// uminv Hd, Vn.4H
-	2, MatH, MatV, byte(WORD), 0x52, 0xd7,
+	2, MatH, MatV, byte(WORD), 0x0, 0x75, 0x98, 0, 0,
 	// uminv Sd, Vn.4S
-	2, MatS, MatVStatic, byte(DWORD), 4, 0x52, 0xdf,
+	2, MatS, MatVStatic, byte(DWORD), 4, 0x0, 0x75, 0xa0, 0, 0,
+	// uminv Bd, Pg, Zn.B  ···································································  (g < 8)
+	3, MatB, MatP, 0, MatZ, byte(BYTE), 0x0, 0x75, 0xa8, byte(FeatSVE), 0,
+	// uminv Hd, Pg, Zn.H  ···································································  (g < 8)
+	3, MatH, MatP, 0, MatZ, byte(WORD), 0x0, 0x75, 0xb1, byte(FeatSVE), 0,
+	// uminv Sd, Pg, Zn.S  ···································································  (g < 8)
+	3, MatS, MatP, 0, MatZ, byte(DWORD), 0x0, 0x75, 0xba, byte(FeatSVE), 0,
+	// uminv Dd, Pg, Zn.D  ···································································  (g < 8)
+	3, MatD, MatP, 0, MatZ, byte(QWORD), 0x0, 0x75, 0xc3, byte(FeatSVE), 0,
 
 	5,
 	// umlal Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x52, 0xe7,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x75, 0xcc, 0, 0,
 	// umlal Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x52, 0xf1,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x75, 0xd6, 0, 0,
 	// umlal Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x52, 0xfb,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x75, 0xe0, 0, 0,
 	// umlal Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x53, 0x3,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x75, 0xe8, 0, 0,
 	// umlal Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x53, 0xb,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x75, 0xf0, 0, 0,
 
 	5,
 	// umlal2 Vd.4S, Vn.8H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x53, 0x13,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x75, 0xf8, 0, 0,
 	// umlal2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x53, 0x1d,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x76, 0x2, 0, 0,
 	// umlal2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x53, 0x27,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x76, 0xc, 0, 0,
 	// umlal2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x53, 0x2f,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x76, 0x14, 0, 0,
 	// umlal2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x53, 0x37,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x76, 0x1c, 0, 0,
 
 	5,
 	// umlsl Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x53, 0x3f,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x76, 0x24, 0, 0,
 	// umlsl Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x53, 0x49,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x76, 0x2e, 0, 0,
 	// umlsl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x53, 0x53,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x76, 0x38, 0, 0,
 	// umlsl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x53, 0x5b,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x76, 0x40, 0, 0,
 	// umlsl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x53, 0x63,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x76, 0x48, 0, 0,
 
 	5,
 	// umlsl2 Vd.4S, Vn.8H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x53, 0x6b,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x76, 0x50, 0, 0,
 	// umlsl2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x53, 0x75,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x76, 0x5a, 0, 0,
 	// umlsl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x53, 0x7f,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x76, 0x64, 0, 0,
 	// umlsl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x53, 0x87,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x76, 0x6c, 0, 0,
 	// umlsl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x53, 0x8f,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x76, 0x74, 0, 0,
+
+	1,
+	// ummla Vd.4S, Vn.16B, Vm.16B
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x76, 0x7c, byte(FeatI8MM), 0,
 
 	1,
 	// umnegl Xd, Wn, Wm
-	3, MatX, MatW, MatW, 0x53, 0x97,
+	3, MatX, MatW, MatW, 0x0, 0x76, 0x84, 0, 0,
+
+	2,
+	// umopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x76, 0x8c, byte(FeatSME), 0,
+	// umopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x76, 0x9a, byte(FeatSMEI16I64), 0,
+
+	2,
+	// umops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ································  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x76, 0xa8, byte(FeatSME), 0,
+	// umops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ································  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x76, 0xb6, byte(FeatSMEI16I64), 0,
 
 	4,
 	// umov Wd, Vn.B[i]
-	2, MatW, MatVElement, byte(BYTE), 0x53, 0x9f,
+	2, MatW, MatVElement, byte(BYTE), 0x0, 0x76, 0xc4, 0, 0,
 	// umov Wd, Vn.H[i]
-	2, MatW, MatVElement, byte(WORD), 0x53, 0xa9,
+	2, MatW, MatVElement, byte(WORD), 0x0, 0x76, 0xce, 0, 0,
 	// umov Wd, Vn.S[i]
-	2, MatW, MatVElement, byte(DWORD), 0x53, 0xb3,
+	2, MatW, MatVElement, byte(DWORD), 0x0, 0x76, 0xd8, 0, 0,
 	// umov Xd, Vn.D[i]
-	2, MatX, MatVElement, byte(QWORD), 0x53, 0xbd,
+	2, MatX, MatVElement, byte(QWORD), 0x0, 0x76, 0xe2, 0, 0,
 
 	1,
 	// umsubl Xd, Wn, Wm, Xa
-	4, MatX, MatW, MatW, MatX, 0x53, 0xc7,
+	4, MatX, MatW, MatW, MatX, 0x0, 0x76, 0xec, 0, 0,
 
-	1,
+	9,
 	// umulh Xd, Xn, Xm
-	3, MatX, MatX, MatX, 0x53, 0xd0,
+	3, MatX, MatX, MatX, 0x0, 0x76, 0xf5, 0, 0,
+	// umulh Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x76, 0xfd, byte(FeatSVE2), 0,
+	// umulh Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x77, 0x5, byte(FeatSVE2), 0,
+	// umulh Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x77, 0xd, byte(FeatSVE2), 0,
+	// umulh Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x77, 0x15, byte(FeatSVE2), 0,
+	// umulh Zd.B, Pg/M, Zn.B, Zm.B  ·················································  (g < 8, n == d)
+	4, MatZ, byte(BYTE), MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x77, 0x1d, byte(FeatSVE), 0,
+	// umulh Zd.H, Pg/M, Zn.H, Zm.H  ·················································  (g < 8, n == d)
+	4, MatZ, byte(WORD), MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x77, 0x28, byte(FeatSVE), 0,
+	// umulh Zd.S, Pg/M, Zn.S, Zm.S  ·················································  (g < 8, n == d)
+	4, MatZ, byte(DWORD), MatPM, MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x77, 0x33, byte(FeatSVE), 0,
+	// umulh Zd.D, Pg/M, Zn.D, Zm.D  ·················································  (g < 8, n == d)
+	4, MatZ, byte(QWORD), MatPM, MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x77, 0x3e, byte(FeatSVE), 0,
 
 	6,
 	// umull Vd.4S, Vn.4H, Vm.H[i]  ·························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x53, 0xd8,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVElement, byte(WORD), 0x0, 0x77, 0x49, 0, 0,
 	// umull Vd.2D, Vn.2S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x53, 0xe2,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVElement, byte(DWORD), 0x0, 0x77, 0x53, 0, 0,
 	// umull Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x53, 0xec,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x77, 0x5d, 0, 0,
 	// umull Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x53, 0xf4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x77, 0x65, 0, 0,
 	// umull Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x53, 0xfc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x77, 0x6d, 0, 0,
 	// umull Xd, Wn, Wm
-	3, MatX, MatW, MatW, 0x54, 0x4,
+	3, MatX, MatW, MatW, 0x0, 0x77, 0x75, 0, 0,
 
 	5,
 	// umull2 Vd.4S, Vn.8H, Vm.H[i]  ························································  (m < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x54, 0xc,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVElement, byte(WORD), 0x0, 0x77, 0x7d, 0, 0,
 	// umull2 Vd.2D, Vn.4S, Vm.S[i]
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x54, 0x16,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVElement, byte(DWORD), 0x0, 0x77, 0x87, 0, 0,
 	// umull2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x54, 0x20,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x77, 0x91, 0, 0,
 	// umull2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x54, 0x28,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x77, 0x99, 0, 0,
 	// umull2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x54, 0x30,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x77, 0xa1, 0, 0,
 
-	8,
+	16,
 	// uqadd Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x54, 0x38,
+	3, MatB, MatB, MatB, 0x0, 0x77, 0xa9, 0, 0,
 	// uqadd Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x54, 0x40,
+	3, MatH, MatH, MatH, 0x0, 0x77, 0xb1, 0, 0,
 	// uqadd Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x54, 0x48,
+	3, MatS, MatS, MatS, 0x0, 0x77, 0xb9, 0, 0,
 	// uqadd Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x54, 0x50,
+	3, MatD, MatD, MatD, 0x0, 0x77, 0xc1, 0, 0,
 	// uqadd Vd.16B, Vn.16B, Vm.16B
 	// uqadd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x54, 0x58,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x77, 0xc9, 0, 0,
 	// uqadd Vd.8H, Vn.8H, Vm.8H
 	// uqadd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x54, 0x61,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x77, 0xd2, 0, 0,
 	// uqadd Vd.4S, Vn.4S, Vm.4S
 	// uqadd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x54, 0x6a,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x77, 0xdb, 0, 0,
 	// uqadd Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x54, 0x73,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x77, 0xe4, 0, 0,
+	// uqadd Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x77, 0xed, byte(FeatSVE), 0,
+	// uqadd Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x77, 0xf5, byte(FeatSVE), 0,
+	// uqadd Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x77, 0xfd, byte(FeatSVE), 0,
+	// uqadd Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x78, 0x5, byte(FeatSVE), 0,
+	// uqadd Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x78, 0xd, byte(FeatSVE), 0,
+	// uqadd Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x78, 0x18, byte(FeatSVE), 0,
+	// uqadd Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x78, 0x26, byte(FeatSVE), 0,
+	// uqadd Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x78, 0x34, byte(FeatSVE), 0,
 
 	8,
 	// uqrshl Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x54, 0x7c,
+	3, MatB, MatB, MatB, 0x0, 0x78, 0x42, 0, 0,
 	// uqrshl Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x54, 0x84,
+	3, MatH, MatH, MatH, 0x0, 0x78, 0x4a, 0, 0,
 	// uqrshl Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x54, 0x8c,
+	3, MatS, MatS, MatS, 0x0, 0x78, 0x52, 0, 0,
 	// uqrshl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x54, 0x94,
+	3, MatD, MatD, MatD, 0x0, 0x78, 0x5a, 0, 0,
 	// uqrshl Vd.16B, Vn.16B, Vm.16B
 	// uqrshl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x54, 0x9c,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x78, 0x62, 0, 0,
 	// uqrshl Vd.8H, Vn.8H, Vm.8H
 	// uqrshl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x54, 0xa5,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x78, 0x6b, 0, 0,
 	// uqrshl Vd.4S, Vn.4S, Vm.4S
 	// uqrshl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x54, 0xae,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x78, 0x74, 0, 0,
 	// uqrshl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x54, 0xb7,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x78, 0x7d, 0, 0,
 
 	6,
 	// uqrshrn Bd, Hn, #imm  ··························································  (0 < imm <= 8)
-	3, MatB, MatH, MatImm, 0x54, 0xc0,
+	3, MatB, MatH, MatImm, 0x0, 0x78, 0x86, 0, 0,
 	// uqrshrn Hd, Sn, #imm  ·························································  (0 < imm <= 16)
-	3, MatH, MatS, MatImm, 0x54, 0xcd,
+	3, MatH, MatS, MatImm, 0x0, 0x78, 0x93, 0, 0,
 	// uqrshrn Sd, Dn, #imm  ·························································  (0 < imm <= 32)
-	3, MatS, MatD, MatImm, 0x54, 0xda,
+	3, MatS, MatD, MatImm, 0x0, 0x78, 0xa0, 0, 0,
 	// uqrshrn Vd.8B, Vn.8H, #imm  ····················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x54, 0xe7,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x78, 0xad, 0, 0,
 	// uqrshrn Vd.4H, Vn.4S, #imm  ···················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x54, 0xf2,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x78, 0xb8, 0, 0,
 	// uqrshrn Vd.2S, Vn.2D, #imm  ···················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x54, 0xfd,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x78, 0xc3, 0, 0,
 
 	3,
 	// uqrshrn2 Vd.16B, Vn.8H, #imm  ··················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x55, 0x8,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x78, 0xce, 0, 0,
 	// uqrshrn2 Vd.8H, Vn.4S, #imm  ··················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x55, 0x13,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x78, 0xd9, 0, 0,
 	// uqrshrn2 Vd.4S, Vn.2D, #imm  ··················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x55, 0x1e,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x78, 0xe4, 0, 0,
 
 	16,
 	// uqshl Bd, Bn, #imm  ····························································  (0 <= imm < 8)
-	3, MatB, MatB, MatImm, 0x55, 0x29,
+	3, MatB, MatB, MatImm, 0x0, 0x78, 0xef, 0, 0,
 	// uqshl Hd, Hn, #imm  ···························································  (0 <= imm < 16)
-	3, MatH, MatH, MatImm, 0x55, 0x33,
+	3, MatH, MatH, MatImm, 0x0, 0x78, 0xf9, 0, 0,
 	// uqshl Sd, Sn, #imm  ···························································  (0 <= imm < 32)
-	3, MatS, MatS, MatImm, 0x55, 0x3d,
+	3, MatS, MatS, MatImm, 0x0, 0x79, 0x3, 0, 0,
 	// uqshl Dd, Dn, #imm  ···························································  (0 <= imm < 64)
-	3, MatD, MatD, MatImm, 0x55, 0x47,
+	3, MatD, MatD, MatImm, 0x0, 0x79, 0xd, 0, 0,
 	// uqshl Vd.16B, Vn.16B, #imm  ····················································  (0 <= imm < 8)
 	// uqshl Vd.8B, Vn.8B, #imm  ······················································  (0 <= imm < 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x55, 0x51,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x79, 0x17, 0, 0,
 	// uqshl Vd.8H, Vn.8H, #imm  ·····················································  (0 <= imm < 16)
 	// uqshl Vd.4H, Vn.4H, #imm  ·····················································  (0 <= imm < 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x55, 0x5c,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x79, 0x22, 0, 0,
 	// uqshl Vd.4S, Vn.4S, #imm  ·····················································  (0 <= imm < 32)
 	// uqshl Vd.2S, Vn.2S, #imm  ·····················································  (0 <= imm < 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x55, 0x67,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x79, 0x2d, 0, 0,
 	// uqshl Vd.2D, Vn.2D, #imm  ·····················································  (0 <= imm < 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x55, 0x72,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x79, 0x38, 0, 0,
 	// uqshl Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x55, 0x7d,
+	3, MatB, MatB, MatB, 0x0, 0x79, 0x43, 0, 0,
 	// uqshl Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x55, 0x85,
+	3, MatH, MatH, MatH, 0x0, 0x79, 0x4b, 0, 0,
 	// uqshl Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x55, 0x8d,
+	3, MatS, MatS, MatS, 0x0, 0x79, 0x53, 0, 0,
 	// uqshl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x55, 0x95,
+	3, MatD, MatD, MatD, 0x0, 0x79, 0x5b, 0, 0,
 	// uqshl Vd.16B, Vn.16B, Vm.16B
 	// uqshl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x55, 0x9d,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x79, 0x63, 0, 0,
 	// uqshl Vd.8H, Vn.8H, Vm.8H
 	// uqshl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x55, 0xa6,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x79, 0x6c, 0, 0,
 	// uqshl Vd.4S, Vn.4S, Vm.4S
 	// uqshl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x55, 0xaf,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x79, 0x75, 0, 0,
 	// uqshl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x55, 0xb8,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x79, 0x7e, 0, 0,
 
 	6,
 	// uqshrn Bd, Hn, #imm  ···························································  (0 < imm <= 8)
-	3, MatB, MatH, MatImm, 0x55, 0xc1,
+	3, MatB, MatH, MatImm, 0x0, 0x79, 0x87, 0, 0,
 	// uqshrn Hd, Sn, #imm  ··························································  (0 < imm <= 16)
-	3, MatH, MatS, MatImm, 0x55, 0xce,
+	3, MatH, MatS, MatImm, 0x0, 0x79, 0x94, 0, 0,
 	// uqshrn Sd, Dn, #imm  ··························································  (0 < imm <= 32)
-	3, MatS, MatD, MatImm, 0x55, 0xdb,
+	3, MatS, MatD, MatImm, 0x0, 0x79, 0xa1, 0, 0,
 	// uqshrn Vd.8B, Vn.8H, #imm  ·····················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x55, 0xe8,
+	3, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x79, 0xae, 0, 0,
 	// uqshrn Vd.4H, Vn.4S, #imm  ····················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x55, 0xf3,
+	3, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x79, 0xb9, 0, 0,
 	// uqshrn Vd.2S, Vn.2D, #imm  ····················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x55, 0xfe,
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x79, 0xc4, 0, 0,
 
 	3,
 	// uqshrn2 Vd.16B, Vn.8H, #imm  ···················································  (0 < imm <= 8)
-	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x56, 0x9,
+	3, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x79, 0xcf, 0, 0,
 	// uqshrn2 Vd.8H, Vn.4S, #imm  ···················································  (0 < imm <= 16)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x56, 0x14,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x79, 0xda, 0, 0,
 	// uqshrn2 Vd.4S, Vn.2D, #imm  ···················································  (0 < imm <= 32)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x56, 0x1f,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x79, 0xe5, 0, 0,
 
-	8,
+	16,
 	// uqsub Bd, Bn, Bm
-	3, MatB, MatB, MatB, 0x56, 0x2a,
+	3, MatB, MatB, MatB, 0x0, 0x79, 0xf0, 0, 0,
 	// uqsub Hd, Hn, Hm
-	3, MatH, MatH, MatH, 0x56, 0x32,
+	3, MatH, MatH, MatH, 0x0, 0x79, 0xf8, 0, 0,
 	// uqsub Sd, Sn, Sm
-	3, MatS, MatS, MatS, 0x56, 0x3a,
+	3, MatS, MatS, MatS, 0x0, 0x7a, 0x0, 0, 0,
 	// uqsub Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x56, 0x42,
+	3, MatD, MatD, MatD, 0x0, 0x7a, 0x8, 0, 0,
 	// uqsub Vd.16B, Vn.16B, Vm.16B
 	// uqsub Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x56, 0x4a,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7a, 0x10, 0, 0,
 	// uqsub Vd.8H, Vn.8H, Vm.8H
 	// uqsub Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x56, 0x53,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x7a, 0x19, 0, 0,
 	// uqsub Vd.4S, Vn.4S, Vm.4S
 	// uqsub Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x56, 0x5c,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7a, 0x22, 0, 0,
 	// uqsub Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x56, 0x65,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x7a, 0x2b, 0, 0,
+	// uqsub Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x7a, 0x34, byte(FeatSVE), 0,
+	// uqsub Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x7a, 0x3c, byte(FeatSVE), 0,
+	// uqsub Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x7a, 0x44, byte(FeatSVE), 0,
+	// uqsub Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x7a, 0x4c, byte(FeatSVE), 0,
+	// uqsub Zd.B, Zn.B, #imm  ··············································  (n == d, 0 <= imm < 256)
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatImm, 0x0, 0x7a, 0x54, byte(FeatSVE), 0,
+	// uqsub Zd.H, Zn.H, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(WORD), MatZ, byte(WORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x7a, 0x5f, byte(FeatSVE), 0,
+	// uqsub Zd.S, Zn.S, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(DWORD), MatZ, byte(DWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x7a, 0x6d, byte(FeatSVE), 0,
+	// uqsub Zd.D, Zn.D, #imm1 {, LSL #imm2 }  ·············  (n == d, 0 <= imm1 < 256, imm2 in [0, 8])
+	5, MatZ, byte(QWORD), MatZ, byte(QWORD), MatImm, MatEnd, MatLitMod, SymLSL, 0x0, 0x7a, 0x7b, byte(FeatSVE), 0,
 
 	6,
 	// uqxtn Bd, Hn
-	2, MatB, MatH, 0x56, 0x6e,
+	2, MatB, MatH, 0x0, 0x7a, 0x89, 0, 0,
 	// uqxtn Hd, Sn
-	2, MatH, MatS, 0x56, 0x75,
+	2, MatH, MatS, 0x0, 0x7a, 0x90, 0, 0,
 	// uqxtn Sd, Dn
-	2, MatS, MatD, 0x56, 0x7c,
+	2, MatS, MatD, 0x0, 0x7a, 0x97, 0, 0,
 	// uqxtn Vd.8B, Vn.8H
-	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x56, 0x83,
+	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x0, 0x7a, 0x9e, 0, 0,
 	// uqxtn Vd.4H, Vn.4S
-	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x56, 0x8a,
+	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x7a, 0xa5, 0, 0,
 	// uqxtn Vd.2S, Vn.2D
-	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x56, 0x91,
+	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x7a, 0xac, 0, 0,
 
 	3,
 	// uqxtn2 Vd.16B, Vn.8H
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x56, 0x98,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x0, 0x7a, 0xb3, 0, 0,
 	// uqxtn2 Vd.8H, Vn.4S
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x56, 0x9f,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x0, 0x7a, 0xba, 0, 0,
 	// uqxtn2 Vd.4S, Vn.2D
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x56, 0xa6,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x0, 0x7a, 0xc1, 0, 0,
 
 	1,
 	// urecpe Vd.4S, Vn.4S
 	// urecpe Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x56, 0xad,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7a, 0xc8, 0, 0,
 
 	3,
 	// urhadd Vd.16B, Vn.16B, Vm.16B
 	// urhadd Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x56, 0xb5,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7a, 0xd0, 0, 0,
 	// urhadd Vd.8H, Vn.8H, Vm.8H
 	// urhadd Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x56, 0xbe,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x7a, 0xd9, 0, 0,
 	// urhadd Vd.4S, Vn.4S, Vm.4S
 	// urhadd Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x56, 0xc7,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7a, 0xe2, 0, 0,
 
 	5,
 	// urshl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x56, 0xd0,
+	3, MatD, MatD, MatD, 0x0, 0x7a, 0xeb, 0, 0,
 	// urshl Vd.16B, Vn.16B, Vm.16B
 	// urshl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x56, 0xd8,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7a, 0xf3, 0, 0,
 	// urshl Vd.8H, Vn.8H, Vm.8H
 	// urshl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x56, 0xe1,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x7a, 0xfc, 0, 0,
 	// urshl Vd.4S, Vn.4S, Vm.4S
 	// urshl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x56, 0xea,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7b, 0x5, 0, 0,
 	// urshl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x56, 0xf3,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x7b, 0xe, 0, 0,
 
 	5,
 	// urshr Dd, Dn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x56, 0xfc,
+	3, MatD, MatD, MatImm, 0x0, 0x7b, 0x17, 0, 0,
 	// urshr Vd.16B, Vn.16B, #imm  ····················································  (0 < imm <= 8)
 	// urshr Vd.8B, Vn.8B, #imm  ······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x57, 0x9,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x7b, 0x24, 0, 0,
 	// urshr Vd.8H, Vn.8H, #imm  ·····················································  (0 < imm <= 16)
 	// urshr Vd.4H, Vn.4H, #imm  ·····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x57, 0x15,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x7b, 0x30, 0, 0,
 	// urshr Vd.4S, Vn.4S, #imm  ·····················································  (0 < imm <= 32)
 	// urshr Vd.2S, Vn.2S, #imm  ·····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x57, 0x21,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x7b, 0x3c, 0, 0,
 	// urshr Vd.2D, Vn.2D, #imm  ·····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x57, 0x2d,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x7b, 0x48, 0, 0,
 
 	1,
 	// ursqrte Vd.4S, Vn.4S
 	// ursqrte Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x57, 0x39,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7b, 0x54, 0, 0,
 
 	5,
 	// ursra Dd, Dn, #imm  ···························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x57, 0x41,
+	3, MatD, MatD, MatImm, 0x0, 0x7b, 0x5c, 0, 0,
 	// ursra Vd.16B, Vn.16B, #imm  ····················································  (0 < imm <= 8)
 	// ursra Vd.8B, Vn.8B, #imm  ······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x57, 0x4e,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x7b, 0x69, 0, 0,
 	// ursra Vd.8H, Vn.8H, #imm  ·····················································  (0 < imm <= 16)
 	// ursra Vd.4H, Vn.4H, #imm  ·····················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x57, 0x5a,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x7b, 0x75, 0, 0,
 	// ursra Vd.4S, Vn.4S, #imm  ·····················································  (0 < imm <= 32)
 	// ursra Vd.2S, Vn.2S, #imm  ·····················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x57, 0x66,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x7b, 0x81, 0, 0,
 	// ursra Vd.2D, Vn.2D, #imm  ·····················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x57, 0x72,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x7b, 0x8d, 0, 0,
+
+	4,
+	// usdot Vd.2S, Vn.8B, Vm.8B
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x7b, 0x99, byte(FeatI8MM), 0,
+	// usdot Vd.4S, Vn.16B, Vm.16B
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x7b, 0xa1, byte(FeatI8MM), 0,
+	// usdot Vd.2S, Vn.8B, Vm.4B[i]
+	3, MatVStatic, byte(DWORD), 2, MatVStatic, byte(BYTE), 8, MatVStaticElement, byte(BYTE), 4, 0x0, 0x7b, 0xa9, byte(FeatI8MM), 0,
+	// usdot Vd.4S, Vn.16B, Vm.4B[i]
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStaticElement, byte(BYTE), 4, 0x0, 0x7b, 0xb3, byte(FeatI8MM), 0,
 
 	5,
 	// ushl Dd, Dn, Dm
-	3, MatD, MatD, MatD, 0x57, 0x7e,
+	3, MatD, MatD, MatD, 0x0, 0x7b, 0xbd, 0, 0,
 	// ushl Vd.16B, Vn.16B, Vm.16B
 	// ushl Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x57, 0x86,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7b, 0xc5, 0, 0,
 	// ushl Vd.8H, Vn.8H, Vm.8H
 	// ushl Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x57, 0x8f,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x7b, 0xce, 0, 0,
 	// ushl Vd.4S, Vn.4S, Vm.4S
 	// ushl Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x57, 0x98,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7b, 0xd7, 0, 0,
 	// ushl Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x57, 0xa1,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x7b, 0xe0, 0, 0,
 
 	3,
 	// ushll Vd.8H, Vn.8B, #imm  ······················································  (0 <= imm < 8)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatImm, 0x57, 0xaa,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatImm, 0x0, 0x7b, 0xe9, 0, 0,
 	// ushll Vd.4S, Vn.4H, #imm  ·····················································  (0 <= imm < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatImm, 0x57, 0xb4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatImm, 0x0, 0x7b, 0xf3, 0, 0,
 	// ushll Vd.2D, Vn.2S, #imm  ·····················································  (0 <= imm < 32)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatImm, 0x57, 0xbe,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatImm, 0x0, 0x7b, 0xfd, 0, 0,
 
 	3,
 	// ushll2 Vd.8H, Vn.16B, #imm  ····················································  (0 <= imm < 8)
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatImm, 0x57, 0xc8,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatImm, 0x0, 0x7c, 0x7, 0, 0,
 	// ushll2 Vd.4S, Vn.8H, #imm  ····················································  (0 <= imm < 16)
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatImm, 0x57, 0xd2,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatImm, 0x0, 0x7c, 0x11, 0, 0,
 	// ushll2 Vd.2D, Vn.4S, #imm  ····················································  (0 <= imm < 32)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatImm, 0x57, 0xdc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatImm, 0x0, 0x7c, 0x1b, 0, 0,
 
 	5,
 	// ushr Dd, Dn, #imm  ····························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x57, 0xe6,
+	3, MatD, MatD, MatImm, 0x0, 0x7c, 0x25, 0, 0,
 	// ushr Vd.16B, Vn.16B, #imm  ·····················································  (0 < imm <= 8)
 	// ushr Vd.8B, Vn.8B, #imm  ·······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x57, 0xf3,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x7c, 0x32, 0, 0,
 	// ushr Vd.8H, Vn.8H, #imm  ······················································  (0 < imm <= 16)
 	// ushr Vd.4H, Vn.4H, #imm  ······················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x57, 0xff,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x7c, 0x3e, 0, 0,
 	// ushr Vd.4S, Vn.4S, #imm  ······················································  (0 < imm <= 32)
 	// ushr Vd.2S, Vn.2S, #imm  ······················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x58, 0xb,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x7c, 0x4a, 0, 0,
 	// ushr Vd.2D, Vn.2D, #imm  ······················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x58, 0x17,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x7c, 0x56, 0, 0,
+
+	1,
+	// usmmla Vd.4S, Vn.16B, Vm.16B
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x7c, 0x62, byte(FeatI8MM), 0,
+
+	2,
+	// usmopa ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x7c, 0x6a, byte(FeatSME), 0,
+	// usmopa ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x7c, 0x78, byte(FeatSMEI16I64), 0,
+
+	2,
+	// usmops ZAd.S, Pg1/M, Pg2/M, Zn.B, Zm.B  ·······························  (d < 4, g1 < 8, g2 < 8)
+	5, MatZATile, byte(DWORD), MatPM, MatPM, MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x7c, 0x86, byte(FeatSME), 0,
+	// usmops ZAd.D, Pg1/M, Pg2/M, Zn.H, Zm.H  ·······························  (d < 8, g1 < 8, g2 < 8)
+	5, MatZATile, byte(QWORD), MatPM, MatPM, MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x7c, 0x94, byte(FeatSMEI16I64), 0,
 
 	8,
 	// usqadd Bd, Bn
-	2, MatB, MatB, 0x58, 0x23,
+	2, MatB, MatB, 0x0, 0x7c, 0xa2, 0, 0,
 	// usqadd Hd, Hn
-	2, MatH, MatH, 0x58, 0x2a,
+	2, MatH, MatH, 0x0, 0x7c, 0xa9, 0, 0,
 	// usqadd Sd, Sn
-	2, MatS, MatS, 0x58, 0x31,
+	2, MatS, MatS, 0x0, 0x7c, 0xb0, 0, 0,
 	// usqadd Dd, Dn
-	2, MatD, MatD, 0x58, 0x38,
+	2, MatD, MatD, 0x0, 0x7c, 0xb7, 0, 0,
 	// usqadd Vd.16B, Vn.16B
 	// usqadd Vd.8B, Vn.8B
-	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x58, 0x3f,
+	2, MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7c, 0xbe, 0, 0,
 	// usqadd Vd.8H, Vn.8H
 	// usqadd Vd.4H, Vn.4H
-	2, MatV, byte(WORD), MatV, byte(WORD), 0x58, 0x47,
+	2, MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x7c, 0xc6, 0, 0,
 	// usqadd Vd.4S, Vn.4S
 	// usqadd Vd.2S, Vn.2S
-	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x58, 0x4f,
+	2, MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7c, 0xce, 0, 0,
 	// usqadd Vd.2D, Vn.2D
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x58, 0x57,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x7c, 0xd6, 0, 0,
 
 	5,
 	// usra Dd, Dn, #imm  ····························································  (0 < imm <= 64)
-	3, MatD, MatD, MatImm, 0x58, 0x5f,
+	3, MatD, MatD, MatImm, 0x0, 0x7c, 0xde, 0, 0,
 	// usra Vd.16B, Vn.16B, #imm  ·····················································  (0 < imm <= 8)
 	// usra Vd.8B, Vn.8B, #imm  ·······················································  (0 < imm <= 8)
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x58, 0x6c,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatImm, 0x0, 0x7c, 0xeb, 0, 0,
 	// usra Vd.8H, Vn.8H, #imm  ······················································  (0 < imm <= 16)
 	// usra Vd.4H, Vn.4H, #imm  ······················································  (0 < imm <= 16)
-	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x58, 0x78,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatImm, 0x0, 0x7c, 0xf7, 0, 0,
 	// usra Vd.4S, Vn.4S, #imm  ······················································  (0 < imm <= 32)
 	// usra Vd.2S, Vn.2S, #imm  ······················································  (0 < imm <= 32)
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x58, 0x84,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatImm, 0x0, 0x7d, 0x3, 0, 0,
 	// usra Vd.2D, Vn.2D, #imm  ······················································  (0 < imm <= 64)
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x58, 0x90,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x7d, 0xf, 0, 0,
 
 	3,
 	// usubl Vd.8H, Vn.8B, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x58, 0x9c,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x7d, 0x1b, 0, 0,
 	// usubl Vd.4S, Vn.4H, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x58, 0xa4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x7d, 0x23, 0, 0,
 	// usubl Vd.2D, Vn.2S, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x58, 0xac,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x7d, 0x2b, 0, 0,
 
 	3,
 	// usubl2 Vd.8H, Vn.16B, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x58, 0xb4,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, MatVStatic, byte(BYTE), 16, 0x0, 0x7d, 0x33, 0, 0,
 	// usubl2 Vd.4S, Vn.8H, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x58, 0xbc,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, 0x0, 0x7d, 0x3b, 0, 0,
 	// usubl2 Vd.2D, Vn.4S, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x58, 0xc4,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x7d, 0x43, 0, 0,
 
 	3,
 	// usubw Vd.8H, Vn.8H, Vm.8B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x58, 0xcc,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x7d, 0x4b, 0, 0,
 	// usubw Vd.4S, Vn.4S, Vm.4H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x58, 0xd4,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x7d, 0x53, 0, 0,
 	// usubw Vd.2D, Vn.2D, Vm.2S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x58, 0xdc,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x7d, 0x5b, 0, 0,
 
 	3,
 	// usubw2 Vd.8H, Vn.8H, Vm.16B
-	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x58, 0xe4,
+	3, MatVStatic, byte(WORD), 8, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x0, 0x7d, 0x63, 0, 0,
 	// usubw2 Vd.4S, Vn.4S, Vm.8H
-	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x58, 0xec,
+	3, MatVStatic, byte(DWORD), 4, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x0, 0x7d, 0x6b, 0, 0,
 	// usubw2 Vd.2D, Vn.2D, Vm.4S
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x58, 0xf4,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x0, 0x7d, 0x73, 0, 0,
+
+	3,
+	// uunpkhi Zd.H, Zn.B
+	2, MatZ, byte(WORD), MatZ, byte(BYTE), 0x0, 0x7d, 0x7b, byte(FeatSVE), 0,
+	// uunpkhi Zd.S, Zn.H
+	2, MatZ, byte(DWORD), MatZ, byte(WORD), 0x0, 0x7d, 0x82, byte(FeatSVE), 0,
+	// uunpkhi Zd.D, Zn.S
+	2, MatZ, byte(QWORD), MatZ, byte(DWORD), 0x0, 0x7d, 0x89, byte(FeatSVE), 0,
+
+	3,
+	// uunpklo Zd.H, Zn.B
+	2, MatZ, byte(WORD), MatZ, byte(BYTE), 0x0, 0x7d, 0x90, byte(FeatSVE), 0,
+	// uunpklo Zd.S, Zn.H
+	2, MatZ, byte(DWORD), MatZ, byte(WORD), 0x0, 0x7d, 0x97, byte(FeatSVE), 0,
+	// uunpklo Zd.D, Zn.S
+	2, MatZ, byte(QWORD), MatZ, byte(DWORD), 0x0, 0x7d, 0x9e, byte(FeatSVE), 0,
 
 	1,
 	// uxtb Wd, Wn
-	2, MatW, MatW, 0x58, 0xfc,
+	2, MatW, MatW, 0x0, 0x7d, 0xa5, 0, 0,
 
 	1,
 	// uxth Wd, Wn
-	2, MatW, MatW, 0x59, 0x3,
+	2, MatW, MatW, 0x0, 0x7d, 0xac, 0, 0,
 
 	3,
 	// uxtl Vd.8H, Vn.8B
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x59, 0xa,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 8, 0x0, 0x7d, 0xb3, 0, 0,
 	// uxtl Vd.4S, Vn.4H
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x59, 0x11,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 4, 0x0, 0x7d, 0xba, 0, 0,
 	// uxtl Vd.2D, Vn.2S
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x59, 0x18,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 2, 0x0, 0x7d, 0xc1, 0, 0,
 
 	3,
 	// uxtl2 Vd.8H, Vn.16B
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x59, 0x1f,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(BYTE), 16, 0x0, 0x7d, 0xc8, 0, 0,
 	// uxtl2 Vd.4S, Vn.8H
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x59, 0x26,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(WORD), 8, 0x0, 0x7d, 0xcf, 0, 0,
 	// uxtl2 Vd.2D, Vn.4S
-	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x59, 0x2d,
+	2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(DWORD), 4, 0x0, 0x7d, 0xd6, 0, 0,
 
-	4,
+	12,
 	// uzp1 Vd.16B, Vn.16B, Vm.16B
 	// uzp1 Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x59, 0x34,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7d, 0xdd, 0, 0,
 	// uzp1 Vd.8H, Vn.8H, Vm.8H
 	// uzp1 Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x59, 0x3d,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x7d, 0xe6, 0, 0,
 	// uzp1 Vd.4S, Vn.4S, Vm.4S
 	// uzp1 Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x59, 0x46,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7d, 0xef, 0, 0,
 	// uzp1 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x59, 0x4f,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x7d, 0xf8, 0, 0,
+	// uzp1 Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x7e, 0x1, byte(FeatSVE), 0,
+	// uzp1 Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x7e, 0x9, byte(FeatSVE), 0,
+	// uzp1 Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x7e, 0x11, byte(FeatSVE), 0,
+	// uzp1 Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x7e, 0x19, byte(FeatSVE), 0,
+	// uzp1 Pd.B, Pn.B, Pm.B
+	3, MatP, byte(BYTE), MatP, byte(BYTE), MatP, byte(BYTE), 0x0, 0x7e, 0x21, byte(FeatSVE), 0,
+	// uzp1 Pd.H, Pn.H, Pm.H
+	3, MatP, byte(WORD), MatP, byte(WORD), MatP, byte(WORD), 0x0, 0x7e, 0x29, byte(FeatSVE), 0,
+	// uzp1 Pd.S, Pn.S, Pm.S
+	3, MatP, byte(DWORD), MatP, byte(DWORD), MatP, byte(DWORD), 0x0, 0x7e, 0x31, byte(FeatSVE), 0,
+	// uzp1 Pd.D, Pn.D, Pm.D
+	3, MatP, byte(QWORD), MatP, byte(QWORD), MatP, byte(QWORD), 0x0, 0x7e, 0x39, byte(FeatSVE), 0,
 
-	4,
+	12,
 	// uzp2 Vd.16B, Vn.16B, Vm.16B
 	// uzp2 Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x59, 0x58,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x7e, 0x41, 0, 0,
 	// uzp2 Vd.8H, Vn.8H, Vm.8H
 	// uzp2 Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x59, 0x61,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x7e, 0x4a, 0, 0,
 	// uzp2 Vd.4S, Vn.4S, Vm.4S
 	// uzp2 Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x59, 0x6a,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x7e, 0x53, 0, 0,
 	// uzp2 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x59, 0x73,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x7e, 0x5c, 0, 0,
+	// uzp2 Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x7e, 0x65, byte(FeatSVE), 0,
+	// uzp2 Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x7e, 0x6d, byte(FeatSVE), 0,
+	// uzp2 Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x7e, 0x75, byte(FeatSVE), 0,
+	// uzp2 Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x7e, 0x7d, byte(FeatSVE), 0,
+	// uzp2 Pd.B, Pn.B, Pm.B
+	3, MatP, byte(BYTE), MatP, byte(BYTE), MatP, byte(BYTE), 0x0, 0x7e, 0x85, byte(FeatSVE), 0,
+	// uzp2 Pd.H, Pn.H, Pm.H
+	3, MatP, byte(WORD), MatP, byte(WORD), MatP, byte(WORD), 0x0, 0x7e, 0x8d, byte(FeatSVE), 0,
+	// uzp2 Pd.S, Pn.S, Pm.S
+	3, MatP, byte(DWORD), MatP, byte(DWORD), MatP, byte(DWORD), 0x0, 0x7e, 0x95, byte(FeatSVE), 0,
+	// uzp2 Pd.D, Pn.D, Pm.D
+	3, MatP, byte(QWORD), MatP, byte(QWORD), MatP, byte(QWORD), 0x0, 0x7e, 0x9d, byte(FeatSVE), 0,
 
 	1,
 	// wfe
-	0, 0x59, 0x7c,
+	0, 0x0, 0x7e, 0xa5, 0, 0,
+
+	1,
+	// wfet Xd
+	1, MatX, 0x0, 0x7e, 0xaa, byte(FeatWFxT), 0,
 
 	1,
 	// wfi
-	0, 0x59, 0x81,
+	0, 0x0, 0x7e, 0xb0, 0, 0,
+
+	1,
+	// wfit Xd
+	1, MatX, 0x0, 0x7e, 0xb5, byte(FeatWFxT), 0,
+
+	8,
+	// whilege Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x7e, 0xbb, byte(FeatSVE2), 0,
+	// whilege Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x7e, 0xc3, byte(FeatSVE2), 0,
+	// whilege Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x7e, 0xcb, byte(FeatSVE2), 0,
+	// whilege Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x7e, 0xd3, byte(FeatSVE2), 0,
+	// whilege Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x7e, 0xdb, byte(FeatSVE2), 0,
+	// whilege Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x7e, 0xe3, byte(FeatSVE2), 0,
+	// whilege Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x7e, 0xeb, byte(FeatSVE2), 0,
+	// whilege Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x7e, 0xf3, byte(FeatSVE2), 0,
+
+	8,
+	// whilegt Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x7e, 0xfb, byte(FeatSVE2), 0,
+	// whilegt Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x7f, 0x3, byte(FeatSVE2), 0,
+	// whilegt Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x7f, 0xb, byte(FeatSVE2), 0,
+	// whilegt Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x7f, 0x13, byte(FeatSVE2), 0,
+	// whilegt Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x7f, 0x1b, byte(FeatSVE2), 0,
+	// whilegt Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x7f, 0x23, byte(FeatSVE2), 0,
+	// whilegt Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x7f, 0x2b, byte(FeatSVE2), 0,
+	// whilegt Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x7f, 0x33, byte(FeatSVE2), 0,
+
+	8,
+	// whilehi Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x7f, 0x3b, byte(FeatSVE2), 0,
+	// whilehi Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x7f, 0x43, byte(FeatSVE2), 0,
+	// whilehi Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x7f, 0x4b, byte(FeatSVE2), 0,
+	// whilehi Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x7f, 0x53, byte(FeatSVE2), 0,
+	// whilehi Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x7f, 0x5b, byte(FeatSVE2), 0,
+	// whilehi Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x7f, 0x63, byte(FeatSVE2), 0,
+	// whilehi Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x7f, 0x6b, byte(FeatSVE2), 0,
+	// whilehi Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x7f, 0x73, byte(FeatSVE2), 0,
+
+	8,
+	// whilehs Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x7f, 0x7b, byte(FeatSVE2), 0,
+	// whilehs Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x7f, 0x83, byte(FeatSVE2), 0,
+	// whilehs Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x7f, 0x8b, byte(FeatSVE2), 0,
+	// whilehs Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x7f, 0x93, byte(FeatSVE2), 0,
+	// whilehs Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x7f, 0x9b, byte(FeatSVE2), 0,
+	// whilehs Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x7f, 0xa3, byte(FeatSVE2), 0,
+	// whilehs Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x7f, 0xab, byte(FeatSVE2), 0,
+	// whilehs Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x7f, 0xb3, byte(FeatSVE2), 0,
+
+	8,
+	// whilele Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x7f, 0xbb, byte(FeatSVE), 0,
+	// whilele Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x7f, 0xc3, byte(FeatSVE), 0,
+	// whilele Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x7f, 0xcb, byte(FeatSVE), 0,
+	// whilele Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x7f, 0xd3, byte(FeatSVE), 0,
+	// whilele Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x7f, 0xdb, byte(FeatSVE), 0,
+	// whilele Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x7f, 0xe3, byte(FeatSVE), 0,
+	// whilele Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x7f, 0xeb, byte(FeatSVE), 0,
+	// whilele Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x7f, 0xf3, byte(FeatSVE), 0,
+
+	8,
+	// whilelo Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x7f, 0xfb, byte(FeatSVE), 0,
+	// whilelo Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x80, 0x3, byte(FeatSVE), 0,
+	// whilelo Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x80, 0xb, byte(FeatSVE), 0,
+	// whilelo Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x80, 0x13, byte(FeatSVE), 0,
+	// whilelo Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x80, 0x1b, byte(FeatSVE), 0,
+	// whilelo Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x80, 0x23, byte(FeatSVE), 0,
+	// whilelo Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x80, 0x2b, byte(FeatSVE), 0,
+	// whilelo Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x80, 0x33, byte(FeatSVE), 0,
+
+	8,
+	// whilels Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x80, 0x3b, byte(FeatSVE), 0,
+	// whilels Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x80, 0x43, byte(FeatSVE), 0,
+	// whilels Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x80, 0x4b, byte(FeatSVE), 0,
+	// whilels Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x80, 0x53, byte(FeatSVE), 0,
+	// whilels Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x80, 0x5b, byte(FeatSVE), 0,
+	// whilels Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x80, 0x63, byte(FeatSVE), 0,
+	// whilels Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x80, 0x6b, byte(FeatSVE), 0,
+	// whilels Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x80, 0x73, byte(FeatSVE), 0,
+
+	8,
+	// whilelt Pd.B, Wn, Wm
+	3, MatP, byte(BYTE), MatW, MatW, 0x0, 0x80, 0x7b, byte(FeatSVE), 0,
+	// whilelt Pd.B, Xn, Xm
+	3, MatP, byte(BYTE), MatX, MatX, 0x0, 0x80, 0x83, byte(FeatSVE), 0,
+	// whilelt Pd.H, Wn, Wm
+	3, MatP, byte(WORD), MatW, MatW, 0x0, 0x80, 0x8b, byte(FeatSVE), 0,
+	// whilelt Pd.H, Xn, Xm
+	3, MatP, byte(WORD), MatX, MatX, 0x0, 0x80, 0x93, byte(FeatSVE), 0,
+	// whilelt Pd.S, Wn, Wm
+	3, MatP, byte(DWORD), MatW, MatW, 0x0, 0x80, 0x9b, byte(FeatSVE), 0,
+	// whilelt Pd.S, Xn, Xm
+	3, MatP, byte(DWORD), MatX, MatX, 0x0, 0x80, 0xa3, byte(FeatSVE), 0,
+	// whilelt Pd.D, Wn, Wm
+	3, MatP, byte(QWORD), MatW, MatW, 0x0, 0x80, 0xab, byte(FeatSVE), 0,
+	// whilelt Pd.D, Xn, Xm
+	3, MatP, byte(QWORD), MatX, MatX, 0x0, 0x80, 0xb3, byte(FeatSVE), 0,
+
+	1,
+	// wrffr Pd.B
+	1, MatP, byte(BYTE), 0x0, 0x80, 0xbb, byte(FeatSVE), 0,
+
+	1,
+	// xaflag
+	0, 0x0, 0x80, 0xc1, byte(FeatFlagM2), 0,
 
 	1,
 	// xar Vd.2D, Vn.2D, Vm.2D, #imm  ················································  (0 <= imm < 64)
-	4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x59, 0x86,
+	4, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatImm, 0x0, 0x80, 0xc6, byte(FeatSHA3), 0,
 
 	1,
 	// xpacd Xd
-	1, MatX, 0x59, 0x91,
+	1, MatX, 0x0, 0x80, 0xd1, byte(FeatPAuth), 0,
 
 	1,
 	// xpaci Xd
-	1, MatX, 0x59, 0x97,
+	1, MatX, 0x0, 0x80, 0xd7, byte(FeatPAuth), 0,
 
 	1,
 	// xpaclri
-	0, 0x59, 0x9d,
+	0, 0x0, 0x80, 0xdd, 0, 0,
 
 	3,
 	// xtn Vd.8B, Vn.8H
-	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x59, 0xa2,
+	2, MatVStatic, byte(BYTE), 8, MatVStatic, byte(WORD), 8, 0x0, 0x80, 0xe2, 0, 0,
 	// xtn Vd.4H, Vn.4S
-	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x59, 0xa9,
+	2, MatVStatic, byte(WORD), 4, MatVStatic, byte(DWORD), 4, 0x0, 0x80, 0xe9, 0, 0,
 	// xtn Vd.2S, Vn.2D
-	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x59, 0xb0,
+	2, MatVStatic, byte(DWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x80, 0xf0, 0, 0,
 
 	3,
 	// xtn2 Vd.16B, Vn.8H
-	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x59, 0xb7,
+	2, MatVStatic, byte(BYTE), 16, MatVStatic, byte(WORD), 8, 0x0, 0x80, 0xf7, 0, 0,
 	// xtn2 Vd.8H, Vn.4S
-	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x59, 0xbe,
+	2, MatVStatic, byte(WORD), 8, MatVStatic, byte(DWORD), 4, 0x0, 0x80, 0xfe, 0, 0,
 	// xtn2 Vd.4S, Vn.2D
-	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x59, 0xc5,
+	2, MatVStatic, byte(DWORD), 4, MatVStatic, byte(QWORD), 2, 0x0, 0x81, 0x5, 0, 0,
 
 	1,
 	// yield
-	0, 0x59, 0xcc,
+	0, 0x0, 0x81, 0xc, 0, 0,
 
-	4,
+	1,
+	// zero ZA
+	1, MatZA, 0x0, 0x81, 0x11, byte(FeatSME), 0,
+
+	12,
 	// zip1 Vd.16B, Vn.16B, Vm.16B
 	// zip1 Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x59, 0xd1,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x81, 0x16, 0, 0,
 	// zip1 Vd.8H, Vn.8H, Vm.8H
 	// zip1 Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x59, 0xda,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x81, 0x1f, 0, 0,
 	// zip1 Vd.4S, Vn.4S, Vm.4S
 	// zip1 Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x59, 0xe3,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x81, 0x28, 0, 0,
 	// zip1 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x59, 0xec,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x81, 0x31, 0, 0,
+	// zip1 Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x81, 0x3a, byte(FeatSVE), 0,
+	// zip1 Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x81, 0x42, byte(FeatSVE), 0,
+	// zip1 Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x81, 0x4a, byte(FeatSVE), 0,
+	// zip1 Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x81, 0x52, byte(FeatSVE), 0,
+	// zip1 Pd.B, Pn.B, Pm.B
+	3, MatP, byte(BYTE), MatP, byte(BYTE), MatP, byte(BYTE), 0x0, 0x81, 0x5a, byte(FeatSVE), 0,
+	// zip1 Pd.H, Pn.H, Pm.H
+	3, MatP, byte(WORD), MatP, byte(WORD), MatP, byte(WORD), 0x0, 0x81, 0x62, byte(FeatSVE), 0,
+	// zip1 Pd.S, Pn.S, Pm.S
+	3, MatP, byte(DWORD), MatP, byte(DWORD), MatP, byte(DWORD), 0x0, 0x81, 0x6a, byte(FeatSVE), 0,
+	// zip1 Pd.D, Pn.D, Pm.D
+	3, MatP, byte(QWORD), MatP, byte(QWORD), MatP, byte(QWORD), 0x0, 0x81, 0x72, byte(FeatSVE), 0,
 
-	4,
+	12,
 	// zip2 Vd.16B, Vn.16B, Vm.16B
 	// zip2 Vd.8B, Vn.8B, Vm.8B
-	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x59, 0xf5,
+	3, MatV, byte(BYTE), MatV, byte(BYTE), MatV, byte(BYTE), 0x0, 0x81, 0x7a, 0, 0,
 	// zip2 Vd.8H, Vn.8H, Vm.8H
 	// zip2 Vd.4H, Vn.4H, Vm.4H
-	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x59, 0xfe,
+	3, MatV, byte(WORD), MatV, byte(WORD), MatV, byte(WORD), 0x0, 0x81, 0x83, 0, 0,
 	// zip2 Vd.4S, Vn.4S, Vm.4S
 	// zip2 Vd.2S, Vn.2S, Vm.2S
-	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x5a, 0x7,
+	3, MatV, byte(DWORD), MatV, byte(DWORD), MatV, byte(DWORD), 0x0, 0x81, 0x8c, 0, 0,
 	// zip2 Vd.2D, Vn.2D, Vm.2D
-	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x5a, 0x10,
+	3, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, MatVStatic, byte(QWORD), 2, 0x0, 0x81, 0x95, 0, 0,
+	// zip2 Zd.B, Zn.B, Zm.B
+	3, MatZ, byte(BYTE), MatZ, byte(BYTE), MatZ, byte(BYTE), 0x0, 0x81, 0x9e, byte(FeatSVE), 0,
+	// zip2 Zd.H, Zn.H, Zm.H
+	3, MatZ, byte(WORD), MatZ, byte(WORD), MatZ, byte(WORD), 0x0, 0x81, 0xa6, byte(FeatSVE), 0,
+	// zip2 Zd.S, Zn.S, Zm.S
+	3, MatZ, byte(DWORD), MatZ, byte(DWORD), MatZ, byte(DWORD), 0x0, 0x81, 0xae, byte(FeatSVE), 0,
+	// zip2 Zd.D, Zn.D, Zm.D
+	3, MatZ, byte(QWORD), MatZ, byte(QWORD), MatZ, byte(QWORD), 0x0, 0x81, 0xb6, byte(FeatSVE), 0,
+	// zip2 Pd.B, Pn.B, Pm.B
+	3, MatP, byte(BYTE), MatP, byte(BYTE), MatP, byte(BYTE), 0x0, 0x81, 0xbe, byte(FeatSVE), 0,
+	// zip2 Pd.H, Pn.H, Pm.H
+	3, MatP, byte(WORD), MatP, byte(WORD), MatP, byte(WORD), 0x0, 0x81, 0xc6, byte(FeatSVE), 0,
+	// zip2 Pd.S, Pn.S, Pm.S
+	3, MatP, byte(DWORD), MatP, byte(DWORD), MatP, byte(DWORD), 0x0, 0x81, 0xce, byte(FeatSVE), 0,
+	// zip2 Pd.D, Pn.D, Pm.D
+	3, MatP, byte(QWORD), MatP, byte(QWORD), MatP, byte(QWORD), 0x0, 0x81, 0xd6, byte(FeatSVE), 0,
+}
+
+// The PatternOffsets table maps each Inst to the start of its encodings in the Patterns table.
+var PatternOffsets = [...]uint32{
+	0,
+	1,     // ABS
+	112,   // ADC
+	131,   // ADCS
+	150,   // ADD
+	448,   // ADDG
+	459,   // ADDHA
+	484,   // ADDHN
+	530,   // ADDHN2
+	576,   // ADDP
+	638,   // ADDPL
+	648,   // ADDS
+	733,   // ADDSPL
+	743,   // ADDSVL
+	753,   // ADDV
+	782,   // ADDVA
+	807,   // ADDVL
+	817,   // ADR
+	826,   // ADRP
+	835,   // AESD
+	848,   // AESE
+	861,   // AESIMC
+	874,   // AESMC
+	887,   // AND
+	1028,  // ANDS
+	1071,  // ANDV
+	1116,  // ASR
+	1297,  // ASRV
+	1316,  // AT
+	1325,  // AUTDA
+	1334,  // AUTDB
+	1343,  // AUTDZA
+	1351,  // AUTDZB
+	1359,  // AUTIA
+	1368,  // AUTIA1716
+	1375,  // AUTIASP
+	1382,  // AUTIAZ
+	1389,  // AUTIB
+	1398,  // AUTIB1716
+	1405,  // AUTIBSP
+	1412,  // AUTIBZ
+	1419,  // AUTIZA
+	1427,  // AUTIZB
+	1435,  // AXFLAG
+	1442,  // B
+	1458,  // BCAX
+	1491,  // BFC
+	1510,  // BFCVT
+	1519,  // BFCVTN
+	1532,  // BFCVTN2
+	1545,  // BFDOT
+	1606,  // BFI
+	1627,  // BFM
+	1648,  // BFMLALB
+	1678,  // BFMLALT
+	1708,  // BFMMLA
+	1724,  // BFMOPA
+	1739,  // BFMOPS
+	1754,  // BFXIL
+	1775,  // BIC
+	1900,  // BICS
+	1925,  // BIF
+	1938,  // BIT
+	1951,  // BL
+	1959,  // BLR
+	1967,  // BLRAA
+	1976,  // BLRAAZ
+	1984,  // BLRAB
+	1993,  // BLRABZ
+	2001,  // BR
+	2009,  // BRAA
+	2018,  // BRAAZ
+	2026,  // BRAB
+	2035,  // BRABZ
+	2043,  // BRK
+	2051,  // BSL
+	2078,  // BSL1N
+	2093,  // BSL2N
+	2108,  // BTI
+	2122,  // CAS
+	2141,  // CASA
+	2160,  // CASAB
+	2170,  // CASAH
+	2180,  // CASAL
+	2199,  // CASALB
+	2209,  // CASALH
+	2219,  // CASB
+	2229,  // CASH
+	2239,  // CASL
+	2258,  // CASLB
+	2268,  // CASLH
+	2278,  // CASP
+	2301,  // CASPA
+	2324,  // CASPAL
+	2347,  // CASPL
+	2370,  // CBNZ
+	2387,  // CBZ
+	2404,  // CCMN
+	2445,  // CCMP
+	2486,  // CFINV
+	2493,  // CFP
+	2503,  // CINC
+	2522,  // CINV
+	2541,  // CLRBHB
+	2548,  // CLREX
+	2562,  // CLS
+	2653,  // CLZ
+	2744,  // CMEQ
+	2865,  // CMGE
+	2986,  // CMGT
+	3107,  // CMHI
+	3168,  // CMHS
+	3229,  // CMLE
+	3290,  // CMLT
+	3351,  // CMN
+	3428,  // CMP
+	3505,  // CMPEQ
+	3606,  // CMPGE
+	3707,  // CMPGT
+	3808,  // CMPHI
+	3909,  // CMPHS
+	4010,  // CMPLE
+	4059,  // CMPLO
+	4108,  // CMPLS
+	4157,  // CMPLT
+	4206,  // CMPNE
+	4307,  // CMTST
+	4368,  // CNEG
+	4387,  // CNT
+	4458,  // CNTB
+	4477,  // CNTD
+	4496,  // CNTH
+	4515,  // CNTW
+	4534,  // COMPACT
+	4559,  // CPP
+	4569,  // CPYE
+	4579,  // CPYFE
+	4589,  // CPYFM
+	4599,  // CPYFP
+	4609,  // CPYM
+	4619,  // CPYP
+	4629,  // CRC32B
+	4639,  // CRC32CB
+	4649,  // CRC32CH
+	4659,  // CRC32CW
+	4669,  // CRC32CX
+	4679,  // CRC32H
+	4689,  // CRC32W
+	4699,  // CRC32X
+	4709,  // CSDB
+	4716,  // CSEL
+	4737,  // CSET
+	4754,  // CSETM
+	4771,  // CSINC
+	4792,  // CSINV
+	4813,  // CSNEG
+	4834,  // CTZ
+	4851,  // DC
+	4860,  // DCPS1
+	4869,  // DCPS2
+	4878,  // DCPS3
+	4887,  // DECB
+	4906,  // DECD
+	4925,  // DECH
+	4944,  // DECW
+	4963,  // DGH
+	4970,  // DMB
+	4985,  // DRPS
+	4992,  // DSB
+	5007,  // DUP
+	5203,  // DVP
+	5213,  // EON
+	5238,  // EOR
+	5379,  // EOR3
+	5412,  // EORV
+	5457,  // ERET
+	5464,  // ERETAA
+	5471,  // ERETAB
+	5478,  // ESB
+	5485,  // EXT
+	5531,  // EXTR
+	5552,  // FABD
+	5658,  // FABS
+	5748,  // FACGE
+	5815,  // FACGT
+	5882,  // FADD
+	6024,  // FADDP
+	6094,  // FADDV
+	6128,  // FCADD
+	6171,  // FCCMP
+	6202,  // FCCMPE
+	6233,  // FCMEQ
+	6407,  // FCMGE
+	6581,  // FCMGT
+	6755,  // FCMLA
+	6843,  // FCMLE
+	6912,  // FCMLT
+	6981,  // FCMNE
+	7021,  // FCMP
+	7073,  // FCMPE
+	7125,  // FCSEL
+	7156,  // FCVT
+	7205,  // FCVTAS
+	7310,  // FCVTAU
+	7415,  // FCVTL
+	7440,  // FCVTL2
+	7465,  // FCVTMS
+	7570,  // FCVTMU
+	7675,  // FCVTN
+	7688,  // FCVTN2
+	7701,  // FCVTNS
+	7806,  // FCVTNU
+	7911,  // FCVTPS
+	8016,  // FCVTPU
+	8121,  // FCVTXN
+	8142,  // FCVTXN2
+	8155,  // FCVTZS
+	8409,  // FCVTZU
+	8663,  // FDIV
+	8769,  // FDIVR
+	8809,  // FDUP
+	8837,  // FJCVTZS
+	8846,  // FMADD
+	8877,  // FMAX
+	8983,  // FMAXNM
+	9089,  // FMAXNMP
+	9159,  // FMAXNMV
+	9212,  // FMAXP
+	9282,  // FMAXV
+	9335,  // FMIN
+	9441,  // FMINNM
+	9547,  // FMINNMP
+	9617,  // FMINNMV
+	9670,  // FMINP
+	9740,  // FMINV
+	9793,  // FMLA
+	9976,  // FMLAL
+	10035, // FMLAL2
+	10094, // FMLS
+	10277, // FMLSL
+	10336, // FMLSL2
+	10395, // FMOPA
+	10438, // FMOPS
+	10481, // FMOV
+	10789, // FMSUB
+	10820, // FMUL
+	11066, // FMULX
+	11240, // FNEG
+	11330, // FNMADD
+	11361, // FNMLA
+	11401, // FNMLS
+	11441, // FNMSUB
+	11472, // FNMUL
+	11500, // FRECPE
+	11557, // FRECPS
+	11660, // FRECPX
+	11718, // FRINT32X
+	11757, // FRINT32Z
+	11796, // FRINT64X
+	11835, // FRINT64Z
+	11874, // FRINTA
+	11964, // FRINTI
+	12054, // FRINTM
+	12144, // FRINTN
+	12234, // FRINTP
+	12324, // FRINTX
+	12414, // FRINTZ
+	12504, // FRSQRTE
+	12561, // FRSQRTS
+	12664, // FSCALE
+	12704, // FSQRT
+	12794, // FSUB
+	12936, // FSUBR
+	12976, // GMI
+	12986, // HINT
+	12994, // HLT
+	13002, // HVC
+	13010, // IC
+	13027, // INCB
+	13046, // INCD
+	13065, // INCH
+	13084, // INCW
+	13103, // INDEX
+	13184, // INS
+	13261, // IRG
+	13272, // ISB
+	13294, // LD1
+	14179, // LD1B
+	14343, // LD1D
+	14414, // LD1H
+	14581, // LD1Q
+	14603, // LD1R
+	14736, // LD1RB
+	14748, // LD1RD
+	14760, // LD1RH
+	14772, // LD1RW
+	14784, // LD1W
+	14928, // LD2
+	15238, // LD2R
+	15371, // LD3
+	15681, // LD3R
+	15814, // LD4
+	16124, // LD4R
+	16257, // LD64B
+	16266, // LDADD
+	16285, // LDADDA
+	16304, // LDADDAB
+	16314, // LDADDAH
+	16324, // LDADDAL
+	16343, // LDADDALB
+	16353, // LDADDALH
+	16363, // LDADDB
+	16373, // LDADDH
+	16383, // LDADDL
+	16402, // LDADDLB
+	16412, // LDADDLH
+	16422, // LDAPR
+	16439, // LDAPRB
+	16448, // LDAPRH
+	16457, // LDAPUR
+	16474, // LDAPURB
+	16483, // LDAPURH
+	16492, // LDAPURSB
+	16509, // LDAPURSH
+	16526, // LDAPURSW
+	16535, // LDAR
+	16552, // LDARB
+	16561, // LDARH
+	16570, // LDAXP
+	16589, // LDAXR
+	16606, // LDAXRB
+	16615, // LDAXRH
+	16624, // LDCLR
+	16643, // LDCLRA
+	16662, // LDCLRAB
+	16672, // LDCLRAH
+	16682, // LDCLRAL
+	16701, // LDCLRALB
+	16711, // LDCLRALH
+	16721, // LDCLRB
+	16731, // LDCLRH
+	16741, // LDCLRL
+	16760, // LDCLRLB
+	16770, // LDCLRLH
+	16780, // LDEOR
+	16799, // LDEORA
+	16818, // LDEORAB
+	16828, // LDEORAH
+	16838, // LDEORAL
+	16857, // LDEORALB
+	16867, // LDEORALH
+	16877, // LDEORB
+	16887, // LDEORH
+	16897, // LDEORL
+	16916, // LDEORLB
+	16926, // LDEORLH
+	16936, // LDFF1B
+	16949, // LDFF1D
+	16962, // LDFF1H
+	16975, // LDFF1W
+	16988, // LDG
+	16997, // LDLAR
+	17014, // LDLARB
+	17023, // LDLARH
+	17032, // LDNP
+	17078, // LDP
+	17219, // LDPSW
+	17248, // LDR
+	17547, // LDRAA
+	17564, // LDRAB
+	17581, // LDRB
+	17615, // LDRH
+	17649, // LDRSB
+	17716, // LDRSH
+	17783, // LDRSW
+	17825, // LDSET
+	17844, // LDSETA
+	17863, // LDSETAB
+	17873, // LDSETAH
+	17883, // LDSETAL
+	17902, // LDSETALB
+	17912, // LDSETALH
+	17922, // LDSETB
+	17932, // LDSETH
+	17942, // LDSETL
+	17961, // LDSETLB
+	17971, // LDSETLH
+	17981, // LDSMAX
+	18000, // LDSMAXA
+	18019, // LDSMAXAB
+	18029, // LDSMAXAH
+	18039, // LDSMAXAL
+	18058, // LDSMAXALB
+	18068, // LDSMAXALH
+	18078, // LDSMAXB
+	18088, // LDSMAXH
+	18098, // LDSMAXL
+	18117, // LDSMAXLB
+	18127, // LDSMAXLH
+	18137, // LDSMIN
+	18156, // LDSMINA
+	18175, // LDSMINAB
+	18185, // LDSMINAH
+	18195, // LDSMINAL
+	18214, // LDSMINALB
+	18224, // LDSMINALH
+	18234, // LDSMINB
+	18244, // LDSMINH
+	18254, // LDSMINL
+	18273, // LDSMINLB
+	18283, // LDSMINLH
+	18293, // LDTR
+	18310, // LDTRB
+	18319, // LDTRH
+	18328, // LDTRSB
+	18345, // LDTRSH
+	18362, // LDTRSW
+	18371, // LDUMAX
+	18390, // LDUMAXA
+	18409, // LDUMAXAB
+	18419, // LDUMAXAH
+	18429, // LDUMAXAL
+	18448, // LDUMAXALB
+	18458, // LDUMAXALH
+	18468, // LDUMAXB
+	18478, // LDUMAXH
+	18488, // LDUMAXL
+	18507, // LDUMAXLB
+	18517, // LDUMAXLH
+	18527, // LDUMIN
+	18546, // LDUMINA
+	18565, // LDUMINAB
+	18575, // LDUMINAH
+	18585, // LDUMINAL
+	18604, // LDUMINALB
+	18614, // LDUMINALH
+	18624, // LDUMINB
+	18634, // LDUMINH
+	18644, // LDUMINL
+	18663, // LDUMINLB
+	18673, // LDUMINLH
+	18683, // LDUR
+	18740, // LDURB
+	18749, // LDURH
+	18758, // LDURSB
+	18775, // LDURSH
+	18792, // LDURSW
+	18801, // LDXP
+	18820, // LDXR
+	18837, // LDXRB
+	18846, // LDXRH
+	18855, // LSL
+	19036, // LSLV
+	19055, // LSR
+	19236, // LSRV
+	19255, // MADD
+	19276, // MLA
+	19337, // MLS
+	19398, // MNEG
+	19417, // MOV
+	19646, // MOVA
+	19757, // MOVI
+	19823, // MOVK
+	19846, // MOVN
+	19869, // MOVPRFX
+	19968, // MOVZ
+	19991, // MRS
+	20044, // MSR
+	20132, // MSUB
+	20153, // MUL
+	20376, // MVN
+	20409, // MVNI
+	20445, // NBSL
+	20460, // NEG
+	20577, // NEGS
+	20600, // NGC
+	20617, // NGCS
+	20634, // NOP
+	20641, // NOT
+	20696, // ORN
+	20733, // ORR
+	20898, // ORV
+	20943, // PACDA
+	20952, // PACDB
+	20961, // PACDZA
+	20969, // PACDZB
+	20977, // PACGA
+	20987, // PACIA
+	20996, // PACIA1716
+	21003, // PACIASP
+	21010, // PACIAZ
+	21017, // PACIB
+	21026, // PACIB1716
+	21033, // PACIBSP
+	21040, // PACIBZ
+	21047, // PACIZA
+	21055, // PACIZB
+	21063, // PFALSE
+	21072, // PMUL
+	21085, // PMULL
+	21116, // PMULL2
+	21147, // PRFM
+	21196, // PRFUM
+	21213, // PSB
+	21222, // PSSBB
+	21229, // PTEST
+	21240, // PTRUE
+	21309, // PTRUES
+	21378, // RADDHN
+	21424, // RADDHN2
+	21470, // RAX1
+	21486, // RBIT
+	21513, // RDFFR
+	21531, // RDFFRS
+	21541, // RDSVL
+	21550, // RDVL
+	21559, // RET
+	21573, // RETAA
+	21580, // RETAB
+	21587, // REV
+	21684, // REV16
+	21711, // REV32
+	21740, // REV64
+	21779, // RMIF
+	21789, // ROR
+	21826, // RORV
+	21845, // RSHRN
+	21885, // RSHRN2
+	21925, // RSUBHN
+	21971, // RSUBHN2
+	22017, // SABA
+	22054, // SABAL
+	22100, // SABAL2
+	22146, // SABD
+	22235, // SABDL
+	22281, // SABDL2
+	22327, // SADALP
+	22358, // SADDL
+	22404, // SADDL2
+	22450, // SADDLP
+	22481, // SADDLV
+	22510, // SADDV
+	22544, // SADDW
+	22590, // SADDW2
+	22636, // SB
+	22643, // SBC
+	22662, // SBCS
+	22681, // SBFIZ
+	22702, // SBFM
+	22723, // SBFX
+	22744, // SCVTF
+	22998, // SDIV
+	23043, // SDIVR
+	23070, // SDOT
+	23131, // SEL
+	23188, // SETE
+	23198, // SETF16
+	23206, // SETF8
+	23214, // SETFFR
+	23221, // SETGE
+	23231, // SETGM
+	23241, // SETGP
+	23251, // SETM
+	23261, // SETP
+	23271, // SEV
+	23278, // SEVL
+	23285, // SHA1C
+	23297, // SHA1H
+	23306, // SHA1M
+	23318, // SHA1P
+	23330, // SHA1SU0
+	23346, // SHA1SU1
+	23359, // SHA256H
+	23371, // SHA256H2
+	23383, // SHA256SU0
+	23396, // SHA256SU1
+	23412, // SHA512H
+	23424, // SHA512H2
+	23436, // SHA512SU0
+	23449, // SHA512SU1
+	23465, // SHADD
+	23502, // SHL
+	23558, // SHLL
+	23601, // SHLL2
+	23644, // SHRN
+	23684, // SHRN2
+	23724, // SHSUB
+	23761, // SLI
+	23817, // SM3PARTW1
+	23833, // SM3PARTW2
+	23849, // SM3SS1
+	23868, // SM3TT1A
+	23883, // SM3TT1B
+	23898, // SM3TT2A
+	23913, // SM3TT2B
+	23928, // SM4E
+	23941, // SM4EKEY
+	23957, // SMADDL
+	23968, // SMAX
+	24137, // SMAXP
+	24174, // SMAXV
+	24247, // SMC
+	24255, // SMIN
+	24424, // SMINP
+	24461, // SMINV
+	24534, // SMLAL
+	24608, // SMLAL2
+	24682, // SMLSL
+	24756, // SMLSL2
+	24830, // SMMLA
+	24846, // SMNEGL
+	24856, // SMOPA
+	24885, // SMOPS
+	24914, // SMOV
+	24960, // SMSTART
+	24974, // SMSTOP
+	24988, // SMSUBL
+	24999, // SMULH
+	25109, // SMULL
+	25192, // SMULL2
+	25266, // SPLICE
+	25323, // SQABS
+	25398, // SQADD
+	25587, // SQDMLAL
+	25684, // SQDMLAL2
+	25743, // SQDMLSL
+	25840, // SQDMLSL2
+	25899, // SQDMULH
+	26034, // SQDMULL
+	26131, // SQDMULL2
+	26190, // SQNEG
+	26265, // SQRDMLAH
+	26352, // SQRDMLSH
+	26439, // SQRDMULH
+	26526, // SQRSHL
+	26614, // SQRSHRN
+	26681, // SQRSHRN2
+	26721, // SQRSHRUN
+	26788, // SQRSHRUN2
+	26828, // SQSHL
+	26998, // SQSHLU
+	27081, // SQSHRN
+	27148, // SQSHRN2
+	27188, // SQSHRUN
+	27255, // SQSHRUN2
+	27295, // SQSUB
+	27484, // SQXTN
+	27545, // SQXTN2
+	27582, // SQXTUN
+	27643, // SQXTUN2
+	27680, // SRHADD
+	27717, // SRI
+	27773, // SRSHL
+	27834, // SRSHR
+	27890, // SRSRA
+	27946, // SSBB
+	27953, // SSHL
+	28014, // SSHLL
+	28054, // SSHLL2
+	28094, // SSHR
+	28150, // SSRA
+	28206, // SSUBL
+	28252, // SSUBL2
+	28298, // SSUBW
+	28344, // SSUBW2
+	28390, // ST1
+	29275, // ST1B
+	29453, // ST1D
+	29530, // ST1H
+	29711, // ST1Q
+	29735, // ST1W
+	29891, // ST2
+	30201, // ST2G
+	30227, // ST3
+	30537, // ST4
+	30847, // ST64B
+	30856, // ST64BV
+	30866, // ST64BV0
+	30876, // STADD
+	30893, // STADDB
+	30902, // STADDH
+	30911, // STADDL
+	30928, // STADDLB
+	30937, // STADDLH
+	30946, // STCLR
+	30963, // STCLRB
+	30972, // STCLRH
+	30981, // STCLRL
+	30998, // STCLRLB
+	31007, // STCLRLH
+	31016, // STEOR
+	31033, // STEORB
+	31042, // STEORH
+	31051, // STEORL
+	31068, // STEORLB
+	31077, // STEORLH
+	31086, // STG
+	31112, // STGP
+	31141, // STLLR
+	31158, // STLLRB
+	31167, // STLLRH
+	31176, // STLR
+	31193, // STLRB
+	31202, // STLRH
+	31211, // STLUR
+	31228, // STLURB
+	31237, // STLURH
+	31246, // STLXP
+	31267, // STLXR
+	31286, // STLXRB
+	31296, // STLXRH
+	31306, // STNP
+	31352, // STP
+	31493, // STR
+	31752, // STRB
+	31786, // STRH
+	31820, // STSET
+	31837, // STSETB
+	31846, // STSETH
+	31855, // STSETL
+	31872, // STSETLB
+	31881, // STSETLH
+	31890, // STSMAX
+	31907, // STSMAXB
+	31916, // STSMAXH
+	31925, // STSMAXL
+	31942, // STSMAXLB
+	31951, // STSMAXLH
+	31960, // STSMIN
+	31977, // STSMINB
+	31986, // STSMINH
+	31995, // STSMINL
+	32012, // STSMINLB
+	32021, // STSMINLH
+	32030, // STTR
+	32047, // STTRB
+	32056, // STTRH
+	32065, // STUMAX
+	32082, // STUMAXB
+	32091, // STUMAXH
+	32100, // STUMAXL
+	32117, // STUMAXLB
+	32126, // STUMAXLH
+	32135, // STUMIN
+	32152, // STUMINB
+	32161, // STUMINH
+	32170, // STUMINL
+	32187, // STUMINLB
+	32196, // STUMINLH
+	32205, // STUR
+	32262, // STURB
+	32271, // STURH
+	32280, // STXP
+	32301, // STXR
+	32320, // STXRB
+	32330, // STXRH
+	32340, // STZ2G
+	32366, // STZG
+	32392, // SUB
+	32690, // SUBG
+	32701, // SUBHN
+	32747, // SUBHN2
+	32793, // SUBP
+	32803, // SUBPS
+	32813, // SUBR
+	32919, // SUBS
+	33004, // SUDOT
+	33035, // SUMOPA
+	33064, // SUMOPS
+	33093, // SUNPKHI
+	33124, // SUNPKLO
+	33155, // SUQADD
+	33230, // SVC
+	33238, // SWP
+	33257, // SWPA
+	33276, // SWPAB
+	33286, // SWPAH
+	33296, // SWPAL
+	33315, // SWPALB
+	33325, // SWPALH
+	33335, // SWPB
+	33345, // SWPH
+	33355, // SWPL
+	33374, // SWPLB
+	33384, // SWPLH
+	33394, // SXTB
+	33411, // SXTH
+	33428, // SXTL
+	33465, // SXTL2
+	33502, // SXTW
+	33511, // SYS
+	33524, // SYSL
+	33536, // TBL
+	33645, // TBNZ
+	33664, // TBX
+	33721, // TBZ
+	33740, // TLBI
+	33750, // TRN1
+	33898, // TRN2
+	34046, // TSB
+	34055, // TST
+	34094, // UABA
+	34131, // UABAL
+	34177, // UABAL2
+	34223, // UABD
+	34312, // UABDL
+	34358, // UABDL2
+	34404, // UADALP
+	34435, // UADDL
+	34481, // UADDL2
+	34527, // UADDLP
+	34558, // UADDLV
+	34587, // UADDV
+	34632, // UADDW
+	34678, // UADDW2
+	34724, // UBFIZ
+	34745, // UBFM
+	34766, // UBFX
+	34787, // UCVTF
+	35041, // UDF
+	35049, // UDIV
+	35094, // UDIVR
+	35121, // UDOT
+	35182, // UHADD
+	35219, // UHSUB
+	35256, // UMADDL
+	35267, // UMAX
+	35436, // UMAXP
+	35473, // UMAXV
+	35546, // UMIN
+	35715, // UMINP
+	35752, // UMINV
+	35825, // UMLAL
+	35899, // UMLAL2
+	35973, // UMLSL
+	36047, // UMLSL2
+	36121, // UMMLA
+	36137, // UMNEGL
+	36147, // UMOPA
+	36176, // UMOPS
+	36205, // UMOV
+	36242, // UMSUBL
+	36253, // UMULH
+	36363, // UMULL
+	36446, // UMULL2
+	36520, // UQADD
+	36709, // UQRSHL
+	36797, // UQRSHRN
+	36864, // UQRSHRN2
+	36904, // UQSHL
+	37074, // UQSHRN
+	37141, // UQSHRN2
+	37181, // UQSUB
+	37370, // UQXTN
+	37431, // UQXTN2
+	37468, // URECPE
+	37479, // URHADD
+	37516, // URSHL
+	37577, // URSHR
+	37633, // URSQRTE
+	37644, // URSRA
+	37700, // USDOT
+	37761, // USHL
+	37822, // USHLL
+	37862, // USHLL2
+	37902, // USHR
+	37958, // USMMLA
+	37974, // USMOPA
+	38003, // USMOPS
+	38032, // USQADD
+	38107, // USRA
+	38163, // USUBL
+	38209, // USUBL2
+	38255, // USUBW
+	38301, // USUBW2
+	38347, // UUNPKHI
+	38378, // UUNPKLO
+	38409, // UXTB
+	38418, // UXTH
+	38427, // UXTL
+	38464, // UXTL2
+	38501, // UZP1
+	38649, // UZP2
+	38797, // WFE
+	38804, // WFET
+	38812, // WFI
+	38819, // WFIT
+	38827, // WHILEGE
+	38908, // WHILEGT
+	38989, // WHILEHI
+	39070, // WHILEHS
+	39151, // WHILELE
+	39232, // WHILELO
+	39313, // WHILELS
+	39394, // WHILELT
+	39475, // WRFFR
+	39484, // XAFLAG
+	39491, // XAR
+	39508, // XPACD
+	39516, // XPACI
+	39524, // XPACLRI
+	39531, // XTN
+	39568, // XTN2
+	39605, // YIELD
+	39612, // ZERO
+	39620, // ZIP1
+	39768, // ZIP2
+}
+
+// The InstFeatures table contains the union of features required by any encoding of each Inst.
+var InstFeatures = [...]Features{
+	0,
+	1<<FeatCSSC | 1<<FeatSVE,      // ABS
+	0,                             // ADC
+	0,                             // ADCS
+	1 << FeatSVE,                  // ADD
+	1 << FeatMTE,                  // ADDG
+	1<<FeatSME | 1<<FeatSMEI16I64, // ADDHA
+	0,                             // ADDHN
+	0,                             // ADDHN2
+	0,                             // ADDP
+	1 << FeatSVE,                  // ADDPL
+	0,                             // ADDS
+	1 << FeatSME,                  // ADDSPL
+	1 << FeatSME,                  // ADDSVL
+	0,                             // ADDV
+	1<<FeatSME | 1<<FeatSMEI16I64, // ADDVA
+	1 << FeatSVE,                  // ADDVL
+	0,                             // ADR
+	0,                             // ADRP
+	1 << FeatAES,                  // AESD
+	1 << FeatAES,                  // AESE
+	1 << FeatAES,                  // AESIMC
+	1 << FeatAES,                  // AESMC
+	1 << FeatSVE,                  // AND
+	0,                             // ANDS
+	1 << FeatSVE,                  // ANDV
+	1 << FeatSVE,                  // ASR
+	0,                             // ASRV
+	0,                             // AT
+	1 << FeatPAuth,                // AUTDA
+	1 << FeatPAuth,                // AUTDB
+	1 << FeatPAuth,                // AUTDZA
+	1 << FeatPAuth,                // AUTDZB
+	1 << FeatPAuth,                // AUTIA
+	0,                             // AUTIA1716
+	0,                             // AUTIASP
+	0,                             // AUTIAZ
+	1 << FeatPAuth,                // AUTIB
+	0,                             // AUTIB1716
+	0,                             // AUTIBSP
+	0,                             // AUTIBZ
+	1 << FeatPAuth,                // AUTIZA
+	1 << FeatPAuth,                // AUTIZB
+	1 << FeatFlagM2,               // AXFLAG
+	0,                             // B
+	1<<FeatSHA3 | 1<<FeatSVE2,     // BCAX
+	0,                             // BFC
+	1 << FeatBF16,                 // BFCVT
+	1 << FeatBF16,                 // BFCVTN
+	1 << FeatBF16,                 // BFCVTN2
+	1 << FeatBF16,                 // BFDOT
+	0,                             // BFI
+	0,                             // BFM
+	1 << FeatBF16,                 // BFMLALB
+	1 << FeatBF16,                 // BFMLALT
+	1 << FeatBF16,                 // BFMMLA
+	1 << FeatSME,                  // BFMOPA
+	1 << FeatSME,                  // BFMOPS
+	0,                             // BFXIL
+	1 << FeatSVE,                  // BIC
+	0,                             // BICS
+	0,                             // BIF
+	0,                             // BIT
+	0,                             // BL
+	0,                             // BLR
+	1 << FeatPAuth,                // BLRAA
+	1 << FeatPAuth,                // BLRAAZ
+	1 << FeatPAuth,                // BLRAB
+	1 << FeatPAuth,                // BLRABZ
+	0,                             // BR
+	1 << FeatPAuth,                // BRAA
+	1 << FeatPAuth,                // BRAAZ
+	1 << FeatPAuth,                // BRAB
+	1 << FeatPAuth,                // BRABZ
+	0,                             // BRK
+	1 << FeatSVE2,                 // BSL
+	1 << FeatSVE2,                 // BSL1N
+	1 << FeatSVE2,                 // BSL2N
+	0,                             // BTI
+	1 << FeatLSE,                  // CAS
+	1 << FeatLSE,                  // CASA
+	1 << FeatLSE,                  // CASAB
+	1 << FeatLSE,                  // CASAH
+	1 << FeatLSE,                  // CASAL
+	1 << FeatLSE,                  // CASALB
+	1 << FeatLSE,                  // CASALH
+	1 << FeatLSE,                  // CASB
+	1 << FeatLSE,                  // CASH
+	1 << FeatLSE,                  // CASL
+	1 << FeatLSE,                  // CASLB
+	1 << FeatLSE,                  // CASLH
+	1 << FeatLSE,                  // CASP
+	1 << FeatLSE,                  // CASPA
+	1 << FeatLSE,                  // CASPAL
+	1 << FeatLSE,                  // CASPL
+	0,                             // CBNZ
+	0,                             // CBZ
+	0,                             // CCMN
+	0,                             // CCMP
+	1 << FeatFlagM,                // CFINV
+	1 << FeatSPECRES,              // CFP
+	0,                             // CINC
+	0,                             // CINV
+	0,                             // CLRBHB
+	0,                             // CLREX
+	1 << FeatSVE,                  // CLS
+	1 << FeatSVE,                  // CLZ
+	0,                             // CMEQ
+	0,                             // CMGE
+	0,                             // CMGT
+	0,                             // CMHI
+	0,                             // CMHS
+	0,                             // CMLE
+	0,                             // CMLT
+	0,                             // CMN
+	0,                             // CMP
+	1 << FeatSVE,                  // CMPEQ
+	1 << FeatSVE,                  // CMPGE
+	1 << FeatSVE,                  // CMPGT
+	1 << FeatSVE,                  // CMPHI
+	1 << FeatSVE,                  // CMPHS
+	1 << FeatSVE,                  // CMPLE
+	1 << FeatSVE,                  // CMPLO
+	1 << FeatSVE,                  // CMPLS
+	1 << FeatSVE,                  // CMPLT
+	1 << FeatSVE,                  // CMPNE
+	0,                             // CMTST
+	0,                             // CNEG
+	1<<FeatCSSC | 1<<FeatSVE,      // CNT
+	1 << FeatSVE,                  // CNTB
+	1 << FeatSVE,                  // CNTD
+	1 << FeatSVE,                  // CNTH
+	1 << FeatSVE,                  // CNTW
+	1 << FeatSVE,                  // COMPACT
+	1 << FeatSPECRES,              // CPP
+	1 << FeatMOPS,                 // CPYE
+	1 << FeatMOPS,                 // CPYFE
+	1 << FeatMOPS,                 // CPYFM
+	1 << FeatMOPS,                 // CPYFP
+	1 << FeatMOPS,                 // CPYM
+	1 << FeatMOPS,                 // CPYP
+	1 << FeatCRC32,                // CRC32B
+	1 << FeatCRC32,                // CRC32CB
+	1 << FeatCRC32,                // CRC32CH
+	1 << FeatCRC32,                // CRC32CW
+	1 << FeatCRC32,                // CRC32CX
+	1 << FeatCRC32,                // CRC32H
+	1 << FeatCRC32,                // CRC32W
+	1 << FeatCRC32,                // CRC32X
+	0,                             // CSDB
+	0,                             // CSEL
+	0,                             // CSET
+	0,                             // CSETM
+	0,                             // CSINC
+	0,                             // CSINV
+	0,                             // CSNEG
+	1 << FeatCSSC,                 // CTZ
+	0,                             // DC
+	0,                             // DCPS1
+	0,                             // DCPS2
+	0,                             // DCPS3
+	1 << FeatSVE,                  // DECB
+	1 << FeatSVE,                  // DECD
+	1 << FeatSVE,                  // DECH
+	1 << FeatSVE,                  // DECW
+	0,                             // DGH
+	0,                             // DMB
+	0,                             // DRPS
+	0,                             // DSB
+	1 << FeatSVE,                  // DUP
+	1 << FeatSPECRES,              // DVP
+	0,                             // EON
+	1 << FeatSVE,                  // EOR
+	1<<FeatSHA3 | 1<<FeatSVE2,     // EOR3
+	1 << FeatSVE,                  // EORV
+	0,                             // ERET
+	1 << FeatPAuth,                // ERETAA
+	1 << FeatPAuth,                // ERETAB
+	0,                             // ESB
+	1 << FeatSVE,                  // EXT
+	0,                             // EXTR
+	1<<FeatFP16 | 1<<FeatSVE,      // FABD
+	1<<FeatFP16 | 1<<FeatSVE,      // FABS
+	1 << FeatFP16,                 // FACGE
+	1 << FeatFP16,                 // FACGT
+	1<<FeatFP16 | 1<<FeatSVE,      // FADD
+	1 << FeatFP16,                 // FADDP
+	1 << FeatSVE,                  // FADDV
+	1<<FeatFP16 | 1<<FeatFCMA,     // FCADD
+	1 << FeatFP16,                 // FCCMP
+	1 << FeatFP16,                 // FCCMPE
+	1<<FeatFP16 | 1<<FeatSVE,      // FCMEQ
+	1<<FeatFP16 | 1<<FeatSVE,      // FCMGE
+	1<<FeatFP16 | 1<<FeatSVE,      // FCMGT
+	1<<FeatFP16 | 1<<FeatFCMA,     // FCMLA
+	1 << FeatFP16,                 // FCMLE
+	1 << FeatFP16,                 // FCMLT
+	1 << FeatSVE,                  // FCMNE
+	1 << FeatFP16,                 // FCMP
+	1 << FeatFP16,                 // FCMPE
+	1 << FeatFP16,                 // FCSEL
+	0,                             // FCVT
+	1 << FeatFP16,                 // FCVTAS
+	1 << FeatFP16,                 // FCVTAU
+	0,                             // FCVTL
+	0,                             // FCVTL2
+	1 << FeatFP16,                 // FCVTMS
+	1 << FeatFP16,                 // FCVTMU
+	0,                             // FCVTN
+	0,                             // FCVTN2
+	1 << FeatFP16,                 // FCVTNS
+	1 << FeatFP16,                 // FCVTNU
+	1 << FeatFP16,                 // FCVTPS
+	1 << FeatFP16,                 // FCVTPU
+	0,                             // FCVTXN
+	0,                             // FCVTXN2
+	1<<FeatFP16 | 1<<FeatSVE,      // FCVTZS
+	1<<FeatFP16 | 1<<FeatSVE,      // FCVTZU
+	1<<FeatFP16 | 1<<FeatSVE,      // FDIV
+	1 << FeatSVE,                  // FDIVR
+	1 << FeatSVE,                  // FDUP
+	1 << FeatJSCVT,                // FJCVTZS
+	1 << FeatFP16,                 // FMADD
+	1<<FeatFP16 | 1<<FeatSVE,      // FMAX
+	1<<FeatFP16 | 1<<FeatSVE,      // FMAXNM
+	1 << FeatFP16,                 // FMAXNMP
+	1<<FeatFP16 | 1<<FeatSVE,      // FMAXNMV
+	1 << FeatFP16,                 // FMAXP
+	1<<FeatFP16 | 1<<FeatSVE,      // FMAXV
+	1<<FeatFP16 | 1<<FeatSVE,      // FMIN
+	1<<FeatFP16 | 1<<FeatSVE,      // FMINNM
+	1 << FeatFP16,                 // FMINNMP
+	1<<FeatFP16 | 1<<FeatSVE,      // FMINNMV
+	1 << FeatFP16,                 // FMINP
+	1<<FeatFP16 | 1<<FeatSVE,      // FMINV
+	1<<FeatFP16 | 1<<FeatSVE,      // FMLA
+	1 << FeatFHM,                  // FMLAL
+	1 << FeatFHM,                  // FMLAL2
+	1<<FeatFP16 | 1<<FeatSVE,      // FMLS
+	1 << FeatFHM,                  // FMLSL
+	1 << FeatFHM,                  // FMLSL2
+	1<<FeatSME | 1<<FeatSMEF64F64, // FMOPA
+	1<<FeatSME | 1<<FeatSMEF64F64, // FMOPS
+	1<<FeatFP16 | 1<<FeatSVE,      // FMOV
+	1 << FeatFP16,                 // FMSUB
+	1<<FeatFP16 | 1<<FeatSVE,      // FMUL
+	1<<FeatFP16 | 1<<FeatSVE,      // FMULX
+	1<<FeatFP16 | 1<<FeatSVE,      // FNEG
+	1 << FeatFP16,                 // FNMADD
+	1 << FeatSVE,                  // FNMLA
+	1 << FeatSVE,                  // FNMLS
+	1 << FeatFP16,                 // FNMSUB
+	1 << FeatFP16,                 // FNMUL
+	1 << FeatFP16,                 // FRECPE
+	1<<FeatFP16 | 1<<FeatSVE,      // FRECPS
+	1<<FeatFP16 | 1<<FeatSVE,      // FRECPX
+	1 << FeatFRINTTS,              // FRINT32X
+	1 << FeatFRINTTS,              // FRINT32Z
+	1 << FeatFRINTTS,              // FRINT64X
+	1 << FeatFRINTTS,              // FRINT64Z
+	1<<FeatFP16 | 1<<FeatSVE,      // FRINTA
+	1<<FeatFP16 | 1<<FeatSVE,      // FRINTI
+	1<<FeatFP16 | 1<<FeatSVE,      // FRINTM
+	1<<FeatFP16 | 1<<FeatSVE,      // FRINTN
+	1<<FeatFP16 | 1<<FeatSVE,      // FRINTP
+	1<<FeatFP16 | 1<<FeatSVE,      // FRINTX
+	1<<FeatFP16 | 1<<FeatSVE,      // FRINTZ
+	1 << FeatFP16,                 // FRSQRTE
+	1<<FeatFP16 | 1<<FeatSVE,      // FRSQRTS
+	1 << FeatSVE,                  // FSCALE
+	1<<FeatFP16 | 1<<FeatSVE,      // FSQRT
+	1<<FeatFP16 | 1<<FeatSVE,      // FSUB
+	1 << FeatSVE,                  // FSUBR
+	1 << FeatMTE,                  // GMI
+	0,                             // HINT
+	0,                             // HLT
+	0,                             // HVC
+	0,                             // IC
+	1 << FeatSVE,                  // INCB
+	1 << FeatSVE,                  // INCD
+	1 << FeatSVE,                  // INCH
+	1 << FeatSVE,                  // INCW
+	1 << FeatSVE,                  // INDEX
+	0,                             // INS
+	1 << FeatMTE,                  // IRG
+	0,                             // ISB
+	0,                             // LD1
+	1<<FeatSVE | 1<<FeatSME,       // LD1B
+	1<<FeatSVE | 1<<FeatSME,       // LD1D
+	1<<FeatSVE | 1<<FeatSME,       // LD1H
+	1 << FeatSME,                  // LD1Q
+	0,                             // LD1R
+	1 << FeatSVE,                  // LD1RB
+	1 << FeatSVE,                  // LD1RD
+	1 << FeatSVE,                  // LD1RH
+	1 << FeatSVE,                  // LD1RW
+	1<<FeatSVE | 1<<FeatSME,       // LD1W
+	0,                             // LD2
+	0,                             // LD2R
+	0,                             // LD3
+	0,                             // LD3R
+	0,                             // LD4
+	0,                             // LD4R
+	1 << FeatLS64,                 // LD64B
+	1 << FeatLSE,                  // LDADD
+	1 << FeatLSE,                  // LDADDA
+	1 << FeatLSE,                  // LDADDAB
+	1 << FeatLSE,                  // LDADDAH
+	1 << FeatLSE,                  // LDADDAL
+	1 << FeatLSE,                  // LDADDALB
+	1 << FeatLSE,                  // LDADDALH
+	1 << FeatLSE,                  // LDADDB
+	1 << FeatLSE,                  // LDADDH
+	1 << FeatLSE,                  // LDADDL
+	1 << FeatLSE,                  // LDADDLB
+	1 << FeatLSE,                  // LDADDLH
+	1 << FeatLRCPC,                // LDAPR
+	1 << FeatLRCPC,                // LDAPRB
+	1 << FeatLRCPC,                // LDAPRH
+	1 << FeatLRCPC2,               // LDAPUR
+	1 << FeatLRCPC2,               // LDAPURB
+	1 << FeatLRCPC2,               // LDAPURH
+	1 << FeatLRCPC2,               // LDAPURSB
+	1 << FeatLRCPC2,               // LDAPURSH
+	1 << FeatLRCPC2,               // LDAPURSW
+	0,                             // LDAR
+	0,                             // LDARB
+	0,                             // LDARH
+	0,                             // LDAXP
+	0,                             // LDAXR
+	0,                             // LDAXRB
+	0,                             // LDAXRH
+	1 << FeatLSE,                  // LDCLR
+	1 << FeatLSE,                  // LDCLRA
+	1 << FeatLSE,                  // LDCLRAB
+	1 << FeatLSE,                  // LDCLRAH
+	1 << FeatLSE,                  // LDCLRAL
+	1 << FeatLSE,                  // LDCLRALB
+	1 << FeatLSE,                  // LDCLRALH
+	1 << FeatLSE,                  // LDCLRB
+	1 << FeatLSE,                  // LDCLRH
+	1 << FeatLSE,                  // LDCLRL
+	1 << FeatLSE,                  // LDCLRLB
+	1 << FeatLSE,                  // LDCLRLH
+	1 << FeatLSE,                  // LDEOR
+	1 << FeatLSE,                  // LDEORA
+	1 << FeatLSE,                  // LDEORAB
+	1 << FeatLSE,                  // LDEORAH
+	1 << FeatLSE,                  // LDEORAL
+	1 << FeatLSE,                  // LDEORALB
+	1 << FeatLSE,                  // LDEORALH
+	1 << FeatLSE,                  // LDEORB
+	1 << FeatLSE,                  // LDEORH
+	1 << FeatLSE,                  // LDEORL
+	1 << FeatLSE,                  // LDEORLB
+	1 << FeatLSE,                  // LDEORLH
+	1 << FeatSVE,                  // LDFF1B
+	1 << FeatSVE,                  // LDFF1D
+	1 << FeatSVE,                  // LDFF1H
+	1 << FeatSVE,                  // LDFF1W
+	1 << FeatMTE,                  // LDG
+	1 << FeatLOR,                  // LDLAR
+	1 << FeatLOR,                  // LDLARB
+	1 << FeatLOR,                  // LDLARH
+	0,                             // LDNP
+	0,                             // LDP
+	0,                             // LDPSW
+	1<<FeatSVE | 1<<FeatSME,       // LDR
+	1 << FeatPAuth,                // LDRAA
+	1 << FeatPAuth,                // LDRAB
+	0,                             // LDRB
+	0,                             // LDRH
+	0,                             // LDRSB
+	0,                             // LDRSH
+	0,                             // LDRSW
+	1 << FeatLSE,                  // LDSET
+	1 << FeatLSE,                  // LDSETA
+	1 << FeatLSE,                  // LDSETAB
+	1 << FeatLSE,                  // LDSETAH
+	1 << FeatLSE,                  // LDSETAL
+	1 << FeatLSE,                  // LDSETALB
+	1 << FeatLSE,                  // LDSETALH
+	1 << FeatLSE,                  // LDSETB
+	1 << FeatLSE,                  // LDSETH
+	1 << FeatLSE,                  // LDSETL
+	1 << FeatLSE,                  // LDSETLB
+	1 << FeatLSE,                  // LDSETLH
+	1 << FeatLSE,                  // LDSMAX
+	1 << FeatLSE,                  // LDSMAXA
+	1 << FeatLSE,                  // LDSMAXAB
+	1 << FeatLSE,                  // LDSMAXAH
+	1 << FeatLSE,                  // LDSMAXAL
+	1 << FeatLSE,                  // LDSMAXALB
+	1 << FeatLSE,                  // LDSMAXALH
+	1 << FeatLSE,                  // LDSMAXB
+	1 << FeatLSE,                  // LDSMAXH
+	1 << FeatLSE,                  // LDSMAXL
+	1 << FeatLSE,                  // LDSMAXLB
+	1 << FeatLSE,                  // LDSMAXLH
+	1 << FeatLSE,                  // LDSMIN
+	1 << FeatLSE,                  // LDSMINA
+	1 << FeatLSE,                  // LDSMINAB
+	1 << FeatLSE,                  // LDSMINAH
+	1 << FeatLSE,                  // LDSMINAL
+	1 << FeatLSE,                  // LDSMINALB
+	1 << FeatLSE,                  // LDSMINALH
+	1 << FeatLSE,                  // LDSMINB
+	1 << FeatLSE,                  // LDSMINH
+	1 << FeatLSE,                  // LDSMINL
+	1 << FeatLSE,                  // LDSMINLB
+	1 << FeatLSE,                  // LDSMINLH
+	0,                             // LDTR
+	0,                             // LDTRB
+	0,                             // LDTRH
+	0,                             // LDTRSB
+	0,                             // LDTRSH
+	0,                             // LDTRSW
+	1 << FeatLSE,                  // LDUMAX
+	1 << FeatLSE,                  // LDUMAXA
+	1 << FeatLSE,                  // LDUMAXAB
+	1 << FeatLSE,                  // LDUMAXAH
+	1 << FeatLSE,                  // LDUMAXAL
+	1 << FeatLSE,                  // LDUMAXALB
+	1 << FeatLSE,                  // LDUMAXALH
+	1 << FeatLSE,                  // LDUMAXB
+	1 << FeatLSE,                  // LDUMAXH
+	1 << FeatLSE,                  // LDUMAXL
+	1 << FeatLSE,                  // LDUMAXLB
+	1 << FeatLSE,                  // LDUMAXLH
+	1 << FeatLSE,                  // LDUMIN
+	1 << FeatLSE,                  // LDUMINA
+	1 << FeatLSE,                  // LDUMINAB
+	1 << FeatLSE,                  // LDUMINAH
+	1 << FeatLSE,                  // LDUMINAL
+	1 << FeatLSE,                  // LDUMINALB
+	1 << FeatLSE,                  // LDUMINALH
+	1 << FeatLSE,                  // LDUMINB
+	1 << FeatLSE,                  // LDUMINH
+	1 << FeatLSE,                  // LDUMINL
+	1 << FeatLSE,                  // LDUMINLB
+	1 << FeatLSE,                  // LDUMINLH
+	0,                             // LDUR
+	0,                             // LDURB
+	0,                             // LDURH
+	0,                             // LDURSB
+	0,                             // LDURSH
+	0,                             // LDURSW
+	0,                             // LDXP
+	0,                             // LDXR
+	0,                             // LDXRB
+	0,                             // LDXRH
+	1 << FeatSVE,                  // LSL
+	0,                             // LSLV
+	1 << FeatSVE,                  // LSR
+	0,                             // LSRV
+	0,                             // MADD
+	0,                             // MLA
+	0,                             // MLS
+	0,                             // MNEG
+	0,                             // MOV
+	1 << FeatSME,                  // MOVA
+	0,                             // MOVI
+	0,                             // MOVK
+	0,                             // MOVN
+	1 << FeatSVE,                  // MOVPRFX
+	0,                             // MOVZ
+	0,                             // MRS
+	0,                             // MSR
+	0,                             // MSUB
+	1<<FeatSVE | 1<<FeatSVE2,      // MUL
+	0,                             // MVN
+	0,                             // MVNI
+	1 << FeatSVE2,                 // NBSL
+	1 << FeatSVE,                  // NEG
+	0,                             // NEGS
+	0,                             // NGC
+	0,                             // NGCS
+	0,                             // NOP
+	1 << FeatSVE,                  // NOT
+	0,                             // ORN
+	1 << FeatSVE,                  // ORR
+	1 << FeatSVE,                  // ORV
+	1 << FeatPAuth,                // PACDA
+	1 << FeatPAuth,                // PACDB
+	1 << FeatPAuth,                // PACDZA
+	1 << FeatPAuth,                // PACDZB
+	1 << FeatPAuth,                // PACGA
+	1 << FeatPAuth,                // PACIA
+	0,                             // PACIA1716
+	0,                             // PACIASP
+	0,                             // PACIAZ
+	1 << FeatPAuth,                // PACIB
+	0,                             // PACIB1716
+	0,                             // PACIBSP
+	0,                             // PACIBZ
+	1 << FeatPAuth,                // PACIZA
+	1 << FeatPAuth,                // PACIZB
+	1 << FeatSVE,                  // PFALSE
+	0,                             // PMUL
+	1 << FeatAES,                  // PMULL
+	1 << FeatAES,                  // PMULL2
+	0,                             // PRFM
+	0,                             // PRFUM
+	0,                             // PSB
+	0,                             // PSSBB
+	1 << FeatSVE,                  // PTEST
+	1 << FeatSVE,                  // PTRUE
+	1 << FeatSVE,                  // PTRUES
+	0,                             // RADDHN
+	0,                             // RADDHN2
+	1 << FeatSHA3,                 // RAX1
+	0,                             // RBIT
+	1 << FeatSVE,                  // RDFFR
+	1 << FeatSVE,                  // RDFFRS
+	1 << FeatSME,                  // RDSVL
+	1 << FeatSVE,                  // RDVL
+	0,                             // RET
+	1 << FeatPAuth,                // RETAA
+	1 << FeatPAuth,                // RETAB
+	1 << FeatSVE,                  // REV
+	0,                             // REV16
+	0,                             // REV32
+	0,                             // REV64
+	1 << FeatFlagM,                // RMIF
+	0,                             // ROR
+	0,                             // RORV
+	0,                             // RSHRN
+	0,                             // RSHRN2
+	0,                             // RSUBHN
+	0,                             // RSUBHN2
+	0,                             // SABA
+	0,                             // SABAL
+	0,                             // SABAL2
+	1 << FeatSVE,                  // SABD
+	0,                             // SABDL
+	0,                             // SABDL2
+	0,                             // SADALP
+	0,                             // SADDL
+	0,                             // SADDL2
+	0,                             // SADDLP
+	0,                             // SADDLV
+	1 << FeatSVE,                  // SADDV
+	0,                             // SADDW
+	0,                             // SADDW2
+	1 << FeatSB,                   // SB
+	0,                             // SBC
+	0,                             // SBCS
+	0,                             // SBFIZ
+	0,                             // SBFM
+	0,                             // SBFX
+	1<<FeatFP16 | 1<<FeatSVE,      // SCVTF
+	1 << FeatSVE,                  // SDIV
+	1 << FeatSVE,                  // SDIVR
+	1 << FeatDotProd,              // SDOT
+	1 << FeatSVE,                  // SEL
+	1 << FeatMOPS,                 // SETE
+	1 << FeatFlagM,                // SETF16
+	1 << FeatFlagM,                // SETF8
+	1 << FeatSVE,                  // SETFFR
+	1<<FeatMTE | 1<<FeatMOPS,      // SETGE
+	1<<FeatMTE | 1<<FeatMOPS,      // SETGM
+	1<<FeatMTE | 1<<FeatMOPS,      // SETGP
+	1 << FeatMOPS,                 // SETM
+	1 << FeatMOPS,                 // SETP
+	0,                             // SEV
+	0,                             // SEVL
+	1 << FeatSHA1,                 // SHA1C
+	1 << FeatSHA1,                 // SHA1H
+	1 << FeatSHA1,                 // SHA1M
+	1 << FeatSHA1,                 // SHA1P
+	1 << FeatSHA1,                 // SHA1SU0
+	1 << FeatSHA1,                 // SHA1SU1
+	1 << FeatSHA256,               // SHA256H
+	1 << FeatSHA256,               // SHA256H2
+	1 << FeatSHA256,               // SHA256SU0
+	1 << FeatSHA256,               // SHA256SU1
+	1 << FeatSHA512,               // SHA512H
+	1 << FeatSHA512,               // SHA512H2
+	1 << FeatSHA512,               // SHA512SU0
+	1 << FeatSHA512,               // SHA512SU1
+	0,                             // SHADD
+	0,                             // SHL
+	0,                             // SHLL
+	0,                             // SHLL2
+	0,                             // SHRN
+	0,                             // SHRN2
+	0,                             // SHSUB
+	0,                             // SLI
+	1 << FeatSM3,                  // SM3PARTW1
+	1 << FeatSM3,                  // SM3PARTW2
+	1 << FeatSM3,                  // SM3SS1
+	1 << FeatSM3,                  // SM3TT1A
+	1 << FeatSM3,                  // SM3TT1B
+	1 << FeatSM3,                  // SM3TT2A
+	1 << FeatSM3,                  // SM3TT2B
+	1 << FeatSM4,                  // SM4E
+	1 << FeatSM4,                  // SM4EKEY
+	0,                             // SMADDL
+	1<<FeatCSSC | 1<<FeatSVE,      // SMAX
+	0,                             // SMAXP
+	1 << FeatSVE,                  // SMAXV
+	0,                             // SMC
+	1<<FeatCSSC | 1<<FeatSVE,      // SMIN
+	0,                             // SMINP
+	1 << FeatSVE,                  // SMINV
+	0,                             // SMLAL
+	0,                             // SMLAL2
+	0,                             // SMLSL
+	0,                             // SMLSL2
+	1 << FeatI8MM,                 // SMMLA
+	0,                             // SMNEGL
+	1<<FeatSME | 1<<FeatSMEI16I64, // SMOPA
+	1<<FeatSME | 1<<FeatSMEI16I64, // SMOPS
+	0,                             // SMOV
+	1 << FeatSME,                  // SMSTART
+	1 << FeatSME,                  // SMSTOP
+	0,                             // SMSUBL
+	1<<FeatSVE | 1<<FeatSVE2,      // SMULH
+	0,                             // SMULL
+	0,                             // SMULL2
+	1 << FeatSVE,                  // SPLICE
+	0,                             // SQABS
+	1 << FeatSVE,                  // SQADD
+	0,                             // SQDMLAL
+	0,                             // SQDMLAL2
+	0,                             // SQDMLSL
+	0,                             // SQDMLSL2
+	1 << FeatSVE2,                 // SQDMULH
+	0,                             // SQDMULL
+	0,                             // SQDMULL2
+	0,                             // SQNEG
+	1 << FeatRDM,                  // SQRDMLAH
+	1 << FeatRDM,                  // SQRDMLSH
+	0,                             // SQRDMULH
+	0,                             // SQRSHL
+	0,                             // SQRSHRN
+	0,                             // SQRSHRN2
+	0,                             // SQRSHRUN
+	0,                             // SQRSHRUN2
+	0,                             // SQSHL
+	0,                             // SQSHLU
+	0,                             // SQSHRN
+	0,                             // SQSHRN2
+	0,                             // SQSHRUN
+	0,                             // SQSHRUN2
+	1 << FeatSVE,                  // SQSUB
+	0,                             // SQXTN
+	0,                             // SQXTN2
+	0,                             // SQXTUN
+	0,                             // SQXTUN2
+	0,                             // SRHADD
+	0,                             // SRI
+	0,                             // SRSHL
+	0,                             // SRSHR
+	0,                             // SRSRA
+	0,                             // SSBB
+	0,                             // SSHL
+	0,                             // SSHLL
+	0,                             // SSHLL2
+	0,                             // SSHR
+	0,                             // SSRA
+	0,                             // SSUBL
+	0,                             // SSUBL2
+	0,                             // SSUBW
+	0,                             // SSUBW2
+	0,                             // ST1
+	1<<FeatSVE | 1<<FeatSME,       // ST1B
+	1<<FeatSVE | 1<<FeatSME,       // ST1D
+	1<<FeatSVE | 1<<FeatSME,       // ST1H
+	1 << FeatSME,                  // ST1Q
+	1<<FeatSVE | 1<<FeatSME,       // ST1W
+	0,                             // ST2
+	1 << FeatMTE,                  // ST2G
+	0,                             // ST3
+	0,                             // ST4
+	1 << FeatLS64,                 // ST64B
+	1 << FeatLS64,                 // ST64BV
+	1 << FeatLS64,                 // ST64BV0
+	1 << FeatLSE,                  // STADD
+	1 << FeatLSE,                  // STADDB
+	1 << FeatLSE,                  // STADDH
+	1 << FeatLSE,                  // STADDL
+	1 << FeatLSE,                  // STADDLB
+	1 << FeatLSE,                  // STADDLH
+	1 << FeatLSE,                  // STCLR
+	1 << FeatLSE,                  // STCLRB
+	1 << FeatLSE,                  // STCLRH
+	1 << FeatLSE,                  // STCLRL
+	1 << FeatLSE,                  // STCLRLB
+	1 << FeatLSE,                  // STCLRLH
+	1 << FeatLSE,                  // STEOR
+	1 << FeatLSE,                  // STEORB
+	1 << FeatLSE,                  // STEORH
+	1 << FeatLSE,                  // STEORL
+	1 << FeatLSE,                  // STEORLB
+	1 << FeatLSE,                  // STEORLH
+	1 << FeatMTE,                  // STG
+	1 << FeatMTE,                  // STGP
+	1 << FeatLOR,                  // STLLR
+	1 << FeatLOR,                  // STLLRB
+	1 << FeatLOR,                  // STLLRH
+	0,                             // STLR
+	0,                             // STLRB
+	0,                             // STLRH
+	1 << FeatLRCPC2,               // STLUR
+	1 << FeatLRCPC2,               // STLURB
+	1 << FeatLRCPC2,               // STLURH
+	0,                             // STLXP
+	0,                             // STLXR
+	0,                             // STLXRB
+	0,                             // STLXRH
+	0,                             // STNP
+	0,                             // STP
+	1<<FeatSVE | 1<<FeatSME,       // STR
+	0,                             // STRB
+	0,                             // STRH
+	1 << FeatLSE,                  // STSET
+	1 << FeatLSE,                  // STSETB
+	1 << FeatLSE,                  // STSETH
+	1 << FeatLSE,                  // STSETL
+	1 << FeatLSE,                  // STSETLB
+	1 << FeatLSE,                  // STSETLH
+	1 << FeatLSE,                  // STSMAX
+	1 << FeatLSE,                  // STSMAXB
+	1 << FeatLSE,                  // STSMAXH
+	1 << FeatLSE,                  // STSMAXL
+	1 << FeatLSE,                  // STSMAXLB
+	1 << FeatLSE,                  // STSMAXLH
+	1 << FeatLSE,                  // STSMIN
+	1 << FeatLSE,                  // STSMINB
+	1 << FeatLSE,                  // STSMINH
+	1 << FeatLSE,                  // STSMINL
+	1 << FeatLSE,                  // STSMINLB
+	1 << FeatLSE,                  // STSMINLH
+	0,                             // STTR
+	0,                             // STTRB
+	0,                             // STTRH
+	1 << FeatLSE,                  // STUMAX
+	1 << FeatLSE,                  // STUMAXB
+	1 << FeatLSE,                  // STUMAXH
+	1 << FeatLSE,                  // STUMAXL
+	1 << FeatLSE,                  // STUMAXLB
+	1 << FeatLSE,                  // STUMAXLH
+	1 << FeatLSE,                  // STUMIN
+	1 << FeatLSE,                  // STUMINB
+	1 << FeatLSE,                  // STUMINH
+	1 << FeatLSE,                  // STUMINL
+	1 << FeatLSE,                  // STUMINLB
+	1 << FeatLSE,                  // STUMINLH
+	0,                             // STUR
+	0,                             // STURB
+	0,                             // STURH
+	0,                             // STXP
+	0,                             // STXR
+	0,                             // STXRB
+	0,                             // STXRH
+	1 << FeatMTE,                  // STZ2G
+	1 << FeatMTE,                  // STZG
+	1 << FeatSVE,                  // SUB
+	1 << FeatMTE,                  // SUBG
+	0,                             // SUBHN
+	0,                             // SUBHN2
+	1 << FeatMTE,                  // SUBP
+	1 << FeatMTE,                  // SUBPS
+	1 << FeatSVE,                  // SUBR
+	0,                             // SUBS
+	1 << FeatI8MM,                 // SUDOT
+	1<<FeatSME | 1<<FeatSMEI16I64, // SUMOPA
+	1<<FeatSME | 1<<FeatSMEI16I64, // SUMOPS
+	1 << FeatSVE,                  // SUNPKHI
+	1 << FeatSVE,                  // SUNPKLO
+	0,                             // SUQADD
+	0,                             // SVC
+	1 << FeatLSE,                  // SWP
+	1 << FeatLSE,                  // SWPA
+	1 << FeatLSE,                  // SWPAB
+	1 << FeatLSE,                  // SWPAH
+	1 << FeatLSE,                  // SWPAL
+	1 << FeatLSE,                  // SWPALB
+	1 << FeatLSE,                  // SWPALH
+	1 << FeatLSE,                  // SWPB
+	1 << FeatLSE,                  // SWPH
+	1 << FeatLSE,                  // SWPL
+	1 << FeatLSE,                  // SWPLB
+	1 << FeatLSE,                  // SWPLH
+	0,                             // SXTB
+	0,                             // SXTH
+	0,                             // SXTL
+	0,                             // SXTL2
+	0,                             // SXTW
+	0,                             // SYS
+	0,                             // SYSL
+	1 << FeatSVE,                  // TBL
+	0,                             // TBNZ
+	0,                             // TBX
+	0,                             // TBZ
+	0,                             // TLBI
+	1 << FeatSVE,                  // TRN1
+	1 << FeatSVE,                  // TRN2
+	0,                             // TSB
+	0,                             // TST
+	0,                             // UABA
+	0,                             // UABAL
+	0,                             // UABAL2
+	1 << FeatSVE,                  // UABD
+	0,                             // UABDL
+	0,                             // UABDL2
+	0,                             // UADALP
+	0,                             // UADDL
+	0,                             // UADDL2
+	0,                             // UADDLP
+	0,                             // UADDLV
+	1 << FeatSVE,                  // UADDV
+	0,                             // UADDW
+	0,                             // UADDW2
+	0,                             // UBFIZ
+	0,                             // UBFM
+	0,                             // UBFX
+	1<<FeatFP16 | 1<<FeatSVE,      // UCVTF
+	0,                             // UDF
+	1 << FeatSVE,                  // UDIV
+	1 << FeatSVE,                  // UDIVR
+	1 << FeatDotProd,              // UDOT
+	0,                             // UHADD
+	0,                             // UHSUB
+	0,                             // UMADDL
+	1<<FeatCSSC | 1<<FeatSVE,      // UMAX
+	0,                             // UMAXP
+	1 << FeatSVE,                  // UMAXV
+	1<<FeatCSSC | 1<<FeatSVE,      // UMIN
+	0,                             // UMINP
+	1 << FeatSVE,                  // UMINV
+	0,                             // UMLAL
+	0,                             // UMLAL2
+	0,                             // UMLSL
+	0,                             // UMLSL2
+	1 << FeatI8MM,                 // UMMLA
+	0,                             // UMNEGL
+	1<<FeatSME | 1<<FeatSMEI16I64, // UMOPA
+	1<<FeatSME | 1<<FeatSMEI16I64, // UMOPS
+	0,                             // UMOV
+	0,                             // UMSUBL
+	1<<FeatSVE | 1<<FeatSVE2,      // UMULH
+	0,                             // UMULL
+	0,                             // UMULL2
+	1 << FeatSVE,                  // UQADD
+	0,                             // UQRSHL
+	0,                             // UQRSHRN
+	0,                             // UQRSHRN2
+	0,                             // UQSHL
+	0,                             // UQSHRN
+	0,                             // UQSHRN2
+	1 << FeatSVE,                  // UQSUB
+	0,                             // UQXTN
+	0,                             // UQXTN2
+	0,                             // URECPE
+	0,                             // URHADD
+	0,                             // URSHL
+	0,                             // URSHR
+	0,                             // URSQRTE
+	0,                             // URSRA
+	1 << FeatI8MM,                 // USDOT
+	0,                             // USHL
+	0,                             // USHLL
+	0,                             // USHLL2
+	0,                             // USHR
+	1 << FeatI8MM,                 // USMMLA
+	1<<FeatSME | 1<<FeatSMEI16I64, // USMOPA
+	1<<FeatSME | 1<<FeatSMEI16I64, // USMOPS
+	0,                             // USQADD
+	0,                             // USRA
+	0,                             // USUBL
+	0,                             // USUBL2
+	0,                             // USUBW
+	0,                             // USUBW2
+	1 << FeatSVE,                  // UUNPKHI
+	1 << FeatSVE,                  // UUNPKLO
+	0,                             // UXTB
+	0,                             // UXTH
+	0,                             // UXTL
+	0,                             // UXTL2
+	1 << FeatSVE,                  // UZP1
+	1 << FeatSVE,                  // UZP2
+	0,                             // WFE
+	1 << FeatWFxT,                 // WFET
+	0,                             // WFI
+	1 << FeatWFxT,                 // WFIT
+	1 << FeatSVE2,                 // WHILEGE
+	1 << FeatSVE2,                 // WHILEGT
+	1 << FeatSVE2,                 // WHILEHI
+	1 << FeatSVE2,                 // WHILEHS
+	1 << FeatSVE,                  // WHILELE
+	1 << FeatSVE,                  // WHILELO
+	1 << FeatSVE,                  // WHILELS
+	1 << FeatSVE,                  // WHILELT
+	1 << FeatSVE,                  // WRFFR
+	1 << FeatFlagM2,               // XAFLAG
+	1 << FeatSHA3,                 // XAR
+	1 << FeatPAuth,                // XPACD
+	1 << FeatPAuth,                // XPACI
+	0,                             // XPACLRI
+	0,                             // XTN
+	0,                             // XTN2
+	0,                             // YIELD
+	1 << FeatSME,                  // ZERO
+	1 << FeatSVE,                  // ZIP1
+	1 << FeatSVE,                  // ZIP2
 }
@@ -8,6 +8,7 @@ const (
 	MatLitFloat  // LitFloat(float32), float literal
 	MatSymbol    // Symbol
 	MatCond      // condition code Symbol
+	MatSysReg    // SystemReg
 	MatImm       // 32/64-bit integer immediate
 	MatFloat     // 32-bit float immediate (FMOV)
 
@@ -41,6 +42,21 @@ const (
 	MatRegListStatic  // RegListStatic(len, Size, lanes), register list with $0 items, with the elements of size $1 and a lane count of $2
 	MatRegListElement // RegListElement(len, Size), register list with element specifier. It has $0 items with a size of $1
 
+	// scalable vector and predicate regs
+
+	MatZ        // Z(Size), scalable vector register with elements of the specified size (0 for an unsized register)
+	MatZElement // ZElement(Size), scalable vector register with element specifier, with elements of the specified size
+	MatZList    // ZList(len, Size), scalable vector register list with $0 items, with elements of size $1
+	MatP        // P(Size), predicate register with elements of the specified size (0 for an unsized register)
+	MatPZ       // zeroing predicate register (Pg/Z)
+	MatPM       // merging predicate register (Pg/M)
+
+	// SME ZA array, tiles, and slices
+
+	MatZA      // ZA array register
+	MatZATile  // ZATile(Size), ZA tile register with elements of the specified size
+	MatZASlice // ZASlice(Size), horizontal or vertical ZA tile slice with elements of the specified size (0 for a ZA array vector)
+
 	MatOffset // jump offset
 
 	// references
@@ -48,8 +64,14 @@ const (
 	MatRefBase   // memory reference with base register (integer or SP register), optionally followed by a register or immediate offset argument for post-indexing
 	MatRefOffset // memory reference with base register (integer or SP register) and immediate offset
 	MatRefPre    // pre-indexed memory reference with base register (integer or SP register) and immediate offset
+	MatRefWback  // pre-indexed memory reference with X base register and no offset, for writeback-only operands ([Xn]!)
 	MatRefIndex  // memory reference with base register (integer or SP register), index register (integer register), and optional index modifier
 
+	MatRefVL       // memory reference with base register (integer or SP register) and optional immediate offset in multiples of the vector length
+	MatRefIndexLSL // RefIndexLSL(shift), memory reference with base register (integer or SP register), X index register, and LSL modifier of $0 (no modifier if $0 is 0)
+	MatRefIndexZ   // RefIndexZ(Size, shift), memory reference with base register (integer or SP register), scalable vector index register with elements of size $0, and index modifier amount of $1
+	MatRefZ        // RefZ(Size), memory reference with scalable vector base register with elements of size $0, and optional immediate offset
+
 	MatLitMod // LitMod(Mod), a single modifier
 	MatMod    // Mod(*[]Mod), a set of allowed modifiers
 
@@ -62,6 +84,7 @@ var MatcherArgCounts = [...]uint8{
 	MatLitFloat:       1,
 	MatSymbol:         0,
 	MatCond:           0,
+	MatSysReg:         0,
 	MatImm:            0,
 	MatFloat:          0,
 	MatW:              0,
@@ -81,11 +104,25 @@ var MatcherArgCounts = [...]uint8{
 	MatRegList:        2,
 	MatRegListStatic:  3,
 	MatRegListElement: 2,
+	MatZ:              1,
+	MatZElement:       1,
+	MatZList:          2,
+	MatP:              1,
+	MatPZ:             0,
+	MatPM:             0,
+	MatZA:             0,
+	MatZATile:         1,
+	MatZASlice:        1,
 	MatOffset:         0,
 	MatRefBase:        0,
 	MatRefOffset:      0,
 	MatRefPre:         0,
+	MatRefWback:       0,
 	MatRefIndex:       0,
+	MatRefVL:          0,
+	MatRefIndexLSL:    1,
+	MatRefIndexZ:      2,
+	MatRefZ:           1,
 	MatLitMod:         1,
 	MatMod:            1,
 	MatEnd:            0,
@@ -97,6 +134,7 @@ var MatchName = [...]string{
 	MatLitFloat:       "MatLitFloat",
 	MatSymbol:         "MatSymbol",
 	MatCond:           "MatCond",
+	MatSysReg:         "MatSysReg",
 	MatImm:            "MatImm",
 	MatFloat:          "MatFloat",
 	MatW:              "MatW",
@@ -116,11 +154,25 @@ var MatchName = [...]string{
 	MatRegList:        "MatRegList",
 	MatRegListStatic:  "MatRegListStatic",
 	MatRegListElement: "MatRegListElement",
+	MatZ:              "MatZ",
+	MatZElement:       "MatZElement",
+	MatZList:          "MatZList",
+	MatP:              "MatP",
+	MatPZ:             "MatPZ",
+	MatPM:             "MatPM",
+	MatZA:             "MatZA",
+	MatZATile:         "MatZATile",
+	MatZASlice:        "MatZASlice",
 	MatOffset:         "MatOffset",
 	MatRefBase:        "MatRefBase",
 	MatRefOffset:      "MatRefOffset",
 	MatRefPre:         "MatRefPre",
+	MatRefWback:       "MatRefWback",
 	MatRefIndex:       "MatRefIndex",
+	MatRefVL:          "MatRefVL",
+	MatRefIndexLSL:    "MatRefIndexLSL",
+	MatRefIndexZ:      "MatRefIndexZ",
+	MatRefZ:           "MatRefZ",
 	MatLitMod:         "MatLitMod",
 	MatMod:            "MatMod",
 	MatEnd:            "MatEnd",
@@ -132,6 +184,7 @@ var MatcherFlatArgCounts = [...]uint8{
 	MatLitFloat:       0,
 	MatSymbol:         1,
 	MatCond:           1,
+	MatSysReg:         1,
 	MatImm:            1,
 	MatFloat:          1,
 	MatW:              1,
@@ -151,11 +204,25 @@ var MatcherFlatArgCounts = [...]uint8{
 	MatRegList:        1,
 	MatRegListStatic:  1,
 	MatRegListElement: 2,
+	MatZ:              1,
+	MatZElement:       2,
+	MatZList:          1,
+	MatP:              1,
+	MatPZ:             1,
+	MatPM:             1,
+	MatZA:             1,
+	MatZATile:         1,
+	MatZASlice:        4,
 	MatOffset:         1,
 	MatRefBase:        1,
 	MatRefOffset:      2,
 	MatRefPre:         2,
+	MatRefWback:       2,
 	MatRefIndex:       4,
+	MatRefVL:          2,
+	MatRefIndexLSL:    4,
+	MatRefIndexZ:      4,
+	MatRefZ:           2,
 	MatLitMod:         1,
 	MatMod:            2,
 	MatEnd:            0,
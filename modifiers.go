@@ -16,6 +16,7 @@ const (
 	SymUXTW
 	SymUXTH
 	SymUXTB
+	SymMUL
 )
 
 // Modifier lists
@@ -44,6 +45,8 @@ var (
 	ModASR = Mod{ID: SymASR} // ModASR is a shift modifier argument with ID SymASR
 	ModROR = Mod{ID: SymROR} // ModROR is a rotate modifier argument with ID SymROR
 	ModMSL = Mod{ID: SymMSL} // ModMSL is a shift modifier argument with ID SymMSL
+
+	ModMUL = Mod{ID: SymMUL} // ModMUL is a multiplier modifier argument with ID SymMUL
 )
 
 // Imm constructs a modifier argument from m with an immediate shift or rotate amount.
@@ -86,6 +89,7 @@ var ModName = [...]string{
 	SymUXTH: "SymUXTH",
 	SymUXTB: "SymUXTB",
 	SymMSL:  "SymMSL",
+	SymMUL:  "SymMUL",
 }
 
 var ModRequiresImm = [...]bool{
@@ -94,6 +98,7 @@ var ModRequiresImm = [...]bool{
 	SymASR: true,
 	SymROR: true,
 	SymMSL: true,
+	SymMUL: true,
 }
 
 func checkMod(list []uint8, id uint8) bool {
@@ -0,0 +1,57 @@
+package arm
+
+// Observer receives callbacks for each instruction, label, and relocation of an [Assembler] with the observer
+// in its Observers field, for tracing, statistics, or instrumentation. Observers are called in order, and must not
+// retain args. Instructions encoded by [Assembler.Encode] or [Assembler.Matches] are not observed.
+//
+// [NopObserver] may be embedded to implement a subset of the callbacks.
+type Observer interface {
+	// BeforeInst is called before matching inst with args. For the typed emitter methods args is empty, and the
+	// flattened arguments are available from the Flat field.
+	BeforeInst(a *Assembler, inst Inst, args []Operand)
+	// AfterInst is called after the encoded instruction is written at pc. The encoding index, matching operators,
+	// and encoding operators are available from a.Idx, a.Pattern, and a.Commands.
+	//
+	// AfterInst may inject instrumentation by encoding instructions with a, which are written after the observed
+	// instruction and are not observed. Injected instructions replace the state of the observed instruction
+	// (a.Idx, ...) for the remaining observers. Other callbacks must not encode instructions with a.
+	AfterInst(a *Assembler, inst Inst, pc, opcode uint32)
+	// LabelBound is called after label is assigned to pc by [Assembler.SetLabel].
+	LabelBound(a *Assembler, label Label, pc uint32)
+	// RelocApplied is called after the label offset for rel is encoded by [Assembler.ApplyRelocations].
+	RelocApplied(a *Assembler, rel Reloc, opcode uint32)
+}
+
+// NopObserver is an [Observer] which ignores all callbacks.
+type NopObserver struct{}
+
+func (NopObserver) BeforeInst(a *Assembler, inst Inst, args []Operand)   {}
+func (NopObserver) AfterInst(a *Assembler, inst Inst, pc, opcode uint32) {}
+func (NopObserver) LabelBound(a *Assembler, label Label, pc uint32)      {}
+func (NopObserver) RelocApplied(a *Assembler, rel Reloc, opcode uint32)  {}
+
+// observeBefore calls BeforeInst for each observer, with args converted to operands.
+func (a *Assembler) observeBefore(inst Inst, args []Arg) {
+	a.Args = a.scratchArgs[:0]
+	for _, arg := range args {
+		a.Args = append(a.Args, ArgOperand(arg))
+	}
+	a.observing = true
+	for _, o := range a.Observers {
+		o.BeforeInst(a, inst, a.Args)
+	}
+	a.observing = false
+}
+
+// observeAfter calls AfterInst for each observer with the instruction preceding the current PC. Returns false if
+// an instruction injected by an observer set Err.
+func (a *Assembler) observeAfter(inst Inst) bool {
+	pc := a.PC - 4
+	opcode := dec32(a.Code[pc:])
+	a.observing = true
+	for _, o := range a.Observers {
+		o.AfterInst(a, inst, pc, opcode)
+	}
+	a.observing = false
+	return a.Err == nil
+}
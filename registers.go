@@ -27,6 +27,11 @@ const (
 	RegVec32  // 32-bit vector registers
 	RegVec64  // 64-bit vector registers
 	RegVec128 // 128-bit vector registers
+	RegSVE    // scalable vector registers (Z)
+	RegPred   // scalable predicate registers (P)
+	RegPredZ  // zeroing predicate registers (Pg/Z)
+	RegPredM  // merging predicate registers (Pg/M)
+	RegZA     // ZA array and tile registers
 )
 
 var (
@@ -35,6 +40,8 @@ var (
 
 	WSP = Reg{ID: 31, Type: RWSP} // 32-bit stack pointer register
 	XSP = Reg{ID: 31, Type: RXSP} // 64-bit stack pointer register
+
+	ZA = Reg{ID: 0, Type: RZA} // SME ZA array register
 )
 
 // W constructs a 32-bit integer register, with type [RW] and family [RegInt].
@@ -91,6 +98,69 @@ func Vec2D(id uint8) Reg { return Reg{ID: id, Type: V2D} }
 // Vec1Q constructs a 1x128-bit vector SIMD register, with type [V1Q] and family [RegVec128].
 func Vec1Q(id uint8) Reg { return Reg{ID: id, Type: V1Q} }
 
+// Z constructs a scalable vector register without an element size, with type [RZ] and family [RegSVE].
+func Z(id uint8) Reg { return Reg{ID: id, Type: RZ} }
+
+// ZB constructs a scalable vector register with 8-bit elements, with type [RZB] and family [RegSVE].
+func ZB(id uint8) Reg { return Reg{ID: id, Type: RZB} }
+
+// ZH constructs a scalable vector register with 16-bit elements, with type [RZH] and family [RegSVE].
+func ZH(id uint8) Reg { return Reg{ID: id, Type: RZH} }
+
+// ZS constructs a scalable vector register with 32-bit elements, with type [RZS] and family [RegSVE].
+func ZS(id uint8) Reg { return Reg{ID: id, Type: RZS} }
+
+// ZD constructs a scalable vector register with 64-bit elements, with type [RZD] and family [RegSVE].
+func ZD(id uint8) Reg { return Reg{ID: id, Type: RZD} }
+
+// ZQ constructs a scalable vector register with 128-bit elements, with type [RZQ] and family [RegSVE].
+func ZQ(id uint8) Reg { return Reg{ID: id, Type: RZQ} }
+
+// P constructs a scalable predicate register without an element size, with type [RP] and family [RegPred].
+func P(id uint8) Reg { return Reg{ID: id, Type: RP} }
+
+// PB constructs a scalable predicate register with 8-bit elements, with type [RPB] and family [RegPred].
+func PB(id uint8) Reg { return Reg{ID: id, Type: RPB} }
+
+// PH constructs a scalable predicate register with 16-bit elements, with type [RPH] and family [RegPred].
+func PH(id uint8) Reg { return Reg{ID: id, Type: RPH} }
+
+// PS constructs a scalable predicate register with 32-bit elements, with type [RPS] and family [RegPred].
+func PS(id uint8) Reg { return Reg{ID: id, Type: RPS} }
+
+// PD constructs a scalable predicate register with 64-bit elements, with type [RPD] and family [RegPred].
+func PD(id uint8) Reg { return Reg{ID: id, Type: RPD} }
+
+// ZAB constructs an SME ZA tile register with 8-bit elements (ZA0.B), with type [RZAB] and family [RegZA].
+func ZAB(id uint8) Reg { return Reg{ID: id, Type: RZAB} }
+
+// ZAH constructs an SME ZA tile register with 16-bit elements (ZA0.H-ZA1.H), with type [RZAH] and family [RegZA].
+func ZAH(id uint8) Reg { return Reg{ID: id, Type: RZAH} }
+
+// ZAS constructs an SME ZA tile register with 32-bit elements (ZA0.S-ZA3.S), with type [RZAS] and family [RegZA].
+func ZAS(id uint8) Reg { return Reg{ID: id, Type: RZAS} }
+
+// ZAD constructs an SME ZA tile register with 64-bit elements (ZA0.D-ZA7.D), with type [RZAD] and family [RegZA].
+func ZAD(id uint8) Reg { return Reg{ID: id, Type: RZAD} }
+
+// ZAQ constructs an SME ZA tile register with 128-bit elements (ZA0.Q-ZA15.Q), with type [RZAQ] and family [RegZA].
+func ZAQ(id uint8) Reg { return Reg{ID: id, Type: RZAQ} }
+
+// H constructs a horizontal slice of the ZA tile r, indexed by a W12-W15 register plus an immediate offset.
+// For the ZA array register, H constructs a ZA array vector (ZA[Wv, offset]).
+func (r Reg) H(idx Reg, offset uint8) ZASlice { return ZASlice{Tile: r, Idx: idx, Offset: offset} }
+
+// V constructs a vertical slice of the ZA tile r, indexed by a W12-W15 register plus an immediate offset.
+func (r Reg) V(idx Reg, offset uint8) ZASlice {
+	return ZASlice{Tile: r, Idx: idx, Offset: offset, Vertical: true}
+}
+
+// Zeroing qualifies the predicate register r for zeroing predication (Pg/Z), with type [RPZ] and family [RegPredZ].
+func (r Reg) Zeroing() Reg { return Reg{ID: r.ID, Type: RPZ} }
+
+// Merging qualifies the predicate register r for merging predication (Pg/M), with type [RPM] and family [RegPredM].
+func (r Reg) Merging() Reg { return Reg{ID: r.ID, Type: RPM} }
+
 // I selects a vector element from r.
 func (r Reg) I(idx uint8) Reg { return Reg{ID: r.ID, Type: r.Type, ElemInv: ^idx} }
 
@@ -154,6 +224,28 @@ const (
 	V1D  RegType = RegType(QWORD) | RegType(RegVec64<<4)  // V1D represents 1x64-bit vector SIMD registers
 	V2D  RegType = RegType(QWORD) | RegType(RegVec128<<4) // V2D represents 2x64-bit vector SIMD registers
 	V1Q  RegType = RegType(OWORD) | RegType(RegVec128<<4) // V1Q represents 1x128-bit vector SIMD registers
+
+	RZ  RegType = RegType(RegSVE << 4)                // RZ represents scalable vector registers without an element size
+	RZB RegType = RegType(BYTE) | RegType(RegSVE<<4)  // RZB represents scalable vector registers with 8-bit elements
+	RZH RegType = RegType(WORD) | RegType(RegSVE<<4)  // RZH represents scalable vector registers with 16-bit elements
+	RZS RegType = RegType(DWORD) | RegType(RegSVE<<4) // RZS represents scalable vector registers with 32-bit elements
+	RZD RegType = RegType(QWORD) | RegType(RegSVE<<4) // RZD represents scalable vector registers with 64-bit elements
+	RZQ RegType = RegType(OWORD) | RegType(RegSVE<<4) // RZQ represents scalable vector registers with 128-bit elements
+
+	RP  RegType = RegType(RegPred << 4)                // RP represents scalable predicate registers without an element size
+	RPB RegType = RegType(BYTE) | RegType(RegPred<<4)  // RPB represents scalable predicate registers with 8-bit elements
+	RPH RegType = RegType(WORD) | RegType(RegPred<<4)  // RPH represents scalable predicate registers with 16-bit elements
+	RPS RegType = RegType(DWORD) | RegType(RegPred<<4) // RPS represents scalable predicate registers with 32-bit elements
+	RPD RegType = RegType(QWORD) | RegType(RegPred<<4) // RPD represents scalable predicate registers with 64-bit elements
+	RPZ RegType = RegType(RegPredZ << 4)               // RPZ represents zeroing predicate registers (Pg/Z)
+	RPM RegType = RegType(RegPredM << 4)               // RPM represents merging predicate registers (Pg/M)
+
+	RZA  RegType = RegType(RegZA << 4)                // RZA represents the ZA array register
+	RZAB RegType = RegType(BYTE) | RegType(RegZA<<4)  // RZAB represents ZA tile registers with 8-bit elements
+	RZAH RegType = RegType(WORD) | RegType(RegZA<<4)  // RZAH represents ZA tile registers with 16-bit elements
+	RZAS RegType = RegType(DWORD) | RegType(RegZA<<4) // RZAS represents ZA tile registers with 32-bit elements
+	RZAD RegType = RegType(QWORD) | RegType(RegZA<<4) // RZAD represents ZA tile registers with 64-bit elements
+	RZAQ RegType = RegType(OWORD) | RegType(RegZA<<4) // RZAQ represents ZA tile registers with 128-bit elements
 )
 
 // Family returns the register family for sz (integer, SP, scalar, vector).
@@ -179,7 +271,7 @@ func (sz RegType) Bytes() uint8 {
 	}
 }
 
-// Lanes returns the lane count for sz. If sz is not from a vector register family, the lane count is 0.
+// Lanes returns the lane count for sz. If sz is not from a fixed-width vector register family, the lane count is 0.
 func (sz RegType) Lanes() uint8 {
 	elemSize := sz.ElemBytes()
 	if elemSize == 0 {
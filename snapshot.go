@@ -0,0 +1,95 @@
+package arm
+
+// Snapshot is a checkpoint of the output state of an [Assembler], returned by [Assembler.Snapshot].
+type Snapshot struct {
+	pc         uint32
+	labels     int
+	relocs     int
+	pool       int
+	labelMoves int
+	errs       int
+	procs      int
+	cfi        int // rules recorded for the last procedure
+	cfiOpen    bool
+	err        error
+}
+
+// labelMove is a label binding replaced by [Assembler.SetLabel] after a snapshot.
+type labelMove struct {
+	id uint32
+	pc uint32
+}
+
+// Snapshot returns a checkpoint of the PC, labels, relocations, pending pool constants, collected errors, unwind
+// info, and error state, which may be restored by [Assembler.Restore] to discard instructions written after the
+// snapshot.
+func (a *Assembler) Snapshot() Snapshot {
+	a.trackLabels = true
+	return Snapshot{
+		pc:         a.PC,
+		labels:     len(a.LabelPC),
+		relocs:     len(a.Relocs),
+		pool:       len(a.Pool),
+		labelMoves: len(a.labelMoves),
+		errs:       len(a.errs),
+		procs:      len(a.procs),
+		cfi:        a.cfiLen(),
+		cfiOpen:    a.cfiOpen,
+		err:        a.Err,
+	}
+}
+
+// Restore rolls back the assembler to the state of s, which must have been returned by [Assembler.Snapshot]
+// since the most recent call to [Assembler.Init]. Code written after the snapshot is cleared, labels, relocations,
+// source positions, and unwind info added after the snapshot are removed, and labels which were set after the
+// snapshot are reset to their earlier PC. Relocations applied by [Assembler.ApplyRelocations] and constants written
+// by [Assembler.EmitPool] after the snapshot are not restored. Errors collected for discarded instructions (see the
+// CollectErrors field) are removed.
+func (a *Assembler) Restore(s Snapshot) {
+	if s.pc < a.PC {
+		end := a.PC
+		if end > uint32(len(a.Code)) {
+			end = uint32(len(a.Code))
+		}
+		for i := s.pc; i < end; i++ {
+			a.Code[i] = 0
+		}
+	}
+	a.PC = s.pc
+	a.truncateSources(s.pc)
+	for i := len(a.labelMoves) - 1; i >= s.labelMoves; i-- {
+		if move := a.labelMoves[i]; int(move.id) < s.labels {
+			a.LabelPC[move.id] = move.pc
+		}
+	}
+	a.labelMoves = a.labelMoves[:s.labelMoves]
+	if len(a.LabelPC) > s.labels {
+		a.LabelPC = a.LabelPC[:s.labels]
+	}
+	if len(a.Relocs) > s.relocs {
+		a.Relocs = a.Relocs[:s.relocs]
+	}
+	a.procs, a.cfiOpen = a.procs[:s.procs], s.cfiOpen
+	if s.procs != 0 {
+		proc := &a.procs[s.procs-1]
+		proc.Insts = proc.Insts[:s.cfi]
+		if s.cfiOpen {
+			proc.End = 0
+		}
+	}
+	if len(a.errs) > s.errs {
+		a.errs = a.errs[:s.errs]
+	}
+	if len(a.Pool) > s.pool {
+		a.Pool = a.Pool[:s.pool]
+	}
+	a.Err = s.err
+}
+
+// cfiLen returns the number of rules recorded for the last procedure.
+func (a *Assembler) cfiLen() int {
+	if len(a.procs) == 0 {
+		return 0
+	}
+	return len(a.procs[len(a.procs)-1].Insts)
+}
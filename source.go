@@ -0,0 +1,155 @@
+package arm
+
+import (
+	"encoding/binary"
+	"runtime"
+	"sort"
+	"strconv"
+)
+
+// SourcePos is the source position of an instruction, recorded when the TrackSource field of an [Assembler]
+// is set or supplied by [Assembler.SetSourcePos].
+type SourcePos struct {
+	File string
+	Line int
+}
+
+func (pos SourcePos) String() string { return pos.File + ":" + strconv.Itoa(pos.Line) }
+
+// SourceLine is the source position of the instruction at PC, for listings and debug info.
+type SourceLine struct {
+	PC  uint32
+	Pos SourcePos
+}
+
+// SetSourcePos sets the source position recorded for each following instruction, in place of the caller position,
+// until it is cleared by passing the zero SourcePos. Positions may be supplied by front ends for other languages.
+// Positions are recorded while a source position is set, even if the TrackSource field is not set.
+func (a *Assembler) SetSourcePos(pos SourcePos) {
+	a.srcPos, a.srcSet = pos, pos != SourcePos{}
+}
+
+// setCallerPos sets the source position for each following instruction to the caller skip frames above the caller
+// of setCallerPos, if the TrackSource field is set and no position is set, for helpers which write more than one
+// instruction. The caller must clear the position if setCallerPos returns true.
+func (a *Assembler) setCallerPos(skip int) bool {
+	if !a.TrackSource || a.srcSet {
+		return false
+	}
+	_, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return false
+	}
+	a.SetSourcePos(SourcePos{file, line})
+	return true
+}
+
+// SourceAt returns the source position recorded for the instruction at pc, or false if none was recorded.
+func (a *Assembler) SourceAt(pc uint32) (SourcePos, bool) {
+	i := sort.Search(len(a.sources), func(i int) bool { return a.sources[i].PC >= pc })
+	if i == len(a.sources) || a.sources[i].PC != pc {
+		return SourcePos{}, false
+	}
+	return a.sources[i].Pos, true
+}
+
+// SourceLines returns the source positions recorded for each instruction since the most recent call to
+// [Assembler.Init], ordered by PC.
+func (a *Assembler) SourceLines() []SourceLine { return a.sources }
+
+// recordPos records the source position for an instruction at the current PC, replacing positions recorded at
+// or after the PC. If no source position is set, the position is the caller skip frames above the caller of
+// recordPos.
+func (a *Assembler) recordPos(skip int) {
+	pos := a.srcPos
+	if !a.srcSet {
+		_, file, line, ok := runtime.Caller(skip + 1)
+		if !ok {
+			return
+		}
+		pos = SourcePos{file, line}
+	}
+	a.truncateSources(a.PC)
+	a.sources = append(a.sources, SourceLine{a.PC, pos})
+}
+
+// truncateSources removes source positions recorded at or after pc.
+func (a *Assembler) truncateSources(pc uint32) {
+	n := len(a.sources)
+	for n != 0 && a.sources[n-1].PC >= pc {
+		n--
+	}
+	a.sources = a.sources[:n]
+}
+
+// DWARF line number program parameters and opcodes:
+const (
+	dwLineBase       = -5
+	dwLineRange      = 14
+	dwLineOpcodeBase = 13
+	dwLNSCopy        = 0x01
+	dwLNSAdvancePC   = 0x02
+	dwLNSAdvanceLine = 0x03
+	dwLNSSetFile     = 0x04
+	dwLNEEndSequence = 0x01
+	dwLNESetAddress  = 0x02
+	dwLineInstAlign  = 4
+	dwLineVersion    = 4
+)
+
+// DebugLine returns a DWARF 4 .debug_line section with a line number program for the source positions recorded
+// since the most recent call to [Assembler.Init], with absolute addresses relative to base (the address of the code
+// buffer). The sequence ends at the current PC. Instructions without a recorded position are attributed to the
+// preceding position.
+func (a *Assembler) DebugLine(base uint64) []byte {
+	var files []string
+	fileIdx := make(map[string]uint64)
+	for _, line := range a.sources {
+		if _, ok := fileIdx[line.Pos.File]; !ok {
+			files = append(files, line.Pos.File)
+			fileIdx[line.Pos.File] = uint64(len(files))
+		}
+	}
+
+	header := []byte{dwLineInstAlign, 1, 1, 0x100 + dwLineBase, dwLineRange, dwLineOpcodeBase}
+	header = append(header, 0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 0, 1) // standard_opcode_lengths
+	header = append(header, 0)                                  // no include_directories
+	for _, file := range files {
+		header = append(append(header, file...), 0, 0, 0, 0) // name, directory, mtime, length
+	}
+	header = append(header, 0)
+
+	program := append([]byte{0, 9, dwLNESetAddress}, make([]byte, 8)...)
+	binary.LittleEndian.PutUint64(program[3:], base)
+	pc, lineNum, file := uint32(0), 1, uint64(1)
+	for _, line := range a.sources {
+		if idx := fileIdx[line.Pos.File]; idx != file {
+			program = appendULEB(append(program, dwLNSSetFile), idx)
+			file = idx
+		}
+		addrDelta, lineDelta := (line.PC-pc)/dwLineInstAlign, line.Pos.Line-lineNum
+		if op := lineDelta - dwLineBase + dwLineRange*int(addrDelta) + dwLineOpcodeBase; lineDelta >= dwLineBase &&
+			lineDelta < dwLineBase+dwLineRange && op <= 0xFF {
+			program = append(program, byte(op)) // special opcode: advance the address and line, and append a row
+		} else {
+			if lineDelta != 0 {
+				program = appendSLEB(append(program, dwLNSAdvanceLine), int64(lineDelta))
+			}
+			if addrDelta != 0 {
+				program = appendULEB(append(program, dwLNSAdvancePC), uint64(addrDelta))
+			}
+			program = append(program, dwLNSCopy)
+		}
+		pc, lineNum = line.PC, line.Pos.Line
+	}
+	if end := a.PC; end > pc {
+		program = appendULEB(append(program, dwLNSAdvancePC), uint64((end-pc)/dwLineInstAlign))
+	}
+	program = append(program, 0, 1, dwLNEEndSequence)
+
+	section := binary.LittleEndian.AppendUint32(nil, uint32(2+4+len(header)+len(program)))
+	section = binary.LittleEndian.AppendUint16(section, dwLineVersion)
+	section = binary.LittleEndian.AppendUint32(section, uint32(len(header)))
+	section = append(section, header...)
+	return append(section, program...)
+}
@@ -1,5 +1,11 @@
 package arm
 
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
 // Condition code symbols
 const (
 	EQ Symbol = 0 + 1
@@ -176,6 +182,57 @@ const (
 	RVALE3OS     // TLBIOPS (Translation Table)
 	RVAE3        // TLBIOPS (Translation Table)
 	RVALE3       // TLBIOPS (Translation Table)
+
+	POW2  // SVEPATTERNS (Predicate Constraints)
+	VL1   // SVEPATTERNS (Predicate Constraints)
+	VL2   // SVEPATTERNS (Predicate Constraints)
+	VL3   // SVEPATTERNS (Predicate Constraints)
+	VL4   // SVEPATTERNS (Predicate Constraints)
+	VL5   // SVEPATTERNS (Predicate Constraints)
+	VL6   // SVEPATTERNS (Predicate Constraints)
+	VL7   // SVEPATTERNS (Predicate Constraints)
+	VL8   // SVEPATTERNS (Predicate Constraints)
+	VL16  // SVEPATTERNS (Predicate Constraints)
+	VL32  // SVEPATTERNS (Predicate Constraints)
+	VL64  // SVEPATTERNS (Predicate Constraints)
+	VL128 // SVEPATTERNS (Predicate Constraints)
+	VL256 // SVEPATTERNS (Predicate Constraints)
+	MUL4  // SVEPATTERNS (Predicate Constraints)
+	MUL3  // SVEPATTERNS (Predicate Constraints)
+	ALL   // SVEPATTERNS (Predicate Constraints)
+
+	SVCRSM   // SVCRFIELDS (Streaming Vector Control)
+	SVCRZA   // SVCRFIELDS (Streaming Vector Control)
+	SVCRSMZA // SVCRFIELDS (Streaming Vector Control)
+
+	BTIC  // BTITARGETS (Branch Target Identification)
+	BTIJ  // BTITARGETS (Branch Target Identification)
+	BTIJC // BTITARGETS (Branch Target Identification)
+
+	PLDL1KEEP  // PRFOPS (Prefetch Operations)
+	PLDL1STRM  // PRFOPS (Prefetch Operations)
+	PLDL2KEEP  // PRFOPS (Prefetch Operations)
+	PLDL2STRM  // PRFOPS (Prefetch Operations)
+	PLDL3KEEP  // PRFOPS (Prefetch Operations)
+	PLDL3STRM  // PRFOPS (Prefetch Operations)
+	PLDSLCKEEP // PRFOPS (Prefetch Operations)
+	PLDSLCSTRM // PRFOPS (Prefetch Operations)
+	PLIL1KEEP  // PRFOPS (Prefetch Operations)
+	PLIL1STRM  // PRFOPS (Prefetch Operations)
+	PLIL2KEEP  // PRFOPS (Prefetch Operations)
+	PLIL2STRM  // PRFOPS (Prefetch Operations)
+	PLIL3KEEP  // PRFOPS (Prefetch Operations)
+	PLIL3STRM  // PRFOPS (Prefetch Operations)
+	PLISLCKEEP // PRFOPS (Prefetch Operations)
+	PLISLCSTRM // PRFOPS (Prefetch Operations)
+	PSTL1KEEP  // PRFOPS (Prefetch Operations)
+	PSTL1STRM  // PRFOPS (Prefetch Operations)
+	PSTL2KEEP  // PRFOPS (Prefetch Operations)
+	PSTL2STRM  // PRFOPS (Prefetch Operations)
+	PSTL3KEEP  // PRFOPS (Prefetch Operations)
+	PSTL3STRM  // PRFOPS (Prefetch Operations)
+	PSTSLCKEEP // PRFOPS (Prefetch Operations)
+	PSTSLCSTRM // PRFOPS (Prefetch Operations)
 )
 
 // Address Translation
@@ -196,35 +253,67 @@ var MSRIMMOPS = [...]Symbol{SPSEL, DAIFSET, DAIFCLR, UAO, PAN, DIT}
 // Translation Table
 var TLBIOPS = [...]Symbol{VMALLE1IS, VAE1IS, ASIDE1IS, VAAE1IS, VALE1IS, VAALE1IS, VMALLE1, VAE1, ASIDE1, VAAE1, VALE1, VAALE1, IPAS2E1IS, IPAS2LE1IS, ALLE2IS, VAE2IS, ALLE1IS, VALE2IS, VMALLS12E1IS, IPAS2E1, IPAS2LE1, ALLE2, VAE2, ALLE1, VALE2, VMALLS12E1, ALLE3IS, VAE3IS, VALE3IS, ALLE3, VAE3, VALE3, VMALLE1OS, VAE1OS, ASIDE1OS, VAAE1OS, VALE1OS, VAALE1OS, RVAE1IS, RVAAE1IS, RVALE1IS, RVAALE1IS, RVAE1OS, RVAAE1OS, RVALE1OS, RVAALE1OS, RVAE1, RVAAE1, RVALE1, RVAALE1, RIPAS2E1IS, RIPAS2LE1IS, ALLE2OS, VAE2OS, ALLE1OS, VALE2OS, VMALLS12E1OS, RVAE2IS, RVALE2IS, IPAS2E1OS, RIPAS2E1, RIPAS2E1OS, IPAS2LE1OS, RIPAS2LE1, RIPAS2LE1OS, RVAE2OS, RVALE2OS, RVAE2, RVALE2, ALLE3OS, VAE3OS, VALE3OS, RVAE3IS, RVALE3IS, RVAE3OS, RVALE3OS, RVAE3, RVALE3}
 
+// Predicate Constraints
+var SVEPATTERNS = [...]Symbol{POW2, VL1, VL2, VL3, VL4, VL5, VL6, VL7, VL8, VL16, VL32, VL64, VL128, VL256, MUL4, MUL3, ALL}
+
+// Streaming Vector Control
+var SVCRFIELDS = [...]Symbol{SVCRSM, SVCRZA, SVCRSMZA}
+
+// Branch Target Identification
+var BTITARGETS = [...]Symbol{BTIC, BTIJ, BTIJC}
+
+// Prefetch Operations
+var PRFOPS = [...]Symbol{PLDL1KEEP, PLDL1STRM, PLDL2KEEP, PLDL2STRM, PLDL3KEEP, PLDL3STRM, PLDSLCKEEP, PLDSLCSTRM, PLIL1KEEP, PLIL1STRM, PLIL2KEEP, PLIL2STRM, PLIL3KEEP, PLIL3STRM, PLISLCKEEP, PLISLCSTRM, PSTL1KEEP, PSTL1STRM, PSTL2KEEP, PSTL2STRM, PSTL3KEEP, PSTL3STRM, PSTSLCKEEP, PSTSLCSTRM}
+
 func symListContains(listSym uint8, arg Symbol) bool {
-	var list []Symbol
-	switch listSym {
-	default:
-		return false
-	case SymCONTROLREGS:
-		if arg < C0 || arg > C15 {
-			return false
+	if listSym == SymCONTROLREGS {
+		return arg >= C0 && arg <= C15
+	}
+	for _, x := range symList(listSym) {
+		if arg == x {
+			return true
 		}
-		return true
+	}
+	return false
+}
+
+// symList returns the symbols in the symbol list listSym, or nil for SymCONTROLREGS.
+func symList(listSym uint8) []Symbol {
+	switch listSym {
 	case SymATOPS:
-		list = ATOPS[:]
+		return ATOPS[:]
 	case SymDCOPS:
-		list = DCOPS[:]
+		return DCOPS[:]
 	case SymICOPS:
-		list = ICOPS[:]
+		return ICOPS[:]
 	case SymTLBIOPS:
-		list = TLBIOPS[:]
+		return TLBIOPS[:]
 	case SymBARRIEROPS:
-		list = BARRIEROPS[:]
+		return BARRIEROPS[:]
 	case SymMSRIMMOPS:
-		list = MSRIMMOPS[:]
+		return MSRIMMOPS[:]
+	case SymSVEPATTERNS:
+		return SVEPATTERNS[:]
+	case SymSVCRFIELDS:
+		return SVCRFIELDS[:]
+	case SymBTITARGETS:
+		return BTITARGETS[:]
+	case SymPRFOPS:
+		return PRFOPS[:]
 	}
-	for _, x := range list {
-		if arg == x {
-			return true
-		}
+	return nil
+}
+
+// symListWidth returns the bit width of the encoded values in the symbol list listSym.
+func symListWidth(listSym uint8) uint8 {
+	if listSym == SymCONTROLREGS {
+		return 4
 	}
-	return false
+	var values uint16
+	for _, x := range symList(listSym) {
+		values |= SymbolValue[x]
+	}
+	return uint8(bits.Len16(values))
 }
 
 var SymbolValue = [...]uint16{
@@ -370,6 +459,53 @@ var SymbolValue = [...]uint16{
 	RVALE3OS:     0b11010000101101,
 	RVAE3:        0b11010000110001,
 	RVALE3:       0b11010000110101,
+	POW2:         0,
+	VL1:          1,
+	VL2:          2,
+	VL3:          3,
+	VL4:          4,
+	VL5:          5,
+	VL6:          6,
+	VL7:          7,
+	VL8:          8,
+	VL16:         9,
+	VL32:         10,
+	VL64:         11,
+	VL128:        12,
+	VL256:        13,
+	MUL4:         29,
+	MUL3:         30,
+	ALL:          31,
+	SVCRSM:       0b01101000010011,
+	SVCRZA:       0b01101000100011,
+	SVCRSMZA:     0b01101000110011,
+	BTIC:         0b01,
+	BTIJ:         0b10,
+	BTIJC:        0b11,
+	PLDL1KEEP:    0b00000,
+	PLDL1STRM:    0b00001,
+	PLDL2KEEP:    0b00010,
+	PLDL2STRM:    0b00011,
+	PLDL3KEEP:    0b00100,
+	PLDL3STRM:    0b00101,
+	PLDSLCKEEP:   0b00110,
+	PLDSLCSTRM:   0b00111,
+	PLIL1KEEP:    0b01000,
+	PLIL1STRM:    0b01001,
+	PLIL2KEEP:    0b01010,
+	PLIL2STRM:    0b01011,
+	PLIL3KEEP:    0b01100,
+	PLIL3STRM:    0b01101,
+	PLISLCKEEP:   0b01110,
+	PLISLCSTRM:   0b01111,
+	PSTL1KEEP:    0b10000,
+	PSTL1STRM:    0b10001,
+	PSTL2KEEP:    0b10010,
+	PSTL2STRM:    0b10011,
+	PSTL3KEEP:    0b10100,
+	PSTL3STRM:    0b10101,
+	PSTSLCKEEP:   0b10110,
+	PSTSLCSTRM:   0b10111,
 }
 
 var SymbolName = [...]string{
@@ -515,4 +651,948 @@ var SymbolName = [...]string{
 	RVALE3OS:     "RVALE3OS",
 	RVAE3:        "RVAE3",
 	RVALE3:       "RVALE3",
+	POW2:         "POW2",
+	VL1:          "VL1",
+	VL2:          "VL2",
+	VL3:          "VL3",
+	VL4:          "VL4",
+	VL5:          "VL5",
+	VL6:          "VL6",
+	VL7:          "VL7",
+	VL8:          "VL8",
+	VL16:         "VL16",
+	VL32:         "VL32",
+	VL64:         "VL64",
+	VL128:        "VL128",
+	VL256:        "VL256",
+	MUL4:         "MUL4",
+	MUL3:         "MUL3",
+	ALL:          "ALL",
+	SVCRSM:       "SVCRSM",
+	SVCRZA:       "SVCRZA",
+	SVCRSMZA:     "SVCRSMZA",
+	BTIC:         "BTIC",
+	BTIJ:         "BTIJ",
+	BTIJC:        "BTIJC",
+	PLDL1KEEP:    "PLDL1KEEP",
+	PLDL1STRM:    "PLDL1STRM",
+	PLDL2KEEP:    "PLDL2KEEP",
+	PLDL2STRM:    "PLDL2STRM",
+	PLDL3KEEP:    "PLDL3KEEP",
+	PLDL3STRM:    "PLDL3STRM",
+	PLDSLCKEEP:   "PLDSLCKEEP",
+	PLDSLCSTRM:   "PLDSLCSTRM",
+	PLIL1KEEP:    "PLIL1KEEP",
+	PLIL1STRM:    "PLIL1STRM",
+	PLIL2KEEP:    "PLIL2KEEP",
+	PLIL2STRM:    "PLIL2STRM",
+	PLIL3KEEP:    "PLIL3KEEP",
+	PLIL3STRM:    "PLIL3STRM",
+	PLISLCKEEP:   "PLISLCKEEP",
+	PLISLCSTRM:   "PLISLCSTRM",
+	PSTL1KEEP:    "PSTL1KEEP",
+	PSTL1STRM:    "PSTL1STRM",
+	PSTL2KEEP:    "PSTL2KEEP",
+	PSTL2STRM:    "PSTL2STRM",
+	PSTL3KEEP:    "PSTL3KEEP",
+	PSTL3STRM:    "PSTL3STRM",
+	PSTSLCKEEP:   "PSTSLCKEEP",
+	PSTSLCSTRM:   "PSTSLCSTRM",
+}
+
+// SysReg returns the system register with the given encoding fields, where op0 is 2 (debug)
+// or 3 (non-debug), op1 and op2 are 0-7, and CRn and CRm are 0-15. Out-of-range fields are truncated.
+func SysReg(op0, op1, crn, crm, op2 uint8) SystemReg {
+	return SystemReg(op0&3)<<14 | SystemReg(op1&7)<<11 | SystemReg(crn&15)<<7 | SystemReg(crm&15)<<3 | SystemReg(op2&7)
+}
+
+// Fields returns the encoding fields for r.
+func (r SystemReg) Fields() (op0, op1, crn, crm, op2 uint8) {
+	return uint8(r>>14) & 3, uint8(r>>11) & 7, uint8(r>>7) & 15, uint8(r>>3) & 15, uint8(r) & 7
+}
+
+// String returns the name of r from [SystemRegs], or the generic name S<op0>_<op1>_C<n>_C<m>_<op2>.
+func (r SystemReg) String() string {
+	lo, hi := 0, len(SystemRegs)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if SystemRegs[mid].Reg < r {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(SystemRegs) && SystemRegs[lo].Reg == r {
+		return SystemRegs[lo].Name
+	}
+	op0, op1, crn, crm, op2 := r.Fields()
+	return "S" + strconv.Itoa(int(op0)) + "_" + strconv.Itoa(int(op1)) + "_C" + strconv.Itoa(int(crn)) +
+		"_C" + strconv.Itoa(int(crm)) + "_" + strconv.Itoa(int(op2))
+}
+
+// LookupSystemReg returns the system register for a case-insensitive name from [SystemRegs],
+// or for a generic name S<op0>_<op1>_C<n>_C<m>_<op2>.
+func LookupSystemReg(name string) (SystemReg, bool) {
+	for _, r := range SystemRegs {
+		if strings.EqualFold(r.Name, name) {
+			return r.Reg, true
+		}
+	}
+	fields := strings.Split(strings.ToUpper(name), "_")
+	if len(fields) != 5 || !strings.HasPrefix(fields[0], "S") || !strings.HasPrefix(fields[2], "C") || !strings.HasPrefix(fields[3], "C") {
+		return 0, false
+	}
+	fields[0], fields[2], fields[3] = fields[0][1:], fields[2][1:], fields[3][1:]
+	var xs [5]uint8
+	for i, max := range [5]int{3, 7, 15, 15, 7} {
+		x, err := strconv.Atoi(fields[i])
+		if err != nil || x < 0 || x > max || (i == 0 && x < 2) {
+			return 0, false
+		}
+		xs[i] = uint8(x)
+	}
+	return SysReg(xs[0], xs[1], xs[2], xs[3], xs[4]), true
+}
+
+// System registers, for MRS and MSR (register). PSTATE fields which are also accessible through MSR (immediate)
+// are selected by the SPSEL, PAN, UAO, and DIT symbols. Other registers may be constructed with [SysReg].
+const (
+	// Special-purpose registers
+
+	NZCV      SystemReg = 0b11_011_0100_0010_000
+	DAIF      SystemReg = 0b11_011_0100_0010_001
+	CurrentEL SystemReg = 0b11_000_0100_0010_010
+	SVCR      SystemReg = 0b11_011_0100_0010_010
+	SSBS      SystemReg = 0b11_011_0100_0010_110
+	TCO       SystemReg = 0b11_011_0100_0010_111
+	ALLINT    SystemReg = 0b11_000_0100_0011_000
+	FPCR      SystemReg = 0b11_011_0100_0100_000
+	FPSR      SystemReg = 0b11_011_0100_0100_001
+	DSPSR_EL0 SystemReg = 0b11_011_0100_0101_000
+	DLR_EL0   SystemReg = 0b11_011_0100_0101_001
+	SPSR_EL1  SystemReg = 0b11_000_0100_0000_000
+	ELR_EL1   SystemReg = 0b11_000_0100_0000_001
+	SP_EL0    SystemReg = 0b11_000_0100_0001_000
+	SPSR_EL2  SystemReg = 0b11_100_0100_0000_000
+	ELR_EL2   SystemReg = 0b11_100_0100_0000_001
+	SP_EL1    SystemReg = 0b11_100_0100_0001_000
+	SPSR_EL3  SystemReg = 0b11_110_0100_0000_000
+	ELR_EL3   SystemReg = 0b11_110_0100_0000_001
+	SP_EL2    SystemReg = 0b11_110_0100_0001_000
+	SPSR_IRQ  SystemReg = 0b11_100_0100_0011_000
+	SPSR_ABT  SystemReg = 0b11_100_0100_0011_001
+	SPSR_UND  SystemReg = 0b11_100_0100_0011_010
+	SPSR_FIQ  SystemReg = 0b11_100_0100_0011_011
+	SPSR_EL12 SystemReg = 0b11_101_0100_0000_000
+	ELR_EL12  SystemReg = 0b11_101_0100_0000_001
+
+	// Thread and context ID registers
+
+	TPIDR_EL0       SystemReg = 0b11_011_1101_0000_010
+	TPIDRRO_EL0     SystemReg = 0b11_011_1101_0000_011
+	TPIDR2_EL0      SystemReg = 0b11_011_1101_0000_101
+	TPIDR_EL1       SystemReg = 0b11_000_1101_0000_100
+	TPIDR_EL2       SystemReg = 0b11_100_1101_0000_010
+	TPIDR_EL3       SystemReg = 0b11_110_1101_0000_010
+	CONTEXTIDR_EL1  SystemReg = 0b11_000_1101_0000_001
+	CONTEXTIDR_EL2  SystemReg = 0b11_100_1101_0000_001
+	CONTEXTIDR_EL12 SystemReg = 0b11_101_1101_0000_001
+	ACCDATA_EL1     SystemReg = 0b11_000_1101_0000_101
+
+	// Identification registers
+
+	CTR_EL0          SystemReg = 0b11_011_0000_0000_001
+	DCZID_EL0        SystemReg = 0b11_011_0000_0000_111
+	RNDR             SystemReg = 0b11_011_0010_0100_000
+	RNDRRS           SystemReg = 0b11_011_0010_0100_001
+	MIDR_EL1         SystemReg = 0b11_000_0000_0000_000
+	MPIDR_EL1        SystemReg = 0b11_000_0000_0000_101
+	REVIDR_EL1       SystemReg = 0b11_000_0000_0000_110
+	ID_PFR0_EL1      SystemReg = 0b11_000_0000_0001_000
+	ID_PFR1_EL1      SystemReg = 0b11_000_0000_0001_001
+	ID_DFR0_EL1      SystemReg = 0b11_000_0000_0001_010
+	ID_AFR0_EL1      SystemReg = 0b11_000_0000_0001_011
+	ID_MMFR0_EL1     SystemReg = 0b11_000_0000_0001_100
+	ID_MMFR1_EL1     SystemReg = 0b11_000_0000_0001_101
+	ID_MMFR2_EL1     SystemReg = 0b11_000_0000_0001_110
+	ID_MMFR3_EL1     SystemReg = 0b11_000_0000_0001_111
+	ID_ISAR0_EL1     SystemReg = 0b11_000_0000_0010_000
+	ID_ISAR1_EL1     SystemReg = 0b11_000_0000_0010_001
+	ID_ISAR2_EL1     SystemReg = 0b11_000_0000_0010_010
+	ID_ISAR3_EL1     SystemReg = 0b11_000_0000_0010_011
+	ID_ISAR4_EL1     SystemReg = 0b11_000_0000_0010_100
+	ID_ISAR5_EL1     SystemReg = 0b11_000_0000_0010_101
+	ID_MMFR4_EL1     SystemReg = 0b11_000_0000_0010_110
+	ID_ISAR6_EL1     SystemReg = 0b11_000_0000_0010_111
+	MVFR0_EL1        SystemReg = 0b11_000_0000_0011_000
+	MVFR1_EL1        SystemReg = 0b11_000_0000_0011_001
+	MVFR2_EL1        SystemReg = 0b11_000_0000_0011_010
+	ID_PFR2_EL1      SystemReg = 0b11_000_0000_0011_100
+	ID_DFR1_EL1      SystemReg = 0b11_000_0000_0011_101
+	ID_MMFR5_EL1     SystemReg = 0b11_000_0000_0011_110
+	ID_AA64PFR0_EL1  SystemReg = 0b11_000_0000_0100_000
+	ID_AA64PFR1_EL1  SystemReg = 0b11_000_0000_0100_001
+	ID_AA64PFR2_EL1  SystemReg = 0b11_000_0000_0100_010
+	ID_AA64ZFR0_EL1  SystemReg = 0b11_000_0000_0100_100
+	ID_AA64SMFR0_EL1 SystemReg = 0b11_000_0000_0100_101
+	ID_AA64DFR0_EL1  SystemReg = 0b11_000_0000_0101_000
+	ID_AA64DFR1_EL1  SystemReg = 0b11_000_0000_0101_001
+	ID_AA64AFR0_EL1  SystemReg = 0b11_000_0000_0101_100
+	ID_AA64AFR1_EL1  SystemReg = 0b11_000_0000_0101_101
+	ID_AA64ISAR0_EL1 SystemReg = 0b11_000_0000_0110_000
+	ID_AA64ISAR1_EL1 SystemReg = 0b11_000_0000_0110_001
+	ID_AA64ISAR2_EL1 SystemReg = 0b11_000_0000_0110_010
+	ID_AA64MMFR0_EL1 SystemReg = 0b11_000_0000_0111_000
+	ID_AA64MMFR1_EL1 SystemReg = 0b11_000_0000_0111_001
+	ID_AA64MMFR2_EL1 SystemReg = 0b11_000_0000_0111_010
+	ID_AA64MMFR3_EL1 SystemReg = 0b11_000_0000_0111_011
+	CCSIDR_EL1       SystemReg = 0b11_001_0000_0000_000
+	CLIDR_EL1        SystemReg = 0b11_001_0000_0000_001
+	CCSIDR2_EL1      SystemReg = 0b11_001_0000_0000_010
+	GMID_EL1         SystemReg = 0b11_001_0000_0000_100
+	SMIDR_EL1        SystemReg = 0b11_001_0000_0000_110
+	AIDR_EL1         SystemReg = 0b11_001_0000_0000_111
+	CSSELR_EL1       SystemReg = 0b11_010_0000_0000_000
+	VPIDR_EL2        SystemReg = 0b11_100_0000_0000_000
+	VMPIDR_EL2       SystemReg = 0b11_100_0000_0000_101
+
+	// System control registers
+
+	SCTLR_EL1    SystemReg = 0b11_000_0001_0000_000
+	ACTLR_EL1    SystemReg = 0b11_000_0001_0000_001
+	CPACR_EL1    SystemReg = 0b11_000_0001_0000_010
+	RGSR_EL1     SystemReg = 0b11_000_0001_0000_101
+	GCR_EL1      SystemReg = 0b11_000_0001_0000_110
+	ZCR_EL1      SystemReg = 0b11_000_0001_0010_000
+	TRFCR_EL1    SystemReg = 0b11_000_0001_0010_001
+	SMPRI_EL1    SystemReg = 0b11_000_0001_0010_100
+	SMCR_EL1     SystemReg = 0b11_000_0001_0010_110
+	SCTLR_EL2    SystemReg = 0b11_100_0001_0000_000
+	ACTLR_EL2    SystemReg = 0b11_100_0001_0000_001
+	HCR_EL2      SystemReg = 0b11_100_0001_0001_000
+	MDCR_EL2     SystemReg = 0b11_100_0001_0001_001
+	CPTR_EL2     SystemReg = 0b11_100_0001_0001_010
+	HSTR_EL2     SystemReg = 0b11_100_0001_0001_011
+	HACR_EL2     SystemReg = 0b11_100_0001_0001_111
+	ZCR_EL2      SystemReg = 0b11_100_0001_0010_000
+	TRFCR_EL2    SystemReg = 0b11_100_0001_0010_001
+	HCRX_EL2     SystemReg = 0b11_100_0001_0010_010
+	SMPRIMAP_EL2 SystemReg = 0b11_100_0001_0010_101
+	SMCR_EL2     SystemReg = 0b11_100_0001_0010_110
+	SCTLR_EL3    SystemReg = 0b11_110_0001_0000_000
+	ACTLR_EL3    SystemReg = 0b11_110_0001_0000_001
+	SCR_EL3      SystemReg = 0b11_110_0001_0001_000
+	SDER32_EL3   SystemReg = 0b11_110_0001_0001_001
+	CPTR_EL3     SystemReg = 0b11_110_0001_0001_010
+	ZCR_EL3      SystemReg = 0b11_110_0001_0010_000
+	SMCR_EL3     SystemReg = 0b11_110_0001_0010_110
+	MDCR_EL3     SystemReg = 0b11_110_0001_0011_001
+	SCTLR_EL12   SystemReg = 0b11_101_0001_0000_000
+	CPACR_EL12   SystemReg = 0b11_101_0001_0000_010
+	ZCR_EL12     SystemReg = 0b11_101_0001_0010_000
+	SMCR_EL12    SystemReg = 0b11_101_0001_0010_110
+
+	// Memory management registers
+
+	TTBR0_EL1  SystemReg = 0b11_000_0010_0000_000
+	TTBR1_EL1  SystemReg = 0b11_000_0010_0000_001
+	TCR_EL1    SystemReg = 0b11_000_0010_0000_010
+	TTBR0_EL2  SystemReg = 0b11_100_0010_0000_000
+	TTBR1_EL2  SystemReg = 0b11_100_0010_0000_001
+	TCR_EL2    SystemReg = 0b11_100_0010_0000_010
+	VTTBR_EL2  SystemReg = 0b11_100_0010_0001_000
+	VTCR_EL2   SystemReg = 0b11_100_0010_0001_010
+	VNCR_EL2   SystemReg = 0b11_100_0010_0010_000
+	VSTTBR_EL2 SystemReg = 0b11_100_0010_0110_000
+	VSTCR_EL2  SystemReg = 0b11_100_0010_0110_010
+	TTBR0_EL3  SystemReg = 0b11_110_0010_0000_000
+	TCR_EL3    SystemReg = 0b11_110_0010_0000_010
+	TTBR0_EL12 SystemReg = 0b11_101_0010_0000_000
+	TTBR1_EL12 SystemReg = 0b11_101_0010_0000_001
+	TCR_EL12   SystemReg = 0b11_101_0010_0000_010
+	PAR_EL1    SystemReg = 0b11_000_0111_0100_000
+	MAIR_EL1   SystemReg = 0b11_000_1010_0010_000
+	AMAIR_EL1  SystemReg = 0b11_000_1010_0011_000
+	MAIR_EL2   SystemReg = 0b11_100_1010_0010_000
+	AMAIR_EL2  SystemReg = 0b11_100_1010_0011_000
+	MAIR_EL3   SystemReg = 0b11_110_1010_0010_000
+	AMAIR_EL3  SystemReg = 0b11_110_1010_0011_000
+	MAIR_EL12  SystemReg = 0b11_101_1010_0010_000
+	AMAIR_EL12 SystemReg = 0b11_101_1010_0011_000
+	LORSA_EL1  SystemReg = 0b11_000_1010_0100_000
+	LOREA_EL1  SystemReg = 0b11_000_1010_0100_001
+	LORN_EL1   SystemReg = 0b11_000_1010_0100_010
+	LORC_EL1   SystemReg = 0b11_000_1010_0100_011
+	LORID_EL1  SystemReg = 0b11_000_1010_0100_111
+	DACR32_EL2 SystemReg = 0b11_100_0011_0000_000
+
+	// Pointer authentication key registers
+
+	APIAKEYLO_EL1 SystemReg = 0b11_000_0010_0001_000
+	APIAKEYHI_EL1 SystemReg = 0b11_000_0010_0001_001
+	APIBKEYLO_EL1 SystemReg = 0b11_000_0010_0001_010
+	APIBKEYHI_EL1 SystemReg = 0b11_000_0010_0001_011
+	APDAKEYLO_EL1 SystemReg = 0b11_000_0010_0010_000
+	APDAKEYHI_EL1 SystemReg = 0b11_000_0010_0010_001
+	APDBKEYLO_EL1 SystemReg = 0b11_000_0010_0010_010
+	APDBKEYHI_EL1 SystemReg = 0b11_000_0010_0010_011
+	APGAKEYLO_EL1 SystemReg = 0b11_000_0010_0011_000
+	APGAKEYHI_EL1 SystemReg = 0b11_000_0010_0011_001
+
+	// Exception and fault registers
+
+	IFSR32_EL2    SystemReg = 0b11_100_0101_0000_001
+	FPEXC32_EL2   SystemReg = 0b11_100_0101_0011_000
+	AFSR0_EL1     SystemReg = 0b11_000_0101_0001_000
+	AFSR1_EL1     SystemReg = 0b11_000_0101_0001_001
+	ESR_EL1       SystemReg = 0b11_000_0101_0010_000
+	FAR_EL1       SystemReg = 0b11_000_0110_0000_000
+	AFSR0_EL2     SystemReg = 0b11_100_0101_0001_000
+	AFSR1_EL2     SystemReg = 0b11_100_0101_0001_001
+	ESR_EL2       SystemReg = 0b11_100_0101_0010_000
+	FAR_EL2       SystemReg = 0b11_100_0110_0000_000
+	HPFAR_EL2     SystemReg = 0b11_100_0110_0000_100
+	AFSR0_EL3     SystemReg = 0b11_110_0101_0001_000
+	AFSR1_EL3     SystemReg = 0b11_110_0101_0001_001
+	ESR_EL3       SystemReg = 0b11_110_0101_0010_000
+	FAR_EL3       SystemReg = 0b11_110_0110_0000_000
+	AFSR0_EL12    SystemReg = 0b11_101_0101_0001_000
+	AFSR1_EL12    SystemReg = 0b11_101_0101_0001_001
+	ESR_EL12      SystemReg = 0b11_101_0101_0010_000
+	FAR_EL12      SystemReg = 0b11_101_0110_0000_000
+	ERRIDR_EL1    SystemReg = 0b11_000_0101_0011_000
+	ERRSELR_EL1   SystemReg = 0b11_000_0101_0011_001
+	ERXFR_EL1     SystemReg = 0b11_000_0101_0100_000
+	ERXCTLR_EL1   SystemReg = 0b11_000_0101_0100_001
+	ERXSTATUS_EL1 SystemReg = 0b11_000_0101_0100_010
+	ERXADDR_EL1   SystemReg = 0b11_000_0101_0100_011
+	ERXMISC0_EL1  SystemReg = 0b11_000_0101_0101_000
+	ERXMISC1_EL1  SystemReg = 0b11_000_0101_0101_001
+	TFSR_EL1      SystemReg = 0b11_000_0101_0110_000
+	TFSRE0_EL1    SystemReg = 0b11_000_0101_0110_001
+	TFSR_EL2      SystemReg = 0b11_100_0101_0110_000
+	TFSR_EL3      SystemReg = 0b11_110_0101_0110_000
+	TFSR_EL12     SystemReg = 0b11_101_0101_0110_000
+	VBAR_EL1      SystemReg = 0b11_000_1100_0000_000
+	RVBAR_EL1     SystemReg = 0b11_000_1100_0000_001
+	RMR_EL1       SystemReg = 0b11_000_1100_0000_010
+	ISR_EL1       SystemReg = 0b11_000_1100_0001_000
+	DISR_EL1      SystemReg = 0b11_000_1100_0001_001
+	VBAR_EL2      SystemReg = 0b11_100_1100_0000_000
+	RVBAR_EL2     SystemReg = 0b11_100_1100_0000_001
+	RMR_EL2       SystemReg = 0b11_100_1100_0000_010
+	VDISR_EL2     SystemReg = 0b11_100_1100_0001_001
+	VBAR_EL3      SystemReg = 0b11_110_1100_0000_000
+	RVBAR_EL3     SystemReg = 0b11_110_1100_0000_001
+	RMR_EL3       SystemReg = 0b11_110_1100_0000_010
+	VBAR_EL12     SystemReg = 0b11_101_1100_0000_000
+
+	// Generic timer registers
+
+	CNTFRQ_EL0     SystemReg = 0b11_011_1110_0000_000
+	CNTPCT_EL0     SystemReg = 0b11_011_1110_0000_001
+	CNTVCT_EL0     SystemReg = 0b11_011_1110_0000_010
+	CNTPCTSS_EL0   SystemReg = 0b11_011_1110_0000_101
+	CNTVCTSS_EL0   SystemReg = 0b11_011_1110_0000_110
+	CNTP_TVAL_EL0  SystemReg = 0b11_011_1110_0010_000
+	CNTP_CTL_EL0   SystemReg = 0b11_011_1110_0010_001
+	CNTP_CVAL_EL0  SystemReg = 0b11_011_1110_0010_010
+	CNTV_TVAL_EL0  SystemReg = 0b11_011_1110_0011_000
+	CNTV_CTL_EL0   SystemReg = 0b11_011_1110_0011_001
+	CNTV_CVAL_EL0  SystemReg = 0b11_011_1110_0011_010
+	CNTKCTL_EL1    SystemReg = 0b11_000_1110_0001_000
+	CNTHCTL_EL2    SystemReg = 0b11_100_1110_0001_000
+	CNTVOFF_EL2    SystemReg = 0b11_100_1110_0000_011
+	CNTPOFF_EL2    SystemReg = 0b11_100_1110_0000_110
+	CNTHP_TVAL_EL2 SystemReg = 0b11_100_1110_0010_000
+	CNTHP_CTL_EL2  SystemReg = 0b11_100_1110_0010_001
+	CNTHP_CVAL_EL2 SystemReg = 0b11_100_1110_0010_010
+	CNTHV_TVAL_EL2 SystemReg = 0b11_100_1110_0011_000
+	CNTHV_CTL_EL2  SystemReg = 0b11_100_1110_0011_001
+	CNTHV_CVAL_EL2 SystemReg = 0b11_100_1110_0011_010
+	CNTPS_TVAL_EL1 SystemReg = 0b11_111_1110_0010_000
+	CNTPS_CTL_EL1  SystemReg = 0b11_111_1110_0010_001
+	CNTPS_CVAL_EL1 SystemReg = 0b11_111_1110_0010_010
+	CNTKCTL_EL12   SystemReg = 0b11_101_1110_0001_000
+	CNTP_TVAL_EL02 SystemReg = 0b11_101_1110_0010_000
+	CNTP_CTL_EL02  SystemReg = 0b11_101_1110_0010_001
+	CNTP_CVAL_EL02 SystemReg = 0b11_101_1110_0010_010
+	CNTV_TVAL_EL02 SystemReg = 0b11_101_1110_0011_000
+	CNTV_CTL_EL02  SystemReg = 0b11_101_1110_0011_001
+	CNTV_CVAL_EL02 SystemReg = 0b11_101_1110_0011_010
+
+	// Performance monitors registers
+
+	PMCR_EL0        SystemReg = 0b11_011_1001_1100_000
+	PMCNTENSET_EL0  SystemReg = 0b11_011_1001_1100_001
+	PMCNTENCLR_EL0  SystemReg = 0b11_011_1001_1100_010
+	PMOVSCLR_EL0    SystemReg = 0b11_011_1001_1100_011
+	PMSWINC_EL0     SystemReg = 0b11_011_1001_1100_100
+	PMSELR_EL0      SystemReg = 0b11_011_1001_1100_101
+	PMCEID0_EL0     SystemReg = 0b11_011_1001_1100_110
+	PMCEID1_EL0     SystemReg = 0b11_011_1001_1100_111
+	PMCCNTR_EL0     SystemReg = 0b11_011_1001_1101_000
+	PMXEVTYPER_EL0  SystemReg = 0b11_011_1001_1101_001
+	PMXEVCNTR_EL0   SystemReg = 0b11_011_1001_1101_010
+	PMUSERENR_EL0   SystemReg = 0b11_011_1001_1110_000
+	PMOVSSET_EL0    SystemReg = 0b11_011_1001_1110_011
+	PMINTENSET_EL1  SystemReg = 0b11_000_1001_1110_001
+	PMINTENCLR_EL1  SystemReg = 0b11_000_1001_1110_010
+	PMCCFILTR_EL0   SystemReg = 0b11_011_1110_1111_111
+	PMEVCNTR0_EL0   SystemReg = 0b11_011_1110_1000_000
+	PMEVCNTR1_EL0   SystemReg = 0b11_011_1110_1000_001
+	PMEVCNTR2_EL0   SystemReg = 0b11_011_1110_1000_010
+	PMEVCNTR3_EL0   SystemReg = 0b11_011_1110_1000_011
+	PMEVCNTR4_EL0   SystemReg = 0b11_011_1110_1000_100
+	PMEVCNTR5_EL0   SystemReg = 0b11_011_1110_1000_101
+	PMEVCNTR6_EL0   SystemReg = 0b11_011_1110_1000_110
+	PMEVCNTR7_EL0   SystemReg = 0b11_011_1110_1000_111
+	PMEVCNTR8_EL0   SystemReg = 0b11_011_1110_1001_000
+	PMEVCNTR9_EL0   SystemReg = 0b11_011_1110_1001_001
+	PMEVCNTR10_EL0  SystemReg = 0b11_011_1110_1001_010
+	PMEVCNTR11_EL0  SystemReg = 0b11_011_1110_1001_011
+	PMEVCNTR12_EL0  SystemReg = 0b11_011_1110_1001_100
+	PMEVCNTR13_EL0  SystemReg = 0b11_011_1110_1001_101
+	PMEVCNTR14_EL0  SystemReg = 0b11_011_1110_1001_110
+	PMEVCNTR15_EL0  SystemReg = 0b11_011_1110_1001_111
+	PMEVCNTR16_EL0  SystemReg = 0b11_011_1110_1010_000
+	PMEVCNTR17_EL0  SystemReg = 0b11_011_1110_1010_001
+	PMEVCNTR18_EL0  SystemReg = 0b11_011_1110_1010_010
+	PMEVCNTR19_EL0  SystemReg = 0b11_011_1110_1010_011
+	PMEVCNTR20_EL0  SystemReg = 0b11_011_1110_1010_100
+	PMEVCNTR21_EL0  SystemReg = 0b11_011_1110_1010_101
+	PMEVCNTR22_EL0  SystemReg = 0b11_011_1110_1010_110
+	PMEVCNTR23_EL0  SystemReg = 0b11_011_1110_1010_111
+	PMEVCNTR24_EL0  SystemReg = 0b11_011_1110_1011_000
+	PMEVCNTR25_EL0  SystemReg = 0b11_011_1110_1011_001
+	PMEVCNTR26_EL0  SystemReg = 0b11_011_1110_1011_010
+	PMEVCNTR27_EL0  SystemReg = 0b11_011_1110_1011_011
+	PMEVCNTR28_EL0  SystemReg = 0b11_011_1110_1011_100
+	PMEVCNTR29_EL0  SystemReg = 0b11_011_1110_1011_101
+	PMEVCNTR30_EL0  SystemReg = 0b11_011_1110_1011_110
+	PMEVTYPER0_EL0  SystemReg = 0b11_011_1110_1100_000
+	PMEVTYPER1_EL0  SystemReg = 0b11_011_1110_1100_001
+	PMEVTYPER2_EL0  SystemReg = 0b11_011_1110_1100_010
+	PMEVTYPER3_EL0  SystemReg = 0b11_011_1110_1100_011
+	PMEVTYPER4_EL0  SystemReg = 0b11_011_1110_1100_100
+	PMEVTYPER5_EL0  SystemReg = 0b11_011_1110_1100_101
+	PMEVTYPER6_EL0  SystemReg = 0b11_011_1110_1100_110
+	PMEVTYPER7_EL0  SystemReg = 0b11_011_1110_1100_111
+	PMEVTYPER8_EL0  SystemReg = 0b11_011_1110_1101_000
+	PMEVTYPER9_EL0  SystemReg = 0b11_011_1110_1101_001
+	PMEVTYPER10_EL0 SystemReg = 0b11_011_1110_1101_010
+	PMEVTYPER11_EL0 SystemReg = 0b11_011_1110_1101_011
+	PMEVTYPER12_EL0 SystemReg = 0b11_011_1110_1101_100
+	PMEVTYPER13_EL0 SystemReg = 0b11_011_1110_1101_101
+	PMEVTYPER14_EL0 SystemReg = 0b11_011_1110_1101_110
+	PMEVTYPER15_EL0 SystemReg = 0b11_011_1110_1101_111
+	PMEVTYPER16_EL0 SystemReg = 0b11_011_1110_1110_000
+	PMEVTYPER17_EL0 SystemReg = 0b11_011_1110_1110_001
+	PMEVTYPER18_EL0 SystemReg = 0b11_011_1110_1110_010
+	PMEVTYPER19_EL0 SystemReg = 0b11_011_1110_1110_011
+	PMEVTYPER20_EL0 SystemReg = 0b11_011_1110_1110_100
+	PMEVTYPER21_EL0 SystemReg = 0b11_011_1110_1110_101
+	PMEVTYPER22_EL0 SystemReg = 0b11_011_1110_1110_110
+	PMEVTYPER23_EL0 SystemReg = 0b11_011_1110_1110_111
+	PMEVTYPER24_EL0 SystemReg = 0b11_011_1110_1111_000
+	PMEVTYPER25_EL0 SystemReg = 0b11_011_1110_1111_001
+	PMEVTYPER26_EL0 SystemReg = 0b11_011_1110_1111_010
+	PMEVTYPER27_EL0 SystemReg = 0b11_011_1110_1111_011
+	PMEVTYPER28_EL0 SystemReg = 0b11_011_1110_1111_100
+	PMEVTYPER29_EL0 SystemReg = 0b11_011_1110_1111_101
+	PMEVTYPER30_EL0 SystemReg = 0b11_011_1110_1111_110
+
+	// Debug registers
+
+	OSDTRRX_EL1       SystemReg = 0b10_000_0000_0000_010
+	MDCCINT_EL1       SystemReg = 0b10_000_0000_0010_000
+	MDSCR_EL1         SystemReg = 0b10_000_0000_0010_010
+	OSDTRTX_EL1       SystemReg = 0b10_000_0000_0011_010
+	OSECCR_EL1        SystemReg = 0b10_000_0000_0110_010
+	MDRAR_EL1         SystemReg = 0b10_000_0001_0000_000
+	OSLAR_EL1         SystemReg = 0b10_000_0001_0000_100
+	OSLSR_EL1         SystemReg = 0b10_000_0001_0001_100
+	OSDLR_EL1         SystemReg = 0b10_000_0001_0011_100
+	DBGPRCR_EL1       SystemReg = 0b10_000_0001_0100_100
+	DBGCLAIMSET_EL1   SystemReg = 0b10_000_0111_1000_110
+	DBGCLAIMCLR_EL1   SystemReg = 0b10_000_0111_1001_110
+	DBGAUTHSTATUS_EL1 SystemReg = 0b10_000_0111_1110_110
+	MDCCSR_EL0        SystemReg = 0b10_011_0000_0001_000
+	DBGDTR_EL0        SystemReg = 0b10_011_0000_0100_000
+	DBGDTRRX_EL0      SystemReg = 0b10_011_0000_0101_000
+	DBGVCR32_EL2      SystemReg = 0b10_100_0000_0111_000
+	DBGBVR0_EL1       SystemReg = 0b10_000_0000_0000_100
+	DBGBCR0_EL1       SystemReg = 0b10_000_0000_0000_101
+	DBGWVR0_EL1       SystemReg = 0b10_000_0000_0000_110
+	DBGWCR0_EL1       SystemReg = 0b10_000_0000_0000_111
+	DBGBVR1_EL1       SystemReg = 0b10_000_0000_0001_100
+	DBGBCR1_EL1       SystemReg = 0b10_000_0000_0001_101
+	DBGWVR1_EL1       SystemReg = 0b10_000_0000_0001_110
+	DBGWCR1_EL1       SystemReg = 0b10_000_0000_0001_111
+	DBGBVR2_EL1       SystemReg = 0b10_000_0000_0010_100
+	DBGBCR2_EL1       SystemReg = 0b10_000_0000_0010_101
+	DBGWVR2_EL1       SystemReg = 0b10_000_0000_0010_110
+	DBGWCR2_EL1       SystemReg = 0b10_000_0000_0010_111
+	DBGBVR3_EL1       SystemReg = 0b10_000_0000_0011_100
+	DBGBCR3_EL1       SystemReg = 0b10_000_0000_0011_101
+	DBGWVR3_EL1       SystemReg = 0b10_000_0000_0011_110
+	DBGWCR3_EL1       SystemReg = 0b10_000_0000_0011_111
+	DBGBVR4_EL1       SystemReg = 0b10_000_0000_0100_100
+	DBGBCR4_EL1       SystemReg = 0b10_000_0000_0100_101
+	DBGWVR4_EL1       SystemReg = 0b10_000_0000_0100_110
+	DBGWCR4_EL1       SystemReg = 0b10_000_0000_0100_111
+	DBGBVR5_EL1       SystemReg = 0b10_000_0000_0101_100
+	DBGBCR5_EL1       SystemReg = 0b10_000_0000_0101_101
+	DBGWVR5_EL1       SystemReg = 0b10_000_0000_0101_110
+	DBGWCR5_EL1       SystemReg = 0b10_000_0000_0101_111
+	DBGBVR6_EL1       SystemReg = 0b10_000_0000_0110_100
+	DBGBCR6_EL1       SystemReg = 0b10_000_0000_0110_101
+	DBGWVR6_EL1       SystemReg = 0b10_000_0000_0110_110
+	DBGWCR6_EL1       SystemReg = 0b10_000_0000_0110_111
+	DBGBVR7_EL1       SystemReg = 0b10_000_0000_0111_100
+	DBGBCR7_EL1       SystemReg = 0b10_000_0000_0111_101
+	DBGWVR7_EL1       SystemReg = 0b10_000_0000_0111_110
+	DBGWCR7_EL1       SystemReg = 0b10_000_0000_0111_111
+	DBGBVR8_EL1       SystemReg = 0b10_000_0000_1000_100
+	DBGBCR8_EL1       SystemReg = 0b10_000_0000_1000_101
+	DBGWVR8_EL1       SystemReg = 0b10_000_0000_1000_110
+	DBGWCR8_EL1       SystemReg = 0b10_000_0000_1000_111
+	DBGBVR9_EL1       SystemReg = 0b10_000_0000_1001_100
+	DBGBCR9_EL1       SystemReg = 0b10_000_0000_1001_101
+	DBGWVR9_EL1       SystemReg = 0b10_000_0000_1001_110
+	DBGWCR9_EL1       SystemReg = 0b10_000_0000_1001_111
+	DBGBVR10_EL1      SystemReg = 0b10_000_0000_1010_100
+	DBGBCR10_EL1      SystemReg = 0b10_000_0000_1010_101
+	DBGWVR10_EL1      SystemReg = 0b10_000_0000_1010_110
+	DBGWCR10_EL1      SystemReg = 0b10_000_0000_1010_111
+	DBGBVR11_EL1      SystemReg = 0b10_000_0000_1011_100
+	DBGBCR11_EL1      SystemReg = 0b10_000_0000_1011_101
+	DBGWVR11_EL1      SystemReg = 0b10_000_0000_1011_110
+	DBGWCR11_EL1      SystemReg = 0b10_000_0000_1011_111
+	DBGBVR12_EL1      SystemReg = 0b10_000_0000_1100_100
+	DBGBCR12_EL1      SystemReg = 0b10_000_0000_1100_101
+	DBGWVR12_EL1      SystemReg = 0b10_000_0000_1100_110
+	DBGWCR12_EL1      SystemReg = 0b10_000_0000_1100_111
+	DBGBVR13_EL1      SystemReg = 0b10_000_0000_1101_100
+	DBGBCR13_EL1      SystemReg = 0b10_000_0000_1101_101
+	DBGWVR13_EL1      SystemReg = 0b10_000_0000_1101_110
+	DBGWCR13_EL1      SystemReg = 0b10_000_0000_1101_111
+	DBGBVR14_EL1      SystemReg = 0b10_000_0000_1110_100
+	DBGBCR14_EL1      SystemReg = 0b10_000_0000_1110_101
+	DBGWVR14_EL1      SystemReg = 0b10_000_0000_1110_110
+	DBGWCR14_EL1      SystemReg = 0b10_000_0000_1110_111
+	DBGBVR15_EL1      SystemReg = 0b10_000_0000_1111_100
+	DBGBCR15_EL1      SystemReg = 0b10_000_0000_1111_101
+	DBGWVR15_EL1      SystemReg = 0b10_000_0000_1111_110
+	DBGWCR15_EL1      SystemReg = 0b10_000_0000_1111_111
+)
+
+// SystemRegs contains the name of each named system register, ordered by encoding.
+var SystemRegs = [...]struct {
+	Reg  SystemReg
+	Name string
+}{
+	{OSDTRRX_EL1, "OSDTRRX_EL1"},
+	{DBGBVR0_EL1, "DBGBVR0_EL1"},
+	{DBGBCR0_EL1, "DBGBCR0_EL1"},
+	{DBGWVR0_EL1, "DBGWVR0_EL1"},
+	{DBGWCR0_EL1, "DBGWCR0_EL1"},
+	{DBGBVR1_EL1, "DBGBVR1_EL1"},
+	{DBGBCR1_EL1, "DBGBCR1_EL1"},
+	{DBGWVR1_EL1, "DBGWVR1_EL1"},
+	{DBGWCR1_EL1, "DBGWCR1_EL1"},
+	{MDCCINT_EL1, "MDCCINT_EL1"},
+	{MDSCR_EL1, "MDSCR_EL1"},
+	{DBGBVR2_EL1, "DBGBVR2_EL1"},
+	{DBGBCR2_EL1, "DBGBCR2_EL1"},
+	{DBGWVR2_EL1, "DBGWVR2_EL1"},
+	{DBGWCR2_EL1, "DBGWCR2_EL1"},
+	{OSDTRTX_EL1, "OSDTRTX_EL1"},
+	{DBGBVR3_EL1, "DBGBVR3_EL1"},
+	{DBGBCR3_EL1, "DBGBCR3_EL1"},
+	{DBGWVR3_EL1, "DBGWVR3_EL1"},
+	{DBGWCR3_EL1, "DBGWCR3_EL1"},
+	{DBGBVR4_EL1, "DBGBVR4_EL1"},
+	{DBGBCR4_EL1, "DBGBCR4_EL1"},
+	{DBGWVR4_EL1, "DBGWVR4_EL1"},
+	{DBGWCR4_EL1, "DBGWCR4_EL1"},
+	{DBGBVR5_EL1, "DBGBVR5_EL1"},
+	{DBGBCR5_EL1, "DBGBCR5_EL1"},
+	{DBGWVR5_EL1, "DBGWVR5_EL1"},
+	{DBGWCR5_EL1, "DBGWCR5_EL1"},
+	{OSECCR_EL1, "OSECCR_EL1"},
+	{DBGBVR6_EL1, "DBGBVR6_EL1"},
+	{DBGBCR6_EL1, "DBGBCR6_EL1"},
+	{DBGWVR6_EL1, "DBGWVR6_EL1"},
+	{DBGWCR6_EL1, "DBGWCR6_EL1"},
+	{DBGBVR7_EL1, "DBGBVR7_EL1"},
+	{DBGBCR7_EL1, "DBGBCR7_EL1"},
+	{DBGWVR7_EL1, "DBGWVR7_EL1"},
+	{DBGWCR7_EL1, "DBGWCR7_EL1"},
+	{DBGBVR8_EL1, "DBGBVR8_EL1"},
+	{DBGBCR8_EL1, "DBGBCR8_EL1"},
+	{DBGWVR8_EL1, "DBGWVR8_EL1"},
+	{DBGWCR8_EL1, "DBGWCR8_EL1"},
+	{DBGBVR9_EL1, "DBGBVR9_EL1"},
+	{DBGBCR9_EL1, "DBGBCR9_EL1"},
+	{DBGWVR9_EL1, "DBGWVR9_EL1"},
+	{DBGWCR9_EL1, "DBGWCR9_EL1"},
+	{DBGBVR10_EL1, "DBGBVR10_EL1"},
+	{DBGBCR10_EL1, "DBGBCR10_EL1"},
+	{DBGWVR10_EL1, "DBGWVR10_EL1"},
+	{DBGWCR10_EL1, "DBGWCR10_EL1"},
+	{DBGBVR11_EL1, "DBGBVR11_EL1"},
+	{DBGBCR11_EL1, "DBGBCR11_EL1"},
+	{DBGWVR11_EL1, "DBGWVR11_EL1"},
+	{DBGWCR11_EL1, "DBGWCR11_EL1"},
+	{DBGBVR12_EL1, "DBGBVR12_EL1"},
+	{DBGBCR12_EL1, "DBGBCR12_EL1"},
+	{DBGWVR12_EL1, "DBGWVR12_EL1"},
+	{DBGWCR12_EL1, "DBGWCR12_EL1"},
+	{DBGBVR13_EL1, "DBGBVR13_EL1"},
+	{DBGBCR13_EL1, "DBGBCR13_EL1"},
+	{DBGWVR13_EL1, "DBGWVR13_EL1"},
+	{DBGWCR13_EL1, "DBGWCR13_EL1"},
+	{DBGBVR14_EL1, "DBGBVR14_EL1"},
+	{DBGBCR14_EL1, "DBGBCR14_EL1"},
+	{DBGWVR14_EL1, "DBGWVR14_EL1"},
+	{DBGWCR14_EL1, "DBGWCR14_EL1"},
+	{DBGBVR15_EL1, "DBGBVR15_EL1"},
+	{DBGBCR15_EL1, "DBGBCR15_EL1"},
+	{DBGWVR15_EL1, "DBGWVR15_EL1"},
+	{DBGWCR15_EL1, "DBGWCR15_EL1"},
+	{MDRAR_EL1, "MDRAR_EL1"},
+	{OSLAR_EL1, "OSLAR_EL1"},
+	{OSLSR_EL1, "OSLSR_EL1"},
+	{OSDLR_EL1, "OSDLR_EL1"},
+	{DBGPRCR_EL1, "DBGPRCR_EL1"},
+	{DBGCLAIMSET_EL1, "DBGCLAIMSET_EL1"},
+	{DBGCLAIMCLR_EL1, "DBGCLAIMCLR_EL1"},
+	{DBGAUTHSTATUS_EL1, "DBGAUTHSTATUS_EL1"},
+	{MDCCSR_EL0, "MDCCSR_EL0"},
+	{DBGDTR_EL0, "DBGDTR_EL0"},
+	{DBGDTRRX_EL0, "DBGDTRRX_EL0"},
+	{DBGVCR32_EL2, "DBGVCR32_EL2"},
+	{MIDR_EL1, "MIDR_EL1"},
+	{MPIDR_EL1, "MPIDR_EL1"},
+	{REVIDR_EL1, "REVIDR_EL1"},
+	{ID_PFR0_EL1, "ID_PFR0_EL1"},
+	{ID_PFR1_EL1, "ID_PFR1_EL1"},
+	{ID_DFR0_EL1, "ID_DFR0_EL1"},
+	{ID_AFR0_EL1, "ID_AFR0_EL1"},
+	{ID_MMFR0_EL1, "ID_MMFR0_EL1"},
+	{ID_MMFR1_EL1, "ID_MMFR1_EL1"},
+	{ID_MMFR2_EL1, "ID_MMFR2_EL1"},
+	{ID_MMFR3_EL1, "ID_MMFR3_EL1"},
+	{ID_ISAR0_EL1, "ID_ISAR0_EL1"},
+	{ID_ISAR1_EL1, "ID_ISAR1_EL1"},
+	{ID_ISAR2_EL1, "ID_ISAR2_EL1"},
+	{ID_ISAR3_EL1, "ID_ISAR3_EL1"},
+	{ID_ISAR4_EL1, "ID_ISAR4_EL1"},
+	{ID_ISAR5_EL1, "ID_ISAR5_EL1"},
+	{ID_MMFR4_EL1, "ID_MMFR4_EL1"},
+	{ID_ISAR6_EL1, "ID_ISAR6_EL1"},
+	{MVFR0_EL1, "MVFR0_EL1"},
+	{MVFR1_EL1, "MVFR1_EL1"},
+	{MVFR2_EL1, "MVFR2_EL1"},
+	{ID_PFR2_EL1, "ID_PFR2_EL1"},
+	{ID_DFR1_EL1, "ID_DFR1_EL1"},
+	{ID_MMFR5_EL1, "ID_MMFR5_EL1"},
+	{ID_AA64PFR0_EL1, "ID_AA64PFR0_EL1"},
+	{ID_AA64PFR1_EL1, "ID_AA64PFR1_EL1"},
+	{ID_AA64PFR2_EL1, "ID_AA64PFR2_EL1"},
+	{ID_AA64ZFR0_EL1, "ID_AA64ZFR0_EL1"},
+	{ID_AA64SMFR0_EL1, "ID_AA64SMFR0_EL1"},
+	{ID_AA64DFR0_EL1, "ID_AA64DFR0_EL1"},
+	{ID_AA64DFR1_EL1, "ID_AA64DFR1_EL1"},
+	{ID_AA64AFR0_EL1, "ID_AA64AFR0_EL1"},
+	{ID_AA64AFR1_EL1, "ID_AA64AFR1_EL1"},
+	{ID_AA64ISAR0_EL1, "ID_AA64ISAR0_EL1"},
+	{ID_AA64ISAR1_EL1, "ID_AA64ISAR1_EL1"},
+	{ID_AA64ISAR2_EL1, "ID_AA64ISAR2_EL1"},
+	{ID_AA64MMFR0_EL1, "ID_AA64MMFR0_EL1"},
+	{ID_AA64MMFR1_EL1, "ID_AA64MMFR1_EL1"},
+	{ID_AA64MMFR2_EL1, "ID_AA64MMFR2_EL1"},
+	{ID_AA64MMFR3_EL1, "ID_AA64MMFR3_EL1"},
+	{SCTLR_EL1, "SCTLR_EL1"},
+	{ACTLR_EL1, "ACTLR_EL1"},
+	{CPACR_EL1, "CPACR_EL1"},
+	{RGSR_EL1, "RGSR_EL1"},
+	{GCR_EL1, "GCR_EL1"},
+	{ZCR_EL1, "ZCR_EL1"},
+	{TRFCR_EL1, "TRFCR_EL1"},
+	{SMPRI_EL1, "SMPRI_EL1"},
+	{SMCR_EL1, "SMCR_EL1"},
+	{TTBR0_EL1, "TTBR0_EL1"},
+	{TTBR1_EL1, "TTBR1_EL1"},
+	{TCR_EL1, "TCR_EL1"},
+	{APIAKEYLO_EL1, "APIAKEYLO_EL1"},
+	{APIAKEYHI_EL1, "APIAKEYHI_EL1"},
+	{APIBKEYLO_EL1, "APIBKEYLO_EL1"},
+	{APIBKEYHI_EL1, "APIBKEYHI_EL1"},
+	{APDAKEYLO_EL1, "APDAKEYLO_EL1"},
+	{APDAKEYHI_EL1, "APDAKEYHI_EL1"},
+	{APDBKEYLO_EL1, "APDBKEYLO_EL1"},
+	{APDBKEYHI_EL1, "APDBKEYHI_EL1"},
+	{APGAKEYLO_EL1, "APGAKEYLO_EL1"},
+	{APGAKEYHI_EL1, "APGAKEYHI_EL1"},
+	{SPSR_EL1, "SPSR_EL1"},
+	{ELR_EL1, "ELR_EL1"},
+	{SP_EL0, "SP_EL0"},
+	{0b11_000_0100_0010_000, "SPSel"},
+	{CurrentEL, "CurrentEL"},
+	{0b11_000_0100_0010_011, "PAN"},
+	{0b11_000_0100_0010_100, "UAO"},
+	{ALLINT, "ALLINT"},
+	{AFSR0_EL1, "AFSR0_EL1"},
+	{AFSR1_EL1, "AFSR1_EL1"},
+	{ESR_EL1, "ESR_EL1"},
+	{ERRIDR_EL1, "ERRIDR_EL1"},
+	{ERRSELR_EL1, "ERRSELR_EL1"},
+	{ERXFR_EL1, "ERXFR_EL1"},
+	{ERXCTLR_EL1, "ERXCTLR_EL1"},
+	{ERXSTATUS_EL1, "ERXSTATUS_EL1"},
+	{ERXADDR_EL1, "ERXADDR_EL1"},
+	{ERXMISC0_EL1, "ERXMISC0_EL1"},
+	{ERXMISC1_EL1, "ERXMISC1_EL1"},
+	{TFSR_EL1, "TFSR_EL1"},
+	{TFSRE0_EL1, "TFSRE0_EL1"},
+	{FAR_EL1, "FAR_EL1"},
+	{PAR_EL1, "PAR_EL1"},
+	{PMINTENSET_EL1, "PMINTENSET_EL1"},
+	{PMINTENCLR_EL1, "PMINTENCLR_EL1"},
+	{MAIR_EL1, "MAIR_EL1"},
+	{AMAIR_EL1, "AMAIR_EL1"},
+	{LORSA_EL1, "LORSA_EL1"},
+	{LOREA_EL1, "LOREA_EL1"},
+	{LORN_EL1, "LORN_EL1"},
+	{LORC_EL1, "LORC_EL1"},
+	{LORID_EL1, "LORID_EL1"},
+	{VBAR_EL1, "VBAR_EL1"},
+	{RVBAR_EL1, "RVBAR_EL1"},
+	{RMR_EL1, "RMR_EL1"},
+	{ISR_EL1, "ISR_EL1"},
+	{DISR_EL1, "DISR_EL1"},
+	{CONTEXTIDR_EL1, "CONTEXTIDR_EL1"},
+	{TPIDR_EL1, "TPIDR_EL1"},
+	{ACCDATA_EL1, "ACCDATA_EL1"},
+	{CNTKCTL_EL1, "CNTKCTL_EL1"},
+	{CCSIDR_EL1, "CCSIDR_EL1"},
+	{CLIDR_EL1, "CLIDR_EL1"},
+	{CCSIDR2_EL1, "CCSIDR2_EL1"},
+	{GMID_EL1, "GMID_EL1"},
+	{SMIDR_EL1, "SMIDR_EL1"},
+	{AIDR_EL1, "AIDR_EL1"},
+	{CSSELR_EL1, "CSSELR_EL1"},
+	{CTR_EL0, "CTR_EL0"},
+	{DCZID_EL0, "DCZID_EL0"},
+	{RNDR, "RNDR"},
+	{RNDRRS, "RNDRRS"},
+	{NZCV, "NZCV"},
+	{DAIF, "DAIF"},
+	{SVCR, "SVCR"},
+	{0b11_011_0100_0010_101, "DIT"},
+	{SSBS, "SSBS"},
+	{TCO, "TCO"},
+	{FPCR, "FPCR"},
+	{FPSR, "FPSR"},
+	{DSPSR_EL0, "DSPSR_EL0"},
+	{DLR_EL0, "DLR_EL0"},
+	{PMCR_EL0, "PMCR_EL0"},
+	{PMCNTENSET_EL0, "PMCNTENSET_EL0"},
+	{PMCNTENCLR_EL0, "PMCNTENCLR_EL0"},
+	{PMOVSCLR_EL0, "PMOVSCLR_EL0"},
+	{PMSWINC_EL0, "PMSWINC_EL0"},
+	{PMSELR_EL0, "PMSELR_EL0"},
+	{PMCEID0_EL0, "PMCEID0_EL0"},
+	{PMCEID1_EL0, "PMCEID1_EL0"},
+	{PMCCNTR_EL0, "PMCCNTR_EL0"},
+	{PMXEVTYPER_EL0, "PMXEVTYPER_EL0"},
+	{PMXEVCNTR_EL0, "PMXEVCNTR_EL0"},
+	{PMUSERENR_EL0, "PMUSERENR_EL0"},
+	{PMOVSSET_EL0, "PMOVSSET_EL0"},
+	{TPIDR_EL0, "TPIDR_EL0"},
+	{TPIDRRO_EL0, "TPIDRRO_EL0"},
+	{TPIDR2_EL0, "TPIDR2_EL0"},
+	{CNTFRQ_EL0, "CNTFRQ_EL0"},
+	{CNTPCT_EL0, "CNTPCT_EL0"},
+	{CNTVCT_EL0, "CNTVCT_EL0"},
+	{CNTPCTSS_EL0, "CNTPCTSS_EL0"},
+	{CNTVCTSS_EL0, "CNTVCTSS_EL0"},
+	{CNTP_TVAL_EL0, "CNTP_TVAL_EL0"},
+	{CNTP_CTL_EL0, "CNTP_CTL_EL0"},
+	{CNTP_CVAL_EL0, "CNTP_CVAL_EL0"},
+	{CNTV_TVAL_EL0, "CNTV_TVAL_EL0"},
+	{CNTV_CTL_EL0, "CNTV_CTL_EL0"},
+	{CNTV_CVAL_EL0, "CNTV_CVAL_EL0"},
+	{PMEVCNTR0_EL0, "PMEVCNTR0_EL0"},
+	{PMEVCNTR1_EL0, "PMEVCNTR1_EL0"},
+	{PMEVCNTR2_EL0, "PMEVCNTR2_EL0"},
+	{PMEVCNTR3_EL0, "PMEVCNTR3_EL0"},
+	{PMEVCNTR4_EL0, "PMEVCNTR4_EL0"},
+	{PMEVCNTR5_EL0, "PMEVCNTR5_EL0"},
+	{PMEVCNTR6_EL0, "PMEVCNTR6_EL0"},
+	{PMEVCNTR7_EL0, "PMEVCNTR7_EL0"},
+	{PMEVCNTR8_EL0, "PMEVCNTR8_EL0"},
+	{PMEVCNTR9_EL0, "PMEVCNTR9_EL0"},
+	{PMEVCNTR10_EL0, "PMEVCNTR10_EL0"},
+	{PMEVCNTR11_EL0, "PMEVCNTR11_EL0"},
+	{PMEVCNTR12_EL0, "PMEVCNTR12_EL0"},
+	{PMEVCNTR13_EL0, "PMEVCNTR13_EL0"},
+	{PMEVCNTR14_EL0, "PMEVCNTR14_EL0"},
+	{PMEVCNTR15_EL0, "PMEVCNTR15_EL0"},
+	{PMEVCNTR16_EL0, "PMEVCNTR16_EL0"},
+	{PMEVCNTR17_EL0, "PMEVCNTR17_EL0"},
+	{PMEVCNTR18_EL0, "PMEVCNTR18_EL0"},
+	{PMEVCNTR19_EL0, "PMEVCNTR19_EL0"},
+	{PMEVCNTR20_EL0, "PMEVCNTR20_EL0"},
+	{PMEVCNTR21_EL0, "PMEVCNTR21_EL0"},
+	{PMEVCNTR22_EL0, "PMEVCNTR22_EL0"},
+	{PMEVCNTR23_EL0, "PMEVCNTR23_EL0"},
+	{PMEVCNTR24_EL0, "PMEVCNTR24_EL0"},
+	{PMEVCNTR25_EL0, "PMEVCNTR25_EL0"},
+	{PMEVCNTR26_EL0, "PMEVCNTR26_EL0"},
+	{PMEVCNTR27_EL0, "PMEVCNTR27_EL0"},
+	{PMEVCNTR28_EL0, "PMEVCNTR28_EL0"},
+	{PMEVCNTR29_EL0, "PMEVCNTR29_EL0"},
+	{PMEVCNTR30_EL0, "PMEVCNTR30_EL0"},
+	{PMEVTYPER0_EL0, "PMEVTYPER0_EL0"},
+	{PMEVTYPER1_EL0, "PMEVTYPER1_EL0"},
+	{PMEVTYPER2_EL0, "PMEVTYPER2_EL0"},
+	{PMEVTYPER3_EL0, "PMEVTYPER3_EL0"},
+	{PMEVTYPER4_EL0, "PMEVTYPER4_EL0"},
+	{PMEVTYPER5_EL0, "PMEVTYPER5_EL0"},
+	{PMEVTYPER6_EL0, "PMEVTYPER6_EL0"},
+	{PMEVTYPER7_EL0, "PMEVTYPER7_EL0"},
+	{PMEVTYPER8_EL0, "PMEVTYPER8_EL0"},
+	{PMEVTYPER9_EL0, "PMEVTYPER9_EL0"},
+	{PMEVTYPER10_EL0, "PMEVTYPER10_EL0"},
+	{PMEVTYPER11_EL0, "PMEVTYPER11_EL0"},
+	{PMEVTYPER12_EL0, "PMEVTYPER12_EL0"},
+	{PMEVTYPER13_EL0, "PMEVTYPER13_EL0"},
+	{PMEVTYPER14_EL0, "PMEVTYPER14_EL0"},
+	{PMEVTYPER15_EL0, "PMEVTYPER15_EL0"},
+	{PMEVTYPER16_EL0, "PMEVTYPER16_EL0"},
+	{PMEVTYPER17_EL0, "PMEVTYPER17_EL0"},
+	{PMEVTYPER18_EL0, "PMEVTYPER18_EL0"},
+	{PMEVTYPER19_EL0, "PMEVTYPER19_EL0"},
+	{PMEVTYPER20_EL0, "PMEVTYPER20_EL0"},
+	{PMEVTYPER21_EL0, "PMEVTYPER21_EL0"},
+	{PMEVTYPER22_EL0, "PMEVTYPER22_EL0"},
+	{PMEVTYPER23_EL0, "PMEVTYPER23_EL0"},
+	{PMEVTYPER24_EL0, "PMEVTYPER24_EL0"},
+	{PMEVTYPER25_EL0, "PMEVTYPER25_EL0"},
+	{PMEVTYPER26_EL0, "PMEVTYPER26_EL0"},
+	{PMEVTYPER27_EL0, "PMEVTYPER27_EL0"},
+	{PMEVTYPER28_EL0, "PMEVTYPER28_EL0"},
+	{PMEVTYPER29_EL0, "PMEVTYPER29_EL0"},
+	{PMEVTYPER30_EL0, "PMEVTYPER30_EL0"},
+	{PMCCFILTR_EL0, "PMCCFILTR_EL0"},
+	{VPIDR_EL2, "VPIDR_EL2"},
+	{VMPIDR_EL2, "VMPIDR_EL2"},
+	{SCTLR_EL2, "SCTLR_EL2"},
+	{ACTLR_EL2, "ACTLR_EL2"},
+	{HCR_EL2, "HCR_EL2"},
+	{MDCR_EL2, "MDCR_EL2"},
+	{CPTR_EL2, "CPTR_EL2"},
+	{HSTR_EL2, "HSTR_EL2"},
+	{HACR_EL2, "HACR_EL2"},
+	{ZCR_EL2, "ZCR_EL2"},
+	{TRFCR_EL2, "TRFCR_EL2"},
+	{HCRX_EL2, "HCRX_EL2"},
+	{SMPRIMAP_EL2, "SMPRIMAP_EL2"},
+	{SMCR_EL2, "SMCR_EL2"},
+	{TTBR0_EL2, "TTBR0_EL2"},
+	{TTBR1_EL2, "TTBR1_EL2"},
+	{TCR_EL2, "TCR_EL2"},
+	{VTTBR_EL2, "VTTBR_EL2"},
+	{VTCR_EL2, "VTCR_EL2"},
+	{VNCR_EL2, "VNCR_EL2"},
+	{VSTTBR_EL2, "VSTTBR_EL2"},
+	{VSTCR_EL2, "VSTCR_EL2"},
+	{DACR32_EL2, "DACR32_EL2"},
+	{SPSR_EL2, "SPSR_EL2"},
+	{ELR_EL2, "ELR_EL2"},
+	{SP_EL1, "SP_EL1"},
+	{SPSR_IRQ, "SPSR_IRQ"},
+	{SPSR_ABT, "SPSR_ABT"},
+	{SPSR_UND, "SPSR_UND"},
+	{SPSR_FIQ, "SPSR_FIQ"},
+	{IFSR32_EL2, "IFSR32_EL2"},
+	{AFSR0_EL2, "AFSR0_EL2"},
+	{AFSR1_EL2, "AFSR1_EL2"},
+	{ESR_EL2, "ESR_EL2"},
+	{FPEXC32_EL2, "FPEXC32_EL2"},
+	{TFSR_EL2, "TFSR_EL2"},
+	{FAR_EL2, "FAR_EL2"},
+	{HPFAR_EL2, "HPFAR_EL2"},
+	{MAIR_EL2, "MAIR_EL2"},
+	{AMAIR_EL2, "AMAIR_EL2"},
+	{VBAR_EL2, "VBAR_EL2"},
+	{RVBAR_EL2, "RVBAR_EL2"},
+	{RMR_EL2, "RMR_EL2"},
+	{VDISR_EL2, "VDISR_EL2"},
+	{CONTEXTIDR_EL2, "CONTEXTIDR_EL2"},
+	{TPIDR_EL2, "TPIDR_EL2"},
+	{CNTVOFF_EL2, "CNTVOFF_EL2"},
+	{CNTPOFF_EL2, "CNTPOFF_EL2"},
+	{CNTHCTL_EL2, "CNTHCTL_EL2"},
+	{CNTHP_TVAL_EL2, "CNTHP_TVAL_EL2"},
+	{CNTHP_CTL_EL2, "CNTHP_CTL_EL2"},
+	{CNTHP_CVAL_EL2, "CNTHP_CVAL_EL2"},
+	{CNTHV_TVAL_EL2, "CNTHV_TVAL_EL2"},
+	{CNTHV_CTL_EL2, "CNTHV_CTL_EL2"},
+	{CNTHV_CVAL_EL2, "CNTHV_CVAL_EL2"},
+	{SCTLR_EL12, "SCTLR_EL12"},
+	{CPACR_EL12, "CPACR_EL12"},
+	{ZCR_EL12, "ZCR_EL12"},
+	{SMCR_EL12, "SMCR_EL12"},
+	{TTBR0_EL12, "TTBR0_EL12"},
+	{TTBR1_EL12, "TTBR1_EL12"},
+	{TCR_EL12, "TCR_EL12"},
+	{SPSR_EL12, "SPSR_EL12"},
+	{ELR_EL12, "ELR_EL12"},
+	{AFSR0_EL12, "AFSR0_EL12"},
+	{AFSR1_EL12, "AFSR1_EL12"},
+	{ESR_EL12, "ESR_EL12"},
+	{TFSR_EL12, "TFSR_EL12"},
+	{FAR_EL12, "FAR_EL12"},
+	{MAIR_EL12, "MAIR_EL12"},
+	{AMAIR_EL12, "AMAIR_EL12"},
+	{VBAR_EL12, "VBAR_EL12"},
+	{CONTEXTIDR_EL12, "CONTEXTIDR_EL12"},
+	{CNTKCTL_EL12, "CNTKCTL_EL12"},
+	{CNTP_TVAL_EL02, "CNTP_TVAL_EL02"},
+	{CNTP_CTL_EL02, "CNTP_CTL_EL02"},
+	{CNTP_CVAL_EL02, "CNTP_CVAL_EL02"},
+	{CNTV_TVAL_EL02, "CNTV_TVAL_EL02"},
+	{CNTV_CTL_EL02, "CNTV_CTL_EL02"},
+	{CNTV_CVAL_EL02, "CNTV_CVAL_EL02"},
+	{SCTLR_EL3, "SCTLR_EL3"},
+	{ACTLR_EL3, "ACTLR_EL3"},
+	{SCR_EL3, "SCR_EL3"},
+	{SDER32_EL3, "SDER32_EL3"},
+	{CPTR_EL3, "CPTR_EL3"},
+	{ZCR_EL3, "ZCR_EL3"},
+	{SMCR_EL3, "SMCR_EL3"},
+	{MDCR_EL3, "MDCR_EL3"},
+	{TTBR0_EL3, "TTBR0_EL3"},
+	{TCR_EL3, "TCR_EL3"},
+	{SPSR_EL3, "SPSR_EL3"},
+	{ELR_EL3, "ELR_EL3"},
+	{SP_EL2, "SP_EL2"},
+	{AFSR0_EL3, "AFSR0_EL3"},
+	{AFSR1_EL3, "AFSR1_EL3"},
+	{ESR_EL3, "ESR_EL3"},
+	{TFSR_EL3, "TFSR_EL3"},
+	{FAR_EL3, "FAR_EL3"},
+	{MAIR_EL3, "MAIR_EL3"},
+	{AMAIR_EL3, "AMAIR_EL3"},
+	{VBAR_EL3, "VBAR_EL3"},
+	{RVBAR_EL3, "RVBAR_EL3"},
+	{RMR_EL3, "RMR_EL3"},
+	{TPIDR_EL3, "TPIDR_EL3"},
+	{CNTPS_TVAL_EL1, "CNTPS_TVAL_EL1"},
+	{CNTPS_CTL_EL1, "CNTPS_CTL_EL1"},
+	{CNTPS_CVAL_EL1, "CNTPS_CVAL_EL1"},
 }
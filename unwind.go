@@ -0,0 +1,305 @@
+package arm
+
+import "encoding/binary"
+
+// CFIOp is a call frame information rule, recorded by the CFI methods of an [Assembler].
+type CFIOp uint8
+
+const (
+	_ CFIOp = iota
+
+	CFIDefCFA         // the CFA is Reg + Offset
+	CFIDefCFAOffset   // the CFA is the current CFA register + Offset
+	CFIDefCFARegister // the CFA is Reg + the current CFA offset
+	CFIOffset         // Reg is saved at CFA + Offset
+	CFIRestore        // Reg has its value at entry
+	CFIRememberState  // push the rules for all registers
+	CFIRestoreState   // pop the rules pushed by CFIRememberState
+)
+
+// CFIInst is a call frame information rule which applies from PC until the end of its procedure or the next rule.
+type CFIInst struct {
+	PC     uint32
+	Op     CFIOp
+	Reg    Reg
+	Offset int32
+}
+
+// UnwindProc is the call frame information for a procedure, from [Assembler.CFIStartProc] to [Assembler.CFIEndProc].
+// The CFA (canonical frame address) is SP at entry, and X30 holds the return address.
+type UnwindProc struct {
+	Start, End uint32
+	Insts      []CFIInst
+}
+
+// CFIStartProc begins a procedure at the current PC for unwind info. Rules are recorded by the CFI methods,
+// [Assembler.Prologue], and [Assembler.Epilogue] until the procedure is ended by [Assembler.CFIEndProc].
+func (a *Assembler) CFIStartProc() bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.cfiOpen {
+		a.Err = ErrInvalidCFI
+		return false
+	}
+	a.procs = append(a.procs, UnwindProc{Start: a.PC})
+	a.cfiOpen = true
+	return true
+}
+
+// CFIEndProc ends the current procedure at the current PC.
+func (a *Assembler) CFIEndProc() bool {
+	if a.Err != nil {
+		return false
+	}
+	if !a.cfiOpen || a.PC == a.procs[len(a.procs)-1].Start {
+		a.Err = ErrInvalidCFI
+		return false
+	}
+	a.procs[len(a.procs)-1].End = a.PC
+	a.cfiOpen = false
+	return true
+}
+
+// CFIDefCFA records that the CFA is reg + offset from the current PC.
+func (a *Assembler) CFIDefCFA(reg Reg, offset int32) bool {
+	return a.cfi(CFIInst{Op: CFIDefCFA, Reg: reg, Offset: offset})
+}
+
+// CFIDefCFAOffset records that the CFA is the current CFA register + offset from the current PC.
+func (a *Assembler) CFIDefCFAOffset(offset int32) bool {
+	return a.cfi(CFIInst{Op: CFIDefCFAOffset, Offset: offset})
+}
+
+// CFIDefCFARegister records that the CFA is reg + the current CFA offset from the current PC.
+func (a *Assembler) CFIDefCFARegister(reg Reg) bool {
+	return a.cfi(CFIInst{Op: CFIDefCFARegister, Reg: reg})
+}
+
+// CFIOffset records that reg is saved at CFA + offset from the current PC. The offset must be a multiple of 8.
+func (a *Assembler) CFIOffset(reg Reg, offset int32) bool {
+	return a.cfi(CFIInst{Op: CFIOffset, Reg: reg, Offset: offset})
+}
+
+// CFIRestore records that reg has its value at entry from the current PC.
+func (a *Assembler) CFIRestore(reg Reg) bool {
+	return a.cfi(CFIInst{Op: CFIRestore, Reg: reg})
+}
+
+// CFIRememberState saves the rules for all registers at the current PC, to be restored by
+// [Assembler.CFIRestoreState] (e.g. after an epilogue which is followed by more code).
+func (a *Assembler) CFIRememberState() bool { return a.cfi(CFIInst{Op: CFIRememberState}) }
+
+// CFIRestoreState restores the rules saved by the most recent [Assembler.CFIRememberState] from the current PC.
+func (a *Assembler) CFIRestoreState() bool { return a.cfi(CFIInst{Op: CFIRestoreState}) }
+
+// UnwindProcs returns the procedures recorded since the most recent call to [Assembler.Init].
+func (a *Assembler) UnwindProcs() []UnwindProc { return a.procs }
+
+// cfi records a rule at the current PC, setting Err to [ErrInvalidCFI] if no procedure is open or the rule is
+// invalid.
+func (a *Assembler) cfi(inst CFIInst) bool {
+	if a.Err != nil {
+		return false
+	}
+	_, regOK := dwarfReg(inst.Reg)
+	switch inst.Op {
+	case CFIDefCFA, CFIDefCFAOffset:
+		regOK = regOK || inst.Op == CFIDefCFAOffset
+		regOK = regOK && inst.Offset >= 0
+	case CFIOffset:
+		regOK = regOK && inst.Offset%8 == 0
+	case CFIRememberState, CFIRestoreState:
+		regOK = true
+	}
+	if !a.cfiOpen || !regOK {
+		a.Err = ErrInvalidCFI
+		return false
+	}
+	inst.PC = a.PC
+	proc := &a.procs[len(a.procs)-1]
+	proc.Insts = append(proc.Insts, inst)
+	return true
+}
+
+// cfiFrame records the rules for a frame helper if a procedure is open.
+func (a *Assembler) cfiFrame(insts ...CFIInst) {
+	if !a.cfiOpen || a.Err != nil {
+		return
+	}
+	for _, inst := range insts {
+		a.cfi(inst)
+	}
+}
+
+// dwarfReg returns the DWARF register number for reg: 0-30 for X0-X30, 31 for SP, and 64-95 for V0-V31.
+func dwarfReg(reg Reg) (uint8, bool) {
+	switch reg.Family() {
+	case RegSP:
+		return 31, true
+	case RegInt:
+		return reg.ID, reg.ID < 31
+	case RegFloat, RegVec32, RegVec64, RegVec128:
+		return 64 + reg.ID, reg.ID < 32
+	}
+	return 0, false
+}
+
+// EHFrame returns an .eh_frame section with a CIE and an FDE for each ended procedure, with absolute addresses
+// relative to base (the address of the code buffer), followed by a zero terminator, and the offset of each FDE.
+// The section may be registered with libgcc's __register_frame; for libunwind, register each FDE.
+func (a *Assembler) EHFrame(base uint64) (section []byte, fdes []int) {
+	return a.frameSection(base, true)
+}
+
+// DebugFrame returns a .debug_frame section with a CIE and an FDE for each ended procedure, with absolute
+// addresses relative to base.
+func (a *Assembler) DebugFrame(base uint64) []byte {
+	section, _ := a.frameSection(base, false)
+	return section
+}
+
+// DWARF call frame instructions:
+const (
+	dwCFAAdvanceLoc      = 0x40
+	dwCFAOffset          = 0x80
+	dwCFARestore         = 0xC0
+	dwCFAAdvanceLoc1     = 0x02
+	dwCFAAdvanceLoc2     = 0x03
+	dwCFAAdvanceLoc4     = 0x04
+	dwCFAOffsetExtSf     = 0x11
+	dwCFARestoreExt      = 0x06
+	dwCFARememberState   = 0x0A
+	dwCFARestoreState    = 0x0B
+	dwCFADefCFA          = 0x0C
+	dwCFADefCFARegister  = 0x0D
+	dwCFADefCFAOffset    = 0x0E
+	dwCFANop             = 0x00
+	dwCodeAlign          = 4
+	dwDataAlign          = -8
+	dwReturnAddressReg   = 30
+	dwCIEIDDebugFrame    = 0xFFFFFFFF
+	dwAugmentationFDEEnc = 0x00 // DW_EH_PE_absptr
+)
+
+// frameSection writes a CIE followed by an FDE for each ended procedure.
+func (a *Assembler) frameSection(base uint64, eh bool) (section []byte, fdes []int) {
+	cie := []byte{1} // version
+	if eh {
+		cie = append(cie, 'z', 'R', 0)
+	} else {
+		cie = append(cie, 0)
+	}
+	cie = appendULEB(cie, dwCodeAlign)
+	cie = appendSLEB(cie, dwDataAlign)
+	cie = append(cie, dwReturnAddressReg)
+	if eh {
+		cie = append(cie, 1, dwAugmentationFDEEnc)
+	}
+	cie = append(cie, dwCFADefCFA, 31, 0) // CFA = SP + 0
+	id := uint32(0)
+	if !eh {
+		id = dwCIEIDDebugFrame
+	}
+	section = appendFrameEntry(section, id, cie)
+
+	for _, proc := range a.procs {
+		if proc.End == 0 {
+			continue
+		}
+		fdes = append(fdes, len(section))
+		fde := binary.LittleEndian.AppendUint64(nil, base+uint64(proc.Start))
+		fde = binary.LittleEndian.AppendUint64(fde, uint64(proc.End-proc.Start))
+		if eh {
+			fde = append(fde, 0) // augmentation data length
+		}
+		fde = appendCFIInsts(fde, proc)
+		cieOffset := uint32(0) // offset of the CIE within .debug_frame
+		if eh {
+			cieOffset = uint32(len(section) + 4) // distance back to the CIE from the CIE pointer
+		}
+		section = appendFrameEntry(section, cieOffset, fde)
+	}
+	if eh {
+		section = append(section, 0, 0, 0, 0)
+	}
+	return section, fdes
+}
+
+// appendFrameEntry appends a CIE or FDE with a length and id (or CIE pointer), padded with DW_CFA_nop so that the
+// entry including its length field is a multiple of the address size (8 bytes).
+func appendFrameEntry(section []byte, id uint32, body []byte) []byte {
+	length := (4+4+len(body)+7)&^7 - 4 // excludes the length field
+	section = binary.LittleEndian.AppendUint32(section, uint32(length))
+	section = binary.LittleEndian.AppendUint32(section, id)
+	section = append(section, body...)
+	for i := 4 + len(body); i < length; i++ {
+		section = append(section, dwCFANop)
+	}
+	return section
+}
+
+// appendCFIInsts appends the call frame instructions for the rules of proc.
+func appendCFIInsts(b []byte, proc UnwindProc) []byte {
+	loc := proc.Start
+	for _, inst := range proc.Insts {
+		if delta := (inst.PC - loc) / dwCodeAlign; delta != 0 {
+			switch {
+			case delta < 0x40:
+				b = append(b, dwCFAAdvanceLoc|byte(delta))
+			case delta <= 0xFF:
+				b = append(b, dwCFAAdvanceLoc1, byte(delta))
+			case delta <= 0xFFFF:
+				b = binary.LittleEndian.AppendUint16(append(b, dwCFAAdvanceLoc2), uint16(delta))
+			default:
+				b = binary.LittleEndian.AppendUint32(append(b, dwCFAAdvanceLoc4), delta)
+			}
+			loc = inst.PC
+		}
+		reg, _ := dwarfReg(inst.Reg)
+		switch inst.Op {
+		case CFIDefCFA:
+			b = appendULEB(append(b, dwCFADefCFA, reg), uint64(inst.Offset))
+		case CFIDefCFAOffset:
+			b = appendULEB(append(b, dwCFADefCFAOffset), uint64(inst.Offset))
+		case CFIDefCFARegister:
+			b = append(b, dwCFADefCFARegister, reg)
+		case CFIOffset:
+			if inst.Offset <= 0 && reg < 64 {
+				b = appendULEB(append(b, dwCFAOffset|reg), uint64(inst.Offset/dwDataAlign))
+			} else {
+				b = appendSLEB(appendULEB(append(b, dwCFAOffsetExtSf), uint64(reg)), int64(inst.Offset/dwDataAlign))
+			}
+		case CFIRestore:
+			if reg < 64 {
+				b = append(b, dwCFARestore|reg)
+			} else {
+				b = appendULEB(append(b, dwCFARestoreExt), uint64(reg))
+			}
+		case CFIRememberState:
+			b = append(b, dwCFARememberState)
+		case CFIRestoreState:
+			b = append(b, dwCFARestoreState)
+		}
+	}
+	return b
+}
+
+func appendULEB(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+func appendSLEB(b []byte, v int64) []byte {
+	for {
+		c := byte(v & 0x7F)
+		v >>= 7
+		if v == 0 && c&0x40 == 0 || v == -1 && c&0x40 != 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
@@ -0,0 +1,265 @@
+package arm
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// PoolConst is a constant referenced by a pending literal load, written to the code buffer by [Assembler.EmitPool].
+type PoolConst struct {
+	Label Label    // label for the constant, assigned when the constant is emitted
+	Data  [16]byte // little-endian constant data
+	Len   uint8    // 8 or 16 bytes
+}
+
+// VecConst writes the shortest available sequence of instructions which materializes a 128-bit constant
+// in the SIMD register dst, with lane 0 in the lowest bytes of value. For 64-bit vector registers, only the
+// low 8 bytes of value are materialized and the upper 64 bits of the register are cleared. The arrangement of
+// dst is ignored, and the emitted instructions may use any arrangement.
+//
+// Constants are first matched against a single MOVI, MVNI, or FMOV (vector, immediate) instruction with every
+// element size and shift, including the 64-bit byte-mask form of MOVI. Otherwise, a MOVI or MVNI is combined with
+// an ORR or BIC (vector, immediate) for 16-bit or 32-bit elements. If no pair of instructions produces the constant,
+// an LDR (literal) is emitted and the constant is added to the Pool field, to be written by a later call to
+// [Assembler.EmitPool].
+func (a *Assembler) VecConst(dst Reg, value [16]byte) bool {
+	if a.Err != nil {
+		return false
+	}
+	if a.setCallerPos(1) {
+		defer a.SetSourcePos(SourcePos{})
+	}
+	var q bool
+	switch dst.Family() {
+	case RegVec64:
+	case RegVec128:
+		q = true
+	default:
+		a.Err = ErrNoMatch
+		return false
+	}
+	lo, hi := binary.LittleEndian.Uint64(value[:8]), binary.LittleEndian.Uint64(value[8:])
+	if !q {
+		hi = 0
+	}
+
+	if lo == hi || !q {
+		if op, ok := a.vecImm(lo, q); ok {
+			return a.vecImmInst(dst.ID, q, op)
+		}
+		for _, size := range [...]Size{WORD, DWORD} {
+			if first, second, ok := vecImmPair(lo, size); ok {
+				return a.vecImmInst(dst.ID, q, first) && a.vecImmInst(dst.ID, q, second)
+			}
+		}
+	}
+	return a.poolLoad(dst.ID, q, value)
+}
+
+// EmitPool writes all pending constants from the Pool field to the code buffer at the current PC, aligned to
+// 16 bytes, then assigns the label for each constant. Constants must be emitted within 1 MB of the literal loads
+// which reference them, at a location which is not executed (e.g. following an unconditional branch or return).
+// Relocations for the literal loads are applied by [Assembler.ApplyRelocations].
+func (a *Assembler) EmitPool() bool {
+	if a.Err != nil {
+		return false
+	}
+	if len(a.Pool) == 0 {
+		return true
+	}
+	pc := (a.PC + 15) &^ 15
+	end := pc
+	for _, c := range a.Pool {
+		end = (end+uint32(c.Len)-1)&^(uint32(c.Len)-1) + uint32(c.Len)
+	}
+	if int(end) > len(a.Code) {
+		a.Err = ErrInvalidEncoding
+		return false
+	}
+	for ; a.PC < pc; a.PC++ {
+		a.Code[a.PC] = 0
+	}
+	for _, c := range a.Pool {
+		for ; a.PC&(uint32(c.Len)-1) != 0; a.PC++ {
+			a.Code[a.PC] = 0
+		}
+		a.SetLabel(c.Label)
+		a.PC += uint32(copy(a.Code[a.PC:], c.Data[:c.Len]))
+	}
+	a.Pool = a.Pool[:0]
+	return true
+}
+
+// poolLoad emits a literal load of value into the SIMD register id, sharing the label of an identical pending constant.
+func (a *Assembler) poolLoad(id uint8, q bool, value [16]byte) bool {
+	c := PoolConst{Data: value, Len: 16}
+	dst := ScalarQ(id)
+	if !q {
+		c.Data, c.Len, dst = [16]byte{}, 8, ScalarD(id)
+		copy(c.Data[:], value[:8])
+	}
+	for _, pending := range a.Pool {
+		if pending.Len == c.Len && pending.Data == c.Data {
+			return a.Inst(LDR, dst, pending.Label)
+		}
+	}
+	c.Label = a.NewLabel()
+	a.Pool = append(a.Pool, c)
+	return a.Inst(LDR, dst, c.Label)
+}
+
+// vecImmOp is a vector modified-immediate instruction with the element size of its arrangement.
+type vecImmOp struct {
+	Inst Inst
+	Size Size
+	Imm  Arg
+	Mod  Mod // shift modifier, or zero for none
+}
+
+func (a *Assembler) vecImmInst(id uint8, q bool, op vecImmOp) bool {
+	var dst Reg
+	switch {
+	case op.Size == BYTE && q:
+		dst = Vec16B(id)
+	case op.Size == BYTE:
+		dst = Vec8B(id)
+	case op.Size == WORD && q:
+		dst = Vec8H(id)
+	case op.Size == WORD:
+		dst = Vec4H(id)
+	case op.Size == DWORD && q:
+		dst = Vec4S(id)
+	case op.Size == DWORD:
+		dst = Vec2S(id)
+	case q:
+		dst = Vec2D(id)
+	default:
+		dst = ScalarD(id) // MOVI Dd clears the upper 64 bits
+	}
+	if op.Mod.ID == 0 {
+		return a.Inst(op.Inst, dst, op.Imm)
+	}
+	return a.Inst(op.Inst, dst, op.Imm, op.Mod)
+}
+
+// vecImm returns a single instruction which writes the 64-bit pattern v to each half of a vector register,
+// or to the low half when q is false.
+func (a *Assembler) vecImm(v uint64, q bool) (vecImmOp, bool) {
+	if v == splat(v&0xFF, BYTE) {
+		return vecImmOp{Inst: MOVI, Size: BYTE, Imm: Imm(v & 0xFF)}, true
+	}
+	for _, size := range [...]Size{WORD, DWORD} {
+		if elem := v & sizeMask(size); v == splat(elem, size) {
+			if op, ok := vecImmShifted(MOVI, elem, size); ok {
+				return op, true
+			}
+			if op, ok := vecImmShifted(MVNI, ^elem&sizeMask(size), size); ok {
+				return op, true
+			}
+		}
+	}
+	if _, ok := EncodeByteMask(v); ok {
+		return vecImmOp{Inst: MOVI, Size: QWORD, Imm: Wide(v)}, true
+	}
+	if h := uint16(v); v == splat(uint64(h), WORD) && a.hasFeature(FeatFP16) && IsFP8(Half(h).Float64()) {
+		return vecImmOp{Inst: FMOV, Size: WORD, Imm: Half(h)}, true
+	}
+	if s := uint32(v); v == splat(uint64(s), DWORD) && IsFP8(float64(math.Float32frombits(s))) {
+		return vecImmOp{Inst: FMOV, Size: DWORD, Imm: Float(math.Float32frombits(s))}, true
+	}
+	if q && IsFP8(math.Float64frombits(v)) {
+		return vecImmOp{Inst: FMOV, Size: QWORD, Imm: Double(math.Float64frombits(v))}, true
+	}
+	return vecImmOp{}, false
+}
+
+// vecImmPair returns a MOVI or MVNI instruction followed by an ORR or BIC instruction which together write
+// the 64-bit pattern v, for elements of the given size.
+func vecImmPair(v uint64, size Size) (first, second vecImmOp, ok bool) {
+	mask := sizeMask(size)
+	elem := v & mask
+	if v != splat(elem, size) {
+		return first, second, false
+	}
+	for _, inst := range [...]Inst{MOVI, MVNI} {
+		for _, mod := range vecImmMods(size) {
+			for imm := uint64(0); imm < 256; imm++ {
+				base := vecImmValue(imm, mod)
+				if inst == MVNI {
+					base = ^base & mask
+				}
+				first = vecImmOp{Inst: inst, Size: size, Imm: Imm(imm), Mod: mod}
+				if base&^elem == 0 { // ORR sets the remaining bits
+					if second, ok = vecImmShifted(ORR, elem&^base, size); ok {
+						return first, second, true
+					}
+				}
+				if elem&^base == 0 { // BIC clears the extra bits
+					if second, ok = vecImmShifted(BIC, base&^elem, size); ok {
+						return first, second, true
+					}
+				}
+			}
+		}
+	}
+	return first, second, false
+}
+
+// vecImmShifted returns a modified-immediate instruction with an 8-bit immediate shifted into the element value
+// elem. MSL shifts are only returned for MOVI and MVNI.
+func vecImmShifted(inst Inst, elem uint64, size Size) (vecImmOp, bool) {
+	for _, mod := range vecImmMods(size) {
+		if mod.ID == SymMSL && inst != MOVI && inst != MVNI {
+			continue
+		}
+		shift := mod.GetImm()
+		if imm := elem >> shift & 0xFF; vecImmValue(imm, mod) == elem {
+			if shift == 0 {
+				mod = Mod{}
+			}
+			return vecImmOp{Inst: inst, Size: size, Imm: Imm(imm), Mod: mod}, true
+		}
+	}
+	return vecImmOp{}, false
+}
+
+// vecImmMods returns the shift modifiers for modified immediates with elements of the given size.
+func vecImmMods(size Size) []Mod {
+	if size == WORD {
+		return vecImmMods16[:]
+	}
+	return vecImmMods32[:]
+}
+
+var (
+	vecImmMods16 = [...]Mod{ModLSL.Imm(0), ModLSL.Imm(8)}
+	vecImmMods32 = [...]Mod{ModLSL.Imm(0), ModLSL.Imm(8), ModLSL.Imm(16), ModLSL.Imm(24), ModMSL.Imm(8), ModMSL.Imm(16)}
+)
+
+// vecImmValue returns the element value for an 8-bit immediate with a shift modifier.
+func vecImmValue(imm uint64, mod Mod) uint64 {
+	shift := mod.GetImm()
+	if mod.ID == SymMSL {
+		return imm<<shift | (1<<shift - 1) // shifting ones
+	}
+	return imm << shift
+}
+
+// splat repeats an element of the given size across 64 bits.
+func splat(elem uint64, size Size) uint64 {
+	for bits := uint(8) << (size - BYTE); bits < 64; bits *= 2 {
+		elem |= elem << bits
+	}
+	return elem
+}
+
+// sizeMask returns a mask for the low bits of an element of the given size.
+func sizeMask(size Size) uint64 {
+	if size >= QWORD {
+		return math.MaxUint64
+	}
+	return 1<<(8<<(size-BYTE)) - 1
+}
+
+// hasFeature returns true if the target CPU supports f, or if no target CPU is set.
+func (a *Assembler) hasFeature(f Feature) bool { return a.CPU == nil || a.CPU.Features.Has(f) }